@@ -184,7 +184,7 @@ var (
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		ibcfeetypes.ModuleName:         nil,
-		incentivetypes.ModuleName:      nil, // this line is needed to create an account for incentive module
+		incentivetypes.ModuleName:      {authtypes.Burner}, // burns the rewards of BTC delegations opting out of rewards
 	}
 )
 
@@ -377,8 +377,21 @@ func NewBabylonApp(
 	checkpointingKeeper := checkpointingkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[checkpointingtypes.StoreKey]),
-		privSigner.WrappedPV,
+		privSigner.BlsSigner(),
 		epochingKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	// the evidence of a conflicting checkpoint found on BTC is persisted under
+	// the data directory before the node panics or halts, as configured
+	conflictHandler, err := checkpointingtypes.NewConflictingCheckpointHandler(
+		cast.ToString(appOpts.Get("checkpointing.on-conflicting-checkpoint")),
+	)
+	if err != nil {
+		panic(err)
+	}
+	checkpointingKeeper.SetConflictingCheckpointHandler(
+		filepath.Join(homePath, "data", "conflicting_checkpoints"),
+		conflictHandler,
 	)
 
 	// set proposal extension
@@ -676,9 +689,6 @@ func NewBabylonApp(
 		checkpointingtypes.NewMultiCheckpointingHooks(app.EpochingKeeper.Hooks(), app.ZoneConciergeKeeper.Hooks(), app.MonitorKeeper.Hooks()),
 	)
 	app.BtcCheckpointKeeper = btcCheckpointKeeper
-	app.BTCLightClientKeeper = *btclightclientKeeper.SetHooks(
-		btclightclienttypes.NewMultiBTCLightClientHooks(app.BtcCheckpointKeeper.Hooks()),
-	)
 
 	// set up BTC staking keeper
 	app.BTCStakingKeeper = btcstakingkeeper.NewKeeper(
@@ -687,9 +697,14 @@ func NewBabylonApp(
 		&btclightclientKeeper,
 		&btcCheckpointKeeper,
 		&checkpointingKeeper,
+		app.IncentiveKeeper,
 		btcNetParams,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	// make BTCCheckpoint and BTCStaking to subscribe to the BTC light client's hooks
+	app.BTCLightClientKeeper = *btclightclientKeeper.SetHooks(
+		btclightclienttypes.NewMultiBTCLightClientHooks(app.BtcCheckpointKeeper.Hooks(), app.BTCStakingKeeper.Hooks()),
+	)
 	// set up finality keeper
 	app.FinalityKeeper = finalitykeeper.NewKeeper(
 		appCodec,
@@ -902,6 +917,9 @@ func NewBabylonApp(
 	)
 	// Babylon does not want EndBlock processing in staking
 	app.ModuleManager.OrderEndBlockers = append(app.ModuleManager.OrderEndBlockers[:2], app.ModuleManager.OrderEndBlockers[2+1:]...) // remove stakingtypes.ModuleName
+	// the checkpointing module handles conflicting checkpoints once the block
+	// carrying them is committed
+	app.ModuleManager.SetOrderPrepareCheckStaters(checkpointingtypes.ModuleName)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
@@ -944,6 +962,7 @@ func NewBabylonApp(
 	if err != nil {
 		panic(err)
 	}
+	app.setupUpgradeHandlers()
 
 	autocliv1.RegisterQueryServer(app.GRPCQueryRouter(), runtimeservices.NewAutoCLIQueryService(app.ModuleManager.Modules))
 
@@ -1010,6 +1029,7 @@ func NewBabylonApp(
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
+	app.SetPrepareCheckStater(app.PrepareCheckStater)
 	app.SetAnteHandler(anteHandler)
 
 	// set postHandler
@@ -1088,6 +1108,13 @@ func (app *BabylonApp) EndBlocker(ctx sdk.Context) (sdk.EndBlock, error) {
 	return app.ModuleManager.EndBlock(ctx)
 }
 
+// PrepareCheckStater application updates after commit
+func (app *BabylonApp) PrepareCheckStater(ctx sdk.Context) {
+	if err := app.ModuleManager.PrepareCheckState(ctx); err != nil {
+		panic(err)
+	}
+}
+
 // InitChainer application update at chain initialization
 func (app *BabylonApp) InitChainer(ctx sdk.Context, req *abci.RequestInitChain) (*abci.ResponseInitChain, error) {
 	var genesisState GenesisState
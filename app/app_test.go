@@ -9,7 +9,10 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/require"
+
+	incentivetypes "github.com/babylonchain/babylon/x/incentive/types"
 )
 
 func TestBabylonBlockedAddrs(t *testing.T) {
@@ -93,3 +96,27 @@ func TestUpgradeStateOnGenesis(t *testing.T) {
 		}
 	}
 }
+
+func TestUpdateModuleAccountPermissions(t *testing.T) {
+	app := Setup(t, false)
+	ctx := app.NewContext(false)
+
+	// simulate a chain where the incentive module account was created
+	// before it was granted the burner permission
+	macc := app.AccountKeeper.GetModuleAccount(ctx, incentivetypes.ModuleName)
+	require.True(t, macc.HasPermission(authtypes.Burner))
+	baseAcc := authtypes.NewBaseAccount(macc.GetAddress(), nil, macc.GetAccountNumber(), macc.GetSequence())
+	app.AccountKeeper.SetModuleAccount(ctx, authtypes.NewModuleAccount(baseAcc, incentivetypes.ModuleName))
+	macc = app.AccountKeeper.GetModuleAccount(ctx, incentivetypes.ModuleName)
+	require.False(t, macc.HasPermission(authtypes.Burner))
+
+	app.updateModuleAccountPermissions(ctx)
+
+	// the incentive module account is a burner again, and keeps its account number
+	updatedMacc := app.AccountKeeper.GetModuleAccount(ctx, incentivetypes.ModuleName)
+	require.True(t, updatedMacc.HasPermission(authtypes.Burner))
+	require.Equal(t, macc.GetAccountNumber(), updatedMacc.GetAccountNumber())
+	for name, perms := range maccPerms {
+		require.ElementsMatch(t, perms, app.AccountKeeper.GetModuleAccount(ctx, name).GetPermissions())
+	}
+}
@@ -185,6 +185,7 @@ func genesisStateWithValSet(t *testing.T,
 
 	checkpointingGenesis := &checkpointingtypes.GenesisState{
 		GenesisKeys: valSet,
+		Params:      checkpointingtypes.DefaultParams(),
 	}
 	genesisState[checkpointingtypes.ModuleName] = app.AppCodec().MustMarshalJSON(checkpointingGenesis)
 
@@ -0,0 +1,63 @@
+package app
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// UpgradeName is the name of the software upgrade that runs the in-place
+// store migrations of the modules whose consensus version has been bumped
+const UpgradeName = "v0.9.0"
+
+// setupUpgradeHandlers registers the handler of the software upgrade, which
+// updates the permissions of the module accounts and migrates the store of
+// each module from the version recorded in the upgrade keeper to its current
+// consensus version
+func (app *BabylonApp) setupUpgradeHandlers() {
+	app.UpgradeKeeper.SetUpgradeHandler(
+		UpgradeName,
+		func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			app.updateModuleAccountPermissions(ctx)
+			return app.ModuleManager.RunMigrations(ctx, app.configurator, fromVM)
+		},
+	)
+}
+
+// updateModuleAccountPermissions sets the permissions of each stored module
+// account to the ones in maccPerms. Module accounts are created with their
+// permissions once, so permissions added later (e.g., the incentive module
+// becoming a burner) do not take effect on existing chains otherwise
+func (app *BabylonApp) updateModuleAccountPermissions(ctx context.Context) {
+	// iterate over module names in a deterministic order
+	names := make([]string, 0, len(maccPerms))
+	for name := range maccPerms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		addr := authtypes.NewModuleAddress(name)
+		acc := app.AccountKeeper.GetAccount(ctx, addr)
+		if acc == nil {
+			// the module account will be created with the
+			// up-to-date permissions upon its first use
+			continue
+		}
+		macc, ok := acc.(sdk.ModuleAccountI)
+		if !ok {
+			continue
+		}
+		perms := maccPerms[name]
+		if slices.Equal(macc.GetPermissions(), perms) {
+			continue
+		}
+		baseAcc := authtypes.NewBaseAccount(addr, macc.GetPubKey(), macc.GetAccountNumber(), macc.GetSequence())
+		app.AccountKeeper.SetModuleAccount(ctx, authtypes.NewModuleAccount(baseAcc, name, perms...))
+	}
+}
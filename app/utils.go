@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/config"
 
 	"github.com/babylonchain/babylon/privval"
+	checkpointingkeeper "github.com/babylonchain/babylon/x/checkpointing/keeper"
 )
 
 const defaultConfigTemplate = `# This is a TOML config file.
@@ -35,6 +36,18 @@ broadcast-mode = "{{ .BroadcastMode }}"
 
 type PrivSigner struct {
 	WrappedPV *privval.WrappedFilePV
+	// RemoteBlsSigner, if set, signs checkpoints in place of WrappedPV, such
+	// that the BLS key is kept off the node
+	RemoteBlsSigner *privval.RemoteBlsSigner
+}
+
+// BlsSigner returns the signer of checkpoints, i.e., the remote BLS signer if
+// configured, and the local WrappedPV otherwise
+func (ps *PrivSigner) BlsSigner() checkpointingkeeper.BlsSigner {
+	if ps.RemoteBlsSigner != nil {
+		return ps.RemoteBlsSigner
+	}
+	return ps.WrappedPV
 }
 
 func InitPrivSigner(nodeDir string) (*PrivSigner, error) {
@@ -7,4 +7,7 @@ var (
 	ErrDustOutputFound            = errors.New("transaction contains a dust output")
 	ErrInsufficientSlashingAmount = errors.New("insufficient slashing amount")
 	ErrInsufficientChangeAmount   = errors.New("insufficient change amount")
+	ErrInsufficientSlashingFee    = errors.New("insufficient slashing transaction fee")
+	ErrInvalidChangeOutput        = errors.New("invalid change output")
+	ErrUnexpectedSigHashType      = errors.New("unexpected sighash type")
 )
@@ -49,11 +49,7 @@ func buildSlashingTxFromOutpoint(
 	}
 
 	// Calculate the amount to be slashed
-	slashingRateFloat64, err := slashingRate.Float64()
-	if err != nil {
-		return nil, fmt.Errorf("error converting slashing rate to float64: %w", err)
-	}
-	slashingAmount := btcutil.Amount(stakingAmount).MulF64(slashingRateFloat64)
+	slashingAmount := GetSlashingAmount(stakingAmount, slashingRate)
 	if slashingAmount <= 0 {
 		return nil, ErrInsufficientSlashingAmount
 	}
@@ -221,7 +217,8 @@ func IsSimpleTransfer(tx *wire.MsgTx) error {
 // - the lock time of the slashing transaction is 0.
 // - the slashing transaction has exactly two outputs, and:
 //   - the first output must pay to the provided slashing address.
-//   - the first output must pay at least (staking output value * slashing rate) to the slashing address.
+//   - the first output must pay at least (staking output value * slashing rate) to the slashing address,
+//     rounded down to a whole satoshi as in GetSlashingAmount.
 //   - neither of the outputs are considered dust.
 //
 // - the min fee for slashing tx is preserved
@@ -259,14 +256,11 @@ func ValidateSlashingTx(
 		return fmt.Errorf("slashing transaction must have exactly 2 outputs")
 	}
 
-	// Verify that at least staking output value * slashing rate is slashed.
-	slashingRateFloat64, err := slashingRate.Float64()
-	if err != nil {
-		return fmt.Errorf("error converting slashing rate to float64: %w", err)
-	}
-	minSlashingAmount := btcutil.Amount(stakingOutputValue).MulF64(slashingRateFloat64)
+	// Verify that at least staking output value * slashing rate is slashed,
+	// rounded down in the same way as when building the slashing transaction.
+	minSlashingAmount := GetSlashingAmount(stakingOutputValue, slashingRate)
 	if btcutil.Amount(slashingTx.TxOut[0].Value) < minSlashingAmount {
-		return fmt.Errorf("slashing transaction must slash at least staking output value * slashing rate")
+		return fmt.Errorf("slashing transaction must slash at least staking output value * slashing rate (%d sat), got: %d sat", minSlashingAmount, slashingTx.TxOut[0].Value)
 	}
 
 	// Verify that the first output pays to the provided slashing address.
@@ -317,12 +311,12 @@ func ValidateSlashingTx(
 
 	// Ensure that the staking transaction value is larger than the sum of slashing transaction output values.
 	if stakingOutputValue <= slashingTxOutSum {
-		return fmt.Errorf("slashing transaction must not spend more than staking transaction")
+		return fmt.Errorf("%w: slashing transaction must not spend more than staking transaction", ErrInsufficientSlashingFee)
 	}
 
 	// Ensure that the slashing transaction fee is larger than the specified minimum fee.
 	if stakingOutputValue-slashingTxOutSum < slashingTxMinFee {
-		return fmt.Errorf("slashing transaction fee must be larger than %d", slashingTxMinFee)
+		return fmt.Errorf("%w: slashing transaction fee must be larger than %d", ErrInsufficientSlashingFee, slashingTxMinFee)
 	}
 
 	return nil
@@ -385,6 +379,63 @@ func CheckTransactions(
 	return nil
 }
 
+// ValidateStakingTxChangeOutputs validates the outputs of the staking
+// transaction other than the staking output, i.e., the change outputs.
+// OP_RETURN outputs are not change outputs and are skipped. Each change output
+// must
+// - pay to a standard address on the given network,
+// - not pay to the slashing address, and
+// - not pay to the staking script
+func ValidateStakingTxChangeOutputs(
+	stakingTx *wire.MsgTx,
+	stakingOutputIdx uint32,
+	slashingAddress btcutil.Address,
+	net *chaincfg.Params,
+) error {
+	if stakingOutputIdx >= uint32(len(stakingTx.TxOut)) {
+		return fmt.Errorf("invalid staking output index %d, tx has %d outputs", stakingOutputIdx, len(stakingTx.TxOut))
+	}
+
+	stakingPkScript := stakingTx.TxOut[stakingOutputIdx].PkScript
+	slashingPkScript, err := txscript.PayToAddrScript(slashingAddress)
+	if err != nil {
+		return err
+	}
+
+	for i, out := range stakingTx.TxOut {
+		if uint32(i) == stakingOutputIdx {
+			continue
+		}
+
+		class, addrs, _, err := txscript.ExtractPkScriptAddrs(out.PkScript, net)
+		if err != nil {
+			return fmt.Errorf("%w: output %d cannot be parsed: %v", ErrInvalidChangeOutput, i, err)
+		}
+		switch class {
+		case txscript.NullDataTy:
+			// data carrier output, not a change output
+			continue
+		case txscript.PubKeyHashTy, txscript.ScriptHashTy, txscript.WitnessV0PubKeyHashTy,
+			txscript.WitnessV0ScriptHashTy, txscript.WitnessV1TaprootTy:
+			// standard address
+		default:
+			return fmt.Errorf("%w: output %d does not pay to a standard address", ErrInvalidChangeOutput, i)
+		}
+		if len(addrs) != 1 || !addrs[0].IsForNet(net) {
+			return fmt.Errorf("%w: output %d does not pay to an address on network %s", ErrInvalidChangeOutput, i, net.Name)
+		}
+
+		if bytes.Equal(out.PkScript, slashingPkScript) {
+			return fmt.Errorf("%w: output %d pays to the slashing address", ErrInvalidChangeOutput, i)
+		}
+		if bytes.Equal(out.PkScript, stakingPkScript) {
+			return fmt.Errorf("%w: output %d pays to the staking script", ErrInvalidChangeOutput, i)
+		}
+	}
+
+	return nil
+}
+
 func signTxWithOneScriptSpendInputFromTapLeafInternal(
 	txToSign *wire.MsgTx,
 	fundingOutput *wire.TxOut,
@@ -560,6 +611,26 @@ func checkTxBeforeSigning(txToSign *wire.MsgTx, fundingTx *wire.MsgTx, fundingOu
 	return nil
 }
 
+// ValidateSigHashType checks that the given Schnorr signature uses SigHashDefault,
+// the only sighash type under which signatures of the BTC staking protocol are
+// created and verified. Following BIP-341, a 64-byte signature implies
+// SigHashDefault, while a 65-byte signature carries an explicit sighash type in
+// its last byte and is thus rejected
+// NOTE: adaptor signatures have a fixed size without a sighash byte, and the
+// Schnorr signatures decrypted from them always use SigHashDefault
+func ValidateSigHashType(signature []byte) error {
+	switch len(signature) {
+	case schnorr.SignatureSize:
+		return nil
+	case schnorr.SignatureSize + 1:
+		return fmt.Errorf("%w: expected %#x, got %#x", ErrUnexpectedSigHashType,
+			txscript.SigHashDefault, signature[schnorr.SignatureSize])
+	default:
+		return fmt.Errorf("invalid schnorr signature length: expected %d, got %d",
+			schnorr.SignatureSize, len(signature))
+	}
+}
+
 // VerifyTransactionSigWithOutput verifies that:
 // - provided transaction has exactly one input
 // - provided signature is valid schnorr BIP340 signature
@@ -587,23 +658,15 @@ func VerifyTransactionSigWithOutput(
 		return fmt.Errorf("public key must not be nil")
 	}
 
-	tapLeaf := txscript.NewBaseTapLeaf(script)
-
-	inputFetcher := txscript.NewCannedPrevOutputFetcher(
-		fundingOutput.PkScript,
-		fundingOutput.Value,
-	)
-
-	sigHashes := txscript.NewTxSigHashes(transaction, inputFetcher)
-
-	sigHash, err := txscript.CalcTapscriptSignaturehash(
-		sigHashes, txscript.SigHashDefault, transaction, 0, inputFetcher, tapLeaf,
-	)
-
+	sigHash, err := CalcTapscriptSigHash(transaction, fundingOutput, script)
 	if err != nil {
 		return err
 	}
 
+	if err := ValidateSigHashType(signature); err != nil {
+		return err
+	}
+
 	parsedSig, err := schnorr.ParseSignature(signature)
 
 	if err != nil {
@@ -623,6 +686,12 @@ func VerifyTransactionSigWithOutput(
 // - provided transaction has exactly one input
 // - provided signature is valid adaptor signature
 // - provided signature is signing whole provided transaction (SigHashDefault)
+// NOTE: the signed message is the BIP-341 tapscript sighash, which is already
+// domain separated by the "TapSighash" tag and commits to the spent outpoint,
+// its amount and script, and the tapscript leaf. Covenant signatures thus
+// cannot be replayed on another transaction or spending path. No additional
+// domain tag (e.g., chain ID) can be added here, since the decrypted adaptor
+// signatures must remain valid Bitcoin signatures for the slashing/unbonding txs
 func EncVerifyTransactionSigWithOutput(
 	transaction *wire.MsgTx,
 	fundingOut *wire.TxOut,
@@ -643,22 +712,34 @@ func EncVerifyTransactionSigWithOutput(
 		return fmt.Errorf("public key must not be nil")
 	}
 
+	sigHash, err := CalcTapscriptSigHash(transaction, fundingOut, script)
+	if err != nil {
+		return err
+	}
+
+	return signature.EncVerify(pubKey, encKey, sigHash)
+}
+
+// CalcTapscriptSigHash returns the BIP-341 tapscript sighash of the only input
+// of the given transaction under SigHashDefault, where the input spends the
+// given funding output via the given script path. It is the message that the
+// (adaptor) signatures verified by VerifyTransactionSigWithOutput and
+// EncVerifyTransactionSigWithOutput are signed over
+func CalcTapscriptSigHash(
+	transaction *wire.MsgTx,
+	fundingOutput *wire.TxOut,
+	script []byte,
+) ([]byte, error) {
 	tapLeaf := txscript.NewBaseTapLeaf(script)
 
 	inputFetcher := txscript.NewCannedPrevOutputFetcher(
-		fundingOut.PkScript,
-		fundingOut.Value,
+		fundingOutput.PkScript,
+		fundingOutput.Value,
 	)
 
 	sigHashes := txscript.NewTxSigHashes(transaction, inputFetcher)
 
-	sigHash, err := txscript.CalcTapscriptSignaturehash(
+	return txscript.CalcTapscriptSignaturehash(
 		sigHashes, txscript.SigHashDefault, transaction, 0, inputFetcher, tapLeaf,
 	)
-
-	if err != nil {
-		return err
-	}
-
-	return signature.EncVerify(pubKey, encKey, sigHash)
 }
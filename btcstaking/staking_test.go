@@ -112,6 +112,108 @@ func FuzzGeneratingValidStakingSlashingTx(f *testing.F) {
 	})
 }
 
+func TestGetSlashingAmount(t *testing.T) {
+	testCases := []struct {
+		name           string
+		outputValue    int64
+		slashingRate   string
+		expectedAmount btcutil.Amount
+	}{
+		// products that are whole numbers but not exact in floating point
+		{"0.29 slightly below whole in float64", 100, "0.29", 29},
+		{"0.57 slightly below whole in float64", 100, "0.57", 57},
+		{"0.07 slightly above whole in float64", 1000, "0.07", 70},
+		// fractional products are rounded down in favour of the staker
+		{"half satoshi", 10001, "0.5", 5000},
+		{"fraction just below one satoshi", 99, "0.01", 0},
+		{"fraction just below a whole", 101, "0.99", 99},
+		{"repeating rate", 3, "0.33", 0},
+		{"fraction just above a whole", 1234567, "0.15", 185185},
+		{"max BTC supply", 2100000000000000, "0.29", 609000000000000},
+		{"max BTC supply minus one satoshi", 2099999999999999, "0.99", 2078999999999999},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			slashingRate := sdkmath.LegacyMustNewDecFromStr(tc.slashingRate)
+			require.True(t, btcstaking.IsRateValid(slashingRate))
+			require.Equal(t, tc.expectedAmount, btcstaking.GetSlashingAmount(tc.outputValue, slashingRate))
+		})
+	}
+}
+
+// TestSlashingTxRounding checks that the slashing txs built at every valid
+// slashing rate pay exactly the rounded down slashing amount, and that the
+// verification accepts them but rejects a slashing output one satoshi lower
+func TestSlashingTxRounding(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	sd := genValidStakingScriptData(t, r)
+	slashingAddress, err := genRandomBTCAddress(r)
+	require.NoError(t, err)
+	slashingLockTime := uint16(100)
+	fee := int64(2000)
+
+	for _, stakingValue := range []int64{1000001, 1000033, 1000050, 1000099, 123456789} {
+		info, err := btcstaking.BuildStakingInfo(
+			sd.StakerKey,
+			[]*btcec.PublicKey{sd.FinalityProviderKey},
+			[]*btcec.PublicKey{sd.CovenantKey},
+			1,
+			sd.StakingTime,
+			btcutil.Amount(stakingValue),
+			&chaincfg.MainNetParams,
+		)
+		require.NoError(t, err)
+		stakingTx := wire.NewMsgTx(2)
+		stakingTx.AddTxOut(info.StakingOutput)
+
+		for ratePercent := int64(1); ratePercent < 100; ratePercent++ {
+			slashingRate := sdkmath.LegacyNewDecWithPrec(ratePercent, btcstaking.SlashingRatePrecision)
+			expectedAmount := stakingValue * ratePercent / 100
+
+			slashingTx, err := btcstaking.BuildSlashingTxFromStakingTxStrict(
+				stakingTx,
+				0,
+				slashingAddress,
+				sd.StakerKey,
+				slashingLockTime,
+				fee,
+				slashingRate,
+				&chaincfg.MainNetParams,
+			)
+			require.NoError(t, err)
+			require.Equal(t, expectedAmount, slashingTx.TxOut[0].Value, "staking value %d, slashing rate %s", stakingValue, slashingRate)
+
+			err = btcstaking.CheckTransactions(
+				slashingTx,
+				stakingTx,
+				0,
+				fee,
+				slashingRate,
+				slashingAddress,
+				sd.StakerKey,
+				slashingLockTime,
+				&chaincfg.MainNetParams,
+			)
+			require.NoError(t, err)
+
+			// slashing one satoshi less than the rounded down amount is rejected
+			slashingTx.TxOut[0].Value--
+			err = btcstaking.ValidateSlashingTx(
+				slashingTx,
+				slashingAddress,
+				slashingRate,
+				fee,
+				stakingValue,
+				sd.StakerKey,
+				slashingLockTime,
+				&chaincfg.MainNetParams,
+			)
+			require.Error(t, err)
+		}
+	}
+}
+
 func genRandomBTCAddress(r *rand.Rand) (*btcutil.AddressPubKeyHash, error) {
 	return btcutil.NewAddressPubKeyHash(datagen.GenRandomByteArray(r, 20), &chaincfg.MainNetParams)
 }
@@ -163,11 +265,8 @@ func testSlashingTx(
 		// - the change output is less than the dust threshold.
 		// - The slashing output is less than the dust threshold.
 
-		slashingRateFloat64, err2 := slashingRate.Float64()
-		require.NoError(t, err2)
-
 		stakingAmount := btcutil.Amount(stakingTx.TxOut[stakingOutputIdx].Value)
-		slashingAmount := stakingAmount.MulF64(slashingRateFloat64)
+		slashingAmount := btcstaking.GetSlashingAmount(int64(stakingAmount), slashingRate)
 		changeAmount := stakingAmount - slashingAmount - btcutil.Amount(fee)
 
 		// check if the created outputs are not dust
@@ -238,5 +337,90 @@ func FuzzGeneratingSignatureValidation(f *testing.F) {
 		)
 
 		require.NoError(t, err)
+
+		// the same signature with an explicit sighash type is rejected
+		sigHashTypes := []txscript.SigHashType{
+			txscript.SigHashDefault,
+			txscript.SigHashAll,
+			txscript.SigHashNone,
+			txscript.SigHashSingle,
+			txscript.SigHashAll | txscript.SigHashAnyOneCanPay,
+		}
+		sigHashType := sigHashTypes[r.Intn(len(sigHashTypes))]
+		sigWithSigHash := append(sig.Serialize(), byte(sigHashType))
+		err = btcstaking.ValidateSigHashType(sigWithSigHash)
+		require.ErrorIs(t, err, btcstaking.ErrUnexpectedSigHashType)
+		err = btcstaking.VerifyTransactionSigWithOutput(
+			tx,
+			foundingOutput,
+			script,
+			pk.PubKey(),
+			sigWithSigHash,
+		)
+		require.ErrorIs(t, err, btcstaking.ErrUnexpectedSigHashType)
+	})
+}
+
+func FuzzValidateStakingTxChangeOutputs(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		net := &chaincfg.MainNetParams
+		sd := genValidStakingScriptData(t, r)
+
+		info, err := btcstaking.BuildStakingInfo(
+			sd.StakerKey,
+			[]*btcec.PublicKey{sd.FinalityProviderKey},
+			[]*btcec.PublicKey{sd.CovenantKey},
+			1,
+			sd.StakingTime,
+			btcutil.Amount(r.Intn(5000)+5000),
+			net,
+		)
+		require.NoError(t, err)
+		slashingAddr, err := genRandomBTCAddress(r)
+		require.NoError(t, err)
+		changeAddr, err := genRandomBTCAddress(r)
+		require.NoError(t, err)
+		changeScript, err := txscript.PayToAddrScript(changeAddr)
+		require.NoError(t, err)
+		slashingScript, err := txscript.PayToAddrScript(slashingAddr)
+		require.NoError(t, err)
+		opReturnScript, err := txscript.NullDataScript(datagen.GenRandomByteArray(r, 32))
+		require.NoError(t, err)
+
+		genStakingTx := func(changePkScript []byte) *wire.MsgTx {
+			stakingTx := wire.NewMsgTx(2)
+			stakingTx.AddTxOut(info.StakingOutput)
+			stakingTx.AddTxOut(wire.NewTxOut(0, opReturnScript))
+			stakingTx.AddTxOut(wire.NewTxOut(int64(r.Intn(5000)+1), changePkScript))
+			return stakingTx
+		}
+
+		// valid change output, and OP_RETURN output is skipped
+		err = btcstaking.ValidateStakingTxChangeOutputs(genStakingTx(changeScript), 0, slashingAddr, net)
+		require.NoError(t, err)
+
+		// staking tx without change output
+		stakingTx := wire.NewMsgTx(2)
+		stakingTx.AddTxOut(info.StakingOutput)
+		err = btcstaking.ValidateStakingTxChangeOutputs(stakingTx, 0, slashingAddr, net)
+		require.NoError(t, err)
+
+		// invalid staking output index
+		err = btcstaking.ValidateStakingTxChangeOutputs(genStakingTx(changeScript), 3, slashingAddr, net)
+		require.Error(t, err)
+
+		// non-standard change output
+		err = btcstaking.ValidateStakingTxChangeOutputs(genStakingTx(datagen.GenRandomByteArray(r, 32)), 0, slashingAddr, net)
+		require.ErrorIs(t, err, btcstaking.ErrInvalidChangeOutput)
+
+		// change output to the slashing address
+		err = btcstaking.ValidateStakingTxChangeOutputs(genStakingTx(slashingScript), 0, slashingAddr, net)
+		require.ErrorIs(t, err, btcstaking.ErrInvalidChangeOutput)
+
+		// change output to the staking script
+		err = btcstaking.ValidateStakingTxChangeOutputs(genStakingTx(info.StakingOutput.PkScript), 0, slashingAddr, net)
+		require.ErrorIs(t, err, btcstaking.ErrInvalidChangeOutput)
 	})
 }
@@ -47,6 +47,15 @@ func unspendableKeyPathInternalPubKey() btcec.PublicKey {
 	return unspendableKeyPathKey
 }
 
+// UnspendableKeyPathInternalPubKey returns the internal key of all taproot
+// outputs built by this package. It is the fixed NUMS point H defined in
+// BIP-341 rather than a key derived per output, so the taproot output key of
+// an output only depends on its script tree
+func UnspendableKeyPathInternalPubKey() *btcec.PublicKey {
+	key := unspendableKeyPathInternalPubKey()
+	return &key
+}
+
 func NewTaprootTreeFromScripts(
 	scripts [][]byte,
 ) *txscript.IndexedTapScriptTree {
@@ -167,6 +176,17 @@ func (t *taprootScriptHolder) scriptSpendInfoByName(
 	}, nil
 }
 
+// leafIndex returns the position of the leaf with the given hash in the script tree
+func (t *taprootScriptHolder) leafIndex(leafHash chainhash.Hash) (int, error) {
+	scriptIdx, ok := t.scriptTree.LeafProofIndex[leafHash]
+
+	if !ok {
+		return 0, fmt.Errorf("script not found in script tree")
+	}
+
+	return scriptIdx, nil
+}
+
 func (t *taprootScriptHolder) taprootPkScript(net *chaincfg.Params) ([]byte, error) {
 	return DeriveTaprootPkScript(
 		t.scriptTree,
@@ -403,6 +423,17 @@ func (i *StakingInfo) SlashingPathSpendInfo() (*SpendInfo, error) {
 	return i.scriptHolder.scriptSpendInfoByName(i.slashingPathLeafHash)
 }
 
+// InternalKey returns the unspendable internal key of the staking output
+func (i *StakingInfo) InternalKey() *btcec.PublicKey {
+	return i.scriptHolder.internalPubKey
+}
+
+// LeafIndex returns the position of the leaf revealed by the given spend info
+// in the script tree of the staking output
+func (i *StakingInfo) LeafIndex(spendInfo *SpendInfo) (int, error) {
+	return i.scriptHolder.leafIndex(spendInfo.RevealedLeaf.TapHash())
+}
+
 // Unbonding script has 2 spending paths:
 // 1. Staker can spend after relative time lock - staking
 // 2. Staker can spend with finality provider and covenant cooperation any time.
@@ -476,15 +507,22 @@ func (i *UnbondingInfo) SlashingPathSpendInfo() (*SpendInfo, error) {
 	return i.scriptHolder.scriptSpendInfoByName(i.slashingPathLeafHash)
 }
 
-// IsRateValid checks if the given rate is between the valid range i.e., (0,1) with a precision of at most 2 decimal places.
+// SlashingRatePrecision is the maximum number of decimal places of a slashing
+// rate. Together with rounding down in GetSlashingAmount, it makes the slashing
+// amount computable exactly with integer arithmetic by any implementation
+const SlashingRatePrecision = 2
+
+// IsRateValid checks if the given rate is between the valid range i.e., (0,1) with a precision of at most
+// SlashingRatePrecision decimal places.
 func IsRateValid(rate sdkmath.LegacyDec) bool {
 	// Check if the slashing rate is between 0 and 1
 	if rate.LTE(sdkmath.LegacyZeroDec()) || rate.GTE(sdkmath.LegacyOneDec()) {
 		return false
 	}
 
-	// Multiply by 100 to move the decimal places and check if precision is at most 2 decimal places
-	multipliedRate := rate.Mul(sdkmath.LegacyNewDec(100))
+	// Multiply by 10^SlashingRatePrecision to move the decimal places and check if precision is at most
+	// SlashingRatePrecision decimal places
+	multipliedRate := rate.Mul(sdkmath.LegacyNewDec(10).Power(SlashingRatePrecision))
 
 	// Truncate the rate to remove decimal places
 	truncatedRate := multipliedRate.TruncateDec()
@@ -493,6 +531,16 @@ func IsRateValid(rate sdkmath.LegacyDec) bool {
 	return multipliedRate.Equal(truncatedRate)
 }
 
+// GetSlashingAmount returns the amount of the given output value that is
+// slashed under the given slashing rate, i.e., outputValue * slashingRate
+// rounded down to a whole satoshi. Rounding down favours the staker, and the
+// product is computed exactly rather than in floating point, such that slashing
+// txs built and verified by different parties agree on the amount to the satoshi.
+// The slashing rate is assumed to be valid w.r.t. IsRateValid
+func GetSlashingAmount(outputValue int64, slashingRate sdkmath.LegacyDec) btcutil.Amount {
+	return btcutil.Amount(sdkmath.LegacyNewDec(outputValue).Mul(slashingRate).TruncateInt64())
+}
+
 type RelativeTimeLockTapScriptInfo struct {
 	// data necessary to build witness for given script
 	SpendInfo *SpendInfo
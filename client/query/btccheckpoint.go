@@ -61,3 +61,17 @@ func (c *QueryClient) BTCCheckpointsInfo(pagination *sdkquerytypes.PageRequest)
 	})
 	return resp, err
 }
+
+// RecentCheckpoints queries btccheckpoint module for the checkpoints of the most recent epochs
+func (c *QueryClient) RecentCheckpoints(limit uint32) (*btcctypes.QueryRecentCheckpointsResponse, error) {
+	var resp *btcctypes.QueryRecentCheckpointsResponse
+	err := c.QueryBTCCheckpoint(func(ctx context.Context, queryClient btcctypes.QueryClient) error {
+		var err error
+		req := &btcctypes.QueryRecentCheckpointsRequest{
+			Limit: limit,
+		}
+		resp, err = queryClient.RecentCheckpoints(ctx, req)
+		return err
+	})
+	return resp, err
+}
@@ -1,10 +1,14 @@
 package cmd
 
 import (
+	"time"
+
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 
+	"github.com/babylonchain/babylon/privval"
 	bbn "github.com/babylonchain/babylon/types"
+	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
 )
 
 type BtcConfig struct {
@@ -17,19 +21,50 @@ func defaultBabylonBtcConfig() BtcConfig {
 	}
 }
 
+type BlsSignerConfig struct {
+	RemoteAddress string        `mapstructure:"remote-address"`
+	Timeout       time.Duration `mapstructure:"timeout"`
+	TLSCertFile   string        `mapstructure:"tls-cert-file"`
+	TLSKeyFile    string        `mapstructure:"tls-key-file"`
+	TLSCAFile     string        `mapstructure:"tls-ca-file"`
+}
+
+func defaultBlsSignerConfig() BlsSignerConfig {
+	return BlsSignerConfig{
+		RemoteAddress: "",
+		Timeout:       privval.DefaultRemoteBlsSignerTimeout,
+	}
+}
+
+type CheckpointingConfig struct {
+	OnConflictingCheckpoint string `mapstructure:"on-conflicting-checkpoint"`
+}
+
+func defaultCheckpointingConfig() CheckpointingConfig {
+	return CheckpointingConfig{
+		OnConflictingCheckpoint: checkpointingtypes.PanicOnConflictingCheckpoint,
+	}
+}
+
 type BabylonAppConfig struct {
 	serverconfig.Config `mapstructure:",squash"`
 
 	Wasm wasmtypes.WasmConfig `mapstructure:"wasm"`
 
 	BtcConfig BtcConfig `mapstructure:"btc-config"`
+
+	BlsSignerConfig BlsSignerConfig `mapstructure:"bls-signer"`
+
+	CheckpointingConfig CheckpointingConfig `mapstructure:"checkpointing"`
 }
 
 func DefaultBabylonConfig() *BabylonAppConfig {
 	return &BabylonAppConfig{
-		Config:    *serverconfig.DefaultConfig(),
-		Wasm:      wasmtypes.DefaultWasmConfig(),
-		BtcConfig: defaultBabylonBtcConfig(),
+		Config:              *serverconfig.DefaultConfig(),
+		Wasm:                wasmtypes.DefaultWasmConfig(),
+		BtcConfig:           defaultBabylonBtcConfig(),
+		BlsSignerConfig:     defaultBlsSignerConfig(),
+		CheckpointingConfig: defaultCheckpointingConfig(),
 	}
 }
 
@@ -44,5 +79,44 @@ func DefaultBabylonTemplate() string {
 # Configures which bitcoin network should be used for checkpointing
 # valid values are: [mainnet, testnet, simnet, signet, regtest]
 network = "{{ .BtcConfig.Network }}"
+
+###############################################################################
+###                      Babylon BLS signer configuration                   ###
+###############################################################################
+
+[bls-signer]
+
+# gRPC address of a remote signer holding the BLS key of the validator, e.g.,
+# "localhost:9191" or "unix:///path/to/signer.sock". If empty, the BLS key in
+# the local priv_validator_key.json file is used for signing checkpoints.
+# Otherwise, the local BLS key is not loaded. The remote signer only signs
+# checkpoints, and never two different checkpoints of the same epoch
+remote-address = "{{ .BlsSignerConfig.RemoteAddress }}"
+
+# Timeout of requests to the remote signer
+timeout = "{{ .BlsSignerConfig.Timeout }}"
+
+# PEM files of the TLS certificate and key of the node, and of the CA
+# certificate issuing the certificate of the remote signer, for the mutual TLS
+# with the remote signer. They are required unless the remote signer is
+# reachable via a unix socket
+tls-cert-file = "{{ .BlsSignerConfig.TLSCertFile }}"
+tls-key-file = "{{ .BlsSignerConfig.TLSKeyFile }}"
+tls-ca-file = "{{ .BlsSignerConfig.TLSCAFile }}"
+
+###############################################################################
+###                      Babylon checkpointing configuration                ###
+###############################################################################
+
+[checkpointing]
+
+# Behavior upon a checkpoint on BTC that conflicts with the local checkpoint of
+# the same epoch, which indicates a fork of Babylon. In both cases, the
+# evidence is first persisted to data/conflicting_checkpoints under the node
+# home, and the node stops once the block carrying the conflicting checkpoint
+# is committed. Valid values are:
+# - panic: panic
+# - halt: shut the node down gracefully
+on-conflicting-checkpoint = "{{ .CheckpointingConfig.OnConflictingCheckpoint }}"
 `
 }
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"crypto/tls"
 	"errors"
 	"io"
 	"os"
@@ -42,6 +43,7 @@ import (
 	"github.com/babylonchain/babylon/app"
 	"github.com/babylonchain/babylon/app/params"
 	"github.com/babylonchain/babylon/cmd/babylond/cmd/genhelpers"
+	"github.com/babylonchain/babylon/privval"
 )
 
 // NewRootCmd creates a new root command for babylond. It is called once in the
@@ -273,7 +275,7 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts serverty
 	}
 
 	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
-	privSigner, err := app.InitPrivSigner(homeDir)
+	privSigner, err := initPrivSigner(homeDir, appOpts)
 	if err != nil {
 		panic(err)
 	}
@@ -293,6 +295,37 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts serverty
 	)
 }
 
+// initPrivSigner creates the signer of checkpoints, i.e., one requesting the
+// remote BLS signer if configured, in which case the local BLS key is not
+// loaded, and one signing with the local BLS key otherwise
+func initPrivSigner(homeDir string, appOpts servertypes.AppOptions) (*app.PrivSigner, error) {
+	remoteAddress := cast.ToString(appOpts.Get("bls-signer.remote-address"))
+	if remoteAddress == "" {
+		return app.InitPrivSigner(homeDir)
+	}
+
+	timeout := cast.ToDuration(appOpts.Get("bls-signer.timeout"))
+	if timeout == 0 {
+		timeout = privval.DefaultRemoteBlsSignerTimeout
+	}
+	var tlsConfig *tls.Config
+	certFile := cast.ToString(appOpts.Get("bls-signer.tls-cert-file"))
+	keyFile := cast.ToString(appOpts.Get("bls-signer.tls-key-file"))
+	caFile := cast.ToString(appOpts.Get("bls-signer.tls-ca-file"))
+	if certFile != "" || keyFile != "" || caFile != "" {
+		var err error
+		tlsConfig, err = privval.NewBlsSignerTLSConfig(certFile, keyFile, caFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	remoteBlsSigner, err := privval.DialRemoteBlsSigner(remoteAddress, tlsConfig, timeout)
+	if err != nil {
+		return nil, err
+	}
+	return &app.PrivSigner{RemoteBlsSigner: remoteBlsSigner}, nil
+}
+
 // appExport creates a new app (optionally at a given height)
 // and exports state.
 func appExport(
@@ -312,7 +345,7 @@ func appExport(
 		return servertypes.ExportedApp{}, errors.New("application home not set")
 	}
 
-	privSigner, err := app.InitPrivSigner(homePath)
+	privSigner, err := initPrivSigner(homePath, appOpts)
 	if err != nil {
 		panic(err)
 	}
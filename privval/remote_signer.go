@@ -0,0 +1,319 @@
+package privval
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmtos "github.com/cometbft/cometbft/libs/os"
+	"github.com/cometbft/cometbft/libs/tempfile"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/status"
+
+	"github.com/babylonchain/babylon/crypto/bls12381"
+	checkpointingkeeper "github.com/babylonchain/babylon/x/checkpointing/keeper"
+	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
+// DefaultRemoteBlsSignerTimeout is the default timeout of requests to a
+// remote BLS signer
+const DefaultRemoteBlsSignerTimeout = 5 * time.Second
+
+var (
+	// WrappedFilePV is the default BLS signer, which keeps the BLS key in a
+	// local file
+	_ checkpointingkeeper.BlsSigner = &WrappedFilePV{}
+	_ checkpointingkeeper.BlsSigner = &RemoteBlsSigner{}
+
+	_ checkpointingtypes.BlsSignerServer = &BlsSignerServer{}
+)
+
+// blsSignerCodec returns the codec of messages in the BlsSigner gRPC service,
+// which are gogoproto messages that the default gRPC codec cannot handle
+func blsSignerCodec() encoding.Codec {
+	return codec.NewProtoCodec(codectypes.NewInterfaceRegistry()).GRPCCodec()
+}
+
+// NewBlsSignerTLSConfig creates the mutual TLS config of either end of the
+// BlsSigner gRPC service from the given PEM files, i.e., the certificate and
+// key of this end, and the CA certificate that the certificate of the other
+// end has to be issued by
+func NewBlsSignerTLSConfig(certFile, keyFile, caFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the TLS certificate of the BLS signer: %w", err)
+	}
+	caPEM, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the TLS CA certificate of the BLS signer: %w", err)
+	}
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no valid certificate in the TLS CA certificate file %s", caFile)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      caPool,
+		ClientCAs:    caPool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
+
+// isUnixSocket returns whether the given gRPC target is a unix socket, which
+// is only reachable by processes on the same host
+func isUnixSocket(target string) bool {
+	return strings.HasPrefix(target, "unix:") || strings.HasPrefix(target, "unix-abstract:")
+}
+
+// RemoteBlsSigner is a BLS signer that requests BLS signatures from a remote
+// signer via the BlsSigner gRPC service, such that the BLS key of the
+// validator can be kept off the node. The address and public keys of the
+// validator are fetched once upon creation
+type RemoteBlsSigner struct {
+	client  checkpointingtypes.BlsSignerClient
+	timeout time.Duration
+
+	address   sdk.ValAddress
+	blsPubkey bls12381.PublicKey
+	valPubkey cmtcrypto.PubKey
+}
+
+// NewRemoteBlsSigner creates a BLS signer requesting the remote signer
+// behind the given gRPC connection. The connection has to encode messages
+// with a gogoproto codec, as the one created by DialRemoteBlsSigner does
+func NewRemoteBlsSigner(conn grpc1.ClientConn, timeout time.Duration) (*RemoteBlsSigner, error) {
+	client := checkpointingtypes.NewBlsSignerClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	resp, err := client.GetValidatorKeys(ctx, &checkpointingtypes.GetValidatorKeysRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get validator keys from the remote BLS signer: %w", err)
+	}
+	if resp.BlsPubkey == nil || resp.ValPubkey == nil {
+		return nil, errors.New("the remote BLS signer returned empty validator keys")
+	}
+	valPubkey, err := cryptoenc.PubKeyFromProto(*resp.ValPubkey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validator public key from the remote BLS signer: %w", err)
+	}
+
+	return &RemoteBlsSigner{
+		client:    client,
+		timeout:   timeout,
+		address:   resp.ValidatorAddress,
+		blsPubkey: *resp.BlsPubkey,
+		valPubkey: valPubkey,
+	}, nil
+}
+
+// DialRemoteBlsSigner connects to the remote signer at the given gRPC target,
+// e.g., "localhost:9191" or "unix:///path/to/signer.sock", and creates a BLS
+// signer requesting it. The connection is authenticated with the given mutual
+// TLS config, e.g., one created by NewBlsSignerTLSConfig, which can only be
+// nil if the target is a unix socket
+func DialRemoteBlsSigner(target string, tlsConfig *tls.Config, timeout time.Duration) (*RemoteBlsSigner, error) {
+	creds := insecure.NewCredentials()
+	if tlsConfig != nil {
+		if len(tlsConfig.Certificates) == 0 {
+			return nil, errors.New("the TLS config of the remote BLS signer has no client certificate")
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	} else if !isUnixSocket(target) {
+		return nil, fmt.Errorf("the remote BLS signer at %s is not a unix socket and requires mutual TLS", target)
+	}
+
+	conn, err := grpc.Dial(
+		target,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(blsSignerCodec())),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial the remote BLS signer at %s: %w", target, err)
+	}
+	return NewRemoteBlsSigner(conn, timeout)
+}
+
+func (s *RemoteBlsSigner) GetAddress() sdk.ValAddress {
+	return s.address
+}
+
+// SignMsgWithBls requests the remote signer to sign the given message, and
+// verifies the returned signature against the BLS public key of the validator
+func (s *RemoteBlsSigner) SignMsgWithBls(msg []byte) (bls12381.Signature, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	resp, err := s.client.SignMsgWithBls(ctx, &checkpointingtypes.SignMsgWithBlsRequest{Msg: msg})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get BLS signature from the remote BLS signer: %w", err)
+	}
+	if resp.BlsSig == nil {
+		return nil, errors.New("the remote BLS signer returned an empty BLS signature")
+	}
+	if valid, err := bls12381.Verify(*resp.BlsSig, s.blsPubkey, msg); err != nil || !valid {
+		return nil, errors.New("the remote BLS signer returned an invalid BLS signature")
+	}
+	return *resp.BlsSig, nil
+}
+
+func (s *RemoteBlsSigner) GetBlsPubkey() (bls12381.PublicKey, error) {
+	return s.blsPubkey, nil
+}
+
+func (s *RemoteBlsSigner) GetValidatorPubkey() (cmtcrypto.PubKey, error) {
+	return s.valPubkey, nil
+}
+
+// BlsSignerState is the last signed checkpoint of a BlsSignerServer, which is
+// persisted to a file before each signature is returned, so that the server
+// keeps refusing to sign conflicting checkpoints across restarts
+type BlsSignerState struct {
+	// Epoch is the epoch of the last signed checkpoint
+	Epoch uint64 `json:"epoch"`
+	// SignBytes is the sign bytes of the last signed checkpoint
+	SignBytes cmtbytes.HexBytes `json:"sign_bytes"`
+
+	filePath string
+}
+
+// LoadOrGenBlsSignerState loads the BLS signer state from the given file, or
+// returns an empty state to be saved to the file if it does not exist
+func LoadOrGenBlsSignerState(filePath string) (*BlsSignerState, error) {
+	state := &BlsSignerState{filePath: filePath}
+	if !cmtos.FileExists(filePath) {
+		return state, nil
+	}
+	stateJSONBytes, err := os.ReadFile(filepath.Clean(filePath))
+	if err != nil {
+		return nil, err
+	}
+	if err := cmtjson.Unmarshal(stateJSONBytes, state); err != nil {
+		return nil, fmt.Errorf("error reading BLS signer state from %v: %w", filePath, err)
+	}
+	if state.SignBytes != nil && len(state.SignBytes) != 8+checkpointingtypes.HashSize {
+		return nil, fmt.Errorf("invalid sign bytes in BLS signer state %v", filePath)
+	}
+	return state, nil
+}
+
+// Save persists the BLS signer state to its file
+func (s *BlsSignerState) Save() error {
+	if s.filePath == "" {
+		return errors.New("cannot save BLS signer state: filePath not set")
+	}
+	jsonBytes, err := cmtjson.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(s.filePath, jsonBytes, 0600)
+}
+
+// BlsSignerServer implements the BlsSigner gRPC service on top of a local BLS
+// signer. It runs in the process holding the BLS key, e.g., one with access
+// to an HSM, and serves the requests from a RemoteBlsSigner on the node.
+// It only signs the sign bytes of checkpoints, and never signs two different
+// checkpoints of the same epoch or a checkpoint of an epoch lower than the
+// last signed one, so that a compromised node cannot make the validator sign
+// conflicting checkpoints
+type BlsSignerServer struct {
+	signer checkpointingkeeper.BlsSigner
+
+	mu    sync.Mutex
+	state *BlsSignerState
+}
+
+// NewBlsSignerServer creates a BlsSigner gRPC service signing with the given
+// BLS signer. The last signed checkpoint is loaded from and persisted to the
+// given state file
+func NewBlsSignerServer(signer checkpointingkeeper.BlsSigner, stateFilePath string) (*BlsSignerServer, error) {
+	state, err := LoadOrGenBlsSignerState(stateFilePath)
+	if err != nil {
+		return nil, err
+	}
+	return &BlsSignerServer{signer: signer, state: state}, nil
+}
+
+// NewBlsSignerGRPCServer creates a gRPC server serving the given BlsSigner
+// gRPC service, ready to serve requests from RemoteBlsSigner. Clients are
+// authenticated with the given mutual TLS config, e.g., one created by
+// NewBlsSignerTLSConfig, which has to require and verify client certificates
+func NewBlsSignerGRPCServer(srv checkpointingtypes.BlsSignerServer, tlsConfig *tls.Config, opts ...grpc.ServerOption) (*grpc.Server, error) {
+	if tlsConfig == nil || tlsConfig.ClientAuth != tls.RequireAndVerifyClientCert || tlsConfig.ClientCAs == nil {
+		return nil, errors.New("the BLS signer requires mutual TLS with verified client certificates")
+	}
+	opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)), grpc.ForceServerCodec(blsSignerCodec()))
+	grpcServer := grpc.NewServer(opts...)
+	checkpointingtypes.RegisterBlsSignerServer(grpcServer, srv)
+	return grpcServer, nil
+}
+
+func (s *BlsSignerServer) GetValidatorKeys(_ context.Context, _ *checkpointingtypes.GetValidatorKeysRequest) (*checkpointingtypes.GetValidatorKeysResponse, error) {
+	blsPubkey, err := s.signer.GetBlsPubkey()
+	if err != nil {
+		return nil, err
+	}
+	valPubkey, err := s.signer.GetValidatorPubkey()
+	if err != nil {
+		return nil, err
+	}
+	valPubkeyProto, err := cryptoenc.PubKeyToProto(valPubkey)
+	if err != nil {
+		return nil, err
+	}
+	return &checkpointingtypes.GetValidatorKeysResponse{
+		ValidatorAddress: s.signer.GetAddress(),
+		BlsPubkey:        &blsPubkey,
+		ValPubkey:        &valPubkeyProto,
+	}, nil
+}
+
+// SignMsgWithBls signs the given message if it is the sign bytes of a
+// checkpoint of an epoch higher than the last signed one. Signing the last
+// signed checkpoint again is allowed, e.g., upon vote extensions in multiple
+// rounds of the same height
+func (s *BlsSignerServer) SignMsgWithBls(_ context.Context, req *checkpointingtypes.SignMsgWithBlsRequest) (*checkpointingtypes.SignMsgWithBlsResponse, error) {
+	if len(req.Msg) != 8+checkpointingtypes.HashSize {
+		return nil, status.Errorf(codes.InvalidArgument, "the message is not the sign bytes of a checkpoint")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	epoch := sdk.BigEndianToUint64(req.Msg[:8])
+	if s.state.SignBytes != nil && !bytes.Equal(s.state.SignBytes, req.Msg) && epoch <= s.state.Epoch {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"refusing to sign a checkpoint of epoch %d, as a different checkpoint of epoch %d has been signed", epoch, s.state.Epoch)
+	}
+
+	sig, err := s.signer.SignMsgWithBls(req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	// the signature is only returned once the signed checkpoint is persisted
+	s.state.Epoch = epoch
+	s.state.SignBytes = bytes.Clone(req.Msg)
+	if err := s.state.Save(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save the BLS signer state: %v", err)
+	}
+	return &checkpointingtypes.SignMsgWithBlsResponse{BlsSig: &sig}, nil
+}
@@ -186,6 +186,17 @@ func randOutPoint(r *rand.Rand) wire.OutPoint {
 	}
 }
 
+// GenRandomOutPoints generates n distinct random outpoints, e.g., the UTXOs
+// consolidated by a transaction with n inputs
+func GenRandomOutPoints(r *rand.Rand, n int) []*wire.OutPoint {
+	outPoints := make([]*wire.OutPoint, n)
+	for i := range outPoints {
+		outPoint := randOutPoint(r)
+		outPoints[i] = &outPoint
+	}
+	return outPoints
+}
+
 func makeSpendableOutWithRandOutPoint(r *rand.Rand, amount btcutil.Amount) spendableOut {
 	out := randOutPoint(r)
 
@@ -127,6 +127,10 @@ func GenRandomBTCDelegation(
 	if err != nil {
 		return nil, err
 	}
+	// the staking time has to fit in the uint16 timelock of the staking tx
+	if endHeight <= startHeight || endHeight-startHeight > bstypes.MaxStakingTimeBlocks {
+		return nil, fmt.Errorf("staking time %d-%d is not within [1, %d] BTC blocks", startHeight, endHeight, bstypes.MaxStakingTimeBlocks)
+	}
 	// staking/slashing tx
 	stakingSlashingInfo := GenBTCStakingSlashingInfo(
 		r,
@@ -266,6 +270,42 @@ func GenBTCStakingSlashingInfoWithOutPoint(
 	slashingRate sdkmath.LegacyDec,
 	slashingChangeLockTime uint16,
 ) *TestStakingSlashingInfo {
+	return GenBTCStakingSlashingInfoWithOutPoints(
+		r,
+		t,
+		btcNet,
+		[]*wire.OutPoint{outPoint},
+		stakerSK,
+		fpPKs,
+		covenantPKs,
+		covenantQuorum,
+		stakingTimeBlocks,
+		stakingValue,
+		slashingAddress,
+		slashingRate,
+		slashingChangeLockTime,
+	)
+}
+
+// GenBTCStakingSlashingInfoWithOutPoints generates a staking tx spending all
+// the given outpoints, e.g., a staking tx consolidating several UTXOs, together
+// with its slashing tx
+func GenBTCStakingSlashingInfoWithOutPoints(
+	r *rand.Rand,
+	t testing.TB,
+	btcNet *chaincfg.Params,
+	outPoints []*wire.OutPoint,
+	stakerSK *btcec.PrivateKey,
+	fpPKs []*btcec.PublicKey,
+	covenantPKs []*btcec.PublicKey,
+	covenantQuorum uint32,
+	stakingTimeBlocks uint16,
+	stakingValue int64,
+	slashingAddress string,
+	slashingRate sdkmath.LegacyDec,
+	slashingChangeLockTime uint16,
+) *TestStakingSlashingInfo {
+	require.Positive(t, stakingTimeBlocks, "staking time must be positive")
 
 	stakingInfo, err := btcstaking.BuildStakingInfo(
 		stakerSK.PubKey(),
@@ -279,9 +319,10 @@ func GenBTCStakingSlashingInfoWithOutPoint(
 
 	require.NoError(t, err)
 	tx := wire.NewMsgTx(2)
-	// add the given tx input
-	txIn := wire.NewTxIn(outPoint, nil, nil)
-	tx.AddTxIn(txIn)
+	// add the given tx inputs
+	for _, outPoint := range outPoints {
+		tx.AddTxIn(wire.NewTxIn(outPoint, nil, nil))
+	}
 	tx.AddTxOut(stakingInfo.StakingOutput)
 
 	// 2 outputs for changes and staking output
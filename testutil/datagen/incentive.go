@@ -83,9 +83,10 @@ func GenRandomBTCDelDistInfo(r *rand.Rand) (*bstypes.BTCDelDistInfo, error) {
 		return nil, err
 	}
 	return &bstypes.BTCDelDistInfo{
-		BtcPk:       btcPK,
-		BabylonPk:   GenRandomAccount().GetPubKey().(*secp256k1.PubKey),
-		VotingPower: RandomInt(r, 1000) + 1,
+		BtcPk:         btcPK,
+		BabylonPk:     GenRandomAccount().GetPubKey().(*secp256k1.PubKey),
+		StakingTxHash: GenRandomBtcdHash(r).String(),
+		VotingPower:   RandomInt(r, 1000) + 1,
 	}, nil
 }
 
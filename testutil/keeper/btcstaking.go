@@ -28,6 +28,7 @@ func BTCStakingKeeper(
 	btclcKeeper types.BTCLightClientKeeper,
 	btccKeeper types.BtcCheckpointKeeper,
 	ckptKeeper types.CheckpointingKeeper,
+	iKeeper types.IncentiveKeeper,
 ) (*keeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
@@ -45,6 +46,7 @@ func BTCStakingKeeper(
 		btclcKeeper,
 		btccKeeper,
 		ckptKeeper,
+		iKeeper,
 		&chaincfg.SimNetParams,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
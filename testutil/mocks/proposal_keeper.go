@@ -68,6 +68,20 @@ func (mr *MockCheckpointingKeeperMockRecorder) GetEpoch(ctx interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEpoch", reflect.TypeOf((*MockCheckpointingKeeper)(nil).GetEpoch), ctx)
 }
 
+// GetParams mocks base method.
+func (m *MockCheckpointingKeeper) GetParams(ctx context.Context) types.Params {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParams", ctx)
+	ret0, _ := ret[0].(types.Params)
+	return ret0
+}
+
+// GetParams indicates an expected call of GetParams.
+func (mr *MockCheckpointingKeeperMockRecorder) GetParams(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParams", reflect.TypeOf((*MockCheckpointingKeeper)(nil).GetParams), ctx)
+}
+
 // GetPubKeyByConsAddr mocks base method.
 func (m *MockCheckpointingKeeper) GetPubKeyByConsAddr(arg0 context.Context, arg1 types1.ConsAddress) (crypto.PublicKey, error) {
 	m.ctrl.T.Helper()
@@ -125,6 +139,18 @@ func (mr *MockCheckpointingKeeperMockRecorder) SealCheckpoint(ctx, ckptWithMeta
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SealCheckpoint", reflect.TypeOf((*MockCheckpointingKeeper)(nil).SealCheckpoint), ctx, ckptWithMeta)
 }
 
+// SetValidatorBlsSig mocks base method.
+func (m *MockCheckpointingKeeper) SetValidatorBlsSig(ctx context.Context, valAddr types1.ValAddress, sig *types.BlsSig) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetValidatorBlsSig", ctx, valAddr, sig)
+}
+
+// SetValidatorBlsSig indicates an expected call of SetValidatorBlsSig.
+func (mr *MockCheckpointingKeeperMockRecorder) SetValidatorBlsSig(ctx, valAddr, sig interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetValidatorBlsSig", reflect.TypeOf((*MockCheckpointingKeeper)(nil).SetValidatorBlsSig), ctx, valAddr, sig)
+}
+
 // VerifyBLSSig mocks base method.
 func (m *MockCheckpointingKeeper) VerifyBLSSig(ctx context.Context, sig *types.BlsSig) error {
 	m.ctrl.T.Helper()
@@ -28,6 +28,10 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdBtcCheckpointHeightAndHash())
 	cmd.AddCommand(CmdEpochSubmissions())
+	cmd.AddCommand(CmdRecentCheckpoints())
+	cmd.AddCommand(CmdEpochBTCRange())
+	cmd.AddCommand(CmdCheckpointBTCFinalizationETA())
+	cmd.AddCommand(CmdLatestSubmittedCheckpoint())
 	return cmd
 }
 
@@ -91,3 +95,124 @@ func CmdEpochSubmissions() *cobra.Command {
 
 	return cmd
 }
+
+func CmdRecentCheckpoints() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recent-checkpoints [limit]",
+		Short: "checkpoints of the most recent epochs with their status and btc submission depth",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.QueryRecentCheckpointsRequest{}
+			if len(args) == 1 {
+				limit, err := strconv.ParseUint(args[0], 10, 32)
+				if err != nil {
+					return err
+				}
+				req.Limit = uint32(limit)
+			}
+
+			res, err := queryClient.RecentCheckpoints(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdEpochBTCRange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-btc-range <epoch_number>",
+		Short: "btc heights at which the checkpoint of given epoch was submitted and finalized",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			epochNum, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := types.QueryEpochBTCRangeRequest{EpochNum: epochNum}
+			res, err := queryClient.EpochBTCRange(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCheckpointBTCFinalizationETA() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint-finalization-eta <epoch_number> [avg_btc_block_interval_seconds]",
+		Short: "btc blocks and estimated time until the checkpoint of given epoch is finalized",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			epochNum, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := types.QueryCheckpointBTCFinalizationETARequest{EpochNum: epochNum}
+			if len(args) == 2 {
+				req.AvgBtcBlockIntervalSeconds, err = strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.CheckpointBTCFinalizationETA(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdLatestSubmittedCheckpoint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "latest-submitted-checkpoint",
+		Short: "checkpoint of the highest epoch that is submitted to btc but not confirmed yet",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.QueryLatestSubmittedCheckpointRequest{}
+			res, err := queryClient.LatestSubmittedCheckpoint(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
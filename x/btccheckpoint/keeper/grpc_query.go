@@ -121,3 +121,172 @@ func (k Keeper) EpochSubmissions(c context.Context, req *types.QueryEpochSubmiss
 		Keys: submKeysResp,
 	}, nil
 }
+
+const (
+	// DefaultRecentCheckpointsLimit is the number of epochs returned by
+	// RecentCheckpoints if the request does not set a limit
+	DefaultRecentCheckpointsLimit = 10
+	// MaxRecentCheckpointsLimit is the maximum number of epochs returned by
+	// RecentCheckpoints
+	MaxRecentCheckpointsLimit = 100
+)
+
+func (k Keeper) RecentCheckpoints(c context.Context, req *types.QueryRecentCheckpointsRequest) (*types.QueryRecentCheckpointsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	limit := uint64(req.Limit)
+	if limit == 0 {
+		limit = DefaultRecentCheckpointsLimit
+	}
+	if limit > MaxRecentCheckpointsLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit cannot be larger than %d", MaxRecentCheckpointsLimit)
+	}
+
+	ckpts := []*types.RecentCheckpointResponse{}
+	lastEpoch, err := k.checkpointingKeeper.GetLastCheckpointedEpoch(ctx)
+	if err != nil {
+		// no epoch has been checkpointed yet
+		return &types.QueryRecentCheckpointsResponse{Checkpoints: ckpts}, nil
+	}
+
+	for i := uint64(0); i < limit && i <= lastEpoch; i++ {
+		epochNum := lastEpoch - i
+		ckptWithMeta, err := k.checkpointingKeeper.GetRawCheckpoint(ctx, epochNum)
+		if err != nil {
+			// epochs before this one do not have a checkpoint either
+			break
+		}
+
+		ckpt := &types.RecentCheckpointResponse{
+			EpochNum: epochNum,
+			Status:   ckptWithMeta.Status,
+			PowerSum: ckptWithMeta.PowerSum,
+		}
+
+		bestSubmission := k.GetEpochBestSubmissionBtcInfo(ctx, k.GetEpochData(ctx, epochNum))
+		if bestSubmission != nil {
+			height, err := k.GetBlockHeight(ctx, &bestSubmission.YoungestBlockHash)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get best submission height of epoch %d: %v", epochNum, err)
+			}
+			ckpt.Submitted = true
+			ckpt.BestSubmissionBtcBlockHeight = height
+			ckpt.BestSubmissionDepth = bestSubmission.SubmissionDepth()
+		}
+
+		ckpts = append(ckpts, ckpt)
+	}
+
+	return &types.QueryRecentCheckpointsResponse{Checkpoints: ckpts}, nil
+}
+
+func (k Keeper) EpochBTCRange(c context.Context, req *types.QueryEpochBTCRangeRequest) (*types.QueryEpochBTCRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	epochData := k.GetEpochData(ctx, req.EpochNum)
+	bestSubmission := k.GetEpochBestSubmissionBtcInfo(ctx, epochData)
+	if bestSubmission == nil {
+		return nil, status.Errorf(codes.NotFound, "checkpoint of epoch %d not yet submitted", req.EpochNum)
+	}
+
+	submittedHeight, err := k.GetBlockHeight(ctx, &bestSubmission.YoungestBlockHash)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get best submission height of epoch %d: %v", req.EpochNum, err)
+	}
+
+	return &types.QueryEpochBTCRangeResponse{
+		Status:             epochData.Status,
+		SubmittedBtcHeight: submittedHeight,
+		FinalizedBtcHeight: epochData.FinalizedBtcHeight,
+	}, nil
+}
+
+// DefaultAvgBTCBlockIntervalSeconds is the average BTC block interval used by
+// CheckpointBTCFinalizationETA if the request does not set one
+const DefaultAvgBTCBlockIntervalSeconds = 600
+
+func (k Keeper) CheckpointBTCFinalizationETA(c context.Context, req *types.QueryCheckpointBTCFinalizationETARequest) (*types.QueryCheckpointBTCFinalizationETAResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	blockInterval := req.AvgBtcBlockIntervalSeconds
+	if blockInterval == 0 {
+		blockInterval = DefaultAvgBTCBlockIntervalSeconds
+	}
+
+	epochData := k.GetEpochData(ctx, req.EpochNum)
+	bestSubmission := k.GetEpochBestSubmissionBtcInfo(ctx, epochData)
+	if bestSubmission == nil {
+		return nil, status.Errorf(codes.NotFound, "checkpoint of epoch %d not yet submitted", req.EpochNum)
+	}
+
+	finalizationDepth := k.GetParams(ctx).CheckpointFinalizationTimeout
+	depth := bestSubmission.SubmissionDepth()
+
+	var remaining uint64
+	if epochData.Status != types.Finalized && depth < finalizationDepth {
+		remaining = finalizationDepth - depth
+	}
+
+	return &types.QueryCheckpointBTCFinalizationETAResponse{
+		Status:              epochData.Status,
+		BestSubmissionDepth: depth,
+		FinalizationDepth:   finalizationDepth,
+		RemainingBtcBlocks:  remaining,
+		EstimatedSeconds:    remaining * blockInterval,
+	}, nil
+}
+
+func (k Keeper) LatestSubmittedCheckpoint(c context.Context, req *types.QueryLatestSubmittedCheckpointRequest) (*types.QueryLatestSubmittedCheckpointResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// epochs are finalized in order, so only epochs after the last finalized
+	// one can still be in Submitted status
+	store := k.epochDataStore(ctx)
+	it := store.ReverseIterator(sdk.Uint64ToBigEndian(k.getLastFinalizedEpochNumber(ctx)+1), nil)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var epochData types.EpochData
+		k.cdc.MustUnmarshal(it.Value(), &epochData)
+		// epochs whose submissions were all removed from the BTC main chain
+		// are cleared and do not count as submitted
+		if epochData.Status != types.Submitted || len(epochData.Keys) == 0 {
+			continue
+		}
+		epochNum := sdk.BigEndianToUint64(it.Key())
+
+		submKeysResp := make([]*types.SubmissionKeyResponse, len(epochData.Keys))
+		for i, submKey := range epochData.Keys {
+			skr, err := types.NewSubmissionKeyResponse(*submKey)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "invalid submission key of epoch %d: %v", epochNum, err)
+			}
+			submKeysResp[i] = skr
+		}
+
+		ckptInfo, err := k.getCheckpointInfo(ctx, epochNum, &epochData)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get best submission of epoch %d: %v", epochNum, err)
+		}
+
+		return &types.QueryLatestSubmittedCheckpointResponse{
+			EpochNum:       epochNum,
+			Keys:           submKeysResp,
+			BestSubmission: ckptInfo.ToResponse(),
+		}, nil
+	}
+
+	return nil, status.Error(codes.NotFound, "no checkpoint is in Submitted status")
+}
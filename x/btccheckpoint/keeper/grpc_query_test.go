@@ -8,8 +8,11 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	dg "github.com/babylonchain/babylon/testutil/datagen"
+	bkeeper "github.com/babylonchain/babylon/x/btccheckpoint/keeper"
 	"github.com/babylonchain/babylon/x/btccheckpoint/types"
 )
 
@@ -69,3 +72,207 @@ func TestBtcCheckpointInfo(t *testing.T) {
 	require.Equal(t, btcInfo.BestSubmissionVigilanteAddressList[0].Reporter, rawSubmission.Reporter.String())
 	require.Equal(t, btcInfo.BestSubmissionVigilanteAddressList[0].Submitter, sdk.AccAddress(btcRaw.SubmitterAddress).String())
 }
+
+func TestRecentCheckpoints(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	tk := InitTestKeepers(t)
+
+	// no checkpoint yet
+	resp, err := tk.BTCCheckpoint.RecentCheckpoints(tk.SdkCtx, &types.QueryRecentCheckpointsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.Checkpoints)
+
+	// checkpoints of epochs 1 to 3, where only epoch 1 is submitted to BTC
+	lastEpoch := uint64(3)
+	for e := uint64(1); e <= lastEpoch; e++ {
+		ckpt := dg.GenRandomRawCheckpointWithMeta(r)
+		ckpt.Ckpt.EpochNum = e
+		tk.Checkpointing.SetRawCheckpoint(ckpt)
+	}
+
+	raw, _ := dg.RandomRawCheckpointDataForEpoch(r, 1)
+	blck1 := dg.CreateBlock(r, 1, 7, 7, raw.FirstPart)
+	blck2 := dg.CreateBlock(r, 2, 14, 3, raw.SecondPart)
+	msg := dg.GenerateMessageWithRandomSubmitter([]*dg.BlockCreationResult{blck1, blck2})
+	tk.BTCLightClient.SetDepth(blck1.HeaderBytes.Hash(), uint64(5))
+	tk.BTCLightClient.SetDepth(blck2.HeaderBytes.Hash(), uint64(4))
+	_, err = tk.insertProofMsg(msg)
+	require.NoError(t, err)
+
+	// limit is respected and the most recent epochs come first
+	resp, err = tk.BTCCheckpoint.RecentCheckpoints(tk.SdkCtx, &types.QueryRecentCheckpointsRequest{Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, resp.Checkpoints, 2)
+	require.Equal(t, uint64(3), resp.Checkpoints[0].EpochNum)
+	require.Equal(t, uint64(2), resp.Checkpoints[1].EpochNum)
+	for _, ckpt := range resp.Checkpoints {
+		require.False(t, ckpt.Submitted)
+		require.Zero(t, ckpt.BestSubmissionDepth)
+	}
+
+	// the default limit covers all epochs, and the submitted one reports its depth
+	resp, err = tk.BTCCheckpoint.RecentCheckpoints(tk.SdkCtx, &types.QueryRecentCheckpointsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Checkpoints, int(lastEpoch))
+	oldest := resp.Checkpoints[lastEpoch-1]
+	expected, err := tk.Checkpointing.GetRawCheckpoint(tk.SdkCtx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), oldest.EpochNum)
+	require.Equal(t, expected.Status, oldest.Status)
+	require.Equal(t, expected.PowerSum, oldest.PowerSum)
+	require.True(t, oldest.Submitted)
+	require.Equal(t, uint64(4), oldest.BestSubmissionDepth)
+
+	// limit above the maximum is rejected
+	_, err = tk.BTCCheckpoint.RecentCheckpoints(tk.SdkCtx, &types.QueryRecentCheckpointsRequest{Limit: bkeeper.MaxRecentCheckpointsLimit + 1})
+	require.Error(t, err)
+}
+
+func TestEpochBTCRange(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	tk := InitTestKeepers(t)
+	wDeep := types.DefaultParams().CheckpointFinalizationTimeout
+
+	// no checkpoint submitted yet
+	_, err := tk.BTCCheckpoint.EpochBTCRange(tk.SdkCtx, &types.QueryEpochBTCRangeRequest{EpochNum: 1})
+	require.Error(t, err)
+
+	msg := dg.GenerateMessageWithRandomSubmitterForEpoch(r, 1)
+	tk.BTCLightClient.SetDepth(b1Hash(msg), uint64(1))
+	tk.BTCLightClient.SetDepth(b2Hash(msg), uint64(0))
+	_, err = tk.insertProofMsg(msg)
+	require.NoError(t, err)
+
+	submittedHeight, err := tk.BTCLightClient.BlockHeight(tk.Ctx, b2Hash(msg))
+	require.NoError(t, err)
+
+	// submitted but not finalized
+	resp, err := tk.BTCCheckpoint.EpochBTCRange(tk.SdkCtx, &types.QueryEpochBTCRangeRequest{EpochNum: 1})
+	require.NoError(t, err)
+	require.Equal(t, types.Submitted, resp.Status)
+	require.Equal(t, submittedHeight, resp.SubmittedBtcHeight)
+	require.Zero(t, resp.FinalizedBtcHeight)
+
+	// the youngest block of the submission becomes w-deep
+	tk.BTCLightClient.SetDepth(b1Hash(msg), wDeep+1)
+	tk.BTCLightClient.SetDepth(b2Hash(msg), wDeep)
+	tk.onTipChange()
+
+	resp, err = tk.BTCCheckpoint.EpochBTCRange(tk.SdkCtx, &types.QueryEpochBTCRangeRequest{EpochNum: 1})
+	require.NoError(t, err)
+	require.Equal(t, types.Finalized, resp.Status)
+	require.Equal(t, submittedHeight, resp.SubmittedBtcHeight)
+	require.Equal(t, submittedHeight+wDeep, resp.FinalizedBtcHeight)
+}
+
+func TestCheckpointBTCFinalizationETA(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	tk := InitTestKeepers(t)
+	wDeep := types.DefaultParams().CheckpointFinalizationTimeout
+
+	// no checkpoint submitted yet
+	_, err := tk.BTCCheckpoint.CheckpointBTCFinalizationETA(tk.SdkCtx, &types.QueryCheckpointBTCFinalizationETARequest{EpochNum: 1})
+	require.Error(t, err)
+
+	msg := dg.GenerateMessageWithRandomSubmitterForEpoch(r, 1)
+	tk.BTCLightClient.SetDepth(b1Hash(msg), uint64(1))
+	tk.BTCLightClient.SetDepth(b2Hash(msg), uint64(0))
+	_, err = tk.insertProofMsg(msg)
+	require.NoError(t, err)
+
+	// submitted but not finalized, using the default BTC block interval
+	resp, err := tk.BTCCheckpoint.CheckpointBTCFinalizationETA(tk.SdkCtx, &types.QueryCheckpointBTCFinalizationETARequest{EpochNum: 1})
+	require.NoError(t, err)
+	require.Equal(t, types.Submitted, resp.Status)
+	require.Zero(t, resp.BestSubmissionDepth)
+	require.Equal(t, wDeep, resp.FinalizationDepth)
+	require.Equal(t, wDeep, resp.RemainingBtcBlocks)
+	require.Equal(t, wDeep*bkeeper.DefaultAvgBTCBlockIntervalSeconds, resp.EstimatedSeconds)
+
+	// the submission gets deeper, using a custom BTC block interval
+	depth := uint64(r.Int63n(int64(wDeep-1))) + 1
+	tk.BTCLightClient.SetDepth(b1Hash(msg), depth+1)
+	tk.BTCLightClient.SetDepth(b2Hash(msg), depth)
+	tk.onTipChange()
+
+	interval := uint64(r.Int63n(1000)) + 1
+	resp, err = tk.BTCCheckpoint.CheckpointBTCFinalizationETA(tk.SdkCtx, &types.QueryCheckpointBTCFinalizationETARequest{
+		EpochNum:                   1,
+		AvgBtcBlockIntervalSeconds: interval,
+	})
+	require.NoError(t, err)
+	require.Equal(t, depth, resp.BestSubmissionDepth)
+	require.Equal(t, wDeep-depth, resp.RemainingBtcBlocks)
+	require.Equal(t, (wDeep-depth)*interval, resp.EstimatedSeconds)
+
+	// the youngest block of the submission becomes w-deep
+	tk.BTCLightClient.SetDepth(b1Hash(msg), wDeep+1)
+	tk.BTCLightClient.SetDepth(b2Hash(msg), wDeep)
+	tk.onTipChange()
+
+	resp, err = tk.BTCCheckpoint.CheckpointBTCFinalizationETA(tk.SdkCtx, &types.QueryCheckpointBTCFinalizationETARequest{EpochNum: 1})
+	require.NoError(t, err)
+	require.Equal(t, types.Finalized, resp.Status)
+	require.Zero(t, resp.RemainingBtcBlocks)
+	require.Zero(t, resp.EstimatedSeconds)
+}
+
+func TestLatestSubmittedCheckpoint(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	tk := InitTestKeepers(t)
+	kDeep := types.DefaultParams().BtcConfirmationDepth
+
+	// no checkpoint submitted yet
+	_, err := tk.BTCCheckpoint.LatestSubmittedCheckpoint(tk.SdkCtx, &types.QueryLatestSubmittedCheckpointRequest{})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// submit checkpoints of epochs 1 and 2
+	msg1 := dg.GenerateMessageWithRandomSubmitterForEpoch(r, 1)
+	tk.BTCLightClient.SetDepth(b1Hash(msg1), uint64(3))
+	tk.BTCLightClient.SetDepth(b2Hash(msg1), uint64(2))
+	_, err = tk.insertProofMsg(msg1)
+	require.NoError(t, err)
+	msg2 := dg.GenerateMessageWithRandomSubmitterForEpoch(r, 2)
+	tk.BTCLightClient.SetDepth(b1Hash(msg2), uint64(1))
+	tk.BTCLightClient.SetDepth(b2Hash(msg2), uint64(0))
+	_, err = tk.insertProofMsg(msg2)
+	require.NoError(t, err)
+
+	// the highest submitted epoch is returned with its submissions
+	resp, err := tk.BTCCheckpoint.LatestSubmittedCheckpoint(tk.SdkCtx, &types.QueryLatestSubmittedCheckpointRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), resp.EpochNum)
+	require.Len(t, resp.Keys, 1)
+	require.Equal(t, b2Hash(msg2).MarshalHex(), resp.Keys[0].SecondTxBlockHash)
+	require.Equal(t, uint64(2), resp.BestSubmission.EpochNumber)
+	require.Len(t, resp.BestSubmission.BestSubmissionTransactions, 2)
+
+	// the checkpoint of epoch 1 gets confirmed, which does not affect the
+	// latest submitted one
+	tk.BTCLightClient.SetDepth(b1Hash(msg1), kDeep+3)
+	tk.BTCLightClient.SetDepth(b2Hash(msg1), kDeep+2)
+	tk.onTipChange()
+	require.Equal(t, types.Confirmed, tk.GetEpochData(1).Status)
+	resp, err = tk.BTCCheckpoint.LatestSubmittedCheckpoint(tk.SdkCtx, &types.QueryLatestSubmittedCheckpointRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), resp.EpochNum)
+
+	// once the checkpoint of epoch 2 is confirmed as well, no checkpoint is
+	// in Submitted status
+	tk.BTCLightClient.SetDepth(b1Hash(msg2), kDeep+1)
+	tk.BTCLightClient.SetDepth(b2Hash(msg2), kDeep)
+	tk.onTipChange()
+	require.Equal(t, types.Confirmed, tk.GetEpochData(2).Status)
+	_, err = tk.BTCCheckpoint.LatestSubmittedCheckpoint(tk.SdkCtx, &types.QueryLatestSubmittedCheckpointRequest{})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// the submitter makes progress with the checkpoint of epoch 3
+	msg3 := dg.GenerateMessageWithRandomSubmitterForEpoch(r, 3)
+	tk.BTCLightClient.SetDepth(b1Hash(msg3), uint64(1))
+	tk.BTCLightClient.SetDepth(b2Hash(msg3), uint64(0))
+	_, err = tk.insertProofMsg(msg3)
+	require.NoError(t, err)
+	resp, err = tk.BTCCheckpoint.LatestSubmittedCheckpoint(tk.SdkCtx, &types.QueryLatestSubmittedCheckpointRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), resp.EpochNum)
+}
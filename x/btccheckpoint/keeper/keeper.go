@@ -106,6 +106,17 @@ func (k Keeper) GetBlockHeight(ctx context.Context, b *bbn.BTCHeaderHashBytes) (
 	return k.btcLightClientKeeper.BlockHeight(ctx, b)
 }
 
+// finalizationBtcHeight returns the btc tip height at which the given
+// submission is being evaluated, i.e. the height of its youngest block plus the
+// depth of that block
+func (k Keeper) finalizationBtcHeight(ctx context.Context, submission *types.SubmissionBtcInfo) uint64 {
+	height, err := k.GetBlockHeight(ctx, &submission.YoungestBlockHash)
+	if err != nil {
+		panic(fmt.Sprintf("finalized submission must be known to the btc light client: %v", err))
+	}
+	return height + submission.YoungestBlockDepth
+}
+
 func (k Keeper) headerDepth(ctx context.Context, headerHash *bbn.BTCHeaderHashBytes) (uint64, error) {
 	blockDepth, err := k.btcLightClientKeeper.MainChainDepth(ctx, headerHash)
 
@@ -400,6 +411,7 @@ func (k Keeper) checkCheckpoints(ctx context.Context) {
 		if bestSubmissionStatus > currentEpoch.Status && currentEpoch.Status == types.Confirmed {
 			// epoch just got finalized by best submission
 			currentEpoch.Status = types.Finalized
+			currentEpoch.FinalizedBtcHeight = k.finalizationBtcHeight(ctx, epochChanges.EpochBestSubmission)
 			k.checkpointingKeeper.SetCheckpointFinalized(ctx, epoch)
 			k.setLastFinalizedEpochNumber(ctx, epoch)
 		}
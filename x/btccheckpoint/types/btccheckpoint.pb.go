@@ -399,6 +399,10 @@ type EpochData struct {
 	Keys []*SubmissionKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
 	// status is the current btc status of the epoch
 	Status BtcStatus `protobuf:"varint,2,opt,name=status,proto3,enum=babylon.btccheckpoint.v1.BtcStatus" json:"status,omitempty"`
+	// finalized_btc_height is the btc tip height at which the best submission
+	// of the epoch became deep enough to be finalized. It is zero if the epoch
+	// is not finalized yet
+	FinalizedBtcHeight uint64 `protobuf:"varint,3,opt,name=finalized_btc_height,json=finalizedBtcHeight,proto3" json:"finalized_btc_height,omitempty"`
 }
 
 func (m *EpochData) Reset()         { *m = EpochData{} }
@@ -448,6 +452,13 @@ func (m *EpochData) GetStatus() BtcStatus {
 	return Submitted
 }
 
+func (m *EpochData) GetFinalizedBtcHeight() uint64 {
+	if m != nil {
+		return m.FinalizedBtcHeight
+	}
+	return 0
+}
+
 // CheckpointAddresses contains the addresses of the submitter and reporter of a
 // given checkpoint
 type CheckpointAddresses struct {
@@ -601,58 +612,59 @@ func init() {
 }
 
 var fileDescriptor_e096cac78d49b0a6 = []byte{
-	// 814 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x8f, 0x22, 0x45,
-	0x14, 0xa7, 0xa0, 0x67, 0x5d, 0x0a, 0x86, 0x1d, 0x8b, 0x59, 0xd3, 0x21, 0x93, 0x5e, 0xb6, 0x4d,
-	0x5c, 0xd6, 0x28, 0x64, 0x47, 0x4d, 0x36, 0xae, 0x97, 0x69, 0x3e, 0x02, 0xd9, 0x5d, 0x98, 0x34,
-	0xbd, 0x1e, 0xf6, 0x60, 0xa7, 0xbb, 0x29, 0xe8, 0x0a, 0xd0, 0x45, 0xba, 0x0a, 0x02, 0x9e, 0xf4,
-	0x60, 0x62, 0x3c, 0x19, 0xef, 0x9e, 0xfc, 0x67, 0x3c, 0x78, 0xd8, 0xa3, 0xd9, 0xc3, 0xc4, 0xcc,
-	0xfc, 0x07, 0x5e, 0xbd, 0x98, 0xaa, 0xee, 0xe1, 0x6b, 0x06, 0x95, 0xc4, 0x1b, 0xef, 0xd5, 0xef,
-	0x7d, 0xfc, 0x7e, 0xef, 0x3d, 0x1a, 0x7e, 0xe4, 0x3a, 0xee, 0x62, 0x44, 0x83, 0x8a, 0xcb, 0x3d,
-	0xcf, 0xc7, 0xde, 0x70, 0x42, 0x49, 0xc0, 0x2b, 0xb3, 0x27, 0x9b, 0x8e, 0xf2, 0x24, 0xa4, 0x9c,
-	0x22, 0x35, 0x46, 0x97, 0x37, 0x1f, 0x67, 0x4f, 0x0a, 0xc7, 0x03, 0x3a, 0xa0, 0x12, 0x54, 0x11,
-	0xbf, 0x22, 0xbc, 0xfe, 0x17, 0x80, 0x19, 0xc3, 0xaa, 0x76, 0x27, 0xb3, 0xf3, 0x90, 0xd2, 0x3e,
-	0x7a, 0x04, 0xef, 0xb9, 0xdc, 0xb3, 0x79, 0xe8, 0x04, 0xcc, 0xf1, 0x38, 0xa1, 0x81, 0x0a, 0x8a,
-	0xa0, 0x94, 0x35, 0x73, 0x2e, 0xf7, 0xac, 0x95, 0x17, 0x9d, 0xc2, 0xfb, 0x5b, 0x40, 0x9b, 0x04,
-	0x3d, 0x3c, 0x57, 0x93, 0x45, 0x50, 0x3a, 0x34, 0xf3, 0x9b, 0xf0, 0x96, 0x78, 0x42, 0x0f, 0x61,
-	0x76, 0x8c, 0xc3, 0xe1, 0x08, 0xdb, 0x01, 0xed, 0x61, 0xa6, 0xa6, 0x64, 0xe6, 0x4c, 0xe4, 0x6b,
-	0x0b, 0x17, 0x1a, 0xc1, 0xfb, 0x1e, 0x0d, 0xfa, 0x24, 0x1c, 0x93, 0x60, 0x60, 0x8b, 0x0a, 0x3e,
-	0x76, 0x7a, 0x38, 0x54, 0x15, 0x81, 0x35, 0x9e, 0xbe, 0xbd, 0x78, 0xf0, 0xe9, 0x80, 0x70, 0x7f,
-	0xea, 0x96, 0x3d, 0x3a, 0xae, 0xc4, 0x6c, 0x3d, 0xdf, 0x21, 0xc1, 0xb5, 0x51, 0xe1, 0x8b, 0x09,
-	0x66, 0x65, 0xc3, 0xaa, 0x36, 0x65, 0xa8, 0xb1, 0xe0, 0x98, 0x99, 0xf9, 0x55, 0x5a, 0x83, 0x7b,
-	0xd1, 0x8b, 0x3e, 0x87, 0xb9, 0xb5, 0x26, 0x9f, 0xe3, 0x05, 0x3a, 0x86, 0x07, 0x11, 0x0d, 0x20,
-	0x69, 0x44, 0x06, 0x3a, 0x87, 0x8a, 0xef, 0x30, 0x5f, 0x72, 0xcb, 0x1a, 0x5f, 0xbc, 0xbd, 0x78,
-	0xf0, 0x74, 0xcf, 0x26, 0x9a, 0x0e, 0xf3, 0xa3, 0x46, 0x64, 0x26, 0xfd, 0x39, 0x3c, 0xec, 0x4e,
-	0xdd, 0x31, 0x61, 0x2c, 0x2e, 0xfc, 0x39, 0x4c, 0x0d, 0xf1, 0x42, 0x05, 0xc5, 0x54, 0x29, 0x73,
-	0x5a, 0x2a, 0xef, 0x1a, 0x63, 0x79, 0xb3, 0x5f, 0x53, 0x04, 0xe9, 0xdf, 0x01, 0x78, 0x6f, 0x43,
-	0xec, 0x3e, 0x5d, 0xe5, 0x03, 0x7b, 0xe7, 0x43, 0x45, 0x98, 0x59, 0x5f, 0x80, 0x64, 0x34, 0xa6,
-	0x35, 0x97, 0x90, 0x69, 0x22, 0xf6, 0x25, 0x1e, 0x61, 0x64, 0xe8, 0xbf, 0x01, 0x98, 0x5b, 0xb1,
-	0xaa, 0x39, 0xdc, 0x41, 0x5f, 0xc1, 0xfc, 0x8c, 0x0c, 0xc8, 0xc8, 0x09, 0x38, 0xb6, 0x9d, 0x5e,
-	0x2f, 0xc4, 0x8c, 0x61, 0x16, 0xb7, 0xf5, 0xf1, 0xee, 0xb6, 0xaa, 0x4b, 0xeb, 0xec, 0x3a, 0xc8,
-	0x44, 0xcb, 0x4c, 0x4b, 0x1f, 0xaa, 0xc1, 0xbb, 0x7c, 0xce, 0x6c, 0x12, 0xf4, 0xa9, 0x9a, 0x94,
-	0xda, 0x3d, 0xfe, 0x4f, 0x5c, 0x85, 0x46, 0xe6, 0x3b, 0x7c, 0xce, 0xa4, 0x58, 0xc7, 0xf0, 0x00,
-	0x4f, 0xa8, 0xe7, 0x4b, 0x3a, 0x8a, 0x19, 0x19, 0x42, 0xd6, 0x74, 0x5d, 0xfc, 0x92, 0x4c, 0x9e,
-	0x41, 0x65, 0x88, 0x17, 0x2c, 0x9e, 0xd0, 0xa3, 0xdd, 0x55, 0x36, 0xe6, 0x6a, 0xca, 0x20, 0xf4,
-	0x0c, 0xde, 0x61, 0xdc, 0xe1, 0x53, 0x26, 0xc5, 0xcc, 0x9d, 0xbe, 0xbf, 0x3b, 0xdc, 0xe0, 0x5e,
-	0x57, 0x42, 0xcd, 0x38, 0x44, 0xef, 0xc0, 0xfc, 0x2d, 0x72, 0xa0, 0x13, 0x98, 0x66, 0xa2, 0x14,
-	0xe7, 0x38, 0x8c, 0x8f, 0x74, 0xe5, 0x40, 0x05, 0x78, 0x37, 0xc4, 0x13, 0x1a, 0x8a, 0xc7, 0x68,
-	0x80, 0x4b, 0x5b, 0xff, 0x33, 0x05, 0xdf, 0x35, 0xac, 0xea, 0x2a, 0xa9, 0x14, 0xe1, 0x21, 0xcc,
-	0x4a, 0xde, 0x76, 0x30, 0x1d, 0xbb, 0x71, 0x4a, 0xc5, 0xcc, 0x48, 0x5f, 0x5b, 0xba, 0x50, 0x03,
-	0x16, 0x5d, 0xcc, 0xb8, 0xcd, 0x96, 0x14, 0xe5, 0x89, 0xba, 0x23, 0xea, 0x0d, 0x6d, 0x1f, 0x93,
-	0x81, 0xcf, 0x65, 0x31, 0xc5, 0x3c, 0x11, 0xb8, 0x95, 0x12, 0x06, 0xf7, 0x0c, 0x01, 0x6a, 0x4a,
-	0x0c, 0xfa, 0x06, 0x40, 0xed, 0x1f, 0x12, 0x89, 0x53, 0x4b, 0xfd, 0x0f, 0xa7, 0x56, 0xd8, 0xd1,
-	0x84, 0xc3, 0x7c, 0x34, 0x84, 0x27, 0xdb, 0x1d, 0xac, 0x2d, 0x38, 0x53, 0x95, 0x7d, 0x97, 0x69,
-	0xab, 0xd8, 0xda, 0x33, 0x43, 0xdf, 0x02, 0xf8, 0xc1, 0x76, 0xb5, 0x1b, 0x67, 0x61, 0x8f, 0x08,
-	0xe3, 0xea, 0x81, 0xac, 0xbb, 0xe7, 0x65, 0xe8, 0x9b, 0xb5, 0xbf, 0xdc, 0xba, 0x93, 0x17, 0x84,
-	0xf1, 0x0f, 0x7f, 0x02, 0x30, 0xbd, 0xdc, 0x2d, 0xf4, 0x18, 0xbe, 0x57, 0x3f, 0xef, 0x54, 0x9b,
-	0x76, 0xd7, 0x3a, 0xb3, 0x5e, 0x75, 0xed, 0xee, 0x2b, 0xe3, 0x65, 0xcb, 0xb2, 0xea, 0xb5, 0xa3,
-	0x44, 0xe1, 0xf0, 0x87, 0x9f, 0x8b, 0xe9, 0x6e, 0xbc, 0x49, 0xbd, 0x1b, 0xd0, 0x6a, 0xa7, 0xdd,
-	0x68, 0x99, 0x2f, 0xeb, 0xb5, 0x23, 0x10, 0x41, 0xab, 0xd1, 0x3f, 0xeb, 0x2d, 0xd0, 0x46, 0xab,
-	0x7d, 0xf6, 0xa2, 0xf5, 0xba, 0x5e, 0x3b, 0x4a, 0x46, 0xd0, 0x06, 0x09, 0x9c, 0x11, 0xf9, 0x1a,
-	0xf7, 0x0a, 0xca, 0xf7, 0xbf, 0x68, 0x09, 0xa3, 0xf3, 0xeb, 0xa5, 0x06, 0xde, 0x5c, 0x6a, 0xe0,
-	0x8f, 0x4b, 0x0d, 0xfc, 0x78, 0xa5, 0x25, 0xde, 0x5c, 0x69, 0x89, 0xdf, 0xaf, 0xb4, 0xc4, 0xeb,
-	0xcf, 0xfe, 0x6d, 0xea, 0xf3, 0xad, 0x0f, 0xa2, 0xdc, 0x02, 0xf7, 0x8e, 0xfc, 0xac, 0x7d, 0xf2,
-	0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7b, 0x55, 0x92, 0x5f, 0x36, 0x07, 0x00, 0x00,
+	// 830 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xd8, 0x4e, 0x69, 0x9e, 0x93, 0x34, 0x4c, 0x52, 0xb4, 0xb2, 0xa2, 0xad, 0xbb, 0x48,
+	0x34, 0x45, 0x60, 0xd3, 0x00, 0x52, 0x45, 0xb9, 0x64, 0xfd, 0x47, 0xb1, 0xda, 0xda, 0xd1, 0x7a,
+	0xcb, 0xa1, 0x07, 0x56, 0xbb, 0xeb, 0xb1, 0x77, 0x64, 0x7b, 0xc7, 0xda, 0x19, 0x5b, 0x36, 0x27,
+	0x38, 0x20, 0x21, 0x4e, 0x88, 0x3b, 0x27, 0x3e, 0x04, 0x5f, 0x81, 0x03, 0x87, 0x1e, 0x51, 0x0f,
+	0x15, 0x4a, 0xbe, 0x01, 0x57, 0x2e, 0x68, 0x66, 0xd7, 0x7f, 0x5b, 0x03, 0x96, 0xb8, 0x79, 0xde,
+	0xfb, 0xbd, 0x3f, 0xbf, 0xdf, 0x7b, 0xcf, 0x0b, 0x1f, 0x78, 0xae, 0x37, 0xed, 0xb3, 0xb0, 0xe4,
+	0x09, 0xdf, 0x0f, 0x88, 0xdf, 0x1b, 0x32, 0x1a, 0x8a, 0xd2, 0xf8, 0xc1, 0xaa, 0xa1, 0x38, 0x8c,
+	0x98, 0x60, 0x58, 0x4b, 0xd0, 0xc5, 0x55, 0xe7, 0xf8, 0x41, 0xfe, 0xb8, 0xcb, 0xba, 0x4c, 0x81,
+	0x4a, 0xf2, 0x57, 0x8c, 0x37, 0xfe, 0x42, 0x90, 0x33, 0xed, 0x72, 0x6b, 0x38, 0xbe, 0x8c, 0x18,
+	0xeb, 0xe0, 0x7b, 0x70, 0xcb, 0x13, 0xbe, 0x23, 0x22, 0x37, 0xe4, 0xae, 0x2f, 0x28, 0x0b, 0x35,
+	0x54, 0x40, 0xa7, 0x7b, 0xd6, 0x81, 0x27, 0x7c, 0x7b, 0x61, 0xc5, 0x67, 0x70, 0x7b, 0x0d, 0xe8,
+	0xd0, 0xb0, 0x4d, 0x26, 0x5a, 0xba, 0x80, 0x4e, 0xf7, 0xad, 0xa3, 0x55, 0x78, 0x5d, 0xba, 0xf0,
+	0x5d, 0xd8, 0x1b, 0x90, 0xa8, 0xd7, 0x27, 0x4e, 0xc8, 0xda, 0x84, 0x6b, 0x19, 0x95, 0x39, 0x17,
+	0xdb, 0x1a, 0xd2, 0x84, 0xfb, 0x70, 0xdb, 0x67, 0x61, 0x87, 0x46, 0x03, 0x1a, 0x76, 0x1d, 0x59,
+	0x21, 0x20, 0x6e, 0x9b, 0x44, 0x5a, 0x56, 0x62, 0xcd, 0x87, 0x2f, 0x5f, 0xdd, 0xf9, 0xa4, 0x4b,
+	0x45, 0x30, 0xf2, 0x8a, 0x3e, 0x1b, 0x94, 0x12, 0xb6, 0x7e, 0xe0, 0xd2, 0x70, 0xf6, 0x28, 0x89,
+	0xe9, 0x90, 0xf0, 0xa2, 0x69, 0x97, 0x2f, 0x54, 0xa8, 0x39, 0x15, 0x84, 0x5b, 0x47, 0x8b, 0xb4,
+	0xa6, 0xf0, 0x63, 0x8f, 0x31, 0x81, 0x83, 0xa5, 0x26, 0x1f, 0x93, 0x29, 0x3e, 0x86, 0x9d, 0x98,
+	0x06, 0x52, 0x34, 0xe2, 0x07, 0xbe, 0x84, 0x6c, 0xe0, 0xf2, 0x40, 0x71, 0xdb, 0x33, 0x3f, 0x7f,
+	0xf9, 0xea, 0xce, 0xc3, 0x2d, 0x9b, 0xb8, 0x70, 0x79, 0x10, 0x37, 0xa2, 0x32, 0x19, 0x8f, 0x61,
+	0xbf, 0x35, 0xf2, 0x06, 0x94, 0xf3, 0xa4, 0xf0, 0x67, 0x90, 0xe9, 0x91, 0xa9, 0x86, 0x0a, 0x99,
+	0xd3, 0xdc, 0xd9, 0x69, 0x71, 0xd3, 0x18, 0x8b, 0xab, 0xfd, 0x5a, 0x32, 0xc8, 0xf8, 0x16, 0xc1,
+	0xad, 0x15, 0xb1, 0x3b, 0x6c, 0x91, 0x0f, 0x6d, 0x9d, 0x0f, 0x17, 0x20, 0xb7, 0xbc, 0x00, 0xe9,
+	0x78, 0x4c, 0x4b, 0x26, 0x29, 0xd3, 0x50, 0xee, 0x4b, 0x32, 0xc2, 0xf8, 0x61, 0xfc, 0x86, 0xe0,
+	0x60, 0xc1, 0xaa, 0xe2, 0x0a, 0x17, 0x7f, 0x09, 0x47, 0x63, 0xda, 0xa5, 0x7d, 0x37, 0x14, 0xc4,
+	0x71, 0xdb, 0xed, 0x88, 0x70, 0x4e, 0x78, 0xd2, 0xd6, 0x87, 0x9b, 0xdb, 0x2a, 0xcf, 0x5f, 0xe7,
+	0xb3, 0x20, 0x0b, 0xcf, 0x33, 0xcd, 0x6d, 0xb8, 0x02, 0x37, 0xc5, 0x84, 0x3b, 0x34, 0xec, 0x30,
+	0x2d, 0xad, 0xb4, 0xbb, 0xff, 0x9f, 0xb8, 0x4a, 0x8d, 0xac, 0xb7, 0xc4, 0x84, 0x2b, 0xb1, 0x8e,
+	0x61, 0x87, 0x0c, 0x99, 0x1f, 0x28, 0x3a, 0x59, 0x2b, 0x7e, 0x18, 0xbf, 0x20, 0xd8, 0xad, 0xca,
+	0x5f, 0x8a, 0xc9, 0x23, 0xc8, 0xf6, 0xc8, 0x94, 0x27, 0x13, 0xba, 0xb7, 0xb9, 0xca, 0xca, 0x5c,
+	0x2d, 0x15, 0x84, 0x1f, 0xc1, 0x0d, 0x2e, 0x5c, 0x31, 0xe2, 0x4a, 0xcc, 0x83, 0xb3, 0x77, 0x37,
+	0x87, 0x9b, 0xc2, 0x6f, 0x29, 0xa8, 0x95, 0x84, 0xe0, 0x8f, 0xe0, 0xb8, 0x43, 0x43, 0xb7, 0x4f,
+	0xbf, 0x22, 0xed, 0xe4, 0x24, 0x68, 0x37, 0x10, 0x49, 0xb3, 0x78, 0xee, 0x53, 0x7b, 0x2d, 0x3d,
+	0x46, 0x13, 0x8e, 0xde, 0x20, 0x20, 0x3e, 0x81, 0x5d, 0x2e, 0x9b, 0x13, 0x82, 0x44, 0xc9, 0x59,
+	0x2f, 0x0c, 0x38, 0x0f, 0x37, 0x23, 0x32, 0x64, 0x91, 0x74, 0xc6, 0x23, 0x9f, 0xbf, 0x8d, 0x3f,
+	0x33, 0xf0, 0xb6, 0x69, 0x97, 0x17, 0x49, 0x95, 0x6c, 0x77, 0x61, 0x4f, 0x29, 0xe5, 0x84, 0xa3,
+	0x81, 0x97, 0xa4, 0xcc, 0x5a, 0x39, 0x65, 0x6b, 0x28, 0x13, 0xae, 0x41, 0xc1, 0x23, 0x5c, 0x38,
+	0x7c, 0x2e, 0x8a, 0x62, 0xe0, 0xf5, 0x99, 0xdf, 0x9b, 0xf1, 0x48, 0xab, 0xb0, 0x13, 0x89, 0x5b,
+	0x68, 0x67, 0x0a, 0xdf, 0x94, 0xa0, 0x98, 0x11, 0xfe, 0x1a, 0x81, 0xfe, 0x0f, 0x89, 0xe4, 0x71,
+	0x66, 0xfe, 0x87, 0xe3, 0xcc, 0x6f, 0x68, 0xc2, 0xe5, 0x01, 0xee, 0xc1, 0xc9, 0x7a, 0x07, 0x4b,
+	0x27, 0xc1, 0xb5, 0xec, 0xb6, 0xeb, 0xb7, 0x56, 0x6c, 0xc9, 0xcd, 0xf1, 0x37, 0x08, 0xde, 0x5b,
+	0xaf, 0xf6, 0xda, 0x21, 0x39, 0x7d, 0xca, 0x85, 0xb6, 0x53, 0xc8, 0x6c, 0x7f, 0x4b, 0xc6, 0x6a,
+	0xed, 0x2f, 0xd6, 0x2e, 0xeb, 0x09, 0xe5, 0xe2, 0xfd, 0x1f, 0x11, 0xec, 0xce, 0xb7, 0x11, 0xdf,
+	0x87, 0x77, 0xaa, 0x97, 0xcd, 0xf2, 0x85, 0xd3, 0xb2, 0xcf, 0xed, 0x67, 0x2d, 0xa7, 0xf5, 0xcc,
+	0x7c, 0x5a, 0xb7, 0xed, 0x6a, 0xe5, 0x30, 0x95, 0xdf, 0xff, 0xfe, 0xa7, 0xc2, 0x6e, 0x2b, 0xd9,
+	0xa4, 0xf6, 0x6b, 0xd0, 0x72, 0xb3, 0x51, 0xab, 0x5b, 0x4f, 0xab, 0x95, 0x43, 0x14, 0x43, 0xcb,
+	0xf1, 0x7f, 0xf1, 0x1b, 0xa0, 0xb5, 0x7a, 0xe3, 0xfc, 0x49, 0xfd, 0x79, 0xb5, 0x72, 0x98, 0x8e,
+	0xa1, 0xb5, 0xd9, 0x76, 0xe7, 0xb3, 0xdf, 0xfd, 0xac, 0xa7, 0xcc, 0xe6, 0xaf, 0x57, 0x3a, 0x7a,
+	0x71, 0xa5, 0xa3, 0x3f, 0xae, 0x74, 0xf4, 0xc3, 0xb5, 0x9e, 0x7a, 0x71, 0xad, 0xa7, 0x7e, 0xbf,
+	0xd6, 0x53, 0xcf, 0x3f, 0xfd, 0xb7, 0xa9, 0x4f, 0xd6, 0x3e, 0xa1, 0x6a, 0x0b, 0xbc, 0x1b, 0xea,
+	0x43, 0xf8, 0xf1, 0xdf, 0x03, 0x00, 0x84, 0x35, 0xed, 0xda, 0x68, 0x07, 0x00, 0x00,
 }
 
 func (m *BTCSpvProof) Marshal() (dAtA []byte, err error) {
@@ -909,6 +921,11 @@ func (m *EpochData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FinalizedBtcHeight != 0 {
+		i = encodeVarintBtccheckpoint(dAtA, i, uint64(m.FinalizedBtcHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Status != 0 {
 		i = encodeVarintBtccheckpoint(dAtA, i, uint64(m.Status))
 		i--
@@ -1165,6 +1182,9 @@ func (m *EpochData) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovBtccheckpoint(uint64(m.Status))
 	}
+	if m.FinalizedBtcHeight != 0 {
+		n += 1 + sovBtccheckpoint(uint64(m.FinalizedBtcHeight))
+	}
 	return n
 }
 
@@ -1957,6 +1977,25 @@ func (m *EpochData) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedBtcHeight", wireType)
+			}
+			m.FinalizedBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedBtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtccheckpoint(dAtA[iNdEx:])
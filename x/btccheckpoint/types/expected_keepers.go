@@ -4,6 +4,7 @@ import (
 	"context"
 	txformat "github.com/babylonchain/babylon/btctxformatter"
 	bbn "github.com/babylonchain/babylon/types"
+	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
 )
 
 type BTCLightClientKeeper interface {
@@ -36,6 +37,12 @@ type CheckpointingKeeper interface {
 	// SetCheckpointForgotten informs checkpointing module that this checkpoint lost
 	// all submissions on btc chain
 	SetCheckpointForgotten(ctx context.Context, epoch uint64)
+
+	// GetLastCheckpointedEpoch returns the last epoch that has a checkpoint
+	GetLastCheckpointedEpoch(ctx context.Context) (uint64, error)
+	// GetRawCheckpoint returns the checkpoint of the given epoch together with
+	// its status and accumulated voting power
+	GetRawCheckpoint(ctx context.Context, epochNum uint64) (*checkpointingtypes.RawCheckpointWithMeta, error)
 }
 
 type IncentiveKeeper interface {
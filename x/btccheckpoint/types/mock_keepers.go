@@ -6,6 +6,7 @@ import (
 
 	txformat "github.com/babylonchain/babylon/btctxformatter"
 	bbn "github.com/babylonchain/babylon/types"
+	checkpointingtypes "github.com/babylonchain/babylon/x/checkpointing/types"
 )
 
 type MockBTCLightClientKeeper struct {
@@ -14,6 +15,7 @@ type MockBTCLightClientKeeper struct {
 
 type MockCheckpointingKeeper struct {
 	returnError bool
+	checkpoints map[uint64]*checkpointingtypes.RawCheckpointWithMeta
 }
 
 type MockIncentiveKeeper struct {
@@ -29,6 +31,7 @@ func NewMockBTCLightClientKeeper() *MockBTCLightClientKeeper {
 func NewMockCheckpointingKeeper() *MockCheckpointingKeeper {
 	mc := MockCheckpointingKeeper{
 		returnError: false,
+		checkpoints: make(map[uint64]*checkpointingtypes.RawCheckpointWithMeta),
 	}
 	return &mc
 }
@@ -45,6 +48,10 @@ func (mc *MockCheckpointingKeeper) ReturnSuccess() {
 	mc.returnError = false
 }
 
+func (mc *MockCheckpointingKeeper) SetRawCheckpoint(ckpt *checkpointingtypes.RawCheckpointWithMeta) {
+	mc.checkpoints[ckpt.Ckpt.EpochNum] = ckpt
+}
+
 func (mc *MockBTCLightClientKeeper) SetDepth(header *bbn.BTCHeaderHashBytes, dd uint64) {
 	mc.headers[header.String()] = dd
 }
@@ -95,5 +102,26 @@ func (ck MockCheckpointingKeeper) SetCheckpointFinalized(ctx context.Context, ep
 func (ck MockCheckpointingKeeper) SetCheckpointForgotten(ctx context.Context, epoch uint64) {
 }
 
+func (ck MockCheckpointingKeeper) GetLastCheckpointedEpoch(ctx context.Context) (uint64, error) {
+	if len(ck.checkpoints) == 0 {
+		return 0, errors.New("no checkpoint")
+	}
+	var last uint64
+	for epoch := range ck.checkpoints {
+		if epoch > last {
+			last = epoch
+		}
+	}
+	return last, nil
+}
+
+func (ck MockCheckpointingKeeper) GetRawCheckpoint(ctx context.Context, epochNum uint64) (*checkpointingtypes.RawCheckpointWithMeta, error) {
+	ckpt, ok := ck.checkpoints[epochNum]
+	if !ok {
+		return nil, errors.New("checkpoint not found")
+	}
+	return ckpt, nil
+}
+
 func (ik *MockIncentiveKeeper) RewardBTCTimestamping(ctx context.Context, epoch uint64, rewardDistInfo *RewardDistInfo) {
 }
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/babylonchain/babylon/x/checkpointing/types"
 	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -404,34 +405,26 @@ func (m *QueryEpochSubmissionsResponse) GetKeys() []*SubmissionKeyResponse {
 	return nil
 }
 
-// BTCCheckpointInfoResponse contains all data about best submission of checkpoint for
-// given epoch. Best submission is the submission which is deeper in btc ledger.
-type BTCCheckpointInfoResponse struct {
-	// EpochNumber of this checkpoint.
-	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
-	// btc height of the best submission of the epoch
-	BestSubmissionBtcBlockHeight uint64 `protobuf:"varint,2,opt,name=best_submission_btc_block_height,json=bestSubmissionBtcBlockHeight,proto3" json:"best_submission_btc_block_height,omitempty"`
-	// hash of the btc block which determines checkpoint btc block height i.e.
-	// youngest block of best submission Hexadecimal
-	BestSubmissionBtcBlockHash string `protobuf:"bytes,3,opt,name=best_submission_btc_block_hash,json=bestSubmissionBtcBlockHash,proto3" json:"best_submission_btc_block_hash,omitempty"`
-	// the BTC checkpoint transactions of the best submission
-	BestSubmissionTransactions []*TransactionInfoResponse `protobuf:"bytes,4,rep,name=best_submission_transactions,json=bestSubmissionTransactions,proto3" json:"best_submission_transactions,omitempty"`
-	// list of vigilantes' addresses of the best submission
-	BestSubmissionVigilanteAddressList []*CheckpointAddressesResponse `protobuf:"bytes,5,rep,name=best_submission_vigilante_address_list,json=bestSubmissionVigilanteAddressList,proto3" json:"best_submission_vigilante_address_list,omitempty"`
+// QueryRecentCheckpointsRequest is request type for the
+// Query/RecentCheckpoints RPC method
+type QueryRecentCheckpointsRequest struct {
+	// limit is the number of most recent epochs to return. Defaults to 10 if
+	// not set and is capped at 100
+	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (m *BTCCheckpointInfoResponse) Reset()         { *m = BTCCheckpointInfoResponse{} }
-func (m *BTCCheckpointInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*BTCCheckpointInfoResponse) ProtoMessage()    {}
-func (*BTCCheckpointInfoResponse) Descriptor() ([]byte, []int) {
+func (m *QueryRecentCheckpointsRequest) Reset()         { *m = QueryRecentCheckpointsRequest{} }
+func (m *QueryRecentCheckpointsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecentCheckpointsRequest) ProtoMessage()    {}
+func (*QueryRecentCheckpointsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6b9a2f46ada7d854, []int{8}
 }
-func (m *BTCCheckpointInfoResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryRecentCheckpointsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BTCCheckpointInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRecentCheckpointsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BTCCheckpointInfoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRecentCheckpointsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -441,81 +434,184 @@ func (m *BTCCheckpointInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *BTCCheckpointInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BTCCheckpointInfoResponse.Merge(m, src)
+func (m *QueryRecentCheckpointsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecentCheckpointsRequest.Merge(m, src)
 }
-func (m *BTCCheckpointInfoResponse) XXX_Size() int {
+func (m *QueryRecentCheckpointsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *BTCCheckpointInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BTCCheckpointInfoResponse.DiscardUnknown(m)
+func (m *QueryRecentCheckpointsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecentCheckpointsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BTCCheckpointInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryRecentCheckpointsRequest proto.InternalMessageInfo
 
-func (m *BTCCheckpointInfoResponse) GetEpochNumber() uint64 {
+func (m *QueryRecentCheckpointsRequest) GetLimit() uint32 {
 	if m != nil {
-		return m.EpochNumber
+		return m.Limit
 	}
 	return 0
 }
 
-func (m *BTCCheckpointInfoResponse) GetBestSubmissionBtcBlockHeight() uint64 {
+// QueryRecentCheckpointsResponse is response type for the
+// Query/RecentCheckpoints RPC method
+type QueryRecentCheckpointsResponse struct {
+	// checkpoints are sorted from the most recent epoch to the oldest one
+	Checkpoints []*RecentCheckpointResponse `protobuf:"bytes,1,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+}
+
+func (m *QueryRecentCheckpointsResponse) Reset()         { *m = QueryRecentCheckpointsResponse{} }
+func (m *QueryRecentCheckpointsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecentCheckpointsResponse) ProtoMessage()    {}
+func (*QueryRecentCheckpointsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9a2f46ada7d854, []int{9}
+}
+func (m *QueryRecentCheckpointsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecentCheckpointsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecentCheckpointsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecentCheckpointsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecentCheckpointsResponse.Merge(m, src)
+}
+func (m *QueryRecentCheckpointsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecentCheckpointsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecentCheckpointsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecentCheckpointsResponse proto.InternalMessageInfo
+
+func (m *QueryRecentCheckpointsResponse) GetCheckpoints() []*RecentCheckpointResponse {
 	if m != nil {
-		return m.BestSubmissionBtcBlockHeight
+		return m.Checkpoints
+	}
+	return nil
+}
+
+// RecentCheckpointResponse summarises the checkpoint of a single epoch
+type RecentCheckpointResponse struct {
+	// epoch_num is the epoch number of the checkpoint
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// status is the status of the checkpoint
+	Status types.CheckpointStatus `protobuf:"varint,2,opt,name=status,proto3,enum=babylon.checkpointing.v1.CheckpointStatus" json:"status,omitempty"`
+	// power_sum is the voting power accumulated by the checkpoint
+	PowerSum uint64 `protobuf:"varint,3,opt,name=power_sum,json=powerSum,proto3" json:"power_sum,omitempty"`
+	// submitted is true if the checkpoint has a valid submission on BTC
+	Submitted bool `protobuf:"varint,4,opt,name=submitted,proto3" json:"submitted,omitempty"`
+	// best_submission_btc_block_height is the btc height of the best
+	// submission. It is only set if submitted is true
+	BestSubmissionBtcBlockHeight uint64 `protobuf:"varint,5,opt,name=best_submission_btc_block_height,json=bestSubmissionBtcBlockHeight,proto3" json:"best_submission_btc_block_height,omitempty"`
+	// best_submission_depth is the depth of the best submission on the BTC
+	// main chain. It is only set if submitted is true
+	BestSubmissionDepth uint64 `protobuf:"varint,6,opt,name=best_submission_depth,json=bestSubmissionDepth,proto3" json:"best_submission_depth,omitempty"`
+}
+
+func (m *RecentCheckpointResponse) Reset()         { *m = RecentCheckpointResponse{} }
+func (m *RecentCheckpointResponse) String() string { return proto.CompactTextString(m) }
+func (*RecentCheckpointResponse) ProtoMessage()    {}
+func (*RecentCheckpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9a2f46ada7d854, []int{10}
+}
+func (m *RecentCheckpointResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecentCheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecentCheckpointResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecentCheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecentCheckpointResponse.Merge(m, src)
+}
+func (m *RecentCheckpointResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecentCheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecentCheckpointResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecentCheckpointResponse proto.InternalMessageInfo
+
+func (m *RecentCheckpointResponse) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
 	}
 	return 0
 }
 
-func (m *BTCCheckpointInfoResponse) GetBestSubmissionBtcBlockHash() string {
+func (m *RecentCheckpointResponse) GetStatus() types.CheckpointStatus {
 	if m != nil {
-		return m.BestSubmissionBtcBlockHash
+		return m.Status
 	}
-	return ""
+	return types.Accumulating
 }
 
-func (m *BTCCheckpointInfoResponse) GetBestSubmissionTransactions() []*TransactionInfoResponse {
+func (m *RecentCheckpointResponse) GetPowerSum() uint64 {
 	if m != nil {
-		return m.BestSubmissionTransactions
+		return m.PowerSum
 	}
-	return nil
+	return 0
 }
 
-func (m *BTCCheckpointInfoResponse) GetBestSubmissionVigilanteAddressList() []*CheckpointAddressesResponse {
+func (m *RecentCheckpointResponse) GetSubmitted() bool {
 	if m != nil {
-		return m.BestSubmissionVigilanteAddressList
+		return m.Submitted
 	}
-	return nil
+	return false
 }
 
-// TransactionInfoResponse is the info of a tx on Bitcoin,
-// including
-// - the position of the tx on BTC blockchain
-// - the full tx content
-// - the Merkle proof that this tx is on the above position
-type TransactionInfoResponse struct {
-	// Index Bitcoin Transaction index in block.
-	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
-	// Hash BTC Header hash as hex.
-	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
-	// transaction is the full transaction data as str hex.
-	Transaction string `protobuf:"bytes,3,opt,name=transaction,proto3" json:"transaction,omitempty"`
-	// proof is the Merkle proof that this tx is included in the position in `key`
-	Proof string `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof,omitempty"`
+func (m *RecentCheckpointResponse) GetBestSubmissionBtcBlockHeight() uint64 {
+	if m != nil {
+		return m.BestSubmissionBtcBlockHeight
+	}
+	return 0
 }
 
-func (m *TransactionInfoResponse) Reset()         { *m = TransactionInfoResponse{} }
-func (m *TransactionInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*TransactionInfoResponse) ProtoMessage()    {}
-func (*TransactionInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b9a2f46ada7d854, []int{9}
+func (m *RecentCheckpointResponse) GetBestSubmissionDepth() uint64 {
+	if m != nil {
+		return m.BestSubmissionDepth
+	}
+	return 0
 }
-func (m *TransactionInfoResponse) XXX_Unmarshal(b []byte) error {
+
+// QueryEpochBTCRangeRequest is request type for the Query/EpochBTCRange RPC
+// method
+type QueryEpochBTCRangeRequest struct {
+	// epoch_num is the number of the epoch
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+}
+
+func (m *QueryEpochBTCRangeRequest) Reset()         { *m = QueryEpochBTCRangeRequest{} }
+func (m *QueryEpochBTCRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochBTCRangeRequest) ProtoMessage()    {}
+func (*QueryEpochBTCRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9a2f46ada7d854, []int{11}
+}
+func (m *QueryEpochBTCRangeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TransactionInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEpochBTCRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TransactionInfoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEpochBTCRangeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -525,69 +621,117 @@ func (m *TransactionInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *TransactionInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TransactionInfoResponse.Merge(m, src)
+func (m *QueryEpochBTCRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochBTCRangeRequest.Merge(m, src)
 }
-func (m *TransactionInfoResponse) XXX_Size() int {
+func (m *QueryEpochBTCRangeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *TransactionInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_TransactionInfoResponse.DiscardUnknown(m)
+func (m *QueryEpochBTCRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochBTCRangeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TransactionInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryEpochBTCRangeRequest proto.InternalMessageInfo
 
-func (m *TransactionInfoResponse) GetIndex() uint32 {
+func (m *QueryEpochBTCRangeRequest) GetEpochNum() uint64 {
 	if m != nil {
-		return m.Index
+		return m.EpochNum
 	}
 	return 0
 }
 
-func (m *TransactionInfoResponse) GetHash() string {
+// QueryEpochBTCRangeResponse is response type for the Query/EpochBTCRange RPC
+// method
+type QueryEpochBTCRangeResponse struct {
+	// status is the btc status of the epoch's checkpoint
+	Status BtcStatus `protobuf:"varint,1,opt,name=status,proto3,enum=babylon.btccheckpoint.v1.BtcStatus" json:"status,omitempty"`
+	// submitted_btc_height is the btc height of the best submission of the
+	// epoch's checkpoint
+	SubmittedBtcHeight uint64 `protobuf:"varint,2,opt,name=submitted_btc_height,json=submittedBtcHeight,proto3" json:"submitted_btc_height,omitempty"`
+	// finalized_btc_height is the btc tip height at which the checkpoint became
+	// deep enough to be finalized. It is zero if the checkpoint is not finalized
+	// yet
+	FinalizedBtcHeight uint64 `protobuf:"varint,3,opt,name=finalized_btc_height,json=finalizedBtcHeight,proto3" json:"finalized_btc_height,omitempty"`
+}
+
+func (m *QueryEpochBTCRangeResponse) Reset()         { *m = QueryEpochBTCRangeResponse{} }
+func (m *QueryEpochBTCRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochBTCRangeResponse) ProtoMessage()    {}
+func (*QueryEpochBTCRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9a2f46ada7d854, []int{12}
+}
+func (m *QueryEpochBTCRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochBTCRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochBTCRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochBTCRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochBTCRangeResponse.Merge(m, src)
+}
+func (m *QueryEpochBTCRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochBTCRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochBTCRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochBTCRangeResponse proto.InternalMessageInfo
+
+func (m *QueryEpochBTCRangeResponse) GetStatus() BtcStatus {
 	if m != nil {
-		return m.Hash
+		return m.Status
 	}
-	return ""
+	return Submitted
 }
 
-func (m *TransactionInfoResponse) GetTransaction() string {
+func (m *QueryEpochBTCRangeResponse) GetSubmittedBtcHeight() uint64 {
 	if m != nil {
-		return m.Transaction
+		return m.SubmittedBtcHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *TransactionInfoResponse) GetProof() string {
+func (m *QueryEpochBTCRangeResponse) GetFinalizedBtcHeight() uint64 {
 	if m != nil {
-		return m.Proof
+		return m.FinalizedBtcHeight
 	}
-	return ""
+	return 0
 }
 
-// CheckpointAddressesResponse contains the addresses of the submitter and reporter of a
-// given checkpoint
-type CheckpointAddressesResponse struct {
-	// submitter is the address of the checkpoint submitter to BTC, extracted from
-	// the checkpoint itself.
-	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
-	// reporter is the address of the reporter who reported the submissions,
-	// calculated from submission message MsgInsertBTCSpvProof itself
-	Reporter string `protobuf:"bytes,2,opt,name=reporter,proto3" json:"reporter,omitempty"`
+// QueryCheckpointBTCFinalizationETARequest is request type for the
+// Query/CheckpointBTCFinalizationETA RPC method
+type QueryCheckpointBTCFinalizationETARequest struct {
+	// epoch_num is the number of the epoch
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// avg_btc_block_interval_seconds is the average BTC block interval used for
+	// estimating the time until finalization. Defaults to 600 seconds if not set
+	AvgBtcBlockIntervalSeconds uint64 `protobuf:"varint,2,opt,name=avg_btc_block_interval_seconds,json=avgBtcBlockIntervalSeconds,proto3" json:"avg_btc_block_interval_seconds,omitempty"`
 }
 
-func (m *CheckpointAddressesResponse) Reset()         { *m = CheckpointAddressesResponse{} }
-func (m *CheckpointAddressesResponse) String() string { return proto.CompactTextString(m) }
-func (*CheckpointAddressesResponse) ProtoMessage()    {}
-func (*CheckpointAddressesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b9a2f46ada7d854, []int{10}
+func (m *QueryCheckpointBTCFinalizationETARequest) Reset() {
+	*m = QueryCheckpointBTCFinalizationETARequest{}
 }
-func (m *CheckpointAddressesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryCheckpointBTCFinalizationETARequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointBTCFinalizationETARequest) ProtoMessage()    {}
+func (*QueryCheckpointBTCFinalizationETARequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9a2f46ada7d854, []int{13}
+}
+func (m *QueryCheckpointBTCFinalizationETARequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CheckpointAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCheckpointBTCFinalizationETARequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CheckpointAddressesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCheckpointBTCFinalizationETARequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -597,60 +741,68 @@ func (m *CheckpointAddressesResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *CheckpointAddressesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CheckpointAddressesResponse.Merge(m, src)
+func (m *QueryCheckpointBTCFinalizationETARequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointBTCFinalizationETARequest.Merge(m, src)
 }
-func (m *CheckpointAddressesResponse) XXX_Size() int {
+func (m *QueryCheckpointBTCFinalizationETARequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CheckpointAddressesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CheckpointAddressesResponse.DiscardUnknown(m)
+func (m *QueryCheckpointBTCFinalizationETARequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointBTCFinalizationETARequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CheckpointAddressesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryCheckpointBTCFinalizationETARequest proto.InternalMessageInfo
 
-func (m *CheckpointAddressesResponse) GetSubmitter() string {
+func (m *QueryCheckpointBTCFinalizationETARequest) GetEpochNum() uint64 {
 	if m != nil {
-		return m.Submitter
+		return m.EpochNum
 	}
-	return ""
+	return 0
 }
 
-func (m *CheckpointAddressesResponse) GetReporter() string {
+func (m *QueryCheckpointBTCFinalizationETARequest) GetAvgBtcBlockIntervalSeconds() uint64 {
 	if m != nil {
-		return m.Reporter
+		return m.AvgBtcBlockIntervalSeconds
 	}
-	return ""
-}
-
-// SubmissionKeyResponse Checkpoint can be composed from multiple transactions,
-// so to identify whole submission we need list of transaction keys.
-// Each submission can generally be identified by this list of (txIdx,
-// blockHash) tuples. Note: this could possibly be optimized as if transactions
-// were in one block they would have the same block hash and different indexes,
-// but each blockhash is only 33 (1  byte for prefix encoding and 32 byte hash),
-// so there should be other strong arguments for this optimization
-type SubmissionKeyResponse struct {
-	// FirstTxBlockHash is the BTCHeaderHashBytes in hex.
-	FirstTxBlockHash string `protobuf:"bytes,1,opt,name=first_tx_block_hash,json=firstTxBlockHash,proto3" json:"first_tx_block_hash,omitempty"`
-	FirstTxIndex     uint32 `protobuf:"varint,2,opt,name=first_tx_index,json=firstTxIndex,proto3" json:"first_tx_index,omitempty"`
-	// SecondBlockHash is the BTCHeaderHashBytes in hex.
-	SecondTxBlockHash string `protobuf:"bytes,3,opt,name=second_tx_block_hash,json=secondTxBlockHash,proto3" json:"second_tx_block_hash,omitempty"`
-	SecondTxIndex     uint32 `protobuf:"varint,4,opt,name=second_tx_index,json=secondTxIndex,proto3" json:"second_tx_index,omitempty"`
+	return 0
 }
 
-func (m *SubmissionKeyResponse) Reset()         { *m = SubmissionKeyResponse{} }
-func (m *SubmissionKeyResponse) String() string { return proto.CompactTextString(m) }
-func (*SubmissionKeyResponse) ProtoMessage()    {}
-func (*SubmissionKeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b9a2f46ada7d854, []int{11}
-}
-func (m *SubmissionKeyResponse) XXX_Unmarshal(b []byte) error {
+// QueryCheckpointBTCFinalizationETAResponse is response type for the
+// Query/CheckpointBTCFinalizationETA RPC method
+type QueryCheckpointBTCFinalizationETAResponse struct {
+	// status is the btc status of the epoch's checkpoint
+	Status BtcStatus `protobuf:"varint,1,opt,name=status,proto3,enum=babylon.btccheckpoint.v1.BtcStatus" json:"status,omitempty"`
+	// best_submission_depth is the depth of the best submission of the epoch's
+	// checkpoint on the BTC main chain
+	BestSubmissionDepth uint64 `protobuf:"varint,2,opt,name=best_submission_depth,json=bestSubmissionDepth,proto3" json:"best_submission_depth,omitempty"`
+	// finalization_depth is the depth at which a checkpoint is finalized, i.e.,
+	// the checkpoint_finalization_timeout parameter
+	FinalizationDepth uint64 `protobuf:"varint,3,opt,name=finalization_depth,json=finalizationDepth,proto3" json:"finalization_depth,omitempty"`
+	// remaining_btc_blocks is the number of additional BTC blocks needed until
+	// the checkpoint is finalized. It is zero if the checkpoint is finalized
+	RemainingBtcBlocks uint64 `protobuf:"varint,4,opt,name=remaining_btc_blocks,json=remainingBtcBlocks,proto3" json:"remaining_btc_blocks,omitempty"`
+	// estimated_seconds is the estimated wall-clock time in seconds until the
+	// checkpoint is finalized, i.e., remaining_btc_blocks times the average
+	// BTC block interval
+	EstimatedSeconds uint64 `protobuf:"varint,5,opt,name=estimated_seconds,json=estimatedSeconds,proto3" json:"estimated_seconds,omitempty"`
+}
+
+func (m *QueryCheckpointBTCFinalizationETAResponse) Reset() {
+	*m = QueryCheckpointBTCFinalizationETAResponse{}
+}
+func (m *QueryCheckpointBTCFinalizationETAResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryCheckpointBTCFinalizationETAResponse) ProtoMessage() {}
+func (*QueryCheckpointBTCFinalizationETAResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9a2f46ada7d854, []int{14}
+}
+func (m *QueryCheckpointBTCFinalizationETAResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SubmissionKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCheckpointBTCFinalizationETAResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SubmissionKeyResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCheckpointBTCFinalizationETAResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -660,1011 +812,2773 @@ func (m *SubmissionKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *SubmissionKeyResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubmissionKeyResponse.Merge(m, src)
+func (m *QueryCheckpointBTCFinalizationETAResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointBTCFinalizationETAResponse.Merge(m, src)
 }
-func (m *SubmissionKeyResponse) XXX_Size() int {
+func (m *QueryCheckpointBTCFinalizationETAResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *SubmissionKeyResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubmissionKeyResponse.DiscardUnknown(m)
+func (m *QueryCheckpointBTCFinalizationETAResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointBTCFinalizationETAResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SubmissionKeyResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryCheckpointBTCFinalizationETAResponse proto.InternalMessageInfo
 
-func (m *SubmissionKeyResponse) GetFirstTxBlockHash() string {
+func (m *QueryCheckpointBTCFinalizationETAResponse) GetStatus() BtcStatus {
 	if m != nil {
-		return m.FirstTxBlockHash
+		return m.Status
 	}
-	return ""
+	return Submitted
 }
 
-func (m *SubmissionKeyResponse) GetFirstTxIndex() uint32 {
+func (m *QueryCheckpointBTCFinalizationETAResponse) GetBestSubmissionDepth() uint64 {
 	if m != nil {
-		return m.FirstTxIndex
+		return m.BestSubmissionDepth
 	}
 	return 0
 }
 
-func (m *SubmissionKeyResponse) GetSecondTxBlockHash() string {
+func (m *QueryCheckpointBTCFinalizationETAResponse) GetFinalizationDepth() uint64 {
 	if m != nil {
-		return m.SecondTxBlockHash
+		return m.FinalizationDepth
 	}
-	return ""
+	return 0
 }
 
-func (m *SubmissionKeyResponse) GetSecondTxIndex() uint32 {
+func (m *QueryCheckpointBTCFinalizationETAResponse) GetRemainingBtcBlocks() uint64 {
 	if m != nil {
-		return m.SecondTxIndex
+		return m.RemainingBtcBlocks
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.btccheckpoint.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.btccheckpoint.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryBtcCheckpointInfoRequest)(nil), "babylon.btccheckpoint.v1.QueryBtcCheckpointInfoRequest")
-	proto.RegisterType((*QueryBtcCheckpointInfoResponse)(nil), "babylon.btccheckpoint.v1.QueryBtcCheckpointInfoResponse")
-	proto.RegisterType((*QueryBtcCheckpointsInfoRequest)(nil), "babylon.btccheckpoint.v1.QueryBtcCheckpointsInfoRequest")
-	proto.RegisterType((*QueryBtcCheckpointsInfoResponse)(nil), "babylon.btccheckpoint.v1.QueryBtcCheckpointsInfoResponse")
-	proto.RegisterType((*QueryEpochSubmissionsRequest)(nil), "babylon.btccheckpoint.v1.QueryEpochSubmissionsRequest")
-	proto.RegisterType((*QueryEpochSubmissionsResponse)(nil), "babylon.btccheckpoint.v1.QueryEpochSubmissionsResponse")
-	proto.RegisterType((*BTCCheckpointInfoResponse)(nil), "babylon.btccheckpoint.v1.BTCCheckpointInfoResponse")
-	proto.RegisterType((*TransactionInfoResponse)(nil), "babylon.btccheckpoint.v1.TransactionInfoResponse")
-	proto.RegisterType((*CheckpointAddressesResponse)(nil), "babylon.btccheckpoint.v1.CheckpointAddressesResponse")
-	proto.RegisterType((*SubmissionKeyResponse)(nil), "babylon.btccheckpoint.v1.SubmissionKeyResponse")
-}
-
-func init() {
-	proto.RegisterFile("babylon/btccheckpoint/v1/query.proto", fileDescriptor_6b9a2f46ada7d854)
+func (m *QueryCheckpointBTCFinalizationETAResponse) GetEstimatedSeconds() uint64 {
+	if m != nil {
+		return m.EstimatedSeconds
+	}
+	return 0
 }
 
-var fileDescriptor_6b9a2f46ada7d854 = []byte{
-	// 949 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x41, 0x6f, 0xdc, 0x44,
-	0x14, 0x8e, 0x37, 0x4e, 0x94, 0x7d, 0x69, 0xa1, 0x9d, 0x2e, 0x62, 0xb3, 0x09, 0xdb, 0xad, 0xd5,
-	0xa6, 0x11, 0x22, 0xb6, 0xb6, 0xa1, 0x2d, 0x15, 0x08, 0x89, 0x8d, 0x68, 0xa9, 0x40, 0x10, 0xdc,
-	0xc0, 0x81, 0x8b, 0x35, 0xf6, 0x4e, 0xec, 0x51, 0x76, 0x3d, 0xae, 0x67, 0x36, 0xca, 0xaa, 0xe2,
-	0xc2, 0x0d, 0x71, 0x00, 0x89, 0xbf, 0xc1, 0x11, 0x6e, 0x08, 0x89, 0x03, 0x52, 0x25, 0x2e, 0x15,
-	0x5c, 0x38, 0x21, 0x94, 0xf0, 0x43, 0x2a, 0xcf, 0xcc, 0xae, 0xbd, 0x9b, 0xb8, 0x9b, 0xf4, 0xb6,
-	0xf6, 0x7c, 0xdf, 0xf7, 0xbe, 0xf9, 0xde, 0x8b, 0x5f, 0xe0, 0xba, 0x8f, 0xfd, 0x61, 0x8f, 0xc5,
-	0x8e, 0x2f, 0x82, 0x20, 0x22, 0xc1, 0x7e, 0xc2, 0x68, 0x2c, 0x9c, 0x83, 0xb6, 0xf3, 0x78, 0x40,
-	0xd2, 0xa1, 0x9d, 0xa4, 0x4c, 0x30, 0x54, 0xd7, 0x28, 0x7b, 0x02, 0x65, 0x1f, 0xb4, 0x1b, 0xb5,
-	0x90, 0x85, 0x4c, 0x82, 0x9c, 0xec, 0x97, 0xc2, 0x37, 0x56, 0x02, 0xc6, 0xfb, 0x8c, 0x7b, 0xea,
-	0x40, 0x3d, 0xe8, 0xa3, 0xb5, 0x90, 0xb1, 0xb0, 0x47, 0x1c, 0x9c, 0x50, 0x07, 0xc7, 0x31, 0x13,
-	0x58, 0x50, 0x16, 0x8f, 0x4e, 0xdf, 0x54, 0x58, 0xc7, 0xc7, 0x9c, 0x28, 0x07, 0xce, 0x41, 0xdb,
-	0x27, 0x02, 0xb7, 0x9d, 0x04, 0x87, 0x34, 0x96, 0x60, 0x8d, 0xbd, 0x51, 0x6a, 0x3d, 0xc1, 0x29,
-	0xee, 0x6b, 0x49, 0xab, 0x06, 0xe8, 0xf3, 0x4c, 0x68, 0x47, 0xbe, 0x74, 0xc9, 0xe3, 0x01, 0xe1,
-	0xc2, 0xfa, 0x02, 0xae, 0x4c, 0xbc, 0xe5, 0x09, 0x8b, 0x39, 0x41, 0xef, 0xc3, 0xa2, 0x22, 0xd7,
-	0x8d, 0x96, 0xb1, 0xb1, 0x7c, 0xab, 0x65, 0x97, 0xdd, 0xdc, 0x56, 0xcc, 0x8e, 0xf9, 0xf4, 0xdf,
-	0xab, 0x73, 0xae, 0x66, 0x59, 0xef, 0xc1, 0x1b, 0x52, 0xb6, 0x23, 0x82, 0xed, 0x31, 0xfa, 0x61,
-	0xbc, 0xc7, 0x74, 0x5d, 0xb4, 0x0a, 0x55, 0x92, 0xb0, 0x20, 0xf2, 0xe2, 0x41, 0x5f, 0xd6, 0x30,
-	0xdd, 0x25, 0xf9, 0xe2, 0xd3, 0x41, 0xdf, 0xa2, 0xd0, 0x2c, 0x63, 0x6b, 0x7f, 0x0f, 0xc0, 0xa4,
-	0xf1, 0x1e, 0xd3, 0xee, 0xb6, 0xca, 0xdd, 0x75, 0x76, 0xb7, 0x4f, 0x97, 0x70, 0xa5, 0x80, 0x15,
-	0x9d, 0x56, 0x8a, 0x17, 0x9d, 0xde, 0x07, 0xc8, 0x23, 0xd7, 0x05, 0xd7, 0x6d, 0xdd, 0xcb, 0xac,
-	0x3f, 0xb6, 0x9a, 0x10, 0xdd, 0x1f, 0x7b, 0x07, 0x87, 0x44, 0x73, 0xdd, 0x02, 0xd3, 0xfa, 0xd5,
-	0x80, 0xab, 0xa5, 0xa5, 0xf4, 0xb5, 0x76, 0xa0, 0x9a, 0xb9, 0xf2, 0x7a, 0x94, 0x8b, 0xba, 0xd1,
-	0x9a, 0x7f, 0xd9, 0xbb, 0x2d, 0x65, 0x2a, 0x9f, 0x50, 0x2e, 0xd0, 0x83, 0x09, 0xf7, 0x15, 0xe9,
-	0xfe, 0xe6, 0x4c, 0xf7, 0x5a, 0xa6, 0x68, 0xff, 0x5d, 0x58, 0x93, 0xee, 0x3f, 0xcc, 0x9a, 0xf4,
-	0x68, 0xe0, 0xf7, 0x29, 0xe7, 0xd9, 0xc0, 0x9e, 0xa9, 0xa1, 0x5d, 0x3d, 0x0e, 0x27, 0xc9, 0xfa,
-	0xe2, 0xdb, 0x60, 0xee, 0x93, 0x21, 0xd7, 0x77, 0x76, 0xca, 0xef, 0x9c, 0x93, 0x3f, 0x26, 0xc3,
-	0xbc, 0x97, 0x19, 0xd9, 0xfa, 0x73, 0x1e, 0x56, 0x4a, 0x33, 0x41, 0xd7, 0xe0, 0xc2, 0xd8, 0xa0,
-	0x4f, 0x52, 0xed, 0x71, 0x79, 0xe4, 0xd1, 0x27, 0x29, 0xba, 0x0f, 0x2d, 0x9f, 0x70, 0xe1, 0xf1,
-	0x71, 0x11, 0xcf, 0x17, 0x81, 0xe7, 0xf7, 0x58, 0xb0, 0xef, 0x45, 0x84, 0x86, 0x91, 0x90, 0x11,
-	0x9a, 0xee, 0x5a, 0x86, 0xcb, 0xbd, 0x74, 0x44, 0xd0, 0xc9, 0x40, 0x1f, 0x49, 0x0c, 0xea, 0x40,
-	0xf3, 0x05, 0x3a, 0x98, 0x47, 0xf5, 0xf9, 0x96, 0xb1, 0x51, 0x75, 0x1b, 0x25, 0x2a, 0x98, 0x47,
-	0x88, 0xc3, 0xda, 0xb4, 0x86, 0x48, 0x71, 0xcc, 0x71, 0x20, 0xbf, 0x13, 0x75, 0x53, 0x26, 0xd5,
-	0x2e, 0x4f, 0x6a, 0x37, 0x47, 0x4f, 0xcc, 0xc6, 0x54, 0xd1, 0x02, 0x8c, 0xa3, 0x6f, 0x0d, 0x58,
-	0x9f, 0xae, 0x7a, 0x40, 0x43, 0xda, 0xc3, 0xb1, 0x20, 0x1e, 0xee, 0x76, 0x53, 0xc2, 0xb9, 0x9a,
-	0xce, 0x05, 0x59, 0xff, 0x76, 0x79, 0xfd, 0xbc, 0x0d, 0x1f, 0x28, 0x1e, 0x19, 0xb7, 0xdb, 0xb5,
-	0x26, 0x3d, 0x7c, 0x39, 0x2a, 0xa1, 0x91, 0xd9, 0xe4, 0x5a, 0x4f, 0xe0, 0xf5, 0x92, 0x2b, 0xa0,
-	0x1a, 0x2c, 0xd0, 0xb8, 0x4b, 0x0e, 0x65, 0x0f, 0x2f, 0xba, 0xea, 0x01, 0x21, 0x30, 0x65, 0xb6,
-	0x15, 0x99, 0xad, 0xfc, 0x8d, 0x5a, 0xb0, 0x5c, 0x48, 0x4d, 0xc7, 0x5e, 0x7c, 0x95, 0x69, 0x25,
-	0x29, 0x63, 0x7b, 0x75, 0x53, 0x9e, 0xa9, 0x07, 0xeb, 0x3b, 0x03, 0x56, 0x5f, 0x70, 0x01, 0x74,
-	0x07, 0xaa, 0x32, 0x22, 0x21, 0xf4, 0x24, 0x55, 0x3b, 0xf5, 0xbf, 0x7e, 0xde, 0xac, 0xe9, 0x3f,
-	0x2c, 0x4d, 0x78, 0x24, 0x52, 0x1a, 0x87, 0x6e, 0x0e, 0x45, 0x6f, 0xc3, 0x52, 0x4a, 0x12, 0x96,
-	0x66, 0xb4, 0xca, 0x0c, 0xda, 0x18, 0x69, 0xfd, 0x61, 0xc0, 0x6b, 0xa7, 0x0e, 0x3e, 0xda, 0x84,
-	0x2b, 0x7b, 0x34, 0xe5, 0xc2, 0x13, 0x87, 0xc5, 0xf1, 0x92, 0x8e, 0xdc, 0x4b, 0xf2, 0x68, 0xf7,
-	0x30, 0x1f, 0xaa, 0xeb, 0xf0, 0xca, 0x18, 0xae, 0x12, 0xac, 0xc8, 0x04, 0x2f, 0x68, 0xe4, 0x43,
-	0x19, 0xa4, 0x03, 0x35, 0x4e, 0x02, 0x16, 0x77, 0xa7, 0x54, 0x55, 0x7a, 0x97, 0xd5, 0x59, 0x51,
-	0x76, 0x1d, 0x5e, 0xcd, 0x09, 0x4a, 0xd7, 0x94, 0xba, 0x17, 0x47, 0x58, 0x29, 0x7c, 0xeb, 0xf7,
-	0x05, 0x58, 0x90, 0xdf, 0x01, 0xf4, 0xbd, 0x01, 0x8b, 0x6a, 0x71, 0xa0, 0xb7, 0xca, 0x47, 0xe8,
-	0xe4, 0xbe, 0x6a, 0x6c, 0x9e, 0x11, 0xad, 0xf2, 0xb1, 0x36, 0xbe, 0xf9, 0xfb, 0xff, 0x1f, 0x2b,
-	0x16, 0x6a, 0x39, 0x33, 0x96, 0x24, 0xfa, 0xc5, 0x80, 0xcb, 0x27, 0xf6, 0x0d, 0xba, 0x3b, 0xa3,
-	0x5c, 0xd9, 0x7e, 0x6b, 0xbc, 0x73, 0x7e, 0xa2, 0xb6, 0xbc, 0x29, 0x2d, 0xdf, 0x44, 0x37, 0xca,
-	0x2d, 0x3f, 0x19, 0x7f, 0xc8, 0xbe, 0x46, 0x3f, 0x19, 0x80, 0x4e, 0x6e, 0x14, 0x74, 0xae, 0xfa,
-	0xc5, 0x7d, 0xd7, 0xb8, 0xf7, 0x12, 0x4c, 0x6d, 0xfd, 0x9a, 0xb4, 0xbe, 0x8a, 0x56, 0x4a, 0xad,
-	0xa3, 0xdf, 0x0c, 0xb8, 0x34, 0xbd, 0x05, 0xd0, 0x9d, 0x19, 0x25, 0x4b, 0x76, 0x4e, 0xe3, 0xee,
-	0xb9, 0x79, 0xda, 0xe8, 0x3d, 0x69, 0x74, 0x0b, 0xb5, 0xcf, 0x94, 0xb1, 0x93, 0x7f, 0x0d, 0x79,
-	0xe7, 0xb3, 0xa7, 0x47, 0x4d, 0xe3, 0xd9, 0x51, 0xd3, 0xf8, 0xef, 0xa8, 0x69, 0xfc, 0x70, 0xdc,
-	0x9c, 0x7b, 0x76, 0xdc, 0x9c, 0xfb, 0xe7, 0xb8, 0x39, 0xf7, 0xd5, 0xed, 0x90, 0x8a, 0x68, 0xe0,
-	0xdb, 0x01, 0xeb, 0x8f, 0x64, 0x83, 0x08, 0xd3, 0x78, 0x5c, 0xe3, 0x70, 0xaa, 0x8a, 0x18, 0x26,
-	0x84, 0xfb, 0x8b, 0xf2, 0xdf, 0xb3, 0xad, 0xe7, 0x01, 0x00, 0x00, 0xff, 0xff, 0x07, 0x9f, 0x31,
-	0x97, 0x82, 0x0a, 0x00, 0x00,
+// QueryLatestSubmittedCheckpointRequest is request type for the
+// Query/LatestSubmittedCheckpoint RPC method
+type QueryLatestSubmittedCheckpointRequest struct {
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryLatestSubmittedCheckpointRequest) Reset()         { *m = QueryLatestSubmittedCheckpointRequest{} }
+func (m *QueryLatestSubmittedCheckpointRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestSubmittedCheckpointRequest) ProtoMessage()    {}
+func (*QueryLatestSubmittedCheckpointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9a2f46ada7d854, []int{15}
+}
+func (m *QueryLatestSubmittedCheckpointRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestSubmittedCheckpointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestSubmittedCheckpointRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLatestSubmittedCheckpointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestSubmittedCheckpointRequest.Merge(m, src)
+}
+func (m *QueryLatestSubmittedCheckpointRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestSubmittedCheckpointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestSubmittedCheckpointRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryLatestSubmittedCheckpointRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// BtcCheckpointInfo returns checkpoint info for a given epoch
-	BtcCheckpointInfo(ctx context.Context, in *QueryBtcCheckpointInfoRequest, opts ...grpc.CallOption) (*QueryBtcCheckpointInfoResponse, error)
-	// BtcCheckpointsInfo returns checkpoint info for a range of epochs
-	BtcCheckpointsInfo(ctx context.Context, in *QueryBtcCheckpointsInfoRequest, opts ...grpc.CallOption) (*QueryBtcCheckpointsInfoResponse, error)
-	// EpochSubmissions returns all submissions for a given epoch
-	EpochSubmissions(ctx context.Context, in *QueryEpochSubmissionsRequest, opts ...grpc.CallOption) (*QueryEpochSubmissionsResponse, error)
+// QueryLatestSubmittedCheckpointResponse is response type for the
+// Query/LatestSubmittedCheckpoint RPC method
+type QueryLatestSubmittedCheckpointResponse struct {
+	// epoch_num is the number of the highest epoch whose checkpoint is in
+	// Submitted status
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// keys are the keys of all BTC submissions of the epoch's checkpoint on
+	// the BTC main chain
+	Keys []*SubmissionKeyResponse `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	// best_submission is the info of the best submission of the epoch's
+	// checkpoint, including its BTC transactions
+	BestSubmission *BTCCheckpointInfoResponse `protobuf:"bytes,3,opt,name=best_submission,json=bestSubmission,proto3" json:"best_submission,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryLatestSubmittedCheckpointResponse) Reset() {
+	*m = QueryLatestSubmittedCheckpointResponse{}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryLatestSubmittedCheckpointResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestSubmittedCheckpointResponse) ProtoMessage()    {}
+func (*QueryLatestSubmittedCheckpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9a2f46ada7d854, []int{16}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btccheckpoint.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLatestSubmittedCheckpointResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestSubmittedCheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestSubmittedCheckpointResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryLatestSubmittedCheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestSubmittedCheckpointResponse.Merge(m, src)
+}
+func (m *QueryLatestSubmittedCheckpointResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestSubmittedCheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestSubmittedCheckpointResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) BtcCheckpointInfo(ctx context.Context, in *QueryBtcCheckpointInfoRequest, opts ...grpc.CallOption) (*QueryBtcCheckpointInfoResponse, error) {
-	out := new(QueryBtcCheckpointInfoResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btccheckpoint.v1.Query/BtcCheckpointInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLatestSubmittedCheckpointResponse proto.InternalMessageInfo
+
+func (m *QueryLatestSubmittedCheckpointResponse) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) BtcCheckpointsInfo(ctx context.Context, in *QueryBtcCheckpointsInfoRequest, opts ...grpc.CallOption) (*QueryBtcCheckpointsInfoResponse, error) {
-	out := new(QueryBtcCheckpointsInfoResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btccheckpoint.v1.Query/BtcCheckpointsInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLatestSubmittedCheckpointResponse) GetKeys() []*SubmissionKeyResponse {
+	if m != nil {
+		return m.Keys
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) EpochSubmissions(ctx context.Context, in *QueryEpochSubmissionsRequest, opts ...grpc.CallOption) (*QueryEpochSubmissionsResponse, error) {
-	out := new(QueryEpochSubmissionsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btccheckpoint.v1.Query/EpochSubmissions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLatestSubmittedCheckpointResponse) GetBestSubmission() *BTCCheckpointInfoResponse {
+	if m != nil {
+		return m.BestSubmission
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// BtcCheckpointInfo returns checkpoint info for a given epoch
-	BtcCheckpointInfo(context.Context, *QueryBtcCheckpointInfoRequest) (*QueryBtcCheckpointInfoResponse, error)
-	// BtcCheckpointsInfo returns checkpoint info for a range of epochs
-	BtcCheckpointsInfo(context.Context, *QueryBtcCheckpointsInfoRequest) (*QueryBtcCheckpointsInfoResponse, error)
-	// EpochSubmissions returns all submissions for a given epoch
-	EpochSubmissions(context.Context, *QueryEpochSubmissionsRequest) (*QueryEpochSubmissionsResponse, error)
+// BTCCheckpointInfoResponse contains all data about best submission of checkpoint for
+// given epoch. Best submission is the submission which is deeper in btc ledger.
+type BTCCheckpointInfoResponse struct {
+	// EpochNumber of this checkpoint.
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// btc height of the best submission of the epoch
+	BestSubmissionBtcBlockHeight uint64 `protobuf:"varint,2,opt,name=best_submission_btc_block_height,json=bestSubmissionBtcBlockHeight,proto3" json:"best_submission_btc_block_height,omitempty"`
+	// hash of the btc block which determines checkpoint btc block height i.e.
+	// youngest block of best submission Hexadecimal
+	BestSubmissionBtcBlockHash string `protobuf:"bytes,3,opt,name=best_submission_btc_block_hash,json=bestSubmissionBtcBlockHash,proto3" json:"best_submission_btc_block_hash,omitempty"`
+	// the BTC checkpoint transactions of the best submission
+	BestSubmissionTransactions []*TransactionInfoResponse `protobuf:"bytes,4,rep,name=best_submission_transactions,json=bestSubmissionTransactions,proto3" json:"best_submission_transactions,omitempty"`
+	// list of vigilantes' addresses of the best submission
+	BestSubmissionVigilanteAddressList []*CheckpointAddressesResponse `protobuf:"bytes,5,rep,name=best_submission_vigilante_address_list,json=bestSubmissionVigilanteAddressList,proto3" json:"best_submission_vigilante_address_list,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *BTCCheckpointInfoResponse) Reset()         { *m = BTCCheckpointInfoResponse{} }
+func (m *BTCCheckpointInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*BTCCheckpointInfoResponse) ProtoMessage()    {}
+func (*BTCCheckpointInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9a2f46ada7d854, []int{17}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *BTCCheckpointInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) BtcCheckpointInfo(ctx context.Context, req *QueryBtcCheckpointInfoRequest) (*QueryBtcCheckpointInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BtcCheckpointInfo not implemented")
+func (m *BTCCheckpointInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCCheckpointInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) BtcCheckpointsInfo(ctx context.Context, req *QueryBtcCheckpointsInfoRequest) (*QueryBtcCheckpointsInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BtcCheckpointsInfo not implemented")
+func (m *BTCCheckpointInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCCheckpointInfoResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) EpochSubmissions(ctx context.Context, req *QueryEpochSubmissionsRequest) (*QueryEpochSubmissionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EpochSubmissions not implemented")
+func (m *BTCCheckpointInfoResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *BTCCheckpointInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCCheckpointInfoResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btccheckpoint.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_BTCCheckpointInfoResponse proto.InternalMessageInfo
+
+func (m *BTCCheckpointInfoResponse) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_BtcCheckpointInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBtcCheckpointInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BtcCheckpointInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btccheckpoint.v1.Query/BtcCheckpointInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BtcCheckpointInfo(ctx, req.(*QueryBtcCheckpointInfoRequest))
+func (m *BTCCheckpointInfoResponse) GetBestSubmissionBtcBlockHeight() uint64 {
+	if m != nil {
+		return m.BestSubmissionBtcBlockHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_BtcCheckpointsInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBtcCheckpointsInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BtcCheckpointsInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btccheckpoint.v1.Query/BtcCheckpointsInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BtcCheckpointsInfo(ctx, req.(*QueryBtcCheckpointsInfoRequest))
+func (m *BTCCheckpointInfoResponse) GetBestSubmissionBtcBlockHash() string {
+	if m != nil {
+		return m.BestSubmissionBtcBlockHash
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_EpochSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEpochSubmissionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EpochSubmissions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btccheckpoint.v1.Query/EpochSubmissions",
+func (m *BTCCheckpointInfoResponse) GetBestSubmissionTransactions() []*TransactionInfoResponse {
+	if m != nil {
+		return m.BestSubmissionTransactions
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EpochSubmissions(ctx, req.(*QueryEpochSubmissionsRequest))
+	return nil
+}
+
+func (m *BTCCheckpointInfoResponse) GetBestSubmissionVigilanteAddressList() []*CheckpointAddressesResponse {
+	if m != nil {
+		return m.BestSubmissionVigilanteAddressList
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "babylon.btccheckpoint.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "BtcCheckpointInfo",
-			Handler:    _Query_BtcCheckpointInfo_Handler,
-		},
-		{
-			MethodName: "BtcCheckpointsInfo",
-			Handler:    _Query_BtcCheckpointsInfo_Handler,
-		},
-		{
-			MethodName: "EpochSubmissions",
-			Handler:    _Query_EpochSubmissions_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "babylon/btccheckpoint/v1/query.proto",
+// TransactionInfoResponse is the info of a tx on Bitcoin,
+// including
+// - the position of the tx on BTC blockchain
+// - the full tx content
+// - the Merkle proof that this tx is on the above position
+type TransactionInfoResponse struct {
+	// Index Bitcoin Transaction index in block.
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// Hash BTC Header hash as hex.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// transaction is the full transaction data as str hex.
+	Transaction string `protobuf:"bytes,3,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// proof is the Merkle proof that this tx is included in the position in `key`
+	Proof string `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TransactionInfoResponse) Reset()         { *m = TransactionInfoResponse{} }
+func (m *TransactionInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*TransactionInfoResponse) ProtoMessage()    {}
+func (*TransactionInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9a2f46ada7d854, []int{18}
+}
+func (m *TransactionInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactionInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransactionInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TransactionInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionInfoResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *TransactionInfoResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *TransactionInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionInfoResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_TransactionInfoResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *TransactionInfoResponse) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryBtcCheckpointInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TransactionInfoResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryBtcCheckpointInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return ""
 }
 
-func (m *QueryBtcCheckpointInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EpochNum != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
-		i--
-		dAtA[i] = 0x8
+func (m *TransactionInfoResponse) GetTransaction() string {
+	if m != nil {
+		return m.Transaction
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryBtcCheckpointInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TransactionInfoResponse) GetProof() string {
+	if m != nil {
+		return m.Proof
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryBtcCheckpointInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CheckpointAddressesResponse contains the addresses of the submitter and reporter of a
+// given checkpoint
+type CheckpointAddressesResponse struct {
+	// submitter is the address of the checkpoint submitter to BTC, extracted from
+	// the checkpoint itself.
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// reporter is the address of the reporter who reported the submissions,
+	// calculated from submission message MsgInsertBTCSpvProof itself
+	Reporter string `protobuf:"bytes,2,opt,name=reporter,proto3" json:"reporter,omitempty"`
 }
 
-func (m *QueryBtcCheckpointInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Info != nil {
-		{
-			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *CheckpointAddressesResponse) Reset()         { *m = CheckpointAddressesResponse{} }
+func (m *CheckpointAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckpointAddressesResponse) ProtoMessage()    {}
+func (*CheckpointAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9a2f46ada7d854, []int{19}
+}
+func (m *CheckpointAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointAddressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryBtcCheckpointsInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *CheckpointAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointAddressesResponse.Merge(m, src)
 }
-
-func (m *QueryBtcCheckpointsInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CheckpointAddressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointAddressesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBtcCheckpointsInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_CheckpointAddressesResponse proto.InternalMessageInfo
+
+func (m *CheckpointAddressesResponse) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryBtcCheckpointsInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CheckpointAddressesResponse) GetReporter() string {
+	if m != nil {
+		return m.Reporter
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryBtcCheckpointsInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SubmissionKeyResponse Checkpoint can be composed from multiple transactions,
+// so to identify whole submission we need list of transaction keys.
+// Each submission can generally be identified by this list of (txIdx,
+// blockHash) tuples. Note: this could possibly be optimized as if transactions
+// were in one block they would have the same block hash and different indexes,
+// but each blockhash is only 33 (1  byte for prefix encoding and 32 byte hash),
+// so there should be other strong arguments for this optimization
+type SubmissionKeyResponse struct {
+	// FirstTxBlockHash is the BTCHeaderHashBytes in hex.
+	FirstTxBlockHash string `protobuf:"bytes,1,opt,name=first_tx_block_hash,json=firstTxBlockHash,proto3" json:"first_tx_block_hash,omitempty"`
+	FirstTxIndex     uint32 `protobuf:"varint,2,opt,name=first_tx_index,json=firstTxIndex,proto3" json:"first_tx_index,omitempty"`
+	// SecondBlockHash is the BTCHeaderHashBytes in hex.
+	SecondTxBlockHash string `protobuf:"bytes,3,opt,name=second_tx_block_hash,json=secondTxBlockHash,proto3" json:"second_tx_block_hash,omitempty"`
+	SecondTxIndex     uint32 `protobuf:"varint,4,opt,name=second_tx_index,json=secondTxIndex,proto3" json:"second_tx_index,omitempty"`
 }
 
-func (m *QueryBtcCheckpointsInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.InfoList) > 0 {
-		for iNdEx := len(m.InfoList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.InfoList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *SubmissionKeyResponse) Reset()         { *m = SubmissionKeyResponse{} }
+func (m *SubmissionKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*SubmissionKeyResponse) ProtoMessage()    {}
+func (*SubmissionKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9a2f46ada7d854, []int{20}
+}
+func (m *SubmissionKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmissionKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmissionKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SubmissionKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmissionKeyResponse.Merge(m, src)
+}
+func (m *SubmissionKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmissionKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmissionKeyResponse.DiscardUnknown(m)
 }
 
-func (m *QueryEpochSubmissionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SubmissionKeyResponse proto.InternalMessageInfo
+
+func (m *SubmissionKeyResponse) GetFirstTxBlockHash() string {
+	if m != nil {
+		return m.FirstTxBlockHash
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryEpochSubmissionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SubmissionKeyResponse) GetFirstTxIndex() uint32 {
+	if m != nil {
+		return m.FirstTxIndex
+	}
+	return 0
 }
 
-func (m *QueryEpochSubmissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EpochNum != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
-		i--
-		dAtA[i] = 0x8
+func (m *SubmissionKeyResponse) GetSecondTxBlockHash() string {
+	if m != nil {
+		return m.SecondTxBlockHash
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryEpochSubmissionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SubmissionKeyResponse) GetSecondTxIndex() uint32 {
+	if m != nil {
+		return m.SecondTxIndex
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryEpochSubmissionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.btccheckpoint.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.btccheckpoint.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryBtcCheckpointInfoRequest)(nil), "babylon.btccheckpoint.v1.QueryBtcCheckpointInfoRequest")
+	proto.RegisterType((*QueryBtcCheckpointInfoResponse)(nil), "babylon.btccheckpoint.v1.QueryBtcCheckpointInfoResponse")
+	proto.RegisterType((*QueryBtcCheckpointsInfoRequest)(nil), "babylon.btccheckpoint.v1.QueryBtcCheckpointsInfoRequest")
+	proto.RegisterType((*QueryBtcCheckpointsInfoResponse)(nil), "babylon.btccheckpoint.v1.QueryBtcCheckpointsInfoResponse")
+	proto.RegisterType((*QueryEpochSubmissionsRequest)(nil), "babylon.btccheckpoint.v1.QueryEpochSubmissionsRequest")
+	proto.RegisterType((*QueryEpochSubmissionsResponse)(nil), "babylon.btccheckpoint.v1.QueryEpochSubmissionsResponse")
+	proto.RegisterType((*QueryRecentCheckpointsRequest)(nil), "babylon.btccheckpoint.v1.QueryRecentCheckpointsRequest")
+	proto.RegisterType((*QueryRecentCheckpointsResponse)(nil), "babylon.btccheckpoint.v1.QueryRecentCheckpointsResponse")
+	proto.RegisterType((*RecentCheckpointResponse)(nil), "babylon.btccheckpoint.v1.RecentCheckpointResponse")
+	proto.RegisterType((*QueryEpochBTCRangeRequest)(nil), "babylon.btccheckpoint.v1.QueryEpochBTCRangeRequest")
+	proto.RegisterType((*QueryEpochBTCRangeResponse)(nil), "babylon.btccheckpoint.v1.QueryEpochBTCRangeResponse")
+	proto.RegisterType((*QueryCheckpointBTCFinalizationETARequest)(nil), "babylon.btccheckpoint.v1.QueryCheckpointBTCFinalizationETARequest")
+	proto.RegisterType((*QueryCheckpointBTCFinalizationETAResponse)(nil), "babylon.btccheckpoint.v1.QueryCheckpointBTCFinalizationETAResponse")
+	proto.RegisterType((*QueryLatestSubmittedCheckpointRequest)(nil), "babylon.btccheckpoint.v1.QueryLatestSubmittedCheckpointRequest")
+	proto.RegisterType((*QueryLatestSubmittedCheckpointResponse)(nil), "babylon.btccheckpoint.v1.QueryLatestSubmittedCheckpointResponse")
+	proto.RegisterType((*BTCCheckpointInfoResponse)(nil), "babylon.btccheckpoint.v1.BTCCheckpointInfoResponse")
+	proto.RegisterType((*TransactionInfoResponse)(nil), "babylon.btccheckpoint.v1.TransactionInfoResponse")
+	proto.RegisterType((*CheckpointAddressesResponse)(nil), "babylon.btccheckpoint.v1.CheckpointAddressesResponse")
+	proto.RegisterType((*SubmissionKeyResponse)(nil), "babylon.btccheckpoint.v1.SubmissionKeyResponse")
 }
 
-func (m *QueryEpochSubmissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Keys) > 0 {
-		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("babylon/btccheckpoint/v1/query.proto", fileDescriptor_6b9a2f46ada7d854)
 }
 
-func (m *BTCCheckpointInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var fileDescriptor_6b9a2f46ada7d854 = []byte{
+	// 1472 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xba, 0x4e, 0x94, 0xbc, 0xf4, 0x57, 0xa6, 0xae, 0xbe, 0x8e, 0x9b, 0xba, 0xee, 0x7e,
+	0xdb, 0x34, 0x2d, 0x8d, 0xb7, 0x4e, 0xfa, 0x23, 0x55, 0x51, 0xa1, 0x0e, 0x6d, 0xa9, 0xa8, 0xa0,
+	0x6c, 0x0c, 0x07, 0x84, 0x64, 0x8d, 0xd7, 0x13, 0x7b, 0x54, 0x7b, 0xd7, 0xdd, 0x19, 0x9b, 0x84,
+	0x8a, 0x03, 0xdc, 0x10, 0x07, 0x90, 0xf8, 0x2f, 0x10, 0x27, 0x7e, 0xdc, 0x0a, 0x37, 0xa4, 0x4a,
+	0x5c, 0x2a, 0xb8, 0x70, 0xaa, 0x50, 0x83, 0x04, 0x7f, 0x06, 0xda, 0x99, 0xd9, 0x1f, 0x76, 0x32,
+	0xb1, 0x93, 0x72, 0xf3, 0xee, 0x7c, 0xde, 0x7b, 0x9f, 0xf7, 0x79, 0x6f, 0x67, 0xde, 0x18, 0xce,
+	0xd4, 0x70, 0x6d, 0xb3, 0xe5, 0xb9, 0x56, 0x8d, 0x3b, 0x4e, 0x93, 0x38, 0x0f, 0x3b, 0x1e, 0x75,
+	0xb9, 0xd5, 0x2b, 0x59, 0x8f, 0xba, 0xc4, 0xdf, 0x2c, 0x76, 0x7c, 0x8f, 0x7b, 0x28, 0xab, 0x50,
+	0xc5, 0x3e, 0x54, 0xb1, 0x57, 0xca, 0x65, 0x1a, 0x5e, 0xc3, 0x13, 0x20, 0x2b, 0xf8, 0x25, 0xf1,
+	0xb9, 0x59, 0xc7, 0x63, 0x6d, 0x8f, 0x55, 0xe5, 0x82, 0x7c, 0x50, 0x4b, 0x73, 0x0d, 0xcf, 0x6b,
+	0xb4, 0x88, 0x85, 0x3b, 0xd4, 0xc2, 0xae, 0xeb, 0x71, 0xcc, 0xa9, 0xe7, 0x86, 0xab, 0x17, 0x24,
+	0xd6, 0xaa, 0x61, 0x46, 0x24, 0x03, 0xab, 0x57, 0xaa, 0x11, 0x8e, 0x4b, 0x56, 0x07, 0x37, 0xa8,
+	0x2b, 0xc0, 0x0a, 0x7b, 0x51, 0x4b, 0xbd, 0x9f, 0xa5, 0x44, 0x9f, 0xd5, 0xa2, 0x3b, 0xd8, 0xc7,
+	0xed, 0x90, 0xc0, 0xf9, 0x10, 0x16, 0x63, 0xa8, 0xdb, 0x08, 0x60, 0x83, 0x1e, 0xcd, 0x0c, 0xa0,
+	0x77, 0x03, 0x86, 0x0f, 0x84, 0xbd, 0x4d, 0x1e, 0x75, 0x09, 0xe3, 0xe6, 0x7b, 0x70, 0xac, 0xef,
+	0x2d, 0xeb, 0x78, 0x2e, 0x23, 0xe8, 0x26, 0x4c, 0xc8, 0x38, 0x59, 0xa3, 0x60, 0x2c, 0x4c, 0x2f,
+	0x15, 0x8a, 0x3a, 0x49, 0x8b, 0xd2, 0xb2, 0x9c, 0x7e, 0xfa, 0xfc, 0xd4, 0x98, 0xad, 0xac, 0xcc,
+	0x57, 0xe1, 0xa4, 0x70, 0x5b, 0xe6, 0xce, 0x6a, 0x84, 0xbe, 0xe7, 0xae, 0x7b, 0x2a, 0x2e, 0x3a,
+	0x01, 0x53, 0xa4, 0xe3, 0x39, 0xcd, 0xaa, 0xdb, 0x6d, 0x8b, 0x18, 0x69, 0x7b, 0x52, 0xbc, 0x78,
+	0xbb, 0xdb, 0x36, 0x29, 0xe4, 0x75, 0xd6, 0x8a, 0xdf, 0x5d, 0x48, 0x53, 0x77, 0xdd, 0x53, 0xec,
+	0x96, 0xf5, 0xec, 0xca, 0x95, 0xd5, 0x9d, 0x5d, 0xd8, 0xc2, 0x81, 0xd9, 0xdc, 0x29, 0x14, 0x4b,
+	0x32, 0xbd, 0x03, 0x10, 0xd7, 0x52, 0x05, 0x9c, 0x2f, 0xaa, 0x26, 0x09, 0x0a, 0x5f, 0x94, 0xad,
+	0xa7, 0x0a, 0x5f, 0x7c, 0x80, 0x1b, 0x44, 0xd9, 0xda, 0x09, 0x4b, 0xf3, 0x89, 0x01, 0xa7, 0xb4,
+	0xa1, 0x54, 0x5a, 0x0f, 0x60, 0x2a, 0x60, 0x55, 0x6d, 0x51, 0xc6, 0xb3, 0x46, 0xe1, 0xc0, 0x7e,
+	0x73, 0x9b, 0x0c, 0xbc, 0xdc, 0xa7, 0x8c, 0xa3, 0xbb, 0x7d, 0xec, 0x53, 0x82, 0xfd, 0xb9, 0xa1,
+	0xec, 0x95, 0x9b, 0x24, 0xfd, 0x1b, 0x30, 0x27, 0xd8, 0xdf, 0x0e, 0x8a, 0xb4, 0xd6, 0xad, 0xb5,
+	0x29, 0x63, 0xc1, 0x97, 0x30, 0x52, 0x41, 0xeb, 0x70, 0x52, 0x63, 0xac, 0x12, 0x5f, 0x85, 0xf4,
+	0x43, 0xb2, 0xc9, 0x54, 0xce, 0x96, 0x3e, 0xe7, 0xd8, 0xf8, 0x2d, 0xb2, 0x19, 0xd7, 0x32, 0x30,
+	0x36, 0xaf, 0xa8, 0x28, 0x36, 0x71, 0x88, 0xcb, 0x13, 0x1a, 0x87, 0x1c, 0x33, 0x30, 0xde, 0xa2,
+	0x6d, 0xca, 0x05, 0xbf, 0x43, 0xb6, 0x7c, 0x30, 0x7b, 0x90, 0xd7, 0x99, 0x29, 0x76, 0x15, 0x98,
+	0x8e, 0x59, 0x84, 0x24, 0x97, 0xf4, 0x24, 0x07, 0x3d, 0x45, 0x3c, 0x93, 0x6e, 0xcc, 0xef, 0x52,
+	0x90, 0xd5, 0x21, 0x77, 0x95, 0x13, 0x95, 0x61, 0x82, 0x71, 0xcc, 0xbb, 0x4c, 0x14, 0xf4, 0xf0,
+	0xd2, 0x85, 0x88, 0x4a, 0xdf, 0x36, 0x10, 0x50, 0x89, 0x5d, 0xaf, 0x09, 0x0b, 0x5b, 0x59, 0x06,
+	0x01, 0x3a, 0xde, 0x47, 0xc4, 0xaf, 0xb2, 0x6e, 0x3b, 0x7b, 0x40, 0x06, 0x10, 0x2f, 0xd6, 0xba,
+	0x6d, 0x34, 0x07, 0x53, 0x2c, 0x10, 0x9a, 0x73, 0x52, 0xcf, 0xa6, 0x0b, 0xc6, 0xc2, 0xa4, 0x1d,
+	0xbf, 0x40, 0x77, 0xa0, 0x50, 0x23, 0x8c, 0x57, 0x59, 0x54, 0x8b, 0x6a, 0x8d, 0x3b, 0xd5, 0x5a,
+	0xcb, 0x73, 0x1e, 0x56, 0x9b, 0x84, 0x36, 0x9a, 0x3c, 0x3b, 0x2e, 0x3c, 0xce, 0x05, 0xb8, 0xb8,
+	0x64, 0x65, 0xee, 0x94, 0x03, 0xd0, 0x9b, 0x02, 0x83, 0x96, 0xe0, 0xf8, 0xa0, 0x9f, 0x3a, 0xe9,
+	0xf0, 0x66, 0x76, 0x42, 0x18, 0x1f, 0xeb, 0x37, 0x7e, 0x23, 0x58, 0x32, 0x57, 0x60, 0x36, 0xee,
+	0xa4, 0x72, 0x65, 0xd5, 0xc6, 0x6e, 0xf4, 0xb9, 0xed, 0xde, 0x83, 0x3f, 0x19, 0x90, 0xdb, 0xc9,
+	0x54, 0x09, 0x7e, 0x23, 0xd2, 0xd4, 0x10, 0x9a, 0xfe, 0x7f, 0x97, 0xef, 0x8e, 0x3b, 0x03, 0x62,
+	0x5e, 0x82, 0x4c, 0x24, 0x8f, 0xd0, 0x42, 0xa9, 0x90, 0x12, 0x1c, 0x50, 0xb4, 0x56, 0xe6, 0x8e,
+	0xca, 0xfd, 0x12, 0x64, 0xd6, 0xa9, 0x8b, 0x5b, 0xf4, 0xe3, 0x7e, 0x0b, 0x59, 0x09, 0x14, 0xad,
+	0x45, 0x16, 0xe6, 0x17, 0x06, 0x2c, 0x08, 0xfe, 0x71, 0x49, 0xcb, 0x95, 0xd5, 0x3b, 0x12, 0x28,
+	0xbe, 0xd1, 0xdb, 0x95, 0x5b, 0xa3, 0x28, 0x81, 0xca, 0x90, 0xc7, 0xbd, 0x46, 0xa2, 0x66, 0xd4,
+	0xe5, 0xc4, 0xef, 0xe1, 0x56, 0x95, 0x11, 0xc7, 0x73, 0xeb, 0x4c, 0xf1, 0xce, 0xe1, 0x5e, 0x23,
+	0x2c, 0xd9, 0x3d, 0x05, 0x59, 0x93, 0x08, 0xf3, 0x9b, 0x14, 0x9c, 0x1f, 0x81, 0xcd, 0x7f, 0x21,
+	0xae, 0xb6, 0x4d, 0x52, 0xda, 0x36, 0x41, 0x8b, 0x80, 0xd6, 0x13, 0x5c, 0x94, 0x81, 0x14, 0x77,
+	0x26, 0xb9, 0x22, 0xe1, 0x97, 0x20, 0xe3, 0x93, 0x36, 0xa6, 0x2e, 0x75, 0x13, 0xba, 0x30, 0xd1,
+	0xfa, 0x69, 0x1b, 0x45, 0x6b, 0xa1, 0x1a, 0x0c, 0xbd, 0x02, 0x33, 0x84, 0x71, 0xda, 0xc6, 0x41,
+	0xc5, 0x43, 0xd9, 0x64, 0xd3, 0x1f, 0x8d, 0x16, 0x42, 0xb1, 0xce, 0xc1, 0x59, 0xa1, 0xd5, 0x7d,
+	0xcc, 0x43, 0xae, 0x41, 0x37, 0x24, 0x3f, 0x7b, 0x79, 0x1a, 0xff, 0x63, 0xc0, 0xfc, 0x30, 0xe4,
+	0x28, 0x1b, 0x44, 0xb8, 0x9d, 0xa6, 0x5e, 0x62, 0x3b, 0x45, 0x1f, 0xc2, 0x91, 0x01, 0xdd, 0x85,
+	0x80, 0xfb, 0x3c, 0x92, 0x0e, 0xf7, 0x97, 0xc9, 0xfc, 0xf5, 0x00, 0xcc, 0x6a, 0xd1, 0xe8, 0x34,
+	0x1c, 0x8c, 0xb2, 0xab, 0x11, 0x5f, 0x25, 0x38, 0x1d, 0x26, 0x58, 0x23, 0xfe, 0x48, 0xbb, 0x50,
+	0x6a, 0x84, 0x5d, 0xa8, 0x0c, 0xf9, 0x5d, 0xfc, 0x60, 0x26, 0xdb, 0x66, 0xca, 0xce, 0x69, 0xbc,
+	0x60, 0xd6, 0x44, 0x0c, 0xe6, 0x06, 0x7d, 0x70, 0x1f, 0xbb, 0x0c, 0x3b, 0x62, 0x5a, 0xcc, 0xa6,
+	0x45, 0x1d, 0x4a, 0x7a, 0xdd, 0x2a, 0x31, 0xba, 0x4f, 0xb5, 0x81, 0xa0, 0x09, 0x18, 0x43, 0x9f,
+	0x1b, 0x30, 0x3f, 0x18, 0xb5, 0x47, 0x1b, 0xb4, 0x85, 0x5d, 0x4e, 0xaa, 0xb8, 0x5e, 0xf7, 0x09,
+	0x63, 0x72, 0x94, 0x18, 0x17, 0xf1, 0xaf, 0xe8, 0xe3, 0xc7, 0x65, 0xb8, 0x25, 0xed, 0x48, 0x74,
+	0xfa, 0xd9, 0x66, 0x3f, 0x87, 0xf7, 0xc3, 0x10, 0x0a, 0x19, 0x8c, 0x19, 0xe6, 0x63, 0xf8, 0x9f,
+	0x26, 0x85, 0xe0, 0xd0, 0xa5, 0x6e, 0x9d, 0x6c, 0x84, 0x87, 0xae, 0x78, 0x40, 0x08, 0xd2, 0x42,
+	0xdb, 0x94, 0xd0, 0x56, 0xfc, 0x46, 0x05, 0x98, 0x4e, 0xa8, 0xa6, 0x64, 0x4f, 0xbe, 0x0a, 0x7c,
+	0x75, 0x7c, 0xcf, 0x5b, 0x17, 0x1f, 0xe6, 0x94, 0x2d, 0x1f, 0x82, 0x9d, 0xf1, 0xc4, 0x2e, 0x09,
+	0xa0, 0xab, 0xf1, 0x69, 0x26, 0x3b, 0x69, 0xaa, 0x9c, 0xfd, 0xed, 0x87, 0xc5, 0x8c, 0x9a, 0x82,
+	0x94, 0xc1, 0x1a, 0xf7, 0xa9, 0xdb, 0x88, 0xcf, 0x39, 0x1f, 0x5d, 0x86, 0x49, 0x9f, 0x74, 0x3c,
+	0x3f, 0x30, 0x4b, 0x0d, 0x31, 0x8b, 0x90, 0xe6, 0x2f, 0x06, 0x1c, 0xdf, 0xf1, 0xb3, 0x42, 0x8b,
+	0x70, 0x6c, 0x9d, 0xfa, 0x8c, 0x57, 0xf9, 0x46, 0xb2, 0xbd, 0x04, 0x23, 0xfb, 0xa8, 0x58, 0xaa,
+	0x6c, 0xc4, 0x4d, 0x75, 0x06, 0x0e, 0x47, 0x70, 0xa9, 0x60, 0x4a, 0x28, 0x78, 0x50, 0x21, 0xef,
+	0x09, 0x21, 0x2d, 0xc8, 0xc8, 0xed, 0x67, 0xc0, 0xab, 0x54, 0x6f, 0x46, 0xae, 0x25, 0xdd, 0xce,
+	0xc3, 0x91, 0xd8, 0x40, 0xfa, 0x4d, 0x0b, 0xbf, 0x87, 0x42, 0xac, 0x70, 0xbc, 0xf4, 0xe9, 0x41,
+	0x18, 0x17, 0x7b, 0x11, 0xfa, 0xd2, 0x80, 0x09, 0x39, 0xe5, 0xa3, 0x8b, 0xfa, 0x16, 0xda, 0x7e,
+	0xb9, 0xc8, 0x2d, 0x8e, 0x88, 0x96, 0xfa, 0x98, 0x0b, 0x9f, 0xfd, 0xfe, 0xd7, 0xd7, 0x29, 0x13,
+	0x15, 0xac, 0x21, 0x97, 0x1f, 0xf4, 0xa3, 0x01, 0x33, 0xdb, 0x2e, 0x07, 0xe8, 0xda, 0x90, 0x70,
+	0xba, 0xcb, 0x48, 0x6e, 0x65, 0xef, 0x86, 0x8a, 0xf2, 0xa2, 0xa0, 0x7c, 0x0e, 0x9d, 0xd5, 0x53,
+	0x7e, 0x1c, 0x6d, 0x64, 0x9f, 0xa0, 0x6f, 0x0d, 0x40, 0xdb, 0xc7, 0x7f, 0xb4, 0xa7, 0xf8, 0xc9,
+	0xcb, 0x49, 0xee, 0xfa, 0x3e, 0x2c, 0x15, 0xf5, 0xd3, 0x82, 0xfa, 0x09, 0x34, 0xab, 0xa5, 0x8e,
+	0x7e, 0x36, 0xe0, 0xe8, 0xe0, 0xc8, 0x8e, 0xae, 0x0e, 0x09, 0xa9, 0xb9, 0x20, 0xe4, 0xae, 0xed,
+	0xd9, 0x4e, 0x11, 0xbd, 0x2e, 0x88, 0x2e, 0xa3, 0xd2, 0x48, 0x1a, 0x5b, 0x2c, 0xc1, 0xf5, 0x89,
+	0x01, 0x33, 0xdb, 0xc6, 0xfa, 0xa1, 0x7d, 0xa2, 0xbb, 0x3f, 0xe4, 0x56, 0xf6, 0x6e, 0xa8, 0x72,
+	0xb8, 0x2c, 0x72, 0x28, 0xa2, 0x8b, 0xfa, 0x1c, 0xe2, 0x27, 0x66, 0xf9, 0xc2, 0x11, 0xfa, 0xde,
+	0x80, 0x43, 0x7d, 0xd3, 0x2a, 0x5a, 0x1e, 0x45, 0xc4, 0x81, 0xb1, 0x38, 0x77, 0x79, 0x6f, 0x46,
+	0x8a, 0xf2, 0x35, 0x41, 0xb9, 0x84, 0xac, 0xd1, 0x64, 0x0f, 0xce, 0x4c, 0x5f, 0x70, 0xfc, 0xdb,
+	0x80, 0xb9, 0xdd, 0xa6, 0x42, 0x54, 0x1e, 0xc2, 0x67, 0x84, 0x01, 0x37, 0xb7, 0xfa, 0x52, 0x3e,
+	0x54, 0x8a, 0x37, 0x45, 0x8a, 0x2b, 0xe8, 0xea, 0x68, 0x29, 0xf6, 0x4d, 0x94, 0x84, 0x63, 0xf4,
+	0xdc, 0x80, 0x59, 0xed, 0xa4, 0x86, 0x5e, 0x1b, 0x42, 0x71, 0xd8, 0x34, 0x98, 0x7b, 0x7d, 0xff,
+	0x0e, 0x46, 0x4f, 0x30, 0xd9, 0x76, 0x2d, 0xcc, 0xa3, 0x79, 0x42, 0x5c, 0x57, 0xde, 0x79, 0xfa,
+	0x22, 0x6f, 0x3c, 0x7b, 0x91, 0x37, 0xfe, 0x7c, 0x91, 0x37, 0xbe, 0xda, 0xca, 0x8f, 0x3d, 0xdb,
+	0xca, 0x8f, 0xfd, 0xb1, 0x95, 0x1f, 0xfb, 0xe0, 0x4a, 0x83, 0xf2, 0x66, 0xb7, 0x56, 0x74, 0xbc,
+	0x76, 0xe8, 0xdb, 0x69, 0x62, 0xea, 0x46, 0x81, 0x36, 0x06, 0x42, 0xf1, 0xcd, 0x0e, 0x61, 0xb5,
+	0x09, 0xf1, 0x5f, 0xd4, 0xf2, 0xbf, 0x03, 0x00, 0xc3, 0x0e, 0x59, 0x28, 0xc8, 0x13, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// BtcCheckpointInfo returns checkpoint info for a given epoch
+	BtcCheckpointInfo(ctx context.Context, in *QueryBtcCheckpointInfoRequest, opts ...grpc.CallOption) (*QueryBtcCheckpointInfoResponse, error)
+	// BtcCheckpointsInfo returns checkpoint info for a range of epochs
+	BtcCheckpointsInfo(ctx context.Context, in *QueryBtcCheckpointsInfoRequest, opts ...grpc.CallOption) (*QueryBtcCheckpointsInfoResponse, error)
+	// EpochSubmissions returns all submissions for a given epoch
+	EpochSubmissions(ctx context.Context, in *QueryEpochSubmissionsRequest, opts ...grpc.CallOption) (*QueryEpochSubmissionsResponse, error)
+	// RecentCheckpoints returns the checkpoints of the most recent epochs,
+	// newest first, together with their status and BTC submission depth
+	RecentCheckpoints(ctx context.Context, in *QueryRecentCheckpointsRequest, opts ...grpc.CallOption) (*QueryRecentCheckpointsResponse, error)
+	// EpochBTCRange returns the BTC heights at which the checkpoint of a given
+	// epoch was submitted and finalized
+	EpochBTCRange(ctx context.Context, in *QueryEpochBTCRangeRequest, opts ...grpc.CallOption) (*QueryEpochBTCRangeResponse, error)
+	// CheckpointBTCFinalizationETA returns the number of BTC blocks and the
+	// estimated time until the checkpoint of a given epoch is finalized
+	CheckpointBTCFinalizationETA(ctx context.Context, in *QueryCheckpointBTCFinalizationETARequest, opts ...grpc.CallOption) (*QueryCheckpointBTCFinalizationETAResponse, error)
+	// LatestSubmittedCheckpoint returns the checkpoint of the highest epoch
+	// that is submitted to BTC but not confirmed yet, together with the keys
+	// of its BTC submissions
+	LatestSubmittedCheckpoint(ctx context.Context, in *QueryLatestSubmittedCheckpointRequest, opts ...grpc.CallOption) (*QueryLatestSubmittedCheckpointResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btccheckpoint.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *BTCCheckpointInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BtcCheckpointInfo(ctx context.Context, in *QueryBtcCheckpointInfoRequest, opts ...grpc.CallOption) (*QueryBtcCheckpointInfoResponse, error) {
+	out := new(QueryBtcCheckpointInfoResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btccheckpoint.v1.Query/BtcCheckpointInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *BTCCheckpointInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BestSubmissionVigilanteAddressList) > 0 {
-		for iNdEx := len(m.BestSubmissionVigilanteAddressList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BestSubmissionVigilanteAddressList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.BestSubmissionTransactions) > 0 {
-		for iNdEx := len(m.BestSubmissionTransactions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BestSubmissionTransactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.BestSubmissionBtcBlockHash) > 0 {
-		i -= len(m.BestSubmissionBtcBlockHash)
-		copy(dAtA[i:], m.BestSubmissionBtcBlockHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BestSubmissionBtcBlockHash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.BestSubmissionBtcBlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BestSubmissionBtcBlockHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.EpochNumber != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) BtcCheckpointsInfo(ctx context.Context, in *QueryBtcCheckpointsInfoRequest, opts ...grpc.CallOption) (*QueryBtcCheckpointsInfoResponse, error) {
+	out := new(QueryBtcCheckpointsInfoResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btccheckpoint.v1.Query/BtcCheckpointsInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *TransactionInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EpochSubmissions(ctx context.Context, in *QueryEpochSubmissionsRequest, opts ...grpc.CallOption) (*QueryEpochSubmissionsResponse, error) {
+	out := new(QueryEpochSubmissionsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btccheckpoint.v1.Query/EpochSubmissions", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *TransactionInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RecentCheckpoints(ctx context.Context, in *QueryRecentCheckpointsRequest, opts ...grpc.CallOption) (*QueryRecentCheckpointsResponse, error) {
+	out := new(QueryRecentCheckpointsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btccheckpoint.v1.Query/RecentCheckpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *TransactionInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Proof) > 0 {
-		i -= len(m.Proof)
-		copy(dAtA[i:], m.Proof)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Transaction) > 0 {
-		i -= len(m.Transaction)
-		copy(dAtA[i:], m.Transaction)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Transaction)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) EpochBTCRange(ctx context.Context, in *QueryEpochBTCRangeRequest, opts ...grpc.CallOption) (*QueryEpochBTCRangeResponse, error) {
+	out := new(QueryEpochBTCRangeResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btccheckpoint.v1.Query/EpochBTCRange", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Index != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *queryClient) CheckpointBTCFinalizationETA(ctx context.Context, in *QueryCheckpointBTCFinalizationETARequest, opts ...grpc.CallOption) (*QueryCheckpointBTCFinalizationETAResponse, error) {
+	out := new(QueryCheckpointBTCFinalizationETAResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btccheckpoint.v1.Query/CheckpointBTCFinalizationETA", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *CheckpointAddressesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LatestSubmittedCheckpoint(ctx context.Context, in *QueryLatestSubmittedCheckpointRequest, opts ...grpc.CallOption) (*QueryLatestSubmittedCheckpointResponse, error) {
+	out := new(QueryLatestSubmittedCheckpointResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btccheckpoint.v1.Query/LatestSubmittedCheckpoint", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *CheckpointAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// BtcCheckpointInfo returns checkpoint info for a given epoch
+	BtcCheckpointInfo(context.Context, *QueryBtcCheckpointInfoRequest) (*QueryBtcCheckpointInfoResponse, error)
+	// BtcCheckpointsInfo returns checkpoint info for a range of epochs
+	BtcCheckpointsInfo(context.Context, *QueryBtcCheckpointsInfoRequest) (*QueryBtcCheckpointsInfoResponse, error)
+	// EpochSubmissions returns all submissions for a given epoch
+	EpochSubmissions(context.Context, *QueryEpochSubmissionsRequest) (*QueryEpochSubmissionsResponse, error)
+	// RecentCheckpoints returns the checkpoints of the most recent epochs,
+	// newest first, together with their status and BTC submission depth
+	RecentCheckpoints(context.Context, *QueryRecentCheckpointsRequest) (*QueryRecentCheckpointsResponse, error)
+	// EpochBTCRange returns the BTC heights at which the checkpoint of a given
+	// epoch was submitted and finalized
+	EpochBTCRange(context.Context, *QueryEpochBTCRangeRequest) (*QueryEpochBTCRangeResponse, error)
+	// CheckpointBTCFinalizationETA returns the number of BTC blocks and the
+	// estimated time until the checkpoint of a given epoch is finalized
+	CheckpointBTCFinalizationETA(context.Context, *QueryCheckpointBTCFinalizationETARequest) (*QueryCheckpointBTCFinalizationETAResponse, error)
+	// LatestSubmittedCheckpoint returns the checkpoint of the highest epoch
+	// that is submitted to BTC but not confirmed yet, together with the keys
+	// of its BTC submissions
+	LatestSubmittedCheckpoint(context.Context, *QueryLatestSubmittedCheckpointRequest) (*QueryLatestSubmittedCheckpointResponse, error)
 }
 
-func (m *CheckpointAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reporter) > 0 {
-		i -= len(m.Reporter)
-		copy(dAtA[i:], m.Reporter)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reporter)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Submitter) > 0 {
-		i -= len(m.Submitter)
-		copy(dAtA[i:], m.Submitter)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Submitter)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *SubmissionKeyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) BtcCheckpointInfo(ctx context.Context, req *QueryBtcCheckpointInfoRequest) (*QueryBtcCheckpointInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BtcCheckpointInfo not implemented")
+}
+func (*UnimplementedQueryServer) BtcCheckpointsInfo(ctx context.Context, req *QueryBtcCheckpointsInfoRequest) (*QueryBtcCheckpointsInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BtcCheckpointsInfo not implemented")
+}
+func (*UnimplementedQueryServer) EpochSubmissions(ctx context.Context, req *QueryEpochSubmissionsRequest) (*QueryEpochSubmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochSubmissions not implemented")
+}
+func (*UnimplementedQueryServer) RecentCheckpoints(ctx context.Context, req *QueryRecentCheckpointsRequest) (*QueryRecentCheckpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecentCheckpoints not implemented")
+}
+func (*UnimplementedQueryServer) EpochBTCRange(ctx context.Context, req *QueryEpochBTCRangeRequest) (*QueryEpochBTCRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochBTCRange not implemented")
+}
+func (*UnimplementedQueryServer) CheckpointBTCFinalizationETA(ctx context.Context, req *QueryCheckpointBTCFinalizationETARequest) (*QueryCheckpointBTCFinalizationETAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckpointBTCFinalizationETA not implemented")
+}
+func (*UnimplementedQueryServer) LatestSubmittedCheckpoint(ctx context.Context, req *QueryLatestSubmittedCheckpointRequest) (*QueryLatestSubmittedCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestSubmittedCheckpoint not implemented")
 }
 
-func (m *SubmissionKeyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *SubmissionKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SecondTxIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SecondTxIndex))
-		i--
-		dAtA[i] = 0x20
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.SecondTxBlockHash) > 0 {
-		i -= len(m.SecondTxBlockHash)
-		copy(dAtA[i:], m.SecondTxBlockHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SecondTxBlockHash)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if m.FirstTxIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.FirstTxIndex))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btccheckpoint.v1.Query/Params",
 	}
-	if len(m.FirstTxBlockHash) > 0 {
-		i -= len(m.FirstTxBlockHash)
-		copy(dAtA[i:], m.FirstTxBlockHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FirstTxBlockHash)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Query_BtcCheckpointInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBtcCheckpointInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).BtcCheckpointInfo(ctx, in)
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btccheckpoint.v1.Query/BtcCheckpointInfo",
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BtcCheckpointInfo(ctx, req.(*QueryBtcCheckpointInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBtcCheckpointInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_BtcCheckpointsInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBtcCheckpointsInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.EpochNum != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNum))
+	if interceptor == nil {
+		return srv.(QueryServer).BtcCheckpointsInfo(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryBtcCheckpointInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btccheckpoint.v1.Query/BtcCheckpointsInfo",
 	}
-	var l int
-	_ = l
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BtcCheckpointsInfo(ctx, req.(*QueryBtcCheckpointsInfoRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBtcCheckpointsInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EpochSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochSubmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).EpochSubmissions(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btccheckpoint.v1.Query/EpochSubmissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochSubmissions(ctx, req.(*QueryEpochSubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBtcCheckpointsInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_RecentCheckpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecentCheckpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.InfoList) > 0 {
-		for _, e := range m.InfoList {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).RecentCheckpoints(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btccheckpoint.v1.Query/RecentCheckpoints",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecentCheckpoints(ctx, req.(*QueryRecentCheckpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryEpochSubmissionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EpochBTCRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochBTCRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochBTCRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btccheckpoint.v1.Query/EpochBTCRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochBTCRange(ctx, req.(*QueryEpochBTCRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckpointBTCFinalizationETA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckpointBTCFinalizationETARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckpointBTCFinalizationETA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btccheckpoint.v1.Query/CheckpointBTCFinalizationETA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckpointBTCFinalizationETA(ctx, req.(*QueryCheckpointBTCFinalizationETARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestSubmittedCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestSubmittedCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestSubmittedCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btccheckpoint.v1.Query/LatestSubmittedCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestSubmittedCheckpoint(ctx, req.(*QueryLatestSubmittedCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylon.btccheckpoint.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "BtcCheckpointInfo",
+			Handler:    _Query_BtcCheckpointInfo_Handler,
+		},
+		{
+			MethodName: "BtcCheckpointsInfo",
+			Handler:    _Query_BtcCheckpointsInfo_Handler,
+		},
+		{
+			MethodName: "EpochSubmissions",
+			Handler:    _Query_EpochSubmissions_Handler,
+		},
+		{
+			MethodName: "RecentCheckpoints",
+			Handler:    _Query_RecentCheckpoints_Handler,
+		},
+		{
+			MethodName: "EpochBTCRange",
+			Handler:    _Query_EpochBTCRange_Handler,
+		},
+		{
+			MethodName: "CheckpointBTCFinalizationETA",
+			Handler:    _Query_CheckpointBTCFinalizationETA_Handler,
+		},
+		{
+			MethodName: "LatestSubmittedCheckpoint",
+			Handler:    _Query_LatestSubmittedCheckpoint_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "babylon/btccheckpoint/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.EpochNum != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNum))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEpochSubmissionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Keys) > 0 {
-		for _, e := range m.Keys {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *BTCCheckpointInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBtcCheckpointInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBtcCheckpointInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBtcCheckpointInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.EpochNumber != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNumber))
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.BestSubmissionBtcBlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BestSubmissionBtcBlockHeight))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBtcCheckpointInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.BestSubmissionBtcBlockHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *QueryBtcCheckpointInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBtcCheckpointInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Info != nil {
+		{
+			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.BestSubmissionTransactions) > 0 {
-		for _, e := range m.BestSubmissionTransactions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBtcCheckpointsInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBtcCheckpointsInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBtcCheckpointsInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.BestSubmissionVigilanteAddressList) > 0 {
-		for _, e := range m.BestSubmissionVigilanteAddressList {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBtcCheckpointsInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBtcCheckpointsInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBtcCheckpointsInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InfoList) > 0 {
+		for iNdEx := len(m.InfoList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InfoList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochSubmissionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochSubmissionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochSubmissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochSubmissionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochSubmissionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochSubmissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecentCheckpointsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecentCheckpointsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecentCheckpointsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecentCheckpointsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecentCheckpointsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecentCheckpointsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checkpoints) > 0 {
+		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checkpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecentCheckpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecentCheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecentCheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BestSubmissionDepth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BestSubmissionDepth))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BestSubmissionBtcBlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BestSubmissionBtcBlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Submitted {
+		i--
+		if m.Submitted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PowerSum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PowerSum))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochBTCRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochBTCRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochBTCRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochBTCRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochBTCRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochBTCRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinalizedBtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FinalizedBtcHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SubmittedBtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubmittedBtcHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointBTCFinalizationETARequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointBTCFinalizationETARequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointBTCFinalizationETARequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AvgBtcBlockIntervalSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AvgBtcBlockIntervalSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointBTCFinalizationETAResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointBTCFinalizationETAResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointBTCFinalizationETAResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EstimatedSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EstimatedSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RemainingBtcBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingBtcBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FinalizationDepth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FinalizationDepth))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BestSubmissionDepth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BestSubmissionDepth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestSubmittedCheckpointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestSubmittedCheckpointRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestSubmittedCheckpointRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestSubmittedCheckpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestSubmittedCheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestSubmittedCheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BestSubmission != nil {
+		{
+			size, err := m.BestSubmission.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCCheckpointInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCCheckpointInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCCheckpointInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BestSubmissionVigilanteAddressList) > 0 {
+		for iNdEx := len(m.BestSubmissionVigilanteAddressList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BestSubmissionVigilanteAddressList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BestSubmissionTransactions) > 0 {
+		for iNdEx := len(m.BestSubmissionTransactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BestSubmissionTransactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BestSubmissionBtcBlockHash) > 0 {
+		i -= len(m.BestSubmissionBtcBlockHash)
+		copy(dAtA[i:], m.BestSubmissionBtcBlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BestSubmissionBtcBlockHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BestSubmissionBtcBlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BestSubmissionBtcBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransactionInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Transaction) > 0 {
+		i -= len(m.Transaction)
+		copy(dAtA[i:], m.Transaction)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Transaction)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmissionKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmissionKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmissionKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SecondTxIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SecondTxIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SecondTxBlockHash) > 0 {
+		i -= len(m.SecondTxBlockHash)
+		copy(dAtA[i:], m.SecondTxBlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SecondTxBlockHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FirstTxIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FirstTxIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FirstTxBlockHash) > 0 {
+		i -= len(m.FirstTxBlockHash)
+		copy(dAtA[i:], m.FirstTxBlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FirstTxBlockHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBtcCheckpointInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	return n
+}
+
+func (m *QueryBtcCheckpointInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBtcCheckpointsInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBtcCheckpointsInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InfoList) > 0 {
+		for _, e := range m.InfoList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochSubmissionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	return n
+}
+
+func (m *QueryEpochSubmissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRecentCheckpointsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryRecentCheckpointsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Checkpoints) > 0 {
+		for _, e := range m.Checkpoints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RecentCheckpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.PowerSum != 0 {
+		n += 1 + sovQuery(uint64(m.PowerSum))
+	}
+	if m.Submitted {
+		n += 2
+	}
+	if m.BestSubmissionBtcBlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BestSubmissionBtcBlockHeight))
+	}
+	if m.BestSubmissionDepth != 0 {
+		n += 1 + sovQuery(uint64(m.BestSubmissionDepth))
+	}
+	return n
+}
+
+func (m *QueryEpochBTCRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	return n
+}
+
+func (m *QueryEpochBTCRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.SubmittedBtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SubmittedBtcHeight))
+	}
+	if m.FinalizedBtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FinalizedBtcHeight))
+	}
+	return n
+}
+
+func (m *QueryCheckpointBTCFinalizationETARequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	if m.AvgBtcBlockIntervalSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.AvgBtcBlockIntervalSeconds))
+	}
+	return n
+}
+
+func (m *QueryCheckpointBTCFinalizationETAResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.BestSubmissionDepth != 0 {
+		n += 1 + sovQuery(uint64(m.BestSubmissionDepth))
+	}
+	if m.FinalizationDepth != 0 {
+		n += 1 + sovQuery(uint64(m.FinalizationDepth))
+	}
+	if m.RemainingBtcBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingBtcBlocks))
+	}
+	if m.EstimatedSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.EstimatedSeconds))
+	}
+	return n
+}
+
+func (m *QueryLatestSubmittedCheckpointRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLatestSubmittedCheckpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.BestSubmission != nil {
+		l = m.BestSubmission.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BTCCheckpointInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	if m.BestSubmissionBtcBlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BestSubmissionBtcBlockHeight))
+	}
+	l = len(m.BestSubmissionBtcBlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.BestSubmissionTransactions) > 0 {
+		for _, e := range m.BestSubmissionTransactions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.BestSubmissionVigilanteAddressList) > 0 {
+		for _, e := range m.BestSubmissionVigilanteAddressList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TransactionInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Transaction)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CheckpointAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SubmissionKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FirstTxBlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FirstTxIndex != 0 {
+		n += 1 + sovQuery(uint64(m.FirstTxIndex))
+	}
+	l = len(m.SecondTxBlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SecondTxIndex != 0 {
+		n += 1 + sovQuery(uint64(m.SecondTxIndex))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBtcCheckpointInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBtcCheckpointInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBtcCheckpointInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBtcCheckpointInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBtcCheckpointInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBtcCheckpointInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &BTCCheckpointInfoResponse{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBtcCheckpointsInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBtcCheckpointsInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBtcCheckpointsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBtcCheckpointsInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBtcCheckpointsInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBtcCheckpointsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfoList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InfoList = append(m.InfoList, &BTCCheckpointInfoResponse{})
+			if err := m.InfoList[len(m.InfoList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochSubmissionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochSubmissionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochSubmissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochSubmissionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochSubmissionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochSubmissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, &SubmissionKeyResponse{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *TransactionInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Index != 0 {
-		n += 1 + sovQuery(uint64(m.Index))
-	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Transaction)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Proof)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *CheckpointAddressesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Submitter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Reporter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *SubmissionKeyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FirstTxBlockHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.FirstTxIndex != 0 {
-		n += 1 + sovQuery(uint64(m.FirstTxIndex))
-	}
-	l = len(m.SecondTxBlockHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.SecondTxIndex != 0 {
-		n += 1 + sovQuery(uint64(m.SecondTxIndex))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRecentCheckpointsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1687,12 +3601,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecentCheckpointsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecentCheckpointsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1714,7 +3647,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRecentCheckpointsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1737,15 +3670,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecentCheckpointsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecentCheckpointsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1772,10 +3705,176 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Checkpoints = append(m.Checkpoints, &RecentCheckpointResponse{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecentCheckpointResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecentCheckpointResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecentCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types.CheckpointStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerSum", wireType)
+			}
+			m.PowerSum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PowerSum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Submitted = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestSubmissionBtcBlockHeight", wireType)
+			}
+			m.BestSubmissionBtcBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BestSubmissionBtcBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestSubmissionDepth", wireType)
+			}
+			m.BestSubmissionDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BestSubmissionDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1797,7 +3896,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBtcCheckpointInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochBTCRangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1820,10 +3919,10 @@ func (m *QueryBtcCheckpointInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBtcCheckpointInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochBTCRangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBtcCheckpointInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochBTCRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1866,7 +3965,7 @@ func (m *QueryBtcCheckpointInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBtcCheckpointInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochBTCRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1889,17 +3988,17 @@ func (m *QueryBtcCheckpointInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBtcCheckpointInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochBTCRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBtcCheckpointInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochBTCRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1909,28 +4008,49 @@ func (m *QueryBtcCheckpointInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Status |= BtcStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedBtcHeight", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.SubmittedBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmittedBtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Info == nil {
-				m.Info = &BTCCheckpointInfoResponse{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedBtcHeight", wireType)
 			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.FinalizedBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedBtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1952,7 +4072,7 @@ func (m *QueryBtcCheckpointInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBtcCheckpointsInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCheckpointBTCFinalizationETARequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1975,17 +4095,17 @@ func (m *QueryBtcCheckpointsInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBtcCheckpointsInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCheckpointBTCFinalizationETARequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBtcCheckpointsInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCheckpointBTCFinalizationETARequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
 			}
-			var msglen int
+			m.EpochNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1995,28 +4115,30 @@ func (m *QueryBtcCheckpointsInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EpochNum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvgBtcBlockIntervalSeconds", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.AvgBtcBlockIntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AvgBtcBlockIntervalSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2038,7 +4160,7 @@ func (m *QueryBtcCheckpointsInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBtcCheckpointsInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCheckpointBTCFinalizationETAResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2061,17 +4183,17 @@ func (m *QueryBtcCheckpointsInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBtcCheckpointsInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCheckpointBTCFinalizationETAResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBtcCheckpointsInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCheckpointBTCFinalizationETAResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InfoList", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2081,31 +4203,16 @@ func (m *QueryBtcCheckpointsInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Status |= BtcStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InfoList = append(m.InfoList, &BTCCheckpointInfoResponse{})
-			if err := m.InfoList[len(m.InfoList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestSubmissionDepth", wireType)
 			}
-			var msglen int
+			m.BestSubmissionDepth = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2115,28 +4222,68 @@ func (m *QueryBtcCheckpointsInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BestSubmissionDepth |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizationDepth", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.FinalizationDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizationDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBtcBlocks", wireType)
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.RemainingBtcBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingBtcBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedSeconds", wireType)
+			}
+			m.EstimatedSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2158,7 +4305,7 @@ func (m *QueryBtcCheckpointsInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochSubmissionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestSubmittedCheckpointRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2181,31 +4328,12 @@ func (m *QueryEpochSubmissionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochSubmissionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLatestSubmittedCheckpointRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochSubmissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLatestSubmittedCheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
-			}
-			m.EpochNum = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochNum |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2227,7 +4355,7 @@ func (m *QueryEpochSubmissionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochSubmissionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestSubmittedCheckpointResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2250,13 +4378,32 @@ func (m *QueryEpochSubmissionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochSubmissionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLatestSubmittedCheckpointResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochSubmissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLatestSubmittedCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
@@ -2290,6 +4437,42 @@ func (m *QueryEpochSubmissionsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BestSubmission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BestSubmission == nil {
+				m.BestSubmission = &BTCCheckpointInfoResponse{}
+			}
+			if err := m.BestSubmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,48 @@
+package types_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	bbn "github.com/babylonchain/babylon/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+)
+
+func FuzzVerifyInclusionOfMultiInputTx(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		// a tx consolidating several UTXOs
+		tx := wire.NewMsgTx(2)
+		numInputs := int(datagen.RandomInt(r, 10)) + 2
+		for _, outPoint := range datagen.GenRandomOutPoints(r, numInputs) {
+			tx.AddTxIn(wire.NewTxIn(outPoint, nil, nil))
+		}
+		pkScript, err := datagen.GenRandomPubKeyHashScript(r, &chaincfg.SimNetParams)
+		require.NoError(t, err)
+		tx.AddTxOut(wire.NewTxOut(r.Int63n(1e8)+1, pkScript))
+
+		prevBlock, _ := datagen.GenRandomBtcdBlock(r, 0, nil)
+		btcHeaderWithProof := datagen.CreateBlockWithTransaction(r, &prevBlock.Header, tx)
+
+		// the proof commits to the whole tx with all of its inputs
+		txInfo := btcctypes.NewTransactionInfoFromSpvProof(btcHeaderWithProof.SpvProof)
+		txBytes, err := bbn.SerializeBTCTx(tx)
+		require.NoError(t, err)
+		require.Equal(t, txBytes, txInfo.Transaction)
+		require.NoError(t, txInfo.VerifyInclusion(&btcHeaderWithProof.HeaderBytes, chaincfg.SimNetParams.PowLimit))
+
+		// dropping any input invalidates the proof
+		tx.TxIn = tx.TxIn[1:]
+		txInfo.Transaction, err = bbn.SerializeBTCTx(tx)
+		require.NoError(t, err)
+		require.Error(t, txInfo.VerifyInclusion(&btcHeaderWithProof.HeaderBytes, chaincfg.SimNetParams.PowLimit))
+	})
+}
@@ -3,9 +3,11 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 
+	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/spf13/cobra"
@@ -23,6 +25,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdCovenantCommittee())
 	cmd.AddCommand(CmdFinalityProvider())
 	cmd.AddCommand(CmdFinalityProviders())
 	cmd.AddCommand(CmdBTCDelegations())
@@ -31,6 +34,35 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdActivatedHeight())
 	cmd.AddCommand(CmdFinalityProviderDelegations())
 	cmd.AddCommand(CmdDelegation())
+	cmd.AddCommand(CmdCovenantSignedDelegations())
+	cmd.AddCommand(CmdRewardEligibleDelegations())
+	cmd.AddCommand(CmdSimulateBTCUndelegation())
+	cmd.AddCommand(CmdStaleCovenantPendingDelegations())
+	cmd.AddCommand(CmdSlashingAmount())
+	cmd.AddCommand(CmdSimulateVotingPower())
+	cmd.AddCommand(CmdBTCDelegationsByInclusionHeight())
+	cmd.AddCommand(CmdCovenantQuorumHealth())
+	cmd.AddCommand(CmdDelegationsWithStaleParams())
+	cmd.AddCommand(CmdFinalityProviderPoP())
+	cmd.AddCommand(CmdDelegationPoP())
+	cmd.AddCommand(CmdFinalityProviderPowerAfterUndelegation())
+	cmd.AddCommand(CmdTotalVotingPowerAtHeight())
+	cmd.AddCommand(CmdBTCDelegationScripts())
+	cmd.AddCommand(CmdVerifyDelegatorSlashingSig())
+	cmd.AddCommand(CmdReverifyInclusionProof())
+	cmd.AddCommand(CmdCovenantSigningBatch())
+	cmd.AddCommand(CmdUnbondingCovenantProgress())
+	cmd.AddCommand(CmdRecommendedSlashingFeeRate())
+	cmd.AddCommand(CmdDelegationSpendTree())
+	cmd.AddCommand(CmdVotingPowerTableDiscrepancies())
+	cmd.AddCommand(CmdFinalityProviderSlashingImpact())
+	cmd.AddCommand(CmdVerifyCovenantQuorumSpend())
+	cmd.AddCommand(CmdDelegationFirstRewardHeight())
+	cmd.AddCommand(CmdCovenantSignMsg())
+	cmd.AddCommand(CmdStakingInternalKey())
+	cmd.AddCommand(CmdDelegationsSpendableVia())
+	cmd.AddCommand(CmdCovenantSigNeeded())
+	cmd.AddCommand(CmdDelegationParams())
 
 	return cmd
 }
@@ -126,7 +158,7 @@ func CmdFinalityProviders() *cobra.Command {
 func CmdBTCDelegations() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "btc-delegations [status]",
-		Short: "retrieve all BTC delegations under the given status (pending, active, unbonding, unbonded, any)",
+		Short: "retrieve all BTC delegations under the given status (pending, active, unbonding, unbonded, invalidated, slashed, any)",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
@@ -285,3 +317,868 @@ func CmdFinalityProviderDelegations() *cobra.Command {
 
 	return cmd
 }
+
+func CmdCovenantSignedDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "covenant-signed-delegations [cov_pk_hex]",
+		Short: "retrieve all delegations signed by a given covenant member",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.CovenantSignedDelegations(cmd.Context(), &types.QueryCovenantSignedDelegationsRequest{
+				CovPkHex:   args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "covenant-signed-delegations")
+
+	return cmd
+}
+
+func CmdRewardEligibleDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-eligible-delegations [fp_btc_pk_hex]",
+		Short: "retrieve the active delegations of a given finality provider that are earning rewards and those that are not yet",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RewardEligibleDelegations(
+				cmd.Context(),
+				&types.QueryRewardEligibleDelegationsRequest{
+					FpBtcPkHex: args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "reward-eligible-delegations")
+
+	return cmd
+}
+
+func CmdSimulateBTCUndelegation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-btc-undelegation [staking_tx_hash_hex] [unbonding_tx] [unbonding_slashing_tx] [unbonding_time] [unbonding_value] [delegator_unbonding_slashing_sig]",
+		Short: "validate an unbonding tx and its slashing tx against the staking output of a BTC delegation",
+		Args:  cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// get unbonding tx
+			_, unbondingTxBytes, err := bbn.NewBTCTxFromHex(args[1])
+			if err != nil {
+				return err
+			}
+
+			// get unbonding slashing tx
+			unbondingSlashingTx, err := types.NewBTCSlashingTxFromHex(args[2])
+			if err != nil {
+				return err
+			}
+
+			unbondingTime, err := parseLockTime(args[3])
+			if err != nil {
+				return err
+			}
+
+			unbondingValue, err := parseBtcAmount(args[4])
+			if err != nil {
+				return err
+			}
+
+			// get delegator sig on unbonding slashing tx
+			delegatorUnbondingSlashingSig, err := bbn.NewBIP340SignatureFromHex(args[5])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SimulateBTCUndelegation(
+				cmd.Context(),
+				&types.QuerySimulateBTCUndelegationRequest{
+					StakingTxHashHex:              args[0],
+					UnbondingTx:                   unbondingTxBytes,
+					UnbondingSlashingTx:           unbondingSlashingTx,
+					UnbondingTime:                 uint32(unbondingTime),
+					UnbondingValue:                int64(unbondingValue),
+					DelegatorUnbondingSlashingSig: delegatorUnbondingSlashingSig,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdStaleCovenantPendingDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stale-covenant-pending-delegations [max_blocks_pending]",
+		Short: "retrieve BTC delegations that have been pending for more than the given number of Babylon blocks",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			maxBlocksPending, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.StaleCovenantPendingDelegations(cmd.Context(), &types.QueryStaleCovenantPendingDelegationsRequest{
+				MaxBlocksPending: maxBlocksPending,
+				Pagination:       pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "stale-covenant-pending-delegations")
+
+	return cmd
+}
+
+func CmdSlashingAmount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slashing-amount [staking_tx_hash_hex]",
+		Short: "retrieve the amount of a BTC delegation that would be slashed and where it would be sent",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SlashingAmount(
+				cmd.Context(),
+				&types.QuerySlashingAmountRequest{
+					StakingTxHashHex: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSimulateVotingPower() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-voting-power [total_sat] [staking_time]",
+		Short: "retrieve the voting power a BTC delegation with the given amount and staking time would add if it were created now",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			totalSat, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			stakingTime, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SimulateVotingPower(
+				cmd.Context(),
+				&types.QuerySimulateVotingPowerRequest{
+					TotalSat:    totalSat,
+					StakingTime: uint32(stakingTime),
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBTCDelegationsByInclusionHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-delegations-by-inclusion-height [from_height] [to_height]",
+		Short: "retrieve all BTC delegations whose staking tx is included in a BTC block within the given height range",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			fromHeight, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			toHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BTCDelegationsByInclusionHeight(
+				cmd.Context(),
+				&types.QueryBTCDelegationsByInclusionHeightRequest{
+					FromHeight: fromHeight,
+					ToHeight:   toHeight,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCovenantQuorumHealth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "covenant-quorum-health",
+		Short: "retrieve the number of pending BTC delegations grouped by how many covenant signatures they still miss",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CovenantQuorumHealth(cmd.Context(), &types.QueryCovenantQuorumHealthRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDelegationsWithStaleParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegations-with-stale-params",
+		Short: "retrieve all delegations whose snapshotted parameters differ from the current parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegationsWithStaleParams(cmd.Context(), &types.QueryDelegationsWithStaleParamsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "delegations-with-stale-params")
+
+	return cmd
+}
+
+func CmdFinalityProviderPoP() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-provider-pop [fp_btc_pk_hex]",
+		Short: "retrieve the proof of possession of a given finality provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FinalityProviderPoP(
+				cmd.Context(),
+				&types.QueryFinalityProviderPoPRequest{
+					FpBtcPkHex: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDelegationPoP() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegation-pop [staking_tx_hash_hex]",
+		Short: "retrieve the proof of possession of a given BTC delegation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BTCDelegationPoP(
+				cmd.Context(),
+				&types.QueryBTCDelegationPoPRequest{
+					StakingTxHashHex: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdFinalityProviderPowerAfterUndelegation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-provider-power-after-undelegation [fp_btc_pk_hex] [staking_tx_hash_hex]",
+		Short: "get the current voting power of a given finality provider and its voting power if a given delegation unbonded",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FinalityProviderPowerAfterUndelegation(cmd.Context(), &types.QueryFinalityProviderPowerAfterUndelegationRequest{
+				FpBtcPkHex:       args[0],
+				StakingTxHashHex: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdTotalVotingPowerAtHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-voting-power-at-height [height]",
+		Short: "get the total voting power of all active finality providers at a given height",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.TotalVotingPowerAtHeight(cmd.Context(), &types.QueryTotalVotingPowerAtHeightRequest{
+				Height: height,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBTCDelegationScripts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegation-scripts [fp_btc_pk_hex] [staking_tx_hash_hex]",
+		Short: "get the taproot scripts of a BTC delegation that a given finality provider participates in",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BTCDelegationScripts(cmd.Context(), &types.QueryBTCDelegationScriptsRequest{
+				FpBtcPkHex:       args[0],
+				StakingTxHashHex: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdVerifyDelegatorSlashingSig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-delegator-slashing-sig [staking_tx_hash_hex]",
+		Short: "verify the delegator's signature on the slashing tx of a BTC delegation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VerifyDelegatorSlashingSig(cmd.Context(), &types.QueryVerifyDelegatorSlashingSigRequest{
+				StakingTxHashHex: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdReverifyInclusionProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reverify-inclusion-proof [staking_tx_hash_hex]",
+		Short: "re-verify the inclusion proof of the staking tx of a BTC delegation against the current BTC light client state",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ReverifyInclusionProof(cmd.Context(), &types.QueryReverifyInclusionProofRequest{
+				StakingTxHashHex: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCovenantSigningBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "covenant-signing-batch [staking_tx_hash_hex] [staking_tx_hash_hex] ...",
+		Short: "retrieve the txs to sign and the script paths to sign against for a batch of BTC delegations",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CovenantSigningBatch(cmd.Context(), &types.QueryCovenantSigningBatchRequest{
+				StakingTxHashHexList: args,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUnbondingCovenantProgress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbonding-covenant-progress [staking_tx_hash_hex]",
+		Short: "retrieve the number of covenant signatures collected on the unbonding txs of a BTC delegation against the quorum",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.UnbondingCovenantProgress(cmd.Context(), &types.QueryUnbondingCovenantProgressRequest{
+				StakingTxHashHex: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDelegationSpendTree() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegation-spend-tree [staking_tx_hash_hex]",
+		Short: "retrieve the taproot script tree of the staking output of a BTC delegation, for constructing any of its spends",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DelegationSpendTree(cmd.Context(), &types.QueryDelegationSpendTreeRequest{
+				StakingTxHashHex: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdVotingPowerTableDiscrepancies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "voting-power-table-discrepancies",
+		Short: "retrieve the finality providers whose voting power in the latest voting power table differs from the one recomputed over all BTC delegations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VotingPowerTableDiscrepancies(cmd.Context(), &types.QueryVotingPowerTableDiscrepanciesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdFinalityProviderSlashingImpact() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-provider-slashing-impact [fp_btc_pk_hex]",
+		Short: "retrieve the BTC delegations that would be slashed if the given finality provider is slashed, together with their total stake and slashing amount",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FinalityProviderSlashingImpact(cmd.Context(), &types.QueryFinalityProviderSlashingImpactRequest{
+				FpBtcPkHex: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdVerifyCovenantQuorumSpend() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-covenant-quorum-spend [staking_tx_hash_hex] [unbonding|slashing]",
+		Short: "check whether a valid spend of the staking output of a BTC delegation via the given covenant path can be constructed from the stored signatures",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			path, ok := types.CovenantSpendPath_value[strings.ToUpper(args[1])]
+			if !ok {
+				return fmt.Errorf("invalid covenant spend path %s, must be unbonding or slashing", args[1])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VerifyCovenantQuorumSpend(cmd.Context(), &types.QueryVerifyCovenantQuorumSpendRequest{
+				StakingTxHashHex: args[0],
+				Path:             types.CovenantSpendPath(path),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDelegationFirstRewardHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegation-first-reward-height [staking_tx_hash_hex]",
+		Short: "retrieve the Babylon height at which a BTC delegation first earns a non-zero reward",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DelegationFirstRewardHeight(
+				cmd.Context(),
+				&types.QueryDelegationFirstRewardHeightRequest{
+					StakingTxHashHex: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCovenantSignMsg() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "covenant-sign-msg [staking_tx_hash_hex] [unbonding|slashing|unbonding_slashing] [covenant_pk_hex]",
+		Short: "retrieve the sighash that a covenant member has to sign for a BTC delegation via the given covenant path",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			path, ok := types.CovenantSpendPath_value[strings.ToUpper(args[1])]
+			if !ok {
+				return fmt.Errorf("invalid covenant spend path %s, must be unbonding, slashing or unbonding_slashing", args[1])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CovenantSignMsg(cmd.Context(), &types.QueryCovenantSignMsgRequest{
+				StakingTxHashHex: args[0],
+				Path:             types.CovenantSpendPath(path),
+				CovenantPkHex:    args[2],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdStakingInternalKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "staking-internal-key",
+		Short: "shows the taproot internal key of the staking, unbonding and slashing change outputs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StakingInternalKey(cmd.Context(), &types.QueryStakingInternalKeyRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDelegationsSpendableVia() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegations-spendable-via [timelock|unbonding|slashing] [btc_tip_height]",
+		Short: "retrieve BTC delegations whose staking output can be spent via the given path at the given BTC tip height, or at the current BTC tip if not given",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			path, ok := types.StakingOutputSpendPath_value[strings.ToUpper(args[0])+"_PATH"]
+			if !ok {
+				return fmt.Errorf("invalid staking output spend path %s, must be timelock, unbonding or slashing", args[0])
+			}
+
+			var btcTipHeight uint64
+			if len(args) == 2 {
+				var err error
+				btcTipHeight, err = strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DelegationsSpendableVia(cmd.Context(), &types.QueryDelegationsSpendableViaRequest{
+				Path:         types.StakingOutputSpendPath(path),
+				BtcTipHeight: btcTipHeight,
+				Pagination:   pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "delegations-spendable-via")
+
+	return cmd
+}
+
+func CmdCovenantSigNeeded() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "covenant-sig-needed [staking_tx_hash_hex] [covenant_pk_hex]",
+		Short: "retrieve whether a covenant member still needs to sign a BTC delegation, and the covenant paths missing its signatures",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CovenantSigNeeded(cmd.Context(), &types.QueryCovenantSigNeededRequest{
+				StakingTxHashHex: args[0],
+				CovenantPkHex:    args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDelegationParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegation-params [staking_tx_hash_hex]",
+		Short: "retrieve the parameters that a BTC delegation was created under",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DelegationParams(cmd.Context(), &types.QueryDelegationParamsRequest{
+				StakingTxHashHex: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -34,3 +34,57 @@ func CmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+func CmdCovenantCommittee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "covenant-committee",
+		Short: "shows the covenant committee and quorum in the current parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CovenantCommittee(cmd.Context(), &types.QueryCovenantCommitteeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRecommendedSlashingFeeRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recommended-slashing-fee-rate",
+		Short: "shows the fee rate in sat/vB recommended for broadcasting slashing and unbonding txs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RecommendedSlashingFeeRate(cmd.Context(), &types.QueryRecommendedSlashingFeeRateRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -26,6 +26,8 @@ const (
 	FlagSecurityContact = "security-contact"
 	FlagDetails         = "details"
 	FlagCommissionRate  = "commission-rate"
+	FlagRewardOptOut    = "reward-opt-out"
+	FlagReserved        = "reserved"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -43,6 +45,8 @@ func GetTxCmd() *cobra.Command {
 		NewEditFinalityProviderCmd(),
 		NewCreateBTCDelegationCmd(),
 		NewAddCovenantSigsCmd(),
+		NewActivateReservedDelegationCmd(),
+		NewAddSlashingTxInclusionProofCmd(),
 		NewBTCUndelegateCmd(),
 		NewSelectiveSlashingEvidenceCmd(),
 	)
@@ -237,10 +241,21 @@ func NewCreateBTCDelegationCmd() *cobra.Command {
 				return err
 			}
 
-			// get staking tx info
-			stakingTxInfo, err := btcctypes.NewTransactionInfoFromHex(args[3])
-			if err != nil {
-				return err
+			// get staking tx info, or only the staking tx if the staking tx
+			// is not included in BTC yet
+			reserved, _ := cmd.Flags().GetBool(FlagReserved)
+			var stakingTxInfo *btcctypes.TransactionInfo
+			if reserved {
+				_, stakingTxBytes, err := bbn.NewBTCTxFromHex(args[3])
+				if err != nil {
+					return err
+				}
+				stakingTxInfo = &btcctypes.TransactionInfo{Transaction: stakingTxBytes}
+			} else {
+				stakingTxInfo, err = btcctypes.NewTransactionInfoFromHex(args[3])
+				if err != nil {
+					return err
+				}
 			}
 
 			// TODO: Support multiple finality providers
@@ -302,6 +317,8 @@ func NewCreateBTCDelegationCmd() *cobra.Command {
 				return err
 			}
 
+			rewardOptOut, _ := cmd.Flags().GetBool(FlagRewardOptOut)
+
 			msg := types.MsgCreateBTCDelegation{
 				Signer:                        clientCtx.FromAddress.String(),
 				BabylonPk:                     &babylonPK,
@@ -318,12 +335,16 @@ func NewCreateBTCDelegationCmd() *cobra.Command {
 				UnbondingValue:                int64(unbondingValue),
 				UnbondingSlashingTx:           unbondingSlashingTx,
 				DelegatorUnbondingSlashingSig: delegatorUnbondingSlashingSig,
+				RewardOptOut:                  rewardOptOut,
+				Reserved:                      reserved,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
+	cmd.Flags().Bool(FlagRewardOptOut, false, "Exclude the BTC delegation from BTC staking rewards")
+	cmd.Flags().Bool(FlagReserved, false, "Reserve the BTC delegation before its staking tx is included in BTC, in which case [staking_tx_info] is the staking tx in hex")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -395,6 +416,82 @@ func NewAddCovenantSigsCmd() *cobra.Command {
 	return cmd
 }
 
+func NewActivateReservedDelegationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "activate-reserved-delegation [staking_tx_hash] [staking_tx_info]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Activate a reserved BTC delegation with the inclusion proof of its staking tx",
+		Long: strings.TrimSpace(
+			`Activate a reserved BTC delegation identified by a given staking tx hash, once its staking tx is included in BTC. The staking tx info has to carry the reserved staking tx together with its inclusion proof.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// get staking tx hash
+			stakingTxHash := args[0]
+
+			// get staking tx info
+			stakingTxInfo, err := btcctypes.NewTransactionInfoFromHex(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgActivateReservedDelegation{
+				Signer:        clientCtx.FromAddress.String(),
+				StakingTxHash: stakingTxHash,
+				StakingTx:     stakingTxInfo,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewAddSlashingTxInclusionProofCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-slashing-tx-inclusion-proof [staking_tx_hash] [slashing_tx_info]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Mark a BTC delegation as slashed with the inclusion proof of its slashing tx",
+		Long: strings.TrimSpace(
+			`Mark a BTC delegation identified by a given staking tx hash as slashed, once its slashing tx is included in BTC. The slashing tx info has to carry the slashing tx of the staking tx or of the unbonding tx, together with its inclusion proof.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// get staking tx hash
+			stakingTxHash := args[0]
+
+			// get slashing tx info
+			slashingTxInfo, err := btcctypes.NewTransactionInfoFromHex(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgAddSlashingTxInclusionProof{
+				Signer:        clientCtx.FromAddress.String(),
+				StakingTxHash: stakingTxHash,
+				SlashingTx:    slashingTxInfo,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewBTCUndelegateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "btc-undelegate [staking_tx_hash] [unbonding_tx_sig]",
@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// PruneInactiveDelegations removes from state the BTC delegations that
+//   - are unbonded, either early via unbonding tx or upon timelock expiry,
+//   - have their staking timelock expired for at least olderThanBlocks BTC blocks, and
+//   - whose stakers have withdrawn all of their BTC staking rewards, regardless
+//     of the rewards of their other BTC delegations,
+//
+// as well as the reserved BTC delegations whose reservation has expired for
+// at least olderThanBlocks BTC blocks, which never had voting power.
+// Pending, active, slashed and invalidated BTC delegations are never pruned.
+// For each pruned BTC delegation, a tombstone with its staking tx hash and
+// final status is kept. It returns the staking tx hashes of the pruned BTC
+// delegations
+func (k Keeper) PruneInactiveDelegations(ctx context.Context, olderThanBlocks uint64) []string {
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	if btcTipHeight < olderThanBlocks {
+		return nil
+	}
+	maxEndHeight := btcTipHeight - olderThanBlocks
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	// collect the prunable BTC delegations first, as the inclusion height
+	// index cannot be modified while being iterated
+	prunableDels := []*types.BTCDelegation{}
+	// a BTC delegation's staking tx is included no later than its timelock
+	// expires, so only delegations included up to maxEndHeight are candidates
+	k.IterateBTCDelegationsByInclusionHeight(ctx, 0, maxEndHeight, func(btcDel *types.BTCDelegation) bool {
+		if btcDel.EndHeight > maxEndHeight {
+			return true
+		}
+		params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+		if params == nil {
+			panic("params version in BTC delegation is not found")
+		}
+		if btcDel.GetStatus(btcTipHeight, wValue, params.CovenantQuorum) != types.BTCDelegationStatus_UNBONDED {
+			return true
+		}
+		stakerAddr := sdk.AccAddress(btcDel.BabylonPk.Address())
+		if !k.iKeeper.IsBTCDelegationRewardFullyWithdrawn(ctx, stakerAddr, btcDel.MustGetStakingTxHash().String()) {
+			return true
+		}
+		prunableDels = append(prunableDels, btcDel)
+		return true
+	})
+	k.iterateReservedBTCDelegationsByExpiryHeight(ctx, maxEndHeight, func(btcDel *types.BTCDelegation) bool {
+		params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+		if params == nil {
+			panic("params version in BTC delegation is not found")
+		}
+		if btcDel.GetStatus(btcTipHeight, wValue, params.CovenantQuorum) != types.BTCDelegationStatus_UNBONDED {
+			return true
+		}
+		prunableDels = append(prunableDels, btcDel)
+		return true
+	})
+
+	prunedHashes := make([]string, 0, len(prunableDels))
+	for _, btcDel := range prunableDels {
+		k.pruneBTCDelegation(ctx, btcDel, types.BTCDelegationStatus_UNBONDED)
+		prunedHashes = append(prunedHashes, btcDel.MustGetStakingTxHash().String())
+	}
+	return prunedHashes
+}
+
+// pruneBTCDelegation removes the given BTC delegation, its indexes and its
+// reward records in the incentive module from state, and records a tombstone
+// for it
+func (k Keeper) pruneBTCDelegation(ctx context.Context, btcDel *types.BTCDelegation, finalStatus types.BTCDelegationStatus) {
+	stakingTxHash := btcDel.MustGetStakingTxHash()
+
+	k.btcDelegationStore(ctx).Delete(stakingTxHash[:])
+	if btcDel.Reserved {
+		k.reservedBTCDelegationStore(ctx).Delete(reservedBTCDelegationKey(btcDel))
+	} else {
+		inclusionHeightKey := append(sdk.Uint64ToBigEndian(btcDel.StartHeight), stakingTxHash[:]...)
+		k.btcDelegationInclusionHeightStore(ctx).Delete(inclusionHeightKey)
+	}
+
+	// remove the staking tx hash from the delegator's index under each
+	// restaked finality provider
+	for i := range btcDel.FpBtcPkList {
+		fpBTCPK := btcDel.FpBtcPkList[i]
+		btcDelIndex := k.getBTCDelegatorDelegationIndex(ctx, &fpBTCPK, btcDel.BtcPk)
+		if btcDelIndex == nil {
+			continue
+		}
+		remaining := make([][]byte, 0, len(btcDelIndex.StakingTxHashList))
+		for _, hashBytes := range btcDelIndex.StakingTxHashList {
+			if !bytes.Equal(hashBytes, stakingTxHash[:]) {
+				remaining = append(remaining, hashBytes)
+			}
+		}
+		if len(remaining) == 0 {
+			k.btcDelegatorFpStore(ctx, &fpBTCPK).Delete(*btcDel.BtcPk)
+			// the delegator has no BTC delegation left under this finality
+			// provider, so the rewards attributed to the pair are dropped
+			k.iKeeper.PruneDelegatorValidatorRewards(ctx, btcDel.BtcPk, &fpBTCPK)
+			continue
+		}
+		btcDelIndex.StakingTxHashList = remaining
+		k.setBTCDelegatorDelegationIndex(ctx, &fpBTCPK, btcDel.BtcPk, btcDelIndex)
+	}
+
+	k.iKeeper.PruneBTCDelegationRewardRecords(ctx, stakingTxHash.String())
+
+	k.setBTCDelegationTombstone(ctx, &types.BTCDelegationTombstone{
+		StakingTxHashHex: stakingTxHash.String(),
+		FinalStatus:      finalStatus,
+	})
+}
+
+// iterateReservedBTCDelegationsByExpiryHeight iterates over all reserved BTC
+// delegations whose reservation expires no later than the given BTC height,
+// in ascending order of the expiry height
+func (k Keeper) iterateReservedBTCDelegationsByExpiryHeight(
+	ctx context.Context,
+	toHeight uint64,
+	handler func(btcDel *types.BTCDelegation) bool,
+) {
+	store := k.reservedBTCDelegationStore(ctx)
+	iter := store.Iterator(nil, sdk.Uint64ToBigEndian(toHeight+1))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		stakingTxHash, err := chainhash.NewHash(iter.Value())
+		if err != nil {
+			panic(err) // only programming error
+		}
+		btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+		if btcDel == nil {
+			panic(types.ErrBTCDelegationNotFound) // only programming error
+		}
+		if !handler(btcDel) {
+			break
+		}
+	}
+}
+
+func (k Keeper) setBTCDelegationTombstone(ctx context.Context, tombstone *types.BTCDelegationTombstone) {
+	stakingTxHash, err := chainhash.NewHashFromStr(tombstone.StakingTxHashHex)
+	if err != nil {
+		panic(err) // only programming error
+	}
+	k.btcDelegationTombstoneStore(ctx).Set(stakingTxHash[:], k.cdc.MustMarshal(tombstone))
+}
+
+// GetBTCDelegationTombstone gets the tombstone of the pruned BTC delegation
+// with the given staking tx hash, or nil if it has not been pruned
+func (k Keeper) GetBTCDelegationTombstone(ctx context.Context, stakingTxHash chainhash.Hash) *types.BTCDelegationTombstone {
+	tombstoneBytes := k.btcDelegationTombstoneStore(ctx).Get(stakingTxHash[:])
+	if len(tombstoneBytes) == 0 {
+		return nil
+	}
+	var tombstone types.BTCDelegationTombstone
+	k.cdc.MustUnmarshal(tombstoneBytes, &tombstone)
+	return &tombstone
+}
+
+// btcDelegationTombstoneStore returns the KVStore of the tombstones of pruned
+// BTC delegations
+// prefix: BTCDelegationTombstoneKey
+// key: BTC delegation's staking tx hash
+// value: BTCDelegationTombstone
+func (k Keeper) btcDelegationTombstoneStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.BTCDelegationTombstoneKey)
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+func FuzzPruneInactiveDelegations(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client, BTC checkpoint and incentive modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		iKeeper := types.NewMockIncentiveKeeper(ctrl)
+		h := NewHelperWithIncentiveKeeper(t, btclcKeeper, btccKeeper, nil, iKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+
+		// BTC delegations with a long timelock, which stay active or pending
+		activeTxHash, _, _, msgActiveDel, activeDel := h.CreateDelegation(r, fpPK, changeAddress.EncodeAddress(), 1e8, 10000)
+		h.CreateCovenantSigs(r, covenantSKs, msgActiveDel, activeDel)
+		pendingTxHash, _, _, _, _ := h.CreateDelegation(r, fpPK, changeAddress.EncodeAddress(), 1e8, 10000)
+
+		// BTC delegations with a short timelock, which expire before pruning.
+		// Only the one whose staker has withdrawn all rewards is prunable
+		withdrawnTxHash, _, _, msgWithdrawnDel, withdrawnDel := h.CreateDelegation(r, fpPK, changeAddress.EncodeAddress(), 1e8, 1000)
+		h.CreateCovenantSigs(r, covenantSKs, msgWithdrawnDel, withdrawnDel)
+		unwithdrawnTxHash, _, _, msgUnwithdrawnDel, unwithdrawnDel := h.CreateDelegation(r, fpPK, changeAddress.EncodeAddress(), 1e8, 1000)
+		h.CreateCovenantSigs(r, covenantSKs, msgUnwithdrawnDel, unwithdrawnDel)
+
+		iKeeper.EXPECT().IsBTCDelegationRewardFullyWithdrawn(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ interface{}, _ sdk.AccAddress, stakingTxHash string) bool {
+				return stakingTxHash == withdrawnTxHash
+			},
+		).AnyTimes()
+		// the reward records of the pruned BTC delegation are removed, along
+		// with the rewards attributed to its delegator under the finality
+		// provider since it has no other BTC delegation under it
+		iKeeper.EXPECT().PruneBTCDelegationRewardRecords(gomock.Any(), withdrawnTxHash).Times(1)
+		iKeeper.EXPECT().PruneDelegatorValidatorRewards(gomock.Any(), withdrawnDel.BtcPk, gomock.Any()).Times(1)
+
+		// move the BTC tip beyond the short timelocks by olderThanBlocks
+		olderThanBlocks := datagen.RandomInt(r, 100) + 1
+		pruneCtx := datagen.WithCtxHeight(h.Ctx, 2)
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Eq(pruneCtx)).Return(&btclctypes.BTCHeaderInfo{Height: withdrawnDel.EndHeight + olderThanBlocks}).AnyTimes()
+
+		prunedHashes := h.BTCStakingKeeper.PruneInactiveDelegations(pruneCtx, olderThanBlocks)
+		require.Equal(t, []string{withdrawnTxHash}, prunedHashes)
+
+		// the pruned BTC delegation is removed and leaves a tombstone
+		_, err = h.BTCStakingKeeper.GetBTCDelegation(pruneCtx, withdrawnTxHash)
+		require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+		withdrawnHash, err := chainhash.NewHashFromStr(withdrawnTxHash)
+		require.NoError(t, err)
+		tombstone := h.BTCStakingKeeper.GetBTCDelegationTombstone(pruneCtx, *withdrawnHash)
+		require.NotNil(t, tombstone)
+		require.Equal(t, withdrawnTxHash, tombstone.StakingTxHashHex)
+		require.Equal(t, types.BTCDelegationStatus_UNBONDED, tombstone.FinalStatus)
+		gs, err := h.BTCStakingKeeper.ExportGenesis(pruneCtx)
+		require.NoError(t, err)
+		require.Equal(t, []*types.BTCDelegationTombstone{tombstone}, gs.BtcDelegationTombstones)
+		for _, btcDelegator := range gs.BtcDelegators {
+			require.False(t, btcDelegator.DelBtcPk.Equals(withdrawnDel.BtcPk))
+		}
+
+		// active, pending and not fully withdrawn BTC delegations are never pruned
+		for _, txHash := range []string{activeTxHash, pendingTxHash, unwithdrawnTxHash} {
+			_, err = h.BTCStakingKeeper.GetBTCDelegation(pruneCtx, txHash)
+			require.NoError(t, err)
+		}
+
+		// pruning again does not prune anything more
+		require.Empty(t, h.BTCStakingKeeper.PruneInactiveDelegations(pruneCtx, olderThanBlocks))
+
+		// only the governance account can prune BTC delegations
+		_, err = h.MsgServer.PruneInactiveDelegations(pruneCtx, &types.MsgPruneInactiveDelegations{
+			Authority:       datagen.GenRandomAccount().Address,
+			OlderThanBlocks: olderThanBlocks,
+		})
+		require.Error(t, err)
+	})
+}
@@ -3,12 +3,17 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"math"
 
 	"cosmossdk.io/store/prefix"
 	asig "github.com/babylonchain/babylon/crypto/schnorr-adaptor-signature"
 	bbn "github.com/babylonchain/babylon/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -48,9 +53,13 @@ func (k Keeper) AddBTCDelegation(ctx sdk.Context, btcDel *types.BTCDelegation) e
 	k.setBTCDelegation(ctx, btcDel)
 
 	// notify subscriber
+	newState := types.BTCDelegationStatus_PENDING
+	if btcDel.Reserved {
+		newState = types.BTCDelegationStatus_RESERVED
+	}
 	event := &types.EventBTCDelegationStateUpdate{
 		StakingTxHash: stakingTxHash.String(),
-		NewState:      types.BTCDelegationStatus_PENDING,
+		NewState:      newState,
 	}
 	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
 		panic(fmt.Errorf("failed to emit EventBTCDelegationStateUpdate for the new pending BTC delegation: %w", err))
@@ -59,15 +68,62 @@ func (k Keeper) AddBTCDelegation(ctx sdk.Context, btcDel *types.BTCDelegation) e
 	// NOTE: we don't need to record events for pending BTC delegations since these
 	// do not affect voting power distribution
 
-	// record event that the BTC delegation will become unbonded at endHeight-w
+	// the timelock of a reserved BTC delegation is unknown until its
+	// activation, which records the unbonded event instead
+	if !btcDel.Reserved {
+		k.addBTCDelegationExpiryEvent(ctx, btcDel, stakingTxHash.String())
+	}
+
+	return nil
+}
+
+// addBTCDelegationExpiryEvent records the event that the given BTC delegation
+// will become unbonded at endHeight-w
+func (k Keeper) addBTCDelegationExpiryEvent(ctx sdk.Context, btcDel *types.BTCDelegation, stakingTxHash string) {
 	unbondedEvent := types.NewEventPowerDistUpdateWithBTCDel(&types.EventBTCDelegationStateUpdate{
-		StakingTxHash: stakingTxHash.String(),
+		StakingTxHash: stakingTxHash,
 		NewState:      types.BTCDelegationStatus_UNBONDED,
 	})
-	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+	wValue := btcDel.FinalizationTimeout(k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout)
 	k.addPowerDistUpdateEvent(ctx, btcDel.EndHeight-wValue, unbondedEvent)
+}
 
-	return nil
+// activateReservedBTCDelegation records the inclusion of the staking tx of the
+// given reserved BTC delegation, after which the BTC delegation is pending, or
+// active if it already has a covenant quorum
+func (k Keeper) activateReservedBTCDelegation(
+	ctx sdk.Context,
+	btcDel *types.BTCDelegation,
+	startHeight uint64,
+	endHeight uint64,
+	stakingTxInfo *btcctypes.TransactionInfo,
+	covenantQuorum uint32,
+) {
+	k.reservedBTCDelegationStore(ctx).Delete(reservedBTCDelegationKey(btcDel))
+	btcDel.Reserved = false
+	btcDel.StartHeight = startHeight
+	btcDel.EndHeight = endHeight
+	btcDel.StakingTxKey = stakingTxInfo.Key
+	btcDel.StakingTxInclusionProof = stakingTxInfo.Proof
+	k.setBTCDelegation(ctx, btcDel)
+
+	stakingTxHash := btcDel.MustGetStakingTxHash().String()
+	k.addBTCDelegationExpiryEvent(ctx, btcDel, stakingTxHash)
+
+	if btcDel.HasCovenantQuorums(covenantQuorum) {
+		k.recordActiveBTCDelegation(ctx, stakingTxHash)
+		return
+	}
+
+	// notify subscriber that the BTC delegation is now waiting for the
+	// remaining covenant signatures
+	event := &types.EventBTCDelegationStateUpdate{
+		StakingTxHash: stakingTxHash,
+		NewState:      types.BTCDelegationStatus_PENDING,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		panic(fmt.Errorf("failed to emit EventBTCDelegationStateUpdate for the new pending BTC delegation: %w", err))
+	}
 }
 
 // addCovenantSigsToBTCDelegation adds signatures from a given covenant member
@@ -92,22 +148,89 @@ func (k Keeper) addCovenantSigsToBTCDelegation(
 	k.setBTCDelegation(ctx, btcDel)
 
 	// If reaching the covenant quorum after this msg, the BTC delegation becomes
-	// active. Then, record and emit this event
-	if len(btcDel.CovenantSigs) == int(params.CovenantQuorum) {
-		// notify subscriber
-		event := &types.EventBTCDelegationStateUpdate{
-			StakingTxHash: btcDel.MustGetStakingTxHash().String(),
-			NewState:      types.BTCDelegationStatus_ACTIVE,
+	// active. Then, record and emit this event. A reserved BTC delegation
+	// becomes active upon its activation instead
+	if len(btcDel.CovenantSigs) == int(params.CovenantQuorum) && !btcDel.Reserved {
+		k.recordActiveBTCDelegation(ctx, btcDel.MustGetStakingTxHash().String())
+	}
+}
+
+// addCovenantUnbondingSigToBTCDelegation adds the signature on the unbonding
+// tx from a given covenant member to the given BTC delegation, a finality
+// provider of which was slashed before the BTC delegation got activated. The
+// signatures on the slashing txs are discarded, as anyone can decrypt them
+// with the slashed finality provider's exposed SK and slash the delegator
+func (k Keeper) addCovenantUnbondingSigToBTCDelegation(
+	ctx sdk.Context,
+	btcDel *types.BTCDelegation,
+	covPK *bbn.BIP340PubKey,
+	unbondingTxSig *bbn.BIP340Signature,
+) {
+	btcDel.AddCovenantUnbondingSig(covPK, unbondingTxSig)
+	if btcDel.FpSlashedBeforeActivation {
+		k.setBTCDelegation(ctx, btcDel)
+		return
+	}
+	k.markFpSlashedBeforeActivation(ctx, btcDel)
+}
+
+// markFpSlashedBeforeActivation marks the given BTC delegation, a finality
+// provider of which was slashed before the BTC delegation got activated, as
+// unbonded. The BTC delegation never gets voting power, so there is no power
+// distribution update to record
+func (k Keeper) markFpSlashedBeforeActivation(ctx sdk.Context, btcDel *types.BTCDelegation) {
+	btcDel.FpSlashedBeforeActivation = true
+	// drop the covenant signatures on the slashing txs received before the
+	// finality provider was slashed
+	btcDel.CovenantSigs = nil
+	btcDel.BtcUndelegation.CovenantSlashingSigs = nil
+	k.setBTCDelegation(ctx, btcDel)
+
+	// notify subscriber that the BTC delegation is unbonded without ever
+	// being active
+	event := &types.EventBTCDelegationStateUpdate{
+		StakingTxHash: btcDel.MustGetStakingTxHash().String(),
+		NewState:      types.BTCDelegationStatus_UNBONDED,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		panic(fmt.Errorf("failed to emit EventBTCDelegationStateUpdate for the BTC delegation under a slashed finality provider: %w", err))
+	}
+}
+
+// hasSlashedFinalityProvider returns whether any finality provider that the
+// given BTC delegation restakes to is slashed
+func (k Keeper) hasSlashedFinalityProvider(ctx context.Context, btcDel *types.BTCDelegation) bool {
+	for _, fpBTCPK := range btcDel.FpBtcPkList {
+		fp, err := k.GetFinalityProvider(ctx, fpBTCPK)
+		if err != nil {
+			// the finality providers are ensured to exist upon the creation
+			// of the BTC delegation
+			panic(fmt.Errorf("failed to get finality provider %s of an existing BTC delegation: %w", fpBTCPK.MarshalHex(), err))
 		}
-		if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
-			panic(fmt.Errorf("failed to emit EventBTCDelegationStateUpdate for the new active BTC delegation: %w", err))
+		if fp.IsSlashed() {
+			return true
 		}
+	}
+	return false
+}
 
-		// record event that the BTC delegation becomes active at this height
-		activeEvent := types.NewEventPowerDistUpdateWithBTCDel(event)
-		btcTip := k.btclcKeeper.GetTipInfo(ctx)
-		k.addPowerDistUpdateEvent(ctx, btcTip.Height, activeEvent)
+// recordActiveBTCDelegation emits the event that the BTC delegation with the
+// given staking tx hash becomes active, and records it for updating the voting
+// power distribution at the current BTC tip
+func (k Keeper) recordActiveBTCDelegation(ctx sdk.Context, stakingTxHash string) {
+	// notify subscriber
+	event := &types.EventBTCDelegationStateUpdate{
+		StakingTxHash: stakingTxHash,
+		NewState:      types.BTCDelegationStatus_ACTIVE,
 	}
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		panic(fmt.Errorf("failed to emit EventBTCDelegationStateUpdate for the new active BTC delegation: %w", err))
+	}
+
+	// record event that the BTC delegation becomes active at this height
+	activeEvent := types.NewEventPowerDistUpdateWithBTCDel(event)
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+	k.addPowerDistUpdateEvent(ctx, btcTip.Height, activeEvent)
 }
 
 // btcUndelegate adds the signature of the unbonding tx signed by the staker
@@ -136,11 +259,294 @@ func (k Keeper) btcUndelegate(
 	k.addPowerDistUpdateEvent(ctx, btcTip.Height, unbondedEvent)
 }
 
+// executeUnbondingPathWitness assembles the witness of the unbonding tx of
+// the given BTC delegation from the given covenant signatures and delegator
+// signature, and executes it against the unbonding leaf of the staking output.
+// The covenant signatures are keyed by the hex of the covenant PKs. Since the
+// covenant script requires exactly a quorum number of signatures, only the
+// first quorum of them w.r.t. the order of sorted covenant PKs are used.
+// If the delegator signature is nil, i.e., the delegator has not signed the
+// unbonding tx yet, the delegator's part of the script is assumed to pass and
+// only the covenant part is verified
+func (k Keeper) executeUnbondingPathWitness(
+	btcDel *types.BTCDelegation,
+	params *types.Params,
+	covSigs map[string]*schnorr.Signature,
+	delegatorSig *schnorr.Signature,
+) error {
+	stakingInfo, err := btcDel.GetStakingInfo(params, k.btcNet)
+	if err != nil {
+		return err
+	}
+	unbondingSpendInfo, err := stakingInfo.UnbondingPathSpendInfo()
+	if err != nil {
+		return err
+	}
+	unbondingTx, err := bbn.NewBTCTxFromBytes(btcDel.BtcUndelegation.UnbondingTx)
+	if err != nil {
+		return err
+	}
+
+	orderedCovPKs := bbn.SortBIP340PKs(params.CovenantPks)
+	orderedCovSigs := make([]*schnorr.Signature, len(orderedCovPKs))
+	numCovSigs := uint32(0)
+	for i, covPK := range orderedCovPKs {
+		if numCovSigs == params.CovenantQuorum {
+			break
+		}
+		if covSig, ok := covSigs[covPK.MarshalHex()]; ok {
+			orderedCovSigs[i] = covSig
+			numCovSigs++
+		}
+	}
+	if numCovSigs < params.CovenantQuorum {
+		return fmt.Errorf("only %d out of %d required covenant signatures are given", numCovSigs, params.CovenantQuorum)
+	}
+
+	spendTx := unbondingTx.Copy()
+	prevOutputFetcher := txscript.NewCannedPrevOutputFetcher(
+		stakingInfo.StakingOutput.PkScript,
+		stakingInfo.StakingOutput.Value,
+	)
+	sigHashes := txscript.NewTxSigHashes(spendTx, prevOutputFetcher)
+
+	var sigCache *txscript.SigCache
+	if delegatorSig == nil {
+		// use a placeholder delegator signature, and pre-approve it in the
+		// signature cache of the script engine such that the engine accepts it
+		// without verification, while still verifying the covenant signatures
+		sigHash, err := txscript.CalcTapscriptSignaturehash(
+			sigHashes,
+			txscript.SigHashDefault,
+			spendTx,
+			0,
+			prevOutputFetcher,
+			unbondingSpendInfo.RevealedLeaf,
+		)
+		if err != nil {
+			return err
+		}
+		delegatorSig = schnorr.NewSignature(new(btcec.FieldVal), new(btcec.ModNScalar))
+		sigCache = txscript.NewSigCache(uint(len(orderedCovSigs)) + 1)
+		sigCache.Add(
+			chainhash.Hash(sigHash),
+			delegatorSig.Serialize(),
+			schnorr.SerializePubKey(btcDel.BtcPk.MustToBTCPK()),
+		)
+	}
+
+	witness, err := unbondingSpendInfo.CreateUnbondingPathWitness(orderedCovSigs, delegatorSig)
+	if err != nil {
+		return err
+	}
+	spendTx.TxIn[0].Witness = witness
+
+	engine, err := txscript.NewEngine(
+		stakingInfo.StakingOutput.PkScript,
+		spendTx,
+		0,
+		txscript.StandardVerifyFlags,
+		sigCache,
+		sigHashes,
+		stakingInfo.StakingOutput.Value,
+		prevOutputFetcher,
+	)
+	if err != nil {
+		return err
+	}
+	return engine.Execute()
+}
+
+// markBTCDelegationsSlashable records the given BTC height as the height from
+// which on the slashing tx of each BTC delegation under the given slashed
+// finality provider can be broadcast to BTC, for the active or early unbonded
+// ones. Such a BTC delegation is only slashed once the inclusion proof of its
+// slashing tx is submitted
+func (k Keeper) markBTCDelegationsSlashable(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, btcHeight uint64) {
+	for _, btcDel := range k.getSlashableBTCDelegations(ctx, fpBTCPK, btcHeight) {
+		// keep the height at which the first of its finality providers was
+		// slashed
+		if btcDel.FpSlashedBtcHeight > 0 {
+			continue
+		}
+		btcDel.FpSlashedBtcHeight = btcHeight
+		k.setBTCDelegation(ctx, btcDel)
+	}
+}
+
+// getSlashableBTCDelegations returns the BTC delegations under the given
+// finality provider whose slashing tx can be broadcast to BTC at the given
+// BTC height, i.e., the active or early unbonded ones
+func (k Keeper) getSlashableBTCDelegations(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, btcHeight uint64) []*types.BTCDelegation {
+	covenantQuorum := k.GetParams(ctx).CovenantQuorum
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	iter := k.btcDelegatorFpStore(ctx, fpBTCPK).Iterator(nil, nil)
+	defer iter.Close()
+
+	btcDels := []*types.BTCDelegation{}
+	for ; iter.Valid(); iter.Next() {
+		var btcDelIndex types.BTCDelegatorDelegationIndex
+		k.cdc.MustUnmarshal(iter.Value(), &btcDelIndex)
+		for _, stakingTxHashBytes := range btcDelIndex.StakingTxHashList {
+			stakingTxHash, err := chainhash.NewHash(stakingTxHashBytes)
+			if err != nil {
+				panic(err) // only programming error
+			}
+			btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+			status := btcDel.GetStatus(btcHeight, wValue, covenantQuorum)
+			if status != types.BTCDelegationStatus_ACTIVE && !(status == types.BTCDelegationStatus_UNBONDED && btcDel.IsUnbondedEarly()) {
+				continue
+			}
+			btcDels = append(btcDels, btcDel)
+		}
+	}
+	return btcDels
+}
+
+// unbondNotActivatedBTCDelegations marks the BTC delegations under the given
+// slashed finality provider that are not activated yet, i.e., the pending or
+// reserved ones, as unbonded, such that they are reported as unbonded right
+// away rather than upon their next covenant signature or activation
+func (k Keeper) unbondNotActivatedBTCDelegations(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, btcHeight uint64) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, btcDel := range k.getNotActivatedBTCDelegations(ctx, fpBTCPK, btcHeight) {
+		k.markFpSlashedBeforeActivation(sdkCtx, btcDel)
+	}
+}
+
+// getNotActivatedBTCDelegations returns the BTC delegations under the given
+// finality provider that are pending or reserved at the given BTC height
+func (k Keeper) getNotActivatedBTCDelegations(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, btcHeight uint64) []*types.BTCDelegation {
+	covenantQuorum := k.GetParams(ctx).CovenantQuorum
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	iter := k.btcDelegatorFpStore(ctx, fpBTCPK).Iterator(nil, nil)
+	defer iter.Close()
+
+	btcDels := []*types.BTCDelegation{}
+	for ; iter.Valid(); iter.Next() {
+		var btcDelIndex types.BTCDelegatorDelegationIndex
+		k.cdc.MustUnmarshal(iter.Value(), &btcDelIndex)
+		for _, stakingTxHashBytes := range btcDelIndex.StakingTxHashList {
+			stakingTxHash, err := chainhash.NewHash(stakingTxHashBytes)
+			if err != nil {
+				panic(err) // only programming error
+			}
+			btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+			status := btcDel.GetStatus(btcHeight, wValue, covenantQuorum)
+			if status != types.BTCDelegationStatus_PENDING && status != types.BTCDelegationStatus_RESERVED {
+				continue
+			}
+			btcDels = append(btcDels, btcDel)
+		}
+	}
+	return btcDels
+}
+
+// GetActiveBTCDelegationsAtHeight returns the BTC delegations restaked to the
+// given finality provider that are active at the BTC tip of the given Babylon
+// height.
+// NOTE: BTC delegations that have been unbonded early are never included, as
+// the BTC height of early unbonding is not recorded
+func (k Keeper) GetActiveBTCDelegationsAtHeight(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, babylonHeight uint64) []*types.BTCDelegation {
+	btcHeight := k.GetBTCHeightAtBabylonHeight(ctx, babylonHeight)
+	covenantQuorum := k.GetParams(ctx).CovenantQuorum
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	iter := k.btcDelegatorFpStore(ctx, fpBTCPK).Iterator(nil, nil)
+	defer iter.Close()
+
+	btcDels := []*types.BTCDelegation{}
+	for ; iter.Valid(); iter.Next() {
+		var btcDelIndex types.BTCDelegatorDelegationIndex
+		k.cdc.MustUnmarshal(iter.Value(), &btcDelIndex)
+		for _, stakingTxHashBytes := range btcDelIndex.StakingTxHashList {
+			stakingTxHash, err := chainhash.NewHash(stakingTxHashBytes)
+			if err != nil {
+				panic(err) // only programming error
+			}
+			btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+			if btcDel.GetStatus(btcHeight, wValue, covenantQuorum) == types.BTCDelegationStatus_ACTIVE {
+				btcDels = append(btcDels, btcDel)
+			}
+		}
+	}
+	return btcDels
+}
+
+// slashBTCDelegation marks the given BTC delegation as slashed, given the BTC
+// height of the block that includes its slashing tx
+func (k Keeper) slashBTCDelegation(ctx context.Context, btcDel *types.BTCDelegation, slashingTxHeight uint64) {
+	btcDel.SlashedBtcHeight = slashingTxHeight
+	k.setBTCDelegation(ctx, btcDel)
+
+	// notify subscriber about this slashed BTC delegation
+	event := &types.EventBTCDelegationStateUpdate{
+		StakingTxHash: btcDel.MustGetStakingTxHash().String(),
+		NewState:      types.BTCDelegationStatus_SLASHED,
+	}
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(event); err != nil {
+		panic(fmt.Errorf("failed to emit EventBTCDelegationStateUpdate for the new slashed BTC delegation: %w", err))
+	}
+
+	// record event that the BTC delegation becomes slashed at the current BTC
+	// height, so that it loses voting power under all finality providers it
+	// restakes to
+	slashedEvent := types.NewEventPowerDistUpdateWithBTCDel(event)
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+	k.addPowerDistUpdateEvent(ctx, btcTip.Height, slashedEvent)
+}
+
 func (k Keeper) setBTCDelegation(ctx context.Context, btcDel *types.BTCDelegation) {
 	store := k.btcDelegationStore(ctx)
 	stakingTxHash := btcDel.MustGetStakingTxHash()
 	btcDelBytes := k.cdc.MustMarshal(btcDel)
 	store.Set(stakingTxHash[:], btcDelBytes)
+
+	// index the BTC delegation by the BTC height of its staking tx, which
+	// is unknown for a reserved BTC delegation until its activation. A
+	// reserved BTC delegation is indexed by its reservation expiry instead
+	if btcDel.Reserved {
+		if btcDel.ReservationExpiryBtcHeight > 0 {
+			k.reservedBTCDelegationStore(ctx).Set(reservedBTCDelegationKey(btcDel), stakingTxHash[:])
+		}
+		return
+	}
+	inclusionHeightKey := append(sdk.Uint64ToBigEndian(btcDel.StartHeight), stakingTxHash[:]...)
+	k.btcDelegationInclusionHeightStore(ctx).Set(inclusionHeightKey, stakingTxHash[:])
+}
+
+// IterateBTCDelegationsByInclusionHeight iterates over all BTC delegations
+// whose staking tx is included in a BTC block within [fromHeight, toHeight],
+// in ascending order of the inclusion height
+func (k Keeper) IterateBTCDelegationsByInclusionHeight(
+	ctx context.Context,
+	fromHeight uint64,
+	toHeight uint64,
+	handler func(btcDel *types.BTCDelegation) bool,
+) {
+	store := k.btcDelegationInclusionHeightStore(ctx)
+	var end []byte
+	if toHeight < math.MaxUint64 {
+		end = sdk.Uint64ToBigEndian(toHeight + 1)
+	}
+	iter := store.Iterator(sdk.Uint64ToBigEndian(fromHeight), end)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		stakingTxHash, err := chainhash.NewHash(iter.Value())
+		if err != nil {
+			panic(err) // only programming error
+		}
+		btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+		if btcDel == nil {
+			panic(types.ErrBTCDelegationNotFound) // only programming error
+		}
+		if !handler(btcDel) {
+			break
+		}
+	}
 }
 
 // GetBTCDelegation gets the BTC delegation with a given staking tx hash
@@ -177,3 +583,30 @@ func (k Keeper) btcDelegationStore(ctx context.Context) prefix.Store {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	return prefix.NewStore(storeAdapter, types.BTCDelegationKey)
 }
+
+// btcDelegationInclusionHeightStore returns the KVStore of the BTC delegations
+// indexed by the BTC height of their staking tx
+// prefix: BTCDelegationInclusionHeightKey
+// key: (BTC height of the staking tx || BTC delegation's staking tx hash)
+// value: BTC delegation's staking tx hash
+func (k Keeper) btcDelegationInclusionHeightStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.BTCDelegationInclusionHeightKey)
+}
+
+// reservedBTCDelegationKey returns the key of the given reserved BTC
+// delegation in the reserved BTC delegation store
+func reservedBTCDelegationKey(btcDel *types.BTCDelegation) []byte {
+	stakingTxHash := btcDel.MustGetStakingTxHash()
+	return append(sdk.Uint64ToBigEndian(btcDel.ReservationExpiryBtcHeight), stakingTxHash[:]...)
+}
+
+// reservedBTCDelegationStore returns the KVStore of the reserved BTC
+// delegations indexed by the BTC height at which their reservation expires
+// prefix: ReservedBTCDelegationKey
+// key: (reservation expiry BTC height || BTC delegation's staking tx hash)
+// value: BTC delegation's staking tx hash
+func (k Keeper) reservedBTCDelegationStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.ReservedBTCDelegationKey)
+}
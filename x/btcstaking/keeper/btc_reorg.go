@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InvalidateOrphanedBTCDelegations invalidates all BTC delegations whose
+// staking tx is included in a BTC block that has been orphaned by the BTC
+// reorgs since the last `BeginBlock`. The BTC light client only keeps the
+// BTC main chain, so after rolling back to a given height, the BTC blocks
+// above this height that include staking txs are no longer in the main chain,
+// unless they have been included in the main chain again since then.
+// This is triggered upon each `BeginBlock`
+func (k Keeper) InvalidateOrphanedBTCDelegations(ctx context.Context) {
+	rollBackHeight, found := k.getBTCRollBackHeight(ctx)
+	if !found {
+		return
+	}
+	k.deleteBTCRollBackHeight(ctx)
+
+	// find all BTC delegations whose staking tx is included in a BTC block
+	// above the rollback height that is no longer in the main chain
+	orphanedBTCDels := []*types.BTCDelegation{}
+	k.IterateBTCDelegationsByInclusionHeight(ctx, rollBackHeight+1, math.MaxUint64, func(btcDel *types.BTCDelegation) bool {
+		if !btcDel.Invalidated && !k.isStakingTxInMainChain(ctx, btcDel) {
+			orphanedBTCDels = append(orphanedBTCDels, btcDel)
+		}
+		return true
+	})
+
+	for _, btcDel := range orphanedBTCDels {
+		k.invalidateBTCDelegation(ctx, btcDel)
+	}
+}
+
+// isStakingTxInMainChain returns whether the BTC block including the staking
+// tx of the given BTC delegation is in the BTC main chain at the height
+// recorded in the BTC delegation. BTC delegations that do not record the BTC
+// block including their staking tx are deemed not in the main chain
+func (k Keeper) isStakingTxInMainChain(ctx context.Context, btcDel *types.BTCDelegation) bool {
+	if btcDel.StakingTxKey == nil {
+		return false
+	}
+	// the BTC light client only keeps headers in the main chain
+	stakingTxHeader := k.btclcKeeper.GetHeaderByHash(ctx, btcDel.StakingTxKey.Hash)
+	return stakingTxHeader != nil && stakingTxHeader.Height == btcDel.StartHeight
+}
+
+// invalidateBTCDelegation marks the given BTC delegation as invalidated and
+// records the event that it no longer has voting power
+func (k Keeper) invalidateBTCDelegation(ctx context.Context, btcDel *types.BTCDelegation) {
+	btcDel.Invalidated = true
+	k.setBTCDelegation(ctx, btcDel)
+
+	// notify subscriber about this invalidated BTC delegation
+	event := &types.EventBTCDelegationStateUpdate{
+		StakingTxHash: btcDel.MustGetStakingTxHash().String(),
+		NewState:      types.BTCDelegationStatus_INVALIDATED,
+	}
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(event); err != nil {
+		panic(fmt.Errorf("failed to emit EventBTCDelegationStateUpdate for the new invalidated BTC delegation: %w", err))
+	}
+
+	// record event that the BTC delegation becomes invalidated, so that it is
+	// removed from the voting power distribution. The BTC tip may have been
+	// rolled back below the one at the last height, so the event is recorded
+	// at the higher of the two, which is processed in this `BeginBlock`
+	height := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	lastBTCTipHeight := k.GetBTCHeightAtBabylonHeight(ctx, height-1)
+	invalidatedEvent := types.NewEventPowerDistUpdateWithBTCDel(event)
+	k.addPowerDistUpdateEvent(ctx, max(k.GetCurrentBTCHeight(ctx), lastBTCTipHeight), invalidatedEvent)
+}
+
+// setBTCRollBackHeight records the height that the BTC light client rolls
+// back to, keeping the lowest one if there are multiple rollbacks
+func (k Keeper) setBTCRollBackHeight(ctx context.Context, height uint64) {
+	if rollBackHeight, found := k.getBTCRollBackHeight(ctx); found && rollBackHeight <= height {
+		return
+	}
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.BTCRollBackHeightKey, sdk.Uint64ToBigEndian(height))
+}
+
+// getBTCRollBackHeight returns the lowest height that the BTC light client
+// rolled back to since the last `BeginBlock`, and false if there is no rollback
+func (k Keeper) getBTCRollBackHeight(ctx context.Context) (uint64, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	heightBytes := store.Get(types.BTCRollBackHeightKey)
+	if heightBytes == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(heightBytes), true
+}
+
+func (k Keeper) deleteBTCRollBackHeight(ctx context.Context) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Delete(types.BTCRollBackHeightKey)
+}
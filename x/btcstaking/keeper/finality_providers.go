@@ -58,6 +58,14 @@ func (k Keeper) SlashFinalityProvider(ctx context.Context, fpBTCPK []byte) error
 	fp.SlashedBtcHeight = btcTip.Height
 	k.SetFinalityProvider(ctx, fp)
 
+	// record that the slashing txs of the BTC delegations can be broadcast to
+	// BTC from now on. They are marked as slashed once their slashing txs are
+	// observed in BTC
+	k.markBTCDelegationsSlashable(ctx, fp.BtcPk, btcTip.Height)
+	// mark the BTC delegations that are not activated yet as unbonded, as
+	// they can no longer be activated
+	k.unbondNotActivatedBTCDelegations(ctx, fp.BtcPk, btcTip.Height)
+
 	// record slashed event. The next `BeginBlock` will consume this
 	// event for updating the finality provider set
 	powerUpdateEvent := types.NewEventPowerDistUpdateWithSlashedFP(fp.BtcPk)
@@ -55,6 +55,10 @@ func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
 		k.setVotingPowerDistCache(ctx, vpCache.BlockHeight, vpCache.VpDistribution)
 	}
 
+	for _, tombstone := range gs.BtcDelegationTombstones {
+		k.setBTCDelegationTombstone(ctx, tombstone)
+	}
+
 	return nil
 }
 
@@ -90,6 +94,11 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	tombstones, err := k.btcDelegationTombstones(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
 		Params:            k.GetAllParams(ctx),
 		FinalityProviders: fps,
@@ -99,6 +108,8 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		BtcDelegators:     btcDels,
 		Events:            evts,
 		VpDstCache:        vpsCache,
+
+		BtcDelegationTombstones: tombstones,
 	}, nil
 }
 
@@ -134,6 +145,22 @@ func (k Keeper) btcDelegations(ctx context.Context) ([]*types.BTCDelegation, err
 	return dels, nil
 }
 
+func (k Keeper) btcDelegationTombstones(ctx context.Context) ([]*types.BTCDelegationTombstone, error) {
+	tombstones := make([]*types.BTCDelegationTombstone, 0)
+	iter := k.btcDelegationTombstoneStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var tombstone types.BTCDelegationTombstone
+		if err := tombstone.Unmarshal(iter.Value()); err != nil {
+			return nil, err
+		}
+		tombstones = append(tombstones, &tombstone)
+	}
+
+	return tombstones, nil
+}
+
 // fpVotingPowers gets the voting power of a given finality provider at a given Babylon height.
 func (k Keeper) fpVotingPowers(ctx context.Context) ([]*types.VotingPowerFP, error) {
 	iter := k.votingPowerStore(ctx).Iterator(nil, nil)
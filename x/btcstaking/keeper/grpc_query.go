@@ -1,9 +1,14 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"math"
+	"sort"
 
 	errorsmod "cosmossdk.io/errors"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -11,12 +16,23 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/babylonchain/babylon/btcstaking"
 	bbn "github.com/babylonchain/babylon/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
+	etypes "github.com/babylonchain/babylon/x/epoching/types"
 )
 
 var _ types.QueryServer = Keeper{}
 
+// MaxCovenantSigningBatchSize is the maximum number of BTC delegations that
+// can be queried in a single CovenantSigningBatch query
+const MaxCovenantSigningBatchSize = 100
+
+// MaxInclusionHeightRange is the maximum number of BTC heights that can be
+// queried in a single BTCDelegationsByInclusionHeight query
+const MaxInclusionHeightRange = 1000
+
 // FinalityProviders returns a paginated list of all Babylon maintained finality providers
 func (k Keeper) FinalityProviders(c context.Context, req *types.QueryFinalityProvidersRequest) (*types.QueryFinalityProvidersResponse, error) {
 	if req == nil {
@@ -120,6 +136,50 @@ func (k Keeper) BTCDelegations(ctx context.Context, req *types.QueryBTCDelegatio
 	}, nil
 }
 
+// StaleCovenantPendingDelegations returns a paginated list of BTC delegations
+// that are still pending more than `max_blocks_pending` Babylon blocks after
+// their creation. BTC delegations without a recorded creation height are skipped
+func (k Keeper) StaleCovenantPendingDelegations(ctx context.Context, req *types.QueryStaleCovenantPendingDelegationsRequest) (*types.QueryStaleCovenantPendingDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	babylonHeight := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	covenantQuorum := k.GetParams(ctx).CovenantQuorum
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	store := k.btcDelegationStore(ctx)
+	var btcDels []*types.BTCDelegationResponse
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var btcDel types.BTCDelegation
+		k.cdc.MustUnmarshal(value, &btcDel)
+
+		// hit if the BTC delegation is pending and has been so for more than
+		// max_blocks_pending blocks
+		if btcDel.CreatedBabylonHeight == 0 || babylonHeight-btcDel.CreatedBabylonHeight <= req.MaxBlocksPending {
+			return false, nil
+		}
+		status := btcDel.GetStatus(btcTipHeight, wValue, covenantQuorum)
+		if status != types.BTCDelegationStatus_PENDING {
+			return false, nil
+		}
+
+		if accumulate {
+			btcDels = append(btcDels, types.NewBTCDelegationResponse(&btcDel, status))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStaleCovenantPendingDelegationsResponse{
+		BtcDelegations: btcDels,
+		Pagination:     pageRes,
+	}, nil
+}
+
 // FinalityProviderPowerAtHeight returns the voting power of the specified finality provider
 // at the provided Babylon height
 func (k Keeper) FinalityProviderPowerAtHeight(ctx context.Context, req *types.QueryFinalityProviderPowerAtHeightRequest) (*types.QueryFinalityProviderPowerAtHeightResponse, error) {
@@ -302,3 +362,1528 @@ func (k Keeper) BTCDelegation(ctx context.Context, req *types.QueryBTCDelegation
 		BtcDelegation: types.NewBTCDelegationResponse(btcDel, status),
 	}, nil
 }
+
+// CovenantSignedDelegations returns all BTC delegations that the given covenant
+// member has contributed signatures to
+func (k Keeper) CovenantSignedDelegations(ctx context.Context, req *types.QueryCovenantSignedDelegationsRequest) (*types.QueryCovenantSignedDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.CovPkHex) == 0 {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "covenant BTC public key cannot be empty")
+	}
+
+	covPK, err := bbn.NewBIP340PubKeyFromHex(req.CovPkHex)
+	if err != nil {
+		return nil, err
+	}
+
+	covenantQuorum := k.GetParams(ctx).CovenantQuorum
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	store := k.btcDelegationStore(ctx)
+	var btcDels []*types.BTCDelegationResponse
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var btcDel types.BTCDelegation
+		k.cdc.MustUnmarshal(value, &btcDel)
+
+		if !btcDel.IsSignedByCovMember(covPK) {
+			return false, nil
+		}
+		if accumulate {
+			status := btcDel.GetStatus(btcTipHeight, wValue, covenantQuorum)
+			btcDels = append(btcDels, types.NewBTCDelegationResponse(&btcDel, status))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCovenantSignedDelegationsResponse{
+		BtcDelegations: btcDels,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// RewardEligibleDelegations returns the active BTC delegations of the given finality
+// provider, distinguishing those that earn rewards at the current height, i.e., that
+// are in the voting power distribution cache under an active finality provider, from
+// those that do not
+func (k Keeper) RewardEligibleDelegations(ctx context.Context, req *types.QueryRewardEligibleDelegationsRequest) (*types.QueryRewardEligibleDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.FpBtcPkHex) == 0 {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "finality provider BTC public key cannot be empty")
+	}
+
+	fpPK, err := bbn.NewBIP340PubKeyFromHex(req.FpBtcPkHex)
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.HasFinalityProvider(ctx, fpPK.MustMarshal()) {
+		return nil, types.ErrFpNotFound
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	distCacheHeight := uint64(sdkCtx.HeaderInfo().Height)
+	btccParams := k.btccKeeper.GetParams(ctx)
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	params := k.GetParams(ctx)
+
+	// collect the staking tx hashes of the BTC delegations under this finality
+	// provider if it is among the active finality providers in the voting power
+	// distribution cache, as only those receive rewards
+	fpActive := false
+	inDistCache := map[string]struct{}{}
+	if dc := k.getVotingPowerDistCache(ctx, distCacheHeight); dc != nil {
+		for _, fp := range dc.GetActiveFinalityProviders(params.MaxActiveFinalityProviders) {
+			if !fp.BtcPk.Equals(fpPK) {
+				continue
+			}
+			fpActive = true
+			for _, btcDel := range fp.BtcDels {
+				inDistCache[btcDel.StakingTxHash] = struct{}{}
+			}
+		}
+	}
+
+	resp := &types.QueryRewardEligibleDelegationsResponse{
+		EligibleDelegations:           []*types.BTCDelegationResponse{},
+		PendingDelegations:            []*types.BTCDelegationResponse{},
+		DistCacheHeight:               distCacheHeight,
+		BtcTipHeight:                  btcTipHeight,
+		BtcConfirmationDepth:          btccParams.BtcConfirmationDepth,
+		CheckpointFinalizationTimeout: btccParams.CheckpointFinalizationTimeout,
+		FpActive:                      fpActive,
+	}
+
+	btcDelStore := k.btcDelegatorFpStore(sdkCtx, fpPK)
+	pageRes, err := query.Paginate(btcDelStore, req.Pagination, func(key, value []byte) error {
+		delBTCPK, err := bbn.NewBIP340PubKey(key)
+		if err != nil {
+			return err
+		}
+		btcDels := k.getBTCDelegatorDelegations(sdkCtx, fpPK, delBTCPK)
+		for _, btcDel := range btcDels.Dels {
+			delStatus := btcDel.GetStatus(btcTipHeight, btccParams.CheckpointFinalizationTimeout, params.CovenantQuorum)
+			if delStatus != types.BTCDelegationStatus_ACTIVE {
+				continue
+			}
+			btcDelResp := types.NewBTCDelegationResponse(btcDel, delStatus)
+			if _, ok := inDistCache[btcDel.MustGetStakingTxHash().String()]; ok {
+				resp.EligibleDelegations = append(resp.EligibleDelegations, btcDelResp)
+			} else {
+				resp.PendingDelegations = append(resp.PendingDelegations, btcDelResp)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp.Pagination = pageRes
+
+	return resp, nil
+}
+
+// SimulateBTCUndelegation validates the given unbonding tx and its slashing tx
+// against the staking output of an existing BTC delegation, using the same
+// checks as BTC delegation creation. It does not change state
+func (k Keeper) SimulateBTCUndelegation(ctx context.Context, req *types.QuerySimulateBTCUndelegationRequest) (*types.QuerySimulateBTCUndelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.UnbondingTx == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty unbonding tx")
+	}
+	if req.UnbondingSlashingTx == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty unbonding slashing tx")
+	}
+	if req.DelegatorUnbondingSlashingSig == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator signature")
+	}
+
+	// decode staking tx hash
+	stakingTxHash, err := chainhash.NewHashFromStr(req.StakingTxHashHex)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// find BTC delegation and the params it was validated against
+	btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+	if btcDel == nil {
+		return nil, types.ErrBTCDelegationNotFound
+	}
+	params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+	if params == nil {
+		panic("params version in BTC delegation is not found")
+	}
+
+	if err := k.simulateBTCUndelegation(ctx, btcDel, params, req); err != nil {
+		return &types.QuerySimulateBTCUndelegationResponse{InvalidReason: err.Error()}, nil
+	}
+
+	return &types.QuerySimulateBTCUndelegationResponse{Valid: true}, nil
+}
+
+func (k Keeper) simulateBTCUndelegation(
+	ctx context.Context,
+	btcDel *types.BTCDelegation,
+	params *types.Params,
+	req *types.QuerySimulateBTCUndelegationRequest,
+) error {
+	unbondingMsgTx, err := bbn.NewBTCTxFromBytes(req.UnbondingTx)
+	if err != nil {
+		return types.ErrInvalidUnbondingTx.Wrapf("cannot be converted to wire.MsgTx: %v", err)
+	}
+	if err := btcstaking.IsSimpleTransfer(unbondingMsgTx); err != nil {
+		return types.ErrInvalidUnbondingTx.Wrap(err.Error())
+	}
+
+	// the unbonding time is committed in the delegation's slashing tx, and has
+	// to be larger than the min unbonding time
+	minUnbondingTime := types.MinimumUnbondingTime(*params, k.btccKeeper.GetParams(ctx))
+	if uint64(req.UnbondingTime) <= minUnbondingTime {
+		return types.ErrInvalidUnbondingTx.Wrapf("unbonding time %d must be larger than %d", req.UnbondingTime, minUnbondingTime)
+	}
+	if req.UnbondingTime != btcDel.UnbondingTime {
+		return types.ErrInvalidUnbondingTx.Wrapf("unbonding time %d does not match the BTC delegation's unbonding time %d", req.UnbondingTime, btcDel.UnbondingTime)
+	}
+
+	stakingMsgTx, err := bbn.NewBTCTxFromBytes(btcDel.StakingTx)
+	if err != nil {
+		// BTC delegation in the store has been validated
+		panic(fmt.Errorf("failed to parse staking tx of a BTC delegation: %w", err))
+	}
+	fpPKs, err := bbn.NewBTCPKsFromBIP340PKs(btcDel.FpBtcPkList)
+	if err != nil {
+		panic(fmt.Errorf("failed to parse finality provider PKs of a BTC delegation: %w", err))
+	}
+
+	return k.verifyUnbondingTxs(
+		params,
+		btcDel.BtcPk.MustToBTCPK(),
+		fpPKs,
+		stakingMsgTx,
+		btcDel.StakingOutputIdx,
+		uint16(btcDel.UnbondingTime),
+		req.UnbondingValue,
+		req.UnbondingTx,
+		req.UnbondingSlashingTx,
+		req.DelegatorUnbondingSlashingSig,
+	)
+}
+
+// SlashingAmount returns the amount of a BTC delegation that would be sent to
+// the slashing address if the BTC delegation is slashed, as committed in its
+// slashing txs
+func (k Keeper) SlashingAmount(ctx context.Context, req *types.QuerySlashingAmountRequest) (*types.QuerySlashingAmountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// decode staking tx hash
+	stakingTxHash, err := chainhash.NewHashFromStr(req.StakingTxHashHex)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// find BTC delegation and the params it was validated against
+	btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+	if btcDel == nil {
+		return nil, types.ErrBTCDelegationNotFound
+	}
+	params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+	if params == nil {
+		panic("params version in BTC delegation is not found")
+	}
+
+	// slashing txs of the BTC delegation have been verified to pay at least
+	// `slashing_rate` of the staking/unbonding output to the slashing address
+	// in their first output
+	resp := &types.QuerySlashingAmountResponse{
+		SlashingAddress: params.SlashingAddress,
+		SlashingRate:    params.SlashingRate,
+		SlashingAmount:  btcDel.SlashingTx.MustGetSlashingAmount(),
+	}
+	if btcDel.BtcUndelegation != nil {
+		resp.UnbondingSlashingAmount = btcDel.BtcUndelegation.SlashingTx.MustGetSlashingAmount()
+	}
+
+	return resp, nil
+}
+
+// SimulateVotingPower returns the voting power that a hypothetical BTC
+// delegation with the given amount and staking time would contribute, if its
+// staking tx was included at the current BTC tip and it received a covenant
+// quorum
+func (k Keeper) SimulateVotingPower(ctx context.Context, req *types.QuerySimulateVotingPowerRequest) (*types.QuerySimulateVotingPowerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.TotalSat == 0 {
+		return nil, status.Error(codes.InvalidArgument, "total sat must be positive")
+	}
+	if req.StakingTime == 0 || req.StakingTime > math.MaxUint16 {
+		return nil, status.Errorf(codes.InvalidArgument, "staking time must be in (0, %d]", math.MaxUint16)
+	}
+
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+	btcDel := &types.BTCDelegation{
+		StartHeight:     btcTipHeight,
+		EndHeight:       btcTipHeight + uint64(req.StakingTime),
+		TotalSat:        req.TotalSat,
+		BtcUndelegation: &types.BTCUndelegation{},
+	}
+	// NOTE: zero covenant quorum is trivially satisfied, which simulates a BTC
+	// delegation that has received a covenant quorum
+	votingPower := btcDel.VotingPower(btcTipHeight, wValue, 0)
+
+	return &types.QuerySimulateVotingPowerResponse{
+		VotingPower:  votingPower,
+		BtcTipHeight: btcTipHeight,
+	}, nil
+}
+
+// BTCDelegationsByInclusionHeight returns all BTC delegations whose staking tx
+// is included in a BTC block within the given height range, ordered by the
+// inclusion height. BTC delegations created before the inclusion height index
+// existed are indexed by the Migrate1to2 store migration
+func (k Keeper) BTCDelegationsByInclusionHeight(ctx context.Context, req *types.QueryBTCDelegationsByInclusionHeightRequest) (*types.QueryBTCDelegationsByInclusionHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.FromHeight > req.ToHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "from height %d is larger than to height %d", req.FromHeight, req.ToHeight)
+	}
+	if req.ToHeight-req.FromHeight >= MaxInclusionHeightRange {
+		return nil, status.Errorf(codes.InvalidArgument, "height range cannot contain more than %d BTC heights", MaxInclusionHeightRange)
+	}
+
+	covenantQuorum := k.GetParams(ctx).CovenantQuorum
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	btcDels := []*types.BTCDelegationResponse{}
+	k.IterateBTCDelegationsByInclusionHeight(ctx, req.FromHeight, req.ToHeight, func(btcDel *types.BTCDelegation) bool {
+		status := btcDel.GetStatus(btcTipHeight, wValue, covenantQuorum)
+		btcDels = append(btcDels, types.NewBTCDelegationResponse(btcDel, status))
+		return true
+	})
+
+	return &types.QueryBTCDelegationsByInclusionHeightResponse{
+		BtcDelegations: btcDels,
+	}, nil
+}
+
+// CovenantQuorumHealth returns a histogram of the number of covenant
+// signatures that pending BTC delegations still miss to reach the quorum
+func (k Keeper) CovenantQuorumHealth(ctx context.Context, req *types.QueryCovenantQuorumHealthRequest) (*types.QueryCovenantQuorumHealthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	covenantQuorum := k.GetParams(ctx).CovenantQuorum
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	// a map where key is the number of missing covenant signatures and value
+	// is the number of pending BTC delegations missing them
+	numDelsByMissingSigs := map[uint32]uint64{}
+	numPendingDels := uint64(0)
+	iter := k.btcDelegationStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var btcDel types.BTCDelegation
+		k.cdc.MustUnmarshal(iter.Value(), &btcDel)
+
+		if btcDel.GetStatus(btcTipHeight, wValue, covenantQuorum) != types.BTCDelegationStatus_PENDING {
+			continue
+		}
+		missingSigs := uint32(0)
+		if numSigs := uint32(len(btcDel.CovenantSigs)); numSigs < covenantQuorum {
+			missingSigs = covenantQuorum - numSigs
+		}
+		numDelsByMissingSigs[missingSigs]++
+		numPendingDels++
+	}
+
+	buckets := make([]*types.CovenantQuorumHealthBucket, 0, len(numDelsByMissingSigs))
+	for missingSigs, numDels := range numDelsByMissingSigs {
+		buckets = append(buckets, &types.CovenantQuorumHealthBucket{
+			MissingSigs:    missingSigs,
+			NumDelegations: numDels,
+		})
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		return buckets[i].MissingSigs < buckets[j].MissingSigs
+	})
+
+	return &types.QueryCovenantQuorumHealthResponse{
+		CovenantQuorum:        covenantQuorum,
+		NumPendingDelegations: numPendingDels,
+		Buckets:               buckets,
+	}, nil
+}
+
+// DelegationsWithStaleParams returns the BTC delegations whose snapshotted
+// parameters differ from the current ones. Delegations whose params version is
+// older than the current one but whose parameters did not change in between are
+// not considered stale
+func (k Keeper) DelegationsWithStaleParams(ctx context.Context, req *types.QueryDelegationsWithStaleParamsRequest) (*types.QueryDelegationsWithStaleParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	curParams := k.GetParamsWithVersion(ctx)
+	curParamsBytes := k.cdc.MustMarshal(&curParams.Params)
+	covenantQuorum := curParams.Params.CovenantQuorum
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	// cache whether each params version differs from the current params
+	staleVersions := map[uint32]bool{curParams.Version: false}
+	isStale := func(version uint32) bool {
+		stale, ok := staleVersions[version]
+		if !ok {
+			p := k.GetParamsByVersion(ctx, version)
+			stale = p == nil || !bytes.Equal(k.cdc.MustMarshal(p), curParamsBytes)
+			staleVersions[version] = stale
+		}
+		return stale
+	}
+
+	store := k.btcDelegationStore(ctx)
+	var btcDels []*types.BTCDelegationResponse
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var btcDel types.BTCDelegation
+		k.cdc.MustUnmarshal(value, &btcDel)
+
+		if !isStale(btcDel.ParamsVersion) {
+			return false, nil
+		}
+		if accumulate {
+			status := btcDel.GetStatus(btcTipHeight, wValue, covenantQuorum)
+			btcDels = append(btcDels, types.NewBTCDelegationResponse(&btcDel, status))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegationsWithStaleParamsResponse{
+		CurrentParamsVersion: curParams.Version,
+		BtcDelegations:       btcDels,
+		Pagination:           pageRes,
+	}, nil
+}
+
+// FinalityProviderPoP returns the proof of possession of the given finality
+// provider, which allows re-verifying the link between its Babylon PK and BTC PK
+func (k Keeper) FinalityProviderPoP(ctx context.Context, req *types.QueryFinalityProviderPoPRequest) (*types.QueryFinalityProviderPoPResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.FpBtcPkHex) == 0 {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "finality provider BTC public key cannot be empty")
+	}
+
+	fpPK, err := bbn.NewBIP340PubKeyFromHex(req.FpBtcPkHex)
+	if err != nil {
+		return nil, err
+	}
+
+	fp, err := k.GetFinalityProvider(ctx, fpPK.MustMarshal())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFinalityProviderPoPResponse{
+		BabylonPk: fp.BabylonPk,
+		BtcPk:     fp.BtcPk,
+		Pop:       fp.Pop,
+	}, nil
+}
+
+// BTCDelegationPoP returns the proof of possession of the given BTC
+// delegation, which allows re-verifying the link between the delegator's
+// Babylon PK and BTC PK
+func (k Keeper) BTCDelegationPoP(ctx context.Context, req *types.QueryBTCDelegationPoPRequest) (*types.QueryBTCDelegationPoPResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	stakingTxHash, err := chainhash.NewHashFromStr(req.StakingTxHashHex)
+	if err != nil {
+		return nil, err
+	}
+
+	btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+	if btcDel == nil {
+		return nil, types.ErrBTCDelegationNotFound
+	}
+
+	return &types.QueryBTCDelegationPoPResponse{
+		BabylonPk: btcDel.BabylonPk,
+		BtcPk:     btcDel.BtcPk,
+		Pop:       btcDel.Pop,
+	}, nil
+}
+
+// FinalityProviderPowerAfterUndelegation returns the current voting power of the
+// given finality provider, and the voting power it would have if the given BTC
+// delegation unbonded. The finality provider has zero voting power if it is not
+// in the active set at the current height
+func (k Keeper) FinalityProviderPowerAfterUndelegation(ctx context.Context, req *types.QueryFinalityProviderPowerAfterUndelegationRequest) (*types.QueryFinalityProviderPowerAfterUndelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	fpPK, err := bbn.NewBIP340PubKeyFromHex(req.FpBtcPkHex)
+	if err != nil {
+		return nil, err
+	}
+	if !k.HasFinalityProvider(ctx, fpPK.MustMarshal()) {
+		return nil, types.ErrFpNotFound
+	}
+
+	stakingTxHash, err := chainhash.NewHashFromStr(req.StakingTxHashHex)
+	if err != nil {
+		return nil, err
+	}
+	btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+	if btcDel == nil {
+		return nil, types.ErrBTCDelegationNotFound
+	}
+	if btcDel.GetFpIdx(fpPK) < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "BTC delegation %s is not restaked to finality provider %s",
+			req.StakingTxHashHex, req.FpBtcPkHex)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	votingPower := k.GetVotingPower(ctx, fpPK.MustMarshal(), uint64(sdkCtx.HeaderInfo().Height))
+
+	// the BTC delegation contributes to the voting power only if the finality
+	// provider is in the active set
+	delVotingPower := uint64(0)
+	if votingPower > 0 {
+		delVotingPower = btcDel.VotingPower(
+			k.btclcKeeper.GetTipInfo(ctx).Height,
+			k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout,
+			k.GetParams(ctx).CovenantQuorum,
+		)
+		delVotingPower = min(delVotingPower, votingPower)
+	}
+
+	return &types.QueryFinalityProviderPowerAfterUndelegationResponse{
+		VotingPower:                  votingPower,
+		DelegationVotingPower:        delVotingPower,
+		VotingPowerAfterUndelegation: votingPower - delVotingPower,
+	}, nil
+}
+
+// TotalVotingPowerAtHeight returns the total voting power of all active finality
+// providers at the given height, reconstructed from the voting power table
+func (k Keeper) TotalVotingPowerAtHeight(ctx context.Context, req *types.QueryTotalVotingPowerAtHeightRequest) (*types.QueryTotalVotingPowerAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !k.HasVotingPowerTable(ctx, req.Height) {
+		return nil, types.ErrVotingPowerTableNotUpdated.Wrapf("height: %d", req.Height)
+	}
+
+	power, numFPs := k.GetTotalVotingPower(ctx, req.Height)
+
+	return &types.QueryTotalVotingPowerAtHeightResponse{
+		VotingPower:          power,
+		NumFinalityProviders: numFPs,
+	}, nil
+}
+
+// BTCDelegationScripts returns the taproot scripts of a BTC delegation that the
+// given finality provider participates in. For BTC delegations restaked to
+// multiple finality providers, the scripts embed the keys of all of them, so
+// these scripts have to be used for slashing or unbonding the BTC delegation
+func (k Keeper) BTCDelegationScripts(ctx context.Context, req *types.QueryBTCDelegationScriptsRequest) (*types.QueryBTCDelegationScriptsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	fpPK, err := bbn.NewBIP340PubKeyFromHex(req.FpBtcPkHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to unmarshal finality provider BTC PK hex: %v", err)
+	}
+	stakingTxHash, err := chainhash.NewHashFromStr(req.StakingTxHashHex)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// find BTC delegation and the params it was validated against
+	btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+	if btcDel == nil {
+		return nil, types.ErrBTCDelegationNotFound
+	}
+	fpIdx := btcDel.GetFpIdx(fpPK)
+	if fpIdx < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "finality provider %s is not a member of the BTC delegation %s", req.FpBtcPkHex, req.StakingTxHashHex)
+	}
+	params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+	if params == nil {
+		panic("params version in BTC delegation is not found")
+	}
+
+	resp, err := k.btcDelegationScripts(btcDel, params)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to build scripts of the BTC delegation: %v", err)
+	}
+	resp.FpIdx = uint32(fpIdx)
+
+	return resp, nil
+}
+
+func (k Keeper) btcDelegationScripts(btcDel *types.BTCDelegation, params *types.Params) (*types.QueryBTCDelegationScriptsResponse, error) {
+	stakingInfo, err := btcDel.GetStakingInfo(params, k.btcNet)
+	if err != nil {
+		return nil, err
+	}
+	stakingSlashingSpendInfo, err := stakingInfo.SlashingPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+	stakingSlashingPath, err := types.NewTaprootScriptPath(stakingSlashingSpendInfo)
+	if err != nil {
+		return nil, err
+	}
+	stakingUnbondingSpendInfo, err := stakingInfo.UnbondingPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+	stakingUnbondingPath, err := types.NewTaprootScriptPath(stakingUnbondingSpendInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	unbondingInfo, err := btcDel.GetUnbondingInfo(params, k.btcNet)
+	if err != nil {
+		return nil, err
+	}
+	unbondingSlashingSpendInfo, err := unbondingInfo.SlashingPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+	unbondingSlashingPath, err := types.NewTaprootScriptPath(unbondingSlashingSpendInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBTCDelegationScriptsResponse{
+		FpBtcPkList:             btcDel.FpBtcPkList,
+		StakingOutputPkScript:   stakingInfo.GetPkScript(),
+		StakingSlashingPath:     stakingSlashingPath,
+		StakingUnbondingPath:    stakingUnbondingPath,
+		UnbondingOutputPkScript: unbondingInfo.UnbondingOutput.PkScript,
+		UnbondingSlashingPath:   unbondingSlashingPath,
+	}, nil
+}
+
+// VerifyDelegatorSlashingSig re-verifies the delegator's signature on the
+// slashing tx of the given BTC delegation against the slashing path script
+// reconstructed from the BTC delegation and the params it was validated against
+func (k Keeper) VerifyDelegatorSlashingSig(ctx context.Context, req *types.QueryVerifyDelegatorSlashingSigRequest) (*types.QueryVerifyDelegatorSlashingSigResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	stakingTxHash, err := chainhash.NewHashFromStr(req.StakingTxHashHex)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// find BTC delegation and the params it was validated against
+	btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+	if btcDel == nil {
+		return nil, types.ErrBTCDelegationNotFound
+	}
+	params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+	if params == nil {
+		panic("params version in BTC delegation is not found")
+	}
+
+	if err := k.verifyDelegatorSlashingSig(btcDel, params); err != nil {
+		return &types.QueryVerifyDelegatorSlashingSigResponse{InvalidReason: err.Error()}, nil
+	}
+
+	return &types.QueryVerifyDelegatorSlashingSigResponse{Valid: true}, nil
+}
+
+func (k Keeper) verifyDelegatorSlashingSig(btcDel *types.BTCDelegation, params *types.Params) error {
+	if btcDel.SlashingTx == nil || btcDel.DelegatorSig == nil {
+		return types.ErrInvalidSlashingTx.Wrap("the BTC delegation does not have a slashing tx signed by the delegator")
+	}
+	stakingInfo, err := btcDel.GetStakingInfo(params, k.btcNet)
+	if err != nil {
+		return err
+	}
+	slashingSpendInfo, err := stakingInfo.SlashingPathSpendInfo()
+	if err != nil {
+		return err
+	}
+	err = btcDel.SlashingTx.VerifySignature(
+		stakingInfo.StakingOutput,
+		slashingSpendInfo.GetPkScriptPath(),
+		btcDel.BtcPk.MustToBTCPK(),
+		btcDel.DelegatorSig,
+	)
+	if err != nil {
+		return types.ErrInvalidSlashingTx.Wrapf("invalid delegator signature: %v", err)
+	}
+	return nil
+}
+
+// ReverifyInclusionProof re-verifies the inclusion proof of the staking tx of
+// the given BTC delegation against the current BTC light client state, i.e.,
+// whether the BTC block including the staking tx is still in the main chain
+// at the expected depth and the proof still holds. This is useful after reorgs.
+// BTC delegations without a recorded inclusion proof cannot be re-verified
+func (k Keeper) ReverifyInclusionProof(ctx context.Context, req *types.QueryReverifyInclusionProofRequest) (*types.QueryReverifyInclusionProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	stakingTxHash, err := chainhash.NewHashFromStr(req.StakingTxHashHex)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+	if btcDel == nil {
+		return nil, types.ErrBTCDelegationNotFound
+	}
+	// BTC delegations created before the inclusion proof was recorded, or
+	// reserved ones whose staking tx is not included yet, have nothing to
+	// re-verify, which is different from a proof that no longer holds
+	if btcDel.StakingTxKey == nil || len(btcDel.StakingTxInclusionProof) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "the inclusion proof of the staking tx is not recorded")
+	}
+
+	depth, err := k.reverifyInclusionProof(ctx, btcDel)
+	if err != nil {
+		return &types.QueryReverifyInclusionProofResponse{InvalidReason: err.Error(), Depth: depth}, nil
+	}
+
+	return &types.QueryReverifyInclusionProofResponse{Valid: true, Depth: depth}, nil
+}
+
+// reverifyInclusionProof re-verifies the inclusion proof of the staking tx of
+// the given BTC delegation, and returns the depth of the BTC block including
+// the staking tx if the block is still in the main chain. The inclusion proof
+// of the staking tx has to be recorded
+func (k Keeper) reverifyInclusionProof(ctx context.Context, btcDel *types.BTCDelegation) (uint64, error) {
+	// the BTC light client only keeps headers in the main chain
+	stakingTxHeader := k.btclcKeeper.GetHeaderByHash(ctx, btcDel.StakingTxKey.Hash)
+	if stakingTxHeader == nil {
+		return 0, types.ErrInvalidStakingTx.Wrap("the BTC block including the staking tx is no longer in the main chain")
+	}
+	if stakingTxHeader.Height != btcDel.StartHeight {
+		return 0, types.ErrInvalidStakingTx.Wrapf("the BTC block including the staking tx is at height %d rather than %d", stakingTxHeader.Height, btcDel.StartHeight)
+	}
+
+	// ensure staking tx is still k-deep
+	kValue := btcDel.BtcConfirmationDepth
+	if kValue == 0 {
+		kValue = k.btccKeeper.GetParams(ctx).BtcConfirmationDepth
+	}
+	depth := k.btclcKeeper.GetTipInfo(ctx).Height - stakingTxHeader.Height
+	if depth < kValue {
+		return depth, types.ErrInvalidStakingTx.Wrapf("not k-deep: k=%d; depth=%d", kValue, depth)
+	}
+
+	stakingTxInfo := btcctypes.NewTransactionInfo(btcDel.StakingTxKey, btcDel.StakingTx, btcDel.StakingTxInclusionProof)
+	if err := stakingTxInfo.VerifyInclusion(stakingTxHeader.Header, k.btccKeeper.GetPowLimit()); err != nil {
+		return depth, types.ErrInvalidStakingTx.Wrapf("not included in the Bitcoin chain: %v", err)
+	}
+
+	return depth, nil
+}
+
+// CovenantSigningBatch returns, for each of the given BTC delegations, the txs
+// that covenant members sign and the script paths they sign against, such
+// that covenant members do not need a round-trip per BTC delegation
+func (k Keeper) CovenantSigningBatch(ctx context.Context, req *types.QueryCovenantSigningBatchRequest) (*types.QueryCovenantSigningBatchResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.StakingTxHashHexList) > MaxCovenantSigningBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "the batch contains %d BTC delegations, more than the maximum %d", len(req.StakingTxHashHexList), MaxCovenantSigningBatchSize)
+	}
+
+	signingInfos := make([]*types.CovenantSigningInfo, 0, len(req.StakingTxHashHexList))
+	for _, stakingTxHashHex := range req.StakingTxHashHexList {
+		stakingTxHash, err := chainhash.NewHashFromStr(stakingTxHashHex)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		// find BTC delegation and the params it was validated against
+		btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+		if btcDel == nil {
+			return nil, types.ErrBTCDelegationNotFound.Wrapf("staking tx hash: %s", stakingTxHashHex)
+		}
+		params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+		if params == nil {
+			panic("params version in BTC delegation is not found")
+		}
+
+		scripts, err := k.btcDelegationScripts(btcDel, params)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to build scripts of the BTC delegation %s: %v", stakingTxHashHex, err)
+		}
+		signingInfos = append(signingInfos, &types.CovenantSigningInfo{
+			StakingTxHashHex:      stakingTxHashHex,
+			FpBtcPkList:           btcDel.FpBtcPkList,
+			StakingTx:             btcDel.StakingTx,
+			StakingOutputIdx:      btcDel.StakingOutputIdx,
+			SlashingTx:            btcDel.SlashingTx,
+			StakingSlashingPath:   scripts.StakingSlashingPath,
+			UnbondingTx:           btcDel.BtcUndelegation.UnbondingTx,
+			StakingUnbondingPath:  scripts.StakingUnbondingPath,
+			UnbondingSlashingTx:   btcDel.BtcUndelegation.SlashingTx,
+			UnbondingSlashingPath: scripts.UnbondingSlashingPath,
+		})
+	}
+
+	return &types.QueryCovenantSigningBatchResponse{SigningInfos: signingInfos}, nil
+}
+
+// UnbondingCovenantProgress returns how many covenant signatures on the
+// unbonding tx and the unbonding slashing tx of the given BTC delegation have
+// been collected, against the covenant committee the BTC delegation was
+// validated with
+func (k Keeper) UnbondingCovenantProgress(ctx context.Context, req *types.QueryUnbondingCovenantProgressRequest) (*types.QueryUnbondingCovenantProgressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	stakingTxHash, err := chainhash.NewHashFromStr(req.StakingTxHashHex)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// find BTC delegation and the params it was validated against
+	btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+	if btcDel == nil {
+		return nil, types.ErrBTCDelegationNotFound.Wrapf("staking tx hash: %s", req.StakingTxHashHex)
+	}
+	params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+	if params == nil {
+		panic("params version in BTC delegation is not found")
+	}
+
+	btcUndel := btcDel.BtcUndelegation
+	pendingCovPKs := []bbn.BIP340PubKey{}
+	for i := range params.CovenantPks {
+		covPK := params.CovenantPks[i]
+		if !btcUndel.IsSignedByCovMember(&covPK) {
+			pendingCovPKs = append(pendingCovPKs, covPK)
+		}
+	}
+
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+	return &types.QueryUnbondingCovenantProgressResponse{
+		Status:                           btcDel.GetStatus(k.btclcKeeper.GetTipInfo(ctx).Height, wValue, params.CovenantQuorum),
+		DelegatorUnbondingSigSubmitted:   btcUndel.DelegatorUnbondingSig != nil,
+		CovenantQuorum:                   params.CovenantQuorum,
+		NumCovenantUnbondingSigs:         uint32(len(btcUndel.CovenantUnbondingSigList)),
+		NumCovenantUnbondingSlashingSigs: uint32(len(btcUndel.CovenantSlashingSigs)),
+		HasCovenantQuorums:               btcUndel.HasCovenantQuorums(params.CovenantQuorum),
+		PendingCovenantPks:               pendingCovPKs,
+	}, nil
+}
+
+// DelegationSpendTree returns the full taproot script tree of the staking
+// output of the given BTC delegation, reconstructed via BuildStakingInfo from
+// the BTC delegation and the params it was validated against
+func (k Keeper) DelegationSpendTree(ctx context.Context, req *types.QueryDelegationSpendTreeRequest) (*types.QueryDelegationSpendTreeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	stakingTxHash, err := chainhash.NewHashFromStr(req.StakingTxHashHex)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// find BTC delegation and the params it was validated against
+	btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+	if btcDel == nil {
+		return nil, types.ErrBTCDelegationNotFound.Wrapf("staking tx hash: %s", req.StakingTxHashHex)
+	}
+	params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+	if params == nil {
+		panic("params version in BTC delegation is not found")
+	}
+
+	resp, err := k.delegationSpendTree(btcDel, params)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to build spend tree of the BTC delegation: %v", err)
+	}
+	return resp, nil
+}
+
+func (k Keeper) delegationSpendTree(btcDel *types.BTCDelegation, params *types.Params) (*types.QueryDelegationSpendTreeResponse, error) {
+	stakingInfo, err := btcDel.GetStakingInfo(params, k.btcNet)
+	if err != nil {
+		return nil, err
+	}
+
+	spendInfoGetters := []func() (*btcstaking.SpendInfo, error){
+		stakingInfo.TimeLockPathSpendInfo,
+		stakingInfo.UnbondingPathSpendInfo,
+		stakingInfo.SlashingPathSpendInfo,
+	}
+	leaves := make([]*types.TaprootTreeLeaf, 0, len(spendInfoGetters))
+	for _, getSpendInfo := range spendInfoGetters {
+		spendInfo, err := getSpendInfo()
+		if err != nil {
+			return nil, err
+		}
+		leafIdx, err := stakingInfo.LeafIndex(spendInfo)
+		if err != nil {
+			return nil, err
+		}
+		leaf, err := types.NewTaprootTreeLeaf(leafIdx, spendInfo)
+		if err != nil {
+			return nil, err
+		}
+		leaves = append(leaves, leaf)
+	}
+
+	return &types.QueryDelegationSpendTreeResponse{
+		InternalKey:           schnorr.SerializePubKey(stakingInfo.InternalKey()),
+		StakingOutputPkScript: stakingInfo.GetPkScript(),
+		TimelockLeaf:          leaves[0],
+		UnbondingLeaf:         leaves[1],
+		SlashingLeaf:          leaves[2],
+	}, nil
+}
+
+// VotingPowerTableDiscrepancies recomputes the latest voting power table from
+// scratch over all BTC delegations, and returns the finality providers whose
+// voting power in the stored voting power table differs from the recomputed
+// one. Unlike ReconcileVotingPowerTable, it does not fix the stored table
+func (k Keeper) VotingPowerTableDiscrepancies(ctx context.Context, req *types.QueryVotingPowerTableDiscrepanciesRequest) (*types.QueryVotingPowerTableDiscrepanciesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	height, ok := k.getLastVotingPowerTableHeight(ctx)
+	if !ok {
+		return nil, types.ErrBTCStakingNotActivated
+	}
+	discrepancies, _ := k.findVotingPowerTableDiscrepancies(ctx, height)
+
+	return &types.QueryVotingPowerTableDiscrepanciesResponse{
+		Height:        height,
+		Discrepancies: discrepancies,
+	}, nil
+}
+
+// FinalityProviderSlashingImpact returns the BTC delegations that would be
+// slashed if the given finality provider is slashed at the current BTC tip,
+// together with their total stake and the total amount that their slashing
+// txs send to the slashing addresses
+func (k Keeper) FinalityProviderSlashingImpact(ctx context.Context, req *types.QueryFinalityProviderSlashingImpactRequest) (*types.QueryFinalityProviderSlashingImpactResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	fpPK, err := bbn.NewBIP340PubKeyFromHex(req.FpBtcPkHex)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if !k.HasFinalityProvider(ctx, fpPK.MustMarshal()) {
+		return nil, types.ErrFpNotFound
+	}
+
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+	covenantQuorum := k.GetParams(ctx).CovenantQuorum
+
+	resp := &types.QueryFinalityProviderSlashingImpactResponse{
+		BtcDelegations: []*types.BTCDelegationResponse{},
+	}
+	for _, btcDel := range k.getSlashableBTCDelegations(ctx, fpPK, btcTipHeight) {
+		status := btcDel.GetStatus(btcTipHeight, wValue, covenantQuorum)
+		resp.BtcDelegations = append(resp.BtcDelegations, types.NewBTCDelegationResponse(btcDel, status))
+		resp.TotalSat += btcDel.TotalSat
+		// early unbonded BTC delegations are slashed via the slashing tx of
+		// the unbonding output
+		if btcDel.IsUnbondedEarly() {
+			resp.TotalSlashingAmount += btcDel.BtcUndelegation.SlashingTx.MustGetSlashingAmount()
+		} else {
+			resp.TotalSlashingAmount += btcDel.SlashingTx.MustGetSlashingAmount()
+		}
+	}
+
+	return resp, nil
+}
+
+// VerifyCovenantQuorumSpend checks whether a valid spend of the staking output
+// of the given BTC delegation via the given covenant path can currently be
+// constructed from the signatures stored in the BTC delegation
+func (k Keeper) VerifyCovenantQuorumSpend(ctx context.Context, req *types.QueryVerifyCovenantQuorumSpendRequest) (*types.QueryVerifyCovenantQuorumSpendResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	stakingTxHash, err := chainhash.NewHashFromStr(req.StakingTxHashHex)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// find BTC delegation and the params it was validated against
+	btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+	if btcDel == nil {
+		return nil, types.ErrBTCDelegationNotFound.Wrapf("staking tx hash: %s", req.StakingTxHashHex)
+	}
+	params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+	if params == nil {
+		panic("params version in BTC delegation is not found")
+	}
+
+	var resp *types.QueryVerifyCovenantQuorumSpendResponse
+	switch req.Path {
+	case types.CovenantSpendPath_UNBONDING:
+		resp, err = k.verifyCovenantQuorumUnbondingSpend(btcDel, params)
+	case types.CovenantSpendPath_SLASHING:
+		btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+		resp, err = k.verifyCovenantQuorumSlashingSpend(btcDel, params, btcTipHeight)
+	case types.CovenantSpendPath_UNBONDING_SLASHING:
+		return nil, status.Error(codes.InvalidArgument, "only the spend paths of the staking output can be verified")
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown covenant spend path: %d", req.Path)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to verify covenant quorum spend of the BTC delegation: %v", err)
+	}
+	return resp, nil
+}
+
+// verifyCovenantQuorumUnbondingSpend assembles the witness of the unbonding tx
+// from the stored covenant unbonding signatures and the delegator's unbonding
+// signature, and executes it against the unbonding leaf of the staking output
+func (k Keeper) verifyCovenantQuorumUnbondingSpend(btcDel *types.BTCDelegation, params *types.Params) (*types.QueryVerifyCovenantQuorumSpendResponse, error) {
+	resp := &types.QueryVerifyCovenantQuorumSpendResponse{
+		CovenantQuorum: params.CovenantQuorum,
+	}
+	if btcDel.BtcUndelegation == nil {
+		resp.Reason = "the BTC delegation does not have an unbonding tx"
+		return resp, nil
+	}
+
+	stakingInfo, err := btcDel.GetStakingInfo(params, k.btcNet)
+	if err != nil {
+		return nil, err
+	}
+	unbondingSpendInfo, err := stakingInfo.UnbondingPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+	unbondingTx, err := bbn.NewBTCTxFromBytes(btcDel.BtcUndelegation.UnbondingTx)
+	if err != nil {
+		return nil, err
+	}
+
+	// collect covenant signatures on the unbonding tx that are valid and
+	// signed by a member of the covenant committee
+	validCovSigs := map[string]*schnorr.Signature{}
+	for _, covSig := range btcDel.BtcUndelegation.CovenantUnbondingSigList {
+		if !params.HasCovenantPK(covSig.Pk) {
+			continue
+		}
+		err := btcstaking.VerifyTransactionSigWithOutput(
+			unbondingTx,
+			stakingInfo.StakingOutput,
+			unbondingSpendInfo.GetPkScriptPath(),
+			covSig.Pk.MustToBTCPK(),
+			*covSig.Sig,
+		)
+		if err != nil {
+			continue
+		}
+		validCovSigs[covSig.Pk.MarshalHex()] = covSig.Sig.MustToBTCSig()
+	}
+	resp.NumValidCovenantSigs = uint32(len(validCovSigs))
+
+	if resp.NumValidCovenantSigs < params.CovenantQuorum {
+		resp.Reason = fmt.Sprintf("only %d out of %d required covenant signatures on the unbonding tx are valid", resp.NumValidCovenantSigs, params.CovenantQuorum)
+		return resp, nil
+	}
+	if btcDel.BtcUndelegation.DelegatorUnbondingSig == nil {
+		resp.Reason = "the delegator has not signed the unbonding tx"
+		return resp, nil
+	}
+
+	err = k.executeUnbondingPathWitness(
+		btcDel,
+		params,
+		validCovSigs,
+		btcDel.BtcUndelegation.DelegatorUnbondingSig.MustToBTCSig(),
+	)
+	if err != nil {
+		resp.Reason = fmt.Sprintf("the assembled witness of the unbonding tx fails script execution: %v", err)
+		return resp, nil
+	}
+
+	resp.Spendable = true
+	return resp, nil
+}
+
+// verifyCovenantQuorumSlashingSpend verifies the stored covenant adaptor
+// signatures and the delegator's signature on the slashing tx. Covenant
+// adaptor signatures can only be decrypted with the secret key of a restaked
+// finality provider. Once the finality provider is slashed, its secret key is
+// extractable from the evidence of its equivocation via Evidence.ExtractBTCSK,
+// so that, as in IsSpendableVia, the slashing path is spendable once a
+// restaked finality provider is slashed and a covenant quorum of adaptor
+// signatures is valid
+func (k Keeper) verifyCovenantQuorumSlashingSpend(btcDel *types.BTCDelegation, params *types.Params, btcTipHeight uint64) (*types.QueryVerifyCovenantQuorumSpendResponse, error) {
+	resp := &types.QueryVerifyCovenantQuorumSpendResponse{
+		CovenantQuorum: params.CovenantQuorum,
+	}
+
+	stakingInfo, err := btcDel.GetStakingInfo(params, k.btcNet)
+	if err != nil {
+		return nil, err
+	}
+	slashingSpendInfo, err := stakingInfo.SlashingPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	// count covenant members whose adaptor signatures on the slashing tx are
+	// valid under every restaked finality provider
+	for _, covSigs := range btcDel.CovenantSigs {
+		if !params.HasCovenantPK(covSigs.CovPk) || len(covSigs.AdaptorSigs) != len(btcDel.FpBtcPkList) {
+			continue
+		}
+		err := btcDel.SlashingTx.EncVerifyAdaptorSignatures(
+			stakingInfo.StakingOutput,
+			slashingSpendInfo,
+			covSigs.CovPk,
+			btcDel.FpBtcPkList,
+			covSigs.AdaptorSigs,
+		)
+		if err != nil {
+			continue
+		}
+		resp.NumValidCovenantSigs++
+	}
+
+	if resp.NumValidCovenantSigs < params.CovenantQuorum {
+		resp.Reason = fmt.Sprintf("only %d out of %d required covenant adaptor signatures on the slashing tx are valid", resp.NumValidCovenantSigs, params.CovenantQuorum)
+		return resp, nil
+	}
+	err = btcDel.SlashingTx.VerifySignature(
+		stakingInfo.StakingOutput,
+		slashingSpendInfo.GetPkScriptPath(),
+		btcDel.BtcPk.MustToBTCPK(),
+		btcDel.DelegatorSig,
+	)
+	if err != nil {
+		resp.Reason = fmt.Sprintf("the delegator's signature on the slashing tx is invalid: %v", err)
+		return resp, nil
+	}
+
+	if !btcDel.IsSlashable(btcTipHeight) {
+		resp.Reason = "no restaked finality provider is slashed, so the covenant adaptor signatures on the slashing tx cannot be decrypted"
+		return resp, nil
+	}
+	if !btcDel.IsSpendableVia(types.StakingOutputSpendPath_SLASHING_PATH, btcTipHeight, params.CovenantQuorum) {
+		resp.Reason = "the staking output of the BTC delegation is not spendable via the slashing path, e.g., as it has been unbonded early"
+		return resp, nil
+	}
+
+	resp.Spendable = true
+	return resp, nil
+}
+
+// CovenantSignMsg returns the message that the given covenant member has to
+// sign for the given BTC delegation via the given covenant path. It is the
+// same tapscript sighash that AddCovenantSigs verifies the signatures against
+func (k Keeper) CovenantSignMsg(ctx context.Context, req *types.QueryCovenantSignMsgRequest) (*types.QueryCovenantSignMsgResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	stakingTxHash, err := chainhash.NewHashFromStr(req.StakingTxHashHex)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	covPK, err := bbn.NewBIP340PubKeyFromHex(req.CovenantPkHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid covenant PK: %v", err)
+	}
+
+	// find BTC delegation and the params it was validated against
+	btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+	if btcDel == nil {
+		return nil, types.ErrBTCDelegationNotFound.Wrapf("staking tx hash: %s", req.StakingTxHashHex)
+	}
+	params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+	if params == nil {
+		panic("params version in BTC delegation is not found")
+	}
+	if !params.HasCovenantPK(covPK) {
+		return nil, types.ErrInvalidCovenantPK.Wrapf("covenant pk: %s", req.CovenantPkHex)
+	}
+
+	resp, err := k.covenantSignMsg(btcDel, params, req.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	// the covenant PKs are sorted in the multisig of the covenant committee
+	covenantPKs, err := bbn.NewBTCPKsFromBIP340PKs(params.CovenantPks)
+	if err != nil {
+		panic(fmt.Errorf("failed to parse covenant PKs in KVStore: %w", err))
+	}
+	for i, pk := range btcstaking.SortKeys(covenantPKs) {
+		if bbn.NewBIP340PubKeyFromBTCPK(pk).Equals(covPK) {
+			resp.CovenantKeyIndex = uint32(i)
+			break
+		}
+	}
+
+	return resp, nil
+}
+
+// covenantSignMsg builds the signing tx, the script path and the sighash of
+// the given covenant path of the given BTC delegation
+func (k Keeper) covenantSignMsg(btcDel *types.BTCDelegation, params *types.Params, path types.CovenantSpendPath) (*types.QueryCovenantSignMsgResponse, error) {
+	stakingInfo, err := btcDel.GetStakingInfo(params, k.btcNet)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to build staking info of the BTC delegation: %v", err)
+	}
+	unbondingMsgTx, err := bbn.NewBTCTxFromBytes(btcDel.BtcUndelegation.UnbondingTx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to parse unbonding tx of the BTC delegation: %v", err)
+	}
+
+	resp := &types.QueryCovenantSignMsgResponse{}
+	var (
+		signingTx  []byte
+		fundingOut = stakingInfo.StakingOutput
+		spendInfo  *btcstaking.SpendInfo
+	)
+	switch path {
+	case types.CovenantSpendPath_UNBONDING:
+		signingTx = btcDel.BtcUndelegation.UnbondingTx
+		spendInfo, err = stakingInfo.UnbondingPathSpendInfo()
+	case types.CovenantSpendPath_SLASHING:
+		signingTx = *btcDel.SlashingTx
+		spendInfo, err = stakingInfo.SlashingPathSpendInfo()
+		resp.EncKeyList = btcDel.FpBtcPkList
+	case types.CovenantSpendPath_UNBONDING_SLASHING:
+		// unbonding tx always has only one output
+		signingTx = *btcDel.BtcUndelegation.SlashingTx
+		fundingOut = unbondingMsgTx.TxOut[0]
+		var unbondingInfo *btcstaking.UnbondingInfo
+		unbondingInfo, err = btcDel.GetUnbondingInfo(params, k.btcNet)
+		if err == nil {
+			spendInfo, err = unbondingInfo.SlashingPathSpendInfo()
+		}
+		resp.EncKeyList = btcDel.FpBtcPkList
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown covenant spend path: %d", path)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to build the script path: %v", err)
+	}
+
+	signingMsgTx, err := bbn.NewBTCTxFromBytes(signingTx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to parse the signing tx: %v", err)
+	}
+	sigHash, err := btcstaking.CalcTapscriptSigHash(signingMsgTx, fundingOut, spendInfo.GetPkScriptPath())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to compute the sighash: %v", err)
+	}
+	scriptPath, err := types.NewTaprootScriptPath(spendInfo)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to build the script path: %v", err)
+	}
+	leafHash := spendInfo.RevealedLeaf.TapHash()
+
+	resp.SigHash = sigHash
+	resp.SigningTx = signingTx
+	resp.ScriptPath = scriptPath
+	resp.LeafHash = leafHash[:]
+	return resp, nil
+}
+
+// DelegationFirstRewardHeight returns the Babylon height at which the given
+// BTC delegation first earns a non-zero reward. A BTC delegation enters the
+// voting power distribution at the block after it becomes active, is rewarded
+// when that block is finalized, and then earns nothing in the epoch of its
+// first reward distribution if the incentive module locks up its rewards
+func (k Keeper) DelegationFirstRewardHeight(ctx context.Context, req *types.QueryDelegationFirstRewardHeightRequest) (*types.QueryDelegationFirstRewardHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	stakingTxHash, err := chainhash.NewHashFromStr(req.StakingTxHashHex)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+	if btcDel == nil {
+		return nil, types.ErrBTCDelegationNotFound
+	}
+	if btcDel.RewardOptOut {
+		return nil, status.Error(codes.FailedPrecondition, "the BTC delegation opts out of rewards")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	curHeight := uint64(sdkCtx.HeaderInfo().Height)
+	btccParams := k.btccKeeper.GetParams(ctx)
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	params := k.GetParams(ctx)
+	delStatus := btcDel.GetStatus(btcTipHeight, btccParams.CheckpointFinalizationTimeout, params.CovenantQuorum)
+	if delStatus == types.BTCDelegationStatus_UNBONDED || delStatus == types.BTCDelegationStatus_INVALIDATED {
+		return nil, status.Errorf(codes.FailedPrecondition, "the BTC delegation is %s and will not earn rewards", delStatus.String())
+	}
+	if delStatus == types.BTCDelegationStatus_RESERVED {
+		return nil, status.Errorf(codes.FailedPrecondition, "the BTC delegation is reserved and its staking tx is not included in BTC yet")
+	}
+
+	epoch := k.ckptKeeper.GetEpoch(ctx)
+	resp := &types.QueryDelegationFirstRewardHeightResponse{
+		RewardLockupEpochs: k.iKeeper.GetRewardLockupEpochs(ctx),
+	}
+
+	startEpoch, lockedUp, rewarded := k.iKeeper.GetBTCDelRewardLockup(ctx, stakingTxHash)
+	if rewarded && !lockedUp {
+		// the BTC delegation received rewards while there was no reward
+		// lockup, so no start epoch is recorded and it already earns rewards
+		resp.Earning = true
+		resp.FirstRewardHeight = curHeight
+		return resp, nil
+	}
+	if lockedUp {
+		// the BTC delegation has already received a reward distribution
+		resp.RewardStartEpoch = startEpoch
+	} else {
+		switch {
+		case k.isInActiveDistCache(ctx, curHeight, btcDel):
+			resp.ActivationHeight = curHeight
+		case delStatus == types.BTCDelegationStatus_ACTIVE:
+			// an active BTC delegation enters the voting power distribution
+			// at the next BeginBlock
+			resp.ActivationHeight = curHeight + 1
+		default:
+			// the covenant quorum can be reached in the next block at the
+			// earliest, after which the BTC delegation becomes active
+			resp.AwaitingCovenantQuorum = true
+			resp.ActivationHeight = curHeight + 2
+		}
+		// finality signatures over a block can only be included in later
+		// blocks, so a block is finalized at the next height at the earliest
+		resp.FinalizationHeight = resp.ActivationHeight + 1
+		resp.RewardStartEpoch = epochOfHeight(epoch, resp.FinalizationHeight)
+	}
+
+	// the BTC delegation receives nothing in the epoch of its first reward
+	// distribution if rewards are locked up
+	firstRewardEpoch := resp.RewardStartEpoch
+	if resp.RewardLockupEpochs > 0 {
+		firstRewardEpoch++
+	}
+	switch {
+	case firstRewardEpoch > epoch.EpochNumber:
+		resp.FirstRewardHeight = firstHeightOfEpoch(epoch, firstRewardEpoch)
+		if resp.FinalizationHeight > resp.FirstRewardHeight {
+			resp.FirstRewardHeight = resp.FinalizationHeight
+		}
+	case rewarded:
+		resp.Earning = true
+		resp.FirstRewardHeight = curHeight
+	default:
+		// without lockup, the first reward distribution in the current epoch
+		// is already non-zero
+		resp.FirstRewardHeight = resp.FinalizationHeight
+	}
+	resp.BlocksUntilFirstReward = resp.FirstRewardHeight - curHeight
+
+	return resp, nil
+}
+
+// isInActiveDistCache returns whether the given BTC delegation is in the
+// voting power distribution cache at the given height under an active
+// finality provider
+func (k Keeper) isInActiveDistCache(ctx context.Context, height uint64, btcDel *types.BTCDelegation) bool {
+	dc := k.getVotingPowerDistCache(ctx, height)
+	if dc == nil {
+		return false
+	}
+	stakingTxHash := btcDel.MustGetStakingTxHash().String()
+	for _, fp := range dc.GetActiveFinalityProviders(k.GetParams(ctx).MaxActiveFinalityProviders) {
+		for _, del := range fp.BtcDels {
+			if del.StakingTxHash == stakingTxHash {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// epochOfHeight returns the number of the epoch that the given height falls
+// into, assuming that the interval of the given current epoch does not change
+func epochOfHeight(curEpoch *etypes.Epoch, height uint64) uint64 {
+	lastHeight := curEpoch.GetLastBlockHeight()
+	if height <= lastHeight {
+		return curEpoch.EpochNumber
+	}
+	interval := curEpoch.CurrentEpochInterval
+	return curEpoch.EpochNumber + (height-lastHeight+interval-1)/interval
+}
+
+// firstHeightOfEpoch returns the first height of the given epoch after the
+// given current epoch, assuming that the interval of the current epoch does
+// not change
+func firstHeightOfEpoch(curEpoch *etypes.Epoch, epochNum uint64) uint64 {
+	return curEpoch.GetLastBlockHeight() + (epochNum-curEpoch.EpochNumber-1)*curEpoch.CurrentEpochInterval + 1
+}
+
+// StakingInternalKey returns the taproot internal key of the outputs of BTC
+// delegations, which is the fixed NUMS point of BIP-341
+func (k Keeper) StakingInternalKey(_ context.Context, req *types.QueryStakingInternalKeyRequest) (*types.QueryStakingInternalKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	return &types.QueryStakingInternalKeyResponse{
+		InternalKey: schnorr.SerializePubKey(btcstaking.UnspendableKeyPathInternalPubKey()),
+	}, nil
+}
+
+// DelegationsSpendableVia returns a paginated list of BTC delegations whose
+// staking output can be spent via the given path at the given BTC tip height,
+// or at the current BTC tip if not given
+func (k Keeper) DelegationsSpendableVia(ctx context.Context, req *types.QueryDelegationsSpendableViaRequest) (*types.QueryDelegationsSpendableViaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if _, ok := types.StakingOutputSpendPath_name[int32(req.Path)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid staking output spend path %d", req.Path)
+	}
+
+	covenantQuorum := k.GetParams(ctx).CovenantQuorum
+	btcTipHeight := req.BtcTipHeight
+	if btcTipHeight == 0 {
+		btcTipHeight = k.btclcKeeper.GetTipInfo(ctx).Height
+	}
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	store := k.btcDelegationStore(ctx)
+	var btcDels []*types.BTCDelegationResponse
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var btcDel types.BTCDelegation
+		k.cdc.MustUnmarshal(value, &btcDel)
+
+		if !btcDel.IsSpendableVia(req.Path, btcTipHeight, covenantQuorum) {
+			return false, nil
+		}
+		if accumulate {
+			status := btcDel.GetStatus(btcTipHeight, wValue, covenantQuorum)
+			btcDels = append(btcDels, types.NewBTCDelegationResponse(&btcDel, status))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegationsSpendableViaResponse{
+		BtcDelegations: btcDels,
+		BtcTipHeight:   btcTipHeight,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// CovenantSigNeeded returns whether the given covenant member still needs to
+// sign the given BTC delegation, and the covenant paths still missing its
+// signatures. It follows the rules upon which MsgAddCovenantSigs adds
+// signatures to the BTC delegation
+func (k Keeper) CovenantSigNeeded(ctx context.Context, req *types.QueryCovenantSigNeededRequest) (*types.QueryCovenantSigNeededResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	stakingTxHash, err := chainhash.NewHashFromStr(req.StakingTxHashHex)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	covPK, err := bbn.NewBIP340PubKeyFromHex(req.CovenantPkHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid covenant PK: %v", err)
+	}
+
+	btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+	if btcDel == nil {
+		return nil, types.ErrBTCDelegationNotFound.Wrapf("staking tx hash: %s", req.StakingTxHashHex)
+	}
+	params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+	if params == nil {
+		panic("params version in BTC delegation is not found")
+	}
+	if !params.HasCovenantPK(covPK) {
+		return nil, types.ErrInvalidCovenantPK.Wrapf("covenant pk: %s", req.CovenantPkHex)
+	}
+
+	// further covenant signatures are rejected once the BTC delegation has
+	// covenant quorums, and ignored once it is no longer pending, unless its
+	// delegator can still unbond it after a finality provider is slashed
+	resp := &types.QueryCovenantSigNeededResponse{}
+	if btcDel.HasCovenantQuorums(params.CovenantQuorum) {
+		return resp, nil
+	}
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+	delStatus := btcDel.GetStatus(btcTipHeight, wValue, params.CovenantQuorum)
+	unbondable := btcDel.FpSlashedBeforeActivation && !btcDel.IsUnbondedEarly()
+	if delStatus != types.BTCDelegationStatus_PENDING && delStatus != types.BTCDelegationStatus_RESERVED && !unbondable {
+		return resp, nil
+	}
+
+	if !btcDel.BtcUndelegation.IsSignedByCovMemberOnUnbonding(covPK) {
+		resp.MissingPaths = append(resp.MissingPaths, types.CovenantSpendPath_UNBONDING)
+	}
+	// only the signature on the unbonding tx is kept if a finality provider
+	// of the BTC delegation is slashed
+	if !btcDel.FpSlashedBeforeActivation && !k.hasSlashedFinalityProvider(ctx, btcDel) {
+		if !btcDel.IsSignedByCovMember(covPK) {
+			resp.MissingPaths = append(resp.MissingPaths, types.CovenantSpendPath_SLASHING)
+		}
+		if !btcDel.BtcUndelegation.IsSignedByCovMemberOnSlashing(covPK) {
+			resp.MissingPaths = append(resp.MissingPaths, types.CovenantSpendPath_UNBONDING_SLASHING)
+		}
+	}
+	resp.Needed = len(resp.MissingPaths) > 0
+
+	return resp, nil
+}
@@ -1,23 +1,36 @@
 package keeper_test
 
 import (
+	"bytes"
+	"context"
+	"encoding/hex"
 	"errors"
+	"math"
 	"math/rand"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/babylonchain/babylon/btcstaking"
+	asig "github.com/babylonchain/babylon/crypto/schnorr-adaptor-signature"
 	"github.com/babylonchain/babylon/testutil/datagen"
 	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
 	bbn "github.com/babylonchain/babylon/types"
 	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
 	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
+	bskeeper "github.com/babylonchain/babylon/x/btcstaking/keeper"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
+	etypes "github.com/babylonchain/babylon/x/epoching/types"
 )
 
 func FuzzActivatedHeight(f *testing.F) {
@@ -26,7 +39,7 @@ func FuzzActivatedHeight(f *testing.F) {
 		r := rand.New(rand.NewSource(seed))
 
 		// Setup keeper and context
-		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil, nil)
 		ctx = sdk.UnwrapSDKContext(ctx)
 
 		// not activated yet
@@ -51,7 +64,7 @@ func FuzzFinalityProviders(f *testing.F) {
 		r := rand.New(rand.NewSource(seed))
 
 		// Setup keeper and context
-		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil, nil)
 		ctx = sdk.UnwrapSDKContext(ctx)
 
 		// Generate random finality providers and add them to kv store
@@ -116,7 +129,7 @@ func FuzzFinalityProvider(f *testing.F) {
 	f.Fuzz(func(t *testing.T, seed int64) {
 		r := rand.New(rand.NewSource(seed))
 		// Setup keeper and context
-		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil, nil)
 		ctx = sdk.UnwrapSDKContext(ctx)
 
 		// Generate random finality providers and add them to kv store
@@ -173,7 +186,7 @@ func FuzzPendingBTCDelegations(f *testing.F) {
 		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
 		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
 		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
-		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, ckptKeeper)
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, ckptKeeper, nil)
 
 		// covenant and slashing addr
 		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
@@ -229,6 +242,7 @@ func FuzzPendingBTCDelegations(f *testing.F) {
 					btcDel.CovenantSigs = nil
 					pendingBtcDelsMap[btcDel.BtcPk.MarshalHex()] = btcDel
 				}
+				btcDel.RewardOptOut = datagen.OneInN(r, 2)
 				err = keeper.AddBTCDelegation(ctx, btcDel)
 				require.NoError(t, err)
 
@@ -238,6 +252,17 @@ func FuzzPendingBTCDelegations(f *testing.F) {
 				})
 				require.NoError(t, err)
 				require.NotNil(t, delView)
+				require.Equal(t, btcDel.RewardOptOut, delView.BtcDelegation.RewardOptOut)
+				// the response carries the full delegation, so that no
+				// further query is needed
+				require.Equal(t, txHash, delView.BtcDelegation.StakingTxHashHex)
+				require.Equal(t, delView.BtcDelegation.Status.String(), delView.BtcDelegation.StatusDesc)
+				require.Equal(t, btcDel.FpBtcPkList, delView.BtcDelegation.FpBtcPkList)
+				require.Equal(t, btcDel.BabylonPk, delView.BtcDelegation.BabylonPk)
+				require.Equal(t, btcDel.Pop, delView.BtcDelegation.Pop)
+				require.Equal(t, btcDel.CovenantSigs, delView.BtcDelegation.CovenantSigs)
+				require.Equal(t, btcDel.BtcUndelegation.ToResponse(), delView.BtcDelegation.UndelegationResponse)
+				require.Equal(t, btcDel.SlashedBtcHeight, delView.BtcDelegation.SlashedBtcHeight)
 			}
 		}
 
@@ -269,13 +294,184 @@ func FuzzPendingBTCDelegations(f *testing.F) {
 	})
 }
 
+func FuzzStaleCovenantPendingDelegations(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, ckptKeeper, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		keeper.SetFinalityProvider(ctx, fp)
+
+		startHeight := datagen.RandomInt(r, 100) + 1
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: startHeight}).AnyTimes()
+		endHeight := datagen.RandomInt(r, 1000) + startHeight + btcctypes.DefaultParams().CheckpointFinalizationTimeout + 1
+
+		// BTC delegations created at random Babylon heights, some of which
+		// are still pending
+		babylonHeight := uint64(200)
+		maxBlocksPending := datagen.RandomInt(r, int(babylonHeight))
+		numBTCDels := datagen.RandomInt(r, 10) + 1
+		staleBtcDelsMap := make(map[string]bool)
+		for i := uint64(0); i <= numBTCDels; i++ {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				net,
+				[]bbn.BIP340PubKey{*fp.BtcPk},
+				delSK,
+				covenantSKs,
+				covenantPKs,
+				covenantQuorum,
+				slashingAddress.EncodeAddress(),
+				startHeight, endHeight, 10000,
+				slashingRate,
+				uint16(101),
+			)
+			require.NoError(t, err)
+			btcDel.CreatedBabylonHeight = datagen.RandomInt(r, int(babylonHeight)) + 1
+			pending := datagen.RandomInt(r, 2) == 1
+			if i == numBTCDels {
+				// a pending BTC delegation without creation height is skipped
+				btcDel.CreatedBabylonHeight = 0
+				pending = true
+			}
+			if pending {
+				btcDel.CovenantSigs = nil
+				if btcDel.CreatedBabylonHeight > 0 && babylonHeight-btcDel.CreatedBabylonHeight > maxBlocksPending {
+					staleBtcDelsMap[btcDel.MustGetStakingTxHash().String()] = true
+				}
+			}
+			err = keeper.AddBTCDelegation(ctx, btcDel)
+			require.NoError(t, err)
+		}
+
+		ctx = datagen.WithCtxHeight(ctx, babylonHeight)
+		resp, err := keeper.StaleCovenantPendingDelegations(ctx, &types.QueryStaleCovenantPendingDelegationsRequest{
+			MaxBlocksPending: maxBlocksPending,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.BtcDelegations, len(staleBtcDelsMap))
+		for _, btcDel := range resp.BtcDelegations {
+			stakingTx, _, err := bbn.NewBTCTxFromHex(btcDel.StakingTxHex)
+			require.NoError(t, err)
+			require.True(t, staleBtcDelsMap[stakingTx.TxHash().String()])
+			require.Equal(t, types.BTCDelegationStatus_PENDING.String(), btcDel.StatusDesc)
+		}
+	})
+}
+
+func FuzzCovenantSignedDelegations(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, ckptKeeper, nil)
+
+		btcDelGen := NewBTCDelGenerator(t, r)
+
+		// the covenant member whose signed delegations are queried
+		covPK := bbn.NewBIP340PubKeyFromBTCPK(btcDelGen.CovenantPKs[0])
+
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		keeper.SetFinalityProvider(ctx, fp)
+
+		startHeight := datagen.RandomInt(r, 100) + 1
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: startHeight}).AnyTimes()
+		endHeight := datagen.RandomInt(r, 1000) + startHeight + btcctypes.DefaultParams().CheckpointFinalizationTimeout + 1
+
+		// Generate a random number of BTC delegations, where the covenant
+		// member's signatures are removed from a random subset of them
+		numBTCDels := datagen.RandomInt(r, 10) + 1
+		signedBtcDelsMap := make(map[string]*types.BTCDelegation)
+		for i := uint64(0); i < numBTCDels; i++ {
+			btcDel, _ := btcDelGen.GenBTCDelegation([]bbn.BIP340PubKey{*fp.BtcPk}, startHeight, endHeight, 10000)
+			if datagen.RandomInt(r, 2) == 1 {
+				covSigs := []*types.CovenantAdaptorSignatures{}
+				for _, covSig := range btcDel.CovenantSigs {
+					if !covSig.CovPk.Equals(covPK) {
+						covSigs = append(covSigs, covSig)
+					}
+				}
+				btcDel.CovenantSigs = covSigs
+			} else {
+				signedBtcDelsMap[btcDel.MustGetStakingTxHash().String()] = btcDel
+			}
+			err = keeper.AddBTCDelegation(ctx, btcDel)
+			require.NoError(t, err)
+		}
+
+		// an empty covenant PK is rejected
+		_, err = keeper.CovenantSignedDelegations(ctx, &types.QueryCovenantSignedDelegationsRequest{})
+		require.Error(t, err)
+
+		// querying paginated BTC delegations signed by the covenant member
+		limit := datagen.RandomInt(r, int(numBTCDels)) + 1
+		pagination := constructRequestWithLimit(r, limit)
+		req := &types.QueryCovenantSignedDelegationsRequest{
+			CovPkHex:   covPK.MarshalHex(),
+			Pagination: pagination,
+		}
+		numResults := 0
+		for {
+			resp, err := keeper.CovenantSignedDelegations(ctx, req)
+			require.NoError(t, err)
+			for _, btcDel := range resp.BtcDelegations {
+				stakingTx, _, err := bbn.NewBTCTxFromHex(btcDel.StakingTxHex)
+				require.NoError(t, err)
+				stakingTxHash := stakingTx.TxHash().String()
+				_, ok := signedBtcDelsMap[stakingTxHash]
+				require.True(t, ok)
+				// the response carries the same status as the single delegation query
+				delResp, err := keeper.BTCDelegation(ctx, &types.QueryBTCDelegationRequest{
+					StakingTxHashHex: stakingTxHash,
+				})
+				require.NoError(t, err)
+				require.Equal(t, delResp.BtcDelegation, btcDel)
+			}
+			numResults += len(resp.BtcDelegations)
+			if resp.Pagination.NextKey == nil {
+				break
+			}
+			// Construct the next page request
+			pagination.Key = resp.Pagination.NextKey
+		}
+		require.Equal(t, len(signedBtcDelsMap), numResults)
+	})
+}
+
 func FuzzFinalityProviderPowerAtHeight(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 	f.Fuzz(func(t *testing.T, seed int64) {
 		r := rand.New(rand.NewSource(seed))
 
 		// Setup keeper and context
-		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil, nil)
 
 		// random finality provider
 		fp, err := datagen.GenRandomFinalityProvider(r)
@@ -318,13 +514,53 @@ func FuzzFinalityProviderPowerAtHeight(f *testing.F) {
 	})
 }
 
+func FuzzTotalVotingPowerAtHeight(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		// Setup keeper and context
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil, nil)
+
+		// set random voting power for a random number of finality providers
+		// at random height
+		randomHeight := datagen.RandomInt(r, 100) + 1
+		numFps := datagen.RandomInt(r, 10) + 1
+		expectedTotalPower := uint64(0)
+		for i := uint64(0); i < numFps; i++ {
+			fp, err := datagen.GenRandomFinalityProvider(r)
+			require.NoError(t, err)
+			keeper.SetFinalityProvider(ctx, fp)
+			randomPower := datagen.RandomInt(r, 100) + 1
+			keeper.SetVotingPower(ctx, fp.BtcPk.MustMarshal(), randomHeight, randomPower)
+			expectedTotalPower += randomPower
+		}
+
+		// happy case
+		resp, err := keeper.TotalVotingPowerAtHeight(ctx, &types.QueryTotalVotingPowerAtHeightRequest{
+			Height: randomHeight,
+		})
+		require.NoError(t, err)
+		require.Equal(t, expectedTotalPower, resp.VotingPower)
+		require.Equal(t, numFps, resp.NumFinalityProviders)
+
+		// case where the voting power store is not updated in
+		// the given height
+		requestHeight := randomHeight + datagen.RandomInt(r, 10) + 1
+		_, err = keeper.TotalVotingPowerAtHeight(ctx, &types.QueryTotalVotingPowerAtHeightRequest{
+			Height: requestHeight,
+		})
+		require.ErrorIs(t, err, types.ErrVotingPowerTableNotUpdated)
+	})
+}
+
 func FuzzFinalityProviderCurrentVotingPower(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 	f.Fuzz(func(t *testing.T, seed int64) {
 		r := rand.New(rand.NewSource(seed))
 
 		// Setup keeper and context
-		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil, nil)
 
 		// random finality provider
 		fp, err := datagen.GenRandomFinalityProvider(r)
@@ -377,7 +613,7 @@ func FuzzActiveFinalityProvidersAtHeight(f *testing.F) {
 		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
 		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
 		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
-		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, ckptKeeper)
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, ckptKeeper, nil)
 
 		// covenant and slashing addr
 		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
@@ -497,7 +733,7 @@ func FuzzFinalityProviderDelegations(f *testing.F) {
 		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
 		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
 		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
-		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, ckptKeeper)
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, ckptKeeper, nil)
 
 		// covenant and slashing addr
 		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
@@ -599,7 +835,264 @@ func FuzzFinalityProviderDelegations(f *testing.F) {
 	})
 }
 
+func FuzzRewardEligibleDelegations(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+
+		// Test nil request
+		resp, err := h.BTCStakingKeeper.RewardEligibleDelegations(h.Ctx, nil)
+		require.Nil(t, resp)
+		require.Error(t, err)
+
+		// generate and insert new BTC delegation
+		stakingValue := int64(2 * 10e8)
+		_, _, _, msgCreateBTCDel, actualDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+		btcTip := btclcKeeper.GetTipInfo(h.Ctx)
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+
+		// the BTC delegation is pending, so it is neither eligible nor pending for rewards
+		req := &types.QueryRewardEligibleDelegationsRequest{FpBtcPkHex: fp.BtcPk.MarshalHex()}
+		resp, err = h.BTCStakingKeeper.RewardEligibleDelegations(h.Ctx, req)
+		h.NoError(err)
+		require.Empty(t, resp.EligibleDelegations)
+		require.Empty(t, resp.PendingDelegations)
+		require.Equal(t, babylonHeight, resp.DistCacheHeight)
+		require.Equal(t, btccKeeper.GetParams(h.Ctx).CheckpointFinalizationTimeout, resp.CheckpointFinalizationTimeout)
+
+		// activate the BTC delegation, which is not in the voting power distribution yet
+		msgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel)
+		for i := 0; i < int(h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum); i++ {
+			_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msgs[i])
+			h.NoError(err)
+		}
+		resp, err = h.BTCStakingKeeper.RewardEligibleDelegations(h.Ctx, req)
+		h.NoError(err)
+		require.Empty(t, resp.EligibleDelegations)
+		require.Len(t, resp.PendingDelegations, 1)
+		require.Equal(t, actualDel.BtcPk, resp.PendingDelegations[0].BtcPk)
+
+		// the BTC delegation enters the voting power distribution at the next height
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		resp, err = h.BTCStakingKeeper.RewardEligibleDelegations(h.Ctx, req)
+		h.NoError(err)
+		require.Empty(t, resp.PendingDelegations)
+		require.Len(t, resp.EligibleDelegations, 1)
+		require.Equal(t, actualDel.BtcPk, resp.EligibleDelegations[0].BtcPk)
+		require.True(t, resp.FpActive)
+
+		// pagination limits the BTC delegators in the response
+		req.Pagination = constructRequestWithLimit(r, 1)
+		resp, err = h.BTCStakingKeeper.RewardEligibleDelegations(h.Ctx, req)
+		h.NoError(err)
+		require.Len(t, resp.EligibleDelegations, 1)
+		require.NotNil(t, resp.Pagination)
+		req.Pagination = nil
+
+		// another finality provider with more voting power takes the only
+		// active slot, so the BTC delegation no longer earns rewards
+		params := h.BTCStakingKeeper.GetParams(h.Ctx)
+		params.MaxActiveFinalityProviders = 1
+		err = h.BTCStakingKeeper.SetParams(h.Ctx, params)
+		h.NoError(err)
+		_, fpPK2, _ := h.CreateFinalityProvider(r)
+		_, _, _, msgCreateBTCDel2, actualDel2 := h.CreateDelegation(
+			r,
+			fpPK2,
+			changeAddress.EncodeAddress(),
+			2*stakingValue,
+			1000,
+		)
+		msgs = h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel2, actualDel2)
+		for i := 0; i < int(h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum); i++ {
+			_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msgs[i])
+			h.NoError(err)
+		}
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		resp, err = h.BTCStakingKeeper.RewardEligibleDelegations(h.Ctx, req)
+		h.NoError(err)
+		require.False(t, resp.FpActive)
+		require.Empty(t, resp.EligibleDelegations)
+		require.Len(t, resp.PendingDelegations, 1)
+	})
+}
+
 // Constructors for PageRequest objects
+func FuzzSimulateBTCUndelegation(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		h.GenAndApplyParams(r)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider and BTC delegations
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+		stakingValue := int64(2 * 10e8)
+		stakingTxHash, _, _, msgCreateBTCDel, _ := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+		_, _, _, otherMsgCreateBTCDel, _ := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+
+		// the unbonding txs submitted along with the BTC delegation are valid
+		req := &types.QuerySimulateBTCUndelegationRequest{
+			StakingTxHashHex:              stakingTxHash,
+			UnbondingTx:                   msgCreateBTCDel.UnbondingTx,
+			UnbondingValue:                msgCreateBTCDel.UnbondingValue,
+			UnbondingTime:                 msgCreateBTCDel.UnbondingTime,
+			UnbondingSlashingTx:           msgCreateBTCDel.UnbondingSlashingTx,
+			DelegatorUnbondingSlashingSig: msgCreateBTCDel.DelegatorUnbondingSlashingSig,
+		}
+		resp, err := h.BTCStakingKeeper.SimulateBTCUndelegation(h.Ctx, req)
+		require.NoError(t, err)
+		require.True(t, resp.Valid)
+		require.Empty(t, resp.InvalidReason)
+
+		// unbonding time that is not the BTC delegation's one is invalid
+		invalidReq := *req
+		invalidReq.UnbondingTime = req.UnbondingTime + 1
+		resp, err = h.BTCStakingKeeper.SimulateBTCUndelegation(h.Ctx, &invalidReq)
+		require.NoError(t, err)
+		require.False(t, resp.Valid)
+		require.Contains(t, resp.InvalidReason, "unbonding time")
+
+		// unbonding tx that does not spend the staking output is invalid
+		invalidReq = *req
+		invalidReq.UnbondingTx = otherMsgCreateBTCDel.UnbondingTx
+		invalidReq.UnbondingSlashingTx = otherMsgCreateBTCDel.UnbondingSlashingTx
+		invalidReq.DelegatorUnbondingSlashingSig = otherMsgCreateBTCDel.DelegatorUnbondingSlashingSig
+		resp, err = h.BTCStakingKeeper.SimulateBTCUndelegation(h.Ctx, &invalidReq)
+		require.NoError(t, err)
+		require.False(t, resp.Valid)
+		require.Contains(t, resp.InvalidReason, types.ErrInvalidUnbondingTx.Error())
+
+		// unbonding value that is not committed in the unbonding tx is invalid
+		invalidReq = *req
+		invalidReq.UnbondingValue = req.UnbondingValue - 1
+		resp, err = h.BTCStakingKeeper.SimulateBTCUndelegation(h.Ctx, &invalidReq)
+		require.NoError(t, err)
+		require.False(t, resp.Valid)
+		require.Contains(t, resp.InvalidReason, "unbonding tx does not contain expected unbonding output")
+
+		// unknown BTC delegation
+		invalidReq = *req
+		invalidReq.StakingTxHashHex = datagen.GenRandomBtcdHash(r).String()
+		_, err = h.BTCStakingKeeper.SimulateBTCUndelegation(h.Ctx, &invalidReq)
+		require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+	})
+}
+
+func FuzzSlashingAmount(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		h.GenAndApplyParams(r)
+		params := h.BTCStakingKeeper.GetParams(h.Ctx)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider and BTC delegation
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+		stakingValue := int64(2 * 10e8)
+		stakingTxHash, _, _, msgCreateBTCDel, actualDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+
+		resp, err := h.BTCStakingKeeper.SlashingAmount(h.Ctx, &types.QuerySlashingAmountRequest{
+			StakingTxHashHex: stakingTxHash,
+		})
+		require.NoError(t, err)
+		require.Equal(t, params.SlashingAddress, resp.SlashingAddress)
+		require.Equal(t, params.SlashingRate, resp.SlashingRate)
+
+		// the amounts are the ones paid to the slashing address by the slashing txs
+		slashingMsgTx, err := msgCreateBTCDel.SlashingTx.ToMsgTx()
+		require.NoError(t, err)
+		require.Equal(t, uint64(slashingMsgTx.TxOut[0].Value), resp.SlashingAmount)
+		unbondingSlashingMsgTx, err := msgCreateBTCDel.UnbondingSlashingTx.ToMsgTx()
+		require.NoError(t, err)
+		require.Equal(t, uint64(unbondingSlashingMsgTx.TxOut[0].Value), resp.UnbondingSlashingAmount)
+
+		// and they are at least the slashing rate of the staked amount
+		minSlashingAmount := params.SlashingRate.MulInt64(int64(actualDel.TotalSat)).TruncateInt().Uint64()
+		require.GreaterOrEqual(t, resp.SlashingAmount, minSlashingAmount)
+
+		// unknown BTC delegation
+		_, err = h.BTCStakingKeeper.SlashingAmount(h.Ctx, &types.QuerySlashingAmountRequest{
+			StakingTxHashHex: datagen.GenRandomBtcdHash(r).String(),
+		})
+		require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+	})
+}
+
 func constructRequestWithKeyAndLimit(r *rand.Rand, key []byte, limit uint64) *query.PageRequest {
 	// If limit is 0, set one randomly
 	if limit == 0 {
@@ -617,3 +1110,1785 @@ func constructRequestWithKeyAndLimit(r *rand.Rand, key []byte, limit uint64) *qu
 func constructRequestWithLimit(r *rand.Rand, limit uint64) *query.PageRequest {
 	return constructRequestWithKeyAndLimit(r, nil, limit)
 }
+
+func FuzzSimulateVotingPower(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btcTipHeight := datagen.RandomInt(r, 1000) + 1
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: btcTipHeight}).AnyTimes()
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil, nil)
+		wValue := btcctypes.DefaultParams().CheckpointFinalizationTimeout
+
+		// invalid requests
+		_, err := keeper.SimulateVotingPower(ctx, nil)
+		require.Error(t, err)
+		_, err = keeper.SimulateVotingPower(ctx, &types.QuerySimulateVotingPowerRequest{TotalSat: 0, StakingTime: 1000})
+		require.Error(t, err)
+		_, err = keeper.SimulateVotingPower(ctx, &types.QuerySimulateVotingPowerRequest{TotalSat: 1000, StakingTime: 0})
+		require.Error(t, err)
+
+		// a staking time longer than w yields the full amount as voting power
+		totalSat := datagen.RandomInt(r, 1e8) + 1
+		stakingTime := uint32(wValue + datagen.RandomInt(r, 1000))
+		resp, err := keeper.SimulateVotingPower(ctx, &types.QuerySimulateVotingPowerRequest{
+			TotalSat:    totalSat,
+			StakingTime: stakingTime,
+		})
+		require.NoError(t, err)
+		require.Equal(t, totalSat, resp.VotingPower)
+		require.Equal(t, btcTipHeight, resp.BtcTipHeight)
+
+		// a staking time shorter than w yields no voting power
+		stakingTime = uint32(datagen.RandomInt(r, int(wValue)-1) + 1)
+		resp, err = keeper.SimulateVotingPower(ctx, &types.QuerySimulateVotingPowerRequest{
+			TotalSat:    totalSat,
+			StakingTime: stakingTime,
+		})
+		require.NoError(t, err)
+		require.Zero(t, resp.VotingPower)
+	})
+}
+
+func FuzzBTCDelegationsByInclusionHeight(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 1}).AnyTimes()
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		keeper.SetFinalityProvider(ctx, fp)
+
+		// generate BTC delegations included at random BTC heights
+		numBTCDels := datagen.RandomInt(r, 10) + 1
+		btcDelsByHeight := map[uint64][]string{}
+		for i := uint64(0); i < numBTCDels; i++ {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			startHeight := datagen.RandomInt(r, 100) + 1
+			endHeight := startHeight + btcctypes.DefaultParams().CheckpointFinalizationTimeout + 1000
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				net,
+				[]bbn.BIP340PubKey{*fp.BtcPk},
+				delSK,
+				covenantSKs,
+				covenantPKs,
+				covenantQuorum,
+				slashingAddress.EncodeAddress(),
+				startHeight, endHeight, 10000,
+				slashingRate,
+				uint16(101),
+			)
+			require.NoError(t, err)
+			err = keeper.AddBTCDelegation(ctx, btcDel)
+			require.NoError(t, err)
+			btcDelsByHeight[startHeight] = append(btcDelsByHeight[startHeight], btcDel.MustGetStakingTxHash().String())
+		}
+
+		// invalid range
+		_, err = keeper.BTCDelegationsByInclusionHeight(ctx, &types.QueryBTCDelegationsByInclusionHeightRequest{
+			FromHeight: 2,
+			ToHeight:   1,
+		})
+		require.Error(t, err)
+
+		// query a random range and assert the returned BTC delegations are
+		// exactly those included within the range, in ascending height order
+		fromHeight := datagen.RandomInt(r, 100) + 1
+		toHeight := fromHeight + datagen.RandomInt(r, 50)
+		expectedStakingTxHashes := map[string]struct{}{}
+		for height, stakingTxHashes := range btcDelsByHeight {
+			if fromHeight <= height && height <= toHeight {
+				for _, stakingTxHash := range stakingTxHashes {
+					expectedStakingTxHashes[stakingTxHash] = struct{}{}
+				}
+			}
+		}
+		resp, err := keeper.BTCDelegationsByInclusionHeight(ctx, &types.QueryBTCDelegationsByInclusionHeightRequest{
+			FromHeight: fromHeight,
+			ToHeight:   toHeight,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.BtcDelegations, len(expectedStakingTxHashes))
+		for i, btcDel := range resp.BtcDelegations {
+			stakingTx, _, err := bbn.NewBTCTxFromHex(btcDel.StakingTxHex)
+			require.NoError(t, err)
+			_, ok := expectedStakingTxHashes[stakingTx.TxHash().String()]
+			require.True(t, ok)
+			if i > 0 {
+				require.LessOrEqual(t, resp.BtcDelegations[i-1].StartHeight, btcDel.StartHeight)
+			}
+		}
+
+		// query the largest allowed range, which covers all BTC delegations
+		resp, err = keeper.BTCDelegationsByInclusionHeight(ctx, &types.QueryBTCDelegationsByInclusionHeightRequest{
+			FromHeight: 0,
+			ToHeight:   bskeeper.MaxInclusionHeightRange - 1,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.BtcDelegations, int(numBTCDels))
+
+		// range larger than the maximum
+		_, err = keeper.BTCDelegationsByInclusionHeight(ctx, &types.QueryBTCDelegationsByInclusionHeightRequest{
+			FromHeight: 0,
+			ToHeight:   math.MaxUint64,
+		})
+		require.Error(t, err)
+	})
+}
+
+func FuzzCovenantQuorumHealth(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+		params := keeper.GetParams(ctx)
+		params.CovenantPks = bbn.NewBIP340PKsFromBTCPKs(covenantPKs)
+		params.CovenantQuorum = covenantQuorum
+		err = keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		keeper.SetFinalityProvider(ctx, fp)
+
+		startHeight := datagen.RandomInt(r, 100) + 1
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: startHeight}).AnyTimes()
+		endHeight := datagen.RandomInt(r, 1000) + startHeight + btcctypes.DefaultParams().CheckpointFinalizationTimeout + 1
+
+		// generate BTC delegations with a random number of covenant signatures
+		numBTCDels := datagen.RandomInt(r, 10) + 1
+		expectedNumDelsByMissingSigs := map[uint32]uint64{}
+		expectedNumPendingDels := uint64(0)
+		for i := uint64(0); i < numBTCDels; i++ {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				net,
+				[]bbn.BIP340PubKey{*fp.BtcPk},
+				delSK,
+				covenantSKs,
+				covenantPKs,
+				covenantQuorum,
+				slashingAddress.EncodeAddress(),
+				startHeight, endHeight, 10000,
+				slashingRate,
+				uint16(101),
+			)
+			require.NoError(t, err)
+			// keep a random number of covenant signatures, where keeping a
+			// quorum makes the BTC delegation active
+			numSigs := uint32(datagen.RandomInt(r, int(covenantQuorum)+1))
+			btcDel.CovenantSigs = btcDel.CovenantSigs[:numSigs]
+			if numSigs < covenantQuorum {
+				expectedNumDelsByMissingSigs[covenantQuorum-numSigs]++
+				expectedNumPendingDels++
+			}
+			err = keeper.AddBTCDelegation(ctx, btcDel)
+			require.NoError(t, err)
+		}
+
+		resp, err := keeper.CovenantQuorumHealth(ctx, &types.QueryCovenantQuorumHealthRequest{})
+		require.NoError(t, err)
+		require.Equal(t, covenantQuorum, resp.CovenantQuorum)
+		require.Equal(t, expectedNumPendingDels, resp.NumPendingDelegations)
+		require.Len(t, resp.Buckets, len(expectedNumDelsByMissingSigs))
+		for i, bucket := range resp.Buckets {
+			require.Equal(t, expectedNumDelsByMissingSigs[bucket.MissingSigs], bucket.NumDelegations)
+			if i > 0 {
+				require.Less(t, resp.Buckets[i-1].MissingSigs, bucket.MissingSigs)
+			}
+		}
+	})
+}
+
+func FuzzDelegationsWithStaleParams(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+		params := keeper.GetParams(ctx)
+		params.CovenantPks = bbn.NewBIP340PKsFromBTCPKs(covenantPKs)
+		params.CovenantQuorum = covenantQuorum
+		err = keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		keeper.SetFinalityProvider(ctx, fp)
+
+		startHeight := datagen.RandomInt(r, 100) + 1
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: startHeight}).AnyTimes()
+		endHeight := datagen.RandomInt(r, 1000) + startHeight + btcctypes.DefaultParams().CheckpointFinalizationTimeout + 1
+
+		// addBTCDels adds a random number of BTC delegations under the current
+		// params version and returns their staking txs in hex
+		addBTCDels := func() map[string]struct{} {
+			stakingTxs := map[string]struct{}{}
+			numBTCDels := datagen.RandomInt(r, 5) + 1
+			for i := uint64(0); i < numBTCDels; i++ {
+				delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+				require.NoError(t, err)
+				btcDel, err := datagen.GenRandomBTCDelegation(
+					r,
+					t,
+					net,
+					[]bbn.BIP340PubKey{*fp.BtcPk},
+					delSK,
+					covenantSKs,
+					covenantPKs,
+					covenantQuorum,
+					slashingAddress.EncodeAddress(),
+					startHeight, endHeight, 10000,
+					slashingRate,
+					uint16(101),
+				)
+				require.NoError(t, err)
+				btcDel.ParamsVersion = keeper.GetParamsWithVersion(ctx).Version
+				err = keeper.AddBTCDelegation(ctx, btcDel)
+				require.NoError(t, err)
+				stakingTxs[hex.EncodeToString(btcDel.StakingTx)] = struct{}{}
+			}
+			return stakingTxs
+		}
+
+		oldDels := addBTCDels()
+
+		// bumping the params version without changing the params does not make
+		// delegations stale
+		err = keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+		resp, err := keeper.DelegationsWithStaleParams(ctx, &types.QueryDelegationsWithStaleParamsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, keeper.GetParamsWithVersion(ctx).Version, resp.CurrentParamsVersion)
+		require.Empty(t, resp.BtcDelegations)
+
+		// changing the params makes the delegations created before stale,
+		// while the delegations created afterwards are not
+		params.MinSlashingTxFeeSat++
+		err = keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+		newDels := addBTCDels()
+
+		resp, err = keeper.DelegationsWithStaleParams(ctx, &types.QueryDelegationsWithStaleParamsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, keeper.GetParamsWithVersion(ctx).Version, resp.CurrentParamsVersion)
+		require.Len(t, resp.BtcDelegations, len(oldDels))
+		for _, btcDel := range resp.BtcDelegations {
+			require.Contains(t, oldDels, btcDel.StakingTxHex)
+			require.NotContains(t, newDels, btcDel.StakingTxHex)
+		}
+	})
+}
+
+func FuzzProofOfPossessionQueries(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+
+		// unknown finality provider
+		_, err = keeper.FinalityProviderPoP(ctx, &types.QueryFinalityProviderPoPRequest{FpBtcPkHex: fp.BtcPk.MarshalHex()})
+		require.Error(t, err)
+
+		keeper.SetFinalityProvider(ctx, fp)
+		fpResp, err := keeper.FinalityProviderPoP(ctx, &types.QueryFinalityProviderPoPRequest{FpBtcPkHex: fp.BtcPk.MarshalHex()})
+		require.NoError(t, err)
+		require.Equal(t, fp.Pop, fpResp.Pop)
+		require.NoError(t, fpResp.Pop.Verify(fpResp.BabylonPk, fpResp.BtcPk, net))
+
+		startHeight := datagen.RandomInt(r, 100) + 1
+		endHeight := datagen.RandomInt(r, 1000) + startHeight + btcctypes.DefaultParams().CheckpointFinalizationTimeout + 1
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		btcDel, err := datagen.GenRandomBTCDelegation(
+			r,
+			t,
+			net,
+			[]bbn.BIP340PubKey{*fp.BtcPk},
+			delSK,
+			covenantSKs,
+			covenantPKs,
+			covenantQuorum,
+			slashingAddress.EncodeAddress(),
+			startHeight, endHeight, 10000,
+			slashingRate,
+			uint16(101),
+		)
+		require.NoError(t, err)
+		stakingTxHashHex := btcDel.MustGetStakingTxHash().String()
+
+		// unknown BTC delegation
+		_, err = keeper.BTCDelegationPoP(ctx, &types.QueryBTCDelegationPoPRequest{StakingTxHashHex: stakingTxHashHex})
+		require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+
+		err = keeper.AddBTCDelegation(ctx, btcDel)
+		require.NoError(t, err)
+		delResp, err := keeper.BTCDelegationPoP(ctx, &types.QueryBTCDelegationPoPRequest{StakingTxHashHex: stakingTxHashHex})
+		require.NoError(t, err)
+		require.Equal(t, btcDel.Pop, delResp.Pop)
+		require.NoError(t, delResp.Pop.Verify(delResp.BabylonPk, delResp.BtcPk, net))
+	})
+}
+
+func FuzzFinalityProviderPowerAfterUndelegation(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil, nil)
+		babylonHeight := datagen.RandomInt(r, 100) + 1
+		ctx = datagen.WithCtxHeight(ctx, babylonHeight)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+		params := keeper.GetParams(ctx)
+		params.CovenantPks = bbn.NewBIP340PKsFromBTCPKs(covenantPKs)
+		params.CovenantQuorum = covenantQuorum
+		err = keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		keeper.SetFinalityProvider(ctx, fp)
+		otherFp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		keeper.SetFinalityProvider(ctx, otherFp)
+
+		startHeight := datagen.RandomInt(r, 100) + 1
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: startHeight}).AnyTimes()
+		endHeight := datagen.RandomInt(r, 1000) + startHeight + btcctypes.DefaultParams().CheckpointFinalizationTimeout + 1
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		btcDel, err := datagen.GenRandomBTCDelegation(
+			r,
+			t,
+			net,
+			[]bbn.BIP340PubKey{*fp.BtcPk},
+			delSK,
+			covenantSKs,
+			covenantPKs,
+			covenantQuorum,
+			slashingAddress.EncodeAddress(),
+			startHeight, endHeight, 10000,
+			slashingRate,
+			uint16(101),
+		)
+		require.NoError(t, err)
+		err = keeper.AddBTCDelegation(ctx, btcDel)
+		require.NoError(t, err)
+		stakingTxHashHex := btcDel.MustGetStakingTxHash().String()
+
+		// the finality provider is not in the active set
+		resp, err := keeper.FinalityProviderPowerAfterUndelegation(ctx, &types.QueryFinalityProviderPowerAfterUndelegationRequest{
+			FpBtcPkHex:       fp.BtcPk.MarshalHex(),
+			StakingTxHashHex: stakingTxHashHex,
+		})
+		require.NoError(t, err)
+		require.Zero(t, resp.VotingPower)
+		require.Zero(t, resp.DelegationVotingPower)
+		require.Zero(t, resp.VotingPowerAfterUndelegation)
+
+		// the finality provider is in the active set, with voting power from
+		// the BTC delegation and other BTC delegations
+		otherPower := datagen.RandomInt(r, 100000)
+		keeper.SetVotingPower(ctx, fp.BtcPk.MustMarshal(), babylonHeight, btcDel.TotalSat+otherPower)
+		resp, err = keeper.FinalityProviderPowerAfterUndelegation(ctx, &types.QueryFinalityProviderPowerAfterUndelegationRequest{
+			FpBtcPkHex:       fp.BtcPk.MarshalHex(),
+			StakingTxHashHex: stakingTxHashHex,
+		})
+		require.NoError(t, err)
+		require.Equal(t, btcDel.TotalSat+otherPower, resp.VotingPower)
+		require.Equal(t, btcDel.TotalSat, resp.DelegationVotingPower)
+		require.Equal(t, otherPower, resp.VotingPowerAfterUndelegation)
+
+		// the BTC delegation is not restaked to the other finality provider
+		_, err = keeper.FinalityProviderPowerAfterUndelegation(ctx, &types.QueryFinalityProviderPowerAfterUndelegationRequest{
+			FpBtcPkHex:       otherFp.BtcPk.MarshalHex(),
+			StakingTxHashHex: stakingTxHashHex,
+		})
+		require.Error(t, err)
+	})
+}
+
+func FuzzFinalityProviderSlashingImpact(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btcTipHeight := uint64(500)
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: btcTipHeight}).AnyTimes()
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+		params := keeper.GetParams(ctx)
+		params.CovenantPks = bbn.NewBIP340PKsFromBTCPKs(covenantPKs)
+		params.CovenantQuorum = covenantQuorum
+		err = keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		keeper.SetFinalityProvider(ctx, fp)
+
+		// the finality provider has no BTC delegation yet
+		resp, err := keeper.FinalityProviderSlashingImpact(ctx, &types.QueryFinalityProviderSlashingImpactRequest{
+			FpBtcPkHex: fp.BtcPk.MarshalHex(),
+		})
+		require.NoError(t, err)
+		require.Empty(t, resp.BtcDelegations)
+		require.Zero(t, resp.TotalSat)
+		require.Zero(t, resp.TotalSlashingAmount)
+
+		// a random number of BTC delegations that are active, early unbonded,
+		// or expired
+		expectedDels := map[string]bool{}
+		expectedTotalSat, expectedSlashingAmount := uint64(0), uint64(0)
+		numDels := int(datagen.RandomInt(r, 10)) + 1
+		for i := 0; i < numDels; i++ {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			expired := r.Intn(3) == 0
+			endHeight := btcTipHeight + 1000
+			if expired {
+				endHeight = btcTipHeight
+			}
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				net,
+				[]bbn.BIP340PubKey{*fp.BtcPk},
+				delSK,
+				covenantSKs,
+				covenantPKs,
+				covenantQuorum,
+				slashingAddress.EncodeAddress(),
+				1, endHeight, datagen.RandomInt(r, 100000)+10000,
+				slashingRate,
+				uint16(101),
+			)
+			require.NoError(t, err)
+			unbondedEarly := r.Intn(3) == 0
+			if unbondedEarly {
+				btcDel.BtcUndelegation.DelegatorUnbondingSig = btcDel.BtcUndelegation.DelegatorSlashingSig
+			}
+			err = keeper.AddBTCDelegation(ctx, btcDel)
+			require.NoError(t, err)
+
+			switch {
+			case unbondedEarly:
+				expectedDels[btcDel.MustGetStakingTxHash().String()] = true
+				expectedTotalSat += btcDel.TotalSat
+				expectedSlashingAmount += btcDel.BtcUndelegation.SlashingTx.MustGetSlashingAmount()
+			case !expired:
+				expectedDels[btcDel.MustGetStakingTxHash().String()] = true
+				expectedTotalSat += btcDel.TotalSat
+				expectedSlashingAmount += btcDel.SlashingTx.MustGetSlashingAmount()
+			}
+		}
+
+		resp, err = keeper.FinalityProviderSlashingImpact(ctx, &types.QueryFinalityProviderSlashingImpactRequest{
+			FpBtcPkHex: fp.BtcPk.MarshalHex(),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.BtcDelegations, len(expectedDels))
+		for _, btcDel := range resp.BtcDelegations {
+			stakingTx, _, err := bbn.NewBTCTxFromHex(btcDel.StakingTxHex)
+			require.NoError(t, err)
+			require.True(t, expectedDels[stakingTx.TxHash().String()])
+		}
+		require.Equal(t, expectedTotalSat, resp.TotalSat)
+		require.Equal(t, expectedSlashingAmount, resp.TotalSlashingAmount)
+
+		// unknown finality provider
+		_, err = keeper.FinalityProviderSlashingImpact(ctx, &types.QueryFinalityProviderSlashingImpactRequest{
+			FpBtcPkHex: datagen.GenRandomHexStr(r, 32),
+		})
+		require.Error(t, err)
+	})
+}
+
+func FuzzBTCDelegationScripts(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+		params := keeper.GetParams(ctx)
+		params.CovenantPks = bbn.NewBIP340PKsFromBTCPKs(covenantPKs)
+		params.CovenantQuorum = covenantQuorum
+		err = keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		// a BTC delegation restaked to multiple finality providers
+		numFps := datagen.RandomInt(r, 3) + 2
+		fpBTCPKs := []bbn.BIP340PubKey{}
+		for i := uint64(0); i < numFps; i++ {
+			fp, err := datagen.GenRandomFinalityProvider(r)
+			require.NoError(t, err)
+			keeper.SetFinalityProvider(ctx, fp)
+			fpBTCPKs = append(fpBTCPKs, *fp.BtcPk)
+		}
+		startHeight := datagen.RandomInt(r, 100) + 1
+		endHeight := datagen.RandomInt(r, 1000) + startHeight + btcctypes.DefaultParams().CheckpointFinalizationTimeout + 1
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		btcDel, err := datagen.GenRandomBTCDelegation(
+			r,
+			t,
+			net,
+			fpBTCPKs,
+			delSK,
+			covenantSKs,
+			covenantPKs,
+			covenantQuorum,
+			slashingAddress.EncodeAddress(),
+			startHeight, endHeight, 10000,
+			slashingRate,
+			uint16(101),
+		)
+		require.NoError(t, err)
+		btcDel.ParamsVersion = keeper.GetParamsWithVersion(ctx).Version
+		stakingTxHashHex := btcDel.MustGetStakingTxHash().String()
+
+		// unknown BTC delegation
+		_, err = keeper.BTCDelegationScripts(ctx, &types.QueryBTCDelegationScriptsRequest{
+			FpBtcPkHex:       fpBTCPKs[0].MarshalHex(),
+			StakingTxHashHex: stakingTxHashHex,
+		})
+		require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+
+		err = keeper.AddBTCDelegation(ctx, btcDel)
+		require.NoError(t, err)
+
+		// the scripts are the ones of the staking and unbonding outputs, and
+		// embed the keys of all finality providers
+		stakingTx, err := bbn.NewBTCTxFromBytes(btcDel.StakingTx)
+		require.NoError(t, err)
+		unbondingTx, err := bbn.NewBTCTxFromBytes(btcDel.BtcUndelegation.UnbondingTx)
+		require.NoError(t, err)
+		fpIdx := datagen.RandomInt(r, int(numFps))
+		resp, err := keeper.BTCDelegationScripts(ctx, &types.QueryBTCDelegationScriptsRequest{
+			FpBtcPkHex:       fpBTCPKs[fpIdx].MarshalHex(),
+			StakingTxHashHex: stakingTxHashHex,
+		})
+		require.NoError(t, err)
+		require.Equal(t, uint32(fpIdx), resp.FpIdx)
+		require.Equal(t, btcDel.FpBtcPkList, resp.FpBtcPkList)
+		require.Equal(t, stakingTx.TxOut[btcDel.StakingOutputIdx].PkScript, resp.StakingOutputPkScript)
+		require.Equal(t, unbondingTx.TxOut[0].PkScript, resp.UnbondingOutputPkScript)
+		for _, fpBTCPK := range fpBTCPKs {
+			require.True(t, bytes.Contains(resp.StakingSlashingPath.Script, fpBTCPK.MustMarshal()))
+			require.True(t, bytes.Contains(resp.UnbondingSlashingPath.Script, fpBTCPK.MustMarshal()))
+		}
+		require.NotEmpty(t, resp.StakingSlashingPath.ControlBlock)
+		require.NotEmpty(t, resp.StakingUnbondingPath.Script)
+		require.NotEmpty(t, resp.StakingUnbondingPath.ControlBlock)
+		require.NotEmpty(t, resp.UnbondingSlashingPath.ControlBlock)
+
+		// finality provider that is not a member of the BTC delegation
+		randPk, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		_, err = keeper.BTCDelegationScripts(ctx, &types.QueryBTCDelegationScriptsRequest{
+			FpBtcPkHex:       randPk.MarshalHex(),
+			StakingTxHashHex: stakingTxHashHex,
+		})
+		require.Error(t, err)
+	})
+}
+
+func FuzzVerifyDelegatorSlashingSig(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+		params := keeper.GetParams(ctx)
+		params.CovenantPks = bbn.NewBIP340PKsFromBTCPKs(covenantPKs)
+		params.CovenantQuorum = covenantQuorum
+		err = keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		keeper.SetFinalityProvider(ctx, fp)
+
+		genBTCDel := func() *types.BTCDelegation {
+			startHeight := datagen.RandomInt(r, 100) + 1
+			endHeight := datagen.RandomInt(r, 1000) + startHeight + btcctypes.DefaultParams().CheckpointFinalizationTimeout + 1
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				net,
+				[]bbn.BIP340PubKey{*fp.BtcPk},
+				delSK,
+				covenantSKs,
+				covenantPKs,
+				covenantQuorum,
+				slashingAddress.EncodeAddress(),
+				startHeight, endHeight, 10000,
+				slashingRate,
+				uint16(101),
+			)
+			require.NoError(t, err)
+			btcDel.ParamsVersion = keeper.GetParamsWithVersion(ctx).Version
+			return btcDel
+		}
+
+		// unknown BTC delegation
+		btcDel := genBTCDel()
+		_, err = keeper.VerifyDelegatorSlashingSig(ctx, &types.QueryVerifyDelegatorSlashingSigRequest{
+			StakingTxHashHex: btcDel.MustGetStakingTxHash().String(),
+		})
+		require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+
+		// BTC delegation with valid delegator signature
+		err = keeper.AddBTCDelegation(ctx, btcDel)
+		require.NoError(t, err)
+		resp, err := keeper.VerifyDelegatorSlashingSig(ctx, &types.QueryVerifyDelegatorSlashingSigRequest{
+			StakingTxHashHex: btcDel.MustGetStakingTxHash().String(),
+		})
+		require.NoError(t, err)
+		require.True(t, resp.Valid)
+		require.Empty(t, resp.InvalidReason)
+
+		// BTC delegation whose delegator signature is over another slashing tx
+		invalidBTCDel := genBTCDel()
+		invalidBTCDel.DelegatorSig = btcDel.DelegatorSig
+		err = keeper.AddBTCDelegation(ctx, invalidBTCDel)
+		require.NoError(t, err)
+		resp, err = keeper.VerifyDelegatorSlashingSig(ctx, &types.QueryVerifyDelegatorSlashingSigRequest{
+			StakingTxHashHex: invalidBTCDel.MustGetStakingTxHash().String(),
+		})
+		require.NoError(t, err)
+		require.False(t, resp.Valid)
+		require.NotEmpty(t, resp.InvalidReason)
+	})
+}
+
+func FuzzReverifyInclusionProof(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		h.GenAndApplyParams(r)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider and BTC delegation, whose
+		// staking tx is included at BTC height 10 while the BTC tip is at 30
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+		stakingTxHash, _, _, msgCreateBTCDel, actualDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			int64(2*10e8),
+			1000,
+		)
+		require.Equal(t, msgCreateBTCDel.StakingTx.Key, actualDel.StakingTxKey)
+		require.Equal(t, msgCreateBTCDel.StakingTx.Proof, actualDel.StakingTxInclusionProof)
+
+		// unknown BTC delegation
+		_, err = h.BTCStakingKeeper.ReverifyInclusionProof(h.Ctx, &types.QueryReverifyInclusionProofRequest{
+			StakingTxHashHex: datagen.GenRandomBtcdHash(r).String(),
+		})
+		require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+
+		// the inclusion proof still holds
+		req := &types.QueryReverifyInclusionProofRequest{StakingTxHashHex: stakingTxHash}
+		resp, err := h.BTCStakingKeeper.ReverifyInclusionProof(h.Ctx, req)
+		require.NoError(t, err)
+		require.True(t, resp.Valid, resp.InvalidReason)
+		require.Equal(t, uint64(20), resp.Depth)
+
+		// the BTC block including the staking tx is rolled back by a reorg
+		reorgCtx := datagen.WithCtxHeight(h.Ctx, uint64(h.Ctx.HeaderInfo().Height)+1)
+		btclcKeeper.EXPECT().GetHeaderByHash(gomock.Eq(reorgCtx), gomock.Any()).Return(nil).Times(1)
+		resp, err = h.BTCStakingKeeper.ReverifyInclusionProof(reorgCtx, req)
+		require.NoError(t, err)
+		require.False(t, resp.Valid)
+		require.Contains(t, resp.InvalidReason, "no longer in the main chain")
+
+		// a BTC delegation without a recorded inclusion proof cannot be
+		// re-verified, rather than being reported as invalid
+		legacyDel, _ := NewBTCDelGenerator(t, r).GenBTCDelegation(actualDel.FpBtcPkList, 1, 1000, 10000)
+		legacyDel.StakingTxKey = nil
+		legacyDel.StakingTxInclusionProof = nil
+		err = h.BTCStakingKeeper.AddBTCDelegation(h.Ctx, legacyDel)
+		require.NoError(t, err)
+		resp, err = h.BTCStakingKeeper.ReverifyInclusionProof(h.Ctx, &types.QueryReverifyInclusionProofRequest{
+			StakingTxHashHex: legacyDel.MustGetStakingTxHash().String(),
+		})
+		require.Nil(t, resp)
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+func FuzzCovenantSigningBatch(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+		params := keeper.GetParams(ctx)
+		params.CovenantPks = bbn.NewBIP340PKsFromBTCPKs(covenantPKs)
+		params.CovenantQuorum = covenantQuorum
+		err = keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		// a random number of BTC delegations
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		keeper.SetFinalityProvider(ctx, fp)
+		numDels := datagen.RandomInt(r, 10) + 1
+		btcDels := []*types.BTCDelegation{}
+		stakingTxHashHexList := []string{}
+		for i := uint64(0); i < numDels; i++ {
+			startHeight := datagen.RandomInt(r, 100) + 1
+			endHeight := datagen.RandomInt(r, 1000) + startHeight + btcctypes.DefaultParams().CheckpointFinalizationTimeout + 1
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				net,
+				[]bbn.BIP340PubKey{*fp.BtcPk},
+				delSK,
+				covenantSKs,
+				covenantPKs,
+				covenantQuorum,
+				slashingAddress.EncodeAddress(),
+				startHeight, endHeight, 10000,
+				slashingRate,
+				uint16(101),
+			)
+			require.NoError(t, err)
+			btcDel.ParamsVersion = keeper.GetParamsWithVersion(ctx).Version
+			err = keeper.AddBTCDelegation(ctx, btcDel)
+			require.NoError(t, err)
+			btcDels = append(btcDels, btcDel)
+			stakingTxHashHexList = append(stakingTxHashHexList, btcDel.MustGetStakingTxHash().String())
+		}
+
+		// each signing info contains the txs of the BTC delegation, and the
+		// same script paths as the single BTC delegation query
+		resp, err := keeper.CovenantSigningBatch(ctx, &types.QueryCovenantSigningBatchRequest{
+			StakingTxHashHexList: stakingTxHashHexList,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.SigningInfos, len(btcDels))
+		for i, signingInfo := range resp.SigningInfos {
+			btcDel := btcDels[i]
+			require.Equal(t, stakingTxHashHexList[i], signingInfo.StakingTxHashHex)
+			require.Equal(t, btcDel.FpBtcPkList, signingInfo.FpBtcPkList)
+			require.Equal(t, btcDel.StakingTx, signingInfo.StakingTx)
+			require.Equal(t, btcDel.StakingOutputIdx, signingInfo.StakingOutputIdx)
+			require.Equal(t, btcDel.SlashingTx, signingInfo.SlashingTx)
+			require.Equal(t, btcDel.BtcUndelegation.UnbondingTx, signingInfo.UnbondingTx)
+			require.Equal(t, btcDel.BtcUndelegation.SlashingTx, signingInfo.UnbondingSlashingTx)
+
+			scripts, err := keeper.BTCDelegationScripts(ctx, &types.QueryBTCDelegationScriptsRequest{
+				FpBtcPkHex:       fp.BtcPk.MarshalHex(),
+				StakingTxHashHex: stakingTxHashHexList[i],
+			})
+			require.NoError(t, err)
+			require.Equal(t, scripts.StakingSlashingPath, signingInfo.StakingSlashingPath)
+			require.Equal(t, scripts.StakingUnbondingPath, signingInfo.StakingUnbondingPath)
+			require.Equal(t, scripts.UnbondingSlashingPath, signingInfo.UnbondingSlashingPath)
+		}
+
+		// a batch containing an unknown BTC delegation is rejected
+		unknownHashHex := datagen.GenRandomBtcdHash(r).String()
+		_, err = keeper.CovenantSigningBatch(ctx, &types.QueryCovenantSigningBatchRequest{
+			StakingTxHashHexList: append(stakingTxHashHexList, unknownHashHex),
+		})
+		require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+
+		// a batch exceeding the maximum size is rejected
+		oversized := make([]string, bskeeper.MaxCovenantSigningBatchSize+1)
+		for i := range oversized {
+			oversized[i] = stakingTxHashHexList[0]
+		}
+		_, err = keeper.CovenantSigningBatch(ctx, &types.QueryCovenantSigningBatchRequest{
+			StakingTxHashHexList: oversized,
+		})
+		require.Error(t, err)
+	})
+}
+
+func FuzzUnbondingCovenantProgress(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 10}).AnyTimes()
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+		params := keeper.GetParams(ctx)
+		params.CovenantPks = bbn.NewBIP340PKsFromBTCPKs(covenantPKs)
+		params.CovenantQuorum = covenantQuorum
+		err = keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		// a BTC delegation signed by a random subset of the covenant committee
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		keeper.SetFinalityProvider(ctx, fp)
+		numSigned := int(datagen.RandomInt(r, len(covenantSKs))) + 1
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		btcDel, err := datagen.GenRandomBTCDelegation(
+			r,
+			t,
+			net,
+			[]bbn.BIP340PubKey{*fp.BtcPk},
+			delSK,
+			covenantSKs[:numSigned],
+			covenantPKs,
+			covenantQuorum,
+			slashingAddress.EncodeAddress(),
+			1, 1000, 10000,
+			slashingRate,
+			uint16(101),
+		)
+		require.NoError(t, err)
+		btcDel.ParamsVersion = keeper.GetParamsWithVersion(ctx).Version
+		// the delegator may or may not have requested to unbond
+		requestedUnbonding := r.Intn(2) == 0
+		if requestedUnbonding {
+			btcDel.BtcUndelegation.DelegatorUnbondingSig = btcDel.BtcUndelegation.DelegatorSlashingSig
+		}
+		err = keeper.AddBTCDelegation(ctx, btcDel)
+		require.NoError(t, err)
+		stakingTxHashHex := btcDel.MustGetStakingTxHash().String()
+
+		resp, err := keeper.UnbondingCovenantProgress(ctx, &types.QueryUnbondingCovenantProgressRequest{
+			StakingTxHashHex: stakingTxHashHex,
+		})
+		require.NoError(t, err)
+		require.Equal(t, requestedUnbonding, resp.DelegatorUnbondingSigSubmitted)
+		require.Equal(t, covenantQuorum, resp.CovenantQuorum)
+		require.Equal(t, uint32(numSigned), resp.NumCovenantUnbondingSigs)
+		require.Equal(t, uint32(numSigned), resp.NumCovenantUnbondingSlashingSigs)
+		require.Equal(t, uint32(numSigned) >= covenantQuorum, resp.HasCovenantQuorums)
+		require.Equal(t, params.CovenantPks[numSigned:], resp.PendingCovenantPks)
+		if requestedUnbonding {
+			require.Equal(t, types.BTCDelegationStatus_UNBONDED, resp.Status)
+		} else {
+			require.NotEqual(t, types.BTCDelegationStatus_UNBONDED, resp.Status)
+		}
+
+		// unknown BTC delegation
+		_, err = keeper.UnbondingCovenantProgress(ctx, &types.QueryUnbondingCovenantProgressRequest{
+			StakingTxHashHex: datagen.GenRandomBtcdHash(r).String(),
+		})
+		require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+	})
+}
+
+func FuzzDelegationSpendTree(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 10}).AnyTimes()
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+		params := keeper.GetParams(ctx)
+		params.CovenantPks = bbn.NewBIP340PKsFromBTCPKs(covenantPKs)
+		params.CovenantQuorum = covenantQuorum
+		err = keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		// a BTC delegation restaked to a random number of finality providers
+		numFps := int(datagen.RandomInt(r, 3)) + 1
+		fpPKs := []bbn.BIP340PubKey{}
+		for i := 0; i < numFps; i++ {
+			fp, err := datagen.GenRandomFinalityProvider(r)
+			require.NoError(t, err)
+			keeper.SetFinalityProvider(ctx, fp)
+			fpPKs = append(fpPKs, *fp.BtcPk)
+		}
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		btcDel, err := datagen.GenRandomBTCDelegation(
+			r,
+			t,
+			net,
+			fpPKs,
+			delSK,
+			covenantSKs,
+			covenantPKs,
+			covenantQuorum,
+			slashingAddress.EncodeAddress(),
+			1, 1000, 10000,
+			slashingRate,
+			uint16(101),
+		)
+		require.NoError(t, err)
+		btcDel.ParamsVersion = keeper.GetParamsWithVersion(ctx).Version
+		err = keeper.AddBTCDelegation(ctx, btcDel)
+		require.NoError(t, err)
+
+		resp, err := keeper.DelegationSpendTree(ctx, &types.QueryDelegationSpendTreeRequest{
+			StakingTxHashHex: btcDel.MustGetStakingTxHash().String(),
+		})
+		require.NoError(t, err)
+
+		// the spend tree commits to the staking output of the staking tx
+		stakingTx, err := bbn.NewBTCTxFromBytes(btcDel.StakingTx)
+		require.NoError(t, err)
+		require.Equal(t, stakingTx.TxOut[btcDel.StakingOutputIdx].PkScript, resp.StakingOutputPkScript)
+		witnessProgram := resp.StakingOutputPkScript[2:]
+
+		// each leaf is at a distinct position and its control block proves
+		// its inclusion in the staking output under the internal key
+		leafIdxs := map[uint32]bool{}
+		for _, leaf := range []*types.TaprootTreeLeaf{resp.TimelockLeaf, resp.UnbondingLeaf, resp.SlashingLeaf} {
+			require.Less(t, leaf.LeafIndex, uint32(3))
+			require.False(t, leafIdxs[leaf.LeafIndex])
+			leafIdxs[leaf.LeafIndex] = true
+
+			controlBlock, err := txscript.ParseControlBlock(leaf.ScriptPath.ControlBlock)
+			require.NoError(t, err)
+			require.Equal(t, resp.InternalKey, schnorr.SerializePubKey(controlBlock.InternalKey))
+			err = txscript.VerifyTaprootLeafCommitment(controlBlock, witnessProgram, leaf.ScriptPath.Script)
+			require.NoError(t, err)
+		}
+
+		// the internal key is the fixed one exposed by StakingInternalKey, with
+		// which the output key can be reconstructed from the script tree
+		ikResp, err := keeper.StakingInternalKey(ctx, &types.QueryStakingInternalKeyRequest{})
+		require.NoError(t, err)
+		require.Equal(t, resp.InternalKey, ikResp.InternalKey)
+		internalKey, err := schnorr.ParsePubKey(ikResp.InternalKey)
+		require.NoError(t, err)
+		tapTree := txscript.AssembleTaprootScriptTree(
+			txscript.NewBaseTapLeaf(resp.TimelockLeaf.ScriptPath.Script),
+			txscript.NewBaseTapLeaf(resp.UnbondingLeaf.ScriptPath.Script),
+			txscript.NewBaseTapLeaf(resp.SlashingLeaf.ScriptPath.Script),
+		)
+		tapRoot := tapTree.RootNode.TapHash()
+		outputKey := txscript.ComputeTaprootOutputKey(internalKey, tapRoot[:])
+		require.Equal(t, schnorr.SerializePubKey(outputKey), witnessProgram)
+
+		// unknown BTC delegation
+		_, err = keeper.DelegationSpendTree(ctx, &types.QueryDelegationSpendTreeRequest{
+			StakingTxHashHex: datagen.GenRandomBtcdHash(r).String(),
+		})
+		require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+	})
+}
+
+func FuzzVerifyCovenantQuorumSpend(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 10}).AnyTimes()
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+		params := keeper.GetParams(ctx)
+		params.CovenantPks = bbn.NewBIP340PKsFromBTCPKs(covenantPKs)
+		params.CovenantQuorum = covenantQuorum
+		err = keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		// a BTC delegation signed by a random subset of the covenant committee
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		keeper.SetFinalityProvider(ctx, fp)
+		numSigned := int(datagen.RandomInt(r, len(covenantSKs))) + 1
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		btcDel, err := datagen.GenRandomBTCDelegation(
+			r,
+			t,
+			net,
+			[]bbn.BIP340PubKey{*fp.BtcPk},
+			delSK,
+			covenantSKs[:numSigned],
+			covenantPKs,
+			covenantQuorum,
+			slashingAddress.EncodeAddress(),
+			1, 1000, 10000,
+			slashingRate,
+			uint16(101),
+		)
+		require.NoError(t, err)
+		btcDel.ParamsVersion = keeper.GetParamsWithVersion(ctx).Version
+		// the delegator may or may not have signed the unbonding tx
+		requestedUnbonding := r.Intn(2) == 0
+		if requestedUnbonding {
+			delUnbondingSig, err := btcDel.SignUnbondingTx(&params, net, delSK)
+			require.NoError(t, err)
+			btcDel.BtcUndelegation.DelegatorUnbondingSig = bbn.NewBIP340SignatureFromBTCSig(delUnbondingSig)
+		}
+		err = keeper.AddBTCDelegation(ctx, btcDel)
+		require.NoError(t, err)
+		stakingTxHashHex := btcDel.MustGetStakingTxHash().String()
+
+		// the unbonding path is spendable iff the delegator has signed the
+		// unbonding tx and a quorum of covenant members have signed
+		resp, err := keeper.VerifyCovenantQuorumSpend(ctx, &types.QueryVerifyCovenantQuorumSpendRequest{
+			StakingTxHashHex: stakingTxHashHex,
+			Path:             types.CovenantSpendPath_UNBONDING,
+		})
+		require.NoError(t, err)
+		require.Equal(t, covenantQuorum, resp.CovenantQuorum)
+		require.Equal(t, uint32(numSigned), resp.NumValidCovenantSigs)
+		expectedSpendable := requestedUnbonding && uint32(numSigned) >= covenantQuorum
+		require.Equal(t, expectedSpendable, resp.Spendable)
+		require.Equal(t, expectedSpendable, len(resp.Reason) == 0)
+
+		// the slashing path is not spendable before the finality provider is
+		// slashed, whose SK decrypts the covenant adaptor signatures
+		resp, err = keeper.VerifyCovenantQuorumSpend(ctx, &types.QueryVerifyCovenantQuorumSpendRequest{
+			StakingTxHashHex: stakingTxHashHex,
+			Path:             types.CovenantSpendPath_SLASHING,
+		})
+		require.NoError(t, err)
+		require.Equal(t, covenantQuorum, resp.CovenantQuorum)
+		require.Equal(t, uint32(numSigned), resp.NumValidCovenantSigs)
+		require.False(t, resp.Spendable)
+		require.NotEmpty(t, resp.Reason)
+
+		// once the finality provider is slashed, the slashing path is
+		// spendable iff a quorum of covenant members have signed and the
+		// staking output is not spent by the unbonding tx. A BTC delegation
+		// still pending at that point is unbonded, and drops its covenant
+		// signatures on the slashing tx
+		err = keeper.SlashFinalityProvider(ctx, fp.BtcPk.MustMarshal())
+		require.NoError(t, err)
+		resp, err = keeper.VerifyCovenantQuorumSpend(ctx, &types.QueryVerifyCovenantQuorumSpendRequest{
+			StakingTxHashHex: stakingTxHashHex,
+			Path:             types.CovenantSpendPath_SLASHING,
+		})
+		require.NoError(t, err)
+		if !requestedUnbonding && uint32(numSigned) < covenantQuorum {
+			require.Zero(t, resp.NumValidCovenantSigs)
+		} else {
+			require.Equal(t, uint32(numSigned), resp.NumValidCovenantSigs)
+		}
+		expectedSpendable = !requestedUnbonding && uint32(numSigned) >= covenantQuorum
+		require.Equal(t, expectedSpendable, resp.Spendable)
+		require.Equal(t, expectedSpendable, len(resp.Reason) == 0)
+
+		// unknown spend path
+		_, err = keeper.VerifyCovenantQuorumSpend(ctx, &types.QueryVerifyCovenantQuorumSpendRequest{
+			StakingTxHashHex: stakingTxHashHex,
+			Path:             types.CovenantSpendPath(2),
+		})
+		require.Error(t, err)
+
+		// unknown BTC delegation
+		_, err = keeper.VerifyCovenantQuorumSpend(ctx, &types.QueryVerifyCovenantQuorumSpendRequest{
+			StakingTxHashHex: datagen.GenRandomBtcdHash(r).String(),
+		})
+		require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+	})
+}
+
+func FuzzCovenantSignMsg(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 10}).AnyTimes()
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+		params := keeper.GetParams(ctx)
+		params.CovenantPks = bbn.NewBIP340PKsFromBTCPKs(covenantPKs)
+		params.CovenantQuorum = covenantQuorum
+		err = keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		// a BTC delegation signed by the entire covenant committee
+		fpSK, fpPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		fp, err := datagen.GenRandomFinalityProviderWithBTCSK(r, fpSK)
+		require.NoError(t, err)
+		keeper.SetFinalityProvider(ctx, fp)
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		btcDel, err := datagen.GenRandomBTCDelegation(
+			r,
+			t,
+			net,
+			[]bbn.BIP340PubKey{*fp.BtcPk},
+			delSK,
+			covenantSKs,
+			covenantPKs,
+			covenantQuorum,
+			slashingAddress.EncodeAddress(),
+			1, 1000, 10000,
+			slashingRate,
+			uint16(101),
+		)
+		require.NoError(t, err)
+		btcDel.ParamsVersion = keeper.GetParamsWithVersion(ctx).Version
+		err = keeper.AddBTCDelegation(ctx, btcDel)
+		require.NoError(t, err)
+		stakingTxHashHex := btcDel.MustGetStakingTxHash().String()
+		encKey, err := asig.NewEncryptionKeyFromBTCPK(fpPK)
+		require.NoError(t, err)
+
+		// the signatures of each covenant member verify against the
+		// returned sighashes
+		sortedCovPKs := btcstaking.SortKeys(covenantPKs)
+		covIdx := int(datagen.RandomInt(r, len(covenantSKs)))
+		covPK := covenantPKs[covIdx]
+		covPKHex := bbn.NewBIP340PubKeyFromBTCPK(covPK).MarshalHex()
+
+		resp, err := keeper.CovenantSignMsg(ctx, &types.QueryCovenantSignMsgRequest{
+			StakingTxHashHex: stakingTxHashHex,
+			Path:             types.CovenantSpendPath_UNBONDING,
+			CovenantPkHex:    covPKHex,
+		})
+		require.NoError(t, err)
+		require.Equal(t, btcDel.BtcUndelegation.UnbondingTx, resp.SigningTx)
+		require.Empty(t, resp.EncKeyList)
+		require.True(t, sortedCovPKs[resp.CovenantKeyIndex].IsEqual(covPK))
+		unbondingSig, err := btcDel.BtcUndelegation.CovenantUnbondingSigList[covIdx].Sig.ToBTCSig()
+		require.NoError(t, err)
+		require.True(t, unbondingSig.Verify(resp.SigHash, covPK))
+
+		resp, err = keeper.CovenantSignMsg(ctx, &types.QueryCovenantSignMsgRequest{
+			StakingTxHashHex: stakingTxHashHex,
+			Path:             types.CovenantSpendPath_SLASHING,
+			CovenantPkHex:    covPKHex,
+		})
+		require.NoError(t, err)
+		require.Equal(t, btcDel.SlashingTx.MustMarshal(), resp.SigningTx)
+		require.Equal(t, btcDel.FpBtcPkList, resp.EncKeyList)
+		require.True(t, sortedCovPKs[resp.CovenantKeyIndex].IsEqual(covPK))
+		slashingASig, err := asig.NewAdaptorSignatureFromBytes(btcDel.CovenantSigs[covIdx].AdaptorSigs[0])
+		require.NoError(t, err)
+		require.NoError(t, slashingASig.EncVerify(covPK, encKey, resp.SigHash))
+
+		resp, err = keeper.CovenantSignMsg(ctx, &types.QueryCovenantSignMsgRequest{
+			StakingTxHashHex: stakingTxHashHex,
+			Path:             types.CovenantSpendPath_UNBONDING_SLASHING,
+			CovenantPkHex:    covPKHex,
+		})
+		require.NoError(t, err)
+		require.Equal(t, btcDel.BtcUndelegation.SlashingTx.MustMarshal(), resp.SigningTx)
+		require.Equal(t, btcDel.FpBtcPkList, resp.EncKeyList)
+		unbondingSlashingASig, err := asig.NewAdaptorSignatureFromBytes(btcDel.BtcUndelegation.CovenantSlashingSigs[covIdx].AdaptorSigs[0])
+		require.NoError(t, err)
+		require.NoError(t, unbondingSlashingASig.EncVerify(covPK, encKey, resp.SigHash))
+
+		// unknown spend path
+		_, err = keeper.CovenantSignMsg(ctx, &types.QueryCovenantSignMsgRequest{
+			StakingTxHashHex: stakingTxHashHex,
+			Path:             types.CovenantSpendPath(3),
+			CovenantPkHex:    covPKHex,
+		})
+		require.Error(t, err)
+
+		// PK that is not in the covenant committee
+		_, randPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		_, err = keeper.CovenantSignMsg(ctx, &types.QueryCovenantSignMsgRequest{
+			StakingTxHashHex: stakingTxHashHex,
+			Path:             types.CovenantSpendPath_UNBONDING,
+			CovenantPkHex:    bbn.NewBIP340PubKeyFromBTCPK(randPK).MarshalHex(),
+		})
+		require.ErrorIs(t, err, types.ErrInvalidCovenantPK)
+
+		// unknown BTC delegation
+		_, err = keeper.CovenantSignMsg(ctx, &types.QueryCovenantSignMsgRequest{
+			StakingTxHashHex: datagen.GenRandomBtcdHash(r).String(),
+			Path:             types.CovenantSpendPath_UNBONDING,
+			CovenantPkHex:    covPKHex,
+		})
+		require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+	})
+}
+
+func FuzzDelegationFirstRewardHeight(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client, BTC checkpoint, checkpointing and incentive modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		iKeeper := types.NewMockIncentiveKeeper(ctrl)
+		h := NewHelperWithIncentiveKeeper(t, btclcKeeper, btccKeeper, ckptKeeper, iKeeper)
+
+		// the current epoch, within which the Babylon height stays
+		epoch := &etypes.Epoch{
+			EpochNumber:          datagen.RandomInt(r, 10) + 1,
+			CurrentEpochInterval: datagen.RandomInt(r, 10) + 5,
+			FirstBlockHeight:     datagen.RandomInt(r, 100) + 1,
+		}
+		ckptKeeper.EXPECT().GetEpoch(gomock.Any()).Return(epoch).AnyTimes()
+		// expectedEpochOf returns the epoch of the given height by walking
+		// through the epochs after the current one
+		expectedEpochOf := func(height uint64) uint64 {
+			epochNum, first := epoch.EpochNumber, epoch.FirstBlockHeight
+			for height >= first+epoch.CurrentEpochInterval {
+				epochNum++
+				first += epoch.CurrentEpochInterval
+			}
+			return epochNum
+		}
+		expectedFirstHeightOf := func(epochNum uint64) uint64 {
+			return epoch.FirstBlockHeight + (epochNum-epoch.EpochNumber)*epoch.CurrentEpochInterval
+		}
+
+		lockupEpochs := datagen.RandomInt(r, 4)
+		iKeeper.EXPECT().GetRewardLockupEpochs(gomock.Any()).Return(lockupEpochs).AnyTimes()
+		rewardStartEpochs := map[string]uint64{}
+		rewardedBTCDels := map[string]bool{}
+		iKeeper.EXPECT().GetBTCDelRewardLockup(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ interface{}, stakingTxHash *chainhash.Hash) (uint64, bool, bool) {
+				startEpoch, found := rewardStartEpochs[stakingTxHash.String()]
+				return startEpoch, found, rewardedBTCDels[stakingTxHash.String()]
+			},
+		).AnyTimes()
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+
+		// Test nil request
+		resp, err := h.BTCStakingKeeper.DelegationFirstRewardHeight(h.Ctx, nil)
+		require.Nil(t, resp)
+		require.Error(t, err)
+
+		// generate and insert new BTC delegation
+		stakingTxHash, _, _, msgCreateBTCDel, actualDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			int64(2*10e8),
+			1000,
+		)
+		btcTip := btclcKeeper.GetTipInfo(h.Ctx)
+		babylonHeight := epoch.FirstBlockHeight + datagen.RandomInt(r, int(epoch.CurrentEpochInterval-2))
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+
+		// checkFirstRewardHeight checks the response against the expected
+		// activation height of a BTC delegation that has not been rewarded yet
+		checkFirstRewardHeight := func(resp *types.QueryDelegationFirstRewardHeightResponse, activationHeight uint64) {
+			finalizationHeight := activationHeight + 1
+			startEpoch := expectedEpochOf(finalizationHeight)
+			firstRewardHeight := finalizationHeight
+			if lockupEpochs > 0 {
+				firstRewardHeight = expectedFirstHeightOf(startEpoch + 1)
+			}
+			require.False(t, resp.Earning)
+			require.Equal(t, activationHeight, resp.ActivationHeight)
+			require.Equal(t, finalizationHeight, resp.FinalizationHeight)
+			require.Equal(t, startEpoch, resp.RewardStartEpoch)
+			require.Equal(t, lockupEpochs, resp.RewardLockupEpochs)
+			require.Equal(t, firstRewardHeight, resp.FirstRewardHeight)
+			require.Equal(t, firstRewardHeight-babylonHeight, resp.BlocksUntilFirstReward)
+		}
+
+		// the BTC delegation waits for a covenant quorum
+		req := &types.QueryDelegationFirstRewardHeightRequest{StakingTxHashHex: stakingTxHash}
+		resp, err = h.BTCStakingKeeper.DelegationFirstRewardHeight(h.Ctx, req)
+		h.NoError(err)
+		require.True(t, resp.AwaitingCovenantQuorum)
+		checkFirstRewardHeight(resp, babylonHeight+2)
+
+		// activate the BTC delegation, which enters the voting power
+		// distribution at the next height
+		msgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel)
+		for i := 0; i < int(h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum); i++ {
+			_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msgs[i])
+			h.NoError(err)
+		}
+		resp, err = h.BTCStakingKeeper.DelegationFirstRewardHeight(h.Ctx, req)
+		h.NoError(err)
+		require.False(t, resp.AwaitingCovenantQuorum)
+		checkFirstRewardHeight(resp, babylonHeight+1)
+
+		// the BTC delegation is in the voting power distribution at the next height
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		resp, err = h.BTCStakingKeeper.DelegationFirstRewardHeight(h.Ctx, req)
+		h.NoError(err)
+		checkFirstRewardHeight(resp, babylonHeight)
+
+		// the BTC delegation receives its first reward distribution in the
+		// current epoch, after which it earns rewards unless they are locked
+		// up. Its start epoch is only recorded under a reward lockup
+		rewardedBTCDels[stakingTxHash] = true
+		if lockupEpochs > 0 {
+			rewardStartEpochs[stakingTxHash] = epoch.EpochNumber
+		}
+		resp, err = h.BTCStakingKeeper.DelegationFirstRewardHeight(h.Ctx, req)
+		h.NoError(err)
+		require.Zero(t, resp.ActivationHeight)
+		if lockupEpochs > 0 {
+			require.Equal(t, epoch.EpochNumber, resp.RewardStartEpoch)
+			require.False(t, resp.Earning)
+			require.Equal(t, expectedFirstHeightOf(epoch.EpochNumber+1), resp.FirstRewardHeight)
+			require.Equal(t, resp.FirstRewardHeight-babylonHeight, resp.BlocksUntilFirstReward)
+		} else {
+			require.Zero(t, resp.RewardStartEpoch)
+			require.True(t, resp.Earning)
+			require.Equal(t, babylonHeight, resp.FirstRewardHeight)
+			require.Zero(t, resp.BlocksUntilFirstReward)
+		}
+
+		// unknown BTC delegation
+		_, err = h.BTCStakingKeeper.DelegationFirstRewardHeight(h.Ctx, &types.QueryDelegationFirstRewardHeightRequest{
+			StakingTxHashHex: datagen.GenRandomBtcdHash(r).String(),
+		})
+		require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+	})
+}
+
+func FuzzDelegationsSpendableVia(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+		params := keeper.GetParams(ctx)
+		params.CovenantPks = bbn.NewBIP340PKsFromBTCPKs(covenantPKs)
+		params.CovenantQuorum = covenantQuorum
+		err = keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		keeper.SetFinalityProvider(ctx, fp)
+
+		startHeight := datagen.RandomInt(r, 100) + 10
+		tipHeight := startHeight + datagen.RandomInt(r, 100)
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: tipHeight}).AnyTimes()
+
+		// generate BTC delegations that are randomly expired, missing
+		// covenant signatures, or restaking to a slashed finality provider
+		numBTCDels := datagen.RandomInt(r, 10) + 1
+		expected := map[types.StakingOutputSpendPath]map[string]bool{
+			types.StakingOutputSpendPath_TIMELOCK_PATH:  {},
+			types.StakingOutputSpendPath_UNBONDING_PATH: {},
+			types.StakingOutputSpendPath_SLASHING_PATH:  {},
+		}
+		for i := uint64(0); i < numBTCDels; i++ {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			endHeight := startHeight + datagen.RandomInt(r, 200) + 10
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				net,
+				[]bbn.BIP340PubKey{*fp.BtcPk},
+				delSK,
+				covenantSKs,
+				covenantPKs,
+				covenantQuorum,
+				slashingAddress.EncodeAddress(),
+				startHeight, endHeight, 10000,
+				slashingRate,
+				uint16(101),
+			)
+			require.NoError(t, err)
+			hasSlashingQuorum := datagen.OneInN(r, 2)
+			if !hasSlashingQuorum {
+				btcDel.CovenantSigs = nil
+			}
+			hasUnbondingQuorum := datagen.OneInN(r, 2)
+			if !hasUnbondingQuorum {
+				btcDel.BtcUndelegation.CovenantUnbondingSigList = nil
+			}
+			isSlashable := datagen.OneInN(r, 2)
+			if isSlashable {
+				btcDel.FpSlashedBtcHeight = tipHeight
+			}
+			err = keeper.AddBTCDelegation(ctx, btcDel)
+			require.NoError(t, err)
+
+			stakingTxHex := hex.EncodeToString(btcDel.StakingTx)
+			if tipHeight+1 >= endHeight {
+				expected[types.StakingOutputSpendPath_TIMELOCK_PATH][stakingTxHex] = true
+			}
+			if hasUnbondingQuorum {
+				expected[types.StakingOutputSpendPath_UNBONDING_PATH][stakingTxHex] = true
+			}
+			if hasSlashingQuorum && isSlashable {
+				expected[types.StakingOutputSpendPath_SLASHING_PATH][stakingTxHex] = true
+			}
+		}
+
+		for path, expectedDels := range expected {
+			// query at the current BTC tip in pages
+			limit := datagen.RandomInt(r, int(numBTCDels)) + 1
+			pagination := constructRequestWithLimit(r, limit)
+			actualDels := map[string]bool{}
+			for {
+				resp, err := keeper.DelegationsSpendableVia(ctx, &types.QueryDelegationsSpendableViaRequest{
+					Path:       path,
+					Pagination: pagination,
+				})
+				require.NoError(t, err)
+				require.Equal(t, tipHeight, resp.BtcTipHeight)
+				for _, btcDel := range resp.BtcDelegations {
+					actualDels[btcDel.StakingTxHex] = true
+				}
+				if resp.Pagination.NextKey == nil {
+					break
+				}
+				pagination.Key = resp.Pagination.NextKey
+			}
+			require.Equal(t, expectedDels, actualDels)
+		}
+
+		// none of the staking outputs is spendable via the slashing path
+		// before the finality provider is slashed
+		resp, err := keeper.DelegationsSpendableVia(ctx, &types.QueryDelegationsSpendableViaRequest{
+			Path:         types.StakingOutputSpendPath_SLASHING_PATH,
+			BtcTipHeight: tipHeight - 1,
+		})
+		require.NoError(t, err)
+		require.Empty(t, resp.BtcDelegations)
+
+		// invalid path is rejected
+		_, err = keeper.DelegationsSpendableVia(ctx, &types.QueryDelegationsSpendableViaRequest{
+			Path: types.StakingOutputSpendPath(3),
+		})
+		require.Error(t, err)
+	})
+}
+
+func FuzzCovenantSigNeeded(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btcTipHeight := uint64(10)
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).DoAndReturn(func(_ context.Context) *btclctypes.BTCHeaderInfo {
+			return &btclctypes.BTCHeaderInfo{Height: btcTipHeight}
+		}).AnyTimes()
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+		params := keeper.GetParams(ctx)
+		params.CovenantPks = bbn.NewBIP340PKsFromBTCPKs(covenantPKs)
+		params.CovenantQuorum = covenantQuorum
+		err = keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		keeper.SetFinalityProvider(ctx, fp)
+		genBTCDel := func() *types.BTCDelegation {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				net,
+				[]bbn.BIP340PubKey{*fp.BtcPk},
+				delSK,
+				covenantSKs,
+				covenantPKs,
+				covenantQuorum,
+				slashingAddress.EncodeAddress(),
+				1, 1000, 10000,
+				slashingRate,
+				uint16(101),
+			)
+			require.NoError(t, err)
+			btcDel.ParamsVersion = keeper.GetParamsWithVersion(ctx).Version
+			return btcDel
+		}
+		querySigNeeded := func(btcDel *types.BTCDelegation, covIdx int) *types.QueryCovenantSigNeededResponse {
+			resp, err := keeper.CovenantSigNeeded(ctx, &types.QueryCovenantSigNeededRequest{
+				StakingTxHashHex: btcDel.MustGetStakingTxHash().String(),
+				CovenantPkHex:    bbn.NewBIP340PubKeyFromBTCPK(covenantPKs[covIdx]).MarshalHex(),
+			})
+			require.NoError(t, err)
+			require.Equal(t, resp.Needed, len(resp.MissingPaths) > 0)
+			return resp
+		}
+		allPaths := []types.CovenantSpendPath{
+			types.CovenantSpendPath_UNBONDING,
+			types.CovenantSpendPath_SLASHING,
+			types.CovenantSpendPath_UNBONDING_SLASHING,
+		}
+
+		// a BTC delegation with covenant quorums no longer needs signatures
+		activeDel := genBTCDel()
+		err = keeper.AddBTCDelegation(ctx, activeDel)
+		require.NoError(t, err)
+		for i := range covenantPKs {
+			require.False(t, querySigNeeded(activeDel, i).Needed)
+		}
+
+		// a pending BTC delegation signed by the first quorum-1 covenant
+		// members, where the last covenant member has only signed the
+		// unbonding tx
+		pendingDel := genBTCDel()
+		numSigned := int(covenantQuorum) - 1
+		pendingDel.CovenantSigs = pendingDel.CovenantSigs[:numSigned]
+		pendingDel.BtcUndelegation.CovenantSlashingSigs = pendingDel.BtcUndelegation.CovenantSlashingSigs[:numSigned]
+		lastIdx := len(covenantPKs) - 1
+		partialUnbondingSig := pendingDel.BtcUndelegation.CovenantUnbondingSigList[lastIdx]
+		pendingDel.BtcUndelegation.CovenantUnbondingSigList = append(pendingDel.BtcUndelegation.CovenantUnbondingSigList[:numSigned], partialUnbondingSig)
+		err = keeper.AddBTCDelegation(ctx, pendingDel)
+		require.NoError(t, err)
+		for i := range covenantPKs {
+			resp := querySigNeeded(pendingDel, i)
+			switch {
+			case i < numSigned:
+				require.False(t, resp.Needed)
+			case i == lastIdx:
+				require.Equal(t, []types.CovenantSpendPath{types.CovenantSpendPath_SLASHING, types.CovenantSpendPath_UNBONDING_SLASHING}, resp.MissingPaths)
+			default:
+				require.Equal(t, allPaths, resp.MissingPaths)
+			}
+		}
+
+		// once the finality provider is slashed, only the signature on the
+		// unbonding tx is needed
+		fp.SlashedBabylonHeight = 1
+		keeper.SetFinalityProvider(ctx, fp)
+		for i := numSigned; i < lastIdx; i++ {
+			require.Equal(t, []types.CovenantSpendPath{types.CovenantSpendPath_UNBONDING}, querySigNeeded(pendingDel, i).MissingPaths)
+		}
+		require.False(t, querySigNeeded(pendingDel, lastIdx).Needed)
+		fp.SlashedBabylonHeight = 0
+		keeper.SetFinalityProvider(ctx, fp)
+
+		// no signature is needed once the BTC delegation expires
+		btcTipHeight = pendingDel.EndHeight
+		for i := range covenantPKs {
+			require.False(t, querySigNeeded(pendingDel, i).Needed)
+		}
+
+		// PK that is not in the covenant committee
+		_, randPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		_, err = keeper.CovenantSigNeeded(ctx, &types.QueryCovenantSigNeededRequest{
+			StakingTxHashHex: pendingDel.MustGetStakingTxHash().String(),
+			CovenantPkHex:    bbn.NewBIP340PubKeyFromBTCPK(randPK).MarshalHex(),
+		})
+		require.ErrorIs(t, err, types.ErrInvalidCovenantPK)
+
+		// unknown BTC delegation
+		_, err = keeper.CovenantSigNeeded(ctx, &types.QueryCovenantSigNeededRequest{
+			StakingTxHashHex: datagen.GenRandomBtcdHash(r).String(),
+			CovenantPkHex:    bbn.NewBIP340PubKeyFromBTCPK(covenantPKs[0]).MarshalHex(),
+		})
+		require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+	})
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	ltypes "github.com/babylonchain/babylon/x/btclightclient/types"
+)
+
+type Hooks struct {
+	k Keeper
+}
+
+var _ ltypes.BTCLightClientHooks = Hooks{}
+
+func (k Keeper) Hooks() Hooks { return Hooks{k} }
+
+// AfterBTCRollBack records the height that the BTC light client rolls back
+// to, so that BTC delegations whose staking tx is included in an orphaned BTC
+// block are invalidated upon the next `BeginBlock`
+func (h Hooks) AfterBTCRollBack(ctx context.Context, headerInfo *ltypes.BTCHeaderInfo) {
+	h.k.setBTCRollBackHeight(ctx, headerInfo.Height)
+}
+
+func (h Hooks) AfterBTCRollForward(_ context.Context, _ *ltypes.BTCHeaderInfo) {}
+
+func (h Hooks) AfterBTCHeaderInserted(_ context.Context, _ *ltypes.BTCHeaderInfo) {}
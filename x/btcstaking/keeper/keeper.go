@@ -21,6 +21,7 @@ type (
 		btclcKeeper types.BTCLightClientKeeper
 		btccKeeper  types.BtcCheckpointKeeper
 		ckptKeeper  types.CheckpointingKeeper
+		iKeeper     types.IncentiveKeeper
 
 		btcNet *chaincfg.Params
 		// the address capable of executing a MsgUpdateParams message. Typically, this
@@ -36,6 +37,7 @@ func NewKeeper(
 	btclcKeeper types.BTCLightClientKeeper,
 	btccKeeper types.BtcCheckpointKeeper,
 	ckptKeeper types.CheckpointingKeeper,
+	iKeeper types.IncentiveKeeper,
 
 	btcNet *chaincfg.Params,
 	authority string,
@@ -47,6 +49,7 @@ func NewKeeper(
 		btclcKeeper: btclcKeeper,
 		btccKeeper:  btccKeeper,
 		ckptKeeper:  ckptKeeper,
+		iKeeper:     iKeeper,
 
 		btcNet:    btcNet,
 		authority: authority,
@@ -65,6 +68,8 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 func (k Keeper) BeginBlocker(ctx context.Context) error {
 	// index BTC height at the current height
 	k.IndexBTCHeight(ctx)
+	// invalidate BTC delegations whose staking tx is orphaned by BTC reorgs
+	k.InvalidateOrphanedBTCDelegations(ctx)
 	// update voting power distribution
 	k.UpdatePowerDist(ctx)
 
@@ -26,6 +26,64 @@ var (
 	net = &chaincfg.SimNetParams
 )
 
+// BTCDelGenerator generates random BTC delegations under a random covenant
+// committee, slashing address and slashing rate
+type BTCDelGenerator struct {
+	t *testing.T
+	r *rand.Rand
+
+	CovenantSKs     []*btcec.PrivateKey
+	CovenantPKs     []*btcec.PublicKey
+	CovenantQuorum  uint32
+	SlashingAddress string
+	SlashingRate    sdkmath.LegacyDec
+}
+
+func NewBTCDelGenerator(t *testing.T, r *rand.Rand) *BTCDelGenerator {
+	// covenant and slashing addr
+	covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+	slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+	require.NoError(t, err)
+	slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+
+	return &BTCDelGenerator{
+		t:               t,
+		r:               r,
+		CovenantSKs:     covenantSKs,
+		CovenantPKs:     covenantPKs,
+		CovenantQuorum:  covenantQuorum,
+		SlashingAddress: slashingAddress.EncodeAddress(),
+		SlashingRate:    slashingRate,
+	}
+}
+
+// GenBTCDelegation generates a random BTC delegation from a new BTC delegator
+// to the given finality providers, signed by all covenant members. It returns
+// the BTC delegation and the BTC delegator's secret key
+func (g *BTCDelGenerator) GenBTCDelegation(
+	fpPKs []bbn.BIP340PubKey,
+	startHeight, endHeight, totalSat uint64,
+) (*types.BTCDelegation, *btcec.PrivateKey) {
+	delSK, _, err := datagen.GenRandomBTCKeyPair(g.r)
+	require.NoError(g.t, err)
+	btcDel, err := datagen.GenRandomBTCDelegation(
+		g.r,
+		g.t,
+		net,
+		fpPKs,
+		delSK,
+		g.CovenantSKs,
+		g.CovenantPKs,
+		g.CovenantQuorum,
+		g.SlashingAddress,
+		startHeight, endHeight, totalSat,
+		g.SlashingRate,
+		uint16(101),
+	)
+	require.NoError(g.t, err)
+	return btcDel, delSK
+}
+
 type Helper struct {
 	t testing.TB
 
@@ -39,7 +97,11 @@ type Helper struct {
 }
 
 func NewHelper(t testing.TB, btclcKeeper *types.MockBTCLightClientKeeper, btccKeeper *types.MockBtcCheckpointKeeper, ckptKeeper *types.MockCheckpointingKeeper) *Helper {
-	k, ctx := keepertest.BTCStakingKeeper(t, btclcKeeper, btccKeeper, ckptKeeper)
+	return NewHelperWithIncentiveKeeper(t, btclcKeeper, btccKeeper, ckptKeeper, nil)
+}
+
+func NewHelperWithIncentiveKeeper(t testing.TB, btclcKeeper *types.MockBTCLightClientKeeper, btccKeeper *types.MockBtcCheckpointKeeper, ckptKeeper *types.MockCheckpointingKeeper, iKeeper types.IncentiveKeeper) *Helper {
+	k, ctx := keepertest.BTCStakingKeeper(t, btclcKeeper, btccKeeper, ckptKeeper, iKeeper)
 	ctx = ctx.WithHeaderInfo(header.Info{Height: 1})
 	msgSrvr := keeper.NewMsgServerImpl(*k)
 
@@ -151,6 +213,49 @@ func (h *Helper) CreateDelegationCustom(
 	unbondingValue int64,
 	unbondingTime uint16,
 ) (string, *btcec.PrivateKey, *btcec.PublicKey, *types.MsgCreateBTCDelegation, error) {
+	return h.createDelegation(r, []*btcec.PublicKey{fpPK}, stakingValue, stakingTime, unbondingValue, unbondingTime, 1)
+}
+
+// createDelegation creates a BTC delegation restaking to the given finality
+// providers, whose staking tx spends numStakingTxInputs random UTXOs
+func (h *Helper) createDelegation(
+	r *rand.Rand,
+	fpPKs []*btcec.PublicKey,
+	stakingValue int64,
+	stakingTime uint16,
+	unbondingValue int64,
+	unbondingTime uint16,
+	numStakingTxInputs int,
+) (string, *btcec.PrivateKey, *btcec.PublicKey, *types.MsgCreateBTCDelegation, error) {
+	stakingTxHash, delSK, delPK, msgCreateBTCDel := h.genMsgCreateBTCDelegation(
+		r,
+		fpPKs,
+		stakingValue,
+		stakingTime,
+		unbondingValue,
+		unbondingTime,
+		numStakingTxInputs,
+	)
+
+	_, err := h.MsgServer.CreateBTCDelegation(h.Ctx, msgCreateBTCDel)
+	if err != nil {
+		return "", nil, nil, nil, err
+	}
+
+	return stakingTxHash, delSK, delPK, msgCreateBTCDel, nil
+}
+
+// genMsgCreateBTCDelegation generates a valid MsgCreateBTCDelegation without
+// submitting it, where the staking tx is included in a BTC block at height 10
+func (h *Helper) genMsgCreateBTCDelegation(
+	r *rand.Rand,
+	fpPKs []*btcec.PublicKey,
+	stakingValue int64,
+	stakingTime uint16,
+	unbondingValue int64,
+	unbondingTime uint16,
+	numStakingTxInputs int,
+) (string, *btcec.PrivateKey, *btcec.PublicKey, *types.MsgCreateBTCDelegation) {
 	delSK, delPK, err := datagen.GenRandomBTCKeyPair(r)
 	h.NoError(err)
 	stakingTimeBlocks := stakingTime
@@ -158,12 +263,13 @@ func (h *Helper) CreateDelegationCustom(
 	covPKs, err := bbn.NewBTCPKsFromBIP340PKs(bsParams.CovenantPks)
 	h.NoError(err)
 
-	testStakingInfo := datagen.GenBTCStakingSlashingInfo(
+	testStakingInfo := datagen.GenBTCStakingSlashingInfoWithOutPoints(
 		r,
 		h.t,
 		h.Net,
+		datagen.GenRandomOutPoints(r, numStakingTxInputs),
 		delSK,
-		[]*btcec.PublicKey{fpPK},
+		fpPKs,
 		covPKs,
 		bsParams.CovenantQuorum,
 		stakingTimeBlocks,
@@ -222,7 +328,7 @@ func (h *Helper) CreateDelegationCustom(
 		h.t,
 		h.Net,
 		delSK,
-		[]*btcec.PublicKey{fpPK},
+		fpPKs,
 		covPKs,
 		bsParams.CovenantQuorum,
 		wire.NewOutPoint(&stkTxHash, stkOutputIdx),
@@ -241,12 +347,11 @@ func (h *Helper) CreateDelegationCustom(
 	h.NoError(err)
 
 	// all good, construct and send MsgCreateBTCDelegation message
-	fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(fpPK)
 	msgCreateBTCDel := &types.MsgCreateBTCDelegation{
 		Signer:                        signer,
 		BabylonPk:                     delBabylonPK.(*secp256k1.PubKey),
 		BtcPk:                         stPk,
-		FpBtcPkList:                   []bbn.BIP340PubKey{*fpBTCPK},
+		FpBtcPkList:                   bbn.NewBIP340PKsFromBTCPKs(fpPKs),
 		Pop:                           pop,
 		StakingTime:                   uint32(stakingTimeBlocks),
 		StakingValue:                  stakingValue,
@@ -260,12 +365,7 @@ func (h *Helper) CreateDelegationCustom(
 		DelegatorUnbondingSlashingSig: delSlashingTxSig,
 	}
 
-	_, err = h.MsgServer.CreateBTCDelegation(h.Ctx, msgCreateBTCDel)
-	if err != nil {
-		return "", nil, nil, nil, err
-	}
-
-	return stakingTxHash, delSK, delPK, msgCreateBTCDel, nil
+	return stakingTxHash, delSK, delPK, msgCreateBTCDel
 }
 
 func (h *Helper) CreateDelegation(
@@ -303,6 +403,62 @@ func (h *Helper) CreateDelegation(
 	return stakingTxHash, delSK, delPK, msgCreateBTCDel, btcDel
 }
 
+// CreateMultiInputDelegation creates a BTC delegation whose staking tx
+// consolidates numStakingTxInputs UTXOs
+func (h *Helper) CreateMultiInputDelegation(
+	r *rand.Rand,
+	fpPK *btcec.PublicKey,
+	stakingValue int64,
+	stakingTime uint16,
+	numStakingTxInputs int,
+) (string, *types.MsgCreateBTCDelegation, *types.BTCDelegation) {
+	minUnbondingTime := types.MinimumUnbondingTime(
+		h.BTCStakingKeeper.GetParams(h.Ctx),
+		h.BTCCheckpointKeeper.GetParams(h.Ctx),
+	)
+
+	stakingTxHash, _, _, msgCreateBTCDel, err := h.createDelegation(
+		r,
+		[]*btcec.PublicKey{fpPK},
+		stakingValue,
+		stakingTime,
+		stakingValue-1000,
+		uint16(minUnbondingTime)+1,
+		numStakingTxInputs,
+	)
+	h.NoError(err)
+
+	btcDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+	h.NoError(err)
+
+	return stakingTxHash, msgCreateBTCDel, btcDel
+}
+
+// CreateMultiFPDelegation creates a BTC delegation restaking to the given
+// finality providers, and returns the error of creating it if any
+func (h *Helper) CreateMultiFPDelegation(
+	r *rand.Rand,
+	fpPKs []*btcec.PublicKey,
+	stakingValue int64,
+	stakingTime uint16,
+) (string, *types.MsgCreateBTCDelegation, error) {
+	minUnbondingTime := types.MinimumUnbondingTime(
+		h.BTCStakingKeeper.GetParams(h.Ctx),
+		h.BTCCheckpointKeeper.GetParams(h.Ctx),
+	)
+
+	stakingTxHash, _, _, msgCreateBTCDel, err := h.createDelegation(
+		r,
+		fpPKs,
+		stakingValue,
+		stakingTime,
+		stakingValue-1000,
+		uint16(minUnbondingTime)+1,
+		1,
+	)
+	return stakingTxHash, msgCreateBTCDel, err
+}
+
 func (h *Helper) GenerateCovenantSignaturesMessages(
 	r *rand.Rand,
 	covenantSKs []*btcec.PrivateKey,
@@ -391,7 +547,8 @@ func (h *Helper) CreateCovenantSigs(
 
 	h.NoError(err)
 	covenantMsgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, del)
-	for _, msg := range covenantMsgs {
+	// a quorum of covenant signatures activates the BTC delegation
+	for _, msg := range covenantMsgs[:bsParams.CovenantQuorum] {
 		msgCopy := msg
 		_, err := h.MsgServer.AddCovenantSigs(h.Ctx, msgCopy)
 		h.NoError(err)
@@ -426,6 +583,10 @@ func (h *Helper) GetDelegationAndCheckValues(
 	require.Equal(h.t, msgCreateBTCDel.Pop, actualDel.Pop)
 	require.Equal(h.t, msgCreateBTCDel.StakingTx.Transaction, actualDel.StakingTx)
 	require.Equal(h.t, msgCreateBTCDel.SlashingTx, actualDel.SlashingTx)
+	require.Equal(h.t, uint64(h.Ctx.HeaderInfo().Height), actualDel.CreatedBabylonHeight)
+	btccParams := h.BTCCheckpointKeeper.GetParams(h.Ctx)
+	require.Equal(h.t, btccParams.BtcConfirmationDepth, actualDel.BtcConfirmationDepth)
+	require.Equal(h.t, btccParams.CheckpointFinalizationTimeout, actualDel.CheckpointFinalizationTimeout)
 	// ensure the BTC delegation in DB is correctly formatted
 	err = actualDel.ValidateBasic()
 	h.NoError(err)
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// Migrator is a struct for handling in-place store migrations
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the btcstaking module state from consensus version 1
+// to 2. It indexes the existing BTC delegations by the BTC height of their
+// staking tx, which is needed for pruning them, invalidating them upon BTC
+// reorgs, and querying them by inclusion height
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	store := m.keeper.btcDelegationStore(ctx)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	btcDels := []*types.BTCDelegation{}
+	for ; iter.Valid(); iter.Next() {
+		var btcDel types.BTCDelegation
+		m.keeper.cdc.MustUnmarshal(iter.Value(), &btcDel)
+		btcDels = append(btcDels, &btcDel)
+	}
+	// setting the BTC delegation again writes its index
+	for _, btcDel := range btcDels {
+		m.keeper.setBTCDelegation(ctx, btcDel)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/babylonchain/babylon/testutil/helper"
+	"github.com/babylonchain/babylon/x/btcstaking/keeper"
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	r, h := rand.New(rand.NewSource(11)), helper.NewHelper(t)
+	k, ctx := h.App.BTCStakingKeeper, h.Ctx
+
+	fp := datagen.CreateNFinalityProviders(r, t, 1)[0]
+	h.AddFinalityProvider(fp)
+	delegations := createNDelegationsForFinalityProvider(
+		r,
+		t,
+		fp.BtcPk.MustToBTCPK(),
+		int64(r.Int31n(200000)+10000),
+		int(r.Int31n(10))+1,
+		k.GetParams(ctx).CovenantQuorum,
+	)
+	expectedHashes := []string{}
+	for _, del := range delegations {
+		h.AddDelegation(del)
+		expectedHashes = append(expectedHashes, del.MustGetStakingTxHash().String())
+	}
+	indexedHashes := func() []string {
+		hashes := []string{}
+		k.IterateBTCDelegationsByInclusionHeight(ctx, 0, math.MaxUint64, func(btcDel *types.BTCDelegation) bool {
+			hashes = append(hashes, btcDel.MustGetStakingTxHash().String())
+			return true
+		})
+		return hashes
+	}
+	require.ElementsMatch(t, expectedHashes, indexedHashes())
+
+	// BTC delegations created before consensus version 2 are not indexed by
+	// the BTC height of their staking tx
+	indexStore := prefix.NewStore(ctx.KVStore(h.App.GetKey(types.StoreKey)), types.BTCDelegationInclusionHeightKey)
+	iter := indexStore.Iterator(nil, nil)
+	indexKeys := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		indexKeys = append(indexKeys, iter.Key())
+	}
+	require.NoError(t, iter.Close())
+	for _, key := range indexKeys {
+		indexStore.Delete(key)
+	}
+	require.Empty(t, indexedHashes())
+
+	// the migration backfills the index
+	err := keeper.NewMigrator(k).Migrate1to2(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, expectedHashes, indexedHashes())
+}
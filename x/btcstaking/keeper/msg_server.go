@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -9,8 +10,10 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/babylonchain/babylon/btcstaking"
 	bbn "github.com/babylonchain/babylon/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -49,6 +52,22 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
+// PruneInactiveDelegations prunes the BTC delegations that have been
+// unbonded for long and whose rewards are fully withdrawn
+func (ms msgServer) PruneInactiveDelegations(goCtx context.Context, req *types.MsgPruneInactiveDelegations) (*types.MsgPruneInactiveDelegationsResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+	if req.OlderThanBlocks == 0 {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrap("older_than_blocks must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	prunedHashes := ms.Keeper.PruneInactiveDelegations(ctx, req.OlderThanBlocks)
+
+	return &types.MsgPruneInactiveDelegationsResponse{PrunedStakingTxHashHexList: prunedHashes}, nil
+}
+
 // CreateFinalityProvider creates a finality provider
 func (ms msgServer) CreateFinalityProvider(goCtx context.Context, req *types.MsgCreateFinalityProvider) (*types.MsgCreateFinalityProviderResponse, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyCreateFinalityProvider)
@@ -149,6 +168,171 @@ func caluculateMinimumUnbondingValue(
 	return minUnbondingOutputValue
 }
 
+// verifyUnbondingTxs verifies that the given unbonding tx spends the staking
+// output of a BTC delegation, and that the unbonding tx and its slashing tx are
+// consistent with the staking tx and the given params
+func (k Keeper) verifyUnbondingTxs(
+	params *types.Params,
+	stakerPk *btcec.PublicKey,
+	fpPKs []*btcec.PublicKey,
+	stakingMsgTx *wire.MsgTx,
+	stakingOutputIdx uint32,
+	unbondingTime uint16,
+	unbondingValue int64,
+	unbondingTx []byte,
+	unbondingSlashingTx *types.BTCSlashingTx,
+	delegatorUnbondingSlashingSig *bbn.BIP340Signature,
+) error {
+	covenantPKs, err := bbn.NewBTCPKsFromBIP340PKs(params.CovenantPks)
+	if err != nil {
+		// programming error
+		panic("failed to parse covenant PKs in KVStore")
+	}
+
+	// deserialize provided transactions
+	unbondingSlashingMsgTx, err := unbondingSlashingTx.ToMsgTx()
+	if err != nil {
+		return types.ErrInvalidSlashingTx.Wrapf("cannot convert unbonding slashing tx to wire.MsgTx: %v", err)
+	}
+	unbondingMsgTx, err := bbn.NewBTCTxFromBytes(unbondingTx)
+	if err != nil {
+		return types.ErrInvalidUnbondingTx.Wrapf("cannot be converted to wire.MsgTx: %v", err)
+	}
+	if len(unbondingMsgTx.TxIn) == 0 {
+		return types.ErrInvalidUnbondingTx.Wrapf("unbonding tx has no input")
+	}
+
+	// Check that unbonding tx input is pointing to staking tx
+	stakingTxHash := stakingMsgTx.TxHash()
+	if !unbondingMsgTx.TxIn[0].PreviousOutPoint.Hash.IsEqual(&stakingTxHash) {
+		return types.ErrInvalidUnbondingTx.Wrapf("slashing transaction must spend staking output")
+	}
+	// Check that staking tx output index matches unbonding tx output index
+	if unbondingMsgTx.TxIn[0].PreviousOutPoint.Index != stakingOutputIdx {
+		return types.ErrInvalidUnbondingTx.Wrapf("slashing transaction input must spend staking output")
+	}
+
+	// building unbonding info
+	unbondingInfo, err := btcstaking.BuildUnbondingInfo(
+		stakerPk,
+		fpPKs,
+		covenantPKs,
+		params.CovenantQuorum,
+		unbondingTime,
+		btcutil.Amount(unbondingValue),
+		k.btcNet,
+	)
+	if err != nil {
+		if errors.Is(err, btcstaking.ErrInsufficientSlashingFee) {
+			return types.ErrInsufficientSlashingFee.Wrapf("unbonding slashing tx: %v", err)
+		}
+		return types.ErrInvalidUnbondingTx.Wrapf("err: %v", err)
+	}
+
+	// get unbonding output index
+	unbondingOutputIdx, err := bbn.GetOutputIdxInBTCTx(unbondingMsgTx, unbondingInfo.UnbondingOutput)
+	if err != nil {
+		return types.ErrInvalidUnbondingTx.Wrapf("unbonding tx does not contain expected unbonding output")
+	}
+
+	// Check that slashing tx and unbonding tx are valid and consistent
+	err = btcstaking.CheckTransactions(
+		unbondingSlashingMsgTx,
+		unbondingMsgTx,
+		unbondingOutputIdx,
+		params.MinSlashingTxFeeSat,
+		params.SlashingRate,
+		params.MustGetSlashingAddress(k.btcNet),
+		stakerPk,
+		unbondingTime,
+		k.btcNet,
+	)
+	if err != nil {
+		return types.ErrInvalidUnbondingTx.Wrapf("err: %v", err)
+	}
+
+	// Check staker signature against slashing path of the unbonding tx
+	unbondingSlashingSpendInfo, err := unbondingInfo.SlashingPathSpendInfo()
+	if err != nil {
+		// our staking info was constructed by using BuildStakingInfo constructor, so if
+		// this fails, it is a programming error
+		panic(err)
+	}
+
+	err = unbondingSlashingTx.VerifySignature(
+		unbondingInfo.UnbondingOutput,
+		unbondingSlashingSpendInfo.GetPkScriptPath(),
+		stakerPk,
+		delegatorUnbondingSlashingSig,
+	)
+	if err != nil {
+		return types.ErrInvalidSlashingTx.Wrapf("invalid delegator signature: %v", err)
+	}
+
+	// Check unbonding tx fees against staking tx.
+	// - fee is larger than 0
+	// - ubonding output value is is at leat `MinUnbondingValue` percent of staking output value
+	if unbondingMsgTx.TxOut[0].Value >= stakingMsgTx.TxOut[stakingOutputIdx].Value {
+		// Note: we do not enfore any minimum fee for unbonding tx, we only require that it is larger than 0
+		// Given that unbonding tx must not be replacable and we do not allow sending it second time, it places
+		// burden on staker to choose right fee.
+		// Unbonding tx should not be replaceable at babylon level (and by extension on btc level), as this would
+		// allow staker to spam the network with unbonding txs, which would force covenant and finality provider to send signatures.
+		return types.ErrInvalidUnbondingTx.Wrapf("unbonding tx fee must be larger that 0")
+	}
+
+	minUnbondingValue := caluculateMinimumUnbondingValue(stakingMsgTx.TxOut[stakingOutputIdx], params)
+	if btcutil.Amount(unbondingMsgTx.TxOut[0].Value) < minUnbondingValue {
+		return types.ErrInvalidUnbondingTx.Wrapf("unbonding output value must be at least %s, based on staking output", minUnbondingValue)
+	}
+
+	return nil
+}
+
+// verifyStakingTxInclusion verifies that the given staking tx is included in
+// a k-deep BTC block, that its timelock has more than w BTC blocks left, and
+// that it is standard. It returns the start and end heights of the timelock
+func (ms msgServer) verifyStakingTxInclusion(
+	ctx context.Context,
+	params *types.Params,
+	stakingTxInfo *btcctypes.TransactionInfo,
+	stakingMsgTx *wire.MsgTx,
+	stakingTime uint16,
+	kValue uint64,
+	wValue uint64,
+) (uint64, uint64, error) {
+	// get startheight and endheight of the timelock
+	stakingTxHeader := ms.btclcKeeper.GetHeaderByHash(ctx, stakingTxInfo.Key.Hash)
+	if stakingTxHeader == nil {
+		return 0, 0, fmt.Errorf("header that includes the staking tx is not found")
+	}
+	startHeight := stakingTxHeader.Height
+	endHeight := stakingTxHeader.Height + uint64(stakingTime)
+
+	// ensure staking tx is k-deep
+	btcTip := ms.btclcKeeper.GetTipInfo(ctx)
+	stakingTxDepth := btcTip.Height - stakingTxHeader.Height
+	if stakingTxDepth < kValue {
+		return 0, 0, types.ErrInvalidStakingTx.Wrapf("not k-deep: k=%d; depth=%d", kValue, stakingTxDepth)
+	}
+	// ensure staking tx's timelock has more than w BTC blocks left
+	if btcTip.Height+wValue >= endHeight {
+		return 0, 0, types.ErrInvalidStakingTx.Wrapf("staking tx's timelock has no more than w(=%d) blocks left", wValue)
+	}
+
+	// verify staking tx info, i.e., inclusion proof
+	if err := stakingTxInfo.VerifyInclusion(stakingTxHeader.Header, ms.btccKeeper.GetPowLimit()); err != nil {
+		return 0, 0, types.ErrInvalidStakingTx.Wrapf("not included in the Bitcoin chain: %v", err)
+	}
+
+	// ensure staking tx is standard
+	if err := params.ValidateStakingTxStandardness(stakingMsgTx); err != nil {
+		return 0, 0, err
+	}
+
+	return startHeight, endHeight, nil
+}
+
 // CreateBTCDelegation creates a BTC delegation
 // TODO: refactor this handler. It's now too convoluted
 func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCreateBTCDelegation) (*types.MsgCreateBTCDelegationResponse, error) {
@@ -178,12 +362,23 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 	// - is larger than min unbonding time
 	// - is smaller than math.MaxUint16 (due to check in req.ValidateBasic())
 	validatedUnbondingTime := uint16(req.UnbondingTime)
+	// staking time in request is positive and at most math.MaxUint16 (due to
+	// check in req.ValidateBasic())
+	validatedStakingTime := uint16(req.StakingTime)
 
 	// verify proof of possession
 	if err := req.Pop.Verify(req.BabylonPk, req.BtcPk, ms.btcNet); err != nil {
 		return nil, types.ErrInvalidProofOfPossession.Wrapf("error while validating proof of posession: %v", err)
 	}
 
+	// Ensure the number of finality providers is bounded, as all of their
+	// keys are embedded in the staking script. Zero means unlimited
+	maxFps := vp.Params.MaxFinalityProvidersPerDelegation
+	if maxFps > 0 && uint32(len(req.FpBtcPkList)) > maxFps {
+		return nil, types.ErrTooManyFinalityProviders.Wrapf("got %d finality providers, at most %d are allowed",
+			len(req.FpBtcPkList), maxFps)
+	}
+
 	// Ensure all finality providers are known to Babylon, are not slashed,
 	// and their registered epochs are finalised
 	for _, fpBTCPK := range req.FpBtcPkList {
@@ -204,7 +399,10 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 		return nil, types.ErrInvalidStakingTx.Wrapf("cannot be parsed: %v", err)
 	}
 
-	// Check staking tx is not duplicated
+	// Check staking tx is not duplicated. This includes the staking tx of a BTC
+	// delegation invalidated by a BTC reorg, even if it is included in BTC
+	// again, since pending events and records keyed by its staking tx hash
+	// would otherwise apply to the new BTC delegation
 	stakingTxHash := stakingMsgTx.TxHash()
 	delgation := ms.getBTCDelegation(ctx, stakingTxHash)
 	if delgation != nil {
@@ -228,7 +426,7 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 		fpPKs,
 		covenantPKs,
 		vp.Params.CovenantQuorum,
-		uint16(req.StakingTime),
+		validatedStakingTime,
 		btcutil.Amount(req.StakingValue),
 		ms.btcNet,
 	)
@@ -236,34 +434,39 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 		return nil, types.ErrInvalidStakingTx.Wrapf("err: %v", err)
 	}
 
+	// the staking tx may spend any number of inputs, e.g., consolidating several
+	// UTXOs of the staker, so only its staking output is validated
 	stakingOutputIdx, err := bbn.GetOutputIdxInBTCTx(stakingMsgTx, stakingInfo.StakingOutput)
 	if err != nil {
 		return nil, types.ErrInvalidStakingTx.Wrap("staking tx does not contain expected staking output")
 	}
 
-	// Check staking tx timelock has correct values
-	// get startheight and endheight of the timelock
-	stakingTxHeader := ms.btclcKeeper.GetHeaderByHash(ctx, req.StakingTx.Key.Hash)
-	if stakingTxHeader == nil {
-		return nil, fmt.Errorf("header that includes the staking tx is not found")
-	}
-	startHeight := stakingTxHeader.Height
-	endHeight := stakingTxHeader.Height + uint64(req.StakingTime)
-
-	// ensure staking tx is k-deep
-	btcTip := ms.btclcKeeper.GetTipInfo(ctx)
-	stakingTxDepth := btcTip.Height - stakingTxHeader.Height
-	if stakingTxDepth < kValue {
-		return nil, types.ErrInvalidStakingTx.Wrapf("not k-deep: k=%d; depth=%d", kValue, stakingTxDepth)
-	}
-	// ensure staking tx's timelock has more than w BTC blocks left
-	if btcTip.Height+wValue >= endHeight {
-		return nil, types.ErrInvalidStakingTx.Wrapf("staking tx's timelock has no more than w(=%d) blocks left", wValue)
+	// Check staking tx timelock has correct values, unless the staking tx is
+	// not included in BTC yet. In that case, the checks are deferred to the
+	// activation of the reserved BTC delegation, and the timelock is kept as
+	// [0, stakingTime] such that the staking time can still be derived
+	startHeight, endHeight := uint64(0), uint64(validatedStakingTime)
+	if !req.Reserved {
+		startHeight, endHeight, err = ms.verifyStakingTxInclusion(
+			ctx,
+			&vp.Params,
+			req.StakingTx,
+			stakingMsgTx,
+			validatedStakingTime,
+			kValue,
+			wValue,
+		)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	// verify staking tx info, i.e., inclusion proof
-	if err := req.StakingTx.VerifyInclusion(stakingTxHeader.Header, ms.btccKeeper.GetPowLimit()); err != nil {
-		return nil, types.ErrInvalidStakingTx.Wrapf("not included in the Bitcoin chain: %v", err)
+	// a reserved BTC delegation has to be activated within a bounded number
+	// of BTC blocks, after which it is considered unbonded and can be pruned
+	reservationExpiryHeight := uint64(0)
+	if req.Reserved {
+		btcTipHeight := ms.btclcKeeper.GetTipInfo(ctx).Height
+		reservationExpiryHeight = btcTipHeight + uint64(vp.Params.GetEffectiveMaxReservationBTCBlocks())
 	}
 
 	// check slashing tx and its consistency with staking tx
@@ -291,9 +494,22 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 		validatedUnbondingTime,
 		ms.btcNet,
 	); err != nil {
+		if errors.Is(err, btcstaking.ErrInsufficientSlashingFee) {
+			return nil, types.ErrInsufficientSlashingFee.Wrapf("slashing tx: %v", err)
+		}
 		return nil, types.ErrInvalidStakingTx.Wrap(err.Error())
 	}
 
+	// Check change outputs of staking tx are well-formed for the network
+	if err := btcstaking.ValidateStakingTxChangeOutputs(
+		stakingMsgTx,
+		stakingOutputIdx,
+		slashingAddr,
+		ms.btcNet,
+	); err != nil {
+		return nil, types.ErrInvalidChangeAddress.Wrap(err.Error())
+	}
+
 	// verify delegator sig against slashing path of the staking tx's script
 	slashingSpendInfo, err := stakingInfo.SlashingPathSpendInfo()
 	if err != nil {
@@ -315,133 +531,132 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 	// have voting power only when 1) its corresponding staking tx is k-deep,
 	// and 2) it receives a covenant signature
 	newBTCDel := &types.BTCDelegation{
-		BabylonPk:        req.BabylonPk,
-		BtcPk:            req.BtcPk,
-		Pop:              req.Pop,
-		FpBtcPkList:      req.FpBtcPkList,
-		StartHeight:      startHeight,
-		EndHeight:        endHeight,
-		TotalSat:         uint64(stakingInfo.StakingOutput.Value),
-		StakingTx:        req.StakingTx.Transaction,
-		StakingOutputIdx: stakingOutputIdx,
-		SlashingTx:       req.SlashingTx,
-		DelegatorSig:     req.DelegatorSlashingSig,
-		UnbondingTime:    uint32(validatedUnbondingTime),
-		CovenantSigs:     nil,        // NOTE: covenant signature will be submitted in a separate msg by covenant
-		BtcUndelegation:  nil,        // this will be constructed in below code
-		ParamsVersion:    vp.Version, // version of the params against delegations was validated
+		BabylonPk:            req.BabylonPk,
+		BtcPk:                req.BtcPk,
+		Pop:                  req.Pop,
+		FpBtcPkList:          req.FpBtcPkList,
+		StartHeight:          startHeight,
+		EndHeight:            endHeight,
+		TotalSat:             uint64(stakingInfo.StakingOutput.Value),
+		StakingTx:            req.StakingTx.Transaction,
+		StakingOutputIdx:     stakingOutputIdx,
+		SlashingTx:           req.SlashingTx,
+		DelegatorSig:         req.DelegatorSlashingSig,
+		UnbondingTime:        uint32(validatedUnbondingTime),
+		CovenantSigs:         nil,        // NOTE: covenant signature will be submitted in a separate msg by covenant
+		BtcUndelegation:      nil,        // this will be constructed in below code
+		ParamsVersion:        vp.Version, // version of the params against delegations was validated
+		CreatedBabylonHeight: uint64(ctx.HeaderInfo().Height),
+		// snapshot k and w so that governance changes do not affect the status
+		// of this BTC delegation
+		BtcConfirmationDepth:          kValue,
+		CheckpointFinalizationTimeout: wValue,
+		RewardOptOut:                  req.RewardOptOut,
+		StakingTxKey:                  req.StakingTx.Key,
+		StakingTxInclusionProof:       req.StakingTx.Proof,
+		Reserved:                      req.Reserved,
+		ReservationExpiryBtcHeight:    reservationExpiryHeight,
 	}
 
 	/*
 		logics about early unbonding
 	*/
-
-	// deserialize provided transactions
-	unbondingSlashingMsgTx, err := req.UnbondingSlashingTx.ToMsgTx()
-	if err != nil {
-		return nil, types.ErrInvalidSlashingTx.Wrapf("cannot convert unbonding slashing tx to wire.MsgTx: %v", err)
-	}
-	unbondingMsgTx, err := bbn.NewBTCTxFromBytes(req.UnbondingTx)
-	if err != nil {
-		return nil, types.ErrInvalidUnbondingTx.Wrapf("cannot be converted to wire.MsgTx: %v", err)
+	if err := ms.verifyUnbondingTxs(
+		&vp.Params,
+		stakerPk,
+		fpPKs,
+		stakingMsgTx,
+		stakingOutputIdx,
+		validatedUnbondingTime,
+		req.UnbondingValue,
+		req.UnbondingTx,
+		req.UnbondingSlashingTx,
+		req.DelegatorUnbondingSlashingSig,
+	); err != nil {
+		return nil, err
 	}
 
-	// Check that unbonding tx input is pointing to staking tx
-	if !unbondingMsgTx.TxIn[0].PreviousOutPoint.Hash.IsEqual(&stakingTxHash) {
-		return nil, types.ErrInvalidUnbondingTx.Wrapf("slashing transaction must spend staking output")
-	}
-	// Check that staking tx output index matches unbonding tx output index
-	if unbondingMsgTx.TxIn[0].PreviousOutPoint.Index != stakingOutputIdx {
-		return nil, types.ErrInvalidUnbondingTx.Wrapf("slashing transaction input must spend staking output")
+	// all good, add BTC undelegation
+	newBTCDel.BtcUndelegation = &types.BTCUndelegation{
+		UnbondingTx:              req.UnbondingTx,
+		SlashingTx:               req.UnbondingSlashingTx,
+		DelegatorSlashingSig:     req.DelegatorUnbondingSlashingSig,
+		DelegatorUnbondingSig:    nil,
+		CovenantSlashingSigs:     nil,
+		CovenantUnbondingSigList: nil,
 	}
 
-	// building unbonding info
-	unbondingInfo, err := btcstaking.BuildUnbondingInfo(
-		newBTCDel.BtcPk.MustToBTCPK(),
-		fpPKs,
-		covenantPKs,
-		vp.Params.CovenantQuorum,
-		validatedUnbondingTime,
-		btcutil.Amount(req.UnbondingValue),
-		ms.btcNet,
-	)
-	if err != nil {
-		return nil, types.ErrInvalidUnbondingTx.Wrapf("err: %v", err)
+	// add this BTC delegation, and emit corresponding events
+	if err := ms.AddBTCDelegation(ctx, newBTCDel); err != nil {
+		panic(fmt.Errorf("failed to add BTC delegation that has passed verification: %w", err))
 	}
 
-	// get unbonding output index
-	unbondingOutputIdx, err := bbn.GetOutputIdxInBTCTx(unbondingMsgTx, unbondingInfo.UnbondingOutput)
-	if err != nil {
-		return nil, types.ErrInvalidUnbondingTx.Wrapf("unbonding tx does not contain expected unbonding output")
+	return &types.MsgCreateBTCDelegationResponse{}, nil
+}
+
+// ActivateReservedDelegation activates a reserved BTC delegation once its
+// staking tx is included in BTC. The staking tx has to be the one reserved in
+// the BTC delegation, and is verified against the parameters in effect when
+// the BTC delegation was created
+func (ms msgServer) ActivateReservedDelegation(goCtx context.Context, req *types.MsgActivateReservedDelegation) (*types.MsgActivateReservedDelegationResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyActivateReservedDelegation)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := req.ValidateBasic(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 
-	// Check that slashing tx and unbonding tx are valid and consistent
-	err = btcstaking.CheckTransactions(
-		unbondingSlashingMsgTx,
-		unbondingMsgTx,
-		unbondingOutputIdx,
-		vp.Params.MinSlashingTxFeeSat,
-		vp.Params.SlashingRate,
-		vp.Params.MustGetSlashingAddress(ms.btcNet),
-		stakerPk,
-		validatedUnbondingTime,
-		ms.btcNet,
-	)
+	btcDel, params, err := ms.getBTCDelWithParams(ctx, req.StakingTxHash)
 	if err != nil {
-		return nil, types.ErrInvalidUnbondingTx.Wrapf("err: %v", err)
+		return nil, err
 	}
 
-	// Check staker signature against slashing path of the unbonding tx
-	unbondingSlashingSpendInfo, err := unbondingInfo.SlashingPathSpendInfo()
-	if err != nil {
-		// our staking info was constructed by using BuildStakingInfo constructor, so if
-		// this fails, it is a programming error
-		panic(err)
+	// ensure the BTC delegation is still reserved, i.e., it is not activated
+	// yet, and is not unbonded or slashed in the meantime
+	btcTipHeight := ms.btclcKeeper.GetTipInfo(ctx).Height
+	if btcDel.IsReservationExpired(btcTipHeight) {
+		return nil, types.ErrReservationExpired.Wrapf("BTC delegation %s had to be activated before BTC height %d", req.StakingTxHash, btcDel.ReservationExpiryBtcHeight)
+	}
+	wValue := btcDel.FinalizationTimeout(ms.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout)
+	if delStatus := btcDel.GetStatus(btcTipHeight, wValue, params.CovenantQuorum); delStatus != types.BTCDelegationStatus_RESERVED {
+		return nil, types.ErrInvalidDelegationState.Wrapf("BTC delegation %s is not reserved, current status: %s", req.StakingTxHash, delStatus)
 	}
 
-	err = req.UnbondingSlashingTx.VerifySignature(
-		unbondingInfo.UnbondingOutput,
-		unbondingSlashingSpendInfo.GetPkScriptPath(),
-		newBTCDel.BtcPk.MustToBTCPK(),
-		req.DelegatorUnbondingSlashingSig,
-	)
+	// ensure the submitted staking tx is the reserved one
+	stakingMsgTx, err := bbn.NewBTCTxFromBytes(req.StakingTx.Transaction)
 	if err != nil {
-		return nil, types.ErrInvalidSlashingTx.Wrapf("invalid delegator signature: %v", err)
+		return nil, types.ErrInvalidStakingTx.Wrapf("cannot be parsed: %v", err)
 	}
-
-	// Check unbonding tx fees against staking tx.
-	// - fee is larger than 0
-	// - ubonding output value is is at leat `MinUnbondingValue` percent of staking output value
-	if unbondingMsgTx.TxOut[0].Value >= stakingMsgTx.TxOut[newBTCDel.StakingOutputIdx].Value {
-		// Note: we do not enfore any minimum fee for unbonding tx, we only require that it is larger than 0
-		// Given that unbonding tx must not be replacable and we do not allow sending it second time, it places
-		// burden on staker to choose right fee.
-		// Unbonding tx should not be replaceable at babylon level (and by extension on btc level), as this would
-		// allow staker to spam the network with unbonding txs, which would force covenant and finality provider to send signatures.
-		return nil, types.ErrInvalidUnbondingTx.Wrapf("unbonding tx fee must be larger that 0")
+	if stakingTxHash := stakingMsgTx.TxHash(); stakingTxHash.String() != req.StakingTxHash {
+		return nil, types.ErrInvalidStakingTx.Wrapf("staking tx hash %s does not match the reserved one %s", stakingTxHash.String(), req.StakingTxHash)
 	}
 
-	minUnbondingValue := caluculateMinimumUnbondingValue(stakingMsgTx.TxOut[stakingOutputIdx], &vp.Params)
-	if btcutil.Amount(unbondingMsgTx.TxOut[0].Value) < minUnbondingValue {
-		return nil, types.ErrInvalidUnbondingTx.Wrapf("unbonding output value must be at least %s, based on staking output", minUnbondingValue)
+	// verify the inclusion of the staking tx under the k and w snapshotted in
+	// the BTC delegation
+	startHeight, endHeight, err := ms.verifyStakingTxInclusion(
+		ctx,
+		params,
+		req.StakingTx,
+		stakingMsgTx,
+		btcDel.GetStakingTime(),
+		btcDel.BtcConfirmationDepth,
+		wValue,
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	// all good, add BTC undelegation
-	newBTCDel.BtcUndelegation = &types.BTCUndelegation{
-		UnbondingTx:              req.UnbondingTx,
-		SlashingTx:               req.UnbondingSlashingTx,
-		DelegatorSlashingSig:     req.DelegatorUnbondingSlashingSig,
-		DelegatorUnbondingSig:    nil,
-		CovenantSlashingSigs:     nil,
-		CovenantUnbondingSigList: nil,
+	// refuse to activate the BTC delegation if a finality provider it restakes
+	// to was slashed while it was reserved, in which case the delegator can
+	// only unbond
+	if ms.hasSlashedFinalityProvider(ctx, btcDel) {
+		ms.markFpSlashedBeforeActivation(ctx, btcDel)
+		return &types.MsgActivateReservedDelegationResponse{}, nil
 	}
 
-	// add this BTC delegation, and emit corresponding events
-	if err := ms.AddBTCDelegation(ctx, newBTCDel); err != nil {
-		panic(fmt.Errorf("failed to add BTC delegation that has passed verification: %w", err))
-	}
+	ms.activateReservedBTCDelegation(ctx, btcDel, startHeight, endHeight, req.StakingTx, params.CovenantQuorum)
 
-	return &types.MsgCreateBTCDelegationResponse{}, nil
+	return &types.MsgActivateReservedDelegationResponse{}, nil
 }
 
 func (ms msgServer) getBTCDelWithParams(
@@ -486,17 +701,26 @@ func (ms msgServer) AddCovenantSigs(goCtx context.Context, req *types.MsgAddCove
 		ms.Logger(ctx).Debug("Received duplicated covenant signature", "covenant pk", req.Pk.MarshalHex())
 		return &types.MsgAddCovenantSigsResponse{}, nil
 	}
+	if btcDel.FpSlashedBeforeActivation && btcDel.BtcUndelegation.IsSignedByCovMemberOnUnbonding(req.Pk) {
+		ms.Logger(ctx).Debug("Received duplicated covenant signature", "covenant pk", req.Pk.MarshalHex())
+		return &types.MsgAddCovenantSigsResponse{}, nil
+	}
 
+	// further covenant signatures are pointless once the BTC delegation is
+	// activated, so reject them rather than spending gas on verifying them
 	if btcDel.HasCovenantQuorums(params.CovenantQuorum) {
-		ms.Logger(ctx).Debug("Received covenant signature after achieving quorum", "covenant pk", req.Pk.MarshalHex())
-		return &types.MsgAddCovenantSigsResponse{}, nil
+		return nil, types.ErrBTCDelegationAlreadyActive.Wrapf("staking tx hash: %s, covenant pk: %s", req.StakingTxHash, req.Pk.MarshalHex())
 	}
 
-	// ensure BTC delegation is still pending, i.e., not expired
+	// ensure BTC delegation is still pending, i.e., not expired. A reserved
+	// BTC delegation collects covenant signatures before its activation, and
+	// a BTC delegation under a finality provider slashed before its activation
+	// collects covenant signatures on its unbonding tx
 	btcTipHeight := ms.btclcKeeper.GetTipInfo(ctx).Height
 	wValue := ms.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
 	status := btcDel.GetStatus(btcTipHeight, wValue, params.CovenantQuorum)
-	if status != types.BTCDelegationStatus_PENDING {
+	unbondable := btcDel.FpSlashedBeforeActivation && !btcDel.IsUnbondedEarly()
+	if status != types.BTCDelegationStatus_PENDING && status != types.BTCDelegationStatus_RESERVED && !unbondable {
 		ms.Logger(ctx).Debug("Received covenant signature after the BTC delegation is already expired", "covenant pk", req.Pk.MarshalHex())
 		return &types.MsgAddCovenantSigsResponse{}, nil
 	}
@@ -543,6 +767,8 @@ func (ms msgServer) AddCovenantSigs(goCtx context.Context, req *types.MsgAddCove
 
 	/*
 		Verify Schnorr signature over unbonding tx
+		NOTE: the signature is over the tapscript sighash of the unbonding
+		leaf, so it is rejected if signed over another leaf
 	*/
 	unbondingMsgTx, err := bbn.NewBTCTxFromBytes(btcDel.BtcUndelegation.UnbondingTx)
 	if err != nil {
@@ -564,6 +790,21 @@ func (ms msgServer) AddCovenantSigs(goCtx context.Context, req *types.MsgAddCove
 		return nil, types.ErrInvalidCovenantSig.Wrap(err.Error())
 	}
 
+	// once this signature completes the covenant quorum on the unbonding tx,
+	// execute the unbonding leaf script with the covenant unbonding signatures
+	// before the BTC delegation becomes unbondable. The delegator's signature
+	// is not known until BTCUndelegate, which executes the full script again
+	covUnbondingSigs := make(map[string]*schnorr.Signature, len(btcDel.BtcUndelegation.CovenantUnbondingSigList)+1)
+	for _, covSig := range btcDel.BtcUndelegation.CovenantUnbondingSigList {
+		covUnbondingSigs[covSig.Pk.MarshalHex()] = covSig.Sig.MustToBTCSig()
+	}
+	covUnbondingSigs[req.Pk.MarshalHex()] = req.UnbondingTxSig.MustToBTCSig()
+	if uint32(len(covUnbondingSigs)) >= params.CovenantQuorum {
+		if err := ms.executeUnbondingPathWitness(btcDel, params, covUnbondingSigs, nil); err != nil {
+			return nil, types.ErrInvalidCovenantSig.Wrapf("covenant unbonding signatures do not satisfy the unbonding path: %v", err)
+		}
+	}
+
 	/*
 		verify each adaptor signature on slashing unbonding tx
 	*/
@@ -589,6 +830,16 @@ func (ms msgServer) AddCovenantSigs(goCtx context.Context, req *types.MsgAddCove
 		return nil, types.ErrInvalidCovenantSig.Wrapf("err: %v", err)
 	}
 
+	// A finality provider that the BTC delegation restakes to might have been
+	// slashed since the BTC delegation was created. Activating the BTC
+	// delegation would then add voting power to a slashed finality provider,
+	// so only the signature on the unbonding tx is kept for the delegator to
+	// unbond, and the BTC delegation is considered unbonded
+	if btcDel.FpSlashedBeforeActivation || ms.hasSlashedFinalityProvider(ctx, btcDel) {
+		ms.addCovenantUnbondingSigToBTCDelegation(ctx, btcDel, req.Pk, req.UnbondingTxSig)
+		return &types.MsgAddCovenantSigsResponse{}, nil
+	}
+
 	// All is fine add received signatures to the BTC delegation and BtcUndelegation
 	// and emit corresponding events
 	ms.addCovenantSigsToBTCDelegation(
@@ -604,6 +855,82 @@ func (ms msgServer) AddCovenantSigs(goCtx context.Context, req *types.MsgAddCove
 	return &types.MsgAddCovenantSigsResponse{}, nil
 }
 
+// CreateBTCDelegationWithCovenantSigs creates a BTC delegation and adds the given
+// covenant signatures to it. Since a tx is executed atomically, if any step fails,
+// the BTC delegation will not be created either
+func (ms msgServer) CreateBTCDelegationWithCovenantSigs(goCtx context.Context, req *types.MsgCreateBTCDelegationWithCovenantSigs) (*types.MsgCreateBTCDelegationWithCovenantSigsResponse, error) {
+	// basic stateless checks
+	if err := req.ValidateBasic(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	if _, err := ms.CreateBTCDelegation(goCtx, req.BtcDel); err != nil {
+		return nil, err
+	}
+	for _, covSigs := range req.CovenantSigs {
+		if _, err := ms.AddCovenantSigs(goCtx, covSigs); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgCreateBTCDelegationWithCovenantSigsResponse{}, nil
+}
+
+// AddSlashingTxInclusionProof marks a BTC delegation as slashed once its
+// slashing tx, or that of its unbonding tx, is included in a k-deep BTC block
+func (ms msgServer) AddSlashingTxInclusionProof(goCtx context.Context, req *types.MsgAddSlashingTxInclusionProof) (*types.MsgAddSlashingTxInclusionProofResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyAddSlashingTxInclusionProof)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := req.ValidateBasic(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	btcDel, _, err := ms.getBTCDelWithParams(ctx, req.StakingTxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	// ensure the staking output of the BTC delegation is on BTC and the BTC
+	// delegation is not slashed yet
+	btcTip := ms.btclcKeeper.GetTipInfo(ctx)
+	if btcDel.Invalidated || btcDel.Reserved {
+		return nil, types.ErrInvalidDelegationState.Wrapf("the staking tx of BTC delegation %s is not included in BTC", req.StakingTxHash)
+	}
+	if btcDel.IsSlashed(btcTip.Height) {
+		return nil, types.ErrInvalidDelegationState.Wrapf("BTC delegation %s is already slashed", req.StakingTxHash)
+	}
+
+	// ensure the submitted tx is the slashing tx of the staking tx, or that of
+	// the unbonding tx
+	slashingMsgTx, err := bbn.NewBTCTxFromBytes(req.SlashingTx.Transaction)
+	if err != nil {
+		return nil, types.ErrInvalidSlashingTx.Wrapf("cannot be parsed: %v", err)
+	}
+	slashingTxHash := slashingMsgTx.TxHash()
+	if !slashingTxHash.IsEqual(btcDel.SlashingTx.MustGetTxHash()) &&
+		!slashingTxHash.IsEqual(btcDel.BtcUndelegation.SlashingTx.MustGetTxHash()) {
+		return nil, types.ErrInvalidSlashingTx.Wrapf("tx %s is not a slashing tx of BTC delegation %s", slashingTxHash.String(), req.StakingTxHash)
+	}
+
+	// ensure the slashing tx is included in a k-deep BTC block
+	slashingTxHeader := ms.btclcKeeper.GetHeaderByHash(ctx, req.SlashingTx.Key.Hash)
+	if slashingTxHeader == nil {
+		return nil, types.ErrInvalidSlashingTx.Wrap("header that includes the slashing tx is not found")
+	}
+	kValue := ms.btccKeeper.GetParams(ctx).BtcConfirmationDepth
+	if slashingTxDepth := btcTip.Height - slashingTxHeader.Height; slashingTxDepth < kValue {
+		return nil, types.ErrInvalidSlashingTx.Wrapf("not k-deep: k=%d; depth=%d", kValue, slashingTxDepth)
+	}
+	if err := req.SlashingTx.VerifyInclusion(slashingTxHeader.Header, ms.btccKeeper.GetPowLimit()); err != nil {
+		return nil, types.ErrInvalidSlashingTx.Wrapf("not included in the Bitcoin chain: %v", err)
+	}
+
+	ms.slashBTCDelegation(ctx, btcDel, slashingTxHeader.Height)
+
+	return &types.MsgAddSlashingTxInclusionProofResponse{}, nil
+}
+
 // BTCUndelegate adds a signature on the unbonding tx from the BTC delegator
 // this effectively proves that the BTC delegator wants to unbond and Babylon
 // will consider its BTC delegation unbonded
@@ -654,6 +981,18 @@ func (ms msgServer) BTCUndelegate(goCtx context.Context, req *types.MsgBTCUndele
 		return nil, types.ErrInvalidCovenantSig.Wrap(err.Error())
 	}
 
+	// execute the unbonding leaf script with the witness assembled from the
+	// covenant unbonding signatures and the delegator's signature, such that
+	// the BTC delegation only becomes unbonded if its unbonding tx can
+	// actually spend the staking output on Bitcoin
+	covUnbondingSigs := make(map[string]*schnorr.Signature, len(btcDel.BtcUndelegation.CovenantUnbondingSigList))
+	for _, covSig := range btcDel.BtcUndelegation.CovenantUnbondingSigList {
+		covUnbondingSigs[covSig.Pk.MarshalHex()] = covSig.Sig.MustToBTCSig()
+	}
+	if err := ms.executeUnbondingPathWitness(btcDel, bsParams, covUnbondingSigs, req.UnbondingTxSig.MustToBTCSig()); err != nil {
+		return nil, types.ErrInvalidBTCUndelegateReq.Wrapf("unbonding tx does not satisfy the unbonding path: %v", err)
+	}
+
 	// all good, add the signature to BTC delegation's undelegation
 	// and set back
 	ms.btcUndelegate(ctx, btcDel, req.UnbondingTxSig)
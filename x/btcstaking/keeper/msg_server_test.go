@@ -10,19 +10,24 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/babylonchain/babylon/btcstaking"
 	asig "github.com/babylonchain/babylon/crypto/schnorr-adaptor-signature"
 	"github.com/babylonchain/babylon/testutil/datagen"
 	testhelper "github.com/babylonchain/babylon/testutil/helper"
 	bbn "github.com/babylonchain/babylon/types"
 	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
+	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
 	"github.com/babylonchain/babylon/x/btcstaking/keeper"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
 )
@@ -296,7 +301,8 @@ func FuzzAddCovenantSigs(f *testing.F) {
 		_, err = h.MsgServer.AddCovenantSigs(h.Ctx, &bogusMsg)
 		h.Error(err)
 
-		for _, msg := range msgs {
+		covenantQuorum := h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum
+		for _, msg := range msgs[:covenantQuorum] {
 			_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msg)
 			h.NoError(err)
 			// check that submitting the same covenant signature does not produce an error
@@ -304,6 +310,13 @@ func FuzzAddCovenantSigs(f *testing.F) {
 			h.NoError(err)
 		}
 
+		// the remaining covenant signatures are rejected, as the BTC delegation
+		// is already active
+		for _, msg := range msgs[covenantQuorum:] {
+			_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msg)
+			require.ErrorIs(h.t, err, types.ErrBTCDelegationAlreadyActive)
+		}
+
 		// ensure the BTC delegation now has voting power
 		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
 		h.NoError(err)
@@ -314,7 +327,136 @@ func FuzzAddCovenantSigs(f *testing.F) {
 	})
 }
 
-func FuzzBTCUndelegate(f *testing.F) {
+func FuzzAddCovenantSigs_FpSlashedBeforeActivation(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		wValue := h.BTCCheckpointKeeper.GetParams(h.Ctx).CheckpointFinalizationTimeout
+		minUnbondingTime := types.MinimumUnbondingTime(bsParams, h.BTCCheckpointKeeper.GetParams(h.Ctx))
+
+		// generate and insert new finality provider
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+
+		// generate and insert new BTC delegation, which is either pending or
+		// reserved
+		stakingValue := int64(2 * 10e8)
+		stakingTime := uint16(1000)
+		stakingTxHash, _, _, msgCreateBTCDel := h.genMsgCreateBTCDelegation(
+			r,
+			[]*btcec.PublicKey{fpPK},
+			stakingValue,
+			stakingTime,
+			stakingValue-1000,
+			uint16(minUnbondingTime)+1,
+			1,
+		)
+		stakingTxInfo := msgCreateBTCDel.StakingTx
+		reserved := datagen.OneInN(r, 2)
+		if reserved {
+			msgCreateBTCDel.Reserved = true
+			msgCreateBTCDel.StakingTx = &btcctypes.TransactionInfo{Transaction: stakingTxInfo.Transaction}
+		}
+		_, err := h.MsgServer.CreateBTCDelegation(h.Ctx, msgCreateBTCDel)
+		h.NoError(err)
+		actualDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		msgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel)
+
+		// a number of covenant members sign the BTC delegation before its
+		// finality provider is slashed. A pending BTC delegation would get
+		// activated with a quorum, so it receives less than a quorum, while a
+		// reserved one can receive up to a quorum
+		numSignedBeforeSlashing := int(datagen.RandomInt(r, int(bsParams.CovenantQuorum)))
+		if reserved {
+			numSignedBeforeSlashing = int(datagen.RandomInt(r, int(bsParams.CovenantQuorum)+1))
+		}
+		for _, msg := range msgs[:numSignedBeforeSlashing] {
+			_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msg)
+			h.NoError(err)
+		}
+
+		// the finality provider is slashed before the BTC delegation is
+		// activated
+		err = h.BTCStakingKeeper.SlashFinalityProvider(h.Ctx, fp.BtcPk.MustMarshal())
+		h.NoError(err)
+
+		// the BTC delegation is unbonded right away, and only the covenant
+		// signatures on the unbonding tx are kept
+		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.True(t, actualDel.FpSlashedBeforeActivation)
+		require.Empty(t, actualDel.CovenantSigs)
+		require.Empty(t, actualDel.BtcUndelegation.CovenantSlashingSigs)
+		require.Len(t, actualDel.BtcUndelegation.CovenantUnbondingSigList, numSignedBeforeSlashing)
+		btcTipHeight := h.BTCLightClientKeeper.GetTipInfo(h.Ctx).Height
+		require.Equal(t, types.BTCDelegationStatus_UNBONDED, actualDel.GetStatus(btcTipHeight, wValue, bsParams.CovenantQuorum))
+		unbondedEventEmitted := false
+		for _, event := range h.Ctx.EventManager().Events() {
+			if event.Type != proto.MessageName(&types.EventBTCDelegationStateUpdate{}) {
+				continue
+			}
+			parsedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+			h.NoError(err)
+			stateUpdate := parsedEvent.(*types.EventBTCDelegationStateUpdate)
+			if stateUpdate.StakingTxHash == stakingTxHash && stateUpdate.NewState == types.BTCDelegationStatus_UNBONDED {
+				unbondedEventEmitted = true
+			}
+		}
+		require.True(t, unbondedEventEmitted)
+
+		// the reserved BTC delegation cannot be activated anymore
+		if reserved {
+			_, err = h.MsgServer.ActivateReservedDelegation(h.Ctx, &types.MsgActivateReservedDelegation{
+				Signer:        msgCreateBTCDel.Signer,
+				StakingTxHash: stakingTxHash,
+				StakingTx:     stakingTxInfo,
+			})
+			require.ErrorIs(t, err, types.ErrInvalidDelegationState)
+		}
+
+		// all covenant members sign the BTC delegation, with duplicates
+		for _, msg := range msgs {
+			_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msg)
+			h.NoError(err)
+			_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msg)
+			h.NoError(err)
+		}
+
+		// the BTC delegation is never activated, and only collects covenant
+		// signatures on its unbonding tx so that the delegator can unbond
+		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.True(t, actualDel.FpSlashedBeforeActivation)
+		require.Empty(t, actualDel.CovenantSigs)
+		require.Empty(t, actualDel.BtcUndelegation.CovenantSlashingSigs)
+		require.Len(t, actualDel.BtcUndelegation.CovenantUnbondingSigList, len(msgs))
+		require.True(t, actualDel.BtcUndelegation.HasCovenantQuorumOnUnbonding(bsParams.CovenantQuorum))
+		require.Equal(t, types.BTCDelegationStatus_UNBONDED, actualDel.GetStatus(btcTipHeight, wValue, bsParams.CovenantQuorum))
+		require.Zero(t, actualDel.VotingPower(btcTipHeight, wValue, bsParams.CovenantQuorum))
+
+		// no event of the BTC delegation becoming active is recorded
+		for _, event := range h.BTCStakingKeeper.GetAllPowerDistUpdateEvents(h.Ctx, 0, btcTipHeight) {
+			if delEvent := event.GetBtcDelStateUpdate(); delEvent != nil {
+				require.NotEqual(t, types.BTCDelegationStatus_ACTIVE, delEvent.NewState)
+			}
+		}
+	})
+}
+
+func FuzzCreateBTCDelegationWithCovenantSigs(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 
 	f.Fuzz(func(t *testing.T, seed int64) {
@@ -331,6 +473,326 @@ func FuzzBTCUndelegate(f *testing.F) {
 		// set all parameters
 		covenantSKs, _ := h.GenAndApplyParams(r)
 
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+
+		// generate the BTC delegation and covenant signatures in a cached context,
+		// so that the BTC delegation does not exist in the original context
+		ctx := h.Ctx
+		h.Ctx, _ = ctx.CacheContext()
+		stakingValue := int64(2 * 10e8)
+		stakingTxHash, _, _, msgCreateBTCDel, actualDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+		covMsgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel)
+		stakingTxHeader := btclcKeeper.GetHeaderByHash(h.Ctx, msgCreateBTCDel.StakingTx.Key.Hash)
+		btcTip := btclcKeeper.GetTipInfo(h.Ctx)
+		h.Ctx = ctx
+		btclcKeeper.EXPECT().GetHeaderByHash(gomock.Eq(h.Ctx), gomock.Eq(msgCreateBTCDel.StakingTx.Key.Hash)).Return(stakingTxHeader).AnyTimes()
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		_, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+
+		covenantQuorum := h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum
+		msg := &types.MsgCreateBTCDelegationWithCovenantSigs{
+			Signer:       msgCreateBTCDel.Signer,
+			BtcDel:       msgCreateBTCDel,
+			CovenantSigs: covMsgs[:covenantQuorum],
+		}
+
+		// covenant signatures on another BTC delegation are rejected
+		bogusCovMsg := *covMsgs[0]
+		bogusCovMsg.StakingTxHash = datagen.GenRandomBtcdHash(r).String()
+		bogusMsg := *msg
+		bogusMsg.CovenantSigs = []*types.MsgAddCovenantSigs{&bogusCovMsg}
+		_, err = h.MsgServer.CreateBTCDelegationWithCovenantSigs(h.Ctx, &bogusMsg)
+		h.Error(err)
+
+		// duplicated covenant signatures are rejected
+		bogusMsg.CovenantSigs = []*types.MsgAddCovenantSigs{covMsgs[0], covMsgs[0]}
+		_, err = h.MsgServer.CreateBTCDelegationWithCovenantSigs(h.Ctx, &bogusMsg)
+		h.Error(err)
+
+		// an invalid covenant signature fails the message after the BTC delegation
+		// is created. As in DeliverTx, the message is executed in a cached context
+		// that is discarded upon failure, so the BTC delegation is not persisted
+		invalidCovMsg := *covMsgs[0]
+		invalidCovMsg.SlashingTxSigs = covMsgs[1].SlashingTxSigs
+		bogusMsg.CovenantSigs = []*types.MsgAddCovenantSigs{&invalidCovMsg}
+		cacheCtx, _ := h.Ctx.CacheContext()
+		btclcKeeper.EXPECT().GetHeaderByHash(gomock.Eq(cacheCtx), gomock.Eq(msgCreateBTCDel.StakingTx.Key.Hash)).Return(stakingTxHeader).AnyTimes()
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Eq(cacheCtx)).Return(btcTip).AnyTimes()
+		_, err = h.MsgServer.CreateBTCDelegationWithCovenantSigs(cacheCtx, &bogusMsg)
+		h.Error(err)
+		// the BTC delegation was created in the cached context before the failure
+		_, err = h.BTCStakingKeeper.GetBTCDelegation(cacheCtx, stakingTxHash)
+		h.NoError(err)
+		_, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+
+		// the BTC delegation is created and activated by a single message
+		_, err = h.MsgServer.CreateBTCDelegationWithCovenantSigs(h.Ctx, msg)
+		h.NoError(err)
+		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.True(t, actualDel.HasCovenantQuorums(covenantQuorum))
+		require.True(t, actualDel.BtcUndelegation.HasCovenantQuorums(covenantQuorum))
+		status := actualDel.GetStatus(btcTip.Height, h.BTCCheckpointKeeper.GetParams(h.Ctx).CheckpointFinalizationTimeout, covenantQuorum)
+		require.Equal(t, types.BTCDelegationStatus_ACTIVE, status)
+	})
+}
+
+func FuzzActivateReservedDelegation(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		wValue := h.BTCCheckpointKeeper.GetParams(h.Ctx).CheckpointFinalizationTimeout
+		minUnbondingTime := types.MinimumUnbondingTime(bsParams, h.BTCCheckpointKeeper.GetParams(h.Ctx))
+
+		// generate and insert new finality provider
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+
+		// generate a BTC delegation whose staking tx is included in BTC, and
+		// reserve it with the staking tx only
+		stakingValue := int64(2 * 10e8)
+		stakingTime := uint16(1000)
+		stakingTxHash, _, _, msgCreateBTCDel := h.genMsgCreateBTCDelegation(
+			r,
+			[]*btcec.PublicKey{fpPK},
+			stakingValue,
+			stakingTime,
+			stakingValue-1000,
+			uint16(minUnbondingTime)+1,
+			1,
+		)
+		stakingTxInfo := msgCreateBTCDel.StakingTx
+		reservedMsg := *msgCreateBTCDel
+		reservedMsg.Reserved = true
+
+		// the inclusion proof cannot be given upon reservation
+		_, err := h.MsgServer.CreateBTCDelegation(h.Ctx, &reservedMsg)
+		require.Error(t, err)
+
+		reservedMsg.StakingTx = &btcctypes.TransactionInfo{Transaction: stakingTxInfo.Transaction}
+		_, err = h.MsgServer.CreateBTCDelegation(h.Ctx, &reservedMsg)
+		h.NoError(err)
+
+		btcTipHeight := h.BTCLightClientKeeper.GetTipInfo(h.Ctx).Height
+		actualDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.True(t, actualDel.Reserved)
+		require.Equal(t, stakingTime, actualDel.GetStakingTime())
+		require.Equal(t, types.BTCDelegationStatus_RESERVED, actualDel.GetStatus(btcTipHeight, wValue, bsParams.CovenantQuorum))
+		// the reserved BTC delegation does not expire before its activation
+		require.Empty(t, h.BTCStakingKeeper.GetAllPowerDistUpdateEvents(h.Ctx, 0, 2*uint64(stakingTime)))
+
+		// covenant members can sign the reserved BTC delegation either before
+		// or after its activation
+		covMsgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel)
+		signBeforeActivation := datagen.OneInN(r, 2)
+		if signBeforeActivation {
+			for _, msg := range covMsgs[:bsParams.CovenantQuorum] {
+				_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msg)
+				h.NoError(err)
+			}
+			actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+			h.NoError(err)
+			require.True(t, actualDel.HasCovenantQuorums(bsParams.CovenantQuorum))
+			// still reserved with no voting power
+			require.Equal(t, types.BTCDelegationStatus_RESERVED, actualDel.GetStatus(btcTipHeight, wValue, bsParams.CovenantQuorum))
+			require.Zero(t, actualDel.VotingPower(btcTipHeight, wValue, bsParams.CovenantQuorum))
+			require.Empty(t, h.BTCStakingKeeper.GetAllPowerDistUpdateEvents(h.Ctx, 0, 2*uint64(stakingTime)))
+		}
+
+		// a staking tx other than the reserved one is rejected
+		otherStakingTxHash, _, _, otherMsg := h.genMsgCreateBTCDelegation(
+			r,
+			[]*btcec.PublicKey{fpPK},
+			stakingValue,
+			stakingTime,
+			stakingValue-1000,
+			uint16(minUnbondingTime)+1,
+			1,
+		)
+		_, err = h.MsgServer.ActivateReservedDelegation(h.Ctx, &types.MsgActivateReservedDelegation{
+			Signer:        msgCreateBTCDel.Signer,
+			StakingTxHash: stakingTxHash,
+			StakingTx:     otherMsg.StakingTx,
+		})
+		require.ErrorIs(t, err, types.ErrInvalidStakingTx)
+		// a BTC delegation that is not reserved cannot be activated
+		_, err = h.MsgServer.ActivateReservedDelegation(h.Ctx, &types.MsgActivateReservedDelegation{
+			Signer:        msgCreateBTCDel.Signer,
+			StakingTxHash: otherStakingTxHash,
+			StakingTx:     otherMsg.StakingTx,
+		})
+		require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+
+		// activate the reserved BTC delegation with the inclusion proof
+		activateMsg := &types.MsgActivateReservedDelegation{
+			Signer:        msgCreateBTCDel.Signer,
+			StakingTxHash: stakingTxHash,
+			StakingTx:     stakingTxInfo,
+		}
+		_, err = h.MsgServer.ActivateReservedDelegation(h.Ctx, activateMsg)
+		h.NoError(err)
+		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.False(t, actualDel.Reserved)
+		require.Equal(t, uint64(10), actualDel.StartHeight)
+		require.Equal(t, uint64(10)+uint64(stakingTime), actualDel.EndHeight)
+		require.Equal(t, stakingTxInfo.Key, actualDel.StakingTxKey)
+		require.Equal(t, stakingTxInfo.Proof, actualDel.StakingTxInclusionProof)
+
+		// activating it again is rejected
+		_, err = h.MsgServer.ActivateReservedDelegation(h.Ctx, activateMsg)
+		require.ErrorIs(t, err, types.ErrInvalidDelegationState)
+
+		if !signBeforeActivation {
+			require.Equal(t, types.BTCDelegationStatus_PENDING, actualDel.GetStatus(btcTipHeight, wValue, bsParams.CovenantQuorum))
+			for _, msg := range covMsgs[:bsParams.CovenantQuorum] {
+				_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msg)
+				h.NoError(err)
+			}
+			actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+			h.NoError(err)
+		}
+
+		// the BTC delegation is now active, and its activation and expiry are
+		// recorded for the voting power distribution
+		require.Equal(t, types.BTCDelegationStatus_ACTIVE, actualDel.GetStatus(btcTipHeight, wValue, bsParams.CovenantQuorum))
+		require.Equal(t, uint64(stakingValue), actualDel.VotingPower(btcTipHeight, wValue, bsParams.CovenantQuorum))
+		events := h.BTCStakingKeeper.GetAllPowerDistUpdateEvents(h.Ctx, 0, 2*uint64(stakingTime))
+		require.Len(t, events, 2)
+		var newStates []types.BTCDelegationStatus
+		for _, ev := range events {
+			newStates = append(newStates, ev.GetBtcDelStateUpdate().NewState)
+		}
+		require.ElementsMatch(t, []types.BTCDelegationStatus{types.BTCDelegationStatus_ACTIVE, types.BTCDelegationStatus_UNBONDED}, newStates)
+	})
+}
+
+func FuzzActivateReservedDelegation_Expired(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		iKeeper := types.NewMockIncentiveKeeper(ctrl)
+		h := NewHelperWithIncentiveKeeper(t, btclcKeeper, btccKeeper, ckptKeeper, iKeeper)
+
+		// set all parameters, with a random reservation expiry
+		h.GenAndApplyParams(r)
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		maxReservationBTCBlocks := uint32(datagen.RandomInt(r, 100)) + 1
+		bsParams.MaxReservationBtcBlocks = maxReservationBTCBlocks
+		err := h.BTCStakingKeeper.SetParams(h.Ctx, bsParams)
+		h.NoError(err)
+		wValue := h.BTCCheckpointKeeper.GetParams(h.Ctx).CheckpointFinalizationTimeout
+		minUnbondingTime := types.MinimumUnbondingTime(bsParams, h.BTCCheckpointKeeper.GetParams(h.Ctx))
+
+		// generate and insert new finality provider
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+
+		// reserve a BTC delegation with the staking tx only
+		stakingValue := int64(2 * 10e8)
+		stakingTime := uint16(1000)
+		stakingTxHash, _, _, msgCreateBTCDel := h.genMsgCreateBTCDelegation(
+			r,
+			[]*btcec.PublicKey{fpPK},
+			stakingValue,
+			stakingTime,
+			stakingValue-1000,
+			uint16(minUnbondingTime)+1,
+			1,
+		)
+		stakingTxInfo := msgCreateBTCDel.StakingTx
+		reservedMsg := *msgCreateBTCDel
+		reservedMsg.Reserved = true
+		reservedMsg.StakingTx = &btcctypes.TransactionInfo{Transaction: stakingTxInfo.Transaction}
+		_, err = h.MsgServer.CreateBTCDelegation(h.Ctx, &reservedMsg)
+		h.NoError(err)
+
+		// the reservation expires maxReservationBTCBlocks BTC blocks after
+		// the BTC tip upon reservation
+		btcTipHeight := h.BTCLightClientKeeper.GetTipInfo(h.Ctx).Height
+		actualDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		expiryHeight := btcTipHeight + uint64(maxReservationBTCBlocks)
+		require.Equal(t, expiryHeight, actualDel.ReservationExpiryBtcHeight)
+		require.Equal(t, types.BTCDelegationStatus_RESERVED, actualDel.GetStatus(expiryHeight-1, wValue, bsParams.CovenantQuorum))
+		require.Equal(t, types.BTCDelegationStatus_UNBONDED, actualDel.GetStatus(expiryHeight, wValue, bsParams.CovenantQuorum))
+
+		// the expired BTC delegation can no longer be activated
+		expiredCtx := datagen.WithCtxHeight(h.Ctx, 2)
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Eq(expiredCtx)).Return(&btclctypes.BTCHeaderInfo{Height: expiryHeight}).AnyTimes()
+		_, err = h.MsgServer.ActivateReservedDelegation(expiredCtx, &types.MsgActivateReservedDelegation{
+			Signer:        msgCreateBTCDel.Signer,
+			StakingTxHash: stakingTxHash,
+			StakingTx:     stakingTxInfo,
+		})
+		require.ErrorIs(t, err, types.ErrReservationExpired)
+
+		// the expired BTC delegation is prunable once its reservation has
+		// expired for at least olderThanBlocks BTC blocks
+		olderThanBlocks := datagen.RandomInt(r, 100) + 1
+		require.Empty(t, h.BTCStakingKeeper.PruneInactiveDelegations(expiredCtx, olderThanBlocks))
+		pruneCtx := datagen.WithCtxHeight(h.Ctx, 3)
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Eq(pruneCtx)).Return(&btclctypes.BTCHeaderInfo{Height: expiryHeight + olderThanBlocks}).AnyTimes()
+		iKeeper.EXPECT().PruneBTCDelegationRewardRecords(gomock.Any(), stakingTxHash).Times(1)
+		iKeeper.EXPECT().PruneDelegatorValidatorRewards(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+		prunedHashes := h.BTCStakingKeeper.PruneInactiveDelegations(pruneCtx, olderThanBlocks)
+		require.Equal(t, []string{stakingTxHash}, prunedHashes)
+		_, err = h.BTCStakingKeeper.GetBTCDelegation(pruneCtx, stakingTxHash)
+		require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+		require.Empty(t, h.BTCStakingKeeper.PruneInactiveDelegations(pruneCtx, olderThanBlocks))
+	})
+}
+
+func FuzzBTCUndelegate(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client, BTC checkpoint and incentive modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		iKeeper := types.NewMockIncentiveKeeper(ctrl)
+		h := NewHelperWithIncentiveKeeper(t, btclcKeeper, btccKeeper, ckptKeeper, iKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+
 		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
 		wValue := h.BTCCheckpointKeeper.GetParams(h.Ctx).CheckpointFinalizationTimeout
 
@@ -384,6 +846,247 @@ func FuzzBTCUndelegate(f *testing.F) {
 		h.NoError(err)
 		status = actualDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum)
 		require.Equal(t, types.BTCDelegationStatus_UNBONDED, status)
+
+		// the reward start epoch of the BTC delegation is removed once the
+		// unbonding is processed in the next BeginBlock
+		parsedStakingTxHash, err := chainhash.NewHashFromStr(stakingTxHash)
+		h.NoError(err)
+		iKeeper.EXPECT().DeleteBTCDelRewardStartEpoch(gomock.Any(), parsedStakingTxHash).Times(1)
+		h.SetCtxHeight(uint64(h.Ctx.HeaderInfo().Height) + 1)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: btcTip}).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+	})
+}
+
+func FuzzAddSlashingTxInclusionProof(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		btccParams := h.BTCCheckpointKeeper.GetParams(h.Ctx)
+		wValue := btccParams.CheckpointFinalizationTimeout
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider and BTC delegation
+		fpSK, fpPK, _ := h.CreateFinalityProvider(r)
+		stakingValue := int64(2 * 10e8)
+		stakingTxHash, delSK, _, msgCreateBTCDel, actualDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
+
+		// the BTC delegation either stays staked, or is unbonded early, in
+		// which case the slashing tx of its unbonding tx is submitted
+		slashingTx := msgCreateBTCDel.SlashingTx
+		unbondedEarly := datagen.OneInN(r, 2)
+		if unbondedEarly {
+			actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+			h.NoError(err)
+			delUnbondingSig, err := actualDel.SignUnbondingTx(&bsParams, h.Net, delSK)
+			h.NoError(err)
+			_, err = h.MsgServer.BTCUndelegate(h.Ctx, &types.MsgBTCUndelegate{
+				Signer:         datagen.GenRandomAccount().Address,
+				StakingTxHash:  stakingTxHash,
+				UnbondingTxSig: bbn.NewBIP340SignatureFromBTCSig(delUnbondingSig),
+			})
+			h.NoError(err)
+			slashingTx = msgCreateBTCDel.UnbondingSlashingTx
+		}
+
+		// slash the finality provider, which only makes the BTC delegation
+		// slashable
+		err = h.BTCStakingKeeper.SlashFinalityProvider(h.Ctx, bbn.NewBIP340PubKeyFromBTCPK(fpSK.PubKey()).MustMarshal())
+		h.NoError(err)
+		btcTipHeight := h.BTCLightClientKeeper.GetTipInfo(h.Ctx).Height
+		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.True(t, actualDel.IsSlashable(btcTipHeight))
+		require.False(t, actualDel.IsSlashed(btcTipHeight))
+		require.NotEqual(t, types.BTCDelegationStatus_SLASHED, actualDel.GetStatus(btcTipHeight, wValue, bsParams.CovenantQuorum))
+
+		// include the slashing tx in a BTC block at the given height
+		slashingMsgTx, err := slashingTx.ToMsgTx()
+		h.NoError(err)
+		genSlashingTxInfo := func(height uint64) *btcctypes.TransactionInfo {
+			prevBlock, _ := datagen.GenRandomBtcdBlock(r, 0, nil)
+			btcHeaderWithProof := datagen.CreateBlockWithTransaction(r, &prevBlock.Header, slashingMsgTx)
+			btcHeader := btcHeaderWithProof.HeaderBytes
+			h.BTCLightClientKeeper.EXPECT().GetHeaderByHash(gomock.Eq(h.Ctx), gomock.Eq(btcHeader.Hash())).Return(&btclctypes.BTCHeaderInfo{Header: &btcHeader, Height: height}).AnyTimes()
+			return btcctypes.NewTransactionInfo(
+				&btcctypes.TransactionKey{Index: 1, Hash: btcHeader.Hash()},
+				slashingTx.MustMarshal(),
+				btcHeaderWithProof.SpvProof.MerkleNodes,
+			)
+		}
+		slashingTxHeight := btcTipHeight - btccParams.BtcConfirmationDepth
+		msg := &types.MsgAddSlashingTxInclusionProof{
+			Signer:        datagen.GenRandomAccount().Address,
+			StakingTxHash: stakingTxHash,
+			SlashingTx:    genSlashingTxInfo(slashingTxHeight),
+		}
+
+		// a tx other than the slashing txs of the BTC delegation is rejected
+		wrongMsg := *msg
+		wrongMsg.SlashingTx = msgCreateBTCDel.StakingTx
+		_, err = h.MsgServer.AddSlashingTxInclusionProof(h.Ctx, &wrongMsg)
+		require.ErrorIs(t, err, types.ErrInvalidSlashingTx)
+
+		// a slashing tx that is not k-deep is rejected
+		shallowMsg := *msg
+		shallowMsg.SlashingTx = genSlashingTxInfo(slashingTxHeight + 1)
+		_, err = h.MsgServer.AddSlashingTxInclusionProof(h.Ctx, &shallowMsg)
+		require.ErrorIs(t, err, types.ErrInvalidSlashingTx)
+
+		// the k-deep slashing tx slashes the BTC delegation
+		_, err = h.MsgServer.AddSlashingTxInclusionProof(h.Ctx, msg)
+		h.NoError(err)
+		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.Equal(t, slashingTxHeight, actualDel.SlashedBtcHeight)
+		require.Equal(t, types.BTCDelegationStatus_SLASHED, actualDel.GetStatus(btcTipHeight, wValue, bsParams.CovenantQuorum))
+		require.Zero(t, actualDel.VotingPower(btcTipHeight, wValue, bsParams.CovenantQuorum))
+
+		// the BTC delegation loses its voting power from the current BTC height
+		numSlashedBTCDelEvents := 0
+		h.BTCStakingKeeper.IteratePowerDistUpdateEvents(h.Ctx, btcTipHeight, func(ev *types.EventPowerDistUpdate) bool {
+			if btcDelEvent := ev.GetBtcDelStateUpdate(); btcDelEvent != nil && btcDelEvent.NewState == types.BTCDelegationStatus_SLASHED {
+				require.Equal(t, stakingTxHash, btcDelEvent.StakingTxHash)
+				numSlashedBTCDelEvents++
+			}
+			return true
+		})
+		require.Equal(t, 1, numSlashedBTCDelEvents)
+
+		// submitting the inclusion proof again is rejected
+		_, err = h.MsgServer.AddSlashingTxInclusionProof(h.Ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidDelegationState)
+	})
+}
+
+func FuzzAddCovenantSigs_WrongLeafUnbondingSig(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+
+		// generate and insert new BTC delegation
+		stakingValue := int64(2 * 10e8)
+		stakingTxHash, delSK, _, msgCreateBTCDel, actualDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+
+		// a covenant signature on the unbonding tx that is valid w.r.t. the
+		// covenant PK, but signed over the slashing leaf rather than the
+		// unbonding leaf
+		stakingTx, err := bbn.NewBTCTxFromBytes(actualDel.StakingTx)
+		h.NoError(err)
+		unbondingTx, err := bbn.NewBTCTxFromBytes(actualDel.BtcUndelegation.UnbondingTx)
+		h.NoError(err)
+		stakingInfo, err := actualDel.GetStakingInfo(&bsParams, h.Net)
+		h.NoError(err)
+		slashingSpendInfo, err := stakingInfo.SlashingPathSpendInfo()
+		h.NoError(err)
+		wrongLeafSig, err := btcstaking.SignTxWithOneScriptSpendInputStrict(
+			unbondingTx,
+			stakingTx,
+			actualDel.StakingOutputIdx,
+			slashingSpendInfo.GetPkScriptPath(),
+			covenantSKs[0],
+		)
+		h.NoError(err)
+
+		// the covenant signature over the wrong leaf is rejected
+		msgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel)
+		wrongLeafMsg := *msgs[0]
+		wrongLeafMsg.UnbondingTxSig = bbn.NewBIP340SignatureFromBTCSig(wrongLeafSig)
+		_, err = h.MsgServer.AddCovenantSigs(h.Ctx, &wrongLeafMsg)
+		require.ErrorIs(t, err, types.ErrInvalidCovenantSig)
+
+		// submitting a quorum of valid covenant signatures executes the
+		// unbonding leaf script with the covenant unbonding signatures, after
+		// which the BTC delegation is active
+		for _, msg := range msgs[:bsParams.CovenantQuorum] {
+			_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msg)
+			h.NoError(err)
+		}
+		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.Len(t, actualDel.BtcUndelegation.CovenantUnbondingSigList, int(bsParams.CovenantQuorum))
+		require.True(t, actualDel.HasCovenantQuorums(bsParams.CovenantQuorum))
+
+		// a delegator signature over the wrong leaf is rejected as well
+		wrongLeafDelSig, err := btcstaking.SignTxWithOneScriptSpendInputStrict(
+			unbondingTx,
+			stakingTx,
+			actualDel.StakingOutputIdx,
+			slashingSpendInfo.GetPkScriptPath(),
+			delSK,
+		)
+		h.NoError(err)
+		_, err = h.MsgServer.BTCUndelegate(h.Ctx, &types.MsgBTCUndelegate{
+			Signer:         datagen.GenRandomAccount().Address,
+			StakingTxHash:  stakingTxHash,
+			UnbondingTxSig: bbn.NewBIP340SignatureFromBTCSig(wrongLeafDelSig),
+		})
+		require.Error(t, err)
+
+		// the unbonding tx with the delegator's valid signature satisfies the
+		// unbonding path, so the BTC delegation is unbonded
+		delUnbondingSig, err := actualDel.SignUnbondingTx(&bsParams, h.Net, delSK)
+		h.NoError(err)
+		_, err = h.MsgServer.BTCUndelegate(h.Ctx, &types.MsgBTCUndelegate{
+			Signer:         datagen.GenRandomAccount().Address,
+			StakingTxHash:  stakingTxHash,
+			UnbondingTxSig: bbn.NewBIP340SignatureFromBTCSig(delUnbondingSig),
+		})
+		h.NoError(err)
+		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		btcTip := h.BTCLightClientKeeper.GetTipInfo(h.Ctx).Height
+		wValue := h.BTCCheckpointKeeper.GetParams(h.Ctx).CheckpointFinalizationTimeout
+		status := actualDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum)
+		require.Equal(t, types.BTCDelegationStatus_UNBONDED, status)
 	})
 }
 
@@ -533,6 +1236,115 @@ func FuzzSelectiveSlashing_StakingTx(f *testing.F) {
 	})
 }
 
+func FuzzCreateBTCDelegationWithMultiInputStakingTx(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+
+		// generate and insert new finality provider
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+
+		// generate and insert new BTC delegation whose staking tx consolidates
+		// several UTXOs
+		numInputs := int(datagen.RandomInt(r, 10)) + 2
+		stakingValue := int64(2 * 10e8)
+		stakingTxHash, msgCreateBTCDel, actualDel := h.CreateMultiInputDelegation(
+			r,
+			fpPK,
+			stakingValue,
+			1000,
+			numInputs,
+		)
+
+		// ensure the staking tx is stored as is, with all of its inputs
+		stakingMsgTx, err := bbn.NewBTCTxFromBytes(actualDel.StakingTx)
+		h.NoError(err)
+		require.Len(h.t, stakingMsgTx.TxIn, numInputs)
+		require.Equal(h.t, stakingTxHash, stakingMsgTx.TxHash().String())
+		require.Equal(h.t, msgCreateBTCDel.StakingTx.Transaction, actualDel.StakingTx)
+		require.Equal(h.t, uint64(stakingValue), actualDel.TotalSat)
+		err = actualDel.ValidateBasic()
+		h.NoError(err)
+
+		// the BTC delegation becomes active once covenant signatures are added
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel)
+		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+		btcTip := btclcKeeper.GetTipInfo(h.Ctx).Height
+		wValue := btccKeeper.GetParams(h.Ctx).CheckpointFinalizationTimeout
+		require.Equal(h.t, types.BTCDelegationStatus_ACTIVE, actualDel.GetStatus(btcTip, wValue, bsParams.CovenantQuorum))
+	})
+}
+
+func FuzzCreateBTCDelegationWithMaxFinalityProviders(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters, with a random maximum number of finality
+		// providers per BTC delegation
+		h.GenAndApplyParams(r)
+		maxFps := uint32(datagen.RandomInt(r, 4)) + 1
+		params := h.BTCStakingKeeper.GetParams(h.Ctx)
+		params.MaxFinalityProvidersPerDelegation = maxFps
+		err := h.BTCStakingKeeper.SetParams(h.Ctx, params)
+		h.NoError(err)
+
+		// generate and insert one more finality provider than allowed
+		fpPKs := []*btcec.PublicKey{}
+		for i := uint32(0); i <= maxFps; i++ {
+			_, fpPK, _ := h.CreateFinalityProvider(r)
+			fpPKs = append(fpPKs, fpPK)
+		}
+
+		// a BTC delegation restaking to exactly the maximum number of
+		// finality providers is accepted
+		stakingTxHash, _, err := h.CreateMultiFPDelegation(r, fpPKs[:maxFps], 2*10e8, 1000)
+		h.NoError(err)
+		actualDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.Len(h.t, actualDel.FpBtcPkList, int(maxFps))
+
+		// a BTC delegation restaking to one more finality provider is rejected
+		_, _, err = h.CreateMultiFPDelegation(r, fpPKs, 2*10e8, 1000)
+		require.ErrorIs(h.t, err, types.ErrTooManyFinalityProviders)
+
+		// no maximum is enforced if unset, e.g., in parameters stored before
+		// the maximum was introduced
+		params = h.BTCStakingKeeper.GetParams(h.Ctx)
+		params.MaxFinalityProvidersPerDelegation = 0
+		err = h.BTCStakingKeeper.SetParams(h.Ctx, params)
+		h.NoError(err)
+		stakingTxHash, _, err = h.CreateMultiFPDelegation(r, fpPKs, 2*10e8, 1000)
+		h.NoError(err)
+		actualDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.Len(h.t, actualDel.FpBtcPkList, int(maxFps)+1)
+	})
+}
+
 func TestDoNotAllowDelegationWithoutFinalityProvider(t *testing.T) {
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 	ctrl := gomock.NewController(t)
@@ -821,6 +1633,139 @@ func TestMinimalUnbondingRate(t *testing.T) {
 	}
 }
 
+func TestMinSlashingTxFee(t *testing.T) {
+	// slashing txs generated in tests leave 2000 sat for the miner
+	slashingTxFee := int64(2000)
+
+	tests := []struct {
+		name                string
+		minSlashingTxFeeSat int64
+		err                 error
+	}{
+		{
+			name:                "successful delegation when slashing tx fee is equal to the minimum",
+			minSlashingTxFeeSat: slashingTxFee,
+			err:                 nil,
+		},
+		{
+			name:                "failed delegation when slashing tx fee is less than the minimum",
+			minSlashingTxFeeSat: slashingTxFee + 1,
+			err:                 types.ErrInsufficientSlashingFee,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(time.Now().Unix()))
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// mock BTC light client and BTC checkpoint modules
+			btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+			btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+			ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+			h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+			// set all parameters and then the minimum slashing tx fee
+			_, _ = h.GenAndApplyParams(r)
+			params := h.BTCStakingKeeper.GetParams(h.Ctx)
+			params.MinSlashingTxFeeSat = tt.minSlashingTxFeeSat
+			err := h.BTCStakingKeeper.SetParams(h.Ctx, params)
+			require.NoError(t, err)
+
+			changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+			require.NoError(t, err)
+
+			// generate and insert new finality provider
+			_, fpPK, _ := h.CreateFinalityProvider(r)
+
+			// generate and insert new BTC delegation
+			stakingTxHash, _, _, _, err := h.CreateDelegationCustom(
+				r,
+				fpPK,
+				changeAddress.EncodeAddress(),
+				10000,
+				1000,
+				9000,
+				1000,
+			)
+			if tt.err != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				delegation, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+				require.NoError(t, err)
+				require.NotNil(t, delegation)
+			}
+		})
+	}
+}
+
+func TestStakingTimeBounds(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+	h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+	// set all parameters
+	h.GenAndApplyParams(r)
+	changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+	require.NoError(t, err)
+
+	// generate and insert new finality provider
+	_, fpPK, _ := h.CreateFinalityProvider(r)
+
+	// the max staking time is accepted
+	stakingValue := int64(2 * 10e8)
+	_, _, _, msgCreateBTCDel, _ := h.CreateDelegation(
+		r,
+		fpPK,
+		changeAddress.EncodeAddress(),
+		stakingValue,
+		types.MaxStakingTimeBlocks,
+	)
+	require.Equal(t, uint32(types.MaxStakingTimeBlocks), msgCreateBTCDel.StakingTime)
+
+	// zero staking time and staking time overflowing uint16 are rejected
+	for _, stakingTime := range []uint32{0, types.MaxStakingTimeBlocks + 1, math.MaxUint32} {
+		msg := *msgCreateBTCDel
+		msg.StakingTime = stakingTime
+		err := msg.ValidateBasic()
+		require.ErrorIs(t, err, types.ErrInvalidStakingTx)
+		_, err = h.MsgServer.CreateBTCDelegation(h.Ctx, &msg)
+		require.Error(t, err)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+
+	// datagen refuses to generate a BTC delegation whose staking time overflows uint16
+	delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+	_, err = datagen.GenRandomBTCDelegation(
+		r,
+		t,
+		h.Net,
+		[]bbn.BIP340PubKey{*bbn.NewBIP340PubKeyFromBTCPK(fpPK)},
+		delSK,
+		nil,
+		nil,
+		bsParams.CovenantQuorum,
+		bsParams.SlashingAddress,
+		1,
+		2+types.MaxStakingTimeBlocks,
+		uint64(stakingValue),
+		bsParams.SlashingRate,
+		1000,
+	)
+	require.Error(t, err)
+}
+
 func createNDelegationsForFinalityProvider(
 	r *rand.Rand,
 	t *testing.T,
@@ -2,12 +2,14 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 	"sort"
 
 	"cosmossdk.io/store/prefix"
 	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -17,6 +19,14 @@ import (
 // UpdatePowerDist updates the voting power table and distribution cache.
 // This is triggered upon each `BeginBlock`
 func (k Keeper) UpdatePowerDist(ctx context.Context) {
+	// if the checkpoint finalization timeout w has changed since the last
+	// height, the voting power distribution maintained incrementally under the
+	// old w is inconsistent, so recompute it from scratch
+	if k.updatePowerDistFinalizationTimeout(ctx) {
+		k.RecomputePowerDist(ctx)
+		return
+	}
+
 	height := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
 	btcTipHeight := k.GetCurrentBTCHeight(ctx)
 	maxActiveFps := k.GetParams(ctx).MaxActiveFinalityProviders
@@ -24,9 +34,12 @@ func (k Keeper) UpdatePowerDist(ctx context.Context) {
 	// get the power dist cache in the last height
 	dc := k.getVotingPowerDistCache(ctx, height-1)
 	// get all power distribution update events during the previous tip
-	// and the current tip
+	// and the current tip. If the BTC tip has been rolled back below the
+	// previous tip, the events at the previous tip, e.g., the ones about BTC
+	// delegations invalidated by the rollback, are still processed
 	lastBTCTipHeight := k.GetBTCHeightAtBabylonHeight(ctx, height-1)
-	events := k.GetAllPowerDistUpdateEvents(ctx, lastBTCTipHeight, btcTipHeight)
+	toBTCTipHeight := max(btcTipHeight, lastBTCTipHeight)
+	events := k.GetAllPowerDistUpdateEvents(ctx, lastBTCTipHeight, toBTCTipHeight)
 
 	// if no event exists, then map previous voting power and
 	// cache to the current height
@@ -45,7 +58,7 @@ func (k Keeper) UpdatePowerDist(ctx context.Context) {
 
 	// clear all events that have been consumed in this function
 	defer func() {
-		for i := lastBTCTipHeight; i <= btcTipHeight; i++ {
+		for i := lastBTCTipHeight; i <= toBTCTipHeight; i++ {
 			k.ClearPowerDistUpdateEvents(ctx, i)
 		}
 	}()
@@ -53,6 +66,9 @@ func (k Keeper) UpdatePowerDist(ctx context.Context) {
 	// reconcile old voting power distribution cache and new events
 	// to construct the new distribution
 	newDc := k.ProcessAllPowerDistUpdateEvents(ctx, dc, events, maxActiveFps)
+	// BTC delegations that are no longer active do not need their reward
+	// lockup anymore
+	k.deleteUnbondedBTCDelRewardStartEpochs(ctx, events)
 
 	// record voting power and cache for this height
 	k.recordVotingPowerAndCache(ctx, newDc, maxActiveFps)
@@ -60,6 +76,135 @@ func (k Keeper) UpdatePowerDist(ctx context.Context) {
 	k.recordMetrics(newDc, maxActiveFps)
 }
 
+// RecomputePowerDist recomputes the voting power table and distribution cache
+// at the current height from scratch over all BTC delegations, using the
+// current parameters. All pending power distribution update events are
+// discarded, and the events of BTC delegations expiring in the future are
+// rescheduled under the current checkpoint finalization timeout w.
+// This is triggered upon the `BeginBlock` in which w changes, which only
+// happens upon a governance proposal. It iterates over all BTC delegations
+// twice, so its cost is linear in the number of BTC delegations
+func (k Keeper) RecomputePowerDist(ctx context.Context) {
+	height := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	btcTipHeight := k.GetCurrentBTCHeight(ctx)
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+	params := k.GetParams(ctx)
+
+	// discard all pending events, including the ones about BTC delegations
+	// expiring in the future that are scheduled under the old w. The effects
+	// of events up to the current BTC tip are captured by the recomputation,
+	// and BTC delegations that are no longer active in these events do not
+	// need their reward lockup anymore
+	lastBTCTipHeight := k.GetBTCHeightAtBabylonHeight(ctx, height-1)
+	k.deleteUnbondedBTCDelRewardStartEpochs(ctx, k.GetAllPowerDistUpdateEvents(ctx, lastBTCTipHeight, btcTipHeight))
+	for i := lastBTCTipHeight; i <= btcTipHeight; i++ {
+		k.ClearPowerDistUpdateEvents(ctx, i)
+	}
+	k.clearPowerDistUpdateEventsAfter(ctx, btcTipHeight)
+
+	// reschedule the events that BTC delegations expiring in the future will
+	// become unbonded at endHeight-w
+	iter := k.btcDelegationStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var btcDel types.BTCDelegation
+		k.cdc.MustUnmarshal(iter.Value(), &btcDel)
+
+		delWValue := btcDel.FinalizationTimeout(wValue)
+		if btcDel.EndHeight > btcTipHeight+delWValue {
+			unbondedEvent := types.NewEventPowerDistUpdateWithBTCDel(&types.EventBTCDelegationStateUpdate{
+				StakingTxHash: btcDel.MustGetStakingTxHash().String(),
+				NewState:      types.BTCDelegationStatus_UNBONDED,
+			})
+			k.addPowerDistUpdateEvent(ctx, btcDel.EndHeight-delWValue, unbondedEvent)
+		}
+	}
+
+	// construct the voting power distribution over all BTC delegations that
+	// are active under the current parameters
+	dc := k.computePowerDist(ctx, btcTipHeight, wValue, &params)
+
+	// remove the voting power table at this height, if any, so that it does
+	// not contain finality providers that no longer have voting power
+	k.clearVotingPowerTable(ctx, height)
+
+	// record voting power and cache for this height
+	k.recordVotingPowerAndCache(ctx, dc, params.MaxActiveFinalityProviders)
+	// record metrics
+	k.recordMetrics(dc, params.MaxActiveFinalityProviders)
+}
+
+// computePowerDist computes the voting power distribution from scratch over
+// all BTC delegations that are active at the given BTC height under the given
+// checkpoint finalization timeout w and parameters. It does not change state
+func (k Keeper) computePowerDist(ctx context.Context, btcTipHeight uint64, wValue uint64, params *types.Params) *types.VotingPowerDistCache {
+	fpDistInfos := map[string]*types.FinalityProviderDistInfo{}
+	iter := k.btcDelegationStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var btcDel types.BTCDelegation
+		k.cdc.MustUnmarshal(iter.Value(), &btcDel)
+
+		if btcDel.GetStatus(btcTipHeight, wValue, params.CovenantQuorum) != types.BTCDelegationStatus_ACTIVE {
+			continue
+		}
+		for _, fpBTCPK := range btcDel.FpBtcPkList {
+			fpBTCPKHex := fpBTCPK.MarshalHex()
+			fpDistInfo, ok := fpDistInfos[fpBTCPKHex]
+			if !ok {
+				fp, err := k.GetFinalityProvider(ctx, fpBTCPK)
+				if err != nil {
+					panic(err) // only programming error
+				}
+				// slashed finality providers do not have voting power
+				if fp.IsSlashed() {
+					continue
+				}
+				fpDistInfo = types.NewFinalityProviderDistInfo(fp)
+				fpDistInfos[fpBTCPKHex] = fpDistInfo
+			}
+			fpDistInfo.AddBTCDel(&btcDel)
+		}
+	}
+
+	// add finality providers to the new cache in the order of their BTC PKs
+	// to ensure determinism
+	dc := types.NewVotingPowerDistCache()
+	for _, fpBTCPKHex := range sortedDistInfoKeys(fpDistInfos) {
+		if fpDistInfos[fpBTCPKHex].TotalVotingPower > 0 {
+			dc.AddFinalityProviderDistInfo(fpDistInfos[fpBTCPKHex])
+		}
+	}
+	dc.ApplyActiveFinalityProviders(params.MaxActiveFinalityProviders)
+	return dc
+}
+
+// updatePowerDistFinalizationTimeout records the current checkpoint
+// finalization timeout w as the one the voting power distribution is computed
+// under, and returns whether it differs from the previously recorded one
+func (k Keeper) updatePowerDistFinalizationTimeout(ctx context.Context) bool {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+
+	lastWValueBytes := store.Get(types.PowerDistFinalizationTimeoutKey)
+	store.Set(types.PowerDistFinalizationTimeoutKey, sdk.Uint64ToBigEndian(wValue))
+
+	// the voting power distribution has not been computed before
+	if lastWValueBytes == nil {
+		return false
+	}
+	return sdk.BigEndianToUint64(lastWValueBytes) != wValue
+}
+
+func sortedDistInfoKeys(m map[string]*types.FinalityProviderDistInfo) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func (k Keeper) recordVotingPowerAndCache(ctx context.Context, dc *types.VotingPowerDistCache, maxActiveFps uint32) {
 	babylonTipHeight := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
 
@@ -72,6 +217,89 @@ func (k Keeper) recordVotingPowerAndCache(ctx context.Context, dc *types.VotingP
 
 	// set the voting power distribution cache of the current height
 	k.setVotingPowerDistCache(ctx, babylonTipHeight, dc)
+
+	if babylonTipHeight == 0 {
+		return
+	}
+	prevActiveFPs := k.GetVotingPowerTable(ctx, babylonTipHeight-1)
+	curActiveFPs := k.GetVotingPowerTable(ctx, babylonTipHeight)
+
+	// notify subscribers about finality providers whose voting power changes
+	k.emitVotingPowerUpdatedEvents(ctx, babylonTipHeight, prevActiveFPs, curActiveFPs)
+}
+
+// emitVotingPowerUpdatedEvents compares the given voting power tables at the
+// given height and the previous height, and emits an
+// EventFinalityProviderVotingPowerUpdated for each finality provider whose
+// voting power differs between them
+func (k Keeper) emitVotingPowerUpdatedEvents(ctx context.Context, height uint64, prevActiveFPs, curActiveFPs map[string]uint64) {
+	// union of the finality providers in both tables, of which only the keys
+	// are used
+	allFPs := make(map[string]uint64, len(prevActiveFPs)+len(curActiveFPs))
+	for fpBTCPKHex := range prevActiveFPs {
+		allFPs[fpBTCPKHex] = 0
+	}
+	for fpBTCPKHex := range curActiveFPs {
+		allFPs[fpBTCPKHex] = 0
+	}
+
+	// iterate over sorted BTC PKs to ensure determinism
+	for _, fpBTCPKHex := range sortedKeys(allFPs) {
+		oldPower, newPower := prevActiveFPs[fpBTCPKHex], curActiveFPs[fpBTCPKHex]
+		if oldPower == newPower {
+			continue
+		}
+		event := &types.EventFinalityProviderVotingPowerUpdated{
+			FpBtcPk:        mustNewBIP340PubKeyFromHex(fpBTCPKHex),
+			OldVotingPower: oldPower,
+			NewVotingPower: newPower,
+			Height:         height,
+		}
+		if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(event); err != nil {
+			panic(fmt.Errorf("failed to emit EventFinalityProviderVotingPowerUpdated: %w", err))
+		}
+	}
+}
+
+// deleteUnbondedBTCDelRewardStartEpochs removes the reward start epochs that
+// the incentive module keeps for the BTC delegations unbonded, invalidated or
+// slashed in the given events
+func (k Keeper) deleteUnbondedBTCDelRewardStartEpochs(ctx context.Context, events []*types.EventPowerDistUpdate) {
+	if k.iKeeper == nil {
+		return
+	}
+
+	for _, event := range events {
+		delEvent := event.GetBtcDelStateUpdate()
+		if delEvent == nil {
+			continue
+		}
+		switch delEvent.NewState {
+		case types.BTCDelegationStatus_UNBONDED, types.BTCDelegationStatus_INVALIDATED, types.BTCDelegationStatus_SLASHED:
+			stakingTxHash, err := chainhash.NewHashFromStr(delEvent.StakingTxHash)
+			if err != nil {
+				panic(err) // only programming error
+			}
+			k.iKeeper.DeleteBTCDelRewardStartEpoch(ctx, stakingTxHash)
+		}
+	}
+}
+
+func sortedKeys(m map[string]uint64) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func mustNewBIP340PubKeyFromHex(pkHex string) *bbn.BIP340PubKey {
+	pk, err := bbn.NewBIP340PubKeyFromHex(pkHex)
+	if err != nil {
+		panic(err) // only programming error
+	}
+	return pk
 }
 
 func (k Keeper) recordMetrics(dc *types.VotingPowerDistCache, maxActiveFps uint32) {
@@ -96,6 +324,8 @@ func (k Keeper) recordMetrics(dc *types.VotingPowerDistCache, maxActiveFps uint3
 // The following events will affect the voting power distribution:
 // - newly active BTC delegations
 // - newly unbonded BTC delegations
+// - newly invalidated BTC delegations
+// - newly slashed BTC delegations
 // - slashed finality providers
 func (k Keeper) ProcessAllPowerDistUpdateEvents(
 	ctx context.Context,
@@ -103,9 +333,8 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 	events []*types.EventPowerDistUpdate,
 	maxActiveFps uint32,
 ) *types.VotingPowerDistCache {
-	// a map where key is finality provider's BTC PK hex and value is a list
-	// of BTC delegations that newly become active under this provider
-	activeBTCDels := map[string][]*types.BTCDelegation{}
+	// a list of staking tx hashes of BTC delegations that newly become active
+	activeStakingTxHashes := []string{}
 	// a map where key is unbonded BTC delegation's staking tx hash
 	unbondedBTCDels := map[string]struct{}{}
 	// a map where key is slashed finality providers' BTC PK
@@ -120,17 +349,11 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 			delEvent := typedEvent.BtcDelStateUpdate
 			if delEvent.NewState == types.BTCDelegationStatus_ACTIVE {
 				// newly active BTC delegation
-				btcDel, err := k.GetBTCDelegation(ctx, delEvent.StakingTxHash)
-				if err != nil {
-					panic(err) // only programming error
-				}
-				// add the BTC delegation to each restaked finality provider
-				for _, fpBTCPK := range btcDel.FpBtcPkList {
-					fpBTCPKHex := fpBTCPK.MarshalHex()
-					activeBTCDels[fpBTCPKHex] = append(activeBTCDels[fpBTCPKHex], btcDel)
-				}
-			} else if delEvent.NewState == types.BTCDelegationStatus_UNBONDED {
-				// add the expired BTC delegation to the map
+				activeStakingTxHashes = append(activeStakingTxHashes, delEvent.StakingTxHash)
+			} else if delEvent.NewState == types.BTCDelegationStatus_UNBONDED ||
+				delEvent.NewState == types.BTCDelegationStatus_INVALIDATED ||
+				delEvent.NewState == types.BTCDelegationStatus_SLASHED {
+				// add the expired, invalidated, or slashed BTC delegation to the map
 				unbondedBTCDels[delEvent.StakingTxHash] = struct{}{}
 			}
 		case *types.EventPowerDistUpdate_SlashedFp:
@@ -139,6 +362,30 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 		}
 	}
 
+	// a map where key is finality provider's BTC PK hex and value is a list
+	// of BTC delegations that newly become active under this provider. A BTC
+	// delegation that becomes unbonded, invalidated or slashed within the
+	// same events, or that has been invalidated before its activation is
+	// processed, never gains voting power
+	activeBTCDels := map[string][]*types.BTCDelegation{}
+	for _, stakingTxHash := range activeStakingTxHashes {
+		if _, ok := unbondedBTCDels[stakingTxHash]; ok {
+			continue
+		}
+		btcDel, err := k.GetBTCDelegation(ctx, stakingTxHash)
+		if err != nil {
+			panic(err) // only programming error
+		}
+		if btcDel.Invalidated {
+			continue
+		}
+		// add the BTC delegation to each restaked finality provider
+		for _, fpBTCPK := range btcDel.FpBtcPkList {
+			fpBTCPKHex := fpBTCPK.MarshalHex()
+			activeBTCDels[fpBTCPKHex] = append(activeBTCDels[fpBTCPKHex], btcDel)
+		}
+	}
+
 	/*
 		At this point, there is voting power update.
 		Then, construct a voting power dist cache by reconciling the previous
@@ -282,6 +529,29 @@ func (k Keeper) ClearPowerDistUpdateEvents(ctx context.Context, btcHeight uint64
 	}
 }
 
+// clearPowerDistUpdateEventsAfter removes all voting power distribution update
+// events at BTC heights above the given one
+func (k Keeper) clearPowerDistUpdateEventsAfter(ctx context.Context, btcHeight uint64) {
+	store := k.powerDistUpdateEventStore(ctx)
+	keys := [][]byte{}
+
+	// get all keys
+	// using an enclosure to ensure iterator is closed right after
+	// the function is done
+	func() {
+		iter := store.Iterator(sdk.Uint64ToBigEndian(btcHeight+1), nil)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+	}()
+
+	// remove all keys
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 // GetAllPowerDistUpdateEvents gets all voting power update events
 func (k Keeper) GetAllPowerDistUpdateEvents(ctx context.Context, lastBTCTip uint64, curBTCTip uint64) []*types.EventPowerDistUpdate {
 	events := []*types.EventPowerDistUpdate{}
@@ -1,13 +1,21 @@
 package keeper_test
 
 import (
+	"context"
 	"math/rand"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/babylonchain/babylon/testutil/datagen"
+	keepertest "github.com/babylonchain/babylon/testutil/keeper"
+	bbn "github.com/babylonchain/babylon/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
 	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/btcsuite/btcd/btcec/v2"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -122,14 +130,29 @@ func FuzzFinalityProviderEvents(f *testing.F) {
 		err = h.BTCStakingKeeper.SlashFinalityProvider(h.Ctx, fp.BtcPk.MustMarshal())
 		h.NoError(err)
 
-		// at this point, there should be only 1 event that the finality provider is slashed
+		// at this point, there should be only 1 event that the finality provider
+		// is slashed. Its BTC delegation is not slashed until the inclusion
+		// proof of its slashing tx is submitted
 		btcTipHeight := btclcKeeper.GetTipInfo(h.Ctx).Height
+		numSlashedFPEvents := 0
 		h.BTCStakingKeeper.IteratePowerDistUpdateEvents(h.Ctx, btcTipHeight, func(ev *types.EventPowerDistUpdate) bool {
 			slashedFPEvent := ev.GetSlashedFp()
 			require.NotNil(t, slashedFPEvent)
 			require.Equal(t, fp.BtcPk.MustMarshal(), slashedFPEvent.Pk.MustMarshal())
+			numSlashedFPEvents++
 			return true
 		})
+		require.Equal(t, 1, numSlashedFPEvents)
+
+		// ensure the BTC delegation is slashable but not slashed yet
+		btcDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, actualDel.MustGetStakingTxHash().String())
+		h.NoError(err)
+		wValue := h.BTCCheckpointKeeper.GetParams(h.Ctx).CheckpointFinalizationTimeout
+		covenantQuorum := h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum
+		require.Equal(t, btcTipHeight, btcDel.FpSlashedBtcHeight)
+		require.Zero(t, btcDel.SlashedBtcHeight)
+		require.True(t, btcDel.IsSlashable(btcTipHeight))
+		require.Equal(t, types.BTCDelegationStatus_ACTIVE, btcDel.GetStatus(btcTipHeight, wValue, covenantQuorum))
 
 		// execute BeginBlock
 		babylonHeight += 1
@@ -142,6 +165,101 @@ func FuzzFinalityProviderEvents(f *testing.F) {
 	})
 }
 
+// votingPowerUpdatedEvents returns the EventFinalityProviderVotingPowerUpdated
+// events emitted under the given context
+func votingPowerUpdatedEvents(t *testing.T, ctx sdk.Context) []*types.EventFinalityProviderVotingPowerUpdated {
+	var events []*types.EventFinalityProviderVotingPowerUpdated
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != proto.MessageName(&types.EventFinalityProviderVotingPowerUpdated{}) {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		events = append(events, typedEvent.(*types.EventFinalityProviderVotingPowerUpdated))
+	}
+	return events
+}
+
+func FuzzFinalityProviderVotingPowerUpdatedEvents(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+
+		// insert new BTC delegation and give it covenant quorum
+		stakingValue := int64(2 * 10e8)
+		_, _, _, msgCreateBTCDel, actualDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+		msgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel)
+		for i := 0; i < int(h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum); i++ {
+			_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msgs[i])
+			h.NoError(err)
+		}
+
+		// execute BeginBlock, upon which the finality provider gains voting power
+		btcTip := btclcKeeper.GetTipInfo(h.Ctx)
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		h.SetCtxHeight(babylonHeight)
+		h.Ctx = h.Ctx.WithEventManager(sdk.NewEventManager())
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		events := votingPowerUpdatedEvents(t, h.Ctx)
+		require.Len(t, events, 1)
+		require.Equal(t, fp.BtcPk.MarshalHex(), events[0].FpBtcPk.MarshalHex())
+		require.Zero(t, events[0].OldVotingPower)
+		require.Equal(t, uint64(stakingValue), events[0].NewVotingPower)
+		require.Equal(t, babylonHeight, events[0].Height)
+
+		// execute BeginBlock without any change, upon which no event is emitted
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		h.Ctx = h.Ctx.WithEventManager(sdk.NewEventManager())
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		require.Empty(t, votingPowerUpdatedEvents(t, h.Ctx))
+
+		// slash the finality provider and execute BeginBlock, upon which the
+		// finality provider loses its voting power
+		err = h.BTCStakingKeeper.SlashFinalityProvider(h.Ctx, fp.BtcPk.MustMarshal())
+		h.NoError(err)
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		h.Ctx = h.Ctx.WithEventManager(sdk.NewEventManager())
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		events = votingPowerUpdatedEvents(t, h.Ctx)
+		require.Len(t, events, 1)
+		require.Equal(t, fp.BtcPk.MarshalHex(), events[0].FpBtcPk.MarshalHex())
+		require.Equal(t, uint64(stakingValue), events[0].OldVotingPower)
+		require.Zero(t, events[0].NewVotingPower)
+		require.Equal(t, babylonHeight, events[0].Height)
+	})
+}
+
 func FuzzBTCDelegationEvents(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 
@@ -245,3 +363,334 @@ func FuzzBTCDelegationEvents(f *testing.F) {
 		require.Len(t, events, 0)
 	})
 }
+
+func FuzzBTCReorgInvalidatesBTCDelegation(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+
+		// insert new BTC delegation and give it covenant quorum
+		stakingValue := int64(2 * 10e8)
+		stakingTxHash, _, _, msgCreateBTCDel, actualDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+		msgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel)
+		for i := 0; i < int(h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum); i++ {
+			_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msgs[i])
+			h.NoError(err)
+		}
+
+		// execute BeginBlock and ensure the finality provider has voting power
+		btcTip := btclcKeeper.GetTipInfo(h.Ctx)
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		require.Equal(t, uint64(stakingValue), h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, babylonHeight))
+
+		/*
+			BTC reorg that does not orphan the BTC block including the staking tx
+			ensure the BTC delegation is not affected
+		*/
+		h.BTCStakingKeeper.Hooks().AfterBTCRollBack(h.Ctx, &btclctypes.BTCHeaderInfo{Height: actualDel.StartHeight})
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		require.Equal(t, uint64(stakingValue), h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, babylonHeight))
+		btcDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.False(t, btcDel.Invalidated)
+
+		/*
+			BTC reorg that orphans the BTC block including the staking tx, which
+			is included in the main chain again before the next BeginBlock
+			ensure the BTC delegation is not affected
+		*/
+		h.BTCStakingKeeper.Hooks().AfterBTCRollBack(h.Ctx, &btclctypes.BTCHeaderInfo{Height: actualDel.StartHeight - 1})
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		h.BTCLightClientKeeper.EXPECT().GetHeaderByHash(gomock.Eq(h.Ctx), gomock.Eq(actualDel.StakingTxKey.Hash)).
+			Return(&btclctypes.BTCHeaderInfo{Height: actualDel.StartHeight}).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		require.Equal(t, uint64(stakingValue), h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, babylonHeight))
+		btcDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.False(t, btcDel.Invalidated)
+
+		/*
+			BTC reorg that orphans the BTC block including the staking tx, after
+			which the BTC tip is below the one at the last height
+			ensure the BTC delegation is invalidated and has no voting power
+		*/
+		h.BTCStakingKeeper.Hooks().AfterBTCRollBack(h.Ctx, &btclctypes.BTCHeaderInfo{Height: actualDel.StartHeight - 1})
+		babylonHeight += 1
+		h.SetCtxHeight(babylonHeight)
+		rolledBackTip := &btclctypes.BTCHeaderInfo{Height: actualDel.StartHeight - 1}
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(rolledBackTip).AnyTimes()
+		h.BTCLightClientKeeper.EXPECT().GetHeaderByHash(gomock.Eq(h.Ctx), gomock.Eq(actualDel.StakingTxKey.Hash)).
+			Return(nil).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		require.Zero(t, h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, babylonHeight))
+		btcDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.True(t, btcDel.Invalidated)
+		wValue := btccKeeper.GetParams(h.Ctx).CheckpointFinalizationTimeout
+		require.Equal(t, types.BTCDelegationStatus_INVALIDATED, btcDel.GetStatus(rolledBackTip.Height, wValue, h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum))
+
+		// ensure the invalidation is emitted as an event
+		var invalidatedEvent *types.EventBTCDelegationStateUpdate
+		for _, event := range h.Ctx.EventManager().Events() {
+			if event.Type != proto.MessageName(&types.EventBTCDelegationStateUpdate{}) {
+				continue
+			}
+			typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(t, err)
+			if ev := typedEvent.(*types.EventBTCDelegationStateUpdate); ev.NewState == types.BTCDelegationStatus_INVALIDATED {
+				invalidatedEvent = ev
+			}
+		}
+		require.NotNil(t, invalidatedEvent)
+		require.Equal(t, stakingTxHash, invalidatedEvent.StakingTxHash)
+	})
+}
+
+func FuzzBTCReorgInvalidatesBTCDelegationBeforeActivation(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+
+		// insert new BTC delegation and give it covenant quorum, so that its
+		// activation is recorded but not processed yet
+		stakingValue := int64(2 * 10e8)
+		stakingTxHash, _, _, msgCreateBTCDel, actualDel := h.CreateDelegation(
+			r,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+		)
+		msgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgCreateBTCDel, actualDel)
+		for i := 0; i < int(h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum); i++ {
+			_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msgs[i])
+			h.NoError(err)
+		}
+
+		/*
+			BTC reorg that orphans the BTC block including the staking tx before
+			the next BeginBlock, so that the activation and the invalidation of
+			the BTC delegation are processed in the same BeginBlock
+			ensure the BTC delegation is invalidated and never has voting power
+		*/
+		h.BTCStakingKeeper.Hooks().AfterBTCRollBack(h.Ctx, &btclctypes.BTCHeaderInfo{Height: actualDel.StartHeight - 1})
+		btcTip := btclcKeeper.GetTipInfo(h.Ctx)
+		// a height other than the initial one, under which the staking tx is
+		// mocked to be in the BTC main chain
+		babylonHeight := datagen.RandomInt(r, 10) + 2
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		h.BTCLightClientKeeper.EXPECT().GetHeaderByHash(gomock.Eq(h.Ctx), gomock.Eq(actualDel.StakingTxKey.Hash)).
+			Return(nil).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		h.NoError(err)
+		require.Zero(t, h.BTCStakingKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, babylonHeight))
+		btcDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.True(t, btcDel.Invalidated)
+
+		// the staking tx of the invalidated BTC delegation cannot be reused
+		_, err = h.MsgServer.CreateBTCDelegation(h.Ctx, msgCreateBTCDel)
+		require.ErrorIs(t, err, types.ErrReusedStakingTx)
+	})
+}
+
+func FuzzRecomputePowerDistAfterFinalizationTimeoutChange(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules, where w can be
+		// changed during the test
+		btcTipHeight := uint64(1000)
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: btcTipHeight}).AnyTimes()
+		baseHeader := btclctypes.SimnetGenesisBlock()
+		btclcKeeper.EXPECT().GetBaseBTCHeader(gomock.Any()).Return(&baseHeader).AnyTimes()
+		btccParams := btcctypes.DefaultParams()
+		btccParams.CheckpointFinalizationTimeout = datagen.RandomInt(r, 10) + 1
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).DoAndReturn(func(_ context.Context) btcctypes.Params {
+			return btccParams
+		}).AnyTimes()
+		iKeeper := types.NewMockIncentiveKeeper(ctrl)
+		keeper, ctx := keepertest.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil, iKeeper)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+		params := keeper.GetParams(ctx)
+		params.CovenantPks = bbn.NewBIP340PKsFromBTCPKs(covenantPKs)
+		params.CovenantQuorum = covenantQuorum
+		err = keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		// generate a number of finality providers, each with a number of
+		// active BTC delegations expiring around the current BTC tip
+		btcDels := []*types.BTCDelegation{}
+		numFps := datagen.RandomInt(r, 5) + 1
+		for i := uint64(0); i < numFps; i++ {
+			fp, err := datagen.GenRandomFinalityProvider(r)
+			require.NoError(t, err)
+			keeper.SetFinalityProvider(ctx, fp)
+
+			numDels := datagen.RandomInt(r, 5) + 1
+			for j := uint64(0); j < numDels; j++ {
+				delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+				require.NoError(t, err)
+				startHeight := btcTipHeight - datagen.RandomInt(r, 100)
+				endHeight := btcTipHeight + datagen.RandomInt(r, 100) + 20
+				btcDel, err := datagen.GenRandomBTCDelegation(
+					r,
+					t,
+					net,
+					[]bbn.BIP340PubKey{*fp.BtcPk},
+					delSK,
+					covenantSKs,
+					covenantPKs,
+					covenantQuorum,
+					slashingAddress.EncodeAddress(),
+					startHeight, endHeight, 10000,
+					slashingRate,
+					uint16(101),
+				)
+				require.NoError(t, err)
+				err = keeper.AddBTCDelegation(ctx, btcDel)
+				require.NoError(t, err)
+				btcDels = append(btcDels, btcDel)
+			}
+		}
+
+		// the voting power distribution is computed under the initial w
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		ctx = datagen.WithCtxHeight(ctx, babylonHeight)
+		err = keeper.BeginBlocker(ctx)
+		require.NoError(t, err)
+
+		// a BTC delegation that expires at the current BTC tip, whose pending
+		// event is discarded upon the recomputation. Its reward start epoch is
+		// still removed
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		keeper.SetFinalityProvider(ctx, fp)
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		expiringDel, err := datagen.GenRandomBTCDelegation(
+			r,
+			t,
+			net,
+			[]bbn.BIP340PubKey{*fp.BtcPk},
+			delSK,
+			covenantSKs,
+			covenantPKs,
+			covenantQuorum,
+			slashingAddress.EncodeAddress(),
+			btcTipHeight-10, btcTipHeight+btccParams.CheckpointFinalizationTimeout, 10000,
+			slashingRate,
+			uint16(101),
+		)
+		require.NoError(t, err)
+		err = keeper.AddBTCDelegation(ctx, expiringDel)
+		require.NoError(t, err)
+		btcDels = append(btcDels, expiringDel)
+		expiringTxHash := expiringDel.MustGetStakingTxHash()
+		iKeeper.EXPECT().DeleteBTCDelRewardStartEpoch(gomock.Any(), &expiringTxHash).Times(1)
+
+		// change w, such that some BTC delegations become expired
+		btccParams.CheckpointFinalizationTimeout += datagen.RandomInt(r, 100) + 1
+		babylonHeight += 1
+		ctx = datagen.WithCtxHeight(ctx, babylonHeight)
+		err = keeper.BeginBlocker(ctx)
+		require.NoError(t, err)
+
+		// the recomputed voting power table matches the one computed from
+		// scratch under the new w
+		expectedPowerTable := map[string]uint64{}
+		for _, btcDel := range btcDels {
+			power := btcDel.VotingPower(btcTipHeight, btccParams.CheckpointFinalizationTimeout, covenantQuorum)
+			if power > 0 {
+				expectedPowerTable[btcDel.FpBtcPkList[0].MarshalHex()] += power
+			}
+		}
+		actualPowerTable := keeper.GetVotingPowerTable(ctx, babylonHeight)
+		if len(expectedPowerTable) == 0 {
+			require.Empty(t, actualPowerTable)
+		} else {
+			require.Equal(t, expectedPowerTable, actualPowerTable)
+		}
+
+		// the events that BTC delegations will become unbonded are rescheduled
+		// at endHeight-w under the new w
+		for _, btcDel := range btcDels {
+			expiryHeight := btcDel.EndHeight - btccParams.CheckpointFinalizationTimeout
+			if expiryHeight <= btcTipHeight {
+				continue
+			}
+			events := keeper.GetAllPowerDistUpdateEvents(ctx, expiryHeight, expiryHeight)
+			found := false
+			for _, event := range events {
+				if event.GetBtcDelStateUpdate().StakingTxHash == btcDel.MustGetStakingTxHash().String() {
+					found = true
+				}
+			}
+			require.True(t, found)
+		}
+	})
+}
@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
 
 	"github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -32,3 +34,83 @@ func (k Keeper) ParamsByVersion(goCtx context.Context, req *types.QueryParamsByV
 
 	return &types.QueryParamsByVersionResponse{Params: *pv}, nil
 }
+
+// CovenantCommittee returns the covenant committee and quorum in the current parameters
+func (k Keeper) CovenantCommittee(goCtx context.Context, req *types.QueryCovenantCommitteeRequest) (*types.QueryCovenantCommitteeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pv := k.GetParamsWithVersion(ctx)
+
+	covenantPKs := make([]*types.CovenantPkResponse, len(pv.Params.CovenantPks))
+	for i, covPK := range pv.Params.CovenantPks {
+		btcPK, err := covPK.ToBTCPK()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "invalid covenant PK %s: %v", covPK.MarshalHex(), err)
+		}
+		covenantPKs[i] = &types.CovenantPkResponse{
+			Bip340PkHex:     covPK.MarshalHex(),
+			CompressedPkHex: hex.EncodeToString(btcPK.SerializeCompressed()),
+		}
+	}
+
+	return &types.QueryCovenantCommitteeResponse{
+		CovenantPks:    covenantPKs,
+		CovenantQuorum: pv.Params.CovenantQuorum,
+		ParamsVersion:  pv.Version,
+	}, nil
+}
+
+// RecommendedSlashingFeeRate returns the fee rate recommended for broadcasting
+// slashing and unbonding txs in the current parameters
+func (k Keeper) RecommendedSlashingFeeRate(goCtx context.Context, req *types.QueryRecommendedSlashingFeeRateRequest) (*types.QueryRecommendedSlashingFeeRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	return &types.QueryRecommendedSlashingFeeRateResponse{
+		FeeRateSatPerVbyte:  params.GetEffectiveRecommendedSlashingFeeRate(),
+		MinSlashingTxFeeSat: params.MinSlashingTxFeeSat,
+	}, nil
+}
+
+// DelegationParams returns the parameters that the given BTC delegation was
+// created under
+func (k Keeper) DelegationParams(goCtx context.Context, req *types.QueryDelegationParamsRequest) (*types.QueryDelegationParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	stakingTxHash, err := chainhash.NewHashFromStr(req.StakingTxHashHex)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+	if btcDel == nil {
+		return nil, types.ErrBTCDelegationNotFound
+	}
+	pv := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+	if pv == nil {
+		return nil, types.ErrParamsNotFound.Wrapf("version %d does not exists", btcDel.ParamsVersion)
+	}
+
+	// BTC delegations created before k and w were snapshotted fall back to
+	// the current ones
+	btccParams := k.btccKeeper.GetParams(ctx)
+	kValue := btcDel.BtcConfirmationDepth
+	if kValue == 0 {
+		kValue = btccParams.BtcConfirmationDepth
+	}
+
+	return &types.QueryDelegationParamsResponse{
+		ParamsVersion:                 btcDel.ParamsVersion,
+		Params:                        *pv,
+		BtcConfirmationDepth:          kValue,
+		CheckpointFinalizationTimeout: btcDel.FinalizationTimeout(btccParams.CheckpointFinalizationTimeout),
+	}, nil
+}
@@ -1,15 +1,22 @@
 package keeper_test
 
 import (
+	"encoding/hex"
+	"math/rand"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+	"github.com/babylonchain/babylon/testutil/datagen"
 	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	bbn "github.com/babylonchain/babylon/types"
+	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
 
 func TestParamsQuery(t *testing.T) {
-	keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+	keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil, nil)
 	params := types.DefaultParams()
 
 	err := keeper.SetParams(ctx, params)
@@ -21,7 +28,7 @@ func TestParamsQuery(t *testing.T) {
 }
 
 func TestParamsByVersionQuery(t *testing.T) {
-	keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+	keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil, nil)
 
 	// starting with `1` as BTCStakingKeeper creates params with version 0
 	params1 := types.DefaultParams()
@@ -63,3 +70,164 @@ func TestParamsByVersionQuery(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, &types.QueryParamsByVersionResponse{Params: params3}, resp2)
 }
+
+func FuzzCovenantCommitteeQuery(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil, nil)
+
+		// update params with a random covenant committee
+		_, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		params := types.DefaultParams()
+		params.CovenantPks = bbn.NewBIP340PKsFromBTCPKs(covenantPKs)
+		params.CovenantQuorum = covenantQuorum
+		err := keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		_, err = keeper.CovenantCommittee(ctx, nil)
+		require.Error(t, err)
+
+		resp, err := keeper.CovenantCommittee(ctx, &types.QueryCovenantCommitteeRequest{})
+		require.NoError(t, err)
+		require.Equal(t, covenantQuorum, resp.CovenantQuorum)
+		require.Equal(t, keeper.GetParamsWithVersion(ctx).Version, resp.ParamsVersion)
+		require.Len(t, resp.CovenantPks, len(covenantPKs))
+		for i, covPK := range resp.CovenantPks {
+			require.Equal(t, params.CovenantPks[i].MarshalHex(), covPK.Bip340PkHex)
+			// both encodings refer to the same x coordinate
+			compressedPK, err := hex.DecodeString(covPK.CompressedPkHex)
+			require.NoError(t, err)
+			require.Len(t, compressedPK, 33)
+			require.Equal(t, params.CovenantPks[i].MustMarshal(), compressedPK[1:])
+		}
+	})
+}
+
+func TestRecommendedSlashingFeeRateQuery(t *testing.T) {
+	keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil, nil)
+
+	response, err := keeper.RecommendedSlashingFeeRate(ctx, &types.QueryRecommendedSlashingFeeRateRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams().RecommendedSlashingFeeRate, response.FeeRateSatPerVbyte)
+	require.Equal(t, types.DefaultParams().MinSlashingTxFeeSat, response.MinSlashingTxFeeSat)
+
+	// governance updates the recommended fee rate
+	params := types.DefaultParams()
+	params.RecommendedSlashingFeeRate = 25
+	err = keeper.SetParams(ctx, params)
+	require.NoError(t, err)
+
+	response, err = keeper.RecommendedSlashingFeeRate(ctx, &types.QueryRecommendedSlashingFeeRateRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(25), response.FeeRateSatPerVbyte)
+
+	// an unset fee rate, e.g., in parameters stored before the fee rate was
+	// introduced, falls back to the default one
+	params.RecommendedSlashingFeeRate = 0
+	err = keeper.SetParams(ctx, params)
+	require.NoError(t, err)
+	response, err = keeper.RecommendedSlashingFeeRate(ctx, &types.QueryRecommendedSlashingFeeRateRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams().RecommendedSlashingFeeRate, response.FeeRateSatPerVbyte)
+}
+
+func FuzzDelegationParamsQuery(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		btccParams := btcctypes.DefaultParams()
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btccParams).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, btccKeeper, nil, nil)
+
+		// update params with a random covenant committee, slashing rate and
+		// slashing address
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		params := types.DefaultParams()
+		params.CovenantPks = bbn.NewBIP340PKsFromBTCPKs(covenantPKs)
+		params.CovenantQuorum = covenantQuorum
+		params.SlashingAddress = slashingAddress.EncodeAddress()
+		params.SlashingRate = sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+		err = keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+		paramsVersion := keeper.GetParamsWithVersion(ctx).Version
+
+		// create BTC delegations under these params, one of which has k and w
+		// snapshotted
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		startHeight := datagen.RandomInt(r, 100) + 1
+		endHeight := datagen.RandomInt(r, 1000) + startHeight + btccParams.CheckpointFinalizationTimeout + 1
+		btcDels := make([]*types.BTCDelegation, 2)
+		for i := range btcDels {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			btcDels[i], err = datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				net,
+				[]bbn.BIP340PubKey{*fp.BtcPk},
+				delSK,
+				covenantSKs,
+				covenantPKs,
+				covenantQuorum,
+				params.SlashingAddress,
+				startHeight, endHeight, 10000,
+				params.SlashingRate,
+				uint16(101),
+			)
+			require.NoError(t, err)
+			btcDels[i].ParamsVersion = paramsVersion
+		}
+		btcDels[0].BtcConfirmationDepth = btccParams.BtcConfirmationDepth + datagen.RandomInt(r, 10) + 1
+		btcDels[0].CheckpointFinalizationTimeout = btccParams.CheckpointFinalizationTimeout + datagen.RandomInt(r, 10) + 1
+		for _, btcDel := range btcDels {
+			err = keeper.AddBTCDelegation(ctx, btcDel)
+			require.NoError(t, err)
+		}
+
+		// governance updates the params afterwards
+		err = keeper.SetParams(ctx, types.DefaultParams())
+		require.NoError(t, err)
+
+		// invalid requests
+		_, err = keeper.DelegationParams(ctx, nil)
+		require.Error(t, err)
+		_, err = keeper.DelegationParams(ctx, &types.QueryDelegationParamsRequest{StakingTxHashHex: "invalid"})
+		require.Error(t, err)
+		_, err = keeper.DelegationParams(ctx, &types.QueryDelegationParamsRequest{
+			StakingTxHashHex: datagen.GenRandomBtcdHash(r).String(),
+		})
+		require.ErrorIs(t, err, types.ErrBTCDelegationNotFound)
+
+		// the BTC delegations still refer to the params they were created under
+		for _, btcDel := range btcDels {
+			resp, err := keeper.DelegationParams(ctx, &types.QueryDelegationParamsRequest{
+				StakingTxHashHex: btcDel.MustGetStakingTxHash().String(),
+			})
+			require.NoError(t, err)
+			require.Equal(t, paramsVersion, resp.ParamsVersion)
+			require.Equal(t, params, resp.Params)
+		}
+
+		// the snapshotted k and w take precedence over the current ones
+		resp, err := keeper.DelegationParams(ctx, &types.QueryDelegationParamsRequest{
+			StakingTxHashHex: btcDels[0].MustGetStakingTxHash().String(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, btcDels[0].BtcConfirmationDepth, resp.BtcConfirmationDepth)
+		require.Equal(t, btcDels[0].CheckpointFinalizationTimeout, resp.CheckpointFinalizationTimeout)
+		resp, err = keeper.DelegationParams(ctx, &types.QueryDelegationParamsRequest{
+			StakingTxHashHex: btcDels[1].MustGetStakingTxHash().String(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, btccParams.BtcConfirmationDepth, resp.BtcConfirmationDepth)
+		require.Equal(t, btccParams.CheckpointFinalizationTimeout, resp.CheckpointFinalizationTimeout)
+	})
+}
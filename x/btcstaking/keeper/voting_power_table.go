@@ -98,6 +98,28 @@ func (k Keeper) HasVotingPowerTable(ctx context.Context, height uint64) bool {
 	return iter.Valid()
 }
 
+// clearVotingPowerTable removes the voting power table at a given height
+func (k Keeper) clearVotingPowerTable(ctx context.Context, height uint64) {
+	store := k.votingPowerBbnBlockHeightStore(ctx, height)
+	keys := [][]byte{}
+
+	// get all keys
+	// using an enclosure to ensure iterator is closed right after
+	// the function is done
+	func() {
+		iter := store.Iterator(nil, nil)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+	}()
+
+	// remove all keys
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 // GetVotingPowerTable gets the voting power table, i.e., finality provider set at a given height
 func (k Keeper) GetVotingPowerTable(ctx context.Context, height uint64) map[string]uint64 {
 	store := k.votingPowerBbnBlockHeightStore(ctx, height)
@@ -123,6 +145,23 @@ func (k Keeper) GetVotingPowerTable(ctx context.Context, height uint64) map[stri
 	return fpSet
 }
 
+// GetTotalVotingPower gets the total voting power of all finality providers
+// in the voting power table at a given height, together with the number of
+// finality providers in the table
+func (k Keeper) GetTotalVotingPower(ctx context.Context, height uint64) (uint64, uint64) {
+	store := k.votingPowerBbnBlockHeightStore(ctx, height)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	totalPower, numFPs := uint64(0), uint64(0)
+	for ; iter.Valid(); iter.Next() {
+		totalPower += sdk.BigEndianToUint64(iter.Value())
+		numFPs++
+	}
+
+	return totalPower, numFPs
+}
+
 // GetBTCStakingActivatedHeight returns the height when the BTC staking protocol is activated
 // i.e., the first height where a finality provider has voting power
 // Before the BTC staking protocol is activated, we don't index or tally any block
@@ -148,6 +187,90 @@ func (k Keeper) IsBTCStakingActivated(ctx context.Context) bool {
 	return iter.Valid()
 }
 
+// getLastVotingPowerTableHeight returns the Babylon height of the latest
+// voting power table, or false if no voting power table is recorded yet
+func (k Keeper) getLastVotingPowerTableHeight(ctx context.Context) (uint64, bool) {
+	iter := k.votingPowerStore(ctx).ReverseIterator(nil, nil)
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(iter.Key()), true
+}
+
+// findVotingPowerTableDiscrepancies recomputes the voting power distribution
+// at the given Babylon height from scratch over all BTC delegations, using
+// the current parameters, and compares it with the stored voting power table
+// at this height. It returns the finality providers whose stored voting power
+// differs from the recomputed one in the order of their BTC PKs, together with
+// the recomputed voting power distribution. It does not change state
+func (k Keeper) findVotingPowerTableDiscrepancies(ctx context.Context, height uint64) ([]*types.VotingPowerDiscrepancy, *types.VotingPowerDistCache) {
+	btcTipHeight := k.GetBTCHeightAtBabylonHeight(ctx, height)
+	wValue := k.btccKeeper.GetParams(ctx).CheckpointFinalizationTimeout
+	params := k.GetParams(ctx)
+	dc := k.computePowerDist(ctx, btcTipHeight, wValue, &params)
+
+	expectedTable := map[string]uint64{}
+	for i := uint32(0); i < dc.GetNumActiveFPs(params.MaxActiveFinalityProviders); i++ {
+		fp := dc.FinalityProviders[i]
+		expectedTable[fp.BtcPk.MarshalHex()] = fp.TotalVotingPower
+	}
+	storedTable := k.GetVotingPowerTable(ctx, height)
+
+	// iterate over the union of both tables in the order of BTC PKs
+	allFPs := map[string]uint64{}
+	for fpBTCPKHex := range expectedTable {
+		allFPs[fpBTCPKHex] = 0
+	}
+	for fpBTCPKHex := range storedTable {
+		allFPs[fpBTCPKHex] = 0
+	}
+
+	discrepancies := []*types.VotingPowerDiscrepancy{}
+	for _, fpBTCPKHex := range sortedKeys(allFPs) {
+		if storedTable[fpBTCPKHex] != expectedTable[fpBTCPKHex] {
+			discrepancies = append(discrepancies, &types.VotingPowerDiscrepancy{
+				FpBtcPkHex:          fpBTCPKHex,
+				StoredVotingPower:   storedTable[fpBTCPKHex],
+				ExpectedVotingPower: expectedTable[fpBTCPKHex],
+			})
+		}
+	}
+	return discrepancies, dc
+}
+
+// ReconcileVotingPowerTable recomputes the latest voting power table from
+// scratch over all BTC delegations, and overwrites the stored voting power
+// table and distribution cache at its height if they are inconsistent with
+// the BTC delegations, e.g., after a node crashed in the middle of updating
+// them. It returns the discrepancies found in the stored voting power table.
+// Unlike UpdatePowerDist, which applies power distribution update events
+// incrementally upon each `BeginBlock`, this is a consistency tool to be
+// invoked on demand, e.g., in an upgrade handler
+func (k Keeper) ReconcileVotingPowerTable(ctx context.Context) []*types.VotingPowerDiscrepancy {
+	height, ok := k.getLastVotingPowerTableHeight(ctx)
+	if !ok {
+		return nil
+	}
+
+	discrepancies, dc := k.findVotingPowerTableDiscrepancies(ctx, height)
+	if len(discrepancies) == 0 {
+		return discrepancies
+	}
+
+	maxActiveFps := k.GetParams(ctx).MaxActiveFinalityProviders
+	k.clearVotingPowerTable(ctx, height)
+	for i := uint32(0); i < dc.GetNumActiveFPs(maxActiveFps); i++ {
+		fp := dc.FinalityProviders[i]
+		k.SetVotingPower(ctx, fp.BtcPk.MustMarshal(), height, fp.TotalVotingPower)
+	}
+	k.setVotingPowerDistCache(ctx, height, dc)
+
+	k.Logger(sdk.UnwrapSDKContext(ctx)).Info("reconciled the voting power table",
+		"height", height, "num_discrepancies", len(discrepancies))
+	return discrepancies
+}
+
 // votingPowerBbnBlockHeightStore returns the KVStore of the finality providers' voting power
 // prefix: (VotingPowerKey || Babylon block height)
 // key: Bitcoin secp256k1 PK
@@ -390,3 +390,88 @@ func FuzzVotingPowerTable_ActiveFinalityProviderRotation(f *testing.F) {
 		}
 	})
 }
+
+func FuzzReconcileVotingPowerTable(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		ckptKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		h := NewHelper(t, btclcKeeper, btccKeeper, ckptKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		h.NoError(err)
+
+		// generate a random batch of finality providers, each with a BTC delegation
+		fps := []*types.FinalityProvider{}
+		numFps := datagen.RandomInt(r, 10) + 2
+		stakingValue := datagen.RandomInt(r, 100000) + 100000
+		for i := uint64(0); i < numFps; i++ {
+			_, _, fp := h.CreateFinalityProvider(r)
+			fps = append(fps, fp)
+			_, _, _, delMsg, del := h.CreateDelegation(
+				r,
+				fp.BtcPk.MustToBTCPK(),
+				changeAddress.EncodeAddress(),
+				int64(stakingValue),
+				1000,
+			)
+			h.CreateCovenantSigs(r, covenantSKs, delMsg, del)
+		}
+
+		// no voting power table yet
+		_, err = h.BTCStakingKeeper.VotingPowerTableDiscrepancies(h.Ctx, &types.QueryVotingPowerTableDiscrepanciesRequest{})
+		require.ErrorIs(t, err, types.ErrBTCStakingNotActivated)
+		require.Empty(t, h.BTCStakingKeeper.ReconcileVotingPowerTable(h.Ctx))
+
+		// record the voting power table
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: 30}).AnyTimes()
+		err = h.BTCStakingKeeper.BeginBlocker(h.Ctx)
+		require.NoError(t, err)
+		expectedTable := h.BTCStakingKeeper.GetVotingPowerTable(h.Ctx, babylonHeight)
+
+		// the voting power table updated per block is consistent with the
+		// BTC delegations
+		resp, err := h.BTCStakingKeeper.VotingPowerTableDiscrepancies(h.Ctx, &types.QueryVotingPowerTableDiscrepanciesRequest{})
+		require.NoError(t, err)
+		require.Equal(t, babylonHeight, resp.Height)
+		require.Empty(t, resp.Discrepancies)
+		require.Empty(t, h.BTCStakingKeeper.ReconcileVotingPowerTable(h.Ctx))
+
+		// corrupt the voting power of two random finality providers
+		perm := r.Perm(int(numFps))
+		inflatedFp, erasedFp := fps[perm[0]], fps[perm[1]]
+		h.BTCStakingKeeper.SetVotingPower(h.Ctx, *inflatedFp.BtcPk, babylonHeight, stakingValue+1)
+		h.BTCStakingKeeper.SetVotingPower(h.Ctx, *erasedFp.BtcPk, babylonHeight, 0)
+		expectedDiscrepancies := []*types.VotingPowerDiscrepancy{
+			{FpBtcPkHex: inflatedFp.BtcPk.MarshalHex(), StoredVotingPower: stakingValue + 1, ExpectedVotingPower: stakingValue},
+			{FpBtcPkHex: erasedFp.BtcPk.MarshalHex(), StoredVotingPower: 0, ExpectedVotingPower: stakingValue},
+		}
+		sort.Slice(expectedDiscrepancies, func(i, j int) bool {
+			return expectedDiscrepancies[i].FpBtcPkHex < expectedDiscrepancies[j].FpBtcPkHex
+		})
+
+		// the query reports the discrepancies without fixing them
+		resp, err = h.BTCStakingKeeper.VotingPowerTableDiscrepancies(h.Ctx, &types.QueryVotingPowerTableDiscrepanciesRequest{})
+		require.NoError(t, err)
+		require.Equal(t, expectedDiscrepancies, resp.Discrepancies)
+		require.Equal(t, stakingValue+1, h.BTCStakingKeeper.GetVotingPower(h.Ctx, *inflatedFp.BtcPk, babylonHeight))
+
+		// reconciliation reports the discrepancies and fixes them
+		require.Equal(t, expectedDiscrepancies, h.BTCStakingKeeper.ReconcileVotingPowerTable(h.Ctx))
+		require.Equal(t, expectedTable, h.BTCStakingKeeper.GetVotingPowerTable(h.Ctx, babylonHeight))
+		resp, err = h.BTCStakingKeeper.VotingPowerTableDiscrepancies(h.Ctx, &types.QueryVotingPowerTableDiscrepanciesRequest{})
+		require.NoError(t, err)
+		require.Empty(t, resp.Discrepancies)
+	})
+}
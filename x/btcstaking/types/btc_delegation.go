@@ -24,10 +24,16 @@ func NewBTCDelegationStatusFromString(statusStr string) (BTCDelegationStatus, er
 		return BTCDelegationStatus_ACTIVE, nil
 	case "unbonded":
 		return BTCDelegationStatus_UNBONDED, nil
+	case "invalidated":
+		return BTCDelegationStatus_INVALIDATED, nil
+	case "slashed":
+		return BTCDelegationStatus_SLASHED, nil
+	case "reserved":
+		return BTCDelegationStatus_RESERVED, nil
 	case "any":
 		return BTCDelegationStatus_ANY, nil
 	default:
-		return -1, fmt.Errorf("invalid status string; should be one of {pending, active, unbonding, unbonded, any}")
+		return -1, fmt.Errorf("invalid status string; should be one of {pending, active, unbonding, unbonded, invalidated, slashed, reserved, any}")
 	}
 }
 
@@ -74,6 +80,28 @@ func (d *BTCDelegation) GetCovSlashingAdaptorSig(
 	return nil, ErrInvalidCovenantPK.Wrap("covenant PK is not found")
 }
 
+// IsSlashed returns whether the BTC delegation is slashed at the given BTC
+// height, i.e., whether its slashing tx, or that of its unbonding tx, is
+// included in BTC at or below the given height
+func (d *BTCDelegation) IsSlashed(btcHeight uint64) bool {
+	return d.SlashedBtcHeight > 0 && btcHeight >= d.SlashedBtcHeight
+}
+
+// IsSlashable returns whether the slashing tx of the BTC delegation can be
+// broadcast to BTC at the given BTC height, i.e., whether a finality provider
+// it restakes to has been slashed. It does not tell whether the slashing tx
+// has been broadcast or included in BTC
+func (d *BTCDelegation) IsSlashable(btcHeight uint64) bool {
+	return d.FpSlashedBtcHeight > 0 && btcHeight >= d.FpSlashedBtcHeight
+}
+
+// IsReservationExpired returns whether the BTC delegation is reserved and its
+// reservation has expired at the given BTC height, such that it can no longer
+// be activated
+func (d *BTCDelegation) IsReservationExpired(btcHeight uint64) bool {
+	return d.Reserved && d.ReservationExpiryBtcHeight > 0 && btcHeight >= d.ReservationExpiryBtcHeight
+}
+
 // IsUnbondedEarly returns whether the delegator has signed unbonding signature.
 // Signing unbonding signature means the delegator wants to unbond early, and
 // Babylon will consider this BTC delegation unbonded directly
@@ -81,15 +109,58 @@ func (d *BTCDelegation) IsUnbondedEarly() bool {
 	return d.BtcUndelegation.DelegatorUnbondingSig != nil
 }
 
+// FinalizationTimeout returns the checkpoint finalization timeout (w) in
+// effect when the BTC delegation was created, or the given w value if the
+// BTC delegation was created before w was snapshotted
+func (d *BTCDelegation) FinalizationTimeout(w uint64) uint64 {
+	if d.CheckpointFinalizationTimeout > 0 {
+		return d.CheckpointFinalizationTimeout
+	}
+	return w
+}
+
 // GetStatus returns the status of the BTC Delegation based on BTC height, w value, and covenant quorum
+// The w value snapshotted in the BTC delegation takes precedence over the given one, if any
 // Pending: the BTC height is in the range of d's [startHeight, endHeight-w] and the delegation does not have covenant signatures
 // Active: the BTC height is in the range of d's [startHeight, endHeight-w] and the delegation has quorum number of signatures over slashing tx, unbonding tx, and slashing unbonding tx from covenant committee
-// Unbonded: the BTC height is larger than `endHeight-w` or the BTC delegation has received a signature on unbonding tx from the delegator
+// Unbonded: the BTC height is larger than `endHeight-w`, or the BTC delegation has received a signature on unbonding tx from the delegator, or a finality provider it restakes to was slashed before its activation,
+// or its reservation has expired
+// Invalidated: the BTC block that includes the staking tx has been orphaned by a BTC reorg
+// Slashed: the slashing tx of the delegation, or that of its unbonding tx, is included in BTC at or below the BTC height, as proven
+// by an inclusion proof submitted to Babylon
+// Reserved: the staking tx is not included in BTC yet, regardless of covenant signatures
 func (d *BTCDelegation) GetStatus(btcHeight uint64, w uint64, covenantQuorum uint32) BTCDelegationStatus {
+	if d.Invalidated {
+		return BTCDelegationStatus_INVALIDATED
+	}
+
+	if d.IsSlashed(btcHeight) {
+		return BTCDelegationStatus_SLASHED
+	}
+
 	if d.IsUnbondedEarly() {
 		return BTCDelegationStatus_UNBONDED
 	}
 
+	if d.FpSlashedBeforeActivation {
+		// the BTC delegation is never activated since one of its finality
+		// providers was slashed before, and the delegator can only unbond
+		return BTCDelegationStatus_UNBONDED
+	}
+
+	if d.IsReservationExpired(btcHeight) {
+		// the staking tx was not included in BTC in time, and the BTC
+		// delegation can no longer be activated
+		return BTCDelegationStatus_UNBONDED
+	}
+
+	if d.Reserved {
+		// the staking tx's timelock has not begun since the staking tx is
+		// not included in BTC yet
+		return BTCDelegationStatus_RESERVED
+	}
+
+	w = d.FinalizationTimeout(w)
 	if btcHeight < d.StartHeight || btcHeight+w > d.EndHeight {
 		// staking tx's timelock has not begun, or is less than w BTC
 		// blocks left, or is expired
@@ -118,6 +189,33 @@ func (d *BTCDelegation) VotingPower(btcHeight uint64, w uint64, covenantQuorum u
 	return d.GetTotalSat()
 }
 
+// IsSpendableVia returns whether the staking output of the BTC delegation can be
+// spent via the given path at the given BTC height, given the covenant
+// signatures collected so far. The staking output has to be on BTC and not yet
+// spent by the unbonding or slashing tx, i.e., the BTC delegation is neither
+// invalidated, reserved, unbonded early nor slashed.
+// Timelock: the staking timelock expires, i.e., a tx spending it can be
+// included in the next BTC block
+// Unbonding: the unbonding tx has a quorum number of covenant signatures
+// Slashing: the slashing tx has a quorum number of covenant signatures and a
+// finality provider the BTC delegation restakes to has been slashed
+func (d *BTCDelegation) IsSpendableVia(path StakingOutputSpendPath, btcHeight uint64, covenantQuorum uint32) bool {
+	if d.Invalidated || d.Reserved || d.IsUnbondedEarly() || d.IsSlashed(btcHeight) {
+		return false
+	}
+
+	switch path {
+	case StakingOutputSpendPath_TIMELOCK_PATH:
+		return btcHeight+1 >= d.EndHeight
+	case StakingOutputSpendPath_UNBONDING_PATH:
+		return d.BtcUndelegation.HasCovenantQuorumOnUnbonding(covenantQuorum)
+	case StakingOutputSpendPath_SLASHING_PATH:
+		return uint32(len(d.CovenantSigs)) >= covenantQuorum && d.IsSlashable(btcHeight)
+	default:
+		return false
+	}
+}
+
 func (d *BTCDelegation) GetStakingTxHash() (chainhash.Hash, error) {
 	parsed, err := bbn.NewBTCTxFromBytes(d.StakingTx)
 
@@ -163,6 +261,9 @@ func (d *BTCDelegation) ValidateBasic() error {
 	if d.DelegatorSig == nil {
 		return fmt.Errorf("empty delegator signature")
 	}
+	if err := validateSigHashType(d.DelegatorSig); err != nil {
+		return err
+	}
 
 	// ensure staking tx is correctly formatted
 	if _, err := bbn.NewBTCTxFromBytes(d.StakingTx); err != nil {
@@ -217,6 +318,14 @@ func (d *BTCDelegation) AddCovenantSigs(
 	d.BtcUndelegation.addCovenantSigs(covPk, unbondingSig, unbondingSlashingSigs)
 }
 
+// AddCovenantUnbondingSig adds the signature on the unbonding tx from the
+// given covenant, without any signature on the slashing txs
+// It is up to the caller to ensure that given signature is valid or that it
+// was not added before
+func (d *BTCDelegation) AddCovenantUnbondingSig(covPk *bbn.BIP340PubKey, unbondingSig *bbn.BIP340Signature) {
+	d.BtcUndelegation.addCovenantUnbondingSig(covPk, unbondingSig)
+}
+
 // GetStakingInfo returns the staking info of the BTC delegation
 // the staking info can be used for constructing witness of slashing tx
 // with access to a finality provider's SK
@@ -52,9 +52,10 @@ func FuzzBTCDelegation(f *testing.F) {
 		btcDel.StartHeight = datagen.RandomInt(r, 100)
 		btcDel.EndHeight = btcDel.StartHeight + datagen.RandomInt(r, 100)
 
-		// randomise BTC tip and w
+		// randomise BTC tip and w, where w is never zero as a snapshotted
+		// zero w means w is not snapshotted
 		btcHeight := btcDel.StartHeight + datagen.RandomInt(r, 50)
-		w := datagen.RandomInt(r, 50)
+		w := datagen.RandomInt(r, 50) + 1
 
 		// test expected voting power
 		hasVotingPower := hasCovenantSig && btcDel.StartHeight <= btcHeight && btcHeight+w <= btcDel.EndHeight
@@ -64,6 +65,40 @@ func FuzzBTCDelegation(f *testing.F) {
 		} else {
 			require.Equal(t, uint64(0), actualVotingPower)
 		}
+
+		// once w is snapshotted in the BTC delegation, the given w is ignored
+		btcDel.CheckpointFinalizationTimeout = w
+		require.Equal(t, actualVotingPower, btcDel.VotingPower(btcHeight, w+datagen.RandomInt(r, 50)+1, 1))
+	})
+}
+
+func FuzzBTCDelegationStatusSlashedAfterEarlyUnbonding(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		btcDel := &types.BTCDelegation{BtcUndelegation: &types.BTCUndelegation{}}
+		btcDel.StartHeight = datagen.RandomInt(r, 100) + 1
+		btcDel.EndHeight = btcDel.StartHeight + datagen.RandomInt(r, 100) + 1
+
+		// a finality provider of the BTC delegation is slashed, which only
+		// makes the BTC delegation slashable
+		btcDel.FpSlashedBtcHeight = btcDel.StartHeight + datagen.RandomInt(r, 10)
+		btcHeight := btcDel.FpSlashedBtcHeight + datagen.RandomInt(r, 10)
+		require.True(t, btcDel.IsSlashable(btcHeight))
+		require.NotEqual(t, types.BTCDelegationStatus_SLASHED, btcDel.GetStatus(btcHeight, 0, 1))
+
+		// the BTC delegation unbonded early is unbonded, as its staking output
+		// is spent by the unbonding tx
+		unbondingSig := bbn.BIP340Signature(datagen.GenRandomByteArray(r, bbn.BIP340SignatureLen))
+		btcDel.BtcUndelegation.DelegatorUnbondingSig = &unbondingSig
+		require.Equal(t, types.BTCDelegationStatus_UNBONDED, btcDel.GetStatus(btcHeight, 0, 1))
+
+		// once the slashing tx of the unbonding tx is included in BTC, the BTC
+		// delegation is slashed
+		btcDel.SlashedBtcHeight = btcHeight
+		require.Equal(t, types.BTCDelegationStatus_SLASHED, btcDel.GetStatus(btcHeight, 0, 1))
 	})
 }
 
@@ -88,6 +88,16 @@ func (tx *BTCSlashingTx) MustGetTxHash() *chainhash.Hash {
 	return &txHash
 }
 
+// MustGetSlashingAmount returns the amount in satoshi that the slashing tx sends
+// to the slashing address, i.e., the value of its first output
+func (tx *BTCSlashingTx) MustGetSlashingAmount() uint64 {
+	msgTx, err := tx.ToMsgTx()
+	if err != nil {
+		panic(err)
+	}
+	return uint64(msgTx.TxOut[0].Value)
+}
+
 // Sign generates a signature on the slashing tx
 func (tx *BTCSlashingTx) Sign(
 	fundingTx *wire.MsgTx,
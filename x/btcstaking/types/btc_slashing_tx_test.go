@@ -5,12 +5,14 @@ import (
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/babylonchain/babylon/btcstaking"
 	asig "github.com/babylonchain/babylon/crypto/schnorr-adaptor-signature"
 	btctest "github.com/babylonchain/babylon/testutil/bitcoin"
 	"github.com/babylonchain/babylon/testutil/datagen"
 	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/stretchr/testify/require"
 )
 
@@ -109,6 +111,21 @@ func FuzzSlashingTx_VerifySigAndASig(f *testing.F) {
 			bbn.NewBIP340SignatureFromBTCSig(covSig),
 		)
 		require.NoError(t, err)
+
+		// a signature with an explicit sighash type is rejected
+		sigWithSigHash := bbn.BIP340Signature(append(covSig.Serialize(), byte(txscript.SigHashAll)))
+		err = slashingTx.VerifySignature(
+			testStakingInfo.StakingInfo.StakingOutput,
+			slashingPkScriptPath,
+			covPK,
+			&sigWithSigHash,
+		)
+		require.ErrorIs(t, err, btcstaking.ErrUnexpectedSigHashType)
+		msg := &types.MsgBTCUndelegate{
+			StakingTxHash:  stakingTx.TxHash().String(),
+			UnbondingTxSig: &sigWithSigHash,
+		}
+		require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidSigHashType)
 	})
 }
 
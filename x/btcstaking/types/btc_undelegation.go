@@ -74,8 +74,7 @@ func (ud *BTCUndelegation) addCovenantSigs(
 	unbondingSig *bbn.BIP340Signature,
 	slashingSigs []asig.AdaptorSignature,
 ) {
-	covUnbondingSigInfo := &SignatureInfo{Pk: covPk, Sig: unbondingSig}
-	ud.CovenantUnbondingSigList = append(ud.CovenantUnbondingSigList, covUnbondingSigInfo)
+	ud.addCovenantUnbondingSig(covPk, unbondingSig)
 
 	adaptorSigs := make([][]byte, 0, len(slashingSigs))
 	for _, s := range slashingSigs {
@@ -84,3 +83,8 @@ func (ud *BTCUndelegation) addCovenantSigs(
 	slashingSigsInfo := &CovenantAdaptorSignatures{CovPk: covPk, AdaptorSigs: adaptorSigs}
 	ud.CovenantSlashingSigs = append(ud.CovenantSlashingSigs, slashingSigsInfo)
 }
+
+func (ud *BTCUndelegation) addCovenantUnbondingSig(covPk *bbn.BIP340PubKey, unbondingSig *bbn.BIP340Signature) {
+	covUnbondingSigInfo := &SignatureInfo{Pk: covPk, Sig: unbondingSig}
+	ud.CovenantUnbondingSigList = append(ud.CovenantUnbondingSigList, covUnbondingSigInfo)
+}
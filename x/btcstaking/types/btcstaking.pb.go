@@ -7,6 +7,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_babylonchain_babylon_types "github.com/babylonchain/babylon/types"
+	types1 "github.com/babylonchain/babylon/x/btccheckpoint/types"
 	_ "github.com/cosmos/cosmos-proto"
 	secp256k1 "github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	types "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -45,6 +46,18 @@ const (
 	BTCDelegationStatus_UNBONDED BTCDelegationStatus = 2
 	// ANY is any of the above status
 	BTCDelegationStatus_ANY BTCDelegationStatus = 3
+	// INVALIDATED defines a delegation whose staking tx is no longer included
+	// in the BTC main chain due to a BTC reorg. It has no voting power
+	BTCDelegationStatus_INVALIDATED BTCDelegationStatus = 4
+	// SLASHED defines a delegation whose slashing tx, or that of its
+	// unbonding tx, has been observed in BTC, as proven by an inclusion proof
+	// submitted to Babylon. It has no voting power
+	BTCDelegationStatus_SLASHED BTCDelegationStatus = 5
+	// RESERVED defines a delegation whose staking tx is not included in BTC
+	// yet. It can receive covenant signatures, and has no voting power until
+	// the inclusion proof of its staking tx is submitted. A delegation whose
+	// reservation has expired is UNBONDED instead
+	BTCDelegationStatus_RESERVED BTCDelegationStatus = 6
 )
 
 var BTCDelegationStatus_name = map[int32]string{
@@ -52,13 +65,19 @@ var BTCDelegationStatus_name = map[int32]string{
 	1: "ACTIVE",
 	2: "UNBONDED",
 	3: "ANY",
+	4: "INVALIDATED",
+	5: "SLASHED",
+	6: "RESERVED",
 }
 
 var BTCDelegationStatus_value = map[string]int32{
-	"PENDING":  0,
-	"ACTIVE":   1,
-	"UNBONDED": 2,
-	"ANY":      3,
+	"PENDING":     0,
+	"ACTIVE":      1,
+	"UNBONDED":    2,
+	"ANY":         3,
+	"INVALIDATED": 4,
+	"SLASHED":     5,
+	"RESERVED":    6,
 }
 
 func (x BTCDelegationStatus) String() string {
@@ -286,6 +305,64 @@ type BTCDelegation struct {
 	BtcUndelegation *BTCUndelegation `protobuf:"bytes,14,opt,name=btc_undelegation,json=btcUndelegation,proto3" json:"btc_undelegation,omitempty"`
 	// version of the params used to validate the delegation
 	ParamsVersion uint32 `protobuf:"varint,15,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// created_babylon_height is the Babylon height at which the BTC delegation
+	// was created
+	CreatedBabylonHeight uint64 `protobuf:"varint,16,opt,name=created_babylon_height,json=createdBabylonHeight,proto3" json:"created_babylon_height,omitempty"`
+	// btc_confirmation_depth is the BTC confirmation depth (k) in effect when
+	// the BTC delegation was created
+	BtcConfirmationDepth uint64 `protobuf:"varint,17,opt,name=btc_confirmation_depth,json=btcConfirmationDepth,proto3" json:"btc_confirmation_depth,omitempty"`
+	// checkpoint_finalization_timeout is the checkpoint finalization timeout (w)
+	// in effect when the BTC delegation was created. It is used for deciding the
+	// status of the BTC delegation and its undelegation, so that governance
+	// changes to w do not affect existing BTC delegations. Zero means the BTC
+	// delegation was created before w was snapshotted
+	CheckpointFinalizationTimeout uint64 `protobuf:"varint,18,opt,name=checkpoint_finalization_timeout,json=checkpointFinalizationTimeout,proto3" json:"checkpoint_finalization_timeout,omitempty"`
+	// invalidated is whether the BTC block that includes the staking tx has
+	// been orphaned by a BTC reorg. An invalidated BTC delegation has no
+	// voting power, and its staking tx cannot be used for a new BTC
+	// delegation even if the staking tx is included in BTC again
+	Invalidated bool `protobuf:"varint,19,opt,name=invalidated,proto3" json:"invalidated,omitempty"`
+	// slashed_btc_height is the BTC height of the block that includes the
+	// slashing tx of this BTC delegation, or that of its unbonding tx, as
+	// proven by an inclusion proof submitted to Babylon. A slashed BTC
+	// delegation has no voting power. Zero means the slashing tx is not
+	// observed in BTC
+	SlashedBtcHeight uint64 `protobuf:"varint,20,opt,name=slashed_btc_height,json=slashedBtcHeight,proto3" json:"slashed_btc_height,omitempty"`
+	// reward_opt_out is whether the delegator opts out of BTC staking rewards.
+	// An opted-out BTC delegation still has voting power, but does not accrue
+	// rewards
+	RewardOptOut bool `protobuf:"varint,21,opt,name=reward_opt_out,json=rewardOptOut,proto3" json:"reward_opt_out,omitempty"`
+	// staking_tx_key is the position (txIdx, blockHash) of the staking tx on
+	// BTC. It is empty for BTC delegations created before it was recorded
+	StakingTxKey *types1.TransactionKey `protobuf:"bytes,22,opt,name=staking_tx_key,json=stakingTxKey,proto3" json:"staking_tx_key,omitempty"`
+	// staking_tx_inclusion_proof is the Merkle proof that the staking tx is
+	// included in the position in staking_tx_key
+	StakingTxInclusionProof []byte `protobuf:"bytes,23,opt,name=staking_tx_inclusion_proof,json=stakingTxInclusionProof,proto3" json:"staking_tx_inclusion_proof,omitempty"`
+	// reserved is whether the staking tx of this BTC delegation is not
+	// included in BTC yet. A reserved BTC delegation collects covenant
+	// signatures but has no voting power until it is activated with the
+	// inclusion proof of its staking tx. While reserved, start_height is zero
+	// and end_height is the staking time
+	Reserved bool `protobuf:"varint,24,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	// fp_slashed_before_activation is whether a finality provider that this
+	// BTC delegation restakes to was slashed before the BTC delegation got
+	// activated. Such a BTC delegation is never activated and is considered
+	// unbonded. It only collects covenant signatures on its unbonding tx, such
+	// that the delegator can unbond without being slashed. It is set when the
+	// finality provider is slashed while the BTC delegation is pending or
+	// reserved
+	FpSlashedBeforeActivation bool `protobuf:"varint,25,opt,name=fp_slashed_before_activation,json=fpSlashedBeforeActivation,proto3" json:"fp_slashed_before_activation,omitempty"`
+	// reservation_expiry_btc_height is the BTC height from which on a reserved
+	// BTC delegation can no longer be activated, and is considered unbonded.
+	// Zero means the reservation never expires, as for BTC delegations
+	// reserved before the expiry was introduced
+	ReservationExpiryBtcHeight uint64 `protobuf:"varint,26,opt,name=reservation_expiry_btc_height,json=reservationExpiryBtcHeight,proto3" json:"reservation_expiry_btc_height,omitempty"`
+	// fp_slashed_btc_height is the BTC height at which a finality provider
+	// that this BTC delegation restakes to was slashed, from which on the
+	// slashing tx of this BTC delegation can be broadcast to BTC. Zero means
+	// no finality provider of this BTC delegation was slashed while it was
+	// active or unbonded early
+	FpSlashedBtcHeight uint64 `protobuf:"varint,27,opt,name=fp_slashed_btc_height,json=fpSlashedBtcHeight,proto3" json:"fp_slashed_btc_height,omitempty"`
 }
 
 func (m *BTCDelegation) Reset()         { *m = BTCDelegation{} }
@@ -398,6 +475,90 @@ func (m *BTCDelegation) GetParamsVersion() uint32 {
 	return 0
 }
 
+func (m *BTCDelegation) GetCreatedBabylonHeight() uint64 {
+	if m != nil {
+		return m.CreatedBabylonHeight
+	}
+	return 0
+}
+
+func (m *BTCDelegation) GetBtcConfirmationDepth() uint64 {
+	if m != nil {
+		return m.BtcConfirmationDepth
+	}
+	return 0
+}
+
+func (m *BTCDelegation) GetCheckpointFinalizationTimeout() uint64 {
+	if m != nil {
+		return m.CheckpointFinalizationTimeout
+	}
+	return 0
+}
+
+func (m *BTCDelegation) GetInvalidated() bool {
+	if m != nil {
+		return m.Invalidated
+	}
+	return false
+}
+
+func (m *BTCDelegation) GetSlashedBtcHeight() uint64 {
+	if m != nil {
+		return m.SlashedBtcHeight
+	}
+	return 0
+}
+
+func (m *BTCDelegation) GetRewardOptOut() bool {
+	if m != nil {
+		return m.RewardOptOut
+	}
+	return false
+}
+
+func (m *BTCDelegation) GetStakingTxKey() *types1.TransactionKey {
+	if m != nil {
+		return m.StakingTxKey
+	}
+	return nil
+}
+
+func (m *BTCDelegation) GetStakingTxInclusionProof() []byte {
+	if m != nil {
+		return m.StakingTxInclusionProof
+	}
+	return nil
+}
+
+func (m *BTCDelegation) GetReserved() bool {
+	if m != nil {
+		return m.Reserved
+	}
+	return false
+}
+
+func (m *BTCDelegation) GetFpSlashedBeforeActivation() bool {
+	if m != nil {
+		return m.FpSlashedBeforeActivation
+	}
+	return false
+}
+
+func (m *BTCDelegation) GetReservationExpiryBtcHeight() uint64 {
+	if m != nil {
+		return m.ReservationExpiryBtcHeight
+	}
+	return 0
+}
+
+func (m *BTCDelegation) GetFpSlashedBtcHeight() uint64 {
+	if m != nil {
+		return m.FpSlashedBtcHeight
+	}
+	return 0
+}
+
 // BTCUndelegation contains the information about the early unbonding path of the BTC delegation
 type BTCUndelegation struct {
 	// unbonding_tx is the transaction which will transfer the funds from staking
@@ -572,6 +733,63 @@ func (m *BTCDelegatorDelegationIndex) GetStakingTxHashList() [][]byte {
 	return nil
 }
 
+// BTCDelegationTombstone is the minimal record kept for a BTC delegation
+// that has been pruned from state, for audit purposes
+type BTCDelegationTombstone struct {
+	// staking_tx_hash_hex is the hex string of the staking tx hash of the
+	// pruned BTC delegation
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+	// final_status is the status of the BTC delegation when it was pruned
+	FinalStatus BTCDelegationStatus `protobuf:"varint,2,opt,name=final_status,json=finalStatus,proto3,enum=babylon.btcstaking.v1.BTCDelegationStatus" json:"final_status,omitempty"`
+}
+
+func (m *BTCDelegationTombstone) Reset()         { *m = BTCDelegationTombstone{} }
+func (m *BTCDelegationTombstone) String() string { return proto.CompactTextString(m) }
+func (*BTCDelegationTombstone) ProtoMessage()    {}
+func (*BTCDelegationTombstone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3851ae95ccfaf7db, []int{6}
+}
+func (m *BTCDelegationTombstone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCDelegationTombstone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCDelegationTombstone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BTCDelegationTombstone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCDelegationTombstone.Merge(m, src)
+}
+func (m *BTCDelegationTombstone) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCDelegationTombstone) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCDelegationTombstone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCDelegationTombstone proto.InternalMessageInfo
+
+func (m *BTCDelegationTombstone) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
+	}
+	return ""
+}
+
+func (m *BTCDelegationTombstone) GetFinalStatus() BTCDelegationStatus {
+	if m != nil {
+		return m.FinalStatus
+	}
+	return BTCDelegationStatus_PENDING
+}
+
 // SignatureInfo is a BIP-340 signature together with its signer's BIP-340 PK
 type SignatureInfo struct {
 	Pk  *github_com_babylonchain_babylon_types.BIP340PubKey    `protobuf:"bytes,1,opt,name=pk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"pk,omitempty"`
@@ -582,7 +800,7 @@ func (m *SignatureInfo) Reset()         { *m = SignatureInfo{} }
 func (m *SignatureInfo) String() string { return proto.CompactTextString(m) }
 func (*SignatureInfo) ProtoMessage()    {}
 func (*SignatureInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{6}
+	return fileDescriptor_3851ae95ccfaf7db, []int{7}
 }
 func (m *SignatureInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -624,7 +842,7 @@ func (m *CovenantAdaptorSignatures) Reset()         { *m = CovenantAdaptorSignat
 func (m *CovenantAdaptorSignatures) String() string { return proto.CompactTextString(m) }
 func (*CovenantAdaptorSignatures) ProtoMessage()    {}
 func (*CovenantAdaptorSignatures) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{7}
+	return fileDescriptor_3851ae95ccfaf7db, []int{8}
 }
 func (m *CovenantAdaptorSignatures) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,7 +901,7 @@ func (m *SelectiveSlashingEvidence) Reset()         { *m = SelectiveSlashingEvid
 func (m *SelectiveSlashingEvidence) String() string { return proto.CompactTextString(m) }
 func (*SelectiveSlashingEvidence) ProtoMessage()    {}
 func (*SelectiveSlashingEvidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{8}
+	return fileDescriptor_3851ae95ccfaf7db, []int{9}
 }
 func (m *SelectiveSlashingEvidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -734,6 +952,7 @@ func init() {
 	proto.RegisterType((*BTCUndelegation)(nil), "babylon.btcstaking.v1.BTCUndelegation")
 	proto.RegisterType((*BTCDelegatorDelegations)(nil), "babylon.btcstaking.v1.BTCDelegatorDelegations")
 	proto.RegisterType((*BTCDelegatorDelegationIndex)(nil), "babylon.btcstaking.v1.BTCDelegatorDelegationIndex")
+	proto.RegisterType((*BTCDelegationTombstone)(nil), "babylon.btcstaking.v1.BTCDelegationTombstone")
 	proto.RegisterType((*SignatureInfo)(nil), "babylon.btcstaking.v1.SignatureInfo")
 	proto.RegisterType((*CovenantAdaptorSignatures)(nil), "babylon.btcstaking.v1.CovenantAdaptorSignatures")
 	proto.RegisterType((*SelectiveSlashingEvidence)(nil), "babylon.btcstaking.v1.SelectiveSlashingEvidence")
@@ -744,82 +963,105 @@ func init() {
 }
 
 var fileDescriptor_3851ae95ccfaf7db = []byte{
-	// 1197 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0x1b, 0xc5,
-	0x17, 0xcf, 0xda, 0x8e, 0x53, 0x1f, 0xdb, 0x8d, 0x3b, 0x4d, 0xd3, 0x6d, 0xa3, 0x7f, 0x92, 0xbf,
-	0x29, 0x95, 0x85, 0xa8, 0xdd, 0xa4, 0x1f, 0x02, 0x2e, 0x90, 0xea, 0x38, 0xa5, 0x51, 0xdb, 0xd4,
-	0xac, 0x93, 0x22, 0x40, 0x62, 0x35, 0xde, 0x9d, 0xac, 0x57, 0xb6, 0x77, 0x96, 0x9d, 0xb1, 0xb1,
-	0xdf, 0x80, 0x1b, 0x24, 0x6e, 0xb9, 0xe7, 0x11, 0x78, 0x06, 0xc4, 0x65, 0xc5, 0x0d, 0x28, 0x17,
-	0x11, 0x6a, 0x1f, 0x80, 0x57, 0x40, 0x33, 0x3b, 0xfb, 0xe1, 0xb6, 0x29, 0xb4, 0xce, 0x9d, 0x67,
-	0xce, 0x39, 0xbf, 0xf3, 0xf1, 0xfb, 0xf9, 0xcc, 0xc2, 0xf5, 0x2e, 0xee, 0x4e, 0x07, 0xd4, 0x6b,
-	0x74, 0xb9, 0xc5, 0x38, 0xee, 0xbb, 0x9e, 0xd3, 0x18, 0x6f, 0xa5, 0x4e, 0x75, 0x3f, 0xa0, 0x9c,
-	0xa2, 0x4b, 0xca, 0xaf, 0x9e, 0xb2, 0x8c, 0xb7, 0xae, 0xae, 0x38, 0xd4, 0xa1, 0xd2, 0xa3, 0x21,
-	0x7e, 0x85, 0xce, 0x57, 0xaf, 0x58, 0x94, 0x0d, 0x29, 0x33, 0x43, 0x43, 0x78, 0x50, 0xa6, 0x6a,
-	0x78, 0x6a, 0x58, 0xc1, 0xd4, 0xe7, 0xb4, 0xc1, 0x88, 0xe5, 0x6f, 0xdf, 0xb9, 0xdb, 0xdf, 0x6a,
-	0xf4, 0xc9, 0x34, 0xf2, 0xb9, 0xa6, 0x7c, 0x92, 0x7a, 0xba, 0x84, 0xe3, 0xad, 0xc6, 0x4c, 0x45,
-	0x57, 0x37, 0x5e, 0x5f, 0xb9, 0x4f, 0xfd, 0xd0, 0xa1, 0xfa, 0x47, 0x16, 0x2a, 0xf7, 0x5d, 0x0f,
-	0x0f, 0x5c, 0x3e, 0x6d, 0x07, 0x74, 0xec, 0xda, 0x24, 0x40, 0xbb, 0x50, 0xb4, 0x09, 0xb3, 0x02,
-	0xd7, 0xe7, 0x2e, 0xf5, 0x74, 0x6d, 0x53, 0xab, 0x15, 0xb7, 0xdf, 0xab, 0xab, 0x1a, 0x93, 0xce,
-	0x64, 0xc6, 0x7a, 0x2b, 0x71, 0x35, 0xd2, 0x71, 0xe8, 0x31, 0x80, 0x45, 0x87, 0x43, 0x97, 0x31,
-	0x81, 0x92, 0xd9, 0xd4, 0x6a, 0x85, 0xe6, 0x8d, 0xe3, 0x93, 0x8d, 0xb5, 0x10, 0x88, 0xd9, 0xfd,
-	0xba, 0x4b, 0x1b, 0x43, 0xcc, 0x7b, 0xf5, 0x47, 0xc4, 0xc1, 0xd6, 0xb4, 0x45, 0xac, 0xdf, 0x7f,
-	0xb9, 0x01, 0x2a, 0x4f, 0x8b, 0x58, 0x46, 0x0a, 0x00, 0x7d, 0x0a, 0xa0, 0xba, 0x31, 0xfd, 0xbe,
-	0x9e, 0x95, 0x45, 0x6d, 0x44, 0x45, 0x85, 0xa3, 0xaa, 0xc7, 0xa3, 0xaa, 0xb7, 0x47, 0xdd, 0x87,
-	0x64, 0x6a, 0x14, 0x54, 0x48, 0xbb, 0x8f, 0x1e, 0x43, 0xbe, 0xcb, 0x2d, 0x11, 0x9b, 0xdb, 0xd4,
-	0x6a, 0xa5, 0xe6, 0xdd, 0xe3, 0x93, 0x8d, 0x6d, 0xc7, 0xe5, 0xbd, 0x51, 0xb7, 0x6e, 0xd1, 0x61,
-	0x43, 0x79, 0x5a, 0x3d, 0xec, 0x7a, 0xd1, 0xa1, 0xc1, 0xa7, 0x3e, 0x61, 0xf5, 0xe6, 0x5e, 0xfb,
-	0xd6, 0xed, 0x9b, 0x0a, 0x72, 0xb1, 0xcb, 0xad, 0x76, 0x1f, 0x7d, 0x02, 0x59, 0x9f, 0xfa, 0xfa,
-	0xa2, 0xac, 0xa3, 0x56, 0x7f, 0x2d, 0xf5, 0xf5, 0x76, 0x40, 0xe9, 0xd1, 0x93, 0xa3, 0x36, 0x65,
-	0x8c, 0xc8, 0x2e, 0x0c, 0x11, 0x84, 0x6e, 0xc3, 0x2a, 0x1b, 0x60, 0xd6, 0x23, 0xb6, 0x19, 0xb5,
-	0xd4, 0x23, 0xae, 0xd3, 0xe3, 0x7a, 0x7e, 0x53, 0xab, 0xe5, 0x8c, 0x15, 0x65, 0x6d, 0x86, 0xc6,
-	0x07, 0xd2, 0x86, 0x3e, 0x04, 0x14, 0x47, 0x71, 0x2b, 0x8a, 0x58, 0x92, 0x11, 0x95, 0x28, 0x82,
-	0x5b, 0xa1, 0x77, 0xf5, 0xfb, 0x0c, 0xe8, 0x2f, 0x33, 0xfb, 0x85, 0xcb, 0x7b, 0x8f, 0x09, 0xc7,
-	0xa9, 0x59, 0x68, 0x67, 0x31, 0x8b, 0x55, 0xc8, 0xab, 0x6a, 0x32, 0xb2, 0x1a, 0x75, 0x42, 0xff,
-	0x87, 0xd2, 0x98, 0x72, 0xd7, 0x73, 0x4c, 0x9f, 0x7e, 0x47, 0x02, 0x49, 0x5a, 0xce, 0x28, 0x86,
-	0x77, 0x6d, 0x71, 0xf5, 0x86, 0x51, 0xe4, 0xde, 0x7a, 0x14, 0x8b, 0xa7, 0x8c, 0xe2, 0xef, 0x3c,
-	0x94, 0x9b, 0x07, 0x3b, 0x2d, 0x32, 0x20, 0x0e, 0xe6, 0xaf, 0x6a, 0x49, 0x9b, 0x43, 0x4b, 0x99,
-	0x33, 0xd4, 0x52, 0xf6, 0x5d, 0xb4, 0xf4, 0x35, 0x9c, 0x3f, 0xf2, 0xcd, 0xb0, 0x1a, 0x73, 0xe0,
-	0x32, 0x31, 0xb8, 0xec, 0x1c, 0x25, 0x15, 0x8f, 0xfc, 0xa6, 0x28, 0xea, 0x91, 0xcb, 0x24, 0x81,
-	0x8c, 0xe3, 0x80, 0xcf, 0x4e, 0xb8, 0x28, 0xef, 0x14, 0x15, 0xff, 0x03, 0x20, 0x9e, 0x3d, 0xab,
-	0xdf, 0x02, 0xf1, 0x6c, 0x65, 0x5e, 0x83, 0x02, 0xa7, 0x1c, 0x0f, 0x4c, 0x86, 0x23, 0xad, 0x9e,
-	0x93, 0x17, 0x1d, 0x2c, 0x63, 0x55, 0x83, 0x26, 0x9f, 0xe8, 0xe7, 0xc4, 0x28, 0x8d, 0x82, 0xba,
-	0x39, 0x98, 0x48, 0x96, 0x95, 0x99, 0x8e, 0xb8, 0x3f, 0xe2, 0xa6, 0x6b, 0x4f, 0xf4, 0xc2, 0xa6,
-	0x56, 0x2b, 0x1b, 0x15, 0x65, 0x79, 0x22, 0x0d, 0x7b, 0xf6, 0x04, 0x6d, 0x43, 0x51, 0x32, 0xaf,
-	0xd0, 0x40, 0x12, 0x73, 0xe1, 0xf8, 0x64, 0x43, 0x70, 0xdf, 0x51, 0x96, 0x83, 0x89, 0x01, 0x2c,
-	0xfe, 0x8d, 0xbe, 0x81, 0xb2, 0x1d, 0xaa, 0x82, 0x06, 0x26, 0x73, 0x1d, 0xbd, 0x28, 0xa3, 0x3e,
-	0x3e, 0x3e, 0xd9, 0xb8, 0xf3, 0x36, 0xb3, 0xeb, 0xb8, 0x8e, 0x87, 0xf9, 0x28, 0x20, 0x46, 0x29,
-	0xc6, 0xeb, 0xb8, 0x0e, 0x3a, 0x84, 0xb2, 0x45, 0xc7, 0xc4, 0xc3, 0x1e, 0x17, 0xf0, 0x4c, 0x2f,
-	0x6d, 0x66, 0x6b, 0xc5, 0xed, 0x9b, 0xa7, 0x50, 0xbc, 0xa3, 0x7c, 0xef, 0xd9, 0xd8, 0x0f, 0x11,
-	0x42, 0x54, 0x66, 0x94, 0x22, 0x98, 0x8e, 0xeb, 0x30, 0xf4, 0x3e, 0x9c, 0x1f, 0x79, 0x5d, 0xea,
-	0xd9, 0xb2, 0x57, 0x77, 0x48, 0xf4, 0xb2, 0x1c, 0x4a, 0x39, 0xbe, 0x3d, 0x70, 0x87, 0x04, 0x7d,
-	0x0e, 0x15, 0xa1, 0x8b, 0x91, 0x67, 0xc7, 0xca, 0xd7, 0xcf, 0x4b, 0x8d, 0x5d, 0x3f, 0xa5, 0x80,
-	0xe6, 0xc1, 0xce, 0x61, 0xca, 0xdb, 0x58, 0xee, 0x72, 0x2b, 0x7d, 0x21, 0x32, 0xfb, 0x38, 0xc0,
-	0x43, 0x66, 0x8e, 0x49, 0x20, 0xf7, 0xfa, 0x72, 0x98, 0x39, 0xbc, 0x7d, 0x1a, 0x5e, 0x56, 0x7f,
-	0xca, 0xc1, 0xf2, 0x4b, 0x58, 0x42, 0x4b, 0xa9, 0xa2, 0x27, 0xe1, 0xe6, 0x31, 0x8a, 0x49, 0xc9,
-	0xaf, 0x50, 0x98, 0xf9, 0x2f, 0x14, 0x7e, 0x0b, 0x97, 0x13, 0x0a, 0x93, 0x04, 0x82, 0xcc, 0xec,
-	0xbc, 0x64, 0x5e, 0x8a, 0x91, 0x0f, 0x23, 0x60, 0xc1, 0x2a, 0x85, 0xd5, 0x94, 0x6a, 0xa2, 0x82,
-	0x45, 0xc6, 0xdc, 0xbc, 0x19, 0x57, 0x12, 0xf9, 0x28, 0x5c, 0x91, 0xf0, 0x08, 0x56, 0x13, 0x19,
-	0xa5, 0xf2, 0x31, 0x7d, 0xf1, 0x1d, 0xf5, 0xb4, 0x12, 0xeb, 0x29, 0x49, 0xc3, 0x90, 0x05, 0x6b,
-	0x71, 0x9e, 0x99, 0x51, 0x86, 0x8b, 0x25, 0x2f, 0x93, 0x5d, 0x3b, 0x25, 0x59, 0x8c, 0xbe, 0xe7,
-	0x1d, 0x51, 0x43, 0x8f, 0x80, 0xd2, 0x93, 0x13, 0x3b, 0xa5, 0xda, 0x81, 0xcb, 0xc9, 0x32, 0xa6,
-	0x41, 0xb2, 0x95, 0x19, 0xfa, 0x08, 0x72, 0x36, 0x19, 0x30, 0x5d, 0x7b, 0x63, 0xa2, 0x99, 0x55,
-	0x6e, 0xc8, 0x88, 0xea, 0x3e, 0xac, 0xbd, 0x1e, 0x74, 0xcf, 0xb3, 0xc9, 0x04, 0x35, 0x60, 0x25,
-	0x59, 0x34, 0x66, 0x0f, 0xb3, 0x5e, 0xd8, 0x91, 0x48, 0x54, 0x32, 0x2e, 0xc4, 0x2b, 0xe7, 0x01,
-	0x66, 0x3d, 0x59, 0xe4, 0xcf, 0x1a, 0x94, 0x67, 0x1a, 0x42, 0xf7, 0x21, 0x33, 0xf7, 0x73, 0x99,
-	0xf1, 0xfb, 0xe8, 0x21, 0x64, 0x85, 0x52, 0x32, 0xf3, 0x2a, 0x45, 0xa0, 0x54, 0x7f, 0xd0, 0xe0,
-	0xca, 0xa9, 0x24, 0x8b, 0x57, 0xca, 0xa2, 0xe3, 0x33, 0x78, 0xe5, 0x2d, 0x3a, 0x6e, 0xf7, 0xc5,
-	0x1f, 0x18, 0x87, 0x39, 0x42, 0xed, 0x65, 0xe4, 0xf0, 0x8a, 0x38, 0xce, 0xcb, 0xaa, 0xbf, 0x6a,
-	0x70, 0xa5, 0x43, 0x06, 0xc4, 0xe2, 0xee, 0x98, 0x44, 0xd2, 0xda, 0x15, 0xdf, 0x1e, 0x9e, 0x45,
-	0xd0, 0x75, 0x58, 0x7e, 0x89, 0x05, 0x59, 0x58, 0xc1, 0x28, 0xcf, 0x10, 0x80, 0x0c, 0x28, 0xc4,
-	0x4f, 0xda, 0x9c, 0x0f, 0xec, 0x92, 0x7a, 0xcd, 0xd0, 0x0d, 0xb8, 0x18, 0x10, 0xa1, 0xc9, 0x80,
-	0xd8, 0xa6, 0x42, 0x67, 0xe1, 0x67, 0x64, 0xc9, 0xa8, 0xc4, 0xa6, 0xfb, 0xc2, 0xbd, 0xd3, 0xff,
-	0x60, 0x17, 0x2e, 0xce, 0xc8, 0xac, 0xc3, 0x31, 0x1f, 0x31, 0x54, 0x84, 0xa5, 0xf6, 0xee, 0x7e,
-	0x6b, 0x6f, 0xff, 0xb3, 0xca, 0x02, 0x02, 0xc8, 0xdf, 0xdb, 0x39, 0xd8, 0x7b, 0xba, 0x5b, 0xd1,
-	0x50, 0x09, 0xce, 0x1d, 0xee, 0x37, 0x9f, 0xec, 0xb7, 0x76, 0x5b, 0x95, 0x0c, 0x5a, 0x82, 0xec,
-	0xbd, 0xfd, 0x2f, 0x2b, 0xd9, 0xe6, 0xa3, 0xdf, 0x9e, 0xaf, 0x6b, 0xcf, 0x9e, 0xaf, 0x6b, 0x7f,
-	0x3d, 0x5f, 0xd7, 0x7e, 0x7c, 0xb1, 0xbe, 0xf0, 0xec, 0xc5, 0xfa, 0xc2, 0x9f, 0x2f, 0xd6, 0x17,
-	0xbe, 0xfa, 0xd7, 0x66, 0x26, 0xe9, 0x6f, 0x76, 0xd9, 0x59, 0x37, 0x2f, 0xbf, 0xd9, 0x6f, 0xfd,
-	0x13, 0x00, 0x00, 0xff, 0xff, 0xcf, 0xb8, 0x1f, 0xd2, 0x90, 0x0c, 0x00, 0x00,
+	// 1565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x5b, 0x6f, 0x22, 0xc9,
+	0x15, 0x76, 0x03, 0xbe, 0x70, 0x00, 0x9b, 0xa9, 0xf1, 0xa5, 0x6d, 0x67, 0x6c, 0x42, 0x26, 0x23,
+	0xb4, 0xda, 0x81, 0xb5, 0x77, 0x76, 0x95, 0x8b, 0x94, 0x08, 0x0c, 0x8e, 0xd1, 0xd8, 0x98, 0x34,
+	0xd8, 0x51, 0x12, 0x29, 0xad, 0xa6, 0xbb, 0x80, 0x16, 0xd0, 0xd5, 0xdb, 0x55, 0xb0, 0x90, 0x5f,
+	0x90, 0x97, 0x48, 0x79, 0x8a, 0x94, 0xf7, 0xfc, 0x84, 0xfc, 0x86, 0x28, 0x8f, 0xab, 0xbc, 0x24,
+	0xf2, 0x83, 0x15, 0xcd, 0xfc, 0x8a, 0xbc, 0x45, 0x55, 0xd5, 0x74, 0x37, 0xbe, 0x6c, 0x76, 0xd7,
+	0xf3, 0x46, 0x9d, 0xcb, 0x77, 0x4e, 0x9d, 0xf3, 0x9d, 0xd3, 0x05, 0xbc, 0xea, 0x18, 0x9d, 0xd9,
+	0x90, 0x38, 0xa5, 0x0e, 0x33, 0x29, 0x33, 0x06, 0xb6, 0xd3, 0x2b, 0x4d, 0x8e, 0x22, 0xa7, 0xa2,
+	0xeb, 0x11, 0x46, 0xd0, 0x96, 0x6f, 0x57, 0x8c, 0x68, 0x26, 0x47, 0x7b, 0x9b, 0x3d, 0xd2, 0x23,
+	0xc2, 0xa2, 0xc4, 0x7f, 0x49, 0xe3, 0xbd, 0x5d, 0x93, 0xd0, 0x11, 0xa1, 0xba, 0x54, 0xc8, 0x83,
+	0xaf, 0xca, 0xcb, 0x53, 0xc9, 0xf4, 0x66, 0x2e, 0x23, 0x25, 0x8a, 0x4d, 0xf7, 0xf8, 0xb3, 0xcf,
+	0x07, 0x47, 0xa5, 0x01, 0x9e, 0xcd, 0x6d, 0x5e, 0xfa, 0x36, 0x61, 0x3e, 0x1d, 0xcc, 0x8c, 0xa3,
+	0xd2, 0x42, 0x46, 0x7b, 0x87, 0x0f, 0x67, 0xee, 0x12, 0xd7, 0x37, 0xf8, 0x38, 0x62, 0x60, 0xf6,
+	0xb1, 0x39, 0x70, 0x89, 0xed, 0x30, 0xff, 0x76, 0xa1, 0x40, 0x5a, 0xe7, 0xff, 0x15, 0x87, 0xec,
+	0xa9, 0xed, 0x18, 0x43, 0x9b, 0xcd, 0x9a, 0x1e, 0x99, 0xd8, 0x16, 0xf6, 0x50, 0x0d, 0x52, 0x16,
+	0xa6, 0xa6, 0x67, 0xbb, 0xcc, 0x26, 0x8e, 0xaa, 0xe4, 0x94, 0x42, 0xea, 0xf8, 0x07, 0x45, 0xff,
+	0x46, 0x61, 0x1d, 0x44, 0x7e, 0xc5, 0x6a, 0x68, 0xaa, 0x45, 0xfd, 0xd0, 0x05, 0x80, 0x49, 0x46,
+	0x23, 0x9b, 0x52, 0x8e, 0x12, 0xcb, 0x29, 0x85, 0x64, 0xe5, 0xf5, 0xcd, 0xed, 0xe1, 0xbe, 0x04,
+	0xa2, 0xd6, 0xa0, 0x68, 0x93, 0xd2, 0xc8, 0x60, 0xfd, 0xe2, 0x39, 0xee, 0x19, 0xe6, 0xac, 0x8a,
+	0xcd, 0x7f, 0xfe, 0xed, 0x35, 0xf8, 0x71, 0xaa, 0xd8, 0xd4, 0x22, 0x00, 0xe8, 0x67, 0x00, 0xfe,
+	0xd5, 0x74, 0x77, 0xa0, 0xc6, 0x45, 0x52, 0x87, 0xf3, 0xa4, 0x64, 0x61, 0x8b, 0x41, 0x61, 0x8b,
+	0xcd, 0x71, 0xe7, 0x2d, 0x9e, 0x69, 0x49, 0xdf, 0xa5, 0x39, 0x40, 0x17, 0xb0, 0xd2, 0x61, 0x26,
+	0xf7, 0x4d, 0xe4, 0x94, 0x42, 0xba, 0xf2, 0xf9, 0xcd, 0xed, 0xe1, 0x71, 0xcf, 0x66, 0xfd, 0x71,
+	0xa7, 0x68, 0x92, 0x51, 0xc9, 0xb7, 0x34, 0xfb, 0x86, 0xed, 0xcc, 0x0f, 0x25, 0x36, 0x73, 0x31,
+	0x2d, 0x56, 0xea, 0xcd, 0x4f, 0xdf, 0x7c, 0xe2, 0x43, 0x2e, 0x77, 0x98, 0xd9, 0x1c, 0xa0, 0x9f,
+	0x40, 0xdc, 0x25, 0xae, 0xba, 0x2c, 0xf2, 0x28, 0x14, 0x1f, 0x24, 0x4a, 0xb1, 0xe9, 0x11, 0xd2,
+	0xbd, 0xec, 0x36, 0x09, 0xa5, 0x58, 0xdc, 0x42, 0xe3, 0x4e, 0xe8, 0x0d, 0x6c, 0xd3, 0xa1, 0x41,
+	0xfb, 0xd8, 0xd2, 0xe7, 0x57, 0xea, 0x63, 0xbb, 0xd7, 0x67, 0xea, 0x4a, 0x4e, 0x29, 0x24, 0xb4,
+	0x4d, 0x5f, 0x5b, 0x91, 0xca, 0x33, 0xa1, 0x43, 0x1f, 0x03, 0x0a, 0xbc, 0x98, 0x39, 0xf7, 0x58,
+	0x15, 0x1e, 0xd9, 0xb9, 0x07, 0x33, 0xa5, 0x75, 0xfe, 0x0f, 0x31, 0x50, 0xef, 0x76, 0xf6, 0x57,
+	0x36, 0xeb, 0x5f, 0x60, 0x66, 0x44, 0x6a, 0xa1, 0x7c, 0x88, 0x5a, 0x6c, 0xc3, 0x8a, 0x9f, 0x4d,
+	0x4c, 0x64, 0xe3, 0x9f, 0xd0, 0xf7, 0x21, 0x3d, 0x21, 0xcc, 0x76, 0x7a, 0xba, 0x4b, 0xbe, 0xc4,
+	0x9e, 0x68, 0x5a, 0x42, 0x4b, 0x49, 0x59, 0x93, 0x8b, 0xbe, 0xa6, 0x14, 0x89, 0x6f, 0x5d, 0x8a,
+	0xe5, 0x47, 0x4a, 0xf1, 0xdf, 0x14, 0x64, 0x2a, 0xed, 0x93, 0x2a, 0x1e, 0xe2, 0x9e, 0xc1, 0xee,
+	0x73, 0x49, 0x79, 0x02, 0x97, 0x62, 0x1f, 0x90, 0x4b, 0xf1, 0xef, 0xc2, 0xa5, 0xdf, 0xc2, 0x7a,
+	0xd7, 0xd5, 0x65, 0x36, 0xfa, 0xd0, 0xa6, 0xbc, 0x70, 0xf1, 0x27, 0xa4, 0x94, 0xea, 0xba, 0x15,
+	0x9e, 0xd4, 0xb9, 0x4d, 0x45, 0x03, 0x29, 0x33, 0x3c, 0xb6, 0x58, 0xe1, 0x94, 0x90, 0xf9, 0xad,
+	0x78, 0x01, 0x80, 0x1d, 0x6b, 0x91, 0xbf, 0x49, 0xec, 0x58, 0xbe, 0x7a, 0x1f, 0x92, 0x8c, 0x30,
+	0x63, 0xa8, 0x53, 0x63, 0xce, 0xd5, 0x35, 0x21, 0x68, 0x19, 0xc2, 0xd7, 0xbf, 0xa0, 0xce, 0xa6,
+	0xea, 0x1a, 0x2f, 0xa5, 0x96, 0xf4, 0x25, 0xed, 0xa9, 0xe8, 0xb2, 0xaf, 0x26, 0x63, 0xe6, 0x8e,
+	0x99, 0x6e, 0x5b, 0x53, 0x35, 0x99, 0x53, 0x0a, 0x19, 0x2d, 0xeb, 0x6b, 0x2e, 0x85, 0xa2, 0x6e,
+	0x4d, 0xd1, 0x31, 0xa4, 0x44, 0xe7, 0x7d, 0x34, 0x10, 0x8d, 0x79, 0x76, 0x73, 0x7b, 0xc8, 0x7b,
+	0xdf, 0xf2, 0x35, 0xed, 0xa9, 0x06, 0x34, 0xf8, 0x8d, 0x7e, 0x07, 0x19, 0x4b, 0xb2, 0x82, 0x78,
+	0x3a, 0xb5, 0x7b, 0x6a, 0x4a, 0x78, 0xfd, 0xf8, 0xe6, 0xf6, 0xf0, 0xb3, 0x6f, 0x53, 0xbb, 0x96,
+	0xdd, 0x73, 0x0c, 0x36, 0xf6, 0xb0, 0x96, 0x0e, 0xf0, 0x5a, 0x76, 0x0f, 0x5d, 0x41, 0xc6, 0x24,
+	0x13, 0xec, 0x18, 0x0e, 0xe3, 0xf0, 0x54, 0x4d, 0xe7, 0xe2, 0x85, 0xd4, 0xf1, 0x27, 0x8f, 0xb4,
+	0xf8, 0xc4, 0xb7, 0x2d, 0x5b, 0x86, 0x2b, 0x11, 0x24, 0x2a, 0xd5, 0xd2, 0x73, 0x98, 0x96, 0xdd,
+	0xa3, 0xe8, 0x87, 0xb0, 0x3e, 0x76, 0x3a, 0xc4, 0xb1, 0xc4, 0x5d, 0xed, 0x11, 0x56, 0x33, 0xa2,
+	0x28, 0x99, 0x40, 0xda, 0xb6, 0x47, 0x18, 0xfd, 0x12, 0xb2, 0x9c, 0x17, 0x63, 0xc7, 0x0a, 0x98,
+	0xaf, 0xae, 0x0b, 0x8e, 0xbd, 0x7a, 0x24, 0x81, 0x4a, 0xfb, 0xe4, 0x2a, 0x62, 0xad, 0x6d, 0x74,
+	0x98, 0x19, 0x15, 0xf0, 0xc8, 0xae, 0xe1, 0x19, 0x23, 0xaa, 0x4f, 0xb0, 0x27, 0xf6, 0xfa, 0x86,
+	0x8c, 0x2c, 0xa5, 0xd7, 0x52, 0xc8, 0xa7, 0xda, 0xf4, 0xb0, 0xc1, 0xee, 0x4f, 0x75, 0x56, 0x4e,
+	0xb5, 0xaf, 0x5d, 0x9c, 0xea, 0x37, 0xb0, 0xcd, 0xf3, 0x35, 0x89, 0xd3, 0xb5, 0xbd, 0x91, 0x08,
+	0xa8, 0x5b, 0xd8, 0x65, 0x7d, 0xf5, 0x99, 0xf4, 0xea, 0x30, 0xf3, 0x24, 0xa2, 0xac, 0x72, 0x1d,
+	0x3a, 0x85, 0xc3, 0xf0, 0xb3, 0xa6, 0x77, 0xc5, 0xca, 0xfb, 0xbd, 0x74, 0xe6, 0xa5, 0x21, 0x63,
+	0xa6, 0x22, 0xe1, 0xfe, 0x22, 0x34, 0x3b, 0x8d, 0x58, 0xb5, 0xa5, 0x11, 0xca, 0x41, 0xca, 0x76,
+	0x26, 0xc6, 0xd0, 0xb6, 0x78, 0x66, 0xea, 0xf3, 0x9c, 0x52, 0x58, 0xd3, 0xa2, 0xa2, 0x47, 0xb6,
+	0xce, 0xe6, 0xc3, 0x5b, 0x07, 0xbd, 0x84, 0x75, 0x0f, 0x7f, 0x69, 0x78, 0x96, 0x4e, 0x5c, 0xc6,
+	0x09, 0xac, 0x6e, 0x09, 0xc8, 0xb4, 0x94, 0x5e, 0xba, 0xec, 0x72, 0xcc, 0x50, 0x03, 0xd6, 0xc3,
+	0x11, 0xd0, 0x07, 0x78, 0xa6, 0x6e, 0xdf, 0xdf, 0x02, 0x91, 0xcf, 0xf6, 0xe4, 0xa8, 0xd8, 0xf6,
+	0x0c, 0x87, 0x1a, 0x26, 0xcf, 0x9d, 0x0f, 0x6c, 0x3a, 0x18, 0x98, 0xb7, 0x78, 0x86, 0x7e, 0x0a,
+	0x7b, 0x11, 0x3c, 0xdb, 0x31, 0x87, 0x63, 0xde, 0x11, 0xfe, 0x28, 0x21, 0x5d, 0x75, 0x47, 0x8c,
+	0xd8, 0x4e, 0xe0, 0x51, 0x9f, 0xeb, 0xc5, 0x72, 0x41, 0x7b, 0xb0, 0xe6, 0x61, 0x8a, 0xbd, 0x09,
+	0xb6, 0x54, 0x55, 0x24, 0x1b, 0x9c, 0xd1, 0xcf, 0xe1, 0x7b, 0x5d, 0x57, 0x0f, 0xee, 0x8f, 0xbb,
+	0xc4, 0xc3, 0x3a, 0xcf, 0x62, 0x22, 0x89, 0xb5, 0x2b, 0xec, 0x77, 0xbb, 0x6e, 0xcb, 0x2f, 0x84,
+	0xb0, 0x28, 0x07, 0x06, 0xa8, 0x0c, 0x2f, 0x24, 0x98, 0xec, 0x0d, 0x9e, 0xba, 0xb6, 0x37, 0x8b,
+	0x16, 0x72, 0x4f, 0x14, 0x72, 0x2f, 0x62, 0x54, 0x13, 0x36, 0x61, 0x49, 0x8f, 0x60, 0x2b, 0x9a,
+	0x43, 0xe8, 0xba, 0x2f, 0x5c, 0x51, 0x18, 0x3c, 0xd8, 0xfd, 0x7f, 0x49, 0xc0, 0xc6, 0x1d, 0x56,
+	0xf3, 0xad, 0x16, 0x19, 0x9f, 0xa9, 0xfc, 0x06, 0x6a, 0xa9, 0x70, 0x78, 0xee, 0x2d, 0x93, 0xd8,
+	0x37, 0x59, 0x26, 0x5f, 0xc0, 0x4e, 0xb8, 0x4c, 0xc2, 0x00, 0x7c, 0xad, 0xc4, 0x9f, 0xba, 0x56,
+	0xb6, 0x02, 0xe4, 0xab, 0x39, 0x30, 0xdf, 0x2f, 0x04, 0xb6, 0xc3, 0x90, 0x41, 0xc2, 0x3c, 0x62,
+	0xe2, 0xa9, 0x11, 0x37, 0xc3, 0x45, 0xe6, 0xe3, 0xf2, 0x80, 0x5d, 0xd8, 0x0e, 0x17, 0x5a, 0x24,
+	0x1e, 0x55, 0x97, 0xbf, 0xe3, 0x66, 0xdb, 0x0c, 0x36, 0x5b, 0x18, 0x86, 0x22, 0x13, 0xf6, 0x83,
+	0x38, 0x0b, 0xa5, 0x94, 0x9f, 0xb8, 0x15, 0x11, 0xec, 0xe5, 0x23, 0xc1, 0x02, 0xf4, 0xba, 0xd3,
+	0x25, 0x9a, 0x3a, 0x07, 0x8a, 0x56, 0x8e, 0x7f, 0xdd, 0xf2, 0x2d, 0xd8, 0x09, 0x9f, 0x05, 0xc4,
+	0x0b, 0xdf, 0x07, 0x14, 0xfd, 0x08, 0x12, 0x16, 0x1e, 0x52, 0x55, 0xf9, 0xda, 0x40, 0x0b, 0x8f,
+	0x0a, 0x4d, 0x78, 0xe4, 0x1b, 0xb0, 0xff, 0x30, 0x68, 0xdd, 0xb1, 0xf0, 0x14, 0x95, 0x60, 0x33,
+	0x32, 0x9f, 0x7d, 0x83, 0xf6, 0xe5, 0x8d, 0x78, 0xa0, 0xb4, 0xf6, 0x2c, 0x98, 0xcc, 0x33, 0x83,
+	0xf6, 0x45, 0x92, 0x7f, 0x56, 0x60, 0x7b, 0x21, 0x4e, 0x9b, 0x8c, 0x3a, 0x94, 0x11, 0x07, 0xa3,
+	0xd7, 0xf0, 0xfc, 0x2e, 0x56, 0x1f, 0x4b, 0x3a, 0x27, 0xb5, 0xec, 0x02, 0xd4, 0x19, 0x9e, 0xa2,
+	0x0b, 0x48, 0x8b, 0xed, 0xa8, 0x53, 0x66, 0xb0, 0x31, 0x15, 0xa4, 0x5e, 0x3f, 0xfe, 0xe8, 0x9b,
+	0xdc, 0xad, 0x25, 0x3c, 0xb4, 0x94, 0xf0, 0x97, 0x87, 0xfc, 0x5f, 0x15, 0xc8, 0x2c, 0x54, 0x1a,
+	0x9d, 0x42, 0xec, 0xc9, 0x2f, 0xca, 0x98, 0x3b, 0x40, 0x6f, 0x21, 0xce, 0x29, 0x1c, 0x7b, 0x2a,
+	0x85, 0x39, 0x4a, 0xfe, 0x8f, 0x0a, 0xec, 0x3e, 0xca, 0x3e, 0xfe, 0x90, 0x33, 0xc9, 0xe4, 0x03,
+	0x3c, 0x84, 0x4d, 0x32, 0x69, 0x0e, 0xf8, 0x66, 0x31, 0x64, 0x0c, 0x39, 0x14, 0x31, 0xd1, 0xd5,
+	0x94, 0x11, 0xc4, 0xa5, 0xf9, 0xbf, 0x2b, 0xb0, 0xdb, 0xc2, 0x43, 0xcc, 0x17, 0x23, 0x9e, 0x73,
+	0xbe, 0xc6, 0x9f, 0xe7, 0x8e, 0x89, 0xd1, 0x2b, 0xd8, 0xb8, 0xd3, 0x52, 0xbf, 0x9d, 0x99, 0x85,
+	0x76, 0x22, 0x0d, 0x92, 0xc1, 0xab, 0xef, 0x89, 0x6f, 0xd0, 0x55, 0xff, 0xc1, 0xc7, 0xe9, 0xe4,
+	0x61, 0x3e, 0x2c, 0x1e, 0xb6, 0x74, 0x1f, 0x9d, 0xca, 0x7f, 0x5a, 0x69, 0x2d, 0x1b, 0xa8, 0x4e,
+	0xb9, 0x79, 0x6b, 0xf0, 0xd1, 0x17, 0xf0, 0xfc, 0x01, 0x8e, 0xa0, 0x14, 0xac, 0x36, 0x6b, 0x8d,
+	0x6a, 0xbd, 0xf1, 0x8b, 0xec, 0x12, 0x02, 0x58, 0x29, 0x9f, 0xb4, 0xeb, 0xd7, 0xb5, 0xac, 0x82,
+	0xd2, 0xb0, 0x76, 0xd5, 0xa8, 0x5c, 0x36, 0xaa, 0xb5, 0x6a, 0x36, 0x86, 0x56, 0x21, 0x5e, 0x6e,
+	0xfc, 0x3a, 0x1b, 0x47, 0x1b, 0x90, 0xaa, 0x37, 0xae, 0xcb, 0xe7, 0xf5, 0x6a, 0xb9, 0x5d, 0xab,
+	0x66, 0x13, 0x1c, 0xa0, 0x75, 0x5e, 0x6e, 0x9d, 0xd5, 0xaa, 0xd9, 0x65, 0xee, 0xa4, 0xd5, 0x5a,
+	0x35, 0xed, 0xba, 0x56, 0xcd, 0xae, 0x54, 0xce, 0xff, 0xf1, 0xee, 0x40, 0xf9, 0xea, 0xdd, 0x81,
+	0xf2, 0x9f, 0x77, 0x07, 0xca, 0x9f, 0xde, 0x1f, 0x2c, 0x7d, 0xf5, 0xfe, 0x60, 0xe9, 0xdf, 0xef,
+	0x0f, 0x96, 0x7e, 0xf3, 0x7f, 0x2f, 0x3e, 0x8d, 0xfe, 0x61, 0x16, 0x55, 0xe8, 0xac, 0x88, 0xbf,
+	0xc0, 0x9f, 0xfe, 0x6f, 0x00, 0x18, 0xd1, 0x84, 0xb4, 0x0d, 0x10, 0x00, 0x00,
 }
 
 func (m *FinalityProvider) Marshal() (dAtA []byte, err error) {
@@ -990,6 +1232,119 @@ func (m *BTCDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FpSlashedBtcHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.FpSlashedBtcHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.ReservationExpiryBtcHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.ReservationExpiryBtcHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.FpSlashedBeforeActivation {
+		i--
+		if m.FpSlashedBeforeActivation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.Reserved {
+		i--
+		if m.Reserved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.StakingTxInclusionProof) > 0 {
+		i -= len(m.StakingTxInclusionProof)
+		copy(dAtA[i:], m.StakingTxInclusionProof)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.StakingTxInclusionProof)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.StakingTxKey != nil {
+		{
+			size, err := m.StakingTxKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.RewardOptOut {
+		i--
+		if m.RewardOptOut {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.SlashedBtcHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.SlashedBtcHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.Invalidated {
+		i--
+		if m.Invalidated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.CheckpointFinalizationTimeout != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.CheckpointFinalizationTimeout))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.BtcConfirmationDepth != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.BtcConfirmationDepth))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.CreatedBabylonHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.CreatedBabylonHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if m.ParamsVersion != 0 {
 		i = encodeVarintBtcstaking(dAtA, i, uint64(m.ParamsVersion))
 		i--
@@ -1293,6 +1648,41 @@ func (m *BTCDelegatorDelegationIndex) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *BTCDelegationTombstone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCDelegationTombstone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCDelegationTombstone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinalStatus != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.FinalStatus))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SignatureInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1568,6 +1958,44 @@ func (m *BTCDelegation) Size() (n int) {
 	if m.ParamsVersion != 0 {
 		n += 1 + sovBtcstaking(uint64(m.ParamsVersion))
 	}
+	if m.CreatedBabylonHeight != 0 {
+		n += 2 + sovBtcstaking(uint64(m.CreatedBabylonHeight))
+	}
+	if m.BtcConfirmationDepth != 0 {
+		n += 2 + sovBtcstaking(uint64(m.BtcConfirmationDepth))
+	}
+	if m.CheckpointFinalizationTimeout != 0 {
+		n += 2 + sovBtcstaking(uint64(m.CheckpointFinalizationTimeout))
+	}
+	if m.Invalidated {
+		n += 3
+	}
+	if m.SlashedBtcHeight != 0 {
+		n += 2 + sovBtcstaking(uint64(m.SlashedBtcHeight))
+	}
+	if m.RewardOptOut {
+		n += 3
+	}
+	if m.StakingTxKey != nil {
+		l = m.StakingTxKey.Size()
+		n += 2 + l + sovBtcstaking(uint64(l))
+	}
+	l = len(m.StakingTxInclusionProof)
+	if l > 0 {
+		n += 2 + l + sovBtcstaking(uint64(l))
+	}
+	if m.Reserved {
+		n += 3
+	}
+	if m.FpSlashedBeforeActivation {
+		n += 3
+	}
+	if m.ReservationExpiryBtcHeight != 0 {
+		n += 2 + sovBtcstaking(uint64(m.ReservationExpiryBtcHeight))
+	}
+	if m.FpSlashedBtcHeight != 0 {
+		n += 2 + sovBtcstaking(uint64(m.FpSlashedBtcHeight))
+	}
 	return n
 }
 
@@ -1638,6 +2066,22 @@ func (m *BTCDelegatorDelegationIndex) Size() (n int) {
 	return n
 }
 
+func (m *BTCDelegationTombstone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.FinalStatus != 0 {
+		n += 1 + sovBtcstaking(uint64(m.FinalStatus))
+	}
+	return n
+}
+
 func (m *SignatureInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2588,6 +3032,270 @@ func (m *BTCDelegation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBabylonHeight", wireType)
+			}
+			m.CreatedBabylonHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedBabylonHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcConfirmationDepth", wireType)
+			}
+			m.BtcConfirmationDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcConfirmationDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointFinalizationTimeout", wireType)
+			}
+			m.CheckpointFinalizationTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointFinalizationTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invalidated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Invalidated = bool(v != 0)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBtcHeight", wireType)
+			}
+			m.SlashedBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedBtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardOptOut", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RewardOptOut = bool(v != 0)
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StakingTxKey == nil {
+				m.StakingTxKey = &types1.TransactionKey{}
+			}
+			if err := m.StakingTxKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxInclusionProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxInclusionProof = append(m.StakingTxInclusionProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.StakingTxInclusionProof == nil {
+				m.StakingTxInclusionProof = []byte{}
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reserved = bool(v != 0)
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpSlashedBeforeActivation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FpSlashedBeforeActivation = bool(v != 0)
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservationExpiryBtcHeight", wireType)
+			}
+			m.ReservationExpiryBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReservationExpiryBtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpSlashedBtcHeight", wireType)
+			}
+			m.FpSlashedBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FpSlashedBtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcstaking(dAtA[iNdEx:])
@@ -3032,6 +3740,107 @@ func (m *BTCDelegatorDelegationIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BTCDelegationTombstone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCDelegationTombstone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCDelegationTombstone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalStatus", wireType)
+			}
+			m.FinalStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalStatus |= BTCDelegationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SignatureInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
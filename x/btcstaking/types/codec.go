@@ -12,8 +12,12 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgEditFinalityProvider{}, "btcstaking/MsgEditFinalityProvider", nil)
 	cdc.RegisterConcrete(&MsgCreateBTCDelegation{}, "btcstaking/MsgCreateBTCDelegation", nil)
 	cdc.RegisterConcrete(&MsgAddCovenantSigs{}, "btcstaking/MsgAddCovenantSigs", nil)
+	cdc.RegisterConcrete(&MsgCreateBTCDelegationWithCovenantSigs{}, "btcstaking/MsgCreateBTCDelWithCovSigs", nil)
+	cdc.RegisterConcrete(&MsgActivateReservedDelegation{}, "btcstaking/MsgActivateReservedDelegation", nil)
+	cdc.RegisterConcrete(&MsgAddSlashingTxInclusionProof{}, "btcstaking/MsgAddSlashingTxProof", nil)
 	cdc.RegisterConcrete(&MsgBTCUndelegate{}, "btcstaking/MsgBTCUndelegate", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "btcstaking/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgPruneInactiveDelegations{}, "btcstaking/MsgPruneInactiveDelegations", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -24,8 +28,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgEditFinalityProvider{},
 		&MsgCreateBTCDelegation{},
 		&MsgAddCovenantSigs{},
+		&MsgCreateBTCDelegationWithCovenantSigs{},
+		&MsgActivateReservedDelegation{},
+		&MsgAddSlashingTxInclusionProof{},
 		&MsgBTCUndelegate{},
 		&MsgUpdateParams{},
+		&MsgPruneInactiveDelegations{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -31,4 +31,11 @@ var (
 	ErrVotingPowerTableNotUpdated   = errorsmod.Register(ModuleName, 1122, "voting power table has not been updated")
 	ErrVotingPowerDistCacheNotFound = errorsmod.Register(ModuleName, 1123, "the voting power distribution cache is not found")
 	ErrParamsNotFound               = errorsmod.Register(ModuleName, 1124, "the parameters are not found")
+	ErrInsufficientSlashingFee      = errorsmod.Register(ModuleName, 1125, "the slashing tx does not leave the minimum fee for the miner")
+	ErrInvalidChangeAddress         = errorsmod.Register(ModuleName, 1126, "the change output of the BTC staking tx is not valid")
+	ErrInvalidSigHashType           = errorsmod.Register(ModuleName, 1127, "the signature does not use the expected sighash type")
+	ErrNonStandardStakingTxVersion  = errorsmod.Register(ModuleName, 1128, "the BTC staking tx has a non-standard version")
+	ErrBTCDelegationAlreadyActive   = errorsmod.Register(ModuleName, 1131, "the BTC delegation has already been activated by a covenant quorum")
+	ErrTooManyFinalityProviders     = errorsmod.Register(ModuleName, 1132, "the BTC delegation restakes to too many finality providers")
+	ErrReservationExpired           = errorsmod.Register(ModuleName, 1133, "the reservation of the BTC delegation has expired")
 )
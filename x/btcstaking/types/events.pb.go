@@ -310,12 +310,86 @@ func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) XXX_DiscardUnknown()
 
 var xxx_messageInfo_EventPowerDistUpdate_EventSlashedFinalityProvider proto.InternalMessageInfo
 
+// EventFinalityProviderVotingPowerUpdated is the event emitted upon
+// `BeginBlock` for each finality provider whose voting power in the voting
+// power table changes w.r.t. the previous height. A finality provider that is
+// not in the voting power table, e.g., because it leaves the active finality
+// provider set, has zero voting power
+type EventFinalityProviderVotingPowerUpdated struct {
+	// fp_btc_pk is the BTC PK of the finality provider
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// old_voting_power is the voting power of the finality provider at the
+	// previous height
+	OldVotingPower uint64 `protobuf:"varint,2,opt,name=old_voting_power,json=oldVotingPower,proto3" json:"old_voting_power,omitempty"`
+	// new_voting_power is the voting power of the finality provider at height
+	NewVotingPower uint64 `protobuf:"varint,3,opt,name=new_voting_power,json=newVotingPower,proto3" json:"new_voting_power,omitempty"`
+	// height is the Babylon height at which the voting power changes
+	Height uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *EventFinalityProviderVotingPowerUpdated) Reset() {
+	*m = EventFinalityProviderVotingPowerUpdated{}
+}
+func (m *EventFinalityProviderVotingPowerUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventFinalityProviderVotingPowerUpdated) ProtoMessage()    {}
+func (*EventFinalityProviderVotingPowerUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{4}
+}
+func (m *EventFinalityProviderVotingPowerUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFinalityProviderVotingPowerUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFinalityProviderVotingPowerUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFinalityProviderVotingPowerUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFinalityProviderVotingPowerUpdated.Merge(m, src)
+}
+func (m *EventFinalityProviderVotingPowerUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFinalityProviderVotingPowerUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFinalityProviderVotingPowerUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFinalityProviderVotingPowerUpdated proto.InternalMessageInfo
+
+func (m *EventFinalityProviderVotingPowerUpdated) GetOldVotingPower() uint64 {
+	if m != nil {
+		return m.OldVotingPower
+	}
+	return 0
+}
+
+func (m *EventFinalityProviderVotingPowerUpdated) GetNewVotingPower() uint64 {
+	if m != nil {
+		return m.NewVotingPower
+	}
+	return 0
+}
+
+func (m *EventFinalityProviderVotingPowerUpdated) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EventNewFinalityProvider)(nil), "babylon.btcstaking.v1.EventNewFinalityProvider")
 	proto.RegisterType((*EventBTCDelegationStateUpdate)(nil), "babylon.btcstaking.v1.EventBTCDelegationStateUpdate")
 	proto.RegisterType((*EventSelectiveSlashing)(nil), "babylon.btcstaking.v1.EventSelectiveSlashing")
 	proto.RegisterType((*EventPowerDistUpdate)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate")
 	proto.RegisterType((*EventPowerDistUpdate_EventSlashedFinalityProvider)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate.EventSlashedFinalityProvider")
+	proto.RegisterType((*EventFinalityProviderVotingPowerUpdated)(nil), "babylon.btcstaking.v1.EventFinalityProviderVotingPowerUpdated")
 }
 
 func init() {
@@ -323,36 +397,41 @@ func init() {
 }
 
 var fileDescriptor_74118427820fff75 = []byte{
-	// 463 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x4f, 0x6f, 0xd3, 0x40,
-	0x10, 0xc5, 0x6d, 0x0b, 0xa1, 0x66, 0xcb, 0x1f, 0x61, 0x05, 0x14, 0x45, 0x60, 0x2a, 0x1f, 0x4a,
-	0xc5, 0xc1, 0x6e, 0xd3, 0x0a, 0xee, 0x26, 0x0d, 0x41, 0x54, 0x28, 0xb2, 0xcb, 0x85, 0x8b, 0xb5,
-	0x76, 0x26, 0xf6, 0x2a, 0x66, 0xd7, 0xca, 0x4e, 0x9c, 0xe4, 0x5b, 0xf4, 0x63, 0x71, 0xec, 0xb1,
-	0xe2, 0x80, 0x50, 0xf2, 0x45, 0x90, 0x37, 0xa6, 0x44, 0x6d, 0x1c, 0x6e, 0xc9, 0xe8, 0xbd, 0xf7,
-	0x7b, 0x33, 0xb6, 0x89, 0x1d, 0xd1, 0x68, 0x91, 0x09, 0xee, 0x46, 0x18, 0x4b, 0xa4, 0x63, 0xc6,
-	0x13, 0xb7, 0x38, 0x71, 0xa1, 0x00, 0x8e, 0xd2, 0xc9, 0x27, 0x02, 0x85, 0xf9, 0xbc, 0xd2, 0x38,
-	0xff, 0x34, 0x4e, 0x71, 0xd2, 0x6e, 0x26, 0x22, 0x11, 0x4a, 0xe1, 0x96, 0xbf, 0xd6, 0xe2, 0xf6,
-	0xe1, 0xf6, 0xc0, 0x0d, 0xab, 0xd2, 0xd9, 0x01, 0x69, 0x9d, 0x97, 0x90, 0x2f, 0x30, 0xeb, 0x31,
-	0x4e, 0x33, 0x86, 0x8b, 0xc1, 0x44, 0x14, 0x6c, 0x08, 0x13, 0xf3, 0x3d, 0x31, 0x46, 0x79, 0x4b,
-	0x3f, 0xd0, 0x8f, 0xf6, 0x3b, 0x6f, 0x9c, 0xad, 0x74, 0xe7, 0xae, 0xc9, 0x37, 0x46, 0xb9, 0x7d,
-	0xa5, 0x93, 0x57, 0x2a, 0xd5, 0xbb, 0xfc, 0xd0, 0x85, 0x0c, 0x12, 0x8a, 0x4c, 0xf0, 0x00, 0x29,
-	0xc2, 0xd7, 0x7c, 0x48, 0x11, 0xcc, 0x43, 0xf2, 0xb4, 0x0a, 0x09, 0x71, 0x1e, 0xa6, 0x54, 0xa6,
-	0x8a, 0xd3, 0xf0, 0x1f, 0x57, 0xe3, 0xcb, 0x79, 0x9f, 0xca, 0xd4, 0xfc, 0x48, 0x1a, 0x1c, 0x66,
-	0xa1, 0x2c, 0xad, 0x2d, 0xe3, 0x40, 0x3f, 0x7a, 0xd2, 0x79, 0x5b, 0xd3, 0xe4, 0x1e, 0x6b, 0x2a,
-	0xfd, 0x3d, 0x0e, 0x33, 0x85, 0xb5, 0x47, 0xe4, 0x85, 0x6a, 0x14, 0x40, 0x06, 0x31, 0xb2, 0x02,
-	0x82, 0x8c, 0xca, 0x94, 0xf1, 0xc4, 0xbc, 0x20, 0x7b, 0x50, 0x56, 0xe7, 0x31, 0x54, 0xbb, 0x1e,
-	0xd7, 0x10, 0xee, 0x79, 0xcf, 0x2b, 0x9f, 0x7f, 0x9b, 0x60, 0xdf, 0x18, 0xa4, 0xa9, 0x40, 0x03,
-	0x31, 0x83, 0x49, 0x97, 0x49, 0xac, 0x36, 0x66, 0x84, 0xc8, 0xd2, 0x06, 0xc3, 0xf0, 0xf6, 0xa8,
-	0xfd, 0x1a, 0xd0, 0xb6, 0x80, 0xf5, 0x30, 0x58, 0x47, 0xdc, 0xbd, 0x7a, 0x5f, 0xf3, 0x1b, 0x55,
-	0x7a, 0x2f, 0x37, 0x13, 0xd2, 0x8c, 0x30, 0x0e, 0x87, 0x90, 0xad, 0x0f, 0x17, 0x4e, 0x55, 0x82,
-	0xba, 0xdf, 0x7e, 0xe7, 0x6c, 0x17, 0xb4, 0xee, 0x81, 0xf5, 0x35, 0xff, 0x59, 0x84, 0x71, 0x17,
-	0xb2, 0x8d, 0x61, 0x7b, 0x44, 0x5e, 0xee, 0x6a, 0x65, 0xf6, 0x88, 0x91, 0x8f, 0xd5, 0xae, 0x8f,
-	0xbc, 0x77, 0x3f, 0x7f, 0xbd, 0xee, 0x24, 0x0c, 0xd3, 0x69, 0xe4, 0xc4, 0xe2, 0xbb, 0x5b, 0x95,
-	0x88, 0x53, 0xca, 0xf8, 0xdf, 0x3f, 0x2e, 0x2e, 0x72, 0x90, 0x8e, 0xf7, 0x69, 0x70, 0x7a, 0x76,
-	0x3c, 0x98, 0x46, 0x9f, 0x61, 0xe1, 0x1b, 0xf9, 0xd8, 0x7b, 0x40, 0x0c, 0x28, 0xbc, 0x8b, 0x1f,
-	0x4b, 0x4b, 0xbf, 0x5e, 0x5a, 0xfa, 0xef, 0xa5, 0xa5, 0x5f, 0xad, 0x2c, 0xed, 0x7a, 0x65, 0x69,
-	0x37, 0x2b, 0x4b, 0xfb, 0xf6, 0xdf, 0xdc, 0xf9, 0xe6, 0x67, 0xa0, 0x20, 0xd1, 0x43, 0xf5, 0xfe,
-	0x9f, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0xc4, 0xc4, 0x7d, 0x08, 0x7a, 0x03, 0x00, 0x00,
+	// 544 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0x63, 0x7f, 0x51, 0xbf, 0x66, 0x0a, 0x05, 0xac, 0x50, 0x45, 0x11, 0x98, 0xca, 0x8b,
+	0x36, 0x62, 0x61, 0xb7, 0x69, 0x05, 0x7b, 0x93, 0x86, 0x20, 0x2a, 0x14, 0x39, 0x85, 0x05, 0x1b,
+	0xcb, 0x7f, 0xae, 0xed, 0x51, 0xcc, 0xcc, 0x28, 0x33, 0x71, 0x92, 0xb7, 0xe8, 0x63, 0xb1, 0xec,
+	0xb2, 0x62, 0x81, 0x50, 0xb2, 0xe2, 0x2d, 0x90, 0xc7, 0x6e, 0x09, 0x69, 0x52, 0x16, 0xec, 0x32,
+	0x93, 0x73, 0xcf, 0xef, 0xdc, 0x93, 0x0c, 0x32, 0x7c, 0xcf, 0x9f, 0xa5, 0x94, 0x58, 0xbe, 0x08,
+	0xb8, 0xf0, 0x86, 0x98, 0xc4, 0x56, 0x76, 0x6c, 0x41, 0x06, 0x44, 0x70, 0x93, 0x8d, 0xa8, 0xa0,
+	0xda, 0xd3, 0x52, 0x63, 0xfe, 0xd6, 0x98, 0xd9, 0x71, 0xb3, 0x1e, 0xd3, 0x98, 0x4a, 0x85, 0x95,
+	0x7f, 0x2a, 0xc4, 0xcd, 0x83, 0xf5, 0x86, 0x4b, 0xa3, 0x52, 0x67, 0x0c, 0x50, 0xe3, 0x2c, 0x87,
+	0x7c, 0x80, 0x49, 0x17, 0x13, 0x2f, 0xc5, 0x62, 0xd6, 0x1f, 0xd1, 0x0c, 0x87, 0x30, 0xd2, 0x5e,
+	0x23, 0x35, 0x62, 0x0d, 0x65, 0x5f, 0x69, 0xed, 0xb4, 0x0f, 0xcd, 0xb5, 0x74, 0x73, 0x75, 0xc8,
+	0x51, 0x23, 0x66, 0x5c, 0x2a, 0xe8, 0xb9, 0x74, 0xb5, 0x2f, 0xde, 0x74, 0x20, 0x85, 0xd8, 0x13,
+	0x98, 0x92, 0x81, 0xf0, 0x04, 0x7c, 0x64, 0xa1, 0x27, 0x40, 0x3b, 0x40, 0x8f, 0x4a, 0x13, 0x57,
+	0x4c, 0xdd, 0xc4, 0xe3, 0x89, 0xe4, 0xd4, 0x9c, 0x87, 0xe5, 0xf5, 0xc5, 0xb4, 0xe7, 0xf1, 0x44,
+	0x7b, 0x8b, 0x6a, 0x04, 0x26, 0x2e, 0xcf, 0x47, 0x1b, 0xea, 0xbe, 0xd2, 0xda, 0x6d, 0xbf, 0xdc,
+	0x90, 0xe4, 0x0e, 0x6b, 0xcc, 0x9d, 0x6d, 0x02, 0x13, 0x89, 0x35, 0x22, 0xb4, 0x27, 0x13, 0x0d,
+	0x20, 0x85, 0x40, 0xe0, 0x0c, 0x06, 0xa9, 0xc7, 0x13, 0x4c, 0x62, 0xed, 0x1c, 0x6d, 0x43, 0x1e,
+	0x9d, 0x04, 0x50, 0xee, 0x7a, 0xb4, 0x81, 0x70, 0x67, 0xf6, 0xac, 0x9c, 0x73, 0x6e, 0x1d, 0x8c,
+	0x6b, 0x15, 0xd5, 0x25, 0xa8, 0x4f, 0x27, 0x30, 0xea, 0x60, 0x2e, 0xca, 0x8d, 0x31, 0x42, 0x3c,
+	0x1f, 0x83, 0xd0, 0xbd, 0x2d, 0xb5, 0xb7, 0x01, 0xb4, 0xce, 0xa0, 0xb8, 0x1c, 0x14, 0x16, 0xab,
+	0xad, 0xf7, 0x2a, 0x4e, 0xad, 0x74, 0xef, 0x32, 0x2d, 0x46, 0x75, 0x5f, 0x04, 0x6e, 0x08, 0x69,
+	0x51, 0x9c, 0x3b, 0x66, 0xe1, 0x4d, 0x7f, 0x3b, 0xed, 0xd3, 0xfb, 0xa0, 0x9b, 0x7e, 0xb0, 0x5e,
+	0xc5, 0x79, 0xe2, 0x8b, 0xa0, 0x03, 0xe9, 0xd2, 0x65, 0x33, 0x42, 0xcf, 0xee, 0x4b, 0xa5, 0x75,
+	0x91, 0xca, 0x86, 0x72, 0xd7, 0x07, 0xf6, 0xab, 0x6f, 0xdf, 0x5f, 0xb4, 0x63, 0x2c, 0x92, 0xb1,
+	0x6f, 0x06, 0xf4, 0x8b, 0x55, 0x86, 0x08, 0x12, 0x0f, 0x93, 0x9b, 0x83, 0x25, 0x66, 0x0c, 0xb8,
+	0x69, 0xbf, 0xeb, 0x9f, 0x9c, 0x1e, 0xf5, 0xc7, 0xfe, 0x7b, 0x98, 0x39, 0x2a, 0x1b, 0xda, 0x55,
+	0xa4, 0x42, 0x66, 0xfc, 0x54, 0xd0, 0xa1, 0xc4, 0xad, 0x72, 0x3e, 0x51, 0x81, 0x49, 0x2c, 0xfb,
+	0x2a, 0x82, 0x85, 0x9a, 0x83, 0x6a, 0x11, 0x73, 0xf3, 0x16, 0xfe, 0x39, 0xc0, 0xff, 0x11, 0xb3,
+	0x45, 0xd0, 0x1f, 0x6a, 0x2d, 0xf4, 0x98, 0xa6, 0xa1, 0x9b, 0x49, 0x9a, 0xcb, 0x72, 0x9c, 0xac,
+	0xb4, 0xea, 0xec, 0xd2, 0x34, 0x5c, 0x0a, 0x91, 0x2b, 0xf3, 0x7f, 0xed, 0x1f, 0xca, 0xff, 0x0a,
+	0x25, 0x81, 0xc9, 0xb2, 0x72, 0x0f, 0x6d, 0x25, 0x80, 0xe3, 0x44, 0x34, 0xaa, 0xf2, 0xfb, 0xf2,
+	0x64, 0x9f, 0x7f, 0x9d, 0xeb, 0xca, 0xd5, 0x5c, 0x57, 0x7e, 0xcc, 0x75, 0xe5, 0x72, 0xa1, 0x57,
+	0xae, 0x16, 0x7a, 0xe5, 0x7a, 0xa1, 0x57, 0x3e, 0xff, 0x75, 0x85, 0xe9, 0xf2, 0x93, 0x97, 0xfb,
+	0xf8, 0x5b, 0xf2, 0xad, 0x9f, 0xfc, 0x1a, 0x00, 0xea, 0xb0, 0x18, 0x52, 0x66, 0x04, 0x00, 0x00,
 }
 
 func (m *EventNewFinalityProvider) Marshal() (dAtA []byte, err error) {
@@ -569,6 +648,56 @@ func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) MarshalToSizedBuffer
 	return len(dAtA) - i, nil
 }
 
+func (m *EventFinalityProviderVotingPowerUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFinalityProviderVotingPowerUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFinalityProviderVotingPowerUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewVotingPower != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewVotingPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OldVotingPower != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OldVotingPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -671,6 +800,28 @@ func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Size() (n int) {
 	return n
 }
 
+func (m *EventFinalityProviderVotingPowerUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.OldVotingPower != 0 {
+		n += 1 + sovEvents(uint64(m.OldVotingPower))
+	}
+	if m.NewVotingPower != 0 {
+		n += 1 + sovEvents(uint64(m.NewVotingPower))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1155,6 +1306,148 @@ func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Unmarshal(dAtA []byt
 	}
 	return nil
 }
+func (m *EventFinalityProviderVotingPowerUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFinalityProviderVotingPowerUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFinalityProviderVotingPowerUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldVotingPower", wireType)
+			}
+			m.OldVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewVotingPower", wireType)
+			}
+			m.NewVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
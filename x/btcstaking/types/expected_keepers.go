@@ -4,6 +4,9 @@ import (
 	"context"
 	"math/big"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	bbn "github.com/babylonchain/babylon/types"
 	btcctypes "github.com/babylonchain/babylon/x/btccheckpoint/types"
 	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
@@ -25,3 +28,12 @@ type CheckpointingKeeper interface {
 	GetEpoch(ctx context.Context) *etypes.Epoch
 	GetLastFinalizedEpoch(ctx context.Context) uint64
 }
+
+type IncentiveKeeper interface {
+	IsBTCDelegationRewardFullyWithdrawn(ctx context.Context, stakerAddr sdk.AccAddress, stakingTxHash string) bool
+	PruneBTCDelegationRewardRecords(ctx context.Context, stakingTxHash string)
+	PruneDelegatorValidatorRewards(ctx context.Context, delBTCPK *bbn.BIP340PubKey, fpBTCPK *bbn.BIP340PubKey)
+	GetBTCDelRewardLockup(ctx context.Context, stakingTxHash *chainhash.Hash) (startEpoch uint64, lockedUp bool, rewarded bool)
+	DeleteBTCDelRewardStartEpoch(ctx context.Context, stakingTxHash *chainhash.Hash)
+	GetRewardLockupEpochs(ctx context.Context) uint64
+}
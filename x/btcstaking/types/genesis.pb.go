@@ -43,6 +43,8 @@ type GenesisState struct {
 	// vp_dst_cache is the table of all providers voting power with the total at one specific block.
 	// TODO: remove this after not storing in the keeper store it anymore.
 	VpDstCache []*VotingPowerDistCacheBlkHeight `protobuf:"bytes,8,rep,name=vp_dst_cache,json=vpDstCache,proto3" json:"vp_dst_cache,omitempty"`
+	// btc_delegation_tombstones are the records of all pruned BTC delegations.
+	BtcDelegationTombstones []*BTCDelegationTombstone `protobuf:"bytes,9,rep,name=btc_delegation_tombstones,json=btcDelegationTombstones,proto3" json:"btc_delegation_tombstones,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -134,6 +136,13 @@ func (m *GenesisState) GetVpDstCache() []*VotingPowerDistCacheBlkHeight {
 	return nil
 }
 
+func (m *GenesisState) GetBtcDelegationTombstones() []*BTCDelegationTombstone {
+	if m != nil {
+		return m.BtcDelegationTombstones
+	}
+	return nil
+}
+
 // VotingPowerFP contains the information about the voting power
 // of an finality provider in a specific block height.
 type VotingPowerFP struct {
@@ -430,51 +439,52 @@ func init() {
 }
 
 var fileDescriptor_85d7b95fa5620238 = []byte{
-	// 694 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xdd, 0x4e, 0xd4, 0x4e,
-	0x18, 0xc6, 0x29, 0xbb, 0x2c, 0xfc, 0x67, 0x97, 0x05, 0x86, 0xbf, 0x49, 0x43, 0xc2, 0x0a, 0xc5,
-	0x8f, 0x8d, 0x26, 0x5d, 0x59, 0xd0, 0xc4, 0x43, 0xcb, 0x8a, 0xe2, 0x47, 0xd2, 0xd4, 0x95, 0x03,
-	0x4e, 0x9a, 0x4e, 0x3b, 0xdb, 0x9d, 0x6c, 0x99, 0x69, 0x3a, 0x43, 0x65, 0xaf, 0xc1, 0x13, 0x0f,
-	0xbd, 0x05, 0xef, 0xc4, 0x43, 0x0e, 0x8d, 0x07, 0xc6, 0xc0, 0x1d, 0x78, 0x01, 0xc6, 0x74, 0x5a,
-	0x68, 0xc1, 0x5d, 0xc0, 0x18, 0xcf, 0x3a, 0x93, 0xe7, 0xfd, 0xcd, 0xfb, 0xbc, 0xf3, 0x4c, 0x0a,
-	0xd6, 0x90, 0x83, 0x86, 0x01, 0xa3, 0x2d, 0x24, 0x5c, 0x2e, 0x9c, 0x01, 0xa1, 0x7e, 0x2b, 0x5e,
-	0x6f, 0xf9, 0x98, 0x62, 0x4e, 0xb8, 0x1e, 0x46, 0x4c, 0x30, 0x78, 0x23, 0x13, 0xe9, 0xb9, 0x48,
-	0x8f, 0xd7, 0x97, 0xfe, 0xf7, 0x99, 0xcf, 0xa4, 0xa2, 0x95, 0x7c, 0xa5, 0xe2, 0x25, 0x6d, 0x34,
-	0x31, 0x74, 0x22, 0x67, 0x3f, 0x03, 0x2e, 0xdd, 0x19, 0xad, 0x29, 0xe0, 0x53, 0xdd, 0xed, 0xd1,
-	0x3a, 0x42, 0x5d, 0x4c, 0x05, 0x89, 0xf1, 0xe5, 0x47, 0xe2, 0x18, 0x53, 0x91, 0x1d, 0xa9, 0xfd,
-	0x28, 0x83, 0xda, 0xb3, 0xd4, 0xd5, 0x1b, 0xe1, 0x08, 0x0c, 0x1f, 0x82, 0x4a, 0xda, 0x93, 0xaa,
-	0xac, 0x94, 0x9a, 0xd5, 0xf6, 0xb2, 0x3e, 0xd2, 0xa5, 0x6e, 0x4a, 0x91, 0x95, 0x89, 0xe1, 0x2e,
-	0x80, 0x3d, 0x42, 0x9d, 0x80, 0x88, 0xa1, 0x1d, 0x46, 0x2c, 0x26, 0x1e, 0x8e, 0xb8, 0x3a, 0x29,
-	0x11, 0x77, 0xc7, 0x20, 0xb6, 0xb3, 0x02, 0x33, 0xd3, 0x5b, 0x0b, 0xbd, 0x0b, 0x3b, 0x1c, 0xbe,
-	0x06, 0x73, 0x48, 0xb8, 0xb6, 0x87, 0x03, 0xec, 0x3b, 0x82, 0x30, 0xca, 0xd5, 0x92, 0x84, 0xde,
-	0x1a, 0x03, 0x35, 0xba, 0x5b, 0x9d, 0x33, 0xb1, 0x55, 0x47, 0xc2, 0xcd, 0x97, 0x1c, 0xee, 0x80,
-	0xd9, 0x98, 0x09, 0x42, 0x7d, 0x3b, 0x64, 0xef, 0x92, 0x0e, 0xcb, 0x97, 0xc2, 0x76, 0xa5, 0xd6,
-	0x4c, 0xa4, 0xdb, 0xa6, 0x55, 0x8b, 0xf3, 0x25, 0x87, 0x7b, 0x60, 0x11, 0x05, 0xcc, 0x1d, 0xd8,
-	0x7d, 0x4c, 0xfc, 0xbe, 0xb0, 0xdd, 0xbe, 0x43, 0x28, 0x57, 0xa7, 0x24, 0xf0, 0xde, 0xb8, 0xee,
-	0x92, 0x8a, 0xe7, 0xb2, 0xc0, 0x40, 0xb4, 0xcb, 0x0c, 0xe1, 0x5a, 0x0b, 0x28, 0xdf, 0xdc, 0x92,
-	0x10, 0xf8, 0x02, 0xd4, 0x0b, 0xae, 0x59, 0xc4, 0xd5, 0x8a, 0xc4, 0xae, 0x5d, 0x69, 0x9a, 0x45,
-	0xd6, 0x6c, 0xee, 0x99, 0x45, 0x1c, 0x3e, 0x06, 0x95, 0xf4, 0xc6, 0xd5, 0x69, 0xc9, 0x58, 0x1d,
-	0xc3, 0x78, 0x9a, 0x88, 0x76, 0xa8, 0x87, 0x0f, 0xad, 0xac, 0x00, 0xee, 0x82, 0x5a, 0x1c, 0xda,
-	0x1e, 0x17, 0xb6, 0xeb, 0xb8, 0x7d, 0xac, 0xce, 0x48, 0xc0, 0xe6, 0xd5, 0xc3, 0xea, 0x10, 0x2e,
-	0xb6, 0x92, 0x12, 0x23, 0xc8, 0x8c, 0x59, 0x20, 0x0e, 0x3b, 0xd9, 0xa6, 0xf6, 0x49, 0x01, 0xb3,
-	0xe7, 0x46, 0x0b, 0x57, 0x41, 0xad, 0x38, 0x4c, 0x55, 0x59, 0x51, 0x9a, 0x65, 0xab, 0x5a, 0x98,
-	0x0c, 0xb4, 0xc0, 0x7f, 0xbd, 0xd0, 0x4e, 0xc6, 0x12, 0x0e, 0xd4, 0xc9, 0x15, 0xa5, 0x59, 0x33,
-	0x1e, 0x7d, 0xfd, 0x76, 0xb3, 0xed, 0x13, 0xd1, 0x3f, 0x40, 0xba, 0xcb, 0xf6, 0x5b, 0x59, 0x5f,
-	0xf2, 0x26, 0x4e, 0x17, 0x2d, 0x31, 0x0c, 0x31, 0xd7, 0x8d, 0x1d, 0x73, 0x63, 0xf3, 0x81, 0x79,
-	0x80, 0x5e, 0xe2, 0xa1, 0x35, 0xdd, 0x0b, 0x0d, 0xe1, 0x9a, 0x83, 0xe4, 0xd8, 0x62, 0x1c, 0xd4,
-	0x52, 0x7a, 0x6c, 0xe1, 0x9e, 0xb5, 0x8f, 0x0a, 0x58, 0xbe, 0xd4, 0xd9, 0x75, 0x7a, 0xef, 0x82,
-	0xb9, 0x64, 0x90, 0x84, 0x8b, 0x88, 0xa0, 0x83, 0x24, 0x8a, 0xd2, 0x41, 0xb5, 0x7d, 0xff, 0x0f,
-	0x66, 0x69, 0xd5, 0xe3, 0xb0, 0x53, 0x40, 0x68, 0x04, 0x2c, 0x8e, 0xc8, 0x13, 0x6c, 0x82, 0xf9,
-	0x73, 0xc1, 0x44, 0x88, 0x66, 0x3d, 0xd5, 0xd1, 0x39, 0xf9, 0xef, 0x4a, 0xe1, 0xca, 0xbe, 0x2e,
-	0x28, 0x85, 0xab, 0xfd, 0x54, 0x40, 0xad, 0x18, 0x32, 0xd8, 0x01, 0x25, 0xe2, 0x1d, 0x4a, 0x6e,
-	0xb5, 0xdd, 0xbe, 0x46, 0x2c, 0xf3, 0x57, 0x98, 0x66, 0x2c, 0x29, 0xff, 0x27, 0x77, 0xda, 0x05,
-	0xc0, 0xc3, 0xc1, 0x29, 0xb4, 0xf4, 0x57, 0xd0, 0x19, 0x0f, 0x07, 0x92, 0xaa, 0xbd, 0x57, 0x00,
-	0xc8, 0x5f, 0x08, 0x9c, 0xcf, 0xed, 0x97, 0x53, 0x2b, 0xd7, 0x9e, 0x25, 0x7c, 0x02, 0xa6, 0xe4,
-	0xfb, 0x92, 0xbd, 0x8d, 0x8f, 0x80, 0x3c, 0xed, 0x2c, 0x01, 0x6f, 0x43, 0xcf, 0x11, 0xd8, 0x4a,
-	0x2b, 0x8d, 0x57, 0x9f, 0x8f, 0x1b, 0xca, 0xd1, 0x71, 0x43, 0xf9, 0x7e, 0xdc, 0x50, 0x3e, 0x9c,
-	0x34, 0x26, 0x8e, 0x4e, 0x1a, 0x13, 0x5f, 0x4e, 0x1a, 0x13, 0x7b, 0x57, 0xba, 0x3c, 0x2c, 0xfe,
-	0x0d, 0xa4, 0x65, 0x54, 0x91, 0xbf, 0x82, 0x8d, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x75, 0xe9,
-	0x80, 0x92, 0xf5, 0x06, 0x00, 0x00,
+	// 719 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xdd, 0x4e, 0x13, 0x4f,
+	0x18, 0xc6, 0x59, 0x0a, 0x05, 0xa6, 0xa5, 0xc0, 0xf0, 0xff, 0xc7, 0x95, 0x84, 0x0a, 0xc5, 0x8f,
+	0x46, 0x63, 0x2b, 0x05, 0x4d, 0x3c, 0x74, 0xa9, 0x28, 0x7e, 0x24, 0xcd, 0x58, 0x39, 0xe0, 0x64,
+	0xb3, 0xb3, 0x3b, 0xdd, 0x4e, 0x5a, 0x66, 0x36, 0x3b, 0xc3, 0x4a, 0xaf, 0xc1, 0x13, 0x0f, 0xbd,
+	0x05, 0xef, 0xc4, 0x43, 0x0e, 0x8d, 0x89, 0xc6, 0xc0, 0x7d, 0x18, 0xb3, 0xb3, 0x0b, 0xbb, 0x95,
+	0xb6, 0xd4, 0x18, 0xcf, 0xba, 0x93, 0xe7, 0xfd, 0xbd, 0xef, 0x33, 0xcf, 0x3b, 0x29, 0xd8, 0xc0,
+	0x16, 0xee, 0x75, 0x39, 0xab, 0x62, 0x69, 0x0b, 0x69, 0x75, 0x28, 0x73, 0xab, 0xc1, 0x66, 0xd5,
+	0x25, 0x8c, 0x08, 0x2a, 0x2a, 0x9e, 0xcf, 0x25, 0x87, 0xff, 0xc7, 0xa2, 0x4a, 0x22, 0xaa, 0x04,
+	0x9b, 0x2b, 0xff, 0xb9, 0xdc, 0xe5, 0x4a, 0x51, 0x0d, 0x7f, 0x45, 0xe2, 0x95, 0xd2, 0x60, 0xa2,
+	0x67, 0xf9, 0xd6, 0x61, 0x0c, 0x5c, 0xb9, 0x3d, 0x58, 0x93, 0xc2, 0x47, 0xba, 0x5b, 0x83, 0x75,
+	0x94, 0xd9, 0x84, 0x49, 0x1a, 0x90, 0xd1, 0x2d, 0x49, 0x40, 0x98, 0x8c, 0x5b, 0x96, 0xbe, 0x4d,
+	0x83, 0xfc, 0xb3, 0xc8, 0xd5, 0x1b, 0x69, 0x49, 0x02, 0x1f, 0x82, 0x6c, 0x34, 0x93, 0xae, 0xad,
+	0x65, 0xca, 0xb9, 0xda, 0x6a, 0x65, 0xa0, 0xcb, 0x4a, 0x43, 0x89, 0x50, 0x2c, 0x86, 0xfb, 0x00,
+	0xb6, 0x28, 0xb3, 0xba, 0x54, 0xf6, 0x4c, 0xcf, 0xe7, 0x01, 0x75, 0x88, 0x2f, 0xf4, 0x49, 0x85,
+	0xb8, 0x33, 0x04, 0xb1, 0x1b, 0x17, 0x34, 0x62, 0x3d, 0x5a, 0x6a, 0xfd, 0x76, 0x22, 0xe0, 0x6b,
+	0xb0, 0x80, 0xa5, 0x6d, 0x3a, 0xa4, 0x4b, 0x5c, 0x4b, 0x52, 0xce, 0x84, 0x9e, 0x51, 0xd0, 0x9b,
+	0x43, 0xa0, 0x46, 0x73, 0xa7, 0x7e, 0x21, 0x46, 0x05, 0x2c, 0xed, 0xe4, 0x53, 0xc0, 0x3d, 0x30,
+	0x1f, 0x70, 0x49, 0x99, 0x6b, 0x7a, 0xfc, 0x5d, 0x38, 0xe1, 0xd4, 0x48, 0xd8, 0xbe, 0xd2, 0x36,
+	0x42, 0xe9, 0x6e, 0x03, 0xe5, 0x83, 0xe4, 0x53, 0xc0, 0x03, 0xb0, 0x8c, 0xbb, 0xdc, 0xee, 0x98,
+	0x6d, 0x42, 0xdd, 0xb6, 0x34, 0xed, 0xb6, 0x45, 0x99, 0xd0, 0xa7, 0x15, 0xf0, 0xee, 0xb0, 0xe9,
+	0xc2, 0x8a, 0xe7, 0xaa, 0xc0, 0xc0, 0xac, 0xc9, 0x0d, 0x69, 0xa3, 0x25, 0x9c, 0x1c, 0xee, 0x28,
+	0x08, 0x7c, 0x01, 0x0a, 0x29, 0xd7, 0xdc, 0x17, 0x7a, 0x56, 0x61, 0x37, 0xae, 0x34, 0xcd, 0x7d,
+	0x34, 0x9f, 0x78, 0xe6, 0xbe, 0x80, 0x8f, 0x41, 0x36, 0x4a, 0x5c, 0x9f, 0x51, 0x8c, 0xf5, 0x21,
+	0x8c, 0xa7, 0xa1, 0x68, 0x8f, 0x39, 0xe4, 0x18, 0xc5, 0x05, 0x70, 0x1f, 0xe4, 0x03, 0xcf, 0x74,
+	0x84, 0x34, 0x6d, 0xcb, 0x6e, 0x13, 0x7d, 0x56, 0x01, 0xb6, 0xaf, 0xbe, 0xac, 0x3a, 0x15, 0x72,
+	0x27, 0x2c, 0x31, 0xba, 0xb1, 0x31, 0x04, 0x02, 0xaf, 0x1e, 0x1f, 0x42, 0x0a, 0xae, 0xf7, 0x87,
+	0x6a, 0x4a, 0x7e, 0x88, 0x85, 0xe4, 0x8c, 0x08, 0x7d, 0x4e, 0x35, 0xb9, 0x3f, 0x4e, 0xbc, 0xcd,
+	0xf3, 0x2a, 0x74, 0xad, 0x2f, 0xe7, 0x8b, 0x73, 0x51, 0xfa, 0xa4, 0x81, 0xf9, 0xbe, 0x14, 0xe1,
+	0x3a, 0xc8, 0xa7, 0x73, 0xd3, 0xb5, 0x35, 0xad, 0x3c, 0x85, 0x72, 0xa9, 0x10, 0x20, 0x02, 0x73,
+	0x2d, 0xcf, 0x0c, 0x47, 0xf4, 0x3a, 0xfa, 0xe4, 0x9a, 0x56, 0xce, 0x1b, 0x8f, 0xbe, 0x7e, 0xbf,
+	0x51, 0x73, 0xa9, 0x6c, 0x1f, 0xe1, 0x8a, 0xcd, 0x0f, 0xab, 0xf1, 0x74, 0x2a, 0xf4, 0xf3, 0x8f,
+	0xaa, 0xec, 0x79, 0x44, 0x54, 0x8c, 0xbd, 0xc6, 0xd6, 0xf6, 0x83, 0xc6, 0x11, 0x7e, 0x49, 0x7a,
+	0x68, 0xa6, 0xe5, 0x19, 0xd2, 0x6e, 0x74, 0xc2, 0xb6, 0xe9, 0xcd, 0xd3, 0x33, 0x51, 0xdb, 0xd4,
+	0x4a, 0x95, 0x3e, 0x6a, 0x60, 0x75, 0xe4, 0x25, 0x8e, 0x33, 0x7b, 0x13, 0x2c, 0x84, 0x99, 0x51,
+	0x21, 0x7d, 0x8a, 0x8f, 0xc2, 0xdb, 0x50, 0x0e, 0x72, 0xb5, 0x7b, 0x7f, 0x10, 0x1b, 0x2a, 0x04,
+	0x5e, 0x3d, 0x85, 0x28, 0x51, 0xb0, 0x3c, 0x60, 0x75, 0x61, 0x19, 0x2c, 0xf6, 0xbd, 0x01, 0x8c,
+	0x59, 0x3c, 0x53, 0x01, 0xf7, 0xc9, 0x2f, 0x2b, 0xa5, 0xad, 0x4f, 0x5e, 0x56, 0x4a, 0xbb, 0xf4,
+	0x53, 0x03, 0xf9, 0xf4, 0x3e, 0xc3, 0x3a, 0xc8, 0x50, 0xe7, 0x58, 0x71, 0x73, 0xb5, 0xda, 0x18,
+	0x2f, 0x20, 0x59, 0x84, 0x68, 0x9d, 0xc3, 0xf2, 0x7f, 0x92, 0x69, 0x13, 0x00, 0x87, 0x74, 0xcf,
+	0xa1, 0x99, 0xbf, 0x82, 0xce, 0x3a, 0xa4, 0xab, 0xa8, 0xa5, 0xf7, 0x1a, 0x00, 0xc9, 0x63, 0x84,
+	0x8b, 0x89, 0xfd, 0xa9, 0xc8, 0xca, 0xd8, 0x77, 0x09, 0x9f, 0x80, 0x69, 0xf5, 0x94, 0xf5, 0xcc,
+	0xc8, 0x15, 0x50, 0xdd, 0x2e, 0x36, 0xe0, 0xad, 0xe7, 0x58, 0x92, 0xa0, 0xa8, 0xd2, 0x78, 0xf5,
+	0xf9, 0xb4, 0xa8, 0x9d, 0x9c, 0x16, 0xb5, 0x1f, 0xa7, 0x45, 0xed, 0xc3, 0x59, 0x71, 0xe2, 0xe4,
+	0xac, 0x38, 0xf1, 0xe5, 0xac, 0x38, 0x71, 0x70, 0xa5, 0xcb, 0xe3, 0xf4, 0x1f, 0x8f, 0xb2, 0x8c,
+	0xb3, 0xea, 0x5f, 0x67, 0xeb, 0xd7, 0x00, 0xbd, 0x2f, 0x5b, 0xc1, 0x60, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -497,6 +507,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BtcDelegationTombstones) > 0 {
+		for iNdEx := len(m.BtcDelegationTombstones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegationTombstones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.VpDstCache) > 0 {
 		for iNdEx := len(m.VpDstCache) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -899,6 +923,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.BtcDelegationTombstones) > 0 {
+		for _, e := range m.BtcDelegationTombstones {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1299,6 +1329,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegationTombstones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegationTombstones = append(m.BtcDelegationTombstones, &BTCDelegationTombstone{})
+			if err := m.BtcDelegationTombstones[len(m.BtcDelegationTombstones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -65,9 +65,24 @@ func (dc *VotingPowerDistCache) FilterVotedDistCache(maxActiveFPs uint32, voterB
 	}
 }
 
-// GetFinalityProviderPortion returns the portion of a finality provider's voting power out of the total voting power
+// ApplyRewardWeights records the total voting power of the finality providers
+// scaled by their reward weights, which is then used as the denominator of
+// their portions. It has to be called after setting the reward weights
+func (dc *VotingPowerDistCache) ApplyRewardWeights() {
+	totalWeightedPower := sdkmath.LegacyZeroDec()
+	for _, fp := range dc.FinalityProviders {
+		totalWeightedPower = totalWeightedPower.Add(fp.GetWeightedVotingPower())
+	}
+	dc.TotalWeightedVotingPower = &totalWeightedPower
+}
+
+// GetFinalityProviderPortion returns the portion of a finality provider's voting power out of the total voting power.
+// If the reward weights are applied, the portion is computed over the weighted voting powers instead
 func (dc *VotingPowerDistCache) GetFinalityProviderPortion(v *FinalityProviderDistInfo) sdkmath.LegacyDec {
-	return sdkmath.LegacyNewDec(int64(v.TotalVotingPower)).QuoTruncate(sdkmath.LegacyNewDec(int64(dc.TotalVotingPower)))
+	if dc.TotalWeightedVotingPower == nil {
+		return sdkmath.LegacyNewDec(int64(v.TotalVotingPower)).QuoTruncate(sdkmath.LegacyNewDec(int64(dc.TotalVotingPower)))
+	}
+	return v.GetWeightedVotingPower().QuoTruncate(*dc.TotalWeightedVotingPower)
 }
 
 func NewFinalityProviderDistInfo(fp *FinalityProvider) *FinalityProviderDistInfo {
@@ -84,12 +99,23 @@ func (v *FinalityProviderDistInfo) GetAddress() sdk.AccAddress {
 	return sdk.AccAddress(v.BabylonPk.Address())
 }
 
+// GetWeightedVotingPower returns the voting power of the finality provider
+// scaled by its reward weight, or its voting power if it has no reward weight
+func (v *FinalityProviderDistInfo) GetWeightedVotingPower() sdkmath.LegacyDec {
+	power := sdkmath.LegacyNewDec(int64(v.TotalVotingPower))
+	if v.RewardWeight == nil {
+		return power
+	}
+	return power.Mul(*v.RewardWeight)
+}
+
 func (v *FinalityProviderDistInfo) AddBTCDel(btcDel *BTCDelegation) {
 	btcDelDistInfo := &BTCDelDistInfo{
 		BtcPk:         btcDel.BtcPk,
 		BabylonPk:     btcDel.BabylonPk,
 		StakingTxHash: btcDel.MustGetStakingTxHash().String(),
 		VotingPower:   btcDel.TotalSat,
+		RewardOptOut:  btcDel.RewardOptOut,
 	}
 	v.BtcDels = append(v.BtcDels, btcDelDistInfo)
 	v.TotalVotingPower += btcDelDistInfo.VotingPower
@@ -106,6 +132,25 @@ func (v *FinalityProviderDistInfo) GetBTCDelPortion(d *BTCDelDistInfo) sdkmath.L
 	return sdkmath.LegacyNewDec(int64(d.VotingPower)).QuoTruncate(sdkmath.LegacyNewDec(int64(v.TotalVotingPower)))
 }
 
+// GetRewardedVotingPower returns the total voting power of the finality
+// provider's BTC delegations that do not opt out of rewards
+func (v *FinalityProviderDistInfo) GetRewardedVotingPower() uint64 {
+	rewardedPower := uint64(0)
+	for _, d := range v.BtcDels {
+		if !d.RewardOptOut {
+			rewardedPower += d.VotingPower
+		}
+	}
+	return rewardedPower
+}
+
+// GetBTCDelRewardedPortion returns the portion of a BTC delegation's voting
+// power out of the total voting power of the finality provider's BTC
+// delegations that do not opt out of rewards
+func (v *FinalityProviderDistInfo) GetBTCDelRewardedPortion(d *BTCDelDistInfo) sdkmath.LegacyDec {
+	return sdkmath.LegacyNewDec(int64(d.VotingPower)).QuoTruncate(sdkmath.LegacyNewDec(int64(v.GetRewardedVotingPower())))
+}
+
 func (d *BTCDelDistInfo) GetAddress() sdk.AccAddress {
 	return sdk.AccAddress(d.BabylonPk.Address())
 }
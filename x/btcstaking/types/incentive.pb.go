@@ -33,6 +33,10 @@ type VotingPowerDistCache struct {
 	TotalVotingPower uint64 `protobuf:"varint,1,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
 	// finality_providers is a list of finality providers' voting power information
 	FinalityProviders []*FinalityProviderDistInfo `protobuf:"bytes,2,rep,name=finality_providers,json=finalityProviders,proto3" json:"finality_providers,omitempty"`
+	// total_weighted_voting_power is the total voting power of the finality
+	// providers scaled by their reward weights. It is set together with the
+	// reward weights, and is nil if no finality provider has a reward weight
+	TotalWeightedVotingPower *cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=total_weighted_voting_power,json=totalWeightedVotingPower,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"total_weighted_voting_power,omitempty"`
 }
 
 func (m *VotingPowerDistCache) Reset()         { *m = VotingPowerDistCache{} }
@@ -95,6 +99,10 @@ type FinalityProviderDistInfo struct {
 	TotalVotingPower uint64 `protobuf:"varint,4,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
 	// btc_dels is a list of BTC delegations' voting power information under this finality provider
 	BtcDels []*BTCDelDistInfo `protobuf:"bytes,5,rep,name=btc_dels,json=btcDels,proto3" json:"btc_dels,omitempty"`
+	// reward_weight scales the voting power of the finality provider when
+	// computing its share of rewards. It is set by the finality module upon
+	// finalising a block, and a nil weight is treated as 1
+	RewardWeight *cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=reward_weight,json=rewardWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reward_weight,omitempty"`
 }
 
 func (m *FinalityProviderDistInfo) Reset()         { *m = FinalityProviderDistInfo{} }
@@ -162,6 +170,8 @@ type BTCDelDistInfo struct {
 	StakingTxHash string `protobuf:"bytes,3,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
 	// voting_power is the voting power of the BTC delegation
 	VotingPower uint64 `protobuf:"varint,4,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// reward_opt_out is whether the BTC delegation opts out of rewards
+	RewardOptOut bool `protobuf:"varint,5,opt,name=reward_opt_out,json=rewardOptOut,proto3" json:"reward_opt_out,omitempty"`
 }
 
 func (m *BTCDelDistInfo) Reset()         { *m = BTCDelDistInfo{} }
@@ -218,6 +228,13 @@ func (m *BTCDelDistInfo) GetVotingPower() uint64 {
 	return 0
 }
 
+func (m *BTCDelDistInfo) GetRewardOptOut() bool {
+	if m != nil {
+		return m.RewardOptOut
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*VotingPowerDistCache)(nil), "babylon.btcstaking.v1.VotingPowerDistCache")
 	proto.RegisterType((*FinalityProviderDistInfo)(nil), "babylon.btcstaking.v1.FinalityProviderDistInfo")
@@ -229,39 +246,43 @@ func init() {
 }
 
 var fileDescriptor_ac354c3bd6d7a66b = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x9b, 0xee, 0x0f, 0xcc, 0x1d, 0xff, 0xa2, 0x21, 0x95, 0x21, 0xa5, 0xa5, 0xd2, 0x50,
-	0x0f, 0xcc, 0xa6, 0x1d, 0xec, 0x88, 0x50, 0x16, 0x21, 0x26, 0x36, 0x29, 0x8a, 0x26, 0x0e, 0x1c,
-	0x88, 0x1c, 0xd7, 0x4d, 0xac, 0x24, 0x76, 0x54, 0x7b, 0xa1, 0xf9, 0x16, 0x7c, 0x08, 0x3e, 0x02,
-	0x1f, 0x82, 0xe3, 0xc4, 0x09, 0xed, 0x30, 0xa1, 0xf6, 0x82, 0xf8, 0x14, 0x28, 0x89, 0xd9, 0x0a,
-	0x5a, 0xc5, 0x95, 0x5b, 0xde, 0x3c, 0xcf, 0xfb, 0xbe, 0x7e, 0x7e, 0x96, 0xc1, 0x4e, 0x80, 0x83,
-	0x22, 0x11, 0x1c, 0x05, 0x8a, 0x48, 0x85, 0x63, 0xc6, 0x43, 0x94, 0x0f, 0x10, 0xe3, 0x84, 0x72,
-	0xc5, 0x72, 0x0a, 0xb3, 0x89, 0x50, 0xc2, 0xbc, 0xaf, 0x6d, 0xf0, 0xca, 0x06, 0xf3, 0xc1, 0xf6,
-	0x56, 0x28, 0x42, 0x51, 0x39, 0x50, 0xf9, 0x55, 0x9b, 0xb7, 0x1f, 0x10, 0x21, 0x53, 0x21, 0xfd,
-	0x5a, 0xa8, 0x0b, 0x2d, 0xf5, 0xea, 0x0a, 0x91, 0x49, 0x91, 0x29, 0x81, 0x24, 0x25, 0xd9, 0xf0,
-	0xf9, 0x7e, 0x3c, 0x40, 0x31, 0x2d, 0xb4, 0xa7, 0xf7, 0xc9, 0x00, 0x5b, 0x6f, 0x85, 0x62, 0x3c,
-	0x74, 0xc5, 0x07, 0x3a, 0x71, 0x98, 0x54, 0x07, 0x98, 0x44, 0xd4, 0x7c, 0x02, 0x4c, 0x25, 0x14,
-	0x4e, 0xfc, 0xbc, 0x52, 0xfd, 0xac, 0x94, 0xdb, 0x46, 0xd7, 0xe8, 0xaf, 0x7a, 0x77, 0x2b, 0x65,
-	0xa1, 0xcd, 0x7c, 0x0f, 0xcc, 0x31, 0xe3, 0x38, 0x61, 0xaa, 0x28, 0x4f, 0x92, 0xb3, 0x11, 0x9d,
-	0xc8, 0x76, 0xb3, 0xbb, 0xd2, 0x6f, 0x0d, 0x11, 0xbc, 0x36, 0x0f, 0x7c, 0xa5, 0x1b, 0x5c, 0xed,
-	0x2f, 0x77, 0x1f, 0xf2, 0xb1, 0xf0, 0xee, 0x8d, 0xff, 0x52, 0x64, 0xef, 0x47, 0x13, 0xb4, 0x97,
-	0xf9, 0xcd, 0x63, 0xb0, 0x1e, 0x28, 0xe2, 0x67, 0x71, 0x75, 0xbc, 0x4d, 0x7b, 0xff, 0xfc, 0xa2,
-	0x33, 0x0c, 0x99, 0x8a, 0x4e, 0x03, 0x48, 0x44, 0x8a, 0xf4, 0x7a, 0x12, 0x61, 0xc6, 0x7f, 0x17,
-	0x48, 0x15, 0x19, 0x95, 0xd0, 0x3e, 0x74, 0xf7, 0x9e, 0x3d, 0x75, 0x4f, 0x83, 0x37, 0xb4, 0xf0,
-	0xd6, 0x02, 0x45, 0xdc, 0xd8, 0x7c, 0x01, 0x80, 0x36, 0x95, 0x23, 0x9b, 0x5d, 0xa3, 0xdf, 0x1a,
-	0x76, 0xa0, 0x26, 0x5b, 0xb3, 0x84, 0x97, 0x2c, 0xa1, 0xee, 0xdd, 0xd0, 0x2d, 0x6e, 0x6c, 0x1e,
-	0x03, 0x40, 0x44, 0x9a, 0x32, 0x29, 0x99, 0xe0, 0xed, 0x95, 0xae, 0xd1, 0xdf, 0xb0, 0x77, 0xcf,
-	0x2f, 0x3a, 0x0f, 0xeb, 0x11, 0x72, 0x14, 0x43, 0x26, 0x50, 0x8a, 0x55, 0x04, 0x8f, 0x68, 0x88,
-	0x49, 0xe1, 0x50, 0xf2, 0xf5, 0xf3, 0x2e, 0xd0, 0x1b, 0x1c, 0x4a, 0xbc, 0x85, 0x01, 0x4b, 0x2e,
-	0x62, 0x75, 0xc9, 0x45, 0xbc, 0x04, 0x37, 0x4b, 0x16, 0x23, 0x9a, 0xc8, 0xf6, 0x5a, 0x85, 0x7f,
-	0x67, 0x09, 0x7e, 0xfb, 0xe4, 0xc0, 0xa1, 0xc9, 0x25, 0xf4, 0x1b, 0x81, 0x22, 0x0e, 0x4d, 0x64,
-	0xef, 0xa7, 0x01, 0x6e, 0xff, 0xa9, 0xfd, 0x6f, 0x80, 0x1f, 0x83, 0x3b, 0x3a, 0x87, 0xaf, 0xa6,
-	0x7e, 0x84, 0x65, 0x54, 0x53, 0xf6, 0x6e, 0xe9, 0xdf, 0x27, 0xd3, 0xd7, 0x58, 0x46, 0xe6, 0x23,
-	0xb0, 0x79, 0x0d, 0xb3, 0x56, 0x7e, 0x85, 0xcb, 0x3e, 0xfa, 0x32, 0xb3, 0x8c, 0xb3, 0x99, 0x65,
-	0x7c, 0x9f, 0x59, 0xc6, 0xc7, 0xb9, 0xd5, 0x38, 0x9b, 0x5b, 0x8d, 0x6f, 0x73, 0xab, 0xf1, 0xee,
-	0x9f, 0xf9, 0xa6, 0x8b, 0xaf, 0xb8, 0x0a, 0x1b, 0xac, 0x57, 0x6f, 0x6a, 0xef, 0x57, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x0c, 0xa8, 0xb2, 0x95, 0xe8, 0x03, 0x00, 0x00,
+	// 570 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x97, 0x6e, 0x2d, 0x9b, 0xdb, 0x0d, 0x88, 0x86, 0x14, 0x36, 0x29, 0x2d, 0x15, 0x43,
+	0x3d, 0x30, 0x87, 0x76, 0xb0, 0x23, 0x42, 0x5d, 0x85, 0x98, 0xd8, 0xb4, 0x28, 0x9a, 0x40, 0xe2,
+	0x40, 0xe4, 0xb8, 0x6e, 0x62, 0x25, 0x8d, 0xa3, 0xd8, 0x4d, 0x9b, 0xb7, 0x98, 0x78, 0x16, 0x8e,
+	0x3c, 0x00, 0xc7, 0x89, 0x13, 0xda, 0x61, 0x42, 0xed, 0x8b, 0xa0, 0x24, 0xde, 0xe8, 0xd0, 0x2a,
+	0xa4, 0x9d, 0xb8, 0xc5, 0xf9, 0xff, 0xbf, 0xcf, 0xdf, 0xf7, 0xff, 0x49, 0x06, 0x3b, 0x0e, 0x72,
+	0xd2, 0x80, 0x85, 0x86, 0x23, 0x30, 0x17, 0xc8, 0xa7, 0xa1, 0x6b, 0x24, 0x6d, 0x83, 0x86, 0x98,
+	0x84, 0x82, 0x26, 0x04, 0x46, 0x31, 0x13, 0x4c, 0x7d, 0x24, 0x6d, 0xf0, 0x8f, 0x0d, 0x26, 0xed,
+	0xad, 0x4d, 0x97, 0xb9, 0x2c, 0x77, 0x18, 0xd9, 0x57, 0x61, 0xde, 0x7a, 0x8c, 0x19, 0x1f, 0x32,
+	0x6e, 0x17, 0x42, 0x71, 0x90, 0x52, 0xb3, 0x38, 0x19, 0x38, 0x4e, 0x23, 0xc1, 0x0c, 0x4e, 0x70,
+	0xd4, 0x79, 0xb5, 0xef, 0xb7, 0x0d, 0x9f, 0xa4, 0xd2, 0xd3, 0xfc, 0x52, 0x02, 0x9b, 0x1f, 0x98,
+	0xa0, 0xa1, 0x6b, 0xb2, 0x31, 0x89, 0x7b, 0x94, 0x8b, 0x03, 0x84, 0x3d, 0xa2, 0x3e, 0x07, 0xaa,
+	0x60, 0x02, 0x05, 0x76, 0x92, 0xab, 0x76, 0x94, 0xc9, 0x9a, 0xd2, 0x50, 0x5a, 0x2b, 0xd6, 0x83,
+	0x5c, 0x99, 0x2b, 0x53, 0x3f, 0x03, 0x75, 0x40, 0x43, 0x14, 0x50, 0x91, 0x66, 0x93, 0x24, 0xb4,
+	0x4f, 0x62, 0xae, 0x95, 0x1a, 0xcb, 0xad, 0x6a, 0xc7, 0x80, 0xb7, 0xee, 0x03, 0xdf, 0xca, 0x02,
+	0x53, 0xfa, 0xb3, 0xbb, 0x0f, 0xc3, 0x01, 0xb3, 0x1e, 0x0e, 0xfe, 0x52, 0xb8, 0x1a, 0x80, 0xed,
+	0x62, 0x9a, 0x31, 0xa1, 0xae, 0x27, 0x48, 0xff, 0xe6, 0x58, 0xcb, 0x0d, 0xa5, 0xb5, 0xd6, 0xdd,
+	0xbd, 0xb8, 0xac, 0x6f, 0x17, 0x3b, 0xf3, 0xbe, 0x0f, 0x29, 0x33, 0x86, 0x48, 0x78, 0xf0, 0x88,
+	0xb8, 0x08, 0xa7, 0x3d, 0x82, 0x7f, 0x7c, 0xdd, 0x05, 0x32, 0xa0, 0x1e, 0xc1, 0x96, 0x96, 0x77,
+	0xfc, 0x28, 0x1b, 0xce, 0x6d, 0xd3, 0xfc, 0xb6, 0x0c, 0xb4, 0x45, 0xd3, 0xa9, 0xc7, 0xa0, 0xe2,
+	0x08, 0x6c, 0x47, 0x7e, 0x1e, 0x46, 0xad, 0xbb, 0x7f, 0x71, 0x59, 0xef, 0xb8, 0x54, 0x78, 0x23,
+	0x07, 0x62, 0x36, 0x34, 0xe4, 0xb2, 0xd8, 0x43, 0x34, 0xbc, 0x3a, 0x18, 0x22, 0x8d, 0x08, 0x87,
+	0xdd, 0x43, 0x73, 0xef, 0xe5, 0x0b, 0x73, 0xe4, 0xbc, 0x27, 0xa9, 0x55, 0x76, 0x04, 0x36, 0x7d,
+	0xf5, 0x35, 0x00, 0xd2, 0x94, 0xb5, 0x2c, 0x35, 0x94, 0x56, 0xb5, 0x53, 0x87, 0x72, 0xcc, 0x82,
+	0x1c, 0xbc, 0x26, 0x07, 0x65, 0xed, 0x9a, 0x2c, 0x31, 0x7d, 0xf5, 0x18, 0x00, 0xcc, 0x86, 0x43,
+	0xca, 0x39, 0x65, 0xe1, 0xdd, 0x82, 0x98, 0x6b, 0xb0, 0x00, 0xfb, 0xca, 0x02, 0xec, 0x6f, 0xc0,
+	0x6a, 0x96, 0x45, 0x9f, 0x04, 0x5c, 0x2b, 0xe7, 0xb0, 0x77, 0x16, 0xc0, 0xee, 0x9e, 0x1e, 0xf4,
+	0x48, 0x70, 0x8d, 0xf8, 0x9e, 0x23, 0x70, 0x8f, 0x04, 0x5c, 0xb5, 0xc0, 0x7a, 0x4c, 0xc6, 0x28,
+	0xee, 0x4b, 0xb2, 0x5a, 0xe5, 0x2e, 0x1b, 0xd4, 0x8a, 0x1e, 0x05, 0xcb, 0xe6, 0x59, 0x09, 0x6c,
+	0xdc, 0xbc, 0xef, 0x7f, 0x83, 0xf6, 0x0c, 0xdc, 0x97, 0xd9, 0xd8, 0x62, 0x62, 0x7b, 0x88, 0x7b,
+	0x05, 0x39, 0x6b, 0x5d, 0xfe, 0x3e, 0x9d, 0xbc, 0x43, 0xdc, 0x53, 0x9f, 0x80, 0xda, 0x2d, 0x1c,
+	0xaa, 0xc9, 0x1c, 0x82, 0xa7, 0x60, 0x43, 0x06, 0xc8, 0x22, 0x61, 0xb3, 0x91, 0xd0, 0xca, 0x0d,
+	0xa5, 0xb5, 0x7a, 0x15, 0xc9, 0x49, 0x24, 0x4e, 0x46, 0xa2, 0x7b, 0xf4, 0x7d, 0xaa, 0x2b, 0xe7,
+	0x53, 0x5d, 0xf9, 0x35, 0xd5, 0x95, 0xb3, 0x99, 0xbe, 0x74, 0x3e, 0xd3, 0x97, 0x7e, 0xce, 0xf4,
+	0xa5, 0x4f, 0xff, 0x4c, 0x61, 0x32, 0xff, 0x5a, 0xe5, 0x91, 0x38, 0x95, 0xfc, 0xed, 0xd8, 0xfb,
+	0x3d, 0x00, 0xf9, 0xdf, 0x3e, 0x36, 0xd0, 0x04, 0x00, 0x00,
 }
 
 func (m *VotingPowerDistCache) Marshal() (dAtA []byte, err error) {
@@ -284,6 +305,18 @@ func (m *VotingPowerDistCache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TotalWeightedVotingPower != nil {
+		{
+			size := m.TotalWeightedVotingPower.Size()
+			i -= size
+			if _, err := m.TotalWeightedVotingPower.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintIncentive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.FinalityProviders) > 0 {
 		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -326,6 +359,18 @@ func (m *FinalityProviderDistInfo) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.RewardWeight != nil {
+		{
+			size := m.RewardWeight.Size()
+			i -= size
+			if _, err := m.RewardWeight.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintIncentive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.BtcDels) > 0 {
 		for iNdEx := len(m.BtcDels) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -404,6 +449,16 @@ func (m *BTCDelDistInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RewardOptOut {
+		i--
+		if m.RewardOptOut {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.VotingPower != 0 {
 		i = encodeVarintIncentive(dAtA, i, uint64(m.VotingPower))
 		i--
@@ -469,6 +524,10 @@ func (m *VotingPowerDistCache) Size() (n int) {
 			n += 1 + l + sovIncentive(uint64(l))
 		}
 	}
+	if m.TotalWeightedVotingPower != nil {
+		l = m.TotalWeightedVotingPower.Size()
+		n += 1 + l + sovIncentive(uint64(l))
+	}
 	return n
 }
 
@@ -499,6 +558,10 @@ func (m *FinalityProviderDistInfo) Size() (n int) {
 			n += 1 + l + sovIncentive(uint64(l))
 		}
 	}
+	if m.RewardWeight != nil {
+		l = m.RewardWeight.Size()
+		n += 1 + l + sovIncentive(uint64(l))
+	}
 	return n
 }
 
@@ -523,6 +586,9 @@ func (m *BTCDelDistInfo) Size() (n int) {
 	if m.VotingPower != 0 {
 		n += 1 + sovIncentive(uint64(m.VotingPower))
 	}
+	if m.RewardOptOut {
+		n += 2
+	}
 	return n
 }
 
@@ -614,6 +680,42 @@ func (m *VotingPowerDistCache) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeightedVotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.TotalWeightedVotingPower = &v
+			if err := m.TotalWeightedVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIncentive(dAtA[iNdEx:])
@@ -824,6 +926,42 @@ func (m *FinalityProviderDistInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.RewardWeight = &v
+			if err := m.RewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIncentive(dAtA[iNdEx:])
@@ -996,6 +1134,26 @@ func (m *BTCDelDistInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardOptOut", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RewardOptOut = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIncentive(dAtA[iNdEx:])
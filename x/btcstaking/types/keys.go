@@ -15,12 +15,17 @@ const (
 )
 
 var (
-	ParamsKey               = []byte{0x01} // key prefix for the parameters
-	FinalityProviderKey     = []byte{0x02} // key prefix for the finality providers
-	BTCDelegatorKey         = []byte{0x03} // key prefix for the BTC delegators
-	BTCDelegationKey        = []byte{0x04} // key prefix for the BTC delegations
-	VotingPowerKey          = []byte{0x05} // key prefix for the voting power
-	BTCHeightKey            = []byte{0x06} // key prefix for the BTC heights
-	VotingPowerDistCacheKey = []byte{0x07} // key prefix for voting power distribution cache
-	PowerDistUpdateKey      = []byte{0x08} // key prefix for power distribution update events
+	ParamsKey                       = []byte{0x01} // key prefix for the parameters
+	FinalityProviderKey             = []byte{0x02} // key prefix for the finality providers
+	BTCDelegatorKey                 = []byte{0x03} // key prefix for the BTC delegators
+	BTCDelegationKey                = []byte{0x04} // key prefix for the BTC delegations
+	VotingPowerKey                  = []byte{0x05} // key prefix for the voting power
+	BTCHeightKey                    = []byte{0x06} // key prefix for the BTC heights
+	VotingPowerDistCacheKey         = []byte{0x07} // key prefix for voting power distribution cache
+	PowerDistUpdateKey              = []byte{0x08} // key prefix for power distribution update events
+	BTCRollBackHeightKey            = []byte{0x09} // key for the lowest BTC height rolled back to since the last BeginBlock
+	BTCDelegationInclusionHeightKey = []byte{0x0A} // key prefix for the BTC delegations indexed by the BTC height of their staking tx
+	PowerDistFinalizationTimeoutKey = []byte{0x0B} // key for the w value the voting power distribution was last computed under
+	BTCDelegationTombstoneKey       = []byte{0x0C} // key prefix for the tombstones of pruned BTC delegations
+	ReservedBTCDelegationKey        = []byte{0x0D} // key prefix for the reserved BTC delegations indexed by their reservation expiry BTC height
 )
@@ -13,6 +13,8 @@ import (
 	types0 "github.com/babylonchain/babylon/x/btccheckpoint/types"
 	types1 "github.com/babylonchain/babylon/x/btclightclient/types"
 	types2 "github.com/babylonchain/babylon/x/epoching/types"
+	chainhash "github.com/btcsuite/btcd/chaincfg/chainhash"
+	types3 "github.com/cosmos/cosmos-sdk/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -182,3 +184,106 @@ func (mr *MockCheckpointingKeeperMockRecorder) GetLastFinalizedEpoch(ctx interfa
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastFinalizedEpoch", reflect.TypeOf((*MockCheckpointingKeeper)(nil).GetLastFinalizedEpoch), ctx)
 }
+
+// MockIncentiveKeeper is a mock of IncentiveKeeper interface.
+type MockIncentiveKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockIncentiveKeeperMockRecorder
+}
+
+// MockIncentiveKeeperMockRecorder is the mock recorder for MockIncentiveKeeper.
+type MockIncentiveKeeperMockRecorder struct {
+	mock *MockIncentiveKeeper
+}
+
+// NewMockIncentiveKeeper creates a new mock instance.
+func NewMockIncentiveKeeper(ctrl *gomock.Controller) *MockIncentiveKeeper {
+	mock := &MockIncentiveKeeper{ctrl: ctrl}
+	mock.recorder = &MockIncentiveKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIncentiveKeeper) EXPECT() *MockIncentiveKeeperMockRecorder {
+	return m.recorder
+}
+
+// DeleteBTCDelRewardStartEpoch mocks base method.
+func (m *MockIncentiveKeeper) DeleteBTCDelRewardStartEpoch(ctx context.Context, stakingTxHash *chainhash.Hash) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteBTCDelRewardStartEpoch", ctx, stakingTxHash)
+}
+
+// DeleteBTCDelRewardStartEpoch indicates an expected call of DeleteBTCDelRewardStartEpoch.
+func (mr *MockIncentiveKeeperMockRecorder) DeleteBTCDelRewardStartEpoch(ctx, stakingTxHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBTCDelRewardStartEpoch", reflect.TypeOf((*MockIncentiveKeeper)(nil).DeleteBTCDelRewardStartEpoch), ctx, stakingTxHash)
+}
+
+// GetBTCDelRewardLockup mocks base method.
+func (m *MockIncentiveKeeper) GetBTCDelRewardLockup(ctx context.Context, stakingTxHash *chainhash.Hash) (uint64, bool, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBTCDelRewardLockup", ctx, stakingTxHash)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(bool)
+	return ret0, ret1, ret2
+}
+
+// GetBTCDelRewardLockup indicates an expected call of GetBTCDelRewardLockup.
+func (mr *MockIncentiveKeeperMockRecorder) GetBTCDelRewardLockup(ctx, stakingTxHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBTCDelRewardLockup", reflect.TypeOf((*MockIncentiveKeeper)(nil).GetBTCDelRewardLockup), ctx, stakingTxHash)
+}
+
+// GetRewardLockupEpochs mocks base method.
+func (m *MockIncentiveKeeper) GetRewardLockupEpochs(ctx context.Context) uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRewardLockupEpochs", ctx)
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// GetRewardLockupEpochs indicates an expected call of GetRewardLockupEpochs.
+func (mr *MockIncentiveKeeperMockRecorder) GetRewardLockupEpochs(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRewardLockupEpochs", reflect.TypeOf((*MockIncentiveKeeper)(nil).GetRewardLockupEpochs), ctx)
+}
+
+// IsBTCDelegationRewardFullyWithdrawn mocks base method.
+func (m *MockIncentiveKeeper) IsBTCDelegationRewardFullyWithdrawn(ctx context.Context, stakerAddr types3.AccAddress, stakingTxHash string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBTCDelegationRewardFullyWithdrawn", ctx, stakerAddr, stakingTxHash)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsBTCDelegationRewardFullyWithdrawn indicates an expected call of IsBTCDelegationRewardFullyWithdrawn.
+func (mr *MockIncentiveKeeperMockRecorder) IsBTCDelegationRewardFullyWithdrawn(ctx, stakerAddr, stakingTxHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBTCDelegationRewardFullyWithdrawn", reflect.TypeOf((*MockIncentiveKeeper)(nil).IsBTCDelegationRewardFullyWithdrawn), ctx, stakerAddr, stakingTxHash)
+}
+
+// PruneBTCDelegationRewardRecords mocks base method.
+func (m *MockIncentiveKeeper) PruneBTCDelegationRewardRecords(ctx context.Context, stakingTxHash string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PruneBTCDelegationRewardRecords", ctx, stakingTxHash)
+}
+
+// PruneBTCDelegationRewardRecords indicates an expected call of PruneBTCDelegationRewardRecords.
+func (mr *MockIncentiveKeeperMockRecorder) PruneBTCDelegationRewardRecords(ctx, stakingTxHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneBTCDelegationRewardRecords", reflect.TypeOf((*MockIncentiveKeeper)(nil).PruneBTCDelegationRewardRecords), ctx, stakingTxHash)
+}
+
+// PruneDelegatorValidatorRewards mocks base method.
+func (m *MockIncentiveKeeper) PruneDelegatorValidatorRewards(ctx context.Context, delBTCPK, fpBTCPK *types.BIP340PubKey) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PruneDelegatorValidatorRewards", ctx, delBTCPK, fpBTCPK)
+}
+
+// PruneDelegatorValidatorRewards indicates an expected call of PruneDelegatorValidatorRewards.
+func (mr *MockIncentiveKeeperMockRecorder) PruneDelegatorValidatorRewards(ctx, delBTCPK, fpBTCPK interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneDelegatorValidatorRewards", reflect.TypeOf((*MockIncentiveKeeper)(nil).PruneDelegatorValidatorRewards), ctx, delBTCPK, fpBTCPK)
+}
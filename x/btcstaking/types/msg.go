@@ -13,13 +13,23 @@ import (
 // ensure that these message types implement the sdk.Msg interface
 var (
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgPruneInactiveDelegations{}
 	_ sdk.Msg = &MsgCreateFinalityProvider{}
 	_ sdk.Msg = &MsgEditFinalityProvider{}
 	_ sdk.Msg = &MsgCreateBTCDelegation{}
 	_ sdk.Msg = &MsgAddCovenantSigs{}
+	_ sdk.Msg = &MsgCreateBTCDelegationWithCovenantSigs{}
 	_ sdk.Msg = &MsgBTCUndelegate{}
+	_ sdk.Msg = &MsgActivateReservedDelegation{}
+	_ sdk.Msg = &MsgAddSlashingTxInclusionProof{}
 )
 
+// MaxStakingTimeBlocks is the max timelock of a staking tx in BTC blocks, i.e.,
+// about 455 days. The timelock is enforced by OP_CHECKSEQUENCEVERIFY, whose
+// block-based relative timelock is a 16-bit value as per BIP-68, so a longer
+// timelock cannot be expressed by the staking script
+const MaxStakingTimeBlocks = math.MaxUint16
+
 func (m *MsgCreateFinalityProvider) ValidateBasic() error {
 	if m.Commission == nil {
 		return fmt.Errorf("empty commission")
@@ -106,6 +116,9 @@ func (m *MsgCreateBTCDelegation) ValidateBasic() error {
 		return fmt.Errorf("empty delegator signature")
 	}
 
+	if err := validateSigHashType(m.DelegatorSlashingSig); err != nil {
+		return err
+	}
 	if _, err := m.DelegatorSlashingSig.ToBTCSig(); err != nil {
 		return fmt.Errorf("invalid delegator slashing signature: %w", err)
 	}
@@ -114,9 +127,12 @@ func (m *MsgCreateBTCDelegation) ValidateBasic() error {
 		return err
 	}
 
-	// Check staking time is at most uint16
-	if m.StakingTime > math.MaxUint16 {
-		return ErrInvalidStakingTx.Wrapf("invalid lock time: %d, max: %d", m.StakingTime, math.MaxUint16)
+	// Check staking time is positive and at most uint16
+	if m.StakingTime == 0 {
+		return ErrInvalidStakingTx.Wrap("staking time must be positive")
+	}
+	if m.StakingTime > MaxStakingTimeBlocks {
+		return ErrInvalidStakingTx.Wrapf("staking time %d exceeds the max timelock of %d BTC blocks", m.StakingTime, MaxStakingTimeBlocks)
 	}
 	// Ensure list of finality provider BTC PKs is not empty
 	if len(m.FpBtcPkList) == 0 {
@@ -127,8 +143,16 @@ func (m *MsgCreateBTCDelegation) ValidateBasic() error {
 		return ErrDuplicatedFp
 	}
 
-	// staking tx should be correctly formatted
-	if err := m.StakingTx.ValidateBasic(); err != nil {
+	// staking tx should be correctly formatted. A reserved BTC delegation
+	// carries the staking tx only, whose inclusion proof is submitted later
+	if m.Reserved {
+		if m.StakingTx.Transaction == nil {
+			return fmt.Errorf("transaction in TransactionInfo is nil")
+		}
+		if m.StakingTx.Key != nil || m.StakingTx.Proof != nil {
+			return fmt.Errorf("reserved BTC delegation cannot carry the inclusion proof of its staking tx")
+		}
+	} else if err := m.StakingTx.ValidateBasic(); err != nil {
 		return err
 	}
 	if err := m.Pop.ValidateBasic(); err != nil {
@@ -150,6 +174,9 @@ func (m *MsgCreateBTCDelegation) ValidateBasic() error {
 		return fmt.Errorf("invalid unbonding slashing tx: %w", err)
 	}
 
+	if err := validateSigHashType(m.DelegatorUnbondingSlashingSig); err != nil {
+		return err
+	}
 	if _, err := m.DelegatorUnbondingSlashingSig.ToBTCSig(); err != nil {
 		return fmt.Errorf("invalid delegator unbonding slashing signature: %w", err)
 	}
@@ -189,6 +216,9 @@ func (m *MsgAddCovenantSigs) ValidateBasic() error {
 		return fmt.Errorf("empty covenant signature")
 	}
 
+	if err := validateSigHashType(m.UnbondingTxSig); err != nil {
+		return err
+	}
 	if _, err := m.UnbondingTxSig.ToBTCSig(); err != nil {
 		return fmt.Errorf("invalid covenant unbonding signature: %w", err)
 	}
@@ -200,6 +230,49 @@ func (m *MsgAddCovenantSigs) ValidateBasic() error {
 	return nil
 }
 
+func (m *MsgCreateBTCDelegationWithCovenantSigs) ValidateBasic() error {
+	if m.BtcDel == nil {
+		return fmt.Errorf("empty BTC delegation")
+	}
+	if m.BtcDel.Signer != m.Signer {
+		return fmt.Errorf("signer of the BTC delegation %s does not match the signer %s", m.BtcDel.Signer, m.Signer)
+	}
+	if err := m.BtcDel.ValidateBasic(); err != nil {
+		return err
+	}
+	if len(m.CovenantSigs) == 0 {
+		return fmt.Errorf("empty covenant signatures")
+	}
+
+	stakingTx, err := bbn.NewBTCTxFromBytes(m.BtcDel.StakingTx.Transaction)
+	if err != nil {
+		return fmt.Errorf("invalid staking tx: %w", err)
+	}
+	stakingTxHash := stakingTx.TxHash().String()
+	covPKs := map[string]struct{}{}
+	for _, covSigs := range m.CovenantSigs {
+		if covSigs == nil {
+			return fmt.Errorf("empty covenant signatures")
+		}
+		if covSigs.Signer != m.Signer {
+			return fmt.Errorf("signer of the covenant signatures %s does not match the signer %s", covSigs.Signer, m.Signer)
+		}
+		if err := covSigs.ValidateBasic(); err != nil {
+			return err
+		}
+		if covSigs.StakingTxHash != stakingTxHash {
+			return fmt.Errorf("staking tx hash of the covenant signatures %s does not match that of the BTC delegation %s", covSigs.StakingTxHash, stakingTxHash)
+		}
+		covPKHex := covSigs.Pk.MarshalHex()
+		if _, ok := covPKs[covPKHex]; ok {
+			return fmt.Errorf("duplicated covenant signatures from %s", covPKHex)
+		}
+		covPKs[covPKHex] = struct{}{}
+	}
+
+	return nil
+}
+
 func (m *MsgBTCUndelegate) ValidateBasic() error {
 	if len(m.StakingTxHash) != chainhash.MaxHashStringSize {
 		return fmt.Errorf("staking tx hash is not %d", chainhash.MaxHashStringSize)
@@ -209,9 +282,47 @@ func (m *MsgBTCUndelegate) ValidateBasic() error {
 		return fmt.Errorf("empty signature from the delegator")
 	}
 
+	if err := validateSigHashType(m.UnbondingTxSig); err != nil {
+		return err
+	}
 	if _, err := m.UnbondingTxSig.ToBTCSig(); err != nil {
 		return fmt.Errorf("invalid delegator unbonding signature: %w", err)
 	}
 
 	return nil
 }
+
+func (m *MsgActivateReservedDelegation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return err
+	}
+	if len(m.StakingTxHash) != chainhash.MaxHashStringSize {
+		return fmt.Errorf("staking tx hash is not %d", chainhash.MaxHashStringSize)
+	}
+	if m.StakingTx == nil {
+		return fmt.Errorf("empty staking tx info")
+	}
+	return m.StakingTx.ValidateBasic()
+}
+
+func (m *MsgAddSlashingTxInclusionProof) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return err
+	}
+	if len(m.StakingTxHash) != chainhash.MaxHashStringSize {
+		return fmt.Errorf("staking tx hash is not %d", chainhash.MaxHashStringSize)
+	}
+	if m.SlashingTx == nil {
+		return fmt.Errorf("empty slashing tx info")
+	}
+	return m.SlashingTx.ValidateBasic()
+}
+
+// validateSigHashType ensures the given signature does not carry a sighash
+// type other than SigHashDefault
+func validateSigHashType(sig *bbn.BIP340Signature) error {
+	if err := btcstaking.ValidateSigHashType(*sig); err != nil {
+		return ErrInvalidSigHashType.Wrap(err.Error())
+	}
+	return nil
+}
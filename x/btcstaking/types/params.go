@@ -10,6 +10,7 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
@@ -17,6 +18,20 @@ import (
 
 const (
 	defaultMaxActiveFinalityProviders uint32 = 100
+	defaultMinCovenantCommitteeSize   uint32 = 1
+	defaultMinCovenantQuorum          uint32 = 1
+	// defaultMaxStakingTxVersion is the highest tx version that is standard
+	// as per Bitcoin Core's relay policy
+	defaultMaxStakingTxVersion uint32 = 2
+	// defaultRecommendedSlashingFeeRate is the default fee rate in sat/vB
+	// recommended for broadcasting slashing and unbonding txs
+	defaultRecommendedSlashingFeeRate uint64 = 10
+	// defaultMaxFinalityProvidersPerDelegation is the default maximum number
+	// of finality providers a BTC delegation can restake to
+	defaultMaxFinalityProvidersPerDelegation uint32 = 10
+	// defaultMaxReservationBTCBlocks is the default maximum number of BTC
+	// blocks a BTC delegation can stay reserved, i.e., about one week
+	defaultMaxReservationBTCBlocks uint32 = 1008
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -65,7 +80,13 @@ func DefaultParams() Params {
 		// finalization timeout.
 		MinUnbondingTime: 0,
 		// By default unbonding value is 0.8
-		MinUnbondingRate: sdkmath.LegacyNewDecWithPrec(8, 1), // 8 * 10^{-1} = 0.8
+		MinUnbondingRate:                  sdkmath.LegacyNewDecWithPrec(8, 1), // 8 * 10^{-1} = 0.8
+		MinCovenantCommitteeSize:          defaultMinCovenantCommitteeSize,
+		MinCovenantQuorum:                 defaultMinCovenantQuorum,
+		MaxStakingTxVersion:               defaultMaxStakingTxVersion,
+		RecommendedSlashingFeeRate:        defaultRecommendedSlashingFeeRate,
+		MaxFinalityProvidersPerDelegation: defaultMaxFinalityProvidersPerDelegation,
+		MaxReservationBtcBlocks:           defaultMaxReservationBTCBlocks,
 	}
 }
 
@@ -113,6 +134,22 @@ func validateCovenantPks(covenantPks []bbn.BIP340PubKey) error {
 	return nil
 }
 
+// validateCovenantCommittee checks whether the covenant committee and its
+// quorum are satisfiable and meet the configured minimums
+func validateCovenantCommittee(p Params) error {
+	committeeSize := uint32(len(p.CovenantPks))
+	if committeeSize < p.MinCovenantCommitteeSize {
+		return fmt.Errorf("covenant committee size %d is smaller than the minimum committee size %d", committeeSize, p.MinCovenantCommitteeSize)
+	}
+	if p.CovenantQuorum > committeeSize {
+		return fmt.Errorf("covenant quorum size %d is larger than the covenant committee size %d, so the quorum can never be reached", p.CovenantQuorum, committeeSize)
+	}
+	if p.CovenantQuorum < p.MinCovenantQuorum {
+		return fmt.Errorf("covenant quorum size %d is smaller than the minimum quorum size %d", p.CovenantQuorum, p.MinCovenantQuorum)
+	}
+	return nil
+}
+
 func validateMinUnbondingTime(minUnbondingTimeBlocks uint32) error {
 	if minUnbondingTimeBlocks > math.MaxUint16 {
 		return fmt.Errorf("minimum unbonding time blocks cannot be greater than %d", math.MaxUint16)
@@ -132,6 +169,9 @@ func (p Params) Validate() error {
 	if err := validateCovenantPks(p.CovenantPks); err != nil {
 		return err
 	}
+	if err := validateCovenantCommittee(p); err != nil {
+		return err
+	}
 	if err := validateMinSlashingTxFeeSat(p.MinSlashingTxFeeSat); err != nil {
 		return err
 	}
@@ -189,3 +229,46 @@ func (p Params) CovenantPksHex() []string {
 	}
 	return covPksHex
 }
+
+// GetEffectiveMaxStakingTxVersion returns the maximum version of a BTC staking
+// tx, falling back to the default one if unset, e.g., in parameters stored
+// before MaxStakingTxVersion was introduced
+func (p Params) GetEffectiveMaxStakingTxVersion() uint32 {
+	if p.MaxStakingTxVersion == 0 {
+		return defaultMaxStakingTxVersion
+	}
+	return p.MaxStakingTxVersion
+}
+
+// GetEffectiveRecommendedSlashingFeeRate returns the fee rate recommended for
+// broadcasting slashing and unbonding txs, falling back to the default one if
+// unset, e.g., in parameters stored before RecommendedSlashingFeeRate was
+// introduced
+func (p Params) GetEffectiveRecommendedSlashingFeeRate() uint64 {
+	if p.RecommendedSlashingFeeRate == 0 {
+		return defaultRecommendedSlashingFeeRate
+	}
+	return p.RecommendedSlashingFeeRate
+}
+
+// GetEffectiveMaxReservationBTCBlocks returns the maximum number of BTC blocks
+// a BTC delegation can stay reserved, falling back to the default one if
+// unset, e.g., in parameters stored before MaxReservationBtcBlocks was
+// introduced
+func (p Params) GetEffectiveMaxReservationBTCBlocks() uint32 {
+	if p.MaxReservationBtcBlocks == 0 {
+		return defaultMaxReservationBTCBlocks
+	}
+	return p.MaxReservationBtcBlocks
+}
+
+// ValidateStakingTxStandardness checks that the version of the given staking
+// tx is within [1, MaxStakingTxVersion], such that miners would not reject it
+// as non-standard
+func (p Params) ValidateStakingTxStandardness(stakingTx *wire.MsgTx) error {
+	maxVersion := p.GetEffectiveMaxStakingTxVersion()
+	if stakingTx.Version < 1 || uint32(stakingTx.Version) > maxVersion {
+		return ErrNonStandardStakingTxVersion.Wrapf("version %d is not in [1, %d]", stakingTx.Version, maxVersion)
+	}
+	return nil
+}
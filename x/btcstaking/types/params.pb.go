@@ -44,7 +44,9 @@ type Params struct {
 	// min_commission_rate is the chain-wide minimum commission rate that a finality provider can charge their delegators
 	MinCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_commission_rate"`
 	// slashing_rate determines the portion of the staked amount to be slashed,
-	// expressed as a decimal (e.g., 0.5 for 50%).
+	// expressed as a decimal (e.g., 0.5 for 50%) with at most 2 decimal places.
+	// The slashed amount is the staked amount times the slashing rate rounded
+	// down to a whole satoshi
 	SlashingRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=slashing_rate,json=slashingRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slashing_rate"`
 	// max_active_finality_providers is the maximum number of active finality providers in the BTC staking protocol
 	MaxActiveFinalityProviders uint32 `protobuf:"varint,7,opt,name=max_active_finality_providers,json=maxActiveFinalityProviders,proto3" json:"max_active_finality_providers,omitempty"`
@@ -56,6 +58,30 @@ type Params struct {
 	// must be at least 90% of staking output, for staking request to be considered
 	// valid
 	MinUnbondingRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=min_unbonding_rate,json=minUnbondingRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_unbonding_rate"`
+	// min_covenant_committee_size is the minimum number of members in the
+	// covenant committee
+	MinCovenantCommitteeSize uint32 `protobuf:"varint,10,opt,name=min_covenant_committee_size,json=minCovenantCommitteeSize,proto3" json:"min_covenant_committee_size,omitempty"`
+	// min_covenant_quorum is the minimum quorum size of the covenant committee
+	MinCovenantQuorum uint32 `protobuf:"varint,11,opt,name=min_covenant_quorum,json=minCovenantQuorum,proto3" json:"min_covenant_quorum,omitempty"`
+	// max_staking_tx_version is the maximum version of a BTC staking tx, above
+	// which the staking tx is considered non-standard. Zero means the default
+	// one, i.e., the highest version that is standard as per Bitcoin Core's
+	// relay policy
+	MaxStakingTxVersion uint32 `protobuf:"varint,12,opt,name=max_staking_tx_version,json=maxStakingTxVersion,proto3" json:"max_staking_tx_version,omitempty"`
+	// recommended_slashing_fee_rate is the fee rate in sat/vB recommended for
+	// broadcasting slashing and unbonding txs, such that they confirm promptly.
+	// Zero means the default one
+	RecommendedSlashingFeeRate uint64 `protobuf:"varint,14,opt,name=recommended_slashing_fee_rate,json=recommendedSlashingFeeRate,proto3" json:"recommended_slashing_fee_rate,omitempty"`
+	// max_finality_providers_per_delegation is the maximum number of finality
+	// providers a BTC delegation can restake to. The keys of all of them are
+	// embedded in the staking script, so each one adds to the script size and
+	// the fees of spending the staking output. Zero means unlimited, as in
+	// parameters stored before it was introduced
+	MaxFinalityProvidersPerDelegation uint32 `protobuf:"varint,15,opt,name=max_finality_providers_per_delegation,json=maxFinalityProvidersPerDelegation,proto3" json:"max_finality_providers_per_delegation,omitempty"`
+	// max_reservation_btc_blocks is the maximum number of BTC blocks a BTC
+	// delegation can stay reserved, after which it can no longer be activated
+	// and becomes prunable. Zero means the default one
+	MaxReservationBtcBlocks uint32 `protobuf:"varint,16,opt,name=max_reservation_btc_blocks,json=maxReservationBtcBlocks,proto3" json:"max_reservation_btc_blocks,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -125,6 +151,48 @@ func (m *Params) GetMinUnbondingTime() uint32 {
 	return 0
 }
 
+func (m *Params) GetMinCovenantCommitteeSize() uint32 {
+	if m != nil {
+		return m.MinCovenantCommitteeSize
+	}
+	return 0
+}
+
+func (m *Params) GetMinCovenantQuorum() uint32 {
+	if m != nil {
+		return m.MinCovenantQuorum
+	}
+	return 0
+}
+
+func (m *Params) GetMaxStakingTxVersion() uint32 {
+	if m != nil {
+		return m.MaxStakingTxVersion
+	}
+	return 0
+}
+
+func (m *Params) GetRecommendedSlashingFeeRate() uint64 {
+	if m != nil {
+		return m.RecommendedSlashingFeeRate
+	}
+	return 0
+}
+
+func (m *Params) GetMaxFinalityProvidersPerDelegation() uint32 {
+	if m != nil {
+		return m.MaxFinalityProvidersPerDelegation
+	}
+	return 0
+}
+
+func (m *Params) GetMaxReservationBtcBlocks() uint32 {
+	if m != nil {
+		return m.MaxReservationBtcBlocks
+	}
+	return 0
+}
+
 // StoredParams attach information about the version of stored parameters
 type StoredParams struct {
 	// version of the stored parameters. Each parameters update
@@ -191,42 +259,52 @@ func init() {
 }
 
 var fileDescriptor_8d1392776a3e15b9 = []byte{
-	// 547 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0xbf, 0xe4, 0x4b, 0xe9, 0x34, 0xa5, 0x65, 0x00, 0x61, 0x82, 0xea, 0x44, 0x61, 0x41,
-	0x90, 0xc0, 0x26, 0x6d, 0xc5, 0x02, 0x56, 0x09, 0xa8, 0x12, 0xa2, 0x8b, 0xe0, 0x14, 0x24, 0xd8,
-	0x8c, 0xc6, 0xf6, 0xd4, 0x19, 0x25, 0x33, 0x13, 0x3c, 0x13, 0xcb, 0x7e, 0x0b, 0x96, 0x2c, 0x79,
-	0x08, 0x1e, 0xa2, 0xcb, 0x8a, 0x15, 0xea, 0x22, 0x42, 0xc9, 0x1b, 0xf0, 0x04, 0xc8, 0x63, 0x3b,
-	0xfc, 0x08, 0x09, 0xc4, 0xce, 0xf7, 0xdc, 0x73, 0xcf, 0xfd, 0xf1, 0x19, 0xd0, 0xf1, 0xb0, 0x97,
-	0x4e, 0x05, 0x77, 0x3c, 0xe5, 0x4b, 0x85, 0x27, 0x94, 0x87, 0x4e, 0xdc, 0x73, 0x66, 0x38, 0xc2,
-	0x4c, 0xda, 0xb3, 0x48, 0x28, 0x01, 0xaf, 0x17, 0x1c, 0xfb, 0x3b, 0xc7, 0x8e, 0x7b, 0xcd, 0x6b,
-	0xa1, 0x08, 0x85, 0x66, 0x38, 0xd9, 0x57, 0x4e, 0x6e, 0xde, 0xf4, 0x85, 0x64, 0x42, 0xa2, 0x3c,
-	0x91, 0x07, 0x79, 0xaa, 0xf3, 0xb5, 0x06, 0xea, 0x43, 0x2d, 0x0c, 0x5f, 0x83, 0x86, 0x2f, 0x62,
-	0xc2, 0x31, 0x57, 0x68, 0x36, 0x91, 0xa6, 0xd1, 0xae, 0x76, 0x1b, 0x83, 0x87, 0x17, 0x8b, 0xd6,
-	0x7e, 0x48, 0xd5, 0x78, 0xee, 0xd9, 0xbe, 0x60, 0x4e, 0xd1, 0xd7, 0x1f, 0x63, 0xca, 0xcb, 0xc0,
-	0x51, 0xe9, 0x8c, 0x48, 0x7b, 0xf0, 0x6c, 0x78, 0x70, 0xf8, 0x60, 0x38, 0xf7, 0x9e, 0x93, 0xd4,
-	0xdd, 0x2a, 0xb5, 0x86, 0x13, 0x09, 0xef, 0x80, 0x9d, 0xb5, 0xf4, 0xdb, 0xb9, 0x88, 0xe6, 0xcc,
-	0xfc, 0xaf, 0x6d, 0x74, 0xb7, 0xdd, 0xcb, 0x25, 0xfc, 0x42, 0xa3, 0xf0, 0x2e, 0xd8, 0x95, 0x53,
-	0x2c, 0xc7, 0x94, 0x87, 0x08, 0x07, 0x41, 0x44, 0xa4, 0x34, 0xab, 0x6d, 0xa3, 0xbb, 0xe9, 0xee,
-	0x94, 0x78, 0x3f, 0x87, 0xe1, 0x21, 0xb8, 0xc1, 0x28, 0x47, 0x6b, 0xba, 0x4a, 0xd0, 0x29, 0x21,
-	0x48, 0x62, 0x65, 0xd6, 0xda, 0x46, 0xb7, 0xea, 0x5e, 0x65, 0x94, 0x8f, 0x8a, 0xec, 0x49, 0x72,
-	0x44, 0xc8, 0x08, 0x2b, 0x38, 0x02, 0x19, 0x8c, 0x7c, 0xc1, 0x18, 0x95, 0x92, 0x0a, 0x8e, 0x22,
-	0xac, 0x88, 0xf9, 0x7f, 0xd6, 0x63, 0x70, 0xfb, 0x6c, 0xd1, 0xaa, 0x5c, 0x2c, 0x5a, 0xb7, 0xf2,
-	0x13, 0xc9, 0x60, 0x62, 0x53, 0xe1, 0x30, 0xac, 0xc6, 0xf6, 0x31, 0x09, 0xb1, 0x9f, 0x3e, 0x25,
-	0xbe, 0x7b, 0x85, 0x51, 0xfe, 0x64, 0x5d, 0xee, 0x62, 0x45, 0xe0, 0x2b, 0xb0, 0xbd, 0x1e, 0x43,
-	0xcb, 0xd5, 0xb5, 0x5c, 0xef, 0x2f, 0xe4, 0x3e, 0x7d, 0xbc, 0x0f, 0x8a, 0x1f, 0x92, 0x89, 0x37,
-	0x4a, 0x1d, 0xad, 0xdb, 0x07, 0x7b, 0x0c, 0x27, 0x08, 0xfb, 0x8a, 0xc6, 0x04, 0x9d, 0x52, 0x8e,
-	0xa7, 0x54, 0xa5, 0xd9, 0x6f, 0x8c, 0x69, 0x40, 0x22, 0x69, 0x6e, 0xe8, 0x23, 0x36, 0x19, 0x4e,
-	0xfa, 0x9a, 0x73, 0x54, 0x50, 0x86, 0x25, 0x03, 0xde, 0x03, 0x30, 0xdb, 0x77, 0xce, 0x3d, 0xc1,
-	0x03, 0x7d, 0x26, 0xca, 0x88, 0x79, 0x49, 0xd7, 0xed, 0x32, 0xca, 0x5f, 0x96, 0x89, 0x13, 0xca,
-	0x08, 0x44, 0xbf, 0xb2, 0xf5, 0x36, 0x9b, 0xff, 0xba, 0xcd, 0x4f, 0x0d, 0xb2, 0x8d, 0x1e, 0xd5,
-	0xde, 0x7f, 0x68, 0x55, 0x3a, 0x04, 0x34, 0x46, 0x4a, 0x44, 0x24, 0x28, 0x9c, 0x67, 0x82, 0x8d,
-	0x98, 0x44, 0xd9, 0x39, 0x4d, 0x43, 0x4f, 0x56, 0x86, 0xf0, 0x31, 0xa8, 0xe7, 0xb6, 0xd7, 0x7e,
-	0xd9, 0xda, 0xdf, 0xb3, 0x7f, 0xeb, 0x7b, 0x3b, 0x17, 0x1a, 0xd4, 0xb2, 0x19, 0xdd, 0xa2, 0x64,
-	0x70, 0x7c, 0xb6, 0xb4, 0x8c, 0xf3, 0xa5, 0x65, 0x7c, 0x59, 0x5a, 0xc6, 0xbb, 0x95, 0x55, 0x39,
-	0x5f, 0x59, 0x95, 0xcf, 0x2b, 0xab, 0xf2, 0xe6, 0x8f, 0x86, 0x4e, 0x7e, 0x7c, 0x7b, 0xda, 0xdd,
-	0x5e, 0x5d, 0x3f, 0x98, 0x83, 0x6f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6f, 0x91, 0xdb, 0xb2, 0x9e,
-	0x03, 0x00, 0x00,
+	// 717 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x4e, 0x1b, 0x49,
+	0x10, 0xf6, 0x2c, 0x5e, 0x03, 0x8d, 0x01, 0x33, 0xec, 0x2e, 0xb3, 0x46, 0xd8, 0x5e, 0x56, 0xab,
+	0xf5, 0x4a, 0xbb, 0xe3, 0xe5, 0x47, 0x39, 0x04, 0xe5, 0x80, 0x41, 0x48, 0x49, 0x38, 0x38, 0x63,
+	0x82, 0x94, 0x5c, 0x5a, 0x3d, 0x33, 0x85, 0xdd, 0xb2, 0xbb, 0xdb, 0x99, 0x6e, 0x5b, 0x63, 0x9e,
+	0x22, 0xc7, 0x1c, 0xf3, 0x10, 0x79, 0x08, 0x8e, 0x28, 0xa7, 0x88, 0x03, 0x8a, 0xe0, 0x9e, 0x67,
+	0x88, 0xba, 0x67, 0xc6, 0x40, 0x88, 0x94, 0x28, 0xb7, 0x99, 0xaa, 0xaf, 0xbe, 0xee, 0xfa, 0xbe,
+	0xea, 0x42, 0xeb, 0x3e, 0xf1, 0xc7, 0x7d, 0xc1, 0x1b, 0xbe, 0x0a, 0xa4, 0x22, 0x3d, 0xca, 0x3b,
+	0x8d, 0xd1, 0x46, 0x63, 0x40, 0x22, 0xc2, 0xa4, 0x3b, 0x88, 0x84, 0x12, 0xf6, 0xaf, 0x29, 0xc6,
+	0xbd, 0xc1, 0xb8, 0xa3, 0x8d, 0xf2, 0x2f, 0x1d, 0xd1, 0x11, 0x06, 0xd1, 0xd0, 0x5f, 0x09, 0xb8,
+	0xfc, 0x7b, 0x20, 0x24, 0x13, 0x12, 0x27, 0x89, 0xe4, 0x27, 0x49, 0xad, 0x7f, 0x9a, 0x46, 0x85,
+	0x96, 0x21, 0xb6, 0x5f, 0xa0, 0x62, 0x20, 0x46, 0xc0, 0x09, 0x57, 0x78, 0xd0, 0x93, 0x8e, 0x55,
+	0x9b, 0xaa, 0x17, 0x9b, 0x0f, 0x2e, 0x2e, 0xab, 0x9b, 0x1d, 0xaa, 0xba, 0x43, 0xdf, 0x0d, 0x04,
+	0x6b, 0xa4, 0xe7, 0x06, 0x5d, 0x42, 0x79, 0xf6, 0xd3, 0x50, 0xe3, 0x01, 0x48, 0xb7, 0xf9, 0xb8,
+	0xb5, 0xb5, 0xfd, 0x7f, 0x6b, 0xe8, 0x3f, 0x85, 0xb1, 0x37, 0x97, 0x71, 0xb5, 0x7a, 0xd2, 0xfe,
+	0x1b, 0x2d, 0x4e, 0xa8, 0x5f, 0x0d, 0x45, 0x34, 0x64, 0xce, 0x4f, 0x35, 0xab, 0x3e, 0xef, 0x2d,
+	0x64, 0xe1, 0x67, 0x26, 0x6a, 0xff, 0x83, 0x4a, 0xb2, 0x4f, 0x64, 0x97, 0xf2, 0x0e, 0x26, 0x61,
+	0x18, 0x81, 0x94, 0xce, 0x54, 0xcd, 0xaa, 0xcf, 0x7a, 0x8b, 0x59, 0x7c, 0x37, 0x09, 0xdb, 0xdb,
+	0x68, 0x85, 0x51, 0x8e, 0x27, 0x70, 0x15, 0xe3, 0x13, 0x00, 0x2c, 0x89, 0x72, 0xf2, 0x35, 0xab,
+	0x3e, 0xe5, 0x2d, 0x33, 0xca, 0xdb, 0x69, 0xf6, 0x28, 0x3e, 0x00, 0x68, 0x13, 0x65, 0xb7, 0x91,
+	0x0e, 0xe3, 0x40, 0x30, 0x46, 0xa5, 0xa4, 0x82, 0xe3, 0x88, 0x28, 0x70, 0x7e, 0xd6, 0x67, 0x34,
+	0xff, 0x3c, 0xbb, 0xac, 0xe6, 0x2e, 0x2e, 0xab, 0xab, 0x89, 0x44, 0x32, 0xec, 0xb9, 0x54, 0x34,
+	0x18, 0x51, 0x5d, 0xf7, 0x10, 0x3a, 0x24, 0x18, 0xef, 0x43, 0xe0, 0x2d, 0x31, 0xca, 0xf7, 0x26,
+	0xe5, 0x1e, 0x51, 0x60, 0x1f, 0xa3, 0xf9, 0xc9, 0x35, 0x0c, 0x5d, 0xc1, 0xd0, 0x6d, 0x7c, 0x07,
+	0xdd, 0xfb, 0x77, 0xff, 0xa1, 0xd4, 0x10, 0x4d, 0x5e, 0xcc, 0x78, 0x0c, 0xef, 0x2e, 0x5a, 0x63,
+	0x24, 0xc6, 0x24, 0x50, 0x74, 0x04, 0xf8, 0x84, 0x72, 0xd2, 0xa7, 0x6a, 0xac, 0x6d, 0x1c, 0xd1,
+	0x10, 0x22, 0xe9, 0x4c, 0x1b, 0x11, 0xcb, 0x8c, 0xc4, 0xbb, 0x06, 0x73, 0x90, 0x42, 0x5a, 0x19,
+	0xc2, 0xfe, 0x17, 0xd9, 0xba, 0xdf, 0x21, 0xf7, 0x05, 0x0f, 0x8d, 0x4c, 0x94, 0x81, 0x33, 0x63,
+	0xea, 0x4a, 0x8c, 0xf2, 0xe7, 0x59, 0xe2, 0x88, 0x32, 0xb0, 0xf1, 0x97, 0x68, 0xd3, 0xcd, 0xec,
+	0x8f, 0x76, 0x73, 0xe7, 0x00, 0xd3, 0xd1, 0x23, 0xb4, 0x9a, 0xc8, 0x9f, 0x0e, 0x83, 0xf1, 0x41,
+	0x29, 0xed, 0x1b, 0x3d, 0x05, 0x07, 0x99, 0x7b, 0x39, 0x46, 0xe1, 0x04, 0xb1, 0x97, 0x01, 0xda,
+	0xf4, 0x14, 0x6c, 0x37, 0x73, 0xef, 0xee, 0x2c, 0xcd, 0x99, 0xb2, 0xa5, 0x5b, 0x65, 0xe9, 0x38,
+	0x6d, 0xa1, 0xdf, 0xb4, 0x80, 0xe9, 0x03, 0xd1, 0x23, 0x32, 0x82, 0x48, 0xdb, 0xe6, 0x14, 0x4d,
+	0xc9, 0x32, 0x23, 0x71, 0x3b, 0x49, 0x1e, 0xc5, 0xc7, 0x49, 0x4a, 0xab, 0x1e, 0x81, 0xbe, 0x18,
+	0xf0, 0x10, 0xc2, 0x9b, 0x01, 0xd3, 0xd3, 0x65, 0xf4, 0x58, 0xa8, 0x59, 0xf5, 0xbc, 0x57, 0xbe,
+	0x05, 0xca, 0xc6, 0xec, 0x00, 0xc0, 0xb4, 0xd9, 0x42, 0x7f, 0xe9, 0x73, 0xef, 0x3b, 0x86, 0x07,
+	0x10, 0xe1, 0x10, 0xfa, 0xd0, 0x21, 0x4a, 0x5f, 0x63, 0xd1, 0x5c, 0xe3, 0x0f, 0x46, 0xe2, 0x7b,
+	0xd6, 0xb5, 0x20, 0xda, 0x9f, 0x00, 0xed, 0x1d, 0xa4, 0x5d, 0xc6, 0x11, 0x48, 0x88, 0x46, 0x26,
+	0x84, 0x7d, 0x15, 0x60, 0xbf, 0x2f, 0x82, 0x9e, 0x74, 0x4a, 0x86, 0x66, 0x85, 0x91, 0xd8, 0xbb,
+	0x01, 0x34, 0x55, 0xd0, 0x34, 0xe9, 0x87, 0xf9, 0x37, 0x6f, 0xab, 0xb9, 0x27, 0xf9, 0x99, 0xf9,
+	0xd2, 0xc2, 0x3a, 0xa0, 0x62, 0x5b, 0x89, 0x08, 0xc2, 0xf4, 0xd5, 0x3b, 0x68, 0x3a, 0xd3, 0xc4,
+	0x32, 0x2c, 0xd9, 0xaf, 0xbd, 0x83, 0x0a, 0xc9, 0xca, 0x31, 0x6f, 0x75, 0x6e, 0x73, 0xcd, 0xfd,
+	0xea, 0xce, 0x71, 0x13, 0xa2, 0x66, 0x5e, 0xcf, 0x87, 0x97, 0x96, 0x34, 0x0f, 0xcf, 0xae, 0x2a,
+	0xd6, 0xf9, 0x55, 0xc5, 0xfa, 0x78, 0x55, 0xb1, 0x5e, 0x5f, 0x57, 0x72, 0xe7, 0xd7, 0x95, 0xdc,
+	0x87, 0xeb, 0x4a, 0xee, 0xe5, 0x37, 0x97, 0x49, 0x7c, 0x7b, 0xef, 0x99, 0xcd, 0xe2, 0x17, 0xcc,
+	0xb2, 0xda, 0xfa, 0x3c, 0x00, 0x91, 0x76, 0xda, 0x2f, 0x1a, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -249,6 +327,38 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxReservationBtcBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxReservationBtcBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MaxFinalityProvidersPerDelegation != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxFinalityProvidersPerDelegation))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.RecommendedSlashingFeeRate != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RecommendedSlashingFeeRate))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxStakingTxVersion != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxStakingTxVersion))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MinCovenantQuorum != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinCovenantQuorum))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MinCovenantCommitteeSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinCovenantCommitteeSize))
+		i--
+		dAtA[i] = 0x50
+	}
 	{
 		size := m.MinUnbondingRate.Size()
 		i -= size
@@ -406,6 +516,24 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinUnbondingRate.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.MinCovenantCommitteeSize != 0 {
+		n += 1 + sovParams(uint64(m.MinCovenantCommitteeSize))
+	}
+	if m.MinCovenantQuorum != 0 {
+		n += 1 + sovParams(uint64(m.MinCovenantQuorum))
+	}
+	if m.MaxStakingTxVersion != 0 {
+		n += 1 + sovParams(uint64(m.MaxStakingTxVersion))
+	}
+	if m.RecommendedSlashingFeeRate != 0 {
+		n += 1 + sovParams(uint64(m.RecommendedSlashingFeeRate))
+	}
+	if m.MaxFinalityProvidersPerDelegation != 0 {
+		n += 1 + sovParams(uint64(m.MaxFinalityProvidersPerDelegation))
+	}
+	if m.MaxReservationBtcBlocks != 0 {
+		n += 2 + sovParams(uint64(m.MaxReservationBtcBlocks))
+	}
 	return n
 }
 
@@ -703,6 +831,120 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCovenantCommitteeSize", wireType)
+			}
+			m.MinCovenantCommitteeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCovenantCommitteeSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCovenantQuorum", wireType)
+			}
+			m.MinCovenantQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCovenantQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakingTxVersion", wireType)
+			}
+			m.MaxStakingTxVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStakingTxVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecommendedSlashingFeeRate", wireType)
+			}
+			m.RecommendedSlashingFeeRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecommendedSlashingFeeRate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFinalityProvidersPerDelegation", wireType)
+			}
+			m.MaxFinalityProvidersPerDelegation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFinalityProvidersPerDelegation |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReservationBtcBlocks", wireType)
+			}
+			m.MaxReservationBtcBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReservationBtcBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
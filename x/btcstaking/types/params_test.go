@@ -0,0 +1,126 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+func TestParamsValidateCovenantCommittee(t *testing.T) {
+	tests := []struct {
+		desc     string
+		mutate   func(p *types.Params)
+		errorMsg string
+	}{
+		{
+			desc:   "default params",
+			mutate: func(p *types.Params) {},
+		},
+		{
+			desc: "quorum larger than committee size is unsatisfiable",
+			mutate: func(p *types.Params) {
+				p.CovenantQuorum = uint32(len(p.CovenantPks)) + 1
+			},
+			errorMsg: "the quorum can never be reached",
+		},
+		{
+			desc: "committee smaller than the minimum committee size",
+			mutate: func(p *types.Params) {
+				p.MinCovenantCommitteeSize = uint32(len(p.CovenantPks)) + 1
+			},
+			errorMsg: "smaller than the minimum committee size",
+		},
+		{
+			desc: "quorum smaller than the minimum quorum size",
+			mutate: func(p *types.Params) {
+				p.MinCovenantQuorum = p.CovenantQuorum + 1
+			},
+			errorMsg: "smaller than the minimum quorum size",
+		},
+		{
+			desc: "quorum equal to committee size",
+			mutate: func(p *types.Params) {
+				p.CovenantQuorum = uint32(len(p.CovenantPks))
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			p := types.DefaultParams()
+			tc.mutate(&p)
+			err := p.Validate()
+			if tc.errorMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errorMsg)
+			}
+		})
+	}
+}
+
+func TestParamsValidateStakingTxStandardness(t *testing.T) {
+	tests := []struct {
+		desc        string
+		mutateTx    func(tx *wire.MsgTx)
+		mutateParam func(p *types.Params)
+		expectedErr error
+	}{
+		{
+			desc:     "standard staking tx",
+			mutateTx: func(tx *wire.MsgTx) {},
+		},
+		{
+			desc:        "version 0 is non-standard",
+			mutateTx:    func(tx *wire.MsgTx) { tx.Version = 0 },
+			expectedErr: types.ErrNonStandardStakingTxVersion,
+		},
+		{
+			desc:        "version above the max is non-standard",
+			mutateTx:    func(tx *wire.MsgTx) { tx.Version = 3 },
+			expectedErr: types.ErrNonStandardStakingTxVersion,
+		},
+		{
+			desc:        "version within a raised max is standard",
+			mutateTx:    func(tx *wire.MsgTx) { tx.Version = 3 },
+			mutateParam: func(p *types.Params) { p.MaxStakingTxVersion = 3 },
+		},
+		{
+			desc:        "unset max falls back to the default",
+			mutateTx:    func(tx *wire.MsgTx) { tx.Version = 2 },
+			mutateParam: func(p *types.Params) { p.MaxStakingTxVersion = 0 },
+		},
+		{
+			desc:        "version above the default max is non-standard if the max is unset",
+			mutateTx:    func(tx *wire.MsgTx) { tx.Version = 3 },
+			mutateParam: func(p *types.Params) { p.MaxStakingTxVersion = 0 },
+			expectedErr: types.ErrNonStandardStakingTxVersion,
+		},
+		{
+			desc:     "lock time and RBF signaling are not restricted",
+			mutateTx: func(tx *wire.MsgTx) { tx.LockTime = 1000; tx.TxIn[1].Sequence = 0 },
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			p := types.DefaultParams()
+			if tc.mutateParam != nil {
+				tc.mutateParam(&p)
+			}
+			tx := wire.NewMsgTx(2)
+			tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, nil))
+			tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, 1), nil, nil))
+			tc.mutateTx(tx)
+
+			err := p.ValidateStakingTxStandardness(tx)
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
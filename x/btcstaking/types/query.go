@@ -2,25 +2,40 @@ package types
 
 import (
 	"encoding/hex"
+
+	"github.com/babylonchain/babylon/btcstaking"
 )
 
 // NewBTCDelegationResponse returns a new delegation response structure.
 func NewBTCDelegationResponse(btcDel *BTCDelegation, status BTCDelegationStatus) (resp *BTCDelegationResponse) {
 	resp = &BTCDelegationResponse{
-		BtcPk:                btcDel.BtcPk,
-		FpBtcPkList:          btcDel.FpBtcPkList,
-		StartHeight:          btcDel.StartHeight,
-		EndHeight:            btcDel.EndHeight,
-		TotalSat:             btcDel.TotalSat,
-		StakingTxHex:         hex.EncodeToString(btcDel.StakingTx),
-		DelegatorSlashSigHex: btcDel.DelegatorSig.ToHexStr(),
-		CovenantSigs:         btcDel.CovenantSigs,
-		StakingOutputIdx:     btcDel.StakingOutputIdx,
-		Active:               status == BTCDelegationStatus_ACTIVE,
-		StatusDesc:           status.String(),
-		UnbondingTime:        btcDel.UnbondingTime,
-		UndelegationResponse: nil,
-		ParamsVersion:        btcDel.ParamsVersion,
+		BtcPk:                         btcDel.BtcPk,
+		FpBtcPkList:                   btcDel.FpBtcPkList,
+		StartHeight:                   btcDel.StartHeight,
+		EndHeight:                     btcDel.EndHeight,
+		TotalSat:                      btcDel.TotalSat,
+		StakingTxHex:                  hex.EncodeToString(btcDel.StakingTx),
+		DelegatorSlashSigHex:          btcDel.DelegatorSig.ToHexStr(),
+		CovenantSigs:                  btcDel.CovenantSigs,
+		StakingOutputIdx:              btcDel.StakingOutputIdx,
+		Active:                        status == BTCDelegationStatus_ACTIVE,
+		StatusDesc:                    status.String(),
+		UnbondingTime:                 btcDel.UnbondingTime,
+		UndelegationResponse:          nil,
+		ParamsVersion:                 btcDel.ParamsVersion,
+		CreatedBabylonHeight:          btcDel.CreatedBabylonHeight,
+		BtcConfirmationDepth:          btcDel.BtcConfirmationDepth,
+		CheckpointFinalizationTimeout: btcDel.CheckpointFinalizationTimeout,
+		RewardOptOut:                  btcDel.RewardOptOut,
+		StakingTxHashHex:              btcDel.MustGetStakingTxHash().String(),
+		Status:                        status,
+		BabylonPk:                     btcDel.BabylonPk,
+		Pop:                           btcDel.Pop,
+		Invalidated:                   btcDel.Invalidated,
+		SlashedBtcHeight:              btcDel.SlashedBtcHeight,
+		Reserved:                      btcDel.Reserved,
+		FpSlashedBeforeActivation:     btcDel.FpSlashedBeforeActivation,
+		FpSlashedBtcHeight:            btcDel.FpSlashedBtcHeight,
 	}
 
 	if btcDel.SlashingTx != nil {
@@ -69,3 +84,28 @@ func NewFinalityProviderResponse(f *FinalityProvider, bbnBlockHeight, votingPowe
 		VotingPower:          votingPower,
 	}
 }
+
+// NewTaprootScriptPath returns a new taproot script path from the given spend info
+func NewTaprootScriptPath(spendInfo *btcstaking.SpendInfo) (*TaprootScriptPath, error) {
+	controlBlock, err := spendInfo.ControlBlock.ToBytes()
+	if err != nil {
+		return nil, err
+	}
+	return &TaprootScriptPath{
+		Script:       spendInfo.GetPkScriptPath(),
+		ControlBlock: controlBlock,
+	}, nil
+}
+
+// NewTaprootTreeLeaf returns a new taproot tree leaf at the given position
+// from the given spend info
+func NewTaprootTreeLeaf(leafIdx int, spendInfo *btcstaking.SpendInfo) (*TaprootTreeLeaf, error) {
+	scriptPath, err := NewTaprootScriptPath(spendInfo)
+	if err != nil {
+		return nil, err
+	}
+	return &TaprootTreeLeaf{
+		LeafIndex:  uint32(leafIdx),
+		ScriptPath: scriptPath,
+	}, nil
+}
@@ -35,6 +35,76 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CovenantSpendPath is a spend path of the staking output or the unbonding
+// output that requires a quorum of covenant signatures
+type CovenantSpendPath int32
+
+const (
+	// UNBONDING is the unbonding path, spent by the unbonding tx
+	CovenantSpendPath_UNBONDING CovenantSpendPath = 0
+	// SLASHING is the slashing path, spent by the slashing tx
+	CovenantSpendPath_SLASHING CovenantSpendPath = 1
+	// UNBONDING_SLASHING is the slashing path of the unbonding output, spent by
+	// the slashing tx of the unbonding tx
+	CovenantSpendPath_UNBONDING_SLASHING CovenantSpendPath = 2
+)
+
+var CovenantSpendPath_name = map[int32]string{
+	0: "UNBONDING",
+	1: "SLASHING",
+	2: "UNBONDING_SLASHING",
+}
+
+var CovenantSpendPath_value = map[string]int32{
+	"UNBONDING":          0,
+	"SLASHING":           1,
+	"UNBONDING_SLASHING": 2,
+}
+
+func (x CovenantSpendPath) String() string {
+	return proto.EnumName(CovenantSpendPath_name, int32(x))
+}
+
+func (CovenantSpendPath) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{0}
+}
+
+// StakingOutputSpendPath is a spend path of the staking output
+type StakingOutputSpendPath int32
+
+const (
+	// TIMELOCK_PATH is the timelock path, spent by the staker after the
+	// staking timelock expires
+	StakingOutputSpendPath_TIMELOCK_PATH StakingOutputSpendPath = 0
+	// UNBONDING_PATH is the unbonding path, spent by the unbonding tx with the
+	// signatures of the staker and a covenant quorum
+	StakingOutputSpendPath_UNBONDING_PATH StakingOutputSpendPath = 1
+	// SLASHING_PATH is the slashing path, spent by the slashing tx with the
+	// signatures of the staker, a covenant quorum and a slashed finality
+	// provider
+	StakingOutputSpendPath_SLASHING_PATH StakingOutputSpendPath = 2
+)
+
+var StakingOutputSpendPath_name = map[int32]string{
+	0: "TIMELOCK_PATH",
+	1: "UNBONDING_PATH",
+	2: "SLASHING_PATH",
+}
+
+var StakingOutputSpendPath_value = map[string]int32{
+	"TIMELOCK_PATH":  0,
+	"UNBONDING_PATH": 1,
+	"SLASHING_PATH":  2,
+}
+
+func (x StakingOutputSpendPath) String() string {
+	return proto.EnumName(StakingOutputSpendPath_name, int32(x))
+}
+
+func (StakingOutputSpendPath) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{1}
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -209,6 +279,162 @@ func (m *QueryParamsByVersionResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryCovenantCommitteeRequest is request type for the Query/CovenantCommittee RPC method.
+type QueryCovenantCommitteeRequest struct {
+}
+
+func (m *QueryCovenantCommitteeRequest) Reset()         { *m = QueryCovenantCommitteeRequest{} }
+func (m *QueryCovenantCommitteeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantCommitteeRequest) ProtoMessage()    {}
+func (*QueryCovenantCommitteeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{4}
+}
+func (m *QueryCovenantCommitteeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantCommitteeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCovenantCommitteeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCovenantCommitteeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantCommitteeRequest.Merge(m, src)
+}
+func (m *QueryCovenantCommitteeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCovenantCommitteeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantCommitteeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCovenantCommitteeRequest proto.InternalMessageInfo
+
+// QueryCovenantCommitteeResponse is response type for the Query/CovenantCommittee RPC method.
+type QueryCovenantCommitteeResponse struct {
+	// covenant_pks is the list of public keys of the covenant committee
+	CovenantPks []*CovenantPkResponse `protobuf:"bytes,1,rep,name=covenant_pks,json=covenantPks,proto3" json:"covenant_pks,omitempty"`
+	// covenant_quorum is the minimum number of signatures needed for the covenant multisignature
+	CovenantQuorum uint32 `protobuf:"varint,2,opt,name=covenant_quorum,json=covenantQuorum,proto3" json:"covenant_quorum,omitempty"`
+	// params_version is the version of the parameters that the covenant committee is in
+	ParamsVersion uint32 `protobuf:"varint,3,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+}
+
+func (m *QueryCovenantCommitteeResponse) Reset()         { *m = QueryCovenantCommitteeResponse{} }
+func (m *QueryCovenantCommitteeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantCommitteeResponse) ProtoMessage()    {}
+func (*QueryCovenantCommitteeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{5}
+}
+func (m *QueryCovenantCommitteeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantCommitteeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCovenantCommitteeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCovenantCommitteeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantCommitteeResponse.Merge(m, src)
+}
+func (m *QueryCovenantCommitteeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCovenantCommitteeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantCommitteeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCovenantCommitteeResponse proto.InternalMessageInfo
+
+func (m *QueryCovenantCommitteeResponse) GetCovenantPks() []*CovenantPkResponse {
+	if m != nil {
+		return m.CovenantPks
+	}
+	return nil
+}
+
+func (m *QueryCovenantCommitteeResponse) GetCovenantQuorum() uint32 {
+	if m != nil {
+		return m.CovenantQuorum
+	}
+	return 0
+}
+
+func (m *QueryCovenantCommitteeResponse) GetParamsVersion() uint32 {
+	if m != nil {
+		return m.ParamsVersion
+	}
+	return 0
+}
+
+// CovenantPkResponse is the public key of a covenant committee member in different encodings
+type CovenantPkResponse struct {
+	// bip340_pk_hex is the hex str of the PK encoded in BIP-340 spec
+	Bip340PkHex string `protobuf:"bytes,1,opt,name=bip340_pk_hex,json=bip340PkHex,proto3" json:"bip340_pk_hex,omitempty"`
+	// compressed_pk_hex is the hex str of the PK in the 33-byte compressed form
+	CompressedPkHex string `protobuf:"bytes,2,opt,name=compressed_pk_hex,json=compressedPkHex,proto3" json:"compressed_pk_hex,omitempty"`
+}
+
+func (m *CovenantPkResponse) Reset()         { *m = CovenantPkResponse{} }
+func (m *CovenantPkResponse) String() string { return proto.CompactTextString(m) }
+func (*CovenantPkResponse) ProtoMessage()    {}
+func (*CovenantPkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{6}
+}
+func (m *CovenantPkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CovenantPkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CovenantPkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CovenantPkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CovenantPkResponse.Merge(m, src)
+}
+func (m *CovenantPkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CovenantPkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CovenantPkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CovenantPkResponse proto.InternalMessageInfo
+
+func (m *CovenantPkResponse) GetBip340PkHex() string {
+	if m != nil {
+		return m.Bip340PkHex
+	}
+	return ""
+}
+
+func (m *CovenantPkResponse) GetCompressedPkHex() string {
+	if m != nil {
+		return m.CompressedPkHex
+	}
+	return ""
+}
+
 // QueryFinalityProvidersRequest is the request type for the
 // Query/FinalityProviders RPC method.
 type QueryFinalityProvidersRequest struct {
@@ -220,7 +446,7 @@ func (m *QueryFinalityProvidersRequest) Reset()         { *m = QueryFinalityProv
 func (m *QueryFinalityProvidersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFinalityProvidersRequest) ProtoMessage()    {}
 func (*QueryFinalityProvidersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{4}
+	return fileDescriptor_74d49d26f7429697, []int{7}
 }
 func (m *QueryFinalityProvidersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -269,7 +495,7 @@ func (m *QueryFinalityProvidersResponse) Reset()         { *m = QueryFinalityPro
 func (m *QueryFinalityProvidersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFinalityProvidersResponse) ProtoMessage()    {}
 func (*QueryFinalityProvidersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{5}
+	return fileDescriptor_74d49d26f7429697, []int{8}
 }
 func (m *QueryFinalityProvidersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -322,7 +548,7 @@ func (m *QueryFinalityProviderRequest) Reset()         { *m = QueryFinalityProvi
 func (m *QueryFinalityProviderRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFinalityProviderRequest) ProtoMessage()    {}
 func (*QueryFinalityProviderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{6}
+	return fileDescriptor_74d49d26f7429697, []int{9}
 }
 func (m *QueryFinalityProviderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -368,7 +594,7 @@ func (m *QueryFinalityProviderResponse) Reset()         { *m = QueryFinalityProv
 func (m *QueryFinalityProviderResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFinalityProviderResponse) ProtoMessage()    {}
 func (*QueryFinalityProviderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{7}
+	return fileDescriptor_74d49d26f7429697, []int{10}
 }
 func (m *QueryFinalityProviderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -417,7 +643,7 @@ func (m *QueryBTCDelegationsRequest) Reset()         { *m = QueryBTCDelegationsR
 func (m *QueryBTCDelegationsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBTCDelegationsRequest) ProtoMessage()    {}
 func (*QueryBTCDelegationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{8}
+	return fileDescriptor_74d49d26f7429697, []int{11}
 }
 func (m *QueryBTCDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -473,7 +699,7 @@ func (m *QueryBTCDelegationsResponse) Reset()         { *m = QueryBTCDelegations
 func (m *QueryBTCDelegationsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBTCDelegationsResponse) ProtoMessage()    {}
 func (*QueryBTCDelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{9}
+	return fileDescriptor_74d49d26f7429697, []int{12}
 }
 func (m *QueryBTCDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -535,7 +761,7 @@ func (m *QueryFinalityProviderPowerAtHeightRequest) String() string {
 }
 func (*QueryFinalityProviderPowerAtHeightRequest) ProtoMessage() {}
 func (*QueryFinalityProviderPowerAtHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{10}
+	return fileDescriptor_74d49d26f7429697, []int{13}
 }
 func (m *QueryFinalityProviderPowerAtHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -593,7 +819,7 @@ func (m *QueryFinalityProviderPowerAtHeightResponse) String() string {
 }
 func (*QueryFinalityProviderPowerAtHeightResponse) ProtoMessage() {}
 func (*QueryFinalityProviderPowerAtHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{11}
+	return fileDescriptor_74d49d26f7429697, []int{14}
 }
 func (m *QueryFinalityProviderPowerAtHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -644,7 +870,7 @@ func (m *QueryFinalityProviderCurrentPowerRequest) Reset() {
 func (m *QueryFinalityProviderCurrentPowerRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFinalityProviderCurrentPowerRequest) ProtoMessage()    {}
 func (*QueryFinalityProviderCurrentPowerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{12}
+	return fileDescriptor_74d49d26f7429697, []int{15}
 }
 func (m *QueryFinalityProviderCurrentPowerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -697,7 +923,7 @@ func (m *QueryFinalityProviderCurrentPowerResponse) String() string {
 }
 func (*QueryFinalityProviderCurrentPowerResponse) ProtoMessage() {}
 func (*QueryFinalityProviderCurrentPowerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{13}
+	return fileDescriptor_74d49d26f7429697, []int{16}
 }
 func (m *QueryFinalityProviderCurrentPowerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -757,7 +983,7 @@ func (m *QueryActiveFinalityProvidersAtHeightRequest) String() string {
 }
 func (*QueryActiveFinalityProvidersAtHeightRequest) ProtoMessage() {}
 func (*QueryActiveFinalityProvidersAtHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{14}
+	return fileDescriptor_74d49d26f7429697, []int{17}
 }
 func (m *QueryActiveFinalityProvidersAtHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -817,7 +1043,7 @@ func (m *QueryActiveFinalityProvidersAtHeightResponse) String() string {
 }
 func (*QueryActiveFinalityProvidersAtHeightResponse) ProtoMessage() {}
 func (*QueryActiveFinalityProvidersAtHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{15}
+	return fileDescriptor_74d49d26f7429697, []int{18}
 }
 func (m *QueryActiveFinalityProvidersAtHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -868,7 +1094,7 @@ func (m *QueryActivatedHeightRequest) Reset()         { *m = QueryActivatedHeigh
 func (m *QueryActivatedHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryActivatedHeightRequest) ProtoMessage()    {}
 func (*QueryActivatedHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{16}
+	return fileDescriptor_74d49d26f7429697, []int{19}
 }
 func (m *QueryActivatedHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -906,7 +1132,7 @@ func (m *QueryActivatedHeightResponse) Reset()         { *m = QueryActivatedHeig
 func (m *QueryActivatedHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryActivatedHeightResponse) ProtoMessage()    {}
 func (*QueryActivatedHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{17}
+	return fileDescriptor_74d49d26f7429697, []int{20}
 }
 func (m *QueryActivatedHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -959,7 +1185,7 @@ func (m *QueryFinalityProviderDelegationsRequest) Reset() {
 func (m *QueryFinalityProviderDelegationsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFinalityProviderDelegationsRequest) ProtoMessage()    {}
 func (*QueryFinalityProviderDelegationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{18}
+	return fileDescriptor_74d49d26f7429697, []int{21}
 }
 func (m *QueryFinalityProviderDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1017,7 +1243,7 @@ func (m *QueryFinalityProviderDelegationsResponse) Reset() {
 func (m *QueryFinalityProviderDelegationsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFinalityProviderDelegationsResponse) ProtoMessage()    {}
 func (*QueryFinalityProviderDelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{19}
+	return fileDescriptor_74d49d26f7429697, []int{22}
 }
 func (m *QueryFinalityProviderDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1071,7 +1297,7 @@ func (m *QueryBTCDelegationRequest) Reset()         { *m = QueryBTCDelegationReq
 func (m *QueryBTCDelegationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBTCDelegationRequest) ProtoMessage()    {}
 func (*QueryBTCDelegationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{20}
+	return fileDescriptor_74d49d26f7429697, []int{23}
 }
 func (m *QueryBTCDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1118,7 +1344,7 @@ func (m *QueryBTCDelegationResponse) Reset()         { *m = QueryBTCDelegationRe
 func (m *QueryBTCDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBTCDelegationResponse) ProtoMessage()    {}
 func (*QueryBTCDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{21}
+	return fileDescriptor_74d49d26f7429697, []int{24}
 }
 func (m *QueryBTCDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1154,62 +1380,28 @@ func (m *QueryBTCDelegationResponse) GetBtcDelegation() *BTCDelegationResponse {
 	return nil
 }
 
-// BTCDelegationResponse is the client needed information from a BTCDelegation with the current status based on parameters.
-type BTCDelegationResponse struct {
-	// btc_pk is the Bitcoin secp256k1 PK of this BTC delegation
+// QueryCovenantSignedDelegationsRequest is the request type for the
+// Query/CovenantSignedDelegations RPC method.
+type QueryCovenantSignedDelegationsRequest struct {
+	// cov_pk_hex is the hex str of Bitcoin secp256k1 PK of the covenant member
 	// the PK follows encoding in BIP-340 spec
-	BtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
-	// fp_btc_pk_list is the list of BIP-340 PKs of the finality providers that
-	// this BTC delegation delegates to
-	FpBtcPkList []github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,rep,name=fp_btc_pk_list,json=fpBtcPkList,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk_list,omitempty"`
-	// start_height is the start BTC height of the BTC delegation
-	// it is the start BTC height of the timelock
-	StartHeight uint64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
-	// end_height is the end height of the BTC delegation
-	// it is the end BTC height of the timelock - w
-	EndHeight uint64 `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
-	// total_sat is the total amount of BTC stakes in this delegation
-	// quantified in satoshi
-	TotalSat uint64 `protobuf:"varint,5,opt,name=total_sat,json=totalSat,proto3" json:"total_sat,omitempty"`
-	// staking_tx_hex is the hex string of staking tx
-	StakingTxHex string `protobuf:"bytes,6,opt,name=staking_tx_hex,json=stakingTxHex,proto3" json:"staking_tx_hex,omitempty"`
-	// slashing_tx_hex is the hex string of slashing tx
-	SlashingTxHex string `protobuf:"bytes,7,opt,name=slashing_tx_hex,json=slashingTxHex,proto3" json:"slashing_tx_hex,omitempty"`
-	// delegator_slash_sig_hex is the signature on the slashing tx
-	// by the delegator (i.e., SK corresponding to btc_pk) as string hex.
-	// It will be a part of the witness for the staking tx output.
-	DelegatorSlashSigHex string `protobuf:"bytes,8,opt,name=delegator_slash_sig_hex,json=delegatorSlashSigHex,proto3" json:"delegator_slash_sig_hex,omitempty"`
-	// covenant_sigs is a list of adaptor signatures on the slashing tx
-	// by each covenant member
-	// It will be a part of the witness for the staking tx output.
-	CovenantSigs []*CovenantAdaptorSignatures `protobuf:"bytes,9,rep,name=covenant_sigs,json=covenantSigs,proto3" json:"covenant_sigs,omitempty"`
-	// staking_output_idx is the index of the staking output in the staking tx
-	StakingOutputIdx uint32 `protobuf:"varint,10,opt,name=staking_output_idx,json=stakingOutputIdx,proto3" json:"staking_output_idx,omitempty"`
-	// whether this delegation is active
-	Active bool `protobuf:"varint,11,opt,name=active,proto3" json:"active,omitempty"`
-	// descriptive status of current delegation.
-	StatusDesc string `protobuf:"bytes,12,opt,name=status_desc,json=statusDesc,proto3" json:"status_desc,omitempty"`
-	// unbonding_time used in unbonding output timelock path and in slashing transactions
-	// change outputs
-	UnbondingTime uint32 `protobuf:"varint,13,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
-	// undelegation_response is the undelegation info of this delegation.
-	UndelegationResponse *BTCUndelegationResponse `protobuf:"bytes,14,opt,name=undelegation_response,json=undelegationResponse,proto3" json:"undelegation_response,omitempty"`
-	// params version used to validate delegation
-	ParamsVersion uint32 `protobuf:"varint,15,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	CovPkHex string `protobuf:"bytes,1,opt,name=cov_pk_hex,json=covPkHex,proto3" json:"cov_pk_hex,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *BTCDelegationResponse) Reset()         { *m = BTCDelegationResponse{} }
-func (m *BTCDelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*BTCDelegationResponse) ProtoMessage()    {}
-func (*BTCDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{22}
+func (m *QueryCovenantSignedDelegationsRequest) Reset()         { *m = QueryCovenantSignedDelegationsRequest{} }
+func (m *QueryCovenantSignedDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantSignedDelegationsRequest) ProtoMessage()    {}
+func (*QueryCovenantSignedDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{25}
 }
-func (m *BTCDelegationResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryCovenantSignedDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BTCDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCovenantSignedDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BTCDelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCovenantSignedDelegationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1219,149 +1411,191 @@ func (m *BTCDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *BTCDelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BTCDelegationResponse.Merge(m, src)
+func (m *QueryCovenantSignedDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantSignedDelegationsRequest.Merge(m, src)
 }
-func (m *BTCDelegationResponse) XXX_Size() int {
+func (m *QueryCovenantSignedDelegationsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *BTCDelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BTCDelegationResponse.DiscardUnknown(m)
+func (m *QueryCovenantSignedDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantSignedDelegationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BTCDelegationResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryCovenantSignedDelegationsRequest proto.InternalMessageInfo
 
-func (m *BTCDelegationResponse) GetStartHeight() uint64 {
+func (m *QueryCovenantSignedDelegationsRequest) GetCovPkHex() string {
 	if m != nil {
-		return m.StartHeight
+		return m.CovPkHex
 	}
-	return 0
+	return ""
 }
 
-func (m *BTCDelegationResponse) GetEndHeight() uint64 {
+func (m *QueryCovenantSignedDelegationsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.EndHeight
+		return m.Pagination
 	}
-	return 0
+	return nil
 }
 
-func (m *BTCDelegationResponse) GetTotalSat() uint64 {
-	if m != nil {
-		return m.TotalSat
-	}
-	return 0
+// QueryCovenantSignedDelegationsResponse is the response type for the
+// Query/CovenantSignedDelegations RPC method.
+type QueryCovenantSignedDelegationsResponse struct {
+	// btc_delegations contains all the BTC delegations signed by the covenant member
+	BtcDelegations []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=btc_delegations,json=btcDelegations,proto3" json:"btc_delegations,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *BTCDelegationResponse) GetStakingTxHex() string {
-	if m != nil {
-		return m.StakingTxHex
+func (m *QueryCovenantSignedDelegationsResponse) Reset() {
+	*m = QueryCovenantSignedDelegationsResponse{}
+}
+func (m *QueryCovenantSignedDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantSignedDelegationsResponse) ProtoMessage()    {}
+func (*QueryCovenantSignedDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{26}
+}
+func (m *QueryCovenantSignedDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantSignedDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCovenantSignedDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *QueryCovenantSignedDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantSignedDelegationsResponse.Merge(m, src)
+}
+func (m *QueryCovenantSignedDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCovenantSignedDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantSignedDelegationsResponse.DiscardUnknown(m)
 }
 
-func (m *BTCDelegationResponse) GetSlashingTxHex() string {
+var xxx_messageInfo_QueryCovenantSignedDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryCovenantSignedDelegationsResponse) GetBtcDelegations() []*BTCDelegationResponse {
 	if m != nil {
-		return m.SlashingTxHex
+		return m.BtcDelegations
 	}
-	return ""
+	return nil
 }
 
-func (m *BTCDelegationResponse) GetDelegatorSlashSigHex() string {
+func (m *QueryCovenantSignedDelegationsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.DelegatorSlashSigHex
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-func (m *BTCDelegationResponse) GetCovenantSigs() []*CovenantAdaptorSignatures {
-	if m != nil {
-		return m.CovenantSigs
-	}
-	return nil
+// QueryRewardEligibleDelegationsRequest is the request type for the
+// Query/RewardEligibleDelegations RPC method.
+type QueryRewardEligibleDelegationsRequest struct {
+	// fp_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the finality provider
+	// the PK follows encoding in BIP-340 spec
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *BTCDelegationResponse) GetStakingOutputIdx() uint32 {
-	if m != nil {
-		return m.StakingOutputIdx
-	}
-	return 0
+func (m *QueryRewardEligibleDelegationsRequest) Reset()         { *m = QueryRewardEligibleDelegationsRequest{} }
+func (m *QueryRewardEligibleDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardEligibleDelegationsRequest) ProtoMessage()    {}
+func (*QueryRewardEligibleDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{27}
 }
-
-func (m *BTCDelegationResponse) GetActive() bool {
-	if m != nil {
-		return m.Active
-	}
-	return false
+func (m *QueryRewardEligibleDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *BTCDelegationResponse) GetStatusDesc() string {
-	if m != nil {
-		return m.StatusDesc
+func (m *QueryRewardEligibleDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardEligibleDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *QueryRewardEligibleDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardEligibleDelegationsRequest.Merge(m, src)
+}
+func (m *QueryRewardEligibleDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardEligibleDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardEligibleDelegationsRequest.DiscardUnknown(m)
 }
 
-func (m *BTCDelegationResponse) GetUnbondingTime() uint32 {
+var xxx_messageInfo_QueryRewardEligibleDelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryRewardEligibleDelegationsRequest) GetFpBtcPkHex() string {
 	if m != nil {
-		return m.UnbondingTime
+		return m.FpBtcPkHex
 	}
-	return 0
+	return ""
 }
 
-func (m *BTCDelegationResponse) GetUndelegationResponse() *BTCUndelegationResponse {
+func (m *QueryRewardEligibleDelegationsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.UndelegationResponse
+		return m.Pagination
 	}
 	return nil
 }
 
-func (m *BTCDelegationResponse) GetParamsVersion() uint32 {
-	if m != nil {
-		return m.ParamsVersion
-	}
-	return 0
+// QueryRewardEligibleDelegationsResponse is the response type for the
+// Query/RewardEligibleDelegations RPC method.
+type QueryRewardEligibleDelegationsResponse struct {
+	// eligible_delegations contains the active BTC delegations that are in the
+	// voting power distribution cache at dist_cache_height under an active
+	// finality provider, and thus earn rewards whenever it votes
+	EligibleDelegations []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=eligible_delegations,json=eligibleDelegations,proto3" json:"eligible_delegations,omitempty"`
+	// pending_delegations contains the active BTC delegations that do not earn
+	// rewards, either because they are not yet in the voting power distribution
+	// cache at dist_cache_height or because the finality provider is not active
+	PendingDelegations []*BTCDelegationResponse `protobuf:"bytes,2,rep,name=pending_delegations,json=pendingDelegations,proto3" json:"pending_delegations,omitempty"`
+	// dist_cache_height is the Babylon height of the voting power distribution
+	// cache used as the rewards basis
+	DistCacheHeight uint64 `protobuf:"varint,3,opt,name=dist_cache_height,json=distCacheHeight,proto3" json:"dist_cache_height,omitempty"`
+	// btc_tip_height is the BTC tip height used for computing the delegation status
+	BtcTipHeight uint64 `protobuf:"varint,4,opt,name=btc_tip_height,json=btcTipHeight,proto3" json:"btc_tip_height,omitempty"`
+	// btc_confirmation_depth is the BTC confirmation depth k that a staking tx
+	// needs before the delegation can become active
+	BtcConfirmationDepth uint64 `protobuf:"varint,5,opt,name=btc_confirmation_depth,json=btcConfirmationDepth,proto3" json:"btc_confirmation_depth,omitempty"`
+	// checkpoint_finalization_timeout is the timeout w. A delegation stops being
+	// active w BTC blocks before its timelock expires
+	CheckpointFinalizationTimeout uint64 `protobuf:"varint,6,opt,name=checkpoint_finalization_timeout,json=checkpointFinalizationTimeout,proto3" json:"checkpoint_finalization_timeout,omitempty"`
+	// fp_active indicates whether the finality provider is among the active
+	// finality providers at dist_cache_height. Only active finality providers
+	// and their BTC delegations receive rewards
+	FpActive bool `protobuf:"varint,7,opt,name=fp_active,json=fpActive,proto3" json:"fp_active,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,8,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// BTCUndelegationResponse provides all necessary info about the undeleagation
-type BTCUndelegationResponse struct {
-	// unbonding_tx is the transaction which will transfer the funds from staking
-	// output to unbonding output. Unbonding output will usually have lower timelock
-	// than staking output. The unbonding tx as string hex.
-	UnbondingTxHex string `protobuf:"bytes,1,opt,name=unbonding_tx_hex,json=unbondingTxHex,proto3" json:"unbonding_tx_hex,omitempty"`
-	// delegator_unbonding_sig is the signature on the unbonding tx
-	// by the delegator (i.e., SK corresponding to btc_pk).
-	// It effectively proves that the delegator wants to unbond and thus
-	// Babylon will consider this BTC delegation unbonded. Delegator's BTC
-	// on Bitcoin will be unbonded after timelock. The unbonding delegator sig as string hex.
-	DelegatorUnbondingSigHex string `protobuf:"bytes,2,opt,name=delegator_unbonding_sig_hex,json=delegatorUnbondingSigHex,proto3" json:"delegator_unbonding_sig_hex,omitempty"`
-	// covenant_unbonding_sig_list is the list of signatures on the unbonding tx
-	// by covenant members
-	CovenantUnbondingSigList []*SignatureInfo `protobuf:"bytes,3,rep,name=covenant_unbonding_sig_list,json=covenantUnbondingSigList,proto3" json:"covenant_unbonding_sig_list,omitempty"`
-	// slashingTxHex is the hex string of slashing tx
-	SlashingTxHex string `protobuf:"bytes,4,opt,name=slashing_tx_hex,json=slashingTxHex,proto3" json:"slashing_tx_hex,omitempty"`
-	// delegator_slashing_sig is the signature on the slashing tx
-	// by the delegator (i.e., SK corresponding to btc_pk).
-	// It will be a part of the witness for the unbonding tx output.
-	// The delegator slashing sig as string hex.
-	DelegatorSlashingSigHex string `protobuf:"bytes,5,opt,name=delegator_slashing_sig_hex,json=delegatorSlashingSigHex,proto3" json:"delegator_slashing_sig_hex,omitempty"`
-	// covenant_slashing_sigs is a list of adaptor signatures on the
-	// unbonding slashing tx by each covenant member
-	// It will be a part of the witness for the staking tx output.
-	CovenantSlashingSigs []*CovenantAdaptorSignatures `protobuf:"bytes,6,rep,name=covenant_slashing_sigs,json=covenantSlashingSigs,proto3" json:"covenant_slashing_sigs,omitempty"`
+func (m *QueryRewardEligibleDelegationsResponse) Reset() {
+	*m = QueryRewardEligibleDelegationsResponse{}
 }
-
-func (m *BTCUndelegationResponse) Reset()         { *m = BTCUndelegationResponse{} }
-func (m *BTCUndelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*BTCUndelegationResponse) ProtoMessage()    {}
-func (*BTCUndelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{23}
+func (m *QueryRewardEligibleDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardEligibleDelegationsResponse) ProtoMessage()    {}
+func (*QueryRewardEligibleDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{28}
 }
-func (m *BTCUndelegationResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryRewardEligibleDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BTCUndelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRewardEligibleDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BTCUndelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRewardEligibleDelegationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1371,144 +1605,168 @@ func (m *BTCUndelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *BTCUndelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BTCUndelegationResponse.Merge(m, src)
+func (m *QueryRewardEligibleDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardEligibleDelegationsResponse.Merge(m, src)
 }
-func (m *BTCUndelegationResponse) XXX_Size() int {
+func (m *QueryRewardEligibleDelegationsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *BTCUndelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BTCUndelegationResponse.DiscardUnknown(m)
+func (m *QueryRewardEligibleDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardEligibleDelegationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BTCUndelegationResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryRewardEligibleDelegationsResponse proto.InternalMessageInfo
 
-func (m *BTCUndelegationResponse) GetUnbondingTxHex() string {
+func (m *QueryRewardEligibleDelegationsResponse) GetEligibleDelegations() []*BTCDelegationResponse {
 	if m != nil {
-		return m.UnbondingTxHex
+		return m.EligibleDelegations
 	}
-	return ""
+	return nil
 }
 
-func (m *BTCUndelegationResponse) GetDelegatorUnbondingSigHex() string {
+func (m *QueryRewardEligibleDelegationsResponse) GetPendingDelegations() []*BTCDelegationResponse {
 	if m != nil {
-		return m.DelegatorUnbondingSigHex
+		return m.PendingDelegations
 	}
-	return ""
+	return nil
 }
 
-func (m *BTCUndelegationResponse) GetCovenantUnbondingSigList() []*SignatureInfo {
+func (m *QueryRewardEligibleDelegationsResponse) GetDistCacheHeight() uint64 {
 	if m != nil {
-		return m.CovenantUnbondingSigList
+		return m.DistCacheHeight
 	}
-	return nil
+	return 0
 }
 
-func (m *BTCUndelegationResponse) GetSlashingTxHex() string {
+func (m *QueryRewardEligibleDelegationsResponse) GetBtcTipHeight() uint64 {
 	if m != nil {
-		return m.SlashingTxHex
+		return m.BtcTipHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *BTCUndelegationResponse) GetDelegatorSlashingSigHex() string {
+func (m *QueryRewardEligibleDelegationsResponse) GetBtcConfirmationDepth() uint64 {
 	if m != nil {
-		return m.DelegatorSlashingSigHex
+		return m.BtcConfirmationDepth
 	}
-	return ""
+	return 0
 }
 
-func (m *BTCUndelegationResponse) GetCovenantSlashingSigs() []*CovenantAdaptorSignatures {
+func (m *QueryRewardEligibleDelegationsResponse) GetCheckpointFinalizationTimeout() uint64 {
 	if m != nil {
-		return m.CovenantSlashingSigs
+		return m.CheckpointFinalizationTimeout
 	}
-	return nil
-}
-
-// BTCDelegatorDelegationsResponse is a collection of BTC delegations responses from the same delegator.
-type BTCDelegatorDelegationsResponse struct {
-	Dels []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=dels,proto3" json:"dels,omitempty"`
+	return 0
 }
 
-func (m *BTCDelegatorDelegationsResponse) Reset()         { *m = BTCDelegatorDelegationsResponse{} }
-func (m *BTCDelegatorDelegationsResponse) String() string { return proto.CompactTextString(m) }
-func (*BTCDelegatorDelegationsResponse) ProtoMessage()    {}
-func (*BTCDelegatorDelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{24}
-}
-func (m *BTCDelegatorDelegationsResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *BTCDelegatorDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_BTCDelegatorDelegationsResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *QueryRewardEligibleDelegationsResponse) GetFpActive() bool {
+	if m != nil {
+		return m.FpActive
 	}
+	return false
 }
-func (m *BTCDelegatorDelegationsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BTCDelegatorDelegationsResponse.Merge(m, src)
-}
-func (m *BTCDelegatorDelegationsResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *BTCDelegatorDelegationsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BTCDelegatorDelegationsResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_BTCDelegatorDelegationsResponse proto.InternalMessageInfo
 
-func (m *BTCDelegatorDelegationsResponse) GetDels() []*BTCDelegationResponse {
+func (m *QueryRewardEligibleDelegationsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.Dels
+		return m.Pagination
 	}
 	return nil
 }
 
-// FinalityProviderResponse defines a finality provider with voting power information.
-type FinalityProviderResponse struct {
-	// description defines the description terms for the finality provider.
-	Description *types.Description `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
-	// commission defines the commission rate of the finality provider.
-	Commission *cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=commission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission,omitempty"`
-	// babylon_pk is the Babylon secp256k1 PK of this finality provider
-	BabylonPk *secp256k1.PubKey `protobuf:"bytes,3,opt,name=babylon_pk,json=babylonPk,proto3" json:"babylon_pk,omitempty"`
-	// btc_pk is the Bitcoin secp256k1 PK of this finality provider
+// BTCDelegationResponse is the client needed information from a BTCDelegation with the current status based on parameters.
+type BTCDelegationResponse struct {
+	// btc_pk is the Bitcoin secp256k1 PK of this BTC delegation
 	// the PK follows encoding in BIP-340 spec
-	BtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,4,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
+	BtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
+	// fp_btc_pk_list is the list of BIP-340 PKs of the finality providers that
+	// this BTC delegation delegates to
+	FpBtcPkList []github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,rep,name=fp_btc_pk_list,json=fpBtcPkList,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk_list,omitempty"`
+	// start_height is the start BTC height of the BTC delegation
+	// it is the start BTC height of the timelock
+	StartHeight uint64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the end height of the BTC delegation
+	// it is the end BTC height of the timelock - w
+	EndHeight uint64 `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// total_sat is the total amount of BTC stakes in this delegation
+	// quantified in satoshi
+	TotalSat uint64 `protobuf:"varint,5,opt,name=total_sat,json=totalSat,proto3" json:"total_sat,omitempty"`
+	// staking_tx_hex is the hex string of staking tx
+	StakingTxHex string `protobuf:"bytes,6,opt,name=staking_tx_hex,json=stakingTxHex,proto3" json:"staking_tx_hex,omitempty"`
+	// slashing_tx_hex is the hex string of slashing tx
+	SlashingTxHex string `protobuf:"bytes,7,opt,name=slashing_tx_hex,json=slashingTxHex,proto3" json:"slashing_tx_hex,omitempty"`
+	// delegator_slash_sig_hex is the signature on the slashing tx
+	// by the delegator (i.e., SK corresponding to btc_pk) as string hex.
+	// It will be a part of the witness for the staking tx output.
+	DelegatorSlashSigHex string `protobuf:"bytes,8,opt,name=delegator_slash_sig_hex,json=delegatorSlashSigHex,proto3" json:"delegator_slash_sig_hex,omitempty"`
+	// covenant_sigs is a list of adaptor signatures on the slashing tx
+	// by each covenant member
+	// It will be a part of the witness for the staking tx output.
+	CovenantSigs []*CovenantAdaptorSignatures `protobuf:"bytes,9,rep,name=covenant_sigs,json=covenantSigs,proto3" json:"covenant_sigs,omitempty"`
+	// staking_output_idx is the index of the staking output in the staking tx
+	StakingOutputIdx uint32 `protobuf:"varint,10,opt,name=staking_output_idx,json=stakingOutputIdx,proto3" json:"staking_output_idx,omitempty"`
+	// whether this delegation is active
+	Active bool `protobuf:"varint,11,opt,name=active,proto3" json:"active,omitempty"`
+	// descriptive status of current delegation.
+	StatusDesc string `protobuf:"bytes,12,opt,name=status_desc,json=statusDesc,proto3" json:"status_desc,omitempty"`
+	// unbonding_time used in unbonding output timelock path and in slashing transactions
+	// change outputs
+	UnbondingTime uint32 `protobuf:"varint,13,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
+	// undelegation_response is the undelegation info of this delegation.
+	UndelegationResponse *BTCUndelegationResponse `protobuf:"bytes,14,opt,name=undelegation_response,json=undelegationResponse,proto3" json:"undelegation_response,omitempty"`
+	// params version used to validate delegation
+	ParamsVersion uint32 `protobuf:"varint,15,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// created_babylon_height is the Babylon height at which the BTC delegation
+	// was created
+	CreatedBabylonHeight uint64 `protobuf:"varint,16,opt,name=created_babylon_height,json=createdBabylonHeight,proto3" json:"created_babylon_height,omitempty"`
+	// btc_confirmation_depth is the BTC confirmation depth (k) in effect when
+	// the BTC delegation was created
+	BtcConfirmationDepth uint64 `protobuf:"varint,17,opt,name=btc_confirmation_depth,json=btcConfirmationDepth,proto3" json:"btc_confirmation_depth,omitempty"`
+	// checkpoint_finalization_timeout is the checkpoint finalization timeout (w)
+	// in effect when the BTC delegation was created
+	CheckpointFinalizationTimeout uint64 `protobuf:"varint,18,opt,name=checkpoint_finalization_timeout,json=checkpointFinalizationTimeout,proto3" json:"checkpoint_finalization_timeout,omitempty"`
+	// reward_opt_out is whether the delegator opts out of BTC staking rewards
+	RewardOptOut bool `protobuf:"varint,19,opt,name=reward_opt_out,json=rewardOptOut,proto3" json:"reward_opt_out,omitempty"`
+	// staking_tx_hash_hex is the hash of the staking tx in BTC format
+	StakingTxHashHex string `protobuf:"bytes,20,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+	// status is the current status of this delegation. It is the same status as
+	// described in status_desc
+	Status BTCDelegationStatus `protobuf:"varint,21,opt,name=status,proto3,enum=babylon.btcstaking.v1.BTCDelegationStatus" json:"status,omitempty"`
+	// babylon_pk is the Babylon secp256k1 PK of this BTC delegation
+	BabylonPk *secp256k1.PubKey `protobuf:"bytes,22,opt,name=babylon_pk,json=babylonPk,proto3" json:"babylon_pk,omitempty"`
 	// pop is the proof of possession of babylon_pk and btc_pk
-	Pop *ProofOfPossession `protobuf:"bytes,5,opt,name=pop,proto3" json:"pop,omitempty"`
-	// slashed_babylon_height indicates the Babylon height when
-	// the finality provider is slashed.
-	// if it's 0 then the finality provider is not slashed
-	SlashedBabylonHeight uint64 `protobuf:"varint,6,opt,name=slashed_babylon_height,json=slashedBabylonHeight,proto3" json:"slashed_babylon_height,omitempty"`
-	// slashed_btc_height indicates the BTC height when
-	// the finality provider is slashed.
-	// if it's 0 then the finality provider is not slashed
-	SlashedBtcHeight uint64 `protobuf:"varint,7,opt,name=slashed_btc_height,json=slashedBtcHeight,proto3" json:"slashed_btc_height,omitempty"`
-	// height is the queried Babylon height
-	Height uint64 `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
-	// voting_power is the voting power of this finality provider at the given height
-	VotingPower uint64 `protobuf:"varint,9,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	Pop *ProofOfPossession `protobuf:"bytes,23,opt,name=pop,proto3" json:"pop,omitempty"`
+	// invalidated is whether the BTC block that includes the staking tx has
+	// been orphaned by a BTC reorg
+	Invalidated bool `protobuf:"varint,24,opt,name=invalidated,proto3" json:"invalidated,omitempty"`
+	// slashed_btc_height is the BTC height of the block that includes the
+	// slashing tx of this BTC delegation. Zero means the slashing tx is not
+	// observed in BTC
+	SlashedBtcHeight uint64 `protobuf:"varint,25,opt,name=slashed_btc_height,json=slashedBtcHeight,proto3" json:"slashed_btc_height,omitempty"`
+	// reserved is whether the staking tx of this BTC delegation is not
+	// included in BTC yet
+	Reserved bool `protobuf:"varint,26,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	// fp_slashed_before_activation is whether a finality provider that this
+	// BTC delegation restakes to was slashed before the BTC delegation got
+	// activated
+	FpSlashedBeforeActivation bool `protobuf:"varint,27,opt,name=fp_slashed_before_activation,json=fpSlashedBeforeActivation,proto3" json:"fp_slashed_before_activation,omitempty"`
+	// fp_slashed_btc_height is the BTC height at which a finality provider that
+	// this BTC delegation restakes to was slashed, from which on its slashing
+	// tx can be broadcast to BTC
+	FpSlashedBtcHeight uint64 `protobuf:"varint,28,opt,name=fp_slashed_btc_height,json=fpSlashedBtcHeight,proto3" json:"fp_slashed_btc_height,omitempty"`
 }
 
-func (m *FinalityProviderResponse) Reset()         { *m = FinalityProviderResponse{} }
-func (m *FinalityProviderResponse) String() string { return proto.CompactTextString(m) }
-func (*FinalityProviderResponse) ProtoMessage()    {}
-func (*FinalityProviderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{25}
+func (m *BTCDelegationResponse) Reset()         { *m = BTCDelegationResponse{} }
+func (m *BTCDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*BTCDelegationResponse) ProtoMessage()    {}
+func (*BTCDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{29}
 }
-func (m *FinalityProviderResponse) XXX_Unmarshal(b []byte) error {
+func (m *BTCDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *FinalityProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BTCDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_FinalityProviderResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BTCDelegationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1518,2309 +1776,19846 @@ func (m *FinalityProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *FinalityProviderResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FinalityProviderResponse.Merge(m, src)
+func (m *BTCDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCDelegationResponse.Merge(m, src)
 }
-func (m *FinalityProviderResponse) XXX_Size() int {
+func (m *BTCDelegationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *FinalityProviderResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FinalityProviderResponse.DiscardUnknown(m)
+func (m *BTCDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCDelegationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FinalityProviderResponse proto.InternalMessageInfo
+var xxx_messageInfo_BTCDelegationResponse proto.InternalMessageInfo
 
-func (m *FinalityProviderResponse) GetDescription() *types.Description {
+func (m *BTCDelegationResponse) GetStartHeight() uint64 {
 	if m != nil {
-		return m.Description
+		return m.StartHeight
 	}
-	return nil
+	return 0
 }
 
-func (m *FinalityProviderResponse) GetBabylonPk() *secp256k1.PubKey {
+func (m *BTCDelegationResponse) GetEndHeight() uint64 {
 	if m != nil {
-		return m.BabylonPk
+		return m.EndHeight
 	}
-	return nil
+	return 0
 }
 
-func (m *FinalityProviderResponse) GetPop() *ProofOfPossession {
+func (m *BTCDelegationResponse) GetTotalSat() uint64 {
 	if m != nil {
-		return m.Pop
+		return m.TotalSat
 	}
-	return nil
+	return 0
 }
 
-func (m *FinalityProviderResponse) GetSlashedBabylonHeight() uint64 {
+func (m *BTCDelegationResponse) GetStakingTxHex() string {
 	if m != nil {
-		return m.SlashedBabylonHeight
+		return m.StakingTxHex
 	}
-	return 0
+	return ""
 }
 
-func (m *FinalityProviderResponse) GetSlashedBtcHeight() uint64 {
+func (m *BTCDelegationResponse) GetSlashingTxHex() string {
 	if m != nil {
-		return m.SlashedBtcHeight
+		return m.SlashingTxHex
 	}
-	return 0
+	return ""
 }
 
-func (m *FinalityProviderResponse) GetHeight() uint64 {
+func (m *BTCDelegationResponse) GetDelegatorSlashSigHex() string {
 	if m != nil {
-		return m.Height
+		return m.DelegatorSlashSigHex
 	}
-	return 0
+	return ""
 }
 
-func (m *FinalityProviderResponse) GetVotingPower() uint64 {
+func (m *BTCDelegationResponse) GetCovenantSigs() []*CovenantAdaptorSignatures {
 	if m != nil {
-		return m.VotingPower
+		return m.CovenantSigs
 	}
-	return 0
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.btcstaking.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.btcstaking.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryParamsByVersionRequest)(nil), "babylon.btcstaking.v1.QueryParamsByVersionRequest")
-	proto.RegisterType((*QueryParamsByVersionResponse)(nil), "babylon.btcstaking.v1.QueryParamsByVersionResponse")
-	proto.RegisterType((*QueryFinalityProvidersRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProvidersRequest")
-	proto.RegisterType((*QueryFinalityProvidersResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProvidersResponse")
-	proto.RegisterType((*QueryFinalityProviderRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderRequest")
-	proto.RegisterType((*QueryFinalityProviderResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderResponse")
-	proto.RegisterType((*QueryBTCDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsRequest")
-	proto.RegisterType((*QueryBTCDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsResponse")
-	proto.RegisterType((*QueryFinalityProviderPowerAtHeightRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderPowerAtHeightRequest")
-	proto.RegisterType((*QueryFinalityProviderPowerAtHeightResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderPowerAtHeightResponse")
-	proto.RegisterType((*QueryFinalityProviderCurrentPowerRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderCurrentPowerRequest")
-	proto.RegisterType((*QueryFinalityProviderCurrentPowerResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderCurrentPowerResponse")
-	proto.RegisterType((*QueryActiveFinalityProvidersAtHeightRequest)(nil), "babylon.btcstaking.v1.QueryActiveFinalityProvidersAtHeightRequest")
-	proto.RegisterType((*QueryActiveFinalityProvidersAtHeightResponse)(nil), "babylon.btcstaking.v1.QueryActiveFinalityProvidersAtHeightResponse")
-	proto.RegisterType((*QueryActivatedHeightRequest)(nil), "babylon.btcstaking.v1.QueryActivatedHeightRequest")
-	proto.RegisterType((*QueryActivatedHeightResponse)(nil), "babylon.btcstaking.v1.QueryActivatedHeightResponse")
-	proto.RegisterType((*QueryFinalityProviderDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationsRequest")
-	proto.RegisterType((*QueryFinalityProviderDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationsResponse")
-	proto.RegisterType((*QueryBTCDelegationRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationRequest")
-	proto.RegisterType((*QueryBTCDelegationResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationResponse")
-	proto.RegisterType((*BTCDelegationResponse)(nil), "babylon.btcstaking.v1.BTCDelegationResponse")
-	proto.RegisterType((*BTCUndelegationResponse)(nil), "babylon.btcstaking.v1.BTCUndelegationResponse")
-	proto.RegisterType((*BTCDelegatorDelegationsResponse)(nil), "babylon.btcstaking.v1.BTCDelegatorDelegationsResponse")
-	proto.RegisterType((*FinalityProviderResponse)(nil), "babylon.btcstaking.v1.FinalityProviderResponse")
+func (m *BTCDelegationResponse) GetStakingOutputIdx() uint32 {
+	if m != nil {
+		return m.StakingOutputIdx
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("babylon/btcstaking/v1/query.proto", fileDescriptor_74d49d26f7429697) }
-
-var fileDescriptor_74d49d26f7429697 = []byte{
-	// 1869 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4f, 0x6c, 0x13, 0xd9,
-	0x19, 0x67, 0x92, 0x60, 0xc8, 0x97, 0x3f, 0x84, 0xb7, 0x01, 0x8c, 0x43, 0x62, 0x98, 0xb2, 0x10,
-	0x58, 0x98, 0x21, 0x26, 0x50, 0x69, 0xb7, 0x05, 0x62, 0xb2, 0x0b, 0xec, 0x12, 0xe1, 0x4e, 0xa0,
-	0x95, 0xba, 0x55, 0xad, 0xf1, 0xf8, 0x79, 0x3c, 0x8a, 0x3d, 0x6f, 0x98, 0xf7, 0x9c, 0xda, 0x42,
-	0xb9, 0xf4, 0xd0, 0x5b, 0xa5, 0x4a, 0xed, 0xa1, 0xaa, 0xd4, 0x73, 0x2b, 0xf5, 0xd8, 0x3d, 0x55,
-	0xea, 0x7d, 0x7b, 0x5b, 0x6d, 0x0f, 0xad, 0x38, 0xa0, 0x0a, 0xaa, 0x56, 0xaa, 0xd4, 0x6b, 0xcf,
-	0xd5, 0xbc, 0xf7, 0xc6, 0x33, 0xb6, 0x67, 0x1c, 0x3b, 0xc9, 0xde, 0xe2, 0x79, 0xdf, 0xff, 0xef,
-	0xf7, 0x7e, 0xef, 0xbd, 0x2f, 0x70, 0xa9, 0x62, 0x56, 0x3a, 0x0d, 0xe2, 0xea, 0x15, 0x66, 0x51,
-	0x66, 0xee, 0x38, 0xae, 0xad, 0xef, 0xae, 0xe9, 0x2f, 0x5b, 0xd8, 0xef, 0x68, 0x9e, 0x4f, 0x18,
-	0x41, 0x67, 0xa4, 0x88, 0x16, 0x89, 0x68, 0xbb, 0x6b, 0xb9, 0x45, 0x9b, 0xd8, 0x84, 0x4b, 0xe8,
-	0xc1, 0x5f, 0x42, 0x38, 0x77, 0xc1, 0x26, 0xc4, 0x6e, 0x60, 0xdd, 0xf4, 0x1c, 0xdd, 0x74, 0x5d,
-	0xc2, 0x4c, 0xe6, 0x10, 0x97, 0xca, 0xd5, 0xf3, 0x16, 0xa1, 0x4d, 0x42, 0xcb, 0x42, 0x4d, 0xfc,
-	0x90, 0x4b, 0xaa, 0xf8, 0xa5, 0x5b, 0x7e, 0xc7, 0x63, 0x44, 0xa7, 0xd8, 0xf2, 0x0a, 0x77, 0xee,
-	0xee, 0xac, 0xe9, 0x3b, 0xb8, 0x13, 0xca, 0x5c, 0x96, 0x32, 0x51, 0xa0, 0x15, 0xcc, 0xcc, 0xb5,
-	0xf0, 0xb7, 0x94, 0xba, 0x2e, 0xa5, 0x2a, 0x26, 0xc5, 0x22, 0x91, 0xae, 0xa0, 0x67, 0xda, 0x8e,
-	0xcb, 0x23, 0x0a, 0xbd, 0x26, 0xa7, 0xef, 0x99, 0xbe, 0xd9, 0x0c, 0xbd, 0x5e, 0x49, 0x96, 0x89,
-	0x55, 0x43, 0xc8, 0xe5, 0x53, 0x6c, 0x11, 0x4f, 0x08, 0xa8, 0x8b, 0x80, 0xbe, 0x17, 0x84, 0x53,
-	0xe2, 0xd6, 0x0d, 0xfc, 0xb2, 0x85, 0x29, 0x53, 0x0d, 0x78, 0xaf, 0xe7, 0x2b, 0xf5, 0x88, 0x4b,
-	0x31, 0xfa, 0x08, 0x32, 0x22, 0x8a, 0xac, 0x72, 0x51, 0x59, 0x9d, 0x29, 0x2c, 0x6b, 0x89, 0x6d,
-	0xd0, 0x84, 0x5a, 0x71, 0xea, 0xcb, 0x37, 0xf9, 0x63, 0x86, 0x54, 0x51, 0xbf, 0x0d, 0x4b, 0x31,
-	0x9b, 0xc5, 0xce, 0xf7, 0xb1, 0x4f, 0x1d, 0xe2, 0x4a, 0x97, 0x28, 0x0b, 0x27, 0x76, 0xc5, 0x17,
-	0x6e, 0x7c, 0xce, 0x08, 0x7f, 0xaa, 0x9f, 0xc3, 0x85, 0x64, 0xc5, 0xa3, 0x88, 0xca, 0x86, 0x65,
-	0x6e, 0xfc, 0x13, 0xc7, 0x35, 0x1b, 0x0e, 0xeb, 0x94, 0x7c, 0xb2, 0xeb, 0x54, 0xb1, 0x1f, 0x96,
-	0x02, 0x7d, 0x02, 0x10, 0x75, 0x48, 0x7a, 0xb8, 0xa2, 0x49, 0x98, 0x04, 0xed, 0xd4, 0x04, 0x2e,
-	0x65, 0x3b, 0xb5, 0x92, 0x69, 0x63, 0xa9, 0x6b, 0xc4, 0x34, 0xd5, 0xbf, 0x28, 0xb0, 0x92, 0xe6,
-	0x49, 0x26, 0xf2, 0x63, 0x40, 0x35, 0xb9, 0x18, 0xa0, 0x51, 0xac, 0x66, 0x95, 0x8b, 0x93, 0xab,
-	0x33, 0x05, 0x3d, 0x25, 0xa9, 0x7e, 0x6b, 0xa1, 0x31, 0xe3, 0x74, 0xad, 0xdf, 0x0f, 0x7a, 0xd4,
-	0x93, 0xca, 0x04, 0x4f, 0xe5, 0xea, 0xbe, 0xa9, 0x48, 0x7b, 0xf1, 0x5c, 0x36, 0x64, 0x47, 0x06,
-	0x9d, 0x8b, 0x9a, 0x5d, 0x82, 0xb9, 0x9a, 0x57, 0xae, 0x30, 0xab, 0xec, 0xed, 0x94, 0xeb, 0xb8,
-	0xcd, 0xcb, 0x36, 0x6d, 0x40, 0xcd, 0x2b, 0x32, 0xab, 0xb4, 0xf3, 0x18, 0xb7, 0xd5, 0xbd, 0x94,
-	0xba, 0x77, 0x8b, 0xf1, 0x23, 0x38, 0x3d, 0x50, 0x0c, 0x59, 0xfe, 0xb1, 0x6b, 0xb1, 0xd0, 0x5f,
-	0x0b, 0xf5, 0xf7, 0x0a, 0xe4, 0xb8, 0xff, 0xe2, 0xf3, 0x87, 0x9b, 0xb8, 0x81, 0x6d, 0x41, 0x09,
-	0x61, 0x02, 0x45, 0xc8, 0x50, 0x66, 0xb2, 0x96, 0x80, 0xd4, 0x7c, 0xe1, 0x7a, 0x8a, 0xc7, 0x1e,
-	0xed, 0x6d, 0xae, 0x61, 0x48, 0xcd, 0x3e, 0xe0, 0x4c, 0x1c, 0x18, 0x38, 0x7f, 0x56, 0xe4, 0xc6,
-	0xe9, 0x0f, 0x55, 0x16, 0xea, 0x05, 0x9c, 0x0a, 0x2a, 0x5d, 0x8d, 0x96, 0x24, 0x64, 0x6e, 0x8c,
-	0x12, 0x74, 0xb7, 0x46, 0xf3, 0x15, 0x66, 0xc5, 0xcc, 0x1f, 0x1d, 0x58, 0x6a, 0x70, 0x2d, 0xb1,
-	0xd3, 0x25, 0xf2, 0x13, 0xec, 0x6f, 0xb0, 0xc7, 0xd8, 0xb1, 0xeb, 0x6c, 0x74, 0xe4, 0xa0, 0xb3,
-	0x90, 0xa9, 0x73, 0x1d, 0x1e, 0xd4, 0x94, 0x21, 0x7f, 0xa9, 0xcf, 0xe0, 0xfa, 0x28, 0x7e, 0x64,
-	0xd5, 0x2e, 0xc1, 0xec, 0x2e, 0x61, 0x8e, 0x6b, 0x97, 0xbd, 0x60, 0x9d, 0xfb, 0x99, 0x32, 0x66,
-	0xc4, 0x37, 0xae, 0xa2, 0x6e, 0xc1, 0x6a, 0xa2, 0xc1, 0x87, 0x2d, 0xdf, 0xc7, 0x2e, 0xe3, 0x42,
-	0x63, 0x20, 0x3e, 0xad, 0x0e, 0xbd, 0xe6, 0x64, 0x78, 0x51, 0x92, 0x4a, 0x3c, 0xc9, 0x81, 0xb0,
-	0x27, 0x06, 0xc3, 0xfe, 0xb9, 0x02, 0x1f, 0x70, 0x47, 0x1b, 0x16, 0x73, 0x76, 0xf1, 0x00, 0xdd,
-	0xf4, 0x97, 0x3c, 0xcd, 0xd5, 0x51, 0xe1, 0xf7, 0x6f, 0x0a, 0xdc, 0x18, 0x2d, 0x9e, 0x23, 0xa4,
-	0xc1, 0x1f, 0x38, 0xac, 0xbe, 0x85, 0x99, 0xf9, 0x8d, 0xd2, 0xe0, 0xb2, 0xdc, 0x98, 0x3c, 0x31,
-	0x93, 0xe1, 0x6a, 0x4f, 0x61, 0xd5, 0xbb, 0x92, 0x25, 0x07, 0x96, 0x87, 0xf7, 0x58, 0xfd, 0x95,
-	0x02, 0x57, 0x13, 0x91, 0x92, 0x40, 0x54, 0x23, 0xec, 0x97, 0xa3, 0xea, 0xe3, 0xbf, 0x95, 0x94,
-	0xfd, 0x90, 0x44, 0x4a, 0x3e, 0x9c, 0x8f, 0x91, 0x12, 0xf1, 0x13, 0xe8, 0xe9, 0xee, 0xbe, 0xf4,
-	0x44, 0x92, 0x4c, 0x1b, 0xe7, 0x22, 0xa2, 0xea, 0x11, 0x38, 0xba, 0xbe, 0x7e, 0x0a, 0xe7, 0x07,
-	0x09, 0x37, 0xac, 0xf8, 0x4d, 0x78, 0x4f, 0x06, 0x5b, 0x66, 0xed, 0x72, 0xdd, 0xa4, 0xf5, 0x58,
-	0xdd, 0x17, 0xe4, 0xd2, 0xf3, 0xf6, 0x63, 0x93, 0xd6, 0x83, 0x5d, 0xff, 0x32, 0xe9, 0x9c, 0xe9,
-	0x96, 0x69, 0x1b, 0xe6, 0x7b, 0xb9, 0x5b, 0x9e, 0x70, 0xe3, 0x51, 0xf7, 0x5c, 0x0f, 0x75, 0xab,
-	0xbf, 0xce, 0xc0, 0x99, 0x64, 0x77, 0x5b, 0x90, 0x11, 0x50, 0xe1, 0x6e, 0x66, 0x8b, 0x77, 0x5f,
-	0xbf, 0xc9, 0x17, 0x6c, 0x87, 0xd5, 0x5b, 0x15, 0xcd, 0x22, 0x4d, 0x5d, 0x3a, 0xb5, 0xea, 0xa6,
-	0xe3, 0x86, 0x3f, 0x74, 0xd6, 0xf1, 0x30, 0xd5, 0x8a, 0x4f, 0x4a, 0xb7, 0xd7, 0x6f, 0x95, 0x5a,
-	0x95, 0xcf, 0x70, 0xc7, 0x38, 0x5e, 0x09, 0xc0, 0x85, 0x3e, 0x87, 0xf9, 0x08, 0x7c, 0x0d, 0x87,
-	0x06, 0x8c, 0x3c, 0x79, 0x08, 0xb3, 0x33, 0x12, 0xb5, 0x4f, 0x1d, 0x8e, 0xec, 0x59, 0xca, 0x4c,
-	0x9f, 0x95, 0xe5, 0x1e, 0x99, 0x14, 0x4c, 0xc7, 0xbf, 0x89, 0x8d, 0x84, 0x96, 0x01, 0xb0, 0x5b,
-	0x0d, 0x05, 0xa6, 0xb8, 0xc0, 0x34, 0x76, 0xe5, 0x3e, 0x43, 0x4b, 0x30, 0xcd, 0x08, 0x33, 0x1b,
-	0x65, 0x6a, 0xb2, 0xec, 0x71, 0xbe, 0x7a, 0x92, 0x7f, 0xd8, 0x36, 0x19, 0xba, 0x0c, 0xf3, 0xf1,
-	0x36, 0xe2, 0x76, 0x36, 0xc3, 0x3b, 0x38, 0x1b, 0x75, 0x10, 0xb7, 0xd1, 0x15, 0x38, 0x45, 0x1b,
-	0x26, 0xad, 0xc7, 0xc4, 0x4e, 0x70, 0xb1, 0xb9, 0xf0, 0xb3, 0x90, 0xbb, 0x03, 0xe7, 0x22, 0xa8,
-	0xf3, 0xa5, 0x32, 0x75, 0x6c, 0x2e, 0x7f, 0x92, 0xcb, 0x2f, 0x76, 0x97, 0xb7, 0x83, 0xd5, 0x6d,
-	0xc7, 0x0e, 0xd4, 0x5e, 0xc0, 0x9c, 0x45, 0x76, 0xb1, 0x6b, 0xba, 0x2c, 0x90, 0xa7, 0xd9, 0x69,
-	0xbe, 0x33, 0x6e, 0xa5, 0x74, 0xff, 0xa1, 0x94, 0xdd, 0xa8, 0x9a, 0x5e, 0x60, 0xc9, 0xb1, 0x5d,
-	0x93, 0xb5, 0x7c, 0x4c, 0x8d, 0xd9, 0xd0, 0xcc, 0xb6, 0x63, 0x53, 0x74, 0x03, 0x50, 0x98, 0x1b,
-	0x69, 0x31, 0xaf, 0xc5, 0xca, 0x4e, 0xb5, 0x9d, 0x05, 0x7e, 0xab, 0x0e, 0x11, 0xfa, 0x8c, 0x2f,
-	0x3c, 0xa9, 0xf2, 0xf3, 0xd4, 0xe4, 0xcc, 0x9c, 0x9d, 0xb9, 0xa8, 0xac, 0x9e, 0x34, 0xe4, 0x2f,
-	0x94, 0x87, 0x19, 0x71, 0x93, 0x29, 0x57, 0x31, 0xb5, 0xb2, 0xb3, 0x82, 0x58, 0xc4, 0xa7, 0x4d,
-	0x4c, 0x2d, 0xf4, 0x3e, 0xcc, 0xb7, 0xdc, 0x0a, 0x71, 0xab, 0xbc, 0x3a, 0x4e, 0x13, 0x67, 0xe7,
-	0xb8, 0x8b, 0xb9, 0xee, 0xd7, 0xe7, 0x4e, 0x13, 0x23, 0x0b, 0xce, 0xb4, 0xdc, 0x08, 0xe1, 0x65,
-	0x5f, 0xa2, 0x31, 0x3b, 0xcf, 0xa1, 0xae, 0xa5, 0x43, 0xfd, 0x45, 0x4c, 0xad, 0x0b, 0xf6, 0xc5,
-	0x56, 0xc2, 0xd7, 0x20, 0x16, 0x71, 0xa1, 0x2f, 0x87, 0x8f, 0x88, 0x53, 0x22, 0x16, 0xf1, 0x55,
-	0x3e, 0x19, 0xd4, 0x2f, 0x26, 0xe1, 0x5c, 0x8a, 0x61, 0xb4, 0x0a, 0x0b, 0xb1, 0x74, 0xda, 0xb1,
-	0x5d, 0x1d, 0xa5, 0x29, 0xba, 0xfd, 0x5d, 0x58, 0x8a, 0xba, 0x1d, 0xe9, 0x84, 0x1d, 0x9f, 0xe0,
-	0x4a, 0xd9, 0xae, 0xc8, 0x8b, 0x50, 0x42, 0x76, 0xdd, 0x82, 0xa5, 0x6e, 0xd7, 0x7b, 0xb5, 0xf9,
-	0x1e, 0x9a, 0xe4, 0x18, 0xb8, 0x9c, 0x52, 0x96, 0x6e, 0xd3, 0x9f, 0xb8, 0x35, 0x62, 0x64, 0x43,
-	0x43, 0x71, 0x1f, 0x7c, 0xfb, 0x24, 0x20, 0x77, 0x2a, 0x09, 0xb9, 0x1f, 0x41, 0xae, 0x0f, 0xb9,
-	0xf1, 0x54, 0x8e, 0x73, 0x95, 0x73, 0xbd, 0xe0, 0x8d, 0x32, 0xa9, 0xc1, 0xd9, 0x08, 0xbf, 0x31,
-	0x5d, 0x9a, 0xcd, 0x1c, 0x10, 0xc8, 0x8b, 0x5d, 0x20, 0x47, 0x9e, 0xa8, 0x6a, 0x41, 0x7e, 0x9f,
-	0x53, 0x01, 0x3d, 0x80, 0xa9, 0x2a, 0x6e, 0x1c, 0xec, 0xea, 0xcb, 0x35, 0xd5, 0xdf, 0x4c, 0x41,
-	0x36, 0xf5, 0x35, 0xf2, 0x31, 0xcc, 0x04, 0xbb, 0xc0, 0x77, 0xbc, 0x18, 0x4b, 0x7f, 0x2b, 0x3c,
-	0x5c, 0x22, 0x0f, 0xe2, 0x64, 0xd9, 0x8c, 0x44, 0x8d, 0xb8, 0x1e, 0xda, 0x02, 0xb0, 0x48, 0xb3,
-	0xe9, 0x50, 0x1a, 0x1e, 0x51, 0xd3, 0xc5, 0x9b, 0xaf, 0xdf, 0xe4, 0x97, 0x84, 0x21, 0x5a, 0xdd,
-	0xd1, 0x1c, 0xa2, 0x37, 0x4d, 0x56, 0xd7, 0x9e, 0x62, 0xdb, 0xb4, 0x3a, 0x9b, 0xd8, 0xfa, 0xfa,
-	0x8b, 0x9b, 0x20, 0xfd, 0x6c, 0x62, 0xcb, 0x88, 0x19, 0x40, 0xf7, 0x00, 0x64, 0x9e, 0x01, 0xa7,
-	0x4f, 0xf2, 0xa0, 0xf2, 0x61, 0x50, 0x62, 0x68, 0xa1, 0x75, 0x87, 0x16, 0x9a, 0x64, 0xd9, 0x69,
-	0xa9, 0x52, 0xda, 0x89, 0x9d, 0x07, 0x53, 0x47, 0x71, 0x1e, 0x7c, 0x08, 0x93, 0x1e, 0xf1, 0x38,
-	0x68, 0x66, 0x0a, 0xab, 0x69, 0xaf, 0x70, 0x9f, 0x90, 0xda, 0xb3, 0x5a, 0x89, 0x50, 0x8a, 0x79,
-	0x16, 0x46, 0xa0, 0x84, 0xd6, 0xe1, 0x2c, 0x47, 0x10, 0xae, 0x96, 0xc3, 0x94, 0x24, 0xaf, 0x67,
-	0x38, 0x73, 0x2f, 0xca, 0xd5, 0xa2, 0x58, 0x94, 0x14, 0x1f, 0x30, 0x5d, 0xa8, 0xc5, 0xac, 0x50,
-	0xe3, 0x04, 0xd7, 0x58, 0x08, 0x35, 0x98, 0x25, 0xa5, 0xa3, 0x0b, 0xd7, 0xc9, 0xa1, 0x97, 0xea,
-	0xe9, 0x81, 0x4b, 0x75, 0xe1, 0xb7, 0xa7, 0xe1, 0x38, 0x3f, 0xc7, 0xd1, 0xcf, 0x14, 0xc8, 0x88,
-	0x49, 0x02, 0xba, 0x96, 0x92, 0xe2, 0xe0, 0x40, 0x25, 0x77, 0x7d, 0x14, 0x51, 0x81, 0x35, 0xf5,
-	0xfd, 0x9f, 0xfe, 0xf5, 0x9f, 0xbf, 0x9c, 0xc8, 0xa3, 0x65, 0x7d, 0xd8, 0x20, 0x08, 0xfd, 0x41,
-	0x81, 0x53, 0x7d, 0x23, 0x11, 0x54, 0xd8, 0xdf, 0x4d, 0xff, 0xe0, 0x25, 0x77, 0x7b, 0x2c, 0x1d,
-	0x19, 0xa3, 0xce, 0x63, 0xbc, 0x86, 0xae, 0x0e, 0x8d, 0x51, 0x7f, 0x25, 0xd9, 0x78, 0x0f, 0xfd,
-	0x51, 0x81, 0xd3, 0x03, 0x57, 0x7f, 0xb4, 0x3e, 0xcc, 0x77, 0xda, 0x48, 0x26, 0x77, 0x67, 0x4c,
-	0x2d, 0x19, 0xf3, 0x1a, 0x8f, 0xf9, 0x03, 0x74, 0x2d, 0x25, 0xe6, 0xc1, 0x47, 0x07, 0xfa, 0x5a,
-	0x81, 0x85, 0x7e, 0x83, 0xe8, 0xf6, 0x38, 0xee, 0xc3, 0x98, 0xd7, 0xc7, 0x53, 0x92, 0x21, 0x6f,
-	0xf3, 0x90, 0xb7, 0xd0, 0x67, 0x23, 0x87, 0xac, 0xbf, 0xea, 0x79, 0x0f, 0xec, 0x0d, 0x8a, 0xa0,
-	0xdf, 0x29, 0x30, 0xdf, 0x3b, 0x4b, 0x40, 0x6b, 0xc3, 0xa2, 0x4b, 0x1c, 0x91, 0xe4, 0x0a, 0xe3,
-	0xa8, 0xc8, 0x74, 0x34, 0x9e, 0xce, 0x2a, 0xba, 0xa2, 0xa7, 0x8e, 0x2f, 0xe3, 0x0f, 0x05, 0xf4,
-	0x2f, 0x05, 0xf2, 0xfb, 0xbc, 0x1a, 0x51, 0x71, 0x58, 0x1c, 0xa3, 0x3d, 0x81, 0x73, 0x0f, 0x0f,
-	0x65, 0x43, 0x26, 0xf7, 0x21, 0x4f, 0x6e, 0x1d, 0x15, 0xc6, 0xe8, 0x95, 0x20, 0xa0, 0x3d, 0xf4,
-	0x3f, 0x05, 0x96, 0x87, 0xce, 0x2d, 0xd0, 0x83, 0x71, 0xf0, 0x93, 0x34, 0x5a, 0xc9, 0x6d, 0x1c,
-	0xc2, 0x82, 0x4c, 0xb1, 0xc4, 0x53, 0xfc, 0x14, 0x3d, 0x3e, 0x38, 0x1c, 0x39, 0xc3, 0x46, 0x89,
-	0xff, 0x47, 0x81, 0x0b, 0xc3, 0x06, 0x22, 0xe8, 0xfe, 0x38, 0x51, 0x27, 0x4c, 0x66, 0x72, 0x0f,
-	0x0e, 0x6e, 0x40, 0x66, 0xfd, 0x88, 0x67, 0xbd, 0x81, 0xee, 0x1f, 0x32, 0x6b, 0xce, 0xd8, 0x7d,
-	0xc3, 0x80, 0xe1, 0x8c, 0x9d, 0x3c, 0x58, 0x18, 0xce, 0xd8, 0x29, 0xd3, 0x86, 0x7d, 0x19, 0xdb,
-	0x0c, 0xf5, 0xe4, 0x29, 0x8a, 0xfe, 0xab, 0xc0, 0xd2, 0x90, 0xa7, 0x3e, 0xba, 0x37, 0x4e, 0x61,
-	0x13, 0x08, 0xe4, 0xfe, 0x81, 0xf5, 0x65, 0x46, 0x5b, 0x3c, 0xa3, 0x47, 0xe8, 0xe3, 0x83, 0xf7,
-	0x25, 0x4e, 0x36, 0x7f, 0x52, 0x60, 0xae, 0x87, 0xb7, 0xd0, 0xad, 0x91, 0x29, 0x2e, 0xcc, 0x69,
-	0x6d, 0x0c, 0x0d, 0x99, 0xc5, 0x26, 0xcf, 0xe2, 0x1e, 0xfa, 0xce, 0x68, 0x9c, 0xa8, 0xbf, 0x4a,
-	0x98, 0x3e, 0xec, 0x15, 0x9f, 0x7e, 0xf9, 0x76, 0x45, 0xf9, 0xea, 0xed, 0x8a, 0xf2, 0x8f, 0xb7,
-	0x2b, 0xca, 0x2f, 0xde, 0xad, 0x1c, 0xfb, 0xea, 0xdd, 0xca, 0xb1, 0xbf, 0xbf, 0x5b, 0x39, 0xf6,
-	0xc3, 0x7d, 0xef, 0x73, 0xed, 0xb8, 0x43, 0x7e, 0xb9, 0xab, 0x64, 0xf8, 0xff, 0x86, 0x6e, 0xff,
-	0x3f, 0x00, 0x00, 0xff, 0xff, 0xa8, 0x9b, 0xda, 0xbf, 0x89, 0x1b, 0x00, 0x00,
+func (m *BTCDelegationResponse) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *BTCDelegationResponse) GetStatusDesc() string {
+	if m != nil {
+		return m.StatusDesc
+	}
+	return ""
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ParamsByVersion queries the parameters of the module for a specific version of past params.
-	ParamsByVersion(ctx context.Context, in *QueryParamsByVersionRequest, opts ...grpc.CallOption) (*QueryParamsByVersionResponse, error)
-	// FinalityProviders queries all finality providers
-	FinalityProviders(ctx context.Context, in *QueryFinalityProvidersRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersResponse, error)
-	// FinalityProvider info about one finality provider
-	FinalityProvider(ctx context.Context, in *QueryFinalityProviderRequest, opts ...grpc.CallOption) (*QueryFinalityProviderResponse, error)
-	// BTCDelegations queries all BTC delegations under a given status
-	BTCDelegations(ctx context.Context, in *QueryBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsResponse, error)
-	// ActiveFinalityProvidersAtHeight queries finality providers with non zero voting power at given height.
-	ActiveFinalityProvidersAtHeight(ctx context.Context, in *QueryActiveFinalityProvidersAtHeightRequest, opts ...grpc.CallOption) (*QueryActiveFinalityProvidersAtHeightResponse, error)
-	// FinalityProviderPowerAtHeight queries the voting power of a finality provider at a given height
-	FinalityProviderPowerAtHeight(ctx context.Context, in *QueryFinalityProviderPowerAtHeightRequest, opts ...grpc.CallOption) (*QueryFinalityProviderPowerAtHeightResponse, error)
-	// FinalityProviderCurrentPower queries the voting power of a finality provider at the current height
-	FinalityProviderCurrentPower(ctx context.Context, in *QueryFinalityProviderCurrentPowerRequest, opts ...grpc.CallOption) (*QueryFinalityProviderCurrentPowerResponse, error)
-	// ActivatedHeight queries the height when BTC staking protocol is activated, i.e., the first height when
-	// there exists 1 finality provider with voting power
-	ActivatedHeight(ctx context.Context, in *QueryActivatedHeightRequest, opts ...grpc.CallOption) (*QueryActivatedHeightResponse, error)
-	// FinalityProviderDelegations queries all BTC delegations of the given finality provider
-	FinalityProviderDelegations(ctx context.Context, in *QueryFinalityProviderDelegationsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderDelegationsResponse, error)
-	// BTCDelegation retrieves delegation by corresponding staking tx hash
-	BTCDelegation(ctx context.Context, in *QueryBTCDelegationRequest, opts ...grpc.CallOption) (*QueryBTCDelegationResponse, error)
+func (m *BTCDelegationResponse) GetUnbondingTime() uint32 {
+	if m != nil {
+		return m.UnbondingTime
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *BTCDelegationResponse) GetUndelegationResponse() *BTCUndelegationResponse {
+	if m != nil {
+		return m.UndelegationResponse
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *BTCDelegationResponse) GetParamsVersion() uint32 {
+	if m != nil {
+		return m.ParamsVersion
+	}
+	return 0
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetCreatedBabylonHeight() uint64 {
+	if m != nil {
+		return m.CreatedBabylonHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) ParamsByVersion(ctx context.Context, in *QueryParamsByVersionRequest, opts ...grpc.CallOption) (*QueryParamsByVersionResponse, error) {
-	out := new(QueryParamsByVersionResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ParamsByVersion", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetBtcConfirmationDepth() uint64 {
+	if m != nil {
+		return m.BtcConfirmationDepth
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) FinalityProviders(ctx context.Context, in *QueryFinalityProvidersRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersResponse, error) {
-	out := new(QueryFinalityProvidersResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetCheckpointFinalizationTimeout() uint64 {
+	if m != nil {
+		return m.CheckpointFinalizationTimeout
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) FinalityProvider(ctx context.Context, in *QueryFinalityProviderRequest, opts ...grpc.CallOption) (*QueryFinalityProviderResponse, error) {
-	out := new(QueryFinalityProviderResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetRewardOptOut() bool {
+	if m != nil {
+		return m.RewardOptOut
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) BTCDelegations(ctx context.Context, in *QueryBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsResponse, error) {
-	out := new(QueryBTCDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ActiveFinalityProvidersAtHeight(ctx context.Context, in *QueryActiveFinalityProvidersAtHeightRequest, opts ...grpc.CallOption) (*QueryActiveFinalityProvidersAtHeightResponse, error) {
-	out := new(QueryActiveFinalityProvidersAtHeightResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ActiveFinalityProvidersAtHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetStatus() BTCDelegationStatus {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return BTCDelegationStatus_PENDING
 }
 
-func (c *queryClient) FinalityProviderPowerAtHeight(ctx context.Context, in *QueryFinalityProviderPowerAtHeightRequest, opts ...grpc.CallOption) (*QueryFinalityProviderPowerAtHeightResponse, error) {
-	out := new(QueryFinalityProviderPowerAtHeightResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderPowerAtHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetBabylonPk() *secp256k1.PubKey {
+	if m != nil {
+		return m.BabylonPk
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) FinalityProviderCurrentPower(ctx context.Context, in *QueryFinalityProviderCurrentPowerRequest, opts ...grpc.CallOption) (*QueryFinalityProviderCurrentPowerResponse, error) {
-	out := new(QueryFinalityProviderCurrentPowerResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderCurrentPower", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetPop() *ProofOfPossession {
+	if m != nil {
+		return m.Pop
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ActivatedHeight(ctx context.Context, in *QueryActivatedHeightRequest, opts ...grpc.CallOption) (*QueryActivatedHeightResponse, error) {
-	out := new(QueryActivatedHeightResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ActivatedHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetInvalidated() bool {
+	if m != nil {
+		return m.Invalidated
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) FinalityProviderDelegations(ctx context.Context, in *QueryFinalityProviderDelegationsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderDelegationsResponse, error) {
-	out := new(QueryFinalityProviderDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetSlashedBtcHeight() uint64 {
+	if m != nil {
+		return m.SlashedBtcHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) BTCDelegation(ctx context.Context, in *QueryBTCDelegationRequest, opts ...grpc.CallOption) (*QueryBTCDelegationResponse, error) {
-	out := new(QueryBTCDelegationResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetReserved() bool {
+	if m != nil {
+		return m.Reserved
 	}
-	return out, nil
+	return false
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ParamsByVersion queries the parameters of the module for a specific version of past params.
-	ParamsByVersion(context.Context, *QueryParamsByVersionRequest) (*QueryParamsByVersionResponse, error)
-	// FinalityProviders queries all finality providers
-	FinalityProviders(context.Context, *QueryFinalityProvidersRequest) (*QueryFinalityProvidersResponse, error)
-	// FinalityProvider info about one finality provider
-	FinalityProvider(context.Context, *QueryFinalityProviderRequest) (*QueryFinalityProviderResponse, error)
-	// BTCDelegations queries all BTC delegations under a given status
-	BTCDelegations(context.Context, *QueryBTCDelegationsRequest) (*QueryBTCDelegationsResponse, error)
-	// ActiveFinalityProvidersAtHeight queries finality providers with non zero voting power at given height.
-	ActiveFinalityProvidersAtHeight(context.Context, *QueryActiveFinalityProvidersAtHeightRequest) (*QueryActiveFinalityProvidersAtHeightResponse, error)
-	// FinalityProviderPowerAtHeight queries the voting power of a finality provider at a given height
-	FinalityProviderPowerAtHeight(context.Context, *QueryFinalityProviderPowerAtHeightRequest) (*QueryFinalityProviderPowerAtHeightResponse, error)
-	// FinalityProviderCurrentPower queries the voting power of a finality provider at the current height
-	FinalityProviderCurrentPower(context.Context, *QueryFinalityProviderCurrentPowerRequest) (*QueryFinalityProviderCurrentPowerResponse, error)
-	// ActivatedHeight queries the height when BTC staking protocol is activated, i.e., the first height when
-	// there exists 1 finality provider with voting power
-	ActivatedHeight(context.Context, *QueryActivatedHeightRequest) (*QueryActivatedHeightResponse, error)
-	// FinalityProviderDelegations queries all BTC delegations of the given finality provider
-	FinalityProviderDelegations(context.Context, *QueryFinalityProviderDelegationsRequest) (*QueryFinalityProviderDelegationsResponse, error)
-	// BTCDelegation retrieves delegation by corresponding staking tx hash
-	BTCDelegation(context.Context, *QueryBTCDelegationRequest) (*QueryBTCDelegationResponse, error)
+func (m *BTCDelegationResponse) GetFpSlashedBeforeActivation() bool {
+	if m != nil {
+		return m.FpSlashedBeforeActivation
+	}
+	return false
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *BTCDelegationResponse) GetFpSlashedBtcHeight() uint64 {
+	if m != nil {
+		return m.FpSlashedBtcHeight
+	}
+	return 0
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
-}
-func (*UnimplementedQueryServer) ParamsByVersion(ctx context.Context, req *QueryParamsByVersionRequest) (*QueryParamsByVersionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ParamsByVersion not implemented")
-}
-func (*UnimplementedQueryServer) FinalityProviders(ctx context.Context, req *QueryFinalityProvidersRequest) (*QueryFinalityProvidersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviders not implemented")
-}
-func (*UnimplementedQueryServer) FinalityProvider(ctx context.Context, req *QueryFinalityProviderRequest) (*QueryFinalityProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalityProvider not implemented")
-}
-func (*UnimplementedQueryServer) BTCDelegations(ctx context.Context, req *QueryBTCDelegationsRequest) (*QueryBTCDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegations not implemented")
+// BTCUndelegationResponse provides all necessary info about the undeleagation
+type BTCUndelegationResponse struct {
+	// unbonding_tx is the transaction which will transfer the funds from staking
+	// output to unbonding output. Unbonding output will usually have lower timelock
+	// than staking output. The unbonding tx as string hex.
+	UnbondingTxHex string `protobuf:"bytes,1,opt,name=unbonding_tx_hex,json=unbondingTxHex,proto3" json:"unbonding_tx_hex,omitempty"`
+	// delegator_unbonding_sig is the signature on the unbonding tx
+	// by the delegator (i.e., SK corresponding to btc_pk).
+	// It effectively proves that the delegator wants to unbond and thus
+	// Babylon will consider this BTC delegation unbonded. Delegator's BTC
+	// on Bitcoin will be unbonded after timelock. The unbonding delegator sig as string hex.
+	DelegatorUnbondingSigHex string `protobuf:"bytes,2,opt,name=delegator_unbonding_sig_hex,json=delegatorUnbondingSigHex,proto3" json:"delegator_unbonding_sig_hex,omitempty"`
+	// covenant_unbonding_sig_list is the list of signatures on the unbonding tx
+	// by covenant members
+	CovenantUnbondingSigList []*SignatureInfo `protobuf:"bytes,3,rep,name=covenant_unbonding_sig_list,json=covenantUnbondingSigList,proto3" json:"covenant_unbonding_sig_list,omitempty"`
+	// slashingTxHex is the hex string of slashing tx
+	SlashingTxHex string `protobuf:"bytes,4,opt,name=slashing_tx_hex,json=slashingTxHex,proto3" json:"slashing_tx_hex,omitempty"`
+	// delegator_slashing_sig is the signature on the slashing tx
+	// by the delegator (i.e., SK corresponding to btc_pk).
+	// It will be a part of the witness for the unbonding tx output.
+	// The delegator slashing sig as string hex.
+	DelegatorSlashingSigHex string `protobuf:"bytes,5,opt,name=delegator_slashing_sig_hex,json=delegatorSlashingSigHex,proto3" json:"delegator_slashing_sig_hex,omitempty"`
+	// covenant_slashing_sigs is a list of adaptor signatures on the
+	// unbonding slashing tx by each covenant member
+	// It will be a part of the witness for the staking tx output.
+	CovenantSlashingSigs []*CovenantAdaptorSignatures `protobuf:"bytes,6,rep,name=covenant_slashing_sigs,json=covenantSlashingSigs,proto3" json:"covenant_slashing_sigs,omitempty"`
 }
-func (*UnimplementedQueryServer) ActiveFinalityProvidersAtHeight(ctx context.Context, req *QueryActiveFinalityProvidersAtHeightRequest) (*QueryActiveFinalityProvidersAtHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ActiveFinalityProvidersAtHeight not implemented")
+
+func (m *BTCUndelegationResponse) Reset()         { *m = BTCUndelegationResponse{} }
+func (m *BTCUndelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*BTCUndelegationResponse) ProtoMessage()    {}
+func (*BTCUndelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{30}
 }
-func (*UnimplementedQueryServer) FinalityProviderPowerAtHeight(ctx context.Context, req *QueryFinalityProviderPowerAtHeightRequest) (*QueryFinalityProviderPowerAtHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderPowerAtHeight not implemented")
+func (m *BTCUndelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) FinalityProviderCurrentPower(ctx context.Context, req *QueryFinalityProviderCurrentPowerRequest) (*QueryFinalityProviderCurrentPowerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderCurrentPower not implemented")
+func (m *BTCUndelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCUndelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ActivatedHeight(ctx context.Context, req *QueryActivatedHeightRequest) (*QueryActivatedHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ActivatedHeight not implemented")
+func (m *BTCUndelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCUndelegationResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) FinalityProviderDelegations(ctx context.Context, req *QueryFinalityProviderDelegationsRequest) (*QueryFinalityProviderDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderDelegations not implemented")
+func (m *BTCUndelegationResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) BTCDelegation(ctx context.Context, req *QueryBTCDelegationRequest) (*QueryBTCDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegation not implemented")
+func (m *BTCUndelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCUndelegationResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_BTCUndelegationResponse proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *BTCUndelegationResponse) GetUnbondingTxHex() string {
+	if m != nil {
+		return m.UnbondingTxHex
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ParamsByVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsByVersionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ParamsByVersion(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/ParamsByVersion",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ParamsByVersion(ctx, req.(*QueryParamsByVersionRequest))
+func (m *BTCUndelegationResponse) GetDelegatorUnbondingSigHex() string {
+	if m != nil {
+		return m.DelegatorUnbondingSigHex
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_FinalityProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFinalityProvidersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FinalityProviders(ctx, in)
+func (m *BTCUndelegationResponse) GetCovenantUnbondingSigList() []*SignatureInfo {
+	if m != nil {
+		return m.CovenantUnbondingSigList
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviders",
+	return nil
+}
+
+func (m *BTCUndelegationResponse) GetSlashingTxHex() string {
+	if m != nil {
+		return m.SlashingTxHex
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FinalityProviders(ctx, req.(*QueryFinalityProvidersRequest))
+	return ""
+}
+
+func (m *BTCUndelegationResponse) GetDelegatorSlashingSigHex() string {
+	if m != nil {
+		return m.DelegatorSlashingSigHex
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_FinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFinalityProviderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *BTCUndelegationResponse) GetCovenantSlashingSigs() []*CovenantAdaptorSignatures {
+	if m != nil {
+		return m.CovenantSlashingSigs
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).FinalityProvider(ctx, in)
+	return nil
+}
+
+// BTCDelegatorDelegationsResponse is a collection of BTC delegations responses from the same delegator.
+type BTCDelegatorDelegationsResponse struct {
+	Dels []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=dels,proto3" json:"dels,omitempty"`
+}
+
+func (m *BTCDelegatorDelegationsResponse) Reset()         { *m = BTCDelegatorDelegationsResponse{} }
+func (m *BTCDelegatorDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*BTCDelegatorDelegationsResponse) ProtoMessage()    {}
+func (*BTCDelegatorDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{31}
+}
+func (m *BTCDelegatorDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCDelegatorDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCDelegatorDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProvider",
+}
+func (m *BTCDelegatorDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCDelegatorDelegationsResponse.Merge(m, src)
+}
+func (m *BTCDelegatorDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCDelegatorDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCDelegatorDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCDelegatorDelegationsResponse proto.InternalMessageInfo
+
+func (m *BTCDelegatorDelegationsResponse) GetDels() []*BTCDelegationResponse {
+	if m != nil {
+		return m.Dels
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FinalityProvider(ctx, req.(*QueryFinalityProviderRequest))
+	return nil
+}
+
+// QuerySimulateBTCUndelegationRequest is the request type for the
+// Query/SimulateBTCUndelegation RPC method.
+type QuerySimulateBTCUndelegationRequest struct {
+	// staking_tx_hash_hex is the staking tx hash of the BTC delegation in hex
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+	// unbonding_tx is the bitcoin unbonding transaction that spends the staking output
+	UnbondingTx []byte `protobuf:"bytes,2,opt,name=unbonding_tx,json=unbondingTx,proto3" json:"unbonding_tx,omitempty"`
+	// unbonding_value is amount of satoshis locked in unbonding output
+	UnbondingValue int64 `protobuf:"varint,3,opt,name=unbonding_value,json=unbondingValue,proto3" json:"unbonding_value,omitempty"`
+	// unbonding_time is the time lock of the unbonding output
+	UnbondingTime uint32 `protobuf:"varint,4,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
+	// unbonding_slashing_tx is the slashing tx which slashes the unbonding output
+	UnbondingSlashingTx *BTCSlashingTx `protobuf:"bytes,5,opt,name=unbonding_slashing_tx,json=unbondingSlashingTx,proto3,customtype=BTCSlashingTx" json:"unbonding_slashing_tx,omitempty"`
+	// delegator_unbonding_slashing_sig is the signature on the unbonding slashing
+	// tx by the delegator
+	DelegatorUnbondingSlashingSig *github_com_babylonchain_babylon_types.BIP340Signature `protobuf:"bytes,6,opt,name=delegator_unbonding_slashing_sig,json=delegatorUnbondingSlashingSig,proto3,customtype=github.com/babylonchain/babylon/types.BIP340Signature" json:"delegator_unbonding_slashing_sig,omitempty"`
+}
+
+func (m *QuerySimulateBTCUndelegationRequest) Reset()         { *m = QuerySimulateBTCUndelegationRequest{} }
+func (m *QuerySimulateBTCUndelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateBTCUndelegationRequest) ProtoMessage()    {}
+func (*QuerySimulateBTCUndelegationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{32}
+}
+func (m *QuerySimulateBTCUndelegationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateBTCUndelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateBTCUndelegationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QuerySimulateBTCUndelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateBTCUndelegationRequest.Merge(m, src)
+}
+func (m *QuerySimulateBTCUndelegationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateBTCUndelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateBTCUndelegationRequest.DiscardUnknown(m)
 }
 
-func _Query_BTCDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBTCDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulateBTCUndelegationRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateBTCUndelegationRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).BTCDelegations(ctx, in)
+	return ""
+}
+
+func (m *QuerySimulateBTCUndelegationRequest) GetUnbondingTx() []byte {
+	if m != nil {
+		return m.UnbondingTx
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegations",
+	return nil
+}
+
+func (m *QuerySimulateBTCUndelegationRequest) GetUnbondingValue() int64 {
+	if m != nil {
+		return m.UnbondingValue
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BTCDelegations(ctx, req.(*QueryBTCDelegationsRequest))
+	return 0
+}
+
+func (m *QuerySimulateBTCUndelegationRequest) GetUnbondingTime() uint32 {
+	if m != nil {
+		return m.UnbondingTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ActiveFinalityProvidersAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryActiveFinalityProvidersAtHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ActiveFinalityProvidersAtHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/ActiveFinalityProvidersAtHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ActiveFinalityProvidersAtHeight(ctx, req.(*QueryActiveFinalityProvidersAtHeightRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QuerySimulateBTCUndelegationResponse is the response type for the
+// Query/SimulateBTCUndelegation RPC method.
+type QuerySimulateBTCUndelegationResponse struct {
+	// valid is true if the unbonding tx and its slashing tx pass all checks
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// invalid_reason is the validation error if valid is false
+	InvalidReason string `protobuf:"bytes,2,opt,name=invalid_reason,json=invalidReason,proto3" json:"invalid_reason,omitempty"`
 }
 
-func _Query_FinalityProviderPowerAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFinalityProviderPowerAtHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FinalityProviderPowerAtHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderPowerAtHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FinalityProviderPowerAtHeight(ctx, req.(*QueryFinalityProviderPowerAtHeightRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QuerySimulateBTCUndelegationResponse) Reset()         { *m = QuerySimulateBTCUndelegationResponse{} }
+func (m *QuerySimulateBTCUndelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateBTCUndelegationResponse) ProtoMessage()    {}
+func (*QuerySimulateBTCUndelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{33}
 }
-
-func _Query_FinalityProviderCurrentPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFinalityProviderCurrentPowerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FinalityProviderCurrentPower(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderCurrentPower",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FinalityProviderCurrentPower(ctx, req.(*QueryFinalityProviderCurrentPowerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QuerySimulateBTCUndelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_ActivatedHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryActivatedHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ActivatedHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/ActivatedHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ActivatedHeight(ctx, req.(*QueryActivatedHeightRequest))
+func (m *QuerySimulateBTCUndelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateBTCUndelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Query_FinalityProviderDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFinalityProviderDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FinalityProviderDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FinalityProviderDelegations(ctx, req.(*QueryFinalityProviderDelegationsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QuerySimulateBTCUndelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateBTCUndelegationResponse.Merge(m, src)
 }
-
-func _Query_BTCDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBTCDelegationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BTCDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BTCDelegation(ctx, req.(*QueryBTCDelegationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QuerySimulateBTCUndelegationResponse) XXX_Size() int {
+	return m.Size()
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "babylon.btcstaking.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ParamsByVersion",
-			Handler:    _Query_ParamsByVersion_Handler,
-		},
-		{
-			MethodName: "FinalityProviders",
-			Handler:    _Query_FinalityProviders_Handler,
-		},
-		{
-			MethodName: "FinalityProvider",
-			Handler:    _Query_FinalityProvider_Handler,
-		},
-		{
-			MethodName: "BTCDelegations",
-			Handler:    _Query_BTCDelegations_Handler,
-		},
-		{
-			MethodName: "ActiveFinalityProvidersAtHeight",
-			Handler:    _Query_ActiveFinalityProvidersAtHeight_Handler,
-		},
-		{
-			MethodName: "FinalityProviderPowerAtHeight",
-			Handler:    _Query_FinalityProviderPowerAtHeight_Handler,
-		},
-		{
-			MethodName: "FinalityProviderCurrentPower",
-			Handler:    _Query_FinalityProviderCurrentPower_Handler,
-		},
-		{
-			MethodName: "ActivatedHeight",
-			Handler:    _Query_ActivatedHeight_Handler,
-		},
-		{
-			MethodName: "FinalityProviderDelegations",
-			Handler:    _Query_FinalityProviderDelegations_Handler,
-		},
-		{
-			MethodName: "BTCDelegation",
-			Handler:    _Query_BTCDelegation_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "babylon/btcstaking/v1/query.proto",
+func (m *QuerySimulateBTCUndelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateBTCUndelegationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulateBTCUndelegationResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateBTCUndelegationResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySimulateBTCUndelegationResponse) GetInvalidReason() string {
+	if m != nil {
+		return m.InvalidReason
+	}
+	return ""
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryStaleCovenantPendingDelegationsRequest is the request type for the
+// Query/StaleCovenantPendingDelegations RPC method.
+type QueryStaleCovenantPendingDelegationsRequest struct {
+	// max_blocks_pending is the number of Babylon blocks a BTC delegation can
+	// stay pending before it is considered stale
+	MaxBlocksPending uint64 `protobuf:"varint,1,opt,name=max_blocks_pending,json=maxBlocksPending,proto3" json:"max_blocks_pending,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryStaleCovenantPendingDelegationsRequest) Reset() {
+	*m = QueryStaleCovenantPendingDelegationsRequest{}
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryStaleCovenantPendingDelegationsRequest) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (*QueryStaleCovenantPendingDelegationsRequest) ProtoMessage() {}
+func (*QueryStaleCovenantPendingDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{34}
+}
+func (m *QueryStaleCovenantPendingDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStaleCovenantPendingDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStaleCovenantPendingDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryStaleCovenantPendingDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStaleCovenantPendingDelegationsRequest.Merge(m, src)
+}
+func (m *QueryStaleCovenantPendingDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStaleCovenantPendingDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStaleCovenantPendingDelegationsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsByVersionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryStaleCovenantPendingDelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryStaleCovenantPendingDelegationsRequest) GetMaxBlocksPending() uint64 {
+	if m != nil {
+		return m.MaxBlocksPending
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsByVersionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryStaleCovenantPendingDelegationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsByVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// QueryStaleCovenantPendingDelegationsResponse is the response type for the
+// Query/StaleCovenantPendingDelegations RPC method.
+type QueryStaleCovenantPendingDelegationsResponse struct {
+	// btc_delegations contains the stale pending BTC delegations
+	BtcDelegations []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=btc_delegations,json=btcDelegations,proto3" json:"btc_delegations,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsByVersionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryStaleCovenantPendingDelegationsResponse) Reset() {
+	*m = QueryStaleCovenantPendingDelegationsResponse{}
 }
-
-func (m *QueryParamsByVersionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryStaleCovenantPendingDelegationsResponse) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (m *QueryParamsByVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (*QueryStaleCovenantPendingDelegationsResponse) ProtoMessage() {}
+func (*QueryStaleCovenantPendingDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{35}
+}
+func (m *QueryStaleCovenantPendingDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStaleCovenantPendingDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStaleCovenantPendingDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryFinalityProvidersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryStaleCovenantPendingDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStaleCovenantPendingDelegationsResponse.Merge(m, src)
 }
-
-func (m *QueryFinalityProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryStaleCovenantPendingDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStaleCovenantPendingDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStaleCovenantPendingDelegationsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFinalityProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryStaleCovenantPendingDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryStaleCovenantPendingDelegationsResponse) GetBtcDelegations() []*BTCDelegationResponse {
+	if m != nil {
+		return m.BtcDelegations
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryFinalityProvidersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryStaleCovenantPendingDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFinalityProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySlashingAmountRequest is the request type for the Query/SlashingAmount
+// RPC method.
+type QuerySlashingAmountRequest struct {
+	// staking_tx_hash_hex is the staking tx hash of the BTC delegation in hex
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
 }
 
-func (m *QueryFinalityProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FinalityProviders) > 0 {
-		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QuerySlashingAmountRequest) Reset()         { *m = QuerySlashingAmountRequest{} }
+func (m *QuerySlashingAmountRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashingAmountRequest) ProtoMessage()    {}
+func (*QuerySlashingAmountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{36}
+}
+func (m *QuerySlashingAmountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashingAmountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashingAmountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryFinalityProviderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySlashingAmountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashingAmountRequest.Merge(m, src)
 }
-
-func (m *QueryFinalityProviderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySlashingAmountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashingAmountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashingAmountRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFinalityProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FpBtcPkHex) > 0 {
-		i -= len(m.FpBtcPkHex)
-		copy(dAtA[i:], m.FpBtcPkHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QuerySlashingAmountRequest proto.InternalMessageInfo
+
+func (m *QuerySlashingAmountRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// QuerySlashingAmountResponse is the response type for the Query/SlashingAmount
+// RPC method.
+type QuerySlashingAmountResponse struct {
+	// slashing_address is the address that receives the slashed funds, as in
+	// the params version the BTC delegation was validated against
+	SlashingAddress string `protobuf:"bytes,1,opt,name=slashing_address,json=slashingAddress,proto3" json:"slashing_address,omitempty"`
+	// slashing_rate is the slashing rate in the params version the BTC
+	// delegation was validated against
+	SlashingRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=slashing_rate,json=slashingRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slashing_rate"`
+	// slashing_amount is the amount in satoshi that the slashing tx of the
+	// staking output sends to the slashing address
+	SlashingAmount uint64 `protobuf:"varint,3,opt,name=slashing_amount,json=slashingAmount,proto3" json:"slashing_amount,omitempty"`
+	// unbonding_slashing_amount is the amount in satoshi that the slashing tx
+	// of the unbonding output sends to the slashing address
+	UnbondingSlashingAmount uint64 `protobuf:"varint,4,opt,name=unbonding_slashing_amount,json=unbondingSlashingAmount,proto3" json:"unbonding_slashing_amount,omitempty"`
+}
+
+func (m *QuerySlashingAmountResponse) Reset()         { *m = QuerySlashingAmountResponse{} }
+func (m *QuerySlashingAmountResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashingAmountResponse) ProtoMessage()    {}
+func (*QuerySlashingAmountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{37}
+}
+func (m *QuerySlashingAmountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashingAmountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashingAmountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QuerySlashingAmountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashingAmountResponse.Merge(m, src)
+}
+func (m *QuerySlashingAmountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashingAmountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashingAmountResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QuerySlashingAmountResponse proto.InternalMessageInfo
+
+func (m *QuerySlashingAmountResponse) GetSlashingAddress() string {
+	if m != nil {
+		return m.SlashingAddress
+	}
+	return ""
 }
 
-func (m *QueryFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FinalityProvider != nil {
-		{
-			size, err := m.FinalityProvider.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QuerySlashingAmountResponse) GetSlashingAmount() uint64 {
+	if m != nil {
+		return m.SlashingAmount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryBTCDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySlashingAmountResponse) GetUnbondingSlashingAmount() uint64 {
+	if m != nil {
+		return m.UnbondingSlashingAmount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryBTCDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySimulateVotingPowerRequest is the request type for the
+// Query/SimulateVotingPower RPC method.
+type QuerySimulateVotingPowerRequest struct {
+	// total_sat is the amount of satoshis to be locked in the staking output
+	TotalSat uint64 `protobuf:"varint,1,opt,name=total_sat,json=totalSat,proto3" json:"total_sat,omitempty"`
+	// staking_time is the time lock of the staking output, in BTC blocks
+	StakingTime uint32 `protobuf:"varint,2,opt,name=staking_time,json=stakingTime,proto3" json:"staking_time,omitempty"`
 }
 
-func (m *QueryBTCDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySimulateVotingPowerRequest) Reset()         { *m = QuerySimulateVotingPowerRequest{} }
+func (m *QuerySimulateVotingPowerRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateVotingPowerRequest) ProtoMessage()    {}
+func (*QuerySimulateVotingPowerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{38}
+}
+func (m *QuerySimulateVotingPowerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateVotingPowerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateVotingPowerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QuerySimulateVotingPowerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateVotingPowerRequest.Merge(m, src)
+}
+func (m *QuerySimulateVotingPowerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateVotingPowerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateVotingPowerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateVotingPowerRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateVotingPowerRequest) GetTotalSat() uint64 {
+	if m != nil {
+		return m.TotalSat
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryBTCDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateVotingPowerRequest) GetStakingTime() uint32 {
+	if m != nil {
+		return m.StakingTime
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryBTCDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySimulateVotingPowerResponse is the response type for the
+// Query/SimulateVotingPower RPC method.
+type QuerySimulateVotingPowerResponse struct {
+	// voting_power is the voting power that the BTC delegation would contribute
+	// if its staking tx was included at the current BTC tip and it received a
+	// covenant quorum
+	VotingPower uint64 `protobuf:"varint,1,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// btc_tip_height is the height of the current BTC tip
+	BtcTipHeight uint64 `protobuf:"varint,2,opt,name=btc_tip_height,json=btcTipHeight,proto3" json:"btc_tip_height,omitempty"`
 }
 
-func (m *QueryBTCDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySimulateVotingPowerResponse) Reset()         { *m = QuerySimulateVotingPowerResponse{} }
+func (m *QuerySimulateVotingPowerResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateVotingPowerResponse) ProtoMessage()    {}
+func (*QuerySimulateVotingPowerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{39}
+}
+func (m *QuerySimulateVotingPowerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateVotingPowerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateVotingPowerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.BtcDelegations) > 0 {
-		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QuerySimulateVotingPowerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateVotingPowerResponse.Merge(m, src)
+}
+func (m *QuerySimulateVotingPowerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateVotingPowerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateVotingPowerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateVotingPowerResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateVotingPowerResponse) GetVotingPower() uint64 {
+	if m != nil {
+		return m.VotingPower
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryFinalityProviderPowerAtHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateVotingPowerResponse) GetBtcTipHeight() uint64 {
+	if m != nil {
+		return m.BtcTipHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryFinalityProviderPowerAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBTCDelegationsByInclusionHeightRequest is the request type for the
+// Query/BTCDelegationsByInclusionHeight RPC method.
+type QueryBTCDelegationsByInclusionHeightRequest struct {
+	// from_height is the lowest BTC height of the range (inclusive)
+	FromHeight uint64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height is the highest BTC height of the range (inclusive)
+	ToHeight uint64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
 }
 
-func (m *QueryFinalityProviderPowerAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
+func (m *QueryBTCDelegationsByInclusionHeightRequest) Reset() {
+	*m = QueryBTCDelegationsByInclusionHeightRequest{}
+}
+func (m *QueryBTCDelegationsByInclusionHeightRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryBTCDelegationsByInclusionHeightRequest) ProtoMessage() {}
+func (*QueryBTCDelegationsByInclusionHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{40}
+}
+func (m *QueryBTCDelegationsByInclusionHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationsByInclusionHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationsByInclusionHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.FpBtcPkHex) > 0 {
-		i -= len(m.FpBtcPkHex)
-		copy(dAtA[i:], m.FpBtcPkHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryBTCDelegationsByInclusionHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationsByInclusionHeightRequest.Merge(m, src)
+}
+func (m *QueryBTCDelegationsByInclusionHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationsByInclusionHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationsByInclusionHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCDelegationsByInclusionHeightRequest proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationsByInclusionHeightRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryFinalityProviderPowerAtHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBTCDelegationsByInclusionHeightRequest) GetToHeight() uint64 {
+	if m != nil {
+		return m.ToHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryFinalityProviderPowerAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBTCDelegationsByInclusionHeightResponse is the response type for the
+// Query/BTCDelegationsByInclusionHeight RPC method.
+type QueryBTCDelegationsByInclusionHeightResponse struct {
+	// btc_delegations contains all the BTC delegations whose staking tx is
+	// included in a BTC block within the range, ordered by inclusion height
+	BtcDelegations []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=btc_delegations,json=btcDelegations,proto3" json:"btc_delegations,omitempty"`
 }
 
-func (m *QueryFinalityProviderPowerAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VotingPower != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryBTCDelegationsByInclusionHeightResponse) Reset() {
+	*m = QueryBTCDelegationsByInclusionHeightResponse{}
+}
+func (m *QueryBTCDelegationsByInclusionHeightResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryBTCDelegationsByInclusionHeightResponse) ProtoMessage() {}
+func (*QueryBTCDelegationsByInclusionHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{41}
+}
+func (m *QueryBTCDelegationsByInclusionHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationsByInclusionHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationsByInclusionHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryBTCDelegationsByInclusionHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationsByInclusionHeightResponse.Merge(m, src)
+}
+func (m *QueryBTCDelegationsByInclusionHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationsByInclusionHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationsByInclusionHeightResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFinalityProviderCurrentPowerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBTCDelegationsByInclusionHeightResponse proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationsByInclusionHeightResponse) GetBtcDelegations() []*BTCDelegationResponse {
+	if m != nil {
+		return m.BtcDelegations
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFinalityProviderCurrentPowerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCovenantQuorumHealthRequest is the request type for the
+// Query/CovenantQuorumHealth RPC method.
+type QueryCovenantQuorumHealthRequest struct {
 }
 
-func (m *QueryFinalityProviderCurrentPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FpBtcPkHex) > 0 {
-		i -= len(m.FpBtcPkHex)
-		copy(dAtA[i:], m.FpBtcPkHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryCovenantQuorumHealthRequest) Reset()         { *m = QueryCovenantQuorumHealthRequest{} }
+func (m *QueryCovenantQuorumHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantQuorumHealthRequest) ProtoMessage()    {}
+func (*QueryCovenantQuorumHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{42}
+}
+func (m *QueryCovenantQuorumHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantQuorumHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCovenantQuorumHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCovenantQuorumHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantQuorumHealthRequest.Merge(m, src)
+}
+func (m *QueryCovenantQuorumHealthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCovenantQuorumHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantQuorumHealthRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFinalityProviderCurrentPowerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_QueryCovenantQuorumHealthRequest proto.InternalMessageInfo
+
+// QueryCovenantQuorumHealthResponse is the response type for the
+// Query/CovenantQuorumHealth RPC method.
+type QueryCovenantQuorumHealthResponse struct {
+	// covenant_quorum is the current number of covenant signatures needed for
+	// a BTC delegation to become active
+	CovenantQuorum uint32 `protobuf:"varint,1,opt,name=covenant_quorum,json=covenantQuorum,proto3" json:"covenant_quorum,omitempty"`
+	// num_pending_delegations is the total number of pending BTC delegations
+	NumPendingDelegations uint64 `protobuf:"varint,2,opt,name=num_pending_delegations,json=numPendingDelegations,proto3" json:"num_pending_delegations,omitempty"`
+	// buckets groups the pending BTC delegations by the number of covenant
+	// signatures they still miss, in ascending order of missing signatures
+	Buckets []*CovenantQuorumHealthBucket `protobuf:"bytes,3,rep,name=buckets,proto3" json:"buckets,omitempty"`
 }
 
-func (m *QueryFinalityProviderCurrentPowerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCovenantQuorumHealthResponse) Reset()         { *m = QueryCovenantQuorumHealthResponse{} }
+func (m *QueryCovenantQuorumHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantQuorumHealthResponse) ProtoMessage()    {}
+func (*QueryCovenantQuorumHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{43}
+}
+func (m *QueryCovenantQuorumHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantQuorumHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCovenantQuorumHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCovenantQuorumHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantQuorumHealthResponse.Merge(m, src)
+}
+func (m *QueryCovenantQuorumHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCovenantQuorumHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantQuorumHealthResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFinalityProviderCurrentPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VotingPower != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_QueryCovenantQuorumHealthResponse proto.InternalMessageInfo
+
+func (m *QueryCovenantQuorumHealthResponse) GetCovenantQuorum() uint32 {
+	if m != nil {
+		return m.CovenantQuorum
 	}
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *QueryCovenantQuorumHealthResponse) GetNumPendingDelegations() uint64 {
+	if m != nil {
+		return m.NumPendingDelegations
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryActiveFinalityProvidersAtHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCovenantQuorumHealthResponse) GetBuckets() []*CovenantQuorumHealthBucket {
+	if m != nil {
+		return m.Buckets
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryActiveFinalityProvidersAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CovenantQuorumHealthBucket is the number of pending BTC delegations that
+// miss a given number of covenant signatures to reach the quorum
+type CovenantQuorumHealthBucket struct {
+	// missing_sigs is the number of covenant signatures still needed
+	MissingSigs uint32 `protobuf:"varint,1,opt,name=missing_sigs,json=missingSigs,proto3" json:"missing_sigs,omitempty"`
+	// num_delegations is the number of pending BTC delegations missing
+	// missing_sigs covenant signatures
+	NumDelegations uint64 `protobuf:"varint,2,opt,name=num_delegations,json=numDelegations,proto3" json:"num_delegations,omitempty"`
 }
 
-func (m *QueryActiveFinalityProvidersAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *CovenantQuorumHealthBucket) Reset()         { *m = CovenantQuorumHealthBucket{} }
+func (m *CovenantQuorumHealthBucket) String() string { return proto.CompactTextString(m) }
+func (*CovenantQuorumHealthBucket) ProtoMessage()    {}
+func (*CovenantQuorumHealthBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{44}
+}
+func (m *CovenantQuorumHealthBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CovenantQuorumHealthBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CovenantQuorumHealthBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *CovenantQuorumHealthBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CovenantQuorumHealthBucket.Merge(m, src)
+}
+func (m *CovenantQuorumHealthBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *CovenantQuorumHealthBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_CovenantQuorumHealthBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CovenantQuorumHealthBucket proto.InternalMessageInfo
+
+func (m *CovenantQuorumHealthBucket) GetMissingSigs() uint32 {
+	if m != nil {
+		return m.MissingSigs
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryActiveFinalityProvidersAtHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CovenantQuorumHealthBucket) GetNumDelegations() uint64 {
+	if m != nil {
+		return m.NumDelegations
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryActiveFinalityProvidersAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// FinalityProviderResponse defines a finality provider with voting power information.
+type FinalityProviderResponse struct {
+	// description defines the description terms for the finality provider.
+	Description *types.Description `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// commission defines the commission rate of the finality provider.
+	Commission *cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=commission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission,omitempty"`
+	// babylon_pk is the Babylon secp256k1 PK of this finality provider
+	BabylonPk *secp256k1.PubKey `protobuf:"bytes,3,opt,name=babylon_pk,json=babylonPk,proto3" json:"babylon_pk,omitempty"`
+	// btc_pk is the Bitcoin secp256k1 PK of this finality provider
+	// the PK follows encoding in BIP-340 spec
+	BtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,4,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
+	// pop is the proof of possession of babylon_pk and btc_pk
+	Pop *ProofOfPossession `protobuf:"bytes,5,opt,name=pop,proto3" json:"pop,omitempty"`
+	// slashed_babylon_height indicates the Babylon height when
+	// the finality provider is slashed.
+	// if it's 0 then the finality provider is not slashed
+	SlashedBabylonHeight uint64 `protobuf:"varint,6,opt,name=slashed_babylon_height,json=slashedBabylonHeight,proto3" json:"slashed_babylon_height,omitempty"`
+	// slashed_btc_height indicates the BTC height when
+	// the finality provider is slashed.
+	// if it's 0 then the finality provider is not slashed
+	SlashedBtcHeight uint64 `protobuf:"varint,7,opt,name=slashed_btc_height,json=slashedBtcHeight,proto3" json:"slashed_btc_height,omitempty"`
+	// height is the queried Babylon height
+	Height uint64 `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	// voting_power is the voting power of this finality provider at the given height
+	VotingPower uint64 `protobuf:"varint,9,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
 }
 
-func (m *QueryActiveFinalityProvidersAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FinalityProviders) > 0 {
-		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *FinalityProviderResponse) Reset()         { *m = FinalityProviderResponse{} }
+func (m *FinalityProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderResponse) ProtoMessage()    {}
+func (*FinalityProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{45}
+}
+func (m *FinalityProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *FinalityProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderResponse.Merge(m, src)
+}
+func (m *FinalityProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderResponse.DiscardUnknown(m)
 }
 
-func (m *QueryActivatedHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_FinalityProviderResponse proto.InternalMessageInfo
+
+func (m *FinalityProviderResponse) GetDescription() *types.Description {
+	if m != nil {
+		return m.Description
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryActivatedHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FinalityProviderResponse) GetBabylonPk() *secp256k1.PubKey {
+	if m != nil {
+		return m.BabylonPk
+	}
+	return nil
 }
 
-func (m *QueryActivatedHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *FinalityProviderResponse) GetPop() *ProofOfPossession {
+	if m != nil {
+		return m.Pop
+	}
+	return nil
 }
 
-func (m *QueryActivatedHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FinalityProviderResponse) GetSlashedBabylonHeight() uint64 {
+	if m != nil {
+		return m.SlashedBabylonHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryActivatedHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FinalityProviderResponse) GetSlashedBtcHeight() uint64 {
+	if m != nil {
+		return m.SlashedBtcHeight
+	}
+	return 0
 }
 
-func (m *QueryActivatedHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+func (m *FinalityProviderResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FinalityProviderResponse) GetVotingPower() uint64 {
+	if m != nil {
+		return m.VotingPower
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDelegationsWithStaleParamsRequest is the request type for the
+// Query/DelegationsWithStaleParams RPC method.
+type QueryDelegationsWithStaleParamsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDelegationsWithStaleParamsRequest) Reset() {
+	*m = QueryDelegationsWithStaleParamsRequest{}
+}
+func (m *QueryDelegationsWithStaleParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationsWithStaleParamsRequest) ProtoMessage()    {}
+func (*QueryDelegationsWithStaleParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{46}
+}
+func (m *QueryDelegationsWithStaleParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationsWithStaleParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationsWithStaleParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.FpBtcPkHex) > 0 {
-		i -= len(m.FpBtcPkHex)
-		copy(dAtA[i:], m.FpBtcPkHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryDelegationsWithStaleParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationsWithStaleParamsRequest.Merge(m, src)
+}
+func (m *QueryDelegationsWithStaleParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationsWithStaleParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationsWithStaleParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationsWithStaleParamsRequest proto.InternalMessageInfo
+
+func (m *QueryDelegationsWithStaleParamsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryFinalityProviderDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// QueryDelegationsWithStaleParamsResponse is the response type for the
+// Query/DelegationsWithStaleParams RPC method.
+type QueryDelegationsWithStaleParamsResponse struct {
+	// current_params_version is the version of the current parameters
+	CurrentParamsVersion uint32 `protobuf:"varint,1,opt,name=current_params_version,json=currentParamsVersion,proto3" json:"current_params_version,omitempty"`
+	// btc_delegations contains the BTC delegations whose snapshotted parameters,
+	// identified by their params_version, differ from the current parameters
+	BtcDelegations []*BTCDelegationResponse `protobuf:"bytes,2,rep,name=btc_delegations,json=btcDelegations,proto3" json:"btc_delegations,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegationsWithStaleParamsResponse) Reset() {
+	*m = QueryDelegationsWithStaleParamsResponse{}
+}
+func (m *QueryDelegationsWithStaleParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationsWithStaleParamsResponse) ProtoMessage()    {}
+func (*QueryDelegationsWithStaleParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{47}
+}
+func (m *QueryDelegationsWithStaleParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationsWithStaleParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationsWithStaleParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryDelegationsWithStaleParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationsWithStaleParamsResponse.Merge(m, src)
+}
+func (m *QueryDelegationsWithStaleParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationsWithStaleParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationsWithStaleParamsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFinalityProviderDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryDelegationsWithStaleParamsResponse proto.InternalMessageInfo
+
+func (m *QueryDelegationsWithStaleParamsResponse) GetCurrentParamsVersion() uint32 {
+	if m != nil {
+		return m.CurrentParamsVersion
+	}
+	return 0
 }
 
-func (m *QueryFinalityProviderDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *QueryDelegationsWithStaleParamsResponse) GetBtcDelegations() []*BTCDelegationResponse {
+	if m != nil {
+		return m.BtcDelegations
 	}
-	if len(m.BtcDelegatorDelegations) > 0 {
-		for iNdEx := len(m.BtcDelegatorDelegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BtcDelegatorDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	return nil
+}
+
+func (m *QueryDelegationsWithStaleParamsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFinalityProviderPoPRequest is the request type for the
+// Query/FinalityProviderPoP RPC method.
+type QueryFinalityProviderPoPRequest struct {
+	// fp_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the finality provider
+	// the PK follows encoding in BIP-340 spec
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+}
+
+func (m *QueryFinalityProviderPoPRequest) Reset()         { *m = QueryFinalityProviderPoPRequest{} }
+func (m *QueryFinalityProviderPoPRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalityProviderPoPRequest) ProtoMessage()    {}
+func (*QueryFinalityProviderPoPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{48}
+}
+func (m *QueryFinalityProviderPoPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderPoPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderPoPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFinalityProviderPoPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderPoPRequest.Merge(m, src)
+}
+func (m *QueryFinalityProviderPoPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderPoPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderPoPRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBTCDelegationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFinalityProviderPoPRequest proto.InternalMessageInfo
+
+func (m *QueryFinalityProviderPoPRequest) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryBTCDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFinalityProviderPoPResponse is the response type for the
+// Query/FinalityProviderPoP RPC method.
+type QueryFinalityProviderPoPResponse struct {
+	// babylon_pk is the Babylon secp256k1 PK of the finality provider
+	BabylonPk *secp256k1.PubKey `protobuf:"bytes,1,opt,name=babylon_pk,json=babylonPk,proto3" json:"babylon_pk,omitempty"`
+	// btc_pk is the Bitcoin secp256k1 PK of the finality provider
+	// the PK follows encoding in BIP-340 spec
+	BtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
+	// pop is the proof of possession of babylon_pk and btc_pk
+	Pop *ProofOfPossession `protobuf:"bytes,3,opt,name=pop,proto3" json:"pop,omitempty"`
 }
 
-func (m *QueryBTCDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StakingTxHashHex) > 0 {
-		i -= len(m.StakingTxHashHex)
-		copy(dAtA[i:], m.StakingTxHashHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryFinalityProviderPoPResponse) Reset()         { *m = QueryFinalityProviderPoPResponse{} }
+func (m *QueryFinalityProviderPoPResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalityProviderPoPResponse) ProtoMessage()    {}
+func (*QueryFinalityProviderPoPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{49}
+}
+func (m *QueryFinalityProviderPoPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderPoPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderPoPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFinalityProviderPoPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderPoPResponse.Merge(m, src)
+}
+func (m *QueryFinalityProviderPoPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderPoPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderPoPResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBTCDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFinalityProviderPoPResponse proto.InternalMessageInfo
+
+func (m *QueryFinalityProviderPoPResponse) GetBabylonPk() *secp256k1.PubKey {
+	if m != nil {
+		return m.BabylonPk
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFinalityProviderPoPResponse) GetPop() *ProofOfPossession {
+	if m != nil {
+		return m.Pop
+	}
+	return nil
 }
 
-func (m *QueryBTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BtcDelegation != nil {
-		{
-			size, err := m.BtcDelegation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryBTCDelegationPoPRequest is the request type for the
+// Query/BTCDelegationPoP RPC method.
+type QueryBTCDelegationPoPRequest struct {
+	// staking_tx_hash_hex is the hex str of the staking tx hash of the BTC delegation
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+}
+
+func (m *QueryBTCDelegationPoPRequest) Reset()         { *m = QueryBTCDelegationPoPRequest{} }
+func (m *QueryBTCDelegationPoPRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationPoPRequest) ProtoMessage()    {}
+func (*QueryBTCDelegationPoPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{50}
+}
+func (m *QueryBTCDelegationPoPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationPoPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationPoPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryBTCDelegationPoPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationPoPRequest.Merge(m, src)
+}
+func (m *QueryBTCDelegationPoPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationPoPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationPoPRequest.DiscardUnknown(m)
 }
 
-func (m *BTCDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBTCDelegationPoPRequest proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationPoPRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *BTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBTCDelegationPoPResponse is the response type for the
+// Query/BTCDelegationPoP RPC method.
+type QueryBTCDelegationPoPResponse struct {
+	// babylon_pk is the Babylon secp256k1 PK of the BTC delegator
+	BabylonPk *secp256k1.PubKey `protobuf:"bytes,1,opt,name=babylon_pk,json=babylonPk,proto3" json:"babylon_pk,omitempty"`
+	// btc_pk is the Bitcoin secp256k1 PK of the BTC delegator
+	// the PK follows encoding in BIP-340 spec
+	BtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
+	// pop is the proof of possession of babylon_pk and btc_pk
+	Pop *ProofOfPossession `protobuf:"bytes,3,opt,name=pop,proto3" json:"pop,omitempty"`
 }
 
-func (m *BTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ParamsVersion != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
-		i--
-		dAtA[i] = 0x78
-	}
-	if m.UndelegationResponse != nil {
-		{
-			size, err := m.UndelegationResponse.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryBTCDelegationPoPResponse) Reset()         { *m = QueryBTCDelegationPoPResponse{} }
+func (m *QueryBTCDelegationPoPResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationPoPResponse) ProtoMessage()    {}
+func (*QueryBTCDelegationPoPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{51}
+}
+func (m *QueryBTCDelegationPoPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationPoPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationPoPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x72
+		return b[:n], nil
 	}
-	if m.UnbondingTime != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingTime))
-		i--
-		dAtA[i] = 0x68
+}
+func (m *QueryBTCDelegationPoPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationPoPResponse.Merge(m, src)
+}
+func (m *QueryBTCDelegationPoPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationPoPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationPoPResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCDelegationPoPResponse proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationPoPResponse) GetBabylonPk() *secp256k1.PubKey {
+	if m != nil {
+		return m.BabylonPk
 	}
-	if len(m.StatusDesc) > 0 {
-		i -= len(m.StatusDesc)
-		copy(dAtA[i:], m.StatusDesc)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StatusDesc)))
-		i--
-		dAtA[i] = 0x62
+	return nil
+}
+
+func (m *QueryBTCDelegationPoPResponse) GetPop() *ProofOfPossession {
+	if m != nil {
+		return m.Pop
 	}
-	if m.Active {
-		i--
-		if m.Active {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.StakingOutputIdx != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StakingOutputIdx))
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.CovenantSigs) > 0 {
-		for iNdEx := len(m.CovenantSigs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CovenantSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if len(m.DelegatorSlashSigHex) > 0 {
-		i -= len(m.DelegatorSlashSigHex)
-		copy(dAtA[i:], m.DelegatorSlashSigHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashSigHex)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.SlashingTxHex) > 0 {
-		i -= len(m.SlashingTxHex)
-		copy(dAtA[i:], m.SlashingTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.StakingTxHex) > 0 {
-		i -= len(m.StakingTxHex)
-		copy(dAtA[i:], m.StakingTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHex)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.TotalSat != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSat))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.EndHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.StartHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.FpBtcPkList) > 0 {
-		for iNdEx := len(m.FpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.FpBtcPkList[iNdEx].Size()
-				i -= size
-				if _, err := m.FpBtcPkList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.BtcPk != nil {
-		{
-			size := m.BtcPk.Size()
-			i -= size
-			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *BTCUndelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryFinalityProviderPowerAfterUndelegationRequest is the request type for
+// the Query/FinalityProviderPowerAfterUndelegation RPC method.
+type QueryFinalityProviderPowerAfterUndelegationRequest struct {
+	// fp_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the finality provider
+	// the PK follows encoding in BIP-340 spec
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// staking_tx_hash_hex is the hex str of the staking tx hash of a BTC
+	// delegation restaked to the finality provider
+	StakingTxHashHex string `protobuf:"bytes,2,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
 }
 
-func (m *BTCUndelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFinalityProviderPowerAfterUndelegationRequest) Reset() {
+	*m = QueryFinalityProviderPowerAfterUndelegationRequest{}
 }
-
-func (m *BTCUndelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CovenantSlashingSigs) > 0 {
-		for iNdEx := len(m.CovenantSlashingSigs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CovenantSlashingSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.DelegatorSlashingSigHex) > 0 {
-		i -= len(m.DelegatorSlashingSigHex)
-		copy(dAtA[i:], m.DelegatorSlashingSigHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashingSigHex)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.SlashingTxHex) > 0 {
-		i -= len(m.SlashingTxHex)
-		copy(dAtA[i:], m.SlashingTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.CovenantUnbondingSigList) > 0 {
-		for iNdEx := len(m.CovenantUnbondingSigList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CovenantUnbondingSigList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *QueryFinalityProviderPowerAfterUndelegationRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryFinalityProviderPowerAfterUndelegationRequest) ProtoMessage() {}
+func (*QueryFinalityProviderPowerAfterUndelegationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{52}
+}
+func (m *QueryFinalityProviderPowerAfterUndelegationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderPowerAfterUndelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderPowerAfterUndelegationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.DelegatorUnbondingSigHex) > 0 {
-		i -= len(m.DelegatorUnbondingSigHex)
-		copy(dAtA[i:], m.DelegatorUnbondingSigHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorUnbondingSigHex)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.UnbondingTxHex) > 0 {
-		i -= len(m.UnbondingTxHex)
-		copy(dAtA[i:], m.UnbondingTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnbondingTxHex)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryFinalityProviderPowerAfterUndelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderPowerAfterUndelegationRequest.Merge(m, src)
+}
+func (m *QueryFinalityProviderPowerAfterUndelegationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderPowerAfterUndelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderPowerAfterUndelegationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalityProviderPowerAfterUndelegationRequest proto.InternalMessageInfo
+
+func (m *QueryFinalityProviderPowerAfterUndelegationRequest) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *BTCDelegatorDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFinalityProviderPowerAfterUndelegationRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *BTCDelegatorDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFinalityProviderPowerAfterUndelegationResponse is the response type for
+// the Query/FinalityProviderPowerAfterUndelegation RPC method.
+type QueryFinalityProviderPowerAfterUndelegationResponse struct {
+	// voting_power is the current voting power of the finality provider
+	VotingPower uint64 `protobuf:"varint,1,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// delegation_voting_power is the voting power the BTC delegation currently
+	// contributes to the finality provider
+	DelegationVotingPower uint64 `protobuf:"varint,2,opt,name=delegation_voting_power,json=delegationVotingPower,proto3" json:"delegation_voting_power,omitempty"`
+	// voting_power_after_undelegation is the voting power the finality provider
+	// would have if the BTC delegation unbonded
+	VotingPowerAfterUndelegation uint64 `protobuf:"varint,3,opt,name=voting_power_after_undelegation,json=votingPowerAfterUndelegation,proto3" json:"voting_power_after_undelegation,omitempty"`
 }
 
-func (m *BTCDelegatorDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Dels) > 0 {
-		for iNdEx := len(m.Dels) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Dels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryFinalityProviderPowerAfterUndelegationResponse) Reset() {
+	*m = QueryFinalityProviderPowerAfterUndelegationResponse{}
+}
+func (m *QueryFinalityProviderPowerAfterUndelegationResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryFinalityProviderPowerAfterUndelegationResponse) ProtoMessage() {}
+func (*QueryFinalityProviderPowerAfterUndelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{53}
+}
+func (m *QueryFinalityProviderPowerAfterUndelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderPowerAfterUndelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderPowerAfterUndelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFinalityProviderPowerAfterUndelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderPowerAfterUndelegationResponse.Merge(m, src)
+}
+func (m *QueryFinalityProviderPowerAfterUndelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderPowerAfterUndelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderPowerAfterUndelegationResponse.DiscardUnknown(m)
 }
 
-func (m *FinalityProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFinalityProviderPowerAfterUndelegationResponse proto.InternalMessageInfo
+
+func (m *QueryFinalityProviderPowerAfterUndelegationResponse) GetVotingPower() uint64 {
+	if m != nil {
+		return m.VotingPower
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *FinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFinalityProviderPowerAfterUndelegationResponse) GetDelegationVotingPower() uint64 {
+	if m != nil {
+		return m.DelegationVotingPower
+	}
+	return 0
 }
 
-func (m *FinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VotingPower != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.SlashedBtcHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBtcHeight))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.SlashedBabylonHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBabylonHeight))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.Pop != nil {
-		{
-			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.BtcPk != nil {
-		{
-			size := m.BtcPk.Size()
-			i -= size
-			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.BabylonPk != nil {
-		{
-			size, err := m.BabylonPk.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Commission != nil {
-		{
-			size := m.Commission.Size()
-			i -= size
-			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *QueryFinalityProviderPowerAfterUndelegationResponse) GetVotingPowerAfterUndelegation() uint64 {
+	if m != nil {
+		return m.VotingPowerAfterUndelegation
 	}
-	if m.Description != nil {
-		{
-			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	return 0
+}
+
+// QueryTotalVotingPowerAtHeightRequest is the request type for the
+// Query/TotalVotingPowerAtHeight RPC method.
+type QueryTotalVotingPowerAtHeightRequest struct {
+	// height is the Babylon height for querying the total voting power
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryTotalVotingPowerAtHeightRequest) Reset()         { *m = QueryTotalVotingPowerAtHeightRequest{} }
+func (m *QueryTotalVotingPowerAtHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalVotingPowerAtHeightRequest) ProtoMessage()    {}
+func (*QueryTotalVotingPowerAtHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{54}
+}
+func (m *QueryTotalVotingPowerAtHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalVotingPowerAtHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalVotingPowerAtHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTotalVotingPowerAtHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalVotingPowerAtHeightRequest.Merge(m, src)
+}
+func (m *QueryTotalVotingPowerAtHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalVotingPowerAtHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalVotingPowerAtHeightRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryTotalVotingPowerAtHeightRequest proto.InternalMessageInfo
+
+func (m *QueryTotalVotingPowerAtHeightRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+// QueryTotalVotingPowerAtHeightResponse is the response type for the
+// Query/TotalVotingPowerAtHeight RPC method.
+type QueryTotalVotingPowerAtHeightResponse struct {
+	// voting_power is the total voting power of all active finality providers
+	// at the given height
+	VotingPower uint64 `protobuf:"varint,1,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// num_finality_providers is the number of active finality providers at the
+	// given height
+	NumFinalityProviders uint64 `protobuf:"varint,2,opt,name=num_finality_providers,json=numFinalityProviders,proto3" json:"num_finality_providers,omitempty"`
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTotalVotingPowerAtHeightResponse) Reset()         { *m = QueryTotalVotingPowerAtHeightResponse{} }
+func (m *QueryTotalVotingPowerAtHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalVotingPowerAtHeightResponse) ProtoMessage()    {}
+func (*QueryTotalVotingPowerAtHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{55}
+}
+func (m *QueryTotalVotingPowerAtHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalVotingPowerAtHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalVotingPowerAtHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryTotalVotingPowerAtHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalVotingPowerAtHeightResponse.Merge(m, src)
+}
+func (m *QueryTotalVotingPowerAtHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalVotingPowerAtHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalVotingPowerAtHeightResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsByVersionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Version != 0 {
-		n += 1 + sovQuery(uint64(m.Version))
+var xxx_messageInfo_QueryTotalVotingPowerAtHeightResponse proto.InternalMessageInfo
+
+func (m *QueryTotalVotingPowerAtHeightResponse) GetVotingPower() uint64 {
+	if m != nil {
+		return m.VotingPower
 	}
-	return n
+	return 0
 }
 
-func (m *QueryParamsByVersionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTotalVotingPowerAtHeightResponse) GetNumFinalityProviders() uint64 {
+	if m != nil {
+		return m.NumFinalityProviders
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryFinalityProvidersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryBTCDelegationScriptsRequest is the request type for the
+// Query/BTCDelegationScripts RPC method.
+type QueryBTCDelegationScriptsRequest struct {
+	// fp_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the finality provider
+	// the PK follows encoding in BIP-340 spec
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// staking_tx_hash_hex is the hex str of the staking tx hash of a BTC
+	// delegation restaked to the finality provider
+	StakingTxHashHex string `protobuf:"bytes,2,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
 }
 
-func (m *QueryFinalityProvidersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.FinalityProviders) > 0 {
-		for _, e := range m.FinalityProviders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBTCDelegationScriptsRequest) Reset()         { *m = QueryBTCDelegationScriptsRequest{} }
+func (m *QueryBTCDelegationScriptsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationScriptsRequest) ProtoMessage()    {}
+func (*QueryBTCDelegationScriptsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{56}
+}
+func (m *QueryBTCDelegationScriptsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationScriptsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationScriptsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryBTCDelegationScriptsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationScriptsRequest.Merge(m, src)
+}
+func (m *QueryBTCDelegationScriptsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationScriptsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationScriptsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFinalityProviderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryBTCDelegationScriptsRequest proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationScriptsRequest) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
 	}
-	return n
+	return ""
 }
 
-func (m *QueryFinalityProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FinalityProvider != nil {
-		l = m.FinalityProvider.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBTCDelegationScriptsRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
 	}
-	return n
+	return ""
 }
 
-func (m *QueryBTCDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// TaprootScriptPath is a script path of a taproot output
+type TaprootScriptPath struct {
+	// script is the revealed script of the script path
+	Script []byte `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	// control_block is the serialized control block proving the inclusion of
+	// the script in the taproot output
+	ControlBlock []byte `protobuf:"bytes,2,opt,name=control_block,json=controlBlock,proto3" json:"control_block,omitempty"`
 }
 
-func (m *QueryBTCDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.BtcDelegations) > 0 {
-		for _, e := range m.BtcDelegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *TaprootScriptPath) Reset()         { *m = TaprootScriptPath{} }
+func (m *TaprootScriptPath) String() string { return proto.CompactTextString(m) }
+func (*TaprootScriptPath) ProtoMessage()    {}
+func (*TaprootScriptPath) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{57}
+}
+func (m *TaprootScriptPath) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaprootScriptPath) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaprootScriptPath.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *TaprootScriptPath) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaprootScriptPath.Merge(m, src)
+}
+func (m *TaprootScriptPath) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaprootScriptPath) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaprootScriptPath.DiscardUnknown(m)
 }
 
-func (m *QueryFinalityProviderPowerAtHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+var xxx_messageInfo_TaprootScriptPath proto.InternalMessageInfo
+
+func (m *TaprootScriptPath) GetScript() []byte {
+	if m != nil {
+		return m.Script
 	}
-	return n
+	return nil
 }
 
-func (m *QueryFinalityProviderPowerAtHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.VotingPower != 0 {
-		n += 1 + sovQuery(uint64(m.VotingPower))
+func (m *TaprootScriptPath) GetControlBlock() []byte {
+	if m != nil {
+		return m.ControlBlock
 	}
-	return n
+	return nil
 }
 
-func (m *QueryFinalityProviderCurrentPowerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+// QueryBTCDelegationScriptsResponse is the response type for the
+// Query/BTCDelegationScripts RPC method.
+type QueryBTCDelegationScriptsResponse struct {
+	// fp_btc_pk_list is the list of BIP-340 PKs of the finality providers whose
+	// keys are embedded in the scripts
+	FpBtcPkList []github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,rep,name=fp_btc_pk_list,json=fpBtcPkList,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk_list,omitempty"`
+	// fp_idx is the index of the requested finality provider in fp_btc_pk_list
+	FpIdx uint32 `protobuf:"varint,2,opt,name=fp_idx,json=fpIdx,proto3" json:"fp_idx,omitempty"`
+	// staking_output_pk_script is the taproot pk script of the staking output
+	StakingOutputPkScript []byte `protobuf:"bytes,3,opt,name=staking_output_pk_script,json=stakingOutputPkScript,proto3" json:"staking_output_pk_script,omitempty"`
+	// staking_slashing_path is the script path for slashing the staking output
+	StakingSlashingPath *TaprootScriptPath `protobuf:"bytes,4,opt,name=staking_slashing_path,json=stakingSlashingPath,proto3" json:"staking_slashing_path,omitempty"`
+	// staking_unbonding_path is the script path for unbonding the staking output
+	StakingUnbondingPath *TaprootScriptPath `protobuf:"bytes,5,opt,name=staking_unbonding_path,json=stakingUnbondingPath,proto3" json:"staking_unbonding_path,omitempty"`
+	// unbonding_output_pk_script is the taproot pk script of the unbonding output
+	UnbondingOutputPkScript []byte `protobuf:"bytes,6,opt,name=unbonding_output_pk_script,json=unbondingOutputPkScript,proto3" json:"unbonding_output_pk_script,omitempty"`
+	// unbonding_slashing_path is the script path for slashing the unbonding output
+	UnbondingSlashingPath *TaprootScriptPath `protobuf:"bytes,7,opt,name=unbonding_slashing_path,json=unbondingSlashingPath,proto3" json:"unbonding_slashing_path,omitempty"`
+}
+
+func (m *QueryBTCDelegationScriptsResponse) Reset()         { *m = QueryBTCDelegationScriptsResponse{} }
+func (m *QueryBTCDelegationScriptsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationScriptsResponse) ProtoMessage()    {}
+func (*QueryBTCDelegationScriptsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{58}
+}
+func (m *QueryBTCDelegationScriptsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationScriptsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationScriptsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryBTCDelegationScriptsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationScriptsResponse.Merge(m, src)
+}
+func (m *QueryBTCDelegationScriptsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationScriptsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationScriptsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFinalityProviderCurrentPowerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
-	}
-	if m.VotingPower != 0 {
-		n += 1 + sovQuery(uint64(m.VotingPower))
+var xxx_messageInfo_QueryBTCDelegationScriptsResponse proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationScriptsResponse) GetFpIdx() uint32 {
+	if m != nil {
+		return m.FpIdx
 	}
-	return n
+	return 0
 }
 
-func (m *QueryActiveFinalityProvidersAtHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBTCDelegationScriptsResponse) GetStakingOutputPkScript() []byte {
+	if m != nil {
+		return m.StakingOutputPkScript
 	}
-	return n
+	return nil
 }
 
-func (m *QueryActiveFinalityProvidersAtHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.FinalityProviders) > 0 {
-		for _, e := range m.FinalityProviders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBTCDelegationScriptsResponse) GetStakingSlashingPath() *TaprootScriptPath {
+	if m != nil {
+		return m.StakingSlashingPath
 	}
-	return n
+	return nil
 }
 
-func (m *QueryActivatedHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBTCDelegationScriptsResponse) GetStakingUnbondingPath() *TaprootScriptPath {
+	if m != nil {
+		return m.StakingUnbondingPath
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryActivatedHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+func (m *QueryBTCDelegationScriptsResponse) GetUnbondingOutputPkScript() []byte {
+	if m != nil {
+		return m.UnbondingOutputPkScript
 	}
-	return n
+	return nil
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBTCDelegationScriptsResponse) GetUnbondingSlashingPath() *TaprootScriptPath {
+	if m != nil {
+		return m.UnbondingSlashingPath
 	}
-	return n
+	return nil
 }
 
-func (m *QueryFinalityProviderDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.BtcDelegatorDelegations) > 0 {
-		for _, e := range m.BtcDelegatorDelegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+// QueryVerifyDelegatorSlashingSigRequest is the request type for the
+// Query/VerifyDelegatorSlashingSig RPC method.
+type QueryVerifyDelegatorSlashingSigRequest struct {
+	// staking_tx_hash_hex is the hex str of the staking tx hash of the BTC delegation
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+}
+
+func (m *QueryVerifyDelegatorSlashingSigRequest) Reset() {
+	*m = QueryVerifyDelegatorSlashingSigRequest{}
+}
+func (m *QueryVerifyDelegatorSlashingSigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyDelegatorSlashingSigRequest) ProtoMessage()    {}
+func (*QueryVerifyDelegatorSlashingSigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{59}
+}
+func (m *QueryVerifyDelegatorSlashingSigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyDelegatorSlashingSigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyDelegatorSlashingSigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryVerifyDelegatorSlashingSigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyDelegatorSlashingSigRequest.Merge(m, src)
+}
+func (m *QueryVerifyDelegatorSlashingSigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyDelegatorSlashingSigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyDelegatorSlashingSigRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBTCDelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.StakingTxHashHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryVerifyDelegatorSlashingSigRequest proto.InternalMessageInfo
+
+func (m *QueryVerifyDelegatorSlashingSigRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
 	}
-	return n
+	return ""
 }
 
-func (m *QueryBTCDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BtcDelegation != nil {
-		l = m.BtcDelegation.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryVerifyDelegatorSlashingSigResponse is the response type for the
+// Query/VerifyDelegatorSlashingSig RPC method.
+type QueryVerifyDelegatorSlashingSigResponse struct {
+	// valid is true if the delegator's signature on the slashing tx is valid
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// invalid_reason is the verification error if valid is false
+	InvalidReason string `protobuf:"bytes,2,opt,name=invalid_reason,json=invalidReason,proto3" json:"invalid_reason,omitempty"`
 }
 
-func (m *BTCDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BtcPk != nil {
-		l = m.BtcPk.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.FpBtcPkList) > 0 {
-		for _, e := range m.FpBtcPkList {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryVerifyDelegatorSlashingSigResponse) Reset() {
+	*m = QueryVerifyDelegatorSlashingSigResponse{}
+}
+func (m *QueryVerifyDelegatorSlashingSigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyDelegatorSlashingSigResponse) ProtoMessage()    {}
+func (*QueryVerifyDelegatorSlashingSigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{60}
+}
+func (m *QueryVerifyDelegatorSlashingSigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyDelegatorSlashingSigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyDelegatorSlashingSigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.StartHeight != 0 {
-		n += 1 + sovQuery(uint64(m.StartHeight))
+}
+func (m *QueryVerifyDelegatorSlashingSigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyDelegatorSlashingSigResponse.Merge(m, src)
+}
+func (m *QueryVerifyDelegatorSlashingSigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyDelegatorSlashingSigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyDelegatorSlashingSigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyDelegatorSlashingSigResponse proto.InternalMessageInfo
+
+func (m *QueryVerifyDelegatorSlashingSigResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	if m.EndHeight != 0 {
-		n += 1 + sovQuery(uint64(m.EndHeight))
-	}
-	if m.TotalSat != 0 {
-		n += 1 + sovQuery(uint64(m.TotalSat))
-	}
-	l = len(m.StakingTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.SlashingTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.DelegatorSlashSigHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return false
+}
+
+func (m *QueryVerifyDelegatorSlashingSigResponse) GetInvalidReason() string {
+	if m != nil {
+		return m.InvalidReason
 	}
-	if len(m.CovenantSigs) > 0 {
-		for _, e := range m.CovenantSigs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+// QueryReverifyInclusionProofRequest is the request type for the
+// Query/ReverifyInclusionProof RPC method.
+type QueryReverifyInclusionProofRequest struct {
+	// staking_tx_hash_hex is the hex str of the staking tx hash of the BTC delegation
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+}
+
+func (m *QueryReverifyInclusionProofRequest) Reset()         { *m = QueryReverifyInclusionProofRequest{} }
+func (m *QueryReverifyInclusionProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReverifyInclusionProofRequest) ProtoMessage()    {}
+func (*QueryReverifyInclusionProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{61}
+}
+func (m *QueryReverifyInclusionProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReverifyInclusionProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReverifyInclusionProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.StakingOutputIdx != 0 {
-		n += 1 + sovQuery(uint64(m.StakingOutputIdx))
-	}
-	if m.Active {
-		n += 2
-	}
-	l = len(m.StatusDesc)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.UnbondingTime != 0 {
-		n += 1 + sovQuery(uint64(m.UnbondingTime))
-	}
-	if m.UndelegationResponse != nil {
-		l = m.UndelegationResponse.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryReverifyInclusionProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReverifyInclusionProofRequest.Merge(m, src)
+}
+func (m *QueryReverifyInclusionProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReverifyInclusionProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReverifyInclusionProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReverifyInclusionProofRequest proto.InternalMessageInfo
+
+func (m *QueryReverifyInclusionProofRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
 	}
-	if m.ParamsVersion != 0 {
-		n += 1 + sovQuery(uint64(m.ParamsVersion))
+	return ""
+}
+
+// QueryReverifyInclusionProofResponse is the response type for the
+// Query/ReverifyInclusionProof RPC method.
+type QueryReverifyInclusionProofResponse struct {
+	// valid is true if the BTC block including the staking tx is still in the
+	// main chain at the expected depth, and the inclusion proof still holds
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// invalid_reason is the verification error if valid is false
+	InvalidReason string `protobuf:"bytes,2,opt,name=invalid_reason,json=invalidReason,proto3" json:"invalid_reason,omitempty"`
+	// depth is the depth of the BTC block including the staking tx in the main
+	// chain. It is only meaningful if the block is still in the main chain
+	Depth uint64 `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+}
+
+func (m *QueryReverifyInclusionProofResponse) Reset()         { *m = QueryReverifyInclusionProofResponse{} }
+func (m *QueryReverifyInclusionProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReverifyInclusionProofResponse) ProtoMessage()    {}
+func (*QueryReverifyInclusionProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{62}
+}
+func (m *QueryReverifyInclusionProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReverifyInclusionProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReverifyInclusionProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryReverifyInclusionProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReverifyInclusionProofResponse.Merge(m, src)
+}
+func (m *QueryReverifyInclusionProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReverifyInclusionProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReverifyInclusionProofResponse.DiscardUnknown(m)
 }
 
-func (m *BTCUndelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryReverifyInclusionProofResponse proto.InternalMessageInfo
+
+func (m *QueryReverifyInclusionProofResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	var l int
-	_ = l
-	l = len(m.UnbondingTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return false
+}
+
+func (m *QueryReverifyInclusionProofResponse) GetInvalidReason() string {
+	if m != nil {
+		return m.InvalidReason
 	}
-	l = len(m.DelegatorUnbondingSigHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *QueryReverifyInclusionProofResponse) GetDepth() uint64 {
+	if m != nil {
+		return m.Depth
 	}
-	if len(m.CovenantUnbondingSigList) > 0 {
-		for _, e := range m.CovenantUnbondingSigList {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+// QueryCovenantSigningBatchRequest is the request type for the
+// Query/CovenantSigningBatch RPC method.
+type QueryCovenantSigningBatchRequest struct {
+	// staking_tx_hash_hex_list is the list of hex strs of the staking tx hashes
+	// of the BTC delegations
+	StakingTxHashHexList []string `protobuf:"bytes,1,rep,name=staking_tx_hash_hex_list,json=stakingTxHashHexList,proto3" json:"staking_tx_hash_hex_list,omitempty"`
+}
+
+func (m *QueryCovenantSigningBatchRequest) Reset()         { *m = QueryCovenantSigningBatchRequest{} }
+func (m *QueryCovenantSigningBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantSigningBatchRequest) ProtoMessage()    {}
+func (*QueryCovenantSigningBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{63}
+}
+func (m *QueryCovenantSigningBatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantSigningBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCovenantSigningBatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.SlashingTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.DelegatorSlashingSigHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryCovenantSigningBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantSigningBatchRequest.Merge(m, src)
+}
+func (m *QueryCovenantSigningBatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCovenantSigningBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantSigningBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCovenantSigningBatchRequest proto.InternalMessageInfo
+
+func (m *QueryCovenantSigningBatchRequest) GetStakingTxHashHexList() []string {
+	if m != nil {
+		return m.StakingTxHashHexList
 	}
-	if len(m.CovenantSlashingSigs) > 0 {
-		for _, e := range m.CovenantSlashingSigs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryCovenantSigningBatchResponse is the response type for the
+// Query/CovenantSigningBatch RPC method.
+type QueryCovenantSigningBatchResponse struct {
+	// signing_infos is the list of signing info of the BTC delegations, in the
+	// same order as the requested staking tx hashes
+	SigningInfos []*CovenantSigningInfo `protobuf:"bytes,1,rep,name=signing_infos,json=signingInfos,proto3" json:"signing_infos,omitempty"`
+}
+
+func (m *QueryCovenantSigningBatchResponse) Reset()         { *m = QueryCovenantSigningBatchResponse{} }
+func (m *QueryCovenantSigningBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantSigningBatchResponse) ProtoMessage()    {}
+func (*QueryCovenantSigningBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{64}
+}
+func (m *QueryCovenantSigningBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantSigningBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCovenantSigningBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryCovenantSigningBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantSigningBatchResponse.Merge(m, src)
+}
+func (m *QueryCovenantSigningBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCovenantSigningBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantSigningBatchResponse.DiscardUnknown(m)
 }
 
-func (m *BTCDelegatorDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryCovenantSigningBatchResponse proto.InternalMessageInfo
+
+func (m *QueryCovenantSigningBatchResponse) GetSigningInfos() []*CovenantSigningInfo {
+	if m != nil {
+		return m.SigningInfos
 	}
-	var l int
-	_ = l
-	if len(m.Dels) > 0 {
-		for _, e := range m.Dels {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// CovenantSigningInfo is what a covenant member needs to sign the txs of a
+// BTC delegation
+type CovenantSigningInfo struct {
+	// staking_tx_hash_hex is the hex str of the staking tx hash of the BTC delegation
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+	// fp_btc_pk_list is the list of BIP-340 PKs of the finality providers that
+	// the BTC delegation restakes to, to which the adaptor signatures on the
+	// slashing txs are encrypted
+	FpBtcPkList []github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,2,rep,name=fp_btc_pk_list,json=fpBtcPkList,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk_list,omitempty"`
+	// staking_tx is the staking tx
+	StakingTx []byte `protobuf:"bytes,3,opt,name=staking_tx,json=stakingTx,proto3" json:"staking_tx,omitempty"`
+	// staking_output_idx is the index of the staking output in the staking tx
+	StakingOutputIdx uint32 `protobuf:"varint,4,opt,name=staking_output_idx,json=stakingOutputIdx,proto3" json:"staking_output_idx,omitempty"`
+	// slashing_tx is the slashing tx spending the staking output
+	SlashingTx *BTCSlashingTx `protobuf:"bytes,5,opt,name=slashing_tx,json=slashingTx,proto3,customtype=BTCSlashingTx" json:"slashing_tx,omitempty"`
+	// staking_slashing_path is the script path for slashing the staking output
+	StakingSlashingPath *TaprootScriptPath `protobuf:"bytes,6,opt,name=staking_slashing_path,json=stakingSlashingPath,proto3" json:"staking_slashing_path,omitempty"`
+	// unbonding_tx is the unbonding tx spending the staking output
+	UnbondingTx []byte `protobuf:"bytes,7,opt,name=unbonding_tx,json=unbondingTx,proto3" json:"unbonding_tx,omitempty"`
+	// staking_unbonding_path is the script path for unbonding the staking output
+	StakingUnbondingPath *TaprootScriptPath `protobuf:"bytes,8,opt,name=staking_unbonding_path,json=stakingUnbondingPath,proto3" json:"staking_unbonding_path,omitempty"`
+	// unbonding_slashing_tx is the slashing tx spending the unbonding output
+	UnbondingSlashingTx *BTCSlashingTx `protobuf:"bytes,9,opt,name=unbonding_slashing_tx,json=unbondingSlashingTx,proto3,customtype=BTCSlashingTx" json:"unbonding_slashing_tx,omitempty"`
+	// unbonding_slashing_path is the script path for slashing the unbonding output
+	UnbondingSlashingPath *TaprootScriptPath `protobuf:"bytes,10,opt,name=unbonding_slashing_path,json=unbondingSlashingPath,proto3" json:"unbonding_slashing_path,omitempty"`
+}
+
+func (m *CovenantSigningInfo) Reset()         { *m = CovenantSigningInfo{} }
+func (m *CovenantSigningInfo) String() string { return proto.CompactTextString(m) }
+func (*CovenantSigningInfo) ProtoMessage()    {}
+func (*CovenantSigningInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{65}
+}
+func (m *CovenantSigningInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CovenantSigningInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CovenantSigningInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *CovenantSigningInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CovenantSigningInfo.Merge(m, src)
+}
+func (m *CovenantSigningInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *CovenantSigningInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CovenantSigningInfo.DiscardUnknown(m)
 }
 
-func (m *FinalityProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Description != nil {
-		l = m.Description.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Commission != nil {
-		l = m.Commission.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.BabylonPk != nil {
-		l = m.BabylonPk.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.BtcPk != nil {
-		l = m.BtcPk.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pop != nil {
-		l = m.Pop.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.SlashedBabylonHeight != 0 {
-		n += 1 + sovQuery(uint64(m.SlashedBabylonHeight))
+var xxx_messageInfo_CovenantSigningInfo proto.InternalMessageInfo
+
+func (m *CovenantSigningInfo) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
 	}
-	if m.SlashedBtcHeight != 0 {
-		n += 1 + sovQuery(uint64(m.SlashedBtcHeight))
+	return ""
+}
+
+func (m *CovenantSigningInfo) GetStakingTx() []byte {
+	if m != nil {
+		return m.StakingTx
 	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	return nil
+}
+
+func (m *CovenantSigningInfo) GetStakingOutputIdx() uint32 {
+	if m != nil {
+		return m.StakingOutputIdx
 	}
-	if m.VotingPower != 0 {
-		n += 1 + sovQuery(uint64(m.VotingPower))
+	return 0
+}
+
+func (m *CovenantSigningInfo) GetStakingSlashingPath() *TaprootScriptPath {
+	if m != nil {
+		return m.StakingSlashingPath
 	}
-	return n
+	return nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *CovenantSigningInfo) GetUnbondingTx() []byte {
+	if m != nil {
+		return m.UnbondingTx
+	}
+	return nil
+}
+
+func (m *CovenantSigningInfo) GetStakingUnbondingPath() *TaprootScriptPath {
+	if m != nil {
+		return m.StakingUnbondingPath
+	}
+	return nil
+}
+
+func (m *CovenantSigningInfo) GetUnbondingSlashingPath() *TaprootScriptPath {
+	if m != nil {
+		return m.UnbondingSlashingPath
+	}
+	return nil
+}
+
+// QueryUnbondingCovenantProgressRequest is the request type for the
+// Query/UnbondingCovenantProgress RPC method.
+type QueryUnbondingCovenantProgressRequest struct {
+	// staking_tx_hash_hex is the hex str of the staking tx hash of the BTC delegation
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+}
+
+func (m *QueryUnbondingCovenantProgressRequest) Reset()         { *m = QueryUnbondingCovenantProgressRequest{} }
+func (m *QueryUnbondingCovenantProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingCovenantProgressRequest) ProtoMessage()    {}
+func (*QueryUnbondingCovenantProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{66}
+}
+func (m *QueryUnbondingCovenantProgressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingCovenantProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingCovenantProgressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnbondingCovenantProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingCovenantProgressRequest.Merge(m, src)
+}
+func (m *QueryUnbondingCovenantProgressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingCovenantProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingCovenantProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnbondingCovenantProgressRequest proto.InternalMessageInfo
+
+func (m *QueryUnbondingCovenantProgressRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
+	}
+	return ""
+}
+
+// QueryUnbondingCovenantProgressResponse is the response type for the
+// Query/UnbondingCovenantProgress RPC method.
+type QueryUnbondingCovenantProgressResponse struct {
+	// status is the current status of the BTC delegation
+	Status BTCDelegationStatus `protobuf:"varint,1,opt,name=status,proto3,enum=babylon.btcstaking.v1.BTCDelegationStatus" json:"status,omitempty"`
+	// delegator_unbonding_sig_submitted is true if the delegator has submitted
+	// its signature on the unbonding tx, i.e., has requested to unbond
+	DelegatorUnbondingSigSubmitted bool `protobuf:"varint,2,opt,name=delegator_unbonding_sig_submitted,json=delegatorUnbondingSigSubmitted,proto3" json:"delegator_unbonding_sig_submitted,omitempty"`
+	// covenant_quorum is the number of covenant signatures needed on each of
+	// the unbonding tx and the unbonding slashing tx
+	CovenantQuorum uint32 `protobuf:"varint,3,opt,name=covenant_quorum,json=covenantQuorum,proto3" json:"covenant_quorum,omitempty"`
+	// num_covenant_unbonding_sigs is the number of covenant signatures
+	// collected on the unbonding tx
+	NumCovenantUnbondingSigs uint32 `protobuf:"varint,4,opt,name=num_covenant_unbonding_sigs,json=numCovenantUnbondingSigs,proto3" json:"num_covenant_unbonding_sigs,omitempty"`
+	// num_covenant_unbonding_slashing_sigs is the number of covenant adaptor
+	// signatures collected on the unbonding slashing tx
+	NumCovenantUnbondingSlashingSigs uint32 `protobuf:"varint,5,opt,name=num_covenant_unbonding_slashing_sigs,json=numCovenantUnbondingSlashingSigs,proto3" json:"num_covenant_unbonding_slashing_sigs,omitempty"`
+	// has_covenant_quorums is true if both the unbonding tx and the unbonding
+	// slashing tx have collected a quorum of covenant signatures
+	HasCovenantQuorums bool `protobuf:"varint,6,opt,name=has_covenant_quorums,json=hasCovenantQuorums,proto3" json:"has_covenant_quorums,omitempty"`
+	// pending_covenant_pks is the list of BIP-340 PKs of the covenant members
+	// that have not signed both the unbonding tx and the unbonding slashing tx
+	PendingCovenantPks []github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,7,rep,name=pending_covenant_pks,json=pendingCovenantPks,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"pending_covenant_pks,omitempty"`
+}
+
+func (m *QueryUnbondingCovenantProgressResponse) Reset() {
+	*m = QueryUnbondingCovenantProgressResponse{}
+}
+func (m *QueryUnbondingCovenantProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingCovenantProgressResponse) ProtoMessage()    {}
+func (*QueryUnbondingCovenantProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{67}
+}
+func (m *QueryUnbondingCovenantProgressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingCovenantProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingCovenantProgressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnbondingCovenantProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingCovenantProgressResponse.Merge(m, src)
+}
+func (m *QueryUnbondingCovenantProgressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingCovenantProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingCovenantProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnbondingCovenantProgressResponse proto.InternalMessageInfo
+
+func (m *QueryUnbondingCovenantProgressResponse) GetStatus() BTCDelegationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return BTCDelegationStatus_PENDING
+}
+
+func (m *QueryUnbondingCovenantProgressResponse) GetDelegatorUnbondingSigSubmitted() bool {
+	if m != nil {
+		return m.DelegatorUnbondingSigSubmitted
+	}
+	return false
+}
+
+func (m *QueryUnbondingCovenantProgressResponse) GetCovenantQuorum() uint32 {
+	if m != nil {
+		return m.CovenantQuorum
+	}
+	return 0
+}
+
+func (m *QueryUnbondingCovenantProgressResponse) GetNumCovenantUnbondingSigs() uint32 {
+	if m != nil {
+		return m.NumCovenantUnbondingSigs
+	}
+	return 0
+}
+
+func (m *QueryUnbondingCovenantProgressResponse) GetNumCovenantUnbondingSlashingSigs() uint32 {
+	if m != nil {
+		return m.NumCovenantUnbondingSlashingSigs
+	}
+	return 0
+}
+
+func (m *QueryUnbondingCovenantProgressResponse) GetHasCovenantQuorums() bool {
+	if m != nil {
+		return m.HasCovenantQuorums
+	}
+	return false
+}
+
+// QueryRecommendedSlashingFeeRateRequest is the request type for the
+// Query/RecommendedSlashingFeeRate RPC method.
+type QueryRecommendedSlashingFeeRateRequest struct {
+}
+
+func (m *QueryRecommendedSlashingFeeRateRequest) Reset() {
+	*m = QueryRecommendedSlashingFeeRateRequest{}
+}
+func (m *QueryRecommendedSlashingFeeRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecommendedSlashingFeeRateRequest) ProtoMessage()    {}
+func (*QueryRecommendedSlashingFeeRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{68}
+}
+func (m *QueryRecommendedSlashingFeeRateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecommendedSlashingFeeRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecommendedSlashingFeeRateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecommendedSlashingFeeRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecommendedSlashingFeeRateRequest.Merge(m, src)
+}
+func (m *QueryRecommendedSlashingFeeRateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecommendedSlashingFeeRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecommendedSlashingFeeRateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecommendedSlashingFeeRateRequest proto.InternalMessageInfo
+
+// QueryRecommendedSlashingFeeRateResponse is the response type for the
+// Query/RecommendedSlashingFeeRate RPC method.
+type QueryRecommendedSlashingFeeRateResponse struct {
+	// fee_rate_sat_per_vbyte is the fee rate in sat/vB recommended for
+	// broadcasting slashing and unbonding txs
+	FeeRateSatPerVbyte uint64 `protobuf:"varint,1,opt,name=fee_rate_sat_per_vbyte,json=feeRateSatPerVbyte,proto3" json:"fee_rate_sat_per_vbyte,omitempty"`
+	// min_slashing_tx_fee_sat is the minimum absolute fee of a slashing tx in
+	// satoshis, which the fee computed from the fee rate should not fall below
+	MinSlashingTxFeeSat int64 `protobuf:"varint,2,opt,name=min_slashing_tx_fee_sat,json=minSlashingTxFeeSat,proto3" json:"min_slashing_tx_fee_sat,omitempty"`
+}
+
+func (m *QueryRecommendedSlashingFeeRateResponse) Reset() {
+	*m = QueryRecommendedSlashingFeeRateResponse{}
+}
+func (m *QueryRecommendedSlashingFeeRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecommendedSlashingFeeRateResponse) ProtoMessage()    {}
+func (*QueryRecommendedSlashingFeeRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{69}
+}
+func (m *QueryRecommendedSlashingFeeRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecommendedSlashingFeeRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecommendedSlashingFeeRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecommendedSlashingFeeRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecommendedSlashingFeeRateResponse.Merge(m, src)
+}
+func (m *QueryRecommendedSlashingFeeRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecommendedSlashingFeeRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecommendedSlashingFeeRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecommendedSlashingFeeRateResponse proto.InternalMessageInfo
+
+func (m *QueryRecommendedSlashingFeeRateResponse) GetFeeRateSatPerVbyte() uint64 {
+	if m != nil {
+		return m.FeeRateSatPerVbyte
+	}
+	return 0
+}
+
+func (m *QueryRecommendedSlashingFeeRateResponse) GetMinSlashingTxFeeSat() int64 {
+	if m != nil {
+		return m.MinSlashingTxFeeSat
+	}
+	return 0
+}
+
+// QueryDelegationSpendTreeRequest is the request type for the
+// Query/DelegationSpendTree RPC method.
+type QueryDelegationSpendTreeRequest struct {
+	// staking_tx_hash_hex is the hex str of the staking tx hash of the BTC delegation
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+}
+
+func (m *QueryDelegationSpendTreeRequest) Reset()         { *m = QueryDelegationSpendTreeRequest{} }
+func (m *QueryDelegationSpendTreeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationSpendTreeRequest) ProtoMessage()    {}
+func (*QueryDelegationSpendTreeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{70}
+}
+func (m *QueryDelegationSpendTreeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationSpendTreeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationSpendTreeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationSpendTreeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationSpendTreeRequest.Merge(m, src)
+}
+func (m *QueryDelegationSpendTreeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationSpendTreeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationSpendTreeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationSpendTreeRequest proto.InternalMessageInfo
+
+func (m *QueryDelegationSpendTreeRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
+	}
+	return ""
+}
+
+// TaprootTreeLeaf is a leaf of a taproot script tree
+type TaprootTreeLeaf struct {
+	// leaf_index is the position of the leaf in the taproot script tree
+	LeafIndex uint32 `protobuf:"varint,1,opt,name=leaf_index,json=leafIndex,proto3" json:"leaf_index,omitempty"`
+	// script_path is the script of the leaf and its control block
+	ScriptPath *TaprootScriptPath `protobuf:"bytes,2,opt,name=script_path,json=scriptPath,proto3" json:"script_path,omitempty"`
+}
+
+func (m *TaprootTreeLeaf) Reset()         { *m = TaprootTreeLeaf{} }
+func (m *TaprootTreeLeaf) String() string { return proto.CompactTextString(m) }
+func (*TaprootTreeLeaf) ProtoMessage()    {}
+func (*TaprootTreeLeaf) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{71}
+}
+func (m *TaprootTreeLeaf) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaprootTreeLeaf) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaprootTreeLeaf.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaprootTreeLeaf) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaprootTreeLeaf.Merge(m, src)
+}
+func (m *TaprootTreeLeaf) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaprootTreeLeaf) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaprootTreeLeaf.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaprootTreeLeaf proto.InternalMessageInfo
+
+func (m *TaprootTreeLeaf) GetLeafIndex() uint32 {
+	if m != nil {
+		return m.LeafIndex
+	}
+	return 0
+}
+
+func (m *TaprootTreeLeaf) GetScriptPath() *TaprootScriptPath {
+	if m != nil {
+		return m.ScriptPath
+	}
+	return nil
+}
+
+// QueryDelegationSpendTreeResponse is the response type for the
+// Query/DelegationSpendTree RPC method.
+type QueryDelegationSpendTreeResponse struct {
+	// internal_key is the x-only taproot internal key of the staking output.
+	// It is provably unspendable, so the staking output can only be spent via
+	// one of the script paths
+	InternalKey []byte `protobuf:"bytes,1,opt,name=internal_key,json=internalKey,proto3" json:"internal_key,omitempty"`
+	// staking_output_pk_script is the taproot pk script of the staking output
+	StakingOutputPkScript []byte `protobuf:"bytes,2,opt,name=staking_output_pk_script,json=stakingOutputPkScript,proto3" json:"staking_output_pk_script,omitempty"`
+	// timelock_leaf is the leaf for the staker to spend the staking output
+	// after the staking timelock expires
+	TimelockLeaf *TaprootTreeLeaf `protobuf:"bytes,3,opt,name=timelock_leaf,json=timelockLeaf,proto3" json:"timelock_leaf,omitempty"`
+	// unbonding_leaf is the leaf for the staker to spend the staking output
+	// together with the covenant committee, i.e., to unbond early
+	UnbondingLeaf *TaprootTreeLeaf `protobuf:"bytes,4,opt,name=unbonding_leaf,json=unbondingLeaf,proto3" json:"unbonding_leaf,omitempty"`
+	// slashing_leaf is the leaf for the staker to spend the staking output
+	// together with a finality provider and the covenant committee, i.e.,
+	// to slash the staking output
+	SlashingLeaf *TaprootTreeLeaf `protobuf:"bytes,5,opt,name=slashing_leaf,json=slashingLeaf,proto3" json:"slashing_leaf,omitempty"`
+}
+
+func (m *QueryDelegationSpendTreeResponse) Reset()         { *m = QueryDelegationSpendTreeResponse{} }
+func (m *QueryDelegationSpendTreeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationSpendTreeResponse) ProtoMessage()    {}
+func (*QueryDelegationSpendTreeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{72}
+}
+func (m *QueryDelegationSpendTreeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationSpendTreeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationSpendTreeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationSpendTreeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationSpendTreeResponse.Merge(m, src)
+}
+func (m *QueryDelegationSpendTreeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationSpendTreeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationSpendTreeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationSpendTreeResponse proto.InternalMessageInfo
+
+func (m *QueryDelegationSpendTreeResponse) GetInternalKey() []byte {
+	if m != nil {
+		return m.InternalKey
+	}
+	return nil
+}
+
+func (m *QueryDelegationSpendTreeResponse) GetStakingOutputPkScript() []byte {
+	if m != nil {
+		return m.StakingOutputPkScript
+	}
+	return nil
+}
+
+func (m *QueryDelegationSpendTreeResponse) GetTimelockLeaf() *TaprootTreeLeaf {
+	if m != nil {
+		return m.TimelockLeaf
+	}
+	return nil
+}
+
+func (m *QueryDelegationSpendTreeResponse) GetUnbondingLeaf() *TaprootTreeLeaf {
+	if m != nil {
+		return m.UnbondingLeaf
+	}
+	return nil
+}
+
+func (m *QueryDelegationSpendTreeResponse) GetSlashingLeaf() *TaprootTreeLeaf {
+	if m != nil {
+		return m.SlashingLeaf
+	}
+	return nil
+}
+
+// QueryVotingPowerTableDiscrepanciesRequest is the request type for the
+// Query/VotingPowerTableDiscrepancies RPC method.
+type QueryVotingPowerTableDiscrepanciesRequest struct {
+}
+
+func (m *QueryVotingPowerTableDiscrepanciesRequest) Reset() {
+	*m = QueryVotingPowerTableDiscrepanciesRequest{}
+}
+func (m *QueryVotingPowerTableDiscrepanciesRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryVotingPowerTableDiscrepanciesRequest) ProtoMessage() {}
+func (*QueryVotingPowerTableDiscrepanciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{73}
+}
+func (m *QueryVotingPowerTableDiscrepanciesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotingPowerTableDiscrepanciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotingPowerTableDiscrepanciesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVotingPowerTableDiscrepanciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotingPowerTableDiscrepanciesRequest.Merge(m, src)
+}
+func (m *QueryVotingPowerTableDiscrepanciesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotingPowerTableDiscrepanciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotingPowerTableDiscrepanciesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVotingPowerTableDiscrepanciesRequest proto.InternalMessageInfo
+
+// VotingPowerDiscrepancy is a finality provider whose voting power in the
+// stored voting power table differs from the one recomputed from scratch
+type VotingPowerDiscrepancy struct {
+	// fp_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the finality provider
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// stored_voting_power is the voting power of the finality provider in the
+	// stored voting power table
+	StoredVotingPower uint64 `protobuf:"varint,2,opt,name=stored_voting_power,json=storedVotingPower,proto3" json:"stored_voting_power,omitempty"`
+	// expected_voting_power is the voting power of the finality provider
+	// recomputed from scratch over all BTC delegations
+	ExpectedVotingPower uint64 `protobuf:"varint,3,opt,name=expected_voting_power,json=expectedVotingPower,proto3" json:"expected_voting_power,omitempty"`
+}
+
+func (m *VotingPowerDiscrepancy) Reset()         { *m = VotingPowerDiscrepancy{} }
+func (m *VotingPowerDiscrepancy) String() string { return proto.CompactTextString(m) }
+func (*VotingPowerDiscrepancy) ProtoMessage()    {}
+func (*VotingPowerDiscrepancy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{74}
+}
+func (m *VotingPowerDiscrepancy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotingPowerDiscrepancy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotingPowerDiscrepancy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VotingPowerDiscrepancy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotingPowerDiscrepancy.Merge(m, src)
+}
+func (m *VotingPowerDiscrepancy) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotingPowerDiscrepancy) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotingPowerDiscrepancy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotingPowerDiscrepancy proto.InternalMessageInfo
+
+func (m *VotingPowerDiscrepancy) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
+	}
+	return ""
+}
+
+func (m *VotingPowerDiscrepancy) GetStoredVotingPower() uint64 {
+	if m != nil {
+		return m.StoredVotingPower
+	}
+	return 0
+}
+
+func (m *VotingPowerDiscrepancy) GetExpectedVotingPower() uint64 {
+	if m != nil {
+		return m.ExpectedVotingPower
+	}
+	return 0
+}
+
+// QueryVotingPowerTableDiscrepanciesResponse is the response type for the
+// Query/VotingPowerTableDiscrepancies RPC method.
+type QueryVotingPowerTableDiscrepanciesResponse struct {
+	// height is the Babylon height of the latest voting power table
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// discrepancies is the list of finality providers whose stored voting
+	// power differs from the recomputed one, in the order of their BTC PKs
+	Discrepancies []*VotingPowerDiscrepancy `protobuf:"bytes,2,rep,name=discrepancies,proto3" json:"discrepancies,omitempty"`
+}
+
+func (m *QueryVotingPowerTableDiscrepanciesResponse) Reset() {
+	*m = QueryVotingPowerTableDiscrepanciesResponse{}
+}
+func (m *QueryVotingPowerTableDiscrepanciesResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryVotingPowerTableDiscrepanciesResponse) ProtoMessage() {}
+func (*QueryVotingPowerTableDiscrepanciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{75}
+}
+func (m *QueryVotingPowerTableDiscrepanciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVotingPowerTableDiscrepanciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotingPowerTableDiscrepanciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVotingPowerTableDiscrepanciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotingPowerTableDiscrepanciesResponse.Merge(m, src)
+}
+func (m *QueryVotingPowerTableDiscrepanciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVotingPowerTableDiscrepanciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotingPowerTableDiscrepanciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVotingPowerTableDiscrepanciesResponse proto.InternalMessageInfo
+
+func (m *QueryVotingPowerTableDiscrepanciesResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryVotingPowerTableDiscrepanciesResponse) GetDiscrepancies() []*VotingPowerDiscrepancy {
+	if m != nil {
+		return m.Discrepancies
+	}
+	return nil
+}
+
+// QueryFinalityProviderSlashingImpactRequest is the request type for the
+// Query/FinalityProviderSlashingImpact RPC method.
+type QueryFinalityProviderSlashingImpactRequest struct {
+	// fp_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the finality provider
+	// the PK follows encoding in BIP-340 spec
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+}
+
+func (m *QueryFinalityProviderSlashingImpactRequest) Reset() {
+	*m = QueryFinalityProviderSlashingImpactRequest{}
+}
+func (m *QueryFinalityProviderSlashingImpactRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryFinalityProviderSlashingImpactRequest) ProtoMessage() {}
+func (*QueryFinalityProviderSlashingImpactRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{76}
+}
+func (m *QueryFinalityProviderSlashingImpactRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderSlashingImpactRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderSlashingImpactRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalityProviderSlashingImpactRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderSlashingImpactRequest.Merge(m, src)
+}
+func (m *QueryFinalityProviderSlashingImpactRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderSlashingImpactRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderSlashingImpactRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalityProviderSlashingImpactRequest proto.InternalMessageInfo
+
+func (m *QueryFinalityProviderSlashingImpactRequest) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
+	}
+	return ""
+}
+
+// QueryFinalityProviderSlashingImpactResponse is the response type for the
+// Query/FinalityProviderSlashingImpact RPC method.
+type QueryFinalityProviderSlashingImpactResponse struct {
+	// btc_delegations is the list of BTC delegations restaked to the finality
+	// provider whose slashing tx can be broadcast to BTC, i.e., the active or
+	// early unbonded ones
+	BtcDelegations []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=btc_delegations,json=btcDelegations,proto3" json:"btc_delegations,omitempty"`
+	// total_sat is the total amount in satoshi staked by the BTC delegations
+	TotalSat uint64 `protobuf:"varint,2,opt,name=total_sat,json=totalSat,proto3" json:"total_sat,omitempty"`
+	// total_slashing_amount is the total amount in satoshi that the slashing
+	// txs of the BTC delegations send to the slashing addresses, where the
+	// slashing tx of the unbonding output is used for early unbonded BTC
+	// delegations
+	TotalSlashingAmount uint64 `protobuf:"varint,3,opt,name=total_slashing_amount,json=totalSlashingAmount,proto3" json:"total_slashing_amount,omitempty"`
+}
+
+func (m *QueryFinalityProviderSlashingImpactResponse) Reset() {
+	*m = QueryFinalityProviderSlashingImpactResponse{}
+}
+func (m *QueryFinalityProviderSlashingImpactResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryFinalityProviderSlashingImpactResponse) ProtoMessage() {}
+func (*QueryFinalityProviderSlashingImpactResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{77}
+}
+func (m *QueryFinalityProviderSlashingImpactResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderSlashingImpactResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderSlashingImpactResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalityProviderSlashingImpactResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderSlashingImpactResponse.Merge(m, src)
+}
+func (m *QueryFinalityProviderSlashingImpactResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderSlashingImpactResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderSlashingImpactResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalityProviderSlashingImpactResponse proto.InternalMessageInfo
+
+func (m *QueryFinalityProviderSlashingImpactResponse) GetBtcDelegations() []*BTCDelegationResponse {
+	if m != nil {
+		return m.BtcDelegations
+	}
+	return nil
+}
+
+func (m *QueryFinalityProviderSlashingImpactResponse) GetTotalSat() uint64 {
+	if m != nil {
+		return m.TotalSat
+	}
+	return 0
+}
+
+func (m *QueryFinalityProviderSlashingImpactResponse) GetTotalSlashingAmount() uint64 {
+	if m != nil {
+		return m.TotalSlashingAmount
+	}
+	return 0
+}
+
+// QueryVerifyCovenantQuorumSpendRequest is the request type for the
+// Query/VerifyCovenantQuorumSpend RPC method.
+type QueryVerifyCovenantQuorumSpendRequest struct {
+	// staking_tx_hash_hex is the hex str of the staking tx hash of the BTC delegation
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+	// path is the spend path of the staking output to verify
+	Path CovenantSpendPath `protobuf:"varint,2,opt,name=path,proto3,enum=babylon.btcstaking.v1.CovenantSpendPath" json:"path,omitempty"`
+}
+
+func (m *QueryVerifyCovenantQuorumSpendRequest) Reset()         { *m = QueryVerifyCovenantQuorumSpendRequest{} }
+func (m *QueryVerifyCovenantQuorumSpendRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyCovenantQuorumSpendRequest) ProtoMessage()    {}
+func (*QueryVerifyCovenantQuorumSpendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{78}
+}
+func (m *QueryVerifyCovenantQuorumSpendRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyCovenantQuorumSpendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyCovenantQuorumSpendRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyCovenantQuorumSpendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyCovenantQuorumSpendRequest.Merge(m, src)
+}
+func (m *QueryVerifyCovenantQuorumSpendRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyCovenantQuorumSpendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyCovenantQuorumSpendRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyCovenantQuorumSpendRequest proto.InternalMessageInfo
+
+func (m *QueryVerifyCovenantQuorumSpendRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
+	}
+	return ""
+}
+
+func (m *QueryVerifyCovenantQuorumSpendRequest) GetPath() CovenantSpendPath {
+	if m != nil {
+		return m.Path
+	}
+	return CovenantSpendPath_UNBONDING
+}
+
+// QueryVerifyCovenantQuorumSpendResponse is the response type for the
+// Query/VerifyCovenantQuorumSpend RPC method.
+type QueryVerifyCovenantQuorumSpendResponse struct {
+	// spendable indicates whether a witness satisfying the taproot leaf of the
+	// path can currently be assembled from the stored signatures
+	Spendable bool `protobuf:"varint,1,opt,name=spendable,proto3" json:"spendable,omitempty"`
+	// covenant_quorum is the number of covenant signatures required by the
+	// taproot leaf of the path
+	CovenantQuorum uint32 `protobuf:"varint,2,opt,name=covenant_quorum,json=covenantQuorum,proto3" json:"covenant_quorum,omitempty"`
+	// num_valid_covenant_sigs is the number of stored covenant signatures on
+	// the spending tx that pass verification. For the slashing path, these are
+	// adaptor signatures that verify under every restaked finality provider
+	NumValidCovenantSigs uint32 `protobuf:"varint,3,opt,name=num_valid_covenant_sigs,json=numValidCovenantSigs,proto3" json:"num_valid_covenant_sigs,omitempty"`
+	// reason explains why a valid spend cannot currently be constructed. It is
+	// empty if spendable is true
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *QueryVerifyCovenantQuorumSpendResponse) Reset() {
+	*m = QueryVerifyCovenantQuorumSpendResponse{}
+}
+func (m *QueryVerifyCovenantQuorumSpendResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyCovenantQuorumSpendResponse) ProtoMessage()    {}
+func (*QueryVerifyCovenantQuorumSpendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{79}
+}
+func (m *QueryVerifyCovenantQuorumSpendResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyCovenantQuorumSpendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyCovenantQuorumSpendResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyCovenantQuorumSpendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyCovenantQuorumSpendResponse.Merge(m, src)
+}
+func (m *QueryVerifyCovenantQuorumSpendResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyCovenantQuorumSpendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyCovenantQuorumSpendResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyCovenantQuorumSpendResponse proto.InternalMessageInfo
+
+func (m *QueryVerifyCovenantQuorumSpendResponse) GetSpendable() bool {
+	if m != nil {
+		return m.Spendable
+	}
+	return false
+}
+
+func (m *QueryVerifyCovenantQuorumSpendResponse) GetCovenantQuorum() uint32 {
+	if m != nil {
+		return m.CovenantQuorum
+	}
+	return 0
+}
+
+func (m *QueryVerifyCovenantQuorumSpendResponse) GetNumValidCovenantSigs() uint32 {
+	if m != nil {
+		return m.NumValidCovenantSigs
+	}
+	return 0
+}
+
+func (m *QueryVerifyCovenantQuorumSpendResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// QueryDelegationFirstRewardHeightRequest is the request type for the
+// Query/DelegationFirstRewardHeight RPC method.
+type QueryDelegationFirstRewardHeightRequest struct {
+	// staking_tx_hash_hex is the hex str of the staking tx hash of the BTC delegation
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+}
+
+func (m *QueryDelegationFirstRewardHeightRequest) Reset() {
+	*m = QueryDelegationFirstRewardHeightRequest{}
+}
+func (m *QueryDelegationFirstRewardHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationFirstRewardHeightRequest) ProtoMessage()    {}
+func (*QueryDelegationFirstRewardHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{80}
+}
+func (m *QueryDelegationFirstRewardHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationFirstRewardHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationFirstRewardHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationFirstRewardHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationFirstRewardHeightRequest.Merge(m, src)
+}
+func (m *QueryDelegationFirstRewardHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationFirstRewardHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationFirstRewardHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationFirstRewardHeightRequest proto.InternalMessageInfo
+
+func (m *QueryDelegationFirstRewardHeightRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
+	}
+	return ""
+}
+
+// QueryDelegationFirstRewardHeightResponse is the response type for the
+// Query/DelegationFirstRewardHeight RPC method. Heights that are not reached
+// yet are estimated assuming that the epoch interval does not change and
+// that the finality providers of the BTC delegation are active and finalize
+// every block as early as possible
+type QueryDelegationFirstRewardHeightResponse struct {
+	// first_reward_height is the Babylon height from which the BTC delegation
+	// earns a non-zero reward. It is the current height if it already does
+	FirstRewardHeight uint64 `protobuf:"varint,1,opt,name=first_reward_height,json=firstRewardHeight,proto3" json:"first_reward_height,omitempty"`
+	// blocks_until_first_reward is the number of Babylon blocks from the
+	// current height until first_reward_height
+	BlocksUntilFirstReward uint64 `protobuf:"varint,2,opt,name=blocks_until_first_reward,json=blocksUntilFirstReward,proto3" json:"blocks_until_first_reward,omitempty"`
+	// activation_height is the Babylon height of the first block whose voting
+	// power distribution includes the BTC delegation. It is zero if the BTC
+	// delegation has already received rewards
+	ActivationHeight uint64 `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// finalization_height is the Babylon height at which the block at
+	// activation_height is finalized and its rewards are distributed. It is
+	// zero if the BTC delegation has already received rewards
+	FinalizationHeight uint64 `protobuf:"varint,4,opt,name=finalization_height,json=finalizationHeight,proto3" json:"finalization_height,omitempty"`
+	// reward_start_epoch is the epoch in which the BTC delegation receives its
+	// first reward distribution, in which its reward is fully locked up if
+	// reward_lockup_epochs is non-zero. It is zero if the BTC delegation
+	// received rewards while there was no reward lockup
+	RewardStartEpoch uint64 `protobuf:"varint,5,opt,name=reward_start_epoch,json=rewardStartEpoch,proto3" json:"reward_start_epoch,omitempty"`
+	// reward_lockup_epochs is the number of epochs over which the rewards of a
+	// new BTC delegation are prorated
+	RewardLockupEpochs uint64 `protobuf:"varint,6,opt,name=reward_lockup_epochs,json=rewardLockupEpochs,proto3" json:"reward_lockup_epochs,omitempty"`
+	// earning indicates whether the BTC delegation already earns a non-zero reward
+	Earning bool `protobuf:"varint,7,opt,name=earning,proto3" json:"earning,omitempty"`
+	// awaiting_covenant_quorum indicates whether the BTC delegation still
+	// waits for a quorum of covenant signatures, in which case the heights
+	// assume that the quorum is reached in the next block
+	AwaitingCovenantQuorum bool `protobuf:"varint,8,opt,name=awaiting_covenant_quorum,json=awaitingCovenantQuorum,proto3" json:"awaiting_covenant_quorum,omitempty"`
+}
+
+func (m *QueryDelegationFirstRewardHeightResponse) Reset() {
+	*m = QueryDelegationFirstRewardHeightResponse{}
+}
+func (m *QueryDelegationFirstRewardHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationFirstRewardHeightResponse) ProtoMessage()    {}
+func (*QueryDelegationFirstRewardHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{81}
+}
+func (m *QueryDelegationFirstRewardHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationFirstRewardHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationFirstRewardHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationFirstRewardHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationFirstRewardHeightResponse.Merge(m, src)
+}
+func (m *QueryDelegationFirstRewardHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationFirstRewardHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationFirstRewardHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationFirstRewardHeightResponse proto.InternalMessageInfo
+
+func (m *QueryDelegationFirstRewardHeightResponse) GetFirstRewardHeight() uint64 {
+	if m != nil {
+		return m.FirstRewardHeight
+	}
+	return 0
+}
+
+func (m *QueryDelegationFirstRewardHeightResponse) GetBlocksUntilFirstReward() uint64 {
+	if m != nil {
+		return m.BlocksUntilFirstReward
+	}
+	return 0
+}
+
+func (m *QueryDelegationFirstRewardHeightResponse) GetActivationHeight() uint64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *QueryDelegationFirstRewardHeightResponse) GetFinalizationHeight() uint64 {
+	if m != nil {
+		return m.FinalizationHeight
+	}
+	return 0
+}
+
+func (m *QueryDelegationFirstRewardHeightResponse) GetRewardStartEpoch() uint64 {
+	if m != nil {
+		return m.RewardStartEpoch
+	}
+	return 0
+}
+
+func (m *QueryDelegationFirstRewardHeightResponse) GetRewardLockupEpochs() uint64 {
+	if m != nil {
+		return m.RewardLockupEpochs
+	}
+	return 0
+}
+
+func (m *QueryDelegationFirstRewardHeightResponse) GetEarning() bool {
+	if m != nil {
+		return m.Earning
+	}
+	return false
+}
+
+func (m *QueryDelegationFirstRewardHeightResponse) GetAwaitingCovenantQuorum() bool {
+	if m != nil {
+		return m.AwaitingCovenantQuorum
+	}
+	return false
+}
+
+// QueryCovenantSignMsgRequest is the request type for the
+// Query/CovenantSignMsg RPC method.
+type QueryCovenantSignMsgRequest struct {
+	// staking_tx_hash_hex is the hex str of the staking tx hash of the BTC delegation
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+	// path is the covenant path to sign for
+	Path CovenantSpendPath `protobuf:"varint,2,opt,name=path,proto3,enum=babylon.btcstaking.v1.CovenantSpendPath" json:"path,omitempty"`
+	// covenant_pk_hex is the hex str of the BIP-340 PK of the covenant member
+	CovenantPkHex string `protobuf:"bytes,3,opt,name=covenant_pk_hex,json=covenantPkHex,proto3" json:"covenant_pk_hex,omitempty"`
+}
+
+func (m *QueryCovenantSignMsgRequest) Reset()         { *m = QueryCovenantSignMsgRequest{} }
+func (m *QueryCovenantSignMsgRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantSignMsgRequest) ProtoMessage()    {}
+func (*QueryCovenantSignMsgRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{82}
+}
+func (m *QueryCovenantSignMsgRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantSignMsgRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCovenantSignMsgRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCovenantSignMsgRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantSignMsgRequest.Merge(m, src)
+}
+func (m *QueryCovenantSignMsgRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCovenantSignMsgRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantSignMsgRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCovenantSignMsgRequest proto.InternalMessageInfo
+
+func (m *QueryCovenantSignMsgRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
+	}
+	return ""
+}
+
+func (m *QueryCovenantSignMsgRequest) GetPath() CovenantSpendPath {
+	if m != nil {
+		return m.Path
+	}
+	return CovenantSpendPath_UNBONDING
+}
+
+func (m *QueryCovenantSignMsgRequest) GetCovenantPkHex() string {
+	if m != nil {
+		return m.CovenantPkHex
+	}
+	return ""
+}
+
+// QueryCovenantSignMsgResponse is the response type for the
+// Query/CovenantSignMsg RPC method.
+type QueryCovenantSignMsgResponse struct {
+	// sig_hash is the BIP-341 tapscript sighash under SIGHASH_DEFAULT that the
+	// covenant member has to sign. For the slashing paths, it has to be signed
+	// as an adaptor signature encrypted by each key in enc_key_list
+	SigHash []byte `protobuf:"bytes,1,opt,name=sig_hash,json=sigHash,proto3" json:"sig_hash,omitempty"`
+	// signing_tx is the tx that spends the output via the path
+	SigningTx []byte `protobuf:"bytes,2,opt,name=signing_tx,json=signingTx,proto3" json:"signing_tx,omitempty"`
+	// script_path is the taproot script path being signed against
+	ScriptPath *TaprootScriptPath `protobuf:"bytes,3,opt,name=script_path,json=scriptPath,proto3" json:"script_path,omitempty"`
+	// leaf_hash is the tapleaf hash of the script in script_path, to which
+	// sig_hash commits
+	LeafHash []byte `protobuf:"bytes,4,opt,name=leaf_hash,json=leafHash,proto3" json:"leaf_hash,omitempty"`
+	// covenant_key_index is the position of the covenant member's PK among the
+	// sorted covenant PKs in the script of script_path
+	CovenantKeyIndex uint32 `protobuf:"varint,5,opt,name=covenant_key_index,json=covenantKeyIndex,proto3" json:"covenant_key_index,omitempty"`
+	// enc_key_list is the list of BIP-340 PKs of the finality providers that
+	// encrypt the adaptor signatures, in the order in which the adaptor
+	// signatures have to be submitted. It is empty for the unbonding path,
+	// which takes a Schnorr signature
+	EncKeyList []github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,6,rep,name=enc_key_list,json=encKeyList,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"enc_key_list,omitempty"`
+}
+
+func (m *QueryCovenantSignMsgResponse) Reset()         { *m = QueryCovenantSignMsgResponse{} }
+func (m *QueryCovenantSignMsgResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantSignMsgResponse) ProtoMessage()    {}
+func (*QueryCovenantSignMsgResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{83}
+}
+func (m *QueryCovenantSignMsgResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantSignMsgResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCovenantSignMsgResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCovenantSignMsgResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantSignMsgResponse.Merge(m, src)
+}
+func (m *QueryCovenantSignMsgResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCovenantSignMsgResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantSignMsgResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCovenantSignMsgResponse proto.InternalMessageInfo
+
+func (m *QueryCovenantSignMsgResponse) GetSigHash() []byte {
+	if m != nil {
+		return m.SigHash
+	}
+	return nil
+}
+
+func (m *QueryCovenantSignMsgResponse) GetSigningTx() []byte {
+	if m != nil {
+		return m.SigningTx
+	}
+	return nil
+}
+
+func (m *QueryCovenantSignMsgResponse) GetScriptPath() *TaprootScriptPath {
+	if m != nil {
+		return m.ScriptPath
+	}
+	return nil
+}
+
+func (m *QueryCovenantSignMsgResponse) GetLeafHash() []byte {
+	if m != nil {
+		return m.LeafHash
+	}
+	return nil
+}
+
+func (m *QueryCovenantSignMsgResponse) GetCovenantKeyIndex() uint32 {
+	if m != nil {
+		return m.CovenantKeyIndex
+	}
+	return 0
+}
+
+// QueryStakingInternalKeyRequest is the request type for the
+// Query/StakingInternalKey RPC method.
+type QueryStakingInternalKeyRequest struct {
+}
+
+func (m *QueryStakingInternalKeyRequest) Reset()         { *m = QueryStakingInternalKeyRequest{} }
+func (m *QueryStakingInternalKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStakingInternalKeyRequest) ProtoMessage()    {}
+func (*QueryStakingInternalKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{84}
+}
+func (m *QueryStakingInternalKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakingInternalKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakingInternalKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakingInternalKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakingInternalKeyRequest.Merge(m, src)
+}
+func (m *QueryStakingInternalKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakingInternalKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakingInternalKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakingInternalKeyRequest proto.InternalMessageInfo
+
+// QueryStakingInternalKeyResponse is the response type for the
+// Query/StakingInternalKey RPC method.
+type QueryStakingInternalKeyResponse struct {
+	// internal_key is the x-only taproot internal key of the outputs of BTC
+	// delegations. It is the fixed NUMS point H defined in BIP-341, i.e.,
+	// 50929b74c1a04954b78b4b6035e97a5e078a5a0f28ec96d547bfee9ace803ac0, whose
+	// discrete logarithm is unknown. It is not derived per delegation and is
+	// not tweaked with any randomness, so the taproot output key is the
+	// internal key tweaked with the merkle root of the script tree only, and
+	// the outputs can only be spent via one of the script paths
+	InternalKey []byte `protobuf:"bytes,1,opt,name=internal_key,json=internalKey,proto3" json:"internal_key,omitempty"`
+}
+
+func (m *QueryStakingInternalKeyResponse) Reset()         { *m = QueryStakingInternalKeyResponse{} }
+func (m *QueryStakingInternalKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakingInternalKeyResponse) ProtoMessage()    {}
+func (*QueryStakingInternalKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{85}
+}
+func (m *QueryStakingInternalKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakingInternalKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakingInternalKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakingInternalKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakingInternalKeyResponse.Merge(m, src)
+}
+func (m *QueryStakingInternalKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakingInternalKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakingInternalKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakingInternalKeyResponse proto.InternalMessageInfo
+
+func (m *QueryStakingInternalKeyResponse) GetInternalKey() []byte {
+	if m != nil {
+		return m.InternalKey
+	}
+	return nil
+}
+
+// QueryDelegationsSpendableViaRequest is the request type for the
+// Query/DelegationsSpendableVia RPC method.
+type QueryDelegationsSpendableViaRequest struct {
+	// path is the spend path of the staking output
+	Path StakingOutputSpendPath `protobuf:"varint,1,opt,name=path,proto3,enum=babylon.btcstaking.v1.StakingOutputSpendPath" json:"path,omitempty"`
+	// btc_tip_height is the BTC tip height against which the timelock and the
+	// slashing are evaluated. The current BTC tip of the BTC light client is
+	// used if zero
+	BtcTipHeight uint64 `protobuf:"varint,2,opt,name=btc_tip_height,json=btcTipHeight,proto3" json:"btc_tip_height,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegationsSpendableViaRequest) Reset()         { *m = QueryDelegationsSpendableViaRequest{} }
+func (m *QueryDelegationsSpendableViaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationsSpendableViaRequest) ProtoMessage()    {}
+func (*QueryDelegationsSpendableViaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{86}
+}
+func (m *QueryDelegationsSpendableViaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationsSpendableViaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationsSpendableViaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationsSpendableViaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationsSpendableViaRequest.Merge(m, src)
+}
+func (m *QueryDelegationsSpendableViaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationsSpendableViaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationsSpendableViaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationsSpendableViaRequest proto.InternalMessageInfo
+
+func (m *QueryDelegationsSpendableViaRequest) GetPath() StakingOutputSpendPath {
+	if m != nil {
+		return m.Path
+	}
+	return StakingOutputSpendPath_TIMELOCK_PATH
+}
+
+func (m *QueryDelegationsSpendableViaRequest) GetBtcTipHeight() uint64 {
+	if m != nil {
+		return m.BtcTipHeight
+	}
+	return 0
+}
+
+func (m *QueryDelegationsSpendableViaRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDelegationsSpendableViaResponse is the response type for the
+// Query/DelegationsSpendableVia RPC method.
+type QueryDelegationsSpendableViaResponse struct {
+	// btc_delegations contains the BTC delegations whose staking output can be
+	// spent via the given path
+	BtcDelegations []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=btc_delegations,json=btcDelegations,proto3" json:"btc_delegations,omitempty"`
+	// btc_tip_height is the BTC tip height used for evaluation
+	BtcTipHeight uint64 `protobuf:"varint,2,opt,name=btc_tip_height,json=btcTipHeight,proto3" json:"btc_tip_height,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegationsSpendableViaResponse) Reset()         { *m = QueryDelegationsSpendableViaResponse{} }
+func (m *QueryDelegationsSpendableViaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationsSpendableViaResponse) ProtoMessage()    {}
+func (*QueryDelegationsSpendableViaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{87}
+}
+func (m *QueryDelegationsSpendableViaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationsSpendableViaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationsSpendableViaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationsSpendableViaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationsSpendableViaResponse.Merge(m, src)
+}
+func (m *QueryDelegationsSpendableViaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationsSpendableViaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationsSpendableViaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationsSpendableViaResponse proto.InternalMessageInfo
+
+func (m *QueryDelegationsSpendableViaResponse) GetBtcDelegations() []*BTCDelegationResponse {
+	if m != nil {
+		return m.BtcDelegations
+	}
+	return nil
+}
+
+func (m *QueryDelegationsSpendableViaResponse) GetBtcTipHeight() uint64 {
+	if m != nil {
+		return m.BtcTipHeight
+	}
+	return 0
+}
+
+func (m *QueryDelegationsSpendableViaResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCovenantSigNeededRequest is the request type for the
+// Query/CovenantSigNeeded RPC method.
+type QueryCovenantSigNeededRequest struct {
+	// staking_tx_hash_hex is the hex str of the staking tx hash of the BTC delegation
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+	// covenant_pk_hex is the hex str of the BIP-340 PK of the covenant member
+	CovenantPkHex string `protobuf:"bytes,2,opt,name=covenant_pk_hex,json=covenantPkHex,proto3" json:"covenant_pk_hex,omitempty"`
+}
+
+func (m *QueryCovenantSigNeededRequest) Reset()         { *m = QueryCovenantSigNeededRequest{} }
+func (m *QueryCovenantSigNeededRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantSigNeededRequest) ProtoMessage()    {}
+func (*QueryCovenantSigNeededRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{88}
+}
+func (m *QueryCovenantSigNeededRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantSigNeededRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCovenantSigNeededRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCovenantSigNeededRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantSigNeededRequest.Merge(m, src)
+}
+func (m *QueryCovenantSigNeededRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCovenantSigNeededRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantSigNeededRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCovenantSigNeededRequest proto.InternalMessageInfo
+
+func (m *QueryCovenantSigNeededRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
+	}
+	return ""
+}
+
+func (m *QueryCovenantSigNeededRequest) GetCovenantPkHex() string {
+	if m != nil {
+		return m.CovenantPkHex
+	}
+	return ""
+}
+
+// QueryCovenantSigNeededResponse is the response type for the
+// Query/CovenantSigNeeded RPC method.
+type QueryCovenantSigNeededResponse struct {
+	// needed is whether MsgAddCovenantSigs of the covenant member would still
+	// add signatures to the BTC delegation
+	Needed bool `protobuf:"varint,1,opt,name=needed,proto3" json:"needed,omitempty"`
+	// missing_paths is the list of covenant paths that still miss the
+	// signatures of the covenant member. It is empty if needed is false
+	MissingPaths []CovenantSpendPath `protobuf:"varint,2,rep,packed,name=missing_paths,json=missingPaths,proto3,enum=babylon.btcstaking.v1.CovenantSpendPath" json:"missing_paths,omitempty"`
+}
+
+func (m *QueryCovenantSigNeededResponse) Reset()         { *m = QueryCovenantSigNeededResponse{} }
+func (m *QueryCovenantSigNeededResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantSigNeededResponse) ProtoMessage()    {}
+func (*QueryCovenantSigNeededResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{89}
+}
+func (m *QueryCovenantSigNeededResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantSigNeededResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCovenantSigNeededResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCovenantSigNeededResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantSigNeededResponse.Merge(m, src)
+}
+func (m *QueryCovenantSigNeededResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCovenantSigNeededResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantSigNeededResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCovenantSigNeededResponse proto.InternalMessageInfo
+
+func (m *QueryCovenantSigNeededResponse) GetNeeded() bool {
+	if m != nil {
+		return m.Needed
+	}
+	return false
+}
+
+func (m *QueryCovenantSigNeededResponse) GetMissingPaths() []CovenantSpendPath {
+	if m != nil {
+		return m.MissingPaths
+	}
+	return nil
+}
+
+// QueryDelegationParamsRequest is the request type for the
+// Query/DelegationParams RPC method.
+type QueryDelegationParamsRequest struct {
+	// staking_tx_hash_hex is the hex str of the staking tx hash of the BTC delegation
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+}
+
+func (m *QueryDelegationParamsRequest) Reset()         { *m = QueryDelegationParamsRequest{} }
+func (m *QueryDelegationParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationParamsRequest) ProtoMessage()    {}
+func (*QueryDelegationParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{90}
+}
+func (m *QueryDelegationParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationParamsRequest.Merge(m, src)
+}
+func (m *QueryDelegationParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationParamsRequest proto.InternalMessageInfo
+
+func (m *QueryDelegationParamsRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
+	}
+	return ""
+}
+
+// QueryDelegationParamsResponse is the response type for the
+// Query/DelegationParams RPC method.
+type QueryDelegationParamsResponse struct {
+	// params_version is the version of the parameters that the BTC delegation
+	// was validated against
+	ParamsVersion uint32 `protobuf:"varint,1,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// params are the parameters of the given version, including the covenant
+	// committee, the covenant quorum, the slashing rate and the slashing
+	// address of the BTC delegation
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// btc_confirmation_depth is the BTC confirmation depth (k) of the BTC
+	// delegation. It is the current one if the BTC delegation was created
+	// before k was snapshotted
+	BtcConfirmationDepth uint64 `protobuf:"varint,3,opt,name=btc_confirmation_depth,json=btcConfirmationDepth,proto3" json:"btc_confirmation_depth,omitempty"`
+	// checkpoint_finalization_timeout is the checkpoint finalization timeout
+	// (w) of the BTC delegation. It is the current one if the BTC delegation
+	// was created before w was snapshotted
+	CheckpointFinalizationTimeout uint64 `protobuf:"varint,4,opt,name=checkpoint_finalization_timeout,json=checkpointFinalizationTimeout,proto3" json:"checkpoint_finalization_timeout,omitempty"`
+}
+
+func (m *QueryDelegationParamsResponse) Reset()         { *m = QueryDelegationParamsResponse{} }
+func (m *QueryDelegationParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationParamsResponse) ProtoMessage()    {}
+func (*QueryDelegationParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{91}
+}
+func (m *QueryDelegationParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationParamsResponse.Merge(m, src)
+}
+func (m *QueryDelegationParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationParamsResponse proto.InternalMessageInfo
+
+func (m *QueryDelegationParamsResponse) GetParamsVersion() uint32 {
+	if m != nil {
+		return m.ParamsVersion
+	}
+	return 0
+}
+
+func (m *QueryDelegationParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *QueryDelegationParamsResponse) GetBtcConfirmationDepth() uint64 {
+	if m != nil {
+		return m.BtcConfirmationDepth
+	}
+	return 0
+}
+
+func (m *QueryDelegationParamsResponse) GetCheckpointFinalizationTimeout() uint64 {
+	if m != nil {
+		return m.CheckpointFinalizationTimeout
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("babylon.btcstaking.v1.CovenantSpendPath", CovenantSpendPath_name, CovenantSpendPath_value)
+	proto.RegisterEnum("babylon.btcstaking.v1.StakingOutputSpendPath", StakingOutputSpendPath_name, StakingOutputSpendPath_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.btcstaking.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.btcstaking.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryParamsByVersionRequest)(nil), "babylon.btcstaking.v1.QueryParamsByVersionRequest")
+	proto.RegisterType((*QueryParamsByVersionResponse)(nil), "babylon.btcstaking.v1.QueryParamsByVersionResponse")
+	proto.RegisterType((*QueryCovenantCommitteeRequest)(nil), "babylon.btcstaking.v1.QueryCovenantCommitteeRequest")
+	proto.RegisterType((*QueryCovenantCommitteeResponse)(nil), "babylon.btcstaking.v1.QueryCovenantCommitteeResponse")
+	proto.RegisterType((*CovenantPkResponse)(nil), "babylon.btcstaking.v1.CovenantPkResponse")
+	proto.RegisterType((*QueryFinalityProvidersRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProvidersRequest")
+	proto.RegisterType((*QueryFinalityProvidersResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProvidersResponse")
+	proto.RegisterType((*QueryFinalityProviderRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderRequest")
+	proto.RegisterType((*QueryFinalityProviderResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderResponse")
+	proto.RegisterType((*QueryBTCDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsRequest")
+	proto.RegisterType((*QueryBTCDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsResponse")
+	proto.RegisterType((*QueryFinalityProviderPowerAtHeightRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderPowerAtHeightRequest")
+	proto.RegisterType((*QueryFinalityProviderPowerAtHeightResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderPowerAtHeightResponse")
+	proto.RegisterType((*QueryFinalityProviderCurrentPowerRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderCurrentPowerRequest")
+	proto.RegisterType((*QueryFinalityProviderCurrentPowerResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderCurrentPowerResponse")
+	proto.RegisterType((*QueryActiveFinalityProvidersAtHeightRequest)(nil), "babylon.btcstaking.v1.QueryActiveFinalityProvidersAtHeightRequest")
+	proto.RegisterType((*QueryActiveFinalityProvidersAtHeightResponse)(nil), "babylon.btcstaking.v1.QueryActiveFinalityProvidersAtHeightResponse")
+	proto.RegisterType((*QueryActivatedHeightRequest)(nil), "babylon.btcstaking.v1.QueryActivatedHeightRequest")
+	proto.RegisterType((*QueryActivatedHeightResponse)(nil), "babylon.btcstaking.v1.QueryActivatedHeightResponse")
+	proto.RegisterType((*QueryFinalityProviderDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationsRequest")
+	proto.RegisterType((*QueryFinalityProviderDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationsResponse")
+	proto.RegisterType((*QueryBTCDelegationRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationRequest")
+	proto.RegisterType((*QueryBTCDelegationResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationResponse")
+	proto.RegisterType((*QueryCovenantSignedDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryCovenantSignedDelegationsRequest")
+	proto.RegisterType((*QueryCovenantSignedDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryCovenantSignedDelegationsResponse")
+	proto.RegisterType((*QueryRewardEligibleDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryRewardEligibleDelegationsRequest")
+	proto.RegisterType((*QueryRewardEligibleDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryRewardEligibleDelegationsResponse")
+	proto.RegisterType((*BTCDelegationResponse)(nil), "babylon.btcstaking.v1.BTCDelegationResponse")
+	proto.RegisterType((*BTCUndelegationResponse)(nil), "babylon.btcstaking.v1.BTCUndelegationResponse")
+	proto.RegisterType((*BTCDelegatorDelegationsResponse)(nil), "babylon.btcstaking.v1.BTCDelegatorDelegationsResponse")
+	proto.RegisterType((*QuerySimulateBTCUndelegationRequest)(nil), "babylon.btcstaking.v1.QuerySimulateBTCUndelegationRequest")
+	proto.RegisterType((*QuerySimulateBTCUndelegationResponse)(nil), "babylon.btcstaking.v1.QuerySimulateBTCUndelegationResponse")
+	proto.RegisterType((*QueryStaleCovenantPendingDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryStaleCovenantPendingDelegationsRequest")
+	proto.RegisterType((*QueryStaleCovenantPendingDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryStaleCovenantPendingDelegationsResponse")
+	proto.RegisterType((*QuerySlashingAmountRequest)(nil), "babylon.btcstaking.v1.QuerySlashingAmountRequest")
+	proto.RegisterType((*QuerySlashingAmountResponse)(nil), "babylon.btcstaking.v1.QuerySlashingAmountResponse")
+	proto.RegisterType((*QuerySimulateVotingPowerRequest)(nil), "babylon.btcstaking.v1.QuerySimulateVotingPowerRequest")
+	proto.RegisterType((*QuerySimulateVotingPowerResponse)(nil), "babylon.btcstaking.v1.QuerySimulateVotingPowerResponse")
+	proto.RegisterType((*QueryBTCDelegationsByInclusionHeightRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsByInclusionHeightRequest")
+	proto.RegisterType((*QueryBTCDelegationsByInclusionHeightResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsByInclusionHeightResponse")
+	proto.RegisterType((*QueryCovenantQuorumHealthRequest)(nil), "babylon.btcstaking.v1.QueryCovenantQuorumHealthRequest")
+	proto.RegisterType((*QueryCovenantQuorumHealthResponse)(nil), "babylon.btcstaking.v1.QueryCovenantQuorumHealthResponse")
+	proto.RegisterType((*CovenantQuorumHealthBucket)(nil), "babylon.btcstaking.v1.CovenantQuorumHealthBucket")
+	proto.RegisterType((*FinalityProviderResponse)(nil), "babylon.btcstaking.v1.FinalityProviderResponse")
+	proto.RegisterType((*QueryDelegationsWithStaleParamsRequest)(nil), "babylon.btcstaking.v1.QueryDelegationsWithStaleParamsRequest")
+	proto.RegisterType((*QueryDelegationsWithStaleParamsResponse)(nil), "babylon.btcstaking.v1.QueryDelegationsWithStaleParamsResponse")
+	proto.RegisterType((*QueryFinalityProviderPoPRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderPoPRequest")
+	proto.RegisterType((*QueryFinalityProviderPoPResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderPoPResponse")
+	proto.RegisterType((*QueryBTCDelegationPoPRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationPoPRequest")
+	proto.RegisterType((*QueryBTCDelegationPoPResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationPoPResponse")
+	proto.RegisterType((*QueryFinalityProviderPowerAfterUndelegationRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderPowerAfterUndelegationRequest")
+	proto.RegisterType((*QueryFinalityProviderPowerAfterUndelegationResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderPowerAfterUndelegationResponse")
+	proto.RegisterType((*QueryTotalVotingPowerAtHeightRequest)(nil), "babylon.btcstaking.v1.QueryTotalVotingPowerAtHeightRequest")
+	proto.RegisterType((*QueryTotalVotingPowerAtHeightResponse)(nil), "babylon.btcstaking.v1.QueryTotalVotingPowerAtHeightResponse")
+	proto.RegisterType((*QueryBTCDelegationScriptsRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationScriptsRequest")
+	proto.RegisterType((*TaprootScriptPath)(nil), "babylon.btcstaking.v1.TaprootScriptPath")
+	proto.RegisterType((*QueryBTCDelegationScriptsResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationScriptsResponse")
+	proto.RegisterType((*QueryVerifyDelegatorSlashingSigRequest)(nil), "babylon.btcstaking.v1.QueryVerifyDelegatorSlashingSigRequest")
+	proto.RegisterType((*QueryVerifyDelegatorSlashingSigResponse)(nil), "babylon.btcstaking.v1.QueryVerifyDelegatorSlashingSigResponse")
+	proto.RegisterType((*QueryReverifyInclusionProofRequest)(nil), "babylon.btcstaking.v1.QueryReverifyInclusionProofRequest")
+	proto.RegisterType((*QueryReverifyInclusionProofResponse)(nil), "babylon.btcstaking.v1.QueryReverifyInclusionProofResponse")
+	proto.RegisterType((*QueryCovenantSigningBatchRequest)(nil), "babylon.btcstaking.v1.QueryCovenantSigningBatchRequest")
+	proto.RegisterType((*QueryCovenantSigningBatchResponse)(nil), "babylon.btcstaking.v1.QueryCovenantSigningBatchResponse")
+	proto.RegisterType((*CovenantSigningInfo)(nil), "babylon.btcstaking.v1.CovenantSigningInfo")
+	proto.RegisterType((*QueryUnbondingCovenantProgressRequest)(nil), "babylon.btcstaking.v1.QueryUnbondingCovenantProgressRequest")
+	proto.RegisterType((*QueryUnbondingCovenantProgressResponse)(nil), "babylon.btcstaking.v1.QueryUnbondingCovenantProgressResponse")
+	proto.RegisterType((*QueryRecommendedSlashingFeeRateRequest)(nil), "babylon.btcstaking.v1.QueryRecommendedSlashingFeeRateRequest")
+	proto.RegisterType((*QueryRecommendedSlashingFeeRateResponse)(nil), "babylon.btcstaking.v1.QueryRecommendedSlashingFeeRateResponse")
+	proto.RegisterType((*QueryDelegationSpendTreeRequest)(nil), "babylon.btcstaking.v1.QueryDelegationSpendTreeRequest")
+	proto.RegisterType((*TaprootTreeLeaf)(nil), "babylon.btcstaking.v1.TaprootTreeLeaf")
+	proto.RegisterType((*QueryDelegationSpendTreeResponse)(nil), "babylon.btcstaking.v1.QueryDelegationSpendTreeResponse")
+	proto.RegisterType((*QueryVotingPowerTableDiscrepanciesRequest)(nil), "babylon.btcstaking.v1.QueryVotingPowerTableDiscrepanciesRequest")
+	proto.RegisterType((*VotingPowerDiscrepancy)(nil), "babylon.btcstaking.v1.VotingPowerDiscrepancy")
+	proto.RegisterType((*QueryVotingPowerTableDiscrepanciesResponse)(nil), "babylon.btcstaking.v1.QueryVotingPowerTableDiscrepanciesResponse")
+	proto.RegisterType((*QueryFinalityProviderSlashingImpactRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderSlashingImpactRequest")
+	proto.RegisterType((*QueryFinalityProviderSlashingImpactResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderSlashingImpactResponse")
+	proto.RegisterType((*QueryVerifyCovenantQuorumSpendRequest)(nil), "babylon.btcstaking.v1.QueryVerifyCovenantQuorumSpendRequest")
+	proto.RegisterType((*QueryVerifyCovenantQuorumSpendResponse)(nil), "babylon.btcstaking.v1.QueryVerifyCovenantQuorumSpendResponse")
+	proto.RegisterType((*QueryDelegationFirstRewardHeightRequest)(nil), "babylon.btcstaking.v1.QueryDelegationFirstRewardHeightRequest")
+	proto.RegisterType((*QueryDelegationFirstRewardHeightResponse)(nil), "babylon.btcstaking.v1.QueryDelegationFirstRewardHeightResponse")
+	proto.RegisterType((*QueryCovenantSignMsgRequest)(nil), "babylon.btcstaking.v1.QueryCovenantSignMsgRequest")
+	proto.RegisterType((*QueryCovenantSignMsgResponse)(nil), "babylon.btcstaking.v1.QueryCovenantSignMsgResponse")
+	proto.RegisterType((*QueryStakingInternalKeyRequest)(nil), "babylon.btcstaking.v1.QueryStakingInternalKeyRequest")
+	proto.RegisterType((*QueryStakingInternalKeyResponse)(nil), "babylon.btcstaking.v1.QueryStakingInternalKeyResponse")
+	proto.RegisterType((*QueryDelegationsSpendableViaRequest)(nil), "babylon.btcstaking.v1.QueryDelegationsSpendableViaRequest")
+	proto.RegisterType((*QueryDelegationsSpendableViaResponse)(nil), "babylon.btcstaking.v1.QueryDelegationsSpendableViaResponse")
+	proto.RegisterType((*QueryCovenantSigNeededRequest)(nil), "babylon.btcstaking.v1.QueryCovenantSigNeededRequest")
+	proto.RegisterType((*QueryCovenantSigNeededResponse)(nil), "babylon.btcstaking.v1.QueryCovenantSigNeededResponse")
+	proto.RegisterType((*QueryDelegationParamsRequest)(nil), "babylon.btcstaking.v1.QueryDelegationParamsRequest")
+	proto.RegisterType((*QueryDelegationParamsResponse)(nil), "babylon.btcstaking.v1.QueryDelegationParamsResponse")
+}
+
+func init() { proto.RegisterFile("babylon/btcstaking/v1/query.proto", fileDescriptor_74d49d26f7429697) }
+
+var fileDescriptor_74d49d26f7429697 = []byte{
+	// 5588 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3d, 0x5b, 0x6c, 0x1c, 0x59,
+	0x56, 0xa9, 0xb6, 0xe3, 0xd8, 0xc7, 0x8f, 0xd8, 0xd7, 0x8f, 0xb4, 0x2b, 0x71, 0x9c, 0xd4, 0x64,
+	0x92, 0x4c, 0x26, 0x71, 0x4f, 0x1c, 0x27, 0x99, 0x49, 0x26, 0xc9, 0xd8, 0x4e, 0x32, 0xf1, 0x38,
+	0x0f, 0x4f, 0xb7, 0xe3, 0x19, 0x66, 0x66, 0xb7, 0xb6, 0xba, 0xfa, 0x76, 0x77, 0xad, 0xdd, 0x55,
+	0x35, 0x55, 0xd5, 0x1e, 0x9b, 0x28, 0x12, 0xac, 0xb4, 0x0b, 0x12, 0x42, 0x42, 0xcc, 0xfe, 0xc0,
+	0x07, 0x7c, 0xf0, 0xb1, 0x48, 0xc0, 0x07, 0xb0, 0x1f, 0x08, 0x01, 0x82, 0x2f, 0x06, 0xa4, 0x45,
+	0xbb, 0x8b, 0x96, 0x81, 0x41, 0x8c, 0xd0, 0x0c, 0xb0, 0xd2, 0x6a, 0x97, 0x4f, 0x40, 0xcb, 0xc7,
+	0xa2, 0xfb, 0xa8, 0x57, 0x77, 0x55, 0x75, 0x57, 0x77, 0x47, 0xab, 0xe5, 0xcf, 0x75, 0xef, 0x3d,
+	0xe7, 0x9e, 0x73, 0xef, 0xb9, 0xe7, 0x9e, 0xd7, 0x6d, 0xc3, 0xc9, 0xa2, 0x52, 0xdc, 0xdf, 0x31,
+	0xf4, 0x5c, 0xd1, 0x51, 0x6d, 0x47, 0xd9, 0xd6, 0xf4, 0x4a, 0x6e, 0xf7, 0x62, 0xee, 0xfd, 0x3a,
+	0xb6, 0xf6, 0x17, 0x4c, 0xcb, 0x70, 0x0c, 0x34, 0xcd, 0x87, 0x2c, 0xf8, 0x43, 0x16, 0x76, 0x2f,
+	0x8a, 0x53, 0x15, 0xa3, 0x62, 0xd0, 0x11, 0x39, 0xf2, 0x17, 0x1b, 0x2c, 0x1e, 0xab, 0x18, 0x46,
+	0x65, 0x07, 0xe7, 0x14, 0x53, 0xcb, 0x29, 0xba, 0x6e, 0x38, 0x8a, 0xa3, 0x19, 0xba, 0xcd, 0x7b,
+	0x67, 0x55, 0xc3, 0xae, 0x19, 0xb6, 0xcc, 0xc0, 0xd8, 0x07, 0xef, 0x92, 0xd8, 0x57, 0x4e, 0xb5,
+	0xf6, 0x4d, 0xc7, 0xc8, 0xd9, 0x58, 0x35, 0x17, 0x2f, 0x5f, 0xd9, 0xbe, 0x98, 0xdb, 0xc6, 0xfb,
+	0xee, 0x98, 0x53, 0x7c, 0x8c, 0x4f, 0x68, 0x11, 0x3b, 0xca, 0x45, 0xf7, 0x9b, 0x8f, 0x3a, 0xc7,
+	0x47, 0x15, 0x15, 0x1b, 0x33, 0x46, 0xbc, 0x81, 0xa6, 0x52, 0xd1, 0x74, 0x4a, 0x91, 0x3b, 0x6b,
+	0x34, 0xfb, 0xa6, 0x62, 0x29, 0x35, 0x77, 0xd6, 0xd3, 0xd1, 0x63, 0xfc, 0x2f, 0x3e, 0x6e, 0x3e,
+	0x06, 0x97, 0x61, 0xb2, 0x01, 0xd2, 0x14, 0xa0, 0x37, 0x09, 0x39, 0x1b, 0x14, 0x7b, 0x1e, 0xbf,
+	0x5f, 0xc7, 0xb6, 0x23, 0xe5, 0x61, 0x32, 0xd4, 0x6a, 0x9b, 0x86, 0x6e, 0x63, 0x74, 0x1d, 0x06,
+	0x18, 0x15, 0x59, 0xe1, 0x84, 0x70, 0x76, 0x78, 0x71, 0x6e, 0x21, 0x72, 0x1b, 0x16, 0x18, 0xd8,
+	0x4a, 0xff, 0x47, 0x9f, 0xce, 0x1f, 0xc8, 0x73, 0x10, 0xe9, 0x2a, 0x1c, 0x0d, 0xe0, 0x5c, 0xd9,
+	0xdf, 0xc2, 0x96, 0xad, 0x19, 0x3a, 0x9f, 0x12, 0x65, 0xe1, 0xd0, 0x2e, 0x6b, 0xa1, 0xc8, 0x47,
+	0xf3, 0xee, 0xa7, 0xf4, 0x2e, 0x1c, 0x8b, 0x06, 0xec, 0x05, 0x55, 0xf3, 0x30, 0x47, 0x91, 0xaf,
+	0x1a, 0xbb, 0x58, 0x57, 0x74, 0x67, 0xd5, 0xa8, 0xd5, 0x34, 0xc7, 0xc1, 0xd8, 0x5d, 0x8a, 0xbf,
+	0x10, 0xe0, 0x78, 0xdc, 0x08, 0x4e, 0xc0, 0x7d, 0x18, 0x51, 0x79, 0xa7, 0x6c, 0x6e, 0x13, 0x32,
+	0xfa, 0xce, 0x0e, 0x2f, 0xbe, 0x10, 0x43, 0x86, 0x8b, 0x67, 0x63, 0xdb, 0x45, 0x90, 0x1f, 0x56,
+	0xbd, 0x36, 0x1b, 0x9d, 0x81, 0xc3, 0x1e, 0xb6, 0xf7, 0xeb, 0x86, 0x55, 0xaf, 0x65, 0x33, 0x74,
+	0x41, 0xc6, 0xdc, 0xe6, 0x37, 0x69, 0x2b, 0x7a, 0x1e, 0xc6, 0x18, 0x13, 0xb2, 0xbb, 0x70, 0x7d,
+	0x74, 0xdc, 0x28, 0x6b, 0xe5, 0xcb, 0x24, 0x95, 0x00, 0x35, 0x4f, 0x89, 0x24, 0x18, 0x2d, 0x6a,
+	0xe6, 0xa5, 0xa5, 0x97, 0x64, 0x73, 0x5b, 0xae, 0xe2, 0x3d, 0xba, 0x76, 0x43, 0xf9, 0x61, 0xd6,
+	0xb8, 0xb1, 0x7d, 0x0f, 0xef, 0xa1, 0x73, 0x30, 0xa1, 0x1a, 0x35, 0xd3, 0xc2, 0xb6, 0x8d, 0x4b,
+	0xee, 0xb8, 0x0c, 0x1d, 0x77, 0xd8, 0xef, 0xa0, 0x63, 0xa5, 0x0a, 0x5f, 0xc7, 0xbb, 0x9a, 0xae,
+	0xec, 0x68, 0xce, 0xfe, 0x86, 0x65, 0xec, 0x6a, 0x25, 0x6c, 0xb9, 0x22, 0x85, 0xee, 0x02, 0xf8,
+	0x92, 0xce, 0x77, 0xea, 0xf4, 0x02, 0x3f, 0x6e, 0xe4, 0x58, 0x2c, 0xb0, 0xf3, 0xcd, 0x8f, 0xc5,
+	0xc2, 0x86, 0x52, 0x71, 0xf7, 0x20, 0x1f, 0x80, 0x94, 0xfe, 0xc6, 0xdd, 0x8f, 0x88, 0x99, 0x38,
+	0x6f, 0x5f, 0x04, 0x54, 0xe6, 0x9d, 0xb2, 0xe9, 0xf6, 0xf2, 0x5d, 0xc9, 0xc5, 0xec, 0x4a, 0x23,
+	0x36, 0x6f, 0x6f, 0x26, 0xca, 0x8d, 0xf3, 0xa0, 0xd7, 0x43, 0xac, 0x64, 0x28, 0x2b, 0x67, 0x5a,
+	0xb2, 0xc2, 0xf1, 0x05, 0x79, 0x59, 0xe6, 0x92, 0xdd, 0x3c, 0x39, 0x5b, 0xb3, 0x93, 0x30, 0x5a,
+	0x36, 0xe5, 0xa2, 0xa3, 0x86, 0x37, 0x09, 0xca, 0xe6, 0x8a, 0xa3, 0xb2, 0x75, 0x7f, 0x1a, 0xb3,
+	0xee, 0xde, 0x62, 0xbc, 0x07, 0x13, 0x4d, 0x8b, 0xc1, 0x97, 0x3f, 0xf5, 0x5a, 0x8c, 0x37, 0xae,
+	0x85, 0xf4, 0xbb, 0x02, 0x88, 0x74, 0xfe, 0x95, 0xcd, 0xd5, 0xdb, 0x78, 0x07, 0x57, 0x98, 0x6a,
+	0x75, 0x19, 0x58, 0x81, 0x01, 0xdb, 0x51, 0x9c, 0x3a, 0x3b, 0x9a, 0x63, 0x8b, 0xe7, 0x62, 0x66,
+	0x0c, 0x41, 0x17, 0x28, 0x44, 0x9e, 0x43, 0xa2, 0xbb, 0x11, 0xab, 0xdd, 0x89, 0xe0, 0xfc, 0xb9,
+	0xc0, 0x15, 0x50, 0x23, 0xa9, 0x7c, 0xa1, 0x1e, 0xc3, 0x61, 0xb2, 0xd2, 0x25, 0xbf, 0x8b, 0x8b,
+	0xcc, 0xf9, 0x76, 0x88, 0xf6, 0xd6, 0x68, 0xac, 0xe8, 0xa8, 0x01, 0xf4, 0xbd, 0x13, 0x96, 0x32,
+	0xbc, 0x10, 0xb9, 0xd3, 0x1b, 0xc6, 0x07, 0xd8, 0x5a, 0x76, 0xee, 0x61, 0xad, 0x52, 0x75, 0xda,
+	0x97, 0x1c, 0x34, 0x03, 0x03, 0x55, 0x0a, 0x43, 0x89, 0xea, 0xcf, 0xf3, 0x2f, 0xe9, 0x11, 0x9c,
+	0x6b, 0x67, 0x1e, 0xbe, 0x6a, 0x27, 0x61, 0x64, 0xd7, 0x70, 0x34, 0xbd, 0x22, 0x9b, 0xa4, 0x9f,
+	0xce, 0xd3, 0x9f, 0x1f, 0x66, 0x6d, 0x14, 0x44, 0x7a, 0x00, 0x67, 0x23, 0x11, 0xae, 0xd6, 0x2d,
+	0x0b, 0xeb, 0x0e, 0x1d, 0x94, 0x42, 0xe2, 0xe3, 0xd6, 0x21, 0x8c, 0x8e, 0x93, 0xe7, 0x33, 0x29,
+	0x04, 0x99, 0x6c, 0x22, 0x3b, 0xd3, 0x4c, 0xf6, 0xaf, 0x0a, 0xf0, 0x22, 0x9d, 0x68, 0x59, 0x75,
+	0xb4, 0x5d, 0xdc, 0x38, 0x9d, 0xdd, 0xb8, 0xe4, 0x71, 0x53, 0xf5, 0x4a, 0x7e, 0x3f, 0x16, 0xe0,
+	0x7c, 0x7b, 0xf4, 0xf4, 0x50, 0x0d, 0xbe, 0xa5, 0x39, 0xd5, 0x07, 0xd8, 0x51, 0x9e, 0xa9, 0x1a,
+	0x9c, 0x83, 0xa3, 0x3e, 0x63, 0x8a, 0x83, 0x4b, 0xa1, 0x85, 0x95, 0xae, 0xc0, 0xb1, 0xe8, 0xee,
+	0xe4, 0x3d, 0x96, 0xbe, 0x2e, 0xc0, 0x99, 0x48, 0x49, 0x89, 0x50, 0x54, 0x6d, 0x9c, 0x97, 0x5e,
+	0xed, 0xe3, 0xf7, 0x05, 0x38, 0xdb, 0x9a, 0x2c, 0xce, 0x9b, 0x05, 0xb3, 0x01, 0xa5, 0x64, 0x58,
+	0x11, 0xea, 0xe9, 0x4a, 0x4b, 0xf5, 0x64, 0x44, 0xa1, 0xce, 0x1f, 0xf1, 0x15, 0x55, 0x68, 0x40,
+	0xef, 0xf6, 0xf5, 0x0d, 0x98, 0x6d, 0x56, 0xb8, 0xee, 0x8a, 0x5f, 0x80, 0x49, 0x4e, 0xac, 0xec,
+	0xec, 0xc9, 0x55, 0xc5, 0xae, 0x06, 0xd6, 0x7d, 0x9c, 0x77, 0x6d, 0xee, 0xdd, 0x53, 0xec, 0x2a,
+	0x39, 0xf5, 0xef, 0x47, 0xdd, 0x33, 0xde, 0x32, 0x15, 0x60, 0x2c, 0xac, 0xbb, 0xf9, 0x0d, 0x97,
+	0x4e, 0x75, 0x8f, 0x86, 0x54, 0x37, 0x51, 0x00, 0xcf, 0x87, 0x2c, 0xbf, 0x82, 0x56, 0xd1, 0x71,
+	0x29, 0x42, 0x7a, 0x8e, 0x01, 0xa8, 0xc6, 0x6e, 0x58, 0x74, 0x06, 0x55, 0x63, 0xb7, 0xb7, 0x82,
+	0xf3, 0x91, 0x00, 0xa7, 0x5b, 0xd1, 0xf3, 0x33, 0x72, 0x97, 0xfd, 0xba, 0xbb, 0xb4, 0x79, 0xfc,
+	0x81, 0x62, 0x95, 0xee, 0xec, 0x68, 0x15, 0xad, 0xb8, 0x83, 0x7f, 0xba, 0x07, 0xf3, 0xb7, 0xfa,
+	0xe1, 0x74, 0x2b, 0xa2, 0xf8, 0xfa, 0xca, 0x30, 0x85, 0x79, 0x77, 0xd7, 0x8b, 0x3c, 0x89, 0x9b,
+	0x27, 0x42, 0x5f, 0x80, 0x49, 0x13, 0xeb, 0x25, 0x72, 0x3a, 0x82, 0xf8, 0x33, 0x1d, 0xe0, 0x47,
+	0x1c, 0x51, 0x10, 0xfd, 0x39, 0x98, 0x28, 0x69, 0xb6, 0x23, 0xab, 0x8a, 0x5a, 0xc5, 0x32, 0xd7,
+	0x9e, 0x7d, 0x54, 0x7b, 0x1e, 0x26, 0x1d, 0xab, 0xa4, 0x9d, 0xa9, 0x59, 0x74, 0x8a, 0x9d, 0x2d,
+	0x47, 0x33, 0xdd, 0x81, 0xfd, 0x74, 0xe0, 0x48, 0xd1, 0x51, 0x37, 0x35, 0x93, 0x8f, 0x5a, 0x82,
+	0x19, 0x32, 0x4a, 0x35, 0xf4, 0xb2, 0x66, 0xd5, 0xe8, 0x34, 0x72, 0x09, 0x9b, 0x4e, 0x35, 0x7b,
+	0x90, 0x8e, 0x9e, 0x2a, 0x3a, 0xea, 0x6a, 0xa0, 0xf3, 0x36, 0xe9, 0x43, 0x77, 0x61, 0x5e, 0xad,
+	0x62, 0x75, 0xdb, 0x34, 0x34, 0xdd, 0x91, 0xd9, 0x15, 0xf3, 0xf3, 0x0c, 0xd8, 0xd1, 0x6a, 0xd8,
+	0xa8, 0x3b, 0xd9, 0x01, 0x0a, 0x3e, 0xe7, 0x0f, 0xbb, 0x1b, 0x18, 0xb5, 0xc9, 0x06, 0xa1, 0xa3,
+	0x30, 0x54, 0x36, 0x65, 0x85, 0x5e, 0x8c, 0xd9, 0x43, 0x27, 0x84, 0xb3, 0x83, 0xf9, 0xc1, 0xb2,
+	0xc9, 0x2e, 0xca, 0x06, 0xa9, 0x1d, 0xec, 0x5c, 0x6a, 0xff, 0x6a, 0x18, 0xa6, 0xa3, 0xf5, 0xcf,
+	0x03, 0x18, 0x60, 0x22, 0x4a, 0xc5, 0x73, 0x64, 0xe5, 0xca, 0x27, 0x9f, 0xce, 0x2f, 0x56, 0x34,
+	0xa7, 0x5a, 0x2f, 0x2e, 0xa8, 0x46, 0x2d, 0xc7, 0xf7, 0x4b, 0xad, 0x2a, 0x9a, 0xee, 0x7e, 0xe4,
+	0x9c, 0x7d, 0x13, 0xdb, 0x0b, 0x2b, 0x6b, 0x1b, 0xc4, 0xe1, 0xaa, 0x17, 0xd7, 0xf1, 0x7e, 0xfe,
+	0x60, 0x91, 0x08, 0x35, 0x7a, 0x17, 0xc6, 0x7c, 0xa1, 0xdf, 0xd1, 0x6c, 0x87, 0x6e, 0x7c, 0xe7,
+	0x68, 0x87, 0xf9, 0x69, 0xb9, 0xaf, 0xd1, 0x13, 0x35, 0x62, 0x3b, 0x8a, 0xe5, 0x84, 0xb7, 0x7d,
+	0x98, 0xb6, 0xf1, 0xcd, 0x9c, 0x03, 0xc0, 0x7a, 0x29, 0xbc, 0xdd, 0x43, 0x58, 0xe7, 0x17, 0x2f,
+	0x59, 0x6d, 0xc7, 0x70, 0x94, 0x1d, 0xd9, 0x56, 0x1c, 0xbe, 0xbd, 0x83, 0xb4, 0xa1, 0xa0, 0x50,
+	0x71, 0x09, 0xea, 0x75, 0xbc, 0x47, 0x77, 0x70, 0x28, 0x3f, 0xe2, 0xab, 0x74, 0xbc, 0x87, 0x4e,
+	0xc3, 0x61, 0x7b, 0x47, 0xb1, 0xab, 0x81, 0x61, 0x87, 0xe8, 0xb0, 0x51, 0xb7, 0x99, 0x8d, 0xbb,
+	0x0c, 0x47, 0xfc, 0xbb, 0x8f, 0x76, 0xc9, 0xb6, 0x56, 0xa1, 0xe3, 0x07, 0xe9, 0xf8, 0x29, 0xaf,
+	0xbb, 0x40, 0x7a, 0x0b, 0x5a, 0x85, 0x80, 0x3d, 0x86, 0x51, 0xcf, 0x87, 0xb6, 0xb5, 0x8a, 0x9d,
+	0x1d, 0xa2, 0x07, 0xe7, 0xa5, 0x16, 0x2e, 0xf9, 0x72, 0x49, 0x31, 0x09, 0x26, 0xad, 0xa2, 0x2b,
+	0x4e, 0xdd, 0xc2, 0x76, 0xde, 0x73, 0xec, 0x0b, 0x5a, 0xc5, 0x46, 0xe7, 0x01, 0xb9, 0xbc, 0x19,
+	0x75, 0xc7, 0xac, 0x3b, 0xb2, 0x56, 0xda, 0xcb, 0x02, 0xf5, 0xba, 0xdd, 0x2b, 0xeb, 0x11, 0xed,
+	0x58, 0x2b, 0x51, 0x03, 0x9b, 0x4b, 0xe4, 0x30, 0x95, 0x48, 0xfe, 0x85, 0xe6, 0x61, 0x98, 0xb9,
+	0x36, 0x72, 0x09, 0xdb, 0x6a, 0x76, 0x84, 0x29, 0x34, 0xd6, 0x74, 0x1b, 0xdb, 0x2a, 0x71, 0xec,
+	0xeb, 0x7a, 0xd1, 0x60, 0xc7, 0x9f, 0x9c, 0x83, 0xec, 0x28, 0x73, 0xec, 0xbd, 0x56, 0x22, 0xf7,
+	0x48, 0x85, 0xe9, 0xba, 0xee, 0x6b, 0x07, 0xd9, 0xe2, 0xd2, 0x98, 0x1d, 0xa3, 0x22, 0xbe, 0x10,
+	0xaf, 0x25, 0x1e, 0xeb, 0xa5, 0x26, 0x19, 0xce, 0x4f, 0xd5, 0x23, 0x5a, 0x23, 0x82, 0x0c, 0x87,
+	0x23, 0x82, 0x0c, 0xe4, 0xf8, 0xab, 0x16, 0x26, 0xc6, 0x99, 0xcc, 0x67, 0x75, 0xa5, 0x67, 0x9c,
+	0x1d, 0x7f, 0xde, 0xbb, 0xc2, 0x3a, 0x5b, 0x2a, 0x8d, 0x89, 0xee, 0x94, 0x06, 0x6a, 0x47, 0x69,
+	0x9c, 0x82, 0x31, 0x8b, 0x6a, 0x7a, 0xd9, 0x30, 0x1d, 0xb2, 0xa1, 0xd9, 0x49, 0xba, 0x4f, 0x23,
+	0xac, 0xf5, 0x91, 0xe9, 0x3c, 0xaa, 0xc7, 0xda, 0x29, 0x53, 0xd1, 0x76, 0x4a, 0xc0, 0xe3, 0x9d,
+	0xee, 0xd8, 0xe3, 0xbd, 0x09, 0xe0, 0x2e, 0xa2, 0xb9, 0x9d, 0x9d, 0xa1, 0xbb, 0x39, 0xef, 0x2a,
+	0x2c, 0x16, 0x8b, 0x5c, 0xf0, 0x62, 0x91, 0x0b, 0xfc, 0x8c, 0x0f, 0x71, 0x90, 0x8d, 0x6d, 0x74,
+	0x0d, 0xfa, 0x4c, 0xc3, 0xcc, 0x1e, 0xa1, 0x80, 0x67, 0xe3, 0xa2, 0x61, 0x96, 0x61, 0x94, 0x1f,
+	0x95, 0x37, 0x0c, 0xdb, 0xc6, 0x36, 0x8d, 0xa7, 0x11, 0x20, 0x74, 0x02, 0x86, 0x35, 0x7d, 0x57,
+	0xd9, 0xd1, 0x4a, 0x64, 0xbb, 0xb2, 0x59, 0xba, 0x22, 0xc1, 0x26, 0x7a, 0x08, 0xc8, 0x51, 0x23,
+	0x5b, 0xed, 0xa8, 0xee, 0x36, 0xcf, 0xd2, 0x15, 0x1f, 0xe7, 0x3d, 0x2b, 0x8e, 0xca, 0xb7, 0x58,
+	0x84, 0x41, 0x0b, 0xdb, 0xd8, 0xda, 0xc5, 0xa5, 0xac, 0xc8, 0x14, 0xb3, 0xfb, 0x8d, 0x6e, 0xc1,
+	0xb1, 0xb2, 0x29, 0x7b, 0xc8, 0x70, 0xd9, 0xb0, 0x30, 0x53, 0xe2, 0x4c, 0x55, 0x1f, 0xa5, 0xe3,
+	0x67, 0xcb, 0x66, 0x81, 0x63, 0xa5, 0x23, 0x96, 0xbd, 0x01, 0xe8, 0x22, 0x4c, 0x07, 0x11, 0xf8,
+	0xd4, 0x1c, 0xa3, 0xd4, 0x20, 0x1f, 0xd2, 0xa5, 0x47, 0xfa, 0x66, 0x1f, 0x1c, 0x89, 0x39, 0x01,
+	0xe8, 0x2c, 0x8c, 0x07, 0xce, 0xdd, 0x5e, 0xc0, 0xdc, 0xf0, 0xcf, 0x23, 0x53, 0x4b, 0x37, 0xe0,
+	0xa8, 0xaf, 0x96, 0x7c, 0x18, 0x57, 0x35, 0xb1, 0x18, 0x59, 0xd6, 0x1b, 0xf2, 0xd8, 0x1d, 0xc1,
+	0xd5, 0x93, 0x0a, 0x47, 0x3d, 0xf5, 0x14, 0x86, 0xa6, 0xca, 0xbe, 0x8f, 0x2a, 0xab, 0x53, 0x31,
+	0x1b, 0xe7, 0x69, 0xa7, 0x35, 0xbd, 0x6c, 0xe4, 0xb3, 0x2e, 0xa2, 0xe0, 0x1c, 0x54, 0xcf, 0x47,
+	0xa8, 0xd8, 0xfe, 0x28, 0x15, 0x7b, 0x1d, 0xc4, 0x06, 0x15, 0x1b, 0x64, 0xe5, 0x20, 0x05, 0x39,
+	0x12, 0xd6, 0xb2, 0x3e, 0x27, 0x65, 0x98, 0xf1, 0x15, 0x6d, 0x00, 0xd6, 0xce, 0x0e, 0x74, 0xa8,
+	0x71, 0xa7, 0x3c, 0x8d, 0xeb, 0xcf, 0x64, 0x4b, 0x2a, 0xcc, 0xb7, 0xf0, 0x67, 0xd0, 0x6b, 0xd0,
+	0x5f, 0xc2, 0x3b, 0x9d, 0xd9, 0x60, 0x14, 0x52, 0xfa, 0xb0, 0x0f, 0x9e, 0xa3, 0x06, 0x60, 0x41,
+	0xab, 0xd5, 0x77, 0x14, 0x07, 0x37, 0x09, 0x4a, 0x27, 0xae, 0x0b, 0xb9, 0x70, 0x83, 0x62, 0x45,
+	0xa5, 0x63, 0x24, 0x3f, 0x1c, 0x10, 0x29, 0x12, 0xf3, 0xf5, 0x87, 0xec, 0x2a, 0x3b, 0x75, 0x4c,
+	0xaf, 0xe5, 0xbe, 0x80, 0xe0, 0x6d, 0x91, 0xd6, 0x88, 0xab, 0xa1, 0x3f, 0xea, 0x6a, 0xb8, 0x03,
+	0xd3, 0x5e, 0x83, 0x1c, 0x90, 0x02, 0xba, 0x9d, 0x23, 0x2b, 0x13, 0x9f, 0x7c, 0x3a, 0x3f, 0xba,
+	0xb2, 0xb9, 0x5a, 0xf0, 0x04, 0x21, 0x3f, 0xe9, 0x8d, 0xf7, 0x1b, 0xd1, 0x57, 0x04, 0x38, 0x11,
+	0x29, 0xe7, 0x81, 0x9d, 0xa6, 0xd7, 0xfb, 0xc8, 0xca, 0x2b, 0x9f, 0x7c, 0x3a, 0x7f, 0x39, 0x8d,
+	0x69, 0xe2, 0x6d, 0x79, 0x7e, 0x2e, 0xe2, 0x9c, 0xf8, 0x7b, 0x2f, 0xa9, 0x70, 0x2a, 0x79, 0x53,
+	0xf8, 0xfe, 0x4f, 0xc1, 0x41, 0xaa, 0xa4, 0xe8, 0x3e, 0x0c, 0xe6, 0xd9, 0x07, 0x59, 0x30, 0xae,
+	0xbc, 0x64, 0x0b, 0x2b, 0x36, 0x77, 0x10, 0x86, 0xf2, 0xa3, 0xbc, 0x35, 0x4f, 0x1b, 0xa5, 0xdf,
+	0x71, 0x83, 0x3d, 0x05, 0x47, 0xd9, 0xc1, 0x5e, 0xbc, 0xbc, 0xc9, 0x72, 0x76, 0x45, 0xe0, 0x3c,
+	0xa0, 0x9a, 0xb2, 0x27, 0x17, 0x77, 0x0c, 0x75, 0xdb, 0x96, 0xb9, 0x85, 0xcd, 0xe3, 0x0f, 0xe3,
+	0x35, 0x65, 0x6f, 0x85, 0x76, 0x70, 0xf8, 0x9e, 0x79, 0x28, 0x7f, 0xe7, 0x86, 0x80, 0x5a, 0x52,
+	0xf9, 0x33, 0xe2, 0x07, 0xae, 0x73, 0xaf, 0xde, 0xdd, 0xef, 0xe5, 0x9a, 0x51, 0xd7, 0x9d, 0x0e,
+	0x43, 0x04, 0x5f, 0xcd, 0xc0, 0xd1, 0x48, 0x6c, 0x7c, 0x31, 0x5e, 0x80, 0x71, 0x4f, 0x70, 0x95,
+	0x52, 0xc9, 0xc2, 0xb6, 0xcd, 0x71, 0x79, 0x8a, 0x72, 0x99, 0x35, 0xa3, 0x2d, 0xf0, 0x94, 0xa4,
+	0x6c, 0x29, 0x0e, 0x66, 0x42, 0xb3, 0x72, 0x91, 0xa4, 0x8e, 0x3e, 0xf9, 0x74, 0xfe, 0x28, 0x63,
+	0xd5, 0x2e, 0x6d, 0x2f, 0x68, 0x46, 0xae, 0xa6, 0x38, 0xd5, 0x85, 0xfb, 0xb8, 0xa2, 0xa8, 0xfb,
+	0xb7, 0xb1, 0xfa, 0xdd, 0x6f, 0x5e, 0x00, 0xbe, 0x12, 0xb7, 0xb1, 0x9a, 0x1f, 0x71, 0xf1, 0xe4,
+	0x15, 0x07, 0x93, 0x73, 0xee, 0x93, 0x40, 0xa9, 0xe3, 0xe6, 0xf7, 0x98, 0x1d, 0xa2, 0x19, 0x5d,
+	0x83, 0xd9, 0x88, 0xe3, 0xc6, 0x41, 0x98, 0x41, 0x7e, 0xa4, 0xe9, 0xc4, 0x32, 0x58, 0x49, 0x81,
+	0xf9, 0xd0, 0x81, 0xd9, 0xf2, 0x83, 0x9a, 0xee, 0xca, 0x86, 0x2c, 0x78, 0xa1, 0xc1, 0x82, 0x67,
+	0x0e, 0xc2, 0xb6, 0xa7, 0x61, 0x58, 0xf6, 0x69, 0xd8, 0x5d, 0x6f, 0xad, 0x86, 0xa5, 0x6d, 0x38,
+	0x11, 0x3f, 0x45, 0xdb, 0x91, 0xe1, 0x08, 0xd7, 0x32, 0xd3, 0xec, 0x5a, 0x4a, 0xdb, 0xfc, 0x68,
+	0x86, 0xe3, 0xf6, 0x2b, 0xfb, 0x6b, 0xba, 0xba, 0x53, 0xb7, 0x35, 0xd7, 0x9a, 0x74, 0x79, 0x9b,
+	0x87, 0xe1, 0xb2, 0x65, 0xd4, 0xe4, 0x50, 0x4c, 0x10, 0x48, 0x53, 0xd0, 0x7d, 0x09, 0x4f, 0x38,
+	0xe8, 0x18, 0x7c, 0xb2, 0xaf, 0xba, 0x47, 0xac, 0xe5, 0x6c, 0xcf, 0xf4, 0x88, 0x49, 0x12, 0x5f,
+	0xe1, 0xd5, 0x50, 0xce, 0xef, 0x1e, 0x56, 0x76, 0x9c, 0xaa, 0x1b, 0x18, 0xfd, 0x8e, 0x00, 0x27,
+	0x13, 0x06, 0x71, 0x02, 0x23, 0xf2, 0x89, 0x42, 0x64, 0x3e, 0xf1, 0x0a, 0x1c, 0xd1, 0xeb, 0x35,
+	0x39, 0x3a, 0xee, 0x40, 0x56, 0x69, 0x5a, 0xaf, 0xd7, 0x9a, 0x95, 0x0d, 0x5a, 0x87, 0x43, 0xc5,
+	0xba, 0xba, 0x8d, 0x1d, 0x9b, 0x5b, 0x2e, 0x17, 0x5b, 0x5c, 0xfa, 0x41, 0x32, 0x57, 0x28, 0x64,
+	0xde, 0xc5, 0x20, 0x55, 0x41, 0x8c, 0x1f, 0x46, 0x64, 0xaa, 0xa6, 0xd9, 0xb6, 0x67, 0x64, 0x30,
+	0x46, 0x86, 0x79, 0x1b, 0xf5, 0xd1, 0xce, 0xc0, 0x61, 0xc2, 0x45, 0x33, 0xf5, 0x63, 0x7a, 0xbd,
+	0x16, 0x5c, 0xe1, 0xdf, 0xec, 0x87, 0x6c, 0x6c, 0xd6, 0xec, 0x0e, 0x0c, 0x13, 0xe7, 0xcc, 0xd2,
+	0xcc, 0x40, 0x34, 0xf1, 0x39, 0x57, 0xc5, 0xf9, 0x3c, 0x31, 0xfd, 0x76, 0xdb, 0x1f, 0x9a, 0x0f,
+	0xc2, 0xa1, 0x07, 0x24, 0x30, 0x58, 0xa3, 0xe4, 0xb9, 0x37, 0xcf, 0xca, 0x85, 0x74, 0x0a, 0x24,
+	0x80, 0xa0, 0xc1, 0x31, 0xe8, 0x4b, 0xed, 0x18, 0xf8, 0x61, 0x8a, 0xfe, 0x5e, 0x84, 0x29, 0xb8,
+	0x9f, 0x71, 0xb0, 0x13, 0x3f, 0x63, 0x09, 0x66, 0x3c, 0xbb, 0x3d, 0xec, 0x30, 0xb2, 0x80, 0xcf,
+	0x94, 0xeb, 0x49, 0x84, 0x1c, 0xc6, 0x68, 0xdf, 0xe3, 0x50, 0x8c, 0xef, 0xe1, 0x27, 0x06, 0x06,
+	0x13, 0x93, 0x3f, 0x43, 0xcd, 0xc9, 0x1f, 0x93, 0x87, 0x02, 0x03, 0x02, 0x43, 0x52, 0x21, 0xf4,
+	0xde, 0x0d, 0x95, 0x4a, 0xf4, 0x2c, 0xaf, 0xfd, 0x13, 0x37, 0x5b, 0x91, 0x34, 0x25, 0x97, 0x4e,
+	0xe2, 0x6d, 0xb3, 0x6c, 0x97, 0xdc, 0xe0, 0x9c, 0xb3, 0x03, 0x31, 0xc5, 0x7b, 0x37, 0x42, 0x3e,
+	0x7a, 0x84, 0xa6, 0xca, 0xf4, 0xdc, 0x18, 0xe8, 0xeb, 0xdc, 0x18, 0xb8, 0xcd, 0xef, 0xad, 0xe6,
+	0xc4, 0xe3, 0x46, 0x8a, 0xf4, 0xe0, 0x8f, 0x04, 0x38, 0x11, 0x8f, 0x86, 0x2f, 0x60, 0xf8, 0x20,
+	0x09, 0x5d, 0x1c, 0xa4, 0x4c, 0x0f, 0x0f, 0x52, 0x5f, 0x07, 0x07, 0x49, 0x7a, 0xc0, 0xb3, 0x63,
+	0xa1, 0xcd, 0x0a, 0x2c, 0x59, 0x4a, 0x23, 0xea, 0x07, 0x02, 0xcc, 0xc5, 0xe0, 0xfb, 0xff, 0xb7,
+	0x76, 0x5f, 0x13, 0x60, 0x31, 0x21, 0xd7, 0x5d, 0x76, 0xb0, 0x15, 0xe5, 0xff, 0xb5, 0x91, 0x93,
+	0x88, 0x59, 0xf5, 0x4c, 0xcc, 0xaa, 0x7f, 0x2c, 0xc0, 0xa5, 0x54, 0x84, 0xb4, 0x6f, 0x63, 0x5d,
+	0xf1, 0x22, 0xa8, 0x9a, 0xa1, 0xcb, 0x11, 0x49, 0xef, 0x69, 0xbf, 0x3b, 0x60, 0xc6, 0xa1, 0x3b,
+	0x30, 0x1f, 0x1c, 0x2c, 0x2b, 0x84, 0x08, 0x39, 0x18, 0x23, 0xe4, 0xa6, 0xeb, 0xb1, 0xc0, 0x6c,
+	0x4d, 0x94, 0x4a, 0x37, 0xb9, 0xf7, 0xb6, 0x69, 0x38, 0xca, 0x4e, 0x00, 0x7f, 0x9b, 0xd9, 0x73,
+	0xe9, 0x17, 0xdc, 0x4c, 0x51, 0x3c, 0x82, 0xf6, 0xd7, 0x62, 0x09, 0x66, 0x88, 0x6d, 0x10, 0x91,
+	0x15, 0x67, 0x4b, 0x31, 0xa5, 0xd7, 0x6b, 0x8d, 0x3b, 0x60, 0x4b, 0x0e, 0x9c, 0x68, 0x3e, 0x11,
+	0x05, 0x7a, 0xc7, 0xdb, 0xcf, 0x4e, 0x24, 0x36, 0x60, 0x62, 0x53, 0x31, 0x2d, 0xc3, 0x70, 0xd8,
+	0x54, 0x1b, 0x8a, 0x53, 0x25, 0xab, 0xc4, 0x8c, 0x0b, 0x96, 0x67, 0xc8, 0xf3, 0x2f, 0xf4, 0x1c,
+	0x89, 0x77, 0xeb, 0x8e, 0x65, 0xec, 0x30, 0x97, 0x94, 0xc7, 0x18, 0x46, 0x78, 0x23, 0xf5, 0x46,
+	0xa5, 0x3f, 0xe8, 0x87, 0x93, 0x09, 0x8c, 0xf0, 0x65, 0x6c, 0xce, 0x3d, 0x08, 0xbd, 0xcb, 0x3d,
+	0x4c, 0xc3, 0x40, 0xd9, 0xa4, 0x41, 0x73, 0xe6, 0x54, 0x1c, 0x2c, 0x9b, 0x24, 0x52, 0x7e, 0x15,
+	0xb2, 0x0d, 0x71, 0x75, 0x73, 0x5b, 0xe6, 0x8c, 0xf6, 0x51, 0x4e, 0xa6, 0x43, 0xd1, 0xf5, 0x8d,
+	0x6d, 0x46, 0x35, 0x7a, 0x0f, 0xdc, 0x0e, 0xdf, 0x49, 0x32, 0x15, 0xa7, 0x9a, 0xed, 0x4f, 0x54,
+	0x07, 0x4d, 0x0b, 0x9b, 0x77, 0xb7, 0xc6, 0x75, 0xa5, 0xe8, 0x6a, 0x7f, 0x11, 0x66, 0x5c, 0xec,
+	0xbe, 0x33, 0x46, 0xd1, 0x1f, 0x4c, 0x89, 0x7e, 0x8a, 0xf7, 0x7a, 0x01, 0x0e, 0x8a, 0xff, 0x3a,
+	0x88, 0x3e, 0xde, 0x26, 0xc6, 0x69, 0x5c, 0x25, 0xe0, 0xe5, 0x35, 0xb0, 0xfe, 0x25, 0x38, 0x12,
+	0xe1, 0x21, 0x52, 0xea, 0x0e, 0xa5, 0xa4, 0x6e, 0xba, 0xc9, 0x93, 0x24, 0xcd, 0xd2, 0x5b, 0xdc,
+	0x06, 0xda, 0xc2, 0x96, 0x56, 0xde, 0xbf, 0x1d, 0x11, 0x01, 0xec, 0xf0, 0x8e, 0x29, 0xc3, 0x99,
+	0x96, 0x88, 0x7b, 0x11, 0xd4, 0x29, 0x80, 0xc4, 0xf3, 0xb9, 0xbb, 0x74, 0x26, 0xcf, 0x85, 0xa3,
+	0xd7, 0x41, 0x87, 0xc4, 0xef, 0xc1, 0x73, 0x89, 0x48, 0x7b, 0x40, 0x38, 0x01, 0x66, 0x69, 0x10,
+	0xa6, 0x61, 0xd9, 0x87, 0xf4, 0x4e, 0x83, 0x4b, 0x48, 0x22, 0x68, 0x9a, 0x5e, 0x59, 0x51, 0x1c,
+	0xd5, 0x75, 0x09, 0xd1, 0x15, 0xc8, 0x46, 0x30, 0xe3, 0x9f, 0xe3, 0xa1, 0xfc, 0x54, 0x23, 0x47,
+	0xe4, 0x60, 0x4a, 0x0e, 0x9c, 0x4c, 0xc0, 0xcd, 0x79, 0x7a, 0x04, 0xa3, 0x36, 0x6b, 0x97, 0x35,
+	0xbd, 0x6c, 0xb8, 0x8e, 0xee, 0xb9, 0x16, 0xee, 0x1e, 0xc7, 0x45, 0xc3, 0xd5, 0x23, 0xb6, 0xff,
+	0x61, 0x4b, 0xbf, 0x7f, 0x10, 0x26, 0x23, 0x46, 0xa5, 0x0d, 0xb0, 0x3e, 0xd3, 0x74, 0xe9, 0x1c,
+	0x80, 0x4f, 0x0b, 0xd7, 0x46, 0x43, 0x1e, 0x09, 0x31, 0x29, 0xc1, 0xfe, 0x98, 0x94, 0xe0, 0x22,
+	0x0c, 0xb7, 0x15, 0x8d, 0x05, 0x3f, 0x44, 0x1f, 0xaf, 0xe3, 0x06, 0x7a, 0xa1, 0xe3, 0x1a, 0x83,
+	0xd3, 0x87, 0x9a, 0x83, 0xd3, 0xf1, 0x6a, 0x70, 0xb0, 0x27, 0x6a, 0x30, 0x36, 0x58, 0x3d, 0x94,
+	0x2a, 0x58, 0x9d, 0xa0, 0x10, 0xa1, 0x37, 0x0a, 0x71, 0x8b, 0x9b, 0x22, 0x1e, 0xf9, 0x5e, 0x04,
+	0xd6, 0x32, 0x2a, 0x16, 0xb6, 0xed, 0x0e, 0x55, 0xca, 0xaf, 0xb8, 0x85, 0x27, 0x09, 0x88, 0xf9,
+	0x11, 0xec, 0x45, 0x41, 0xed, 0x1a, 0x9c, 0x8c, 0x4b, 0x5e, 0xd9, 0xf5, 0x22, 0xad, 0x6d, 0x2f,
+	0x51, 0xbd, 0x34, 0x98, 0x3f, 0x1e, 0x99, 0xc2, 0x2a, 0xb8, 0xa3, 0xa2, 0x62, 0x4b, 0x7d, 0x91,
+	0xb1, 0xa5, 0x1b, 0x70, 0x94, 0x58, 0x5e, 0xd1, 0x59, 0x2f, 0x9b, 0x9f, 0x97, 0xac, 0x5e, 0xaf,
+	0xad, 0x46, 0xa4, 0xb3, 0x6c, 0xf4, 0x10, 0x4e, 0xc5, 0x81, 0x87, 0x92, 0x4e, 0x07, 0x29, 0x9e,
+	0x13, 0x91, 0x78, 0x02, 0xe9, 0x24, 0xf4, 0x12, 0x4c, 0x55, 0x15, 0x5b, 0x6e, 0xa0, 0xdd, 0xa6,
+	0x47, 0x6a, 0x30, 0x8f, 0xaa, 0x8a, 0x1d, 0x0e, 0x42, 0xd9, 0xa8, 0x0a, 0x53, 0x6e, 0x60, 0x2c,
+	0x54, 0xeb, 0x7f, 0xa8, 0x2b, 0x4d, 0xe3, 0xd6, 0xe6, 0xf8, 0x05, 0xfa, 0xb6, 0x74, 0xd6, 0xab,
+	0x42, 0x22, 0x91, 0x1f, 0xac, 0x97, 0x70, 0xc9, 0xa5, 0xfd, 0x2e, 0xc6, 0x79, 0xc5, 0xf1, 0x9e,
+	0x26, 0x7c, 0xe8, 0x86, 0x0c, 0x92, 0x86, 0x72, 0xc1, 0x59, 0x84, 0x99, 0x32, 0xc6, 0x34, 0x98,
+	0x2d, 0xdb, 0x8a, 0x23, 0x9b, 0xd8, 0x92, 0x77, 0x8b, 0xfb, 0x0e, 0xe6, 0x76, 0x32, 0x2a, 0x33,
+	0x80, 0x82, 0xe2, 0x6c, 0x60, 0x6b, 0x8b, 0xf4, 0xa0, 0x25, 0x38, 0x52, 0xd3, 0xf4, 0xe0, 0x91,
+	0x94, 0x09, 0x0e, 0x12, 0x33, 0xce, 0xd0, 0xec, 0xd4, 0x64, 0x4d, 0xd3, 0xfd, 0x13, 0x78, 0x17,
+	0x13, 0x68, 0x69, 0x83, 0xbb, 0xf1, 0x01, 0xf9, 0x23, 0x5c, 0x6e, 0x5a, 0x18, 0x77, 0x78, 0x3e,
+	0x9e, 0xc0, 0x61, 0x7e, 0x46, 0x09, 0x92, 0xfb, 0x58, 0x29, 0x13, 0xad, 0xbc, 0x83, 0x95, 0xb2,
+	0xac, 0xe9, 0x25, 0x0e, 0x38, 0x9a, 0x1f, 0x22, 0x2d, 0x6b, 0xa4, 0x01, 0xad, 0xc1, 0x30, 0xb3,
+	0xa2, 0xd8, 0xf9, 0xcf, 0xa4, 0x3c, 0xff, 0x60, 0x7b, 0x7f, 0x4b, 0xdf, 0xcf, 0xc0, 0x89, 0x78,
+	0x7e, 0x7c, 0xdf, 0x43, 0xd3, 0x1d, 0x6c, 0xe9, 0xca, 0x8e, 0xbc, 0x8d, 0xf7, 0xb9, 0x75, 0x3e,
+	0xec, 0xb6, 0xad, 0xe3, 0xfd, 0x44, 0x1b, 0x37, 0x93, 0x64, 0xe3, 0xae, 0xc3, 0x28, 0x09, 0xc3,
+	0x13, 0x13, 0x5e, 0x26, 0x1c, 0x72, 0x57, 0xf7, 0x74, 0x32, 0x37, 0xee, 0x4a, 0xe5, 0x47, 0x5c,
+	0x60, 0xba, 0x6e, 0x0f, 0x82, 0xf9, 0x43, 0x8a, 0xad, 0x3f, 0x15, 0x36, 0x3f, 0xcf, 0x48, 0xd1,
+	0xad, 0x07, 0xf2, 0x24, 0x14, 0xdb, 0xc1, 0x74, 0xb4, 0xb9, 0xc0, 0xe4, 0x4b, 0x7a, 0x91, 0x17,
+	0x76, 0x07, 0x9c, 0xbc, 0x4d, 0x85, 0xd4, 0xc5, 0x69, 0xb6, 0x6a, 0x61, 0x53, 0xd1, 0x55, 0x0d,
+	0x7b, 0x2f, 0x94, 0x7e, 0x5b, 0x80, 0x99, 0xc0, 0x40, 0x7f, 0xcc, 0x7e, 0x3b, 0xbe, 0xd8, 0x02,
+	0x11, 0x40, 0xc3, 0xc2, 0xa5, 0x28, 0x87, 0x78, 0x82, 0x75, 0x05, 0x9d, 0xe1, 0x45, 0x98, 0xc6,
+	0x7b, 0x26, 0x56, 0x9d, 0x46, 0x08, 0x66, 0xa0, 0x4d, 0xba, 0x9d, 0x01, 0x18, 0xe9, 0x37, 0x04,
+	0x5e, 0x48, 0xdf, 0x82, 0x9f, 0x16, 0x95, 0xea, 0x05, 0x18, 0x2d, 0x05, 0x01, 0x78, 0xcc, 0xee,
+	0x42, 0xcc, 0x12, 0x47, 0xaf, 0x49, 0x3e, 0x8c, 0x23, 0xb6, 0xc6, 0xdf, 0x3d, 0xcc, 0x6b, 0x35,
+	0x53, 0x51, 0x53, 0x3c, 0x26, 0x90, 0xbe, 0xe7, 0xe6, 0x4f, 0x5b, 0x61, 0x7c, 0xb6, 0x89, 0xc9,
+	0x50, 0x5e, 0x2b, 0xd3, 0x90, 0xd7, 0x5a, 0x84, 0x69, 0xde, 0x19, 0x99, 0x82, 0x9b, 0x64, 0x03,
+	0xc3, 0xb9, 0xb4, 0xaf, 0xbb, 0xe1, 0x07, 0xe6, 0xab, 0x84, 0x6f, 0x05, 0xaa, 0x07, 0x3a, 0x2c,
+	0x0a, 0x78, 0x15, 0xfa, 0x3d, 0xd5, 0x34, 0x16, 0xab, 0x9a, 0x3c, 0xe3, 0x98, 0xcc, 0x44, 0x55,
+	0x13, 0x85, 0x22, 0x8f, 0xd2, 0x4e, 0xb7, 0x22, 0x8b, 0xaf, 0xf4, 0x31, 0x18, 0xb2, 0x49, 0x03,
+	0x91, 0x3c, 0xee, 0x8c, 0xf8, 0x0d, 0xed, 0x3f, 0x36, 0xbb, 0xcc, 0x92, 0x43, 0xcc, 0x77, 0x09,
+	0xd7, 0xd6, 0xb1, 0x1b, 0x9f, 0xc4, 0x4e, 0xb6, 0x48, 0xef, 0x6a, 0xb0, 0x62, 0x6e, 0x06, 0x06,
+	0xb8, 0xa3, 0xc3, 0x6a, 0x4f, 0xf8, 0x97, 0xf4, 0x76, 0x53, 0xb0, 0xfb, 0xae, 0x66, 0xd9, 0x0e,
+	0xab, 0xbc, 0x0d, 0x47, 0x86, 0x52, 0x5e, 0x16, 0xdf, 0xe8, 0x83, 0xb3, 0xad, 0x51, 0xf3, 0xc5,
+	0x59, 0x80, 0xc9, 0x32, 0xe9, 0x94, 0x79, 0x21, 0x58, 0xe8, 0x04, 0x4e, 0x94, 0x1b, 0xe1, 0xd0,
+	0x2b, 0x30, 0xcb, 0x53, 0xfe, 0x75, 0xdd, 0xd1, 0x76, 0xe4, 0x20, 0x30, 0x97, 0xb7, 0x19, 0x36,
+	0xe0, 0x31, 0xe9, 0x0f, 0x4c, 0x8c, 0x5e, 0x84, 0x09, 0xbf, 0xb4, 0x29, 0x5c, 0x7b, 0x39, 0xee,
+	0x77, 0xf0, 0x79, 0x72, 0x84, 0xae, 0x40, 0x5d, 0x5b, 0xa8, 0x12, 0x13, 0x05, 0xbb, 0xfc, 0xc4,
+	0x08, 0x67, 0x81, 0xd5, 0x76, 0x62, 0xd3, 0x50, 0xdd, 0xd2, 0xdb, 0x71, 0xd6, 0x53, 0x20, 0x1d,
+	0x77, 0x48, 0x3b, 0x31, 0x7f, 0xf8, 0x68, 0x42, 0x6b, 0xdd, 0x64, 0xc3, 0x6d, 0x9e, 0x7a, 0xe1,
+	0x98, 0xee, 0xd3, 0x2e, 0x0a, 0x60, 0x93, 0xd7, 0x99, 0x58, 0xb1, 0x74, 0x52, 0xe4, 0xc0, 0xca,
+	0x6b, 0xdd, 0x4f, 0xf4, 0x32, 0x64, 0x95, 0x0f, 0x14, 0xcd, 0x09, 0x59, 0x46, 0x5c, 0x94, 0x06,
+	0xe9, 0xd0, 0x19, 0xb7, 0x3f, 0x2c, 0xa6, 0xd2, 0x1f, 0xb9, 0x0f, 0xb2, 0x82, 0x2e, 0xe0, 0x03,
+	0xbb, 0xf2, 0xd3, 0x38, 0x51, 0xa4, 0x5a, 0x2a, 0x60, 0xd7, 0xd1, 0x89, 0xfa, 0x98, 0x6b, 0xee,
+	0xbf, 0xcd, 0x24, 0xe2, 0xf5, 0x51, 0x86, 0xc7, 0xdb, 0x9b, 0x88, 0xe6, 0x22, 0x35, 0x0b, 0x83,
+	0xb4, 0x76, 0x4a, 0xb1, 0xab, 0xdc, 0x0c, 0x38, 0x64, 0x6b, 0x15, 0x42, 0x24, 0x75, 0x25, 0xb9,
+	0xff, 0xec, 0x95, 0x01, 0x0d, 0xf1, 0x96, 0xcd, 0x26, 0xa3, 0xa5, 0xaf, 0x73, 0xa3, 0x85, 0xe8,
+	0x41, 0x6a, 0x1e, 0x51, 0x2a, 0x68, 0xae, 0x2f, 0x3f, 0x48, 0x1a, 0x28, 0x19, 0xe7, 0x01, 0x79,
+	0xac, 0x6e, 0xe3, 0x7d, 0x6e, 0x43, 0x31, 0xd3, 0x79, 0xdc, 0xed, 0x59, 0xc7, 0xfb, 0xcc, 0x94,
+	0x7a, 0x1b, 0x46, 0xb0, 0xae, 0xd2, 0x81, 0xd4, 0xb5, 0x1e, 0xe8, 0xca, 0xe0, 0x05, 0xac, 0xab,
+	0xeb, 0x78, 0x9f, 0xc6, 0x1c, 0x4e, 0xf0, 0x87, 0x9c, 0x05, 0xc6, 0xd5, 0x9a, 0x6f, 0x2c, 0xb9,
+	0x97, 0xbc, 0x9b, 0x11, 0x8a, 0x1a, 0xd1, 0xb6, 0xe5, 0x25, 0xfd, 0x83, 0x00, 0xcf, 0x35, 0x68,
+	0x04, 0xbb, 0xe0, 0x6a, 0xc0, 0x2d, 0x4d, 0x71, 0xe5, 0x6d, 0x99, 0x0b, 0x10, 0xf3, 0xac, 0xe2,
+	0x2e, 0xd8, 0x42, 0xd0, 0x48, 0x6b, 0x94, 0xa2, 0xb6, 0x0a, 0x1a, 0x1a, 0x52, 0x86, 0x7d, 0x1d,
+	0xa7, 0x0c, 0x7f, 0x28, 0xc0, 0xa9, 0x64, 0xc6, 0x9e, 0xed, 0x6d, 0xdb, 0x1e, 0xb7, 0x3d, 0xcb,
+	0x0f, 0xee, 0x36, 0x3c, 0xd5, 0x2e, 0x68, 0x95, 0x87, 0x18, 0x97, 0x70, 0xa7, 0x57, 0x70, 0xc4,
+	0x91, 0xcf, 0x44, 0x1d, 0xf9, 0x5f, 0x6a, 0x7c, 0x01, 0x1e, 0x98, 0xd8, 0x37, 0xde, 0x74, 0xda,
+	0xc2, 0x6f, 0x58, 0xfe, 0x85, 0x1e, 0xc0, 0xa8, 0x5b, 0xaf, 0x40, 0xe4, 0x83, 0x19, 0x6f, 0x69,
+	0x94, 0x93, 0x5b, 0xee, 0x40, 0x3e, 0x6c, 0x2f, 0xd7, 0x17, 0x48, 0xcc, 0x85, 0x72, 0xd1, 0x29,
+	0xaf, 0xca, 0x5f, 0xcc, 0xc0, 0x5c, 0x0c, 0xbe, 0xd8, 0xea, 0x6f, 0x21, 0xaa, 0xfa, 0xdb, 0x7f,
+	0x81, 0x9f, 0x49, 0xfd, 0x02, 0x3f, 0xa1, 0x08, 0xbc, 0xaf, 0xbb, 0x22, 0xf0, 0xfe, 0x36, 0x8a,
+	0xc0, 0xcf, 0xdd, 0x83, 0x89, 0xa6, 0x55, 0x47, 0xa3, 0x30, 0xf4, 0xf8, 0xe1, 0xca, 0xa3, 0x87,
+	0xb7, 0xd7, 0x1e, 0xbe, 0x3e, 0x7e, 0x00, 0x8d, 0xc0, 0x60, 0xe1, 0xfe, 0x72, 0xe1, 0x1e, 0xf9,
+	0x12, 0xd0, 0x0c, 0x20, 0xaf, 0x53, 0xf6, 0xda, 0x33, 0xe7, 0xf2, 0x30, 0x13, 0xad, 0x1b, 0xd0,
+	0x04, 0x8c, 0x6e, 0xae, 0x3d, 0xb8, 0x73, 0xff, 0xd1, 0xea, 0xba, 0xbc, 0xb1, 0xbc, 0x79, 0x6f,
+	0xfc, 0x00, 0x42, 0x30, 0xe6, 0x23, 0xa1, 0x6d, 0x02, 0x19, 0xe6, 0xa2, 0x63, 0x4d, 0x99, 0xc5,
+	0xef, 0xdd, 0x85, 0x83, 0x74, 0x87, 0xd0, 0xd7, 0x04, 0x18, 0x60, 0xcb, 0x87, 0xe2, 0x7e, 0x58,
+	0xa0, 0xf9, 0x77, 0x1c, 0xc4, 0x73, 0xed, 0x0c, 0x65, 0x7b, 0x2d, 0x3d, 0xff, 0x95, 0xbf, 0xff,
+	0xb7, 0x0f, 0x33, 0xf3, 0x68, 0x2e, 0x97, 0xf4, 0xfb, 0x13, 0xe8, 0xf7, 0x04, 0x38, 0xdc, 0xf0,
+	0x4b, 0x0c, 0x68, 0xb1, 0xf5, 0x34, 0x8d, 0xbf, 0xf7, 0x20, 0x5e, 0x4a, 0x05, 0xc3, 0x69, 0xcc,
+	0x51, 0x1a, 0x5f, 0x40, 0x67, 0x12, 0x69, 0xcc, 0x3d, 0xe1, 0xd2, 0xfa, 0x14, 0xfd, 0xb1, 0x00,
+	0x13, 0x4d, 0x3f, 0xdc, 0x80, 0x96, 0x92, 0xe6, 0x8e, 0xfb, 0x25, 0x08, 0xf1, 0x72, 0x4a, 0x28,
+	0x4e, 0xf3, 0x45, 0x4a, 0xf3, 0x8b, 0xe8, 0x85, 0x18, 0x9a, 0x3d, 0x1d, 0xa4, 0x7a, 0xf4, 0x11,
+	0xaa, 0x9b, 0xf2, 0x90, 0xc9, 0x54, 0xc7, 0xfd, 0xee, 0x82, 0x78, 0x39, 0x25, 0x54, 0x9b, 0x54,
+	0x37, 0xe7, 0x50, 0xd1, 0x77, 0x05, 0x18, 0x6f, 0x44, 0x88, 0x2e, 0xa5, 0x99, 0xde, 0xa5, 0x79,
+	0x29, 0x1d, 0x10, 0x27, 0xb9, 0x40, 0x49, 0x7e, 0x80, 0xd6, 0xdb, 0x26, 0x39, 0xf7, 0x24, 0xe4,
+	0xd7, 0x3e, 0x6d, 0x1e, 0x82, 0xbe, 0x21, 0xc0, 0x58, 0xb8, 0x14, 0x10, 0x5d, 0x4c, 0xa2, 0x2e,
+	0xf2, 0x77, 0x10, 0xc4, 0xc5, 0x34, 0x20, 0x9c, 0x9d, 0x05, 0xca, 0xce, 0x59, 0x74, 0x3a, 0x17,
+	0xfb, 0x5b, 0x2f, 0xc1, 0x1b, 0x1d, 0xfd, 0x87, 0x00, 0xf3, 0x2d, 0x9e, 0x86, 0xa3, 0x95, 0x24,
+	0x3a, 0xda, 0x7b, 0xe7, 0x2e, 0xae, 0x76, 0x85, 0x83, 0x33, 0x77, 0x8d, 0x32, 0xb7, 0x84, 0x16,
+	0x53, 0xec, 0x15, 0xb3, 0x31, 0x9e, 0xa2, 0xff, 0x12, 0x60, 0x2e, 0xf1, 0xc7, 0x09, 0xd0, 0x6b,
+	0x69, 0xe4, 0x27, 0xaa, 0x1c, 0x41, 0x5c, 0xee, 0x02, 0x03, 0x67, 0x71, 0x83, 0xb2, 0xf8, 0x06,
+	0xba, 0xd7, 0xb9, 0x38, 0xd2, 0x18, 0x93, 0xcf, 0xf8, 0x0f, 0x04, 0x38, 0x96, 0xf4, 0xab, 0x07,
+	0xe8, 0x56, 0x1a, 0xaa, 0x23, 0x7e, 0x7e, 0x41, 0x7c, 0xad, 0x73, 0x04, 0x9c, 0xeb, 0xd7, 0x29,
+	0xd7, 0xcb, 0xe8, 0x56, 0x97, 0x5c, 0xd3, 0x7b, 0xa6, 0xe1, 0xc5, 0x7f, 0xf2, 0x3d, 0x13, 0xfd,
+	0xeb, 0x01, 0xe2, 0xa5, 0x54, 0x30, 0x6d, 0xde, 0x33, 0x8a, 0x0b, 0xc7, 0x0d, 0x5f, 0xf4, 0x23,
+	0x01, 0x8e, 0x26, 0xbc, 0xe7, 0x47, 0x37, 0xd3, 0x2c, 0x6c, 0x84, 0x02, 0xb9, 0xd5, 0x31, 0x3c,
+	0xe7, 0xe8, 0x01, 0xe5, 0xe8, 0x75, 0x74, 0xa7, 0xf3, 0x7d, 0x09, 0x2a, 0x9b, 0x3f, 0x15, 0x60,
+	0x34, 0xa4, 0xb7, 0xd0, 0x4b, 0x6d, 0xab, 0x38, 0x97, 0xa7, 0x8b, 0x29, 0x20, 0x38, 0x17, 0xb7,
+	0x29, 0x17, 0x37, 0xd1, 0xab, 0xed, 0xe9, 0xc4, 0xdc, 0x93, 0x08, 0x73, 0xf8, 0x29, 0xfa, 0x67,
+	0x01, 0x66, 0x63, 0xdf, 0xd0, 0xa3, 0x57, 0xdb, 0xb9, 0xe6, 0xe3, 0x7e, 0x0a, 0x40, 0xbc, 0xd1,
+	0x21, 0x34, 0x67, 0x70, 0x99, 0x32, 0x78, 0x1d, 0xbd, 0xd2, 0xc2, 0x58, 0xb0, 0x73, 0x4f, 0xfc,
+	0x5f, 0x1c, 0x08, 0x6f, 0xcd, 0x7f, 0x0b, 0x30, 0x1b, 0xfb, 0x82, 0x3d, 0x99, 0xbb, 0x56, 0xaf,
+	0xf1, 0xc5, 0x1b, 0x1d, 0x42, 0x73, 0xee, 0xbe, 0x40, 0xb9, 0x7b, 0x0b, 0x3d, 0xee, 0x5c, 0x08,
+	0x79, 0xdc, 0x2a, 0xea, 0xf5, 0x3d, 0xfa, 0x4f, 0x01, 0x8e, 0xc4, 0xbc, 0x12, 0x42, 0xd7, 0x92,
+	0x28, 0x4f, 0x7e, 0xef, 0x25, 0x5e, 0xef, 0x08, 0x96, 0xf3, 0xfc, 0x0e, 0xe5, 0x79, 0x13, 0xe5,
+	0xbb, 0x11, 0xd9, 0x9c, 0xcd, 0x67, 0x09, 0x15, 0xe0, 0x11, 0xad, 0x33, 0xdf, 0xe2, 0x29, 0x50,
+	0xf2, 0x95, 0xdf, 0xde, 0x6b, 0x27, 0x71, 0xb5, 0x2b, 0x1c, 0x6d, 0x8a, 0xb6, 0x4d, 0xf0, 0x04,
+	0x92, 0xab, 0xcd, 0xcf, 0x10, 0xd0, 0xb7, 0x04, 0x18, 0x0b, 0x07, 0xe8, 0x93, 0x8d, 0xb1, 0xc8,
+	0x67, 0x45, 0xe2, 0x62, 0x1a, 0x10, 0x4e, 0xfc, 0x26, 0x25, 0xfe, 0x21, 0xba, 0xdf, 0xdd, 0x2e,
+	0x86, 0x13, 0x0f, 0xe8, 0xcf, 0x04, 0x98, 0x8c, 0x78, 0x42, 0x83, 0xae, 0xb4, 0x23, 0x70, 0xcd,
+	0xcf, 0x7a, 0xc4, 0xab, 0xa9, 0xe1, 0x38, 0x7b, 0x4b, 0x94, 0xbd, 0x05, 0x74, 0x3e, 0x6e, 0x6f,
+	0x5c, 0xf1, 0x0b, 0x26, 0xbf, 0xd0, 0x2f, 0x67, 0x82, 0xaf, 0x32, 0x23, 0x9f, 0xc9, 0x24, 0x8b,
+	0x5f, 0x7b, 0x2f, 0x7a, 0xc4, 0xd5, 0xae, 0x70, 0x70, 0x16, 0xdf, 0xa3, 0x2c, 0x6e, 0xa1, 0xcd,
+	0xf6, 0x76, 0x50, 0x2e, 0x92, 0xc0, 0x28, 0x47, 0xc5, 0x6f, 0xf9, 0xdc, 0x93, 0xc0, 0xc3, 0xa2,
+	0xa7, 0xb9, 0x27, 0xde, 0x2b, 0xa2, 0xa7, 0xe8, 0x2f, 0x05, 0x98, 0x8a, 0x7a, 0xb7, 0x82, 0xae,
+	0xb6, 0x73, 0x1f, 0x44, 0x3c, 0xee, 0x11, 0x5f, 0x4e, 0x0f, 0xc8, 0x39, 0xbd, 0x4c, 0x39, 0xcd,
+	0xa1, 0x0b, 0xad, 0x1c, 0x4e, 0x16, 0xa5, 0x97, 0xab, 0x8c, 0xd2, 0x7f, 0x11, 0x40, 0x8c, 0x7f,
+	0x7b, 0x80, 0x12, 0x55, 0x7f, 0xcb, 0x67, 0x12, 0xe2, 0xcd, 0x4e, 0xc1, 0x39, 0x53, 0xaf, 0x51,
+	0xa6, 0xae, 0xa1, 0x97, 0xdb, 0xdc, 0xbe, 0x0f, 0x34, 0xa7, 0x2a, 0x33, 0x95, 0xc2, 0x03, 0x17,
+	0xdf, 0x12, 0x60, 0x32, 0xe2, 0x4d, 0x40, 0xf2, 0x61, 0x8b, 0x7f, 0x8b, 0x20, 0x5e, 0x4d, 0x0d,
+	0xc7, 0x59, 0xb9, 0x43, 0x59, 0xb9, 0x85, 0x6e, 0x74, 0x63, 0x22, 0x9b, 0xe8, 0xaf, 0x05, 0x18,
+	0x6f, 0x2c, 0xd2, 0x4f, 0x76, 0xb7, 0x63, 0x9e, 0x08, 0x88, 0x4b, 0xe9, 0x80, 0x38, 0x1b, 0xf7,
+	0x28, 0x1b, 0x2b, 0xe8, 0xb5, 0xae, 0x54, 0x22, 0xe1, 0xe4, 0x0f, 0x33, 0x70, 0xba, 0xbd, 0xc2,
+	0x77, 0xb4, 0x96, 0xde, 0x2f, 0x8b, 0xa9, 0xe2, 0x17, 0xdf, 0xe8, 0x05, 0x2a, 0xbe, 0x16, 0x26,
+	0x5d, 0x8b, 0x2f, 0xa3, 0x6a, 0x97, 0x5e, 0x4f, 0x44, 0x95, 0x7d, 0x8c, 0x0d, 0xfb, 0x1d, 0x01,
+	0xb2, 0x71, 0x25, 0xf1, 0x28, 0xd1, 0x60, 0x69, 0x51, 0x89, 0x2f, 0xbe, 0xda, 0x19, 0x70, 0x9b,
+	0x8e, 0x3d, 0xcb, 0xc0, 0x07, 0xaf, 0x11, 0xdf, 0xbf, 0xfd, 0xb1, 0x00, 0x53, 0x51, 0xb5, 0xe9,
+	0xc9, 0x4a, 0x34, 0xa1, 0x2c, 0x5f, 0x7c, 0x39, 0x3d, 0x20, 0xe7, 0xc3, 0xa0, 0x7c, 0x68, 0xa8,
+	0xd2, 0xf9, 0x8e, 0xb6, 0x69, 0x13, 0x70, 0x1e, 0x7f, 0x22, 0x80, 0x18, 0x5f, 0x10, 0x9d, 0xac,
+	0x7e, 0x5b, 0x56, 0x68, 0x8b, 0x37, 0x3b, 0x05, 0xe7, 0xcb, 0x51, 0xa4, 0xcb, 0xf1, 0x1e, 0x7a,
+	0xa7, 0xab, 0xc3, 0xce, 0x2a, 0xa6, 0xe5, 0xe8, 0x9f, 0x9b, 0x20, 0xe6, 0xfb, 0x4c, 0x74, 0x55,
+	0x35, 0x7a, 0x25, 0xd9, 0xef, 0x48, 0x28, 0xef, 0x16, 0xaf, 0x75, 0x02, 0xda, 0xa6, 0xbf, 0xd2,
+	0x1e, 0xd7, 0x16, 0x9f, 0x24, 0x60, 0x4f, 0x98, 0x94, 0xab, 0xa0, 0xd1, 0x10, 0x2c, 0xb8, 0x6e,
+	0xcf, 0x68, 0x88, 0x28, 0xff, 0x16, 0x5f, 0x4e, 0x0f, 0x98, 0xd6, 0x68, 0x70, 0x33, 0xd8, 0x45,
+	0x4a, 0xe9, 0x8f, 0x05, 0x98, 0x8d, 0xad, 0x5a, 0x4d, 0x76, 0x36, 0x5b, 0x55, 0xd1, 0x8a, 0x37,
+	0x3a, 0x84, 0xe6, 0x1c, 0x7d, 0x89, 0x72, 0xf4, 0x0e, 0x7a, 0xbb, 0xab, 0xcd, 0xf3, 0xab, 0xe5,
+	0x7c, 0xcf, 0xc4, 0x65, 0xef, 0x9f, 0x04, 0x10, 0xe3, 0x4b, 0x2f, 0x51, 0x0b, 0x67, 0xb9, 0x45,
+	0x75, 0xa7, 0x78, 0xb3, 0x53, 0x70, 0xce, 0xff, 0xab, 0x94, 0xff, 0x2b, 0x68, 0x29, 0x86, 0x7f,
+	0xcb, 0x47, 0xe1, 0x9f, 0x43, 0xb7, 0x46, 0x14, 0x7d, 0x2c, 0xc0, 0x64, 0x44, 0xc5, 0x63, 0xb2,
+	0xb5, 0x14, 0x5f, 0xf2, 0x29, 0x5e, 0x4d, 0x0d, 0xc7, 0xd9, 0x78, 0x44, 0xd9, 0x58, 0x43, 0xaf,
+	0x77, 0xe7, 0x79, 0x11, 0xbc, 0xb2, 0x43, 0x38, 0xf8, 0x77, 0x01, 0xe6, 0x12, 0x4b, 0xf2, 0x92,
+	0xc3, 0xc7, 0xed, 0x54, 0x27, 0x8a, 0xcb, 0x5d, 0x60, 0xe0, 0x7c, 0xdf, 0xa2, 0x7c, 0xbf, 0x82,
+	0xae, 0xc6, 0xf0, 0x1d, 0x7a, 0x9c, 0xe7, 0x10, 0x3c, 0xb9, 0x50, 0x8d, 0x1f, 0x39, 0x9a, 0xc7,
+	0x93, 0xab, 0xf1, 0x50, 0xaa, 0x28, 0x77, 0x64, 0x6d, 0xa0, 0xb8, 0xd2, 0x0d, 0x0a, 0xce, 0xea,
+	0x9b, 0x94, 0xd5, 0x75, 0xb4, 0xd6, 0xf9, 0x5d, 0xeb, 0x09, 0xb0, 0xc6, 0x38, 0xfb, 0x5f, 0x01,
+	0x66, 0x63, 0x6b, 0xe3, 0x92, 0xf5, 0x52, 0xab, 0x4a, 0x3f, 0xf1, 0x46, 0x87, 0xd0, 0x9c, 0x5b,
+	0x85, 0x72, 0xfb, 0x2e, 0xfa, 0xb9, 0x5e, 0x5c, 0xa5, 0x8d, 0xbe, 0x1c, 0x95, 0x73, 0xf4, 0x3f,
+	0x02, 0x1c, 0x4d, 0x28, 0x7f, 0x43, 0x6d, 0x3a, 0x63, 0x71, 0x25, 0x79, 0xe2, 0xad, 0x8e, 0xe1,
+	0xf9, 0x1a, 0xbc, 0x4d, 0xd7, 0x20, 0x8f, 0x36, 0xba, 0x5a, 0x83, 0x88, 0xd2, 0x3d, 0x92, 0x84,
+	0x3c, 0xdc, 0x50, 0x9a, 0x95, 0x9c, 0x36, 0x88, 0x2e, 0x3e, 0x13, 0x2f, 0xa5, 0x82, 0xe1, 0x6c,
+	0x6d, 0x51, 0xb6, 0x36, 0xd0, 0xc3, 0xae, 0xd8, 0x0a, 0x5d, 0xb5, 0x72, 0xcd, 0xae, 0xa0, 0x3f,
+	0x11, 0x00, 0x35, 0xd7, 0x40, 0xa1, 0xcb, 0x2d, 0xc2, 0x72, 0xd1, 0x55, 0x55, 0xe2, 0x95, 0xb4,
+	0x60, 0x9c, 0xbb, 0x4b, 0x94, 0xbb, 0x0b, 0xe8, 0xc5, 0xf8, 0x00, 0x1e, 0x65, 0x26, 0x58, 0x8f,
+	0x45, 0xee, 0x91, 0x23, 0x31, 0xe5, 0x49, 0xc9, 0x31, 0xd9, 0xe4, 0x62, 0x2d, 0xf1, 0x7a, 0x47,
+	0xb0, 0x9c, 0x93, 0x55, 0xca, 0xc9, 0x0d, 0x74, 0xbd, 0xcd, 0x7d, 0xf2, 0xea, 0x65, 0xe5, 0x5d,
+	0x4d, 0xc9, 0x3d, 0x21, 0xa5, 0x3c, 0x4f, 0xd1, 0x0f, 0x03, 0xa5, 0x05, 0x5e, 0x45, 0x50, 0x7b,
+	0xa5, 0x05, 0x8d, 0x95, 0x4b, 0xe2, 0xe5, 0x94, 0x50, 0x9c, 0x8f, 0x2f, 0x53, 0x3e, 0x4a, 0xa8,
+	0xd8, 0x33, 0x79, 0x93, 0x59, 0xe1, 0x52, 0xee, 0x89, 0xd7, 0xc8, 0x35, 0x2c, 0xfa, 0x5b, 0x01,
+	0xc6, 0x1b, 0xeb, 0x84, 0x92, 0xc3, 0x0d, 0x31, 0x55, 0x4a, 0xe2, 0x52, 0x3a, 0x20, 0xce, 0xeb,
+	0x3a, 0xe5, 0xf5, 0x0e, 0x5a, 0xed, 0x2e, 0xdc, 0xc0, 0x0a, 0x4b, 0xee, 0x7f, 0xf4, 0xd9, 0x71,
+	0xe1, 0xdb, 0x9f, 0x1d, 0x17, 0xfe, 0xf5, 0xb3, 0xe3, 0xc2, 0xaf, 0x7d, 0x7e, 0xfc, 0xc0, 0xb7,
+	0x3f, 0x3f, 0x7e, 0xe0, 0x1f, 0x3f, 0x3f, 0x7e, 0xe0, 0x9d, 0x96, 0x45, 0x8d, 0x7b, 0xc1, 0x79,
+	0x69, 0x85, 0x63, 0x71, 0x80, 0xfe, 0x2b, 0x95, 0x4b, 0xff, 0x37, 0x00, 0x57, 0x87, 0xbe, 0xdf,
+	0xb8, 0x66, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ParamsByVersion queries the parameters of the module for a specific version of past params.
+	ParamsByVersion(ctx context.Context, in *QueryParamsByVersionRequest, opts ...grpc.CallOption) (*QueryParamsByVersionResponse, error)
+	// CovenantCommittee queries the covenant committee and quorum in the current parameters
+	CovenantCommittee(ctx context.Context, in *QueryCovenantCommitteeRequest, opts ...grpc.CallOption) (*QueryCovenantCommitteeResponse, error)
+	// FinalityProviders queries all finality providers
+	FinalityProviders(ctx context.Context, in *QueryFinalityProvidersRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersResponse, error)
+	// FinalityProvider info about one finality provider
+	FinalityProvider(ctx context.Context, in *QueryFinalityProviderRequest, opts ...grpc.CallOption) (*QueryFinalityProviderResponse, error)
+	// BTCDelegations queries all BTC delegations under a given status
+	BTCDelegations(ctx context.Context, in *QueryBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsResponse, error)
+	// ActiveFinalityProvidersAtHeight queries finality providers with non zero voting power at given height.
+	ActiveFinalityProvidersAtHeight(ctx context.Context, in *QueryActiveFinalityProvidersAtHeightRequest, opts ...grpc.CallOption) (*QueryActiveFinalityProvidersAtHeightResponse, error)
+	// FinalityProviderPowerAtHeight queries the voting power of a finality provider at a given height
+	FinalityProviderPowerAtHeight(ctx context.Context, in *QueryFinalityProviderPowerAtHeightRequest, opts ...grpc.CallOption) (*QueryFinalityProviderPowerAtHeightResponse, error)
+	// FinalityProviderCurrentPower queries the voting power of a finality provider at the current height
+	FinalityProviderCurrentPower(ctx context.Context, in *QueryFinalityProviderCurrentPowerRequest, opts ...grpc.CallOption) (*QueryFinalityProviderCurrentPowerResponse, error)
+	// ActivatedHeight queries the height when BTC staking protocol is activated, i.e., the first height when
+	// there exists 1 finality provider with voting power
+	ActivatedHeight(ctx context.Context, in *QueryActivatedHeightRequest, opts ...grpc.CallOption) (*QueryActivatedHeightResponse, error)
+	// FinalityProviderDelegations queries all BTC delegations of the given finality provider
+	FinalityProviderDelegations(ctx context.Context, in *QueryFinalityProviderDelegationsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderDelegationsResponse, error)
+	// BTCDelegation retrieves delegation by corresponding staking tx hash
+	BTCDelegation(ctx context.Context, in *QueryBTCDelegationRequest, opts ...grpc.CallOption) (*QueryBTCDelegationResponse, error)
+	// CovenantSignedDelegations queries all BTC delegations that the given covenant member has signed
+	CovenantSignedDelegations(ctx context.Context, in *QueryCovenantSignedDelegationsRequest, opts ...grpc.CallOption) (*QueryCovenantSignedDelegationsResponse, error)
+	// RewardEligibleDelegations queries the active BTC delegations of the given
+	// finality provider, split into those currently earning rewards and those
+	// still waiting to enter the voting power distribution
+	RewardEligibleDelegations(ctx context.Context, in *QueryRewardEligibleDelegationsRequest, opts ...grpc.CallOption) (*QueryRewardEligibleDelegationsResponse, error)
+	// SimulateBTCUndelegation validates an unbonding tx and its slashing tx
+	// against the staking output of a given BTC delegation, without submitting
+	// anything. It allows stakers to catch fee, timelock and script errors
+	// before committing the unbonding tx on Bitcoin
+	SimulateBTCUndelegation(ctx context.Context, in *QuerySimulateBTCUndelegationRequest, opts ...grpc.CallOption) (*QuerySimulateBTCUndelegationResponse, error)
+	// StaleCovenantPendingDelegations queries BTC delegations that have been
+	// waiting for a quorum of covenant signatures for more than a given number
+	// of Babylon blocks since their creation
+	StaleCovenantPendingDelegations(ctx context.Context, in *QueryStaleCovenantPendingDelegationsRequest, opts ...grpc.CallOption) (*QueryStaleCovenantPendingDelegationsResponse, error)
+	// SlashingAmount queries the amount of a BTC delegation that would be sent
+	// to the slashing address if the BTC delegation is slashed
+	SlashingAmount(ctx context.Context, in *QuerySlashingAmountRequest, opts ...grpc.CallOption) (*QuerySlashingAmountResponse, error)
+	// SimulateVotingPower queries the voting power that a hypothetical BTC
+	// delegation with the given amount and staking time would contribute at
+	// the current BTC tip
+	SimulateVotingPower(ctx context.Context, in *QuerySimulateVotingPowerRequest, opts ...grpc.CallOption) (*QuerySimulateVotingPowerResponse, error)
+	// BTCDelegationsByInclusionHeight queries all BTC delegations whose staking
+	// tx is included in a BTC block within the given height range. The range
+	// cannot contain more than MaxInclusionHeightRange BTC heights
+	BTCDelegationsByInclusionHeight(ctx context.Context, in *QueryBTCDelegationsByInclusionHeightRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsByInclusionHeightResponse, error)
+	// CovenantQuorumHealth queries a histogram of the number of covenant
+	// signatures that pending BTC delegations still miss to reach the quorum
+	CovenantQuorumHealth(ctx context.Context, in *QueryCovenantQuorumHealthRequest, opts ...grpc.CallOption) (*QueryCovenantQuorumHealthResponse, error)
+	// DelegationsWithStaleParams queries BTC delegations whose snapshotted
+	// parameters differ from the current parameters
+	DelegationsWithStaleParams(ctx context.Context, in *QueryDelegationsWithStaleParamsRequest, opts ...grpc.CallOption) (*QueryDelegationsWithStaleParamsResponse, error)
+	// FinalityProviderPoP queries the proof of possession of a finality provider
+	FinalityProviderPoP(ctx context.Context, in *QueryFinalityProviderPoPRequest, opts ...grpc.CallOption) (*QueryFinalityProviderPoPResponse, error)
+	// BTCDelegationPoP queries the proof of possession of a BTC delegation
+	BTCDelegationPoP(ctx context.Context, in *QueryBTCDelegationPoPRequest, opts ...grpc.CallOption) (*QueryBTCDelegationPoPResponse, error)
+	// FinalityProviderPowerAfterUndelegation queries the current voting power of
+	// a finality provider and the voting power it would have if the given BTC
+	// delegation unbonded
+	FinalityProviderPowerAfterUndelegation(ctx context.Context, in *QueryFinalityProviderPowerAfterUndelegationRequest, opts ...grpc.CallOption) (*QueryFinalityProviderPowerAfterUndelegationResponse, error)
+	// TotalVotingPowerAtHeight queries the total voting power of all active
+	// finality providers at the given Babylon height
+	TotalVotingPowerAtHeight(ctx context.Context, in *QueryTotalVotingPowerAtHeightRequest, opts ...grpc.CallOption) (*QueryTotalVotingPowerAtHeightResponse, error)
+	// BTCDelegationScripts queries the taproot scripts of a BTC delegation that
+	// a given finality provider participates in, together with the finality
+	// providers whose keys are embedded in the scripts
+	BTCDelegationScripts(ctx context.Context, in *QueryBTCDelegationScriptsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationScriptsResponse, error)
+	// VerifyDelegatorSlashingSig re-verifies the delegator's signature on the
+	// slashing tx of a BTC delegation against the slashing path script
+	// reconstructed from the BTC delegation
+	VerifyDelegatorSlashingSig(ctx context.Context, in *QueryVerifyDelegatorSlashingSigRequest, opts ...grpc.CallOption) (*QueryVerifyDelegatorSlashingSigResponse, error)
+	// ReverifyInclusionProof re-verifies the inclusion proof of the staking tx
+	// of a BTC delegation against the current BTC light client state. It fails
+	// with FailedPrecondition if the inclusion proof is not recorded
+	ReverifyInclusionProof(ctx context.Context, in *QueryReverifyInclusionProofRequest, opts ...grpc.CallOption) (*QueryReverifyInclusionProofResponse, error)
+	// CovenantSigningBatch queries the txs that covenant members sign and the
+	// script paths they sign against for a batch of BTC delegations
+	CovenantSigningBatch(ctx context.Context, in *QueryCovenantSigningBatchRequest, opts ...grpc.CallOption) (*QueryCovenantSigningBatchResponse, error)
+	// UnbondingCovenantProgress queries how many covenant signatures on the
+	// unbonding tx and the unbonding slashing tx of a BTC delegation have been
+	// collected, compared with the covenant quorum
+	UnbondingCovenantProgress(ctx context.Context, in *QueryUnbondingCovenantProgressRequest, opts ...grpc.CallOption) (*QueryUnbondingCovenantProgressResponse, error)
+	// RecommendedSlashingFeeRate queries the governance-set fee rate
+	// recommended for broadcasting slashing and unbonding txs
+	RecommendedSlashingFeeRate(ctx context.Context, in *QueryRecommendedSlashingFeeRateRequest, opts ...grpc.CallOption) (*QueryRecommendedSlashingFeeRateResponse, error)
+	// DelegationSpendTree queries the full taproot script tree of the staking
+	// output of a BTC delegation, such that a wallet holding the delegator's
+	// key can construct any valid spend of the staking output
+	DelegationSpendTree(ctx context.Context, in *QueryDelegationSpendTreeRequest, opts ...grpc.CallOption) (*QueryDelegationSpendTreeResponse, error)
+	// VotingPowerTableDiscrepancies recomputes the latest voting power table
+	// from scratch over all BTC delegations, and reports where the stored
+	// voting power table differs from it
+	VotingPowerTableDiscrepancies(ctx context.Context, in *QueryVotingPowerTableDiscrepanciesRequest, opts ...grpc.CallOption) (*QueryVotingPowerTableDiscrepanciesResponse, error)
+	// FinalityProviderSlashingImpact queries the BTC delegations that would be
+	// slashed if a given finality provider is slashed, together with their
+	// total stake and the total amount that would be sent to the slashing
+	// addresses
+	FinalityProviderSlashingImpact(ctx context.Context, in *QueryFinalityProviderSlashingImpactRequest, opts ...grpc.CallOption) (*QueryFinalityProviderSlashingImpactResponse, error)
+	// VerifyCovenantQuorumSpend queries whether a valid spend of the staking
+	// output of a BTC delegation via the given covenant path can currently be
+	// constructed from the stored signatures, by assembling its witness and
+	// executing it against the taproot leaf. The slashing path additionally
+	// needs the secret key of a restaked finality provider to decrypt the
+	// covenant adaptor signatures, so it is spendable once the finality
+	// provider is slashed, whose secret key is extractable from the evidence
+	VerifyCovenantQuorumSpend(ctx context.Context, in *QueryVerifyCovenantQuorumSpendRequest, opts ...grpc.CallOption) (*QueryVerifyCovenantQuorumSpendResponse, error)
+	// DelegationFirstRewardHeight queries the Babylon height at which a given
+	// BTC delegation will first earn a non-zero reward, combining its
+	// activation, the finalization of the activation block and the reward
+	// lockup of the incentive module
+	DelegationFirstRewardHeight(ctx context.Context, in *QueryDelegationFirstRewardHeightRequest, opts ...grpc.CallOption) (*QueryDelegationFirstRewardHeightResponse, error)
+	// CovenantSignMsg queries the exact message that a covenant member has to
+	// sign for a BTC delegation via the given covenant path, i.e., the tapscript
+	// sighash of the spending tx, together with the leaf and the position of
+	// the covenant member's key in it
+	CovenantSignMsg(ctx context.Context, in *QueryCovenantSignMsgRequest, opts ...grpc.CallOption) (*QueryCovenantSignMsgResponse, error)
+	// StakingInternalKey queries the taproot internal key of the staking,
+	// unbonding and slashing change outputs of BTC delegations, with which the
+	// taproot output keys can be reconstructed from the script trees
+	StakingInternalKey(ctx context.Context, in *QueryStakingInternalKeyRequest, opts ...grpc.CallOption) (*QueryStakingInternalKeyResponse, error)
+	// DelegationsSpendableVia queries the BTC delegations whose staking output
+	// can currently be spent via the given spend path, given the collected
+	// signatures and the BTC tip
+	DelegationsSpendableVia(ctx context.Context, in *QueryDelegationsSpendableViaRequest, opts ...grpc.CallOption) (*QueryDelegationsSpendableViaResponse, error)
+	// CovenantSigNeeded queries whether a covenant member still needs to sign a
+	// BTC delegation, and the covenant paths still missing its signatures
+	CovenantSigNeeded(ctx context.Context, in *QueryCovenantSigNeededRequest, opts ...grpc.CallOption) (*QueryCovenantSigNeededResponse, error)
+	// DelegationParams queries the parameters that a BTC delegation was created
+	// under, which are the ones for reconstructing its scripts and validating
+	// its spends
+	DelegationParams(ctx context.Context, in *QueryDelegationParamsRequest, opts ...grpc.CallOption) (*QueryDelegationParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParamsByVersion(ctx context.Context, in *QueryParamsByVersionRequest, opts ...grpc.CallOption) (*QueryParamsByVersionResponse, error) {
+	out := new(QueryParamsByVersionResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ParamsByVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CovenantCommittee(ctx context.Context, in *QueryCovenantCommitteeRequest, opts ...grpc.CallOption) (*QueryCovenantCommitteeResponse, error) {
+	out := new(QueryCovenantCommitteeResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/CovenantCommittee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinalityProviders(ctx context.Context, in *QueryFinalityProvidersRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersResponse, error) {
+	out := new(QueryFinalityProvidersResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinalityProvider(ctx context.Context, in *QueryFinalityProviderRequest, opts ...grpc.CallOption) (*QueryFinalityProviderResponse, error) {
+	out := new(QueryFinalityProviderResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BTCDelegations(ctx context.Context, in *QueryBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsResponse, error) {
+	out := new(QueryBTCDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ActiveFinalityProvidersAtHeight(ctx context.Context, in *QueryActiveFinalityProvidersAtHeightRequest, opts ...grpc.CallOption) (*QueryActiveFinalityProvidersAtHeightResponse, error) {
+	out := new(QueryActiveFinalityProvidersAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ActiveFinalityProvidersAtHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinalityProviderPowerAtHeight(ctx context.Context, in *QueryFinalityProviderPowerAtHeightRequest, opts ...grpc.CallOption) (*QueryFinalityProviderPowerAtHeightResponse, error) {
+	out := new(QueryFinalityProviderPowerAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderPowerAtHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinalityProviderCurrentPower(ctx context.Context, in *QueryFinalityProviderCurrentPowerRequest, opts ...grpc.CallOption) (*QueryFinalityProviderCurrentPowerResponse, error) {
+	out := new(QueryFinalityProviderCurrentPowerResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderCurrentPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ActivatedHeight(ctx context.Context, in *QueryActivatedHeightRequest, opts ...grpc.CallOption) (*QueryActivatedHeightResponse, error) {
+	out := new(QueryActivatedHeightResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ActivatedHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinalityProviderDelegations(ctx context.Context, in *QueryFinalityProviderDelegationsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderDelegationsResponse, error) {
+	out := new(QueryFinalityProviderDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BTCDelegation(ctx context.Context, in *QueryBTCDelegationRequest, opts ...grpc.CallOption) (*QueryBTCDelegationResponse, error) {
+	out := new(QueryBTCDelegationResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CovenantSignedDelegations(ctx context.Context, in *QueryCovenantSignedDelegationsRequest, opts ...grpc.CallOption) (*QueryCovenantSignedDelegationsResponse, error) {
+	out := new(QueryCovenantSignedDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/CovenantSignedDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardEligibleDelegations(ctx context.Context, in *QueryRewardEligibleDelegationsRequest, opts ...grpc.CallOption) (*QueryRewardEligibleDelegationsResponse, error) {
+	out := new(QueryRewardEligibleDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/RewardEligibleDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateBTCUndelegation(ctx context.Context, in *QuerySimulateBTCUndelegationRequest, opts ...grpc.CallOption) (*QuerySimulateBTCUndelegationResponse, error) {
+	out := new(QuerySimulateBTCUndelegationResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/SimulateBTCUndelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StaleCovenantPendingDelegations(ctx context.Context, in *QueryStaleCovenantPendingDelegationsRequest, opts ...grpc.CallOption) (*QueryStaleCovenantPendingDelegationsResponse, error) {
+	out := new(QueryStaleCovenantPendingDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/StaleCovenantPendingDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SlashingAmount(ctx context.Context, in *QuerySlashingAmountRequest, opts ...grpc.CallOption) (*QuerySlashingAmountResponse, error) {
+	out := new(QuerySlashingAmountResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/SlashingAmount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateVotingPower(ctx context.Context, in *QuerySimulateVotingPowerRequest, opts ...grpc.CallOption) (*QuerySimulateVotingPowerResponse, error) {
+	out := new(QuerySimulateVotingPowerResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/SimulateVotingPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BTCDelegationsByInclusionHeight(ctx context.Context, in *QueryBTCDelegationsByInclusionHeightRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsByInclusionHeightResponse, error) {
+	out := new(QueryBTCDelegationsByInclusionHeightResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegationsByInclusionHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CovenantQuorumHealth(ctx context.Context, in *QueryCovenantQuorumHealthRequest, opts ...grpc.CallOption) (*QueryCovenantQuorumHealthResponse, error) {
+	out := new(QueryCovenantQuorumHealthResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/CovenantQuorumHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegationsWithStaleParams(ctx context.Context, in *QueryDelegationsWithStaleParamsRequest, opts ...grpc.CallOption) (*QueryDelegationsWithStaleParamsResponse, error) {
+	out := new(QueryDelegationsWithStaleParamsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/DelegationsWithStaleParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinalityProviderPoP(ctx context.Context, in *QueryFinalityProviderPoPRequest, opts ...grpc.CallOption) (*QueryFinalityProviderPoPResponse, error) {
+	out := new(QueryFinalityProviderPoPResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderPoP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BTCDelegationPoP(ctx context.Context, in *QueryBTCDelegationPoPRequest, opts ...grpc.CallOption) (*QueryBTCDelegationPoPResponse, error) {
+	out := new(QueryBTCDelegationPoPResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegationPoP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinalityProviderPowerAfterUndelegation(ctx context.Context, in *QueryFinalityProviderPowerAfterUndelegationRequest, opts ...grpc.CallOption) (*QueryFinalityProviderPowerAfterUndelegationResponse, error) {
+	out := new(QueryFinalityProviderPowerAfterUndelegationResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderPowerAfterUndelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalVotingPowerAtHeight(ctx context.Context, in *QueryTotalVotingPowerAtHeightRequest, opts ...grpc.CallOption) (*QueryTotalVotingPowerAtHeightResponse, error) {
+	out := new(QueryTotalVotingPowerAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/TotalVotingPowerAtHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BTCDelegationScripts(ctx context.Context, in *QueryBTCDelegationScriptsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationScriptsResponse, error) {
+	out := new(QueryBTCDelegationScriptsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegationScripts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyDelegatorSlashingSig(ctx context.Context, in *QueryVerifyDelegatorSlashingSigRequest, opts ...grpc.CallOption) (*QueryVerifyDelegatorSlashingSigResponse, error) {
+	out := new(QueryVerifyDelegatorSlashingSigResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/VerifyDelegatorSlashingSig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReverifyInclusionProof(ctx context.Context, in *QueryReverifyInclusionProofRequest, opts ...grpc.CallOption) (*QueryReverifyInclusionProofResponse, error) {
+	out := new(QueryReverifyInclusionProofResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ReverifyInclusionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CovenantSigningBatch(ctx context.Context, in *QueryCovenantSigningBatchRequest, opts ...grpc.CallOption) (*QueryCovenantSigningBatchResponse, error) {
+	out := new(QueryCovenantSigningBatchResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/CovenantSigningBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnbondingCovenantProgress(ctx context.Context, in *QueryUnbondingCovenantProgressRequest, opts ...grpc.CallOption) (*QueryUnbondingCovenantProgressResponse, error) {
+	out := new(QueryUnbondingCovenantProgressResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/UnbondingCovenantProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecommendedSlashingFeeRate(ctx context.Context, in *QueryRecommendedSlashingFeeRateRequest, opts ...grpc.CallOption) (*QueryRecommendedSlashingFeeRateResponse, error) {
+	out := new(QueryRecommendedSlashingFeeRateResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/RecommendedSlashingFeeRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegationSpendTree(ctx context.Context, in *QueryDelegationSpendTreeRequest, opts ...grpc.CallOption) (*QueryDelegationSpendTreeResponse, error) {
+	out := new(QueryDelegationSpendTreeResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/DelegationSpendTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VotingPowerTableDiscrepancies(ctx context.Context, in *QueryVotingPowerTableDiscrepanciesRequest, opts ...grpc.CallOption) (*QueryVotingPowerTableDiscrepanciesResponse, error) {
+	out := new(QueryVotingPowerTableDiscrepanciesResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/VotingPowerTableDiscrepancies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinalityProviderSlashingImpact(ctx context.Context, in *QueryFinalityProviderSlashingImpactRequest, opts ...grpc.CallOption) (*QueryFinalityProviderSlashingImpactResponse, error) {
+	out := new(QueryFinalityProviderSlashingImpactResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderSlashingImpact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyCovenantQuorumSpend(ctx context.Context, in *QueryVerifyCovenantQuorumSpendRequest, opts ...grpc.CallOption) (*QueryVerifyCovenantQuorumSpendResponse, error) {
+	out := new(QueryVerifyCovenantQuorumSpendResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/VerifyCovenantQuorumSpend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegationFirstRewardHeight(ctx context.Context, in *QueryDelegationFirstRewardHeightRequest, opts ...grpc.CallOption) (*QueryDelegationFirstRewardHeightResponse, error) {
+	out := new(QueryDelegationFirstRewardHeightResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/DelegationFirstRewardHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CovenantSignMsg(ctx context.Context, in *QueryCovenantSignMsgRequest, opts ...grpc.CallOption) (*QueryCovenantSignMsgResponse, error) {
+	out := new(QueryCovenantSignMsgResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/CovenantSignMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StakingInternalKey(ctx context.Context, in *QueryStakingInternalKeyRequest, opts ...grpc.CallOption) (*QueryStakingInternalKeyResponse, error) {
+	out := new(QueryStakingInternalKeyResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/StakingInternalKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegationsSpendableVia(ctx context.Context, in *QueryDelegationsSpendableViaRequest, opts ...grpc.CallOption) (*QueryDelegationsSpendableViaResponse, error) {
+	out := new(QueryDelegationsSpendableViaResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/DelegationsSpendableVia", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CovenantSigNeeded(ctx context.Context, in *QueryCovenantSigNeededRequest, opts ...grpc.CallOption) (*QueryCovenantSigNeededResponse, error) {
+	out := new(QueryCovenantSigNeededResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/CovenantSigNeeded", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegationParams(ctx context.Context, in *QueryDelegationParamsRequest, opts ...grpc.CallOption) (*QueryDelegationParamsResponse, error) {
+	out := new(QueryDelegationParamsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/DelegationParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ParamsByVersion queries the parameters of the module for a specific version of past params.
+	ParamsByVersion(context.Context, *QueryParamsByVersionRequest) (*QueryParamsByVersionResponse, error)
+	// CovenantCommittee queries the covenant committee and quorum in the current parameters
+	CovenantCommittee(context.Context, *QueryCovenantCommitteeRequest) (*QueryCovenantCommitteeResponse, error)
+	// FinalityProviders queries all finality providers
+	FinalityProviders(context.Context, *QueryFinalityProvidersRequest) (*QueryFinalityProvidersResponse, error)
+	// FinalityProvider info about one finality provider
+	FinalityProvider(context.Context, *QueryFinalityProviderRequest) (*QueryFinalityProviderResponse, error)
+	// BTCDelegations queries all BTC delegations under a given status
+	BTCDelegations(context.Context, *QueryBTCDelegationsRequest) (*QueryBTCDelegationsResponse, error)
+	// ActiveFinalityProvidersAtHeight queries finality providers with non zero voting power at given height.
+	ActiveFinalityProvidersAtHeight(context.Context, *QueryActiveFinalityProvidersAtHeightRequest) (*QueryActiveFinalityProvidersAtHeightResponse, error)
+	// FinalityProviderPowerAtHeight queries the voting power of a finality provider at a given height
+	FinalityProviderPowerAtHeight(context.Context, *QueryFinalityProviderPowerAtHeightRequest) (*QueryFinalityProviderPowerAtHeightResponse, error)
+	// FinalityProviderCurrentPower queries the voting power of a finality provider at the current height
+	FinalityProviderCurrentPower(context.Context, *QueryFinalityProviderCurrentPowerRequest) (*QueryFinalityProviderCurrentPowerResponse, error)
+	// ActivatedHeight queries the height when BTC staking protocol is activated, i.e., the first height when
+	// there exists 1 finality provider with voting power
+	ActivatedHeight(context.Context, *QueryActivatedHeightRequest) (*QueryActivatedHeightResponse, error)
+	// FinalityProviderDelegations queries all BTC delegations of the given finality provider
+	FinalityProviderDelegations(context.Context, *QueryFinalityProviderDelegationsRequest) (*QueryFinalityProviderDelegationsResponse, error)
+	// BTCDelegation retrieves delegation by corresponding staking tx hash
+	BTCDelegation(context.Context, *QueryBTCDelegationRequest) (*QueryBTCDelegationResponse, error)
+	// CovenantSignedDelegations queries all BTC delegations that the given covenant member has signed
+	CovenantSignedDelegations(context.Context, *QueryCovenantSignedDelegationsRequest) (*QueryCovenantSignedDelegationsResponse, error)
+	// RewardEligibleDelegations queries the active BTC delegations of the given
+	// finality provider, split into those currently earning rewards and those
+	// still waiting to enter the voting power distribution
+	RewardEligibleDelegations(context.Context, *QueryRewardEligibleDelegationsRequest) (*QueryRewardEligibleDelegationsResponse, error)
+	// SimulateBTCUndelegation validates an unbonding tx and its slashing tx
+	// against the staking output of a given BTC delegation, without submitting
+	// anything. It allows stakers to catch fee, timelock and script errors
+	// before committing the unbonding tx on Bitcoin
+	SimulateBTCUndelegation(context.Context, *QuerySimulateBTCUndelegationRequest) (*QuerySimulateBTCUndelegationResponse, error)
+	// StaleCovenantPendingDelegations queries BTC delegations that have been
+	// waiting for a quorum of covenant signatures for more than a given number
+	// of Babylon blocks since their creation
+	StaleCovenantPendingDelegations(context.Context, *QueryStaleCovenantPendingDelegationsRequest) (*QueryStaleCovenantPendingDelegationsResponse, error)
+	// SlashingAmount queries the amount of a BTC delegation that would be sent
+	// to the slashing address if the BTC delegation is slashed
+	SlashingAmount(context.Context, *QuerySlashingAmountRequest) (*QuerySlashingAmountResponse, error)
+	// SimulateVotingPower queries the voting power that a hypothetical BTC
+	// delegation with the given amount and staking time would contribute at
+	// the current BTC tip
+	SimulateVotingPower(context.Context, *QuerySimulateVotingPowerRequest) (*QuerySimulateVotingPowerResponse, error)
+	// BTCDelegationsByInclusionHeight queries all BTC delegations whose staking
+	// tx is included in a BTC block within the given height range. The range
+	// cannot contain more than MaxInclusionHeightRange BTC heights
+	BTCDelegationsByInclusionHeight(context.Context, *QueryBTCDelegationsByInclusionHeightRequest) (*QueryBTCDelegationsByInclusionHeightResponse, error)
+	// CovenantQuorumHealth queries a histogram of the number of covenant
+	// signatures that pending BTC delegations still miss to reach the quorum
+	CovenantQuorumHealth(context.Context, *QueryCovenantQuorumHealthRequest) (*QueryCovenantQuorumHealthResponse, error)
+	// DelegationsWithStaleParams queries BTC delegations whose snapshotted
+	// parameters differ from the current parameters
+	DelegationsWithStaleParams(context.Context, *QueryDelegationsWithStaleParamsRequest) (*QueryDelegationsWithStaleParamsResponse, error)
+	// FinalityProviderPoP queries the proof of possession of a finality provider
+	FinalityProviderPoP(context.Context, *QueryFinalityProviderPoPRequest) (*QueryFinalityProviderPoPResponse, error)
+	// BTCDelegationPoP queries the proof of possession of a BTC delegation
+	BTCDelegationPoP(context.Context, *QueryBTCDelegationPoPRequest) (*QueryBTCDelegationPoPResponse, error)
+	// FinalityProviderPowerAfterUndelegation queries the current voting power of
+	// a finality provider and the voting power it would have if the given BTC
+	// delegation unbonded
+	FinalityProviderPowerAfterUndelegation(context.Context, *QueryFinalityProviderPowerAfterUndelegationRequest) (*QueryFinalityProviderPowerAfterUndelegationResponse, error)
+	// TotalVotingPowerAtHeight queries the total voting power of all active
+	// finality providers at the given Babylon height
+	TotalVotingPowerAtHeight(context.Context, *QueryTotalVotingPowerAtHeightRequest) (*QueryTotalVotingPowerAtHeightResponse, error)
+	// BTCDelegationScripts queries the taproot scripts of a BTC delegation that
+	// a given finality provider participates in, together with the finality
+	// providers whose keys are embedded in the scripts
+	BTCDelegationScripts(context.Context, *QueryBTCDelegationScriptsRequest) (*QueryBTCDelegationScriptsResponse, error)
+	// VerifyDelegatorSlashingSig re-verifies the delegator's signature on the
+	// slashing tx of a BTC delegation against the slashing path script
+	// reconstructed from the BTC delegation
+	VerifyDelegatorSlashingSig(context.Context, *QueryVerifyDelegatorSlashingSigRequest) (*QueryVerifyDelegatorSlashingSigResponse, error)
+	// ReverifyInclusionProof re-verifies the inclusion proof of the staking tx
+	// of a BTC delegation against the current BTC light client state. It fails
+	// with FailedPrecondition if the inclusion proof is not recorded
+	ReverifyInclusionProof(context.Context, *QueryReverifyInclusionProofRequest) (*QueryReverifyInclusionProofResponse, error)
+	// CovenantSigningBatch queries the txs that covenant members sign and the
+	// script paths they sign against for a batch of BTC delegations
+	CovenantSigningBatch(context.Context, *QueryCovenantSigningBatchRequest) (*QueryCovenantSigningBatchResponse, error)
+	// UnbondingCovenantProgress queries how many covenant signatures on the
+	// unbonding tx and the unbonding slashing tx of a BTC delegation have been
+	// collected, compared with the covenant quorum
+	UnbondingCovenantProgress(context.Context, *QueryUnbondingCovenantProgressRequest) (*QueryUnbondingCovenantProgressResponse, error)
+	// RecommendedSlashingFeeRate queries the governance-set fee rate
+	// recommended for broadcasting slashing and unbonding txs
+	RecommendedSlashingFeeRate(context.Context, *QueryRecommendedSlashingFeeRateRequest) (*QueryRecommendedSlashingFeeRateResponse, error)
+	// DelegationSpendTree queries the full taproot script tree of the staking
+	// output of a BTC delegation, such that a wallet holding the delegator's
+	// key can construct any valid spend of the staking output
+	DelegationSpendTree(context.Context, *QueryDelegationSpendTreeRequest) (*QueryDelegationSpendTreeResponse, error)
+	// VotingPowerTableDiscrepancies recomputes the latest voting power table
+	// from scratch over all BTC delegations, and reports where the stored
+	// voting power table differs from it
+	VotingPowerTableDiscrepancies(context.Context, *QueryVotingPowerTableDiscrepanciesRequest) (*QueryVotingPowerTableDiscrepanciesResponse, error)
+	// FinalityProviderSlashingImpact queries the BTC delegations that would be
+	// slashed if a given finality provider is slashed, together with their
+	// total stake and the total amount that would be sent to the slashing
+	// addresses
+	FinalityProviderSlashingImpact(context.Context, *QueryFinalityProviderSlashingImpactRequest) (*QueryFinalityProviderSlashingImpactResponse, error)
+	// VerifyCovenantQuorumSpend queries whether a valid spend of the staking
+	// output of a BTC delegation via the given covenant path can currently be
+	// constructed from the stored signatures, by assembling its witness and
+	// executing it against the taproot leaf. The slashing path additionally
+	// needs the secret key of a restaked finality provider to decrypt the
+	// covenant adaptor signatures, so it is spendable once the finality
+	// provider is slashed, whose secret key is extractable from the evidence
+	VerifyCovenantQuorumSpend(context.Context, *QueryVerifyCovenantQuorumSpendRequest) (*QueryVerifyCovenantQuorumSpendResponse, error)
+	// DelegationFirstRewardHeight queries the Babylon height at which a given
+	// BTC delegation will first earn a non-zero reward, combining its
+	// activation, the finalization of the activation block and the reward
+	// lockup of the incentive module
+	DelegationFirstRewardHeight(context.Context, *QueryDelegationFirstRewardHeightRequest) (*QueryDelegationFirstRewardHeightResponse, error)
+	// CovenantSignMsg queries the exact message that a covenant member has to
+	// sign for a BTC delegation via the given covenant path, i.e., the tapscript
+	// sighash of the spending tx, together with the leaf and the position of
+	// the covenant member's key in it
+	CovenantSignMsg(context.Context, *QueryCovenantSignMsgRequest) (*QueryCovenantSignMsgResponse, error)
+	// StakingInternalKey queries the taproot internal key of the staking,
+	// unbonding and slashing change outputs of BTC delegations, with which the
+	// taproot output keys can be reconstructed from the script trees
+	StakingInternalKey(context.Context, *QueryStakingInternalKeyRequest) (*QueryStakingInternalKeyResponse, error)
+	// DelegationsSpendableVia queries the BTC delegations whose staking output
+	// can currently be spent via the given spend path, given the collected
+	// signatures and the BTC tip
+	DelegationsSpendableVia(context.Context, *QueryDelegationsSpendableViaRequest) (*QueryDelegationsSpendableViaResponse, error)
+	// CovenantSigNeeded queries whether a covenant member still needs to sign a
+	// BTC delegation, and the covenant paths still missing its signatures
+	CovenantSigNeeded(context.Context, *QueryCovenantSigNeededRequest) (*QueryCovenantSigNeededResponse, error)
+	// DelegationParams queries the parameters that a BTC delegation was created
+	// under, which are the ones for reconstructing its scripts and validating
+	// its spends
+	DelegationParams(context.Context, *QueryDelegationParamsRequest) (*QueryDelegationParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ParamsByVersion(ctx context.Context, req *QueryParamsByVersionRequest) (*QueryParamsByVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsByVersion not implemented")
+}
+func (*UnimplementedQueryServer) CovenantCommittee(ctx context.Context, req *QueryCovenantCommitteeRequest) (*QueryCovenantCommitteeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CovenantCommittee not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviders(ctx context.Context, req *QueryFinalityProvidersRequest) (*QueryFinalityProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviders not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProvider(ctx context.Context, req *QueryFinalityProviderRequest) (*QueryFinalityProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProvider not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegations(ctx context.Context, req *QueryBTCDelegationsRequest) (*QueryBTCDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegations not implemented")
+}
+func (*UnimplementedQueryServer) ActiveFinalityProvidersAtHeight(ctx context.Context, req *QueryActiveFinalityProvidersAtHeightRequest) (*QueryActiveFinalityProvidersAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveFinalityProvidersAtHeight not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviderPowerAtHeight(ctx context.Context, req *QueryFinalityProviderPowerAtHeightRequest) (*QueryFinalityProviderPowerAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderPowerAtHeight not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviderCurrentPower(ctx context.Context, req *QueryFinalityProviderCurrentPowerRequest) (*QueryFinalityProviderCurrentPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderCurrentPower not implemented")
+}
+func (*UnimplementedQueryServer) ActivatedHeight(ctx context.Context, req *QueryActivatedHeightRequest) (*QueryActivatedHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivatedHeight not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviderDelegations(ctx context.Context, req *QueryFinalityProviderDelegationsRequest) (*QueryFinalityProviderDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderDelegations not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegation(ctx context.Context, req *QueryBTCDelegationRequest) (*QueryBTCDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegation not implemented")
+}
+func (*UnimplementedQueryServer) CovenantSignedDelegations(ctx context.Context, req *QueryCovenantSignedDelegationsRequest) (*QueryCovenantSignedDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CovenantSignedDelegations not implemented")
+}
+func (*UnimplementedQueryServer) RewardEligibleDelegations(ctx context.Context, req *QueryRewardEligibleDelegationsRequest) (*QueryRewardEligibleDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardEligibleDelegations not implemented")
+}
+func (*UnimplementedQueryServer) SimulateBTCUndelegation(ctx context.Context, req *QuerySimulateBTCUndelegationRequest) (*QuerySimulateBTCUndelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateBTCUndelegation not implemented")
+}
+func (*UnimplementedQueryServer) StaleCovenantPendingDelegations(ctx context.Context, req *QueryStaleCovenantPendingDelegationsRequest) (*QueryStaleCovenantPendingDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StaleCovenantPendingDelegations not implemented")
+}
+func (*UnimplementedQueryServer) SlashingAmount(ctx context.Context, req *QuerySlashingAmountRequest) (*QuerySlashingAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashingAmount not implemented")
+}
+func (*UnimplementedQueryServer) SimulateVotingPower(ctx context.Context, req *QuerySimulateVotingPowerRequest) (*QuerySimulateVotingPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateVotingPower not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegationsByInclusionHeight(ctx context.Context, req *QueryBTCDelegationsByInclusionHeightRequest) (*QueryBTCDelegationsByInclusionHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegationsByInclusionHeight not implemented")
+}
+func (*UnimplementedQueryServer) CovenantQuorumHealth(ctx context.Context, req *QueryCovenantQuorumHealthRequest) (*QueryCovenantQuorumHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CovenantQuorumHealth not implemented")
+}
+func (*UnimplementedQueryServer) DelegationsWithStaleParams(ctx context.Context, req *QueryDelegationsWithStaleParamsRequest) (*QueryDelegationsWithStaleParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationsWithStaleParams not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviderPoP(ctx context.Context, req *QueryFinalityProviderPoPRequest) (*QueryFinalityProviderPoPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderPoP not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegationPoP(ctx context.Context, req *QueryBTCDelegationPoPRequest) (*QueryBTCDelegationPoPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegationPoP not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviderPowerAfterUndelegation(ctx context.Context, req *QueryFinalityProviderPowerAfterUndelegationRequest) (*QueryFinalityProviderPowerAfterUndelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderPowerAfterUndelegation not implemented")
+}
+func (*UnimplementedQueryServer) TotalVotingPowerAtHeight(ctx context.Context, req *QueryTotalVotingPowerAtHeightRequest) (*QueryTotalVotingPowerAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalVotingPowerAtHeight not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegationScripts(ctx context.Context, req *QueryBTCDelegationScriptsRequest) (*QueryBTCDelegationScriptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegationScripts not implemented")
+}
+func (*UnimplementedQueryServer) VerifyDelegatorSlashingSig(ctx context.Context, req *QueryVerifyDelegatorSlashingSigRequest) (*QueryVerifyDelegatorSlashingSigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyDelegatorSlashingSig not implemented")
+}
+func (*UnimplementedQueryServer) ReverifyInclusionProof(ctx context.Context, req *QueryReverifyInclusionProofRequest) (*QueryReverifyInclusionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverifyInclusionProof not implemented")
+}
+func (*UnimplementedQueryServer) CovenantSigningBatch(ctx context.Context, req *QueryCovenantSigningBatchRequest) (*QueryCovenantSigningBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CovenantSigningBatch not implemented")
+}
+func (*UnimplementedQueryServer) UnbondingCovenantProgress(ctx context.Context, req *QueryUnbondingCovenantProgressRequest) (*QueryUnbondingCovenantProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingCovenantProgress not implemented")
+}
+func (*UnimplementedQueryServer) RecommendedSlashingFeeRate(ctx context.Context, req *QueryRecommendedSlashingFeeRateRequest) (*QueryRecommendedSlashingFeeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecommendedSlashingFeeRate not implemented")
+}
+func (*UnimplementedQueryServer) DelegationSpendTree(ctx context.Context, req *QueryDelegationSpendTreeRequest) (*QueryDelegationSpendTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationSpendTree not implemented")
+}
+func (*UnimplementedQueryServer) VotingPowerTableDiscrepancies(ctx context.Context, req *QueryVotingPowerTableDiscrepanciesRequest) (*QueryVotingPowerTableDiscrepanciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotingPowerTableDiscrepancies not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviderSlashingImpact(ctx context.Context, req *QueryFinalityProviderSlashingImpactRequest) (*QueryFinalityProviderSlashingImpactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderSlashingImpact not implemented")
+}
+func (*UnimplementedQueryServer) VerifyCovenantQuorumSpend(ctx context.Context, req *QueryVerifyCovenantQuorumSpendRequest) (*QueryVerifyCovenantQuorumSpendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCovenantQuorumSpend not implemented")
+}
+func (*UnimplementedQueryServer) DelegationFirstRewardHeight(ctx context.Context, req *QueryDelegationFirstRewardHeightRequest) (*QueryDelegationFirstRewardHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationFirstRewardHeight not implemented")
+}
+func (*UnimplementedQueryServer) CovenantSignMsg(ctx context.Context, req *QueryCovenantSignMsgRequest) (*QueryCovenantSignMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CovenantSignMsg not implemented")
+}
+func (*UnimplementedQueryServer) StakingInternalKey(ctx context.Context, req *QueryStakingInternalKeyRequest) (*QueryStakingInternalKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakingInternalKey not implemented")
+}
+func (*UnimplementedQueryServer) DelegationsSpendableVia(ctx context.Context, req *QueryDelegationsSpendableViaRequest) (*QueryDelegationsSpendableViaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationsSpendableVia not implemented")
+}
+func (*UnimplementedQueryServer) CovenantSigNeeded(ctx context.Context, req *QueryCovenantSigNeededRequest) (*QueryCovenantSigNeededResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CovenantSigNeeded not implemented")
+}
+func (*UnimplementedQueryServer) DelegationParams(ctx context.Context, req *QueryDelegationParamsRequest) (*QueryDelegationParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationParams not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParamsByVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsByVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsByVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/ParamsByVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsByVersion(ctx, req.(*QueryParamsByVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CovenantCommittee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCovenantCommitteeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CovenantCommittee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/CovenantCommittee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CovenantCommittee(ctx, req.(*QueryCovenantCommitteeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviders(ctx, req.(*QueryFinalityProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProvider(ctx, req.(*QueryFinalityProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegations(ctx, req.(*QueryBTCDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActiveFinalityProvidersAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveFinalityProvidersAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveFinalityProvidersAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/ActiveFinalityProvidersAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveFinalityProvidersAtHeight(ctx, req.(*QueryActiveFinalityProvidersAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityProviderPowerAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderPowerAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviderPowerAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderPowerAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviderPowerAtHeight(ctx, req.(*QueryFinalityProviderPowerAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityProviderCurrentPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderCurrentPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviderCurrentPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderCurrentPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviderCurrentPower(ctx, req.(*QueryFinalityProviderCurrentPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActivatedHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActivatedHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActivatedHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/ActivatedHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActivatedHeight(ctx, req.(*QueryActivatedHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityProviderDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviderDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviderDelegations(ctx, req.(*QueryFinalityProviderDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegation(ctx, req.(*QueryBTCDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CovenantSignedDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCovenantSignedDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CovenantSignedDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/CovenantSignedDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CovenantSignedDelegations(ctx, req.(*QueryCovenantSignedDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardEligibleDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardEligibleDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardEligibleDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/RewardEligibleDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardEligibleDelegations(ctx, req.(*QueryRewardEligibleDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateBTCUndelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateBTCUndelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateBTCUndelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/SimulateBTCUndelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateBTCUndelegation(ctx, req.(*QuerySimulateBTCUndelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StaleCovenantPendingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStaleCovenantPendingDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StaleCovenantPendingDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/StaleCovenantPendingDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StaleCovenantPendingDelegations(ctx, req.(*QueryStaleCovenantPendingDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlashingAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashingAmountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashingAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/SlashingAmount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashingAmount(ctx, req.(*QuerySlashingAmountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateVotingPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateVotingPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateVotingPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/SimulateVotingPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateVotingPower(ctx, req.(*QuerySimulateVotingPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCDelegationsByInclusionHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationsByInclusionHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegationsByInclusionHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegationsByInclusionHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegationsByInclusionHeight(ctx, req.(*QueryBTCDelegationsByInclusionHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CovenantQuorumHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCovenantQuorumHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CovenantQuorumHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/CovenantQuorumHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CovenantQuorumHealth(ctx, req.(*QueryCovenantQuorumHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegationsWithStaleParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationsWithStaleParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationsWithStaleParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/DelegationsWithStaleParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationsWithStaleParams(ctx, req.(*QueryDelegationsWithStaleParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityProviderPoP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderPoPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviderPoP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderPoP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviderPoP(ctx, req.(*QueryFinalityProviderPoPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCDelegationPoP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationPoPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegationPoP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegationPoP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegationPoP(ctx, req.(*QueryBTCDelegationPoPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityProviderPowerAfterUndelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderPowerAfterUndelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviderPowerAfterUndelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderPowerAfterUndelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviderPowerAfterUndelegation(ctx, req.(*QueryFinalityProviderPowerAfterUndelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalVotingPowerAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalVotingPowerAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalVotingPowerAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/TotalVotingPowerAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalVotingPowerAtHeight(ctx, req.(*QueryTotalVotingPowerAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCDelegationScripts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationScriptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegationScripts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegationScripts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegationScripts(ctx, req.(*QueryBTCDelegationScriptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyDelegatorSlashingSig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyDelegatorSlashingSigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyDelegatorSlashingSig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/VerifyDelegatorSlashingSig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyDelegatorSlashingSig(ctx, req.(*QueryVerifyDelegatorSlashingSigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReverifyInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReverifyInclusionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReverifyInclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/ReverifyInclusionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReverifyInclusionProof(ctx, req.(*QueryReverifyInclusionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CovenantSigningBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCovenantSigningBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CovenantSigningBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/CovenantSigningBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CovenantSigningBatch(ctx, req.(*QueryCovenantSigningBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondingCovenantProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingCovenantProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingCovenantProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/UnbondingCovenantProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingCovenantProgress(ctx, req.(*QueryUnbondingCovenantProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecommendedSlashingFeeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecommendedSlashingFeeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecommendedSlashingFeeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/RecommendedSlashingFeeRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecommendedSlashingFeeRate(ctx, req.(*QueryRecommendedSlashingFeeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegationSpendTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationSpendTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationSpendTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/DelegationSpendTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationSpendTree(ctx, req.(*QueryDelegationSpendTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VotingPowerTableDiscrepancies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotingPowerTableDiscrepanciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotingPowerTableDiscrepancies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/VotingPowerTableDiscrepancies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotingPowerTableDiscrepancies(ctx, req.(*QueryVotingPowerTableDiscrepanciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityProviderSlashingImpact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderSlashingImpactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviderSlashingImpact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderSlashingImpact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviderSlashingImpact(ctx, req.(*QueryFinalityProviderSlashingImpactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyCovenantQuorumSpend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyCovenantQuorumSpendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyCovenantQuorumSpend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/VerifyCovenantQuorumSpend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyCovenantQuorumSpend(ctx, req.(*QueryVerifyCovenantQuorumSpendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegationFirstRewardHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationFirstRewardHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationFirstRewardHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/DelegationFirstRewardHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationFirstRewardHeight(ctx, req.(*QueryDelegationFirstRewardHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CovenantSignMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCovenantSignMsgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CovenantSignMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/CovenantSignMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CovenantSignMsg(ctx, req.(*QueryCovenantSignMsgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StakingInternalKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakingInternalKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StakingInternalKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/StakingInternalKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StakingInternalKey(ctx, req.(*QueryStakingInternalKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegationsSpendableVia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationsSpendableViaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationsSpendableVia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/DelegationsSpendableVia",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationsSpendableVia(ctx, req.(*QueryDelegationsSpendableViaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CovenantSigNeeded_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCovenantSigNeededRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CovenantSigNeeded(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/CovenantSigNeeded",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CovenantSigNeeded(ctx, req.(*QueryCovenantSigNeededRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegationParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/DelegationParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationParams(ctx, req.(*QueryDelegationParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylon.btcstaking.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ParamsByVersion",
+			Handler:    _Query_ParamsByVersion_Handler,
+		},
+		{
+			MethodName: "CovenantCommittee",
+			Handler:    _Query_CovenantCommittee_Handler,
+		},
+		{
+			MethodName: "FinalityProviders",
+			Handler:    _Query_FinalityProviders_Handler,
+		},
+		{
+			MethodName: "FinalityProvider",
+			Handler:    _Query_FinalityProvider_Handler,
+		},
+		{
+			MethodName: "BTCDelegations",
+			Handler:    _Query_BTCDelegations_Handler,
+		},
+		{
+			MethodName: "ActiveFinalityProvidersAtHeight",
+			Handler:    _Query_ActiveFinalityProvidersAtHeight_Handler,
+		},
+		{
+			MethodName: "FinalityProviderPowerAtHeight",
+			Handler:    _Query_FinalityProviderPowerAtHeight_Handler,
+		},
+		{
+			MethodName: "FinalityProviderCurrentPower",
+			Handler:    _Query_FinalityProviderCurrentPower_Handler,
+		},
+		{
+			MethodName: "ActivatedHeight",
+			Handler:    _Query_ActivatedHeight_Handler,
+		},
+		{
+			MethodName: "FinalityProviderDelegations",
+			Handler:    _Query_FinalityProviderDelegations_Handler,
+		},
+		{
+			MethodName: "BTCDelegation",
+			Handler:    _Query_BTCDelegation_Handler,
+		},
+		{
+			MethodName: "CovenantSignedDelegations",
+			Handler:    _Query_CovenantSignedDelegations_Handler,
+		},
+		{
+			MethodName: "RewardEligibleDelegations",
+			Handler:    _Query_RewardEligibleDelegations_Handler,
+		},
+		{
+			MethodName: "SimulateBTCUndelegation",
+			Handler:    _Query_SimulateBTCUndelegation_Handler,
+		},
+		{
+			MethodName: "StaleCovenantPendingDelegations",
+			Handler:    _Query_StaleCovenantPendingDelegations_Handler,
+		},
+		{
+			MethodName: "SlashingAmount",
+			Handler:    _Query_SlashingAmount_Handler,
+		},
+		{
+			MethodName: "SimulateVotingPower",
+			Handler:    _Query_SimulateVotingPower_Handler,
+		},
+		{
+			MethodName: "BTCDelegationsByInclusionHeight",
+			Handler:    _Query_BTCDelegationsByInclusionHeight_Handler,
+		},
+		{
+			MethodName: "CovenantQuorumHealth",
+			Handler:    _Query_CovenantQuorumHealth_Handler,
+		},
+		{
+			MethodName: "DelegationsWithStaleParams",
+			Handler:    _Query_DelegationsWithStaleParams_Handler,
+		},
+		{
+			MethodName: "FinalityProviderPoP",
+			Handler:    _Query_FinalityProviderPoP_Handler,
+		},
+		{
+			MethodName: "BTCDelegationPoP",
+			Handler:    _Query_BTCDelegationPoP_Handler,
+		},
+		{
+			MethodName: "FinalityProviderPowerAfterUndelegation",
+			Handler:    _Query_FinalityProviderPowerAfterUndelegation_Handler,
+		},
+		{
+			MethodName: "TotalVotingPowerAtHeight",
+			Handler:    _Query_TotalVotingPowerAtHeight_Handler,
+		},
+		{
+			MethodName: "BTCDelegationScripts",
+			Handler:    _Query_BTCDelegationScripts_Handler,
+		},
+		{
+			MethodName: "VerifyDelegatorSlashingSig",
+			Handler:    _Query_VerifyDelegatorSlashingSig_Handler,
+		},
+		{
+			MethodName: "ReverifyInclusionProof",
+			Handler:    _Query_ReverifyInclusionProof_Handler,
+		},
+		{
+			MethodName: "CovenantSigningBatch",
+			Handler:    _Query_CovenantSigningBatch_Handler,
+		},
+		{
+			MethodName: "UnbondingCovenantProgress",
+			Handler:    _Query_UnbondingCovenantProgress_Handler,
+		},
+		{
+			MethodName: "RecommendedSlashingFeeRate",
+			Handler:    _Query_RecommendedSlashingFeeRate_Handler,
+		},
+		{
+			MethodName: "DelegationSpendTree",
+			Handler:    _Query_DelegationSpendTree_Handler,
+		},
+		{
+			MethodName: "VotingPowerTableDiscrepancies",
+			Handler:    _Query_VotingPowerTableDiscrepancies_Handler,
+		},
+		{
+			MethodName: "FinalityProviderSlashingImpact",
+			Handler:    _Query_FinalityProviderSlashingImpact_Handler,
+		},
+		{
+			MethodName: "VerifyCovenantQuorumSpend",
+			Handler:    _Query_VerifyCovenantQuorumSpend_Handler,
+		},
+		{
+			MethodName: "DelegationFirstRewardHeight",
+			Handler:    _Query_DelegationFirstRewardHeight_Handler,
+		},
+		{
+			MethodName: "CovenantSignMsg",
+			Handler:    _Query_CovenantSignMsg_Handler,
+		},
+		{
+			MethodName: "StakingInternalKey",
+			Handler:    _Query_StakingInternalKey_Handler,
+		},
+		{
+			MethodName: "DelegationsSpendableVia",
+			Handler:    _Query_DelegationsSpendableVia_Handler,
+		},
+		{
+			MethodName: "CovenantSigNeeded",
+			Handler:    _Query_CovenantSigNeeded_Handler,
+		},
+		{
+			MethodName: "DelegationParams",
+			Handler:    _Query_DelegationParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "babylon/btcstaking/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsByVersionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsByVersionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsByVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsByVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsByVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsByVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantCommitteeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantCommitteeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantCommitteeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantCommitteeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantCommitteeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantCommitteeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ParamsVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CovenantQuorum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CovenantQuorum))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CovenantPks) > 0 {
+		for iNdEx := len(m.CovenantPks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantPks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CovenantPkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CovenantPkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CovenantPkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CompressedPkHex) > 0 {
+		i -= len(m.CompressedPkHex)
+		copy(dAtA[i:], m.CompressedPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CompressedPkHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bip340PkHex) > 0 {
+		i -= len(m.Bip340PkHex)
+		copy(dAtA[i:], m.Bip340PkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bip340PkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProvidersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FinalityProviders) > 0 {
+		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinalityProvider != nil {
+		{
+			size, err := m.FinalityProvider.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderPowerAtHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderPowerAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderPowerAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderPowerAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderPowerAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderPowerAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderCurrentPowerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderCurrentPowerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderCurrentPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderCurrentPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderCurrentPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderCurrentPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveFinalityProvidersAtHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveFinalityProvidersAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveFinalityProvidersAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveFinalityProvidersAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveFinalityProvidersAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveFinalityProvidersAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FinalityProviders) > 0 {
+		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActivatedHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActivatedHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActivatedHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActivatedHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActivatedHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActivatedHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcDelegatorDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegatorDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegatorDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BtcDelegation != nil {
+		{
+			size, err := m.BtcDelegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantSignedDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantSignedDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantSignedDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CovPkHex) > 0 {
+		i -= len(m.CovPkHex)
+		copy(dAtA[i:], m.CovPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CovPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantSignedDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantSignedDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantSignedDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardEligibleDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardEligibleDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardEligibleDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardEligibleDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardEligibleDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardEligibleDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.FpActive {
+		i--
+		if m.FpActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CheckpointFinalizationTimeout != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CheckpointFinalizationTimeout))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BtcConfirmationDepth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BtcConfirmationDepth))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BtcTipHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BtcTipHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DistCacheHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DistCacheHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PendingDelegations) > 0 {
+		for iNdEx := len(m.PendingDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.EligibleDelegations) > 0 {
+		for iNdEx := len(m.EligibleDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EligibleDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FpSlashedBtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FpSlashedBtcHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.FpSlashedBeforeActivation {
+		i--
+		if m.FpSlashedBeforeActivation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.Reserved {
+		i--
+		if m.Reserved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.SlashedBtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBtcHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.Invalidated {
+		i--
+		if m.Invalidated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.Pop != nil {
+		{
+			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.BabylonPk != nil {
+		{
+			size, err := m.BabylonPk.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.RewardOptOut {
+		i--
+		if m.RewardOptOut {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.CheckpointFinalizationTimeout != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CheckpointFinalizationTimeout))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.BtcConfirmationDepth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BtcConfirmationDepth))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.CreatedBabylonHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreatedBabylonHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.ParamsVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.UndelegationResponse != nil {
+		{
+			size, err := m.UndelegationResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.UnbondingTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingTime))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.StatusDesc) > 0 {
+		i -= len(m.StatusDesc)
+		copy(dAtA[i:], m.StatusDesc)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StatusDesc)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.StakingOutputIdx != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StakingOutputIdx))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.CovenantSigs) > 0 {
+		for iNdEx := len(m.CovenantSigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.DelegatorSlashSigHex) > 0 {
+		i -= len(m.DelegatorSlashSigHex)
+		copy(dAtA[i:], m.DelegatorSlashSigHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashSigHex)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.SlashingTxHex) > 0 {
+		i -= len(m.SlashingTxHex)
+		copy(dAtA[i:], m.SlashingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.StakingTxHex) > 0 {
+		i -= len(m.StakingTxHex)
+		copy(dAtA[i:], m.StakingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHex)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TotalSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSat))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for iNdEx := len(m.FpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.FpBtcPkList[iNdEx].Size()
+				i -= size
+				if _, err := m.FpBtcPkList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCUndelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCUndelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCUndelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CovenantSlashingSigs) > 0 {
+		for iNdEx := len(m.CovenantSlashingSigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantSlashingSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DelegatorSlashingSigHex) > 0 {
+		i -= len(m.DelegatorSlashingSigHex)
+		copy(dAtA[i:], m.DelegatorSlashingSigHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashingSigHex)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SlashingTxHex) > 0 {
+		i -= len(m.SlashingTxHex)
+		copy(dAtA[i:], m.SlashingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CovenantUnbondingSigList) > 0 {
+		for iNdEx := len(m.CovenantUnbondingSigList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantUnbondingSigList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DelegatorUnbondingSigHex) > 0 {
+		i -= len(m.DelegatorUnbondingSigHex)
+		copy(dAtA[i:], m.DelegatorUnbondingSigHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorUnbondingSigHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UnbondingTxHex) > 0 {
+		i -= len(m.UnbondingTxHex)
+		copy(dAtA[i:], m.UnbondingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnbondingTxHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCDelegatorDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCDelegatorDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCDelegatorDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Dels) > 0 {
+		for iNdEx := len(m.Dels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateBTCUndelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateBTCUndelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateBTCUndelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DelegatorUnbondingSlashingSig != nil {
+		{
+			size := m.DelegatorUnbondingSlashingSig.Size()
+			i -= size
+			if _, err := m.DelegatorUnbondingSlashingSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.UnbondingSlashingTx != nil {
+		{
+			size := m.UnbondingSlashingTx.Size()
+			i -= size
+			if _, err := m.UnbondingSlashingTx.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.UnbondingTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UnbondingValue != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingValue))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.UnbondingTx) > 0 {
+		i -= len(m.UnbondingTx)
+		copy(dAtA[i:], m.UnbondingTx)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnbondingTx)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateBTCUndelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateBTCUndelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateBTCUndelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InvalidReason) > 0 {
+		i -= len(m.InvalidReason)
+		copy(dAtA[i:], m.InvalidReason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvalidReason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStaleCovenantPendingDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStaleCovenantPendingDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStaleCovenantPendingDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MaxBlocksPending != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxBlocksPending))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStaleCovenantPendingDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStaleCovenantPendingDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStaleCovenantPendingDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashingAmountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashingAmountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashingAmountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashingAmountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashingAmountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashingAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondingSlashingAmount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingSlashingAmount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SlashingAmount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashingAmount))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.SlashingRate.Size()
+		i -= size
+		if _, err := m.SlashingRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.SlashingAddress) > 0 {
+		i -= len(m.SlashingAddress)
+		copy(dAtA[i:], m.SlashingAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateVotingPowerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateVotingPowerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateVotingPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StakingTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StakingTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TotalSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSat))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateVotingPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateVotingPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateVotingPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BtcTipHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BtcTipHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.VotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationsByInclusionHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationsByInclusionHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationsByInclusionHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationsByInclusionHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationsByInclusionHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationsByInclusionHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BtcDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantQuorumHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantQuorumHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantQuorumHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantQuorumHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantQuorumHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantQuorumHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.NumPendingDelegations != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumPendingDelegations))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CovenantQuorum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CovenantQuorum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CovenantQuorumHealthBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CovenantQuorumHealthBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CovenantQuorumHealthBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumDelegations != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumDelegations))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MissingSigs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissingSigs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalityProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SlashedBtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBtcHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SlashedBabylonHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBabylonHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Pop != nil {
+		{
+			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BabylonPk != nil {
+		{
+			size, err := m.BabylonPk.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Commission != nil {
+		{
+			size := m.Commission.Size()
+			i -= size
+			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Description != nil {
+		{
+			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationsWithStaleParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationsWithStaleParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationsWithStaleParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationsWithStaleParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationsWithStaleParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationsWithStaleParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BtcDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.CurrentParamsVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentParamsVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderPoPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderPoPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderPoPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderPoPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderPoPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderPoPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pop != nil {
+		{
+			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BabylonPk != nil {
+		{
+			size, err := m.BabylonPk.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationPoPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationPoPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationPoPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationPoPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationPoPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationPoPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pop != nil {
+		{
+			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BabylonPk != nil {
+		{
+			size, err := m.BabylonPk.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderPowerAfterUndelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderPowerAfterUndelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderPowerAfterUndelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderPowerAfterUndelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderPowerAfterUndelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderPowerAfterUndelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotingPowerAfterUndelegation != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPowerAfterUndelegation))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DelegationVotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DelegationVotingPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.VotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalVotingPowerAtHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalVotingPowerAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalVotingPowerAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalVotingPowerAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalVotingPowerAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalVotingPowerAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumFinalityProviders != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumFinalityProviders))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.VotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationScriptsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationScriptsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationScriptsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaprootScriptPath) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaprootScriptPath) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaprootScriptPath) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ControlBlock) > 0 {
+		i -= len(m.ControlBlock)
+		copy(dAtA[i:], m.ControlBlock)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ControlBlock)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Script) > 0 {
+		i -= len(m.Script)
+		copy(dAtA[i:], m.Script)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Script)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationScriptsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationScriptsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationScriptsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondingSlashingPath != nil {
+		{
+			size, err := m.UnbondingSlashingPath.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.UnbondingOutputPkScript) > 0 {
+		i -= len(m.UnbondingOutputPkScript)
+		copy(dAtA[i:], m.UnbondingOutputPkScript)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnbondingOutputPkScript)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.StakingUnbondingPath != nil {
+		{
+			size, err := m.StakingUnbondingPath.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.StakingSlashingPath != nil {
+		{
+			size, err := m.StakingSlashingPath.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StakingOutputPkScript) > 0 {
+		i -= len(m.StakingOutputPkScript)
+		copy(dAtA[i:], m.StakingOutputPkScript)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingOutputPkScript)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FpIdx != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FpIdx))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for iNdEx := len(m.FpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.FpBtcPkList[iNdEx].Size()
+				i -= size
+				if _, err := m.FpBtcPkList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyDelegatorSlashingSigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyDelegatorSlashingSigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyDelegatorSlashingSigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyDelegatorSlashingSigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyDelegatorSlashingSigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyDelegatorSlashingSigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InvalidReason) > 0 {
+		i -= len(m.InvalidReason)
+		copy(dAtA[i:], m.InvalidReason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvalidReason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReverifyInclusionProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReverifyInclusionProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReverifyInclusionProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReverifyInclusionProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReverifyInclusionProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReverifyInclusionProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Depth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.InvalidReason) > 0 {
+		i -= len(m.InvalidReason)
+		copy(dAtA[i:], m.InvalidReason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvalidReason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantSigningBatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantSigningBatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantSigningBatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHexList) > 0 {
+		for iNdEx := len(m.StakingTxHashHexList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StakingTxHashHexList[iNdEx])
+			copy(dAtA[i:], m.StakingTxHashHexList[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHexList[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantSigningBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantSigningBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantSigningBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SigningInfos) > 0 {
+		for iNdEx := len(m.SigningInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CovenantSigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CovenantSigningInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CovenantSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnbondingSlashingPath != nil {
+		{
+			size, err := m.UnbondingSlashingPath.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.UnbondingSlashingTx != nil {
+		{
+			size := m.UnbondingSlashingTx.Size()
+			i -= size
+			if _, err := m.UnbondingSlashingTx.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.StakingUnbondingPath != nil {
+		{
+			size, err := m.StakingUnbondingPath.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.UnbondingTx) > 0 {
+		i -= len(m.UnbondingTx)
+		copy(dAtA[i:], m.UnbondingTx)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnbondingTx)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.StakingSlashingPath != nil {
+		{
+			size, err := m.StakingSlashingPath.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.SlashingTx != nil {
+		{
+			size := m.SlashingTx.Size()
+			i -= size
+			if _, err := m.SlashingTx.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.StakingOutputIdx != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StakingOutputIdx))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.StakingTx) > 0 {
+		i -= len(m.StakingTx)
+		copy(dAtA[i:], m.StakingTx)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTx)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for iNdEx := len(m.FpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.FpBtcPkList[iNdEx].Size()
+				i -= size
+				if _, err := m.FpBtcPkList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingCovenantProgressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingCovenantProgressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingCovenantProgressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingCovenantProgressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingCovenantProgressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingCovenantProgressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingCovenantPks) > 0 {
+		for iNdEx := len(m.PendingCovenantPks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.PendingCovenantPks[iNdEx].Size()
+				i -= size
+				if _, err := m.PendingCovenantPks[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.HasCovenantQuorums {
+		i--
+		if m.HasCovenantQuorums {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NumCovenantUnbondingSlashingSigs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumCovenantUnbondingSlashingSigs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NumCovenantUnbondingSigs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumCovenantUnbondingSigs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CovenantQuorum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CovenantQuorum))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DelegatorUnbondingSigSubmitted {
+		i--
+		if m.DelegatorUnbondingSigSubmitted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecommendedSlashingFeeRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecommendedSlashingFeeRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecommendedSlashingFeeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecommendedSlashingFeeRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecommendedSlashingFeeRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecommendedSlashingFeeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinSlashingTxFeeSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinSlashingTxFeeSat))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FeeRateSatPerVbyte != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FeeRateSatPerVbyte))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationSpendTreeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationSpendTreeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationSpendTreeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaprootTreeLeaf) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaprootTreeLeaf) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaprootTreeLeaf) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScriptPath != nil {
+		{
+			size, err := m.ScriptPath.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LeafIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LeafIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationSpendTreeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationSpendTreeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationSpendTreeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SlashingLeaf != nil {
+		{
+			size, err := m.SlashingLeaf.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.UnbondingLeaf != nil {
+		{
+			size, err := m.UnbondingLeaf.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TimelockLeaf != nil {
+		{
+			size, err := m.TimelockLeaf.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StakingOutputPkScript) > 0 {
+		i -= len(m.StakingOutputPkScript)
+		copy(dAtA[i:], m.StakingOutputPkScript)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingOutputPkScript)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InternalKey) > 0 {
+		i -= len(m.InternalKey)
+		copy(dAtA[i:], m.InternalKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InternalKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotingPowerTableDiscrepanciesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingPowerTableDiscrepanciesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingPowerTableDiscrepanciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *VotingPowerDiscrepancy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotingPowerDiscrepancy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotingPowerDiscrepancy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpectedVotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpectedVotingPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StoredVotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StoredVotingPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotingPowerTableDiscrepanciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingPowerTableDiscrepanciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingPowerTableDiscrepanciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Discrepancies) > 0 {
+		for iNdEx := len(m.Discrepancies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Discrepancies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderSlashingImpactRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderSlashingImpactRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderSlashingImpactRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderSlashingImpactResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderSlashingImpactResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderSlashingImpactResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalSlashingAmount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSlashingAmount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSat))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BtcDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyCovenantQuorumSpendRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyCovenantQuorumSpendRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyCovenantQuorumSpendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Path != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Path))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyCovenantQuorumSpendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyCovenantQuorumSpendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyCovenantQuorumSpendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NumValidCovenantSigs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumValidCovenantSigs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CovenantQuorum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CovenantQuorum))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Spendable {
+		i--
+		if m.Spendable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationFirstRewardHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationFirstRewardHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationFirstRewardHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationFirstRewardHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationFirstRewardHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationFirstRewardHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AwaitingCovenantQuorum {
+		i--
+		if m.AwaitingCovenantQuorum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Earning {
+		i--
+		if m.Earning {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RewardLockupEpochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RewardLockupEpochs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RewardStartEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RewardStartEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FinalizationHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FinalizationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlocksUntilFirstReward != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksUntilFirstReward))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FirstRewardHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FirstRewardHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantSignMsgRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantSignMsgRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantSignMsgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CovenantPkHex) > 0 {
+		i -= len(m.CovenantPkHex)
+		copy(dAtA[i:], m.CovenantPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CovenantPkHex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Path != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Path))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantSignMsgResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantSignMsgResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantSignMsgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EncKeyList) > 0 {
+		for iNdEx := len(m.EncKeyList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.EncKeyList[iNdEx].Size()
+				i -= size
+				if _, err := m.EncKeyList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.CovenantKeyIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CovenantKeyIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.LeafHash) > 0 {
+		i -= len(m.LeafHash)
+		copy(dAtA[i:], m.LeafHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LeafHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ScriptPath != nil {
+		{
+			size, err := m.ScriptPath.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SigningTx) > 0 {
+		i -= len(m.SigningTx)
+		copy(dAtA[i:], m.SigningTx)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SigningTx)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SigHash) > 0 {
+		i -= len(m.SigHash)
+		copy(dAtA[i:], m.SigHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SigHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingInternalKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingInternalKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingInternalKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingInternalKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingInternalKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingInternalKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InternalKey) > 0 {
+		i -= len(m.InternalKey)
+		copy(dAtA[i:], m.InternalKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InternalKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationsSpendableViaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationsSpendableViaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationsSpendableViaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BtcTipHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BtcTipHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Path != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Path))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationsSpendableViaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationsSpendableViaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationsSpendableViaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BtcTipHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BtcTipHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BtcDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantSigNeededRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantSigNeededRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantSigNeededRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CovenantPkHex) > 0 {
+		i -= len(m.CovenantPkHex)
+		copy(dAtA[i:], m.CovenantPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CovenantPkHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantSigNeededResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantSigNeededResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantSigNeededResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MissingPaths) > 0 {
+		dAtA45 := make([]byte, len(m.MissingPaths)*10)
+		var j44 int
+		for _, num := range m.MissingPaths {
+			for num >= 1<<7 {
+				dAtA45[j44] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j44++
+			}
+			dAtA45[j44] = uint8(num)
+			j44++
+		}
+		i -= j44
+		copy(dAtA[i:], dAtA45[:j44])
+		i = encodeVarintQuery(dAtA, i, uint64(j44))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Needed {
+		i--
+		if m.Needed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CheckpointFinalizationTimeout != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CheckpointFinalizationTimeout))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BtcConfirmationDepth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BtcConfirmationDepth))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ParamsVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsByVersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryParamsByVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCovenantCommitteeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCovenantCommitteeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CovenantPks) > 0 {
+		for _, e := range m.CovenantPks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CovenantQuorum != 0 {
+		n += 1 + sovQuery(uint64(m.CovenantQuorum))
+	}
+	if m.ParamsVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ParamsVersion))
+	}
+	return n
+}
+
+func (m *CovenantPkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bip340PkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CompressedPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProvidersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FinalityProviders) > 0 {
+		for _, e := range m.FinalityProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FinalityProvider != nil {
+		l = m.FinalityProvider.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderPowerAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderPowerAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderCurrentPowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderCurrentPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	return n
+}
+
+func (m *QueryActiveFinalityProvidersAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActiveFinalityProvidersAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FinalityProviders) > 0 {
+		for _, e := range m.FinalityProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActivatedHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryActivatedHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegatorDelegations) > 0 {
+		for _, e := range m.BtcDelegatorDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcDelegation != nil {
+		l = m.BtcDelegation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCovenantSignedDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CovPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCovenantSignedDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardEligibleDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardEligibleDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EligibleDelegations) > 0 {
+		for _, e := range m.EligibleDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PendingDelegations) > 0 {
+		for _, e := range m.PendingDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.DistCacheHeight != 0 {
+		n += 1 + sovQuery(uint64(m.DistCacheHeight))
+	}
+	if m.BtcTipHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BtcTipHeight))
+	}
+	if m.BtcConfirmationDepth != 0 {
+		n += 1 + sovQuery(uint64(m.BtcConfirmationDepth))
+	}
+	if m.CheckpointFinalizationTimeout != 0 {
+		n += 1 + sovQuery(uint64(m.CheckpointFinalizationTimeout))
+	}
+	if m.FpActive {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BTCDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for _, e := range m.FpBtcPkList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.TotalSat != 0 {
+		n += 1 + sovQuery(uint64(m.TotalSat))
+	}
+	l = len(m.StakingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SlashingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DelegatorSlashSigHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CovenantSigs) > 0 {
+		for _, e := range m.CovenantSigs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.StakingOutputIdx != 0 {
+		n += 1 + sovQuery(uint64(m.StakingOutputIdx))
+	}
+	if m.Active {
+		n += 2
+	}
+	l = len(m.StatusDesc)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UnbondingTime != 0 {
+		n += 1 + sovQuery(uint64(m.UnbondingTime))
+	}
+	if m.UndelegationResponse != nil {
+		l = m.UndelegationResponse.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ParamsVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ParamsVersion))
+	}
+	if m.CreatedBabylonHeight != 0 {
+		n += 2 + sovQuery(uint64(m.CreatedBabylonHeight))
+	}
+	if m.BtcConfirmationDepth != 0 {
+		n += 2 + sovQuery(uint64(m.BtcConfirmationDepth))
+	}
+	if m.CheckpointFinalizationTimeout != 0 {
+		n += 2 + sovQuery(uint64(m.CheckpointFinalizationTimeout))
+	}
+	if m.RewardOptOut {
+		n += 3
+	}
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 2 + sovQuery(uint64(m.Status))
+	}
+	if m.BabylonPk != nil {
+		l = m.BabylonPk.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Pop != nil {
+		l = m.Pop.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Invalidated {
+		n += 3
+	}
+	if m.SlashedBtcHeight != 0 {
+		n += 2 + sovQuery(uint64(m.SlashedBtcHeight))
+	}
+	if m.Reserved {
+		n += 3
+	}
+	if m.FpSlashedBeforeActivation {
+		n += 3
+	}
+	if m.FpSlashedBtcHeight != 0 {
+		n += 2 + sovQuery(uint64(m.FpSlashedBtcHeight))
+	}
+	return n
+}
+
+func (m *BTCUndelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UnbondingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DelegatorUnbondingSigHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CovenantUnbondingSigList) > 0 {
+		for _, e := range m.CovenantUnbondingSigList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.SlashingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DelegatorSlashingSigHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CovenantSlashingSigs) > 0 {
+		for _, e := range m.CovenantSlashingSigs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BTCDelegatorDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Dels) > 0 {
+		for _, e := range m.Dels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySimulateBTCUndelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UnbondingTx)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UnbondingValue != 0 {
+		n += 1 + sovQuery(uint64(m.UnbondingValue))
+	}
+	if m.UnbondingTime != 0 {
+		n += 1 + sovQuery(uint64(m.UnbondingTime))
+	}
+	if m.UnbondingSlashingTx != nil {
+		l = m.UnbondingSlashingTx.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DelegatorUnbondingSlashingSig != nil {
+		l = m.DelegatorUnbondingSlashingSig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateBTCUndelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.InvalidReason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStaleCovenantPendingDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxBlocksPending != 0 {
+		n += 1 + sovQuery(uint64(m.MaxBlocksPending))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStaleCovenantPendingDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashingAmountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashingAmountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SlashingAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.SlashingRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.SlashingAmount != 0 {
+		n += 1 + sovQuery(uint64(m.SlashingAmount))
+	}
+	if m.UnbondingSlashingAmount != 0 {
+		n += 1 + sovQuery(uint64(m.UnbondingSlashingAmount))
+	}
+	return n
+}
+
+func (m *QuerySimulateVotingPowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalSat != 0 {
+		n += 1 + sovQuery(uint64(m.TotalSat))
+	}
+	if m.StakingTime != 0 {
+		n += 1 + sovQuery(uint64(m.StakingTime))
+	}
+	return n
+}
+
+func (m *QuerySimulateVotingPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	if m.BtcTipHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BtcTipHeight))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationsByInclusionHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationsByInclusionHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCovenantQuorumHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCovenantQuorumHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CovenantQuorum != 0 {
+		n += 1 + sovQuery(uint64(m.CovenantQuorum))
+	}
+	if m.NumPendingDelegations != 0 {
+		n += 1 + sovQuery(uint64(m.NumPendingDelegations))
+	}
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CovenantQuorumHealthBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MissingSigs != 0 {
+		n += 1 + sovQuery(uint64(m.MissingSigs))
+	}
+	if m.NumDelegations != 0 {
+		n += 1 + sovQuery(uint64(m.NumDelegations))
+	}
+	return n
+}
+
+func (m *FinalityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Description != nil {
+		l = m.Description.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Commission != nil {
+		l = m.Commission.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BabylonPk != nil {
+		l = m.BabylonPk.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pop != nil {
+		l = m.Pop.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SlashedBabylonHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SlashedBabylonHeight))
+	}
+	if m.SlashedBtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SlashedBtcHeight))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	return n
+}
+
+func (m *QueryDelegationsWithStaleParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationsWithStaleParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentParamsVersion != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentParamsVersion))
+	}
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderPoPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderPoPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BabylonPk != nil {
+		l = m.BabylonPk.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pop != nil {
+		l = m.Pop.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationPoPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationPoPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BabylonPk != nil {
+		l = m.BabylonPk.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pop != nil {
+		l = m.Pop.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderPowerAfterUndelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderPowerAfterUndelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	if m.DelegationVotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.DelegationVotingPower))
+	}
+	if m.VotingPowerAfterUndelegation != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPowerAfterUndelegation))
+	}
+	return n
+}
+
+func (m *QueryTotalVotingPowerAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryTotalVotingPowerAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	if m.NumFinalityProviders != 0 {
+		n += 1 + sovQuery(uint64(m.NumFinalityProviders))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationScriptsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TaprootScriptPath) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Script)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ControlBlock)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationScriptsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FpBtcPkList) > 0 {
+		for _, e := range m.FpBtcPkList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.FpIdx != 0 {
+		n += 1 + sovQuery(uint64(m.FpIdx))
+	}
+	l = len(m.StakingOutputPkScript)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StakingSlashingPath != nil {
+		l = m.StakingSlashingPath.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StakingUnbondingPath != nil {
+		l = m.StakingUnbondingPath.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UnbondingOutputPkScript)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UnbondingSlashingPath != nil {
+		l = m.UnbondingSlashingPath.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyDelegatorSlashingSigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyDelegatorSlashingSigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.InvalidReason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReverifyInclusionProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReverifyInclusionProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.InvalidReason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovQuery(uint64(m.Depth))
+	}
+	return n
+}
+
+func (m *QueryCovenantSigningBatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StakingTxHashHexList) > 0 {
+		for _, s := range m.StakingTxHashHexList {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCovenantSigningBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SigningInfos) > 0 {
+		for _, e := range m.SigningInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CovenantSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for _, e := range m.FpBtcPkList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.StakingTx)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StakingOutputIdx != 0 {
+		n += 1 + sovQuery(uint64(m.StakingOutputIdx))
+	}
+	if m.SlashingTx != nil {
+		l = m.SlashingTx.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StakingSlashingPath != nil {
+		l = m.StakingSlashingPath.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UnbondingTx)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StakingUnbondingPath != nil {
+		l = m.StakingUnbondingPath.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UnbondingSlashingTx != nil {
+		l = m.UnbondingSlashingTx.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UnbondingSlashingPath != nil {
+		l = m.UnbondingSlashingPath.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingCovenantProgressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingCovenantProgressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.DelegatorUnbondingSigSubmitted {
+		n += 2
+	}
+	if m.CovenantQuorum != 0 {
+		n += 1 + sovQuery(uint64(m.CovenantQuorum))
+	}
+	if m.NumCovenantUnbondingSigs != 0 {
+		n += 1 + sovQuery(uint64(m.NumCovenantUnbondingSigs))
+	}
+	if m.NumCovenantUnbondingSlashingSigs != 0 {
+		n += 1 + sovQuery(uint64(m.NumCovenantUnbondingSlashingSigs))
+	}
+	if m.HasCovenantQuorums {
+		n += 2
+	}
+	if len(m.PendingCovenantPks) > 0 {
+		for _, e := range m.PendingCovenantPks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRecommendedSlashingFeeRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRecommendedSlashingFeeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FeeRateSatPerVbyte != 0 {
+		n += 1 + sovQuery(uint64(m.FeeRateSatPerVbyte))
+	}
+	if m.MinSlashingTxFeeSat != 0 {
+		n += 1 + sovQuery(uint64(m.MinSlashingTxFeeSat))
+	}
+	return n
+}
+
+func (m *QueryDelegationSpendTreeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TaprootTreeLeaf) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LeafIndex != 0 {
+		n += 1 + sovQuery(uint64(m.LeafIndex))
+	}
+	if m.ScriptPath != nil {
+		l = m.ScriptPath.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationSpendTreeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InternalKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StakingOutputPkScript)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TimelockLeaf != nil {
+		l = m.TimelockLeaf.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UnbondingLeaf != nil {
+		l = m.UnbondingLeaf.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SlashingLeaf != nil {
+		l = m.SlashingLeaf.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVotingPowerTableDiscrepanciesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *VotingPowerDiscrepancy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StoredVotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.StoredVotingPower))
+	}
+	if m.ExpectedVotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.ExpectedVotingPower))
+	}
+	return n
+}
+
+func (m *QueryVotingPowerTableDiscrepanciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if len(m.Discrepancies) > 0 {
+		for _, e := range m.Discrepancies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderSlashingImpactRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderSlashingImpactResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TotalSat != 0 {
+		n += 1 + sovQuery(uint64(m.TotalSat))
+	}
+	if m.TotalSlashingAmount != 0 {
+		n += 1 + sovQuery(uint64(m.TotalSlashingAmount))
+	}
+	return n
+}
+
+func (m *QueryVerifyCovenantQuorumSpendRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Path != 0 {
+		n += 1 + sovQuery(uint64(m.Path))
+	}
+	return n
+}
+
+func (m *QueryVerifyCovenantQuorumSpendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Spendable {
+		n += 2
+	}
+	if m.CovenantQuorum != 0 {
+		n += 1 + sovQuery(uint64(m.CovenantQuorum))
+	}
+	if m.NumValidCovenantSigs != 0 {
+		n += 1 + sovQuery(uint64(m.NumValidCovenantSigs))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationFirstRewardHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationFirstRewardHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FirstRewardHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FirstRewardHeight))
+	}
+	if m.BlocksUntilFirstReward != 0 {
+		n += 1 + sovQuery(uint64(m.BlocksUntilFirstReward))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ActivationHeight))
+	}
+	if m.FinalizationHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FinalizationHeight))
+	}
+	if m.RewardStartEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.RewardStartEpoch))
+	}
+	if m.RewardLockupEpochs != 0 {
+		n += 1 + sovQuery(uint64(m.RewardLockupEpochs))
+	}
+	if m.Earning {
+		n += 2
+	}
+	if m.AwaitingCovenantQuorum {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryCovenantSignMsgRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Path != 0 {
+		n += 1 + sovQuery(uint64(m.Path))
+	}
+	l = len(m.CovenantPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCovenantSignMsgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SigHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SigningTx)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ScriptPath != nil {
+		l = m.ScriptPath.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LeafHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CovenantKeyIndex != 0 {
+		n += 1 + sovQuery(uint64(m.CovenantKeyIndex))
+	}
+	if len(m.EncKeyList) > 0 {
+		for _, e := range m.EncKeyList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStakingInternalKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStakingInternalKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InternalKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationsSpendableViaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Path != 0 {
+		n += 1 + sovQuery(uint64(m.Path))
+	}
+	if m.BtcTipHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BtcTipHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationsSpendableViaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.BtcTipHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BtcTipHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCovenantSigNeededRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CovenantPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCovenantSigNeededResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Needed {
+		n += 2
+	}
+	if len(m.MissingPaths) > 0 {
+		l = 0
+		for _, e := range m.MissingPaths {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryDelegationParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParamsVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ParamsVersion))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.BtcConfirmationDepth != 0 {
+		n += 1 + sovQuery(uint64(m.BtcConfirmationDepth))
+	}
+	if m.CheckpointFinalizationTimeout != 0 {
+		n += 1 + sovQuery(uint64(m.CheckpointFinalizationTimeout))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByVersionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByVersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCovenantCommitteeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCovenantCommitteeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCovenantCommitteeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCovenantCommitteeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCovenantCommitteeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCovenantCommitteeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantPks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantPks = append(m.CovenantPks, &CovenantPkResponse{})
+			if err := m.CovenantPks[len(m.CovenantPks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantQuorum", wireType)
+			}
+			m.CovenantQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CovenantQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
+			}
+			m.ParamsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParamsVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CovenantPkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CovenantPkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CovenantPkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bip340PkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bip340PkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressedPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompressedPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProvidersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProvidersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderResponse{})
+			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalityProvider == nil {
+				m.FinalityProvider = &FinalityProviderResponse{}
+			}
+			if err := m.FinalityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BTCDelegationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderPowerAtHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderPowerAtHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderCurrentPowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderCurrentPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveFinalityProvidersAtHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveFinalityProvidersAtHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderWithMeta{})
+			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActivatedHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActivatedHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActivatedHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActivatedHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActivatedHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActivatedHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegatorDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegatorDelegations = append(m.BtcDelegatorDelegations, &BTCDelegatorDelegationsResponse{})
+			if err := m.BtcDelegatorDelegations[len(m.BtcDelegatorDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BtcDelegation == nil {
+				m.BtcDelegation = &BTCDelegationResponse{}
+			}
+			if err := m.BtcDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCovenantSignedDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCovenantSignedDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCovenantSignedDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCovenantSignedDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCovenantSignedDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCovenantSignedDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardEligibleDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardEligibleDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardEligibleDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardEligibleDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardEligibleDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardEligibleDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EligibleDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EligibleDelegations = append(m.EligibleDelegations, &BTCDelegationResponse{})
+			if err := m.EligibleDelegations[len(m.EligibleDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingDelegations = append(m.PendingDelegations, &BTCDelegationResponse{})
+			if err := m.PendingDelegations[len(m.PendingDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistCacheHeight", wireType)
+			}
+			m.DistCacheHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistCacheHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcTipHeight", wireType)
+			}
+			m.BtcTipHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcTipHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcConfirmationDepth", wireType)
+			}
+			m.BtcConfirmationDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcConfirmationDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointFinalizationTimeout", wireType)
+			}
+			m.CheckpointFinalizationTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointFinalizationTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FpActive = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkList", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPkList = append(m.FpBtcPkList, v)
+			if err := m.FpBtcPkList[len(m.FpBtcPkList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSat", wireType)
+			}
+			m.TotalSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingTxHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashSigHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorSlashSigHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantSigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantSigs = append(m.CovenantSigs, &CovenantAdaptorSignatures{})
+			if err := m.CovenantSigs[len(m.CovenantSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingOutputIdx", wireType)
+			}
+			m.StakingOutputIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingOutputIdx |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusDesc", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusDesc = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			m.UnbondingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UndelegationResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UndelegationResponse == nil {
+				m.UndelegationResponse = &BTCUndelegationResponse{}
+			}
+			if err := m.UndelegationResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
+			}
+			m.ParamsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParamsVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBabylonHeight", wireType)
+			}
+			m.CreatedBabylonHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedBabylonHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcConfirmationDepth", wireType)
+			}
+			m.BtcConfirmationDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcConfirmationDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointFinalizationTimeout", wireType)
+			}
+			m.CheckpointFinalizationTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointFinalizationTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardOptOut", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RewardOptOut = bool(v != 0)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BTCDelegationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonPk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BabylonPk == nil {
+				m.BabylonPk = &secp256k1.PubKey{}
+			}
+			if err := m.BabylonPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pop == nil {
+				m.Pop = &ProofOfPossession{}
+			}
+			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invalidated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Invalidated = bool(v != 0)
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBtcHeight", wireType)
+			}
+			m.SlashedBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedBtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reserved = bool(v != 0)
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpSlashedBeforeActivation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FpSlashedBeforeActivation = bool(v != 0)
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpSlashedBtcHeight", wireType)
+			}
+			m.FpSlashedBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FpSlashedBtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCUndelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCUndelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTxHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingTxHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingSigHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorUnbondingSigHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantUnbondingSigList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantUnbondingSigList = append(m.CovenantUnbondingSigList, &SignatureInfo{})
+			if err := m.CovenantUnbondingSigList[len(m.CovenantUnbondingSigList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingTxHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashingSigHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorSlashingSigHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantSlashingSigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantSlashingSigs = append(m.CovenantSlashingSigs, &CovenantAdaptorSignatures{})
+			if err := m.CovenantSlashingSigs[len(m.CovenantSlashingSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCDelegatorDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCDelegatorDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCDelegatorDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dels = append(m.Dels, &BTCDelegationResponse{})
+			if err := m.Dels[len(m.Dels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateBTCUndelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateBTCUndelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateBTCUndelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingTx = append(m.UnbondingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.UnbondingTx == nil {
+				m.UnbondingTx = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingValue", wireType)
+			}
+			m.UnbondingValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingValue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			m.UnbondingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v BTCSlashingTx
+			m.UnbondingSlashingTx = &v
+			if err := m.UnbondingSlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingSlashingSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340Signature
+			m.DelegatorUnbondingSlashingSig = &v
+			if err := m.DelegatorUnbondingSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateBTCUndelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateBTCUndelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateBTCUndelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStaleCovenantPendingDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStaleCovenantPendingDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStaleCovenantPendingDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlocksPending", wireType)
+			}
+			m.MaxBlocksPending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlocksPending |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStaleCovenantPendingDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStaleCovenantPendingDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStaleCovenantPendingDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashingAmountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashingAmountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashingAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashingAmountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashingAmountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashingAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingAmount", wireType)
+			}
+			m.SlashingAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashingAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashingAmount", wireType)
+			}
+			m.UnbondingSlashingAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingSlashingAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateVotingPowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateVotingPowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateVotingPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSat", wireType)
+			}
+			m.TotalSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTime", wireType)
+			}
+			m.StakingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateVotingPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateVotingPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateVotingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcTipHeight", wireType)
+			}
+			m.BtcTipHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcTipHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationsByInclusionHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationsByInclusionHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationsByInclusionHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationsByInclusionHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationsByInclusionHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationsByInclusionHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCovenantQuorumHealthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCovenantQuorumHealthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCovenantQuorumHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCovenantQuorumHealthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCovenantQuorumHealthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCovenantQuorumHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantQuorum", wireType)
+			}
+			m.CovenantQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CovenantQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPendingDelegations", wireType)
+			}
+			m.NumPendingDelegations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPendingDelegations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, &CovenantQuorumHealthBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CovenantQuorumHealthBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CovenantQuorumHealthBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CovenantQuorumHealthBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingSigs", wireType)
+			}
+			m.MissingSigs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissingSigs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumDelegations", wireType)
+			}
+			m.NumDelegations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumDelegations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Description == nil {
+				m.Description = &types.Description{}
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Commission = &v
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonPk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BabylonPk == nil {
+				m.BabylonPk = &secp256k1.PubKey{}
+			}
+			if err := m.BabylonPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pop == nil {
+				m.Pop = &ProofOfPossession{}
+			}
+			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBabylonHeight", wireType)
+			}
+			m.SlashedBabylonHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedBabylonHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBtcHeight", wireType)
+			}
+			m.SlashedBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedBtcHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationsWithStaleParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationsWithStaleParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationsWithStaleParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationsWithStaleParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationsWithStaleParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationsWithStaleParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentParamsVersion", wireType)
+			}
+			m.CurrentParamsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentParamsVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderPoPRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderPoPRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderPoPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderPoPResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderPoPResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderPoPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonPk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BabylonPk == nil {
+				m.BabylonPk = &secp256k1.PubKey{}
+			}
+			if err := m.BabylonPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pop == nil {
+				m.Pop = &ProofOfPossession{}
+			}
+			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationPoPRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationPoPRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationPoPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationPoPResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationPoPResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationPoPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonPk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BabylonPk == nil {
+				m.BabylonPk = &secp256k1.PubKey{}
+			}
+			if err := m.BabylonPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pop == nil {
+				m.Pop = &ProofOfPossession{}
+			}
+			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderPowerAfterUndelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderPowerAfterUndelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderPowerAfterUndelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderPowerAfterUndelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderPowerAfterUndelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderPowerAfterUndelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationVotingPower", wireType)
+			}
+			m.DelegationVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegationVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerAfterUndelegation", wireType)
+			}
+			m.VotingPowerAfterUndelegation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPowerAfterUndelegation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalVotingPowerAtHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalVotingPowerAtHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalVotingPowerAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalVotingPowerAtHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalVotingPowerAtHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalVotingPowerAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumFinalityProviders", wireType)
+			}
+			m.NumFinalityProviders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumFinalityProviders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationScriptsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationScriptsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationScriptsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaprootScriptPath) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaprootScriptPath: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaprootScriptPath: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Script", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Script = append(m.Script[:0], dAtA[iNdEx:postIndex]...)
+			if m.Script == nil {
+				m.Script = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControlBlock", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ControlBlock = append(m.ControlBlock[:0], dAtA[iNdEx:postIndex]...)
+			if m.ControlBlock == nil {
+				m.ControlBlock = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationScriptsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationScriptsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationScriptsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkList", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPkList = append(m.FpBtcPkList, v)
+			if err := m.FpBtcPkList[len(m.FpBtcPkList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpIdx", wireType)
+			}
+			m.FpIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FpIdx |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingOutputPkScript", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingOutputPkScript = append(m.StakingOutputPkScript[:0], dAtA[iNdEx:postIndex]...)
+			if m.StakingOutputPkScript == nil {
+				m.StakingOutputPkScript = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingSlashingPath", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StakingSlashingPath == nil {
+				m.StakingSlashingPath = &TaprootScriptPath{}
+			}
+			if err := m.StakingSlashingPath.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingUnbondingPath", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StakingUnbondingPath == nil {
+				m.StakingUnbondingPath = &TaprootScriptPath{}
+			}
+			if err := m.StakingUnbondingPath.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOutputPkScript", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingOutputPkScript = append(m.UnbondingOutputPkScript[:0], dAtA[iNdEx:postIndex]...)
+			if m.UnbondingOutputPkScript == nil {
+				m.UnbondingOutputPkScript = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashingPath", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UnbondingSlashingPath == nil {
+				m.UnbondingSlashingPath = &TaprootScriptPath{}
+			}
+			if err := m.UnbondingSlashingPath.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyDelegatorSlashingSigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyDelegatorSlashingSigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyDelegatorSlashingSigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyDelegatorSlashingSigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyDelegatorSlashingSigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyDelegatorSlashingSigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReverifyInclusionProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReverifyInclusionProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReverifyInclusionProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReverifyInclusionProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReverifyInclusionProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReverifyInclusionProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCovenantSigningBatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCovenantSigningBatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCovenantSigningBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHexList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHexList = append(m.StakingTxHashHexList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCovenantSigningBatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3843,12 +21638,46 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCovenantSigningBatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCovenantSigningBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningInfos = append(m.SigningInfos, &CovenantSigningInfo{})
+			if err := m.SigningInfos[len(m.SigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3870,7 +21699,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *CovenantSigningInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3880,28 +21709,253 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CovenantSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CovenantSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkList", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPkList = append(m.FpBtcPkList, v)
+			if err := m.FpBtcPkList[len(m.FpBtcPkList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTx = append(m.StakingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.StakingTx == nil {
+				m.StakingTx = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingOutputIdx", wireType)
+			}
+			m.StakingOutputIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingOutputIdx |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v BTCSlashingTx
+			m.SlashingTx = &v
+			if err := m.SlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingSlashingPath", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StakingSlashingPath == nil {
+				m.StakingSlashingPath = &TaprootScriptPath{}
+			}
+			if err := m.StakingSlashingPath.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.UnbondingTx = append(m.UnbondingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.UnbondingTx == nil {
+				m.UnbondingTx = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingUnbondingPath", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3928,65 +21982,18 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.StakingUnbondingPath == nil {
+				m.StakingUnbondingPath = &TaprootScriptPath{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.StakingUnbondingPath.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByVersionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashingTx", wireType)
 			}
-			m.Version = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3996,64 +22003,30 @@ func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v BTCSlashingTx
+			m.UnbondingSlashingTx = &v
+			if err := m.UnbondingSlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByVersionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashingPath", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4080,7 +22053,10 @@ func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.UnbondingSlashingPath == nil {
+				m.UnbondingSlashingPath = &TaprootScriptPath{}
+			}
+			if err := m.UnbondingSlashingPath.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4105,7 +22081,7 @@ func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUnbondingCovenantProgressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4128,17 +22104,17 @@ func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProvidersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnbondingCovenantProgressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnbondingCovenantProgressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4148,27 +22124,23 @@ func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4191,7 +22163,7 @@ func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUnbondingCovenantProgressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4214,17 +22186,17 @@ func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProvidersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnbondingCovenantProgressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnbondingCovenantProgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4234,31 +22206,113 @@ func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Status |= BTCDelegationStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingSigSubmitted", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.DelegatorUnbondingSigSubmitted = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantQuorum", wireType)
 			}
-			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderResponse{})
-			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CovenantQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CovenantQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumCovenantUnbondingSigs", wireType)
+			}
+			m.NumCovenantUnbondingSigs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumCovenantUnbondingSigs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumCovenantUnbondingSlashingSigs", wireType)
+			}
+			m.NumCovenantUnbondingSlashingSigs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumCovenantUnbondingSlashingSigs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasCovenantQuorums", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+			m.HasCovenantQuorums = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCovenantPks", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4268,25 +22322,24 @@ func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.PendingCovenantPks = append(m.PendingCovenantPks, v)
+			if err := m.PendingCovenantPks[len(m.PendingCovenantPks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4311,7 +22364,7 @@ func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRecommendedSlashingFeeRateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4334,17 +22387,67 @@ func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecommendedSlashingFeeRateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecommendedSlashingFeeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecommendedSlashingFeeRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecommendedSlashingFeeRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecommendedSlashingFeeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRateSatPerVbyte", wireType)
 			}
-			var stringLen uint64
+			m.FeeRateSatPerVbyte = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4354,24 +22457,30 @@ func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.FeeRateSatPerVbyte |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSlashingTxFeeSat", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.MinSlashingTxFeeSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSlashingTxFeeSat |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4393,7 +22502,7 @@ func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationSpendTreeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4416,17 +22525,17 @@ func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationSpendTreeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationSpendTreeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4436,27 +22545,23 @@ func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinalityProvider == nil {
-				m.FinalityProvider = &FinalityProviderResponse{}
-			}
-			if err := m.FinalityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4479,7 +22584,7 @@ func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *TaprootTreeLeaf) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4502,17 +22607,17 @@ func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaprootTreeLeaf: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaprootTreeLeaf: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafIndex", wireType)
 			}
-			m.Status = 0
+			m.LeafIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4522,14 +22627,14 @@ func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= BTCDelegationStatus(b&0x7F) << shift
+				m.LeafIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptPath", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4556,10 +22661,10 @@ func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.ScriptPath == nil {
+				m.ScriptPath = &TaprootScriptPath{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ScriptPath.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4584,7 +22689,7 @@ func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationSpendTreeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4607,17 +22712,17 @@ func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationSpendTreeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationSpendTreeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4627,31 +22732,31 @@ func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
-			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.InternalKey = append(m.InternalKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.InternalKey == nil {
+				m.InternalKey = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingOutputPkScript", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4661,83 +22766,67 @@ func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StakingOutputPkScript = append(m.StakingOutputPkScript[:0], dAtA[iNdEx:postIndex]...)
+			if m.StakingOutputPkScript == nil {
+				m.StakingOutputPkScript = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockLeaf", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFinalityProviderPowerAtHeightRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.TimelockLeaf == nil {
+				m.TimelockLeaf = &TaprootTreeLeaf{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.TimelockLeaf.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingLeaf", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4747,29 +22836,33 @@ func (m *QueryFinalityProviderPowerAtHeightRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			if m.UnbondingLeaf == nil {
+				m.UnbondingLeaf = &TaprootTreeLeaf{}
+			}
+			if err := m.UnbondingLeaf.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingLeaf", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4779,11 +22872,28 @@ func (m *QueryFinalityProviderPowerAtHeightRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SlashingLeaf == nil {
+				m.SlashingLeaf = &TaprootTreeLeaf{}
+			}
+			if err := m.SlashingLeaf.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4805,7 +22915,7 @@ func (m *QueryFinalityProviderPowerAtHeightRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryFinalityProviderPowerAtHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVotingPowerTableDiscrepanciesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4828,31 +22938,12 @@ func (m *QueryFinalityProviderPowerAtHeightResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotingPowerTableDiscrepanciesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderPowerAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotingPowerTableDiscrepanciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
-			}
-			m.VotingPower = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VotingPower |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4874,7 +22965,7 @@ func (m *QueryFinalityProviderPowerAtHeightResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryFinalityProviderCurrentPowerRequest) Unmarshal(dAtA []byte) error {
+func (m *VotingPowerDiscrepancy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4897,10 +22988,10 @@ func (m *QueryFinalityProviderCurrentPowerRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: VotingPowerDiscrepancy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VotingPowerDiscrepancy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4935,6 +23026,44 @@ func (m *QueryFinalityProviderCurrentPowerRequest) Unmarshal(dAtA []byte) error
 			}
 			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredVotingPower", wireType)
+			}
+			m.StoredVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StoredVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedVotingPower", wireType)
+			}
+			m.ExpectedVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4956,7 +23085,7 @@ func (m *QueryFinalityProviderCurrentPowerRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryFinalityProviderCurrentPowerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVotingPowerTableDiscrepanciesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4979,10 +23108,10 @@ func (m *QueryFinalityProviderCurrentPowerResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotingPowerTableDiscrepanciesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderCurrentPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotingPowerTableDiscrepanciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5005,10 +23134,10 @@ func (m *QueryFinalityProviderCurrentPowerResponse) Unmarshal(dAtA []byte) error
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discrepancies", wireType)
 			}
-			m.VotingPower = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5018,11 +23147,26 @@ func (m *QueryFinalityProviderCurrentPowerResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotingPower |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Discrepancies = append(m.Discrepancies, &VotingPowerDiscrepancy{})
+			if err := m.Discrepancies[len(m.Discrepancies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5044,7 +23188,7 @@ func (m *QueryFinalityProviderCurrentPowerResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryActiveFinalityProvidersAtHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProviderSlashingImpactRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5067,36 +23211,17 @@ func (m *QueryActiveFinalityProvidersAtHeightRequest) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProviderSlashingImpactRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProviderSlashingImpactRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5106,27 +23231,23 @@ func (m *QueryActiveFinalityProvidersAtHeightRequest) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5149,7 +23270,7 @@ func (m *QueryActiveFinalityProvidersAtHeightRequest) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryActiveFinalityProvidersAtHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProviderSlashingImpactResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5172,15 +23293,15 @@ func (m *QueryActiveFinalityProvidersAtHeightResponse) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProviderSlashingImpactResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActiveFinalityProvidersAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProviderSlashingImpactResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5207,16 +23328,16 @@ func (m *QueryActiveFinalityProvidersAtHeightResponse) Unmarshal(dAtA []byte) er
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderWithMeta{})
-			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSat", wireType)
 			}
-			var msglen int
+			m.TotalSat = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5226,28 +23347,30 @@ func (m *QueryActiveFinalityProvidersAtHeightResponse) Unmarshal(dAtA []byte) er
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TotalSat |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSlashingAmount", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TotalSlashingAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSlashingAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5269,7 +23392,7 @@ func (m *QueryActiveFinalityProvidersAtHeightResponse) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *QueryActivatedHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyCovenantQuorumSpendRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5292,12 +23415,63 @@ func (m *QueryActivatedHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivatedHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyCovenantQuorumSpendRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivatedHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyCovenantQuorumSpendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			m.Path = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Path |= CovenantSpendPath(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5319,7 +23493,7 @@ func (m *QueryActivatedHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActivatedHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyCovenantQuorumSpendResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5342,17 +23516,56 @@ func (m *QueryActivatedHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivatedHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyCovenantQuorumSpendResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivatedHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyCovenantQuorumSpendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spendable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Spendable = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantQuorum", wireType)
+			}
+			m.CovenantQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CovenantQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumValidCovenantSigs", wireType)
 			}
-			m.Height = 0
+			m.NumValidCovenantSigs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5362,11 +23575,43 @@ func (m *QueryActivatedHeightResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.NumValidCovenantSigs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5388,7 +23633,7 @@ func (m *QueryActivatedHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationFirstRewardHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5411,15 +23656,15 @@ func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationFirstRewardHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationFirstRewardHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5447,43 +23692,7 @@ func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5506,7 +23715,7 @@ func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationFirstRewardHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5529,17 +23738,17 @@ func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationFirstRewardHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationFirstRewardHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegatorDelegations", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstRewardHeight", wireType)
 			}
-			var msglen int
+			m.FirstRewardHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5549,31 +23758,54 @@ func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FirstRewardHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksUntilFirstReward", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.BlocksUntilFirstReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksUntilFirstReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
 			}
-			m.BtcDelegatorDelegations = append(m.BtcDelegatorDelegations, &BTCDelegatorDelegationsResponse{})
-			if err := m.BtcDelegatorDelegations[len(m.BtcDelegatorDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizationHeight", wireType)
 			}
-			var msglen int
+			m.FinalizationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5583,28 +23815,89 @@ func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FinalizationHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardStartEpoch", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.RewardStartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardStartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardLockupEpochs", wireType)
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.RewardLockupEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardLockupEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Earning", wireType)
 			}
-			iNdEx = postIndex
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Earning = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AwaitingCovenantQuorum", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AwaitingCovenantQuorum = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5626,7 +23919,7 @@ func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryBTCDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCovenantSignMsgRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5649,10 +23942,10 @@ func (m *QueryBTCDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCovenantSignMsgRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCovenantSignMsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5679,69 +23972,38 @@ func (m *QueryBTCDelegationRequest) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthQuery
 			}
 			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBTCDelegationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCDelegationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Path = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Path |= CovenantSpendPath(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantPkHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5751,27 +24013,23 @@ func (m *QueryBTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BtcDelegation == nil {
-				m.BtcDelegation = &BTCDelegationResponse{}
-			}
-			if err := m.BtcDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CovenantPkHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5794,7 +24052,7 @@ func (m *QueryBTCDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCovenantSignMsgResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5817,15 +24075,15 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BTCDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCovenantSignMsgResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCovenantSignMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5852,15 +24110,14 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.BtcPk = &v
-			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SigHash = append(m.SigHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.SigHash == nil {
+				m.SigHash = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningTx", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5887,17 +24144,16 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.FpBtcPkList = append(m.FpBtcPkList, v)
-			if err := m.FpBtcPkList[len(m.FpBtcPkList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SigningTx = append(m.SigningTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.SigningTx == nil {
+				m.SigningTx = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptPath", wireType)
 			}
-			m.StartHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5907,16 +24163,33 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScriptPath == nil {
+				m.ScriptPath = &TaprootScriptPath{}
+			}
+			if err := m.ScriptPath.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafHash", wireType)
 			}
-			m.EndHeight = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5926,16 +24199,31 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndHeight |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeafHash = append(m.LeafHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LeafHash == nil {
+				m.LeafHash = []byte{}
+			}
+			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantKeyIndex", wireType)
 			}
-			m.TotalSat = 0
+			m.CovenantKeyIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5945,16 +24233,16 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalSat |= uint64(b&0x7F) << shift
+				m.CovenantKeyIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EncKeyList", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5964,93 +24252,132 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingTxHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxHex", wireType)
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.EncKeyList = append(m.EncKeyList, v)
+			if err := m.EncKeyList[len(m.EncKeyList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingInternalKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashingTxHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashSigHex", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingInternalKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingInternalKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingInternalKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorSlashSigHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingInternalKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingInternalKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CovenantSigs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6060,70 +24387,81 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CovenantSigs = append(m.CovenantSigs, &CovenantAdaptorSignatures{})
-			if err := m.CovenantSigs[len(m.CovenantSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.InternalKey = append(m.InternalKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.InternalKey == nil {
+				m.InternalKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingOutputIdx", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.StakingOutputIdx = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StakingOutputIdx |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationsSpendableViaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			m.Active = bool(v != 0)
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusDesc", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationsSpendableViaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationsSpendableViaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			m.Path = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6133,29 +24471,16 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Path |= StakingOutputSpendPath(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StatusDesc = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcTipHeight", wireType)
 			}
-			m.UnbondingTime = 0
+			m.BtcTipHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6165,14 +24490,14 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingTime |= uint32(b&0x7F) << shift
+				m.BtcTipHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UndelegationResponse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6199,32 +24524,13 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UndelegationResponse == nil {
-				m.UndelegationResponse = &BTCUndelegationResponse{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.UndelegationResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
-			}
-			m.ParamsVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ParamsVersion |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6246,7 +24552,7 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationsSpendableViaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6269,17 +24575,17 @@ func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BTCUndelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationsSpendableViaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BTCUndelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationsSpendableViaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTxHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6289,29 +24595,31 @@ func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbondingTxHex = string(dAtA[iNdEx:postIndex])
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingSigHex", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcTipHeight", wireType)
 			}
-			var stringLen uint64
+			m.BtcTipHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6321,27 +24629,14 @@ func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BtcTipHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorUnbondingSigHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CovenantUnbondingSigList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6368,46 +24663,66 @@ func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CovenantUnbondingSigList = append(m.CovenantUnbondingSigList, &SignatureInfo{})
-			if err := m.CovenantUnbondingSigList[len(m.CovenantUnbondingSigList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxHex", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCovenantSigNeededRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashingTxHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCovenantSigNeededRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCovenantSigNeededRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashingSigHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6435,13 +24750,13 @@ func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorSlashingSigHex = string(dAtA[iNdEx:postIndex])
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CovenantSlashingSigs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantPkHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6451,25 +24766,23 @@ func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CovenantSlashingSigs = append(m.CovenantSlashingSigs, &CovenantAdaptorSignatures{})
-			if err := m.CovenantSlashingSigs[len(m.CovenantSlashingSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CovenantPkHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6492,7 +24805,7 @@ func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BTCDelegatorDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCovenantSigNeededResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6515,17 +24828,17 @@ func (m *BTCDelegatorDelegationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BTCDelegatorDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCovenantSigNeededResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BTCDelegatorDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCovenantSigNeededResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dels", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Needed", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6535,26 +24848,81 @@ func (m *BTCDelegatorDelegationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Dels = append(m.Dels, &BTCDelegationResponse{})
-			if err := m.Dels[len(m.Dels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Needed = bool(v != 0)
+		case 2:
+			if wireType == 0 {
+				var v CovenantSpendPath
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= CovenantSpendPath(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MissingPaths = append(m.MissingPaths, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.MissingPaths) == 0 {
+					m.MissingPaths = make([]CovenantSpendPath, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v CovenantSpendPath
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= CovenantSpendPath(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MissingPaths = append(m.MissingPaths, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingPaths", wireType)
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6576,7 +24944,7 @@ func (m *BTCDelegatorDelegationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6599,51 +24967,15 @@ func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FinalityProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Description == nil {
-				m.Description = &types.Description{}
-			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6671,53 +25003,63 @@ func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.LegacyDec
-			m.Commission = &v
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BabylonPk", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BabylonPk == nil {
-				m.BabylonPk = &secp256k1.PubKey{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if err := m.BabylonPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var byteLen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
+			}
+			m.ParamsVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6727,30 +25069,14 @@ func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ParamsVersion |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.BtcPk = &v
-			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6777,56 +25103,15 @@ func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pop == nil {
-				m.Pop = &ProofOfPossession{}
-			}
-			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBabylonHeight", wireType)
-			}
-			m.SlashedBabylonHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SlashedBabylonHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBtcHeight", wireType)
-			}
-			m.SlashedBtcHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SlashedBtcHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcConfirmationDepth", wireType)
 			}
-			m.Height = 0
+			m.BtcConfirmationDepth = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6836,16 +25121,16 @@ func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.BtcConfirmationDepth |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointFinalizationTimeout", wireType)
 			}
-			m.VotingPower = 0
+			m.CheckpointFinalizationTimeout = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6855,7 +25140,7 @@ func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotingPower |= uint64(b&0x7F) << shift
+				m.CheckpointFinalizationTimeout |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
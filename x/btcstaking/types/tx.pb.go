@@ -264,7 +264,9 @@ type MsgCreateBTCDelegation struct {
 	// fp_btc_pk_list is the list of Bitcoin secp256k1 PKs of the finality providers, if there is more than one
 	// finality provider pk it means that delegation is re-staked
 	FpBtcPkList []github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,5,rep,name=fp_btc_pk_list,json=fpBtcPkList,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk_list,omitempty"`
-	// staking_time is the time lock used in staking transaction
+	// staking_time is the time lock used in staking transaction, in BTC blocks.
+	// It has to be positive and at most 65535 (i.e., math.MaxUint16), which is
+	// the max relative timelock OP_CHECKSEQUENCEVERIFY can express in blocks
 	StakingTime uint32 `protobuf:"varint,6,opt,name=staking_time,json=stakingTime,proto3" json:"staking_time,omitempty"`
 	// staking_value  is the amount of satoshis locked in staking output
 	StakingValue int64 `protobuf:"varint,7,opt,name=staking_value,json=stakingValue,proto3" json:"staking_value,omitempty"`
@@ -296,6 +298,14 @@ type MsgCreateBTCDelegation struct {
 	UnbondingSlashingTx *BTCSlashingTx `protobuf:"bytes,14,opt,name=unbonding_slashing_tx,json=unbondingSlashingTx,proto3,customtype=BTCSlashingTx" json:"unbonding_slashing_tx,omitempty"`
 	// delegator_unbonding_slashing_sig is the signature on the slashing tx by the delegator (i.e., SK corresponding to btc_pk).
 	DelegatorUnbondingSlashingSig *github_com_babylonchain_babylon_types.BIP340Signature `protobuf:"bytes,15,opt,name=delegator_unbonding_slashing_sig,json=delegatorUnbondingSlashingSig,proto3,customtype=github.com/babylonchain/babylon/types.BIP340Signature" json:"delegator_unbonding_slashing_sig,omitempty"`
+	// reward_opt_out is whether the delegator opts out of BTC staking rewards,
+	// e.g., when staking purely for the security of the network
+	RewardOptOut bool `protobuf:"varint,16,opt,name=reward_opt_out,json=rewardOptOut,proto3" json:"reward_opt_out,omitempty"`
+	// reserved is whether the staking tx is not included in BTC yet, e.g., when
+	// its staking start is in the future. If so, staking_tx only carries the
+	// staking tx, and the BTC delegation is accepted in the reserved state until
+	// the inclusion proof is submitted via MsgActivateReservedDelegation
+	Reserved bool `protobuf:"varint,17,opt,name=reserved,proto3" json:"reserved,omitempty"`
 }
 
 func (m *MsgCreateBTCDelegation) Reset()         { *m = MsgCreateBTCDelegation{} }
@@ -394,6 +404,20 @@ func (m *MsgCreateBTCDelegation) GetUnbondingValue() int64 {
 	return 0
 }
 
+func (m *MsgCreateBTCDelegation) GetRewardOptOut() bool {
+	if m != nil {
+		return m.RewardOptOut
+	}
+	return false
+}
+
+func (m *MsgCreateBTCDelegation) GetReserved() bool {
+	if m != nil {
+		return m.Reserved
+	}
+	return false
+}
+
 // MsgCreateBTCDelegationResponse is the response for MsgCreateBTCDelegation
 type MsgCreateBTCDelegationResponse struct {
 }
@@ -551,6 +575,330 @@ func (m *MsgAddCovenantSigsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddCovenantSigsResponse proto.InternalMessageInfo
 
+// MsgCreateBTCDelegationWithCovenantSigs is the message for creating a BTC
+// delegation and adding signatures from covenant members to it in a single tx,
+// e.g., when the submitter is also a covenant member. Either the BTC delegation
+// is created with all the covenant signatures, or nothing is changed.
+type MsgCreateBTCDelegationWithCovenantSigs struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// btc_del is the message for creating the BTC delegation
+	// its signer has to be the same as the signer of this message
+	BtcDel *MsgCreateBTCDelegation `protobuf:"bytes,2,opt,name=btc_del,json=btcDel,proto3" json:"btc_del,omitempty"`
+	// covenant_sigs is a list of messages for adding covenant signatures to the
+	// BTC delegation. Their signers have to be the same as the signer of this
+	// message, and their staking tx hashes have to be the hash of the staking tx
+	// in btc_del
+	CovenantSigs []*MsgAddCovenantSigs `protobuf:"bytes,3,rep,name=covenant_sigs,json=covenantSigs,proto3" json:"covenant_sigs,omitempty"`
+}
+
+func (m *MsgCreateBTCDelegationWithCovenantSigs) Reset() {
+	*m = MsgCreateBTCDelegationWithCovenantSigs{}
+}
+func (m *MsgCreateBTCDelegationWithCovenantSigs) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateBTCDelegationWithCovenantSigs) ProtoMessage()    {}
+func (*MsgCreateBTCDelegationWithCovenantSigs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{8}
+}
+func (m *MsgCreateBTCDelegationWithCovenantSigs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateBTCDelegationWithCovenantSigs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateBTCDelegationWithCovenantSigs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateBTCDelegationWithCovenantSigs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateBTCDelegationWithCovenantSigs.Merge(m, src)
+}
+func (m *MsgCreateBTCDelegationWithCovenantSigs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateBTCDelegationWithCovenantSigs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateBTCDelegationWithCovenantSigs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateBTCDelegationWithCovenantSigs proto.InternalMessageInfo
+
+func (m *MsgCreateBTCDelegationWithCovenantSigs) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgCreateBTCDelegationWithCovenantSigs) GetBtcDel() *MsgCreateBTCDelegation {
+	if m != nil {
+		return m.BtcDel
+	}
+	return nil
+}
+
+func (m *MsgCreateBTCDelegationWithCovenantSigs) GetCovenantSigs() []*MsgAddCovenantSigs {
+	if m != nil {
+		return m.CovenantSigs
+	}
+	return nil
+}
+
+// MsgCreateBTCDelegationWithCovenantSigsResponse is the response for
+// MsgCreateBTCDelegationWithCovenantSigs
+type MsgCreateBTCDelegationWithCovenantSigsResponse struct {
+}
+
+func (m *MsgCreateBTCDelegationWithCovenantSigsResponse) Reset() {
+	*m = MsgCreateBTCDelegationWithCovenantSigsResponse{}
+}
+func (m *MsgCreateBTCDelegationWithCovenantSigsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgCreateBTCDelegationWithCovenantSigsResponse) ProtoMessage() {}
+func (*MsgCreateBTCDelegationWithCovenantSigsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{9}
+}
+func (m *MsgCreateBTCDelegationWithCovenantSigsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateBTCDelegationWithCovenantSigsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateBTCDelegationWithCovenantSigsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateBTCDelegationWithCovenantSigsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateBTCDelegationWithCovenantSigsResponse.Merge(m, src)
+}
+func (m *MsgCreateBTCDelegationWithCovenantSigsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateBTCDelegationWithCovenantSigsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateBTCDelegationWithCovenantSigsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateBTCDelegationWithCovenantSigsResponse proto.InternalMessageInfo
+
+// MsgActivateReservedDelegation is the message for activating a reserved BTC
+// delegation once its staking tx is included in BTC
+type MsgActivateReservedDelegation struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// staking_tx_hash is the hash of the staking tx.
+	// It uniquely identifies a BTC delegation
+	StakingTxHash string `protobuf:"bytes,2,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// staking_tx is the staking tx together with its inclusion proof. The
+	// staking tx has to be the one reserved in the BTC delegation
+	StakingTx *types1.TransactionInfo `protobuf:"bytes,3,opt,name=staking_tx,json=stakingTx,proto3" json:"staking_tx,omitempty"`
+}
+
+func (m *MsgActivateReservedDelegation) Reset()         { *m = MsgActivateReservedDelegation{} }
+func (m *MsgActivateReservedDelegation) String() string { return proto.CompactTextString(m) }
+func (*MsgActivateReservedDelegation) ProtoMessage()    {}
+func (*MsgActivateReservedDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{10}
+}
+func (m *MsgActivateReservedDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgActivateReservedDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgActivateReservedDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgActivateReservedDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgActivateReservedDelegation.Merge(m, src)
+}
+func (m *MsgActivateReservedDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgActivateReservedDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgActivateReservedDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgActivateReservedDelegation proto.InternalMessageInfo
+
+func (m *MsgActivateReservedDelegation) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgActivateReservedDelegation) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
+	}
+	return ""
+}
+
+func (m *MsgActivateReservedDelegation) GetStakingTx() *types1.TransactionInfo {
+	if m != nil {
+		return m.StakingTx
+	}
+	return nil
+}
+
+// MsgActivateReservedDelegationResponse is the response for
+// MsgActivateReservedDelegation
+type MsgActivateReservedDelegationResponse struct {
+}
+
+func (m *MsgActivateReservedDelegationResponse) Reset()         { *m = MsgActivateReservedDelegationResponse{} }
+func (m *MsgActivateReservedDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgActivateReservedDelegationResponse) ProtoMessage()    {}
+func (*MsgActivateReservedDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{11}
+}
+func (m *MsgActivateReservedDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgActivateReservedDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgActivateReservedDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgActivateReservedDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgActivateReservedDelegationResponse.Merge(m, src)
+}
+func (m *MsgActivateReservedDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgActivateReservedDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgActivateReservedDelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgActivateReservedDelegationResponse proto.InternalMessageInfo
+
+// MsgAddSlashingTxInclusionProof is the message for reporting that the
+// slashing tx of a BTC delegation is included in BTC
+type MsgAddSlashingTxInclusionProof struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// staking_tx_hash is the hash of the staking tx.
+	// It uniquely identifies a BTC delegation
+	StakingTxHash string `protobuf:"bytes,2,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// slashing_tx is the slashing tx together with its inclusion proof. It has
+	// to be the slashing tx of the BTC delegation, or that of its unbonding tx
+	SlashingTx *types1.TransactionInfo `protobuf:"bytes,3,opt,name=slashing_tx,json=slashingTx,proto3" json:"slashing_tx,omitempty"`
+}
+
+func (m *MsgAddSlashingTxInclusionProof) Reset()         { *m = MsgAddSlashingTxInclusionProof{} }
+func (m *MsgAddSlashingTxInclusionProof) String() string { return proto.CompactTextString(m) }
+func (*MsgAddSlashingTxInclusionProof) ProtoMessage()    {}
+func (*MsgAddSlashingTxInclusionProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{12}
+}
+func (m *MsgAddSlashingTxInclusionProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddSlashingTxInclusionProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddSlashingTxInclusionProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddSlashingTxInclusionProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddSlashingTxInclusionProof.Merge(m, src)
+}
+func (m *MsgAddSlashingTxInclusionProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddSlashingTxInclusionProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddSlashingTxInclusionProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddSlashingTxInclusionProof proto.InternalMessageInfo
+
+func (m *MsgAddSlashingTxInclusionProof) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgAddSlashingTxInclusionProof) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
+	}
+	return ""
+}
+
+func (m *MsgAddSlashingTxInclusionProof) GetSlashingTx() *types1.TransactionInfo {
+	if m != nil {
+		return m.SlashingTx
+	}
+	return nil
+}
+
+// MsgAddSlashingTxInclusionProofResponse is the response for
+// MsgAddSlashingTxInclusionProof
+type MsgAddSlashingTxInclusionProofResponse struct {
+}
+
+func (m *MsgAddSlashingTxInclusionProofResponse) Reset() {
+	*m = MsgAddSlashingTxInclusionProofResponse{}
+}
+func (m *MsgAddSlashingTxInclusionProofResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddSlashingTxInclusionProofResponse) ProtoMessage()    {}
+func (*MsgAddSlashingTxInclusionProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{13}
+}
+func (m *MsgAddSlashingTxInclusionProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddSlashingTxInclusionProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddSlashingTxInclusionProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddSlashingTxInclusionProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddSlashingTxInclusionProofResponse.Merge(m, src)
+}
+func (m *MsgAddSlashingTxInclusionProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddSlashingTxInclusionProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddSlashingTxInclusionProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddSlashingTxInclusionProofResponse proto.InternalMessageInfo
+
 // MsgBTCUndelegate is the message for handling signature on unbonding tx
 // from its delegator. This signature effectively proves that the delegator
 // wants to unbond this BTC delegation
@@ -568,7 +916,7 @@ func (m *MsgBTCUndelegate) Reset()         { *m = MsgBTCUndelegate{} }
 func (m *MsgBTCUndelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgBTCUndelegate) ProtoMessage()    {}
 func (*MsgBTCUndelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{8}
+	return fileDescriptor_4baddb53e97f38f2, []int{14}
 }
 func (m *MsgBTCUndelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -619,7 +967,7 @@ func (m *MsgBTCUndelegateResponse) Reset()         { *m = MsgBTCUndelegateRespon
 func (m *MsgBTCUndelegateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBTCUndelegateResponse) ProtoMessage()    {}
 func (*MsgBTCUndelegateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{9}
+	return fileDescriptor_4baddb53e97f38f2, []int{15}
 }
 func (m *MsgBTCUndelegateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -666,7 +1014,7 @@ func (m *MsgSelectiveSlashingEvidence) Reset()         { *m = MsgSelectiveSlashi
 func (m *MsgSelectiveSlashingEvidence) String() string { return proto.CompactTextString(m) }
 func (*MsgSelectiveSlashingEvidence) ProtoMessage()    {}
 func (*MsgSelectiveSlashingEvidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{10}
+	return fileDescriptor_4baddb53e97f38f2, []int{16}
 }
 func (m *MsgSelectiveSlashingEvidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -724,7 +1072,7 @@ func (m *MsgSelectiveSlashingEvidenceResponse) Reset()         { *m = MsgSelecti
 func (m *MsgSelectiveSlashingEvidenceResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSelectiveSlashingEvidenceResponse) ProtoMessage()    {}
 func (*MsgSelectiveSlashingEvidenceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{11}
+	return fileDescriptor_4baddb53e97f38f2, []int{17}
 }
 func (m *MsgSelectiveSlashingEvidenceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -770,7 +1118,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{12}
+	return fileDescriptor_4baddb53e97f38f2, []int{18}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -821,7 +1169,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{13}
+	return fileDescriptor_4baddb53e97f38f2, []int{19}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -850,120 +1198,254 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateFinalityProvider)(nil), "babylon.btcstaking.v1.MsgCreateFinalityProvider")
-	proto.RegisterType((*MsgCreateFinalityProviderResponse)(nil), "babylon.btcstaking.v1.MsgCreateFinalityProviderResponse")
-	proto.RegisterType((*MsgEditFinalityProvider)(nil), "babylon.btcstaking.v1.MsgEditFinalityProvider")
-	proto.RegisterType((*MsgEditFinalityProviderResponse)(nil), "babylon.btcstaking.v1.MsgEditFinalityProviderResponse")
-	proto.RegisterType((*MsgCreateBTCDelegation)(nil), "babylon.btcstaking.v1.MsgCreateBTCDelegation")
-	proto.RegisterType((*MsgCreateBTCDelegationResponse)(nil), "babylon.btcstaking.v1.MsgCreateBTCDelegationResponse")
-	proto.RegisterType((*MsgAddCovenantSigs)(nil), "babylon.btcstaking.v1.MsgAddCovenantSigs")
-	proto.RegisterType((*MsgAddCovenantSigsResponse)(nil), "babylon.btcstaking.v1.MsgAddCovenantSigsResponse")
-	proto.RegisterType((*MsgBTCUndelegate)(nil), "babylon.btcstaking.v1.MsgBTCUndelegate")
-	proto.RegisterType((*MsgBTCUndelegateResponse)(nil), "babylon.btcstaking.v1.MsgBTCUndelegateResponse")
-	proto.RegisterType((*MsgSelectiveSlashingEvidence)(nil), "babylon.btcstaking.v1.MsgSelectiveSlashingEvidence")
-	proto.RegisterType((*MsgSelectiveSlashingEvidenceResponse)(nil), "babylon.btcstaking.v1.MsgSelectiveSlashingEvidenceResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "babylon.btcstaking.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylon.btcstaking.v1.MsgUpdateParamsResponse")
+// MsgPruneInactiveDelegations defines a message for pruning the BTC
+// delegations that have been inactive for long from state
+type MsgPruneInactiveDelegations struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// older_than_blocks is the number of BTC blocks that the staking timelock
+	// of a BTC delegation has to be expired for before it can be pruned
+	OlderThanBlocks uint64 `protobuf:"varint,2,opt,name=older_than_blocks,json=olderThanBlocks,proto3" json:"older_than_blocks,omitempty"`
 }
 
-func init() { proto.RegisterFile("babylon/btcstaking/v1/tx.proto", fileDescriptor_4baddb53e97f38f2) }
-
-var fileDescriptor_4baddb53e97f38f2 = []byte{
-	// 1284 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x6f, 0xdb, 0xc6,
-	0x13, 0x35, 0x2d, 0x5b, 0xf9, 0x79, 0x64, 0xd9, 0xfe, 0x31, 0x8e, 0x43, 0xb3, 0x89, 0x24, 0x3b,
-	0x69, 0xe2, 0x04, 0x35, 0x15, 0x3b, 0x8d, 0xd1, 0x26, 0x40, 0x81, 0xc8, 0x76, 0x90, 0xa0, 0x11,
-	0x2a, 0x50, 0x76, 0x0f, 0xed, 0x41, 0xa0, 0xa8, 0x35, 0xb5, 0x90, 0xc4, 0x25, 0xb8, 0x6b, 0x41,
-	0x42, 0x81, 0xa2, 0x08, 0x7a, 0x2d, 0xd0, 0x53, 0x0f, 0xbd, 0xf5, 0x1b, 0xe4, 0x90, 0x8f, 0xd0,
-	0x43, 0x8e, 0x41, 0x4e, 0x85, 0x0b, 0x18, 0x45, 0x52, 0x20, 0x87, 0x9e, 0x7b, 0x2f, 0x48, 0x2e,
-	0x97, 0xa4, 0x2a, 0x36, 0x76, 0x9c, 0x1b, 0x77, 0xf7, 0xcd, 0xcc, 0x9b, 0x37, 0xb3, 0x7f, 0x08,
-	0x85, 0xa6, 0xd1, 0x1c, 0x76, 0x89, 0x5d, 0x6e, 0x32, 0x93, 0x32, 0xa3, 0x83, 0x6d, 0xab, 0xdc,
-	0xdf, 0x28, 0xb3, 0x81, 0xe6, 0xb8, 0x84, 0x11, 0xf9, 0x02, 0x5f, 0xd7, 0xa2, 0x75, 0xad, 0xbf,
-	0xa1, 0x2e, 0x5a, 0xc4, 0x22, 0x3e, 0xa2, 0xec, 0x7d, 0x05, 0x60, 0x75, 0xd9, 0x24, 0xb4, 0x47,
-	0x68, 0x23, 0x58, 0x08, 0x06, 0x7c, 0xe9, 0x62, 0x30, 0x2a, 0xf7, 0xa8, 0xef, 0xbf, 0x47, 0x2d,
-	0xbe, 0xb0, 0xca, 0x17, 0x4c, 0x77, 0xe8, 0x30, 0x52, 0xa6, 0xc8, 0x74, 0x36, 0xef, 0x6c, 0x75,
-	0x36, 0xca, 0x1d, 0x34, 0x0c, 0x8d, 0x57, 0xc7, 0x93, 0x74, 0x0c, 0xd7, 0xe8, 0x85, 0x98, 0x8f,
-	0x62, 0x18, 0xb3, 0x8d, 0xcc, 0x8e, 0x43, 0xb0, 0xcd, 0x3c, 0x58, 0x62, 0x82, 0xa3, 0xaf, 0xf2,
-	0xa8, 0x91, 0xb7, 0x26, 0x62, 0xc6, 0x46, 0x38, 0xe6, 0xa8, 0x62, 0x4a, 0x5c, 0xe2, 0x04, 0x80,
-	0xd5, 0x5f, 0x32, 0xb0, 0x5c, 0xa5, 0xd6, 0xb6, 0x8b, 0x0c, 0x86, 0x1e, 0x60, 0xdb, 0xe8, 0x62,
-	0x36, 0xac, 0xb9, 0xa4, 0x8f, 0x5b, 0xc8, 0x95, 0x97, 0x20, 0x4b, 0xb1, 0x65, 0x23, 0x57, 0x91,
-	0x4a, 0xd2, 0xda, 0x8c, 0xce, 0x47, 0xf2, 0x2e, 0xe4, 0x5a, 0x88, 0x9a, 0x2e, 0x76, 0x18, 0x26,
-	0xb6, 0x32, 0x59, 0x92, 0xd6, 0x72, 0x9b, 0x57, 0x34, 0xae, 0x57, 0xa4, 0xb2, 0x4f, 0x49, 0xdb,
-	0x89, 0xa0, 0x7a, 0xdc, 0x4e, 0xae, 0x02, 0x98, 0xa4, 0xd7, 0xc3, 0x94, 0x7a, 0x5e, 0x32, 0x5e,
-	0x88, 0xca, 0xfa, 0xd1, 0x71, 0xf1, 0x83, 0xc0, 0x11, 0x6d, 0x75, 0x34, 0x4c, 0xca, 0x3d, 0x83,
-	0xb5, 0xb5, 0xc7, 0xc8, 0x32, 0xcc, 0xe1, 0x0e, 0x32, 0x5f, 0x3e, 0x5b, 0x07, 0x1e, 0x67, 0x07,
-	0x99, 0x7a, 0xcc, 0x81, 0xfc, 0x19, 0x00, 0x4f, 0xb7, 0xe1, 0x74, 0x94, 0x29, 0x9f, 0x54, 0x31,
-	0x24, 0x15, 0x54, 0x47, 0x13, 0xd5, 0xd1, 0x6a, 0x87, 0xcd, 0xcf, 0xd1, 0x50, 0x9f, 0xe1, 0x26,
-	0xb5, 0x8e, 0x5c, 0x85, 0x6c, 0x93, 0x99, 0x9e, 0xed, 0x74, 0x49, 0x5a, 0x9b, 0xad, 0x6c, 0x1d,
-	0x1d, 0x17, 0x37, 0x2d, 0xcc, 0xda, 0x87, 0x4d, 0xcd, 0x24, 0xbd, 0x32, 0x47, 0x9a, 0x6d, 0x03,
-	0xdb, 0xe1, 0xa0, 0xcc, 0x86, 0x0e, 0xa2, 0x5a, 0xe5, 0x51, 0xed, 0xf6, 0xc7, 0xb7, 0xb8, 0xcb,
-	0xe9, 0x26, 0x33, 0x6b, 0x1d, 0xf9, 0x2e, 0x64, 0x1c, 0xe2, 0x28, 0x59, 0x9f, 0xc7, 0x9a, 0x36,
-	0xb6, 0x0d, 0xb5, 0x9a, 0x4b, 0xc8, 0xc1, 0x17, 0x07, 0x35, 0x42, 0x29, 0xf2, 0xb3, 0xd0, 0x3d,
-	0xa3, 0xbb, 0xb9, 0x27, 0x6f, 0x9e, 0xde, 0xe4, 0x6a, 0xaf, 0x5e, 0x81, 0x95, 0xd4, 0x12, 0xe9,
-	0x88, 0x3a, 0xc4, 0xa6, 0x68, 0xf5, 0x2f, 0x09, 0x2e, 0x56, 0xa9, 0xb5, 0xdb, 0xc2, 0xec, 0xc4,
-	0x65, 0xbc, 0x20, 0x12, 0xf6, 0x2a, 0x38, 0x1b, 0x12, 0x1f, 0xa9, 0x6e, 0xe6, 0xbd, 0x54, 0x77,
-	0xea, 0x8c, 0xd5, 0x4d, 0x4a, 0xb2, 0x02, 0xc5, 0x94, 0x64, 0x85, 0x20, 0xbf, 0x9f, 0x83, 0x25,
-	0x21, 0x5b, 0x65, 0x6f, 0x7b, 0x07, 0x75, 0x91, 0x65, 0xf8, 0xcc, 0xd2, 0xf4, 0x48, 0x36, 0xd0,
-	0xe4, 0xa9, 0x1b, 0x88, 0x57, 0x3c, 0xf3, 0x0e, 0x15, 0x8f, 0x35, 0xdf, 0xd4, 0xfb, 0x68, 0xbe,
-	0xaf, 0x61, 0xee, 0xc0, 0x69, 0x04, 0x1e, 0x1b, 0x5d, 0x4c, 0x99, 0x32, 0x5d, 0xca, 0x9c, 0xc1,
-	0x6d, 0xee, 0xc0, 0xa9, 0x78, 0x8e, 0x1f, 0x63, 0xca, 0xe4, 0x15, 0x98, 0xe5, 0x09, 0x35, 0x18,
-	0xee, 0x21, 0xbf, 0xc5, 0xf3, 0x7a, 0x8e, 0xcf, 0xed, 0xe1, 0x1e, 0x92, 0xaf, 0x40, 0x3e, 0x84,
-	0xf4, 0x8d, 0xee, 0x21, 0x52, 0xce, 0x95, 0xa4, 0xb5, 0x8c, 0x1e, 0xda, 0x7d, 0xe9, 0xcd, 0xc9,
-	0x0f, 0x01, 0x84, 0x9f, 0x81, 0xf2, 0x3f, 0x5f, 0xb6, 0x1b, 0x71, 0xd9, 0x62, 0xa7, 0x5e, 0x7f,
-	0x43, 0xdb, 0x73, 0x0d, 0x9b, 0x1a, 0xa6, 0x57, 0xc2, 0x47, 0xf6, 0x01, 0xd1, 0x67, 0xc2, 0x80,
-	0x03, 0x79, 0x13, 0x72, 0xb4, 0x6b, 0xd0, 0x36, 0x77, 0x35, 0xe3, 0x4b, 0xf8, 0xff, 0xa3, 0xe3,
-	0x62, 0xbe, 0xb2, 0xb7, 0x5d, 0xe7, 0x2b, 0x7b, 0x03, 0x1d, 0xa8, 0xf8, 0x96, 0x09, 0x2c, 0xb5,
-	0x82, 0x9e, 0x20, 0x6e, 0x43, 0x58, 0x53, 0x6c, 0x29, 0xe0, 0x9b, 0x7f, 0x7a, 0x74, 0x5c, 0xbc,
-	0x73, 0x1a, 0xa9, 0xea, 0xd8, 0xb2, 0x0d, 0x76, 0xe8, 0x22, 0x7d, 0x51, 0x38, 0x0e, 0x63, 0xd7,
-	0xb1, 0x25, 0x7f, 0x08, 0x73, 0x87, 0x76, 0x93, 0xd8, 0x2d, 0x21, 0x5c, 0xce, 0x17, 0x2e, 0x2f,
-	0x66, 0x7d, 0xe9, 0x56, 0x60, 0x36, 0x06, 0x1b, 0x28, 0xb3, 0xfe, 0xde, 0xcc, 0x45, 0xa0, 0x81,
-	0x7c, 0x1d, 0xe6, 0x23, 0x48, 0xa0, 0x6f, 0xde, 0xd7, 0x37, 0x0a, 0x10, 0x28, 0xbc, 0x0b, 0x17,
-	0x22, 0x60, 0x5c, 0xa1, 0xb9, 0x34, 0x85, 0xce, 0x0b, 0x7c, 0x34, 0x29, 0x3f, 0x91, 0xa0, 0x14,
-	0x69, 0x35, 0xc6, 0xa3, 0xa7, 0xda, 0xfc, 0x59, 0x55, 0xbb, 0x2c, 0x42, 0xec, 0x8f, 0x72, 0xa8,
-	0x63, 0x2b, 0x79, 0x00, 0x94, 0xa0, 0x30, 0x7e, 0x73, 0x8b, 0xfd, 0xff, 0xf7, 0x24, 0xc8, 0x55,
-	0x6a, 0xdd, 0x6f, 0xb5, 0xb6, 0x49, 0x1f, 0xd9, 0x86, 0xcd, 0xea, 0xd8, 0xa2, 0xa9, 0x7b, 0xff,
-	0x01, 0x4c, 0x86, 0xe7, 0xe0, 0x3b, 0x6f, 0x92, 0x49, 0xa7, 0x23, 0x5f, 0x83, 0xf9, 0xa8, 0xa7,
-	0x1b, 0x6d, 0x83, 0xb6, 0x83, 0x8b, 0x4d, 0xcf, 0x8b, 0x6e, 0x7d, 0x68, 0xd0, 0xb6, 0xbc, 0x06,
-	0x0b, 0xb1, 0x7a, 0x78, 0x02, 0x52, 0x65, 0xca, 0xdb, 0xa2, 0xfa, 0x5c, 0xd4, 0xa3, 0x3e, 0x63,
-	0x13, 0x16, 0xe2, 0xfd, 0xe0, 0x6b, 0x3d, 0x7d, 0x56, 0xad, 0xe7, 0x62, 0xed, 0xe4, 0xf5, 0xe6,
-	0x3d, 0x50, 0x05, 0x9d, 0xd1, 0x68, 0x54, 0xc9, 0xfa, 0xc4, 0x2e, 0x86, 0x88, 0xfd, 0x84, 0x2d,
-	0x4d, 0x56, 0xe6, 0x12, 0xa8, 0xff, 0x96, 0x5d, 0x54, 0xe5, 0x57, 0x09, 0x16, 0xaa, 0xd4, 0xaa,
-	0xec, 0x6d, 0xef, 0xdb, 0xbc, 0xdc, 0x28, 0xb5, 0x26, 0x63, 0xb4, 0x9c, 0x1c, 0xa7, 0xe5, 0x38,
-	0x85, 0x32, 0xef, 0x59, 0xa1, 0x64, 0x92, 0x2a, 0x28, 0xa3, 0x59, 0x88, 0x14, 0x7f, 0x96, 0xe0,
-	0x52, 0x95, 0x5a, 0x75, 0xd4, 0x45, 0x26, 0xc3, 0x7d, 0x14, 0xf6, 0xf0, 0xae, 0x77, 0x3f, 0xd9,
-	0xe6, 0xd9, 0xd3, 0x5d, 0x87, 0xf3, 0x2e, 0x32, 0x49, 0x1f, 0xb9, 0xa8, 0xd5, 0xe0, 0xa7, 0x3c,
-	0xed, 0x04, 0x19, 0xeb, 0x0b, 0x62, 0xe9, 0x81, 0x77, 0x62, 0xd7, 0x3b, 0x49, 0xe2, 0xd7, 0xe0,
-	0xea, 0x7f, 0x71, 0x13, 0x49, 0xfc, 0x24, 0xc1, 0x7c, 0x95, 0x5a, 0xfb, 0x4e, 0xcb, 0x60, 0xa8,
-	0xe6, 0x3f, 0x53, 0xe5, 0x2d, 0x98, 0x31, 0x0e, 0x59, 0x9b, 0xb8, 0x98, 0x0d, 0x03, 0xea, 0x15,
-	0xe5, 0xe5, 0xb3, 0xf5, 0x45, 0x7e, 0x41, 0xde, 0x6f, 0xb5, 0x5c, 0x44, 0x69, 0x9d, 0xb9, 0xd8,
-	0xb6, 0xf4, 0x08, 0x2a, 0xdf, 0x83, 0x6c, 0xf0, 0xd0, 0xe5, 0x57, 0xea, 0xe5, 0xb4, 0x9b, 0xd1,
-	0x07, 0x55, 0xa6, 0x9e, 0x1f, 0x17, 0x27, 0x74, 0x6e, 0x72, 0x77, 0xce, 0x63, 0x1f, 0x39, 0x5b,
-	0x5d, 0xf6, 0x9f, 0x39, 0x71, 0x5e, 0x21, 0xe7, 0xcd, 0x3f, 0xb3, 0x90, 0xa9, 0x52, 0x4b, 0xfe,
-	0x5e, 0x82, 0xa5, 0x94, 0x07, 0xed, 0xad, 0x94, 0xd0, 0xa9, 0xef, 0x2b, 0xf5, 0x93, 0xd3, 0x5a,
-	0x84, 0x74, 0xe4, 0x6f, 0x61, 0x71, 0xec, 0x6b, 0x4c, 0x4b, 0xf7, 0x38, 0x0e, 0xaf, 0x6e, 0x9d,
-	0x0e, 0x2f, 0xe2, 0x7f, 0x03, 0xe7, 0xc7, 0x3d, 0x7e, 0xd6, 0xdf, 0x96, 0x50, 0x02, 0xae, 0xde,
-	0x39, 0x15, 0x5c, 0x04, 0x27, 0x30, 0x3f, 0x7a, 0xf2, 0xde, 0x48, 0xf7, 0x34, 0x02, 0x55, 0x37,
-	0x4e, 0x0c, 0x15, 0x01, 0x31, 0xe4, 0x93, 0x87, 0xca, 0xf5, 0x74, 0x1f, 0x09, 0xa0, 0x5a, 0x3e,
-	0x21, 0x50, 0x84, 0xfa, 0x41, 0x82, 0xe5, 0xf4, 0xdd, 0x7d, 0x3b, 0xdd, 0x5d, 0xaa, 0x91, 0x7a,
-	0xef, 0x1d, 0x8c, 0x04, 0x9f, 0x03, 0x98, 0x4d, 0xec, 0xd3, 0x6b, 0xe9, 0xce, 0xe2, 0x38, 0x55,
-	0x3b, 0x19, 0x2e, 0x8c, 0xa3, 0x4e, 0x7f, 0xf7, 0xe6, 0xe9, 0x4d, 0xa9, 0xf2, 0xf8, 0xf9, 0xab,
-	0x82, 0xf4, 0xe2, 0x55, 0x41, 0xfa, 0xe3, 0x55, 0x41, 0xfa, 0xf1, 0x75, 0x61, 0xe2, 0xc5, 0xeb,
-	0xc2, 0xc4, 0x6f, 0xaf, 0x0b, 0x13, 0x5f, 0xbd, 0xf5, 0xce, 0x1c, 0xc4, 0xff, 0x43, 0xfd, 0x63,
-	0xb7, 0x99, 0xf5, 0xff, 0x43, 0x6f, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0xfd, 0xd3, 0xc8, 0xf6,
-	0xc7, 0x0f, 0x00, 0x00,
+func (m *MsgPruneInactiveDelegations) Reset()         { *m = MsgPruneInactiveDelegations{} }
+func (m *MsgPruneInactiveDelegations) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneInactiveDelegations) ProtoMessage()    {}
+func (*MsgPruneInactiveDelegations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{20}
+}
+func (m *MsgPruneInactiveDelegations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneInactiveDelegations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneInactiveDelegations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneInactiveDelegations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneInactiveDelegations.Merge(m, src)
+}
+func (m *MsgPruneInactiveDelegations) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneInactiveDelegations) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneInactiveDelegations.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgPruneInactiveDelegations proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgPruneInactiveDelegations) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
+func (m *MsgPruneInactiveDelegations) GetOlderThanBlocks() uint64 {
+	if m != nil {
+		return m.OlderThanBlocks
+	}
+	return 0
+}
+
+// MsgPruneInactiveDelegationsResponse is the response to the
+// MsgPruneInactiveDelegations message.
+type MsgPruneInactiveDelegationsResponse struct {
+	// pruned_staking_tx_hash_hex_list is the list of staking tx hashes of the
+	// pruned BTC delegations
+	PrunedStakingTxHashHexList []string `protobuf:"bytes,1,rep,name=pruned_staking_tx_hash_hex_list,json=prunedStakingTxHashHexList,proto3" json:"pruned_staking_tx_hash_hex_list,omitempty"`
+}
+
+func (m *MsgPruneInactiveDelegationsResponse) Reset()         { *m = MsgPruneInactiveDelegationsResponse{} }
+func (m *MsgPruneInactiveDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneInactiveDelegationsResponse) ProtoMessage()    {}
+func (*MsgPruneInactiveDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{21}
+}
+func (m *MsgPruneInactiveDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneInactiveDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneInactiveDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPruneInactiveDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneInactiveDelegationsResponse.Merge(m, src)
+}
+func (m *MsgPruneInactiveDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneInactiveDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneInactiveDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPruneInactiveDelegationsResponse proto.InternalMessageInfo
+
+func (m *MsgPruneInactiveDelegationsResponse) GetPrunedStakingTxHashHexList() []string {
+	if m != nil {
+		return m.PrunedStakingTxHashHexList
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgCreateFinalityProvider)(nil), "babylon.btcstaking.v1.MsgCreateFinalityProvider")
+	proto.RegisterType((*MsgCreateFinalityProviderResponse)(nil), "babylon.btcstaking.v1.MsgCreateFinalityProviderResponse")
+	proto.RegisterType((*MsgEditFinalityProvider)(nil), "babylon.btcstaking.v1.MsgEditFinalityProvider")
+	proto.RegisterType((*MsgEditFinalityProviderResponse)(nil), "babylon.btcstaking.v1.MsgEditFinalityProviderResponse")
+	proto.RegisterType((*MsgCreateBTCDelegation)(nil), "babylon.btcstaking.v1.MsgCreateBTCDelegation")
+	proto.RegisterType((*MsgCreateBTCDelegationResponse)(nil), "babylon.btcstaking.v1.MsgCreateBTCDelegationResponse")
+	proto.RegisterType((*MsgAddCovenantSigs)(nil), "babylon.btcstaking.v1.MsgAddCovenantSigs")
+	proto.RegisterType((*MsgAddCovenantSigsResponse)(nil), "babylon.btcstaking.v1.MsgAddCovenantSigsResponse")
+	proto.RegisterType((*MsgCreateBTCDelegationWithCovenantSigs)(nil), "babylon.btcstaking.v1.MsgCreateBTCDelegationWithCovenantSigs")
+	proto.RegisterType((*MsgCreateBTCDelegationWithCovenantSigsResponse)(nil), "babylon.btcstaking.v1.MsgCreateBTCDelegationWithCovenantSigsResponse")
+	proto.RegisterType((*MsgActivateReservedDelegation)(nil), "babylon.btcstaking.v1.MsgActivateReservedDelegation")
+	proto.RegisterType((*MsgActivateReservedDelegationResponse)(nil), "babylon.btcstaking.v1.MsgActivateReservedDelegationResponse")
+	proto.RegisterType((*MsgAddSlashingTxInclusionProof)(nil), "babylon.btcstaking.v1.MsgAddSlashingTxInclusionProof")
+	proto.RegisterType((*MsgAddSlashingTxInclusionProofResponse)(nil), "babylon.btcstaking.v1.MsgAddSlashingTxInclusionProofResponse")
+	proto.RegisterType((*MsgBTCUndelegate)(nil), "babylon.btcstaking.v1.MsgBTCUndelegate")
+	proto.RegisterType((*MsgBTCUndelegateResponse)(nil), "babylon.btcstaking.v1.MsgBTCUndelegateResponse")
+	proto.RegisterType((*MsgSelectiveSlashingEvidence)(nil), "babylon.btcstaking.v1.MsgSelectiveSlashingEvidence")
+	proto.RegisterType((*MsgSelectiveSlashingEvidenceResponse)(nil), "babylon.btcstaking.v1.MsgSelectiveSlashingEvidenceResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "babylon.btcstaking.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylon.btcstaking.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgPruneInactiveDelegations)(nil), "babylon.btcstaking.v1.MsgPruneInactiveDelegations")
+	proto.RegisterType((*MsgPruneInactiveDelegationsResponse)(nil), "babylon.btcstaking.v1.MsgPruneInactiveDelegationsResponse")
+}
+
+func init() { proto.RegisterFile("babylon/btcstaking/v1/tx.proto", fileDescriptor_4baddb53e97f38f2) }
+
+var fileDescriptor_4baddb53e97f38f2 = []byte{
+	// 1624 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x6c, 0xdb, 0xc8,
+	0x15, 0x0e, 0x2d, 0xdb, 0x1b, 0x3f, 0x49, 0xb6, 0xc3, 0xfc, 0xd1, 0xcc, 0x46, 0x92, 0xed, 0xac,
+	0xa3, 0x06, 0x35, 0x15, 0x3b, 0x6b, 0xa3, 0x75, 0xba, 0x05, 0x22, 0xdb, 0x41, 0xdc, 0x46, 0x8d,
+	0x40, 0xd9, 0x2d, 0xd0, 0x1e, 0x04, 0x8a, 0x1c, 0x53, 0xac, 0x24, 0x0e, 0xc1, 0x19, 0xa9, 0x12,
+	0x0a, 0x14, 0xc5, 0xa2, 0xa7, 0x02, 0x05, 0xb6, 0x28, 0xd0, 0x43, 0x81, 0x1e, 0x7a, 0xee, 0x65,
+	0x0f, 0x8b, 0x02, 0xbd, 0xf7, 0x90, 0xe3, 0x62, 0x4f, 0x85, 0x0f, 0x46, 0x91, 0xa0, 0xd8, 0x43,
+	0xcf, 0xbd, 0x2f, 0xf8, 0x37, 0x24, 0xb5, 0xa2, 0x22, 0x59, 0xb9, 0x69, 0x66, 0xde, 0xcf, 0xf7,
+	0xbe, 0xf7, 0xe6, 0xbd, 0xa1, 0x20, 0xd7, 0x50, 0x1a, 0x83, 0x36, 0x36, 0x4b, 0x0d, 0xaa, 0x12,
+	0xaa, 0xb4, 0x0c, 0x53, 0x2f, 0xf5, 0x76, 0x4a, 0xb4, 0x2f, 0x59, 0x36, 0xa6, 0x98, 0xbf, 0xed,
+	0x9f, 0x4b, 0xe1, 0xb9, 0xd4, 0xdb, 0x11, 0x6f, 0xe9, 0x58, 0xc7, 0xae, 0x44, 0xc9, 0xf9, 0xe5,
+	0x09, 0x8b, 0x6b, 0x2a, 0x26, 0x1d, 0x4c, 0xea, 0xde, 0x81, 0xb7, 0xf0, 0x8f, 0xee, 0x7a, 0xab,
+	0x52, 0x87, 0xb8, 0xf6, 0x3b, 0x44, 0xf7, 0x0f, 0x36, 0xfc, 0x03, 0xd5, 0x1e, 0x58, 0x14, 0x97,
+	0x08, 0x52, 0xad, 0xdd, 0xbd, 0xfd, 0xd6, 0x4e, 0xa9, 0x85, 0x06, 0x81, 0xf2, 0xc6, 0x68, 0x90,
+	0x96, 0x62, 0x2b, 0x9d, 0x40, 0xe6, 0xbb, 0x11, 0x19, 0xb5, 0x89, 0xd4, 0x96, 0x85, 0x0d, 0x93,
+	0x3a, 0x62, 0xb1, 0x0d, 0x5f, 0xfa, 0x81, 0xef, 0x35, 0xb4, 0xd6, 0x40, 0x54, 0xd9, 0x09, 0xd6,
+	0xbe, 0x54, 0x3e, 0xc1, 0x2f, 0xb6, 0x3c, 0x81, 0x8d, 0xbf, 0xa5, 0x60, 0xad, 0x42, 0xf4, 0x43,
+	0x1b, 0x29, 0x14, 0x3d, 0x37, 0x4c, 0xa5, 0x6d, 0xd0, 0x41, 0xd5, 0xc6, 0x3d, 0x43, 0x43, 0x36,
+	0x7f, 0x07, 0x16, 0x89, 0xa1, 0x9b, 0xc8, 0x16, 0xb8, 0x02, 0x57, 0x5c, 0x92, 0xfd, 0x15, 0x7f,
+	0x0c, 0x69, 0x0d, 0x11, 0xd5, 0x36, 0x2c, 0x6a, 0x60, 0x53, 0x98, 0x2b, 0x70, 0xc5, 0xf4, 0xee,
+	0xa6, 0xe4, 0xf3, 0x15, 0xb2, 0xec, 0x42, 0x92, 0x8e, 0x42, 0x51, 0x39, 0xaa, 0xc7, 0x57, 0x00,
+	0x54, 0xdc, 0xe9, 0x18, 0x84, 0x38, 0x56, 0x52, 0x8e, 0x8b, 0xf2, 0xf6, 0xc5, 0x65, 0xfe, 0x9e,
+	0x67, 0x88, 0x68, 0x2d, 0xc9, 0xc0, 0xa5, 0x8e, 0x42, 0x9b, 0xd2, 0x4b, 0xa4, 0x2b, 0xea, 0xe0,
+	0x08, 0xa9, 0x5f, 0x7d, 0xb1, 0x0d, 0xbe, 0x9f, 0x23, 0xa4, 0xca, 0x11, 0x03, 0xfc, 0x0f, 0x01,
+	0xfc, 0x70, 0xeb, 0x56, 0x4b, 0x98, 0x77, 0x41, 0xe5, 0x03, 0x50, 0x5e, 0x76, 0x24, 0x96, 0x1d,
+	0xa9, 0xda, 0x6d, 0xfc, 0x18, 0x0d, 0xe4, 0x25, 0x5f, 0xa5, 0xda, 0xe2, 0x2b, 0xb0, 0xd8, 0xa0,
+	0xaa, 0xa3, 0xbb, 0x50, 0xe0, 0x8a, 0x99, 0xf2, 0xfe, 0xc5, 0x65, 0x7e, 0x57, 0x37, 0x68, 0xb3,
+	0xdb, 0x90, 0x54, 0xdc, 0x29, 0xf9, 0x92, 0x6a, 0x53, 0x31, 0xcc, 0x60, 0x51, 0xa2, 0x03, 0x0b,
+	0x11, 0xa9, 0x7c, 0x52, 0x7d, 0xf2, 0xf1, 0x63, 0xdf, 0xe4, 0x42, 0x83, 0xaa, 0xd5, 0x16, 0x7f,
+	0x00, 0x29, 0x0b, 0x5b, 0xc2, 0xa2, 0x8b, 0xa3, 0x28, 0x8d, 0x2c, 0x43, 0xa9, 0x6a, 0x63, 0x7c,
+	0xfe, 0xea, 0xbc, 0x8a, 0x09, 0x41, 0x6e, 0x14, 0xb2, 0xa3, 0x74, 0x90, 0xfe, 0xf4, 0xeb, 0xcf,
+	0x1f, 0xf9, 0x6c, 0x6f, 0x6c, 0xc2, 0x7a, 0x62, 0x8a, 0x64, 0x44, 0x2c, 0x6c, 0x12, 0xb4, 0xf1,
+	0x3f, 0x0e, 0xee, 0x56, 0x88, 0x7e, 0xac, 0x19, 0x74, 0xe2, 0x34, 0xde, 0x66, 0x01, 0x3b, 0x19,
+	0xcc, 0x04, 0xc0, 0x87, 0xb2, 0x9b, 0x7a, 0x2f, 0xd9, 0x9d, 0x9f, 0x31, 0xbb, 0x71, 0x4a, 0xd6,
+	0x21, 0x9f, 0x10, 0x2c, 0x23, 0xe4, 0xaf, 0xd7, 0xe1, 0x0e, 0xa3, 0xad, 0x7c, 0x7a, 0x78, 0x84,
+	0xda, 0x48, 0x57, 0x5c, 0x64, 0x49, 0x7c, 0xc4, 0x0b, 0x68, 0x6e, 0xea, 0x02, 0xf2, 0x33, 0x9e,
+	0xba, 0x42, 0xc6, 0x23, 0xc5, 0x37, 0xff, 0x3e, 0x8a, 0xef, 0x17, 0xb0, 0x7c, 0x6e, 0xd5, 0x3d,
+	0x8b, 0xf5, 0xb6, 0x41, 0xa8, 0xb0, 0x50, 0x48, 0xcd, 0x60, 0x36, 0x7d, 0x6e, 0x95, 0x1d, 0xc3,
+	0x2f, 0x0d, 0x42, 0xf9, 0x75, 0xc8, 0xf8, 0x01, 0xd5, 0xa9, 0xd1, 0x41, 0x6e, 0x89, 0x67, 0xe5,
+	0xb4, 0xbf, 0x77, 0x6a, 0x74, 0x10, 0xbf, 0x09, 0xd9, 0x40, 0xa4, 0xa7, 0xb4, 0xbb, 0x48, 0xf8,
+	0xa0, 0xc0, 0x15, 0x53, 0x72, 0xa0, 0xf7, 0x53, 0x67, 0x8f, 0x7f, 0x01, 0xc0, 0xec, 0xf4, 0x85,
+	0xeb, 0x2e, 0x6d, 0xdf, 0x89, 0xd2, 0x16, 0xe9, 0x7a, 0xbd, 0x1d, 0xe9, 0xd4, 0x56, 0x4c, 0xa2,
+	0xa8, 0x4e, 0x0a, 0x4f, 0xcc, 0x73, 0x2c, 0x2f, 0x05, 0x0e, 0xfb, 0xfc, 0x2e, 0xa4, 0x49, 0x5b,
+	0x21, 0x4d, 0xdf, 0xd4, 0x92, 0x4b, 0xe1, 0x8d, 0x8b, 0xcb, 0x7c, 0xb6, 0x7c, 0x7a, 0x58, 0xf3,
+	0x4f, 0x4e, 0xfb, 0x32, 0x10, 0xf6, 0x9b, 0xc7, 0x70, 0x47, 0xf3, 0x6a, 0x02, 0xdb, 0x75, 0xa6,
+	0x4d, 0x0c, 0x5d, 0x00, 0x57, 0xfd, 0xfb, 0x17, 0x97, 0xf9, 0xbd, 0x69, 0xa8, 0xaa, 0x19, 0xba,
+	0xa9, 0xd0, 0xae, 0x8d, 0xe4, 0x5b, 0xcc, 0x70, 0xe0, 0xbb, 0x66, 0xe8, 0xfc, 0x47, 0xb0, 0xdc,
+	0x35, 0x1b, 0xd8, 0xd4, 0x18, 0x71, 0x69, 0x97, 0xb8, 0x2c, 0xdb, 0x75, 0xa9, 0x5b, 0x87, 0x4c,
+	0x44, 0xac, 0x2f, 0x64, 0xdc, 0xbb, 0x99, 0x0e, 0x85, 0xfa, 0xfc, 0x43, 0x58, 0x09, 0x45, 0x3c,
+	0x7e, 0xb3, 0x2e, 0xbf, 0xa1, 0x03, 0x8f, 0xe1, 0x63, 0xb8, 0x1d, 0x0a, 0x46, 0x19, 0x5a, 0x4e,
+	0x62, 0xe8, 0x26, 0x93, 0x0f, 0x37, 0xf9, 0x4f, 0x39, 0x28, 0x84, 0x5c, 0x8d, 0xb0, 0xe8, 0xb0,
+	0xb6, 0x32, 0x2b, 0x6b, 0xf7, 0x99, 0x8b, 0xb3, 0x61, 0x0c, 0x0e, 0x7d, 0x0f, 0x60, 0xd9, 0x46,
+	0xbf, 0x52, 0x6c, 0xad, 0x8e, 0x2d, 0x5a, 0xc7, 0x5d, 0x2a, 0xac, 0x16, 0xb8, 0xe2, 0x75, 0x39,
+	0xe3, 0xed, 0xbe, 0xb2, 0xe8, 0xab, 0x2e, 0xe5, 0x45, 0xb8, 0x6e, 0x23, 0x82, 0xec, 0x1e, 0xd2,
+	0x84, 0x1b, 0xee, 0x39, 0x5b, 0xc7, 0x5b, 0x48, 0x01, 0x72, 0xa3, 0xdb, 0x03, 0xeb, 0x20, 0xff,
+	0x9f, 0x03, 0xbe, 0x42, 0xf4, 0x67, 0x9a, 0x76, 0x88, 0x7b, 0xc8, 0x54, 0x4c, 0x5a, 0x33, 0x74,
+	0x92, 0xd8, 0x3d, 0x9e, 0xc3, 0x5c, 0xd0, 0x49, 0xaf, 0x7c, 0xcd, 0xe6, 0xac, 0x16, 0xbf, 0x05,
+	0x2b, 0xe1, 0xad, 0xa8, 0x37, 0x15, 0xd2, 0xf4, 0x46, 0xa3, 0x9c, 0x65, 0xf5, 0xfe, 0x42, 0x21,
+	0x4d, 0xbe, 0x08, 0xab, 0x91, 0x8c, 0x3a, 0x29, 0x20, 0xc2, 0xbc, 0x73, 0xc9, 0xe5, 0xe5, 0xb0,
+	0xca, 0x5d, 0xc4, 0x2a, 0xac, 0x46, 0x2b, 0xca, 0xcd, 0xd6, 0xc2, 0xac, 0xd9, 0x5a, 0x8e, 0x14,
+	0xa4, 0x93, 0x9e, 0xa7, 0x20, 0x32, 0x38, 0xc3, 0xde, 0x88, 0xb0, 0xe8, 0x02, 0xbb, 0x1b, 0x48,
+	0x9c, 0xc5, 0x74, 0x49, 0x3c, 0x33, 0x1f, 0x82, 0xf8, 0x6d, 0xda, 0x59, 0x56, 0xfe, 0xcb, 0xc1,
+	0xd6, 0xe8, 0xc4, 0xfd, 0xcc, 0xa0, 0xcd, 0x09, 0x33, 0xf5, 0x81, 0xd3, 0x19, 0x35, 0xd4, 0xf6,
+	0x9b, 0xfc, 0x76, 0x42, 0xaf, 0x4e, 0x28, 0x10, 0xa7, 0x53, 0x1f, 0xa1, 0x36, 0xff, 0x13, 0xc8,
+	0xaa, 0xbe, 0x3f, 0x2f, 0xca, 0x54, 0x21, 0x35, 0xdc, 0xc2, 0xe2, 0xd6, 0x86, 0x83, 0xca, 0xa8,
+	0x91, 0x55, 0x9c, 0x85, 0xc7, 0x20, 0x4d, 0x16, 0x26, 0x63, 0xe6, 0x1f, 0x1c, 0xdc, 0x77, 0x7c,
+	0xa8, 0xd4, 0xe8, 0x29, 0x14, 0xc9, 0x7e, 0xd9, 0x4f, 0x30, 0xf8, 0x46, 0x94, 0xdc, 0xdc, 0xa8,
+	0x92, 0x8b, 0x37, 0xec, 0xd4, 0xd5, 0x1b, 0x76, 0x3c, 0xd4, 0x87, 0xf0, 0xd1, 0x58, 0xdc, 0x2c,
+	0xc2, 0x7f, 0x72, 0xee, 0xa5, 0x7d, 0xa6, 0x69, 0x61, 0x73, 0x3a, 0x31, 0xd5, 0x76, 0x97, 0x18,
+	0xd8, 0x74, 0x07, 0xeb, 0xcc, 0x21, 0xfe, 0x28, 0x3e, 0x49, 0xa6, 0x8e, 0x31, 0x32, 0x61, 0xe2,
+	0x41, 0x16, 0x61, 0x6b, 0x3c, 0x74, 0x16, 0xe5, 0xbf, 0x38, 0x58, 0xad, 0x10, 0xbd, 0x7c, 0x7a,
+	0x78, 0x66, 0xfa, 0x2d, 0x11, 0xcd, 0x1c, 0xd7, 0xa8, 0x1e, 0x90, 0x7a, 0xcf, 0x3d, 0x20, 0x1e,
+	0xb0, 0x08, 0xc2, 0x70, 0x14, 0x2c, 0xc4, 0xbf, 0x70, 0xf0, 0x61, 0x85, 0xe8, 0x35, 0xd4, 0x46,
+	0x4e, 0xd6, 0x51, 0xc0, 0xc9, 0xb1, 0xf3, 0x86, 0x33, 0xd5, 0xd9, 0xc3, 0xdd, 0x86, 0x9b, 0x36,
+	0x72, 0x2e, 0x97, 0x8d, 0xb4, 0xba, 0xff, 0x12, 0x22, 0x2d, 0x2f, 0x62, 0x79, 0x95, 0x1d, 0x3d,
+	0x77, 0x5e, 0x35, 0xb5, 0x56, 0x1c, 0xf8, 0x16, 0x3c, 0x18, 0x87, 0x8d, 0x05, 0xf1, 0x67, 0x0e,
+	0x56, 0x2a, 0x44, 0x3f, 0xb3, 0x34, 0x85, 0xa2, 0xaa, 0xfb, 0x29, 0xc7, 0xef, 0xc3, 0x92, 0xd2,
+	0xa5, 0x4d, 0x6c, 0x1b, 0x74, 0xe0, 0x41, 0x2f, 0x0b, 0x5f, 0x7d, 0xb1, 0x7d, 0xcb, 0x7f, 0x44,
+	0x3e, 0xd3, 0x34, 0x1b, 0x11, 0x52, 0xa3, 0xb6, 0x61, 0xea, 0x72, 0x28, 0xca, 0x3f, 0x85, 0x45,
+	0xef, 0x63, 0xd0, 0xef, 0x48, 0xf7, 0x93, 0x5e, 0x8f, 0xae, 0x50, 0x79, 0xfe, 0xf5, 0x65, 0xfe,
+	0x9a, 0xec, 0xab, 0x1c, 0x2c, 0x3b, 0xe8, 0x43, 0x63, 0x1b, 0x6b, 0x70, 0x77, 0x08, 0x17, 0xc3,
+	0xfc, 0x47, 0x0e, 0xee, 0x55, 0x88, 0x5e, 0xb5, 0xbb, 0x26, 0x3a, 0x31, 0x15, 0x37, 0xc0, 0xf0,
+	0xa2, 0x5d, 0x1d, 0xff, 0x23, 0xb8, 0x81, 0xdb, 0x1a, 0xb2, 0xeb, 0xb4, 0xa9, 0x98, 0xf5, 0x46,
+	0x1b, 0xab, 0x2d, 0x2f, 0x94, 0x79, 0x79, 0xc5, 0x3d, 0x38, 0x6d, 0x2a, 0x66, 0xd9, 0xdd, 0xfe,
+	0x16, 0xdc, 0x5f, 0xc2, 0xe6, 0x18, 0x48, 0x01, 0x74, 0xfe, 0x10, 0xf2, 0x96, 0x23, 0xa3, 0xd5,
+	0x87, 0x2a, 0xa0, 0xde, 0x44, 0x7d, 0xef, 0x8d, 0xcb, 0x15, 0x52, 0xc5, 0x25, 0x59, 0xf4, 0xc4,
+	0x6a, 0xd1, 0x82, 0x78, 0x81, 0xfa, 0xce, 0xd3, 0x75, 0xf7, 0x75, 0x1a, 0x52, 0x15, 0xa2, 0xf3,
+	0xbf, 0xe3, 0xe0, 0x4e, 0xc2, 0x47, 0xef, 0xe3, 0x77, 0x0d, 0x83, 0x61, 0x0d, 0xf1, 0x7b, 0xd3,
+	0x6a, 0xb0, 0x98, 0x7e, 0x03, 0xb7, 0x46, 0x7e, 0xb1, 0x49, 0xc9, 0x16, 0x47, 0xc9, 0x8b, 0xfb,
+	0xd3, 0xc9, 0x33, 0xff, 0xbf, 0x86, 0x9b, 0xa3, 0x3e, 0x90, 0xa6, 0x9b, 0x87, 0xe2, 0xde, 0x74,
+	0xe3, 0x33, 0x70, 0x8e, 0x61, 0x65, 0xf8, 0x6d, 0x35, 0xf9, 0xe8, 0x14, 0x77, 0x26, 0x9f, 0xb2,
+	0x81, 0xc3, 0xbf, 0x73, 0xb0, 0x39, 0xc9, 0xbb, 0xe1, 0x93, 0xa9, 0xe2, 0x19, 0x56, 0x17, 0x8f,
+	0x67, 0x52, 0x67, 0x68, 0x3f, 0xe3, 0x40, 0x1c, 0x33, 0xcb, 0x3f, 0x1e, 0x13, 0x7f, 0xa2, 0x96,
+	0xf8, 0x83, 0xab, 0x68, 0x31, 0x48, 0x7f, 0xe2, 0xe0, 0xde, 0xb8, 0xe1, 0xbb, 0x37, 0x36, 0x27,
+	0x49, 0x6a, 0xe2, 0x27, 0x57, 0x52, 0x63, 0xa8, 0x0c, 0xc8, 0xc6, 0x67, 0xe5, 0xc3, 0x64, 0x7b,
+	0x31, 0x41, 0xb1, 0x34, 0xa1, 0x20, 0x73, 0xf5, 0x07, 0x0e, 0xd6, 0x92, 0x87, 0xd6, 0x93, 0x64,
+	0x73, 0x89, 0x4a, 0xe2, 0xd3, 0x2b, 0x28, 0x31, 0x3c, 0xe7, 0x90, 0x89, 0x8d, 0x9f, 0xad, 0x64,
+	0x63, 0x51, 0x39, 0x51, 0x9a, 0x4c, 0x8e, 0xf9, 0xf9, 0x3d, 0x07, 0x42, 0xe2, 0xcc, 0xd8, 0x4d,
+	0x36, 0x96, 0xa4, 0x23, 0x1e, 0x4c, 0xaf, 0x13, 0x80, 0x11, 0x17, 0x7e, 0xfb, 0xf5, 0xe7, 0x8f,
+	0xb8, 0xf2, 0xcb, 0xd7, 0x6f, 0x72, 0xdc, 0x97, 0x6f, 0x72, 0xdc, 0x7f, 0xde, 0xe4, 0xb8, 0xcf,
+	0xde, 0xe6, 0xae, 0x7d, 0xf9, 0x36, 0x77, 0xed, 0xdf, 0x6f, 0x73, 0xd7, 0x7e, 0xfe, 0xce, 0x2f,
+	0xaf, 0x7e, 0xf4, 0xff, 0x50, 0xf7, 0x69, 0xd3, 0x58, 0x74, 0xff, 0x0f, 0x7d, 0xf2, 0xcd, 0x00,
+	0xc3, 0x29, 0x68, 0x6f, 0x4f, 0x16, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	// CreateFinalityProvider creates a new finality provider
@@ -974,6 +1456,15 @@ type MsgClient interface {
 	CreateBTCDelegation(ctx context.Context, in *MsgCreateBTCDelegation, opts ...grpc.CallOption) (*MsgCreateBTCDelegationResponse, error)
 	// AddCovenantSigs handles signatures from a covenant member
 	AddCovenantSigs(ctx context.Context, in *MsgAddCovenantSigs, opts ...grpc.CallOption) (*MsgAddCovenantSigsResponse, error)
+	// CreateBTCDelegationWithCovenantSigs creates a new BTC delegation and adds
+	// signatures from covenant members to it atomically
+	CreateBTCDelegationWithCovenantSigs(ctx context.Context, in *MsgCreateBTCDelegationWithCovenantSigs, opts ...grpc.CallOption) (*MsgCreateBTCDelegationWithCovenantSigsResponse, error)
+	// ActivateReservedDelegation activates a reserved BTC delegation with the
+	// inclusion proof of its staking tx
+	ActivateReservedDelegation(ctx context.Context, in *MsgActivateReservedDelegation, opts ...grpc.CallOption) (*MsgActivateReservedDelegationResponse, error)
+	// AddSlashingTxInclusionProof marks a BTC delegation as slashed with the
+	// inclusion proof of its slashing tx in BTC
+	AddSlashingTxInclusionProof(ctx context.Context, in *MsgAddSlashingTxInclusionProof, opts ...grpc.CallOption) (*MsgAddSlashingTxInclusionProofResponse, error)
 	// BTCUndelegate handles a signature on unbonding tx from its delegator
 	BTCUndelegate(ctx context.Context, in *MsgBTCUndelegate, opts ...grpc.CallOption) (*MsgBTCUndelegateResponse, error)
 	// SelectiveSlashingEvidence handles the evidence of selective slashing launched
@@ -981,6 +1472,9 @@ type MsgClient interface {
 	SelectiveSlashingEvidence(ctx context.Context, in *MsgSelectiveSlashingEvidence, opts ...grpc.CallOption) (*MsgSelectiveSlashingEvidenceResponse, error)
 	// UpdateParams updates the btcstaking module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// PruneInactiveDelegations prunes the BTC delegations that have been
+	// unbonded for long and whose rewards are fully withdrawn
+	PruneInactiveDelegations(ctx context.Context, in *MsgPruneInactiveDelegations, opts ...grpc.CallOption) (*MsgPruneInactiveDelegationsResponse, error)
 }
 
 type msgClient struct {
@@ -1027,6 +1521,33 @@ func (c *msgClient) AddCovenantSigs(ctx context.Context, in *MsgAddCovenantSigs,
 	return out, nil
 }
 
+func (c *msgClient) CreateBTCDelegationWithCovenantSigs(ctx context.Context, in *MsgCreateBTCDelegationWithCovenantSigs, opts ...grpc.CallOption) (*MsgCreateBTCDelegationWithCovenantSigsResponse, error) {
+	out := new(MsgCreateBTCDelegationWithCovenantSigsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/CreateBTCDelegationWithCovenantSigs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ActivateReservedDelegation(ctx context.Context, in *MsgActivateReservedDelegation, opts ...grpc.CallOption) (*MsgActivateReservedDelegationResponse, error) {
+	out := new(MsgActivateReservedDelegationResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/ActivateReservedDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddSlashingTxInclusionProof(ctx context.Context, in *MsgAddSlashingTxInclusionProof, opts ...grpc.CallOption) (*MsgAddSlashingTxInclusionProofResponse, error) {
+	out := new(MsgAddSlashingTxInclusionProofResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/AddSlashingTxInclusionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) BTCUndelegate(ctx context.Context, in *MsgBTCUndelegate, opts ...grpc.CallOption) (*MsgBTCUndelegateResponse, error) {
 	out := new(MsgBTCUndelegateResponse)
 	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/BTCUndelegate", in, out, opts...)
@@ -1054,6 +1575,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) PruneInactiveDelegations(ctx context.Context, in *MsgPruneInactiveDelegations, opts ...grpc.CallOption) (*MsgPruneInactiveDelegationsResponse, error) {
+	out := new(MsgPruneInactiveDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/PruneInactiveDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateFinalityProvider creates a new finality provider
@@ -1064,6 +1594,15 @@ type MsgServer interface {
 	CreateBTCDelegation(context.Context, *MsgCreateBTCDelegation) (*MsgCreateBTCDelegationResponse, error)
 	// AddCovenantSigs handles signatures from a covenant member
 	AddCovenantSigs(context.Context, *MsgAddCovenantSigs) (*MsgAddCovenantSigsResponse, error)
+	// CreateBTCDelegationWithCovenantSigs creates a new BTC delegation and adds
+	// signatures from covenant members to it atomically
+	CreateBTCDelegationWithCovenantSigs(context.Context, *MsgCreateBTCDelegationWithCovenantSigs) (*MsgCreateBTCDelegationWithCovenantSigsResponse, error)
+	// ActivateReservedDelegation activates a reserved BTC delegation with the
+	// inclusion proof of its staking tx
+	ActivateReservedDelegation(context.Context, *MsgActivateReservedDelegation) (*MsgActivateReservedDelegationResponse, error)
+	// AddSlashingTxInclusionProof marks a BTC delegation as slashed with the
+	// inclusion proof of its slashing tx in BTC
+	AddSlashingTxInclusionProof(context.Context, *MsgAddSlashingTxInclusionProof) (*MsgAddSlashingTxInclusionProofResponse, error)
 	// BTCUndelegate handles a signature on unbonding tx from its delegator
 	BTCUndelegate(context.Context, *MsgBTCUndelegate) (*MsgBTCUndelegateResponse, error)
 	// SelectiveSlashingEvidence handles the evidence of selective slashing launched
@@ -1071,6 +1610,9 @@ type MsgServer interface {
 	SelectiveSlashingEvidence(context.Context, *MsgSelectiveSlashingEvidence) (*MsgSelectiveSlashingEvidenceResponse, error)
 	// UpdateParams updates the btcstaking module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// PruneInactiveDelegations prunes the BTC delegations that have been
+	// unbonded for long and whose rewards are fully withdrawn
+	PruneInactiveDelegations(context.Context, *MsgPruneInactiveDelegations) (*MsgPruneInactiveDelegationsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1089,6 +1631,15 @@ func (*UnimplementedMsgServer) CreateBTCDelegation(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) AddCovenantSigs(ctx context.Context, req *MsgAddCovenantSigs) (*MsgAddCovenantSigsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddCovenantSigs not implemented")
 }
+func (*UnimplementedMsgServer) CreateBTCDelegationWithCovenantSigs(ctx context.Context, req *MsgCreateBTCDelegationWithCovenantSigs) (*MsgCreateBTCDelegationWithCovenantSigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBTCDelegationWithCovenantSigs not implemented")
+}
+func (*UnimplementedMsgServer) ActivateReservedDelegation(ctx context.Context, req *MsgActivateReservedDelegation) (*MsgActivateReservedDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateReservedDelegation not implemented")
+}
+func (*UnimplementedMsgServer) AddSlashingTxInclusionProof(ctx context.Context, req *MsgAddSlashingTxInclusionProof) (*MsgAddSlashingTxInclusionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSlashingTxInclusionProof not implemented")
+}
 func (*UnimplementedMsgServer) BTCUndelegate(ctx context.Context, req *MsgBTCUndelegate) (*MsgBTCUndelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BTCUndelegate not implemented")
 }
@@ -1098,6 +1649,9 @@ func (*UnimplementedMsgServer) SelectiveSlashingEvidence(ctx context.Context, re
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) PruneInactiveDelegations(ctx context.Context, req *MsgPruneInactiveDelegations) (*MsgPruneInactiveDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneInactiveDelegations not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1175,6 +1729,60 @@ func _Msg_AddCovenantSigs_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateBTCDelegationWithCovenantSigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateBTCDelegationWithCovenantSigs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateBTCDelegationWithCovenantSigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/CreateBTCDelegationWithCovenantSigs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateBTCDelegationWithCovenantSigs(ctx, req.(*MsgCreateBTCDelegationWithCovenantSigs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ActivateReservedDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgActivateReservedDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ActivateReservedDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/ActivateReservedDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ActivateReservedDelegation(ctx, req.(*MsgActivateReservedDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddSlashingTxInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddSlashingTxInclusionProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddSlashingTxInclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/AddSlashingTxInclusionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddSlashingTxInclusionProof(ctx, req.(*MsgAddSlashingTxInclusionProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_BTCUndelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgBTCUndelegate)
 	if err := dec(in); err != nil {
@@ -1229,6 +1837,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PruneInactiveDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPruneInactiveDelegations)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PruneInactiveDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/PruneInactiveDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PruneInactiveDelegations(ctx, req.(*MsgPruneInactiveDelegations))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.btcstaking.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1249,6 +1875,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddCovenantSigs",
 			Handler:    _Msg_AddCovenantSigs_Handler,
 		},
+		{
+			MethodName: "CreateBTCDelegationWithCovenantSigs",
+			Handler:    _Msg_CreateBTCDelegationWithCovenantSigs_Handler,
+		},
+		{
+			MethodName: "ActivateReservedDelegation",
+			Handler:    _Msg_ActivateReservedDelegation_Handler,
+		},
+		{
+			MethodName: "AddSlashingTxInclusionProof",
+			Handler:    _Msg_AddSlashingTxInclusionProof_Handler,
+		},
 		{
 			MethodName: "BTCUndelegate",
 			Handler:    _Msg_BTCUndelegate_Handler,
@@ -1261,6 +1899,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "PruneInactiveDelegations",
+			Handler:    _Msg_PruneInactiveDelegations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/btcstaking/v1/tx.proto",
@@ -1483,6 +2125,30 @@ func (m *MsgCreateBTCDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Reserved {
+		i--
+		if m.Reserved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.RewardOptOut {
+		i--
+		if m.RewardOptOut {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if m.DelegatorUnbondingSlashingSig != nil {
 		{
 			size := m.DelegatorUnbondingSlashingSig.Size()
@@ -1755,7 +2421,7 @@ func (m *MsgAddCovenantSigsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBTCUndelegate) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateBTCDelegationWithCovenantSigs) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1765,33 +2431,40 @@ func (m *MsgBTCUndelegate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBTCUndelegate) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateBTCDelegationWithCovenantSigs) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBTCUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateBTCDelegationWithCovenantSigs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.UnbondingTxSig != nil {
+	if len(m.CovenantSigs) > 0 {
+		for iNdEx := len(m.CovenantSigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BtcDel != nil {
 		{
-			size := m.UnbondingTxSig.Size()
-			i -= size
-			if _, err := m.UnbondingTxSig.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.BtcDel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
 			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.StakingTxHash) > 0 {
-		i -= len(m.StakingTxHash)
-		copy(dAtA[i:], m.StakingTxHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
-		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.Signer) > 0 {
@@ -1804,7 +2477,7 @@ func (m *MsgBTCUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBTCUndelegateResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateBTCDelegationWithCovenantSigsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1814,12 +2487,12 @@ func (m *MsgBTCUndelegateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBTCUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateBTCDelegationWithCovenantSigsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBTCUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateBTCDelegationWithCovenantSigsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1827,7 +2500,7 @@ func (m *MsgBTCUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSelectiveSlashingEvidence) Marshal() (dAtA []byte, err error) {
+func (m *MsgActivateReservedDelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1837,20 +2510,25 @@ func (m *MsgSelectiveSlashingEvidence) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSelectiveSlashingEvidence) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgActivateReservedDelegation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSelectiveSlashingEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgActivateReservedDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RecoveredFpBtcSk) > 0 {
-		i -= len(m.RecoveredFpBtcSk)
-		copy(dAtA[i:], m.RecoveredFpBtcSk)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RecoveredFpBtcSk)))
+	if m.StakingTx != nil {
+		{
+			size, err := m.StakingTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1871,7 +2549,7 @@ func (m *MsgSelectiveSlashingEvidence) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSelectiveSlashingEvidenceResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgActivateReservedDelegationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1881,12 +2559,12 @@ func (m *MsgSelectiveSlashingEvidenceResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgSelectiveSlashingEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgActivateReservedDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSelectiveSlashingEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgActivateReservedDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1894,7 +2572,7 @@ func (m *MsgSelectiveSlashingEvidenceResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddSlashingTxInclusionProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1904,37 +2582,46 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddSlashingTxInclusionProof) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddSlashingTxInclusionProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.SlashingTx != nil {
+		{
+			size, err := m.SlashingTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddSlashingTxInclusionProofResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1944,12 +2631,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddSlashingTxInclusionProofResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddSlashingTxInclusionProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1957,161 +2644,436 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgCreateFinalityProvider) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBTCUndelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBTCUndelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBTCUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Description != nil {
-		l = m.Description.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Commission != nil {
-		l = m.Commission.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.BabylonPk != nil {
-		l = m.BabylonPk.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.UnbondingTxSig != nil {
+		{
+			size := m.UnbondingTxSig.Size()
+			i -= size
+			if _, err := m.UnbondingTxSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.BtcPk != nil {
-		l = m.BtcPk.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pop != nil {
-		l = m.Pop.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateFinalityProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBTCUndelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBTCUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBTCUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgEditFinalityProvider) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSelectiveSlashingEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSelectiveSlashingEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSelectiveSlashingEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.BtcPk)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.RecoveredFpBtcSk) > 0 {
+		i -= len(m.RecoveredFpBtcSk)
+		copy(dAtA[i:], m.RecoveredFpBtcSk)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecoveredFpBtcSk)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Description != nil {
-		l = m.Description.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Commission != nil {
-		l = m.Commission.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgEditFinalityProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSelectiveSlashingEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSelectiveSlashingEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSelectiveSlashingEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateBTCDelegation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.BabylonPk != nil {
-		l = m.BabylonPk.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Pop != nil {
-		l = m.Pop.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.BtcPk != nil {
-		l = m.BtcPk.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.FpBtcPkList) > 0 {
-		for _, e := range m.FpBtcPkList {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.StakingTime != 0 {
-		n += 1 + sovTx(uint64(m.StakingTime))
-	}
-	if m.StakingValue != 0 {
-		n += 1 + sovTx(uint64(m.StakingValue))
-	}
-	if m.StakingTx != nil {
-		l = m.StakingTx.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.SlashingTx != nil {
-		l = m.SlashingTx.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DelegatorSlashingSig != nil {
-		l = m.DelegatorSlashingSig.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.UnbondingTime != 0 {
-		n += 1 + sovTx(uint64(m.UnbondingTime))
-	}
-	l = len(m.UnbondingTx)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.UnbondingValue != 0 {
-		n += 1 + sovTx(uint64(m.UnbondingValue))
-	}
-	if m.UnbondingSlashingTx != nil {
-		l = m.UnbondingSlashingTx.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DelegatorUnbondingSlashingSig != nil {
-		l = m.DelegatorUnbondingSlashingSig.Size()
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateBTCDelegationResponse) Size() (n int) {
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPruneInactiveDelegations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneInactiveDelegations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneInactiveDelegations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OlderThanBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OlderThanBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPruneInactiveDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneInactiveDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneInactiveDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PrunedStakingTxHashHexList) > 0 {
+		for iNdEx := len(m.PrunedStakingTxHashHexList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PrunedStakingTxHashHexList[iNdEx])
+			copy(dAtA[i:], m.PrunedStakingTxHashHexList[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.PrunedStakingTxHashHexList[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Description != nil {
+		l = m.Description.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Commission != nil {
+		l = m.Commission.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BabylonPk != nil {
+		l = m.BabylonPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pop != nil {
+		l = m.Pop.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateFinalityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEditFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BtcPk)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Description != nil {
+		l = m.Description.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Commission != nil {
+		l = m.Commission.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEditFinalityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateBTCDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BabylonPk != nil {
+		l = m.BabylonPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pop != nil {
+		l = m.Pop.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for _, e := range m.FpBtcPkList {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.StakingTime != 0 {
+		n += 1 + sovTx(uint64(m.StakingTime))
+	}
+	if m.StakingValue != 0 {
+		n += 1 + sovTx(uint64(m.StakingValue))
+	}
+	if m.StakingTx != nil {
+		l = m.StakingTx.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SlashingTx != nil {
+		l = m.SlashingTx.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DelegatorSlashingSig != nil {
+		l = m.DelegatorSlashingSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnbondingTime != 0 {
+		n += 1 + sovTx(uint64(m.UnbondingTime))
+	}
+	l = len(m.UnbondingTx)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnbondingValue != 0 {
+		n += 1 + sovTx(uint64(m.UnbondingValue))
+	}
+	if m.UnbondingSlashingTx != nil {
+		l = m.UnbondingSlashingTx.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DelegatorUnbondingSlashingSig != nil {
+		l = m.DelegatorUnbondingSlashingSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RewardOptOut {
+		n += 3
+	}
+	if m.Reserved {
+		n += 3
+	}
+	return n
+}
+
+func (m *MsgCreateBTCDelegationResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2166,7 +3128,7 @@ func (m *MsgAddCovenantSigsResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgBTCUndelegate) Size() (n int) {
+func (m *MsgCreateBTCDelegationWithCovenantSigs) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2176,18 +3138,20 @@ func (m *MsgBTCUndelegate) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.StakingTxHash)
-	if l > 0 {
+	if m.BtcDel != nil {
+		l = m.BtcDel.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.UnbondingTxSig != nil {
-		l = m.UnbondingTxSig.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.CovenantSigs) > 0 {
+		for _, e := range m.CovenantSigs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *MsgBTCUndelegateResponse) Size() (n int) {
+func (m *MsgCreateBTCDelegationWithCovenantSigsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2196,7 +3160,7 @@ func (m *MsgBTCUndelegateResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgSelectiveSlashingEvidence) Size() (n int) {
+func (m *MsgActivateReservedDelegation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2210,14 +3174,14 @@ func (m *MsgSelectiveSlashingEvidence) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.RecoveredFpBtcSk)
-	if l > 0 {
+	if m.StakingTx != nil {
+		l = m.StakingTx.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgSelectiveSlashingEvidenceResponse) Size() (n int) {
+func (m *MsgActivateReservedDelegationResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2226,21 +3190,111 @@ func (m *MsgSelectiveSlashingEvidenceResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
+func (m *MsgAddSlashingTxInclusionProof) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
+	l = len(m.Signer)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SlashingTx != nil {
+		l = m.SlashingTx.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddSlashingTxInclusionProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBTCUndelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnbondingTxSig != nil {
+		l = m.UnbondingTxSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBTCUndelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSelectiveSlashingEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecoveredFpBtcSk)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSelectiveSlashingEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
 func (m *MsgUpdateParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2250,6 +3304,37 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgPruneInactiveDelegations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OlderThanBlocks != 0 {
+		n += 1 + sovTx(uint64(m.OlderThanBlocks))
+	}
+	return n
+}
+
+func (m *MsgPruneInactiveDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PrunedStakingTxHashHexList) > 0 {
+		for _, s := range m.PrunedStakingTxHashHexList {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2287,9 +3372,770 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Description == nil {
+				m.Description = &types.Description{}
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Commission = &v
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonPk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BabylonPk == nil {
+				m.BabylonPk = &secp256k1.PubKey{}
+			}
+			if err := m.BabylonPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pop == nil {
+				m.Pop = &ProofOfPossession{}
+			}
+			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFinalityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcPk = append(m.BtcPk[:0], dAtA[iNdEx:postIndex]...)
+			if m.BtcPk == nil {
+				m.BtcPk = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Description == nil {
+				m.Description = &types.Description{}
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Commission = &v
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditFinalityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBTCDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBTCDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonPk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BabylonPk == nil {
+				m.BabylonPk = &secp256k1.PubKey{}
+			}
+			if err := m.BabylonPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pop == nil {
+				m.Pop = &ProofOfPossession{}
+			}
+			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkList", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPkList = append(m.FpBtcPkList, v)
+			if err := m.FpBtcPkList[len(m.FpBtcPkList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTime", wireType)
+			}
+			m.StakingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingValue", wireType)
+			}
+			m.StakingValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingValue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTx", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2299,29 +4145,33 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			if m.StakingTx == nil {
+				m.StakingTx = &types1.TransactionInfo{}
+			}
+			if err := m.StakingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTx", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2331,33 +4181,32 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Description == nil {
-				m.Description = &types.Description{}
-			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v BTCSlashingTx
+			m.SlashingTx = &v
+			if err := m.SlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashingSig", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2367,33 +4216,51 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.LegacyDec
-			m.Commission = &v
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonchain_babylon_types.BIP340Signature
+			m.DelegatorSlashingSig = &v
+			if err := m.DelegatorSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			m.UnbondingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BabylonPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTx", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2403,31 +4270,48 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BabylonPk == nil {
-				m.BabylonPk = &secp256k1.PubKey{}
-			}
-			if err := m.BabylonPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.UnbondingTx = append(m.UnbondingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.UnbondingTx == nil {
+				m.UnbondingTx = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingValue", wireType)
+			}
+			m.UnbondingValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingValue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashingTx", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2454,17 +4338,17 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.BtcPk = &v
-			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v BTCSlashingTx
+			m.UnbondingSlashingTx = &v
+			if err := m.UnbondingSlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingSlashingSig", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2474,28 +4358,67 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pop == nil {
-				m.Pop = &ProofOfPossession{}
-			}
-			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonchain_babylon_types.BIP340Signature
+			m.DelegatorUnbondingSlashingSig = &v
+			if err := m.DelegatorUnbondingSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardOptOut", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RewardOptOut = bool(v != 0)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reserved = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2517,7 +4440,7 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateBTCDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2540,10 +4463,10 @@ func (m *MsgCreateFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateFinalityProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateBTCDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateBTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2567,7 +4490,7 @@ func (m *MsgCreateFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
+func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2577,28 +4500,95 @@ func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddCovenantSigs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddCovenantSigs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.Pk = &v
+			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditFinalityProvider: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2626,11 +4616,11 @@ func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxSigs", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2657,16 +4647,14 @@ func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BtcPk = append(m.BtcPk[:0], dAtA[iNdEx:postIndex]...)
-			if m.BtcPk == nil {
-				m.BtcPk = []byte{}
-			}
+			m.SlashingTxSigs = append(m.SlashingTxSigs, make([]byte, postIndex-iNdEx))
+			copy(m.SlashingTxSigs[len(m.SlashingTxSigs)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTxSig", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2676,33 +4664,32 @@ func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Description == nil {
-				m.Description = &types.Description{}
-			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonchain_babylon_types.BIP340Signature
+			m.UnbondingTxSig = &v
+			if err := m.UnbondingTxSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingUnbondingTxSigs", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2712,27 +4699,23 @@ func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.LegacyDec
-			m.Commission = &v
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SlashingUnbondingTxSigs = append(m.SlashingUnbondingTxSigs, make([]byte, postIndex-iNdEx))
+			copy(m.SlashingUnbondingTxSigs[len(m.SlashingUnbondingTxSigs)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2755,7 +4738,7 @@ func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddCovenantSigsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2778,10 +4761,10 @@ func (m *MsgEditFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditFinalityProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddCovenantSigsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddCovenantSigsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2805,7 +4788,7 @@ func (m *MsgEditFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateBTCDelegationWithCovenantSigs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2828,10 +4811,10 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBTCDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateBTCDelegationWithCovenantSigs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBTCDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateBTCDelegationWithCovenantSigs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2868,7 +4851,7 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BabylonPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDel", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2895,16 +4878,16 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BabylonPk == nil {
-				m.BabylonPk = &secp256k1.PubKey{}
+			if m.BtcDel == nil {
+				m.BtcDel = &MsgCreateBTCDelegation{}
 			}
-			if err := m.BabylonPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BtcDel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantSigs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2931,126 +4914,116 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pop == nil {
-				m.Pop = &ProofOfPossession{}
-			}
-			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CovenantSigs = append(m.CovenantSigs, &MsgAddCovenantSigs{})
+			if err := m.CovenantSigs[len(m.CovenantSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.BtcPk = &v
-			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBTCDelegationWithCovenantSigsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkList", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBTCDelegationWithCovenantSigsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBTCDelegationWithCovenantSigsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.FpBtcPkList = append(m.FpBtcPkList, v)
-			if err := m.FpBtcPkList[len(m.FpBtcPkList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTime", wireType)
-			}
-			m.StakingTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StakingTime |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingValue", wireType)
-			}
-			m.StakingValue = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StakingValue |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgActivateReservedDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-		case 8:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgActivateReservedDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgActivateReservedDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3060,33 +5033,29 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StakingTx == nil {
-				m.StakingTx = &types1.TransactionInfo{}
-			}
-			if err := m.StakingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3096,32 +5065,29 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v BTCSlashingTx
-			m.SlashingTx = &v
-			if err := m.SlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashingSig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTx", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3131,51 +5097,133 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340Signature
-			m.DelegatorSlashingSig = &v
-			if err := m.DelegatorSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.StakingTx == nil {
+				m.StakingTx = &types1.TransactionInfo{}
+			}
+			if err := m.StakingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.UnbondingTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingTime |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 12:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgActivateReservedDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgActivateReservedDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgActivateReservedDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddSlashingTxInclusionProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddSlashingTxInclusionProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddSlashingTxInclusionProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3185,50 +5233,29 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbondingTx = append(m.UnbondingTx[:0], dAtA[iNdEx:postIndex]...)
-			if m.UnbondingTx == nil {
-				m.UnbondingTx = []byte{}
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingValue", wireType)
-			}
-			m.UnbondingValue = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingValue |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashingTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3238,32 +5265,29 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v BTCSlashingTx
-			m.UnbondingSlashingTx = &v
-			if err := m.UnbondingSlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingSlashingSig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTx", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3273,24 +5297,25 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340Signature
-			m.DelegatorUnbondingSlashingSig = &v
-			if err := m.DelegatorUnbondingSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SlashingTx == nil {
+				m.SlashingTx = &types1.TransactionInfo{}
+			}
+			if err := m.SlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3315,7 +5340,7 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateBTCDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddSlashingTxInclusionProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3338,10 +5363,10 @@ func (m *MsgCreateBTCDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBTCDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddSlashingTxInclusionProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddSlashingTxInclusionProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3365,7 +5390,7 @@ func (m *MsgCreateBTCDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
+func (m *MsgBTCUndelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3388,10 +5413,10 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddCovenantSigs: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBTCUndelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddCovenantSigs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBTCUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3427,41 +5452,6 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.Pk = &v
-			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
@@ -3493,39 +5483,7 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 			}
 			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxSigs", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SlashingTxSigs = append(m.SlashingTxSigs, make([]byte, postIndex-iNdEx))
-			copy(m.SlashingTxSigs[len(m.SlashingTxSigs)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTxSig", wireType)
 			}
@@ -3560,38 +5518,6 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingUnbondingTxSigs", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SlashingUnbondingTxSigs = append(m.SlashingUnbondingTxSigs, make([]byte, postIndex-iNdEx))
-			copy(m.SlashingUnbondingTxSigs[len(m.SlashingUnbondingTxSigs)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3613,7 +5539,7 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddCovenantSigsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBTCUndelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3636,10 +5562,10 @@ func (m *MsgAddCovenantSigsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddCovenantSigsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBTCUndelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddCovenantSigsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBTCUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3663,7 +5589,7 @@ func (m *MsgAddCovenantSigsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBTCUndelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgSelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3686,10 +5612,10 @@ func (m *MsgBTCUndelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBTCUndelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSelectiveSlashingEvidence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBTCUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSelectiveSlashingEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3758,7 +5684,7 @@ func (m *MsgBTCUndelegate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTxSig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveredFpBtcSk", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3785,10 +5711,9 @@ func (m *MsgBTCUndelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340Signature
-			m.UnbondingTxSig = &v
-			if err := m.UnbondingTxSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RecoveredFpBtcSk = append(m.RecoveredFpBtcSk[:0], dAtA[iNdEx:postIndex]...)
+			if m.RecoveredFpBtcSk == nil {
+				m.RecoveredFpBtcSk = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -3812,7 +5737,7 @@ func (m *MsgBTCUndelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBTCUndelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSelectiveSlashingEvidenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3835,10 +5760,10 @@ func (m *MsgBTCUndelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBTCUndelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSelectiveSlashingEvidenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBTCUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSelectiveSlashingEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3862,7 +5787,7 @@ func (m *MsgBTCUndelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3885,15 +5810,15 @@ func (m *MsgSelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSelectiveSlashingEvidence: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSelectiveSlashingEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3921,45 +5846,13 @@ func (m *MsgSelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecoveredFpBtcSk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3969,24 +5862,23 @@ func (m *MsgSelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecoveredFpBtcSk = append(m.RecoveredFpBtcSk[:0], dAtA[iNdEx:postIndex]...)
-			if m.RecoveredFpBtcSk == nil {
-				m.RecoveredFpBtcSk = []byte{}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -4010,7 +5902,7 @@ func (m *MsgSelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSelectiveSlashingEvidenceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4033,10 +5925,10 @@ func (m *MsgSelectiveSlashingEvidenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSelectiveSlashingEvidenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSelectiveSlashingEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4060,7 +5952,7 @@ func (m *MsgSelectiveSlashingEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgPruneInactiveDelegations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4083,10 +5975,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPruneInactiveDelegations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPruneInactiveDelegations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4122,10 +6014,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OlderThanBlocks", wireType)
 			}
-			var msglen int
+			m.OlderThanBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4135,25 +6027,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.OlderThanBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4175,7 +6053,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPruneInactiveDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4198,12 +6076,44 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPruneInactiveDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPruneInactiveDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedStakingTxHashHexList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrunedStakingTxHashHexList = append(m.PrunedStakingTxHashHexList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strconv"
@@ -25,9 +26,23 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
+	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdRawCheckpoint())
 	cmd.AddCommand(CmdRawCheckpointList())
 	cmd.AddCommand(CmdRawCheckpoints())
+	cmd.AddCommand(CmdCurrentCheckpoint())
+	cmd.AddCommand(CmdVerifyBlsMultiSig())
+	cmd.AddCommand(CmdVerifyCheckpointRange())
+	cmd.AddCommand(CmdBlsPublicKeyAtEpoch())
+	cmd.AddCommand(CmdValidatorBlsSig())
+	cmd.AddCommand(CmdCheckpointSigners())
+	cmd.AddCommand(CmdCheckpointParticipationHistory())
+	cmd.AddCommand(CmdAllBlsRegistrations())
+	cmd.AddCommand(CmdLatestCheckpointStateUpdate())
+	cmd.AddCommand(CmdAggregateBlsPubKey())
+	cmd.AddCommand(CmdNextCheckpointHeight())
+	cmd.AddCommand(CmdCheckpointStatusSummary())
+	cmd.AddCommand(CmdCheckpointAccumulationProgress())
 
 	return cmd
 }
@@ -132,3 +147,421 @@ func CmdRawCheckpoints() *cobra.Command {
 
 	return cmd
 }
+
+// CmdCurrentCheckpoint defines the cobra command to query the checkpoint that is currently accumulating
+func CmdCurrentCheckpoint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "current-checkpoint",
+		Short: "retrieve the checkpoint that is currently accumulating BLS signatures",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CurrentCheckpoint(context.Background(), &types.QueryCurrentCheckpointRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdVerifyBlsMultiSig defines the cobra command to verify a BLS multi sig against the validator set of an epoch
+func CmdVerifyBlsMultiSig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-bls-multi-sig [epoch_number] [block_hash_hex] [bitmap_hex] [bls_multi_sig_hex]",
+		Short: "verify a BLS multi sig on a block hash against the validator set of the given epoch",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			epochNum, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			bitmap, err := hex.DecodeString(args[2])
+			if err != nil {
+				return err
+			}
+			multiSig, err := hex.DecodeString(args[3])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VerifyBlsMultiSig(context.Background(), &types.QueryVerifyBlsMultiSigRequest{
+				EpochNum:     epochNum,
+				BlockHashHex: args[1],
+				Bitmap:       bitmap,
+				BlsMultiSig:  multiSig,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdVerifyCheckpointRange defines the cobra command to re-verify the stored checkpoints of an epoch range
+func CmdVerifyCheckpointRange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-checkpoint-range [from_epoch] [to_epoch]",
+		Short: "re-verify the stored checkpoints of the epochs in the given range (inclusive) and report the result of each epoch",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			fromEpoch, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			toEpoch, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VerifyCheckpoints(context.Background(), &types.QueryVerifyCheckpointsRequest{
+				FromEpoch: fromEpoch,
+				ToEpoch:   toEpoch,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdBlsPublicKeyAtEpoch defines the cobra command to query the BLS public key that a validator used at an epoch
+func CmdBlsPublicKeyAtEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bls-public-key-at-epoch [val_address] [epoch_number]",
+		Short: "retrieve the BLS public key that the validator used at the given epoch",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			epochNum, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BlsPublicKeyAtEpoch(context.Background(), &types.QueryBlsPublicKeyAtEpochRequest{
+				ValAddress: args[0],
+				EpochNum:   epochNum,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdValidatorBlsSig defines the cobra command to query the BLS sig that a
+// validator contributed to the checkpoint of a given epoch
+func CmdValidatorBlsSig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-bls-sig [epoch_number] [val_address]",
+		Short: "retrieve the individual BLS sig that the validator contributed to the sealed checkpoint of the given epoch, if retained",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			epochNum, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorBlsSig(context.Background(), &types.QueryValidatorBlsSigRequest{
+				EpochNum:   epochNum,
+				ValAddress: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdCheckpointSigners defines the cobra command to query the signers of the
+// checkpoint of a given epoch
+func CmdCheckpointSigners() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint-signers [epoch_number]",
+		Short: "retrieve the validators who signed the checkpoint of the given epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			epochNum, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCheckpointSignersRequest{EpochNum: epochNum}
+			res, err := queryClient.CheckpointSigners(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdAllBlsRegistrations defines the cobra command to query all registered
+// BLS public keys
+// CmdCheckpointParticipationHistory defines the cobra command to query the
+// checkpoint signing participation of each epoch in a given range
+func CmdCheckpointParticipationHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint-participation-history [from_epoch] [to_epoch]",
+		Short: "retrieve the fraction of voting power that signed the checkpoint of each epoch in the given range (inclusive)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			fromEpoch, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			toEpoch, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.CheckpointParticipationHistory(context.Background(), &types.QueryCheckpointParticipationHistoryRequest{
+				FromEpoch: fromEpoch,
+				ToEpoch:   toEpoch,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdAllBlsRegistrations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-bls-registrations",
+		Short: "retrieve all registered BLS public keys together with their validators",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AllBlsRegistrations(context.Background(), &types.QueryAllBlsRegistrationsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all-bls-registrations")
+
+	return cmd
+}
+
+// CmdLatestCheckpointStateUpdate defines the cobra command to query the latest checkpoint status transition
+func CmdLatestCheckpointStateUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "latest-checkpoint-state-update",
+		Short: "retrieve the checkpoint status transition that occurred most recently across all epochs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LatestCheckpointStateUpdate(context.Background(), &types.QueryLatestCheckpointStateUpdateRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdAggregateBlsPubKey defines the cobra command to query the aggregate BLS public key of a subset of an epoch's validator set
+func CmdAggregateBlsPubKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-bls-pub-key [epoch_number] [bitmap_hex]",
+		Short: "retrieve the aggregate BLS public key of the validators of the given epoch indicated by the bitmap",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			epochNum, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			bitmap, err := hex.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AggregateBlsPubKey(context.Background(), &types.QueryAggregateBlsPubKeyRequest{
+				EpochNum: epochNum,
+				Bitmap:   bitmap,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdNextCheckpointHeight defines the cobra command to query the length of the current epoch and the height at which its checkpoint will be built
+func CmdNextCheckpointHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-checkpoint-height",
+		Short: "retrieve the length of the current epoch and the height at which its checkpoint will be built",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.NextCheckpointHeight(context.Background(), &types.QueryNextCheckpointHeightRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdCheckpointStatusSummary defines the cobra command to query the number of checkpoints and the latest epoch in each status
+func CmdCheckpointStatusSummary() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint-status-summary",
+		Short: "retrieve the number of checkpoints and the latest epoch in each status",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CheckpointStatusSummary(context.Background(), &types.QueryCheckpointStatusSummaryRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCheckpointAccumulationProgress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint-accumulation-progress [epoch_number]",
+		Short: "retrieve the progress of the checkpoint of the given epoch towards being sealed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			epochNum, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCheckpointAccumulationProgressRequest{EpochNum: epochNum}
+			res, err := queryClient.CheckpointAccumulationProgress(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -8,10 +8,17 @@ import (
 	"github.com/babylonchain/babylon/x/checkpointing/types"
 )
 
+// BlsSigner signs checkpoints with the BLS key of the validator. The BLS key
+// can be kept in a local file (privval.WrappedFilePV), or off the node in a
+// remote signer following the BlsSigner gRPC service (privval.RemoteBlsSigner)
 type BlsSigner interface {
+	// GetAddress returns the address of the validator
 	GetAddress() sdk.ValAddress
+	// SignMsgWithBls signs the given message with the BLS key
 	SignMsgWithBls(msg []byte) (bls12381.Signature, error)
+	// GetBlsPubkey returns the BLS public key of the validator
 	GetBlsPubkey() (bls12381.PublicKey, error)
+	// GetValidatorPubkey returns the Ed25519 public key of the validator
 	GetValidatorPubkey() (crypto.PubKey, error)
 }
 
@@ -1,10 +1,31 @@
 package keeper_test
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	cryptorand "crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"math/rand"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
 
 	"github.com/babylonchain/babylon/crypto/bls12381"
+	"github.com/babylonchain/babylon/privval"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/x/checkpointing/types"
 	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
 )
 
@@ -28,3 +49,215 @@ var (
 	blsPubKey2  = blsPrivKey2.PubKey()
 	pubkeys     = []bls12381.PublicKey{blsPubKey1, blsPubKey2}
 )
+
+// genTLSFiles generates a CA, and the certificate and key issued by it for
+// the given usage at 127.0.0.1, and writes them as PEM files under the given
+// directory. It returns the paths of the certificate, key and CA files
+func genTLSFiles(t *testing.T, dir string, usage x509.ExtKeyUsage) (string, string, string) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), cryptorand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "bls-signer-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(cryptorand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), cryptorand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "bls-signer"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	certDER, err := x509.CreateCertificate(cryptorand.Reader, template, caTemplate, &key.PublicKey, caKey)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	caFile := filepath.Join(dir, "ca.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}), 0600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+	require.NoError(t, os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}), 0600))
+	return certFile, keyFile, caFile
+}
+
+// startBlsSignerServer serves the given BlsSigner gRPC service with mutual
+// TLS at a random local address, and returns the address and the TLS config
+// of clients trusted by it
+func startBlsSignerServer(t *testing.T, srv types.BlsSignerServer) (string, *tls.Config) {
+	serverCert, serverKey, clientCA := genTLSFiles(t, t.TempDir(), x509.ExtKeyUsageServerAuth)
+	clientCert, clientKey, serverCA := genTLSFiles(t, t.TempDir(), x509.ExtKeyUsageClientAuth)
+	serverTLSConfig, err := privval.NewBlsSignerTLSConfig(serverCert, serverKey, serverCA)
+	require.NoError(t, err)
+	clientTLSConfig, err := privval.NewBlsSignerTLSConfig(clientCert, clientKey, clientCA)
+	require.NoError(t, err)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcServer, err := privval.NewBlsSignerGRPCServer(srv, serverTLSConfig)
+	require.NoError(t, err)
+	go func() {
+		_ = grpcServer.Serve(lis)
+	}()
+	t.Cleanup(grpcServer.Stop)
+	return lis.Addr().String(), clientTLSConfig
+}
+
+// startRemoteBlsSigner serves the given BlsSigner gRPC service at a random
+// local address, and returns a remote BLS signer connected to it
+func startRemoteBlsSigner(t *testing.T, srv types.BlsSignerServer) *privval.RemoteBlsSigner {
+	addr, clientTLSConfig := startBlsSignerServer(t, srv)
+	remoteSigner, err := privval.DialRemoteBlsSigner(addr, clientTLSConfig, time.Second)
+	require.NoError(t, err)
+	return remoteSigner
+}
+
+func TestSignBLSViaRemoteSigner(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	// the BLS key is kept by the remote signer
+	dir := t.TempDir()
+	pv := privval.GenWrappedFilePV(filepath.Join(dir, "key.json"), filepath.Join(dir, "state.json"))
+	pv.Key.DelegatorAddress = datagen.GenRandomAccount().Address
+	srv, err := privval.NewBlsSignerServer(pv, filepath.Join(dir, "bls_signer_state.json"))
+	require.NoError(t, err)
+	remoteSigner := startRemoteBlsSigner(t, srv)
+
+	// the keeper identifies the validator via the remote signer
+	k, _, _ := testkeeper.CheckpointingKeeper(t, nil, remoteSigner)
+	require.Equal(t, pv.GetAddress(), k.GetBLSSignerAddress())
+	require.Equal(t, sdk.ValAddress(pv.Key.PubKey.Address()), k.GetValidatorAddress())
+	blsPubkey, err := remoteSigner.GetBlsPubkey()
+	require.NoError(t, err)
+	require.Equal(t, pv.Key.BlsPubKey, blsPubkey)
+
+	// the keeper signs via the remote signer, yielding the same signature as
+	// the local signer
+	epochNum := datagen.RandomInt(r, 100) + 1
+	blockHash := datagen.GenRandomBlockHash(r)
+	sig, err := k.SignBLS(epochNum, blockHash)
+	require.NoError(t, err)
+	valid, err := bls12381.Verify(sig, pv.Key.BlsPubKey, types.GetSignBytes(epochNum, blockHash))
+	require.NoError(t, err)
+	require.True(t, valid)
+	localSig, err := pv.SignMsgWithBls(types.GetSignBytes(epochNum, blockHash))
+	require.NoError(t, err)
+	require.Equal(t, localSig, sig)
+}
+
+// maliciousBlsSignerServer is a BlsSigner gRPC service signing with a BLS key
+// other than the one it reports
+type maliciousBlsSignerServer struct {
+	*privval.BlsSignerServer
+	blsPrivKey bls12381.PrivateKey
+}
+
+func (s *maliciousBlsSignerServer) SignMsgWithBls(_ context.Context, req *types.SignMsgWithBlsRequest) (*types.SignMsgWithBlsResponse, error) {
+	sig := bls12381.Sign(s.blsPrivKey, req.Msg)
+	return &types.SignMsgWithBlsResponse{BlsSig: &sig}, nil
+}
+
+func TestSignBLSViaRemoteSignerRejectsInvalidSig(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	dir := t.TempDir()
+	pv := privval.GenWrappedFilePV(filepath.Join(dir, "key.json"), filepath.Join(dir, "state.json"))
+	srv, err := privval.NewBlsSignerServer(pv, filepath.Join(dir, "bls_signer_state.json"))
+	require.NoError(t, err)
+	remoteSigner := startRemoteBlsSigner(t, &maliciousBlsSignerServer{
+		BlsSignerServer: srv,
+		blsPrivKey:      bls12381.GenPrivKey(),
+	})
+
+	k, _, _ := testkeeper.CheckpointingKeeper(t, nil, remoteSigner)
+	_, err = k.SignBLS(datagen.RandomInt(r, 100)+1, datagen.GenRandomBlockHash(r))
+	require.Error(t, err)
+}
+
+func TestRemoteBlsSignerRequiresMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	pv := privval.GenWrappedFilePV(filepath.Join(dir, "key.json"), filepath.Join(dir, "state.json"))
+	srv, err := privval.NewBlsSignerServer(pv, filepath.Join(dir, "bls_signer_state.json"))
+	require.NoError(t, err)
+	addr, clientTLSConfig := startBlsSignerServer(t, srv)
+
+	// the server cannot be started without verifying client certificates
+	_, err = privval.NewBlsSignerGRPCServer(srv, nil)
+	require.Error(t, err)
+	_, err = privval.NewBlsSignerGRPCServer(srv, &tls.Config{})
+	require.Error(t, err)
+
+	// a TCP connection cannot be made without TLS or a client certificate
+	_, err = privval.DialRemoteBlsSigner(addr, nil, time.Second)
+	require.Error(t, err)
+	noCertTLSConfig := clientTLSConfig.Clone()
+	noCertTLSConfig.Certificates = nil
+	_, err = privval.DialRemoteBlsSigner(addr, noCertTLSConfig, time.Second)
+	require.Error(t, err)
+
+	// a client certificate not issued by the CA trusted by the server is
+	// rejected
+	otherCert, otherKey, _ := genTLSFiles(t, t.TempDir(), x509.ExtKeyUsageClientAuth)
+	otherTLSConfig := clientTLSConfig.Clone()
+	otherCertPair, err := tls.LoadX509KeyPair(otherCert, otherKey)
+	require.NoError(t, err)
+	otherTLSConfig.Certificates = []tls.Certificate{otherCertPair}
+	_, err = privval.DialRemoteBlsSigner(addr, otherTLSConfig, time.Second)
+	require.Error(t, err)
+
+	// a trusted client certificate is accepted
+	_, err = privval.DialRemoteBlsSigner(addr, clientTLSConfig, time.Second)
+	require.NoError(t, err)
+}
+
+func TestBlsSignerServerSignsCheckpointsMonotonically(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	dir := t.TempDir()
+	pv := privval.GenWrappedFilePV(filepath.Join(dir, "key.json"), filepath.Join(dir, "state.json"))
+	stateFilePath := filepath.Join(dir, "bls_signer_state.json")
+	srv, err := privval.NewBlsSignerServer(pv, stateFilePath)
+	require.NoError(t, err)
+	sign := func(msg []byte) error {
+		_, err := srv.SignMsgWithBls(context.Background(), &types.SignMsgWithBlsRequest{Msg: msg})
+		return err
+	}
+
+	// a message other than the sign bytes of a checkpoint is rejected
+	require.Error(t, sign(datagen.GenRandomByteArray(r, 100)))
+
+	// the checkpoint of an epoch can be signed multiple times
+	epochNum := datagen.RandomInt(r, 100) + 2
+	blockHash := datagen.GenRandomBlockHash(r)
+	require.NoError(t, sign(types.GetSignBytes(epochNum, blockHash)))
+	require.NoError(t, sign(types.GetSignBytes(epochNum, blockHash)))
+
+	// a different checkpoint of the same epoch, or a checkpoint of a lower
+	// epoch, is rejected
+	require.Error(t, sign(types.GetSignBytes(epochNum, datagen.GenRandomBlockHash(r))))
+	require.Error(t, sign(types.GetSignBytes(epochNum-1, datagen.GenRandomBlockHash(r))))
+
+	// a checkpoint of a higher epoch is signed
+	lastSignBytes := types.GetSignBytes(epochNum+1, datagen.GenRandomBlockHash(r))
+	require.NoError(t, sign(lastSignBytes))
+
+	// the last signed checkpoint is persisted, so a restarted server still
+	// rejects conflicting checkpoints
+	srv, err = privval.NewBlsSignerServer(pv, stateFilePath)
+	require.NoError(t, err)
+	require.Error(t, sign(types.GetSignBytes(epochNum+1, datagen.GenRandomBlockHash(r))))
+	require.Error(t, sign(types.GetSignBytes(epochNum, blockHash)))
+	require.NoError(t, sign(lastSignBytes))
+}
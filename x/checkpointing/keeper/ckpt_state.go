@@ -81,6 +81,24 @@ func (cs CheckpointsState) GetRawCkptsWithMetaByStatus(status types.CheckpointSt
 	return nil
 }
 
+// IterateRawCkptsWithMetaReverse iterates over raw checkpoints with meta by the
+// descending order of epoch, until f returns true
+func (cs CheckpointsState) IterateRawCkptsWithMetaReverse(f func(*types.RawCheckpointWithMeta) bool) error {
+	iter := cs.checkpoints.ReverseIterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		ckptWithMeta, err := types.BytesToCkptWithMeta(cs.cdc, iter.Value())
+		if err != nil {
+			return err
+		}
+		if f(ckptWithMeta) {
+			return nil
+		}
+	}
+	return nil
+}
+
 // UpdateCkptStatus updates the checkpoint's status
 func (cs CheckpointsState) UpdateCkptStatus(ckpt *types.RawCheckpoint, status types.CheckpointStatus) error {
 	ckptWithMeta, err := cs.GetRawCkptWithMeta(ckpt.EpochNum)
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"sync"
+
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
+// pendingConflictingCheckpoint holds the evidence of the first conflicting
+// checkpoint found in the block being finalized until it is handled
+type pendingConflictingCheckpoint struct {
+	mu       sync.Mutex
+	evidence *types.ConflictingCheckpointEvidence
+}
+
+// set records the given evidence, unless another one is already pending
+func (p *pendingConflictingCheckpoint) set(evidence *types.ConflictingCheckpointEvidence) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.evidence == nil {
+		p.evidence = evidence
+	}
+}
+
+// take returns the pending evidence, if any, and clears it
+func (p *pendingConflictingCheckpoint) take() *types.ConflictingCheckpointEvidence {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	evidence := p.evidence
+	p.evidence = nil
+	return evidence
+}
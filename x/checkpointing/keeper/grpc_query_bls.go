@@ -2,8 +2,11 @@ package keeper
 
 import (
 	"context"
+
+	"github.com/babylonchain/babylon/crypto/bls12381"
 	"github.com/babylonchain/babylon/x/checkpointing/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/jinzhu/copier"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -49,3 +52,111 @@ func (k Keeper) BlsPublicKeyList(c context.Context, req *types.QueryBlsPublicKey
 		ValidatorWithBlsKeys: copiedValBLSKeys,
 	}, nil
 }
+
+// BlsPublicKeyAtEpoch returns the BLS public key that the given validator used at the given epoch
+func (k Keeper) BlsPublicKeyAtEpoch(c context.Context, req *types.QueryBlsPublicKeyAtEpochRequest) (*types.QueryBlsPublicKeyAtEpochResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	valAddr, err := sdk.ValAddressFromBech32(req.ValAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %v", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(c)
+	if err := k.checkEpochNotInFuture(sdkCtx, req.EpochNum); err != nil {
+		return nil, err
+	}
+
+	valBLSKey, err := k.GetBlsPubKeyAtEpoch(sdkCtx, req.EpochNum, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBlsPublicKeyAtEpochResponse{ValidatorWithBlsKey: valBLSKey}, nil
+}
+
+// ValidatorBlsSig returns the individual BLS sig that a validator contributed
+// to the sealed checkpoint of the given epoch, if it was retained
+func (k Keeper) ValidatorBlsSig(c context.Context, req *types.QueryValidatorBlsSigRequest) (*types.QueryValidatorBlsSigResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	valAddr, err := sdk.ValAddressFromBech32(req.ValAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %v", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(c)
+	if err := k.checkEpochNotInFuture(sdkCtx, req.EpochNum); err != nil {
+		return nil, err
+	}
+
+	sig, err := k.GetValidatorBlsSig(sdkCtx, req.EpochNum, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorBlsSigResponse{BlsSig: sig}, nil
+}
+
+// AllBlsRegistrations returns all registered BLS public keys together with the
+// validators that registered them, in the ascending order of validator address
+func (k Keeper) AllBlsRegistrations(c context.Context, req *types.QueryAllBlsRegistrationsRequest) (*types.QueryAllBlsRegistrationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(c)
+	store := k.RegistrationState(sdkCtx).addrToBlsKeys
+	var registrations []*types.BlsRegistration
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var blsPubKey bls12381.PublicKey
+		if err := blsPubKey.Unmarshal(value); err != nil {
+			return err
+		}
+		registrations = append(registrations, &types.BlsRegistration{
+			ValidatorAddress: sdk.ValAddress(key).String(),
+			BlsPubKey:        &blsPubKey,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllBlsRegistrationsResponse{Registrations: registrations, Pagination: pageRes}, nil
+}
+
+// AggregateBlsPubKey returns the aggregate BLS public key of the subset of the
+// given epoch's validator set indicated by the given bitmap, aggregated in the
+// same way as when verifying a BLS multi sig, such that external verifiers can
+// verify the multi sig against a single public key
+func (k Keeper) AggregateBlsPubKey(c context.Context, req *types.QueryAggregateBlsPubKeyRequest) (*types.QueryAggregateBlsPubKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(c)
+	if err := k.checkEpochNotInFuture(sdkCtx, req.EpochNum); err != nil {
+		return nil, err
+	}
+
+	signersPubKeys, powerSum, err := k.getSignersBlsPubKeys(sdkCtx, req.EpochNum, req.Bitmap)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(signersPubKeys) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "the bitmap indicates no signer")
+	}
+	aggPubKey, err := bls12381.AggrPKList(signersPubKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAggregateBlsPubKeyResponse{
+		AggregateBlsPubKey: &aggPubKey,
+		NumSigners:         uint64(len(signersPubKeys)),
+		PowerSum:           uint64(powerSum),
+	}, nil
+}
@@ -8,19 +8,25 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/babylonchain/babylon/app"
+	"github.com/babylonchain/babylon/crypto/bls12381"
 	"github.com/babylonchain/babylon/testutil/datagen"
 	testhelper "github.com/babylonchain/babylon/testutil/helper"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/testutil/mocks"
 	checkpointingkeeper "github.com/babylonchain/babylon/x/checkpointing/keeper"
 	"github.com/babylonchain/babylon/x/checkpointing/types"
+	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
 )
 
 // FuzzQueryBLSKeySet does the following checks
 // 1. check the query when there's only a genesis validator
 // 2. check the query when there are n+1 validators without pagination
 // 3. check the query when there are n+1 validators with pagination
+// 4. check the query of a validator's BLS public key at a given epoch
 func FuzzQueryBLSKeySet(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 	f.Fuzz(func(t *testing.T, seed int64) {
@@ -109,5 +115,142 @@ func FuzzQueryBLSKeySet(f *testing.F) {
 		resp, err = queryClient.BlsPublicKeyList(ctx, &req)
 		require.NoError(t, err)
 		require.Len(t, resp.ValidatorWithBlsKeys, n)
+
+		// 4.1 query the BLS public key of the genesis validator at epoch 1
+		atEpochResp, err := queryClient.BlsPublicKeyAtEpoch(ctx, &types.QueryBlsPublicKeyAtEpochRequest{
+			ValAddress: genesisVal.GetValAddressStr(),
+			EpochNum:   1,
+		})
+		require.NoError(t, err)
+		require.Equal(t, genesisBLSPubkey.Bytes(), atEpochResp.ValidatorWithBlsKey.BlsPubKey)
+		require.Equal(t, uint64(1000), atEpochResp.ValidatorWithBlsKey.VotingPower)
+
+		// 4.2 a new validator is not in the validator set of epoch 1 but is in that of epoch 2
+		newValAddr := wcvMsgs[0].MsgCreateValidator.ValidatorAddress
+		_, err = queryClient.BlsPublicKeyAtEpoch(ctx, &types.QueryBlsPublicKeyAtEpochRequest{
+			ValAddress: newValAddr,
+			EpochNum:   1,
+		})
+		require.Error(t, err)
+		atEpochResp, err = queryClient.BlsPublicKeyAtEpoch(ctx, &types.QueryBlsPublicKeyAtEpochRequest{
+			ValAddress: newValAddr,
+			EpochNum:   2,
+		})
+		require.NoError(t, err)
+		require.Equal(t, newValAddr, atEpochResp.ValidatorWithBlsKey.ValidatorAddress)
+		require.Equal(t, wcvMsgs[0].Key.Pubkey.Bytes(), atEpochResp.ValidatorWithBlsKey.BlsPubKey)
+
+		// 4.3 a future epoch is rejected
+		_, err = queryClient.BlsPublicKeyAtEpoch(ctx, &types.QueryBlsPublicKeyAtEpochRequest{
+			ValAddress: newValAddr,
+			EpochNum:   3,
+		})
+		require.Error(t, err)
+	})
+}
+
+func FuzzQueryAllBlsRegistrations(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ck, ctx, _ := testkeeper.CheckpointingKeeper(t, nil, nil)
+
+		// register a random number of validators with random BLS public keys
+		numVals := int(datagen.RandomInt(r, 20)) + 1
+		vals := datagen.GenRandomValSet(numVals)
+		expected := map[string][]byte{}
+		for _, val := range vals {
+			blsPubKey := bls12381.GenPrivKey().PubKey()
+			err := ck.CreateRegistration(ctx, blsPubKey, val.Addr)
+			require.NoError(t, err)
+			expected[val.GetValAddressStr()] = blsPubKey.Bytes()
+		}
+
+		// query all registrations without pagination
+		res, err := ck.AllBlsRegistrations(ctx, &types.QueryAllBlsRegistrationsRequest{})
+		require.NoError(t, err)
+		require.Len(t, res.Registrations, numVals)
+		for _, reg := range res.Registrations {
+			require.Equal(t, expected[reg.ValidatorAddress], reg.BlsPubKey.Bytes())
+		}
+
+		// query all registrations page by page
+		limit := datagen.RandomInt(r, numVals) + 1
+		actual := map[string][]byte{}
+		pagination := &query.PageRequest{Limit: limit}
+		for {
+			res, err := ck.AllBlsRegistrations(ctx, &types.QueryAllBlsRegistrationsRequest{Pagination: pagination})
+			require.NoError(t, err)
+			require.LessOrEqual(t, uint64(len(res.Registrations)), limit)
+			for _, reg := range res.Registrations {
+				actual[reg.ValidatorAddress] = reg.BlsPubKey.Bytes()
+			}
+			if len(res.Pagination.NextKey) == 0 {
+				break
+			}
+			pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: limit}
+		}
+		require.Equal(t, expected, actual)
+	})
+}
+
+func FuzzQueryValidatorBlsSig(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		epochNum := datagen.RandomInt(r, 100) + 1
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ek := mocks.NewMockEpochingKeeper(ctrl)
+		ek.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: epochNum}).AnyTimes()
+		ck, ctx, _ := testkeeper.CheckpointingKeeper(t, ek, nil)
+
+		// retain the BLS sigs of a random set of validators
+		vals := datagen.GenRandomValSet(int(datagen.RandomInt(r, 10)) + 1)
+		blockHash := datagen.GenRandomBlockHash(r)
+		sigs := make([]*types.BlsSig, len(vals))
+		for i, val := range vals {
+			blsSig := datagen.GenRandomBlsMultiSig(r)
+			sigs[i] = &types.BlsSig{
+				EpochNum:         epochNum,
+				BlockHash:        &blockHash,
+				BlsSig:           &blsSig,
+				SignerAddress:    val.GetValAddressStr(),
+				ValidatorAddress: datagen.GenRandomHexStr(r, 20),
+			}
+			ck.SetValidatorBlsSig(ctx, val.Addr, sigs[i])
+		}
+
+		for i, val := range vals {
+			res, err := ck.ValidatorBlsSig(ctx, &types.QueryValidatorBlsSigRequest{
+				EpochNum:   epochNum,
+				ValAddress: val.GetValAddressStr(),
+			})
+			require.NoError(t, err)
+			require.Equal(t, sigs[i].SignerAddress, res.BlsSig.SignerAddress)
+			require.Equal(t, sigs[i].BlsSig.Bytes(), res.BlsSig.BlsSig.Bytes())
+			require.True(t, res.BlsSig.BlockHash.Equal(blockHash))
+
+			// the BLS sig is not retained for the other epochs
+			_, err = ck.ValidatorBlsSig(ctx, &types.QueryValidatorBlsSigRequest{
+				EpochNum:   epochNum - 1,
+				ValAddress: val.GetValAddressStr(),
+			})
+			require.ErrorIs(t, err, types.ErrBlsSigNotRetained)
+		}
+
+		// the BLS sig of a validator that did not sign is not retained
+		_, err := ck.ValidatorBlsSig(ctx, &types.QueryValidatorBlsSigRequest{
+			EpochNum:   epochNum,
+			ValAddress: datagen.GenRandomValidatorAddress().String(),
+		})
+		require.ErrorIs(t, err, types.ErrBlsSigNotRetained)
+
+		// epochs in the future are rejected
+		_, err = ck.ValidatorBlsSig(ctx, &types.QueryValidatorBlsSigRequest{
+			EpochNum:   epochNum + 1,
+			ValAddress: vals[0].GetValAddressStr(),
+		})
+		require.Error(t, err)
 	})
 }
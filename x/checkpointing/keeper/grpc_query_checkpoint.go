@@ -2,18 +2,30 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
+	"errors"
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/babylonchain/babylon/crypto/bls12381"
 	"github.com/babylonchain/babylon/x/checkpointing/types"
 )
 
 var _ types.QueryServer = Keeper{}
 
+// MaxVerifyCheckpointRange is the maximum number of epochs that can be
+// re-verified in a single VerifyCheckpoints query
+const MaxVerifyCheckpointRange = 100
+
+// MaxCheckpointParticipationRange is the maximum number of epochs that can be
+// covered in a single CheckpointParticipationHistory query
+const MaxCheckpointParticipationRange = 100
+
 // RawCheckpointList returns a list of checkpoint by status in the ascending order of epoch
 func (k Keeper) RawCheckpointList(c context.Context, req *types.QueryRawCheckpointListRequest) (*types.QueryRawCheckpointListResponse, error) {
 	if req == nil {
@@ -158,6 +170,82 @@ func (k Keeper) LastCheckpointWithStatus(ctx context.Context, req *types.QueryLa
 	return nil, fmt.Errorf("cannot find checkpoint with status %v", req.Status)
 }
 
+// CurrentCheckpoint returns the checkpoint that is currently accumulating BLS
+// signatures, along with the total voting power and the voting power required
+// to seal it
+func (k Keeper) CurrentCheckpoint(ctx context.Context, req *types.QueryCurrentCheckpointRequest) (*types.QueryCurrentCheckpointResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	tipEpoch, err := k.GetLastCheckpointedEpoch(sdkCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the last checkpointed epoch number: %w", err)
+	}
+	ckptWithMeta, err := k.GetRawCheckpoint(sdkCtx, tipEpoch)
+	if err != nil {
+		return nil, err
+	}
+	if ckptWithMeta.Status != types.Accumulating {
+		return nil, types.ErrCkptNotAccumulating.Wrapf("the checkpoint at epoch %d is %s", tipEpoch, ckptWithMeta.Status.String())
+	}
+
+	totalPower := k.GetTotalVotingPower(sdkCtx, tipEpoch)
+
+	return &types.QueryCurrentCheckpointResponse{
+		RawCheckpoint:  ckptWithMeta.ToResponse(),
+		TotalPower:     uint64(totalPower),
+		ThresholdPower: types.SealingPower(totalPower, k.GetParams(sdkCtx).SealingThreshold),
+	}, nil
+}
+
+// VerifyBlsMultiSig verifies the given BLS multi-sig against the validator set
+// of the given epoch in the same way as checkpoints submitted to BTC, without
+// changing state
+func (k Keeper) VerifyBlsMultiSig(ctx context.Context, req *types.QueryVerifyBlsMultiSigRequest) (*types.QueryVerifyBlsMultiSigResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	blockHashBytes, err := hex.DecodeString(req.BlockHashHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid block hash hex: %v", err)
+	}
+	blockHash := types.BlockHash(blockHashBytes)
+	var multiSig bls12381.Signature
+	if err := multiSig.Unmarshal(req.BlsMultiSig); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid BLS multi-sig: %v", err)
+	}
+	ckpt := &types.RawCheckpoint{
+		EpochNum:    req.EpochNum,
+		BlockHash:   &blockHash,
+		Bitmap:      req.Bitmap,
+		BlsMultiSig: &multiSig,
+	}
+	if err := ckpt.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := k.checkEpochNotInFuture(sdkCtx, req.EpochNum); err != nil {
+		return nil, err
+	}
+
+	resp := &types.QueryVerifyBlsMultiSigResponse{
+		TotalPower: uint64(k.GetTotalVotingPower(sdkCtx, req.EpochNum)),
+	}
+	powerSum, err := k.verifyRawCheckpoint(sdkCtx, ckpt)
+	resp.PowerSum = uint64(powerSum)
+	if err != nil {
+		resp.InvalidReason = err.Error()
+		return resp, nil
+	}
+	resp.Valid = true
+
+	return resp, nil
+}
+
 // GetLastCheckpointedEpoch returns the last epoch number that associates with a checkpoint
 func (k Keeper) GetLastCheckpointedEpoch(ctx context.Context) (uint64, error) {
 	curEpoch := k.GetEpoch(ctx).EpochNumber
@@ -172,3 +260,232 @@ func (k Keeper) GetLastCheckpointedEpoch(ctx context.Context) (uint64, error) {
 	}
 	return tipEpoch, nil
 }
+
+// VerifyCheckpoints re-verifies the stored checkpoints of the given epoch range
+// and returns the result of each epoch. It does not change state
+func (k Keeper) VerifyCheckpoints(ctx context.Context, req *types.QueryVerifyCheckpointsRequest) (*types.QueryVerifyCheckpointsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.FromEpoch > req.ToEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "from epoch %d is larger than to epoch %d", req.FromEpoch, req.ToEpoch)
+	}
+	if req.ToEpoch-req.FromEpoch >= MaxVerifyCheckpointRange {
+		return nil, status.Errorf(codes.InvalidArgument, "epoch range cannot contain more than %d epochs", MaxVerifyCheckpointRange)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := k.checkEpochNotInFuture(sdkCtx, req.ToEpoch); err != nil {
+		return nil, err
+	}
+
+	results, err := k.VerifyCheckpointRange(sdkCtx, req.FromEpoch, req.ToEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVerifyCheckpointsResponse{Results: results}, nil
+}
+
+// checkEpochNotInFuture returns an InvalidArgument error if the given epoch
+// is later than the current epoch, whose validator set is unknown yet
+func (k Keeper) checkEpochNotInFuture(ctx context.Context, epochNum uint64) error {
+	if curEpoch := k.GetEpoch(ctx).EpochNumber; epochNum > curEpoch {
+		return status.Errorf(codes.InvalidArgument, "epoch %d is later than the current epoch %d", epochNum, curEpoch)
+	}
+	return nil
+}
+
+// CheckpointSigners returns the validators who signed the checkpoint of the
+// given epoch, as indicated by the checkpoint's bitmap, together with their
+// accumulated voting power
+func (k Keeper) CheckpointSigners(ctx context.Context, req *types.QueryCheckpointSignersRequest) (*types.QueryCheckpointSignersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	ckptWithMeta, err := k.GetRawCheckpoint(sdkCtx, req.EpochNum)
+	if err != nil {
+		return nil, err
+	}
+
+	signerSet, err := k.GetValidatorSet(sdkCtx, req.EpochNum).FindSubset(ckptWithMeta.Ckpt.Bitmap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the signer set via bitmap of epoch %d: %w", req.EpochNum, err)
+	}
+	signers := make([]*types.CheckpointSigner, 0, len(signerSet))
+	var signersPower int64
+	for _, v := range signerSet {
+		signers = append(signers, &types.CheckpointSigner{
+			ValAddress: v.GetValAddressStr(),
+			Power:      v.Power,
+		})
+		signersPower += v.Power
+	}
+
+	return &types.QueryCheckpointSignersResponse{
+		Signers:      signers,
+		SignersPower: uint64(signersPower),
+		TotalPower:   uint64(k.GetTotalVotingPower(sdkCtx, req.EpochNum)),
+	}, nil
+}
+
+// CheckpointParticipationHistory returns, for each epoch in the given range
+// whose checkpoint is stored, the fraction of the epoch's voting power that
+// contributed a BLS signature to the checkpoint, as indicated by its bitmap
+func (k Keeper) CheckpointParticipationHistory(ctx context.Context, req *types.QueryCheckpointParticipationHistoryRequest) (*types.QueryCheckpointParticipationHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.FromEpoch > req.ToEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "from epoch %d is larger than to epoch %d", req.FromEpoch, req.ToEpoch)
+	}
+	if req.ToEpoch-req.FromEpoch >= MaxCheckpointParticipationRange {
+		return nil, status.Errorf(codes.InvalidArgument, "epoch range cannot contain more than %d epochs", MaxCheckpointParticipationRange)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	participations := make([]*types.CheckpointParticipation, 0, req.ToEpoch-req.FromEpoch+1)
+	for epoch := req.FromEpoch; epoch <= req.ToEpoch; epoch++ {
+		ckptWithMeta, err := k.GetRawCheckpoint(sdkCtx, epoch)
+		if errors.Is(err, types.ErrCkptDoesNotExist) {
+			// the checkpoint of the epoch is not built yet
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		valSet := k.GetValidatorSet(sdkCtx, epoch)
+		signerSet, err := valSet.FindSubset(ckptWithMeta.Ckpt.Bitmap)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the signer set via bitmap of epoch %d: %w", epoch, err)
+		}
+		var signersPower int64
+		for _, v := range signerSet {
+			signersPower += v.Power
+		}
+		totalPower := k.GetTotalVotingPower(sdkCtx, epoch)
+		rate := sdkmath.LegacyZeroDec()
+		if totalPower > 0 {
+			rate = sdkmath.LegacyNewDec(signersPower).QuoInt64(totalPower)
+		}
+
+		participations = append(participations, &types.CheckpointParticipation{
+			EpochNum:          epoch,
+			Status:            ckptWithMeta.Status,
+			NumSigners:        uint64(len(signerSet)),
+			NumValidators:     uint64(len(valSet)),
+			SignersPower:      uint64(signersPower),
+			TotalPower:        uint64(totalPower),
+			ParticipationRate: rate,
+		})
+	}
+
+	return &types.QueryCheckpointParticipationHistoryResponse{Participations: participations}, nil
+}
+
+// LatestCheckpointStateUpdate returns the checkpoint status transition that
+// occurred most recently across all epochs, such that a restarted monitor can
+// resync to it without replaying all events
+func (k Keeper) LatestCheckpointStateUpdate(ctx context.Context, req *types.QueryLatestCheckpointStateUpdateRequest) (*types.QueryLatestCheckpointStateUpdateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	transition, err := k.GetLatestCheckpointStatusTransition(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryLatestCheckpointStateUpdateResponse{
+		EpochNum:    transition.EpochNum,
+		From:        transition.From,
+		To:          transition.To,
+		BlockHeight: transition.BlockHeight,
+		BlockTime:   transition.BlockTime,
+	}, nil
+}
+
+// NextCheckpointHeight returns the length of the current epoch and the height
+// at which its checkpoint will be built, i.e., the first block of the next
+// epoch, which aggregates the BLS signatures sent in the epoch's last block
+func (k Keeper) NextCheckpointHeight(ctx context.Context, req *types.QueryNextCheckpointHeightRequest) (*types.QueryNextCheckpointHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	epoch := k.GetEpoch(sdkCtx)
+	return &types.QueryNextCheckpointHeightResponse{
+		EpochNum:         epoch.EpochNumber,
+		EpochLength:      epoch.CurrentEpochInterval,
+		FirstBlockHeight: epoch.FirstBlockHeight,
+		LastBlockHeight:  epoch.GetLastBlockHeight(),
+		CheckpointHeight: epoch.GetSealerBlockHeight(),
+		CurrentHeight:    uint64(sdkCtx.HeaderInfo().Height),
+	}, nil
+}
+
+// CheckpointStatusSummary returns the number of checkpoints in each status and
+// the latest epoch in each status. Since checkpoints are finalized in the
+// order of epochs, it only iterates the checkpoints from the tip until the
+// latest finalized one, below which all checkpoints are finalized
+func (k Keeper) CheckpointStatusSummary(ctx context.Context, req *types.QueryCheckpointStatusSummaryRequest) (*types.QueryCheckpointStatusSummaryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	summaries := make([]*types.CheckpointStatusSummary, 0, len(types.CheckpointStatus_name))
+	for s := types.Accumulating; s <= types.Finalized; s++ {
+		summaries = append(summaries, &types.CheckpointStatusSummary{Status: s})
+	}
+	resp := &types.QueryCheckpointStatusSummaryResponse{Summaries: summaries}
+
+	cs := k.CheckpointsState(ctx)
+	isTip := true
+	err := cs.IterateRawCkptsWithMetaReverse(func(ckptWithMeta *types.RawCheckpointWithMeta) bool {
+		epoch := ckptWithMeta.Ckpt.EpochNum
+		if isTip {
+			resp.TipEpoch = epoch
+			isTip = false
+		}
+		summary := summaries[ckptWithMeta.Status]
+		if summary.Count == 0 {
+			summary.LatestEpoch = epoch
+		}
+		if ckptWithMeta.Status != types.Finalized {
+			summary.Count++
+			return false
+		}
+		// the checkpoints at all epochs below are finalized, including the
+		// one at epoch 0 if any
+		summary.Count = epoch
+		if _, err := cs.GetRawCkptWithMeta(0); err == nil {
+			summary.Count++
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// CheckpointAccumulationProgress returns the progress of the checkpoint of the
+// given epoch towards being sealed
+func (k Keeper) CheckpointAccumulationProgress(ctx context.Context, req *types.QueryCheckpointAccumulationProgressRequest) (*types.QueryCheckpointAccumulationProgressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	progress, err := k.GetCheckpointAccumulationProgress(sdk.UnwrapSDKContext(ctx), req.EpochNum)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCheckpointAccumulationProgressResponse{Progress: progress}, nil
+}
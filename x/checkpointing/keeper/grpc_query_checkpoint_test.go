@@ -4,13 +4,18 @@ import (
 	"context"
 	"math/rand"
 	"testing"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/boljen/go-bitmap"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/babylonchain/babylon/x/checkpointing/keeper"
 
 	"github.com/golang/mock/gomock"
 
+	"github.com/babylonchain/babylon/crypto/bls12381"
 	"github.com/babylonchain/babylon/testutil/mocks"
 	"github.com/babylonchain/babylon/x/checkpointing/types"
 	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
@@ -161,6 +166,261 @@ func FuzzQueryLastCheckpointWithStatus(f *testing.F) {
 	})
 }
 
+func FuzzQueryCurrentCheckpoint(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		tipEpoch := datagen.RandomInt(r, 100) + 10
+		totalPower := int64(datagen.RandomInt(r, 1000) + 1)
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ek := mocks.NewMockEpochingKeeper(ctrl)
+		ek.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: tipEpoch}).AnyTimes()
+		ek.EXPECT().GetTotalVotingPower(gomock.Any(), tipEpoch-1).Return(totalPower).AnyTimes()
+		ckptKeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, ek, nil)
+		checkpoints := datagen.GenSequenceRawCheckpointsWithMeta(r, tipEpoch)
+		for e := uint64(0); e < tipEpoch; e++ {
+			checkpoints[int(e)].Status = types.Sealed
+		}
+		// the checkpoint of the last ended epoch is still accumulating
+		curCkpt := checkpoints[int(tipEpoch-1)]
+		curCkpt.Status = types.Accumulating
+		for e := uint64(0); e < tipEpoch; e++ {
+			err := ckptKeeper.AddRawCheckpoint(ctx, checkpoints[int(e)])
+			require.NoError(t, err)
+		}
+
+		req := &types.QueryCurrentCheckpointRequest{}
+		resp, err := ckptKeeper.CurrentCheckpoint(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, curCkpt.ToResponse(), resp.RawCheckpoint)
+		require.Equal(t, uint64(totalPower), resp.TotalPower)
+		require.Equal(t, uint64(totalPower*2/3+1), resp.ThresholdPower)
+		require.True(t, resp.ThresholdPower*3 > uint64(totalPower)*2)
+
+		// once sealed, the checkpoint is no longer returned
+		curCkpt.Status = types.Sealed
+		err = ckptKeeper.UpdateCheckpoint(ctx, curCkpt)
+		require.NoError(t, err)
+		_, err = ckptKeeper.CurrentCheckpoint(ctx, req)
+		require.ErrorIs(t, err, types.ErrCkptNotAccumulating)
+	})
+}
+
+func FuzzQueryVerifyBlsMultiSig(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		epochNum := datagen.RandomInt(r, 100) + 1
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ek := mocks.NewMockEpochingKeeper(ctrl)
+		ek.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: epochNum}).AnyTimes()
+		ek.EXPECT().GetValidatorSet(gomock.Any(), epochNum).Return(valSet).AnyTimes()
+		ek.EXPECT().GetTotalVotingPower(gomock.Any(), epochNum).Return(int64(20)).AnyTimes()
+		ckptKeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, ek, nil)
+		for i, val := range valSet {
+			err := ckptKeeper.CreateRegistration(ctx, pubkeys[i], val.Addr)
+			require.NoError(t, err)
+		}
+
+		blockHash := datagen.GenRandomBlockHash(r)
+		msgBytes := types.GetSignBytes(epochNum, blockHash)
+		sig1 := bls12381.Sign(blsPrivKey1, msgBytes)
+		sig2 := bls12381.Sign(blsPrivKey2, msgBytes)
+		multiSig, err := bls12381.AggrSig(sig1, sig2)
+		require.NoError(t, err)
+		bmAll := bitmap.New(types.BitmapBits)
+		bmAll.Set(0, true)
+		bmAll.Set(1, true)
+		bmOne := bitmap.New(types.BitmapBits)
+		bmOne.Set(0, true)
+
+		// 1. a multi sig by all validators is valid
+		req := &types.QueryVerifyBlsMultiSigRequest{
+			EpochNum:     epochNum,
+			BlockHashHex: blockHash.String(),
+			Bitmap:       bmAll,
+			BlsMultiSig:  multiSig,
+		}
+		resp, err := ckptKeeper.VerifyBlsMultiSig(ctx, req)
+		require.NoError(t, err)
+		require.True(t, resp.Valid)
+		require.Equal(t, uint64(20), resp.PowerSum)
+		require.Equal(t, uint64(20), resp.TotalPower)
+		require.Empty(t, resp.InvalidReason)
+
+		// 2. a multi sig by validators with insufficient voting power is invalid
+		req.Bitmap = bmOne
+		req.BlsMultiSig = sig1
+		resp, err = ckptKeeper.VerifyBlsMultiSig(ctx, req)
+		require.NoError(t, err)
+		require.False(t, resp.Valid)
+		require.Equal(t, uint64(10), resp.PowerSum)
+		require.NotEmpty(t, resp.InvalidReason)
+
+		// 3. a multi sig not matching the bitmap is invalid
+		req.Bitmap = bmAll
+		req.BlsMultiSig = sig1
+		resp, err = ckptKeeper.VerifyBlsMultiSig(ctx, req)
+		require.NoError(t, err)
+		require.False(t, resp.Valid)
+		require.Equal(t, uint64(20), resp.PowerSum)
+		require.NotEmpty(t, resp.InvalidReason)
+
+		// 4. malformed requests and future epochs are rejected
+		req.BlsMultiSig = datagen.GenRandomByteArray(r, 10)
+		_, err = ckptKeeper.VerifyBlsMultiSig(ctx, req)
+		require.Error(t, err)
+		req.BlsMultiSig = multiSig
+		req.EpochNum = epochNum + 1
+		_, err = ckptKeeper.VerifyBlsMultiSig(ctx, req)
+		require.Error(t, err)
+	})
+}
+
+func TestQueryVerifyBlsMultiSigIgnoresSealingThreshold(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	epochNum := datagen.RandomInt(r, 100) + 1
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ek := mocks.NewMockEpochingKeeper(ctrl)
+	ek.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: epochNum}).AnyTimes()
+	ek.EXPECT().GetValidatorSet(gomock.Any(), epochNum).Return(valSet).AnyTimes()
+	ek.EXPECT().GetTotalVotingPower(gomock.Any(), epochNum).Return(int64(19)).AnyTimes()
+	ckptKeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, ek, nil)
+	for i, val := range valSet {
+		err := ckptKeeper.CreateRegistration(ctx, pubkeys[i], val.Addr)
+		require.NoError(t, err)
+	}
+
+	blockHash := datagen.GenRandomBlockHash(r)
+	bmOne := bitmap.New(types.BitmapBits)
+	bmOne.Set(0, true)
+	req := &types.QueryVerifyBlsMultiSigRequest{
+		EpochNum:     epochNum,
+		BlockHashHex: blockHash.String(),
+		Bitmap:       bmOne,
+		BlsMultiSig:  bls12381.Sign(blsPrivKey1, types.GetSignBytes(epochNum, blockHash)),
+	}
+
+	// 10 out of 19 is not more than 2/3 of the total voting power
+	resp, err := ckptKeeper.VerifyBlsMultiSig(ctx, req)
+	require.NoError(t, err)
+	require.False(t, resp.Valid)
+
+	// a checkpoint sealed under a lower sealing threshold is still invalid,
+	// as the validity always requires more than 2/3 of the total voting power
+	err = ckptKeeper.SetParams(ctx, types.NewParams(sdkmath.LegacyNewDecWithPrec(52, 2), false))
+	require.NoError(t, err)
+	resp, err = ckptKeeper.VerifyBlsMultiSig(ctx, req)
+	require.NoError(t, err)
+	require.False(t, resp.Valid)
+	require.Equal(t, uint64(10), resp.PowerSum)
+}
+
+func FuzzQueryVerifyCheckpoints(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		fromEpoch := datagen.RandomInt(r, 100) + 1
+		curEpoch := fromEpoch + 3
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ek := mocks.NewMockEpochingKeeper(ctrl)
+		ek.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: curEpoch}).AnyTimes()
+		ek.EXPECT().GetValidatorSet(gomock.Any(), gomock.Any()).Return(valSet).AnyTimes()
+		ek.EXPECT().GetTotalVotingPower(gomock.Any(), gomock.Any()).Return(int64(20)).AnyTimes()
+		ckptKeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, ek, nil)
+		for i, val := range valSet {
+			err := ckptKeeper.CreateRegistration(ctx, pubkeys[i], val.Addr)
+			require.NoError(t, err)
+		}
+
+		bmAll := bitmap.New(types.BitmapBits)
+		bmAll.Set(0, true)
+		bmAll.Set(1, true)
+		bmOne := bitmap.New(types.BitmapBits)
+		bmOne.Set(0, true)
+		genCkpt := func(epochNum uint64, bm bitmap.Bitmap, status types.CheckpointStatus) *types.RawCheckpointWithMeta {
+			blockHash := datagen.GenRandomBlockHash(r)
+			msgBytes := types.GetSignBytes(epochNum, blockHash)
+			multiSig := bls12381.Sign(blsPrivKey1, msgBytes)
+			if bm.Get(1) {
+				var err error
+				multiSig, err = bls12381.AggrSig(multiSig, bls12381.Sign(blsPrivKey2, msgBytes))
+				require.NoError(t, err)
+			}
+			ckpt := &types.RawCheckpoint{
+				EpochNum:    epochNum,
+				BlockHash:   &blockHash,
+				Bitmap:      bm,
+				BlsMultiSig: &multiSig,
+			}
+			return types.NewCheckpointWithMeta(ckpt, status)
+		}
+
+		// a valid checkpoint, a checkpoint with insufficient voting power, and an
+		// accumulating checkpoint, while the last epoch in the range has no checkpoint
+		ckpts := []*types.RawCheckpointWithMeta{
+			genCkpt(fromEpoch, bmAll, types.Finalized),
+			genCkpt(fromEpoch+1, bmOne, types.Sealed),
+			genCkpt(fromEpoch+2, bmAll, types.Accumulating),
+		}
+		for _, ckpt := range ckpts {
+			err := ckptKeeper.AddRawCheckpoint(ctx, ckpt)
+			require.NoError(t, err)
+		}
+
+		resp, err := ckptKeeper.VerifyCheckpoints(ctx, &types.QueryVerifyCheckpointsRequest{
+			FromEpoch: fromEpoch,
+			ToEpoch:   curEpoch,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 4)
+		for i, res := range resp.Results {
+			require.Equal(t, fromEpoch+uint64(i), res.EpochNum)
+			require.Equal(t, uint64(20), res.TotalPower)
+		}
+		require.True(t, resp.Results[0].Valid)
+		require.Equal(t, types.Finalized, resp.Results[0].Status)
+		require.Equal(t, uint64(20), resp.Results[0].PowerSum)
+		require.Empty(t, resp.Results[0].InvalidReason)
+		require.False(t, resp.Results[1].Valid)
+		require.Equal(t, types.Sealed, resp.Results[1].Status)
+		require.Equal(t, uint64(10), resp.Results[1].PowerSum)
+		require.NotEmpty(t, resp.Results[1].InvalidReason)
+		for _, res := range resp.Results[2:] {
+			require.False(t, res.Valid)
+			require.NotEmpty(t, res.InvalidReason)
+		}
+		for _, res := range resp.Results[:3] {
+			require.True(t, res.Found)
+		}
+		require.Equal(t, types.Accumulating, resp.Results[2].Status)
+		require.False(t, resp.Results[3].Found)
+
+		// the verification does not change the stored checkpoints
+		for _, ckpt := range ckpts {
+			stored, err := ckptKeeper.GetRawCheckpoint(ctx, ckpt.Ckpt.EpochNum)
+			require.NoError(t, err)
+			require.Equal(t, ckpt.Status, stored.Status)
+		}
+
+		// invalid ranges are rejected
+		_, err = ckptKeeper.VerifyCheckpoints(ctx, &types.QueryVerifyCheckpointsRequest{FromEpoch: fromEpoch + 1, ToEpoch: fromEpoch})
+		require.Error(t, err)
+		_, err = ckptKeeper.VerifyCheckpoints(ctx, &types.QueryVerifyCheckpointsRequest{FromEpoch: fromEpoch, ToEpoch: curEpoch + 1})
+		require.Error(t, err)
+		_, err = ckptKeeper.VerifyCheckpoints(ctx, &types.QueryVerifyCheckpointsRequest{FromEpoch: 0, ToEpoch: keeper.MaxVerifyCheckpointRange})
+		require.Error(t, err)
+	})
+}
+
 // func TestQueryRawCheckpointList(t *testing.T) {
 func FuzzQueryRawCheckpointList(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
@@ -221,3 +481,371 @@ func testRawCheckpointListWithType(
 		req = types.NewQueryRawCheckpointListRequest(pagination, status)
 	}
 }
+
+func FuzzQueryCheckpointSigners(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		epochNum := datagen.RandomInt(r, 100) + 1
+		vals := datagen.GenRandomValSet(int(datagen.RandomInt(r, 50)) + 1)
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ek := mocks.NewMockEpochingKeeper(ctrl)
+		ek.EXPECT().GetValidatorSet(gomock.Any(), epochNum).Return(vals).AnyTimes()
+		ek.EXPECT().GetTotalVotingPower(gomock.Any(), epochNum).Return(int64(10 * len(vals))).AnyTimes()
+		ckptKeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, ek, nil)
+
+		// the checkpoint is signed by a random subset of the validator set
+		ckptWithMeta := datagen.GenRandomRawCheckpointWithMeta(r)
+		ckptWithMeta.Ckpt.EpochNum = epochNum
+		ckptWithMeta.Status = types.Sealed
+		bm, _ := datagen.GenRandomBitmap(r)
+		ckptWithMeta.Ckpt.Bitmap = bm
+		err := ckptKeeper.AddRawCheckpoint(ctx, ckptWithMeta)
+		require.NoError(t, err)
+		expectedSigners, err := vals.FindSubset(bm)
+		require.NoError(t, err)
+
+		resp, err := ckptKeeper.CheckpointSigners(ctx, &types.QueryCheckpointSignersRequest{EpochNum: epochNum})
+		require.NoError(t, err)
+		require.Len(t, resp.Signers, len(expectedSigners))
+		for i, val := range expectedSigners {
+			require.Equal(t, val.GetValAddressStr(), resp.Signers[i].ValAddress)
+			require.Equal(t, val.Power, resp.Signers[i].Power)
+		}
+		require.Equal(t, uint64(10*len(expectedSigners)), resp.SignersPower)
+		require.Equal(t, uint64(10*len(vals)), resp.TotalPower)
+
+		// the checkpoint of a non-existing epoch is not found
+		_, err = ckptKeeper.CheckpointSigners(ctx, &types.QueryCheckpointSignersRequest{EpochNum: epochNum + 1})
+		require.Error(t, err)
+	})
+}
+
+func FuzzQueryCheckpointParticipationHistory(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		vals := datagen.GenRandomValSet(int(datagen.RandomInt(r, 50)) + 1)
+		totalPower := int64(10 * len(vals))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ek := mocks.NewMockEpochingKeeper(ctrl)
+		ek.EXPECT().GetValidatorSet(gomock.Any(), gomock.Any()).Return(vals).AnyTimes()
+		ek.EXPECT().GetTotalVotingPower(gomock.Any(), gomock.Any()).Return(totalPower).AnyTimes()
+		ckptKeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, ek, nil)
+
+		// store the checkpoints of a random subset of epochs, each signed by
+		// a random subset of the validator set
+		fromEpoch := datagen.RandomInt(r, 100) + 1
+		toEpoch := fromEpoch + datagen.RandomInt(r, int(keeper.MaxCheckpointParticipationRange))
+		expected := []*types.CheckpointParticipation{}
+		for epoch := fromEpoch; epoch <= toEpoch; epoch++ {
+			if datagen.OneInN(r, 3) {
+				continue
+			}
+			ckptWithMeta := datagen.GenRandomRawCheckpointWithMeta(r)
+			ckptWithMeta.Ckpt.EpochNum = epoch
+			bm, _ := datagen.GenRandomBitmap(r)
+			ckptWithMeta.Ckpt.Bitmap = bm
+			err := ckptKeeper.AddRawCheckpoint(ctx, ckptWithMeta)
+			require.NoError(t, err)
+
+			signers, err := vals.FindSubset(bm)
+			require.NoError(t, err)
+			signersPower := int64(10 * len(signers))
+			expected = append(expected, &types.CheckpointParticipation{
+				EpochNum:          epoch,
+				Status:            ckptWithMeta.Status,
+				NumSigners:        uint64(len(signers)),
+				NumValidators:     uint64(len(vals)),
+				SignersPower:      uint64(signersPower),
+				TotalPower:        uint64(totalPower),
+				ParticipationRate: sdkmath.LegacyNewDec(signersPower).QuoInt64(totalPower),
+			})
+		}
+
+		resp, err := ckptKeeper.CheckpointParticipationHistory(ctx, &types.QueryCheckpointParticipationHistoryRequest{
+			FromEpoch: fromEpoch,
+			ToEpoch:   toEpoch,
+		})
+		require.NoError(t, err)
+		require.Equal(t, expected, resp.Participations)
+		for _, p := range resp.Participations {
+			require.True(t, p.ParticipationRate.LTE(sdkmath.LegacyOneDec()))
+		}
+
+		// invalid ranges are rejected
+		_, err = ckptKeeper.CheckpointParticipationHistory(ctx, &types.QueryCheckpointParticipationHistoryRequest{
+			FromEpoch: toEpoch + 1,
+			ToEpoch:   toEpoch,
+		})
+		require.Error(t, err)
+		_, err = ckptKeeper.CheckpointParticipationHistory(ctx, &types.QueryCheckpointParticipationHistoryRequest{
+			FromEpoch: fromEpoch,
+			ToEpoch:   fromEpoch + keeper.MaxCheckpointParticipationRange,
+		})
+		require.Error(t, err)
+	})
+}
+
+func FuzzQueryLatestCheckpointStateUpdate(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ckptKeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, nil, nil)
+
+		// no status transition has occurred yet
+		_, err := ckptKeeper.LatestCheckpointStateUpdate(ctx, &types.QueryLatestCheckpointStateUpdateRequest{})
+		require.ErrorIs(t, err, types.ErrNoCkptStatusTransition)
+
+		// add sealed checkpoints of a few epochs
+		numEpochs := datagen.RandomInt(r, 5) + 1
+		for epoch := uint64(0); epoch < numEpochs; epoch++ {
+			ckptWithMeta := datagen.GenRandomRawCheckpointWithMeta(r)
+			ckptWithMeta.Ckpt.EpochNum = epoch
+			ckptWithMeta.Status = types.Sealed
+			err := ckptKeeper.AddRawCheckpoint(ctx, ckptWithMeta)
+			require.NoError(t, err)
+		}
+
+		// submit and confirm the checkpoints of random epochs, and the query
+		// always returns the most recent transition
+		for i := 0; i < 10; i++ {
+			ctx = updateRandomCtx(r, ctx)
+			epoch := datagen.RandomInt(r, int(numEpochs))
+			from, err := ckptKeeper.GetStatus(ctx, epoch)
+			require.NoError(t, err)
+			var to types.CheckpointStatus
+			switch from {
+			case types.Sealed:
+				ckptKeeper.SetCheckpointSubmitted(ctx, epoch)
+				to = types.Submitted
+			case types.Submitted:
+				ckptKeeper.SetCheckpointForgotten(ctx, epoch)
+				to = types.Sealed
+			default:
+				continue
+			}
+
+			resp, err := ckptKeeper.LatestCheckpointStateUpdate(ctx, &types.QueryLatestCheckpointStateUpdateRequest{})
+			require.NoError(t, err)
+			require.Equal(t, epoch, resp.EpochNum)
+			require.Equal(t, from, resp.From)
+			require.Equal(t, to, resp.To)
+			require.Equal(t, uint64(ctx.HeaderInfo().Height), resp.BlockHeight)
+			require.True(t, ctx.HeaderInfo().Time.Equal(*resp.BlockTime))
+		}
+	})
+}
+
+func FuzzQueryAggregateBlsPubKey(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		epochNum := datagen.RandomInt(r, 100) + 1
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ek := mocks.NewMockEpochingKeeper(ctrl)
+		ek.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: epochNum}).AnyTimes()
+		ek.EXPECT().GetValidatorSet(gomock.Any(), epochNum).Return(valSet).AnyTimes()
+		ckptKeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, ek, nil)
+		for i, val := range valSet {
+			err := ckptKeeper.CreateRegistration(ctx, pubkeys[i], val.Addr)
+			require.NoError(t, err)
+		}
+
+		blockHash := datagen.GenRandomBlockHash(r)
+		msgBytes := types.GetSignBytes(epochNum, blockHash)
+		sig1 := bls12381.Sign(blsPrivKey1, msgBytes)
+		sig2 := bls12381.Sign(blsPrivKey2, msgBytes)
+		multiSig, err := bls12381.AggrSig(sig1, sig2)
+		require.NoError(t, err)
+		bmAll := bitmap.New(types.BitmapBits)
+		bmAll.Set(0, true)
+		bmAll.Set(1, true)
+		bmOne := bitmap.New(types.BitmapBits)
+		bmOne.Set(0, true)
+
+		// 1. the multi sig by all validators verifies against the aggregate key
+		resp, err := ckptKeeper.AggregateBlsPubKey(ctx, &types.QueryAggregateBlsPubKeyRequest{EpochNum: epochNum, Bitmap: bmAll})
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), resp.NumSigners)
+		require.Equal(t, uint64(20), resp.PowerSum)
+		valid, err := bls12381.Verify(multiSig, *resp.AggregateBlsPubKey, msgBytes)
+		require.NoError(t, err)
+		require.True(t, valid)
+
+		// 2. the aggregate key of a single signer is its own key
+		resp, err = ckptKeeper.AggregateBlsPubKey(ctx, &types.QueryAggregateBlsPubKeyRequest{EpochNum: epochNum, Bitmap: bmOne})
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), resp.NumSigners)
+		require.True(t, blsPubKey1.Equal(*resp.AggregateBlsPubKey))
+		valid, err = bls12381.Verify(multiSig, *resp.AggregateBlsPubKey, msgBytes)
+		require.NoError(t, err)
+		require.False(t, valid)
+
+		// 3. bitmaps indicating no signer or too short, and future epochs are rejected
+		_, err = ckptKeeper.AggregateBlsPubKey(ctx, &types.QueryAggregateBlsPubKeyRequest{EpochNum: epochNum, Bitmap: bitmap.New(types.BitmapBits)})
+		require.Error(t, err)
+		_, err = ckptKeeper.AggregateBlsPubKey(ctx, &types.QueryAggregateBlsPubKeyRequest{EpochNum: epochNum, Bitmap: []byte{}})
+		require.Error(t, err)
+		_, err = ckptKeeper.AggregateBlsPubKey(ctx, &types.QueryAggregateBlsPubKeyRequest{EpochNum: epochNum + 1, Bitmap: bmAll})
+		require.Error(t, err)
+	})
+}
+
+func FuzzQueryNextCheckpointHeight(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		// a random epoch with a random length
+		epochNum := datagen.RandomInt(r, 100) + 1
+		epochInterval := datagen.RandomInt(r, 100) + 2
+		firstBlockHeight := (epochNum-1)*epochInterval + 1
+		epoch := &epochingtypes.Epoch{
+			EpochNumber:          epochNum,
+			CurrentEpochInterval: epochInterval,
+			FirstBlockHeight:     firstBlockHeight,
+		}
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ek := mocks.NewMockEpochingKeeper(ctrl)
+		ek.EXPECT().GetEpoch(gomock.Any()).Return(epoch).AnyTimes()
+		ckptKeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, ek, nil)
+		curHeight := firstBlockHeight + datagen.RandomInt(r, int(epochInterval))
+		ctx = datagen.WithCtxHeight(ctx, curHeight)
+
+		resp, err := ckptKeeper.NextCheckpointHeight(ctx, &types.QueryNextCheckpointHeightRequest{})
+		require.NoError(t, err)
+		require.Equal(t, epochNum, resp.EpochNum)
+		require.Equal(t, epochInterval, resp.EpochLength)
+		require.Equal(t, firstBlockHeight, resp.FirstBlockHeight)
+		require.Equal(t, firstBlockHeight+epochInterval-1, resp.LastBlockHeight)
+		// the checkpoint is built in the first block of the next epoch
+		require.Equal(t, firstBlockHeight+epochInterval, resp.CheckpointHeight)
+		require.Equal(t, curHeight, resp.CurrentHeight)
+		require.Less(t, resp.CurrentHeight, resp.CheckpointHeight)
+	})
+}
+
+func FuzzQueryCheckpointStatusSummary(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ckptKeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, nil, nil)
+
+		// no checkpoint yet
+		resp, err := ckptKeeper.CheckpointStatusSummary(ctx, &types.QueryCheckpointStatusSummaryRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Summaries, len(types.CheckpointStatus_name))
+		for _, summary := range resp.Summaries {
+			require.Zero(t, summary.Count)
+		}
+
+		// a sequence of checkpoints, which may or may not start from epoch 0,
+		// where the checkpoints up to a random epoch are finalized and the
+		// remaining ones are in random non-finalized statuses
+		tipEpoch := datagen.RandomInt(r, 100) + 1
+		checkpoints := datagen.GenSequenceRawCheckpointsWithMeta(r, tipEpoch)
+		if datagen.OneInN(r, 2) {
+			checkpoints = checkpoints[1:]
+		}
+		hasFinalized := !datagen.OneInN(r, 4)
+		finalizedEpoch := datagen.RandomInt(r, int(tipEpoch)+1)
+		expectedCounts := make(map[types.CheckpointStatus]uint64)
+		expectedLatestEpochs := make(map[types.CheckpointStatus]uint64)
+		for _, ckpt := range checkpoints {
+			if hasFinalized && ckpt.Ckpt.EpochNum <= finalizedEpoch {
+				ckpt.Status = types.Finalized
+			} else {
+				ckpt.Status = types.CheckpointStatus(datagen.RandomInt(r, int(types.Finalized)))
+			}
+			err := ckptKeeper.AddRawCheckpoint(ctx, ckpt)
+			require.NoError(t, err)
+			expectedCounts[ckpt.Status]++
+			expectedLatestEpochs[ckpt.Status] = ckpt.Ckpt.EpochNum
+		}
+
+		resp, err = ckptKeeper.CheckpointStatusSummary(ctx, &types.QueryCheckpointStatusSummaryRequest{})
+		require.NoError(t, err)
+		require.Equal(t, tipEpoch, resp.TipEpoch)
+		require.Len(t, resp.Summaries, len(types.CheckpointStatus_name))
+		for i, summary := range resp.Summaries {
+			require.Equal(t, types.CheckpointStatus(i), summary.Status)
+			require.Equal(t, expectedCounts[summary.Status], summary.Count, summary.Status.String())
+			if summary.Count > 0 {
+				require.Equal(t, expectedLatestEpochs[summary.Status], summary.LatestEpoch, summary.Status.String())
+			}
+		}
+	})
+}
+
+func FuzzQueryCheckpointAccumulationProgress(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		vals := datagen.GenRandomValSet(int(datagen.RandomInt(r, 50)) + 1)
+		totalPower := int64(10 * len(vals))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ek := mocks.NewMockEpochingKeeper(ctrl)
+		ek.EXPECT().GetValidatorSet(gomock.Any(), gomock.Any()).Return(vals).AnyTimes()
+		ek.EXPECT().GetTotalVotingPower(gomock.Any(), gomock.Any()).Return(totalPower).AnyTimes()
+		ckptKeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, ek, nil)
+
+		// an accumulating checkpoint signed by a random subset of the
+		// validator set
+		epoch := datagen.RandomInt(r, 100) + 1
+		ckptWithMeta := datagen.GenRandomRawCheckpointWithMeta(r)
+		ckptWithMeta.Ckpt.EpochNum = epoch
+		ckptWithMeta.Status = types.Accumulating
+		bm, _ := datagen.GenRandomBitmap(r)
+		ckptWithMeta.Ckpt.Bitmap = bm
+		err := ckptKeeper.AddRawCheckpoint(ctx, ckptWithMeta)
+		require.NoError(t, err)
+
+		signers, err := vals.FindSubset(bm)
+		require.NoError(t, err)
+		accumulatedPower := uint64(10 * len(signers))
+		sealingThreshold := ckptKeeper.GetParams(ctx).SealingThreshold
+		sealingPower := types.SealingPower(totalPower, sealingThreshold)
+
+		resp, err := ckptKeeper.CheckpointAccumulationProgress(ctx, &types.QueryCheckpointAccumulationProgressRequest{EpochNum: epoch})
+		require.NoError(t, err)
+		progress := resp.Progress
+		require.Equal(t, epoch, progress.EpochNum)
+		require.Equal(t, types.Accumulating, progress.Status)
+		require.Equal(t, accumulatedPower, progress.AccumulatedPower)
+		require.Equal(t, uint64(totalPower), progress.TotalPower)
+		require.Equal(t, sealingThreshold, progress.SealingThreshold)
+		require.Equal(t, sealingPower, progress.SealingPower)
+		if accumulatedPower >= sealingPower {
+			require.Equal(t, sdkmath.LegacyNewDec(100), progress.PercentageToSeal)
+		} else {
+			expectedPercentage := sdkmath.LegacyNewDec(100).MulInt64(int64(accumulatedPower)).QuoInt64(int64(sealingPower))
+			require.Equal(t, expectedPercentage, progress.PercentageToSeal)
+			require.True(t, progress.PercentageToSeal.LT(sdkmath.LegacyNewDec(100)))
+		}
+
+		// once sealed, the checkpoint is fully accumulated
+		ckptWithMeta.Status = types.Sealed
+		err = ckptKeeper.UpdateCheckpoint(ctx, ckptWithMeta)
+		require.NoError(t, err)
+		resp, err = ckptKeeper.CheckpointAccumulationProgress(ctx, &types.QueryCheckpointAccumulationProgressRequest{EpochNum: epoch})
+		require.NoError(t, err)
+		require.Equal(t, accumulatedPower, resp.Progress.AccumulatedPower)
+		require.Equal(t, sdkmath.LegacyNewDec(100), resp.Progress.PercentageToSeal)
+
+		// the checkpoint of an epoch that is not built yet does not exist
+		_, err = ckptKeeper.CheckpointAccumulationProgress(ctx, &types.QueryCheckpointAccumulationProgressRequest{EpochNum: epoch + 1})
+		require.ErrorIs(t, err, types.ErrCkptDoesNotExist)
+	})
+}
@@ -2,10 +2,10 @@ package keeper
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	corestoretypes "cosmossdk.io/core/store"
+	sdkmath "cosmossdk.io/math"
 
 	txformat "github.com/babylonchain/babylon/btctxformatter"
 
@@ -27,6 +27,20 @@ type (
 		blsSigner      BlsSigner
 		epochingKeeper types.EpochingKeeper
 		hooks          types.CheckpointingHooks
+		// conflictEvidenceDir is the directory to which the evidence of a
+		// conflicting checkpoint is persisted. The evidence is not persisted
+		// if empty
+		conflictEvidenceDir string
+		// conflictHandler handles a conflicting checkpoint after the block
+		// carrying it has been committed
+		conflictHandler types.ConflictingCheckpointHandler
+		// pendingConflict holds the evidence of a conflicting checkpoint found
+		// in the block being finalized, which is yet to be handled. It is
+		// shared across the copies of the keeper
+		pendingConflict *pendingConflictingCheckpoint
+		// the address capable of executing a MsgUpdateParams message. Typically, this
+		// should be the x/gov module account.
+		authority string
 	}
 )
 
@@ -35,13 +49,17 @@ func NewKeeper(
 	storeService corestoretypes.KVStoreService,
 	signer BlsSigner,
 	ek types.EpochingKeeper,
+	authority string,
 ) Keeper {
 	return Keeper{
-		cdc:            cdc,
-		storeService:   storeService,
-		blsSigner:      signer,
-		epochingKeeper: ek,
-		hooks:          nil,
+		cdc:             cdc,
+		storeService:    storeService,
+		blsSigner:       signer,
+		epochingKeeper:  ek,
+		hooks:           nil,
+		conflictHandler: types.PanicConflictingCheckpointHandler,
+		pendingConflict: &pendingConflictingCheckpoint{},
+		authority:       authority,
 	}
 }
 
@@ -60,6 +78,17 @@ func (k *Keeper) SetHooks(sh types.CheckpointingHooks) *Keeper {
 	return k
 }
 
+// SetConflictingCheckpointHandler sets the handler of conflicting checkpoints,
+// and the directory to which their evidences are persisted when found in a
+// finalized block. The handler is invoked after the block is committed. The
+// evidences are persisted outside the KV store, since any
+// state written by a tx carrying a conflicting checkpoint is reverted
+func (k *Keeper) SetConflictingCheckpointHandler(evidenceDir string, handler types.ConflictingCheckpointHandler) *Keeper {
+	k.conflictEvidenceDir = evidenceDir
+	k.conflictHandler = handler
+	return k
+}
+
 func (k Keeper) SealCheckpoint(ctx context.Context, ckptWithMeta *types.RawCheckpointWithMeta) error {
 	if ckptWithMeta.Status != types.Sealed {
 		return fmt.Errorf("the checkpoint is not Sealed")
@@ -75,6 +104,7 @@ func (k Keeper) SealCheckpoint(ctx context.Context, ckptWithMeta *types.RawCheck
 
 	// record state update of Sealed
 	ckptWithMeta.RecordStateUpdate(ctx, types.Sealed)
+	k.setLatestCheckpointStatusTransition(ctx, ckptWithMeta.Ckpt.EpochNum, types.Accumulating, types.Sealed)
 	// emit event
 	if err := sdkCtx.EventManager().EmitTypedEvent(
 		&types.EventCheckpointSealed{Checkpoint: ckptWithMeta},
@@ -145,6 +175,9 @@ func (k Keeper) AddRawCheckpoint(ctx context.Context, ckptWithMeta *types.RawChe
 	return k.CheckpointsState(ctx).CreateRawCkptWithMeta(ckptWithMeta)
 }
 
+// BuildRawCheckpoint builds a raw checkpoint of the given epoch that commits
+// to the given hash, which is the checkpoint hash that validators signed over
+// in their vote extensions
 func (k Keeper) BuildRawCheckpoint(ctx context.Context, epochNum uint64, blockHash types.BlockHash) (*types.RawCheckpointWithMeta, error) {
 	ckptWithMeta := types.NewCheckpointWithMeta(types.NewCheckpoint(epochNum, blockHash), types.Accumulating)
 	ckptWithMeta.RecordStateUpdate(ctx, types.Accumulating) // record the state update of Accumulating
@@ -157,51 +190,154 @@ func (k Keeper) BuildRawCheckpoint(ctx context.Context, epochNum uint64, blockHa
 	return ckptWithMeta, nil
 }
 
+// GetCheckpointAccumulationProgress recomputes the voting power of the BLS
+// signatures accumulated in the checkpoint of the given epoch from its bitmap,
+// and returns it along with the progress towards the sealing power under the
+// current sealing threshold
+func (k Keeper) GetCheckpointAccumulationProgress(ctx context.Context, epochNum uint64) (*types.CheckpointAccumulationProgress, error) {
+	ckptWithMeta, err := k.GetRawCheckpoint(ctx, epochNum)
+	if err != nil {
+		return nil, err
+	}
+	signerSet, err := k.GetValidatorSet(ctx, epochNum).FindSubset(ckptWithMeta.Ckpt.Bitmap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the signer set via bitmap of epoch %d: %w", epochNum, err)
+	}
+	var accumulatedPower int64
+	for _, v := range signerSet {
+		accumulatedPower += v.Power
+	}
+	totalPower := k.GetTotalVotingPower(ctx, epochNum)
+	sealingThreshold := k.GetParams(ctx).SealingThreshold
+	sealingPower := types.SealingPower(totalPower, sealingThreshold)
+
+	// a checkpoint that is no longer accumulating has been sealed, even if the
+	// sealing threshold was raised afterwards
+	percentage := sdkmath.LegacyNewDec(100)
+	if ckptWithMeta.Status == types.Accumulating && uint64(accumulatedPower) < sealingPower {
+		percentage = percentage.MulInt64(accumulatedPower).QuoInt64(int64(sealingPower))
+	}
+
+	return &types.CheckpointAccumulationProgress{
+		EpochNum:         epochNum,
+		Status:           ckptWithMeta.Status,
+		AccumulatedPower: uint64(accumulatedPower),
+		TotalPower:       uint64(totalPower),
+		SealingThreshold: sealingThreshold,
+		SealingPower:     sealingPower,
+		PercentageToSeal: percentage,
+	}, nil
+}
+
+// VerifyRawCheckpoint verifies a raw checkpoint that is not necessarily
+// produced locally, e.g., one submitted to BTC. Note that the voting power
+// check here always requires more than 2/3 of the total voting power, which is
+// the BFT safety bound for a quorum on a checkpoint. It is deliberately
+// independent of the sealing threshold param, which only decides when a
+// locally accumulating checkpoint gets sealed.
 func (k Keeper) VerifyRawCheckpoint(ctx context.Context, ckpt *types.RawCheckpoint) error {
+	_, err := k.verifyRawCheckpoint(ctx, ckpt)
+	return err
+}
+
+// verifyRawCheckpoint verifies the raw checkpoint and returns the voting power
+// of the signers indicated by the bitmap, which is returned even if the
+// signers do not have sufficient voting power
+func (k Keeper) verifyRawCheckpoint(ctx context.Context, ckpt *types.RawCheckpoint) (int64, error) {
 	// check whether sufficient voting power is accumulated
 	// and verify if the multi signature is valid
 	totalPower := k.GetTotalVotingPower(ctx, ckpt.EpochNum)
-	signerSet, err := k.GetValidatorSet(ctx, ckpt.EpochNum).FindSubset(ckpt.Bitmap)
+	signersPubKeys, sum, err := k.getSignersBlsPubKeys(ctx, ckpt.EpochNum, ckpt.Bitmap)
 	if err != nil {
-		return fmt.Errorf("failed to get the signer set via bitmap of epoch %d: %w", ckpt.EpochNum, err)
-	}
-	var sum int64
-	signersPubKeys := make([]bls12381.PublicKey, len(signerSet))
-	for i, v := range signerSet {
-		signersPubKeys[i], err = k.GetBlsPubKey(ctx, v.Addr)
-		if err != nil {
-			return err
-		}
-		sum += v.Power
+		return 0, err
 	}
 	if sum*3 <= totalPower*2 {
-		return types.ErrInvalidRawCheckpoint.Wrap("insufficient voting power")
+		return sum, types.ErrInvalidRawCheckpoint.Wrap("insufficient voting power")
 	}
 	msgBytes := types.GetSignBytes(ckpt.GetEpochNum(), *ckpt.BlockHash)
 	ok, err := bls12381.VerifyMultiSig(*ckpt.BlsMultiSig, signersPubKeys, msgBytes)
 	if err != nil {
-		return err
+		return sum, err
 	}
 	if !ok {
-		return types.ErrInvalidRawCheckpoint.Wrap("invalid BLS multi-sig")
+		return sum, types.ErrInvalidRawCheckpoint.Wrap("invalid BLS multi-sig")
 	}
 
-	return nil
+	return sum, nil
+}
+
+// getSignersBlsPubKeys returns the BLS public keys and the accumulated voting
+// power of the subset of the given epoch's validator set indicated by the
+// given bitmap
+func (k Keeper) getSignersBlsPubKeys(ctx context.Context, epochNum uint64, bm []byte) ([]bls12381.PublicKey, int64, error) {
+	signerSet, err := k.GetValidatorSet(ctx, epochNum).FindSubset(bm)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get the signer set via bitmap of epoch %d: %w", epochNum, err)
+	}
+	var sum int64
+	signersPubKeys := make([]bls12381.PublicKey, len(signerSet))
+	for i, v := range signerSet {
+		signersPubKeys[i], err = k.GetBlsPubKey(ctx, v.Addr)
+		if err != nil {
+			return nil, 0, err
+		}
+		sum += v.Power
+	}
+	return signersPubKeys, sum, nil
 }
 
 // VerifyCheckpoint verifies checkpoint from BTC. It verifies
 // the raw checkpoint and decides whether it is an invalid checkpoint or a
 // conflicting checkpoint. A conflicting checkpoint indicates the existence
-// of a fork
+// of a fork, upon which the handler of conflicting checkpoints is invoked
+// once the block carrying it is committed
 func (k Keeper) VerifyCheckpoint(ctx context.Context, checkpoint txformat.RawBtcCheckpoint) error {
 	_, err := k.verifyCkptBytes(ctx, &checkpoint)
-	if err != nil {
-		if errors.Is(err, types.ErrConflictingCheckpoint) {
-			panic(err)
+	return err
+}
+
+// VerifyCheckpointRange re-verifies the stored checkpoints of the epochs in
+// [fromEpoch, toEpoch] and returns the result of each epoch. Unlike
+// verifyCkptBytes, it does not invoke hooks, emit events or panic upon
+// failures, so it is safe for auditing checkpoints that have been processed
+func (k Keeper) VerifyCheckpointRange(ctx context.Context, fromEpoch uint64, toEpoch uint64) ([]*types.CheckpointVerificationResult, error) {
+	if fromEpoch > toEpoch {
+		return nil, fmt.Errorf("from epoch %d is larger than to epoch %d", fromEpoch, toEpoch)
+	}
+
+	results := make([]*types.CheckpointVerificationResult, 0, toEpoch-fromEpoch+1)
+	for epoch := fromEpoch; epoch <= toEpoch; epoch++ {
+		res := &types.CheckpointVerificationResult{
+			EpochNum:   epoch,
+			TotalPower: uint64(k.GetTotalVotingPower(ctx, epoch)),
 		}
-		return err
+		results = append(results, res)
+
+		ckptWithMeta, err := k.GetRawCheckpoint(ctx, epoch)
+		if err != nil {
+			res.InvalidReason = err.Error()
+			continue
+		}
+		res.Found = true
+		res.Status = ckptWithMeta.Status
+		if ckptWithMeta.Status == types.Accumulating {
+			res.InvalidReason = types.ErrInvalidCkptStatus.Wrap("checkpoint is still accumulating BLS sigs").Error()
+			continue
+		}
+		if err := ckptWithMeta.Ckpt.ValidateBasic(); err != nil {
+			res.InvalidReason = err.Error()
+			continue
+		}
+		powerSum, err := k.verifyRawCheckpoint(ctx, ckptWithMeta.Ckpt)
+		res.PowerSum = uint64(powerSum)
+		if err != nil {
+			res.InvalidReason = err.Error()
+			continue
+		}
+		res.Valid = true
 	}
-	return nil
+
+	return results, nil
 }
 
 // verifyCkptBytes verifies checkpoint from BTC. A checkpoint is valid if
@@ -210,7 +346,6 @@ func (k Keeper) VerifyCheckpoint(ctx context.Context, checkpoint txformat.RawBtc
 // conflicting checkpoint. A conflicting checkpoint indicates the existence
 // of a fork
 func (k Keeper) verifyCkptBytes(ctx context.Context, rawCheckpoint *txformat.RawBtcCheckpoint) (*types.RawCheckpointWithMeta, error) {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	ckpt, err := types.FromBTCCkptToRawCkpt(rawCheckpoint)
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode raw checkpoint from BTC raw checkpoint: %w", err)
@@ -254,19 +389,68 @@ func (k Keeper) verifyCkptBytes(ctx context.Context, rawCheckpoint *txformat.Raw
 	}
 
 	// multi-sig is valid but the quorum is on a different branch, meaning conflicting is observed
+	k.handleConflictingCheckpoint(ctx, ckpt, ckptWithMeta)
+
+	return nil, types.ErrConflictingCheckpoint
+}
+
+// handleConflictingCheckpoint emits EventConflictingCheckpoint upon a
+// conflicting checkpoint. If the block is being finalized, it also persists
+// the evidence and records it as pending, so that the handler of conflicting
+// checkpoints is invoked by HandlePendingConflictingCheckpoint once the block
+// is committed. The handler is never invoked in the tx path, so that the
+// result of the tx carrying the conflicting checkpoint is the same regardless
+// of the handler. Failing to persist the evidence does not prevent the handler
+// from being invoked, as the evidence is still available in the logs and the
+// event
+func (k Keeper) handleConflictingCheckpoint(ctx context.Context, ckpt *types.RawCheckpoint, localCkptWithMeta *types.RawCheckpointWithMeta) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	k.Logger(sdkCtx).Error(types.ErrConflictingCheckpoint.Wrapf("epoch %v", ckpt.EpochNum).Error())
+
 	// report conflicting checkpoint event
-	err = sdkCtx.EventManager().EmitTypedEvent(
+	err := sdkCtx.EventManager().EmitTypedEvent(
 		&types.EventConflictingCheckpoint{
 			ConflictingCheckpoint: ckpt,
-			LocalCheckpoint:       ckptWithMeta,
+			LocalCheckpoint:       localCkptWithMeta,
 		},
 	)
 	if err != nil {
 		panic(err)
 	}
 
-	return nil, types.ErrConflictingCheckpoint
+	// CheckTx and simulations go through the same path, in which case
+	// the evidence is neither persisted nor handled
+	if sdkCtx.ExecMode() != sdk.ExecModeFinalize {
+		return
+	}
+
+	blockTime := sdkCtx.HeaderInfo().Time
+	evidence := &types.ConflictingCheckpointEvidence{
+		ConflictingCheckpoint: ckpt,
+		LocalCheckpoint:       localCkptWithMeta,
+		BlockHeight:           uint64(sdkCtx.HeaderInfo().Height),
+		BlockTime:             &blockTime,
+	}
+	if k.conflictEvidenceDir != "" {
+		if err := types.WriteConflictingCheckpointEvidence(k.conflictEvidenceDir, evidence); err != nil {
+			k.Logger(sdkCtx).Error("failed to persist conflicting checkpoint evidence", "epoch", ckpt.EpochNum, "error", err)
+		} else {
+			k.Logger(sdkCtx).Error("persisted conflicting checkpoint evidence", "epoch", ckpt.EpochNum,
+				"file", types.ConflictingCheckpointEvidenceFile(k.conflictEvidenceDir, ckpt.EpochNum))
+		}
+	}
+
+	k.pendingConflict.set(evidence)
+}
+
+// HandlePendingConflictingCheckpoint invokes the handler of conflicting
+// checkpoints, which may terminate the process, if a conflicting checkpoint
+// has been found in a finalized block. It is called once the block is
+// committed
+func (k Keeper) HandlePendingConflictingCheckpoint(ctx context.Context) {
+	if evidence := k.pendingConflict.take(); evidence != nil {
+		k.conflictHandler(ctx, evidence)
+	}
 }
 
 func (k *Keeper) SetEpochingKeeper(ek types.EpochingKeeper) {
@@ -358,6 +542,7 @@ func (k Keeper) setCheckpointStatus(ctx context.Context, epoch uint64, from type
 	if err != nil {
 		panic("failed to update checkpoint status")
 	}
+	k.setLatestCheckpointStatusTransition(ctx, epoch, from, to)
 	statusChangeMsg := fmt.Sprintf("Checkpointing: checkpoint status for epoch %v successfully changed from %v to %v", epoch, from.String(), to.String())
 	k.Logger(sdk.UnwrapSDKContext(ctx)).Info(statusChangeMsg)
 	return ckptWithMeta
@@ -394,6 +579,27 @@ func (k Keeper) GetBlsPubKey(ctx context.Context, address sdk.ValAddress) (bls12
 	return k.RegistrationState(ctx).GetBlsPubKey(address)
 }
 
+// GetBlsPubKeyAtEpoch returns the BLS public key that the given validator used
+// in the given epoch, together with its voting power in that epoch. Note that
+// BLS keys cannot be rotated at the moment, so the key is the one registered
+// by the validator as long as the validator is in the epoch's validator set
+func (k Keeper) GetBlsPubKeyAtEpoch(ctx context.Context, epochNumber uint64, address sdk.ValAddress) (*types.ValidatorWithBlsKey, error) {
+	val, _, err := k.GetValidatorSet(ctx, epochNumber).FindValidatorWithIndex(address)
+	if err != nil {
+		return nil, fmt.Errorf("validator %s is not in the validator set of epoch %d: %w", address.String(), epochNumber, err)
+	}
+	pubkey, err := k.GetBlsPubKey(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ValidatorWithBlsKey{
+		ValidatorAddress: val.GetValAddressStr(),
+		BlsPubKey:        pubkey,
+		VotingPower:      uint64(val.Power),
+	}, nil
+}
+
 func (k Keeper) GetEpoch(ctx context.Context) *epochingtypes.Epoch {
 	return k.epochingKeeper.GetEpoch(ctx)
 }
@@ -430,3 +636,69 @@ func (k Keeper) SetLastFinalizedEpoch(ctx context.Context, epochNumber uint64) {
 		panic(err)
 	}
 }
+
+// setLatestCheckpointStatusTransition records the given status transition of
+// the checkpoint of the given epoch as the latest one, where the time/height
+// are captured by the current ctx
+func (k Keeper) setLatestCheckpointStatusTransition(ctx context.Context, epoch uint64, from types.CheckpointStatus, to types.CheckpointStatus) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.HeaderInfo().Time
+	transition := &types.CheckpointStatusTransition{
+		EpochNum:    epoch,
+		From:        from,
+		To:          to,
+		BlockHeight: uint64(sdkCtx.HeaderInfo().Height),
+		BlockTime:   &blockTime,
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.LatestCkptStatusTransitionKey, k.cdc.MustMarshal(transition)); err != nil {
+		panic(err)
+	}
+}
+
+// GetLatestCheckpointStatusTransition gets the checkpoint status transition
+// that occurred most recently across all epochs
+func (k Keeper) GetLatestCheckpointStatusTransition(ctx context.Context) (*types.CheckpointStatusTransition, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.LatestCkptStatusTransitionKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil, types.ErrNoCkptStatusTransition
+	}
+	var transition types.CheckpointStatusTransition
+	k.cdc.MustUnmarshal(bz, &transition)
+	return &transition, nil
+}
+
+// SetValidatorBlsSig retains the individual BLS sig that a validator
+// contributed to the sealed checkpoint of the BLS sig's epoch
+func (k Keeper) SetValidatorBlsSig(ctx context.Context, valAddr sdk.ValAddress, sig *types.BlsSig) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(k.validatorBlsSigKey(sig.EpochNum, valAddr), k.cdc.MustMarshal(sig)); err != nil {
+		panic(err)
+	}
+}
+
+// GetValidatorBlsSig gets the individual BLS sig that a validator contributed
+// to the sealed checkpoint of the given epoch. It returns an error if the
+// validator did not contribute to the checkpoint or if BLS sigs were not
+// retained when the checkpoint was sealed
+func (k Keeper) GetValidatorBlsSig(ctx context.Context, epochNum uint64, valAddr sdk.ValAddress) (*types.BlsSig, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(k.validatorBlsSigKey(epochNum, valAddr))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil, types.ErrBlsSigNotRetained.Wrapf("epoch: %d, validator: %s", epochNum, valAddr.String())
+	}
+	var sig types.BlsSig
+	k.cdc.MustUnmarshal(bz, &sig)
+	return &sig, nil
+}
+
+func (k Keeper) validatorBlsSigKey(epochNum uint64, valAddr sdk.ValAddress) []byte {
+	return append(types.ValidatorBlsSigPrefix, types.ValidatorBlsSigKey(epochNum, valAddr)...)
+}
@@ -1,11 +1,14 @@
 package keeper_test
 
 import (
+	"context"
 	"math/rand"
+	"os"
 	"testing"
 
 	"github.com/boljen/go-bitmap"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -223,12 +226,138 @@ func FuzzKeeperCheckpointEpoch(f *testing.F) {
 			bls12381.Sign(blsPrivKey1, msgBytes),
 			t,
 		)
+		evidenceDir := t.TempDir()
+		ckptKeeper.SetConflictingCheckpointHandler(evidenceDir, types.PanicConflictingCheckpointHandler)
+		finalizeCtx := sdk.UnwrapSDKContext(ctx).WithExecMode(sdk.ExecModeFinalize)
+		err = ckptKeeper.VerifyCheckpoint(finalizeCtx, *rawBtcCheckpoint)
+		require.ErrorIs(t, err, types.ErrConflictingCheckpoint)
+
+		// the evidence is persisted before panicking upon commit
+		evidence, err := types.ReadConflictingCheckpointEvidence(evidenceDir, localCkptWithMeta.Ckpt.EpochNum)
+		require.NoError(t, err)
+		require.True(t, localCkptWithMeta.Equal(evidence.LocalCheckpoint))
+		require.Equal(t, conflictBlockHash, evidence.ConflictingCheckpoint.BlockHash.MustMarshal())
 		require.Panics(t, func() {
-			_ = ckptKeeper.VerifyCheckpoint(ctx, *rawBtcCheckpoint)
+			ckptKeeper.HandlePendingConflictingCheckpoint(finalizeCtx)
 		})
 	})
 }
 
+// FuzzConflictingCheckpointHandler checks that upon a conflicting checkpoint,
+// the checkpoint is rejected in the same way regardless of the handler, that
+// the evidence is persisted only when finalizing a block, and that the handler
+// is invoked only once the block is committed
+func FuzzConflictingCheckpointHandler(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ek := mocks.NewMockEpochingKeeper(ctrl)
+		ek.EXPECT().GetValidatorSet(gomock.Any(), gomock.Any()).Return(valSet).AnyTimes()
+		ek.EXPECT().GetTotalVotingPower(gomock.Any(), gomock.Any()).Return(int64(10)).AnyTimes()
+		ckptKeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, ek, nil)
+		for i, val := range valSet {
+			err := ckptKeeper.CreateRegistration(ctx, pubkeys[i], val.Addr)
+			require.NoError(t, err)
+		}
+		height := datagen.RandomInt(r, 1000) + 1
+		ctx = datagen.WithCtxHeight(ctx, height)
+
+		// add local checkpoint, signed by the first validator
+		bm := bitmap.New(types.BitmapBits)
+		bm.Set(0, true)
+		localCkptWithMeta := datagen.GenRandomRawCheckpointWithMeta(r)
+		localCkptWithMeta.Status = types.Sealed
+		localCkptWithMeta.PowerSum = 10
+		localCkptWithMeta.Ckpt.Bitmap = bm
+		msgBytes := types.GetSignBytes(localCkptWithMeta.Ckpt.EpochNum, *localCkptWithMeta.Ckpt.BlockHash)
+		sig := bls12381.Sign(blsPrivKey1, msgBytes)
+		localCkptWithMeta.Ckpt.BlsMultiSig = &sig
+		err := ckptKeeper.AddRawCheckpoint(ctx, localCkptWithMeta)
+		require.NoError(t, err)
+
+		// a handler that records the evidence without terminating the process
+		evidenceDir := t.TempDir()
+		var handledEvidence *types.ConflictingCheckpointEvidence
+		ckptKeeper.SetConflictingCheckpointHandler(evidenceDir, func(_ context.Context, evidence *types.ConflictingCheckpointEvidence) {
+			handledEvidence = evidence
+		})
+		finalizeCtx := ctx.WithExecMode(sdk.ExecModeFinalize)
+
+		// a valid checkpoint does not lead to the handler
+		rawBtcCheckpoint := makeBtcCkptBytes(
+			r,
+			localCkptWithMeta.Ckpt.EpochNum,
+			localCkptWithMeta.Ckpt.BlockHash.MustMarshal(),
+			localCkptWithMeta.Ckpt.Bitmap,
+			localCkptWithMeta.Ckpt.BlsMultiSig.Bytes(),
+			t,
+		)
+		err = ckptKeeper.VerifyCheckpoint(finalizeCtx, *rawBtcCheckpoint)
+		require.NoError(t, err)
+		ckptKeeper.HandlePendingConflictingCheckpoint(finalizeCtx)
+		require.Nil(t, handledEvidence)
+		_, err = types.ReadConflictingCheckpointEvidence(evidenceDir, localCkptWithMeta.Ckpt.EpochNum)
+		require.ErrorIs(t, err, os.ErrNotExist)
+
+		conflictBlockHash := datagen.GenRandomByteArray(r, btctxformatter.BlockHashLength)
+		msgBytes = types.GetSignBytes(localCkptWithMeta.Ckpt.EpochNum, conflictBlockHash)
+		rawBtcCheckpoint = makeBtcCkptBytes(
+			r,
+			localCkptWithMeta.Ckpt.EpochNum,
+			conflictBlockHash,
+			localCkptWithMeta.Ckpt.Bitmap,
+			bls12381.Sign(blsPrivKey1, msgBytes),
+			t,
+		)
+
+		// a conflicting checkpoint in a simulation is rejected and reported,
+		// but neither persisted nor handled
+		simulateCtx := ctx.WithExecMode(sdk.ExecModeSimulate).WithEventManager(sdk.NewEventManager())
+		err = ckptKeeper.VerifyCheckpoint(simulateCtx, *rawBtcCheckpoint)
+		require.ErrorIs(t, err, types.ErrConflictingCheckpoint)
+		require.True(t, hasConflictingCheckpointEvent(simulateCtx))
+		ckptKeeper.HandlePendingConflictingCheckpoint(finalizeCtx)
+		require.Nil(t, handledEvidence)
+		_, err = types.ReadConflictingCheckpointEvidence(evidenceDir, localCkptWithMeta.Ckpt.EpochNum)
+		require.ErrorIs(t, err, os.ErrNotExist)
+
+		// a conflicting checkpoint in a finalized block is rejected in the
+		// same way, with its evidence persisted but not yet handled
+		finalizeCtx = finalizeCtx.WithEventManager(sdk.NewEventManager())
+		err = ckptKeeper.VerifyCheckpoint(finalizeCtx, *rawBtcCheckpoint)
+		require.ErrorIs(t, err, types.ErrConflictingCheckpoint)
+		require.True(t, hasConflictingCheckpointEvent(finalizeCtx))
+		require.Nil(t, handledEvidence)
+		evidence, err := types.ReadConflictingCheckpointEvidence(evidenceDir, localCkptWithMeta.Ckpt.EpochNum)
+		require.NoError(t, err)
+		require.Equal(t, height, evidence.BlockHeight)
+		require.True(t, localCkptWithMeta.Equal(evidence.LocalCheckpoint))
+		require.Equal(t, conflictBlockHash, evidence.ConflictingCheckpoint.BlockHash.MustMarshal())
+
+		// the handler is invoked upon commit with the persisted evidence,
+		// and only once
+		ckptKeeper.HandlePendingConflictingCheckpoint(finalizeCtx)
+		require.NotNil(t, handledEvidence)
+		require.True(t, handledEvidence.LocalCheckpoint.Equal(evidence.LocalCheckpoint))
+		require.True(t, handledEvidence.ConflictingCheckpoint.Equal(evidence.ConflictingCheckpoint))
+		handledEvidence = nil
+		ckptKeeper.HandlePendingConflictingCheckpoint(finalizeCtx)
+		require.Nil(t, handledEvidence)
+	})
+}
+
+func hasConflictingCheckpointEvent(ctx sdk.Context) bool {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == proto.MessageName(&types.EventConflictingCheckpoint{}) {
+			return true
+		}
+	}
+	return false
+}
+
 func makeBtcCkptBytes(r *rand.Rand, epoch uint64, appHash []byte, bitmap []byte, blsSig []byte, t *testing.T) *btctxformatter.RawBtcCheckpoint {
 	tag := datagen.GenRandomByteArray(r, btctxformatter.TagLength)
 	babylonTag := btctxformatter.BabylonTag(tag[:btctxformatter.TagLength])
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
+// SetParams sets the x/checkpointing module parameters.
+func (k Keeper) SetParams(ctx context.Context, p types.Params) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&p)
+	return store.Set(types.ParamsKey, bz)
+}
+
+// GetParams returns the current x/checkpointing module parameters.
+// Chains that were started before the parameters were introduced have
+// nothing stored under ParamsKey, in which case the default parameters
+// apply.
+func (k Keeper) GetParams(ctx context.Context) (p types.Params) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.ParamsKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return types.DefaultParams()
+	}
+	k.cdc.MustUnmarshal(bz, &p)
+	return p
+}
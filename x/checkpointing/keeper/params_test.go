@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	"github.com/babylonchain/babylon/x/checkpointing/keeper"
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
+func TestGetParams(t *testing.T) {
+	k, ctx, _ := testkeeper.CheckpointingKeeper(t, nil, nil)
+	params := types.NewParams(sdkmath.LegacyNewDecWithPrec(75, 2), true)
+
+	err := k.SetParams(ctx, params)
+	require.NoError(t, err)
+
+	require.EqualValues(t, params, k.GetParams(ctx))
+
+	// the sealing threshold can be raised up to the total voting power
+	params = types.NewParams(sdkmath.LegacyOneDec(), false)
+	err = k.SetParams(ctx, params)
+	require.NoError(t, err)
+	require.EqualValues(t, params, k.GetParams(ctx))
+}
+
+func TestSetInvalidParams(t *testing.T) {
+	k, ctx, _ := testkeeper.CheckpointingKeeper(t, nil, nil)
+
+	for _, threshold := range []sdkmath.LegacyDec{
+		sdkmath.LegacyNewDecWithPrec(5, 1),
+		sdkmath.LegacyNewDecWithPrec(1, 1),
+		sdkmath.LegacyNewDecWithPrec(101, 2),
+	} {
+		err := k.SetParams(ctx, types.NewParams(threshold, false))
+		require.Error(t, err)
+	}
+	require.EqualValues(t, types.DefaultParams(), k.GetParams(ctx))
+}
+
+func TestGetParamsWithoutStoredParams(t *testing.T) {
+	// a chain upgraded from a version without params has nothing stored
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	k := keeper.NewKeeper(nil, runtime.NewKVStoreService(storeKey), nil, nil, "")
+	params := k.GetParams(ctx)
+	require.EqualValues(t, types.DefaultParams(), params)
+	require.False(t, params.SealingThreshold.IsNil())
+}
+
+func TestParamsQuery(t *testing.T) {
+	k, ctx, _ := testkeeper.CheckpointingKeeper(t, nil, nil)
+
+	response, err := k.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryParamsResponse{Params: types.DefaultParams()}, response)
+}
@@ -23,10 +23,11 @@ import (
 )
 
 var (
-	_ appmodule.AppModule       = AppModule{}
-	_ appmodule.HasBeginBlocker = AppModule{}
-	_ module.HasABCIEndBlock    = AppModule{}
-	_ module.AppModuleBasic     = AppModuleBasic{}
+	_ appmodule.AppModule            = AppModule{}
+	_ appmodule.HasBeginBlocker      = AppModule{}
+	_ appmodule.HasPrepareCheckState = AppModule{}
+	_ module.HasABCIEndBlock         = AppModule{}
+	_ module.AppModuleBasic          = AppModuleBasic{}
 )
 
 // ----------------------------------------------------------------------------
@@ -163,6 +164,14 @@ func (am AppModule) EndBlock(_ context.Context) ([]abci.ValidatorUpdate, error)
 	return []abci.ValidatorUpdate{}, nil
 }
 
+// PrepareCheckState is invoked once the block is committed. It hands the
+// conflicting checkpoint found in the committed block, if any, to the handler
+// of conflicting checkpoints.
+func (am AppModule) PrepareCheckState(ctx context.Context) error {
+	am.keeper.HandlePendingConflictingCheckpoint(ctx)
+	return nil
+}
+
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
 func (am AppModule) IsOnePerModuleType() { // marker
 }
@@ -11,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
 
+	"github.com/boljen/go-bitmap"
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	ckpttypes "github.com/babylonchain/babylon/x/checkpointing/types"
@@ -108,6 +109,12 @@ func (h *ProposalHandler) PrepareProposal() sdk.PrepareProposalHandler {
 	}
 }
 
+// buildCheckpointFromVoteExtensions builds a sealed checkpoint of the given
+// epoch out of the BLS sigs in the vote extensions of the last block.
+// NOTE: BLS sigs are only accepted through vote extensions, and a checkpoint
+// is accumulated and sealed within a single proposal. Thus, no checkpoint
+// stays accumulating across blocks, and a validator that missed signing an
+// epoch cannot submit its BLS sig for that epoch later on.
 func (h *ProposalHandler) buildCheckpointFromVoteExtensions(ctx sdk.Context, epoch uint64, extendedVotes []abci.ExtendedVoteInfo) (*ckpttypes.RawCheckpointWithMeta, error) {
 	prevBlockID, err := h.findLastBlockHash(extendedVotes)
 	if err != nil {
@@ -117,6 +124,7 @@ func (h *ProposalHandler) buildCheckpointFromVoteExtensions(ctx sdk.Context, epo
 	validBLSSigs := h.getValidBlsSigs(ctx, extendedVotes, prevBlockID)
 	vals := h.ckptKeeper.GetValidatorSet(ctx, epoch)
 	totalPower := h.ckptKeeper.GetTotalVotingPower(ctx, epoch)
+	sealingThreshold := h.ckptKeeper.GetParams(ctx).SealingThreshold
 	// TODO: maybe we don't need to verify BLS sigs anymore as they are already
 	//  verified by VerifyVoteExtension
 	for _, sig := range validBLSSigs {
@@ -137,7 +145,7 @@ func (h *ProposalHandler) buildCheckpointFromVoteExtensions(ctx sdk.Context, epo
 			)
 			continue
 		}
-		err = ckpt.Accumulate(vals, signerAddress, signerBlsKey, *sig.BlsSig, totalPower)
+		err = ckpt.Accumulate(vals, signerAddress, signerBlsKey, *sig.BlsSig, totalPower, sealingThreshold)
 		if err != nil {
 			h.logger.Error(
 				"skip invalid BLS sig",
@@ -357,10 +365,43 @@ func (h *ProposalHandler) PreBlocker() sdk.PreBlocker {
 			return res, fmt.Errorf("failed to update checkpoint: %w", err)
 		}
 
+		// 3. retain the individual BLS sigs aggregated into the checkpoint
+		if k.GetParams(ctx).RetainValidatorBlsSigs {
+			h.retainValidatorBlsSigs(ctx, injectedCkpt)
+		}
+
 		return res, nil
 	}
 }
 
+// retainValidatorBlsSigs stores the BLS sig in the vote extension of each
+// validator that is in the bitmap of the injected checkpoint, i.e., the BLS
+// sigs that are aggregated into the checkpoint's BLS multi sig. The vote
+// extensions are already verified in ProcessProposal.
+func (h *ProposalHandler) retainValidatorBlsSigs(ctx sdk.Context, injectedCkpt *ckpttypes.InjectedCheckpoint) {
+	k := h.ckptKeeper
+	ckpt := injectedCkpt.Ckpt.Ckpt
+	vals := k.GetValidatorSet(ctx, ckpt.EpochNum)
+	for _, voteInfo := range injectedCkpt.ExtendedCommitInfo.Votes {
+		var ve ckpttypes.VoteExtension
+		if err := ve.Unmarshal(voteInfo.VoteExtension); err != nil {
+			continue
+		}
+		if ve.EpochNum != ckpt.EpochNum || ve.BlockHash == nil || !ve.BlockHash.Equal(*ckpt.BlockHash) {
+			continue
+		}
+		signerAddress, err := sdk.ValAddressFromBech32(ve.Signer)
+		if err != nil {
+			continue
+		}
+		_, index, err := vals.FindValidatorWithIndex(signerAddress)
+		if err != nil || !bitmap.Get(ckpt.Bitmap, index) {
+			continue
+		}
+		k.SetValidatorBlsSig(ctx, signerAddress, ve.ToBLSSig())
+	}
+}
+
 // extractInjectedCheckpoint extracts the injected checkpoint from the tx set
 func extractInjectedCheckpoint(txs [][]byte) (*ckpttypes.InjectedCheckpoint, error) {
 	if len(txs) < defaultInjectedTxIndex+1 {
@@ -18,4 +18,6 @@ type CheckpointingKeeper interface {
 	GetBlsPubKey(ctx context.Context, address sdk.ValAddress) (bls12381.PublicKey, error)
 	VerifyBLSSig(ctx context.Context, sig *types.BlsSig) error
 	SealCheckpoint(ctx context.Context, ckptWithMeta *types.RawCheckpointWithMeta) error
+	GetParams(ctx context.Context) types.Params
+	SetValidatorBlsSig(ctx context.Context, valAddr sdk.ValAddress, sig *types.BlsSig)
 }
@@ -482,6 +482,7 @@ func TestPrepareProposalAtVoteExtensionHeight(t *testing.T) {
 			// Those are true for every scenario
 			ek.EXPECT().GetEpoch(gomock.Any()).Return(ec.Epoch).AnyTimes()
 			ek.EXPECT().GetTotalVotingPower(gomock.Any(), ec.Epoch.EpochNumber).Return(scenario.TotalPower).AnyTimes()
+			ek.EXPECT().GetParams(gomock.Any()).Return(checkpointingtypes.DefaultParams()).AnyTimes()
 			ek.EXPECT().GetValidatorSet(gomock.Any(), ec.Epoch.EpochNumber).Return(et.NewSortedValidatorSet(ToValidatorSet(scenario.ValidatorSet))).AnyTimes()
 
 			h := checkpointing.NewProposalHandler(
@@ -0,0 +1,1050 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: babylon/checkpointing/v1/bls_signer.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_babylonchain_babylon_crypto_bls12381 "github.com/babylonchain/babylon/crypto/bls12381"
+	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GetValidatorKeysRequest is the request type for the
+// BlsSigner/GetValidatorKeys RPC method.
+type GetValidatorKeysRequest struct {
+}
+
+func (m *GetValidatorKeysRequest) Reset()         { *m = GetValidatorKeysRequest{} }
+func (m *GetValidatorKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*GetValidatorKeysRequest) ProtoMessage()    {}
+func (*GetValidatorKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_22691d4c667f1047, []int{0}
+}
+func (m *GetValidatorKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetValidatorKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetValidatorKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetValidatorKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValidatorKeysRequest.Merge(m, src)
+}
+func (m *GetValidatorKeysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetValidatorKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetValidatorKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetValidatorKeysRequest proto.InternalMessageInfo
+
+// GetValidatorKeysResponse is the response type for the
+// BlsSigner/GetValidatorKeys RPC method.
+type GetValidatorKeysResponse struct {
+	// validator_address is the address of the validator
+	ValidatorAddress []byte `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// bls_pubkey is the BLS public key of the validator
+	BlsPubkey *github_com_babylonchain_babylon_crypto_bls12381.PublicKey `protobuf:"bytes,2,opt,name=bls_pubkey,json=blsPubkey,proto3,customtype=github.com/babylonchain/babylon/crypto/bls12381.PublicKey" json:"bls_pubkey,omitempty"`
+	// val_pubkey is the Ed25519 public key of the validator
+	ValPubkey *crypto.PublicKey `protobuf:"bytes,3,opt,name=val_pubkey,json=valPubkey,proto3" json:"val_pubkey,omitempty"`
+}
+
+func (m *GetValidatorKeysResponse) Reset()         { *m = GetValidatorKeysResponse{} }
+func (m *GetValidatorKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*GetValidatorKeysResponse) ProtoMessage()    {}
+func (*GetValidatorKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_22691d4c667f1047, []int{1}
+}
+func (m *GetValidatorKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetValidatorKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetValidatorKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetValidatorKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValidatorKeysResponse.Merge(m, src)
+}
+func (m *GetValidatorKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetValidatorKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetValidatorKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetValidatorKeysResponse proto.InternalMessageInfo
+
+func (m *GetValidatorKeysResponse) GetValidatorAddress() []byte {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *GetValidatorKeysResponse) GetValPubkey() *crypto.PublicKey {
+	if m != nil {
+		return m.ValPubkey
+	}
+	return nil
+}
+
+// SignMsgWithBlsRequest is the request type for the
+// BlsSigner/SignMsgWithBls RPC method.
+type SignMsgWithBlsRequest struct {
+	// msg is the message to be signed
+	Msg []byte `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (m *SignMsgWithBlsRequest) Reset()         { *m = SignMsgWithBlsRequest{} }
+func (m *SignMsgWithBlsRequest) String() string { return proto.CompactTextString(m) }
+func (*SignMsgWithBlsRequest) ProtoMessage()    {}
+func (*SignMsgWithBlsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_22691d4c667f1047, []int{2}
+}
+func (m *SignMsgWithBlsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignMsgWithBlsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignMsgWithBlsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignMsgWithBlsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignMsgWithBlsRequest.Merge(m, src)
+}
+func (m *SignMsgWithBlsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignMsgWithBlsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignMsgWithBlsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignMsgWithBlsRequest proto.InternalMessageInfo
+
+func (m *SignMsgWithBlsRequest) GetMsg() []byte {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+// SignMsgWithBlsResponse is the response type for the
+// BlsSigner/SignMsgWithBls RPC method.
+type SignMsgWithBlsResponse struct {
+	// bls_sig is the BLS signature over the message
+	BlsSig *github_com_babylonchain_babylon_crypto_bls12381.Signature `protobuf:"bytes,1,opt,name=bls_sig,json=blsSig,proto3,customtype=github.com/babylonchain/babylon/crypto/bls12381.Signature" json:"bls_sig,omitempty"`
+}
+
+func (m *SignMsgWithBlsResponse) Reset()         { *m = SignMsgWithBlsResponse{} }
+func (m *SignMsgWithBlsResponse) String() string { return proto.CompactTextString(m) }
+func (*SignMsgWithBlsResponse) ProtoMessage()    {}
+func (*SignMsgWithBlsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_22691d4c667f1047, []int{3}
+}
+func (m *SignMsgWithBlsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignMsgWithBlsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignMsgWithBlsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignMsgWithBlsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignMsgWithBlsResponse.Merge(m, src)
+}
+func (m *SignMsgWithBlsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignMsgWithBlsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignMsgWithBlsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignMsgWithBlsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*GetValidatorKeysRequest)(nil), "babylon.checkpointing.v1.GetValidatorKeysRequest")
+	proto.RegisterType((*GetValidatorKeysResponse)(nil), "babylon.checkpointing.v1.GetValidatorKeysResponse")
+	proto.RegisterType((*SignMsgWithBlsRequest)(nil), "babylon.checkpointing.v1.SignMsgWithBlsRequest")
+	proto.RegisterType((*SignMsgWithBlsResponse)(nil), "babylon.checkpointing.v1.SignMsgWithBlsResponse")
+}
+
+func init() {
+	proto.RegisterFile("babylon/checkpointing/v1/bls_signer.proto", fileDescriptor_22691d4c667f1047)
+}
+
+var fileDescriptor_22691d4c667f1047 = []byte{
+	// 432 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0x16, 0x2a, 0x1d, 0x45, 0xe2, 0xe2, 0x8f, 0x35, 0x94, 0xb5, 0xe4, 0xd4, 0x22,
+	0xcc, 0xb8, 0x29, 0x82, 0x22, 0x1e, 0xcc, 0xc5, 0x43, 0x11, 0x4b, 0x0a, 0x15, 0x44, 0x28, 0x3b,
+	0x9b, 0xc7, 0x64, 0xc8, 0x64, 0x66, 0x9d, 0x37, 0xbb, 0x38, 0xff, 0x85, 0x7f, 0x96, 0xc7, 0x1e,
+	0xc5, 0x83, 0x48, 0xf2, 0x67, 0x78, 0x91, 0xcd, 0x6e, 0xaa, 0x8d, 0x06, 0x7f, 0xdc, 0x1e, 0xfb,
+	0xbe, 0xef, 0xbb, 0xfb, 0xf9, 0xbe, 0xb7, 0xf4, 0x40, 0x64, 0x22, 0x68, 0x6b, 0x78, 0x3e, 0x81,
+	0x7c, 0x5a, 0x58, 0x65, 0xbc, 0x32, 0x92, 0x57, 0x29, 0x17, 0x1a, 0xcf, 0x50, 0x49, 0x03, 0x8e,
+	0x15, 0xce, 0x7a, 0x1b, 0xc5, 0xad, 0x94, 0x5d, 0x92, 0xb2, 0x2a, 0xed, 0xdd, 0x92, 0x56, 0xda,
+	0xa5, 0x88, 0xd7, 0x55, 0xa3, 0xef, 0xed, 0x7a, 0x30, 0x63, 0x70, 0x33, 0x65, 0x3c, 0xcf, 0x5d,
+	0x28, 0xbc, 0xe5, 0x53, 0x08, 0xd8, 0x74, 0xfb, 0xf7, 0xe8, 0xdd, 0x17, 0xe0, 0x4f, 0x33, 0xad,
+	0xc6, 0x99, 0xb7, 0xee, 0x08, 0x02, 0x8e, 0xe0, 0x5d, 0x09, 0xe8, 0xfb, 0x73, 0x42, 0xe3, 0x5f,
+	0x7b, 0x58, 0x58, 0x83, 0x10, 0x3d, 0xa0, 0x37, 0xab, 0x55, 0xe3, 0x2c, 0x1b, 0x8f, 0x1d, 0x20,
+	0xc6, 0x64, 0x8f, 0xec, 0x5f, 0x1f, 0x75, 0x2f, 0x1a, 0xcf, 0x9b, 0xe7, 0xd1, 0x5b, 0x4a, 0x6b,
+	0x8c, 0xa2, 0x14, 0x53, 0x08, 0xf1, 0x95, 0x5a, 0x35, 0x7c, 0xf6, 0xf9, 0xcb, 0xfd, 0x27, 0x52,
+	0xf9, 0x49, 0x29, 0x58, 0x6e, 0x67, 0xbc, 0xa5, 0xca, 0x27, 0x99, 0x32, 0xfc, 0x22, 0x8d, 0xe6,
+	0x7b, 0x85, 0xc6, 0x74, 0x70, 0xf8, 0x38, 0x65, 0xc7, 0xa5, 0xd0, 0x2a, 0x3f, 0x82, 0x30, 0xda,
+	0x11, 0x1a, 0x8f, 0x97, 0x7e, 0xd1, 0x53, 0x4a, 0xab, 0x4c, 0xaf, 0xdc, 0xb7, 0xf6, 0xc8, 0xfe,
+	0xb5, 0xc1, 0x2e, 0xfb, 0x41, 0xcd, 0x1a, 0x97, 0x9f, 0x87, 0xab, 0x4c, 0x37, 0xc3, 0xfd, 0x03,
+	0x7a, 0xfb, 0x44, 0x49, 0xf3, 0x12, 0xe5, 0x6b, 0xe5, 0x27, 0x43, 0xbd, 0xa2, 0x8f, 0xba, 0x74,
+	0x6b, 0x86, 0xb2, 0x45, 0xaa, 0xcb, 0x7e, 0x41, 0xef, 0xac, 0x4b, 0xdb, 0x30, 0x4e, 0xe9, 0xd5,
+	0x76, 0x4d, 0x31, 0xf9, 0x7f, 0xb8, 0xda, 0x3c, 0xf3, 0xa5, 0x83, 0xd1, 0xb6, 0xd0, 0x78, 0xa2,
+	0xe4, 0xe0, 0x1b, 0xa1, 0x3b, 0xc3, 0x65, 0x69, 0xc0, 0x45, 0x81, 0x76, 0xd7, 0xd7, 0x11, 0xa5,
+	0x6c, 0xd3, 0x35, 0xb0, 0x0d, 0x6b, 0xed, 0x0d, 0xfe, 0x65, 0xa4, 0x05, 0x44, 0x7a, 0xe3, 0x32,
+	0x7a, 0xc4, 0x37, 0xbb, 0xfc, 0x36, 0xcf, 0xde, 0xc3, 0xbf, 0x1f, 0x68, 0x5e, 0x3a, 0x7c, 0xf5,
+	0x71, 0x9e, 0x90, 0xf3, 0x79, 0x42, 0xbe, 0xce, 0x13, 0xf2, 0x61, 0x91, 0x74, 0xce, 0x17, 0x49,
+	0xe7, 0xd3, 0x22, 0xe9, 0xbc, 0x79, 0xf4, 0xa7, 0x68, 0xdf, 0xaf, 0xfd, 0x47, 0x3e, 0x14, 0x80,
+	0x62, 0x7b, 0x79, 0xf2, 0x87, 0xdf, 0x07, 0x00, 0xc7, 0x4f, 0x14, 0x9c, 0x6d, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BlsSignerClient is the client API for BlsSigner service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BlsSignerClient interface {
+	// GetValidatorKeys returns the address and public keys of the validator
+	GetValidatorKeys(ctx context.Context, in *GetValidatorKeysRequest, opts ...grpc.CallOption) (*GetValidatorKeysResponse, error)
+	// SignMsgWithBls signs the given message with the BLS key of the validator
+	SignMsgWithBls(ctx context.Context, in *SignMsgWithBlsRequest, opts ...grpc.CallOption) (*SignMsgWithBlsResponse, error)
+}
+
+type blsSignerClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewBlsSignerClient(cc grpc1.ClientConn) BlsSignerClient {
+	return &blsSignerClient{cc}
+}
+
+func (c *blsSignerClient) GetValidatorKeys(ctx context.Context, in *GetValidatorKeysRequest, opts ...grpc.CallOption) (*GetValidatorKeysResponse, error) {
+	out := new(GetValidatorKeysResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.BlsSigner/GetValidatorKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blsSignerClient) SignMsgWithBls(ctx context.Context, in *SignMsgWithBlsRequest, opts ...grpc.CallOption) (*SignMsgWithBlsResponse, error) {
+	out := new(SignMsgWithBlsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.BlsSigner/SignMsgWithBls", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BlsSignerServer is the server API for BlsSigner service.
+type BlsSignerServer interface {
+	// GetValidatorKeys returns the address and public keys of the validator
+	GetValidatorKeys(context.Context, *GetValidatorKeysRequest) (*GetValidatorKeysResponse, error)
+	// SignMsgWithBls signs the given message with the BLS key of the validator
+	SignMsgWithBls(context.Context, *SignMsgWithBlsRequest) (*SignMsgWithBlsResponse, error)
+}
+
+// UnimplementedBlsSignerServer can be embedded to have forward compatible implementations.
+type UnimplementedBlsSignerServer struct {
+}
+
+func (*UnimplementedBlsSignerServer) GetValidatorKeys(ctx context.Context, req *GetValidatorKeysRequest) (*GetValidatorKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidatorKeys not implemented")
+}
+func (*UnimplementedBlsSignerServer) SignMsgWithBls(ctx context.Context, req *SignMsgWithBlsRequest) (*SignMsgWithBlsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignMsgWithBls not implemented")
+}
+
+func RegisterBlsSignerServer(s grpc1.Server, srv BlsSignerServer) {
+	s.RegisterService(&_BlsSigner_serviceDesc, srv)
+}
+
+func _BlsSigner_GetValidatorKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlsSignerServer).GetValidatorKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.BlsSigner/GetValidatorKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlsSignerServer).GetValidatorKeys(ctx, req.(*GetValidatorKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlsSigner_SignMsgWithBls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignMsgWithBlsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlsSignerServer).SignMsgWithBls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.BlsSigner/SignMsgWithBls",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlsSignerServer).SignMsgWithBls(ctx, req.(*SignMsgWithBlsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BlsSigner_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylon.checkpointing.v1.BlsSigner",
+	HandlerType: (*BlsSignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetValidatorKeys",
+			Handler:    _BlsSigner_GetValidatorKeys_Handler,
+		},
+		{
+			MethodName: "SignMsgWithBls",
+			Handler:    _BlsSigner_SignMsgWithBls_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "babylon/checkpointing/v1/bls_signer.proto",
+}
+
+func (m *GetValidatorKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValidatorKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetValidatorKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetValidatorKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValidatorKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetValidatorKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValPubkey != nil {
+		{
+			size, err := m.ValPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBlsSigner(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlsPubkey != nil {
+		{
+			size := m.BlsPubkey.Size()
+			i -= size
+			if _, err := m.BlsPubkey.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBlsSigner(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintBlsSigner(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignMsgWithBlsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignMsgWithBlsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignMsgWithBlsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintBlsSigner(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignMsgWithBlsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignMsgWithBlsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignMsgWithBlsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlsSig != nil {
+		{
+			size := m.BlsSig.Size()
+			i -= size
+			if _, err := m.BlsSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBlsSigner(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBlsSigner(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBlsSigner(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetValidatorKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetValidatorKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovBlsSigner(uint64(l))
+	}
+	if m.BlsPubkey != nil {
+		l = m.BlsPubkey.Size()
+		n += 1 + l + sovBlsSigner(uint64(l))
+	}
+	if m.ValPubkey != nil {
+		l = m.ValPubkey.Size()
+		n += 1 + l + sovBlsSigner(uint64(l))
+	}
+	return n
+}
+
+func (m *SignMsgWithBlsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovBlsSigner(uint64(l))
+	}
+	return n
+}
+
+func (m *SignMsgWithBlsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlsSig != nil {
+		l = m.BlsSig.Size()
+		n += 1 + l + sovBlsSigner(uint64(l))
+	}
+	return n
+}
+
+func sovBlsSigner(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBlsSigner(x uint64) (n int) {
+	return sovBlsSigner(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetValidatorKeysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlsSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetValidatorKeysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetValidatorKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlsSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlsSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetValidatorKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlsSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetValidatorKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetValidatorKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlsSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlsSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlsSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsPubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlsSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlsSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlsSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_crypto_bls12381.PublicKey
+			m.BlsPubkey = &v
+			if err := m.BlsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlsSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlsSigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlsSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValPubkey == nil {
+				m.ValPubkey = &crypto.PublicKey{}
+			}
+			if err := m.ValPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlsSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlsSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignMsgWithBlsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlsSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignMsgWithBlsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignMsgWithBlsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlsSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlsSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlsSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlsSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlsSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignMsgWithBlsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlsSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignMsgWithBlsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignMsgWithBlsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlsSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlsSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlsSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_crypto_bls12381.Signature
+			m.BlsSig = &v
+			if err := m.BlsSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlsSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlsSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBlsSigner(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBlsSigner
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlsSigner
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlsSigner
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBlsSigner
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBlsSigner
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBlsSigner
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBlsSigner        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBlsSigner          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBlsSigner = fmt.Errorf("proto: unexpected end of group")
+)
@@ -328,6 +328,168 @@ func (m *CheckpointStateUpdate) GetBlockTime() *time.Time {
 	return nil
 }
 
+// CheckpointStatusTransition defines a status transition of the checkpoint of
+// an epoch
+type CheckpointStatusTransition struct {
+	// epoch_num defines the epoch of the checkpoint
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// from defines the status of the checkpoint before the transition
+	From CheckpointStatus `protobuf:"varint,2,opt,name=from,proto3,enum=babylon.checkpointing.v1.CheckpointStatus" json:"from,omitempty"`
+	// to defines the status of the checkpoint after the transition
+	To CheckpointStatus `protobuf:"varint,3,opt,name=to,proto3,enum=babylon.checkpointing.v1.CheckpointStatus" json:"to,omitempty"`
+	// block_height is the height of the Babylon block that triggers the
+	// transition
+	BlockHeight uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// block_time is the timestamp in the Babylon block that triggers the
+	// transition
+	BlockTime *time.Time `protobuf:"bytes,5,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time,omitempty"`
+}
+
+func (m *CheckpointStatusTransition) Reset()         { *m = CheckpointStatusTransition{} }
+func (m *CheckpointStatusTransition) String() string { return proto.CompactTextString(m) }
+func (*CheckpointStatusTransition) ProtoMessage()    {}
+func (*CheckpointStatusTransition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73996df9c6aabde4, []int{4}
+}
+func (m *CheckpointStatusTransition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointStatusTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointStatusTransition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckpointStatusTransition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointStatusTransition.Merge(m, src)
+}
+func (m *CheckpointStatusTransition) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointStatusTransition) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointStatusTransition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointStatusTransition proto.InternalMessageInfo
+
+func (m *CheckpointStatusTransition) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+func (m *CheckpointStatusTransition) GetFrom() CheckpointStatus {
+	if m != nil {
+		return m.From
+	}
+	return Accumulating
+}
+
+func (m *CheckpointStatusTransition) GetTo() CheckpointStatus {
+	if m != nil {
+		return m.To
+	}
+	return Accumulating
+}
+
+func (m *CheckpointStatusTransition) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *CheckpointStatusTransition) GetBlockTime() *time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return nil
+}
+
+// ConflictingCheckpointEvidence is the evidence that a checkpoint with a valid
+// BLS multi-sig over a different block hash than the local checkpoint of the
+// same epoch is found on BTC, which indicates a fork of Babylon
+type ConflictingCheckpointEvidence struct {
+	// conflicting_checkpoint is the checkpoint found on BTC
+	ConflictingCheckpoint *RawCheckpoint `protobuf:"bytes,1,opt,name=conflicting_checkpoint,json=conflictingCheckpoint,proto3" json:"conflicting_checkpoint,omitempty"`
+	// local_checkpoint is the local checkpoint of the same epoch
+	LocalCheckpoint *RawCheckpointWithMeta `protobuf:"bytes,2,opt,name=local_checkpoint,json=localCheckpoint,proto3" json:"local_checkpoint,omitempty"`
+	// block_height is the height of the Babylon block in which the conflicting
+	// checkpoint is found
+	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// block_time is the timestamp of the Babylon block in which the conflicting
+	// checkpoint is found
+	BlockTime *time.Time `protobuf:"bytes,4,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time,omitempty"`
+}
+
+func (m *ConflictingCheckpointEvidence) Reset()         { *m = ConflictingCheckpointEvidence{} }
+func (m *ConflictingCheckpointEvidence) String() string { return proto.CompactTextString(m) }
+func (*ConflictingCheckpointEvidence) ProtoMessage()    {}
+func (*ConflictingCheckpointEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73996df9c6aabde4, []int{5}
+}
+func (m *ConflictingCheckpointEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConflictingCheckpointEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConflictingCheckpointEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConflictingCheckpointEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConflictingCheckpointEvidence.Merge(m, src)
+}
+func (m *ConflictingCheckpointEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConflictingCheckpointEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConflictingCheckpointEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConflictingCheckpointEvidence proto.InternalMessageInfo
+
+func (m *ConflictingCheckpointEvidence) GetConflictingCheckpoint() *RawCheckpoint {
+	if m != nil {
+		return m.ConflictingCheckpoint
+	}
+	return nil
+}
+
+func (m *ConflictingCheckpointEvidence) GetLocalCheckpoint() *RawCheckpointWithMeta {
+	if m != nil {
+		return m.LocalCheckpoint
+	}
+	return nil
+}
+
+func (m *ConflictingCheckpointEvidence) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ConflictingCheckpointEvidence) GetBlockTime() *time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return nil
+}
+
 // BlsSig wraps the BLS sig with metadata.
 type BlsSig struct {
 	// epoch_num defines the epoch number that the BLS sig is signed on
@@ -350,7 +512,7 @@ func (m *BlsSig) Reset()         { *m = BlsSig{} }
 func (m *BlsSig) String() string { return proto.CompactTextString(m) }
 func (*BlsSig) ProtoMessage()    {}
 func (*BlsSig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_73996df9c6aabde4, []int{4}
+	return fileDescriptor_73996df9c6aabde4, []int{6}
 }
 func (m *BlsSig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -406,6 +568,8 @@ func init() {
 	proto.RegisterType((*RawCheckpointWithMeta)(nil), "babylon.checkpointing.v1.RawCheckpointWithMeta")
 	proto.RegisterType((*InjectedCheckpoint)(nil), "babylon.checkpointing.v1.InjectedCheckpoint")
 	proto.RegisterType((*CheckpointStateUpdate)(nil), "babylon.checkpointing.v1.CheckpointStateUpdate")
+	proto.RegisterType((*CheckpointStatusTransition)(nil), "babylon.checkpointing.v1.CheckpointStatusTransition")
+	proto.RegisterType((*ConflictingCheckpointEvidence)(nil), "babylon.checkpointing.v1.ConflictingCheckpointEvidence")
 	proto.RegisterType((*BlsSig)(nil), "babylon.checkpointing.v1.BlsSig")
 }
 
@@ -414,61 +578,68 @@ func init() {
 }
 
 var fileDescriptor_73996df9c6aabde4 = []byte{
-	// 853 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
-	0x1c, 0x8d, 0x5b, 0x37, 0x6c, 0x26, 0xcd, 0x2a, 0x8c, 0xb6, 0x28, 0xca, 0x4a, 0x49, 0x28, 0x42,
-	0x94, 0x05, 0xd9, 0x6a, 0x56, 0x48, 0xfc, 0x11, 0x82, 0xc4, 0x4d, 0x21, 0xda, 0xa6, 0x5b, 0xd9,
-	0x09, 0x48, 0x2b, 0x21, 0x6b, 0x6c, 0x4f, 0xec, 0x21, 0xb6, 0xc7, 0xf2, 0x8c, 0xbb, 0x1b, 0xee,
-	0x48, 0xa8, 0xa7, 0xfd, 0x02, 0x95, 0x90, 0xf8, 0x02, 0x7c, 0x07, 0x2e, 0x1c, 0xf7, 0x88, 0x16,
-	0x69, 0x41, 0xed, 0x05, 0xf8, 0x14, 0x68, 0xc6, 0x4e, 0xd3, 0x6c, 0x59, 0xb1, 0xa0, 0xde, 0x26,
-	0xcf, 0xef, 0x4d, 0x66, 0xde, 0xef, 0x3d, 0x0d, 0x78, 0xdb, 0x41, 0xce, 0x3c, 0xa4, 0xb1, 0xee,
-	0x06, 0xd8, 0x9d, 0x25, 0x94, 0xc4, 0x9c, 0xc4, 0xbe, 0x7e, 0xbc, 0x7b, 0x09, 0xd0, 0x92, 0x94,
-	0x72, 0x0a, 0x1b, 0x05, 0x55, 0x5b, 0xa1, 0x6a, 0xc7, 0xbb, 0xcd, 0xb6, 0x4f, 0xa9, 0x1f, 0x62,
-	0x5d, 0xf2, 0x9c, 0x6c, 0xaa, 0x73, 0x12, 0x61, 0xc6, 0x51, 0x94, 0xe4, 0xd2, 0xe6, 0x2d, 0x9f,
-	0xfa, 0x54, 0x2e, 0x75, 0xb1, 0x2a, 0xd0, 0xdb, 0x1c, 0xc7, 0x1e, 0x4e, 0x23, 0x12, 0x73, 0x1d,
-	0x39, 0x2e, 0xd1, 0xf9, 0x3c, 0xc1, 0x2c, 0xff, 0xb8, 0xfd, 0xab, 0x02, 0x6a, 0x26, 0x7a, 0x68,
-	0x5c, 0xfc, 0x17, 0xbc, 0x0d, 0x2a, 0x38, 0xa1, 0x6e, 0x60, 0xc7, 0x59, 0xd4, 0x50, 0x3a, 0xca,
-	0x8e, 0x6a, 0xde, 0x90, 0xc0, 0x61, 0x16, 0xc1, 0x77, 0x01, 0x70, 0x42, 0xea, 0xce, 0xec, 0x00,
-	0xb1, 0xa0, 0xb1, 0xd6, 0x51, 0x76, 0x36, 0xfb, 0xb5, 0xa7, 0xcf, 0xda, 0x95, 0xbe, 0x40, 0x3f,
-	0x47, 0x2c, 0x30, 0x2b, 0xce, 0x62, 0x09, 0x5f, 0x03, 0x65, 0x87, 0xf0, 0x08, 0x25, 0x8d, 0x75,
-	0xc1, 0x34, 0x8b, 0x5f, 0x10, 0x81, 0x9a, 0x13, 0x32, 0x3b, 0xca, 0x42, 0x4e, 0x6c, 0x46, 0xfc,
-	0x86, 0x2a, 0x37, 0xfa, 0xf8, 0xe9, 0xb3, 0xf6, 0x07, 0x3e, 0xe1, 0x41, 0xe6, 0x68, 0x2e, 0x8d,
-	0xf4, 0xc2, 0x08, 0x37, 0x40, 0x24, 0xd6, 0x2f, 0x0c, 0x4c, 0xe7, 0x09, 0xa7, 0xba, 0x13, 0xb2,
-	0xdd, 0xee, 0xdd, 0xf7, 0x77, 0x35, 0x8b, 0xf8, 0x31, 0xe2, 0x59, 0x8a, 0xcd, 0xaa, 0x13, 0xb2,
-	0x91, 0xd8, 0xd2, 0x22, 0xfe, 0x87, 0xea, 0x1f, 0xdf, 0xb7, 0x95, 0xed, 0x3f, 0xd7, 0xc0, 0xd6,
-	0xca, 0xed, 0xbe, 0x24, 0x3c, 0x18, 0x61, 0x8e, 0xe0, 0x47, 0x40, 0x75, 0x67, 0x09, 0x97, 0x17,
-	0xac, 0x76, 0xdf, 0xd2, 0x5e, 0x64, 0xba, 0xb6, 0x22, 0x37, 0xa5, 0x08, 0xf6, 0x41, 0x99, 0x71,
-	0xc4, 0x33, 0x26, 0x1d, 0xb8, 0xd9, 0xbd, 0xf3, 0x62, 0xf9, 0x52, 0x6b, 0x49, 0x85, 0x59, 0x28,
-	0xe1, 0x57, 0x40, 0x9c, 0xd7, 0x46, 0xbe, 0x9f, 0xda, 0xc9, 0x2c, 0x37, 0xe8, 0xff, 0x39, 0x70,
-	0x94, 0x39, 0x21, 0x71, 0xef, 0xe1, 0xb9, 0xb0, 0x9e, 0xf5, 0x7c, 0x3f, 0x3d, 0x9a, 0x89, 0x29,
-	0x26, 0xf4, 0x21, 0x4e, 0x6d, 0x96, 0x45, 0xd2, 0x5e, 0xd5, 0xbc, 0x21, 0x01, 0x2b, 0x8b, 0xe0,
-	0x08, 0x54, 0x42, 0x32, 0xc5, 0xee, 0xdc, 0x0d, 0x71, 0x63, 0xa3, 0xb3, 0xbe, 0x53, 0xed, 0xea,
-	0x2f, 0x7b, 0x05, 0x3c, 0x49, 0x3c, 0xc4, 0xb1, 0xb9, 0xdc, 0xa1, 0xf0, 0xfa, 0x47, 0x05, 0xc0,
-	0x61, 0xfc, 0x35, 0x76, 0x39, 0xf6, 0x2e, 0xc5, 0xc9, 0x58, 0x31, 0x5a, 0x7f, 0x49, 0xa3, 0x17,
-	0x73, 0x2a, 0x0c, 0x9f, 0x80, 0x5b, 0xf8, 0x91, 0x8c, 0xb1, 0x67, 0xbb, 0x34, 0x8a, 0x08, 0xb7,
-	0x49, 0x3c, 0xa5, 0xd2, 0xfe, 0x6a, 0xf7, 0x0d, 0x6d, 0x99, 0x70, 0x4d, 0x24, 0x5c, 0x1b, 0x14,
-	0x64, 0x43, 0x72, 0x87, 0xf1, 0x94, 0x9a, 0x10, 0x5f, 0xc1, 0xb6, 0x7f, 0x52, 0xc0, 0xd6, 0x3f,
-	0xde, 0x0e, 0x7e, 0x0a, 0x36, 0xc4, 0x9c, 0xb0, 0x3c, 0xf6, 0x7f, 0x1b, 0x70, 0x2e, 0x84, 0xaf,
-	0x83, 0xcd, 0xa2, 0x29, 0x98, 0xf8, 0x01, 0x97, 0x47, 0x55, 0x45, 0x46, 0x45, 0x39, 0x24, 0x04,
-	0x3f, 0x59, 0x94, 0x49, 0xf4, 0x58, 0x26, 0xa0, 0xda, 0x6d, 0x6a, 0x79, 0xc9, 0xb5, 0x45, 0xc9,
-	0xb5, 0xf1, 0xa2, 0xe4, 0x7d, 0xf5, 0xf1, 0x6f, 0x6d, 0xa5, 0xe8, 0x97, 0x40, 0x0b, 0xe3, 0xbf,
-	0x5d, 0x03, 0xe5, 0x7e, 0xc8, 0x2c, 0xe2, 0x5f, 0x67, 0x77, 0xbf, 0x00, 0xaf, 0x88, 0x7c, 0x8a,
-	0x76, 0xae, 0x5f, 0x47, 0x3b, 0xcb, 0x4e, 0x7e, 0xc4, 0x37, 0xc1, 0x4d, 0x46, 0xfc, 0x18, 0xa7,
-	0x36, 0xf2, 0xbc, 0x14, 0x33, 0x26, 0xd3, 0x59, 0x31, 0x6b, 0x39, 0xda, 0xcb, 0x41, 0xf8, 0x0e,
-	0x78, 0xf5, 0x18, 0x85, 0xc4, 0x43, 0x9c, 0x2e, 0x99, 0x1b, 0x92, 0x59, 0xbf, 0xf8, 0x50, 0x90,
-	0xa5, 0x0f, 0xa5, 0x3b, 0x7f, 0x29, 0xa0, 0xfe, 0xfc, 0x34, 0xa0, 0x06, 0x1a, 0xc6, 0xbd, 0xa3,
-	0xb1, 0x6d, 0x8d, 0x7b, 0xe3, 0x89, 0x65, 0xf7, 0x0c, 0x63, 0x32, 0x9a, 0x1c, 0xf4, 0xc6, 0xc3,
-	0xc3, 0xcf, 0xea, 0xa5, 0x66, 0xfd, 0xe4, 0xb4, 0xb3, 0xd9, 0x73, 0xdd, 0x2c, 0xca, 0x42, 0x24,
-	0x26, 0x0a, 0xb7, 0x01, 0xbc, 0xcc, 0xb7, 0x06, 0xbd, 0x83, 0xc1, 0x5e, 0x5d, 0x69, 0x82, 0x93,
-	0xd3, 0x4e, 0xd9, 0xc2, 0x28, 0xc4, 0x1e, 0xdc, 0x01, 0x5b, 0x2b, 0x9c, 0x49, 0x7f, 0x34, 0x1c,
-	0x8f, 0x07, 0x7b, 0xf5, 0xb5, 0x66, 0xed, 0xe4, 0xb4, 0x53, 0xb1, 0x32, 0x27, 0x22, 0x9c, 0x5f,
-	0x65, 0x1a, 0xf7, 0x0f, 0xf7, 0x87, 0xe6, 0x68, 0xb0, 0x57, 0x5f, 0xcf, 0x99, 0x06, 0x8d, 0xa7,
-	0x24, 0x8d, 0xae, 0x32, 0xf7, 0x87, 0x87, 0xbd, 0x83, 0xe1, 0x83, 0xc1, 0x5e, 0x5d, 0xcd, 0x99,
-	0xfb, 0x24, 0x46, 0x21, 0xf9, 0x06, 0x7b, 0x4d, 0xf5, 0xbb, 0x1f, 0x5a, 0xa5, 0xfe, 0xfd, 0x9f,
-	0xcf, 0x5a, 0xca, 0x93, 0xb3, 0x96, 0xf2, 0xfb, 0x59, 0x4b, 0x79, 0x7c, 0xde, 0x2a, 0x3d, 0x39,
-	0x6f, 0x95, 0x7e, 0x39, 0x6f, 0x95, 0x1e, 0xbc, 0xf7, 0x6f, 0x33, 0x7a, 0xf4, 0xdc, 0x23, 0x24,
-	0x9f, 0x03, 0xa7, 0x2c, 0x03, 0x77, 0xf7, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x97, 0x52,
-	0x24, 0xaa, 0x06, 0x00, 0x00,
+	// 966 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0xe3, 0xc4,
+	0x1b, 0x8e, 0x13, 0x37, 0xbf, 0xcd, 0xa4, 0xdd, 0x5f, 0x18, 0x6d, 0x57, 0x56, 0x56, 0xa4, 0xa1,
+	0x08, 0x51, 0x16, 0x64, 0xab, 0x59, 0x21, 0xc1, 0x22, 0xfe, 0x24, 0x69, 0x0a, 0xd1, 0x36, 0xdd,
+	0xca, 0x4e, 0x40, 0xaa, 0x04, 0xd6, 0x78, 0x3c, 0x71, 0x86, 0xda, 0x1e, 0xcb, 0x1e, 0x77, 0x37,
+	0xdc, 0x91, 0x50, 0x4f, 0x7b, 0xe5, 0x50, 0x09, 0x89, 0x2f, 0xc0, 0x37, 0xe0, 0xc0, 0x85, 0xe3,
+	0x1e, 0xd1, 0x22, 0x2d, 0xa8, 0xbd, 0x00, 0x9f, 0x02, 0x79, 0xec, 0x34, 0x49, 0xbb, 0x4b, 0x37,
+	0xd5, 0xde, 0x26, 0xaf, 0x9f, 0xe7, 0xf5, 0xfb, 0x3e, 0xef, 0xfb, 0x4c, 0x0c, 0xde, 0xb2, 0x90,
+	0x35, 0x76, 0x99, 0xaf, 0xe1, 0x11, 0xc1, 0x07, 0x01, 0xa3, 0x3e, 0xa7, 0xbe, 0xa3, 0x1d, 0x6e,
+	0xce, 0x04, 0xd4, 0x20, 0x64, 0x9c, 0x41, 0x25, 0x83, 0xaa, 0x73, 0x50, 0xf5, 0x70, 0xb3, 0xba,
+	0xe6, 0x30, 0xe6, 0xb8, 0x44, 0x13, 0x38, 0x2b, 0x1e, 0x6a, 0x9c, 0x7a, 0x24, 0xe2, 0xc8, 0x0b,
+	0x52, 0x6a, 0xf5, 0x86, 0xc3, 0x1c, 0x26, 0x8e, 0x5a, 0x72, 0xca, 0xa2, 0xb7, 0x38, 0xf1, 0x6d,
+	0x12, 0x7a, 0xd4, 0xe7, 0x1a, 0xb2, 0x30, 0xd5, 0xf8, 0x38, 0x20, 0x51, 0xfa, 0x70, 0xfd, 0x77,
+	0x09, 0xac, 0xe8, 0xe8, 0x41, 0xfb, 0xec, 0x5d, 0xf0, 0x16, 0x28, 0x91, 0x80, 0xe1, 0x91, 0xe9,
+	0xc7, 0x9e, 0x22, 0xd5, 0xa5, 0x0d, 0x59, 0xbf, 0x26, 0x02, 0xbb, 0xb1, 0x07, 0xdf, 0x01, 0xc0,
+	0x72, 0x19, 0x3e, 0x30, 0x47, 0x28, 0x1a, 0x29, 0xf9, 0xba, 0xb4, 0xb1, 0xdc, 0x5a, 0x79, 0xf2,
+	0x74, 0xad, 0xd4, 0x4a, 0xa2, 0x9f, 0xa1, 0x68, 0xa4, 0x97, 0xac, 0xc9, 0x11, 0xde, 0x04, 0x45,
+	0x8b, 0x72, 0x0f, 0x05, 0x4a, 0x21, 0x41, 0xea, 0xd9, 0x2f, 0x88, 0xc0, 0x8a, 0xe5, 0x46, 0xa6,
+	0x17, 0xbb, 0x9c, 0x9a, 0x11, 0x75, 0x14, 0x59, 0x24, 0xfa, 0xf0, 0xc9, 0xd3, 0xb5, 0xf7, 0x1d,
+	0xca, 0x47, 0xb1, 0xa5, 0x62, 0xe6, 0x69, 0x99, 0x10, 0x78, 0x84, 0xa8, 0xaf, 0x9d, 0x09, 0x18,
+	0x8e, 0x03, 0xce, 0x34, 0xcb, 0x8d, 0x36, 0x1b, 0x77, 0xde, 0xdb, 0x54, 0x0d, 0xea, 0xf8, 0x88,
+	0xc7, 0x21, 0xd1, 0xcb, 0x96, 0x1b, 0xf5, 0x92, 0x94, 0x06, 0x75, 0xee, 0xca, 0x7f, 0xfd, 0xb0,
+	0x26, 0xad, 0xff, 0x9d, 0x07, 0xab, 0x73, 0xdd, 0x7d, 0x41, 0xf9, 0xa8, 0x47, 0x38, 0x82, 0x1f,
+	0x00, 0x19, 0x1f, 0x04, 0x5c, 0x34, 0x58, 0x6e, 0xbc, 0xa9, 0x3e, 0x4f, 0x74, 0x75, 0x8e, 0xae,
+	0x0b, 0x12, 0x6c, 0x81, 0x62, 0xc4, 0x11, 0x8f, 0x23, 0xa1, 0xc0, 0xf5, 0xc6, 0xed, 0xe7, 0xd3,
+	0xa7, 0x5c, 0x43, 0x30, 0xf4, 0x8c, 0x09, 0xbf, 0x04, 0x49, 0xbd, 0x26, 0x72, 0x9c, 0xd0, 0x0c,
+	0x0e, 0x94, 0xc2, 0xd5, 0x15, 0xd8, 0x8b, 0x2d, 0x97, 0xe2, 0x7b, 0x64, 0x9c, 0x48, 0x1f, 0x35,
+	0x1d, 0x27, 0xdc, 0x3b, 0x48, 0xa6, 0x18, 0xb0, 0x07, 0x24, 0x34, 0xa3, 0xd8, 0x13, 0xf2, 0xca,
+	0xfa, 0x35, 0x11, 0x30, 0x62, 0x0f, 0xf6, 0x40, 0xc9, 0xa5, 0x43, 0x82, 0xc7, 0xd8, 0x25, 0xca,
+	0x52, 0xbd, 0xb0, 0x51, 0x6e, 0x68, 0x2f, 0xda, 0x02, 0x19, 0x04, 0x36, 0xe2, 0x44, 0x9f, 0x66,
+	0xc8, 0xb4, 0xfe, 0x49, 0x02, 0xb0, 0xeb, 0x7f, 0x4d, 0x30, 0x27, 0xf6, 0xcc, 0x3a, 0xb5, 0xe7,
+	0x84, 0xd6, 0x5e, 0x50, 0xe8, 0xc9, 0x9c, 0x32, 0xc1, 0x07, 0xe0, 0x06, 0x79, 0x28, 0xd6, 0xd8,
+	0x36, 0x31, 0xf3, 0x3c, 0xca, 0x4d, 0xea, 0x0f, 0x99, 0x90, 0xbf, 0xdc, 0x78, 0x5d, 0x9d, 0x6e,
+	0xb8, 0x9a, 0x6c, 0xb8, 0xda, 0xc9, 0xc0, 0x6d, 0x81, 0xed, 0xfa, 0x43, 0xa6, 0x43, 0x72, 0x21,
+	0xb6, 0xfe, 0x8b, 0x04, 0x56, 0x9f, 0xd9, 0x1d, 0xfc, 0x04, 0x2c, 0x25, 0x73, 0x22, 0x8a, 0xb4,
+	0xf0, 0x80, 0x53, 0x22, 0x7c, 0x0d, 0x2c, 0x67, 0x4e, 0x21, 0xd4, 0x19, 0x71, 0x51, 0xaa, 0xac,
+	0x97, 0x53, 0x73, 0x88, 0x10, 0xfc, 0x78, 0x62, 0xa6, 0xc4, 0xc7, 0x62, 0x03, 0xca, 0x8d, 0xaa,
+	0x9a, 0x9a, 0x5c, 0x9d, 0x98, 0x5c, 0xed, 0x4f, 0x4c, 0xde, 0x92, 0x1f, 0xfd, 0xb1, 0x26, 0x65,
+	0xfe, 0x4a, 0xa2, 0x99, 0xf0, 0xdf, 0xe7, 0x41, 0xf5, 0x7c, 0x15, 0xfd, 0x10, 0xf9, 0x11, 0xe5,
+	0x94, 0xf9, 0xff, 0xed, 0xe7, 0x8f, 0x80, 0x3c, 0x0c, 0x99, 0x77, 0x85, 0x3d, 0x16, 0x3c, 0x78,
+	0x17, 0xe4, 0x39, 0x53, 0x0a, 0x0b, 0xb3, 0xf3, 0x9c, 0x5d, 0x50, 0x48, 0xbe, 0x4c, 0xa1, 0xa5,
+	0x85, 0x15, 0x5a, 0xff, 0x39, 0x0f, 0x5e, 0x6d, 0x33, 0x7f, 0xe8, 0x52, 0x9c, 0xd4, 0x32, 0xad,
+	0xa3, 0x73, 0x48, 0x6d, 0xe2, 0x63, 0x02, 0xbf, 0x02, 0x37, 0xf1, 0x14, 0x60, 0x4e, 0x4b, 0x5f,
+	0xf4, 0x6a, 0x58, 0xc5, 0xcf, 0x7a, 0x0f, 0xdc, 0x07, 0x15, 0x97, 0x61, 0xe4, 0xce, 0x66, 0xce,
+	0x5f, 0xcd, 0x0b, 0xff, 0x17, 0x89, 0x66, 0x72, 0x9f, 0x57, 0xb0, 0x70, 0x99, 0x82, 0xf2, 0xe2,
+	0x0a, 0x7e, 0x9b, 0x07, 0xc5, 0x96, 0x1b, 0x19, 0xd4, 0x79, 0x99, 0xff, 0x0c, 0x9f, 0x83, 0xff,
+	0x25, 0xb7, 0x5f, 0x72, 0xf7, 0x17, 0x5e, 0xc6, 0xdd, 0x5f, 0xb4, 0xd2, 0x12, 0xdf, 0x00, 0xd7,
+	0x23, 0xea, 0xf8, 0x24, 0x34, 0x91, 0x6d, 0x87, 0x24, 0x8a, 0x44, 0xcb, 0x25, 0x7d, 0x25, 0x8d,
+	0x36, 0xd3, 0x20, 0x7c, 0x1b, 0xbc, 0x72, 0x88, 0x5c, 0x6a, 0x23, 0xce, 0xa6, 0xc8, 0x25, 0x81,
+	0xac, 0x9c, 0x3d, 0xc8, 0xc0, 0xc2, 0x65, 0xb9, 0xdb, 0xff, 0x48, 0xa0, 0x72, 0x7e, 0x8d, 0xa1,
+	0x0a, 0x94, 0xf6, 0xbd, 0xbd, 0xbe, 0x69, 0xf4, 0x9b, 0xfd, 0x81, 0x61, 0x36, 0xdb, 0xed, 0x41,
+	0x6f, 0xb0, 0xd3, 0xec, 0x77, 0x77, 0x3f, 0xad, 0xe4, 0xaa, 0x95, 0xa3, 0xe3, 0xfa, 0x72, 0x13,
+	0xe3, 0xd8, 0x8b, 0x5d, 0x94, 0x8c, 0x16, 0xae, 0x03, 0x38, 0x8b, 0x37, 0x3a, 0xcd, 0x9d, 0xce,
+	0x56, 0x45, 0xaa, 0x82, 0xa3, 0xe3, 0x7a, 0xd1, 0x20, 0xc8, 0x25, 0x36, 0xdc, 0x00, 0xab, 0x73,
+	0x98, 0x41, 0xab, 0xd7, 0xed, 0xf7, 0x3b, 0x5b, 0x95, 0x7c, 0x75, 0xe5, 0xe8, 0xb8, 0x5e, 0x32,
+	0x62, 0xcb, 0xa3, 0x9c, 0x5f, 0x44, 0xb6, 0xef, 0xef, 0x6e, 0x77, 0xf5, 0x5e, 0x67, 0xab, 0x52,
+	0x48, 0x91, 0xc9, 0xe2, 0xd3, 0xd0, 0xbb, 0x88, 0xdc, 0xee, 0xee, 0x36, 0x77, 0xba, 0xfb, 0x9d,
+	0xad, 0x8a, 0x9c, 0x22, 0xb7, 0xa9, 0x8f, 0x5c, 0xfa, 0x0d, 0xb1, 0xab, 0xf2, 0x77, 0x3f, 0xd6,
+	0x72, 0xad, 0xfb, 0xbf, 0x9e, 0xd4, 0xa4, 0xc7, 0x27, 0x35, 0xe9, 0xcf, 0x93, 0x9a, 0xf4, 0xe8,
+	0xb4, 0x96, 0x7b, 0x7c, 0x5a, 0xcb, 0xfd, 0x76, 0x5a, 0xcb, 0xed, 0xbf, 0x7b, 0xd9, 0x8c, 0x1e,
+	0x9e, 0xfb, 0xc4, 0x11, 0x1f, 0x1b, 0x56, 0x51, 0xac, 0xda, 0x9d, 0x7f, 0x07, 0x00, 0xce, 0x33,
+	0xce, 0x4a, 0x08, 0x09, 0x00, 0x00,
 }
 
 func (this *RawCheckpoint) Equal(that interface{}) bool {
@@ -811,6 +982,121 @@ func (m *CheckpointStateUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CheckpointStatusTransition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointStatusTransition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointStatusTransition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTime != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.BlockTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintCheckpoint(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintCheckpoint(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.To != 0 {
+		i = encodeVarintCheckpoint(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.From != 0 {
+		i = encodeVarintCheckpoint(dAtA, i, uint64(m.From))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintCheckpoint(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConflictingCheckpointEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConflictingCheckpointEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConflictingCheckpointEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTime != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.BlockTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintCheckpoint(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintCheckpoint(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LocalCheckpoint != nil {
+		{
+			size, err := m.LocalCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCheckpoint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ConflictingCheckpoint != nil {
+		{
+			size, err := m.ConflictingCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCheckpoint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BlsSig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -977,6 +1263,55 @@ func (m *CheckpointStateUpdate) Size() (n int) {
 	return n
 }
 
+func (m *CheckpointStatusTransition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovCheckpoint(uint64(m.EpochNum))
+	}
+	if m.From != 0 {
+		n += 1 + sovCheckpoint(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovCheckpoint(uint64(m.To))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovCheckpoint(uint64(m.BlockHeight))
+	}
+	if m.BlockTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.BlockTime)
+		n += 1 + l + sovCheckpoint(uint64(l))
+	}
+	return n
+}
+
+func (m *ConflictingCheckpointEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConflictingCheckpoint != nil {
+		l = m.ConflictingCheckpoint.Size()
+		n += 1 + l + sovCheckpoint(uint64(l))
+	}
+	if m.LocalCheckpoint != nil {
+		l = m.LocalCheckpoint.Size()
+		n += 1 + l + sovCheckpoint(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovCheckpoint(uint64(m.BlockHeight))
+	}
+	if m.BlockTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.BlockTime)
+		n += 1 + l + sovCheckpoint(uint64(l))
+	}
+	return n
+}
+
 func (m *BlsSig) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1623,6 +1958,345 @@ func (m *CheckpointStateUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CheckpointStatusTransition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCheckpoint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointStatusTransition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointStatusTransition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= CheckpointStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= CheckpointStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockTime == nil {
+				m.BlockTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCheckpoint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConflictingCheckpointEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCheckpoint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConflictingCheckpointEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConflictingCheckpointEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConflictingCheckpoint == nil {
+				m.ConflictingCheckpoint = &RawCheckpoint{}
+			}
+			if err := m.ConflictingCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LocalCheckpoint == nil {
+				m.LocalCheckpoint = &RawCheckpointWithMeta{}
+			}
+			if err := m.LocalCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockTime == nil {
+				m.BlockTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCheckpoint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCheckpoint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BlsSig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
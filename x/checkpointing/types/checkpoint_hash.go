@@ -0,0 +1,77 @@
+package types
+
+import (
+	"context"
+	"fmt"
+)
+
+// CheckpointHashProvider provides the hash that the checkpoint of an epoch
+// commits to. At the last block of an epoch, each validator signs
+// GetSignBytes(epochNum, hash) with its BLS key in its vote extension, where
+// hash is returned by the provider, and the BLS sigs are later aggregated into
+// the BLS multi-sig of the raw checkpoint built by BuildRawCheckpoint.
+//
+// A provider must satisfy the following invariants:
+//   - Deterministic: given the same block, it returns the same hash on every
+//     honest node, otherwise the BLS sigs over the hash cannot be aggregated
+//     and the vote extensions of other validators are rejected
+//   - Pre-execution: it only depends on the height and CometBFT hash of the
+//     block and on state committed before the block, since it is invoked by
+//     both ExtendVote and VerifyVoteExtension before the block is executed
+//   - Fixed size: the returned hash is HashSize bytes long, which is required
+//     by the BlockHash of a raw checkpoint and by the checkpoint format on BTC
+//   - Chain-wide: all validators of the chain use the same provider, so it
+//     must be chosen when wiring the app rather than via node config
+//   - Consistent with verifiers: parties that verify a checkpoint against a
+//     header must compare the hash with the same source. For example, the
+//     BTC timestamps of zoneconcierge compare the hash in the checkpoint with
+//     the sealer block hash of the epoch, and thus assume BlockHashProvider
+type CheckpointHashProvider interface {
+	// CheckpointHash returns the hash to be checkpointed for the block at the
+	// given height with the given CometBFT hash
+	CheckpointHash(ctx context.Context, height int64, blockHash []byte) (BlockHash, error)
+}
+
+// BlockHashProvider is the default CheckpointHashProvider, under which the
+// checkpoint of an epoch commits to the CometBFT hash of the epoch's last block
+type BlockHashProvider struct{}
+
+func NewBlockHashProvider() *BlockHashProvider {
+	return &BlockHashProvider{}
+}
+
+// CheckpointHash returns the given CometBFT block hash
+func (p *BlockHashProvider) CheckpointHash(_ context.Context, _ int64, blockHash []byte) (BlockHash, error) {
+	return newCheckpointHash(blockHash)
+}
+
+// AppHashProvider is a CheckpointHashProvider under which the checkpoint of an
+// epoch commits to the app hash in the header of the epoch's last block, i.e.,
+// the state root committed by its previous block. The app hash is the one
+// committed by the node, which is the same across honest nodes at the time
+// the last block of the epoch is being voted on.
+type AppHashProvider struct {
+	lastCommitHash func() []byte
+}
+
+// NewAppHashProvider creates an AppHashProvider. The given function returns
+// the hash of the last committed state, e.g., BaseApp.LastCommitID().Hash
+func NewAppHashProvider(lastCommitHash func() []byte) *AppHashProvider {
+	return &AppHashProvider{lastCommitHash: lastCommitHash}
+}
+
+// CheckpointHash returns the app hash committed before the given block
+func (p *AppHashProvider) CheckpointHash(_ context.Context, _ int64, _ []byte) (BlockHash, error) {
+	return newCheckpointHash(p.lastCommitHash())
+}
+
+// newCheckpointHash copies the given hash into a BlockHash, ensuring it is of
+// the size required by a raw checkpoint
+func newCheckpointHash(hash []byte) (BlockHash, error) {
+	if len(hash) != HashSize {
+		return nil, fmt.Errorf("invalid checkpoint hash length, expected: %d, got: %d", HashSize, len(hash))
+	}
+	bh := make(BlockHash, HashSize)
+	copy(bh, hash)
+	return bh, nil
+}
@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/babylonchain/babylon/x/checkpointing/types"
+)
+
+func FuzzCheckpointHashProviders(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctx := context.Background()
+		height := int64(datagen.RandomInt(r, 1000) + 1)
+		epochNum := datagen.RandomInt(r, 100) + 1
+		blockHash := datagen.GenRandomByteArray(r, types.HashSize)
+		appHash := datagen.GenRandomByteArray(r, types.HashSize)
+
+		// the block hash provider checkpoints the CometBFT block hash
+		blockHashProvider := types.NewBlockHashProvider()
+		bh, err := blockHashProvider.CheckpointHash(ctx, height, blockHash)
+		require.NoError(t, err)
+		require.Equal(t, types.BlockHash(blockHash), bh)
+
+		// the app hash provider checkpoints the last committed app hash
+		// regardless of the block hash
+		appHashProvider := types.NewAppHashProvider(func() []byte { return appHash })
+		ah, err := appHashProvider.CheckpointHash(ctx, height, blockHash)
+		require.NoError(t, err)
+		require.Equal(t, types.BlockHash(appHash), ah)
+
+		// the returned hashes are copies, so the BLS sign bytes stay the same
+		// even if the source is modified afterwards
+		signBytes := types.GetSignBytes(epochNum, bh)
+		blockHash[0] ^= 0xff
+		require.Equal(t, signBytes, types.GetSignBytes(epochNum, bh))
+		signBytes = types.GetSignBytes(epochNum, ah)
+		appHash[0] ^= 0xff
+		require.Equal(t, signBytes, types.GetSignBytes(epochNum, ah))
+
+		// hashes of invalid length are rejected
+		invalidHash := datagen.GenRandomByteArray(r, datagen.RandomIntOtherThan(r, types.HashSize, 100))
+		_, err = blockHashProvider.CheckpointHash(ctx, height, invalidHash)
+		require.Error(t, err)
+		_, err = types.NewAppHashProvider(func() []byte { return invalidHash }).CheckpointHash(ctx, height, blockHash)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/gogoproto/jsonpb"
+)
+
+const (
+	// PanicOnConflictingCheckpoint panics upon a conflicting checkpoint, which
+	// is the default behavior
+	PanicOnConflictingCheckpoint = "panic"
+	// HaltOnConflictingCheckpoint shuts the node down gracefully upon a
+	// conflicting checkpoint
+	HaltOnConflictingCheckpoint = "halt"
+)
+
+// ConflictingCheckpointHandler handles a conflicting checkpoint found on BTC,
+// which indicates a fork of Babylon. It is invoked once the block carrying the
+// conflicting checkpoint has been committed, after the evidence has been
+// persisted, and may terminate the process. The BTC checkpoint is rejected with
+// ErrConflictingCheckpoint regardless of the handler
+type ConflictingCheckpointHandler func(ctx context.Context, evidence *ConflictingCheckpointEvidence)
+
+// NewConflictingCheckpointHandler returns the ConflictingCheckpointHandler of
+// the given behavior, i.e., one of {panic, halt}. An empty behavior falls
+// back to panic
+func NewConflictingCheckpointHandler(behavior string) (ConflictingCheckpointHandler, error) {
+	switch behavior {
+	case "", PanicOnConflictingCheckpoint:
+		return PanicConflictingCheckpointHandler, nil
+	case HaltOnConflictingCheckpoint:
+		return HaltConflictingCheckpointHandler, nil
+	default:
+		return nil, fmt.Errorf("invalid behavior upon conflicting checkpoint %q, expected one of [%s, %s]",
+			behavior, PanicOnConflictingCheckpoint, HaltOnConflictingCheckpoint)
+	}
+}
+
+// PanicConflictingCheckpointHandler panics with ErrConflictingCheckpoint
+func PanicConflictingCheckpointHandler(_ context.Context, evidence *ConflictingCheckpointEvidence) {
+	panic(ErrConflictingCheckpoint.Wrapf("epoch %d", evidence.LocalCheckpoint.Ckpt.EpochNum))
+}
+
+// HaltConflictingCheckpointHandler sends SIGTERM to the node's own process, so
+// that the node stops via its graceful shutdown path, i.e., the same one as
+// upon Ctrl-C, rather than a raw panic. If the signal cannot be sent, it
+// panics instead
+func HaltConflictingCheckpointHandler(ctx context.Context, evidence *ConflictingCheckpointEvidence) {
+	p, err := os.FindProcess(os.Getpid())
+	if err == nil {
+		err = p.Signal(syscall.SIGTERM)
+	}
+	if err != nil {
+		PanicConflictingCheckpointHandler(ctx, evidence)
+	}
+}
+
+// ConflictingCheckpointEvidenceFile returns the path of the file under the
+// given directory to which the conflicting checkpoint evidence of the given
+// epoch is persisted
+func ConflictingCheckpointEvidenceFile(dir string, epoch uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("conflicting_checkpoint_%d.json", epoch))
+}
+
+// WriteConflictingCheckpointEvidence persists the given evidence as a JSON
+// file under the given directory. The file is written atomically and synced
+// to disk, so that it survives the termination of the process right after
+func WriteConflictingCheckpointEvidence(dir string, evidence *ConflictingCheckpointEvidence) error {
+	evidenceBytes, err := codec.ProtoMarshalJSON(evidence, nil)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	tmpFile, err := os.CreateTemp(dir, "conflicting_checkpoint_*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name()) //nolint:errcheck // no-op once renamed
+	if _, err := tmpFile.Write(evidenceBytes); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Sync(); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpFile.Name(), ConflictingCheckpointEvidenceFile(dir, evidence.LocalCheckpoint.Ckpt.EpochNum)); err != nil {
+		return err
+	}
+	// sync the directory so that the rename is durable as well
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+// ReadConflictingCheckpointEvidence reads the conflicting checkpoint evidence
+// of the given epoch persisted under the given directory
+func ReadConflictingCheckpointEvidence(dir string, epoch uint64) (*ConflictingCheckpointEvidence, error) {
+	evidenceBytes, err := os.ReadFile(ConflictingCheckpointEvidenceFile(dir, epoch))
+	if err != nil {
+		return nil, err
+	}
+	var evidence ConflictingCheckpointEvidence
+	if err := jsonpb.Unmarshal(bytes.NewReader(evidenceBytes), &evidence); err != nil {
+		return nil, err
+	}
+	return &evidence, nil
+}
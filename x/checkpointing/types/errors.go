@@ -19,4 +19,6 @@ var (
 	ErrConflictingCheckpoint   = errorsmod.Register(ModuleName, 1213, "Conflicting checkpoint is found")
 	ErrInvalidAppHash          = errorsmod.Register(ModuleName, 1214, "Provided app hash is Invalid")
 	ErrInsufficientVotingPower = errorsmod.Register(ModuleName, 1215, "Accumulated voting power is not greater than 2/3 of total power")
+	ErrNoCkptStatusTransition  = errorsmod.Register(ModuleName, 1216, "no checkpoint status transition has occurred")
+	ErrBlsSigNotRetained       = errorsmod.Register(ModuleName, 1217, "BLS sig of the validator is not retained")
 )
@@ -33,6 +33,11 @@ var (
 	BlsKeyToAddrPrefix = append(RegistrationPrefix, 0x1) // where we save BLS key set
 
 	LastFinalizedEpochKey = []byte{0x04} // LastFinalizedEpochKey defines the key to store the last finalised epoch
+	ParamsKey             = []byte{0x05} // ParamsKey defines the key to store the module params
+
+	LatestCkptStatusTransitionKey = []byte{0x06} // LatestCkptStatusTransitionKey defines the key to store the latest checkpoint status transition
+
+	ValidatorBlsSigPrefix = []byte{0x07} // reserve this namespace for the individual BLS sigs aggregated into sealed checkpoints
 )
 
 // CkptsObjectKey defines epoch
@@ -45,6 +50,11 @@ func ValidatorBlsKeySetKey(epoch uint64) []byte {
 	return sdk.Uint64ToBigEndian(epoch)
 }
 
+// ValidatorBlsSigKey defines epoch || validator address
+func ValidatorBlsSigKey(epoch uint64, valAddr sdk.ValAddress) []byte {
+	return append(sdk.Uint64ToBigEndian(epoch), valAddr...)
+}
+
 // AddrToBlsKeyKey defines validator address
 func AddrToBlsKeyKey(valAddr sdk.ValAddress) []byte {
 	return valAddr
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// DefaultSealingThreshold is the default portion of the total voting power
+// required to seal a raw checkpoint. The division rounds 2/3 up to
+// 0.666666666666666667 so that strictly more than 2/3 of the total voting
+// power is required.
+var DefaultSealingThreshold = sdkmath.LegacyNewDec(2).Quo(sdkmath.LegacyNewDec(3))
+
+// NewParams creates a new Params instance
+func NewParams(sealingThreshold sdkmath.LegacyDec, retainValidatorBlsSigs bool) Params {
+	return Params{
+		SealingThreshold:       sealingThreshold,
+		RetainValidatorBlsSigs: retainValidatorBlsSigs,
+	}
+}
+
+// DefaultParams returns a default set of parameters. Individual BLS sigs are
+// not retained by default as they are only needed for auditing.
+func DefaultParams() Params {
+	return NewParams(DefaultSealingThreshold, false)
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	return validateSealingThreshold(p.SealingThreshold)
+}
+
+func validateSealingThreshold(threshold sdkmath.LegacyDec) error {
+	if threshold.IsNil() {
+		return fmt.Errorf("sealing threshold cannot be nil")
+	}
+	// the sealing threshold has to be greater than 1/2 so that at most one
+	// checkpoint can be sealed for each epoch
+	if threshold.LTE(sdkmath.LegacyNewDecWithPrec(5, 1)) {
+		return fmt.Errorf("sealing threshold must be greater than 1/2, got %s", threshold.String())
+	}
+	if threshold.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("sealing threshold must not be greater than 1, got %s", threshold.String())
+	}
+	return nil
+}
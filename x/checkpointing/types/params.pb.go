@@ -0,0 +1,399 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: babylon/checkpointing/v1/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the module.
+type Params struct {
+	// sealing_threshold is the minimum portion of the epoch's total voting
+	// power that a raw checkpoint needs to accumulate in order to be sealed.
+	// It has to be within (1/2, 1].
+	SealingThreshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=sealing_threshold,json=sealingThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"sealing_threshold"`
+	// retain_validator_bls_sigs indicates whether the individual BLS sigs that
+	// are aggregated into a sealed checkpoint are retained, so that the BLS sig
+	// of each signer can be queried after the checkpoint is sealed
+	RetainValidatorBlsSigs bool `protobuf:"varint,2,opt,name=retain_validator_bls_sigs,json=retainValidatorBlsSigs,proto3" json:"retain_validator_bls_sigs,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e909869559c0a3ee, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetRetainValidatorBlsSigs() bool {
+	if m != nil {
+		return m.RetainValidatorBlsSigs
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "babylon.checkpointing.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("babylon/checkpointing/v1/params.proto", fileDescriptor_e909869559c0a3ee)
+}
+
+var fileDescriptor_e909869559c0a3ee = []byte{
+	// 301 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xb1, 0x4a, 0x33, 0x41,
+	0x14, 0x85, 0x77, 0x7e, 0x7e, 0x82, 0x6e, 0xa5, 0x41, 0x24, 0x89, 0xb0, 0x09, 0x82, 0x90, 0xc6,
+	0x19, 0x82, 0x58, 0x68, 0x19, 0x52, 0x0a, 0x4a, 0x14, 0x0b, 0x0b, 0x97, 0xd9, 0xc9, 0x30, 0x33,
+	0x64, 0x76, 0xee, 0xb2, 0x77, 0x0c, 0xe6, 0x2d, 0x7c, 0x04, 0x5b, 0x7b, 0x1f, 0x22, 0x65, 0xb0,
+	0x12, 0x8b, 0x20, 0x49, 0xe3, 0x63, 0x88, 0x99, 0x4d, 0xa1, 0xdd, 0x3d, 0x9c, 0xef, 0x7e, 0xc5,
+	0x89, 0x8f, 0x32, 0x9e, 0x4d, 0x2d, 0x38, 0x26, 0xb4, 0x14, 0xe3, 0x02, 0x8c, 0xf3, 0xc6, 0x29,
+	0x36, 0xe9, 0xb1, 0x82, 0x97, 0x3c, 0x47, 0x5a, 0x94, 0xe0, 0xa1, 0xde, 0xa8, 0x30, 0xfa, 0x0b,
+	0xa3, 0x93, 0x5e, 0x6b, 0x4f, 0x81, 0x82, 0x35, 0xc4, 0x7e, 0xae, 0xc0, 0xb7, 0x9a, 0x02, 0x30,
+	0x07, 0x4c, 0x43, 0x11, 0x42, 0xa8, 0x0e, 0x5f, 0x48, 0x5c, 0xbb, 0x5a, 0xbb, 0xeb, 0xf7, 0xf1,
+	0x2e, 0x4a, 0x6e, 0x8d, 0x53, 0xa9, 0xd7, 0xa5, 0x44, 0x0d, 0x76, 0xd4, 0x20, 0x1d, 0xd2, 0xdd,
+	0xee, 0xf7, 0x66, 0x8b, 0x76, 0xf4, 0xb1, 0x68, 0x1f, 0x84, 0x5f, 0x1c, 0x8d, 0xa9, 0x01, 0x96,
+	0x73, 0xaf, 0xe9, 0x85, 0x54, 0x5c, 0x4c, 0x07, 0x52, 0xbc, 0xbd, 0x1e, 0xc7, 0x95, 0x7a, 0x20,
+	0xc5, 0x70, 0xa7, 0x72, 0xdd, 0x6c, 0x54, 0xf5, 0xb3, 0xb8, 0x59, 0x4a, 0xcf, 0x8d, 0x4b, 0x27,
+	0xdc, 0x9a, 0x11, 0xf7, 0x50, 0xa6, 0x99, 0xc5, 0x14, 0x8d, 0xc2, 0xc6, 0xbf, 0x0e, 0xe9, 0x6e,
+	0x0d, 0xf7, 0x03, 0x70, 0xbb, 0xe9, 0xfb, 0x16, 0xaf, 0x8d, 0xc2, 0xf3, 0xff, 0x5f, 0xcf, 0x6d,
+	0xd2, 0xbf, 0x9c, 0x2d, 0x13, 0x32, 0x5f, 0x26, 0xe4, 0x73, 0x99, 0x90, 0xa7, 0x55, 0x12, 0xcd,
+	0x57, 0x49, 0xf4, 0xbe, 0x4a, 0xa2, 0xbb, 0x53, 0x65, 0xbc, 0x7e, 0xc8, 0xa8, 0x80, 0x9c, 0x55,
+	0xdb, 0x08, 0xcd, 0x8d, 0xdb, 0x04, 0xf6, 0xf8, 0x67, 0x51, 0x3f, 0x2d, 0x24, 0x66, 0xb5, 0xf5,
+	0x06, 0x27, 0xdf, 0x03, 0x00, 0x9a, 0x58, 0x1a, 0x42, 0x77, 0x01, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.SealingThreshold.Equal(that1.SealingThreshold) {
+		return false
+	}
+	if this.RetainValidatorBlsSigs != that1.RetainValidatorBlsSigs {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetainValidatorBlsSigs {
+		i--
+		if m.RetainValidatorBlsSigs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.SealingThreshold.Size()
+		i -= size
+		if _, err := m.SealingThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SealingThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.RetainValidatorBlsSigs {
+		n += 2
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealingThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SealingThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetainValidatorBlsSigs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RetainValidatorBlsSigs = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
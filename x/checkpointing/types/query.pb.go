@@ -5,8 +5,10 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_babylonchain_babylon_crypto_bls12381 "github.com/babylonchain/babylon/crypto/bls12381"
+	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -35,6 +37,89 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 // QueryRawCheckpointListRequest is the request type for the
 // Query/RawCheckpoints RPC method.
 type QueryRawCheckpointListRequest struct {
@@ -48,7 +133,7 @@ func (m *QueryRawCheckpointListRequest) Reset()         { *m = QueryRawCheckpoin
 func (m *QueryRawCheckpointListRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRawCheckpointListRequest) ProtoMessage()    {}
 func (*QueryRawCheckpointListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_113f1ca5c3c2ca44, []int{0}
+	return fileDescriptor_113f1ca5c3c2ca44, []int{2}
 }
 func (m *QueryRawCheckpointListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -104,7 +189,7 @@ func (m *QueryRawCheckpointListResponse) Reset()         { *m = QueryRawCheckpoi
 func (m *QueryRawCheckpointListResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRawCheckpointListResponse) ProtoMessage()    {}
 func (*QueryRawCheckpointListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_113f1ca5c3c2ca44, []int{1}
+	return fileDescriptor_113f1ca5c3c2ca44, []int{3}
 }
 func (m *QueryRawCheckpointListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -158,7 +243,7 @@ func (m *QueryRawCheckpointRequest) Reset()         { *m = QueryRawCheckpointReq
 func (m *QueryRawCheckpointRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRawCheckpointRequest) ProtoMessage()    {}
 func (*QueryRawCheckpointRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_113f1ca5c3c2ca44, []int{2}
+	return fileDescriptor_113f1ca5c3c2ca44, []int{4}
 }
 func (m *QueryRawCheckpointRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -204,7 +289,7 @@ func (m *QueryRawCheckpointResponse) Reset()         { *m = QueryRawCheckpointRe
 func (m *QueryRawCheckpointResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRawCheckpointResponse) ProtoMessage()    {}
 func (*QueryRawCheckpointResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_113f1ca5c3c2ca44, []int{3}
+	return fileDescriptor_113f1ca5c3c2ca44, []int{5}
 }
 func (m *QueryRawCheckpointResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -251,7 +336,7 @@ func (m *QueryRawCheckpointsRequest) Reset()         { *m = QueryRawCheckpointsR
 func (m *QueryRawCheckpointsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRawCheckpointsRequest) ProtoMessage()    {}
 func (*QueryRawCheckpointsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_113f1ca5c3c2ca44, []int{4}
+	return fileDescriptor_113f1ca5c3c2ca44, []int{6}
 }
 func (m *QueryRawCheckpointsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -300,7 +385,7 @@ func (m *QueryRawCheckpointsResponse) Reset()         { *m = QueryRawCheckpoints
 func (m *QueryRawCheckpointsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRawCheckpointsResponse) ProtoMessage()    {}
 func (*QueryRawCheckpointsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_113f1ca5c3c2ca44, []int{5}
+	return fileDescriptor_113f1ca5c3c2ca44, []int{7}
 }
 func (m *QueryRawCheckpointsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -356,7 +441,7 @@ func (m *QueryBlsPublicKeyListRequest) Reset()         { *m = QueryBlsPublicKeyL
 func (m *QueryBlsPublicKeyListRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBlsPublicKeyListRequest) ProtoMessage()    {}
 func (*QueryBlsPublicKeyListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_113f1ca5c3c2ca44, []int{6}
+	return fileDescriptor_113f1ca5c3c2ca44, []int{8}
 }
 func (m *QueryBlsPublicKeyListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -411,7 +496,7 @@ func (m *QueryBlsPublicKeyListResponse) Reset()         { *m = QueryBlsPublicKey
 func (m *QueryBlsPublicKeyListResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBlsPublicKeyListResponse) ProtoMessage()    {}
 func (*QueryBlsPublicKeyListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_113f1ca5c3c2ca44, []int{7}
+	return fileDescriptor_113f1ca5c3c2ca44, []int{9}
 }
 func (m *QueryBlsPublicKeyListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -454,24 +539,27 @@ func (m *QueryBlsPublicKeyListResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-// QueryEpochStatusRequest is the request type for the Query/EpochStatus
-// RPC method.
-type QueryEpochStatusRequest struct {
-	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+// QueryBlsPublicKeyAtEpochRequest is the request type for the
+// Query/BlsPublicKeyAtEpoch RPC method.
+type QueryBlsPublicKeyAtEpochRequest struct {
+	// val_address defines the address of the validator
+	ValAddress string `protobuf:"bytes,1,opt,name=val_address,json=valAddress,proto3" json:"val_address,omitempty"`
+	// epoch_num defines the epoch for the queried bls public key
+	EpochNum uint64 `protobuf:"varint,2,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
 }
 
-func (m *QueryEpochStatusRequest) Reset()         { *m = QueryEpochStatusRequest{} }
-func (m *QueryEpochStatusRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryEpochStatusRequest) ProtoMessage()    {}
-func (*QueryEpochStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_113f1ca5c3c2ca44, []int{8}
+func (m *QueryBlsPublicKeyAtEpochRequest) Reset()         { *m = QueryBlsPublicKeyAtEpochRequest{} }
+func (m *QueryBlsPublicKeyAtEpochRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlsPublicKeyAtEpochRequest) ProtoMessage()    {}
+func (*QueryBlsPublicKeyAtEpochRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{10}
 }
-func (m *QueryEpochStatusRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryBlsPublicKeyAtEpochRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEpochStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBlsPublicKeyAtEpochRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEpochStatusRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBlsPublicKeyAtEpochRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -481,43 +569,52 @@ func (m *QueryEpochStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryEpochStatusRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEpochStatusRequest.Merge(m, src)
+func (m *QueryBlsPublicKeyAtEpochRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlsPublicKeyAtEpochRequest.Merge(m, src)
 }
-func (m *QueryEpochStatusRequest) XXX_Size() int {
+func (m *QueryBlsPublicKeyAtEpochRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEpochStatusRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEpochStatusRequest.DiscardUnknown(m)
+func (m *QueryBlsPublicKeyAtEpochRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlsPublicKeyAtEpochRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEpochStatusRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryBlsPublicKeyAtEpochRequest proto.InternalMessageInfo
 
-func (m *QueryEpochStatusRequest) GetEpochNum() uint64 {
+func (m *QueryBlsPublicKeyAtEpochRequest) GetValAddress() string {
+	if m != nil {
+		return m.ValAddress
+	}
+	return ""
+}
+
+func (m *QueryBlsPublicKeyAtEpochRequest) GetEpochNum() uint64 {
 	if m != nil {
 		return m.EpochNum
 	}
 	return 0
 }
 
-// QueryEpochStatusResponse is the response type for the Query/EpochStatus
-// RPC method.
-type QueryEpochStatusResponse struct {
-	Status CheckpointStatus `protobuf:"varint,1,opt,name=status,proto3,enum=babylon.checkpointing.v1.CheckpointStatus" json:"status,omitempty"`
+// QueryBlsPublicKeyAtEpochResponse is the response type for the
+// Query/BlsPublicKeyAtEpoch RPC method.
+type QueryBlsPublicKeyAtEpochResponse struct {
+	// validator_with_bls_key contains the bls public key and the voting power
+	// of the validator at the given epoch
+	ValidatorWithBlsKey *ValidatorWithBlsKey `protobuf:"bytes,1,opt,name=validator_with_bls_key,json=validatorWithBlsKey,proto3" json:"validator_with_bls_key,omitempty"`
 }
 
-func (m *QueryEpochStatusResponse) Reset()         { *m = QueryEpochStatusResponse{} }
-func (m *QueryEpochStatusResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryEpochStatusResponse) ProtoMessage()    {}
-func (*QueryEpochStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_113f1ca5c3c2ca44, []int{9}
+func (m *QueryBlsPublicKeyAtEpochResponse) Reset()         { *m = QueryBlsPublicKeyAtEpochResponse{} }
+func (m *QueryBlsPublicKeyAtEpochResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlsPublicKeyAtEpochResponse) ProtoMessage()    {}
+func (*QueryBlsPublicKeyAtEpochResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{11}
 }
-func (m *QueryEpochStatusResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryBlsPublicKeyAtEpochResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEpochStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBlsPublicKeyAtEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEpochStatusResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBlsPublicKeyAtEpochResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -527,45 +624,46 @@ func (m *QueryEpochStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryEpochStatusResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEpochStatusResponse.Merge(m, src)
+func (m *QueryBlsPublicKeyAtEpochResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlsPublicKeyAtEpochResponse.Merge(m, src)
 }
-func (m *QueryEpochStatusResponse) XXX_Size() int {
+func (m *QueryBlsPublicKeyAtEpochResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEpochStatusResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEpochStatusResponse.DiscardUnknown(m)
+func (m *QueryBlsPublicKeyAtEpochResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlsPublicKeyAtEpochResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEpochStatusResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryBlsPublicKeyAtEpochResponse proto.InternalMessageInfo
 
-func (m *QueryEpochStatusResponse) GetStatus() CheckpointStatus {
+func (m *QueryBlsPublicKeyAtEpochResponse) GetValidatorWithBlsKey() *ValidatorWithBlsKey {
 	if m != nil {
-		return m.Status
+		return m.ValidatorWithBlsKey
 	}
-	return Accumulating
+	return nil
 }
 
-// QueryRecentEpochStatusCountRequest is the request type for the
-// Query/EpochStatusCount RPC method.
-type QueryRecentEpochStatusCountRequest struct {
-	// epoch_count is the number of the most recent epochs to include in the
-	// aggregation
-	EpochCount uint64 `protobuf:"varint,1,opt,name=epoch_count,json=epochCount,proto3" json:"epoch_count,omitempty"`
+// QueryValidatorBlsSigRequest is the request type for the
+// Query/ValidatorBlsSig RPC method.
+type QueryValidatorBlsSigRequest struct {
+	// epoch_num defines the epoch of the queried checkpoint
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// val_address defines the address of the validator
+	ValAddress string `protobuf:"bytes,2,opt,name=val_address,json=valAddress,proto3" json:"val_address,omitempty"`
 }
 
-func (m *QueryRecentEpochStatusCountRequest) Reset()         { *m = QueryRecentEpochStatusCountRequest{} }
-func (m *QueryRecentEpochStatusCountRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryRecentEpochStatusCountRequest) ProtoMessage()    {}
-func (*QueryRecentEpochStatusCountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_113f1ca5c3c2ca44, []int{10}
+func (m *QueryValidatorBlsSigRequest) Reset()         { *m = QueryValidatorBlsSigRequest{} }
+func (m *QueryValidatorBlsSigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorBlsSigRequest) ProtoMessage()    {}
+func (*QueryValidatorBlsSigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{12}
 }
-func (m *QueryRecentEpochStatusCountRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryValidatorBlsSigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryRecentEpochStatusCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValidatorBlsSigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryRecentEpochStatusCountRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValidatorBlsSigRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -575,45 +673,53 @@ func (m *QueryRecentEpochStatusCountRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryRecentEpochStatusCountRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRecentEpochStatusCountRequest.Merge(m, src)
+func (m *QueryValidatorBlsSigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorBlsSigRequest.Merge(m, src)
 }
-func (m *QueryRecentEpochStatusCountRequest) XXX_Size() int {
+func (m *QueryValidatorBlsSigRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryRecentEpochStatusCountRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRecentEpochStatusCountRequest.DiscardUnknown(m)
+func (m *QueryValidatorBlsSigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorBlsSigRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRecentEpochStatusCountRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryValidatorBlsSigRequest proto.InternalMessageInfo
 
-func (m *QueryRecentEpochStatusCountRequest) GetEpochCount() uint64 {
+func (m *QueryValidatorBlsSigRequest) GetEpochNum() uint64 {
 	if m != nil {
-		return m.EpochCount
+		return m.EpochNum
 	}
 	return 0
 }
 
-// QueryRecentEpochStatusCountResponse is the response type for the
-// Query/EpochStatusCount RPC method.
-type QueryRecentEpochStatusCountResponse struct {
-	TipEpoch    uint64            `protobuf:"varint,1,opt,name=tip_epoch,json=tipEpoch,proto3" json:"tip_epoch,omitempty"`
-	EpochCount  uint64            `protobuf:"varint,2,opt,name=epoch_count,json=epochCount,proto3" json:"epoch_count,omitempty"`
-	StatusCount map[string]uint64 `protobuf:"bytes,3,rep,name=status_count,json=statusCount,proto3" json:"status_count,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+func (m *QueryValidatorBlsSigRequest) GetValAddress() string {
+	if m != nil {
+		return m.ValAddress
+	}
+	return ""
 }
 
-func (m *QueryRecentEpochStatusCountResponse) Reset()         { *m = QueryRecentEpochStatusCountResponse{} }
-func (m *QueryRecentEpochStatusCountResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryRecentEpochStatusCountResponse) ProtoMessage()    {}
-func (*QueryRecentEpochStatusCountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_113f1ca5c3c2ca44, []int{11}
+// QueryValidatorBlsSigResponse is the response type for the
+// Query/ValidatorBlsSig RPC method.
+type QueryValidatorBlsSigResponse struct {
+	// bls_sig is the BLS sig that the validator contributed to the checkpoint,
+	// which can be verified against the validator's BLS public key at the
+	// epoch independently of the BLS multi sig
+	BlsSig *BlsSig `protobuf:"bytes,1,opt,name=bls_sig,json=blsSig,proto3" json:"bls_sig,omitempty"`
 }
-func (m *QueryRecentEpochStatusCountResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryValidatorBlsSigResponse) Reset()         { *m = QueryValidatorBlsSigResponse{} }
+func (m *QueryValidatorBlsSigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorBlsSigResponse) ProtoMessage()    {}
+func (*QueryValidatorBlsSigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{13}
+}
+func (m *QueryValidatorBlsSigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryRecentEpochStatusCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValidatorBlsSigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryRecentEpochStatusCountResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValidatorBlsSigResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -623,57 +729,44 @@ func (m *QueryRecentEpochStatusCountResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *QueryRecentEpochStatusCountResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRecentEpochStatusCountResponse.Merge(m, src)
+func (m *QueryValidatorBlsSigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorBlsSigResponse.Merge(m, src)
 }
-func (m *QueryRecentEpochStatusCountResponse) XXX_Size() int {
+func (m *QueryValidatorBlsSigResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryRecentEpochStatusCountResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRecentEpochStatusCountResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryRecentEpochStatusCountResponse proto.InternalMessageInfo
-
-func (m *QueryRecentEpochStatusCountResponse) GetTipEpoch() uint64 {
-	if m != nil {
-		return m.TipEpoch
-	}
-	return 0
+func (m *QueryValidatorBlsSigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorBlsSigResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRecentEpochStatusCountResponse) GetEpochCount() uint64 {
-	if m != nil {
-		return m.EpochCount
-	}
-	return 0
-}
+var xxx_messageInfo_QueryValidatorBlsSigResponse proto.InternalMessageInfo
 
-func (m *QueryRecentEpochStatusCountResponse) GetStatusCount() map[string]uint64 {
+func (m *QueryValidatorBlsSigResponse) GetBlsSig() *BlsSig {
 	if m != nil {
-		return m.StatusCount
+		return m.BlsSig
 	}
 	return nil
 }
 
-// QueryLastCheckpointWithStatusRequest is the request type for the
-// Query/LastCheckpointWithStatus RPC method.
-type QueryLastCheckpointWithStatusRequest struct {
-	Status CheckpointStatus `protobuf:"varint,1,opt,name=status,proto3,enum=babylon.checkpointing.v1.CheckpointStatus" json:"status,omitempty"`
+// QueryAllBlsRegistrationsRequest is the request type for the
+// Query/AllBlsRegistrations RPC method.
+type QueryAllBlsRegistrationsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryLastCheckpointWithStatusRequest) Reset()         { *m = QueryLastCheckpointWithStatusRequest{} }
-func (m *QueryLastCheckpointWithStatusRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryLastCheckpointWithStatusRequest) ProtoMessage()    {}
-func (*QueryLastCheckpointWithStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_113f1ca5c3c2ca44, []int{12}
+func (m *QueryAllBlsRegistrationsRequest) Reset()         { *m = QueryAllBlsRegistrationsRequest{} }
+func (m *QueryAllBlsRegistrationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBlsRegistrationsRequest) ProtoMessage()    {}
+func (*QueryAllBlsRegistrationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{14}
 }
-func (m *QueryLastCheckpointWithStatusRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllBlsRegistrationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLastCheckpointWithStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllBlsRegistrationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLastCheckpointWithStatusRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllBlsRegistrationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -683,43 +776,45 @@ func (m *QueryLastCheckpointWithStatusRequest) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *QueryLastCheckpointWithStatusRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLastCheckpointWithStatusRequest.Merge(m, src)
+func (m *QueryAllBlsRegistrationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBlsRegistrationsRequest.Merge(m, src)
 }
-func (m *QueryLastCheckpointWithStatusRequest) XXX_Size() int {
+func (m *QueryAllBlsRegistrationsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLastCheckpointWithStatusRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLastCheckpointWithStatusRequest.DiscardUnknown(m)
+func (m *QueryAllBlsRegistrationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBlsRegistrationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLastCheckpointWithStatusRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllBlsRegistrationsRequest proto.InternalMessageInfo
 
-func (m *QueryLastCheckpointWithStatusRequest) GetStatus() CheckpointStatus {
+func (m *QueryAllBlsRegistrationsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Status
+		return m.Pagination
 	}
-	return Accumulating
+	return nil
 }
 
-// QueryLastCheckpointWithStatusResponse is the response type for the
-// Query/LastCheckpointWithStatus RPC method.
-type QueryLastCheckpointWithStatusResponse struct {
-	RawCheckpoint *RawCheckpointResponse `protobuf:"bytes,1,opt,name=raw_checkpoint,json=rawCheckpoint,proto3" json:"raw_checkpoint,omitempty"`
+// QueryAllBlsRegistrationsResponse is the response type for the
+// Query/AllBlsRegistrations RPC method.
+type QueryAllBlsRegistrationsResponse struct {
+	Registrations []*BlsRegistration `protobuf:"bytes,1,rep,name=registrations,proto3" json:"registrations,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryLastCheckpointWithStatusResponse) Reset()         { *m = QueryLastCheckpointWithStatusResponse{} }
-func (m *QueryLastCheckpointWithStatusResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryLastCheckpointWithStatusResponse) ProtoMessage()    {}
-func (*QueryLastCheckpointWithStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_113f1ca5c3c2ca44, []int{13}
+func (m *QueryAllBlsRegistrationsResponse) Reset()         { *m = QueryAllBlsRegistrationsResponse{} }
+func (m *QueryAllBlsRegistrationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBlsRegistrationsResponse) ProtoMessage()    {}
+func (*QueryAllBlsRegistrationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{15}
 }
-func (m *QueryLastCheckpointWithStatusResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllBlsRegistrationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLastCheckpointWithStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllBlsRegistrationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLastCheckpointWithStatusResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllBlsRegistrationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -729,51 +824,52 @@ func (m *QueryLastCheckpointWithStatusResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryLastCheckpointWithStatusResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLastCheckpointWithStatusResponse.Merge(m, src)
+func (m *QueryAllBlsRegistrationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBlsRegistrationsResponse.Merge(m, src)
 }
-func (m *QueryLastCheckpointWithStatusResponse) XXX_Size() int {
+func (m *QueryAllBlsRegistrationsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLastCheckpointWithStatusResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLastCheckpointWithStatusResponse.DiscardUnknown(m)
+func (m *QueryAllBlsRegistrationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBlsRegistrationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLastCheckpointWithStatusResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllBlsRegistrationsResponse proto.InternalMessageInfo
 
-func (m *QueryLastCheckpointWithStatusResponse) GetRawCheckpoint() *RawCheckpointResponse {
+func (m *QueryAllBlsRegistrationsResponse) GetRegistrations() []*BlsRegistration {
 	if m != nil {
-		return m.RawCheckpoint
+		return m.Registrations
 	}
 	return nil
 }
 
-// RawCheckpointResponse wraps the BLS multi sig with metadata
-type RawCheckpointResponse struct {
-	// epoch_num defines the epoch number the raw checkpoint is for
-	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
-	// block_hash_hex defines the 'BlockID.Hash', which is the hash of
-	// the block that individual BLS sigs are signed on as hex string
-	BlockHashHex string `protobuf:"bytes,2,opt,name=block_hash_hex,json=blockHashHex,proto3" json:"block_hash_hex,omitempty"`
-	// bitmap defines the bitmap that indicates the signers of the BLS multi sig
-	Bitmap []byte `protobuf:"bytes,3,opt,name=bitmap,proto3" json:"bitmap,omitempty"`
-	// bls_multi_sig defines the multi sig that is aggregated from individual BLS
-	// sigs
-	BlsMultiSig *github_com_babylonchain_babylon_crypto_bls12381.Signature `protobuf:"bytes,4,opt,name=bls_multi_sig,json=blsMultiSig,proto3,customtype=github.com/babylonchain/babylon/crypto/bls12381.Signature" json:"bls_multi_sig,omitempty"`
+func (m *QueryAllBlsRegistrationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *RawCheckpointResponse) Reset()         { *m = RawCheckpointResponse{} }
-func (m *RawCheckpointResponse) String() string { return proto.CompactTextString(m) }
-func (*RawCheckpointResponse) ProtoMessage()    {}
-func (*RawCheckpointResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_113f1ca5c3c2ca44, []int{14}
+// BlsRegistration is a BLS public key registered by a validator
+type BlsRegistration struct {
+	// validator_address is the address of the validator in bech32 string
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// bls_pub_key is the BLS public key registered by the validator
+	BlsPubKey *github_com_babylonchain_babylon_crypto_bls12381.PublicKey `protobuf:"bytes,2,opt,name=bls_pub_key,json=blsPubKey,proto3,customtype=github.com/babylonchain/babylon/crypto/bls12381.PublicKey" json:"bls_pub_key,omitempty"`
 }
-func (m *RawCheckpointResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *BlsRegistration) Reset()         { *m = BlsRegistration{} }
+func (m *BlsRegistration) String() string { return proto.CompactTextString(m) }
+func (*BlsRegistration) ProtoMessage()    {}
+func (*BlsRegistration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{16}
+}
+func (m *BlsRegistration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RawCheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BlsRegistration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RawCheckpointResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BlsRegistration.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -783,65 +879,43 @@ func (m *RawCheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *RawCheckpointResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RawCheckpointResponse.Merge(m, src)
+func (m *BlsRegistration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlsRegistration.Merge(m, src)
 }
-func (m *RawCheckpointResponse) XXX_Size() int {
+func (m *BlsRegistration) XXX_Size() int {
 	return m.Size()
 }
-func (m *RawCheckpointResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RawCheckpointResponse.DiscardUnknown(m)
+func (m *BlsRegistration) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlsRegistration.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RawCheckpointResponse proto.InternalMessageInfo
-
-func (m *RawCheckpointResponse) GetEpochNum() uint64 {
-	if m != nil {
-		return m.EpochNum
-	}
-	return 0
-}
+var xxx_messageInfo_BlsRegistration proto.InternalMessageInfo
 
-func (m *RawCheckpointResponse) GetBlockHashHex() string {
+func (m *BlsRegistration) GetValidatorAddress() string {
 	if m != nil {
-		return m.BlockHashHex
+		return m.ValidatorAddress
 	}
 	return ""
 }
 
-func (m *RawCheckpointResponse) GetBitmap() []byte {
-	if m != nil {
-		return m.Bitmap
-	}
-	return nil
-}
-
-// CheckpointStateUpdateResponse defines a state transition on the checkpoint.
-type CheckpointStateUpdateResponse struct {
-	// state defines the event of a state transition towards this state
-	State CheckpointStatus `protobuf:"varint,1,opt,name=state,proto3,enum=babylon.checkpointing.v1.CheckpointStatus" json:"state,omitempty"`
-	// status_desc respresents the description of status enum.
-	StatusDesc string `protobuf:"bytes,2,opt,name=status_desc,json=statusDesc,proto3" json:"status_desc,omitempty"`
-	// block_height is the height of the Babylon block that triggers the state
-	// update
-	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	// block_time is the timestamp in the Babylon block that triggers the state
-	// update
-	BlockTime *time.Time `protobuf:"bytes,4,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time,omitempty"`
+// QueryEpochStatusRequest is the request type for the Query/EpochStatus
+// RPC method.
+type QueryEpochStatusRequest struct {
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
 }
 
-func (m *CheckpointStateUpdateResponse) Reset()         { *m = CheckpointStateUpdateResponse{} }
-func (m *CheckpointStateUpdateResponse) String() string { return proto.CompactTextString(m) }
-func (*CheckpointStateUpdateResponse) ProtoMessage()    {}
-func (*CheckpointStateUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_113f1ca5c3c2ca44, []int{15}
+func (m *QueryEpochStatusRequest) Reset()         { *m = QueryEpochStatusRequest{} }
+func (m *QueryEpochStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochStatusRequest) ProtoMessage()    {}
+func (*QueryEpochStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{17}
 }
-func (m *CheckpointStateUpdateResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryEpochStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CheckpointStateUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEpochStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CheckpointStateUpdateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEpochStatusRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -851,75 +925,91 @@ func (m *CheckpointStateUpdateResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *CheckpointStateUpdateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CheckpointStateUpdateResponse.Merge(m, src)
+func (m *QueryEpochStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochStatusRequest.Merge(m, src)
 }
-func (m *CheckpointStateUpdateResponse) XXX_Size() int {
+func (m *QueryEpochStatusRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CheckpointStateUpdateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CheckpointStateUpdateResponse.DiscardUnknown(m)
+func (m *QueryEpochStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochStatusRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CheckpointStateUpdateResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryEpochStatusRequest proto.InternalMessageInfo
 
-func (m *CheckpointStateUpdateResponse) GetState() CheckpointStatus {
+func (m *QueryEpochStatusRequest) GetEpochNum() uint64 {
 	if m != nil {
-		return m.State
+		return m.EpochNum
 	}
-	return Accumulating
+	return 0
 }
 
-func (m *CheckpointStateUpdateResponse) GetStatusDesc() string {
-	if m != nil {
-		return m.StatusDesc
-	}
-	return ""
+// QueryEpochStatusResponse is the response type for the Query/EpochStatus
+// RPC method.
+type QueryEpochStatusResponse struct {
+	Status CheckpointStatus `protobuf:"varint,1,opt,name=status,proto3,enum=babylon.checkpointing.v1.CheckpointStatus" json:"status,omitempty"`
 }
 
-func (m *CheckpointStateUpdateResponse) GetBlockHeight() uint64 {
-	if m != nil {
-		return m.BlockHeight
+func (m *QueryEpochStatusResponse) Reset()         { *m = QueryEpochStatusResponse{} }
+func (m *QueryEpochStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochStatusResponse) ProtoMessage()    {}
+func (*QueryEpochStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{18}
+}
+func (m *QueryEpochStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *QueryEpochStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochStatusResponse.Merge(m, src)
+}
+func (m *QueryEpochStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochStatusResponse.DiscardUnknown(m)
 }
 
-func (m *CheckpointStateUpdateResponse) GetBlockTime() *time.Time {
+var xxx_messageInfo_QueryEpochStatusResponse proto.InternalMessageInfo
+
+func (m *QueryEpochStatusResponse) GetStatus() CheckpointStatus {
 	if m != nil {
-		return m.BlockTime
+		return m.Status
 	}
-	return nil
+	return Accumulating
 }
 
-// RawCheckpointWithMetaResponse wraps the raw checkpoint with metadata.
-type RawCheckpointWithMetaResponse struct {
-	Ckpt *RawCheckpointResponse `protobuf:"bytes,1,opt,name=ckpt,proto3" json:"ckpt,omitempty"`
-	// status defines the status of the checkpoint
-	Status CheckpointStatus `protobuf:"varint,2,opt,name=status,proto3,enum=babylon.checkpointing.v1.CheckpointStatus" json:"status,omitempty"`
-	// status_desc respresents the description of status enum.
-	StatusDesc string `protobuf:"bytes,3,opt,name=status_desc,json=statusDesc,proto3" json:"status_desc,omitempty"`
-	// bls_aggr_pk defines the aggregated BLS public key
-	BlsAggrPk *github_com_babylonchain_babylon_crypto_bls12381.PublicKey `protobuf:"bytes,4,opt,name=bls_aggr_pk,json=blsAggrPk,proto3,customtype=github.com/babylonchain/babylon/crypto/bls12381.PublicKey" json:"bls_aggr_pk,omitempty"`
-	// power_sum defines the accumulated voting power for the checkpoint
-	PowerSum uint64 `protobuf:"varint,5,opt,name=power_sum,json=powerSum,proto3" json:"power_sum,omitempty"`
-	// lifecycle defines the lifecycle of this checkpoint, i.e., each state
-	// transition and the time (in both timestamp and block height) of this
-	// transition.
-	Lifecycle []*CheckpointStateUpdateResponse `protobuf:"bytes,6,rep,name=lifecycle,proto3" json:"lifecycle,omitempty"`
+// QueryRecentEpochStatusCountRequest is the request type for the
+// Query/EpochStatusCount RPC method.
+type QueryRecentEpochStatusCountRequest struct {
+	// epoch_count is the number of the most recent epochs to include in the
+	// aggregation
+	EpochCount uint64 `protobuf:"varint,1,opt,name=epoch_count,json=epochCount,proto3" json:"epoch_count,omitempty"`
 }
 
-func (m *RawCheckpointWithMetaResponse) Reset()         { *m = RawCheckpointWithMetaResponse{} }
-func (m *RawCheckpointWithMetaResponse) String() string { return proto.CompactTextString(m) }
-func (*RawCheckpointWithMetaResponse) ProtoMessage()    {}
-func (*RawCheckpointWithMetaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_113f1ca5c3c2ca44, []int{16}
+func (m *QueryRecentEpochStatusCountRequest) Reset()         { *m = QueryRecentEpochStatusCountRequest{} }
+func (m *QueryRecentEpochStatusCountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecentEpochStatusCountRequest) ProtoMessage()    {}
+func (*QueryRecentEpochStatusCountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{19}
 }
-func (m *RawCheckpointWithMetaResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryRecentEpochStatusCountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RawCheckpointWithMetaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRecentEpochStatusCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RawCheckpointWithMetaResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRecentEpochStatusCountRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -929,1491 +1019,9430 @@ func (m *RawCheckpointWithMetaResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *RawCheckpointWithMetaResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RawCheckpointWithMetaResponse.Merge(m, src)
+func (m *QueryRecentEpochStatusCountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecentEpochStatusCountRequest.Merge(m, src)
 }
-func (m *RawCheckpointWithMetaResponse) XXX_Size() int {
+func (m *QueryRecentEpochStatusCountRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RawCheckpointWithMetaResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RawCheckpointWithMetaResponse.DiscardUnknown(m)
+func (m *QueryRecentEpochStatusCountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecentEpochStatusCountRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RawCheckpointWithMetaResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryRecentEpochStatusCountRequest proto.InternalMessageInfo
 
-func (m *RawCheckpointWithMetaResponse) GetCkpt() *RawCheckpointResponse {
+func (m *QueryRecentEpochStatusCountRequest) GetEpochCount() uint64 {
 	if m != nil {
-		return m.Ckpt
+		return m.EpochCount
 	}
-	return nil
+	return 0
 }
 
-func (m *RawCheckpointWithMetaResponse) GetStatus() CheckpointStatus {
-	if m != nil {
-		return m.Status
+// QueryRecentEpochStatusCountResponse is the response type for the
+// Query/EpochStatusCount RPC method.
+type QueryRecentEpochStatusCountResponse struct {
+	TipEpoch    uint64            `protobuf:"varint,1,opt,name=tip_epoch,json=tipEpoch,proto3" json:"tip_epoch,omitempty"`
+	EpochCount  uint64            `protobuf:"varint,2,opt,name=epoch_count,json=epochCount,proto3" json:"epoch_count,omitempty"`
+	StatusCount map[string]uint64 `protobuf:"bytes,3,rep,name=status_count,json=statusCount,proto3" json:"status_count,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (m *QueryRecentEpochStatusCountResponse) Reset()         { *m = QueryRecentEpochStatusCountResponse{} }
+func (m *QueryRecentEpochStatusCountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecentEpochStatusCountResponse) ProtoMessage()    {}
+func (*QueryRecentEpochStatusCountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{20}
+}
+func (m *QueryRecentEpochStatusCountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecentEpochStatusCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecentEpochStatusCountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return Accumulating
+}
+func (m *QueryRecentEpochStatusCountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecentEpochStatusCountResponse.Merge(m, src)
+}
+func (m *QueryRecentEpochStatusCountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecentEpochStatusCountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecentEpochStatusCountResponse.DiscardUnknown(m)
 }
 
-func (m *RawCheckpointWithMetaResponse) GetStatusDesc() string {
+var xxx_messageInfo_QueryRecentEpochStatusCountResponse proto.InternalMessageInfo
+
+func (m *QueryRecentEpochStatusCountResponse) GetTipEpoch() uint64 {
 	if m != nil {
-		return m.StatusDesc
+		return m.TipEpoch
 	}
-	return ""
+	return 0
 }
 
-func (m *RawCheckpointWithMetaResponse) GetPowerSum() uint64 {
+func (m *QueryRecentEpochStatusCountResponse) GetEpochCount() uint64 {
 	if m != nil {
-		return m.PowerSum
+		return m.EpochCount
 	}
 	return 0
 }
 
-func (m *RawCheckpointWithMetaResponse) GetLifecycle() []*CheckpointStateUpdateResponse {
+func (m *QueryRecentEpochStatusCountResponse) GetStatusCount() map[string]uint64 {
 	if m != nil {
-		return m.Lifecycle
+		return m.StatusCount
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryRawCheckpointListRequest)(nil), "babylon.checkpointing.v1.QueryRawCheckpointListRequest")
-	proto.RegisterType((*QueryRawCheckpointListResponse)(nil), "babylon.checkpointing.v1.QueryRawCheckpointListResponse")
-	proto.RegisterType((*QueryRawCheckpointRequest)(nil), "babylon.checkpointing.v1.QueryRawCheckpointRequest")
-	proto.RegisterType((*QueryRawCheckpointResponse)(nil), "babylon.checkpointing.v1.QueryRawCheckpointResponse")
-	proto.RegisterType((*QueryRawCheckpointsRequest)(nil), "babylon.checkpointing.v1.QueryRawCheckpointsRequest")
-	proto.RegisterType((*QueryRawCheckpointsResponse)(nil), "babylon.checkpointing.v1.QueryRawCheckpointsResponse")
-	proto.RegisterType((*QueryBlsPublicKeyListRequest)(nil), "babylon.checkpointing.v1.QueryBlsPublicKeyListRequest")
-	proto.RegisterType((*QueryBlsPublicKeyListResponse)(nil), "babylon.checkpointing.v1.QueryBlsPublicKeyListResponse")
-	proto.RegisterType((*QueryEpochStatusRequest)(nil), "babylon.checkpointing.v1.QueryEpochStatusRequest")
-	proto.RegisterType((*QueryEpochStatusResponse)(nil), "babylon.checkpointing.v1.QueryEpochStatusResponse")
-	proto.RegisterType((*QueryRecentEpochStatusCountRequest)(nil), "babylon.checkpointing.v1.QueryRecentEpochStatusCountRequest")
-	proto.RegisterType((*QueryRecentEpochStatusCountResponse)(nil), "babylon.checkpointing.v1.QueryRecentEpochStatusCountResponse")
-	proto.RegisterMapType((map[string]uint64)(nil), "babylon.checkpointing.v1.QueryRecentEpochStatusCountResponse.StatusCountEntry")
-	proto.RegisterType((*QueryLastCheckpointWithStatusRequest)(nil), "babylon.checkpointing.v1.QueryLastCheckpointWithStatusRequest")
-	proto.RegisterType((*QueryLastCheckpointWithStatusResponse)(nil), "babylon.checkpointing.v1.QueryLastCheckpointWithStatusResponse")
-	proto.RegisterType((*RawCheckpointResponse)(nil), "babylon.checkpointing.v1.RawCheckpointResponse")
-	proto.RegisterType((*CheckpointStateUpdateResponse)(nil), "babylon.checkpointing.v1.CheckpointStateUpdateResponse")
-	proto.RegisterType((*RawCheckpointWithMetaResponse)(nil), "babylon.checkpointing.v1.RawCheckpointWithMetaResponse")
+// QueryLastCheckpointWithStatusRequest is the request type for the
+// Query/LastCheckpointWithStatus RPC method.
+type QueryLastCheckpointWithStatusRequest struct {
+	Status CheckpointStatus `protobuf:"varint,1,opt,name=status,proto3,enum=babylon.checkpointing.v1.CheckpointStatus" json:"status,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("babylon/checkpointing/v1/query.proto", fileDescriptor_113f1ca5c3c2ca44)
+func (m *QueryLastCheckpointWithStatusRequest) Reset()         { *m = QueryLastCheckpointWithStatusRequest{} }
+func (m *QueryLastCheckpointWithStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLastCheckpointWithStatusRequest) ProtoMessage()    {}
+func (*QueryLastCheckpointWithStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{21}
 }
-
-var fileDescriptor_113f1ca5c3c2ca44 = []byte{
-	// 1266 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x5d, 0x6f, 0x1b, 0x45,
-	0x17, 0xee, 0xda, 0x49, 0xf4, 0xfa, 0x38, 0xcd, 0x5b, 0x46, 0xa5, 0x35, 0x6e, 0xeb, 0x94, 0xa5,
-	0x94, 0xb4, 0xa8, 0xbb, 0xb2, 0xd3, 0x7c, 0x50, 0xfa, 0x01, 0x2e, 0x81, 0x4a, 0xfd, 0x20, 0x6c,
-	0x68, 0x91, 0x90, 0xe8, 0x32, 0xbb, 0x99, 0xae, 0x17, 0xaf, 0x77, 0x37, 0x9e, 0x59, 0x27, 0x56,
-	0xa9, 0x90, 0xe0, 0x0f, 0x54, 0x42, 0xe2, 0x8a, 0x7f, 0xc0, 0x0d, 0xdc, 0x71, 0xcd, 0x55, 0x25,
-	0x10, 0xaa, 0x84, 0x90, 0x10, 0x48, 0x50, 0x25, 0x88, 0xdf, 0x81, 0x76, 0x76, 0x1c, 0x7b, 0x6d,
-	0xaf, 0x1d, 0x3b, 0xb9, 0xe1, 0x2e, 0x3e, 0x7b, 0xce, 0xcc, 0x73, 0x9e, 0xf3, 0x31, 0x4f, 0xe0,
-	0x8c, 0x81, 0x8d, 0xa6, 0xe3, 0xb9, 0xaa, 0x59, 0x21, 0x66, 0xd5, 0xf7, 0x6c, 0x97, 0xd9, 0xae,
-	0xa5, 0x36, 0x8a, 0xea, 0x46, 0x40, 0xea, 0x4d, 0xc5, 0xaf, 0x7b, 0xcc, 0x43, 0x39, 0xe1, 0xa5,
-	0xc4, 0xbc, 0x94, 0x46, 0x31, 0x7f, 0xd4, 0xf2, 0x2c, 0x8f, 0x3b, 0xa9, 0xe1, 0x5f, 0x91, 0x7f,
-	0xfe, 0xa4, 0xe5, 0x79, 0x96, 0x43, 0x54, 0xec, 0xdb, 0x2a, 0x76, 0x5d, 0x8f, 0x61, 0x66, 0x7b,
-	0x2e, 0x15, 0x5f, 0x67, 0xc5, 0x57, 0xfe, 0xcb, 0x08, 0x1e, 0xa8, 0xcc, 0xae, 0x11, 0xca, 0x70,
-	0xcd, 0x17, 0x0e, 0x67, 0x13, 0x41, 0x19, 0x0e, 0xd5, 0xab, 0x44, 0xc0, 0xca, 0x9f, 0x4b, 0xf4,
-	0x6b, 0x1b, 0x84, 0xeb, 0x79, 0xd3, 0xa3, 0x35, 0x8f, 0xaa, 0x06, 0xa6, 0x24, 0x4a, 0x4d, 0x6d,
-	0x14, 0x0d, 0xc2, 0x70, 0x51, 0xf5, 0xb1, 0x65, 0xbb, 0x1c, 0x60, 0xe4, 0x2b, 0x7f, 0x23, 0xc1,
-	0xa9, 0xf7, 0x42, 0x17, 0x0d, 0x6f, 0x5e, 0xdf, 0x3d, 0xe8, 0x96, 0x4d, 0x99, 0x46, 0x36, 0x02,
-	0x42, 0x19, 0x2a, 0xc3, 0x14, 0x65, 0x98, 0x05, 0x34, 0x27, 0x9d, 0x96, 0xe6, 0x66, 0x4a, 0xe7,
-	0x95, 0x24, 0x82, 0x94, 0xf6, 0x01, 0x6b, 0x3c, 0x42, 0x13, 0x91, 0xe8, 0x6d, 0x80, 0xf6, 0xcd,
-	0xb9, 0xd4, 0x69, 0x69, 0x2e, 0x5b, 0x3a, 0xab, 0x44, 0x30, 0x95, 0x10, 0xa6, 0x12, 0x55, 0x40,
-	0xc0, 0x54, 0x56, 0xb1, 0x45, 0xc4, 0xfd, 0x5a, 0x47, 0xa4, 0xfc, 0xa3, 0x04, 0x85, 0x24, 0xb4,
-	0xd4, 0xf7, 0x5c, 0x4a, 0xd0, 0xc7, 0xf0, 0xff, 0x3a, 0xde, 0xd4, 0xdb, 0xd8, 0x42, 0xdc, 0xe9,
-	0xb9, 0x6c, 0x69, 0x29, 0x19, 0x77, 0xec, 0xb4, 0x0f, 0x6c, 0x56, 0xb9, 0x4d, 0x18, 0x6e, 0x9d,
-	0xa8, 0xcd, 0xd4, 0x3b, 0x3f, 0x53, 0xf4, 0x4e, 0x9f, 0x64, 0x5e, 0x19, 0x9a, 0x8c, 0x38, 0xac,
-	0x33, 0x9b, 0x65, 0x78, 0xa1, 0x37, 0x99, 0x16, 0xed, 0x27, 0x20, 0x43, 0x7c, 0xcf, 0xac, 0xe8,
-	0x6e, 0x50, 0xe3, 0xcc, 0x4f, 0x68, 0xff, 0xe3, 0x86, 0x3b, 0x41, 0x4d, 0xfe, 0x14, 0xf2, 0xfd,
-	0x22, 0x05, 0x05, 0xf7, 0x61, 0x26, 0x4e, 0x01, 0x8f, 0xdf, 0x07, 0x03, 0x87, 0x63, 0x0c, 0xc8,
-	0xeb, 0xfd, 0x6e, 0xa7, 0x2d, 0xe0, 0xf1, 0x5a, 0x4b, 0x63, 0xd7, 0xfa, 0x89, 0x04, 0x27, 0xfa,
-	0x5e, 0xf3, 0xdf, 0x2b, 0xf4, 0x17, 0x12, 0x9c, 0xe4, 0xa9, 0x94, 0x1d, 0xba, 0x1a, 0x18, 0x8e,
-	0x6d, 0xde, 0x24, 0xcd, 0xce, 0x19, 0x1b, 0x54, 0xec, 0x03, 0x1b, 0x9e, 0x9f, 0x5b, 0xa3, 0xde,
-	0x8b, 0x42, 0x50, 0xba, 0x0e, 0xc7, 0x1b, 0xd8, 0xb1, 0xd7, 0x31, 0xf3, 0xea, 0xfa, 0xa6, 0xcd,
-	0x2a, 0xba, 0xd8, 0x41, 0x2d, 0x6a, 0x2f, 0x24, 0x53, 0x7b, 0xaf, 0x15, 0x18, 0xd2, 0x5a, 0x76,
-	0xe8, 0x4d, 0xd2, 0xd4, 0x8e, 0x36, 0x7a, 0x8d, 0x07, 0x48, 0xeb, 0x22, 0x1c, 0xe7, 0xf9, 0xac,
-	0x84, 0x4c, 0x89, 0x8d, 0xb3, 0x97, 0xe9, 0xb9, 0x0f, 0xb9, 0xde, 0x38, 0x41, 0xc1, 0x01, 0x6c,
-	0x3b, 0x79, 0x05, 0xe4, 0xa8, 0x71, 0x89, 0x49, 0x5c, 0xd6, 0x71, 0xcb, 0x75, 0x2f, 0x68, 0x0f,
-	0xf8, 0x2c, 0x64, 0x23, 0x88, 0x66, 0x68, 0x15, 0x20, 0x81, 0x9b, 0xb8, 0x9f, 0xfc, 0x55, 0x0a,
-	0x5e, 0x1a, 0x78, 0x8e, 0x80, 0x7c, 0x02, 0x32, 0xcc, 0xf6, 0x75, 0x1e, 0xd9, 0xca, 0x95, 0xd9,
-	0x3e, 0xf7, 0xef, 0xbe, 0x25, 0xd5, 0x7d, 0x0b, 0xda, 0x80, 0xe9, 0x08, 0xb6, 0xf0, 0x48, 0xf3,
-	0x42, 0xdf, 0x49, 0x4e, 0x7b, 0x0f, 0x90, 0x94, 0x0e, 0xdb, 0x8a, 0xcb, 0xea, 0x4d, 0x2d, 0x4b,
-	0xdb, 0x96, 0xfc, 0x55, 0x38, 0xd2, 0xed, 0x80, 0x8e, 0x40, 0xba, 0x4a, 0x9a, 0x1c, 0x7e, 0x46,
-	0x0b, 0xff, 0x44, 0x47, 0x61, 0xb2, 0x81, 0x9d, 0x80, 0x08, 0xcc, 0xd1, 0x8f, 0x4b, 0xa9, 0x65,
-	0x49, 0xfe, 0x04, 0xce, 0x70, 0x10, 0xb7, 0x30, 0x65, 0xf1, 0x71, 0x8e, 0x37, 0xc1, 0x41, 0xd4,
-	0xf2, 0x33, 0x78, 0x79, 0xc8, 0x5d, 0xa2, 0x0a, 0xf7, 0x12, 0x96, 0xae, 0xba, 0xc7, 0x6d, 0x94,
-	0xb4, 0x6c, 0x7f, 0x95, 0xe0, 0xf9, 0xfe, 0x6b, 0x7e, 0xe0, 0xd2, 0x38, 0x03, 0x33, 0x86, 0xe3,
-	0x99, 0x55, 0xbd, 0x82, 0x69, 0x45, 0xaf, 0x90, 0x2d, 0x4e, 0x63, 0x46, 0x9b, 0xe6, 0xd6, 0x1b,
-	0x98, 0x56, 0x6e, 0x90, 0x2d, 0x74, 0x0c, 0xa6, 0x0c, 0x9b, 0xd5, 0xb0, 0x9f, 0x4b, 0x9f, 0x96,
-	0xe6, 0xa6, 0x35, 0xf1, 0x0b, 0x61, 0x38, 0x1c, 0x4e, 0x7e, 0x2d, 0x70, 0x98, 0xad, 0x53, 0xdb,
-	0xca, 0x4d, 0x84, 0x9f, 0xcb, 0x57, 0x7e, 0xff, 0x73, 0xf6, 0x35, 0xcb, 0x66, 0x95, 0xc0, 0x50,
-	0x4c, 0xaf, 0xa6, 0x8a, 0xcc, 0xcc, 0x0a, 0xb6, 0x5d, 0x75, 0x57, 0x9f, 0xd4, 0x9b, 0x3e, 0xf3,
-	0x42, 0xf5, 0x52, 0x2c, 0xcd, 0x2f, 0x17, 0x95, 0x35, 0xdb, 0x72, 0x31, 0x0b, 0xea, 0x44, 0xcb,
-	0x1a, 0x0e, 0xbd, 0x1d, 0x1e, 0xb9, 0x66, 0x5b, 0xf2, 0x3f, 0x12, 0x9c, 0x8a, 0xb3, 0x4e, 0xee,
-	0xfa, 0xeb, 0x98, 0xed, 0x8e, 0x3a, 0x7a, 0x03, 0x26, 0xc3, 0x22, 0x90, 0x31, 0xaa, 0x17, 0x05,
-	0x86, 0xcd, 0x2f, 0x7a, 0x7b, 0x9d, 0x50, 0x53, 0x30, 0x00, 0x91, 0xe9, 0x2d, 0x42, 0x4d, 0xf4,
-	0x22, 0x4c, 0x0b, 0x96, 0x88, 0x6d, 0x55, 0x18, 0x67, 0x61, 0x22, 0xc4, 0x19, 0x72, 0xc4, 0x4d,
-	0xe8, 0x1a, 0x40, 0xe4, 0x12, 0x0a, 0x37, 0xce, 0x43, 0xb6, 0x94, 0x57, 0x22, 0x55, 0xa7, 0xb4,
-	0x54, 0x9d, 0xf2, 0x7e, 0x4b, 0xd5, 0x95, 0x27, 0x1e, 0xff, 0x35, 0x2b, 0x69, 0x19, 0x1e, 0x13,
-	0x5a, 0xe5, 0xaf, 0xd3, 0x70, 0x6a, 0xe0, 0xbb, 0x83, 0xae, 0xc3, 0x84, 0x59, 0xf5, 0xc7, 0x6e,
-	0x18, 0x1e, 0xdc, 0xd1, 0xec, 0xa9, 0xb1, 0x65, 0x5a, 0x17, 0x5f, 0xe9, 0x1e, 0xbe, 0x3e, 0x82,
-	0xb0, 0x86, 0x3a, 0xb6, 0xac, 0xba, 0xee, 0x57, 0xf7, 0xd3, 0x15, 0xbb, 0x0f, 0x50, 0x48, 0x15,
-	0x7d, 0xd3, 0xb2, 0xea, 0xab, 0xd5, 0xb0, 0xa3, 0x7d, 0x6f, 0x93, 0xd4, 0x75, 0x1a, 0xd4, 0x72,
-	0x93, 0x51, 0x47, 0x73, 0xc3, 0x5a, 0x50, 0x43, 0x77, 0x21, 0xe3, 0xd8, 0x0f, 0x88, 0xd9, 0x34,
-	0x1d, 0x92, 0x9b, 0x1a, 0xf6, 0xd2, 0x0f, 0x6c, 0x2d, 0xad, 0x7d, 0x52, 0xe9, 0x19, 0xc0, 0x24,
-	0x9f, 0x70, 0xf4, 0x83, 0x04, 0xcf, 0xf5, 0xe8, 0x4a, 0xb4, 0x34, 0x6c, 0x13, 0x26, 0xe8, 0xe6,
-	0xfc, 0xf2, 0xe8, 0x81, 0x11, 0x3a, 0xf9, 0xd2, 0xe7, 0xbf, 0xfc, 0xfd, 0x65, 0xea, 0x22, 0x2a,
-	0xa9, 0x89, 0x9a, 0xbf, 0x4b, 0xf9, 0xa8, 0x0f, 0xa3, 0x22, 0x3d, 0x42, 0xdf, 0x4b, 0x70, 0x38,
-	0x76, 0x32, 0x9a, 0x1f, 0x05, 0x47, 0x0b, 0xfc, 0xc5, 0xd1, 0x82, 0x04, 0xf0, 0xcb, 0x1c, 0xf8,
-	0x22, 0xba, 0xb8, 0x57, 0xe0, 0xea, 0xc3, 0xdd, 0x0d, 0xf6, 0x08, 0x7d, 0x2b, 0xc1, 0x4c, 0x5c,
-	0xeb, 0xa1, 0x91, 0x60, 0xb4, 0xf6, 0x7e, 0x7e, 0x61, 0xc4, 0x28, 0x81, 0xbe, 0xc8, 0xd1, 0xbf,
-	0x8a, 0xce, 0xed, 0x99, 0xf6, 0xb0, 0x65, 0x8e, 0x74, 0xab, 0x29, 0xb4, 0x38, 0xe4, 0xfa, 0x04,
-	0x11, 0x98, 0x5f, 0x1a, 0x39, 0x4e, 0x00, 0xbf, 0xc2, 0x81, 0x2f, 0xa1, 0x05, 0x75, 0xe0, 0xff,
-	0x92, 0x3e, 0x0f, 0xe6, 0x72, 0x2e, 0xc6, 0xfb, 0x77, 0x12, 0x64, 0x3b, 0x5e, 0x72, 0x54, 0x1c,
-	0x82, 0xa3, 0x57, 0x6e, 0xe5, 0x4b, 0xa3, 0x84, 0x08, 0xd4, 0xaf, 0x73, 0xd4, 0x0b, 0x68, 0x3e,
-	0x19, 0x35, 0x07, 0x19, 0x03, 0xab, 0x8a, 0x4d, 0xf5, 0x93, 0x04, 0xc7, 0xfa, 0x6b, 0x10, 0x74,
-	0x79, 0x4c, 0xe9, 0x12, 0x65, 0x72, 0x65, 0x5f, 0xc2, 0x47, 0x5e, 0xe0, 0x49, 0xa9, 0xe8, 0xc2,
-	0xb0, 0xa4, 0x2e, 0x75, 0x8a, 0x2e, 0xf4, 0x87, 0x04, 0xb9, 0x24, 0x85, 0x81, 0xae, 0x0e, 0x81,
-	0x34, 0x44, 0x06, 0xe5, 0xaf, 0x8d, 0x1d, 0x2f, 0x92, 0xba, 0xca, 0x93, 0x5a, 0x46, 0x8b, 0xc9,
-	0x49, 0x39, 0x98, 0x32, 0xbd, 0x7b, 0xb6, 0xc5, 0x4e, 0x2a, 0xbf, 0xfb, 0x64, 0xbb, 0x20, 0x3d,
-	0xdd, 0x2e, 0x48, 0xcf, 0xb6, 0x0b, 0xd2, 0xe3, 0x9d, 0xc2, 0xa1, 0xa7, 0x3b, 0x85, 0x43, 0xbf,
-	0xed, 0x14, 0x0e, 0x7d, 0xb8, 0x30, 0xec, 0xd9, 0xd8, 0xea, 0xba, 0x8a, 0x35, 0x7d, 0x42, 0x8d,
-	0x29, 0xfe, 0xee, 0xce, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xee, 0xf6, 0x49, 0x5b, 0xd1, 0x11,
-	0x00, 0x00,
+func (m *QueryLastCheckpointWithStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastCheckpointWithStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastCheckpointWithStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLastCheckpointWithStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastCheckpointWithStatusRequest.Merge(m, src)
+}
+func (m *QueryLastCheckpointWithStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastCheckpointWithStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastCheckpointWithStatusRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryLastCheckpointWithStatusRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// RawCheckpointList queries all checkpoints that match the given status.
-	RawCheckpointList(ctx context.Context, in *QueryRawCheckpointListRequest, opts ...grpc.CallOption) (*QueryRawCheckpointListResponse, error)
-	// RawCheckpoint queries a checkpoints at a given epoch number.
-	RawCheckpoint(ctx context.Context, in *QueryRawCheckpointRequest, opts ...grpc.CallOption) (*QueryRawCheckpointResponse, error)
-	// RawCheckpoints queries checkpoints for a epoch range specified in pagination params.
-	RawCheckpoints(ctx context.Context, in *QueryRawCheckpointsRequest, opts ...grpc.CallOption) (*QueryRawCheckpointsResponse, error)
-	// BlsPublicKeyList queries a list of bls public keys of the validators at a
-	// given epoch number.
-	BlsPublicKeyList(ctx context.Context, in *QueryBlsPublicKeyListRequest, opts ...grpc.CallOption) (*QueryBlsPublicKeyListResponse, error)
-	// EpochStatus queries the status of the checkpoint at a given epoch
-	EpochStatus(ctx context.Context, in *QueryEpochStatusRequest, opts ...grpc.CallOption) (*QueryEpochStatusResponse, error)
-	// RecentEpochStatusCount queries the number of epochs with each status in
-	// recent epochs
-	RecentEpochStatusCount(ctx context.Context, in *QueryRecentEpochStatusCountRequest, opts ...grpc.CallOption) (*QueryRecentEpochStatusCountResponse, error)
-	// LastCheckpointWithStatus queries the last checkpoint with a given status or
-	// a more matured status
-	LastCheckpointWithStatus(ctx context.Context, in *QueryLastCheckpointWithStatusRequest, opts ...grpc.CallOption) (*QueryLastCheckpointWithStatusResponse, error)
+func (m *QueryLastCheckpointWithStatusRequest) GetStatus() CheckpointStatus {
+	if m != nil {
+		return m.Status
+	}
+	return Accumulating
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryLastCheckpointWithStatusResponse is the response type for the
+// Query/LastCheckpointWithStatus RPC method.
+type QueryLastCheckpointWithStatusResponse struct {
+	RawCheckpoint *RawCheckpointResponse `protobuf:"bytes,1,opt,name=raw_checkpoint,json=rawCheckpoint,proto3" json:"raw_checkpoint,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryLastCheckpointWithStatusResponse) Reset()         { *m = QueryLastCheckpointWithStatusResponse{} }
+func (m *QueryLastCheckpointWithStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLastCheckpointWithStatusResponse) ProtoMessage()    {}
+func (*QueryLastCheckpointWithStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{22}
 }
-
-func (c *queryClient) RawCheckpointList(ctx context.Context, in *QueryRawCheckpointListRequest, opts ...grpc.CallOption) (*QueryRawCheckpointListResponse, error) {
-	out := new(QueryRawCheckpointListResponse)
-	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/RawCheckpointList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLastCheckpointWithStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastCheckpointWithStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastCheckpointWithStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryLastCheckpointWithStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastCheckpointWithStatusResponse.Merge(m, src)
+}
+func (m *QueryLastCheckpointWithStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastCheckpointWithStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastCheckpointWithStatusResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) RawCheckpoint(ctx context.Context, in *QueryRawCheckpointRequest, opts ...grpc.CallOption) (*QueryRawCheckpointResponse, error) {
-	out := new(QueryRawCheckpointResponse)
-	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/RawCheckpoint", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLastCheckpointWithStatusResponse proto.InternalMessageInfo
+
+func (m *QueryLastCheckpointWithStatusResponse) GetRawCheckpoint() *RawCheckpointResponse {
+	if m != nil {
+		return m.RawCheckpoint
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) RawCheckpoints(ctx context.Context, in *QueryRawCheckpointsRequest, opts ...grpc.CallOption) (*QueryRawCheckpointsResponse, error) {
-	out := new(QueryRawCheckpointsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/RawCheckpoints", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryCurrentCheckpointRequest is the request type for the
+// Query/CurrentCheckpoint RPC method.
+type QueryCurrentCheckpointRequest struct {
 }
 
-func (c *queryClient) BlsPublicKeyList(ctx context.Context, in *QueryBlsPublicKeyListRequest, opts ...grpc.CallOption) (*QueryBlsPublicKeyListResponse, error) {
-	out := new(QueryBlsPublicKeyListResponse)
-	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/BlsPublicKeyList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCurrentCheckpointRequest) Reset()         { *m = QueryCurrentCheckpointRequest{} }
+func (m *QueryCurrentCheckpointRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentCheckpointRequest) ProtoMessage()    {}
+func (*QueryCurrentCheckpointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{23}
+}
+func (m *QueryCurrentCheckpointRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentCheckpointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentCheckpointRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCurrentCheckpointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentCheckpointRequest.Merge(m, src)
+}
+func (m *QueryCurrentCheckpointRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentCheckpointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentCheckpointRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) EpochStatus(ctx context.Context, in *QueryEpochStatusRequest, opts ...grpc.CallOption) (*QueryEpochStatusResponse, error) {
-	out := new(QueryEpochStatusResponse)
-	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/EpochStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCurrentCheckpointRequest proto.InternalMessageInfo
+
+// QueryCurrentCheckpointResponse is the response type for the
+// Query/CurrentCheckpoint RPC method.
+type QueryCurrentCheckpointResponse struct {
+	// raw_checkpoint is the accumulating checkpoint, including the signer
+	// bitmap and the voting power accumulated so far
+	RawCheckpoint *RawCheckpointWithMetaResponse `protobuf:"bytes,1,opt,name=raw_checkpoint,json=rawCheckpoint,proto3" json:"raw_checkpoint,omitempty"`
+	// total_power is the total voting power of the validator set of the
+	// checkpoint's epoch
+	TotalPower uint64 `protobuf:"varint,2,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	// threshold_power is the minimum accumulated voting power required for
+	// the checkpoint to be sealed
+	ThresholdPower uint64 `protobuf:"varint,3,opt,name=threshold_power,json=thresholdPower,proto3" json:"threshold_power,omitempty"`
+}
+
+func (m *QueryCurrentCheckpointResponse) Reset()         { *m = QueryCurrentCheckpointResponse{} }
+func (m *QueryCurrentCheckpointResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentCheckpointResponse) ProtoMessage()    {}
+func (*QueryCurrentCheckpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{24}
+}
+func (m *QueryCurrentCheckpointResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentCheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentCheckpointResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCurrentCheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentCheckpointResponse.Merge(m, src)
+}
+func (m *QueryCurrentCheckpointResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentCheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentCheckpointResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) RecentEpochStatusCount(ctx context.Context, in *QueryRecentEpochStatusCountRequest, opts ...grpc.CallOption) (*QueryRecentEpochStatusCountResponse, error) {
-	out := new(QueryRecentEpochStatusCountResponse)
-	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/RecentEpochStatusCount", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCurrentCheckpointResponse proto.InternalMessageInfo
+
+func (m *QueryCurrentCheckpointResponse) GetRawCheckpoint() *RawCheckpointWithMetaResponse {
+	if m != nil {
+		return m.RawCheckpoint
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) LastCheckpointWithStatus(ctx context.Context, in *QueryLastCheckpointWithStatusRequest, opts ...grpc.CallOption) (*QueryLastCheckpointWithStatusResponse, error) {
-	out := new(QueryLastCheckpointWithStatusResponse)
-	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/LastCheckpointWithStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCurrentCheckpointResponse) GetTotalPower() uint64 {
+	if m != nil {
+		return m.TotalPower
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// RawCheckpointList queries all checkpoints that match the given status.
-	RawCheckpointList(context.Context, *QueryRawCheckpointListRequest) (*QueryRawCheckpointListResponse, error)
-	// RawCheckpoint queries a checkpoints at a given epoch number.
-	RawCheckpoint(context.Context, *QueryRawCheckpointRequest) (*QueryRawCheckpointResponse, error)
-	// RawCheckpoints queries checkpoints for a epoch range specified in pagination params.
-	RawCheckpoints(context.Context, *QueryRawCheckpointsRequest) (*QueryRawCheckpointsResponse, error)
-	// BlsPublicKeyList queries a list of bls public keys of the validators at a
-	// given epoch number.
-	BlsPublicKeyList(context.Context, *QueryBlsPublicKeyListRequest) (*QueryBlsPublicKeyListResponse, error)
-	// EpochStatus queries the status of the checkpoint at a given epoch
-	EpochStatus(context.Context, *QueryEpochStatusRequest) (*QueryEpochStatusResponse, error)
-	// RecentEpochStatusCount queries the number of epochs with each status in
-	// recent epochs
-	RecentEpochStatusCount(context.Context, *QueryRecentEpochStatusCountRequest) (*QueryRecentEpochStatusCountResponse, error)
-	// LastCheckpointWithStatus queries the last checkpoint with a given status or
-	// a more matured status
-	LastCheckpointWithStatus(context.Context, *QueryLastCheckpointWithStatusRequest) (*QueryLastCheckpointWithStatusResponse, error)
+func (m *QueryCurrentCheckpointResponse) GetThresholdPower() uint64 {
+	if m != nil {
+		return m.ThresholdPower
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryVerifyBlsMultiSigRequest is the request type for the
+// Query/VerifyBlsMultiSig RPC method.
+type QueryVerifyBlsMultiSigRequest struct {
+	// epoch_num defines the epoch whose validator set signed the multi sig
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// block_hash_hex defines the hex string of the block hash that the BLS
+	// multi sig is signed on
+	BlockHashHex string `protobuf:"bytes,2,opt,name=block_hash_hex,json=blockHashHex,proto3" json:"block_hash_hex,omitempty"`
+	// bitmap defines the bitmap that indicates the signers of the BLS multi sig
+	Bitmap []byte `protobuf:"bytes,3,opt,name=bitmap,proto3" json:"bitmap,omitempty"`
+	// bls_multi_sig defines the BLS multi sig to verify
+	BlsMultiSig []byte `protobuf:"bytes,4,opt,name=bls_multi_sig,json=blsMultiSig,proto3" json:"bls_multi_sig,omitempty"`
 }
 
-func (*UnimplementedQueryServer) RawCheckpointList(ctx context.Context, req *QueryRawCheckpointListRequest) (*QueryRawCheckpointListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RawCheckpointList not implemented")
+func (m *QueryVerifyBlsMultiSigRequest) Reset()         { *m = QueryVerifyBlsMultiSigRequest{} }
+func (m *QueryVerifyBlsMultiSigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyBlsMultiSigRequest) ProtoMessage()    {}
+func (*QueryVerifyBlsMultiSigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{25}
 }
-func (*UnimplementedQueryServer) RawCheckpoint(ctx context.Context, req *QueryRawCheckpointRequest) (*QueryRawCheckpointResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RawCheckpoint not implemented")
+func (m *QueryVerifyBlsMultiSigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) RawCheckpoints(ctx context.Context, req *QueryRawCheckpointsRequest) (*QueryRawCheckpointsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RawCheckpoints not implemented")
+func (m *QueryVerifyBlsMultiSigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyBlsMultiSigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) BlsPublicKeyList(ctx context.Context, req *QueryBlsPublicKeyListRequest) (*QueryBlsPublicKeyListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlsPublicKeyList not implemented")
+func (m *QueryVerifyBlsMultiSigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyBlsMultiSigRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) EpochStatus(ctx context.Context, req *QueryEpochStatusRequest) (*QueryEpochStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EpochStatus not implemented")
+func (m *QueryVerifyBlsMultiSigRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) RecentEpochStatusCount(ctx context.Context, req *QueryRecentEpochStatusCountRequest) (*QueryRecentEpochStatusCountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RecentEpochStatusCount not implemented")
+func (m *QueryVerifyBlsMultiSigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyBlsMultiSigRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) LastCheckpointWithStatus(ctx context.Context, req *QueryLastCheckpointWithStatusRequest) (*QueryLastCheckpointWithStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastCheckpointWithStatus not implemented")
+
+var xxx_messageInfo_QueryVerifyBlsMultiSigRequest proto.InternalMessageInfo
+
+func (m *QueryVerifyBlsMultiSigRequest) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryVerifyBlsMultiSigRequest) GetBlockHashHex() string {
+	if m != nil {
+		return m.BlockHashHex
+	}
+	return ""
 }
 
-func _Query_RawCheckpointList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRawCheckpointListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryVerifyBlsMultiSigRequest) GetBitmap() []byte {
+	if m != nil {
+		return m.Bitmap
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).RawCheckpointList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.checkpointing.v1.Query/RawCheckpointList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RawCheckpointList(ctx, req.(*QueryRawCheckpointListRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_RawCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRawCheckpointRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RawCheckpoint(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.checkpointing.v1.Query/RawCheckpoint",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RawCheckpoint(ctx, req.(*QueryRawCheckpointRequest))
+func (m *QueryVerifyBlsMultiSigRequest) GetBlsMultiSig() []byte {
+	if m != nil {
+		return m.BlsMultiSig
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_RawCheckpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRawCheckpointsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RawCheckpoints(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.checkpointing.v1.Query/RawCheckpoints",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RawCheckpoints(ctx, req.(*QueryRawCheckpointsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryVerifyBlsMultiSigResponse is the response type for the
+// Query/VerifyBlsMultiSig RPC method.
+type QueryVerifyBlsMultiSigResponse struct {
+	// valid indicates whether the BLS multi sig is valid and signed by
+	// sufficient voting power
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// power_sum is the voting power of the signers indicated by the bitmap
+	PowerSum uint64 `protobuf:"varint,2,opt,name=power_sum,json=powerSum,proto3" json:"power_sum,omitempty"`
+	// total_power is the total voting power of the epoch's validator set
+	TotalPower uint64 `protobuf:"varint,3,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	// invalid_reason describes why the BLS multi sig is invalid, if so
+	InvalidReason string `protobuf:"bytes,4,opt,name=invalid_reason,json=invalidReason,proto3" json:"invalid_reason,omitempty"`
 }
 
-func _Query_BlsPublicKeyList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlsPublicKeyListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BlsPublicKeyList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.checkpointing.v1.Query/BlsPublicKeyList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BlsPublicKeyList(ctx, req.(*QueryBlsPublicKeyListRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryVerifyBlsMultiSigResponse) Reset()         { *m = QueryVerifyBlsMultiSigResponse{} }
+func (m *QueryVerifyBlsMultiSigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyBlsMultiSigResponse) ProtoMessage()    {}
+func (*QueryVerifyBlsMultiSigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{26}
 }
-
-func _Query_EpochStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEpochStatusRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EpochStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.checkpointing.v1.Query/EpochStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EpochStatus(ctx, req.(*QueryEpochStatusRequest))
+func (m *QueryVerifyBlsMultiSigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyBlsMultiSigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyBlsMultiSigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryVerifyBlsMultiSigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyBlsMultiSigResponse.Merge(m, src)
+}
+func (m *QueryVerifyBlsMultiSigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyBlsMultiSigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyBlsMultiSigResponse.DiscardUnknown(m)
 }
 
-func _Query_RecentEpochStatusCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRecentEpochStatusCountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RecentEpochStatusCount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.checkpointing.v1.Query/RecentEpochStatusCount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RecentEpochStatusCount(ctx, req.(*QueryRecentEpochStatusCountRequest))
+var xxx_messageInfo_QueryVerifyBlsMultiSigResponse proto.InternalMessageInfo
+
+func (m *QueryVerifyBlsMultiSigResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_LastCheckpointWithStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLastCheckpointWithStatusRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastCheckpointWithStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.checkpointing.v1.Query/LastCheckpointWithStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastCheckpointWithStatus(ctx, req.(*QueryLastCheckpointWithStatusRequest))
+func (m *QueryVerifyBlsMultiSigResponse) GetPowerSum() uint64 {
+	if m != nil {
+		return m.PowerSum
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "babylon.checkpointing.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RawCheckpointList",
-			Handler:    _Query_RawCheckpointList_Handler,
-		},
-		{
-			MethodName: "RawCheckpoint",
-			Handler:    _Query_RawCheckpoint_Handler,
-		},
-		{
-			MethodName: "RawCheckpoints",
-			Handler:    _Query_RawCheckpoints_Handler,
-		},
-		{
-			MethodName: "BlsPublicKeyList",
-			Handler:    _Query_BlsPublicKeyList_Handler,
-		},
-		{
-			MethodName: "EpochStatus",
-			Handler:    _Query_EpochStatus_Handler,
-		},
-		{
-			MethodName: "RecentEpochStatusCount",
-			Handler:    _Query_RecentEpochStatusCount_Handler,
-		},
-		{
-			MethodName: "LastCheckpointWithStatus",
-			Handler:    _Query_LastCheckpointWithStatus_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "babylon/checkpointing/v1/query.proto",
+func (m *QueryVerifyBlsMultiSigResponse) GetTotalPower() uint64 {
+	if m != nil {
+		return m.TotalPower
+	}
+	return 0
 }
 
-func (m *QueryRawCheckpointListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVerifyBlsMultiSigResponse) GetInvalidReason() string {
+	if m != nil {
+		return m.InvalidReason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryRawCheckpointListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVerifyCheckpointsRequest is the request type for the
+// Query/VerifyCheckpoints RPC method.
+type QueryVerifyCheckpointsRequest struct {
+	// from_epoch defines the first epoch of the range (inclusive)
+	FromEpoch uint64 `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	// to_epoch defines the last epoch of the range (inclusive)
+	ToEpoch uint64 `protobuf:"varint,2,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty"`
 }
 
-func (m *QueryRawCheckpointListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryVerifyCheckpointsRequest) Reset()         { *m = QueryVerifyCheckpointsRequest{} }
+func (m *QueryVerifyCheckpointsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyCheckpointsRequest) ProtoMessage()    {}
+func (*QueryVerifyCheckpointsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{27}
+}
+func (m *QueryVerifyCheckpointsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyCheckpointsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyCheckpointsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryVerifyCheckpointsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyCheckpointsRequest.Merge(m, src)
+}
+func (m *QueryVerifyCheckpointsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyCheckpointsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyCheckpointsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyCheckpointsRequest proto.InternalMessageInfo
+
+func (m *QueryVerifyCheckpointsRequest) GetFromEpoch() uint64 {
+	if m != nil {
+		return m.FromEpoch
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryRawCheckpointListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVerifyCheckpointsRequest) GetToEpoch() uint64 {
+	if m != nil {
+		return m.ToEpoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryRawCheckpointListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryVerifyCheckpointsResponse is the response type for the
+// Query/VerifyCheckpoints RPC method.
+type QueryVerifyCheckpointsResponse struct {
+	// results contains the verification result of each epoch in the range
+	Results []*CheckpointVerificationResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (m *QueryRawCheckpointListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryVerifyCheckpointsResponse) Reset()         { *m = QueryVerifyCheckpointsResponse{} }
+func (m *QueryVerifyCheckpointsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyCheckpointsResponse) ProtoMessage()    {}
+func (*QueryVerifyCheckpointsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{28}
+}
+func (m *QueryVerifyCheckpointsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyCheckpointsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyCheckpointsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.RawCheckpoints) > 0 {
-		for iNdEx := len(m.RawCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RawCheckpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryVerifyCheckpointsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyCheckpointsResponse.Merge(m, src)
+}
+func (m *QueryVerifyCheckpointsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyCheckpointsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyCheckpointsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRawCheckpointRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVerifyCheckpointsResponse proto.InternalMessageInfo
+
+func (m *QueryVerifyCheckpointsResponse) GetResults() []*CheckpointVerificationResult {
+	if m != nil {
+		return m.Results
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRawCheckpointRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CheckpointVerificationResult is the result of re-verifying the stored
+// checkpoint of an epoch
+type CheckpointVerificationResult struct {
+	// epoch_num defines the epoch of the checkpoint
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// status defines the status of the stored checkpoint. It is only
+	// meaningful if found is true
+	Status CheckpointStatus `protobuf:"varint,2,opt,name=status,proto3,enum=babylon.checkpointing.v1.CheckpointStatus" json:"status,omitempty"`
+	// valid indicates whether the checkpoint passes the verification
+	Valid bool `protobuf:"varint,3,opt,name=valid,proto3" json:"valid,omitempty"`
+	// power_sum is the voting power of the signers indicated by the bitmap
+	PowerSum uint64 `protobuf:"varint,4,opt,name=power_sum,json=powerSum,proto3" json:"power_sum,omitempty"`
+	// total_power is the total voting power of the epoch's validator set
+	TotalPower uint64 `protobuf:"varint,5,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	// invalid_reason describes why the checkpoint fails the verification, if so
+	InvalidReason string `protobuf:"bytes,6,opt,name=invalid_reason,json=invalidReason,proto3" json:"invalid_reason,omitempty"`
+	// found indicates whether a checkpoint is stored for the epoch
+	Found bool `protobuf:"varint,7,opt,name=found,proto3" json:"found,omitempty"`
+}
+
+func (m *CheckpointVerificationResult) Reset()         { *m = CheckpointVerificationResult{} }
+func (m *CheckpointVerificationResult) String() string { return proto.CompactTextString(m) }
+func (*CheckpointVerificationResult) ProtoMessage()    {}
+func (*CheckpointVerificationResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{29}
+}
+func (m *CheckpointVerificationResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryRawCheckpointRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EpochNum != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
-		i--
-		dAtA[i] = 0x8
+func (m *CheckpointVerificationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointVerificationResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CheckpointVerificationResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointVerificationResult.Merge(m, src)
+}
+func (m *CheckpointVerificationResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointVerificationResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointVerificationResult.DiscardUnknown(m)
 }
 
-func (m *QueryRawCheckpointResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CheckpointVerificationResult proto.InternalMessageInfo
+
+func (m *CheckpointVerificationResult) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryRawCheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CheckpointVerificationResult) GetStatus() CheckpointStatus {
+	if m != nil {
+		return m.Status
+	}
+	return Accumulating
 }
 
-func (m *QueryRawCheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RawCheckpoint != nil {
-		{
-			size, err := m.RawCheckpoint.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *CheckpointVerificationResult) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryRawCheckpointsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CheckpointVerificationResult) GetPowerSum() uint64 {
+	if m != nil {
+		return m.PowerSum
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryRawCheckpointsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CheckpointVerificationResult) GetTotalPower() uint64 {
+	if m != nil {
+		return m.TotalPower
+	}
+	return 0
 }
 
-func (m *QueryRawCheckpointsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *CheckpointVerificationResult) GetInvalidReason() string {
+	if m != nil {
+		return m.InvalidReason
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryRawCheckpointsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CheckpointVerificationResult) GetFound() bool {
+	if m != nil {
+		return m.Found
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryRawCheckpointsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCheckpointSignersRequest is the request type for the
+// Query/CheckpointSigners RPC method.
+type QueryCheckpointSignersRequest struct {
+	// epoch_num defines the epoch of the queried checkpoint
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
 }
 
-func (m *QueryRawCheckpointsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RawCheckpoints) > 0 {
-		for iNdEx := len(m.RawCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RawCheckpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryCheckpointSignersRequest) Reset()         { *m = QueryCheckpointSignersRequest{} }
+func (m *QueryCheckpointSignersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointSignersRequest) ProtoMessage()    {}
+func (*QueryCheckpointSignersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{30}
+}
+func (m *QueryCheckpointSignersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointSignersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointSignersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCheckpointSignersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointSignersRequest.Merge(m, src)
+}
+func (m *QueryCheckpointSignersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointSignersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointSignersRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBlsPublicKeyListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCheckpointSignersRequest proto.InternalMessageInfo
+
+func (m *QueryCheckpointSignersRequest) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryBlsPublicKeyListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCheckpointSignersResponse is the response type for the
+// Query/CheckpointSigners RPC method.
+type QueryCheckpointSignersResponse struct {
+	// signers is the list of validators who signed the checkpoint
+	Signers []*CheckpointSigner `protobuf:"bytes,1,rep,name=signers,proto3" json:"signers,omitempty"`
+	// signers_power is the accumulated voting power of the signers
+	SignersPower uint64 `protobuf:"varint,2,opt,name=signers_power,json=signersPower,proto3" json:"signers_power,omitempty"`
+	// total_power is the total voting power of the validator set of the epoch
+	TotalPower uint64 `protobuf:"varint,3,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
 }
 
-func (m *QueryBlsPublicKeyListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryCheckpointSignersResponse) Reset()         { *m = QueryCheckpointSignersResponse{} }
+func (m *QueryCheckpointSignersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointSignersResponse) ProtoMessage()    {}
+func (*QueryCheckpointSignersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{31}
+}
+func (m *QueryCheckpointSignersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointSignersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointSignersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.EpochNum != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryCheckpointSignersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointSignersResponse.Merge(m, src)
+}
+func (m *QueryCheckpointSignersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointSignersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointSignersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointSignersResponse proto.InternalMessageInfo
+
+func (m *QueryCheckpointSignersResponse) GetSigners() []*CheckpointSigner {
+	if m != nil {
+		return m.Signers
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryBlsPublicKeyListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCheckpointSignersResponse) GetSignersPower() uint64 {
+	if m != nil {
+		return m.SignersPower
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryBlsPublicKeyListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCheckpointSignersResponse) GetTotalPower() uint64 {
+	if m != nil {
+		return m.TotalPower
+	}
+	return 0
 }
 
-func (m *QueryBlsPublicKeyListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ValidatorWithBlsKeys) > 0 {
-		for iNdEx := len(m.ValidatorWithBlsKeys) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValidatorWithBlsKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// QueryCheckpointParticipationHistoryRequest is the request type for the
+// Query/CheckpointParticipationHistory RPC method.
+type QueryCheckpointParticipationHistoryRequest struct {
+	// from_epoch defines the first epoch of the range (inclusive)
+	FromEpoch uint64 `protobuf:"varint,1,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	// to_epoch defines the last epoch of the range (inclusive)
+	ToEpoch uint64 `protobuf:"varint,2,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty"`
 }
 
-func (m *QueryEpochStatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryCheckpointParticipationHistoryRequest) Reset() {
+	*m = QueryCheckpointParticipationHistoryRequest{}
 }
-
-func (m *QueryEpochStatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCheckpointParticipationHistoryRequest) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (m *QueryEpochStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EpochNum != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*QueryCheckpointParticipationHistoryRequest) ProtoMessage() {}
+func (*QueryCheckpointParticipationHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{32}
 }
-
-func (m *QueryEpochStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCheckpointParticipationHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointParticipationHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointParticipationHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryEpochStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCheckpointParticipationHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointParticipationHistoryRequest.Merge(m, src)
+}
+func (m *QueryCheckpointParticipationHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointParticipationHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointParticipationHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryEpochStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryCheckpointParticipationHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryCheckpointParticipationHistoryRequest) GetFromEpoch() uint64 {
+	if m != nil {
+		return m.FromEpoch
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryRecentEpochStatusCountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCheckpointParticipationHistoryRequest) GetToEpoch() uint64 {
+	if m != nil {
+		return m.ToEpoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryRecentEpochStatusCountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCheckpointParticipationHistoryResponse is the response type for the
+// Query/CheckpointParticipationHistory RPC method.
+type QueryCheckpointParticipationHistoryResponse struct {
+	// participations contains the signing participation of each epoch in the
+	// range whose checkpoint is stored, in ascending order of epoch
+	Participations []*CheckpointParticipation `protobuf:"bytes,1,rep,name=participations,proto3" json:"participations,omitempty"`
 }
 
-func (m *QueryRecentEpochStatusCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EpochCount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochCount))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryCheckpointParticipationHistoryResponse) Reset() {
+	*m = QueryCheckpointParticipationHistoryResponse{}
+}
+func (m *QueryCheckpointParticipationHistoryResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryCheckpointParticipationHistoryResponse) ProtoMessage() {}
+func (*QueryCheckpointParticipationHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{33}
+}
+func (m *QueryCheckpointParticipationHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointParticipationHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointParticipationHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCheckpointParticipationHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointParticipationHistoryResponse.Merge(m, src)
+}
+func (m *QueryCheckpointParticipationHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointParticipationHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointParticipationHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRecentEpochStatusCountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCheckpointParticipationHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryCheckpointParticipationHistoryResponse) GetParticipations() []*CheckpointParticipation {
+	if m != nil {
+		return m.Participations
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRecentEpochStatusCountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CheckpointParticipation is the signing participation in the checkpoint of
+// an epoch
+type CheckpointParticipation struct {
+	// epoch_num defines the epoch of the checkpoint
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// status defines the status of the checkpoint. The bitmap of an
+	// accumulating checkpoint may still grow
+	Status CheckpointStatus `protobuf:"varint,2,opt,name=status,proto3,enum=babylon.checkpointing.v1.CheckpointStatus" json:"status,omitempty"`
+	// num_signers is the number of validators who signed the checkpoint
+	NumSigners uint64 `protobuf:"varint,3,opt,name=num_signers,json=numSigners,proto3" json:"num_signers,omitempty"`
+	// num_validators is the number of validators in the epoch's validator set
+	NumValidators uint64 `protobuf:"varint,4,opt,name=num_validators,json=numValidators,proto3" json:"num_validators,omitempty"`
+	// signers_power is the accumulated voting power of the signers
+	SignersPower uint64 `protobuf:"varint,5,opt,name=signers_power,json=signersPower,proto3" json:"signers_power,omitempty"`
+	// total_power is the total voting power of the epoch's validator set
+	TotalPower uint64 `protobuf:"varint,6,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	// participation_rate is signers_power / total_power
+	ParticipationRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=participation_rate,json=participationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"participation_rate"`
+}
+
+func (m *CheckpointParticipation) Reset()         { *m = CheckpointParticipation{} }
+func (m *CheckpointParticipation) String() string { return proto.CompactTextString(m) }
+func (*CheckpointParticipation) ProtoMessage()    {}
+func (*CheckpointParticipation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{34}
+}
+func (m *CheckpointParticipation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryRecentEpochStatusCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StatusCount) > 0 {
-		for k := range m.StatusCount {
-			v := m.StatusCount[k]
-			baseI := i
-			i = encodeVarintQuery(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
+func (m *CheckpointParticipation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointParticipation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.EpochCount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochCount))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.TipEpoch != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TipEpoch))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *CheckpointParticipation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointParticipation.Merge(m, src)
+}
+func (m *CheckpointParticipation) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointParticipation) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointParticipation.DiscardUnknown(m)
 }
 
-func (m *QueryLastCheckpointWithStatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CheckpointParticipation proto.InternalMessageInfo
+
+func (m *CheckpointParticipation) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryLastCheckpointWithStatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CheckpointParticipation) GetStatus() CheckpointStatus {
+	if m != nil {
+		return m.Status
+	}
+	return Accumulating
 }
 
-func (m *QueryLastCheckpointWithStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+func (m *CheckpointParticipation) GetNumSigners() uint64 {
+	if m != nil {
+		return m.NumSigners
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryLastCheckpointWithStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CheckpointParticipation) GetNumValidators() uint64 {
+	if m != nil {
+		return m.NumValidators
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryLastCheckpointWithStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CheckpointParticipation) GetSignersPower() uint64 {
+	if m != nil {
+		return m.SignersPower
+	}
+	return 0
 }
 
-func (m *QueryLastCheckpointWithStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RawCheckpoint != nil {
-		{
-			size, err := m.RawCheckpoint.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *CheckpointParticipation) GetTotalPower() uint64 {
+	if m != nil {
+		return m.TotalPower
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *RawCheckpointResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryLatestCheckpointStateUpdateRequest is the request type for the
+// Query/LatestCheckpointStateUpdate RPC method.
+type QueryLatestCheckpointStateUpdateRequest struct {
 }
 
-func (m *RawCheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryLatestCheckpointStateUpdateRequest) Reset() {
+	*m = QueryLatestCheckpointStateUpdateRequest{}
 }
-
-func (m *RawCheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlsMultiSig != nil {
-		{
-			size := m.BlsMultiSig.Size()
-			i -= size
-			if _, err := m.BlsMultiSig.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryLatestCheckpointStateUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestCheckpointStateUpdateRequest) ProtoMessage()    {}
+func (*QueryLatestCheckpointStateUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{35}
+}
+func (m *QueryLatestCheckpointStateUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestCheckpointStateUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestCheckpointStateUpdateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Bitmap) > 0 {
-		i -= len(m.Bitmap)
-		copy(dAtA[i:], m.Bitmap)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bitmap)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BlockHashHex) > 0 {
-		i -= len(m.BlockHashHex)
-		copy(dAtA[i:], m.BlockHashHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHashHex)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.EpochNum != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *CheckpointStateUpdateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryLatestCheckpointStateUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestCheckpointStateUpdateRequest.Merge(m, src)
 }
-
-func (m *CheckpointStateUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryLatestCheckpointStateUpdateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestCheckpointStateUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestCheckpointStateUpdateRequest.DiscardUnknown(m)
 }
 
-func (m *CheckpointStateUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockTime != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.BlockTime):])
-		if err9 != nil {
-			return 0, err9
+var xxx_messageInfo_QueryLatestCheckpointStateUpdateRequest proto.InternalMessageInfo
+
+// QueryLatestCheckpointStateUpdateResponse is the response type for the
+// Query/LatestCheckpointStateUpdate RPC method.
+type QueryLatestCheckpointStateUpdateResponse struct {
+	// epoch_num defines the epoch of the checkpoint
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// from defines the status of the checkpoint before the transition
+	From CheckpointStatus `protobuf:"varint,2,opt,name=from,proto3,enum=babylon.checkpointing.v1.CheckpointStatus" json:"from,omitempty"`
+	// to defines the status of the checkpoint after the transition
+	To CheckpointStatus `protobuf:"varint,3,opt,name=to,proto3,enum=babylon.checkpointing.v1.CheckpointStatus" json:"to,omitempty"`
+	// block_height is the height of the Babylon block that triggers the
+	// transition
+	BlockHeight uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// block_time is the timestamp in the Babylon block that triggers the
+	// transition
+	BlockTime *time.Time `protobuf:"bytes,5,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time,omitempty"`
+}
+
+func (m *QueryLatestCheckpointStateUpdateResponse) Reset() {
+	*m = QueryLatestCheckpointStateUpdateResponse{}
+}
+func (m *QueryLatestCheckpointStateUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestCheckpointStateUpdateResponse) ProtoMessage()    {}
+func (*QueryLatestCheckpointStateUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{36}
+}
+func (m *QueryLatestCheckpointStateUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestCheckpointStateUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestCheckpointStateUpdateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n9
-		i = encodeVarintQuery(dAtA, i, uint64(n9))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.StatusDesc) > 0 {
-		i -= len(m.StatusDesc)
-		copy(dAtA[i:], m.StatusDesc)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StatusDesc)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.State != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryLatestCheckpointStateUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestCheckpointStateUpdateResponse.Merge(m, src)
+}
+func (m *QueryLatestCheckpointStateUpdateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestCheckpointStateUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestCheckpointStateUpdateResponse.DiscardUnknown(m)
 }
 
-func (m *RawCheckpointWithMetaResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLatestCheckpointStateUpdateResponse proto.InternalMessageInfo
+
+func (m *QueryLatestCheckpointStateUpdateResponse) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *RawCheckpointWithMetaResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryLatestCheckpointStateUpdateResponse) GetFrom() CheckpointStatus {
+	if m != nil {
+		return m.From
+	}
+	return Accumulating
 }
 
-func (m *RawCheckpointWithMetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Lifecycle) > 0 {
-		for iNdEx := len(m.Lifecycle) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Lifecycle[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if m.PowerSum != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PowerSum))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.BlsAggrPk != nil {
-		{
-			size := m.BlsAggrPk.Size()
-			i -= size
-			if _, err := m.BlsAggrPk.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.StatusDesc) > 0 {
-		i -= len(m.StatusDesc)
-		copy(dAtA[i:], m.StatusDesc)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StatusDesc)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Ckpt != nil {
-		{
-			size, err := m.Ckpt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryLatestCheckpointStateUpdateResponse) GetTo() CheckpointStatus {
+	if m != nil {
+		return m.To
 	}
-	return len(dAtA) - i, nil
+	return Accumulating
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryLatestCheckpointStateUpdateResponse) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *QueryRawCheckpointListRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+
+func (m *QueryLatestCheckpointStateUpdateResponse) GetBlockTime() *time.Time {
+	if m != nil {
+		return m.BlockTime
 	}
-	return n
+	return nil
 }
 
-func (m *QueryRawCheckpointListResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.RawCheckpoints) > 0 {
-		for _, e := range m.RawCheckpoints {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+// CheckpointSigner is a validator who signed a checkpoint
+type CheckpointSigner struct {
+	// val_address is the address of the validator in bech32 string
+	ValAddress string `protobuf:"bytes,1,opt,name=val_address,json=valAddress,proto3" json:"val_address,omitempty"`
+	// power is the voting power of the validator in the checkpoint's epoch
+	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *CheckpointSigner) Reset()         { *m = CheckpointSigner{} }
+func (m *CheckpointSigner) String() string { return proto.CompactTextString(m) }
+func (*CheckpointSigner) ProtoMessage()    {}
+func (*CheckpointSigner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{37}
+}
+func (m *CheckpointSigner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointSigner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointSigner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *CheckpointSigner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointSigner.Merge(m, src)
+}
+func (m *CheckpointSigner) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointSigner) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointSigner.DiscardUnknown(m)
 }
 
-func (m *QueryRawCheckpointRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EpochNum != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNum))
+var xxx_messageInfo_CheckpointSigner proto.InternalMessageInfo
+
+func (m *CheckpointSigner) GetValAddress() string {
+	if m != nil {
+		return m.ValAddress
 	}
-	return n
+	return ""
 }
 
-func (m *QueryRawCheckpointResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RawCheckpoint != nil {
-		l = m.RawCheckpoint.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *CheckpointSigner) GetPower() int64 {
+	if m != nil {
+		return m.Power
 	}
-	return n
+	return 0
 }
 
-func (m *QueryRawCheckpointsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// RawCheckpointResponse wraps the BLS multi sig with metadata
+type RawCheckpointResponse struct {
+	// epoch_num defines the epoch number the raw checkpoint is for
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// block_hash_hex defines the 'BlockID.Hash', which is the hash of
+	// the block that individual BLS sigs are signed on as hex string
+	BlockHashHex string `protobuf:"bytes,2,opt,name=block_hash_hex,json=blockHashHex,proto3" json:"block_hash_hex,omitempty"`
+	// bitmap defines the bitmap that indicates the signers of the BLS multi sig
+	Bitmap []byte `protobuf:"bytes,3,opt,name=bitmap,proto3" json:"bitmap,omitempty"`
+	// bls_multi_sig defines the multi sig that is aggregated from individual BLS
+	// sigs
+	BlsMultiSig *github_com_babylonchain_babylon_crypto_bls12381.Signature `protobuf:"bytes,4,opt,name=bls_multi_sig,json=blsMultiSig,proto3,customtype=github.com/babylonchain/babylon/crypto/bls12381.Signature" json:"bls_multi_sig,omitempty"`
 }
 
-func (m *QueryRawCheckpointsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.RawCheckpoints) > 0 {
-		for _, e := range m.RawCheckpoints {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *RawCheckpointResponse) Reset()         { *m = RawCheckpointResponse{} }
+func (m *RawCheckpointResponse) String() string { return proto.CompactTextString(m) }
+func (*RawCheckpointResponse) ProtoMessage()    {}
+func (*RawCheckpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{38}
+}
+func (m *RawCheckpointResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RawCheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RawCheckpointResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
 }
-
-func (m *QueryBlsPublicKeyListRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EpochNum != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNum))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *RawCheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RawCheckpointResponse.Merge(m, src)
+}
+func (m *RawCheckpointResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RawCheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RawCheckpointResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBlsPublicKeyListResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ValidatorWithBlsKeys) > 0 {
-		for _, e := range m.ValidatorWithBlsKeys {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_RawCheckpointResponse proto.InternalMessageInfo
+
+func (m *RawCheckpointResponse) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
 	}
-	return n
+	return 0
 }
 
-func (m *QueryEpochStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EpochNum != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNum))
+func (m *RawCheckpointResponse) GetBlockHashHex() string {
+	if m != nil {
+		return m.BlockHashHex
 	}
-	return n
+	return ""
 }
 
-func (m *QueryEpochStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
+func (m *RawCheckpointResponse) GetBitmap() []byte {
+	if m != nil {
+		return m.Bitmap
 	}
-	return n
+	return nil
 }
 
-func (m *QueryRecentEpochStatusCountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EpochCount != 0 {
-		n += 1 + sovQuery(uint64(m.EpochCount))
-	}
-	return n
+// CheckpointStateUpdateResponse defines a state transition on the checkpoint.
+type CheckpointStateUpdateResponse struct {
+	// state defines the event of a state transition towards this state
+	State CheckpointStatus `protobuf:"varint,1,opt,name=state,proto3,enum=babylon.checkpointing.v1.CheckpointStatus" json:"state,omitempty"`
+	// status_desc respresents the description of status enum.
+	StatusDesc string `protobuf:"bytes,2,opt,name=status_desc,json=statusDesc,proto3" json:"status_desc,omitempty"`
+	// block_height is the height of the Babylon block that triggers the state
+	// update
+	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// block_time is the timestamp in the Babylon block that triggers the state
+	// update
+	BlockTime *time.Time `protobuf:"bytes,4,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time,omitempty"`
 }
 
-func (m *QueryRecentEpochStatusCountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TipEpoch != 0 {
-		n += 1 + sovQuery(uint64(m.TipEpoch))
-	}
-	if m.EpochCount != 0 {
-		n += 1 + sovQuery(uint64(m.EpochCount))
-	}
-	if len(m.StatusCount) > 0 {
-		for k, v := range m.StatusCount {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + sovQuery(uint64(v))
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+func (m *CheckpointStateUpdateResponse) Reset()         { *m = CheckpointStateUpdateResponse{} }
+func (m *CheckpointStateUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckpointStateUpdateResponse) ProtoMessage()    {}
+func (*CheckpointStateUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{39}
+}
+func (m *CheckpointStateUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointStateUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointStateUpdateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *CheckpointStateUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointStateUpdateResponse.Merge(m, src)
+}
+func (m *CheckpointStateUpdateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointStateUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointStateUpdateResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLastCheckpointWithStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
+var xxx_messageInfo_CheckpointStateUpdateResponse proto.InternalMessageInfo
+
+func (m *CheckpointStateUpdateResponse) GetState() CheckpointStatus {
+	if m != nil {
+		return m.State
 	}
-	return n
+	return Accumulating
 }
 
-func (m *QueryLastCheckpointWithStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RawCheckpoint != nil {
-		l = m.RawCheckpoint.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *CheckpointStateUpdateResponse) GetStatusDesc() string {
+	if m != nil {
+		return m.StatusDesc
 	}
-	return n
+	return ""
 }
 
-func (m *RawCheckpointResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EpochNum != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNum))
+func (m *CheckpointStateUpdateResponse) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	l = len(m.BlockHashHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *CheckpointStateUpdateResponse) GetBlockTime() *time.Time {
+	if m != nil {
+		return m.BlockTime
 	}
-	l = len(m.Bitmap)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// RawCheckpointWithMetaResponse wraps the raw checkpoint with metadata.
+type RawCheckpointWithMetaResponse struct {
+	Ckpt *RawCheckpointResponse `protobuf:"bytes,1,opt,name=ckpt,proto3" json:"ckpt,omitempty"`
+	// status defines the status of the checkpoint
+	Status CheckpointStatus `protobuf:"varint,2,opt,name=status,proto3,enum=babylon.checkpointing.v1.CheckpointStatus" json:"status,omitempty"`
+	// status_desc respresents the description of status enum.
+	StatusDesc string `protobuf:"bytes,3,opt,name=status_desc,json=statusDesc,proto3" json:"status_desc,omitempty"`
+	// bls_aggr_pk defines the aggregated BLS public key
+	BlsAggrPk *github_com_babylonchain_babylon_crypto_bls12381.PublicKey `protobuf:"bytes,4,opt,name=bls_aggr_pk,json=blsAggrPk,proto3,customtype=github.com/babylonchain/babylon/crypto/bls12381.PublicKey" json:"bls_aggr_pk,omitempty"`
+	// power_sum defines the accumulated voting power for the checkpoint
+	PowerSum uint64 `protobuf:"varint,5,opt,name=power_sum,json=powerSum,proto3" json:"power_sum,omitempty"`
+	// lifecycle defines the lifecycle of this checkpoint, i.e., each state
+	// transition and the time (in both timestamp and block height) of this
+	// transition.
+	Lifecycle []*CheckpointStateUpdateResponse `protobuf:"bytes,6,rep,name=lifecycle,proto3" json:"lifecycle,omitempty"`
+}
+
+func (m *RawCheckpointWithMetaResponse) Reset()         { *m = RawCheckpointWithMetaResponse{} }
+func (m *RawCheckpointWithMetaResponse) String() string { return proto.CompactTextString(m) }
+func (*RawCheckpointWithMetaResponse) ProtoMessage()    {}
+func (*RawCheckpointWithMetaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{40}
+}
+func (m *RawCheckpointWithMetaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RawCheckpointWithMetaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RawCheckpointWithMetaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.BlsMultiSig != nil {
-		l = m.BlsMultiSig.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *RawCheckpointWithMetaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RawCheckpointWithMetaResponse.Merge(m, src)
+}
+func (m *RawCheckpointWithMetaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RawCheckpointWithMetaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RawCheckpointWithMetaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RawCheckpointWithMetaResponse proto.InternalMessageInfo
+
+func (m *RawCheckpointWithMetaResponse) GetCkpt() *RawCheckpointResponse {
+	if m != nil {
+		return m.Ckpt
 	}
-	return n
+	return nil
 }
 
-func (m *CheckpointStateUpdateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RawCheckpointWithMetaResponse) GetStatus() CheckpointStatus {
+	if m != nil {
+		return m.Status
 	}
-	var l int
-	_ = l
-	if m.State != 0 {
-		n += 1 + sovQuery(uint64(m.State))
+	return Accumulating
+}
+
+func (m *RawCheckpointWithMetaResponse) GetStatusDesc() string {
+	if m != nil {
+		return m.StatusDesc
 	}
-	l = len(m.StatusDesc)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *RawCheckpointWithMetaResponse) GetPowerSum() uint64 {
+	if m != nil {
+		return m.PowerSum
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BlockHeight))
+	return 0
+}
+
+func (m *RawCheckpointWithMetaResponse) GetLifecycle() []*CheckpointStateUpdateResponse {
+	if m != nil {
+		return m.Lifecycle
 	}
-	if m.BlockTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.BlockTime)
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryAggregateBlsPubKeyRequest is the request type for the
+// Query/AggregateBlsPubKey RPC method.
+type QueryAggregateBlsPubKeyRequest struct {
+	// epoch_num defines the epoch whose validator set the signers belong to
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// bitmap defines the bitmap that indicates the signers in the validator set
+	Bitmap []byte `protobuf:"bytes,2,opt,name=bitmap,proto3" json:"bitmap,omitempty"`
+}
+
+func (m *QueryAggregateBlsPubKeyRequest) Reset()         { *m = QueryAggregateBlsPubKeyRequest{} }
+func (m *QueryAggregateBlsPubKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregateBlsPubKeyRequest) ProtoMessage()    {}
+func (*QueryAggregateBlsPubKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{41}
+}
+func (m *QueryAggregateBlsPubKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregateBlsPubKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregateBlsPubKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAggregateBlsPubKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregateBlsPubKeyRequest.Merge(m, src)
+}
+func (m *QueryAggregateBlsPubKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregateBlsPubKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregateBlsPubKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAggregateBlsPubKeyRequest proto.InternalMessageInfo
+
+func (m *QueryAggregateBlsPubKeyRequest) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+func (m *QueryAggregateBlsPubKeyRequest) GetBitmap() []byte {
+	if m != nil {
+		return m.Bitmap
+	}
+	return nil
+}
+
+// QueryAggregateBlsPubKeyResponse is the response type for the
+// Query/AggregateBlsPubKey RPC method.
+type QueryAggregateBlsPubKeyResponse struct {
+	// aggregate_bls_pub_key is the aggregate BLS public key of the signers,
+	// against which their BLS multi sig can be verified
+	AggregateBlsPubKey *github_com_babylonchain_babylon_crypto_bls12381.PublicKey `protobuf:"bytes,1,opt,name=aggregate_bls_pub_key,json=aggregateBlsPubKey,proto3,customtype=github.com/babylonchain/babylon/crypto/bls12381.PublicKey" json:"aggregate_bls_pub_key,omitempty"`
+	// num_signers is the number of signers indicated by the bitmap
+	NumSigners uint64 `protobuf:"varint,2,opt,name=num_signers,json=numSigners,proto3" json:"num_signers,omitempty"`
+	// power_sum is the voting power of the signers
+	PowerSum uint64 `protobuf:"varint,3,opt,name=power_sum,json=powerSum,proto3" json:"power_sum,omitempty"`
+}
+
+func (m *QueryAggregateBlsPubKeyResponse) Reset()         { *m = QueryAggregateBlsPubKeyResponse{} }
+func (m *QueryAggregateBlsPubKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregateBlsPubKeyResponse) ProtoMessage()    {}
+func (*QueryAggregateBlsPubKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{42}
+}
+func (m *QueryAggregateBlsPubKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAggregateBlsPubKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAggregateBlsPubKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAggregateBlsPubKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregateBlsPubKeyResponse.Merge(m, src)
+}
+func (m *QueryAggregateBlsPubKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAggregateBlsPubKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregateBlsPubKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAggregateBlsPubKeyResponse proto.InternalMessageInfo
+
+func (m *QueryAggregateBlsPubKeyResponse) GetNumSigners() uint64 {
+	if m != nil {
+		return m.NumSigners
+	}
+	return 0
+}
+
+func (m *QueryAggregateBlsPubKeyResponse) GetPowerSum() uint64 {
+	if m != nil {
+		return m.PowerSum
+	}
+	return 0
+}
+
+// QueryNextCheckpointHeightRequest is the request type for the
+// Query/NextCheckpointHeight RPC method.
+type QueryNextCheckpointHeightRequest struct {
+}
+
+func (m *QueryNextCheckpointHeightRequest) Reset()         { *m = QueryNextCheckpointHeightRequest{} }
+func (m *QueryNextCheckpointHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextCheckpointHeightRequest) ProtoMessage()    {}
+func (*QueryNextCheckpointHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{43}
+}
+func (m *QueryNextCheckpointHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextCheckpointHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextCheckpointHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextCheckpointHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextCheckpointHeightRequest.Merge(m, src)
+}
+func (m *QueryNextCheckpointHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextCheckpointHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextCheckpointHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextCheckpointHeightRequest proto.InternalMessageInfo
+
+// QueryNextCheckpointHeightResponse is the response type for the
+// Query/NextCheckpointHeight RPC method.
+type QueryNextCheckpointHeightResponse struct {
+	// epoch_num is the number of the current epoch
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// epoch_length is the number of blocks in the current epoch
+	EpochLength uint64 `protobuf:"varint,2,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// first_block_height is the height of the first block of the current epoch
+	FirstBlockHeight uint64 `protobuf:"varint,3,opt,name=first_block_height,json=firstBlockHeight,proto3" json:"first_block_height,omitempty"`
+	// last_block_height is the height of the last block of the current epoch,
+	// in which validators send their BLS signatures over the epoch
+	LastBlockHeight uint64 `protobuf:"varint,4,opt,name=last_block_height,json=lastBlockHeight,proto3" json:"last_block_height,omitempty"`
+	// checkpoint_height is the height of the first block of the next epoch,
+	// in which the BLS signatures are aggregated into the current epoch's
+	// checkpoint
+	CheckpointHeight uint64 `protobuf:"varint,5,opt,name=checkpoint_height,json=checkpointHeight,proto3" json:"checkpoint_height,omitempty"`
+	// current_height is the current Babylon height
+	CurrentHeight uint64 `protobuf:"varint,6,opt,name=current_height,json=currentHeight,proto3" json:"current_height,omitempty"`
+}
+
+func (m *QueryNextCheckpointHeightResponse) Reset()         { *m = QueryNextCheckpointHeightResponse{} }
+func (m *QueryNextCheckpointHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextCheckpointHeightResponse) ProtoMessage()    {}
+func (*QueryNextCheckpointHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{44}
+}
+func (m *QueryNextCheckpointHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextCheckpointHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextCheckpointHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextCheckpointHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextCheckpointHeightResponse.Merge(m, src)
+}
+func (m *QueryNextCheckpointHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextCheckpointHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextCheckpointHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextCheckpointHeightResponse proto.InternalMessageInfo
+
+func (m *QueryNextCheckpointHeightResponse) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+func (m *QueryNextCheckpointHeightResponse) GetEpochLength() uint64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
+func (m *QueryNextCheckpointHeightResponse) GetFirstBlockHeight() uint64 {
+	if m != nil {
+		return m.FirstBlockHeight
+	}
+	return 0
+}
+
+func (m *QueryNextCheckpointHeightResponse) GetLastBlockHeight() uint64 {
+	if m != nil {
+		return m.LastBlockHeight
+	}
+	return 0
+}
+
+func (m *QueryNextCheckpointHeightResponse) GetCheckpointHeight() uint64 {
+	if m != nil {
+		return m.CheckpointHeight
+	}
+	return 0
+}
+
+func (m *QueryNextCheckpointHeightResponse) GetCurrentHeight() uint64 {
+	if m != nil {
+		return m.CurrentHeight
+	}
+	return 0
+}
+
+// QueryCheckpointStatusSummaryRequest is the request type for the
+// Query/CheckpointStatusSummary RPC method.
+type QueryCheckpointStatusSummaryRequest struct {
+}
+
+func (m *QueryCheckpointStatusSummaryRequest) Reset()         { *m = QueryCheckpointStatusSummaryRequest{} }
+func (m *QueryCheckpointStatusSummaryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointStatusSummaryRequest) ProtoMessage()    {}
+func (*QueryCheckpointStatusSummaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{45}
+}
+func (m *QueryCheckpointStatusSummaryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointStatusSummaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointStatusSummaryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointStatusSummaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointStatusSummaryRequest.Merge(m, src)
+}
+func (m *QueryCheckpointStatusSummaryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointStatusSummaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointStatusSummaryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointStatusSummaryRequest proto.InternalMessageInfo
+
+// QueryCheckpointStatusSummaryResponse is the response type for the
+// Query/CheckpointStatusSummary RPC method.
+type QueryCheckpointStatusSummaryResponse struct {
+	// tip_epoch is the epoch of the latest checkpoint
+	TipEpoch uint64 `protobuf:"varint,1,opt,name=tip_epoch,json=tipEpoch,proto3" json:"tip_epoch,omitempty"`
+	// summaries is the summary of each checkpoint status, in the order of
+	// increasing maturity from ACCUMULATING to FINALIZED
+	Summaries []*CheckpointStatusSummary `protobuf:"bytes,2,rep,name=summaries,proto3" json:"summaries,omitempty"`
+}
+
+func (m *QueryCheckpointStatusSummaryResponse) Reset()         { *m = QueryCheckpointStatusSummaryResponse{} }
+func (m *QueryCheckpointStatusSummaryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointStatusSummaryResponse) ProtoMessage()    {}
+func (*QueryCheckpointStatusSummaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{46}
+}
+func (m *QueryCheckpointStatusSummaryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointStatusSummaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointStatusSummaryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointStatusSummaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointStatusSummaryResponse.Merge(m, src)
+}
+func (m *QueryCheckpointStatusSummaryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointStatusSummaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointStatusSummaryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointStatusSummaryResponse proto.InternalMessageInfo
+
+func (m *QueryCheckpointStatusSummaryResponse) GetTipEpoch() uint64 {
+	if m != nil {
+		return m.TipEpoch
+	}
+	return 0
+}
+
+func (m *QueryCheckpointStatusSummaryResponse) GetSummaries() []*CheckpointStatusSummary {
+	if m != nil {
+		return m.Summaries
+	}
+	return nil
+}
+
+// CheckpointStatusSummary is the summary of the checkpoints in a status
+type CheckpointStatusSummary struct {
+	Status CheckpointStatus `protobuf:"varint,1,opt,name=status,proto3,enum=babylon.checkpointing.v1.CheckpointStatus" json:"status,omitempty"`
+	// count is the number of checkpoints in this status
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// latest_epoch is the highest epoch whose checkpoint is in this status. It
+	// is meaningless if count is zero
+	LatestEpoch uint64 `protobuf:"varint,3,opt,name=latest_epoch,json=latestEpoch,proto3" json:"latest_epoch,omitempty"`
+}
+
+func (m *CheckpointStatusSummary) Reset()         { *m = CheckpointStatusSummary{} }
+func (m *CheckpointStatusSummary) String() string { return proto.CompactTextString(m) }
+func (*CheckpointStatusSummary) ProtoMessage()    {}
+func (*CheckpointStatusSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{47}
+}
+func (m *CheckpointStatusSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointStatusSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointStatusSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckpointStatusSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointStatusSummary.Merge(m, src)
+}
+func (m *CheckpointStatusSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointStatusSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointStatusSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointStatusSummary proto.InternalMessageInfo
+
+func (m *CheckpointStatusSummary) GetStatus() CheckpointStatus {
+	if m != nil {
+		return m.Status
+	}
+	return Accumulating
+}
+
+func (m *CheckpointStatusSummary) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *CheckpointStatusSummary) GetLatestEpoch() uint64 {
+	if m != nil {
+		return m.LatestEpoch
+	}
+	return 0
+}
+
+// QueryCheckpointAccumulationProgressRequest is the request type for the
+// Query/CheckpointAccumulationProgress RPC method.
+type QueryCheckpointAccumulationProgressRequest struct {
+	// epoch_num defines the epoch of the checkpoint
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+}
+
+func (m *QueryCheckpointAccumulationProgressRequest) Reset() {
+	*m = QueryCheckpointAccumulationProgressRequest{}
+}
+func (m *QueryCheckpointAccumulationProgressRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryCheckpointAccumulationProgressRequest) ProtoMessage() {}
+func (*QueryCheckpointAccumulationProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{48}
+}
+func (m *QueryCheckpointAccumulationProgressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointAccumulationProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointAccumulationProgressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointAccumulationProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointAccumulationProgressRequest.Merge(m, src)
+}
+func (m *QueryCheckpointAccumulationProgressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointAccumulationProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointAccumulationProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointAccumulationProgressRequest proto.InternalMessageInfo
+
+func (m *QueryCheckpointAccumulationProgressRequest) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+// QueryCheckpointAccumulationProgressResponse is the response type for the
+// Query/CheckpointAccumulationProgress RPC method.
+type QueryCheckpointAccumulationProgressResponse struct {
+	Progress *CheckpointAccumulationProgress `protobuf:"bytes,1,opt,name=progress,proto3" json:"progress,omitempty"`
+}
+
+func (m *QueryCheckpointAccumulationProgressResponse) Reset() {
+	*m = QueryCheckpointAccumulationProgressResponse{}
+}
+func (m *QueryCheckpointAccumulationProgressResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryCheckpointAccumulationProgressResponse) ProtoMessage() {}
+func (*QueryCheckpointAccumulationProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{49}
+}
+func (m *QueryCheckpointAccumulationProgressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointAccumulationProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointAccumulationProgressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointAccumulationProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointAccumulationProgressResponse.Merge(m, src)
+}
+func (m *QueryCheckpointAccumulationProgressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointAccumulationProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointAccumulationProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointAccumulationProgressResponse proto.InternalMessageInfo
+
+func (m *QueryCheckpointAccumulationProgressResponse) GetProgress() *CheckpointAccumulationProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+// CheckpointAccumulationProgress is the progress of the checkpoint of an epoch
+// towards being sealed
+type CheckpointAccumulationProgress struct {
+	// epoch_num defines the epoch of the checkpoint
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// status defines the status of the checkpoint
+	Status CheckpointStatus `protobuf:"varint,2,opt,name=status,proto3,enum=babylon.checkpointing.v1.CheckpointStatus" json:"status,omitempty"`
+	// accumulated_power is the voting power of the validators whose BLS
+	// signatures are accumulated in the checkpoint, as indicated by its bitmap
+	AccumulatedPower uint64 `protobuf:"varint,3,opt,name=accumulated_power,json=accumulatedPower,proto3" json:"accumulated_power,omitempty"`
+	// total_power is the total voting power of the epoch's validator set
+	TotalPower uint64 `protobuf:"varint,4,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	// sealing_threshold is the portion of the total voting power required for
+	// the checkpoint to be sealed
+	SealingThreshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=sealing_threshold,json=sealingThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"sealing_threshold"`
+	// sealing_power is the minimum accumulated voting power required for the
+	// checkpoint to be sealed
+	SealingPower uint64 `protobuf:"varint,6,opt,name=sealing_power,json=sealingPower,proto3" json:"sealing_power,omitempty"`
+	// percentage_to_seal is accumulated_power / sealing_power in percent,
+	// capped at 100. It is 100 for checkpoints that are no longer accumulating
+	PercentageToSeal cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=percentage_to_seal,json=percentageToSeal,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"percentage_to_seal"`
+}
+
+func (m *CheckpointAccumulationProgress) Reset()         { *m = CheckpointAccumulationProgress{} }
+func (m *CheckpointAccumulationProgress) String() string { return proto.CompactTextString(m) }
+func (*CheckpointAccumulationProgress) ProtoMessage()    {}
+func (*CheckpointAccumulationProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_113f1ca5c3c2ca44, []int{50}
+}
+func (m *CheckpointAccumulationProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointAccumulationProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointAccumulationProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckpointAccumulationProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointAccumulationProgress.Merge(m, src)
+}
+func (m *CheckpointAccumulationProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointAccumulationProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointAccumulationProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointAccumulationProgress proto.InternalMessageInfo
+
+func (m *CheckpointAccumulationProgress) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+func (m *CheckpointAccumulationProgress) GetStatus() CheckpointStatus {
+	if m != nil {
+		return m.Status
+	}
+	return Accumulating
+}
+
+func (m *CheckpointAccumulationProgress) GetAccumulatedPower() uint64 {
+	if m != nil {
+		return m.AccumulatedPower
+	}
+	return 0
+}
+
+func (m *CheckpointAccumulationProgress) GetTotalPower() uint64 {
+	if m != nil {
+		return m.TotalPower
+	}
+	return 0
+}
+
+func (m *CheckpointAccumulationProgress) GetSealingPower() uint64 {
+	if m != nil {
+		return m.SealingPower
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.checkpointing.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.checkpointing.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryRawCheckpointListRequest)(nil), "babylon.checkpointing.v1.QueryRawCheckpointListRequest")
+	proto.RegisterType((*QueryRawCheckpointListResponse)(nil), "babylon.checkpointing.v1.QueryRawCheckpointListResponse")
+	proto.RegisterType((*QueryRawCheckpointRequest)(nil), "babylon.checkpointing.v1.QueryRawCheckpointRequest")
+	proto.RegisterType((*QueryRawCheckpointResponse)(nil), "babylon.checkpointing.v1.QueryRawCheckpointResponse")
+	proto.RegisterType((*QueryRawCheckpointsRequest)(nil), "babylon.checkpointing.v1.QueryRawCheckpointsRequest")
+	proto.RegisterType((*QueryRawCheckpointsResponse)(nil), "babylon.checkpointing.v1.QueryRawCheckpointsResponse")
+	proto.RegisterType((*QueryBlsPublicKeyListRequest)(nil), "babylon.checkpointing.v1.QueryBlsPublicKeyListRequest")
+	proto.RegisterType((*QueryBlsPublicKeyListResponse)(nil), "babylon.checkpointing.v1.QueryBlsPublicKeyListResponse")
+	proto.RegisterType((*QueryBlsPublicKeyAtEpochRequest)(nil), "babylon.checkpointing.v1.QueryBlsPublicKeyAtEpochRequest")
+	proto.RegisterType((*QueryBlsPublicKeyAtEpochResponse)(nil), "babylon.checkpointing.v1.QueryBlsPublicKeyAtEpochResponse")
+	proto.RegisterType((*QueryValidatorBlsSigRequest)(nil), "babylon.checkpointing.v1.QueryValidatorBlsSigRequest")
+	proto.RegisterType((*QueryValidatorBlsSigResponse)(nil), "babylon.checkpointing.v1.QueryValidatorBlsSigResponse")
+	proto.RegisterType((*QueryAllBlsRegistrationsRequest)(nil), "babylon.checkpointing.v1.QueryAllBlsRegistrationsRequest")
+	proto.RegisterType((*QueryAllBlsRegistrationsResponse)(nil), "babylon.checkpointing.v1.QueryAllBlsRegistrationsResponse")
+	proto.RegisterType((*BlsRegistration)(nil), "babylon.checkpointing.v1.BlsRegistration")
+	proto.RegisterType((*QueryEpochStatusRequest)(nil), "babylon.checkpointing.v1.QueryEpochStatusRequest")
+	proto.RegisterType((*QueryEpochStatusResponse)(nil), "babylon.checkpointing.v1.QueryEpochStatusResponse")
+	proto.RegisterType((*QueryRecentEpochStatusCountRequest)(nil), "babylon.checkpointing.v1.QueryRecentEpochStatusCountRequest")
+	proto.RegisterType((*QueryRecentEpochStatusCountResponse)(nil), "babylon.checkpointing.v1.QueryRecentEpochStatusCountResponse")
+	proto.RegisterMapType((map[string]uint64)(nil), "babylon.checkpointing.v1.QueryRecentEpochStatusCountResponse.StatusCountEntry")
+	proto.RegisterType((*QueryLastCheckpointWithStatusRequest)(nil), "babylon.checkpointing.v1.QueryLastCheckpointWithStatusRequest")
+	proto.RegisterType((*QueryLastCheckpointWithStatusResponse)(nil), "babylon.checkpointing.v1.QueryLastCheckpointWithStatusResponse")
+	proto.RegisterType((*QueryCurrentCheckpointRequest)(nil), "babylon.checkpointing.v1.QueryCurrentCheckpointRequest")
+	proto.RegisterType((*QueryCurrentCheckpointResponse)(nil), "babylon.checkpointing.v1.QueryCurrentCheckpointResponse")
+	proto.RegisterType((*QueryVerifyBlsMultiSigRequest)(nil), "babylon.checkpointing.v1.QueryVerifyBlsMultiSigRequest")
+	proto.RegisterType((*QueryVerifyBlsMultiSigResponse)(nil), "babylon.checkpointing.v1.QueryVerifyBlsMultiSigResponse")
+	proto.RegisterType((*QueryVerifyCheckpointsRequest)(nil), "babylon.checkpointing.v1.QueryVerifyCheckpointsRequest")
+	proto.RegisterType((*QueryVerifyCheckpointsResponse)(nil), "babylon.checkpointing.v1.QueryVerifyCheckpointsResponse")
+	proto.RegisterType((*CheckpointVerificationResult)(nil), "babylon.checkpointing.v1.CheckpointVerificationResult")
+	proto.RegisterType((*QueryCheckpointSignersRequest)(nil), "babylon.checkpointing.v1.QueryCheckpointSignersRequest")
+	proto.RegisterType((*QueryCheckpointSignersResponse)(nil), "babylon.checkpointing.v1.QueryCheckpointSignersResponse")
+	proto.RegisterType((*QueryCheckpointParticipationHistoryRequest)(nil), "babylon.checkpointing.v1.QueryCheckpointParticipationHistoryRequest")
+	proto.RegisterType((*QueryCheckpointParticipationHistoryResponse)(nil), "babylon.checkpointing.v1.QueryCheckpointParticipationHistoryResponse")
+	proto.RegisterType((*CheckpointParticipation)(nil), "babylon.checkpointing.v1.CheckpointParticipation")
+	proto.RegisterType((*QueryLatestCheckpointStateUpdateRequest)(nil), "babylon.checkpointing.v1.QueryLatestCheckpointStateUpdateRequest")
+	proto.RegisterType((*QueryLatestCheckpointStateUpdateResponse)(nil), "babylon.checkpointing.v1.QueryLatestCheckpointStateUpdateResponse")
+	proto.RegisterType((*CheckpointSigner)(nil), "babylon.checkpointing.v1.CheckpointSigner")
+	proto.RegisterType((*RawCheckpointResponse)(nil), "babylon.checkpointing.v1.RawCheckpointResponse")
+	proto.RegisterType((*CheckpointStateUpdateResponse)(nil), "babylon.checkpointing.v1.CheckpointStateUpdateResponse")
+	proto.RegisterType((*RawCheckpointWithMetaResponse)(nil), "babylon.checkpointing.v1.RawCheckpointWithMetaResponse")
+	proto.RegisterType((*QueryAggregateBlsPubKeyRequest)(nil), "babylon.checkpointing.v1.QueryAggregateBlsPubKeyRequest")
+	proto.RegisterType((*QueryAggregateBlsPubKeyResponse)(nil), "babylon.checkpointing.v1.QueryAggregateBlsPubKeyResponse")
+	proto.RegisterType((*QueryNextCheckpointHeightRequest)(nil), "babylon.checkpointing.v1.QueryNextCheckpointHeightRequest")
+	proto.RegisterType((*QueryNextCheckpointHeightResponse)(nil), "babylon.checkpointing.v1.QueryNextCheckpointHeightResponse")
+	proto.RegisterType((*QueryCheckpointStatusSummaryRequest)(nil), "babylon.checkpointing.v1.QueryCheckpointStatusSummaryRequest")
+	proto.RegisterType((*QueryCheckpointStatusSummaryResponse)(nil), "babylon.checkpointing.v1.QueryCheckpointStatusSummaryResponse")
+	proto.RegisterType((*CheckpointStatusSummary)(nil), "babylon.checkpointing.v1.CheckpointStatusSummary")
+	proto.RegisterType((*QueryCheckpointAccumulationProgressRequest)(nil), "babylon.checkpointing.v1.QueryCheckpointAccumulationProgressRequest")
+	proto.RegisterType((*QueryCheckpointAccumulationProgressResponse)(nil), "babylon.checkpointing.v1.QueryCheckpointAccumulationProgressResponse")
+	proto.RegisterType((*CheckpointAccumulationProgress)(nil), "babylon.checkpointing.v1.CheckpointAccumulationProgress")
+}
+
+func init() {
+	proto.RegisterFile("babylon/checkpointing/v1/query.proto", fileDescriptor_113f1ca5c3c2ca44)
+}
+
+var fileDescriptor_113f1ca5c3c2ca44 = []byte{
+	// 2785 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x1a, 0x4b, 0x6c, 0x14, 0xc9,
+	0x95, 0x9e, 0xb1, 0x0d, 0x7e, 0xfe, 0x60, 0x17, 0xde, 0xc5, 0x0c, 0x60, 0x43, 0x2f, 0x2c, 0x1f,
+	0xc3, 0x4c, 0x6c, 0xc0, 0x0c, 0x5e, 0xf0, 0xae, 0xc7, 0x76, 0x02, 0x81, 0x05, 0xa7, 0xf9, 0x44,
+	0x24, 0x0a, 0xbd, 0x3d, 0xed, 0xf2, 0x4c, 0xc7, 0x3d, 0xdd, 0x43, 0x77, 0xb5, 0xc1, 0x22, 0x28,
+	0x52, 0x56, 0x8a, 0x72, 0x0b, 0x51, 0xa2, 0xbd, 0xe4, 0x73, 0xcd, 0x21, 0x39, 0x24, 0x52, 0x22,
+	0xe5, 0xb0, 0x97, 0x44, 0x39, 0x90, 0xaf, 0xd8, 0x44, 0x48, 0xc9, 0x46, 0x22, 0x11, 0x44, 0x7b,
+	0xc8, 0x2d, 0xc7, 0xdc, 0xa2, 0xae, 0xaa, 0x9e, 0xe9, 0xee, 0xe9, 0x9e, 0xee, 0x19, 0x4c, 0xa4,
+	0x9c, 0x3c, 0xfd, 0xea, 0xbd, 0x57, 0xef, 0x57, 0xf5, 0x5e, 0xbd, 0x67, 0x38, 0x54, 0x56, 0xca,
+	0x9b, 0xba, 0x69, 0x14, 0xd4, 0x2a, 0x56, 0xd7, 0xeb, 0xa6, 0x66, 0x10, 0xcd, 0xa8, 0x14, 0x36,
+	0xa6, 0x0b, 0x77, 0x1d, 0x6c, 0x6d, 0xe6, 0xeb, 0x96, 0x49, 0x4c, 0x34, 0xce, 0xb1, 0xf2, 0x01,
+	0xac, 0xfc, 0xc6, 0x74, 0x6e, 0xac, 0x62, 0x56, 0x4c, 0x8a, 0x54, 0x70, 0x7f, 0x31, 0xfc, 0xdc,
+	0xbe, 0x8a, 0x69, 0x56, 0x74, 0x5c, 0x50, 0xea, 0x5a, 0x41, 0x31, 0x0c, 0x93, 0x28, 0x44, 0x33,
+	0x0d, 0x9b, 0xaf, 0x4e, 0xf2, 0x55, 0xfa, 0x55, 0x76, 0xd6, 0x0a, 0x44, 0xab, 0x61, 0x9b, 0x28,
+	0xb5, 0x3a, 0x47, 0xd8, 0xa3, 0x9a, 0x76, 0xcd, 0xb4, 0x65, 0xc6, 0x97, 0x7d, 0xf0, 0xa5, 0x37,
+	0x63, 0xe5, 0x2d, 0xeb, 0xb6, 0xbc, 0x8e, 0xb9, 0xc4, 0xb9, 0x63, 0xb1, 0x78, 0x4d, 0x00, 0x47,
+	0x3d, 0x1c, 0x8b, 0x5a, 0x57, 0x2c, 0xa5, 0xe6, 0xed, 0x7c, 0x9c, 0xc9, 0x51, 0x28, 0x2b, 0x36,
+	0x66, 0xc6, 0x29, 0x6c, 0x4c, 0x97, 0x31, 0x51, 0x5c, 0xbc, 0x8a, 0x66, 0x50, 0x15, 0x19, 0xae,
+	0x38, 0x06, 0xe8, 0x73, 0x2e, 0xc6, 0x0a, 0x65, 0x20, 0xe1, 0xbb, 0x0e, 0xb6, 0x89, 0x78, 0x13,
+	0x76, 0x05, 0xa0, 0x76, 0xdd, 0x34, 0x6c, 0x8c, 0xe6, 0xa1, 0x8f, 0x6d, 0x34, 0x2e, 0x1c, 0x10,
+	0x8e, 0x0e, 0xcc, 0x1c, 0xc8, 0xc7, 0x59, 0x3b, 0xcf, 0x28, 0x4b, 0x3d, 0x8f, 0x9f, 0x4d, 0x6e,
+	0x93, 0x38, 0x95, 0xf8, 0x23, 0x01, 0xf6, 0x53, 0xbe, 0x92, 0x72, 0x6f, 0xb1, 0x41, 0x71, 0x45,
+	0xb3, 0x09, 0xdf, 0x18, 0x95, 0xa0, 0xcf, 0x26, 0x0a, 0x71, 0xd8, 0x0e, 0xc3, 0x33, 0xc7, 0xe3,
+	0x77, 0x68, 0x32, 0xb8, 0x4e, 0x29, 0x24, 0x4e, 0x89, 0x3e, 0x0d, 0xd0, 0x54, 0x73, 0x3c, 0x43,
+	0x25, 0x7d, 0x33, 0xcf, 0x7d, 0xe3, 0xda, 0x24, 0xcf, 0x02, 0x86, 0xdb, 0x24, 0xbf, 0xa2, 0x54,
+	0x30, 0xdf, 0x5f, 0xf2, 0x51, 0x8a, 0xbf, 0x13, 0x60, 0x22, 0x4e, 0x5a, 0x6e, 0x90, 0xf7, 0x60,
+	0xa7, 0xa5, 0xdc, 0x93, 0x9b, 0xb2, 0xb9, 0x72, 0x67, 0x8f, 0x0e, 0xcc, 0x9c, 0x8d, 0x97, 0x3b,
+	0xc0, 0xed, 0xf3, 0x1a, 0xa9, 0xbe, 0x8b, 0x89, 0xe2, 0x71, 0x94, 0x86, 0x2d, 0xff, 0xb2, 0x8d,
+	0x3e, 0x13, 0xa1, 0xcc, 0x91, 0x44, 0x65, 0x38, 0x33, 0xbf, 0x36, 0x45, 0xd8, 0xd3, 0xaa, 0x8c,
+	0x67, 0xf6, 0xbd, 0xd0, 0x8f, 0xeb, 0xa6, 0x5a, 0x95, 0x0d, 0xa7, 0x46, 0x2d, 0xdf, 0x23, 0xed,
+	0xa0, 0x80, 0xab, 0x4e, 0x4d, 0xfc, 0x0a, 0xe4, 0xa2, 0x28, 0xb9, 0x09, 0xee, 0xc0, 0x70, 0xd0,
+	0x04, 0x3c, 0x36, 0xba, 0xb6, 0xc0, 0x50, 0xc0, 0x02, 0xe2, 0x6a, 0xd4, 0xee, 0x5e, 0xa0, 0x86,
+	0x7c, 0x2d, 0x74, 0xed, 0xeb, 0xc7, 0x02, 0xec, 0x8d, 0xdc, 0xe6, 0xff, 0xcf, 0xd1, 0xef, 0x0b,
+	0xb0, 0x8f, 0xaa, 0x52, 0xd2, 0xed, 0x15, 0xa7, 0xac, 0x6b, 0xea, 0x65, 0xbc, 0xe9, 0x3f, 0x63,
+	0xed, 0x9c, 0xbd, 0x65, 0x87, 0xe7, 0x8f, 0xde, 0x51, 0x6f, 0x95, 0x82, 0x9b, 0x74, 0x15, 0x76,
+	0x6f, 0x28, 0xba, 0xb6, 0xaa, 0x10, 0xd3, 0x92, 0xef, 0x69, 0xa4, 0x2a, 0xf3, 0x7b, 0xd1, 0x33,
+	0xed, 0xc9, 0x78, 0xd3, 0xde, 0xf2, 0x08, 0x5d, 0xb3, 0x96, 0x74, 0xfb, 0x32, 0xde, 0x94, 0xc6,
+	0x36, 0x5a, 0x81, 0x5b, 0x68, 0x56, 0x19, 0x26, 0x5b, 0xf4, 0x59, 0x20, 0xcb, 0xae, 0xdd, 0x3c,
+	0xc3, 0x4e, 0xc2, 0xc0, 0x86, 0xa2, 0xcb, 0xca, 0xea, 0xaa, 0x85, 0x6d, 0x76, 0x83, 0xf5, 0x4b,
+	0xb0, 0xa1, 0xe8, 0x0b, 0x0c, 0x12, 0xb4, 0x7c, 0x26, 0x74, 0xcc, 0xbe, 0x2e, 0xc0, 0x81, 0xf8,
+	0x1d, 0xb8, 0xd1, 0xca, 0xf0, 0x7a, 0xb4, 0xd1, 0x78, 0xec, 0x77, 0x68, 0xb3, 0x5d, 0x11, 0x36,
+	0x13, 0xbf, 0xc8, 0x8f, 0x42, 0x83, 0xa0, 0xa4, 0xdb, 0xd7, 0xb5, 0x4a, 0xaa, 0xf0, 0x09, 0x99,
+	0x20, 0x13, 0x36, 0x81, 0x78, 0x1b, 0xf6, 0x45, 0x33, 0xe7, 0x0a, 0x9e, 0x83, 0xed, 0xae, 0x46,
+	0xb6, 0x56, 0x49, 0xce, 0x31, 0x9c, 0xb4, 0xaf, 0x4c, 0xff, 0x8a, 0x1a, 0xf7, 0xd0, 0x82, 0xae,
+	0x97, 0x74, 0x5b, 0xc2, 0x15, 0xcd, 0x26, 0x16, 0x4b, 0xe7, 0x5b, 0x7d, 0x5d, 0x7c, 0xe8, 0xf9,
+	0x2a, 0x72, 0x2f, 0xae, 0xca, 0x35, 0x18, 0xb2, 0xfc, 0x0b, 0x3c, 0xac, 0x8f, 0xb5, 0x55, 0xc8,
+	0xcf, 0x4a, 0x0a, 0xd2, 0x6f, 0x5d, 0x2c, 0x7f, 0x5f, 0x80, 0x9d, 0xa1, 0xbd, 0xd0, 0x14, 0x8c,
+	0x36, 0x23, 0x2b, 0x18, 0xc2, 0x23, 0x8d, 0x05, 0x2f, 0x90, 0xbf, 0x04, 0x03, 0xae, 0x97, 0xea,
+	0x4e, 0x99, 0xc6, 0x9e, 0x2b, 0xca, 0x60, 0xe9, 0xc2, 0xc7, 0xcf, 0x26, 0xcf, 0x55, 0x34, 0x52,
+	0x75, 0xca, 0x79, 0xd5, 0xac, 0x15, 0xb8, 0x9a, 0x6a, 0x55, 0xd1, 0x8c, 0x42, 0xa3, 0x72, 0xb1,
+	0x36, 0xeb, 0xc4, 0x74, 0x4b, 0xa0, 0xe9, 0x99, 0x53, 0xc5, 0xe9, 0x7c, 0x23, 0xd2, 0xa5, 0xfe,
+	0x32, 0x8d, 0x7b, 0x37, 0x02, 0x67, 0x61, 0x37, 0xb5, 0x2e, 0x8d, 0x7d, 0x9e, 0xdd, 0xd3, 0x64,
+	0xaa, 0x3b, 0x30, 0xde, 0x4a, 0xc7, 0xbd, 0xb1, 0x05, 0x95, 0x85, 0xb8, 0x0c, 0x22, 0x4b, 0x12,
+	0x58, 0xc5, 0x06, 0xf1, 0xed, 0xb2, 0x68, 0x3a, 0xcd, 0x64, 0x3a, 0x09, 0x03, 0x4c, 0x44, 0xd5,
+	0x85, 0x72, 0x21, 0x81, 0x82, 0x28, 0x9e, 0xf8, 0x41, 0x06, 0xde, 0x68, 0xcb, 0x87, 0x8b, 0xbc,
+	0x17, 0xfa, 0x89, 0x56, 0x97, 0x29, 0xa5, 0xa7, 0x2b, 0xd1, 0xea, 0x14, 0x3f, 0xbc, 0x4b, 0x26,
+	0xbc, 0x0b, 0xba, 0x0b, 0x83, 0x4c, 0x6c, 0x8e, 0x91, 0xa5, 0xd1, 0x77, 0x35, 0x5e, 0xed, 0x14,
+	0x22, 0xe5, 0x7d, 0xb0, 0x65, 0x83, 0x58, 0x9b, 0xd2, 0x80, 0xdd, 0x84, 0xe4, 0xe6, 0x61, 0x24,
+	0x8c, 0x80, 0x46, 0x20, 0xeb, 0x5d, 0x4f, 0xfd, 0x92, 0xfb, 0x13, 0x8d, 0x41, 0xef, 0x86, 0xa2,
+	0x3b, 0x98, 0xcb, 0xcc, 0x3e, 0xe6, 0x32, 0x45, 0x41, 0xfc, 0x32, 0x1c, 0xa2, 0x42, 0x5c, 0x51,
+	0x6c, 0x12, 0x4c, 0x9d, 0xc1, 0x20, 0xd8, 0x0a, 0x5f, 0x7e, 0x15, 0x0e, 0x27, 0xec, 0xc5, 0xbd,
+	0x70, 0x2b, 0xa6, 0xc0, 0x29, 0xa4, 0xcc, 0xfc, 0x71, 0x85, 0xcd, 0x24, 0x4f, 0x90, 0x8b, 0x8e,
+	0x65, 0x61, 0x83, 0xb4, 0x14, 0x65, 0xe2, 0x6f, 0xbd, 0xfa, 0x33, 0x02, 0xe3, 0x7f, 0x53, 0x7c,
+	0xb9, 0x41, 0x46, 0x4c, 0xa2, 0xe8, 0x72, 0xdd, 0xbc, 0x87, 0x2d, 0x2f, 0xc8, 0x28, 0x68, 0xc5,
+	0x85, 0xa0, 0x23, 0xb0, 0x93, 0x54, 0x2d, 0x6c, 0x57, 0x4d, 0x7d, 0x95, 0x23, 0x65, 0x29, 0xd2,
+	0x70, 0x03, 0x4c, 0x11, 0xc5, 0x1f, 0x78, 0xf5, 0xc0, 0x2d, 0x6c, 0x69, 0x6b, 0x6e, 0x8e, 0x7b,
+	0xd7, 0xd1, 0x89, 0x96, 0x36, 0xaf, 0x1c, 0x82, 0xe1, 0xb2, 0x6e, 0xaa, 0xeb, 0x72, 0x55, 0xb1,
+	0xab, 0x72, 0x15, 0xdf, 0xe7, 0xa9, 0x65, 0x90, 0x42, 0x2f, 0x2a, 0x76, 0xf5, 0x22, 0xbe, 0x8f,
+	0x5e, 0x87, 0xbe, 0xb2, 0x46, 0x6a, 0x4a, 0x9d, 0x0a, 0x31, 0x28, 0xf1, 0x2f, 0x24, 0xc2, 0x90,
+	0x7b, 0x5d, 0xd5, 0xdc, 0x1d, 0x69, 0x6a, 0xe9, 0xa1, 0xcb, 0x03, 0xe5, 0xa6, 0x14, 0xe2, 0x77,
+	0x3d, 0x6b, 0x47, 0x08, 0xc8, 0xad, 0xcd, 0x02, 0x57, 0x5b, 0xa5, 0xd2, 0xed, 0x90, 0xd8, 0x87,
+	0x2b, 0x37, 0x55, 0x5c, 0xb6, 0x9b, 0x49, 0x9d, 0x02, 0xae, 0xb3, 0x7c, 0xe8, 0x37, 0x60, 0xb6,
+	0xc5, 0x80, 0x87, 0x61, 0x58, 0x33, 0x28, 0x23, 0xd9, 0xc2, 0x8a, 0x6d, 0x1a, 0x54, 0xb6, 0x7e,
+	0x69, 0x88, 0x43, 0x25, 0x0a, 0x14, 0x6f, 0x07, 0xac, 0x17, 0x51, 0x08, 0xef, 0x07, 0x58, 0xb3,
+	0xcc, 0x5a, 0xe0, 0xb2, 0xe8, 0x77, 0x21, 0xec, 0xb6, 0xd8, 0x03, 0x3b, 0x88, 0xc9, 0x17, 0x99,
+	0x8c, 0xdb, 0x89, 0x49, 0x97, 0x44, 0x0b, 0x26, 0xe2, 0x58, 0x73, 0xbd, 0x57, 0x60, 0xbb, 0x85,
+	0x6d, 0x47, 0x6f, 0x14, 0xbd, 0xb3, 0x69, 0xce, 0x1b, 0xe5, 0xa7, 0xa9, 0x2c, 0x93, 0x51, 0x72,
+	0xc9, 0x63, 0x23, 0x3e, 0xca, 0xc0, 0xbe, 0x76, 0x98, 0xed, 0x83, 0xa1, 0x79, 0xfc, 0x33, 0x5d,
+	0x3f, 0x12, 0x1b, 0xbe, 0xcc, 0xc6, 0xfa, 0xb2, 0xa7, 0xbd, 0x2f, 0x7b, 0x53, 0xf8, 0xb2, 0x2f,
+	0xc2, 0x97, 0xee, 0xd6, 0x6b, 0xa6, 0x63, 0xac, 0x8e, 0x6f, 0x67, 0x5b, 0xd3, 0x0f, 0xf1, 0xbc,
+	0x77, 0x1d, 0x34, 0x25, 0xd6, 0x2a, 0x06, 0xb6, 0xd2, 0x65, 0xbe, 0x1f, 0x37, 0xee, 0x8a, 0x56,
+	0x72, 0xee, 0xc5, 0x25, 0xd8, 0x6e, 0x33, 0x10, 0xf7, 0x62, 0x3a, 0xb3, 0x51, 0x12, 0xc9, 0x23,
+	0x45, 0x6f, 0xc0, 0x10, 0xff, 0x19, 0xb8, 0x13, 0x06, 0x39, 0x90, 0x19, 0x22, 0x29, 0xea, 0xc5,
+	0x35, 0x38, 0x1e, 0x92, 0x76, 0x45, 0xb1, 0x88, 0xa6, 0x6a, 0x75, 0x1a, 0x04, 0x17, 0x35, 0x9b,
+	0x98, 0xd6, 0xa6, 0xa7, 0x79, 0xf7, 0xb1, 0xfd, 0x0d, 0x01, 0xa6, 0x52, 0x6d, 0xc4, 0x6d, 0x74,
+	0x1b, 0x86, 0xeb, 0xfe, 0x75, 0xcf, 0x54, 0xd3, 0x69, 0x4c, 0x15, 0xe0, 0x2c, 0x85, 0x18, 0x89,
+	0xff, 0xca, 0xc0, 0xee, 0x18, 0xdc, 0x57, 0x1f, 0xed, 0x93, 0x30, 0x60, 0x38, 0x35, 0xd9, 0xf3,
+	0x3f, 0x77, 0x88, 0xe1, 0xd4, 0x78, 0x90, 0xb8, 0xa1, 0xeb, 0x22, 0x34, 0x0a, 0x3d, 0x9b, 0x47,
+	0xff, 0x90, 0xe1, 0xd4, 0x1a, 0xa5, 0x7a, 0x84, 0xf7, 0x7b, 0x93, 0xbd, 0xdf, 0xd7, 0x72, 0x4e,
+	0xde, 0x03, 0x14, 0x30, 0x8e, 0x6c, 0x29, 0x04, 0xd3, 0xd3, 0xd0, 0x5f, 0x9a, 0x76, 0x1b, 0x46,
+	0x1f, 0x3f, 0x9b, 0xdc, 0xcb, 0xca, 0x5a, 0x7b, 0x75, 0x3d, 0xaf, 0x99, 0x85, 0x9a, 0x42, 0xaa,
+	0xf9, 0x2b, 0xb8, 0xa2, 0xa8, 0x9b, 0x4b, 0x58, 0xfd, 0xd3, 0xcf, 0x4e, 0x02, 0x5b, 0xce, 0x2f,
+	0x61, 0x55, 0x1a, 0x0d, 0x30, 0x93, 0x14, 0x82, 0xc5, 0x63, 0x70, 0x84, 0x27, 0x77, 0x82, 0x6d,
+	0x12, 0x34, 0x0b, 0xbe, 0x59, 0x5f, 0x55, 0x88, 0x57, 0xd6, 0x8b, 0x3f, 0xcc, 0xc0, 0xd1, 0x64,
+	0xdc, 0x66, 0x45, 0x16, 0xef, 0xa8, 0x79, 0xe8, 0x71, 0x83, 0xb2, 0x0b, 0x37, 0x51, 0x3a, 0x34,
+	0x07, 0x19, 0x62, 0x8e, 0x67, 0x3b, 0xa6, 0xce, 0x10, 0x13, 0x1d, 0x84, 0x41, 0x9e, 0x1f, 0xb1,
+	0x56, 0xa9, 0x12, 0xee, 0xbd, 0x01, 0x96, 0x1d, 0x29, 0x08, 0xbd, 0x0d, 0xc0, 0x50, 0x88, 0x56,
+	0xc3, 0xd4, 0x71, 0x03, 0x33, 0xb9, 0x3c, 0x6b, 0x70, 0xe6, 0xbd, 0x06, 0x67, 0xfe, 0x86, 0xd7,
+	0xe0, 0x2c, 0xf5, 0x3c, 0xfa, 0xfb, 0xa4, 0xe0, 0x56, 0xe5, 0xa6, 0xba, 0xee, 0x42, 0xc5, 0x4b,
+	0x30, 0x12, 0xbe, 0x17, 0x92, 0x9f, 0xbc, 0x63, 0xd0, 0xdb, 0xbc, 0x27, 0xb2, 0x12, 0xfb, 0x10,
+	0x9f, 0x0a, 0xf0, 0x5a, 0x74, 0x3b, 0xe9, 0x15, 0x56, 0x01, 0x4a, 0x64, 0x15, 0xd0, 0xdd, 0xb3,
+	0xc5, 0x55, 0x5f, 0x21, 0x8e, 0x85, 0x83, 0x45, 0xc4, 0x27, 0x02, 0xec, 0x6f, 0x1f, 0x41, 0xef,
+	0x40, 0xaf, 0x7b, 0x26, 0x71, 0x17, 0x95, 0x2b, 0x23, 0x74, 0x4d, 0xce, 0xeb, 0xfa, 0x55, 0x6c,
+	0xab, 0xde, 0x13, 0x9b, 0x81, 0x96, 0xb0, 0xad, 0xb6, 0xc4, 0x42, 0x36, 0x29, 0x16, 0x7a, 0x3a,
+	0x8f, 0x85, 0xef, 0x65, 0x61, 0x7f, 0xdb, 0x4a, 0x12, 0x2d, 0x42, 0x8f, 0xba, 0x5e, 0xef, 0xba,
+	0x58, 0xa6, 0xc4, 0x5b, 0x75, 0xf7, 0xf9, 0xed, 0x95, 0x6d, 0xb1, 0x17, 0x7f, 0xcc, 0x2a, 0x95,
+	0x8a, 0x25, 0xd7, 0xd7, 0xc7, 0x7b, 0xb6, 0xea, 0x31, 0xbb, 0x50, 0xa9, 0x58, 0x2b, 0xeb, 0xc1,
+	0x9a, 0xa2, 0x37, 0x54, 0x53, 0xdc, 0x84, 0x7e, 0x5d, 0x5b, 0xc3, 0xea, 0xa6, 0xaa, 0xe3, 0xf1,
+	0xbe, 0xa4, 0x8e, 0x62, 0xdb, 0xd0, 0x92, 0x9a, 0x9c, 0xc4, 0x9b, 0xbc, 0x1a, 0x70, 0x45, 0xc0,
+	0x15, 0x85, 0xe0, 0x92, 0xf7, 0xb6, 0x4e, 0x55, 0x6d, 0x37, 0x4f, 0x50, 0xc6, 0x7f, 0x82, 0xc4,
+	0x8f, 0x04, 0x98, 0x8c, 0xe5, 0xcb, 0xfd, 0x5e, 0x87, 0xd7, 0x14, 0x6f, 0x55, 0xf6, 0x37, 0x09,
+	0x84, 0xad, 0xb0, 0x2b, 0x52, 0x5a, 0x76, 0x0e, 0x27, 0xb7, 0x4c, 0x4b, 0x72, 0x0b, 0x78, 0x20,
+	0x1b, 0xf4, 0x80, 0x28, 0xf2, 0x4e, 0xce, 0x55, 0x7c, 0xdf, 0x77, 0xf9, 0xb3, 0x73, 0xe2, 0xe5,
+	0x88, 0x6f, 0x65, 0xe0, 0x60, 0x1b, 0xa4, 0x34, 0x57, 0xd7, 0x41, 0x18, 0x64, 0x8b, 0x3a, 0x36,
+	0x2a, 0xc4, 0x2b, 0x54, 0xd8, 0x13, 0xfe, 0x0a, 0x05, 0xa1, 0x13, 0x80, 0xd6, 0x34, 0xcb, 0x26,
+	0x72, 0xc4, 0xe9, 0x1d, 0xa1, 0x2b, 0x25, 0xdf, 0x11, 0x3e, 0x0e, 0xa3, 0xba, 0x12, 0x46, 0x66,
+	0xd7, 0xfe, 0x4e, 0x5d, 0x09, 0xe2, 0x4e, 0xc1, 0x68, 0x33, 0x96, 0x3c, 0x5c, 0x16, 0x8a, 0x23,
+	0x6a, 0x48, 0x1d, 0xb7, 0x14, 0x50, 0xd9, 0x83, 0xd3, 0xc3, 0x64, 0x19, 0x7c, 0x88, 0x43, 0x19,
+	0x9a, 0x78, 0x98, 0xf7, 0x30, 0xc2, 0xe7, 0xee, 0xba, 0x53, 0xab, 0x29, 0x8d, 0xda, 0x4d, 0xfc,
+	0x8e, 0x00, 0x87, 0xda, 0xe3, 0xa5, 0x69, 0x76, 0x5c, 0x83, 0x7e, 0x9b, 0xe2, 0x6b, 0xd8, 0x75,
+	0x70, 0xea, 0x92, 0x2c, 0xb8, 0x55, 0x93, 0x87, 0xf8, 0x81, 0x00, 0xbb, 0x63, 0xd0, 0xb6, 0x64,
+	0x06, 0x35, 0x06, 0xbd, 0xfe, 0xbe, 0x0c, 0xfb, 0x70, 0x83, 0x40, 0xa7, 0x55, 0x06, 0x57, 0x93,
+	0xdf, 0xcc, 0x0c, 0xc6, 0x2a, 0xd6, 0x4b, 0x2d, 0x95, 0xf1, 0x82, 0xaa, 0x3a, 0x35, 0x47, 0xa7,
+	0xc5, 0xcd, 0x8a, 0x65, 0x56, 0xdc, 0xb4, 0x9a, 0xea, 0x4d, 0xf0, 0x7e, 0x6b, 0xf1, 0x1b, 0xcd,
+	0x8b, 0x7b, 0xe0, 0x06, 0xec, 0xa8, 0x73, 0x18, 0xbf, 0xb5, 0x8b, 0x69, 0x34, 0x8f, 0xe4, 0xd9,
+	0xe0, 0x24, 0xfe, 0x3c, 0x0b, 0x13, 0xed, 0x91, 0x5f, 0x7d, 0xf9, 0x3b, 0x05, 0xa3, 0x8a, 0xb7,
+	0x31, 0x0e, 0xf6, 0x29, 0x46, 0x7c, 0x0b, 0x91, 0xe5, 0x6b, 0x4f, 0x4b, 0xf9, 0x7a, 0x07, 0x46,
+	0x6d, 0xac, 0xe8, 0x9a, 0x51, 0x91, 0x1b, 0x4d, 0x8e, 0xf1, 0xde, 0x6e, 0xab, 0xd7, 0x11, 0xce,
+	0xeb, 0x86, 0xc7, 0x8a, 0x16, 0xd9, 0x9c, 0xbf, 0xbf, 0x82, 0x1e, 0xe4, 0x40, 0x26, 0x84, 0x0c,
+	0xa8, 0x8e, 0x2d, 0x15, 0x1b, 0x44, 0xa9, 0x60, 0x99, 0x98, 0xb2, 0xbb, 0xda, 0x7d, 0x0d, 0x3d,
+	0xd2, 0x64, 0x76, 0xc3, 0xbc, 0x8e, 0x15, 0x7d, 0xe6, 0xc9, 0x41, 0xe8, 0xa5, 0xd1, 0x83, 0xbe,
+	0x29, 0x40, 0x1f, 0x1b, 0xe7, 0xa2, 0x13, 0x09, 0xdd, 0xc3, 0xc0, 0x14, 0x39, 0x77, 0x32, 0x25,
+	0x36, 0x8b, 0x3f, 0xf1, 0xe8, 0xd7, 0xfe, 0xfc, 0xcf, 0x6f, 0x67, 0x44, 0x74, 0xa0, 0x90, 0x30,
+	0xe6, 0x46, 0xbf, 0x12, 0x60, 0xb4, 0x65, 0x28, 0x8b, 0xce, 0x26, 0x6c, 0x17, 0x37, 0x74, 0xce,
+	0x15, 0x3b, 0x27, 0xe4, 0x22, 0xcf, 0x51, 0x91, 0x4f, 0xa3, 0x99, 0x78, 0x91, 0x43, 0x63, 0xc3,
+	0xc2, 0x03, 0x16, 0x93, 0x0f, 0xd1, 0x2f, 0x04, 0x18, 0x0a, 0x70, 0x46, 0xa7, 0x3a, 0x91, 0xc3,
+	0x13, 0xfe, 0x74, 0x67, 0x44, 0x5c, 0xf0, 0xf3, 0x54, 0xf0, 0x59, 0x74, 0x3a, 0xad, 0xe0, 0x85,
+	0x07, 0x8d, 0x23, 0xfa, 0x10, 0xfd, 0x44, 0x80, 0x61, 0x29, 0x38, 0xbe, 0xec, 0x48, 0x8c, 0x46,
+	0x84, 0x9c, 0xe9, 0x90, 0x8a, 0x4b, 0x3f, 0x4d, 0xa5, 0x9f, 0x42, 0xc7, 0x52, 0x9b, 0xdd, 0x0d,
+	0x99, 0x91, 0xf0, 0x28, 0x12, 0xcd, 0x26, 0x6c, 0x1f, 0x33, 0x41, 0xcd, 0x9d, 0xed, 0x98, 0x8e,
+	0x0b, 0x7e, 0x81, 0x0a, 0x7e, 0x16, 0x9d, 0x29, 0xb4, 0xfd, 0xe7, 0x90, 0x3a, 0x25, 0xa6, 0xb3,
+	0xd0, 0x80, 0xdd, 0xff, 0x2a, 0xc0, 0xae, 0x88, 0xe9, 0x20, 0x3a, 0xd7, 0x81, 0x3c, 0xc1, 0x99,
+	0x65, 0x6e, 0xae, 0x1b, 0x52, 0xae, 0xcd, 0x65, 0xaa, 0xcd, 0x32, 0x5a, 0xec, 0x4a, 0x9b, 0xc2,
+	0x03, 0xdf, 0xcb, 0xf1, 0x21, 0xfa, 0x83, 0x00, 0x3b, 0x43, 0x43, 0x41, 0x94, 0x14, 0x1e, 0xd1,
+	0x13, 0xca, 0xdc, 0x6c, 0xa7, 0x64, 0xe9, 0xf5, 0xa1, 0xe2, 0x07, 0xd5, 0xe0, 0xe3, 0x4a, 0x3b,
+	0xa4, 0xcf, 0x2f, 0x05, 0xd8, 0x15, 0x31, 0x1d, 0x4c, 0xf4, 0x55, 0xfc, 0xf4, 0x32, 0x37, 0xd7,
+	0x0d, 0x29, 0xd7, 0xed, 0x14, 0xd5, 0xed, 0x24, 0x9a, 0x6a, 0xef, 0xab, 0xe0, 0xc0, 0xf1, 0xa7,
+	0x02, 0x0c, 0xf8, 0x46, 0x41, 0x68, 0x3a, 0x41, 0x80, 0xd6, 0x79, 0x5d, 0x6e, 0xa6, 0x13, 0x12,
+	0x2e, 0xeb, 0x5b, 0x54, 0xd6, 0x33, 0xe8, 0x54, 0x47, 0x7e, 0xe0, 0xb9, 0xfe, 0xf7, 0x02, 0xbc,
+	0x1e, 0x3d, 0xc4, 0x42, 0xe7, 0xbb, 0x9c, 0x7d, 0x31, 0x4d, 0x2e, 0xbc, 0xd4, 0xe4, 0x4c, 0x3c,
+	0x43, 0x95, 0x2a, 0xa0, 0x93, 0x49, 0x4a, 0xcd, 0xf9, 0xa7, 0x76, 0xe8, 0x6f, 0x02, 0x8c, 0xc7,
+	0x8d, 0xa8, 0xd0, 0x7c, 0x82, 0x48, 0x09, 0x73, 0xb4, 0xdc, 0xdb, 0x5d, 0xd3, 0x73, 0xa5, 0xe6,
+	0xa9, 0x52, 0x45, 0x34, 0x1b, 0xaf, 0x14, 0x7d, 0xa4, 0x84, 0x73, 0x89, 0x97, 0x03, 0x3f, 0x14,
+	0x60, 0xb4, 0x65, 0xba, 0x95, 0x98, 0xc8, 0xe3, 0x26, 0x66, 0xb9, 0x62, 0xe7, 0x84, 0x5c, 0x91,
+	0xd3, 0x54, 0x91, 0x3c, 0x3a, 0x11, 0xaf, 0x88, 0xf7, 0x28, 0x6a, 0x2e, 0xa0, 0x8f, 0x04, 0x18,
+	0x6d, 0x19, 0x17, 0x25, 0x8a, 0x1f, 0x37, 0x01, 0xcb, 0x15, 0x3b, 0x27, 0xe4, 0xe2, 0x5f, 0xa2,
+	0xe2, 0x2f, 0xa2, 0x85, 0x8e, 0x4e, 0xcc, 0x06, 0xe5, 0x27, 0x07, 0x9a, 0x62, 0xd4, 0x25, 0x2d,
+	0xa3, 0xa0, 0x94, 0x3a, 0x45, 0x64, 0xf8, 0x62, 0xe7, 0x84, 0xe9, 0x5d, 0xc2, 0x15, 0xf0, 0xe7,
+	0xf9, 0x5f, 0xbb, 0x11, 0x15, 0x9e, 0x81, 0x24, 0x47, 0x54, 0xcc, 0xd0, 0x25, 0x57, 0xec, 0x9c,
+	0x30, 0x7d, 0x85, 0x15, 0x75, 0x89, 0x71, 0x81, 0xff, 0x2d, 0xc0, 0x44, 0xcc, 0xb4, 0x80, 0xcf,
+	0x2c, 0xd0, 0x52, 0x6a, 0xd1, 0xda, 0xcc, 0x56, 0x72, 0xcb, 0x2f, 0xc9, 0x85, 0x6b, 0x5b, 0xa2,
+	0xda, 0x9e, 0x47, 0x73, 0x85, 0x14, 0xff, 0xcd, 0x2a, 0x07, 0xbb, 0xff, 0x55, 0xae, 0xd0, 0x27,
+	0x02, 0xec, 0x6d, 0xd3, 0x84, 0x47, 0x0b, 0x89, 0xb7, 0x55, 0x52, 0xb3, 0x3f, 0x57, 0x7a, 0x19,
+	0x16, 0x5c, 0xd5, 0x77, 0xa8, 0xaa, 0x73, 0xa8, 0xd8, 0xee, 0xce, 0xa3, 0x8f, 0x7c, 0x9f, 0xc6,
+	0xb4, 0x75, 0x2b, 0x3b, 0x4c, 0x91, 0xa7, 0x02, 0xa0, 0xd6, 0x0e, 0x1a, 0x4a, 0x8a, 0xb5, 0xd8,
+	0x66, 0x5e, 0xee, 0x5c, 0x17, 0x94, 0x5c, 0x9b, 0xcf, 0x52, 0x6d, 0x96, 0x50, 0xa9, 0xa3, 0x30,
+	0x8d, 0xec, 0xf0, 0xa1, 0xdf, 0x08, 0x30, 0x16, 0xd5, 0x21, 0x43, 0x49, 0x85, 0x4b, 0x9b, 0xde,
+	0x5b, 0xee, 0xad, 0xae, 0x68, 0xb9, 0x76, 0x45, 0xaa, 0xdd, 0x0c, 0xfa, 0x54, 0xbc, 0x76, 0x06,
+	0xbe, 0x1f, 0xf0, 0x14, 0xeb, 0x79, 0xa1, 0xa7, 0x6d, 0x1a, 0x44, 0x17, 0xd2, 0x5f, 0x0a, 0x11,
+	0x2d, 0xb1, 0xdc, 0x7c, 0xb7, 0xe4, 0xe9, 0xcb, 0xa3, 0x50, 0xe4, 0x39, 0xb6, 0x6c, 0x73, 0xd9,
+	0xff, 0x23, 0x24, 0xb6, 0x63, 0xd2, 0x5f, 0x2c, 0x6d, 0x5a, 0x53, 0xb9, 0xe5, 0x97, 0xe4, 0xf2,
+	0x72, 0xf1, 0xe9, 0x63, 0x29, 0x7b, 0xad, 0xa8, 0xd2, 0xb5, 0xc7, 0xcf, 0x27, 0x84, 0x27, 0xcf,
+	0x27, 0x84, 0x7f, 0x3c, 0x9f, 0x10, 0x1e, 0xbd, 0x98, 0xd8, 0xf6, 0xe4, 0xc5, 0xc4, 0xb6, 0xbf,
+	0xbc, 0x98, 0xd8, 0xf6, 0x85, 0x33, 0x49, 0x1d, 0xe9, 0xfb, 0xa1, 0x6d, 0xc9, 0x66, 0x1d, 0xdb,
+	0xe5, 0x3e, 0x3a, 0x2a, 0x39, 0xf5, 0xdf, 0x01, 0x00, 0x02, 0x5b, 0x96, 0x49, 0x9b, 0x30, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// RawCheckpointList queries all checkpoints that match the given status.
+	RawCheckpointList(ctx context.Context, in *QueryRawCheckpointListRequest, opts ...grpc.CallOption) (*QueryRawCheckpointListResponse, error)
+	// RawCheckpoint queries a checkpoints at a given epoch number.
+	RawCheckpoint(ctx context.Context, in *QueryRawCheckpointRequest, opts ...grpc.CallOption) (*QueryRawCheckpointResponse, error)
+	// RawCheckpoints queries checkpoints for a epoch range specified in pagination params.
+	RawCheckpoints(ctx context.Context, in *QueryRawCheckpointsRequest, opts ...grpc.CallOption) (*QueryRawCheckpointsResponse, error)
+	// BlsPublicKeyList queries a list of bls public keys of the validators at a
+	// given epoch number.
+	BlsPublicKeyList(ctx context.Context, in *QueryBlsPublicKeyListRequest, opts ...grpc.CallOption) (*QueryBlsPublicKeyListResponse, error)
+	// BlsPublicKeyAtEpoch queries the bls public key that a validator used at a
+	// given epoch number.
+	BlsPublicKeyAtEpoch(ctx context.Context, in *QueryBlsPublicKeyAtEpochRequest, opts ...grpc.CallOption) (*QueryBlsPublicKeyAtEpochResponse, error)
+	// ValidatorBlsSig queries the individual BLS sig that a validator
+	// contributed to the sealed checkpoint of a given epoch, if retained
+	ValidatorBlsSig(ctx context.Context, in *QueryValidatorBlsSigRequest, opts ...grpc.CallOption) (*QueryValidatorBlsSigResponse, error)
+	// AllBlsRegistrations queries all registered BLS public keys together with
+	// the validators that registered them, independent of any epoch's
+	// validator set
+	AllBlsRegistrations(ctx context.Context, in *QueryAllBlsRegistrationsRequest, opts ...grpc.CallOption) (*QueryAllBlsRegistrationsResponse, error)
+	// EpochStatus queries the status of the checkpoint at a given epoch
+	EpochStatus(ctx context.Context, in *QueryEpochStatusRequest, opts ...grpc.CallOption) (*QueryEpochStatusResponse, error)
+	// RecentEpochStatusCount queries the number of epochs with each status in
+	// recent epochs
+	RecentEpochStatusCount(ctx context.Context, in *QueryRecentEpochStatusCountRequest, opts ...grpc.CallOption) (*QueryRecentEpochStatusCountResponse, error)
+	// LastCheckpointWithStatus queries the last checkpoint with a given status or
+	// a more matured status
+	LastCheckpointWithStatus(ctx context.Context, in *QueryLastCheckpointWithStatusRequest, opts ...grpc.CallOption) (*QueryLastCheckpointWithStatusResponse, error)
+	// CurrentCheckpoint queries the checkpoint that is currently accumulating
+	// BLS signatures, together with its progress towards being sealed
+	CurrentCheckpoint(ctx context.Context, in *QueryCurrentCheckpointRequest, opts ...grpc.CallOption) (*QueryCurrentCheckpointResponse, error)
+	// VerifyBlsMultiSig verifies a BLS multi-signature on the given epoch and
+	// block hash against the validator set of the epoch without changing state
+	VerifyBlsMultiSig(ctx context.Context, in *QueryVerifyBlsMultiSigRequest, opts ...grpc.CallOption) (*QueryVerifyBlsMultiSigResponse, error)
+	// VerifyCheckpoints re-verifies the stored checkpoints of the given
+	// epoch range without changing state
+	VerifyCheckpoints(ctx context.Context, in *QueryVerifyCheckpointsRequest, opts ...grpc.CallOption) (*QueryVerifyCheckpointsResponse, error)
+	// CheckpointSigners queries the validators who signed the checkpoint of
+	// the given epoch, as indicated by the checkpoint's bitmap
+	CheckpointSigners(ctx context.Context, in *QueryCheckpointSignersRequest, opts ...grpc.CallOption) (*QueryCheckpointSignersResponse, error)
+	// CheckpointParticipationHistory queries, for each epoch in the given
+	// range, the fraction of the voting power that contributed a BLS signature
+	// to the epoch's checkpoint, as indicated by the checkpoint's bitmap
+	CheckpointParticipationHistory(ctx context.Context, in *QueryCheckpointParticipationHistoryRequest, opts ...grpc.CallOption) (*QueryCheckpointParticipationHistoryResponse, error)
+	// LatestCheckpointStateUpdate queries the checkpoint status transition that
+	// occurred most recently across all epochs
+	LatestCheckpointStateUpdate(ctx context.Context, in *QueryLatestCheckpointStateUpdateRequest, opts ...grpc.CallOption) (*QueryLatestCheckpointStateUpdateResponse, error)
+	// AggregateBlsPubKey queries the aggregate BLS public key of the subset of
+	// the given epoch's validator set indicated by the given bitmap
+	AggregateBlsPubKey(ctx context.Context, in *QueryAggregateBlsPubKeyRequest, opts ...grpc.CallOption) (*QueryAggregateBlsPubKeyResponse, error)
+	// NextCheckpointHeight queries the length of the current epoch and the
+	// height at which the current epoch's checkpoint will be built
+	NextCheckpointHeight(ctx context.Context, in *QueryNextCheckpointHeightRequest, opts ...grpc.CallOption) (*QueryNextCheckpointHeightResponse, error)
+	// CheckpointStatusSummary queries the number of checkpoints in each status
+	// and the latest epoch in each status
+	CheckpointStatusSummary(ctx context.Context, in *QueryCheckpointStatusSummaryRequest, opts ...grpc.CallOption) (*QueryCheckpointStatusSummaryResponse, error)
+	// CheckpointAccumulationProgress queries the progress of the checkpoint of
+	// the given epoch towards being sealed, i.e., the voting power of the BLS
+	// signatures accumulated so far relative to the sealing threshold
+	CheckpointAccumulationProgress(ctx context.Context, in *QueryCheckpointAccumulationProgressRequest, opts ...grpc.CallOption) (*QueryCheckpointAccumulationProgressResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RawCheckpointList(ctx context.Context, in *QueryRawCheckpointListRequest, opts ...grpc.CallOption) (*QueryRawCheckpointListResponse, error) {
+	out := new(QueryRawCheckpointListResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/RawCheckpointList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RawCheckpoint(ctx context.Context, in *QueryRawCheckpointRequest, opts ...grpc.CallOption) (*QueryRawCheckpointResponse, error) {
+	out := new(QueryRawCheckpointResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/RawCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RawCheckpoints(ctx context.Context, in *QueryRawCheckpointsRequest, opts ...grpc.CallOption) (*QueryRawCheckpointsResponse, error) {
+	out := new(QueryRawCheckpointsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/RawCheckpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlsPublicKeyList(ctx context.Context, in *QueryBlsPublicKeyListRequest, opts ...grpc.CallOption) (*QueryBlsPublicKeyListResponse, error) {
+	out := new(QueryBlsPublicKeyListResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/BlsPublicKeyList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlsPublicKeyAtEpoch(ctx context.Context, in *QueryBlsPublicKeyAtEpochRequest, opts ...grpc.CallOption) (*QueryBlsPublicKeyAtEpochResponse, error) {
+	out := new(QueryBlsPublicKeyAtEpochResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/BlsPublicKeyAtEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorBlsSig(ctx context.Context, in *QueryValidatorBlsSigRequest, opts ...grpc.CallOption) (*QueryValidatorBlsSigResponse, error) {
+	out := new(QueryValidatorBlsSigResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/ValidatorBlsSig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllBlsRegistrations(ctx context.Context, in *QueryAllBlsRegistrationsRequest, opts ...grpc.CallOption) (*QueryAllBlsRegistrationsResponse, error) {
+	out := new(QueryAllBlsRegistrationsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/AllBlsRegistrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochStatus(ctx context.Context, in *QueryEpochStatusRequest, opts ...grpc.CallOption) (*QueryEpochStatusResponse, error) {
+	out := new(QueryEpochStatusResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/EpochStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecentEpochStatusCount(ctx context.Context, in *QueryRecentEpochStatusCountRequest, opts ...grpc.CallOption) (*QueryRecentEpochStatusCountResponse, error) {
+	out := new(QueryRecentEpochStatusCountResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/RecentEpochStatusCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LastCheckpointWithStatus(ctx context.Context, in *QueryLastCheckpointWithStatusRequest, opts ...grpc.CallOption) (*QueryLastCheckpointWithStatusResponse, error) {
+	out := new(QueryLastCheckpointWithStatusResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/LastCheckpointWithStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CurrentCheckpoint(ctx context.Context, in *QueryCurrentCheckpointRequest, opts ...grpc.CallOption) (*QueryCurrentCheckpointResponse, error) {
+	out := new(QueryCurrentCheckpointResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/CurrentCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyBlsMultiSig(ctx context.Context, in *QueryVerifyBlsMultiSigRequest, opts ...grpc.CallOption) (*QueryVerifyBlsMultiSigResponse, error) {
+	out := new(QueryVerifyBlsMultiSigResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/VerifyBlsMultiSig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyCheckpoints(ctx context.Context, in *QueryVerifyCheckpointsRequest, opts ...grpc.CallOption) (*QueryVerifyCheckpointsResponse, error) {
+	out := new(QueryVerifyCheckpointsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/VerifyCheckpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckpointSigners(ctx context.Context, in *QueryCheckpointSignersRequest, opts ...grpc.CallOption) (*QueryCheckpointSignersResponse, error) {
+	out := new(QueryCheckpointSignersResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/CheckpointSigners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckpointParticipationHistory(ctx context.Context, in *QueryCheckpointParticipationHistoryRequest, opts ...grpc.CallOption) (*QueryCheckpointParticipationHistoryResponse, error) {
+	out := new(QueryCheckpointParticipationHistoryResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/CheckpointParticipationHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LatestCheckpointStateUpdate(ctx context.Context, in *QueryLatestCheckpointStateUpdateRequest, opts ...grpc.CallOption) (*QueryLatestCheckpointStateUpdateResponse, error) {
+	out := new(QueryLatestCheckpointStateUpdateResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/LatestCheckpointStateUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AggregateBlsPubKey(ctx context.Context, in *QueryAggregateBlsPubKeyRequest, opts ...grpc.CallOption) (*QueryAggregateBlsPubKeyResponse, error) {
+	out := new(QueryAggregateBlsPubKeyResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/AggregateBlsPubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NextCheckpointHeight(ctx context.Context, in *QueryNextCheckpointHeightRequest, opts ...grpc.CallOption) (*QueryNextCheckpointHeightResponse, error) {
+	out := new(QueryNextCheckpointHeightResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/NextCheckpointHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckpointStatusSummary(ctx context.Context, in *QueryCheckpointStatusSummaryRequest, opts ...grpc.CallOption) (*QueryCheckpointStatusSummaryResponse, error) {
+	out := new(QueryCheckpointStatusSummaryResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/CheckpointStatusSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckpointAccumulationProgress(ctx context.Context, in *QueryCheckpointAccumulationProgressRequest, opts ...grpc.CallOption) (*QueryCheckpointAccumulationProgressResponse, error) {
+	out := new(QueryCheckpointAccumulationProgressResponse)
+	err := c.cc.Invoke(ctx, "/babylon.checkpointing.v1.Query/CheckpointAccumulationProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// RawCheckpointList queries all checkpoints that match the given status.
+	RawCheckpointList(context.Context, *QueryRawCheckpointListRequest) (*QueryRawCheckpointListResponse, error)
+	// RawCheckpoint queries a checkpoints at a given epoch number.
+	RawCheckpoint(context.Context, *QueryRawCheckpointRequest) (*QueryRawCheckpointResponse, error)
+	// RawCheckpoints queries checkpoints for a epoch range specified in pagination params.
+	RawCheckpoints(context.Context, *QueryRawCheckpointsRequest) (*QueryRawCheckpointsResponse, error)
+	// BlsPublicKeyList queries a list of bls public keys of the validators at a
+	// given epoch number.
+	BlsPublicKeyList(context.Context, *QueryBlsPublicKeyListRequest) (*QueryBlsPublicKeyListResponse, error)
+	// BlsPublicKeyAtEpoch queries the bls public key that a validator used at a
+	// given epoch number.
+	BlsPublicKeyAtEpoch(context.Context, *QueryBlsPublicKeyAtEpochRequest) (*QueryBlsPublicKeyAtEpochResponse, error)
+	// ValidatorBlsSig queries the individual BLS sig that a validator
+	// contributed to the sealed checkpoint of a given epoch, if retained
+	ValidatorBlsSig(context.Context, *QueryValidatorBlsSigRequest) (*QueryValidatorBlsSigResponse, error)
+	// AllBlsRegistrations queries all registered BLS public keys together with
+	// the validators that registered them, independent of any epoch's
+	// validator set
+	AllBlsRegistrations(context.Context, *QueryAllBlsRegistrationsRequest) (*QueryAllBlsRegistrationsResponse, error)
+	// EpochStatus queries the status of the checkpoint at a given epoch
+	EpochStatus(context.Context, *QueryEpochStatusRequest) (*QueryEpochStatusResponse, error)
+	// RecentEpochStatusCount queries the number of epochs with each status in
+	// recent epochs
+	RecentEpochStatusCount(context.Context, *QueryRecentEpochStatusCountRequest) (*QueryRecentEpochStatusCountResponse, error)
+	// LastCheckpointWithStatus queries the last checkpoint with a given status or
+	// a more matured status
+	LastCheckpointWithStatus(context.Context, *QueryLastCheckpointWithStatusRequest) (*QueryLastCheckpointWithStatusResponse, error)
+	// CurrentCheckpoint queries the checkpoint that is currently accumulating
+	// BLS signatures, together with its progress towards being sealed
+	CurrentCheckpoint(context.Context, *QueryCurrentCheckpointRequest) (*QueryCurrentCheckpointResponse, error)
+	// VerifyBlsMultiSig verifies a BLS multi-signature on the given epoch and
+	// block hash against the validator set of the epoch without changing state
+	VerifyBlsMultiSig(context.Context, *QueryVerifyBlsMultiSigRequest) (*QueryVerifyBlsMultiSigResponse, error)
+	// VerifyCheckpoints re-verifies the stored checkpoints of the given
+	// epoch range without changing state
+	VerifyCheckpoints(context.Context, *QueryVerifyCheckpointsRequest) (*QueryVerifyCheckpointsResponse, error)
+	// CheckpointSigners queries the validators who signed the checkpoint of
+	// the given epoch, as indicated by the checkpoint's bitmap
+	CheckpointSigners(context.Context, *QueryCheckpointSignersRequest) (*QueryCheckpointSignersResponse, error)
+	// CheckpointParticipationHistory queries, for each epoch in the given
+	// range, the fraction of the voting power that contributed a BLS signature
+	// to the epoch's checkpoint, as indicated by the checkpoint's bitmap
+	CheckpointParticipationHistory(context.Context, *QueryCheckpointParticipationHistoryRequest) (*QueryCheckpointParticipationHistoryResponse, error)
+	// LatestCheckpointStateUpdate queries the checkpoint status transition that
+	// occurred most recently across all epochs
+	LatestCheckpointStateUpdate(context.Context, *QueryLatestCheckpointStateUpdateRequest) (*QueryLatestCheckpointStateUpdateResponse, error)
+	// AggregateBlsPubKey queries the aggregate BLS public key of the subset of
+	// the given epoch's validator set indicated by the given bitmap
+	AggregateBlsPubKey(context.Context, *QueryAggregateBlsPubKeyRequest) (*QueryAggregateBlsPubKeyResponse, error)
+	// NextCheckpointHeight queries the length of the current epoch and the
+	// height at which the current epoch's checkpoint will be built
+	NextCheckpointHeight(context.Context, *QueryNextCheckpointHeightRequest) (*QueryNextCheckpointHeightResponse, error)
+	// CheckpointStatusSummary queries the number of checkpoints in each status
+	// and the latest epoch in each status
+	CheckpointStatusSummary(context.Context, *QueryCheckpointStatusSummaryRequest) (*QueryCheckpointStatusSummaryResponse, error)
+	// CheckpointAccumulationProgress queries the progress of the checkpoint of
+	// the given epoch towards being sealed, i.e., the voting power of the BLS
+	// signatures accumulated so far relative to the sealing threshold
+	CheckpointAccumulationProgress(context.Context, *QueryCheckpointAccumulationProgressRequest) (*QueryCheckpointAccumulationProgressResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) RawCheckpointList(ctx context.Context, req *QueryRawCheckpointListRequest) (*QueryRawCheckpointListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RawCheckpointList not implemented")
+}
+func (*UnimplementedQueryServer) RawCheckpoint(ctx context.Context, req *QueryRawCheckpointRequest) (*QueryRawCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RawCheckpoint not implemented")
+}
+func (*UnimplementedQueryServer) RawCheckpoints(ctx context.Context, req *QueryRawCheckpointsRequest) (*QueryRawCheckpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RawCheckpoints not implemented")
+}
+func (*UnimplementedQueryServer) BlsPublicKeyList(ctx context.Context, req *QueryBlsPublicKeyListRequest) (*QueryBlsPublicKeyListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlsPublicKeyList not implemented")
+}
+func (*UnimplementedQueryServer) BlsPublicKeyAtEpoch(ctx context.Context, req *QueryBlsPublicKeyAtEpochRequest) (*QueryBlsPublicKeyAtEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlsPublicKeyAtEpoch not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorBlsSig(ctx context.Context, req *QueryValidatorBlsSigRequest) (*QueryValidatorBlsSigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorBlsSig not implemented")
+}
+func (*UnimplementedQueryServer) AllBlsRegistrations(ctx context.Context, req *QueryAllBlsRegistrationsRequest) (*QueryAllBlsRegistrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllBlsRegistrations not implemented")
+}
+func (*UnimplementedQueryServer) EpochStatus(ctx context.Context, req *QueryEpochStatusRequest) (*QueryEpochStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochStatus not implemented")
+}
+func (*UnimplementedQueryServer) RecentEpochStatusCount(ctx context.Context, req *QueryRecentEpochStatusCountRequest) (*QueryRecentEpochStatusCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecentEpochStatusCount not implemented")
+}
+func (*UnimplementedQueryServer) LastCheckpointWithStatus(ctx context.Context, req *QueryLastCheckpointWithStatusRequest) (*QueryLastCheckpointWithStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastCheckpointWithStatus not implemented")
+}
+func (*UnimplementedQueryServer) CurrentCheckpoint(ctx context.Context, req *QueryCurrentCheckpointRequest) (*QueryCurrentCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentCheckpoint not implemented")
+}
+func (*UnimplementedQueryServer) VerifyBlsMultiSig(ctx context.Context, req *QueryVerifyBlsMultiSigRequest) (*QueryVerifyBlsMultiSigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyBlsMultiSig not implemented")
+}
+func (*UnimplementedQueryServer) VerifyCheckpoints(ctx context.Context, req *QueryVerifyCheckpointsRequest) (*QueryVerifyCheckpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCheckpoints not implemented")
+}
+func (*UnimplementedQueryServer) CheckpointSigners(ctx context.Context, req *QueryCheckpointSignersRequest) (*QueryCheckpointSignersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckpointSigners not implemented")
+}
+func (*UnimplementedQueryServer) CheckpointParticipationHistory(ctx context.Context, req *QueryCheckpointParticipationHistoryRequest) (*QueryCheckpointParticipationHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckpointParticipationHistory not implemented")
+}
+func (*UnimplementedQueryServer) LatestCheckpointStateUpdate(ctx context.Context, req *QueryLatestCheckpointStateUpdateRequest) (*QueryLatestCheckpointStateUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestCheckpointStateUpdate not implemented")
+}
+func (*UnimplementedQueryServer) AggregateBlsPubKey(ctx context.Context, req *QueryAggregateBlsPubKeyRequest) (*QueryAggregateBlsPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateBlsPubKey not implemented")
+}
+func (*UnimplementedQueryServer) NextCheckpointHeight(ctx context.Context, req *QueryNextCheckpointHeightRequest) (*QueryNextCheckpointHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextCheckpointHeight not implemented")
+}
+func (*UnimplementedQueryServer) CheckpointStatusSummary(ctx context.Context, req *QueryCheckpointStatusSummaryRequest) (*QueryCheckpointStatusSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckpointStatusSummary not implemented")
+}
+func (*UnimplementedQueryServer) CheckpointAccumulationProgress(ctx context.Context, req *QueryCheckpointAccumulationProgressRequest) (*QueryCheckpointAccumulationProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckpointAccumulationProgress not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RawCheckpointList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRawCheckpointListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RawCheckpointList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Query/RawCheckpointList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RawCheckpointList(ctx, req.(*QueryRawCheckpointListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RawCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRawCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RawCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Query/RawCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RawCheckpoint(ctx, req.(*QueryRawCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RawCheckpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRawCheckpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RawCheckpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Query/RawCheckpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RawCheckpoints(ctx, req.(*QueryRawCheckpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlsPublicKeyList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlsPublicKeyListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlsPublicKeyList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Query/BlsPublicKeyList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlsPublicKeyList(ctx, req.(*QueryBlsPublicKeyListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlsPublicKeyAtEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlsPublicKeyAtEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlsPublicKeyAtEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Query/BlsPublicKeyAtEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlsPublicKeyAtEpoch(ctx, req.(*QueryBlsPublicKeyAtEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorBlsSig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorBlsSigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorBlsSig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Query/ValidatorBlsSig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorBlsSig(ctx, req.(*QueryValidatorBlsSigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllBlsRegistrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBlsRegistrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllBlsRegistrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Query/AllBlsRegistrations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllBlsRegistrations(ctx, req.(*QueryAllBlsRegistrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Query/EpochStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochStatus(ctx, req.(*QueryEpochStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecentEpochStatusCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecentEpochStatusCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecentEpochStatusCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Query/RecentEpochStatusCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecentEpochStatusCount(ctx, req.(*QueryRecentEpochStatusCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LastCheckpointWithStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastCheckpointWithStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastCheckpointWithStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Query/LastCheckpointWithStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastCheckpointWithStatus(ctx, req.(*QueryLastCheckpointWithStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CurrentCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Query/CurrentCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentCheckpoint(ctx, req.(*QueryCurrentCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyBlsMultiSig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyBlsMultiSigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyBlsMultiSig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Query/VerifyBlsMultiSig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyBlsMultiSig(ctx, req.(*QueryVerifyBlsMultiSigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyCheckpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyCheckpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyCheckpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Query/VerifyCheckpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyCheckpoints(ctx, req.(*QueryVerifyCheckpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckpointSigners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckpointSignersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckpointSigners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Query/CheckpointSigners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckpointSigners(ctx, req.(*QueryCheckpointSignersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckpointParticipationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckpointParticipationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckpointParticipationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Query/CheckpointParticipationHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckpointParticipationHistory(ctx, req.(*QueryCheckpointParticipationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestCheckpointStateUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestCheckpointStateUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestCheckpointStateUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Query/LatestCheckpointStateUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestCheckpointStateUpdate(ctx, req.(*QueryLatestCheckpointStateUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AggregateBlsPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregateBlsPubKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregateBlsPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Query/AggregateBlsPubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregateBlsPubKey(ctx, req.(*QueryAggregateBlsPubKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextCheckpointHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextCheckpointHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextCheckpointHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Query/NextCheckpointHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextCheckpointHeight(ctx, req.(*QueryNextCheckpointHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckpointStatusSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckpointStatusSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckpointStatusSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Query/CheckpointStatusSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckpointStatusSummary(ctx, req.(*QueryCheckpointStatusSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckpointAccumulationProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckpointAccumulationProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckpointAccumulationProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.checkpointing.v1.Query/CheckpointAccumulationProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckpointAccumulationProgress(ctx, req.(*QueryCheckpointAccumulationProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylon.checkpointing.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "RawCheckpointList",
+			Handler:    _Query_RawCheckpointList_Handler,
+		},
+		{
+			MethodName: "RawCheckpoint",
+			Handler:    _Query_RawCheckpoint_Handler,
+		},
+		{
+			MethodName: "RawCheckpoints",
+			Handler:    _Query_RawCheckpoints_Handler,
+		},
+		{
+			MethodName: "BlsPublicKeyList",
+			Handler:    _Query_BlsPublicKeyList_Handler,
+		},
+		{
+			MethodName: "BlsPublicKeyAtEpoch",
+			Handler:    _Query_BlsPublicKeyAtEpoch_Handler,
+		},
+		{
+			MethodName: "ValidatorBlsSig",
+			Handler:    _Query_ValidatorBlsSig_Handler,
+		},
+		{
+			MethodName: "AllBlsRegistrations",
+			Handler:    _Query_AllBlsRegistrations_Handler,
+		},
+		{
+			MethodName: "EpochStatus",
+			Handler:    _Query_EpochStatus_Handler,
+		},
+		{
+			MethodName: "RecentEpochStatusCount",
+			Handler:    _Query_RecentEpochStatusCount_Handler,
+		},
+		{
+			MethodName: "LastCheckpointWithStatus",
+			Handler:    _Query_LastCheckpointWithStatus_Handler,
+		},
+		{
+			MethodName: "CurrentCheckpoint",
+			Handler:    _Query_CurrentCheckpoint_Handler,
+		},
+		{
+			MethodName: "VerifyBlsMultiSig",
+			Handler:    _Query_VerifyBlsMultiSig_Handler,
+		},
+		{
+			MethodName: "VerifyCheckpoints",
+			Handler:    _Query_VerifyCheckpoints_Handler,
+		},
+		{
+			MethodName: "CheckpointSigners",
+			Handler:    _Query_CheckpointSigners_Handler,
+		},
+		{
+			MethodName: "CheckpointParticipationHistory",
+			Handler:    _Query_CheckpointParticipationHistory_Handler,
+		},
+		{
+			MethodName: "LatestCheckpointStateUpdate",
+			Handler:    _Query_LatestCheckpointStateUpdate_Handler,
+		},
+		{
+			MethodName: "AggregateBlsPubKey",
+			Handler:    _Query_AggregateBlsPubKey_Handler,
+		},
+		{
+			MethodName: "NextCheckpointHeight",
+			Handler:    _Query_NextCheckpointHeight_Handler,
+		},
+		{
+			MethodName: "CheckpointStatusSummary",
+			Handler:    _Query_CheckpointStatusSummary_Handler,
+		},
+		{
+			MethodName: "CheckpointAccumulationProgress",
+			Handler:    _Query_CheckpointAccumulationProgress_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "babylon/checkpointing/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRawCheckpointListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRawCheckpointListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRawCheckpointListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRawCheckpointListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRawCheckpointListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRawCheckpointListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RawCheckpoints) > 0 {
+		for iNdEx := len(m.RawCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RawCheckpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRawCheckpointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRawCheckpointRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRawCheckpointRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRawCheckpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRawCheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRawCheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RawCheckpoint != nil {
+		{
+			size, err := m.RawCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRawCheckpointsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRawCheckpointsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRawCheckpointsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRawCheckpointsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRawCheckpointsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRawCheckpointsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RawCheckpoints) > 0 {
+		for iNdEx := len(m.RawCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RawCheckpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlsPublicKeyListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlsPublicKeyListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlsPublicKeyListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlsPublicKeyListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlsPublicKeyListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlsPublicKeyListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorWithBlsKeys) > 0 {
+		for iNdEx := len(m.ValidatorWithBlsKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorWithBlsKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlsPublicKeyAtEpochRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlsPublicKeyAtEpochRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlsPublicKeyAtEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValAddress) > 0 {
+		i -= len(m.ValAddress)
+		copy(dAtA[i:], m.ValAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlsPublicKeyAtEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlsPublicKeyAtEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlsPublicKeyAtEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidatorWithBlsKey != nil {
+		{
+			size, err := m.ValidatorWithBlsKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorBlsSigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorBlsSigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorBlsSigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValAddress) > 0 {
+		i -= len(m.ValAddress)
+		copy(dAtA[i:], m.ValAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorBlsSigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorBlsSigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorBlsSigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlsSig != nil {
+		{
+			size, err := m.BlsSig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBlsRegistrationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlsRegistrationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlsRegistrationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBlsRegistrationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlsRegistrationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlsRegistrationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Registrations) > 0 {
+		for iNdEx := len(m.Registrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Registrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlsRegistration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlsRegistration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlsRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlsPubKey != nil {
+		{
+			size := m.BlsPubKey.Size()
+			i -= size
+			if _, err := m.BlsPubKey.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecentEpochStatusCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecentEpochStatusCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecentEpochStatusCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecentEpochStatusCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecentEpochStatusCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecentEpochStatusCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StatusCount) > 0 {
+		for k := range m.StatusCount {
+			v := m.StatusCount[k]
+			baseI := i
+			i = encodeVarintQuery(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EpochCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TipEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TipEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastCheckpointWithStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastCheckpointWithStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastCheckpointWithStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastCheckpointWithStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastCheckpointWithStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastCheckpointWithStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RawCheckpoint != nil {
+		{
+			size, err := m.RawCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentCheckpointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentCheckpointRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentCheckpointRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentCheckpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentCheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentCheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ThresholdPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ThresholdPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RawCheckpoint != nil {
+		{
+			size, err := m.RawCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyBlsMultiSigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyBlsMultiSigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyBlsMultiSigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlsMultiSig) > 0 {
+		i -= len(m.BlsMultiSig)
+		copy(dAtA[i:], m.BlsMultiSig)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlsMultiSig)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Bitmap) > 0 {
+		i -= len(m.Bitmap)
+		copy(dAtA[i:], m.Bitmap)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bitmap)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlockHashHex) > 0 {
+		i -= len(m.BlockHashHex)
+		copy(dAtA[i:], m.BlockHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHashHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyBlsMultiSigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyBlsMultiSigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyBlsMultiSigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InvalidReason) > 0 {
+		i -= len(m.InvalidReason)
+		copy(dAtA[i:], m.InvalidReason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvalidReason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TotalPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PowerSum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PowerSum))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyCheckpointsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyCheckpointsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyCheckpointsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyCheckpointsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyCheckpointsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyCheckpointsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointVerificationResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointVerificationResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointVerificationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Found {
+		i--
+		if m.Found {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.InvalidReason) > 0 {
+		i -= len(m.InvalidReason)
+		copy(dAtA[i:], m.InvalidReason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvalidReason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TotalPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PowerSum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PowerSum))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointSignersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointSignersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointSignersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointSignersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointSignersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointSignersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SignersPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignersPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointParticipationHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointParticipationHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointParticipationHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointParticipationHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointParticipationHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointParticipationHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participations) > 0 {
+		for iNdEx := len(m.Participations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointParticipation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointParticipation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointParticipation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ParticipationRate.Size()
+		i -= size
+		if _, err := m.ParticipationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.TotalPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SignersPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignersPower))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NumValidators != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumValidators))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NumSigners != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumSigners))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestCheckpointStateUpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestCheckpointStateUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestCheckpointStateUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestCheckpointStateUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestCheckpointStateUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestCheckpointStateUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTime != nil {
+		n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.BlockTime):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintQuery(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.To != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.From != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.From))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointSigner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointSigner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointSigner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValAddress) > 0 {
+		i -= len(m.ValAddress)
+		copy(dAtA[i:], m.ValAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RawCheckpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RawCheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RawCheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlsMultiSig != nil {
+		{
+			size := m.BlsMultiSig.Size()
+			i -= size
+			if _, err := m.BlsMultiSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Bitmap) > 0 {
+		i -= len(m.Bitmap)
+		copy(dAtA[i:], m.Bitmap)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bitmap)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlockHashHex) > 0 {
+		i -= len(m.BlockHashHex)
+		copy(dAtA[i:], m.BlockHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHashHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointStateUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointStateUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointStateUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTime != nil {
+		n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.BlockTime):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintQuery(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.StatusDesc) > 0 {
+		i -= len(m.StatusDesc)
+		copy(dAtA[i:], m.StatusDesc)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StatusDesc)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.State != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RawCheckpointWithMetaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RawCheckpointWithMetaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RawCheckpointWithMetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Lifecycle) > 0 {
+		for iNdEx := len(m.Lifecycle) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lifecycle[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.PowerSum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PowerSum))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BlsAggrPk != nil {
+		{
+			size := m.BlsAggrPk.Size()
+			i -= size
+			if _, err := m.BlsAggrPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StatusDesc) > 0 {
+		i -= len(m.StatusDesc)
+		copy(dAtA[i:], m.StatusDesc)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StatusDesc)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Ckpt != nil {
+		{
+			size, err := m.Ckpt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregateBlsPubKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregateBlsPubKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateBlsPubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bitmap) > 0 {
+		i -= len(m.Bitmap)
+		copy(dAtA[i:], m.Bitmap)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bitmap)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregateBlsPubKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregateBlsPubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateBlsPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PowerSum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PowerSum))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NumSigners != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumSigners))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AggregateBlsPubKey != nil {
+		{
+			size := m.AggregateBlsPubKey.Size()
+			i -= size
+			if _, err := m.AggregateBlsPubKey.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextCheckpointHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextCheckpointHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextCheckpointHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextCheckpointHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextCheckpointHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextCheckpointHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CheckpointHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CheckpointHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastBlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastBlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FirstBlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FirstBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointStatusSummaryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointStatusSummaryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointStatusSummaryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointStatusSummaryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointStatusSummaryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointStatusSummaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Summaries) > 0 {
+		for iNdEx := len(m.Summaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Summaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TipEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TipEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointStatusSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointStatusSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointStatusSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LatestEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LatestEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointAccumulationProgressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointAccumulationProgressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointAccumulationProgressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointAccumulationProgressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointAccumulationProgressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointAccumulationProgressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Progress != nil {
+		{
+			size, err := m.Progress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointAccumulationProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointAccumulationProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointAccumulationProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PercentageToSeal.Size()
+		i -= size
+		if _, err := m.PercentageToSeal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.SealingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SealingPower))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.SealingThreshold.Size()
+		i -= size
+		if _, err := m.SealingThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.TotalPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AccumulatedPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AccumulatedPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRawCheckpointListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRawCheckpointListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RawCheckpoints) > 0 {
+		for _, e := range m.RawCheckpoints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRawCheckpointRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	return n
+}
+
+func (m *QueryRawCheckpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RawCheckpoint != nil {
+		l = m.RawCheckpoint.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRawCheckpointsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRawCheckpointsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RawCheckpoints) > 0 {
+		for _, e := range m.RawCheckpoints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlsPublicKeyListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlsPublicKeyListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorWithBlsKeys) > 0 {
+		for _, e := range m.ValidatorWithBlsKeys {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlsPublicKeyAtEpochRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	return n
+}
+
+func (m *QueryBlsPublicKeyAtEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorWithBlsKey != nil {
+		l = m.ValidatorWithBlsKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorBlsSigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	l = len(m.ValAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorBlsSigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlsSig != nil {
+		l = m.BlsSig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBlsRegistrationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBlsRegistrationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Registrations) > 0 {
+		for _, e := range m.Registrations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BlsRegistration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlsPubKey != nil {
+		l = m.BlsPubKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	return n
+}
+
+func (m *QueryEpochStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryRecentEpochStatusCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochCount != 0 {
+		n += 1 + sovQuery(uint64(m.EpochCount))
+	}
+	return n
+}
+
+func (m *QueryRecentEpochStatusCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TipEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.TipEpoch))
+	}
+	if m.EpochCount != 0 {
+		n += 1 + sovQuery(uint64(m.EpochCount))
+	}
+	if len(m.StatusCount) > 0 {
+		for k, v := range m.StatusCount {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + sovQuery(uint64(v))
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryLastCheckpointWithStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryLastCheckpointWithStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RawCheckpoint != nil {
+		l = m.RawCheckpoint.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCurrentCheckpointRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCurrentCheckpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RawCheckpoint != nil {
+		l = m.RawCheckpoint.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPower))
+	}
+	if m.ThresholdPower != 0 {
+		n += 1 + sovQuery(uint64(m.ThresholdPower))
+	}
+	return n
+}
+
+func (m *QueryVerifyBlsMultiSigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	l = len(m.BlockHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Bitmap)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlsMultiSig)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyBlsMultiSigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	if m.PowerSum != 0 {
+		n += 1 + sovQuery(uint64(m.PowerSum))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPower))
+	}
+	l = len(m.InvalidReason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyCheckpointsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.ToEpoch))
+	}
+	return n
+}
+
+func (m *QueryVerifyCheckpointsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CheckpointVerificationResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Valid {
+		n += 2
+	}
+	if m.PowerSum != 0 {
+		n += 1 + sovQuery(uint64(m.PowerSum))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPower))
+	}
+	l = len(m.InvalidReason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Found {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryCheckpointSignersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	return n
+}
+
+func (m *QueryCheckpointSignersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for _, e := range m.Signers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.SignersPower != 0 {
+		n += 1 + sovQuery(uint64(m.SignersPower))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPower))
+	}
+	return n
+}
+
+func (m *QueryCheckpointParticipationHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.ToEpoch))
+	}
+	return n
+}
+
+func (m *QueryCheckpointParticipationHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Participations) > 0 {
+		for _, e := range m.Participations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CheckpointParticipation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.NumSigners != 0 {
+		n += 1 + sovQuery(uint64(m.NumSigners))
+	}
+	if m.NumValidators != 0 {
+		n += 1 + sovQuery(uint64(m.NumValidators))
+	}
+	if m.SignersPower != 0 {
+		n += 1 + sovQuery(uint64(m.SignersPower))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPower))
+	}
+	l = m.ParticipationRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLatestCheckpointStateUpdateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLatestCheckpointStateUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	if m.From != 0 {
+		n += 1 + sovQuery(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovQuery(uint64(m.To))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if m.BlockTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.BlockTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CheckpointSigner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovQuery(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *RawCheckpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	l = len(m.BlockHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Bitmap)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlsMultiSig != nil {
+		l = m.BlsMultiSig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CheckpointStateUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != 0 {
+		n += 1 + sovQuery(uint64(m.State))
+	}
+	l = len(m.StatusDesc)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if m.BlockTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.BlockTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RawCheckpointWithMetaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ckpt != nil {
+		l = m.Ckpt.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = len(m.StatusDesc)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlsAggrPk != nil {
+		l = m.BlsAggrPk.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PowerSum != 0 {
+		n += 1 + sovQuery(uint64(m.PowerSum))
+	}
+	if len(m.Lifecycle) > 0 {
+		for _, e := range m.Lifecycle {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAggregateBlsPubKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	l = len(m.Bitmap)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregateBlsPubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AggregateBlsPubKey != nil {
+		l = m.AggregateBlsPubKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NumSigners != 0 {
+		n += 1 + sovQuery(uint64(m.NumSigners))
+	}
+	if m.PowerSum != 0 {
+		n += 1 + sovQuery(uint64(m.PowerSum))
+	}
+	return n
+}
+
+func (m *QueryNextCheckpointHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNextCheckpointHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	if m.EpochLength != 0 {
+		n += 1 + sovQuery(uint64(m.EpochLength))
+	}
+	if m.FirstBlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FirstBlockHeight))
+	}
+	if m.LastBlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastBlockHeight))
+	}
+	if m.CheckpointHeight != 0 {
+		n += 1 + sovQuery(uint64(m.CheckpointHeight))
+	}
+	if m.CurrentHeight != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentHeight))
+	}
+	return n
+}
+
+func (m *QueryCheckpointStatusSummaryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCheckpointStatusSummaryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TipEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.TipEpoch))
+	}
+	if len(m.Summaries) > 0 {
+		for _, e := range m.Summaries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CheckpointStatusSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	if m.LatestEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.LatestEpoch))
+	}
+	return n
+}
+
+func (m *QueryCheckpointAccumulationProgressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	return n
+}
+
+func (m *QueryCheckpointAccumulationProgressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Progress != nil {
+		l = m.Progress.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CheckpointAccumulationProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.AccumulatedPower != 0 {
+		n += 1 + sovQuery(uint64(m.AccumulatedPower))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPower))
+	}
+	l = m.SealingThreshold.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.SealingPower != 0 {
+		n += 1 + sovQuery(uint64(m.SealingPower))
+	}
+	l = m.PercentageToSeal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRawCheckpointListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRawCheckpointListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRawCheckpointListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= CheckpointStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRawCheckpointListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRawCheckpointListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRawCheckpointListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawCheckpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawCheckpoints = append(m.RawCheckpoints, &RawCheckpointWithMetaResponse{})
+			if err := m.RawCheckpoints[len(m.RawCheckpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRawCheckpointRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRawCheckpointRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRawCheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRawCheckpointResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRawCheckpointResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRawCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RawCheckpoint == nil {
+				m.RawCheckpoint = &RawCheckpointWithMetaResponse{}
+			}
+			if err := m.RawCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRawCheckpointsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRawCheckpointsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRawCheckpointsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRawCheckpointsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRawCheckpointsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRawCheckpointsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawCheckpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawCheckpoints = append(m.RawCheckpoints, &RawCheckpointWithMetaResponse{})
+			if err := m.RawCheckpoints[len(m.RawCheckpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlsPublicKeyListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlsPublicKeyListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlsPublicKeyListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlsPublicKeyListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlsPublicKeyListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlsPublicKeyListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorWithBlsKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorWithBlsKeys = append(m.ValidatorWithBlsKeys, &ValidatorWithBlsKey{})
+			if err := m.ValidatorWithBlsKeys[len(m.ValidatorWithBlsKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlsPublicKeyAtEpochRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlsPublicKeyAtEpochRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlsPublicKeyAtEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlsPublicKeyAtEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlsPublicKeyAtEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlsPublicKeyAtEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorWithBlsKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidatorWithBlsKey == nil {
+				m.ValidatorWithBlsKey = &ValidatorWithBlsKey{}
+			}
+			if err := m.ValidatorWithBlsKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorBlsSigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorBlsSigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorBlsSigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorBlsSigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorBlsSigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorBlsSigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsSig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlsSig == nil {
+				m.BlsSig = &BlsSig{}
+			}
+			if err := m.BlsSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllBlsRegistrationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBlsRegistrationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBlsRegistrationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllBlsRegistrationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBlsRegistrationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBlsRegistrationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Registrations = append(m.Registrations, &BlsRegistration{})
+			if err := m.Registrations[len(m.Registrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlsRegistration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlsRegistration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlsRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsPubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_crypto_bls12381.PublicKey
+			m.BlsPubKey = &v
+			if err := m.BlsPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= CheckpointStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecentEpochStatusCountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecentEpochStatusCountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecentEpochStatusCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochCount", wireType)
+			}
+			m.EpochCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecentEpochStatusCountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecentEpochStatusCountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecentEpochStatusCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TipEpoch", wireType)
+			}
+			m.TipEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TipEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochCount", wireType)
+			}
+			m.EpochCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusCount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StatusCount == nil {
+				m.StatusCount = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.StatusCount[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastCheckpointWithStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastCheckpointWithStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastCheckpointWithStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= CheckpointStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastCheckpointWithStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastCheckpointWithStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastCheckpointWithStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RawCheckpoint == nil {
+				m.RawCheckpoint = &RawCheckpointResponse{}
+			}
+			if err := m.RawCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentCheckpointRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentCheckpointRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentCheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentCheckpointResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentCheckpointResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RawCheckpoint == nil {
+				m.RawCheckpoint = &RawCheckpointWithMetaResponse{}
+			}
+			if err := m.RawCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdPower", wireType)
+			}
+			m.ThresholdPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThresholdPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyBlsMultiSigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyBlsMultiSigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyBlsMultiSigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bitmap", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bitmap = append(m.Bitmap[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bitmap == nil {
+				m.Bitmap = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsMultiSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsMultiSig = append(m.BlsMultiSig[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlsMultiSig == nil {
+				m.BlsMultiSig = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyBlsMultiSigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyBlsMultiSigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyBlsMultiSigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerSum", wireType)
+			}
+			m.PowerSum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PowerSum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyCheckpointsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyCheckpointsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyCheckpointsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyCheckpointsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyCheckpointsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyCheckpointsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &CheckpointVerificationResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckpointVerificationResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointVerificationResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointVerificationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= CheckpointStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerSum", wireType)
+			}
+			m.PowerSum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PowerSum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Found = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckpointSignersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointSignersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointSignersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckpointSignersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointSignersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointSignersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, &CheckpointSigner{})
+			if err := m.Signers[len(m.Signers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignersPower", wireType)
+			}
+			m.SignersPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignersPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckpointParticipationHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointParticipationHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointParticipationHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
+			}
+			m.FromEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *RawCheckpointWithMetaResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Ckpt != nil {
-		l = m.Ckpt.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
-	}
-	l = len(m.StatusDesc)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.BlsAggrPk != nil {
-		l = m.BlsAggrPk.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.PowerSum != 0 {
-		n += 1 + sovQuery(uint64(m.PowerSum))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.Lifecycle) > 0 {
-		for _, e := range m.Lifecycle {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryCheckpointParticipationHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointParticipationHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointParticipationHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participations = append(m.Participations, &CheckpointParticipation{})
+			if err := m.Participations[len(m.Participations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryRawCheckpointListRequest) Unmarshal(dAtA []byte) error {
+func (m *CheckpointParticipation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2436,13 +10465,32 @@ func (m *QueryRawCheckpointListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRawCheckpointListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckpointParticipation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRawCheckpointListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckpointParticipation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
@@ -2461,11 +10509,87 @@ func (m *QueryRawCheckpointListRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumSigners", wireType)
+			}
+			m.NumSigners = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumSigners |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumValidators", wireType)
+			}
+			m.NumValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumValidators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignersPower", wireType)
+			}
+			m.SignersPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignersPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipationRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2475,28 +10599,76 @@ func (m *QueryRawCheckpointListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ParticipationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestCheckpointStateUpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestCheckpointStateUpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestCheckpointStateUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2518,7 +10690,7 @@ func (m *QueryRawCheckpointListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRawCheckpointListResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestCheckpointStateUpdateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2541,17 +10713,17 @@ func (m *QueryRawCheckpointListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRawCheckpointListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLatestCheckpointStateUpdateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRawCheckpointListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLatestCheckpointStateUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawCheckpoints", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
 			}
-			var msglen int
+			m.EpochNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2561,29 +10733,71 @@ func (m *QueryRawCheckpointListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EpochNum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= CheckpointStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			m.RawCheckpoints = append(m.RawCheckpoints, &RawCheckpointWithMetaResponse{})
-			if err := m.RawCheckpoints[len(m.RawCheckpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= CheckpointStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2610,10 +10824,10 @@ func (m *QueryRawCheckpointListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.BlockTime == nil {
+				m.BlockTime = new(time.Time)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2638,7 +10852,7 @@ func (m *QueryRawCheckpointListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRawCheckpointRequest) Unmarshal(dAtA []byte) error {
+func (m *CheckpointSigner) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2661,17 +10875,49 @@ func (m *QueryRawCheckpointRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRawCheckpointRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckpointSigner: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRawCheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckpointSigner: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
-			m.EpochNum = 0
+			m.Power = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2681,7 +10927,7 @@ func (m *QueryRawCheckpointRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNum |= uint64(b&0x7F) << shift
+				m.Power |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2707,7 +10953,7 @@ func (m *QueryRawCheckpointRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRawCheckpointResponse) Unmarshal(dAtA []byte) error {
+func (m *RawCheckpointResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2730,17 +10976,36 @@ func (m *QueryRawCheckpointResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRawCheckpointResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RawCheckpointResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRawCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RawCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawCheckpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHashHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2750,83 +11015,63 @@ func (m *QueryRawCheckpointResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RawCheckpoint == nil {
-				m.RawCheckpoint = &RawCheckpointWithMetaResponse{}
-			}
-			if err := m.RawCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BlockHashHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bitmap", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryRawCheckpointsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Bitmap = append(m.Bitmap[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bitmap == nil {
+				m.Bitmap = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRawCheckpointsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRawCheckpointsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsMultiSig", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2836,25 +11081,24 @@ func (m *QueryRawCheckpointsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonchain_babylon_crypto_bls12381.Signature
+			m.BlsMultiSig = &v
+			if err := m.BlsMultiSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2879,7 +11123,7 @@ func (m *QueryRawCheckpointsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRawCheckpointsResponse) Unmarshal(dAtA []byte) error {
+func (m *CheckpointStateUpdateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2902,17 +11146,17 @@ func (m *QueryRawCheckpointsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRawCheckpointsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckpointStateUpdateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRawCheckpointsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckpointStateUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawCheckpoints", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var msglen int
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2922,31 +11166,16 @@ func (m *QueryRawCheckpointsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.State |= CheckpointStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RawCheckpoints = append(m.RawCheckpoints, &RawCheckpointWithMetaResponse{})
-			if err := m.RawCheckpoints[len(m.RawCheckpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusDesc", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2956,83 +11185,29 @@ func (m *QueryRawCheckpointsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StatusDesc = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBlsPublicKeyListRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlsPublicKeyListRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlsPublicKeyListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.EpochNum = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3042,14 +11217,14 @@ func (m *QueryBlsPublicKeyListRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNum |= uint64(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3076,10 +11251,10 @@ func (m *QueryBlsPublicKeyListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.BlockTime == nil {
+				m.BlockTime = new(time.Time)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3104,7 +11279,7 @@ func (m *QueryBlsPublicKeyListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlsPublicKeyListResponse) Unmarshal(dAtA []byte) error {
+func (m *RawCheckpointWithMetaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3127,15 +11302,15 @@ func (m *QueryBlsPublicKeyListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlsPublicKeyListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RawCheckpointWithMetaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlsPublicKeyListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RawCheckpointWithMetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorWithBlsKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ckpt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3162,16 +11337,37 @@ func (m *QueryBlsPublicKeyListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorWithBlsKeys = append(m.ValidatorWithBlsKeys, &ValidatorWithBlsKey{})
-			if err := m.ValidatorWithBlsKeys[len(m.ValidatorWithBlsKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Ckpt == nil {
+				m.Ckpt = &RawCheckpointResponse{}
+			}
+			if err := m.Ckpt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= CheckpointStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusDesc", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3181,83 +11377,83 @@ func (m *QueryBlsPublicKeyListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.StatusDesc = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsAggrPk", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryEpochStatusRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			var v github_com_babylonchain_babylon_crypto_bls12381.PublicKey
+			m.BlsAggrPk = &v
+			if err := m.BlsAggrPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerSum", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PowerSum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PowerSum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochStatusRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lifecycle", wireType)
 			}
-			m.EpochNum = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3267,11 +11463,26 @@ func (m *QueryEpochStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNum |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lifecycle = append(m.Lifecycle, &CheckpointStateUpdateResponse{})
+			if err := m.Lifecycle[len(m.Lifecycle)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3293,7 +11504,7 @@ func (m *QueryEpochStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregateBlsPubKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3316,17 +11527,17 @@ func (m *QueryEpochStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregateBlsPubKeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregateBlsPubKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
 			}
-			m.Status = 0
+			m.EpochNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3336,66 +11547,16 @@ func (m *QueryEpochStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= CheckpointStatus(b&0x7F) << shift
+				m.EpochNum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryRecentEpochStatusCountRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRecentEpochStatusCountRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRecentEpochStatusCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochCount", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bitmap", wireType)
 			}
-			m.EpochCount = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3405,11 +11566,26 @@ func (m *QueryRecentEpochStatusCountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochCount |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bitmap = append(m.Bitmap[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bitmap == nil {
+				m.Bitmap = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3431,7 +11607,7 @@ func (m *QueryRecentEpochStatusCountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRecentEpochStatusCountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAggregateBlsPubKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3454,17 +11630,17 @@ func (m *QueryRecentEpochStatusCountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRecentEpochStatusCountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAggregateBlsPubKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRecentEpochStatusCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAggregateBlsPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TipEpoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateBlsPubKey", wireType)
 			}
-			m.TipEpoch = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3474,16 +11650,32 @@ func (m *QueryRecentEpochStatusCountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TipEpoch |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_crypto_bls12381.PublicKey
+			m.AggregateBlsPubKey = &v
+			if err := m.AggregateBlsPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumSigners", wireType)
 			}
-			m.EpochCount = 0
+			m.NumSigners = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3493,124 +11685,30 @@ func (m *QueryRecentEpochStatusCountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochCount |= uint64(b&0x7F) << shift
+				m.NumSigners |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusCount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StatusCount == nil {
-				m.StatusCount = make(map[string]uint64)
-			}
-			var mapkey string
-			var mapvalue uint64
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerSum", wireType)
+			}
+			m.PowerSum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PowerSum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.StatusCount[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3632,7 +11730,7 @@ func (m *QueryRecentEpochStatusCountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastCheckpointWithStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNextCheckpointHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3655,31 +11753,12 @@ func (m *QueryLastCheckpointWithStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastCheckpointWithStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextCheckpointHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastCheckpointWithStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextCheckpointHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= CheckpointStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3701,7 +11780,7 @@ func (m *QueryLastCheckpointWithStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastCheckpointWithStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNextCheckpointHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3724,17 +11803,17 @@ func (m *QueryLastCheckpointWithStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastCheckpointWithStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextCheckpointHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastCheckpointWithStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextCheckpointHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawCheckpoint", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
 			}
-			var msglen int
+			m.EpochNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3744,28 +11823,106 @@ func (m *QueryLastCheckpointWithStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EpochNum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstBlockHeight", wireType)
 			}
-			if m.RawCheckpoint == nil {
-				m.RawCheckpoint = &RawCheckpointResponse{}
+			m.FirstBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.RawCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockHeight", wireType)
+			}
+			m.LastBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointHeight", wireType)
+			}
+			m.CheckpointHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHeight", wireType)
+			}
+			m.CurrentHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3787,7 +11944,7 @@ func (m *QueryLastCheckpointWithStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawCheckpointResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCheckpointStatusSummaryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3810,102 +11967,86 @@ func (m *RawCheckpointResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawCheckpointResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCheckpointStatusSummaryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCheckpointStatusSummaryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
-			}
-			m.EpochNum = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochNum |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHashHex", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckpointStatusSummaryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHashHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bitmap", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var byteLen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointStatusSummaryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointStatusSummaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TipEpoch", wireType)
+			}
+			m.TipEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Bitmap = append(m.Bitmap[:0], dAtA[iNdEx:postIndex]...)
-			if m.Bitmap == nil {
-				m.Bitmap = []byte{}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TipEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlsMultiSig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Summaries", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3915,24 +12056,23 @@ func (m *RawCheckpointResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_crypto_bls12381.Signature
-			m.BlsMultiSig = &v
-			if err := m.BlsMultiSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Summaries = append(m.Summaries, &CheckpointStatusSummary{})
+			if err := m.Summaries[len(m.Summaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3957,7 +12097,7 @@ func (m *RawCheckpointResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CheckpointStateUpdateResponse) Unmarshal(dAtA []byte) error {
+func (m *CheckpointStatusSummary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3980,17 +12120,17 @@ func (m *CheckpointStateUpdateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CheckpointStateUpdateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckpointStatusSummary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CheckpointStateUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckpointStatusSummary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.State = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4000,16 +12140,16 @@ func (m *CheckpointStateUpdateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= CheckpointStatus(b&0x7F) << shift
+				m.Status |= CheckpointStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusDesc", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4019,29 +12159,85 @@ func (m *CheckpointStateUpdateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Count |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestEpoch", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			m.LatestEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StatusDesc = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckpointAccumulationProgressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointAccumulationProgressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointAccumulationProgressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
 			}
-			m.BlockHeight = 0
+			m.EpochNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4051,14 +12247,64 @@ func (m *CheckpointStateUpdateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				m.EpochNum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckpointAccumulationProgressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointAccumulationProgressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointAccumulationProgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4085,10 +12331,10 @@ func (m *CheckpointStateUpdateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockTime == nil {
-				m.BlockTime = new(time.Time)
+			if m.Progress == nil {
+				m.Progress = &CheckpointAccumulationProgress{}
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Progress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4113,7 +12359,7 @@ func (m *CheckpointStateUpdateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawCheckpointWithMetaResponse) Unmarshal(dAtA []byte) error {
+func (m *CheckpointAccumulationProgress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4136,17 +12382,17 @@ func (m *RawCheckpointWithMetaResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawCheckpointWithMetaResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckpointAccumulationProgress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawCheckpointWithMetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckpointAccumulationProgress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ckpt", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
 			}
-			var msglen int
+			m.EpochNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4156,28 +12402,11 @@ func (m *RawCheckpointWithMetaResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EpochNum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Ckpt == nil {
-				m.Ckpt = &RawCheckpointResponse{}
-			}
-			if err := m.Ckpt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
@@ -4198,10 +12427,10 @@ func (m *RawCheckpointWithMetaResponse) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusDesc", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedPower", wireType)
 			}
-			var stringLen uint64
+			m.AccumulatedPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4211,29 +12440,35 @@ func (m *RawCheckpointWithMetaResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AccumulatedPower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.StatusDesc = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlsAggrPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SealingThreshold", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4243,32 +12478,31 @@ func (m *RawCheckpointWithMetaResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_crypto_bls12381.PublicKey
-			m.BlsAggrPk = &v
-			if err := m.BlsAggrPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SealingThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PowerSum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SealingPower", wireType)
 			}
-			m.PowerSum = 0
+			m.SealingPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4278,16 +12512,16 @@ func (m *RawCheckpointWithMetaResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PowerSum |= uint64(b&0x7F) << shift
+				m.SealingPower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lifecycle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PercentageToSeal", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4297,23 +12531,23 @@ func (m *RawCheckpointWithMetaResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lifecycle = append(m.Lifecycle, &CheckpointStateUpdateResponse{})
-			if err := m.Lifecycle[len(m.Lifecycle)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PercentageToSeal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/boljen/go-bitmap"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -56,7 +57,8 @@ func (cm *RawCheckpointWithMeta) Accumulate(
 	signerAddr sdk.ValAddress,
 	signerBlsKey bls12381.PublicKey,
 	sig bls12381.Signature,
-	totalPower int64) error {
+	totalPower int64,
+	sealingThreshold sdkmath.LegacyDec) error {
 
 	// the checkpoint should be accumulating
 	if cm.Status != Accumulating {
@@ -101,13 +103,20 @@ func (cm *RawCheckpointWithMeta) Accumulate(
 
 	// accumulate voting power and update status when the threshold is reached
 	cm.PowerSum += uint64(val.Power)
-	if int64(cm.PowerSum)*3 > totalPower*2 {
+	if cm.PowerSum >= SealingPower(totalPower, sealingThreshold) {
 		cm.Status = Sealed
 	}
 
 	return nil
 }
 
+// SealingPower returns the minimum voting power a checkpoint needs to
+// accumulate to be sealed, i.e., the given portion of the total power
+// rounded up
+func SealingPower(totalPower int64, sealingThreshold sdkmath.LegacyDec) uint64 {
+	return sdkmath.LegacyNewDec(totalPower).Mul(sealingThreshold).Ceil().TruncateInt().Uint64()
+}
+
 func (cm *RawCheckpointWithMeta) IsMoreMatureThanStatus(status CheckpointStatus) bool {
 	return cm.Status > status
 }
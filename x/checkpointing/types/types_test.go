@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
 	"github.com/babylonchain/babylon/testutil/datagen"
@@ -25,12 +26,12 @@ func TestRawCheckpointWithMeta_Accumulate1(t *testing.T) {
 	ckpt, err := ckptkeeper.BuildRawCheckpoint(ctx, epochNum, blockHash)
 	require.NoError(t, err)
 	valSet := datagen.GenRandomValSet(n)
-	err = ckpt.Accumulate(valSet, valSet[0].Addr, blsPubkeys[0], blsSigs[0], totalPower)
+	err = ckpt.Accumulate(valSet, valSet[0].Addr, blsPubkeys[0], blsSigs[0], totalPower, types.DefaultParams().SealingThreshold)
 	require.NoError(t, err)
 	require.Equal(t, types.Sealed, ckpt.Status)
 
 	// accumulate the same BLS sig
-	err = ckpt.Accumulate(valSet, valSet[0].Addr, blsPubkeys[0], blsSigs[0], totalPower)
+	err = ckpt.Accumulate(valSet, valSet[0].Addr, blsPubkeys[0], blsSigs[0], totalPower, types.DefaultParams().SealingThreshold)
 	require.ErrorIs(t, err, types.ErrCkptNotAccumulating)
 	require.Equal(t, types.Sealed, ckpt.Status)
 }
@@ -49,7 +50,7 @@ func TestRawCheckpointWithMeta_Accumulate4(t *testing.T) {
 	require.NoError(t, err)
 	valSet := datagen.GenRandomValSet(n)
 	for i := 0; i < n; i++ {
-		err = ckpt.Accumulate(valSet, valSet[i].Addr, blsPubkeys[i], blsSigs[i], totalPower)
+		err = ckpt.Accumulate(valSet, valSet[i].Addr, blsPubkeys[i], blsSigs[i], totalPower, types.DefaultParams().SealingThreshold)
 		if i <= 1 {
 			require.NoError(t, err)
 			require.Equal(t, types.Accumulating, ckpt.Status)
@@ -63,3 +64,35 @@ func TestRawCheckpointWithMeta_Accumulate4(t *testing.T) {
 		}
 	}
 }
+
+// 4 validators with a sealing threshold requiring all of them
+func TestRawCheckpointWithMeta_AccumulateFullThreshold(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	epochNum := uint64(2)
+	n := 4
+	totalPower := int64(10) * int64(n)
+	ckptkeeper, ctx, _ := testkeeper.CheckpointingKeeper(t, nil, nil)
+	blockHash := datagen.GenRandomBlockHash(r)
+	msg := types.GetSignBytes(epochNum, blockHash)
+	blsPubkeys, blsSigs := datagen.GenRandomPubkeysAndSigs(n, msg)
+	ckpt, err := ckptkeeper.BuildRawCheckpoint(ctx, epochNum, blockHash)
+	require.NoError(t, err)
+	valSet := datagen.GenRandomValSet(n)
+	for i := 0; i < n; i++ {
+		err = ckpt.Accumulate(valSet, valSet[i].Addr, blsPubkeys[i], blsSigs[i], totalPower, sdkmath.LegacyOneDec())
+		require.NoError(t, err)
+		if i < n-1 {
+			require.Equal(t, types.Accumulating, ckpt.Status)
+		} else {
+			require.Equal(t, types.Sealed, ckpt.Status)
+		}
+	}
+}
+
+func TestSealingPower(t *testing.T) {
+	threshold := types.DefaultParams().SealingThreshold
+	// the default threshold requires strictly more than 2/3 of the total power
+	for totalPower := int64(1); totalPower <= 1000; totalPower++ {
+		require.Equal(t, uint64(totalPower*2/3+1), types.SealingPower(totalPower, threshold))
+	}
+}
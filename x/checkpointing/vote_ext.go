@@ -14,13 +14,30 @@ import (
 
 // VoteExtensionHandler defines a BLS-based vote extension handlers for Babylon.
 type VoteExtensionHandler struct {
-	logger     log.Logger
-	ckptKeeper *keeper.Keeper
-	valStore   baseapp.ValidatorStore
+	logger       log.Logger
+	ckptKeeper   *keeper.Keeper
+	valStore     baseapp.ValidatorStore
+	hashProvider ckpttypes.CheckpointHashProvider
 }
 
+// NewVoteExtensionHandler creates a vote extension handler under which
+// checkpoints commit to the CometBFT block hash. Use SetCheckpointHashProvider
+// to checkpoint a different hash
 func NewVoteExtensionHandler(logger log.Logger, ckptKeeper *keeper.Keeper) *VoteExtensionHandler {
-	return &VoteExtensionHandler{logger: logger, ckptKeeper: ckptKeeper, valStore: ckptKeeper}
+	return &VoteExtensionHandler{
+		logger:       logger,
+		ckptKeeper:   ckptKeeper,
+		valStore:     ckptKeeper,
+		hashProvider: ckpttypes.NewBlockHashProvider(),
+	}
+}
+
+// SetCheckpointHashProvider sets the provider of the hash that checkpoints
+// commit to. It must be the same for all validators of the chain and set
+// before the handlers are registered
+func (h *VoteExtensionHandler) SetCheckpointHashProvider(hashProvider ckpttypes.CheckpointHashProvider) *VoteExtensionHandler {
+	h.hashProvider = hashProvider
+	return h
 }
 
 func (h *VoteExtensionHandler) SetHandlers(bApp *baseapp.BaseApp) {
@@ -30,7 +47,8 @@ func (h *VoteExtensionHandler) SetHandlers(bApp *baseapp.BaseApp) {
 
 // ExtendVote sends a BLS signature as a vote extension
 // the signature is signed over the hash of the last
-// block of the current epoch
+// block of the current epoch, as given by the checkpoint
+// hash provider
 // NOTE: we should not allow empty vote extension to be
 // sent as we cannot ensure all the vote extensions will
 // be checked by VerifyVoteExtension due to the issue
@@ -64,21 +82,23 @@ func (h *VoteExtensionHandler) ExtendVote() sdk.ExtendVoteHandler {
 			panic(fmt.Errorf("the BLS signer %s is not in the validator set", signer.String()))
 		}
 
-		// 2. sign BLS signature
-		blsSig, err := k.SignBLS(epoch.EpochNumber, req.Hash)
+		// 2. get the hash to be checkpointed
+		bhash, err := h.hashProvider.CheckpointHash(ctx, req.Height, req.Hash)
+		if err != nil {
+			// NOTE: this indicates programmatic error in CometBFT or
+			// in the checkpoint hash provider
+			panic(fmt.Errorf("invalid checkpoint hash at height %v: %w", req.Height, err))
+		}
+
+		// 3. sign BLS signature
+		blsSig, err := k.SignBLS(epoch.EpochNumber, bhash)
 		if err != nil {
 			// NOTE: this indicates misconfiguration of the BLS key
 			panic(fmt.Errorf("failed to sign BLS signature at epoch %v, height %v",
 				epoch.EpochNumber, req.Height))
 		}
 
-		var bhash ckpttypes.BlockHash
-		if err := bhash.Unmarshal(req.Hash); err != nil {
-			// NOTE: this indicates programmatic error in CometBFT
-			panic(fmt.Errorf("invalid CometBFT hash"))
-		}
-
-		// 3. build vote extension
+		// 4. build vote extension
 		ve := &ckpttypes.VoteExtension{
 			Signer:           signer.String(),
 			ValidatorAddress: k.GetValidatorAddress().String(),
@@ -143,9 +163,14 @@ func (h *VoteExtensionHandler) VerifyVoteExtension() sdk.VerifyVoteExtensionHand
 		}
 
 		// 3. verify signing hash
-		if !blsSig.BlockHash.Equal(req.Hash) {
+		bhash, err := h.hashProvider.CheckpointHash(ctx, req.Height, req.Hash)
+		if err != nil {
+			h.logger.Error("failed to get checkpoint hash", "err", err, "height", req.Height)
+			return resReject, nil
+		}
+		if !blsSig.BlockHash.Equal(bhash) {
 			// processed BlsSig message is for invalid last commit hash
-			h.logger.Error("in valid block ID in BLS sig", "want", req.Hash, "got", blsSig.BlockHash)
+			h.logger.Error("in valid block ID in BLS sig", "want", bhash.String(), "got", blsSig.BlockHash)
 			return resReject, nil
 		}
 
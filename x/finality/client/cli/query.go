@@ -1,14 +1,17 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
 
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/spf13/cobra"
 
+	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/finality/types"
 )
 
@@ -34,7 +37,15 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdBlock())
 	cmd.AddCommand(CmdListBlocks())
 	cmd.AddCommand(CmdVotesAtHeight())
+	cmd.AddCommand(CmdFinalitySigsAtHeight())
+	cmd.AddCommand(CmdFinalizingVoters())
+	cmd.AddCommand(CmdFinalityProviderFinalitySigs())
 	cmd.AddCommand(CmdListEvidences())
+	cmd.AddCommand(CmdEarliestUnfinalizedHeight())
+	cmd.AddCommand(CmdBlockSecuringDelegations())
+	cmd.AddCommand(CmdFinalityProvidersLowOnPubRand())
+	cmd.AddCommand(CmdInactiveFinalityProviders())
+	cmd.AddCommand(CmdSimulateFinalitySig())
 
 	return cmd
 }
@@ -68,6 +79,108 @@ func CmdVotesAtHeight() *cobra.Command {
 	return cmd
 }
 
+func CmdFinalizingVoters() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalizing-voters [height]",
+		Short: "retrieve the finality provider pks whose votes finalized the block at requested babylon height, in the order the votes arrived",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FinalizingVoters(cmd.Context(), &types.QueryFinalizingVotersRequest{Height: height})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdFinalitySigsAtHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-sigs-at-height [height]",
+		Short: "retrieve all finality signatures, with the voted app hash and public randomness, at requested babylon height",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FinalitySigsAtHeight(cmd.Context(), &types.QueryFinalitySigsAtHeightRequest{Height: height})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdFinalityProviderFinalitySigs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-provider-finality-sigs [fp_btc_pk_hex] [from_height] [to_height]",
+		Short: "retrieve all finality signatures, with the voted app hash and public randomness, submitted by a given finality provider within a babylon height range",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			fromHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			toHeight, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FinalityProviderFinalitySigs(cmd.Context(), &types.QueryFinalityProviderFinalitySigsRequest{
+				FpBtcPkHex: args[0],
+				FromHeight: fromHeight,
+				ToHeight:   toHeight,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "finality-provider-finality-sigs")
+
+	return cmd
+}
+
 func CmdListPublicRandomness() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-public-randomness [fp_btc_pk_hex]",
@@ -244,3 +357,168 @@ func CmdListEvidences() *cobra.Command {
 
 	return cmd
 }
+
+func CmdEarliestUnfinalizedHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "earliest-unfinalized-height",
+		Short: "retrieve the lowest babylon height that is not finalized yet and the number of votes it has",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EarliestUnfinalizedHeight(cmd.Context(), &types.QueryEarliestUnfinalizedHeightRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBlockSecuringDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-securing-delegations [height]",
+		Short: "retrieve the BTC delegations whose finality providers voted for the finalized block at requested babylon height",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BlockSecuringDelegations(cmd.Context(), &types.QueryBlockSecuringDelegationsRequest{Height: height})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdFinalityProvidersLowOnPubRand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-providers-low-on-pub-rand [min_remaining]",
+		Short: "retrieve active finality providers whose committed public randomness covers no more than min_remaining heights above the current height",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			minRemaining, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FinalityProvidersLowOnPubRand(cmd.Context(), &types.QueryFinalityProvidersLowOnPubRandRequest{MinRemaining: minRemaining})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdInactiveFinalityProviders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inactive-finality-providers [reason]",
+		Short: "retrieve finality providers not participating in finality voting at the current height under the given reason (slashed, no-active-delegations, outside-active-set, insufficient-pub-rand, any)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			reason, err := types.NewFinalityProviderInactiveReason(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.InactiveFinalityProviders(cmd.Context(), &types.QueryInactiveFinalityProvidersRequest{Reason: reason})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSimulateFinalitySig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-finality-sig [fp_btc_pk_hex] [block_height] [pub_rand] [proof] [block_app_hash] [finality_sig]",
+		Short: "check whether a finality signature would be accepted, and why not if it would be rejected",
+		Args:  cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			blockHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			pubRand, err := bbn.NewSchnorrPubRandFromHex(args[2])
+			if err != nil {
+				return err
+			}
+			proofBytes, err := hex.DecodeString(args[3])
+			if err != nil {
+				return err
+			}
+			var proof cmtcrypto.Proof
+			if err := clientCtx.Codec.Unmarshal(proofBytes, &proof); err != nil {
+				return err
+			}
+			appHash, err := hex.DecodeString(args[4])
+			if err != nil {
+				return err
+			}
+			finalitySig, err := bbn.NewSchnorrEOTSSigFromHex(args[5])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SimulateFinalitySig(cmd.Context(), &types.QuerySimulateFinalitySigRequest{
+				FpBtcPkHex:   args[0],
+				BlockHeight:  blockHeight,
+				PubRand:      pubRand,
+				Proof:        &proof,
+				BlockAppHash: appHash,
+				FinalitySig:  finalitySig,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/babylonchain/babylon/x/finality/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k Keeper) SetFinalizationDiscrepancy(ctx context.Context, discrepancy *types.FinalizationDiscrepancy) {
+	store := k.finalizationDiscrepancyStore(ctx)
+	store.Set(sdk.Uint64ToBigEndian(discrepancy.BlockHeight), k.cdc.MustMarshal(discrepancy))
+}
+
+func (k Keeper) HasFinalizationDiscrepancy(ctx context.Context, height uint64) bool {
+	store := k.finalizationDiscrepancyStore(ctx)
+	return store.Has(sdk.Uint64ToBigEndian(height))
+}
+
+func (k Keeper) GetFinalizationDiscrepancy(ctx context.Context, height uint64) (*types.FinalizationDiscrepancy, error) {
+	store := k.finalizationDiscrepancyStore(ctx)
+	discrepancyBytes := store.Get(sdk.Uint64ToBigEndian(height))
+	if len(discrepancyBytes) == 0 {
+		return nil, types.ErrEvidenceNotFound.Wrapf("no finalization discrepancy at height %d", height)
+	}
+	var discrepancy types.FinalizationDiscrepancy
+	k.cdc.MustUnmarshal(discrepancyBytes, &discrepancy)
+	return &discrepancy, nil
+}
+
+// verifyFinalizationQuorumEvidence ensures the given evidence is the vote set
+// and the voting power table recorded for the finalized block at the given
+// height, and that the votes do not reach the finalization threshold. It
+// returns the voted power and the total power of the block
+func (k Keeper) verifyFinalizationQuorumEvidence(ctx context.Context, block *types.IndexedBlock, evidence *types.FinalizationQuorumEvidence) (uint64, uint64, error) {
+	if !block.Finalized {
+		return 0, 0, types.ErrBlockNotFinalized.Wrapf("height: %d", block.Height)
+	}
+
+	// the evidence has to be the one recorded on Babylon, such that a
+	// challenge cannot be made up with a fabricated vote set or power table
+	voters := evidence.VoterSet()
+	recordedVoters := k.GetVoters(ctx, block.Height)
+	if len(voters) != len(recordedVoters) {
+		return 0, 0, types.ErrInvalidFinalizationChallenge.Wrapf("the vote set has %d voters, but %d are recorded", len(voters), len(recordedVoters))
+	}
+	for pkHex := range voters {
+		if _, ok := recordedVoters[pkHex]; !ok {
+			return 0, 0, types.ErrInvalidFinalizationChallenge.Wrapf("no vote is recorded from finality provider %s", pkHex)
+		}
+	}
+	powerTable := evidence.PowerTable()
+	recordedPowerTable := k.BTCStakingKeeper.GetVotingPowerTable(ctx, block.Height)
+	if len(powerTable) != len(recordedPowerTable) {
+		return 0, 0, types.ErrInvalidFinalizationChallenge.Wrapf("the voting power table has %d finality providers, but %d are recorded", len(powerTable), len(recordedPowerTable))
+	}
+	for pkHex, power := range powerTable {
+		recordedPower, ok := recordedPowerTable[pkHex]
+		if !ok || recordedPower != power {
+			return 0, 0, types.ErrInvalidFinalizationChallenge.Wrapf("the voting power %d of finality provider %s is not the recorded one", power, pkHex)
+		}
+	}
+
+	votedPower, totalPower := tallyPower(powerTable, voters)
+	if votedPower*3 > totalPower*2 {
+		return 0, 0, types.ErrInvalidFinalizationChallenge.Wrapf("the votes reach the finalization threshold, voted power: %d, total power: %d", votedPower, totalPower)
+	}
+	return votedPower, totalPower, nil
+}
+
+// finalizationDiscrepancyStore returns the KVStore of the finalization
+// discrepancies
+// prefix: FinalizationDiscrepancyKey
+// key: block height
+// value: FinalizationDiscrepancy
+func (k Keeper) finalizationDiscrepancyStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.FinalizationDiscrepancyKey)
+}
@@ -32,6 +32,10 @@ func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
 		k.SetPubRandCommit(ctx, prc.FpBtcPk, prc.PubRandCommit)
 	}
 
+	for _, discrepancy := range gs.FinalizationDiscrepancies {
+		k.SetFinalizationDiscrepancy(ctx, discrepancy)
+	}
+
 	return k.SetParams(ctx, gs.Params)
 }
 
@@ -62,13 +66,19 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	discrepancies, err := k.finalizationDiscrepancies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
-		Params:           k.GetParams(ctx),
-		IndexedBlocks:    blocks,
-		Evidences:        evidences,
-		VoteSigs:         voteSigs,
-		PublicRandomness: pubRandomness,
-		PubRandCommit:    prCommit,
+		Params:                    k.GetParams(ctx),
+		IndexedBlocks:             blocks,
+		Evidences:                 evidences,
+		VoteSigs:                  voteSigs,
+		PublicRandomness:          pubRandomness,
+		PubRandCommit:             prCommit,
+		FinalizationDiscrepancies: discrepancies,
 	}, nil
 }
 
@@ -110,6 +120,25 @@ func (k Keeper) evidences(ctx context.Context) (evidences []*types.Evidence, err
 	return evidences, nil
 }
 
+// finalizationDiscrepancies loads all finalization discrepancies stored.
+// This function has high resource consumption and should be only used on export genesis.
+func (k Keeper) finalizationDiscrepancies(ctx context.Context) ([]*types.FinalizationDiscrepancy, error) {
+	discrepancies := make([]*types.FinalizationDiscrepancy, 0)
+
+	iter := k.finalizationDiscrepancyStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var discrepancy types.FinalizationDiscrepancy
+		if err := k.cdc.Unmarshal(iter.Value(), &discrepancy); err != nil {
+			return nil, err
+		}
+		discrepancies = append(discrepancies, &discrepancy)
+	}
+
+	return discrepancies, nil
+}
+
 // voteSigs iterates over all votes on the store, parses the height and the finality provider
 // public key from the iterator key and the finality signature from the iterator value.
 // This function has high resource consumption and should be only used on export genesis.
@@ -33,6 +33,7 @@ func TestExportGenesis(t *testing.T) {
 	allBlocks := make([]*types.IndexedBlock, numPubRand)
 	allEvidences := make([]*types.Evidence, numPubRand)
 	allPublicRandomness := make([]*types.PublicRandomness, numPubRand)
+	allDiscrepancies := make([]*types.FinalizationDiscrepancy, numPubRand)
 	for i := 0; i < int(numPubRand); i++ {
 		// Votes
 		vt := &types.VoteSig{
@@ -75,6 +76,18 @@ func TestExportGenesis(t *testing.T) {
 		}
 		allPublicRandomness[i] = randomness
 
+		// finalization discrepancies
+		discrepancy := &types.FinalizationDiscrepancy{
+			BlockHeight:    blkHeight,
+			AppHash:        blockHash,
+			VotedPower:     datagen.RandomInt(r, 100),
+			TotalPower:     datagen.RandomInt(r, 100) + 100,
+			Reporter:       signer,
+			RecordedHeight: blkHeight + 1,
+		}
+		k.SetFinalizationDiscrepancy(ctx, discrepancy)
+		allDiscrepancies[i] = discrepancy
+
 		// updates the block everytime to make sure something is different.
 		blkHeight++
 	}
@@ -100,4 +113,5 @@ func TestExportGenesis(t *testing.T) {
 	require.Equal(t, allEvidences, gs.Evidences)
 	require.Equal(t, allPublicRandomness, gs.PublicRandomness)
 	require.Equal(t, prc, gs.PubRandCommit[0].PubRandCommit)
+	require.Equal(t, allDiscrepancies, gs.FinalizationDiscrepancies)
 }
@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 
@@ -13,6 +16,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	bbn "github.com/babylonchain/babylon/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/babylonchain/babylon/x/finality/types"
 )
 
@@ -159,6 +163,114 @@ func (k Keeper) VotesAtHeight(ctx context.Context, req *types.QueryVotesAtHeight
 	return &types.QueryVotesAtHeightResponse{BtcPks: btcPks}, nil
 }
 
+// FinalitySigsAtHeight returns the finality signatures on the Babylon block at a
+// given height, together with the voted app hash and public randomness
+func (k Keeper) FinalitySigsAtHeight(ctx context.Context, req *types.QueryFinalitySigsAtHeightRequest) (*types.QueryFinalitySigsAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	block, err := k.GetBlock(sdkCtx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	sigs := []*types.FinalitySigResponse{}
+	store := k.voteHeightStore(sdkCtx, req.Height)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		fpBTCPK, err := bbn.NewBIP340PubKey(iter.Key())
+		if err != nil {
+			// failing to unmarshal finality provider BTC PK in KVStore is a programming error
+			panic(fmt.Errorf("%w: %w", bbn.ErrUnmarshal, err))
+		}
+		sig, err := bbn.NewSchnorrEOTSSig(iter.Value())
+		if err != nil {
+			// failing to unmarshal EOTS sig in KVStore is a programming error
+			panic(fmt.Errorf("failed to unmarshal EOTS signature: %w", err))
+		}
+		// votes are only accepted upon verifying them against the public
+		// randomness, so the public randomness always exists
+		pubRand, err := k.GetPubRand(sdkCtx, fpBTCPK, req.Height)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get public randomness of finality provider %s at height %d: %v",
+				fpBTCPK.MarshalHex(), req.Height, err)
+		}
+
+		sigs = append(sigs, &types.FinalitySigResponse{
+			FpBtcPk:      fpBTCPK,
+			BlockAppHash: block.AppHash,
+			FinalitySig:  sig,
+			PubRand:      pubRand,
+			Height:       req.Height,
+		})
+	}
+
+	return &types.QueryFinalitySigsAtHeightResponse{Sigs: sigs}, nil
+}
+
+// FinalityProviderFinalitySigs returns the finality signatures submitted by a
+// given finality provider on the blocks within a given height range
+func (k Keeper) FinalityProviderFinalitySigs(ctx context.Context, req *types.QueryFinalityProviderFinalitySigsRequest) (*types.QueryFinalityProviderFinalitySigsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.FromHeight > req.ToHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "from height %d is larger than to height %d", req.FromHeight, req.ToHeight)
+	}
+
+	fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(req.FpBtcPkHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to unmarshal finality provider BTC PK hex: %v", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	// public randomness is stored upon each vote of the finality provider, so
+	// its public randomness store indexes the heights it has voted at
+	store := k.pubRandFpStore(sdkCtx, fpBTCPK)
+	sigs := []*types.FinalitySigResponse{}
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		height := sdk.BigEndianToUint64(key)
+		if height < req.FromHeight || height > req.ToHeight {
+			return false, nil
+		}
+		// the vote might not be stored, e.g., if it is on a fork
+		sig, err := k.GetSig(sdkCtx, height, fpBTCPK)
+		if err != nil {
+			return false, nil
+		}
+		if accumulate {
+			block, err := k.GetBlock(sdkCtx, height)
+			if err != nil {
+				return false, err
+			}
+			pubRand, err := bbn.NewSchnorrPubRand(value)
+			if err != nil {
+				panic("failed to unmarshal EOTS public randomness in KVStore")
+			}
+			sigs = append(sigs, &types.FinalitySigResponse{
+				FpBtcPk:      fpBTCPK,
+				BlockAppHash: block.AppHash,
+				FinalitySig:  sig,
+				PubRand:      pubRand,
+				Height:       height,
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFinalityProviderFinalitySigsResponse{
+		Sigs:       sigs,
+		Pagination: pageRes,
+	}, nil
+}
+
 // Evidence returns the first evidence that allows to extract the finality provider's SK
 // associated with the given finality provider's PK.
 func (k Keeper) Evidence(ctx context.Context, req *types.QueryEvidenceRequest) (*types.QueryEvidenceResponse, error) {
@@ -225,3 +337,295 @@ func (k Keeper) ListEvidences(ctx context.Context, req *types.QueryListEvidences
 	}
 	return resp, nil
 }
+
+// EarliestUnfinalizedHeight returns the lowest height since the BTC staking
+// protocol is activated that is not finalized yet, together with the number
+// of votes it has received
+func (k Keeper) EarliestUnfinalizedHeight(ctx context.Context, req *types.QueryEarliestUnfinalizedHeightRequest) (*types.QueryEarliestUnfinalizedHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	activatedHeight, err := k.BTCStakingKeeper.GetBTCStakingActivatedHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// blocks below the next height to finalise are either finalised or
+	// non-finalisable
+	height := k.getNextHeightToFinalize(ctx)
+	if height < activatedHeight {
+		height = activatedHeight
+	}
+	if !k.HasBlock(ctx, height) {
+		return nil, types.ErrBlockNotFound.Wrapf("all indexed blocks since height %d are finalized", activatedHeight)
+	}
+
+	return &types.QueryEarliestUnfinalizedHeightResponse{
+		Height:   height,
+		NumVotes: uint64(len(k.GetVoters(ctx, height))),
+	}, nil
+}
+
+// BlockSecuringDelegations returns the BTC delegations whose finality providers
+// voted for the finalized block at the given height, i.e., the BTC delegations
+// that were active at that height under the voters
+func (k Keeper) BlockSecuringDelegations(ctx context.Context, req *types.QueryBlockSecuringDelegationsRequest) (*types.QueryBlockSecuringDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	block, err := k.GetBlock(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	if !block.Finalized {
+		return nil, types.ErrBlockNotFinalized.Wrapf("height: %d", req.Height)
+	}
+
+	// sort the voters for a deterministic order of BTC delegations
+	voterBTCPKs := []string{}
+	for pkHex := range k.GetVoters(ctx, req.Height) {
+		voterBTCPKs = append(voterBTCPKs, pkHex)
+	}
+	sort.Strings(voterBTCPKs)
+
+	// a BTC delegation restaked to multiple voters is included once, along
+	// with all of these voters
+	resp := &types.QueryBlockSecuringDelegationsResponse{}
+	delIdx := map[string]int{}
+	for _, pkHex := range voterBTCPKs {
+		fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(pkHex)
+		if err != nil {
+			// failing to unmarshal finality provider BTC PK in KVStore is a programming error
+			panic(fmt.Errorf("%w: %w", bbn.ErrUnmarshal, err))
+		}
+		for _, btcDel := range k.BTCStakingKeeper.GetActiveBTCDelegationsAtHeight(ctx, fpBTCPK, req.Height) {
+			stakingTxHash := btcDel.MustGetStakingTxHash().String()
+			if i, ok := delIdx[stakingTxHash]; ok {
+				resp.Delegations[i].FpBtcPkHexList = append(resp.Delegations[i].FpBtcPkHexList, pkHex)
+				continue
+			}
+			delIdx[stakingTxHash] = len(resp.Delegations)
+			resp.Delegations = append(resp.Delegations, &types.SecuringDelegation{
+				StakingTxHashHex: stakingTxHash,
+				BtcPkHex:         btcDel.BtcPk.MarshalHex(),
+				TotalSat:         btcDel.TotalSat,
+				FpBtcPkHexList:   []string{pkHex},
+			})
+			resp.TotalSat += btcDel.TotalSat
+		}
+	}
+
+	return resp, nil
+}
+
+// FinalityProvidersLowOnPubRand returns the finality providers in the voting
+// power table at the current height whose highest height with committed public
+// randomness is within the given number of heights above the current height,
+// such that they can be alerted to commit more before missing votes
+func (k Keeper) FinalityProvidersLowOnPubRand(ctx context.Context, req *types.QueryFinalityProvidersLowOnPubRandRequest) (*types.QueryFinalityProvidersLowOnPubRandResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	curHeight := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	resp := &types.QueryFinalityProvidersLowOnPubRandResponse{CurrentHeight: curHeight}
+
+	// sort the finality providers for a deterministic order
+	fpBTCPKs := []string{}
+	for pkHex := range k.BTCStakingKeeper.GetVotingPowerTable(ctx, curHeight) {
+		fpBTCPKs = append(fpBTCPKs, pkHex)
+	}
+	sort.Strings(fpBTCPKs)
+
+	for _, pkHex := range fpBTCPKs {
+		fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(pkHex)
+		if err != nil {
+			// failing to unmarshal finality provider BTC PK in KVStore is a programming error
+			panic(fmt.Errorf("%w: %w", bbn.ErrUnmarshal, err))
+		}
+		fpStatus := &types.FinalityProviderPubRandStatus{FpBtcPkHex: pkHex}
+		if prCommit := k.GetLastPubRandCommit(ctx, fpBTCPK); prCommit != nil {
+			fpStatus.HasPubRandCommit = true
+			fpStatus.LastCommittedHeight = prCommit.EndHeight()
+			if fpStatus.LastCommittedHeight > curHeight {
+				fpStatus.Remaining = fpStatus.LastCommittedHeight - curHeight
+			}
+		}
+		if fpStatus.Remaining <= req.MinRemaining {
+			resp.FinalityProviders = append(resp.FinalityProviders, fpStatus)
+		}
+	}
+
+	return resp, nil
+}
+
+// InactiveFinalityProviders returns the finality providers that do not
+// participate in finality voting at the current height, each annotated with
+// the reason why. Finality providers in the voting power table are inactive
+// only if they have no public randomness committed for the current height
+func (k Keeper) InactiveFinalityProviders(ctx context.Context, req *types.QueryInactiveFinalityProvidersRequest) (*types.QueryInactiveFinalityProvidersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	curHeight := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	vpTable := k.BTCStakingKeeper.GetVotingPowerTable(ctx, curHeight)
+	resp := &types.QueryInactiveFinalityProvidersResponse{CurrentHeight: curHeight}
+
+	var iterErr error
+	k.BTCStakingKeeper.IterateFPs(ctx, func(fp *bstypes.FinalityProvider) bool {
+		var reason types.FinalityProviderInactiveReason
+		switch {
+		case vpTable[fp.BtcPk.MarshalHex()] > 0:
+			_, err := k.GetPubRandCommitForHeight(ctx, fp.BtcPk, curHeight)
+			if err == nil {
+				// the finality provider is active
+				return true
+			}
+			if !errors.Is(err, types.ErrPubRandNotFound) {
+				iterErr = err
+				return false
+			}
+			reason = types.FinalityProviderInactiveReason_INACTIVE_REASON_INSUFFICIENT_PUB_RAND
+		case fp.IsSlashed():
+			reason = types.FinalityProviderInactiveReason_INACTIVE_REASON_SLASHED
+		case len(k.BTCStakingKeeper.GetActiveBTCDelegationsAtHeight(ctx, fp.BtcPk, curHeight)) == 0:
+			reason = types.FinalityProviderInactiveReason_INACTIVE_REASON_NO_ACTIVE_DELEGATIONS
+		default:
+			reason = types.FinalityProviderInactiveReason_INACTIVE_REASON_OUTSIDE_ACTIVE_SET
+		}
+
+		if req.Reason == types.FinalityProviderInactiveReason_INACTIVE_REASON_ANY || req.Reason == reason {
+			resp.FinalityProviders = append(resp.FinalityProviders, &types.InactiveFinalityProvider{
+				FpBtcPkHex: fp.BtcPk.MarshalHex(),
+				Reason:     reason,
+			})
+		}
+		return true
+	})
+	if iterErr != nil {
+		return nil, iterErr
+	}
+
+	return resp, nil
+}
+
+// SimulateFinalitySig checks whether the given finality signature would be
+// accepted, using the same checks as AddFinalitySig. In addition, it rejects
+// votes that would be accepted but are of no use or would get the finality
+// provider slashed, i.e., votes for finalized blocks, forks, and blocks at
+// heights where the finality provider has cast a different vote. It does not
+// change state
+func (k Keeper) SimulateFinalitySig(ctx context.Context, req *types.QuerySimulateFinalitySigRequest) (*types.QuerySimulateFinalitySigResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.PubRand == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty public randomness")
+	}
+	if req.Proof == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty public randomness inclusion proof")
+	}
+	if req.FinalitySig == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty finality signature")
+	}
+
+	fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(req.FpBtcPkHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to unmarshal finality provider BTC PK hex: %v", err)
+	}
+
+	msg := &types.MsgAddFinalitySig{
+		FpBtcPk:      fpBTCPK,
+		BlockHeight:  req.BlockHeight,
+		PubRand:      req.PubRand,
+		Proof:        req.Proof,
+		BlockAppHash: req.BlockAppHash,
+		FinalitySig:  req.FinalitySig,
+	}
+	if err := k.simulateFinalitySig(ctx, msg); err != nil {
+		return &types.QuerySimulateFinalitySigResponse{InvalidReason: err.Error()}, nil
+	}
+
+	return &types.QuerySimulateFinalitySigResponse{Valid: true}, nil
+}
+
+func (k Keeper) simulateFinalitySig(ctx context.Context, req *types.MsgAddFinalitySig) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// run exactly the checks of AddFinalitySig
+	prCommit, err := k.checkFinalitySigEligibility(sdkCtx, req)
+	if err != nil {
+		return err
+	}
+	if prCommit == nil {
+		// exactly the same vote is accepted as a no-op
+		return nil
+	}
+	if err := types.VerifyFinalitySig(req, prCommit); err != nil {
+		return err
+	}
+
+	// ensure the voted block is a canonical block that is not finalized yet
+	indexedBlock, err := k.GetBlock(ctx, req.BlockHeight)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(indexedBlock.AppHash, req.BlockAppHash) {
+		return types.ErrInvalidFinalitySig.Wrapf("the voted block at height %d is a fork of the canonical block", req.BlockHeight)
+	}
+	if indexedBlock.Finalized {
+		return types.ErrBlockAlreadyFinalized.Wrapf("height: %d", req.BlockHeight)
+	}
+
+	// ensure the finality provider has not cast a different vote at this height
+	if _, err := k.GetSig(ctx, req.BlockHeight, req.FpBtcPk); err == nil || k.HasEvidence(ctx, req.FpBtcPk, req.BlockHeight) {
+		return types.ErrConflictingVote.Wrapf("height: %d", req.BlockHeight)
+	}
+
+	return nil
+}
+
+// FinalizingVoters returns the finality providers whose votes finalized the
+// block at the given height, in the order their votes arrived, up to the vote
+// with which the voted power crosses the finalization threshold
+func (k Keeper) FinalizingVoters(ctx context.Context, req *types.QueryFinalizingVotersRequest) (*types.QueryFinalizingVotersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	b, err := k.GetBlock(sdkCtx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	if !b.Finalized {
+		return nil, types.ErrBlockNotFinalized.Wrapf("height: %d", req.Height)
+	}
+
+	fpSet := k.BTCStakingKeeper.GetVotingPowerTable(sdkCtx, req.Height)
+	resp := &types.QueryFinalizingVotersResponse{}
+	for _, power := range fpSet {
+		resp.TotalPower += power
+	}
+	for _, fpBTCPK := range k.GetOrderedVoters(sdkCtx, req.Height) {
+		power := fpSet[fpBTCPK.MarshalHex()]
+		if power == 0 {
+			continue
+		}
+		resp.FinalizingPower += power
+		pk := fpBTCPK
+		resp.Voters = append(resp.Voters, &types.FinalizingVoter{
+			FpBtcPk:         &pk,
+			VotingPower:     power,
+			CumulativePower: resp.FinalizingPower,
+		})
+		if resp.FinalizingPower*3 > resp.TotalPower*2 {
+			break
+		}
+	}
+
+	return resp, nil
+}
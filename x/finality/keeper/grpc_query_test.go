@@ -4,6 +4,7 @@ import (
 	"math/rand"
 	"testing"
 
+	"cosmossdk.io/core/header"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -13,6 +14,7 @@ import (
 	"github.com/babylonchain/babylon/testutil/datagen"
 	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
 	bbn "github.com/babylonchain/babylon/types"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/babylonchain/babylon/x/finality/keeper"
 	"github.com/babylonchain/babylon/x/finality/types"
 )
@@ -181,6 +183,152 @@ func FuzzVotesAtHeight(f *testing.F) {
 	})
 }
 
+func FuzzFinalitySigsAtHeight(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		// Setup keeper and context
+		keeper, ctx := testkeeper.FinalityKeeper(t, nil, nil)
+		ctx = sdk.UnwrapSDKContext(ctx)
+
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+
+		// the block is not indexed yet
+		_, err := keeper.FinalitySigsAtHeight(ctx, &types.QueryFinalitySigsAtHeightRequest{Height: babylonHeight})
+		require.Error(t, err)
+
+		appHash := datagen.GenRandomByteArray(r, 32)
+		keeper.SetBlock(ctx, &types.IndexedBlock{Height: babylonHeight, AppHash: appHash})
+
+		// Add random number of votes and their public randomness to the store
+		numVotedFps := datagen.RandomInt(r, 10) + 1
+		expectedSigs := make(map[string]*types.FinalitySigResponse, numVotedFps)
+		for i := uint64(0); i < numVotedFps; i++ {
+			votedFpPK, err := datagen.GenRandomBIP340PubKey(r)
+			require.NoError(t, err)
+			votedSig, err := bbn.NewSchnorrEOTSSig(datagen.GenRandomByteArray(r, 32))
+			require.NoError(t, err)
+			pubRand, err := bbn.NewSchnorrPubRand(datagen.GenRandomByteArray(r, 32))
+			require.NoError(t, err)
+			keeper.SetPubRand(ctx, votedFpPK, babylonHeight, *pubRand)
+			keeper.SetSig(ctx, babylonHeight, votedFpPK, votedSig)
+
+			expectedSigs[votedFpPK.MarshalHex()] = &types.FinalitySigResponse{
+				FpBtcPk:      votedFpPK,
+				BlockAppHash: appHash,
+				FinalitySig:  votedSig,
+				PubRand:      pubRand,
+				Height:       babylonHeight,
+			}
+		}
+
+		resp, err := keeper.FinalitySigsAtHeight(ctx, &types.QueryFinalitySigsAtHeightRequest{Height: babylonHeight})
+		require.NoError(t, err)
+		require.Len(t, resp.Sigs, len(expectedSigs))
+		for i, sig := range resp.Sigs {
+			require.Equal(t, expectedSigs[sig.FpBtcPk.MarshalHex()], sig)
+			if i > 0 {
+				require.Less(t, resp.Sigs[i-1].FpBtcPk.MarshalHex(), sig.FpBtcPk.MarshalHex())
+			}
+		}
+	})
+}
+
+func FuzzFinalityProviderFinalitySigs(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		// Setup keeper and context
+		keeper, ctx := testkeeper.FinalityKeeper(t, nil, nil)
+		numBlocks := datagen.RandomInt(r, 50) + 10
+		ctx = ctx.WithHeaderInfo(header.Info{Height: int64(numBlocks)})
+
+		fpBTCPK, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		otherFpBTCPK, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+
+		// the finality provider votes on a random subset of blocks, where
+		// votes on some blocks are not stored, e.g., due to being on a fork
+		expectedSigs := map[uint64]*types.FinalitySigResponse{}
+		for height := uint64(1); height <= numBlocks; height++ {
+			appHash := datagen.GenRandomByteArray(r, 32)
+			keeper.SetBlock(ctx, &types.IndexedBlock{Height: height, AppHash: appHash})
+
+			// another finality provider votes on every block
+			otherSig, err := bbn.NewSchnorrEOTSSig(datagen.GenRandomByteArray(r, 32))
+			require.NoError(t, err)
+			otherPubRand, err := bbn.NewSchnorrPubRand(datagen.GenRandomByteArray(r, 32))
+			require.NoError(t, err)
+			keeper.SetPubRand(ctx, otherFpBTCPK, height, *otherPubRand)
+			keeper.SetSig(ctx, height, otherFpBTCPK, otherSig)
+
+			if r.Intn(2) == 0 {
+				continue
+			}
+			pubRand, err := bbn.NewSchnorrPubRand(datagen.GenRandomByteArray(r, 32))
+			require.NoError(t, err)
+			keeper.SetPubRand(ctx, fpBTCPK, height, *pubRand)
+			if r.Intn(5) == 0 {
+				continue
+			}
+			sig, err := bbn.NewSchnorrEOTSSig(datagen.GenRandomByteArray(r, 32))
+			require.NoError(t, err)
+			keeper.SetSig(ctx, height, fpBTCPK, sig)
+			expectedSigs[height] = &types.FinalitySigResponse{
+				FpBtcPk:      fpBTCPK,
+				BlockAppHash: appHash,
+				FinalitySig:  sig,
+				PubRand:      pubRand,
+				Height:       height,
+			}
+		}
+
+		// query a random height range page by page
+		fromHeight := datagen.RandomInt(r, int(numBlocks)) + 1
+		toHeight := fromHeight + datagen.RandomInt(r, int(numBlocks-fromHeight)+1)
+		limit := datagen.RandomInt(r, 5) + 1
+		sigs := []*types.FinalitySigResponse{}
+		var nextKey []byte
+		for {
+			resp, err := keeper.FinalityProviderFinalitySigs(ctx, &types.QueryFinalityProviderFinalitySigsRequest{
+				FpBtcPkHex: fpBTCPK.MarshalHex(),
+				FromHeight: fromHeight,
+				ToHeight:   toHeight,
+				Pagination: &query.PageRequest{Key: nextKey, Limit: limit},
+			})
+			require.NoError(t, err)
+			require.LessOrEqual(t, uint64(len(resp.Sigs)), limit)
+			sigs = append(sigs, resp.Sigs...)
+			nextKey = resp.Pagination.NextKey
+			if nextKey == nil {
+				break
+			}
+		}
+
+		expectedHeights := []uint64{}
+		for height := fromHeight; height <= toHeight; height++ {
+			if _, ok := expectedSigs[height]; ok {
+				expectedHeights = append(expectedHeights, height)
+			}
+		}
+		require.Len(t, sigs, len(expectedHeights))
+		for i, sig := range sigs {
+			require.Equal(t, expectedSigs[expectedHeights[i]], sig)
+		}
+
+		// invalid height range
+		_, err = keeper.FinalityProviderFinalitySigs(ctx, &types.QueryFinalityProviderFinalitySigsRequest{
+			FpBtcPkHex: fpBTCPK.MarshalHex(),
+			FromHeight: toHeight + 1,
+			ToHeight:   toHeight,
+		})
+		require.Error(t, err)
+	})
+}
+
 func FuzzListPubRandCommit(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 	f.Fuzz(func(t *testing.T, seed int64) {
@@ -363,3 +511,491 @@ func FuzzListEvidences(f *testing.F) {
 		}
 	})
 }
+
+func FuzzEarliestUnfinalizedHeight(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		bsKeeper.EXPECT().GetParams(gomock.Any()).Return(bstypes.Params{MaxActiveFinalityProviders: 100}).AnyTimes()
+		iKeeper := types.NewMockIncentiveKeeper(ctrl)
+		fKeeper, ctx := testkeeper.FinalityKeeper(t, bsKeeper, iKeeper)
+
+		// BTC staking protocol is not activated yet
+		bsKeeper.EXPECT().GetBTCStakingActivatedHeight(gomock.Any()).Return(uint64(0), bstypes.ErrBTCStakingNotActivated).Times(1)
+		_, err := fKeeper.EarliestUnfinalizedHeight(ctx, &types.QueryEarliestUnfinalizedHeightRequest{})
+		require.Error(t, err)
+
+		// activate BTC staking protocol at a random height
+		activatedHeight := datagen.RandomInt(r, 10) + 1
+		bsKeeper.EXPECT().GetBTCStakingActivatedHeight(gomock.Any()).Return(activatedHeight, nil).AnyTimes()
+
+		// no block is indexed yet
+		_, err = fKeeper.EarliestUnfinalizedHeight(ctx, &types.QueryEarliestUnfinalizedHeightRequest{})
+		require.ErrorIs(t, err, types.ErrBlockNotFound)
+
+		// index a list of blocks, give some of them QCs, and tally them
+		numWithQCs := datagen.RandomInt(r, 5) + 1
+		for i := activatedHeight; i < activatedHeight+10; i++ {
+			fKeeper.SetBlock(ctx, &types.IndexedBlock{
+				Height:    i,
+				AppHash:   datagen.GenRandomByteArray(r, 32),
+				Finalized: false,
+			})
+			if i < activatedHeight+numWithQCs {
+				err := giveQCToHeight(r, ctx, bsKeeper, fKeeper, i)
+				require.NoError(t, err)
+			} else {
+				err := giveNoQCToHeight(r, ctx, bsKeeper, fKeeper, i)
+				require.NoError(t, err)
+			}
+		}
+		// we don't test incentive in this function
+		bsKeeper.EXPECT().GetVotingPowerDistCache(gomock.Any(), gomock.Any()).Return(bstypes.NewVotingPowerDistCache(), nil).Times(int(numWithQCs))
+		iKeeper.EXPECT().RewardBTCStaking(gomock.Any(), gomock.Any(), gomock.Any()).Return().Times(int(numWithQCs))
+		bsKeeper.EXPECT().RemoveVotingPowerDistCache(gomock.Any(), gomock.Any()).Return().Times(int(numWithQCs))
+		ctx = datagen.WithCtxHeight(ctx, activatedHeight+10-1)
+		fKeeper.TallyBlocks(ctx)
+
+		// the earliest unfinalized height is the first block without QC, which
+		// has 1 vote
+		resp, err := fKeeper.EarliestUnfinalizedHeight(ctx, &types.QueryEarliestUnfinalizedHeightRequest{})
+		require.NoError(t, err)
+		require.Equal(t, activatedHeight+numWithQCs, resp.Height)
+		require.Equal(t, uint64(1), resp.NumVotes)
+	})
+}
+
+func FuzzBlockSecuringDelegations(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper, ctx := testkeeper.FinalityKeeper(t, bsKeeper, nil)
+
+		// index a block that is not finalized yet
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		block := &types.IndexedBlock{
+			Height:    babylonHeight,
+			AppHash:   datagen.GenRandomByteArray(r, 32),
+			Finalized: false,
+		}
+		fKeeper.SetBlock(ctx, block)
+		_, err := fKeeper.BlockSecuringDelegations(ctx, &types.QueryBlockSecuringDelegationsRequest{Height: babylonHeight})
+		require.ErrorIs(t, err, types.ErrBlockNotFinalized)
+		block.Finalized = true
+		fKeeper.SetBlock(ctx, block)
+
+		// a BTC delegation restaked to all voters
+		sharedDel := genRandomBTCDelegation(t, r)
+		expectedSat := sharedDel.TotalSat
+		expectedDels := map[string][]string{
+			sharedDel.MustGetStakingTxHash().String(): {},
+		}
+
+		// random voters, each of which has random BTC delegations
+		numVoters := datagen.RandomInt(r, 5) + 1
+		for i := uint64(0); i < numVoters; i++ {
+			fpBTCPK, err := datagen.GenRandomBIP340PubKey(r)
+			require.NoError(t, err)
+			sig, err := bbn.NewSchnorrEOTSSig(datagen.GenRandomByteArray(r, 32))
+			require.NoError(t, err)
+			fKeeper.SetSig(ctx, babylonHeight, fpBTCPK, sig)
+
+			btcDels := []*bstypes.BTCDelegation{sharedDel}
+			numDels := datagen.RandomInt(r, 5)
+			for j := uint64(0); j < numDels; j++ {
+				btcDel := genRandomBTCDelegation(t, r)
+				btcDels = append(btcDels, btcDel)
+				expectedSat += btcDel.TotalSat
+				expectedDels[btcDel.MustGetStakingTxHash().String()] = []string{}
+			}
+			for _, btcDel := range btcDels {
+				stakingTxHash := btcDel.MustGetStakingTxHash().String()
+				expectedDels[stakingTxHash] = append(expectedDels[stakingTxHash], fpBTCPK.MarshalHex())
+			}
+			bsKeeper.EXPECT().GetActiveBTCDelegationsAtHeight(gomock.Any(), fpBTCPK, babylonHeight).Return(btcDels).Times(1)
+		}
+
+		// each BTC delegation is returned once along with all of its voters
+		resp, err := fKeeper.BlockSecuringDelegations(ctx, &types.QueryBlockSecuringDelegationsRequest{Height: babylonHeight})
+		require.NoError(t, err)
+		require.Equal(t, expectedSat, resp.TotalSat)
+		require.Len(t, resp.Delegations, len(expectedDels))
+		for _, del := range resp.Delegations {
+			require.ElementsMatch(t, expectedDels[del.StakingTxHashHex], del.FpBtcPkHexList)
+		}
+	})
+}
+
+func genRandomBTCDelegation(t *testing.T, r *rand.Rand) *bstypes.BTCDelegation {
+	delBTCPK, err := datagen.GenRandomBIP340PubKey(r)
+	require.NoError(t, err)
+	stakingTx, err := bbn.SerializeBTCTx(datagen.GenRandomTx(r))
+	require.NoError(t, err)
+	return &bstypes.BTCDelegation{
+		BtcPk:     delBTCPK,
+		StakingTx: stakingTx,
+		TotalSat:  datagen.RandomInt(r, 100000) + 1,
+	}
+}
+
+func FuzzFinalityProvidersLowOnPubRand(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper, ctx := testkeeper.FinalityKeeper(t, bsKeeper, nil)
+		curHeight := datagen.RandomInt(r, 1000) + 100
+		ctx = datagen.WithCtxHeight(ctx, curHeight)
+		minRemaining := datagen.RandomInt(r, 50)
+
+		// random active finality providers, each of which has committed public
+		// randomness up to a random height, or has never committed
+		vpTable := map[string]uint64{}
+		expectedLow := map[string]uint64{} // key: BTC PK hex, value: remaining
+		numFps := datagen.RandomInt(r, 10) + 1
+		for i := uint64(0); i < numFps; i++ {
+			fpBTCPK, err := datagen.GenRandomBIP340PubKey(r)
+			require.NoError(t, err)
+			vpTable[fpBTCPK.MarshalHex()] = datagen.RandomInt(r, 1000) + 1
+			if datagen.OneInN(r, 5) {
+				expectedLow[fpBTCPK.MarshalHex()] = 0
+				continue
+			}
+			prCommit := &types.PubRandCommit{
+				StartHeight: datagen.RandomInt(r, int(curHeight)) + 1,
+				NumPubRand:  datagen.RandomInt(r, 200) + 1,
+				Commitment:  datagen.GenRandomByteArray(r, 32),
+			}
+			fKeeper.SetPubRandCommit(ctx, fpBTCPK, prCommit)
+			remaining := uint64(0)
+			if prCommit.EndHeight() > curHeight {
+				remaining = prCommit.EndHeight() - curHeight
+			}
+			if remaining <= minRemaining {
+				expectedLow[fpBTCPK.MarshalHex()] = remaining
+			}
+		}
+		bsKeeper.EXPECT().GetVotingPowerTable(gomock.Any(), curHeight).Return(vpTable).Times(1)
+
+		resp, err := fKeeper.FinalityProvidersLowOnPubRand(ctx, &types.QueryFinalityProvidersLowOnPubRandRequest{MinRemaining: minRemaining})
+		require.NoError(t, err)
+		require.Equal(t, curHeight, resp.CurrentHeight)
+		require.Len(t, resp.FinalityProviders, len(expectedLow))
+		for _, fpStatus := range resp.FinalityProviders {
+			remaining, ok := expectedLow[fpStatus.FpBtcPkHex]
+			require.True(t, ok)
+			require.Equal(t, remaining, fpStatus.Remaining)
+		}
+	})
+}
+
+func FuzzInactiveFinalityProviders(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper, ctx := testkeeper.FinalityKeeper(t, bsKeeper, nil)
+		curHeight := datagen.RandomInt(r, 1000) + 100
+		ctx = datagen.WithCtxHeight(ctx, curHeight)
+
+		// random finality providers, each of which is either active or
+		// inactive under a random reason
+		fps := []*bstypes.FinalityProvider{}
+		vpTable := map[string]uint64{}
+		expectedReasons := map[string]types.FinalityProviderInactiveReason{} // key: BTC PK hex
+		numFps := datagen.RandomInt(r, 10) + 1
+		for i := uint64(0); i < numFps; i++ {
+			fp, err := datagen.GenRandomFinalityProvider(r)
+			require.NoError(t, err)
+			fps = append(fps, fp)
+			fpBTCPKHex := fp.BtcPk.MarshalHex()
+
+			switch datagen.RandomInt(r, 5) {
+			case 0:
+				// active, with public randomness for the current height
+				vpTable[fpBTCPKHex] = datagen.RandomInt(r, 1000) + 1
+				fKeeper.SetPubRandCommit(ctx, fp.BtcPk, &types.PubRandCommit{
+					StartHeight: curHeight - datagen.RandomInt(r, 50),
+					NumPubRand:  100,
+					Commitment:  datagen.GenRandomByteArray(r, 32),
+				})
+			case 1:
+				vpTable[fpBTCPKHex] = datagen.RandomInt(r, 1000) + 1
+				expectedReasons[fpBTCPKHex] = types.FinalityProviderInactiveReason_INACTIVE_REASON_INSUFFICIENT_PUB_RAND
+			case 2:
+				fp.SlashedBabylonHeight = datagen.RandomInt(r, int(curHeight)) + 1
+				expectedReasons[fpBTCPKHex] = types.FinalityProviderInactiveReason_INACTIVE_REASON_SLASHED
+			case 3:
+				bsKeeper.EXPECT().GetActiveBTCDelegationsAtHeight(gomock.Any(), fp.BtcPk, curHeight).Return(nil).AnyTimes()
+				expectedReasons[fpBTCPKHex] = types.FinalityProviderInactiveReason_INACTIVE_REASON_NO_ACTIVE_DELEGATIONS
+			case 4:
+				bsKeeper.EXPECT().GetActiveBTCDelegationsAtHeight(gomock.Any(), fp.BtcPk, curHeight).Return([]*bstypes.BTCDelegation{{}}).AnyTimes()
+				expectedReasons[fpBTCPKHex] = types.FinalityProviderInactiveReason_INACTIVE_REASON_OUTSIDE_ACTIVE_SET
+			}
+		}
+		bsKeeper.EXPECT().GetVotingPowerTable(gomock.Any(), curHeight).Return(vpTable).AnyTimes()
+		bsKeeper.EXPECT().IterateFPs(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ interface{}, handler func(fp *bstypes.FinalityProvider) bool) {
+				for _, fp := range fps {
+					if !handler(fp) {
+						return
+					}
+				}
+			},
+		).AnyTimes()
+
+		// all inactive finality providers are returned with their reasons
+		resp, err := fKeeper.InactiveFinalityProviders(ctx, &types.QueryInactiveFinalityProvidersRequest{
+			Reason: types.FinalityProviderInactiveReason_INACTIVE_REASON_ANY,
+		})
+		require.NoError(t, err)
+		require.Equal(t, curHeight, resp.CurrentHeight)
+		require.Len(t, resp.FinalityProviders, len(expectedReasons))
+		for _, inactiveFp := range resp.FinalityProviders {
+			require.Equal(t, expectedReasons[inactiveFp.FpBtcPkHex], inactiveFp.Reason)
+		}
+
+		// filtering by a given reason only returns the matching ones
+		reason := types.FinalityProviderInactiveReason(datagen.RandomInt(r, 4) + 1)
+		resp, err = fKeeper.InactiveFinalityProviders(ctx, &types.QueryInactiveFinalityProvidersRequest{Reason: reason})
+		require.NoError(t, err)
+		numExpected := 0
+		for _, expectedReason := range expectedReasons {
+			if expectedReason == reason {
+				numExpected++
+			}
+		}
+		require.Len(t, resp.FinalityProviders, numExpected)
+		for _, inactiveFp := range resp.FinalityProviders {
+			require.Equal(t, reason, inactiveFp.Reason)
+		}
+	})
+}
+
+func FuzzSimulateFinalitySig(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper, ctx := testkeeper.FinalityKeeper(t, bsKeeper, nil)
+		ms := keeper.NewMsgServerImpl(*fKeeper)
+
+		// create and register a random finality provider with voting power
+		btcSK, btcPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		fp, err := datagen.GenRandomFinalityProviderWithBTCSK(r, btcSK)
+		require.NoError(t, err)
+		fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(btcPK)
+		fpBTCPKBytes := fpBTCPK.MustMarshal()
+		bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(true).AnyTimes()
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).AnyTimes()
+		bsKeeper.EXPECT().GetVotingPower(gomock.Any(), gomock.Eq(fpBTCPKBytes), gomock.Any()).Return(uint64(1)).AnyTimes()
+
+		// commit some public randomness
+		startHeight := uint64(0)
+		numPubRand := uint64(200)
+		randListInfo, msgCommitPubRandList, err := datagen.GenRandomMsgCommitPubRandList(r, btcSK, startHeight, numPubRand)
+		require.NoError(t, err)
+		_, err = ms.CommitPubRandList(ctx, msgCommitPubRandList)
+		require.NoError(t, err)
+
+		signer := datagen.GenRandomAccount().Address
+		simulate := func(msg *types.MsgAddFinalitySig) *types.QuerySimulateFinalitySigResponse {
+			resp, err := fKeeper.SimulateFinalitySig(ctx, &types.QuerySimulateFinalitySigRequest{
+				FpBtcPkHex:   msg.FpBtcPk.MarshalHex(),
+				BlockHeight:  msg.BlockHeight,
+				PubRand:      msg.PubRand,
+				Proof:        msg.Proof,
+				BlockAppHash: msg.BlockAppHash,
+				FinalitySig:  msg.FinalitySig,
+			})
+			require.NoError(t, err)
+			return resp
+		}
+
+		// a vote for a block that is not indexed yet is rejected
+		blockHeight := uint64(1)
+		blockAppHash := datagen.GenRandomByteArray(r, 32)
+		msg, err := datagen.NewMsgAddFinalitySig(signer, btcSK, startHeight, blockHeight, randListInfo, blockAppHash)
+		require.NoError(t, err)
+		resp := simulate(msg)
+		require.False(t, resp.Valid)
+		require.Contains(t, resp.InvalidReason, types.ErrBlockNotFound.Error())
+
+		// a valid vote for a canonical block is accepted, without being stored
+		fKeeper.SetBlock(ctx, &types.IndexedBlock{Height: blockHeight, AppHash: blockAppHash})
+		resp = simulate(msg)
+		require.True(t, resp.Valid)
+		require.Empty(t, resp.InvalidReason)
+		_, err = fKeeper.GetSig(ctx, blockHeight, fpBTCPK)
+		require.Error(t, err)
+
+		// a vote at a height without committed public randomness is rejected
+		uncommittedMsg := *msg
+		uncommittedMsg.BlockHeight = startHeight + numPubRand + 1
+		resp = simulate(&uncommittedMsg)
+		require.False(t, resp.Valid)
+		require.Contains(t, resp.InvalidReason, types.ErrPubRandNotCommitted.Error())
+
+		// a vote for a fork is rejected
+		forkMsg, err := datagen.NewMsgAddFinalitySig(signer, btcSK, startHeight, blockHeight, randListInfo, datagen.GenRandomByteArray(r, 32))
+		require.NoError(t, err)
+		resp = simulate(forkMsg)
+		require.False(t, resp.Valid)
+		require.Contains(t, resp.InvalidReason, types.ErrInvalidFinalitySig.Error())
+
+		// a vote with a signature over another app hash is rejected
+		invalidMsg := *msg
+		invalidMsg.FinalitySig = forkMsg.FinalitySig
+		resp = simulate(&invalidMsg)
+		require.False(t, resp.Valid)
+
+		// resubmitting the same vote is accepted
+		_, err = ms.AddFinalitySig(ctx, msg)
+		require.NoError(t, err)
+		resp = simulate(msg)
+		require.True(t, resp.Valid)
+
+		// a vote conflicting with a prior vote for a fork is rejected
+		blockHeight2 := blockHeight + 1
+		blockAppHash2 := datagen.GenRandomByteArray(r, 32)
+		fKeeper.SetBlock(ctx, &types.IndexedBlock{Height: blockHeight2, AppHash: blockAppHash2})
+		forkMsg2, err := datagen.NewMsgAddFinalitySig(signer, btcSK, startHeight, blockHeight2, randListInfo, datagen.GenRandomByteArray(r, 32))
+		require.NoError(t, err)
+		_, err = ms.AddFinalitySig(ctx, forkMsg2)
+		require.NoError(t, err)
+		msg2, err := datagen.NewMsgAddFinalitySig(signer, btcSK, startHeight, blockHeight2, randListInfo, blockAppHash2)
+		require.NoError(t, err)
+		resp = simulate(msg2)
+		require.False(t, resp.Valid)
+		require.Contains(t, resp.InvalidReason, types.ErrConflictingVote.Error())
+
+		// a vote for a finalized block is rejected
+		blockHeight3 := blockHeight2 + 1
+		blockAppHash3 := datagen.GenRandomByteArray(r, 32)
+		fKeeper.SetBlock(ctx, &types.IndexedBlock{Height: blockHeight3, AppHash: blockAppHash3, Finalized: true})
+		msg3, err := datagen.NewMsgAddFinalitySig(signer, btcSK, startHeight, blockHeight3, randListInfo, blockAppHash3)
+		require.NoError(t, err)
+		resp = simulate(msg3)
+		require.False(t, resp.Valid)
+		require.Contains(t, resp.InvalidReason, types.ErrBlockAlreadyFinalized.Error())
+	})
+}
+
+func FuzzFinalizingVoters(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper, ctx := testkeeper.FinalityKeeper(t, bsKeeper, nil)
+
+		// a random voting power table at a finalized height
+		height := datagen.RandomInt(r, 100) + 1
+		fKeeper.SetBlock(ctx, &types.IndexedBlock{
+			Height:    height,
+			AppHash:   datagen.GenRandomByteArray(r, 32),
+			Finalized: true,
+		})
+		numFps := int(datagen.RandomInt(r, 10)) + 1
+		powerTable := map[string]uint64{}
+		fpPKs := []*bbn.BIP340PubKey{}
+		totalPower := uint64(0)
+		for i := 0; i < numFps; i++ {
+			fpPK, err := datagen.GenRandomBIP340PubKey(r)
+			require.NoError(t, err)
+			power := datagen.RandomInt(r, 1000) + 1
+			powerTable[fpPK.MarshalHex()] = power
+			totalPower += power
+			fpPKs = append(fpPKs, fpPK)
+		}
+		bsKeeper.EXPECT().GetVotingPowerTable(gomock.Any(), gomock.Eq(height)).Return(powerTable).AnyTimes()
+
+		// a random subset of the finality providers and a finality provider
+		// without voting power vote in a random order
+		noPowerFpPK, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		voters := []*bbn.BIP340PubKey{noPowerFpPK}
+		for _, fpPK := range fpPKs {
+			if datagen.OneInN(r, 4) {
+				continue
+			}
+			voters = append(voters, fpPK)
+		}
+		r.Shuffle(len(voters), func(i, j int) { voters[i], voters[j] = voters[j], voters[i] })
+		for _, voter := range voters {
+			sig, err := bbn.NewSchnorrEOTSSig(datagen.GenRandomByteArray(r, 32))
+			require.NoError(t, err)
+			fKeeper.SetSig(ctx, height, voter, sig)
+		}
+		// overwriting a vote does not change the arrival order
+		sig, err := bbn.NewSchnorrEOTSSig(datagen.GenRandomByteArray(r, 32))
+		require.NoError(t, err)
+		fKeeper.SetSig(ctx, height, voters[0], sig)
+
+		// the expected finalizing voters are the shortest prefix of the votes
+		// with voting power that crosses the finalization threshold
+		expectedVoters := []*types.FinalizingVoter{}
+		cumulativePower := uint64(0)
+		for _, voter := range voters {
+			power := powerTable[voter.MarshalHex()]
+			if power == 0 {
+				continue
+			}
+			cumulativePower += power
+			expectedVoters = append(expectedVoters, &types.FinalizingVoter{
+				FpBtcPk:         voter,
+				VotingPower:     power,
+				CumulativePower: cumulativePower,
+			})
+			if cumulativePower*3 > totalPower*2 {
+				break
+			}
+		}
+
+		resp, err := fKeeper.FinalizingVoters(ctx, &types.QueryFinalizingVotersRequest{Height: height})
+		require.NoError(t, err)
+		require.Equal(t, totalPower, resp.TotalPower)
+		require.Equal(t, cumulativePower, resp.FinalizingPower)
+		require.Len(t, resp.Voters, len(expectedVoters))
+		for i, voter := range resp.Voters {
+			require.True(t, expectedVoters[i].FpBtcPk.Equals(voter.FpBtcPk))
+			require.Equal(t, expectedVoters[i].VotingPower, voter.VotingPower)
+			require.Equal(t, expectedVoters[i].CumulativePower, voter.CumulativePower)
+			// only the last voter crosses the finalization threshold
+			if i < len(resp.Voters)-1 {
+				require.LessOrEqual(t, voter.CumulativePower*3, totalPower*2)
+			}
+		}
+
+		// a block that is not finalized has no finalizing voters
+		fKeeper.SetBlock(ctx, &types.IndexedBlock{
+			Height:  height + 1,
+			AppHash: datagen.GenRandomByteArray(r, 32),
+		})
+		_, err = fKeeper.FinalizingVoters(ctx, &types.QueryFinalizingVotersRequest{Height: height + 1})
+		require.ErrorIs(t, err, types.ErrBlockNotFinalized)
+		_, err = fKeeper.FinalizingVoters(ctx, &types.QueryFinalizingVotersRequest{Height: height + 2})
+		require.ErrorIs(t, err, types.ErrBlockNotFound)
+	})
+}
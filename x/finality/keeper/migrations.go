@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the finality module state from consensus version 1
+// to 2. Params stored before the vote timeliness weighting was introduced
+// have no vote timeliness bonus, which would fail the validation of any
+// later params update, so the bonus is set to zero
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	if params.VoteTimelinessBonus.IsNil() {
+		params.VoteTimelinessBonus = math.LegacyZeroDec()
+	}
+	return m.keeper.SetParams(ctx, params)
+}
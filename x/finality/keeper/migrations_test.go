@@ -0,0 +1,35 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/babylon/testutil/helper"
+	"github.com/babylonchain/babylon/x/finality/keeper"
+	"github.com/babylonchain/babylon/x/finality/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	h := helper.NewHelper(t)
+	k, ctx := h.App.FinalityKeeper, h.Ctx
+
+	// params stored before consensus version 2 only have min_pub_rand, so
+	// the vote timeliness bonus is nil and they fail validation
+	minPubRand := uint64(100)
+	store := ctx.KVStore(h.App.GetKey(types.StoreKey))
+	store.Set(types.ParamsKey, []byte{0x08, byte(minPubRand)})
+	params := k.GetParams(ctx)
+	require.True(t, params.VoteTimelinessBonus.IsNil())
+	require.Error(t, params.Validate())
+
+	// the migration sets the bonus to zero and keeps the other params
+	err := keeper.NewMigrator(k).Migrate1to2(ctx)
+	require.NoError(t, err)
+	params = k.GetParams(ctx)
+	require.NoError(t, params.Validate())
+	require.True(t, params.VoteTimelinessBonus.IsZero())
+	require.Equal(t, minPubRand, params.MinPubRand)
+	require.Zero(t, params.VoteTimelinessWindow)
+	require.Zero(t, params.MaxPubRandFutureHeight)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -44,14 +45,140 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
+// isDuplicateFinalitySig returns whether the finality provider has already cast
+// exactly the same vote, i.e., the same signature over the same app hash at the
+// same height. Such a vote is a no-op, whereas a vote over a different app hash
+// at the same height is an equivocation
+func (k Keeper) isDuplicateFinalitySig(ctx context.Context, req *types.MsgAddFinalitySig) bool {
+	// the finality provider has voted for the canonical block at this height
+	if existingSig, err := k.GetSig(ctx, req.BlockHeight, req.FpBtcPk); err == nil {
+		indexedBlock, err := k.GetBlock(ctx, req.BlockHeight)
+		if err == nil && bytes.Equal(indexedBlock.AppHash, req.BlockAppHash) && existingSig.Equals(req.FinalitySig) {
+			return true
+		}
+	}
+	// the finality provider has voted for a fork at this height
+	if k.HasEvidence(ctx, req.FpBtcPk, req.BlockHeight) {
+		evidence, err := k.GetEvidence(ctx, req.FpBtcPk, req.BlockHeight)
+		if err == nil && bytes.Equal(evidence.ForkAppHash, req.BlockAppHash) && evidence.ForkFinalitySig.Equals(req.FinalitySig) {
+			return true
+		}
+	}
+	return false
+}
+
 // AddFinalitySig adds a new vote to a given block
 func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinalitySig) (*types.MsgAddFinalitySigResponse, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyAddFinalitySig)
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	prCommit, err := ms.checkFinalitySigEligibility(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if prCommit == nil {
+		// exactly same vote alreay exists, return success to the provider
+		return &types.MsgAddFinalitySigResponse{}, nil
+	}
+
+	// verify the finality signature message w.r.t. the public randomness commitment
+	// including the public randomness inclusion proof and the finality signature
+	if err := types.VerifyFinalitySig(req, prCommit); err != nil {
+		return nil, err
+	}
+
+	if err := ms.processFinalitySig(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddFinalitySigResponse{}, nil
+}
+
+// AddFinalitySigMulti adds the votes of multiple finality providers to a given
+// block. Each entry goes through the same checks as AddFinalitySig, including
+// the detection of double signing of its finality provider. Entries whose
+// finality providers are not eligible to vote are skipped, whereas a finality
+// signature that fails verification rejects the whole message, and so do
+// multiple entries of the same finality provider. The state changes of an
+// entry are only kept if it is processed successfully
+func (ms msgServer) AddFinalitySigMulti(goCtx context.Context, req *types.MsgAddFinalitySigMulti) (*types.MsgAddFinalitySigMultiResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyAddFinalitySigMulti)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if len(req.Entries) == 0 {
+		return nil, types.ErrInvalidFinalitySig.Wrap("empty finality signature entries")
+	}
+	// all entries vote for the same height, so none of them can be processed
+	// if the block is not indexed yet
+	if _, err := ms.GetBlock(ctx, req.BlockHeight); err != nil {
+		return nil, err
+	}
+
+	// each finality provider can only vote once in a message
+	fpBTCPKs := make(map[string]struct{}, len(req.Entries))
+	for i, entry := range req.Entries {
+		if entry.FpBtcPk == nil {
+			continue
+		}
+		fpBTCPKHex := entry.FpBtcPk.MarshalHex()
+		if _, ok := fpBTCPKs[fpBTCPKHex]; ok {
+			return nil, types.ErrInvalidFinalitySig.Wrapf("entry %d: duplicate finality provider %s", i, fpBTCPKHex)
+		}
+		fpBTCPKs[fpBTCPKHex] = struct{}{}
+	}
+
+	results := make([]*types.FinalitySigEntryResult, 0, len(req.Entries))
+	for i, entry := range req.Entries {
+		if entry.PubRand == nil || entry.Proof == nil {
+			return nil, types.ErrInvalidFinalitySig.Wrapf("entry %d: empty public randomness or inclusion proof", i)
+		}
+		msg := &types.MsgAddFinalitySig{
+			Signer:       req.Signer,
+			FpBtcPk:      entry.FpBtcPk,
+			BlockHeight:  req.BlockHeight,
+			PubRand:      entry.PubRand,
+			Proof:        entry.Proof,
+			BlockAppHash: entry.BlockAppHash,
+			FinalitySig:  entry.FinalitySig,
+		}
+		result := &types.FinalitySigEntryResult{FpBtcPk: entry.FpBtcPk}
+		results = append(results, result)
+
+		prCommit, err := ms.checkFinalitySigEligibility(ctx, msg)
+		if err != nil {
+			result.SkipReason = err.Error()
+			continue
+		}
+		if prCommit != nil {
+			if err := types.VerifyFinalitySig(msg, prCommit); err != nil {
+				return nil, types.ErrInvalidFinalitySig.Wrapf("entry %d: %v", i, err)
+			}
+			// a skipped entry must not leave its public randomness behind
+			cacheCtx, writeCache := ctx.CacheContext()
+			if err := ms.processFinalitySig(cacheCtx, msg); err != nil {
+				result.SkipReason = err.Error()
+				continue
+			}
+			writeCache()
+		}
+		result.Accepted = true
+	}
+
+	return &types.MsgAddFinalitySigMultiResponse{Results: results}, nil
+}
+
+// checkFinalitySigEligibility ensures the finality provider is eligible to
+// cast the given vote, and returns the public randomness commitment against
+// which the finality signature is to be verified. It returns a nil commitment
+// if the finality provider has already cast exactly the same vote
+func (k Keeper) checkFinalitySigEligibility(ctx sdk.Context, req *types.MsgAddFinalitySig) (*types.PubRandCommit, error) {
 	// ensure the finality provider exists
-	fp, err := ms.BTCStakingKeeper.GetFinalityProvider(ctx, req.FpBtcPk.MustMarshal())
+	if req.FpBtcPk == nil {
+		return nil, types.ErrInvalidFinalitySig.Wrap("empty finality provider BTC PK")
+	}
+	fp, err := k.BTCStakingKeeper.GetFinalityProvider(ctx, req.FpBtcPk.MustMarshal())
 	if err != nil {
 		return nil, err
 	}
@@ -77,11 +204,8 @@ func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinal
 	}
 
 	// ensure the finality provider has voting power at this height
-	if req.FpBtcPk == nil {
-		return nil, types.ErrInvalidFinalitySig.Wrap("empty finality provider BTC PK")
-	}
 	fpPK := req.FpBtcPk
-	if ms.BTCStakingKeeper.GetVotingPower(ctx, fpPK.MustMarshal(), req.BlockHeight) == 0 {
+	if k.BTCStakingKeeper.GetVotingPower(ctx, fpPK.MustMarshal(), req.BlockHeight) == 0 {
 		return nil, types.ErrInvalidFinalitySig.Wrapf("the finality provider %v does not have voting power at height %d", fpPK.MustMarshal(), req.BlockHeight)
 	}
 
@@ -89,31 +213,38 @@ func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinal
 	if req.FinalitySig == nil {
 		return nil, types.ErrInvalidFinalitySig.Wrap("empty finality signature")
 	}
-	existingSig, err := ms.GetSig(ctx, req.BlockHeight, fpPK)
-	if err == nil && existingSig.Equals(req.FinalitySig) {
-		ms.Logger(ctx).Debug("Received duplicated finiality vote", "block height", req.BlockHeight, "finality provider", req.FpBtcPk)
-		// exactly same vote alreay exists, return success to the provider
-		return &types.MsgAddFinalitySigResponse{}, nil
+	if k.isDuplicateFinalitySig(ctx, req) {
+		k.Logger(ctx).Debug("Received duplicated finiality vote", "block height", req.BlockHeight, "finality provider", req.FpBtcPk)
+		return nil, nil
 	}
 
-	// find the public randomness commitment for this height from this finality provider
-	prCommit, err := ms.GetPubRandCommitForHeight(ctx, req.FpBtcPk, req.BlockHeight)
+	// ensure the finality provider has committed public randomness covering
+	// this height, and find the corresponding commitment
+	prCommit, err := k.GetPubRandCommitForHeight(ctx, req.FpBtcPk, req.BlockHeight)
+	if errors.Is(err, types.ErrPubRandNotFound) {
+		return nil, types.ErrPubRandNotCommitted.Wrapf("finality provider %s has no public randomness committed for height %d",
+			fpPK.MarshalHex(), req.BlockHeight)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	// verify the finality signature message w.r.t. the public randomness commitment
-	// including the public randomness inclusion proof and the finality signature
-	if err := types.VerifyFinalitySig(req, prCommit); err != nil {
-		return nil, err
-	}
+	return prCommit, nil
+}
+
+// processFinalitySig adds the given verified vote, or records it as the
+// evidence of voting for a fork. The finality provider is slashed if it has
+// voted for both the canonical block and a fork at this height
+func (ms msgServer) processFinalitySig(ctx sdk.Context, req *types.MsgAddFinalitySig) error {
+	fpPK := req.FpBtcPk
+
 	// the public randomness is good, set the public randomness
 	ms.SetPubRand(ctx, req.FpBtcPk, req.BlockHeight, *req.PubRand)
 
 	// verify whether the voted block is a fork or not
 	indexedBlock, err := ms.GetBlock(ctx, req.BlockHeight)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if !bytes.Equal(indexedBlock.AppHash, req.BlockAppHash) {
 		// the finality provider votes for a fork!
@@ -144,7 +275,7 @@ func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinal
 
 		// NOTE: we should NOT return error here, otherwise the state change triggered in this tx
 		// (including the evidence) will be rolled back
-		return &types.MsgAddFinalitySigResponse{}, nil
+		return nil
 	}
 
 	// this signature is good, add vote to DB
@@ -171,7 +302,53 @@ func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinal
 		ms.slashFinalityProvider(ctx, req.FpBtcPk, evidence)
 	}
 
-	return &types.MsgAddFinalitySigResponse{}, nil
+	return nil
+}
+
+// SubmitFinalizationChallenge records the evidence that a finalized block does
+// not reach the finalization threshold, and emits an alert event. It does not
+// revert the finalization of the block
+func (ms msgServer) SubmitFinalizationChallenge(goCtx context.Context, req *types.MsgSubmitFinalizationChallenge) (*types.MsgSubmitFinalizationChallengeResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeySubmitFinalizationChallenge)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.Evidence == nil {
+		return nil, types.ErrInvalidFinalizationChallenge.Wrap("empty evidence")
+	}
+	if err := req.Evidence.ValidateBasic(); err != nil {
+		return nil, types.ErrInvalidFinalizationChallenge.Wrap(err.Error())
+	}
+	if ms.HasFinalizationDiscrepancy(ctx, req.BlockHeight) {
+		return nil, types.ErrFinalizationDiscrepancyExists.Wrapf("height: %d", req.BlockHeight)
+	}
+
+	block, err := ms.GetBlock(ctx, req.BlockHeight)
+	if err != nil {
+		return nil, err
+	}
+	votedPower, totalPower, err := ms.verifyFinalizationQuorumEvidence(ctx, block, req.Evidence)
+	if err != nil {
+		return nil, err
+	}
+
+	// the evidence is valid, record the discrepancy and raise an alert
+	discrepancy := &types.FinalizationDiscrepancy{
+		BlockHeight:    block.Height,
+		AppHash:        block.AppHash,
+		VotedPower:     votedPower,
+		TotalPower:     totalPower,
+		Reporter:       req.Signer,
+		RecordedHeight: uint64(ctx.HeaderInfo().Height),
+	}
+	ms.SetFinalizationDiscrepancy(ctx, discrepancy)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventFinalizationDiscrepancy{Discrepancy: discrepancy}); err != nil {
+		panic(fmt.Errorf("failed to emit EventFinalizationDiscrepancy event: %w", err))
+	}
+	ms.Logger(ctx).Error("a finalized block does not reach the finalization threshold",
+		"height", block.Height, "voted power", votedPower, "total power", totalPower)
+
+	return &types.MsgSubmitFinalizationChallengeResponse{}, nil
 }
 
 // CommitPubRandList commits a list of EOTS public randomness
@@ -181,13 +358,25 @@ func (ms msgServer) CommitPubRandList(goCtx context.Context, req *types.MsgCommi
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// ensure the request contains enough number of public randomness
-	minPubRand := ms.GetParams(ctx).MinPubRand
+	params := ms.GetParams(ctx)
+	minPubRand := params.MinPubRand
 	givenNumPubRand := req.NumPubRand
 	if givenNumPubRand < minPubRand {
 		return nil, types.ErrTooFewPubRand.Wrapf("required minimum: %d, actual: %d", minPubRand, givenNumPubRand)
 	}
 	// TODO: ensure log_2(givenNumPubRand) is an integer?
 
+	// ensure the public randomness does not extend too far beyond the current
+	// height
+	if params.MaxPubRandFutureHeight > 0 {
+		curHeight := uint64(ctx.HeaderInfo().Height)
+		endHeight := req.StartHeight + req.NumPubRand - 1
+		if req.StartHeight+req.NumPubRand < req.StartHeight || endHeight > curHeight+params.MaxPubRandFutureHeight {
+			return nil, types.ErrPubRandTooFarInFuture.Wrapf("current height: %d, max future height: %d, end height of the commitment: %d",
+				curHeight, params.MaxPubRandFutureHeight, endHeight)
+		}
+	}
+
 	// ensure the finality provider is registered
 	if req.FpBtcPk == nil {
 		return nil, types.ErrInvalidPubRand.Wrap("empty finality provider public key")
@@ -232,7 +421,7 @@ func (ms msgServer) CommitPubRandList(goCtx context.Context, req *types.MsgCommi
 
 // slashFinalityProvider slashes a finality provider with the given evidence
 // including setting its voting power to zero, extracting its BTC SK,
-// and emit an event
+// and emit events
 func (k Keeper) slashFinalityProvider(ctx context.Context, fpBtcPk *bbn.BIP340PubKey, evidence *types.Evidence) {
 	// slash this finality provider, i.e., set its voting power to zero
 	if err := k.BTCStakingKeeper.SlashFinalityProvider(ctx, fpBtcPk.MustMarshal()); err != nil {
@@ -240,8 +429,21 @@ func (k Keeper) slashFinalityProvider(ctx context.Context, fpBtcPk *bbn.BIP340Pu
 	}
 
 	// emit slashing event
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	eventSlashing := types.NewEventSlashedFinalityProvider(evidence)
-	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(eventSlashing); err != nil {
+	if err := sdkCtx.EventManager().EmitTypedEvent(eventSlashing); err != nil {
 		panic(fmt.Errorf("failed to emit EventSlashedFinalityProvider event: %w", err))
 	}
+
+	// extract the BTC SK and emit equivocation event
+	btcSK, err := evidence.ExtractBTCSK()
+	if err != nil {
+		// the finality provider is slashed anyway, so only log the error
+		k.Logger(sdkCtx).Error("failed to extract BTC SK from the evidence", "finality provider", fpBtcPk.MarshalHex(), "error", err)
+		return
+	}
+	eventEquivocation := types.NewEventFinalityProviderEquivocation(evidence, btcSK)
+	if err := sdkCtx.EventManager().EmitTypedEvent(eventEquivocation); err != nil {
+		panic(fmt.Errorf("failed to emit EventFinalityProviderEquivocation event: %w", err))
+	}
 }
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"context"
+	"encoding/hex"
 	"math/rand"
 	"testing"
 	"time"
@@ -13,6 +14,10 @@ import (
 	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/babylonchain/babylon/x/finality/keeper"
 	"github.com/babylonchain/babylon/x/finality/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -92,6 +97,58 @@ func FuzzCommitPubRandList(f *testing.F) {
 	})
 }
 
+func FuzzCommitPubRandListMaxFutureHeight(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil)
+		ms := keeper.NewMsgServerImpl(*fKeeper)
+
+		btcSK, btcPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		fpBTCPKBytes := bbn.NewBIP340PubKeyFromBTCPK(btcPK).MustMarshal()
+		bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(true).AnyTimes()
+
+		// set a random limit on how far ahead public randomness may be committed
+		params := fKeeper.GetParams(ctx)
+		params.MaxPubRandFutureHeight = params.MinPubRand + datagen.RandomInt(r, 1000)
+		err = fKeeper.SetParams(ctx, params)
+		require.NoError(t, err)
+		curHeight := datagen.RandomInt(r, 1000) + 1
+		ctx = ctx.WithHeaderInfo(header.Info{Height: int64(curHeight)})
+		numPubRand := params.MinPubRand
+
+		// a commitment ending one height beyond the limit should fail
+		startHeight := curHeight + params.MaxPubRandFutureHeight - numPubRand + 2
+		_, msg, err := datagen.GenRandomMsgCommitPubRandList(r, btcSK, startHeight, numPubRand)
+		require.NoError(t, err)
+		_, err = ms.CommitPubRandList(ctx, msg)
+		require.ErrorIs(t, err, types.ErrPubRandTooFarInFuture)
+
+		// a commitment ending exactly at the limit should succeed
+		startHeight--
+		_, msg, err = datagen.GenRandomMsgCommitPubRandList(r, btcSK, startHeight, numPubRand)
+		require.NoError(t, err)
+		_, err = ms.CommitPubRandList(ctx, msg)
+		require.NoError(t, err)
+
+		// the limit is lifted once it is disabled
+		params.MaxPubRandFutureHeight = 0
+		err = fKeeper.SetParams(ctx, params)
+		require.NoError(t, err)
+		startHeight += numPubRand + datagen.RandomInt(r, 1000)
+		_, msg, err = datagen.GenRandomMsgCommitPubRandList(r, btcSK, startHeight, numPubRand)
+		require.NoError(t, err)
+		_, err = ms.CommitPubRandList(ctx, msg)
+		require.NoError(t, err)
+	})
+}
+
 func FuzzAddFinalitySig(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 
@@ -143,7 +200,7 @@ func FuzzAddFinalitySig(f *testing.F) {
 		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).Times(1)
 		msg.BlockHeight = blockHeight2
 		_, err = ms.AddFinalitySig(ctx, msg)
-		require.Error(t, err)
+		require.ErrorIs(t, err, types.ErrPubRandNotCommitted)
 		// reset block height
 		msg.BlockHeight = blockHeight
 
@@ -165,6 +222,15 @@ func FuzzAddFinalitySig(f *testing.F) {
 		resp, err := ms.AddFinalitySig(ctx, msg)
 		require.NoError(t, err)
 		require.NotNil(t, resp)
+		// the duplicate vote is not treated as an equivocation
+		require.False(t, fKeeper.HasEvidence(ctx, fpBTCPK, blockHeight))
+		// the same signature over a different app hash is not a duplicate vote
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).Times(1)
+		msgDiffAppHash := *msg
+		msgDiffAppHash.BlockAppHash = datagen.GenRandomByteArray(r, 32)
+		_, err = ms.AddFinalitySig(ctx, &msgDiffAppHash)
+		require.Error(t, err)
+		require.False(t, fKeeper.HasEvidence(ctx, fpBTCPK, blockHeight))
 
 		// Case 5: the finality provider is slashed if it votes for a fork
 		blockAppHash2 := datagen.GenRandomByteArray(r, 32)
@@ -194,6 +260,20 @@ func FuzzAddFinalitySig(f *testing.F) {
 		// not affect verification
 		require.True(t, btcSK.Key.Equals(&btcSK2.Key) || btcSK.Key.Negate().Equals(&btcSK2.Key))
 		require.Equal(t, btcSK.PubKey().SerializeCompressed()[1:], btcSK2.PubKey().SerializeCompressed()[1:])
+		// ensure the extracted SK is emitted in the equivocation event
+		var eventEquivocation *types.EventFinalityProviderEquivocation
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != proto.MessageName(&types.EventFinalityProviderEquivocation{}) {
+				continue
+			}
+			typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(t, err)
+			eventEquivocation = typedEvent.(*types.EventFinalityProviderEquivocation)
+		}
+		require.NotNil(t, eventEquivocation)
+		require.Equal(t, fpBTCPK.MarshalHex(), eventEquivocation.FpBtcPkHex)
+		require.Equal(t, blockHeight, eventEquivocation.BlockHeight)
+		require.Equal(t, hex.EncodeToString(btcSK2.Serialize()), eventEquivocation.ExtractedBtcSkHex)
 
 		// Case 6: slashed finality provider cannot vote
 		fp.SlashedBabylonHeight = blockHeight
@@ -203,6 +283,246 @@ func FuzzAddFinalitySig(f *testing.F) {
 	})
 }
 
+func FuzzAddFinalitySigMulti(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil)
+		ms := keeper.NewMsgServerImpl(*fKeeper)
+
+		startHeight := uint64(0)
+		numPubRand := uint64(200)
+		blockHeight := startHeight + datagen.RandomInt(r, int(numPubRand))
+		blockAppHash := datagen.GenRandomByteArray(r, 32)
+		signer := datagen.GenRandomAccount().Address
+
+		// create finality providers that have committed public randomness,
+		// where the last one does not have voting power at the height
+		numFps := int(datagen.RandomInt(r, 5)) + 2
+		btcSKs := make([]*btcec.PrivateKey, numFps)
+		randListInfos := make([]*datagen.RandListInfo, numFps)
+		fpBTCPKs := make([]*bbn.BIP340PubKey, numFps)
+		for i := 0; i < numFps; i++ {
+			btcSK, btcPK, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			fp, err := datagen.GenRandomFinalityProviderWithBTCSK(r, btcSK)
+			require.NoError(t, err)
+			fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(btcPK)
+			bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPK.MustMarshal())).Return(true).AnyTimes()
+			bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPK.MustMarshal())).Return(fp, nil).AnyTimes()
+			power := uint64(1)
+			if i == numFps-1 {
+				power = 0
+			}
+			bsKeeper.EXPECT().GetVotingPower(gomock.Any(), gomock.Eq(fpBTCPK.MustMarshal()), gomock.Eq(blockHeight)).Return(power).AnyTimes()
+
+			randListInfo, msgCommitPubRandList, err := datagen.GenRandomMsgCommitPubRandList(r, btcSK, startHeight, numPubRand)
+			require.NoError(t, err)
+			_, err = ms.CommitPubRandList(ctx, msgCommitPubRandList)
+			require.NoError(t, err)
+
+			btcSKs[i] = btcSK
+			randListInfos[i] = randListInfo
+			fpBTCPKs[i] = fpBTCPK
+		}
+		genEntry := func(i int, appHash []byte) *types.FinalitySigEntry {
+			msg, err := datagen.NewMsgAddFinalitySig(signer, btcSKs[i], startHeight, blockHeight, randListInfos[i], appHash)
+			require.NoError(t, err)
+			return &types.FinalitySigEntry{
+				FpBtcPk:      msg.FpBtcPk,
+				PubRand:      msg.PubRand,
+				Proof:        msg.Proof,
+				BlockAppHash: msg.BlockAppHash,
+				FinalitySig:  msg.FinalitySig,
+			}
+		}
+		entries := make([]*types.FinalitySigEntry, numFps)
+		for i := 0; i < numFps; i++ {
+			entries[i] = genEntry(i, blockAppHash)
+		}
+
+		// Case 1: fail if the block is not indexed yet
+		_, err := ms.AddFinalitySigMulti(ctx, &types.MsgAddFinalitySigMulti{Signer: signer, BlockHeight: blockHeight, Entries: entries})
+		require.ErrorIs(t, err, types.ErrBlockNotFound)
+		ctx = ctx.WithHeaderInfo(header.Info{Height: int64(blockHeight), AppHash: blockAppHash})
+		fKeeper.IndexBlock(ctx)
+
+		// Case 2: the whole message is rejected if any finality signature is
+		// invalid, even if the other entries are valid
+		invalidIdx := int(datagen.RandomInt(r, numFps-1))
+		invalidEntry := genEntry(invalidIdx, datagen.GenRandomByteArray(r, 32))
+		invalidEntry.BlockAppHash = blockAppHash
+		invalidEntries := append([]*types.FinalitySigEntry{}, entries...)
+		invalidEntries[invalidIdx] = invalidEntry
+		cacheCtx, _ := ctx.CacheContext()
+		_, err = ms.AddFinalitySigMulti(cacheCtx, &types.MsgAddFinalitySigMulti{Signer: signer, BlockHeight: blockHeight, Entries: invalidEntries})
+		require.ErrorIs(t, err, types.ErrInvalidFinalitySig)
+
+		// Case 3: the whole message is rejected if a finality provider has
+		// multiple entries
+		dupIdx := int(datagen.RandomInt(r, numFps))
+		dupEntries := append([]*types.FinalitySigEntry{}, entries...)
+		dupEntries = append(dupEntries, entries[dupIdx])
+		cacheCtx, _ = ctx.CacheContext()
+		_, err = ms.AddFinalitySigMulti(cacheCtx, &types.MsgAddFinalitySigMulti{Signer: signer, BlockHeight: blockHeight, Entries: dupEntries})
+		require.ErrorIs(t, err, types.ErrInvalidFinalitySig)
+
+		// Case 4: valid entries are processed, while the entry of the finality
+		// provider without voting power is skipped
+		resp, err := ms.AddFinalitySigMulti(ctx, &types.MsgAddFinalitySigMulti{Signer: signer, BlockHeight: blockHeight, Entries: entries})
+		require.NoError(t, err)
+		require.Len(t, resp.Results, numFps)
+		for i, result := range resp.Results {
+			require.Equal(t, fpBTCPKs[i].MustMarshal(), result.FpBtcPk.MustMarshal())
+			sig, err := fKeeper.GetSig(ctx, blockHeight, fpBTCPKs[i])
+			if i == numFps-1 {
+				require.False(t, result.Accepted)
+				require.NotEmpty(t, result.SkipReason)
+				require.Error(t, err)
+				continue
+			}
+			require.True(t, result.Accepted)
+			require.NoError(t, err)
+			require.Equal(t, entries[i].FinalitySig.MustMarshal(), sig.MustMarshal())
+		}
+
+		// Case 5: duplicate votes are accepted as no-ops, while a vote for a
+		// fork slashes its finality provider only
+		forkIdx := int(datagen.RandomInt(r, numFps-1))
+		forkEntries := append([]*types.FinalitySigEntry{}, entries[:numFps-1]...)
+		forkEntries[forkIdx] = genEntry(forkIdx, datagen.GenRandomByteArray(r, 32))
+		bsKeeper.EXPECT().SlashFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKs[forkIdx].MustMarshal())).Return(nil).Times(1)
+		resp, err = ms.AddFinalitySigMulti(ctx, &types.MsgAddFinalitySigMulti{Signer: signer, BlockHeight: blockHeight, Entries: forkEntries})
+		require.NoError(t, err)
+		for i, result := range resp.Results {
+			require.True(t, result.Accepted)
+			require.Equal(t, i == forkIdx, fKeeper.HasEvidence(ctx, fpBTCPKs[i], blockHeight))
+		}
+		evidence, err := fKeeper.GetEvidence(ctx, fpBTCPKs[forkIdx], blockHeight)
+		require.NoError(t, err)
+		require.True(t, evidence.IsSlashable())
+	})
+}
+
+func FuzzSubmitFinalizationChallenge(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil)
+		ms := keeper.NewMsgServerImpl(*fKeeper)
+		signer := datagen.GenRandomAccount().Address
+
+		// a finalized block with a random voting power table, voted by a
+		// random subset of the finality providers
+		height := datagen.RandomInt(r, 100) + 1
+		ctx = ctx.WithHeaderInfo(header.Info{Height: int64(height) + 10})
+		block := &types.IndexedBlock{
+			Height:    height,
+			AppHash:   datagen.GenRandomByteArray(r, 32),
+			Finalized: true,
+		}
+		fKeeper.SetBlock(ctx, block)
+		numFps := int(datagen.RandomInt(r, 10)) + 1
+		powerTable := map[string]uint64{}
+		evidence := &types.FinalizationQuorumEvidence{}
+		var votedPower, totalPower uint64
+		for i := 0; i < numFps; i++ {
+			_, fpPK, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(fpPK)
+			power := datagen.RandomInt(r, 1000) + 1
+			powerTable[fpBTCPK.MarshalHex()] = power
+			totalPower += power
+			evidence.VotingPowerTable = append(evidence.VotingPowerTable, &types.FinalityProviderVotingPower{
+				FpBtcPk:     fpBTCPK,
+				VotingPower: power,
+			})
+			if datagen.OneInN(r, 2) {
+				sig, err := bbn.NewSchnorrEOTSSig(datagen.GenRandomByteArray(r, 32))
+				require.NoError(t, err)
+				fKeeper.SetSig(ctx, height, fpBTCPK, sig)
+				evidence.VoterBtcPkList = append(evidence.VoterBtcPkList, *fpBTCPK)
+				votedPower += power
+			}
+		}
+		bsKeeper.EXPECT().GetVotingPowerTable(gomock.Any(), gomock.Eq(height)).Return(powerTable).AnyTimes()
+		msg := &types.MsgSubmitFinalizationChallenge{
+			Signer:      signer,
+			BlockHeight: height,
+			Evidence:    evidence,
+		}
+
+		// evidence that is not the recorded one is rejected
+		tampered := *evidence
+		tampered.VotingPowerTable = append([]*types.FinalityProviderVotingPower{}, evidence.VotingPowerTable...)
+		tampered.VotingPowerTable[0] = &types.FinalityProviderVotingPower{
+			FpBtcPk:     evidence.VotingPowerTable[0].FpBtcPk,
+			VotingPower: evidence.VotingPowerTable[0].VotingPower + 1,
+		}
+		_, err := ms.SubmitFinalizationChallenge(ctx, &types.MsgSubmitFinalizationChallenge{
+			Signer:      signer,
+			BlockHeight: height,
+			Evidence:    &tampered,
+		})
+		require.ErrorIs(t, err, types.ErrInvalidFinalizationChallenge)
+		if len(evidence.VoterBtcPkList) > 0 {
+			tampered = *evidence
+			tampered.VoterBtcPkList = evidence.VoterBtcPkList[1:]
+			_, err = ms.SubmitFinalizationChallenge(ctx, &types.MsgSubmitFinalizationChallenge{
+				Signer:      signer,
+				BlockHeight: height,
+				Evidence:    &tampered,
+			})
+			require.ErrorIs(t, err, types.ErrInvalidFinalizationChallenge)
+		}
+
+		// a block that is not finalized cannot be challenged
+		fKeeper.SetBlock(ctx, &types.IndexedBlock{Height: height + 1, AppHash: datagen.GenRandomByteArray(r, 32)})
+		_, err = ms.SubmitFinalizationChallenge(ctx, &types.MsgSubmitFinalizationChallenge{
+			Signer:      signer,
+			BlockHeight: height + 1,
+			Evidence:    evidence,
+		})
+		require.ErrorIs(t, err, types.ErrBlockNotFinalized)
+
+		// the recorded evidence is accepted iff the votes do not reach the
+		// finalization threshold
+		_, err = ms.SubmitFinalizationChallenge(ctx, msg)
+		if votedPower*3 > totalPower*2 {
+			require.ErrorIs(t, err, types.ErrInvalidFinalizationChallenge)
+			require.False(t, fKeeper.HasFinalizationDiscrepancy(ctx, height))
+			return
+		}
+		require.NoError(t, err)
+		discrepancy, err := fKeeper.GetFinalizationDiscrepancy(ctx, height)
+		require.NoError(t, err)
+		require.Equal(t, block.AppHash, discrepancy.AppHash)
+		require.Equal(t, votedPower, discrepancy.VotedPower)
+		require.Equal(t, totalPower, discrepancy.TotalPower)
+		require.Equal(t, signer, discrepancy.Reporter)
+		require.Equal(t, height+10, discrepancy.RecordedHeight)
+
+		// the block remains finalized
+		ib, err := fKeeper.GetBlock(ctx, height)
+		require.NoError(t, err)
+		require.True(t, ib.Finalized)
+
+		// the discrepancy is recorded only once
+		_, err = ms.SubmitFinalizationChallenge(ctx, msg)
+		require.ErrorIs(t, err, types.ErrFinalizationDiscrepancyExists)
+	})
+}
+
 func TestVoteForConflictingHashShouldRetrieveEvidenceAndSlash(t *testing.T) {
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 	ctrl := gomock.NewController(t)
@@ -254,7 +574,17 @@ func TestVoteForConflictingHashShouldRetrieveEvidenceAndSlash(t *testing.T) {
 		gomock.Eq(fpBTCPKBytes)).Return(fp, nil).Times(1)
 	_, err = ms.AddFinalitySig(ctx, msg1)
 	require.NoError(t, err)
-	// (3) Now vote for the canonical block at height 1. This should slash Finality provider
+	// (3) Vote for the same fork block again. This is a duplicate vote and
+	// should not slash the finality provider
+	bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(),
+		gomock.Eq(fpBTCPKBytes)).Return(fp, nil).Times(1)
+	_, err = ms.AddFinalitySig(ctx, msg1)
+	require.NoError(t, err)
+	evidence, err := fKeeper.GetEvidence(ctx, fpBTCPK, blockHeight)
+	require.NoError(t, err)
+	require.Equal(t, forkHash, evidence.ForkAppHash)
+	require.Equal(t, msg1.FinalitySig.MustMarshal(), evidence.ForkFinalitySig.MustMarshal())
+	// (4) Now vote for the canonical block at height 1. This should slash Finality provider
 	msg, err := datagen.NewMsgAddFinalitySig(signer, btcSK, startHeight, blockHeight, randListInfo, canonicalHash)
 	ctx = ctx.WithHeaderInfo(header.Info{Height: int64(blockHeight), AppHash: canonicalHash})
 	require.NoError(t, err)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/babylonchain/babylon/x/finality/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -91,25 +92,55 @@ func (k Keeper) finalizeBlock(ctx context.Context, block *types.IndexedBlock, vo
 	// filter out voted finality providers
 	maxActiveFPs := k.BTCStakingKeeper.GetParams(ctx).MaxActiveFinalityProviders
 	filteredDc := dc.FilterVotedDistCache(maxActiveFPs, voterBTCPKs)
+	// weight the rewards of voted finality providers by the timeliness of their votes
+	k.applyVoteRewardWeights(ctx, block.Height, filteredDc)
 	// reward voted finality providers
 	k.IncentiveKeeper.RewardBTCStaking(ctx, block.Height, filteredDc)
-	// remove reward distribution cache afterwards
+	// remove reward distribution cache and the heights at which the votes
+	// were recorded afterwards
 	k.BTCStakingKeeper.RemoveVotingPowerDistCache(ctx, block.Height)
+	k.deleteVoteRecordedHeights(ctx, block.Height)
 	// record the last finalized height metric
 	types.RecordLastFinalizedHeight(block.Height)
 }
 
+// applyVoteRewardWeights sets the reward weight of each finality provider in
+// the given distribution cache according to the timeliness of its vote for
+// the given height. It is a no-op if the timeliness weighting is disabled
+func (k Keeper) applyVoteRewardWeights(ctx context.Context, height uint64, dc *bstypes.VotingPowerDistCache) {
+	params := k.GetParams(ctx)
+	if params.VoteTimelinessWindow == 0 {
+		return
+	}
+	for _, fp := range dc.FinalityProviders {
+		recordedHeight, err := k.GetVoteRecordedHeight(ctx, height, fp.BtcPk)
+		if err != nil {
+			// votes cast before the recorded heights were tracked are not
+			// weighted
+			continue
+		}
+		weight := params.VoteRewardWeight(height, recordedHeight)
+		fp.RewardWeight = &weight
+	}
+	dc.ApplyRewardWeights()
+}
+
 // tally checks whether a block with the given finality provider set and votes reaches a quorum or not
 func tally(fpSet map[string]uint64, voterBTCPKs map[string]struct{}) bool {
-	totalPower := uint64(0)
-	votedPower := uint64(0)
+	votedPower, totalPower := tallyPower(fpSet, voterBTCPKs)
+	return votedPower*3 > totalPower*2
+}
+
+// tallyPower returns the voting power of the given votes and the total voting
+// power of the given finality provider set
+func tallyPower(fpSet map[string]uint64, voterBTCPKs map[string]struct{}) (votedPower uint64, totalPower uint64) {
 	for pkStr, power := range fpSet {
 		totalPower += power
 		if _, ok := voterBTCPKs[pkStr]; ok {
 			votedPower += power
 		}
 	}
-	return votedPower*3 > totalPower*2
+	return votedPower, totalPower
 }
 
 // setNextHeightToFinalize sets the next height to finalise as the given height
@@ -1,10 +1,12 @@
 package keeper_test
 
 import (
+	"context"
 	"encoding/hex"
 	"math/rand"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/babylonchain/babylon/testutil/datagen"
 	keepertest "github.com/babylonchain/babylon/testutil/keeper"
 	bbn "github.com/babylonchain/babylon/types"
@@ -146,6 +148,103 @@ func FuzzTallying_FinalizingSomeBlocks(f *testing.F) {
 
 }
 
+func FuzzTallying_VoteTimelinessWeights(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		bsKeeper.EXPECT().GetParams(gomock.Any()).Return(bstypes.Params{MaxActiveFinalityProviders: 100}).AnyTimes()
+		iKeeper := types.NewMockIncentiveKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, iKeeper)
+
+		// enable the timeliness weighting
+		params := types.DefaultParams()
+		params.VoteTimelinessWindow = datagen.RandomInt(r, 10) + 2
+		params.VoteTimelinessBonus = sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 100)+1), 2)
+		err := fKeeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		height := datagen.RandomInt(r, 10) + 1
+		fKeeper.SetBlock(ctx, &types.IndexedBlock{
+			Height:    height,
+			AppHash:   datagen.GenRandomByteArray(r, 32),
+			Finalized: false,
+		})
+
+		// 3 finality providers with equal voting power vote at the voted
+		// height, in the middle of the window, and after the window
+		delays := []uint64{0, params.VoteTimelinessWindow / 2, params.VoteTimelinessWindow + 1}
+		fpSet := map[string]uint64{}
+		dc := bstypes.NewVotingPowerDistCache()
+		for _, delay := range delays {
+			fpPK, err := datagen.GenRandomBIP340PubKey(r)
+			require.NoError(t, err)
+			sig, err := bbn.NewSchnorrEOTSSig(datagen.GenRandomByteArray(r, 32))
+			require.NoError(t, err)
+			fKeeper.SetSig(datagen.WithCtxHeight(ctx, height+delay), height, fpPK, sig)
+			fpSet[fpPK.MarshalHex()] = 1
+			dc.AddFinalityProviderDistInfo(&bstypes.FinalityProviderDistInfo{BtcPk: fpPK, TotalVotingPower: 1})
+		}
+		dc.ApplyActiveFinalityProviders(100)
+
+		var rewardedDc *bstypes.VotingPowerDistCache
+		recordedHeights := map[string]uint64{}
+		bsKeeper.EXPECT().GetVotingPowerTable(gomock.Any(), gomock.Eq(height)).Return(fpSet).Times(1)
+		bsKeeper.EXPECT().GetVotingPowerDistCache(gomock.Any(), gomock.Eq(height)).Return(dc, nil).Times(1)
+		iKeeper.EXPECT().RewardBTCStaking(gomock.Any(), gomock.Eq(height), gomock.Any()).Do(
+			func(ctx context.Context, _ uint64, filteredDc *bstypes.VotingPowerDistCache) {
+				rewardedDc = filteredDc
+				for _, fp := range filteredDc.FinalityProviders {
+					recordedHeight, err := fKeeper.GetVoteRecordedHeight(ctx, height, fp.BtcPk)
+					require.NoError(t, err)
+					recordedHeights[fp.BtcPk.MarshalHex()] = recordedHeight
+				}
+			}).Times(1)
+		bsKeeper.EXPECT().RemoveVotingPowerDistCache(gomock.Any(), gomock.Eq(height)).Return().Times(1)
+		bsKeeper.EXPECT().GetBTCStakingActivatedHeight(gomock.Any()).Return(height, nil).Times(1)
+		ctx = datagen.WithCtxHeight(ctx, height)
+		fKeeper.TallyBlocks(ctx)
+
+		// each finality provider is weighted by the timeliness of its vote,
+		// and timelier votes get larger portions of the rewards
+		require.NotNil(t, rewardedDc)
+		require.Len(t, rewardedDc.FinalityProviders, len(delays))
+		require.NotNil(t, rewardedDc.TotalWeightedVotingPower)
+		portions := map[uint64]sdkmath.LegacyDec{}
+		for _, fp := range rewardedDc.FinalityProviders {
+			recordedHeight := recordedHeights[fp.BtcPk.MarshalHex()]
+			require.NotNil(t, fp.RewardWeight)
+			require.True(t, params.VoteRewardWeight(height, recordedHeight).Equal(*fp.RewardWeight))
+			portions[recordedHeight-height] = rewardedDc.GetFinalityProviderPortion(fp)
+		}
+		prevPortion := sdkmath.LegacyZeroDec()
+		for i := len(delays) - 1; i >= 0; i-- {
+			require.True(t, portions[delays[i]].GT(prevPortion))
+			prevPortion = portions[delays[i]]
+		}
+		// votes after the window are not rewarded with a bonus
+		require.True(t, params.VoteRewardWeight(height, height+delays[2]).Equal(sdkmath.LegacyOneDec()))
+
+		// the recorded heights are removed once the rewards are distributed,
+		// and are not recorded for votes on the finalized block
+		for _, fp := range rewardedDc.FinalityProviders {
+			_, err := fKeeper.GetVoteRecordedHeight(ctx, height, fp.BtcPk)
+			require.ErrorIs(t, err, types.ErrVoteNotFound)
+		}
+		lateFpPK, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		lateSig, err := bbn.NewSchnorrEOTSSig(datagen.GenRandomByteArray(r, 32))
+		require.NoError(t, err)
+		fKeeper.SetSig(datagen.WithCtxHeight(ctx, height+1), height, lateFpPK, lateSig)
+		_, err = fKeeper.GetVoteRecordedHeight(ctx, height, lateFpPK)
+		require.ErrorIs(t, err, types.ErrVoteNotFound)
+	})
+}
+
 func giveQCToHeight(r *rand.Rand, ctx sdk.Context, bsKeeper *types.MockBTCStakingKeeper, fKeeper *keeper.Keeper, height uint64) error {
 	// 4 finality providers
 	fpSet := map[string]uint64{}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 
@@ -13,8 +14,47 @@ import (
 )
 
 func (k Keeper) SetSig(ctx context.Context, height uint64, fpBtcPK *bbn.BIP340PubKey, sig *bbn.SchnorrEOTSSig) {
+	if !k.HasSig(ctx, height, fpBtcPK) {
+		k.appendVoteOrder(ctx, height, fpBtcPK)
+	}
 	store := k.voteHeightStore(ctx, height)
 	store.Set(fpBtcPK.MustMarshal(), sig.MustMarshal())
+	// record the height at which the vote is recorded, which determines the
+	// timeliness of the vote upon reward distribution. Votes for finalized
+	// blocks no longer affect rewards
+	if height >= k.getNextHeightToFinalize(ctx) {
+		recordedHeight := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+		k.voteRecordedHeightStore(ctx, height).Set(fpBtcPK.MustMarshal(), sdk.Uint64ToBigEndian(recordedHeight))
+	}
+}
+
+// deleteVoteRecordedHeights removes the heights at which the votes for the
+// given height were recorded. It is called once the rewards for the height
+// are distributed
+func (k Keeper) deleteVoteRecordedHeights(ctx context.Context, height uint64) {
+	store := k.voteRecordedHeightStore(ctx, height)
+	iter := store.Iterator(nil, nil)
+	keys := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	if err := iter.Close(); err != nil {
+		panic(err)
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetVoteRecordedHeight gets the height at which the vote of the given
+// finality provider for the given height was recorded
+func (k Keeper) GetVoteRecordedHeight(ctx context.Context, height uint64, fpBtcPK *bbn.BIP340PubKey) (uint64, error) {
+	store := k.voteRecordedHeightStore(ctx, height)
+	heightBytes := store.Get(fpBtcPK.MustMarshal())
+	if len(heightBytes) == 0 {
+		return 0, types.ErrVoteNotFound
+	}
+	return sdk.BigEndianToUint64(heightBytes), nil
 }
 
 func (k Keeper) HasSig(ctx context.Context, height uint64, fpBtcPK *bbn.BIP340PubKey) bool {
@@ -90,6 +130,55 @@ func (k Keeper) GetVoters(ctx context.Context, height uint64) map[string]struct{
 	return voterBTCPKs
 }
 
+// appendVoteOrder records that the vote of the given finality provider for
+// the given height arrives after all votes recorded for this height so far
+func (k Keeper) appendVoteOrder(ctx context.Context, height uint64, fpBtcPK *bbn.BIP340PubKey) {
+	store := k.voteOrderHeightStore(ctx, height)
+	seq := uint64(0)
+	iter := store.ReverseIterator(nil, nil)
+	if iter.Valid() {
+		seq = sdk.BigEndianToUint64(iter.Key()) + 1
+	}
+	iter.Close()
+	store.Set(sdk.Uint64ToBigEndian(seq), fpBtcPK.MustMarshal())
+}
+
+// GetOrderedVoters gets the BTC PKs of the finality providers that voted for
+// the given height, in the order in which their votes arrived. Votes cast
+// before the arrival order was tracked are placed after the others, ordered
+// by BTC PK
+func (k Keeper) GetOrderedVoters(ctx context.Context, height uint64) []bbn.BIP340PubKey {
+	voters := k.GetVoters(ctx, height)
+	orderedVoters := make([]bbn.BIP340PubKey, 0, len(voters))
+
+	iter := k.voteOrderHeightStore(ctx, height).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		fpBTCPK, err := bbn.NewBIP340PubKey(iter.Value())
+		if err != nil {
+			// failing to unmarshal finality provider's BTC PK in KVStore is a programming error
+			panic(fmt.Errorf("%w: %w", bbn.ErrUnmarshal, err))
+		}
+		orderedVoters = append(orderedVoters, *fpBTCPK)
+		delete(voters, fpBTCPK.MarshalHex())
+	}
+
+	// votes without recorded order
+	unorderedVoters := make([]string, 0, len(voters))
+	for pkHex := range voters {
+		unorderedVoters = append(unorderedVoters, pkHex)
+	}
+	sort.Strings(unorderedVoters)
+	for _, pkHex := range unorderedVoters {
+		fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(pkHex)
+		if err != nil {
+			panic(fmt.Errorf("%w: %w", bbn.ErrUnmarshal, err))
+		}
+		orderedVoters = append(orderedVoters, *fpBTCPK)
+	}
+	return orderedVoters
+}
+
 // voteHeightStore returns the KVStore of the votes
 // prefix: VoteKey
 // key: (block height || finality provider PK)
@@ -107,3 +196,25 @@ func (k Keeper) voteStore(ctx context.Context) prefix.Store {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	return prefix.NewStore(storeAdapter, types.VoteKey)
 }
+
+// voteRecordedHeightStore returns the KVStore of the heights at which votes
+// were recorded
+// prefix: VoteRecordedHeightKey
+// key: (block height || finality provider PK)
+// value: height at which the vote was recorded
+func (k Keeper) voteRecordedHeightStore(ctx context.Context, height uint64) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixedStore := prefix.NewStore(storeAdapter, types.VoteRecordedHeightKey)
+	return prefix.NewStore(prefixedStore, sdk.Uint64ToBigEndian(height))
+}
+
+// voteOrderHeightStore returns the KVStore of the order in which the votes
+// for a given height arrived
+// prefix: VoteOrderKey
+// key: (block height || sequence number of the vote)
+// value: finality provider PK
+func (k Keeper) voteOrderHeightStore(ctx context.Context, height uint64) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixedStore := prefix.NewStore(storeAdapter, types.VoteOrderKey)
+	return prefix.NewStore(prefixedStore, sdk.Uint64ToBigEndian(height))
+}
@@ -10,7 +10,9 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCommitPubRandList{}, "finality/MsgCommitPubRandList", nil)
 	cdc.RegisterConcrete(&MsgAddFinalitySig{}, "finality/MsgAddFinalitySig", nil)
+	cdc.RegisterConcrete(&MsgAddFinalitySigMulti{}, "finality/MsgAddFinalitySigMulti", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "finality/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgSubmitFinalizationChallenge{}, "finality/MsgSubmitFinalizationChallenge", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -19,7 +21,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgCommitPubRandList{},
 		&MsgAddFinalitySig{},
+		&MsgAddFinalitySigMulti{},
 		&MsgUpdateParams{},
+		&MsgSubmitFinalizationChallenge{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -1,7 +1,21 @@
 package types
 
+import (
+	"encoding/hex"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+)
+
 func NewEventSlashedFinalityProvider(evidence *Evidence) *EventSlashedFinalityProvider {
 	return &EventSlashedFinalityProvider{
 		Evidence: evidence,
 	}
 }
+
+func NewEventFinalityProviderEquivocation(evidence *Evidence, btcSK *btcec.PrivateKey) *EventFinalityProviderEquivocation {
+	return &EventFinalityProviderEquivocation{
+		FpBtcPkHex:        evidence.FpBtcPk.MarshalHex(),
+		BlockHeight:       evidence.BlockHeight,
+		ExtractedBtcSkHex: hex.EncodeToString(btcSK.Serialize()),
+	}
+}
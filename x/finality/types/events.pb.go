@@ -69,27 +69,157 @@ func (m *EventSlashedFinalityProvider) GetEvidence() *Evidence {
 	return nil
 }
 
+// EventFinalityProviderEquivocation is the event emitted when a finality provider
+// is found to sign two conflicting blocks at the same height, carrying the BTC SK
+// extracted from the two EOTS signatures, such that slashing tooling can use it to
+// construct the slashing tx on Bitcoin right away.
+// NOTE: the extracted BTC SK is not confidential. Anyone can extract it from the
+// equivocation evidence that is already public via EventSlashedFinalityProvider
+// and the evidence queries, so emitting it does not expose more than what is
+// already exposed. It is meant to be used for slashing the finality provider's
+// BTC delegations, which is permissionless by design.
+type EventFinalityProviderEquivocation struct {
+	// fp_btc_pk_hex is the hex str of the BTC PK of the equivocating finality provider
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// block_height is the height of the conflicting blocks
+	BlockHeight uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// extracted_btc_sk_hex is the hex str of the extracted BTC SK of the finality provider
+	ExtractedBtcSkHex string `protobuf:"bytes,3,opt,name=extracted_btc_sk_hex,json=extractedBtcSkHex,proto3" json:"extracted_btc_sk_hex,omitempty"`
+}
+
+func (m *EventFinalityProviderEquivocation) Reset()         { *m = EventFinalityProviderEquivocation{} }
+func (m *EventFinalityProviderEquivocation) String() string { return proto.CompactTextString(m) }
+func (*EventFinalityProviderEquivocation) ProtoMessage()    {}
+func (*EventFinalityProviderEquivocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c34c03aae5e3e6bf, []int{1}
+}
+func (m *EventFinalityProviderEquivocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFinalityProviderEquivocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFinalityProviderEquivocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFinalityProviderEquivocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFinalityProviderEquivocation.Merge(m, src)
+}
+func (m *EventFinalityProviderEquivocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFinalityProviderEquivocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFinalityProviderEquivocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFinalityProviderEquivocation proto.InternalMessageInfo
+
+func (m *EventFinalityProviderEquivocation) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
+	}
+	return ""
+}
+
+func (m *EventFinalityProviderEquivocation) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *EventFinalityProviderEquivocation) GetExtractedBtcSkHex() string {
+	if m != nil {
+		return m.ExtractedBtcSkHex
+	}
+	return ""
+}
+
+// EventFinalizationDiscrepancy is the event emitted when a finalized block is
+// found not to reach the finalization threshold. It is an alert for operators,
+// and does not revert the finalization of the block
+type EventFinalizationDiscrepancy struct {
+	// discrepancy is the record of the discrepancy
+	Discrepancy *FinalizationDiscrepancy `protobuf:"bytes,1,opt,name=discrepancy,proto3" json:"discrepancy,omitempty"`
+}
+
+func (m *EventFinalizationDiscrepancy) Reset()         { *m = EventFinalizationDiscrepancy{} }
+func (m *EventFinalizationDiscrepancy) String() string { return proto.CompactTextString(m) }
+func (*EventFinalizationDiscrepancy) ProtoMessage()    {}
+func (*EventFinalizationDiscrepancy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c34c03aae5e3e6bf, []int{2}
+}
+func (m *EventFinalizationDiscrepancy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFinalizationDiscrepancy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFinalizationDiscrepancy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFinalizationDiscrepancy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFinalizationDiscrepancy.Merge(m, src)
+}
+func (m *EventFinalizationDiscrepancy) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFinalizationDiscrepancy) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFinalizationDiscrepancy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFinalizationDiscrepancy proto.InternalMessageInfo
+
+func (m *EventFinalizationDiscrepancy) GetDiscrepancy() *FinalizationDiscrepancy {
+	if m != nil {
+		return m.Discrepancy
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EventSlashedFinalityProvider)(nil), "babylon.finality.v1.EventSlashedFinalityProvider")
+	proto.RegisterType((*EventFinalityProviderEquivocation)(nil), "babylon.finality.v1.EventFinalityProviderEquivocation")
+	proto.RegisterType((*EventFinalizationDiscrepancy)(nil), "babylon.finality.v1.EventFinalizationDiscrepancy")
 }
 
 func init() { proto.RegisterFile("babylon/finality/v1/events.proto", fileDescriptor_c34c03aae5e3e6bf) }
 
 var fileDescriptor_c34c03aae5e3e6bf = []byte{
-	// 193 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0xcb, 0xcc, 0x4b, 0xcc, 0xc9, 0x2c, 0xa9, 0xd4, 0x2f, 0x33, 0xd4,
-	0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xaa,
-	0xd0, 0x83, 0xa9, 0xd0, 0x2b, 0x33, 0x94, 0x52, 0xc2, 0xa6, 0x0d, 0xae, 0x00, 0xac, 0x51, 0x29,
-	0x92, 0x4b, 0xc6, 0x15, 0x64, 0x50, 0x70, 0x4e, 0x62, 0x71, 0x46, 0x6a, 0x8a, 0x1b, 0x54, 0x36,
-	0xa0, 0x28, 0xbf, 0x2c, 0x33, 0x25, 0xb5, 0x48, 0xc8, 0x92, 0x8b, 0x23, 0x15, 0xc4, 0xca, 0x4b,
-	0x4e, 0x95, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd5, 0xc3, 0x62, 0x97, 0x9e, 0x2b, 0x54,
-	0x51, 0x10, 0x5c, 0xb9, 0x93, 0xd7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78,
-	0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44,
-	0x19, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0x0d, 0x4b, 0xce,
-	0x48, 0xcc, 0xcc, 0x83, 0x71, 0xf4, 0x2b, 0x10, 0x4e, 0x2e, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62,
-	0x03, 0xbb, 0xd6, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x4e, 0xb5, 0x88, 0x0a, 0x01, 0x00,
-	0x00,
+	// 332 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xbf, 0x4e, 0xc3, 0x40,
+	0x0c, 0xc6, 0x7b, 0x80, 0x10, 0x5c, 0x61, 0x20, 0x30, 0x54, 0xa8, 0x44, 0x6d, 0xa6, 0x0e, 0x28,
+	0xa1, 0x30, 0xb1, 0x56, 0x14, 0x55, 0x0c, 0xa8, 0x4a, 0x27, 0x58, 0xaa, 0xcb, 0xc5, 0x6d, 0x4e,
+	0x0d, 0x77, 0x21, 0x71, 0xa3, 0x84, 0xa7, 0x60, 0xe6, 0x89, 0x18, 0x3b, 0x32, 0xa2, 0xf6, 0x45,
+	0x50, 0x8e, 0xf4, 0x8f, 0x50, 0xd9, 0x6c, 0x7f, 0x9f, 0x7f, 0xb6, 0x65, 0xda, 0xf0, 0x98, 0x97,
+	0x87, 0x4a, 0x3a, 0x23, 0x21, 0x59, 0x28, 0x30, 0x77, 0xd2, 0xb6, 0x03, 0x29, 0x48, 0x4c, 0xec,
+	0x28, 0x56, 0xa8, 0x8c, 0xd3, 0xd2, 0x61, 0x2f, 0x1d, 0x76, 0xda, 0x3e, 0xb7, 0xb6, 0xb5, 0xad,
+	0x0c, 0xba, 0xd1, 0x7a, 0xa2, 0xf5, 0x6e, 0x01, 0x1a, 0x84, 0x2c, 0x09, 0xc0, 0xbf, 0x2f, 0xd5,
+	0x7e, 0xac, 0x52, 0xe1, 0x43, 0x6c, 0xdc, 0xd2, 0x03, 0x28, 0x22, 0xc9, 0xa1, 0x46, 0x1a, 0xa4,
+	0x55, 0xbd, 0xbe, 0xb0, 0xb7, 0xcc, 0xb2, 0xbb, 0xa5, 0xc9, 0x5d, 0xd9, 0xad, 0x0f, 0x42, 0x9b,
+	0x9a, 0xfd, 0x17, 0xda, 0x7d, 0x9d, 0x8a, 0x54, 0x71, 0x86, 0x42, 0x49, 0xa3, 0x49, 0x8f, 0x47,
+	0xd1, 0xd0, 0x43, 0x3e, 0x8c, 0x26, 0xc3, 0x00, 0x32, 0x3d, 0xe5, 0xd0, 0xa5, 0xa3, 0xa8, 0x83,
+	0xbc, 0x3f, 0xe9, 0x41, 0x66, 0x34, 0xe9, 0x91, 0x17, 0x2a, 0x5e, 0xc8, 0x62, 0x1c, 0x60, 0x6d,
+	0xa7, 0x41, 0x5a, 0x7b, 0x6e, 0x55, 0xd7, 0x7a, 0xba, 0x64, 0x38, 0xf4, 0x0c, 0x32, 0x8c, 0x19,
+	0x47, 0xf0, 0x35, 0x2c, 0xf9, 0x85, 0xed, 0x6a, 0xd8, 0xc9, 0x4a, 0xeb, 0x20, 0x1f, 0x14, 0x4c,
+	0x4b, 0xd2, 0xfa, 0xc6, 0x6e, 0x6f, 0x7a, 0x97, 0x3b, 0x91, 0xf0, 0x18, 0x22, 0x26, 0x79, 0x6e,
+	0x3c, 0xd2, 0xaa, 0xbf, 0x4e, 0xcb, 0xd3, 0x2f, 0xb7, 0x9e, 0xfe, 0x0f, 0xc2, 0xdd, 0x04, 0x74,
+	0x1e, 0x3e, 0xe7, 0x26, 0x99, 0xcd, 0x4d, 0xf2, 0x3d, 0x37, 0xc9, 0xfb, 0xc2, 0xac, 0xcc, 0x16,
+	0x66, 0xe5, 0x6b, 0x61, 0x56, 0x9e, 0xaf, 0xc6, 0x02, 0x83, 0xa9, 0x67, 0x73, 0xf5, 0xe2, 0x94,
+	0x78, 0x1e, 0x30, 0x21, 0x97, 0x89, 0x93, 0xad, 0xff, 0x87, 0x79, 0x04, 0x89, 0xb7, 0xaf, 0x5f,
+	0x77, 0xf3, 0x33, 0x00, 0xab, 0x41, 0x06, 0xb9, 0x17, 0x02, 0x00, 0x00,
 }
 
 func (m *EventSlashedFinalityProvider) Marshal() (dAtA []byte, err error) {
@@ -127,6 +257,83 @@ func (m *EventSlashedFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *EventFinalityProviderEquivocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFinalityProviderEquivocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFinalityProviderEquivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExtractedBtcSkHex) > 0 {
+		i -= len(m.ExtractedBtcSkHex)
+		copy(dAtA[i:], m.ExtractedBtcSkHex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExtractedBtcSkHex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFinalizationDiscrepancy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFinalizationDiscrepancy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFinalizationDiscrepancy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Discrepancy != nil {
+		{
+			size, err := m.Discrepancy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -151,6 +358,39 @@ func (m *EventSlashedFinalityProvider) Size() (n int) {
 	return n
 }
 
+func (m *EventFinalityProviderEquivocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvents(uint64(m.BlockHeight))
+	}
+	l = len(m.ExtractedBtcSkHex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFinalizationDiscrepancy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Discrepancy != nil {
+		l = m.Discrepancy.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -243,6 +483,225 @@ func (m *EventSlashedFinalityProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventFinalityProviderEquivocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFinalityProviderEquivocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFinalityProviderEquivocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtractedBtcSkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtractedBtcSkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFinalizationDiscrepancy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFinalizationDiscrepancy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFinalizationDiscrepancy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discrepancy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Discrepancy == nil {
+				m.Discrepancy = &FinalizationDiscrepancy{}
+			}
+			if err := m.Discrepancy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,6 +3,7 @@ package types
 import (
 	"context"
 
+	bbn "github.com/babylonchain/babylon/types"
 	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
 )
 
@@ -17,6 +18,8 @@ type BTCStakingKeeper interface {
 	GetVotingPowerDistCache(ctx context.Context, height uint64) (*bstypes.VotingPowerDistCache, error)
 	RemoveVotingPowerDistCache(ctx context.Context, height uint64)
 	GetLastFinalizedEpoch(ctx context.Context) uint64
+	GetActiveBTCDelegationsAtHeight(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, babylonHeight uint64) []*bstypes.BTCDelegation
+	IterateFPs(ctx context.Context, handler func(fp *bstypes.FinalityProvider) (shouldContinue bool))
 }
 
 // IncentiveKeeper defines the expected interface needed to distribute rewards.
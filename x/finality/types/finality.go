@@ -112,3 +112,52 @@ func (e *Evidence) ExtractBTCSK() (*btcec.PrivateKey, error) {
 		e.forkMsgToSign(), e.ForkFinalitySig.ToModNScalar(), // msg and sig for fork block
 	)
 }
+
+// ValidateBasic ensures the evidence has a non-empty voting power table, and
+// does not have duplicate finality providers in the vote set or in the voting
+// power table
+func (e *FinalizationQuorumEvidence) ValidateBasic() error {
+	if len(e.VotingPowerTable) == 0 {
+		return fmt.Errorf("empty voting power table")
+	}
+	voters := make(map[string]struct{}, len(e.VoterBtcPkList))
+	for _, pk := range e.VoterBtcPkList {
+		pkHex := pk.MarshalHex()
+		if _, ok := voters[pkHex]; ok {
+			return fmt.Errorf("duplicate voter %s", pkHex)
+		}
+		voters[pkHex] = struct{}{}
+	}
+	fps := make(map[string]struct{}, len(e.VotingPowerTable))
+	for _, fpPower := range e.VotingPowerTable {
+		if fpPower == nil || fpPower.FpBtcPk == nil {
+			return fmt.Errorf("empty finality provider in voting power table")
+		}
+		pkHex := fpPower.FpBtcPk.MarshalHex()
+		if _, ok := fps[pkHex]; ok {
+			return fmt.Errorf("duplicate finality provider %s in voting power table", pkHex)
+		}
+		fps[pkHex] = struct{}{}
+	}
+	return nil
+}
+
+// VoterSet returns the vote set of the evidence, keyed by the hex of the
+// voters' BTC PKs
+func (e *FinalizationQuorumEvidence) VoterSet() map[string]struct{} {
+	voters := make(map[string]struct{}, len(e.VoterBtcPkList))
+	for _, pk := range e.VoterBtcPkList {
+		voters[pk.MarshalHex()] = struct{}{}
+	}
+	return voters
+}
+
+// PowerTable returns the voting power table of the evidence, keyed by the
+// hex of the finality providers' BTC PKs
+func (e *FinalizationQuorumEvidence) PowerTable() map[string]uint64 {
+	table := make(map[string]uint64, len(e.VotingPowerTable))
+	for _, fpPower := range e.VotingPowerTable {
+		table[fpPower.FpBtcPk.MarshalHex()] = fpPower.VotingPower
+	}
+	return table
+}
@@ -233,10 +233,209 @@ func (m *Evidence) GetForkAppHash() []byte {
 	return nil
 }
 
+// FinalityProviderVotingPower is the voting power of a finality provider at a
+// height
+type FinalityProviderVotingPower struct {
+	// fp_btc_pk is the BTC PK of the finality provider
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// voting_power is the voting power of the finality provider
+	VotingPower uint64 `protobuf:"varint,2,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+}
+
+func (m *FinalityProviderVotingPower) Reset()         { *m = FinalityProviderVotingPower{} }
+func (m *FinalityProviderVotingPower) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderVotingPower) ProtoMessage()    {}
+func (*FinalityProviderVotingPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{3}
+}
+func (m *FinalityProviderVotingPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderVotingPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderVotingPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityProviderVotingPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderVotingPower.Merge(m, src)
+}
+func (m *FinalityProviderVotingPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderVotingPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderVotingPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProviderVotingPower proto.InternalMessageInfo
+
+func (m *FinalityProviderVotingPower) GetVotingPower() uint64 {
+	if m != nil {
+		return m.VotingPower
+	}
+	return 0
+}
+
+// FinalizationQuorumEvidence is the evidence that a finalized block does not
+// reach the finalization threshold, consisting of the vote set and the voting
+// power table of the block
+type FinalizationQuorumEvidence struct {
+	// voter_btc_pk_list is the list of BTC PKs of the finality providers that
+	// have voted for the block
+	VoterBtcPkList []github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,rep,name=voter_btc_pk_list,json=voterBtcPkList,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"voter_btc_pk_list,omitempty"`
+	// voting_power_table is the voting power of each finality provider with
+	// voting power at the height of the block
+	VotingPowerTable []*FinalityProviderVotingPower `protobuf:"bytes,2,rep,name=voting_power_table,json=votingPowerTable,proto3" json:"voting_power_table,omitempty"`
+}
+
+func (m *FinalizationQuorumEvidence) Reset()         { *m = FinalizationQuorumEvidence{} }
+func (m *FinalizationQuorumEvidence) String() string { return proto.CompactTextString(m) }
+func (*FinalizationQuorumEvidence) ProtoMessage()    {}
+func (*FinalizationQuorumEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{4}
+}
+func (m *FinalizationQuorumEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalizationQuorumEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalizationQuorumEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalizationQuorumEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizationQuorumEvidence.Merge(m, src)
+}
+func (m *FinalizationQuorumEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalizationQuorumEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizationQuorumEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizationQuorumEvidence proto.InternalMessageInfo
+
+func (m *FinalizationQuorumEvidence) GetVotingPowerTable() []*FinalityProviderVotingPower {
+	if m != nil {
+		return m.VotingPowerTable
+	}
+	return nil
+}
+
+// FinalizationDiscrepancy is the record of a finalized block whose votes do
+// not reach the finalization threshold under its voting power table. The
+// block remains finalized, and the record only serves as evidence
+type FinalizationDiscrepancy struct {
+	// block_height is the height of the finalized block
+	BlockHeight uint64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// app_hash is the AppHash of the finalized block
+	AppHash []byte `protobuf:"bytes,2,opt,name=app_hash,json=appHash,proto3" json:"app_hash,omitempty"`
+	// voted_power is the total voting power of the finality providers that
+	// have voted for the block
+	VotedPower uint64 `protobuf:"varint,3,opt,name=voted_power,json=votedPower,proto3" json:"voted_power,omitempty"`
+	// total_power is the total voting power at the height of the block
+	TotalPower uint64 `protobuf:"varint,4,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	// reporter is the address that submitted the evidence
+	Reporter string `protobuf:"bytes,5,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	// recorded_height is the Babylon height at which the discrepancy is
+	// recorded
+	RecordedHeight uint64 `protobuf:"varint,6,opt,name=recorded_height,json=recordedHeight,proto3" json:"recorded_height,omitempty"`
+}
+
+func (m *FinalizationDiscrepancy) Reset()         { *m = FinalizationDiscrepancy{} }
+func (m *FinalizationDiscrepancy) String() string { return proto.CompactTextString(m) }
+func (*FinalizationDiscrepancy) ProtoMessage()    {}
+func (*FinalizationDiscrepancy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{5}
+}
+func (m *FinalizationDiscrepancy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalizationDiscrepancy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalizationDiscrepancy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalizationDiscrepancy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizationDiscrepancy.Merge(m, src)
+}
+func (m *FinalizationDiscrepancy) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalizationDiscrepancy) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizationDiscrepancy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizationDiscrepancy proto.InternalMessageInfo
+
+func (m *FinalizationDiscrepancy) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *FinalizationDiscrepancy) GetAppHash() []byte {
+	if m != nil {
+		return m.AppHash
+	}
+	return nil
+}
+
+func (m *FinalizationDiscrepancy) GetVotedPower() uint64 {
+	if m != nil {
+		return m.VotedPower
+	}
+	return 0
+}
+
+func (m *FinalizationDiscrepancy) GetTotalPower() uint64 {
+	if m != nil {
+		return m.TotalPower
+	}
+	return 0
+}
+
+func (m *FinalizationDiscrepancy) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+func (m *FinalizationDiscrepancy) GetRecordedHeight() uint64 {
+	if m != nil {
+		return m.RecordedHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*IndexedBlock)(nil), "babylon.finality.v1.IndexedBlock")
 	proto.RegisterType((*PubRandCommit)(nil), "babylon.finality.v1.PubRandCommit")
 	proto.RegisterType((*Evidence)(nil), "babylon.finality.v1.Evidence")
+	proto.RegisterType((*FinalityProviderVotingPower)(nil), "babylon.finality.v1.FinalityProviderVotingPower")
+	proto.RegisterType((*FinalizationQuorumEvidence)(nil), "babylon.finality.v1.FinalizationQuorumEvidence")
+	proto.RegisterType((*FinalizationDiscrepancy)(nil), "babylon.finality.v1.FinalizationDiscrepancy")
 }
 
 func init() {
@@ -244,37 +443,49 @@ func init() {
 }
 
 var fileDescriptor_ca5b87e52e3e6d02 = []byte{
-	// 474 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x1b, 0x56, 0xda, 0xee, 0x35, 0x13, 0x10, 0xa6, 0xa9, 0x20, 0x94, 0x95, 0x9e, 0x7a,
-	0x40, 0xcd, 0xc6, 0x26, 0xc4, 0x95, 0xa0, 0xa1, 0x0d, 0x0e, 0x54, 0x0e, 0x27, 0x2e, 0x91, 0xe3,
-	0xb8, 0x89, 0xd5, 0xc6, 0xb6, 0x12, 0xa7, 0x5a, 0xf9, 0x14, 0x7c, 0x2c, 0x8e, 0x3b, 0xa2, 0x1d,
-	0x26, 0xd4, 0x7e, 0x0f, 0x84, 0xe2, 0xba, 0xe9, 0x76, 0x02, 0xb1, 0x9b, 0xfd, 0xf7, 0xd3, 0xff,
-	0xf7, 0xfe, 0xcf, 0x36, 0x0c, 0x22, 0x1c, 0x2d, 0x66, 0x82, 0x7b, 0x13, 0xc6, 0xf1, 0x8c, 0xa9,
-	0x85, 0x37, 0x3f, 0xae, 0xd7, 0x23, 0x99, 0x0b, 0x25, 0x9c, 0xa7, 0xa6, 0x66, 0x54, 0xeb, 0xf3,
-	0xe3, 0xe7, 0xfb, 0x89, 0x48, 0x84, 0x3e, 0xf7, 0xaa, 0xd5, 0xba, 0x74, 0x10, 0x82, 0x7d, 0xc1,
-	0x63, 0x7a, 0x49, 0x63, 0x7f, 0x26, 0xc8, 0xd4, 0x39, 0x80, 0x56, 0x4a, 0x59, 0x92, 0xaa, 0x9e,
-	0xd5, 0xb7, 0x86, 0x4d, 0x64, 0x76, 0xce, 0x33, 0xe8, 0x60, 0x29, 0xc3, 0x14, 0x17, 0x69, 0xef,
-	0x41, 0xdf, 0x1a, 0xda, 0xa8, 0x8d, 0xa5, 0x3c, 0xc7, 0x45, 0xea, 0xbc, 0x80, 0xdd, 0x35, 0xe7,
-	0x1b, 0x8d, 0x7b, 0x3b, 0x7d, 0x6b, 0xd8, 0x41, 0x5b, 0x61, 0xa0, 0x60, 0x6f, 0x5c, 0x46, 0x08,
-	0xf3, 0xf8, 0xbd, 0xc8, 0x32, 0xa6, 0x9c, 0x97, 0x60, 0x17, 0x0a, 0xe7, 0x2a, 0xbc, 0xc3, 0xe9,
-	0x6a, 0xed, 0x7c, 0x0d, 0xeb, 0x83, 0xcd, 0xcb, 0x2c, 0x94, 0x65, 0x14, 0xe6, 0x98, 0xc7, 0x1a,
-	0xd8, 0x44, 0xc0, 0xcb, 0xcc, 0x58, 0x39, 0x2e, 0x00, 0xd1, 0x76, 0x19, 0xe5, 0x4a, 0x43, 0x6d,
-	0x74, 0x4b, 0x19, 0xfc, 0xde, 0x81, 0xce, 0xd9, 0x9c, 0xc5, 0x94, 0x13, 0xea, 0x20, 0xd8, 0x9d,
-	0xc8, 0x30, 0x52, 0x24, 0x94, 0x53, 0x8d, 0xb3, 0xfd, 0x37, 0xd7, 0x37, 0x87, 0xaf, 0x13, 0xa6,
-	0xd2, 0x32, 0x1a, 0x11, 0x91, 0x79, 0x66, 0x60, 0x24, 0xc5, 0x8c, 0x6f, 0x36, 0x9e, 0x5a, 0x48,
-	0x5a, 0x8c, 0xfc, 0x8b, 0xf1, 0xc9, 0xe9, 0xd1, 0xb8, 0x8c, 0x3e, 0xd1, 0x05, 0x6a, 0x4f, 0xa4,
-	0xaf, 0xc8, 0x78, 0x5a, 0xa5, 0x88, 0xaa, 0x81, 0x6d, 0x52, 0xac, 0x5b, 0xec, 0x6a, 0xcd, 0xa4,
-	0x08, 0xa0, 0x53, 0x27, 0xd0, 0x1d, 0xfa, 0x6f, 0xaf, 0x6f, 0x0e, 0x4f, 0xff, 0x8d, 0x1a, 0x90,
-	0x94, 0x8b, 0x3c, 0x37, 0x79, 0x51, 0x5b, 0x9a, 0xe0, 0xaf, 0xc0, 0x21, 0x98, 0x0b, 0xce, 0x08,
-	0x9e, 0x85, 0xf5, 0x8d, 0x34, 0xf5, 0x00, 0x1e, 0xd7, 0x27, 0xef, 0xcc, 0xd5, 0x0c, 0x60, 0x6f,
-	0x22, 0xf2, 0xe9, 0xb6, 0xf0, 0xa1, 0x2e, 0xec, 0x56, 0xe2, 0xa6, 0x86, 0xc3, 0xc1, 0xd6, 0x71,
-	0xf3, 0x60, 0xc2, 0x82, 0x25, 0xbd, 0xd6, 0x7f, 0x36, 0x7d, 0xf6, 0xf9, 0x4b, 0x10, 0xb0, 0x04,
-	0xed, 0xd7, 0xbe, 0x1f, 0x8c, 0x6d, 0xc0, 0x12, 0x27, 0x86, 0x27, 0xba, 0xa7, 0x3b, 0xa8, 0xf6,
-	0x3d, 0x51, 0x8f, 0x2a, 0xcb, 0x5b, 0x14, 0xff, 0xe3, 0x8f, 0xa5, 0x6b, 0x5d, 0x2d, 0x5d, 0xeb,
-	0xd7, 0xd2, 0xb5, 0xbe, 0xaf, 0xdc, 0xc6, 0xd5, 0xca, 0x6d, 0xfc, 0x5c, 0xb9, 0x8d, 0xaf, 0x47,
-	0x7f, 0x03, 0x5c, 0x6e, 0xbf, 0x96, 0x66, 0x45, 0x2d, 0xfd, 0x55, 0x4e, 0xfe, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0xab, 0xc1, 0xba, 0xbe, 0x7b, 0x03, 0x00, 0x00,
+	// 669 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4b, 0x6f, 0xd3, 0x4a,
+	0x14, 0xae, 0x9b, 0xdc, 0x24, 0x3d, 0x49, 0x5f, 0xbe, 0x55, 0x6f, 0x6e, 0xef, 0x55, 0x12, 0xbc,
+	0x21, 0x0b, 0x94, 0xf4, 0x25, 0xc4, 0x96, 0x40, 0x51, 0x0b, 0x48, 0x04, 0xa7, 0x62, 0xc1, 0x02,
+	0x6b, 0x6c, 0x4f, 0xec, 0x51, 0xe2, 0x99, 0xd1, 0x78, 0x1c, 0x9a, 0xfe, 0x0a, 0x16, 0xfc, 0x28,
+	0x96, 0x5d, 0xa2, 0x2e, 0x0a, 0x6a, 0xff, 0x07, 0x42, 0x1e, 0x3f, 0x92, 0xaa, 0xa8, 0x20, 0x2a,
+	0x76, 0x9e, 0x6f, 0x8e, 0xbf, 0xef, 0x3b, 0x8f, 0x39, 0x60, 0xd8, 0xc8, 0x9e, 0x8e, 0x19, 0xed,
+	0x0e, 0x09, 0x45, 0x63, 0x22, 0xa7, 0xdd, 0xc9, 0x4e, 0xfe, 0xdd, 0xe1, 0x82, 0x49, 0xa6, 0xff,
+	0x9d, 0xc6, 0x74, 0x72, 0x7c, 0xb2, 0xb3, 0xb5, 0xe1, 0x31, 0x8f, 0xa9, 0xfb, 0x6e, 0xfc, 0x95,
+	0x84, 0x1a, 0x16, 0xd4, 0x8e, 0xa8, 0x8b, 0x4f, 0xb0, 0xdb, 0x1b, 0x33, 0x67, 0xa4, 0x6f, 0x42,
+	0xc9, 0xc7, 0xc4, 0xf3, 0x65, 0x5d, 0x6b, 0x69, 0xed, 0xa2, 0x99, 0x9e, 0xf4, 0x7f, 0xa1, 0x82,
+	0x38, 0xb7, 0x7c, 0x14, 0xfa, 0xf5, 0xc5, 0x96, 0xd6, 0xae, 0x99, 0x65, 0xc4, 0xf9, 0x21, 0x0a,
+	0x7d, 0xfd, 0x7f, 0x58, 0x4a, 0x74, 0x4e, 0xb1, 0x5b, 0x2f, 0xb4, 0xb4, 0x76, 0xc5, 0x9c, 0x01,
+	0x86, 0x84, 0xe5, 0x7e, 0x64, 0x9b, 0x88, 0xba, 0x4f, 0x58, 0x10, 0x10, 0xa9, 0xdf, 0x83, 0x5a,
+	0x28, 0x91, 0x90, 0xd6, 0x35, 0x9d, 0xaa, 0xc2, 0x0e, 0x13, 0xb1, 0x16, 0xd4, 0x68, 0x14, 0x58,
+	0x3c, 0xb2, 0x2d, 0x81, 0xa8, 0xab, 0x04, 0x8b, 0x26, 0xd0, 0x28, 0x48, 0xa9, 0xf4, 0x06, 0x80,
+	0xa3, 0xe8, 0x02, 0x4c, 0xa5, 0x12, 0xad, 0x99, 0x73, 0x88, 0xf1, 0xad, 0x00, 0x95, 0x83, 0x09,
+	0x71, 0x31, 0x75, 0xb0, 0x6e, 0xc2, 0xd2, 0x90, 0x5b, 0xb6, 0x74, 0x2c, 0x3e, 0x52, 0x72, 0xb5,
+	0xde, 0xc3, 0xf3, 0x8b, 0xe6, 0xae, 0x47, 0xa4, 0x1f, 0xd9, 0x1d, 0x87, 0x05, 0xdd, 0xb4, 0x60,
+	0x8e, 0x8f, 0x08, 0xcd, 0x0e, 0x5d, 0x39, 0xe5, 0x38, 0xec, 0xf4, 0x8e, 0xfa, 0x7b, 0xfb, 0xdb,
+	0xfd, 0xc8, 0x7e, 0x81, 0xa7, 0x66, 0x79, 0xc8, 0x7b, 0xd2, 0xe9, 0x8f, 0xe2, 0x2c, 0xec, 0xb8,
+	0x60, 0x59, 0x16, 0x89, 0xc5, 0xaa, 0xc2, 0xd2, 0x2c, 0x06, 0x50, 0xc9, 0x33, 0x50, 0x0e, 0x7b,
+	0x8f, 0xce, 0x2f, 0x9a, 0xfb, 0xbf, 0xa6, 0x3a, 0x70, 0x7c, 0xca, 0x84, 0x48, 0xf3, 0x35, 0xcb,
+	0x3c, 0x4d, 0xfc, 0x01, 0xe8, 0x0e, 0xa2, 0x8c, 0x12, 0x07, 0x8d, 0xad, 0xbc, 0x23, 0x45, 0x55,
+	0x80, 0xb5, 0xfc, 0xe6, 0x71, 0xda, 0x1a, 0x03, 0x96, 0x87, 0x4c, 0x8c, 0x66, 0x81, 0x7f, 0xa9,
+	0xc0, 0x6a, 0x0c, 0x66, 0x31, 0x14, 0x36, 0x67, 0x8c, 0xd9, 0xc0, 0x58, 0x21, 0xf1, 0xea, 0xa5,
+	0xdf, 0x34, 0x7d, 0xf0, 0xea, 0x78, 0x30, 0x20, 0x9e, 0xb9, 0x91, 0xf3, 0x3e, 0x4b, 0x69, 0x07,
+	0xc4, 0xd3, 0x5d, 0x58, 0x57, 0x9e, 0xae, 0x49, 0x95, 0xef, 0x28, 0xb5, 0x1a, 0x53, 0xce, 0xa9,
+	0x18, 0x1f, 0x35, 0xf8, 0x2f, 0x3b, 0xf7, 0x05, 0x8b, 0x47, 0x41, 0xbc, 0x61, 0x92, 0x50, 0xaf,
+	0xcf, 0xde, 0x63, 0xf1, 0xa7, 0x66, 0x62, 0xa2, 0x24, 0x2c, 0x1e, 0x6b, 0x64, 0x33, 0x31, 0x99,
+	0xc9, 0x1a, 0x5f, 0x34, 0xd8, 0x4a, 0x6c, 0x9d, 0x22, 0x49, 0x18, 0x7d, 0x1d, 0x31, 0x11, 0x05,
+	0xf9, 0xa4, 0x22, 0x58, 0x9f, 0x30, 0x89, 0x45, 0x6a, 0xcc, 0x1a, 0x93, 0x30, 0x7e, 0x20, 0x85,
+	0x3b, 0xb8, 0x5b, 0x51, 0x84, 0xca, 0xe0, 0x4b, 0x12, 0x4a, 0xfd, 0x1d, 0xe8, 0xf3, 0x26, 0x2d,
+	0x89, 0xec, 0x31, 0xae, 0x2f, 0xb6, 0x0a, 0xed, 0xea, 0xee, 0x76, 0xe7, 0x07, 0x8b, 0xa3, 0x73,
+	0x4b, 0x19, 0xcd, 0xb5, 0xb9, 0xe4, 0x8e, 0x63, 0x26, 0xe3, 0x42, 0x83, 0x7f, 0xe6, 0x33, 0x7c,
+	0x4a, 0x42, 0x47, 0x60, 0x8e, 0xa8, 0x33, 0xbd, 0xf1, 0x68, 0xb4, 0x9b, 0x8f, 0xe6, 0x96, 0x3d,
+	0xd3, 0x84, 0xb8, 0x94, 0xd8, 0x4d, 0xab, 0x5b, 0x50, 0x3f, 0x83, 0x82, 0x92, 0x9e, 0x36, 0xa1,
+	0x2a, 0x99, 0x44, 0xe3, 0x34, 0xa0, 0x98, 0x04, 0x28, 0x28, 0x09, 0xd8, 0x82, 0x8a, 0xc0, 0x9c,
+	0x09, 0x89, 0x85, 0x7a, 0x09, 0x4b, 0x66, 0x7e, 0xd6, 0xef, 0xc3, 0xaa, 0xc0, 0x0e, 0x13, 0x2e,
+	0x76, 0x33, 0x7b, 0x25, 0x45, 0xb0, 0x92, 0xc1, 0x89, 0xc3, 0xde, 0xf3, 0x4f, 0x97, 0x0d, 0xed,
+	0xec, 0xb2, 0xa1, 0x7d, 0xbd, 0x6c, 0x68, 0x1f, 0xae, 0x1a, 0x0b, 0x67, 0x57, 0x8d, 0x85, 0xcf,
+	0x57, 0x8d, 0x85, 0xb7, 0xdb, 0x3f, 0x6b, 0xcf, 0xc9, 0x6c, 0x69, 0xab, 0x4e, 0xd9, 0x25, 0xb5,
+	0x84, 0xf7, 0xbe, 0x0f, 0x00, 0x43, 0x5a, 0x88, 0xec, 0xd5, 0x05, 0x00, 0x00,
 }
 
 func (m *IndexedBlock) Marshal() (dAtA []byte, err error) {
@@ -452,6 +663,154 @@ func (m *Evidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FinalityProviderVotingPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderVotingPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderVotingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalizationQuorumEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalizationQuorumEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalizationQuorumEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VotingPowerTable) > 0 {
+		for iNdEx := len(m.VotingPowerTable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VotingPowerTable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFinality(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.VoterBtcPkList) > 0 {
+		for iNdEx := len(m.VoterBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.VoterBtcPkList[iNdEx].Size()
+				i -= size
+				if _, err := m.VoterBtcPkList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintFinality(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalizationDiscrepancy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalizationDiscrepancy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalizationDiscrepancy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecordedHeight != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.RecordedHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintFinality(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TotalPower != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.VotedPower != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.VotedPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AppHash) > 0 {
+		i -= len(m.AppHash)
+		copy(dAtA[i:], m.AppHash)
+		i = encodeVarintFinality(dAtA, i, uint64(len(m.AppHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFinality(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFinality(v)
 	base := offset
@@ -534,16 +893,604 @@ func (m *Evidence) Size() (n int) {
 		l = m.ForkFinalitySig.Size()
 		n += 1 + l + sovFinality(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *FinalityProviderVotingPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovFinality(uint64(m.VotingPower))
+	}
+	return n
+}
+
+func (m *FinalizationQuorumEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VoterBtcPkList) > 0 {
+		for _, e := range m.VoterBtcPkList {
+			l = e.Size()
+			n += 1 + l + sovFinality(uint64(l))
+		}
+	}
+	if len(m.VotingPowerTable) > 0 {
+		for _, e := range m.VotingPowerTable {
+			l = e.Size()
+			n += 1 + l + sovFinality(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FinalizationDiscrepancy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovFinality(uint64(m.BlockHeight))
+	}
+	l = len(m.AppHash)
+	if l > 0 {
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.VotedPower != 0 {
+		n += 1 + sovFinality(uint64(m.VotedPower))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovFinality(uint64(m.TotalPower))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.RecordedHeight != 0 {
+		n += 1 + sovFinality(uint64(m.RecordedHeight))
+	}
+	return n
+}
+
+func sovFinality(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFinality(x uint64) (n int) {
+	return sovFinality(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppHash = append(m.AppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppHash == nil {
+				m.AppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubRandCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubRandCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPubRand", wireType)
+			}
+			m.NumPubRand = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPubRand |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Evidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Evidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Evidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrPubRand
+			m.PubRand = &v
+			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalAppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CanonicalAppHash = append(m.CanonicalAppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CanonicalAppHash == nil {
+				m.CanonicalAppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkAppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForkAppHash = append(m.ForkAppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ForkAppHash == nil {
+				m.ForkAppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalFinalitySig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
+			m.CanonicalFinalitySig = &v
+			if err := m.CanonicalFinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkFinalitySig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
+			m.ForkFinalitySig = &v
+			if err := m.ForkFinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovFinality(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFinality(x uint64) (n int) {
-	return sovFinality(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
+func (m *FinalityProviderVotingPower) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -566,34 +1513,15 @@ func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IndexedBlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalityProviderVotingPower: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IndexedBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalityProviderVotingPower: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFinality
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -620,16 +1548,17 @@ func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppHash = append(m.AppHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.AppHash == nil {
-				m.AppHash = []byte{}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
 			}
-			var v int
+			m.VotingPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -639,12 +1568,11 @@ func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.VotingPower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Finalized = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFinality(dAtA[iNdEx:])
@@ -666,7 +1594,7 @@ func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
+func (m *FinalizationQuorumEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -689,17 +1617,17 @@ func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PubRandCommit: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalizationQuorumEvidence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PubRandCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalizationQuorumEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterBtcPkList", wireType)
 			}
-			m.StartHeight = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -709,35 +1637,32 @@ func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumPubRand", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
 			}
-			m.NumPubRand = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFinality
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumPubRand |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.VoterBtcPkList = append(m.VoterBtcPkList, v)
+			if err := m.VoterBtcPkList[len(m.VoterBtcPkList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerTable", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -747,24 +1672,24 @@ func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFinality
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFinality
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
-			if m.Commitment == nil {
-				m.Commitment = []byte{}
+			m.VotingPowerTable = append(m.VotingPowerTable, &FinalityProviderVotingPower{})
+			if err := m.VotingPowerTable[len(m.VotingPowerTable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -788,7 +1713,7 @@ func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Evidence) Unmarshal(dAtA []byte) error {
+func (m *FinalizationDiscrepancy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -811,48 +1736,13 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Evidence: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalizationDiscrepancy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Evidence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalizationDiscrepancy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFinality
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthFinality
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFinality
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.FpBtcPk = &v
-			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
@@ -871,9 +1761,9 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -900,17 +1790,16 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.SchnorrPubRand
-			m.PubRand = &v
-			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.AppHash = append(m.AppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppHash == nil {
+				m.AppHash = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalAppHash", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotedPower", wireType)
 			}
-			var byteLen int
+			m.VotedPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -920,31 +1809,16 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.VotedPower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthFinality
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFinality
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CanonicalAppHash = append(m.CanonicalAppHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.CanonicalAppHash == nil {
-				m.CanonicalAppHash = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForkAppHash", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
 			}
-			var byteLen int
+			m.TotalPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -954,31 +1828,16 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.TotalPower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthFinality
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFinality
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ForkAppHash = append(m.ForkAppHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.ForkAppHash == nil {
-				m.ForkAppHash = []byte{}
-			}
-			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalFinalitySig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -988,32 +1847,29 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFinality
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFinality
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
-			m.CanonicalFinalitySig = &v
-			if err := m.CanonicalFinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForkFinalitySig", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordedHeight", wireType)
 			}
-			var byteLen int
+			m.RecordedHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -1023,27 +1879,11 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.RecordedHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthFinality
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFinality
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
-			m.ForkFinalitySig = &v
-			if err := m.ForkFinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFinality(dAtA[iNdEx:])
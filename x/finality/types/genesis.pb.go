@@ -38,6 +38,9 @@ type GenesisState struct {
 	PublicRandomness []*PublicRandomness `protobuf:"bytes,5,rep,name=public_randomness,json=publicRandomness,proto3" json:"public_randomness,omitempty"`
 	// pub_rand_commit contains all the public randomness commitment ever commited from the finality providers.
 	PubRandCommit []*PubRandCommitWithPK `protobuf:"bytes,6,rep,name=pub_rand_commit,json=pubRandCommit,proto3" json:"pub_rand_commit,omitempty"`
+	// finalization_discrepancies contains all the finalization discrepancies
+	// ever recorded.
+	FinalizationDiscrepancies []*FinalizationDiscrepancy `protobuf:"bytes,7,rep,name=finalization_discrepancies,json=finalizationDiscrepancies,proto3" json:"finalization_discrepancies,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -115,6 +118,13 @@ func (m *GenesisState) GetPubRandCommit() []*PubRandCommitWithPK {
 	return nil
 }
 
+func (m *GenesisState) GetFinalizationDiscrepancies() []*FinalizationDiscrepancy {
+	if m != nil {
+		return m.FinalizationDiscrepancies
+	}
+	return nil
+}
+
 // VoteSig the vote of an finality provider
 // with the block of the vote, the finality provider btc public key and the vote signature.
 type VoteSig struct {
@@ -275,41 +285,44 @@ func init() {
 func init() { proto.RegisterFile("babylon/finality/v1/genesis.proto", fileDescriptor_52dc577f74d797d1) }
 
 var fileDescriptor_52dc577f74d797d1 = []byte{
-	// 544 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x94, 0x4d, 0x6f, 0xd3, 0x30,
-	0x18, 0xc7, 0xeb, 0xb5, 0xb4, 0xab, 0xdb, 0xc1, 0xf0, 0x38, 0x44, 0x05, 0xd2, 0x36, 0x12, 0x52,
-	0x4f, 0xc9, 0xd6, 0x4d, 0x88, 0x89, 0x5b, 0xd0, 0xc4, 0x5e, 0x0e, 0x44, 0x0e, 0x02, 0x09, 0x0e,
-	0x51, 0x92, 0xba, 0x89, 0xd5, 0x36, 0xb6, 0x6a, 0xb7, 0x5a, 0xbf, 0x05, 0x5f, 0x85, 0x03, 0xdf,
-	0x61, 0xc7, 0x1d, 0xd1, 0x24, 0x2a, 0xd4, 0x7e, 0x11, 0x54, 0x37, 0xdd, 0x46, 0x09, 0x1a, 0x42,
-	0x48, 0xdc, 0xec, 0x27, 0xff, 0xe7, 0xa7, 0xff, 0xf3, 0x12, 0xc3, 0x66, 0xe0, 0x07, 0x93, 0x3e,
-	0x4b, 0xac, 0x2e, 0x4d, 0xfc, 0x3e, 0x95, 0x13, 0x6b, 0xbc, 0x67, 0x45, 0x24, 0x21, 0x82, 0x0a,
-	0x93, 0x0f, 0x99, 0x64, 0x68, 0x27, 0x95, 0x98, 0x2b, 0x89, 0x39, 0xde, 0xab, 0x3d, 0x8a, 0x58,
-	0xc4, 0xd4, 0x77, 0x6b, 0x71, 0x5a, 0x4a, 0x6b, 0x8d, 0x2c, 0x1a, 0xf7, 0x87, 0xfe, 0x20, 0x85,
-	0xd5, 0x8c, 0x2c, 0xc5, 0x35, 0x58, 0x69, 0x8c, 0xcf, 0x79, 0x58, 0x7d, 0xbd, 0xb4, 0xe0, 0x4a,
-	0x5f, 0x12, 0x74, 0x08, 0x8b, 0x4b, 0x88, 0x06, 0x1a, 0xa0, 0x55, 0x69, 0x3f, 0x36, 0x33, 0x2c,
-	0x99, 0x8e, 0x92, 0xd8, 0x85, 0x8b, 0x69, 0x3d, 0x87, 0xd3, 0x04, 0x74, 0x0c, 0xef, 0xd3, 0xa4,
-	0x43, 0xce, 0x49, 0xc7, 0x0b, 0xfa, 0x2c, 0xec, 0x09, 0x6d, 0xa3, 0x91, 0x6f, 0x55, 0xda, 0xcd,
-	0x4c, 0xc4, 0xc9, 0x52, 0x6a, 0x2f, 0x94, 0x78, 0x8b, 0xde, 0xba, 0x09, 0xf4, 0x12, 0x96, 0xc9,
-	0x98, 0x76, 0x48, 0x12, 0x12, 0xa1, 0xe5, 0x15, 0xe4, 0x69, 0x26, 0xe4, 0x28, 0x55, 0xe1, 0x1b,
-	0x3d, 0x3a, 0x84, 0xe5, 0x31, 0x93, 0xc4, 0x13, 0x34, 0x12, 0x5a, 0x41, 0x25, 0x3f, 0xc9, 0x4c,
-	0x7e, 0xc7, 0x24, 0x71, 0x69, 0x84, 0x37, 0xc7, 0xcb, 0x83, 0x40, 0x18, 0x3e, 0xe4, 0xa3, 0xa0,
-	0x4f, 0x43, 0x6f, 0xe8, 0x27, 0x1d, 0x36, 0x48, 0x88, 0x10, 0xda, 0x3d, 0x85, 0x78, 0x96, 0xdd,
-	0x07, 0xa5, 0xc6, 0xd7, 0x62, 0xbc, 0xcd, 0xd7, 0x22, 0xc8, 0x81, 0x0f, 0xf8, 0x28, 0x50, 0x40,
-	0x2f, 0x64, 0x83, 0x01, 0x95, 0x5a, 0x51, 0x11, 0x5b, 0xbf, 0x23, 0x2e, 0x92, 0x5f, 0x29, 0xe5,
-	0x7b, 0x2a, 0x63, 0xe7, 0x0c, 0x6f, 0xf1, 0xdb, 0x41, 0xe3, 0x1b, 0x80, 0xa5, 0xd4, 0x3b, 0x6a,
-	0xc2, 0xaa, 0xea, 0xb5, 0x17, 0x13, 0x1a, 0xc5, 0x52, 0x0d, 0xad, 0x80, 0x2b, 0x2a, 0x76, 0xac,
-	0x42, 0x08, 0xc3, 0x72, 0x97, 0x7b, 0x81, 0x0c, 0x3d, 0xde, 0xd3, 0x36, 0x1a, 0xa0, 0x55, 0xb5,
-	0x9f, 0x5f, 0x4d, 0xeb, 0xed, 0x88, 0xca, 0x78, 0x14, 0x98, 0x21, 0x1b, 0x58, 0xa9, 0x91, 0x30,
-	0xf6, 0x69, 0xb2, 0xba, 0x58, 0x72, 0xc2, 0x89, 0x30, 0xed, 0x13, 0x67, 0xff, 0x60, 0xd7, 0x19,
-	0x05, 0x67, 0x64, 0x82, 0x4b, 0x5d, 0x6e, 0xcb, 0xd0, 0xe9, 0xa1, 0x8f, 0xb0, 0xba, 0x32, 0xbd,
-	0xe8, 0xb3, 0x96, 0x57, 0xd8, 0x17, 0x57, 0xd3, 0xfa, 0xc1, 0x9f, 0x61, 0xdd, 0x30, 0x4e, 0xd8,
-	0x70, 0x78, 0xf4, 0xe6, 0xad, 0xbb, 0x18, 0x41, 0x65, 0x45, 0x73, 0x69, 0x64, 0x4c, 0x01, 0xdc,
-	0x5e, 0x6f, 0xec, 0xff, 0x2a, 0xd4, 0x85, 0x9b, 0xab, 0xe9, 0xfd, 0x75, 0x91, 0xe9, 0x48, 0x71,
-	0x29, 0x1d, 0xa3, 0xf1, 0x05, 0xc0, 0x9d, 0x8c, 0x39, 0xff, 0x5c, 0x00, 0xf8, 0x37, 0x05, 0x9c,
-	0xfe, 0xba, 0x7e, 0x1b, 0xea, 0xc7, 0x36, 0xee, 0x5e, 0xbf, 0xb5, 0xc5, 0xb3, 0x4f, 0x2f, 0x66,
-	0x3a, 0xb8, 0x9c, 0xe9, 0xe0, 0xfb, 0x4c, 0x07, 0x9f, 0xe6, 0x7a, 0xee, 0x72, 0xae, 0xe7, 0xbe,
-	0xce, 0xf5, 0xdc, 0x87, 0xdd, 0xbb, 0x2c, 0x9e, 0xdf, 0x3c, 0x42, 0xca, 0x6d, 0x50, 0x54, 0xef,
-	0xcf, 0xfe, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x48, 0xf9, 0xea, 0x15, 0x05, 0x00, 0x00,
+	// 584 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x94, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0xe3, 0x26, 0x5f, 0xd2, 0x4c, 0xd2, 0x8f, 0xe2, 0xb2, 0x30, 0x01, 0x9c, 0xc4, 0x12,
+	0x52, 0x16, 0xc8, 0x6e, 0xd3, 0x0a, 0x51, 0xb1, 0x33, 0x14, 0xfa, 0x67, 0x81, 0x35, 0x46, 0x20,
+	0xc1, 0xc2, 0xb2, 0x9d, 0x89, 0x3d, 0x4a, 0xe2, 0x19, 0x79, 0x26, 0x51, 0xc3, 0x53, 0xf0, 0x04,
+	0xbc, 0x05, 0xef, 0xd0, 0x65, 0x97, 0xa8, 0x12, 0x11, 0x4a, 0x5e, 0x04, 0x65, 0xec, 0xa4, 0x21,
+	0x18, 0x15, 0x21, 0x24, 0x76, 0xf6, 0x9d, 0x73, 0x7e, 0xba, 0x77, 0xee, 0xd1, 0x80, 0xa6, 0xe7,
+	0x7a, 0xe3, 0x3e, 0x89, 0x8c, 0x2e, 0x8e, 0xdc, 0x3e, 0xe6, 0x63, 0x63, 0xb4, 0x67, 0x04, 0x28,
+	0x42, 0x0c, 0x33, 0x9d, 0xc6, 0x84, 0x13, 0x79, 0x27, 0x95, 0xe8, 0x0b, 0x89, 0x3e, 0xda, 0xab,
+	0xdd, 0x09, 0x48, 0x40, 0xc4, 0xb9, 0x31, 0xff, 0x4a, 0xa4, 0xb5, 0x46, 0x16, 0x8d, 0xba, 0xb1,
+	0x3b, 0x48, 0x61, 0x35, 0x2d, 0x4b, 0xb1, 0x04, 0x0b, 0x8d, 0xf6, 0xa9, 0x00, 0xaa, 0x2f, 0x93,
+	0x16, 0x6c, 0xee, 0x72, 0x24, 0x1f, 0x82, 0x62, 0x02, 0x51, 0xa4, 0x86, 0xd4, 0xaa, 0xb4, 0xef,
+	0xe9, 0x19, 0x2d, 0xe9, 0x96, 0x90, 0x98, 0x85, 0x8b, 0x49, 0x3d, 0x07, 0x53, 0x83, 0x7c, 0x0c,
+	0xfe, 0xc7, 0x51, 0x07, 0x9d, 0xa3, 0x8e, 0xe3, 0xf5, 0x89, 0xdf, 0x63, 0xca, 0x46, 0x23, 0xdf,
+	0xaa, 0xb4, 0x9b, 0x99, 0x88, 0x93, 0x44, 0x6a, 0xce, 0x95, 0x70, 0x0b, 0xaf, 0xfc, 0x31, 0xf9,
+	0x29, 0x28, 0xa3, 0x11, 0xee, 0xa0, 0xc8, 0x47, 0x4c, 0xc9, 0x0b, 0xc8, 0x83, 0x4c, 0xc8, 0x51,
+	0xaa, 0x82, 0xd7, 0x7a, 0xf9, 0x10, 0x94, 0x47, 0x84, 0x23, 0x87, 0xe1, 0x80, 0x29, 0x05, 0x61,
+	0xbe, 0x9f, 0x69, 0x7e, 0x43, 0x38, 0xb2, 0x71, 0x00, 0x37, 0x47, 0xc9, 0x07, 0x93, 0x21, 0xb8,
+	0x4d, 0x87, 0x5e, 0x1f, 0xfb, 0x4e, 0xec, 0x46, 0x1d, 0x32, 0x88, 0x10, 0x63, 0xca, 0x7f, 0x02,
+	0xf1, 0x30, 0xfb, 0x1e, 0x84, 0x1a, 0x2e, 0xc5, 0x70, 0x9b, 0xae, 0x55, 0x64, 0x0b, 0xdc, 0xa2,
+	0x43, 0x4f, 0x00, 0x1d, 0x9f, 0x0c, 0x06, 0x98, 0x2b, 0x45, 0x41, 0x6c, 0xfd, 0x8a, 0x38, 0x37,
+	0x3f, 0x13, 0xca, 0xb7, 0x98, 0x87, 0xd6, 0x19, 0xdc, 0xa2, 0xab, 0x45, 0xb9, 0x07, 0x6a, 0x89,
+	0xe3, 0x83, 0xcb, 0x31, 0x89, 0x9c, 0x0e, 0x66, 0x7e, 0x8c, 0xa8, 0x1b, 0xf9, 0x18, 0x31, 0xa5,
+	0x24, 0xe0, 0x8f, 0x32, 0xe1, 0x2f, 0x56, 0x6c, 0xcf, 0x97, 0xae, 0x31, 0xbc, 0xdb, 0xcd, 0x3c,
+	0xc0, 0x88, 0x69, 0x5f, 0x25, 0x50, 0x4a, 0x2f, 0x4a, 0x6e, 0x82, 0xaa, 0x58, 0xac, 0x13, 0x22,
+	0x1c, 0x84, 0x5c, 0x24, 0xa4, 0x00, 0x2b, 0xa2, 0x76, 0x2c, 0x4a, 0x32, 0x04, 0xe5, 0x2e, 0x75,
+	0x3c, 0xee, 0x3b, 0xb4, 0xa7, 0x6c, 0x34, 0xa4, 0x56, 0xd5, 0x7c, 0x7c, 0x35, 0xa9, 0xb7, 0x03,
+	0xcc, 0xc3, 0xa1, 0xa7, 0xfb, 0x64, 0x60, 0xa4, 0x8d, 0xf9, 0xa1, 0x8b, 0xa3, 0xc5, 0x8f, 0xc1,
+	0xc7, 0x14, 0x31, 0xdd, 0x3c, 0xb1, 0xf6, 0x0f, 0x76, 0xad, 0xa1, 0x77, 0x86, 0xc6, 0xb0, 0xd4,
+	0xa5, 0x26, 0xf7, 0xad, 0x9e, 0xfc, 0x1e, 0x54, 0x17, 0x43, 0xcc, 0x97, 0xaa, 0xe4, 0x05, 0xf6,
+	0xc9, 0xd5, 0xa4, 0x7e, 0xf0, 0x7b, 0x58, 0xdb, 0x0f, 0x23, 0x12, 0xc7, 0x47, 0xaf, 0x5e, 0xdb,
+	0xf3, 0x7d, 0x57, 0x16, 0x34, 0x1b, 0x07, 0xda, 0x44, 0x02, 0xdb, 0xeb, 0x5b, 0xfc, 0x57, 0x83,
+	0xda, 0x60, 0x73, 0x11, 0x95, 0x3f, 0x1e, 0x32, 0xcd, 0x0f, 0x2c, 0xa5, 0x99, 0xd1, 0x3e, 0x4b,
+	0x60, 0x27, 0x23, 0x54, 0x3f, 0x0e, 0x20, 0xfd, 0x9d, 0x01, 0x4e, 0x7f, 0xce, 0xfa, 0x86, 0x78,
+	0x45, 0xb4, 0x9b, 0xb3, 0xbe, 0x96, 0x72, 0xf3, 0xf4, 0x62, 0xaa, 0x4a, 0x97, 0x53, 0x55, 0xfa,
+	0x36, 0x55, 0xa5, 0x8f, 0x33, 0x35, 0x77, 0x39, 0x53, 0x73, 0x5f, 0x66, 0x6a, 0xee, 0xdd, 0xee,
+	0x4d, 0x2d, 0x9e, 0x5f, 0xbf, 0x78, 0xa2, 0x5b, 0xaf, 0x28, 0x1e, 0xbb, 0xfd, 0xef, 0x03, 0x00,
+	0x22, 0x1c, 0xbc, 0x53, 0x82, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -332,6 +345,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FinalizationDiscrepancies) > 0 {
+		for iNdEx := len(m.FinalizationDiscrepancies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalizationDiscrepancies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.PubRandCommit) > 0 {
 		for iNdEx := len(m.PubRandCommit) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -615,6 +642,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FinalizationDiscrepancies) > 0 {
+		for _, e := range m.FinalizationDiscrepancies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -913,6 +946,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizationDiscrepancies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalizationDiscrepancies = append(m.FinalizationDiscrepancies, &FinalizationDiscrepancy{})
+			if err := m.FinalizationDiscrepancies[len(m.FinalizationDiscrepancies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
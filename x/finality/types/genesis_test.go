@@ -3,6 +3,8 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+
 	"github.com/babylonchain/babylon/x/finality/types"
 	"github.com/stretchr/testify/require"
 )
@@ -22,7 +24,8 @@ func TestGenesisState_Validate(t *testing.T) {
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
 				Params: types.Params{
-					MinPubRand: 200,
+					MinPubRand:          200,
+					VoteTimelinessBonus: math.LegacyZeroDec(),
 				},
 			},
 			valid: true,
@@ -15,11 +15,14 @@ const (
 )
 
 var (
-	BlockKey                = []byte{0x01} // key prefix for blocks
-	VoteKey                 = []byte{0x02} // key prefix for votes
-	PubRandKey              = []byte{0x03} // key prefix for public randomness
-	PubRandCommitKey        = []byte{0x04} // key prefix for commitment of public randomness
-	ParamsKey               = []byte{0x05} // key prefix for the parameters
-	EvidenceKey             = []byte{0x06} // key prefix for evidences
-	NextHeightToFinalizeKey = []byte{0x07} // key prefix for next height to finalise
+	BlockKey                   = []byte{0x01} // key prefix for blocks
+	VoteKey                    = []byte{0x02} // key prefix for votes
+	PubRandKey                 = []byte{0x03} // key prefix for public randomness
+	PubRandCommitKey           = []byte{0x04} // key prefix for commitment of public randomness
+	ParamsKey                  = []byte{0x05} // key prefix for the parameters
+	EvidenceKey                = []byte{0x06} // key prefix for evidences
+	NextHeightToFinalizeKey    = []byte{0x07} // key prefix for next height to finalise
+	VoteRecordedHeightKey      = []byte{0x08} // key prefix for heights at which votes were recorded
+	FinalizationDiscrepancyKey = []byte{0x09} // key prefix for finalization discrepancies
+	VoteOrderKey               = []byte{0x0a} // key prefix for the order in which votes arrived
 )
@@ -7,8 +7,10 @@ import (
 
 // performance oriented metrics measuring the execution time of each message
 const (
-	MetricsKeyCommitPubRandList = "commit_pub_rand_list"
-	MetricsKeyAddFinalitySig    = "add_finality_sig"
+	MetricsKeyCommitPubRandList           = "commit_pub_rand_list"
+	MetricsKeyAddFinalitySig              = "add_finality_sig"
+	MetricsKeyAddFinalitySigMulti         = "add_finality_sig_multi"
+	MetricsKeySubmitFinalizationChallenge = "submit_finalization_challenge"
 )
 
 // Metrics for monitoring block finalization status
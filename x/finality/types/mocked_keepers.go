@@ -8,7 +8,8 @@ import (
 	context "context"
 	reflect "reflect"
 
-	types "github.com/babylonchain/babylon/x/btcstaking/types"
+	types "github.com/babylonchain/babylon/types"
+	types0 "github.com/babylonchain/babylon/x/btcstaking/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -35,6 +36,20 @@ func (m *MockBTCStakingKeeper) EXPECT() *MockBTCStakingKeeperMockRecorder {
 	return m.recorder
 }
 
+// GetActiveBTCDelegationsAtHeight mocks base method.
+func (m *MockBTCStakingKeeper) GetActiveBTCDelegationsAtHeight(ctx context.Context, fpBTCPK *types.BIP340PubKey, babylonHeight uint64) []*types0.BTCDelegation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveBTCDelegationsAtHeight", ctx, fpBTCPK, babylonHeight)
+	ret0, _ := ret[0].([]*types0.BTCDelegation)
+	return ret0
+}
+
+// GetActiveBTCDelegationsAtHeight indicates an expected call of GetActiveBTCDelegationsAtHeight.
+func (mr *MockBTCStakingKeeperMockRecorder) GetActiveBTCDelegationsAtHeight(ctx, fpBTCPK, babylonHeight interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveBTCDelegationsAtHeight", reflect.TypeOf((*MockBTCStakingKeeper)(nil).GetActiveBTCDelegationsAtHeight), ctx, fpBTCPK, babylonHeight)
+}
+
 // GetBTCStakingActivatedHeight mocks base method.
 func (m *MockBTCStakingKeeper) GetBTCStakingActivatedHeight(ctx context.Context) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -51,10 +66,10 @@ func (mr *MockBTCStakingKeeperMockRecorder) GetBTCStakingActivatedHeight(ctx int
 }
 
 // GetFinalityProvider mocks base method.
-func (m *MockBTCStakingKeeper) GetFinalityProvider(ctx context.Context, fpBTCPK []byte) (*types.FinalityProvider, error) {
+func (m *MockBTCStakingKeeper) GetFinalityProvider(ctx context.Context, fpBTCPK []byte) (*types0.FinalityProvider, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetFinalityProvider", ctx, fpBTCPK)
-	ret0, _ := ret[0].(*types.FinalityProvider)
+	ret0, _ := ret[0].(*types0.FinalityProvider)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -80,10 +95,10 @@ func (mr *MockBTCStakingKeeperMockRecorder) GetLastFinalizedEpoch(ctx interface{
 }
 
 // GetParams mocks base method.
-func (m *MockBTCStakingKeeper) GetParams(ctx context.Context) types.Params {
+func (m *MockBTCStakingKeeper) GetParams(ctx context.Context) types0.Params {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetParams", ctx)
-	ret0, _ := ret[0].(types.Params)
+	ret0, _ := ret[0].(types0.Params)
 	return ret0
 }
 
@@ -108,10 +123,10 @@ func (mr *MockBTCStakingKeeperMockRecorder) GetVotingPower(ctx, fpBTCPK, height
 }
 
 // GetVotingPowerDistCache mocks base method.
-func (m *MockBTCStakingKeeper) GetVotingPowerDistCache(ctx context.Context, height uint64) (*types.VotingPowerDistCache, error) {
+func (m *MockBTCStakingKeeper) GetVotingPowerDistCache(ctx context.Context, height uint64) (*types0.VotingPowerDistCache, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetVotingPowerDistCache", ctx, height)
-	ret0, _ := ret[0].(*types.VotingPowerDistCache)
+	ret0, _ := ret[0].(*types0.VotingPowerDistCache)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -150,6 +165,18 @@ func (mr *MockBTCStakingKeeperMockRecorder) HasFinalityProvider(ctx, fpBTCPK int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasFinalityProvider", reflect.TypeOf((*MockBTCStakingKeeper)(nil).HasFinalityProvider), ctx, fpBTCPK)
 }
 
+// IterateFPs mocks base method.
+func (m *MockBTCStakingKeeper) IterateFPs(ctx context.Context, handler func(*types0.FinalityProvider) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateFPs", ctx, handler)
+}
+
+// IterateFPs indicates an expected call of IterateFPs.
+func (mr *MockBTCStakingKeeperMockRecorder) IterateFPs(ctx, handler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateFPs", reflect.TypeOf((*MockBTCStakingKeeper)(nil).IterateFPs), ctx, handler)
+}
+
 // RemoveVotingPowerDistCache mocks base method.
 func (m *MockBTCStakingKeeper) RemoveVotingPowerDistCache(ctx context.Context, height uint64) {
 	m.ctrl.T.Helper()
@@ -200,7 +227,7 @@ func (m *MockIncentiveKeeper) EXPECT() *MockIncentiveKeeperMockRecorder {
 }
 
 // RewardBTCStaking mocks base method.
-func (m *MockIncentiveKeeper) RewardBTCStaking(ctx context.Context, height uint64, filteredDc *types.VotingPowerDistCache) {
+func (m *MockIncentiveKeeper) RewardBTCStaking(ctx context.Context, height uint64, filteredDc *types0.VotingPowerDistCache) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "RewardBTCStaking", ctx, height, filteredDc)
 }
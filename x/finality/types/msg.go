@@ -13,6 +13,7 @@ import (
 var (
 	_ sdk.Msg = &MsgUpdateParams{}
 	_ sdk.Msg = &MsgAddFinalitySig{}
+	_ sdk.Msg = &MsgAddFinalitySigMulti{}
 	_ sdk.Msg = &MsgCommitPubRandList{}
 )
 
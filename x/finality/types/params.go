@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
@@ -17,7 +18,10 @@ func ParamKeyTable() paramtypes.KeyTable {
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
 	return Params{
-		MinPubRand: 100,
+		MinPubRand:             100,
+		VoteTimelinessWindow:   0,
+		VoteTimelinessBonus:    math.LegacyZeroDec(),
+		MaxPubRandFutureHeight: 100_000,
 	}
 }
 
@@ -33,14 +37,59 @@ func validateMinPubRand(minPubRand uint64) error {
 	return nil
 }
 
+func validateMaxPubRandFutureHeight(maxPubRandFutureHeight uint64, minPubRand uint64) error {
+	// a commitment starting at the next height has to cover at least
+	// minPubRand heights
+	if maxPubRandFutureHeight != 0 && maxPubRandFutureHeight < minPubRand {
+		return fmt.Errorf("max pub rand future height (%d) should not be smaller than min pub rand (%d)", maxPubRandFutureHeight, minPubRand)
+	}
+	return nil
+}
+
+func validateVoteTimelinessBonus(bonus math.LegacyDec) error {
+	if bonus.IsNil() {
+		return fmt.Errorf("vote timeliness bonus should not be nil")
+	}
+	if bonus.IsNegative() {
+		return fmt.Errorf("vote timeliness bonus should not be negative")
+	}
+	if bonus.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("vote timeliness bonus should not be larger than 1")
+	}
+	return nil
+}
+
 // Validate validates the set of params
 func (p Params) Validate() error {
 	if err := validateMinPubRand(p.MinPubRand); err != nil {
 		return err
 	}
+	if err := validateVoteTimelinessBonus(p.VoteTimelinessBonus); err != nil {
+		return err
+	}
+	if err := validateMaxPubRandFutureHeight(p.MaxPubRandFutureHeight, p.MinPubRand); err != nil {
+		return err
+	}
 	return nil
 }
 
+// VoteRewardWeight returns the reward weight of a finality vote for a block
+// at the given height that was recorded at the given height. The weight is
+// 1 plus a bonus that decreases linearly with the voting delay, and is 1 for
+// votes whose delay is not shorter than the timeliness window
+func (p Params) VoteRewardWeight(votedHeight uint64, recordedHeight uint64) math.LegacyDec {
+	weight := math.LegacyOneDec()
+	if p.VoteTimelinessWindow == 0 || recordedHeight >= votedHeight+p.VoteTimelinessWindow {
+		return weight
+	}
+	delay := uint64(0)
+	if recordedHeight > votedHeight {
+		delay = recordedHeight - votedHeight
+	}
+	timeliness := math.LegacyNewDec(int64(p.VoteTimelinessWindow - delay)).QuoInt64(int64(p.VoteTimelinessWindow))
+	return weight.Add(p.VoteTimelinessBonus.Mul(timeliness))
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -28,6 +30,19 @@ type Params struct {
 	// min_pub_rand is the minimum number of public randomness each
 	// message should commit
 	MinPubRand uint64 `protobuf:"varint,1,opt,name=min_pub_rand,json=minPubRand,proto3" json:"min_pub_rand,omitempty"`
+	// vote_timeliness_window is the number of heights after a block within
+	// which a finality vote for the block earns a timeliness bonus. The bonus
+	// decreases linearly from vote_timeliness_bonus for a vote recorded at the
+	// voted height to zero for a vote recorded vote_timeliness_window heights
+	// later. Zero disables the timeliness weighting
+	VoteTimelinessWindow uint64 `protobuf:"varint,2,opt,name=vote_timeliness_window,json=voteTimelinessWindow,proto3" json:"vote_timeliness_window,omitempty"`
+	// vote_timeliness_bonus is the maximum extra reward weight, relative to a
+	// late vote, that a finality provider earns by voting timely
+	VoteTimelinessBonus cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=vote_timeliness_bonus,json=voteTimelinessBonus,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"vote_timeliness_bonus"`
+	// max_pub_rand_future_height is the maximum number of heights beyond the
+	// current height that a public randomness commitment may extend to. Zero
+	// disables the limit
+	MaxPubRandFutureHeight uint64 `protobuf:"varint,4,opt,name=max_pub_rand_future_height,json=maxPubRandFutureHeight,proto3" json:"max_pub_rand_future_height,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -69,6 +84,20 @@ func (m *Params) GetMinPubRand() uint64 {
 	return 0
 }
 
+func (m *Params) GetVoteTimelinessWindow() uint64 {
+	if m != nil {
+		return m.VoteTimelinessWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPubRandFutureHeight() uint64 {
+	if m != nil {
+		return m.MaxPubRandFutureHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.finality.v1.Params")
 }
@@ -76,19 +105,29 @@ func init() {
 func init() { proto.RegisterFile("babylon/finality/v1/params.proto", fileDescriptor_25539c9a61c72ee9) }
 
 var fileDescriptor_25539c9a61c72ee9 = []byte{
-	// 192 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0xcb, 0xcc, 0x4b, 0xcc, 0xc9, 0x2c, 0xa9, 0xd4, 0x2f, 0x33, 0xd4,
-	0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xaa,
-	0xd0, 0x83, 0xa9, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb, 0x83,
-	0x58, 0x10, 0xa5, 0x4a, 0x06, 0x5c, 0x6c, 0x01, 0x60, 0xad, 0x42, 0x0a, 0x5c, 0x3c, 0xb9, 0x99,
-	0x79, 0xf1, 0x05, 0xa5, 0x49, 0xf1, 0x45, 0x89, 0x79, 0x29, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x2c,
-	0x41, 0x5c, 0xb9, 0x99, 0x79, 0x01, 0xa5, 0x49, 0x41, 0x89, 0x79, 0x29, 0x56, 0x2c, 0x33, 0x16,
-	0xc8, 0x33, 0x38, 0x79, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72,
-	0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x41,
-	0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xd4, 0x05, 0xc9, 0x19, 0x89,
-	0x99, 0x79, 0x30, 0x8e, 0x7e, 0x05, 0xc2, 0xc9, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60,
-	0x47, 0x18, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xc1, 0x3d, 0x78, 0xb7, 0xd3, 0x00, 0x00, 0x00,
+	// 343 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xc1, 0x4a, 0xc3, 0x30,
+	0x18, 0xc7, 0xdb, 0x39, 0x06, 0x06, 0x4f, 0xdd, 0x1c, 0x75, 0x42, 0x57, 0x3c, 0xed, 0x62, 0xeb,
+	0xd0, 0xd3, 0x8e, 0x63, 0x88, 0x88, 0x87, 0x31, 0x04, 0xc1, 0x4b, 0x48, 0xda, 0xac, 0x0d, 0x2e,
+	0x49, 0x69, 0xd2, 0x6d, 0x7d, 0x04, 0x6f, 0x1e, 0x3d, 0xfa, 0x10, 0x3e, 0xc4, 0x8e, 0xc3, 0x93,
+	0x78, 0x18, 0xb2, 0xbd, 0x88, 0x2c, 0xed, 0x26, 0x7a, 0xcb, 0xc7, 0xef, 0x9f, 0x2f, 0xbf, 0x7c,
+	0x1f, 0x70, 0x31, 0xc2, 0xf9, 0x44, 0x70, 0x7f, 0x4c, 0x39, 0x9a, 0x50, 0x95, 0xfb, 0xd3, 0xae,
+	0x9f, 0xa0, 0x14, 0x31, 0xe9, 0x25, 0xa9, 0x50, 0xc2, 0xaa, 0x97, 0x09, 0x6f, 0x97, 0xf0, 0xa6,
+	0xdd, 0x56, 0x23, 0x12, 0x91, 0xd0, 0xdc, 0xdf, 0x9e, 0x8a, 0x68, 0xeb, 0x24, 0x10, 0x92, 0x09,
+	0x09, 0x0b, 0x50, 0x14, 0x05, 0x3a, 0x7b, 0xae, 0x80, 0xda, 0x50, 0xb7, 0xb5, 0x5c, 0x70, 0xc4,
+	0x28, 0x87, 0x49, 0x86, 0x61, 0x8a, 0x78, 0x68, 0x9b, 0xae, 0xd9, 0xa9, 0x8e, 0x00, 0xa3, 0x7c,
+	0x98, 0xe1, 0x11, 0xe2, 0xa1, 0x75, 0x05, 0x9a, 0x53, 0xa1, 0x08, 0x54, 0x94, 0x91, 0x09, 0xe5,
+	0x44, 0x4a, 0x38, 0xa3, 0x3c, 0x14, 0x33, 0xbb, 0xa2, 0xb3, 0x8d, 0x2d, 0xbd, 0xdf, 0xc3, 0x07,
+	0xcd, 0x2c, 0x02, 0x8e, 0xff, 0xdf, 0xc2, 0x82, 0x67, 0xd2, 0x3e, 0x70, 0xcd, 0xce, 0x61, 0xbf,
+	0xbb, 0x58, 0xb5, 0x8d, 0xaf, 0x55, 0xfb, 0xb4, 0xf0, 0x92, 0xe1, 0x93, 0x47, 0x85, 0xcf, 0x90,
+	0x8a, 0xbd, 0x3b, 0x12, 0xa1, 0x20, 0x1f, 0x90, 0xe0, 0xe3, 0xfd, 0x1c, 0x94, 0xda, 0x03, 0x12,
+	0x8c, 0xea, 0x7f, 0xdf, 0xe9, 0x6f, 0xbb, 0x59, 0x3d, 0xd0, 0x62, 0x68, 0xbe, 0xd7, 0x87, 0xe3,
+	0x4c, 0x65, 0x29, 0x81, 0x31, 0xa1, 0x51, 0xac, 0xec, 0xaa, 0x16, 0x6c, 0x32, 0x34, 0x2f, 0x3f,
+	0x73, 0xad, 0xf1, 0x8d, 0xa6, 0xbd, 0xea, 0xeb, 0x5b, 0xdb, 0xe8, 0xdf, 0x2e, 0xd6, 0x8e, 0xb9,
+	0x5c, 0x3b, 0xe6, 0xf7, 0xda, 0x31, 0x5f, 0x36, 0x8e, 0xb1, 0xdc, 0x38, 0xc6, 0xe7, 0xc6, 0x31,
+	0x1e, 0x2f, 0x22, 0xaa, 0xe2, 0x0c, 0x7b, 0x81, 0x60, 0x7e, 0x39, 0xf6, 0x20, 0x46, 0x94, 0xef,
+	0x0a, 0x7f, 0xfe, 0xbb, 0x27, 0x95, 0x27, 0x44, 0xe2, 0x9a, 0x1e, 0xef, 0xe5, 0xcf, 0x00, 0x16,
+	0x92, 0x31, 0x7b, 0xc8, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -111,6 +150,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxPubRandFutureHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPubRandFutureHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.VoteTimelinessBonus.Size()
+		i -= size
+		if _, err := m.VoteTimelinessBonus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.VoteTimelinessWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VoteTimelinessWindow))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.MinPubRand != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MinPubRand))
 		i--
@@ -139,6 +198,14 @@ func (m *Params) Size() (n int) {
 	if m.MinPubRand != 0 {
 		n += 1 + sovParams(uint64(m.MinPubRand))
 	}
+	if m.VoteTimelinessWindow != 0 {
+		n += 1 + sovParams(uint64(m.VoteTimelinessWindow))
+	}
+	l = m.VoteTimelinessBonus.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxPubRandFutureHeight != 0 {
+		n += 1 + sovParams(uint64(m.MaxPubRandFutureHeight))
+	}
 	return n
 }
 
@@ -196,6 +263,78 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteTimelinessWindow", wireType)
+			}
+			m.VoteTimelinessWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteTimelinessWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteTimelinessBonus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteTimelinessBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPubRandFutureHeight", wireType)
+			}
+			m.MaxPubRandFutureHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPubRandFutureHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
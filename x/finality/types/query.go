@@ -18,3 +18,23 @@ func NewQueriedBlockStatus(status string) (QueriedBlockStatus, error) {
 	}
 	return QueriedBlockStatus_NON_FINALIZED, fmt.Errorf("invalid queried block status %s", status)
 }
+
+// NewFinalityProviderInactiveReason takes a human-readable reason why a
+// finality provider is inactive and returns our custom enum.
+// Options: slashed | no-active-delegations | outside-active-set | insufficient-pub-rand | any
+func NewFinalityProviderInactiveReason(reason string) (FinalityProviderInactiveReason, error) {
+	switch reason {
+	case "slashed":
+		return FinalityProviderInactiveReason_INACTIVE_REASON_SLASHED, nil
+	case "no-active-delegations":
+		return FinalityProviderInactiveReason_INACTIVE_REASON_NO_ACTIVE_DELEGATIONS, nil
+	case "outside-active-set":
+		return FinalityProviderInactiveReason_INACTIVE_REASON_OUTSIDE_ACTIVE_SET, nil
+	case "insufficient-pub-rand":
+		return FinalityProviderInactiveReason_INACTIVE_REASON_INSUFFICIENT_PUB_RAND, nil
+	case "any":
+		return FinalityProviderInactiveReason_INACTIVE_REASON_ANY, nil
+	default:
+		return -1, fmt.Errorf("invalid inactive reason %s; should be one of {slashed, no-active-delegations, outside-active-set, insufficient-pub-rand, any}", reason)
+	}
+}
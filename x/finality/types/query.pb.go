@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_babylonchain_babylon_types "github.com/babylonchain/babylon/types"
+	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -63,6 +64,53 @@ func (QueriedBlockStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_32bddab77af6fdae, []int{0}
 }
 
+// FinalityProviderInactiveReason is the reason why a finality provider does
+// not participate in finality voting
+type FinalityProviderInactiveReason int32
+
+const (
+	// INACTIVE_REASON_ANY means any of the reasons below, and is only used
+	// for filtering
+	FinalityProviderInactiveReason_INACTIVE_REASON_ANY FinalityProviderInactiveReason = 0
+	// INACTIVE_REASON_SLASHED means the finality provider has been slashed
+	FinalityProviderInactiveReason_INACTIVE_REASON_SLASHED FinalityProviderInactiveReason = 1
+	// INACTIVE_REASON_NO_ACTIVE_DELEGATIONS means all BTC delegations to the
+	// finality provider have expired, been unbonded, or are still pending
+	FinalityProviderInactiveReason_INACTIVE_REASON_NO_ACTIVE_DELEGATIONS FinalityProviderInactiveReason = 2
+	// INACTIVE_REASON_OUTSIDE_ACTIVE_SET means the finality provider has active
+	// BTC delegations but is not among the top max_active_finality_providers
+	// finality providers by voting power
+	FinalityProviderInactiveReason_INACTIVE_REASON_OUTSIDE_ACTIVE_SET FinalityProviderInactiveReason = 3
+	// INACTIVE_REASON_INSUFFICIENT_PUB_RAND means the finality provider has
+	// voting power but has not committed public randomness for the current
+	// height, so it cannot vote
+	FinalityProviderInactiveReason_INACTIVE_REASON_INSUFFICIENT_PUB_RAND FinalityProviderInactiveReason = 4
+)
+
+var FinalityProviderInactiveReason_name = map[int32]string{
+	0: "INACTIVE_REASON_ANY",
+	1: "INACTIVE_REASON_SLASHED",
+	2: "INACTIVE_REASON_NO_ACTIVE_DELEGATIONS",
+	3: "INACTIVE_REASON_OUTSIDE_ACTIVE_SET",
+	4: "INACTIVE_REASON_INSUFFICIENT_PUB_RAND",
+}
+
+var FinalityProviderInactiveReason_value = map[string]int32{
+	"INACTIVE_REASON_ANY":                   0,
+	"INACTIVE_REASON_SLASHED":               1,
+	"INACTIVE_REASON_NO_ACTIVE_DELEGATIONS": 2,
+	"INACTIVE_REASON_OUTSIDE_ACTIVE_SET":    3,
+	"INACTIVE_REASON_INSUFFICIENT_PUB_RAND": 4,
+}
+
+func (x FinalityProviderInactiveReason) String() string {
+	return proto.EnumName(FinalityProviderInactiveReason_name, int32(x))
+}
+
+func (FinalityProviderInactiveReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{1}
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -714,6 +762,162 @@ func (m *QueryVotesAtHeightResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryVotesAtHeightResponse proto.InternalMessageInfo
 
+// QueryFinalitySigsAtHeightRequest is the request type for the
+// Query/FinalitySigsAtHeight RPC method.
+type QueryFinalitySigsAtHeightRequest struct {
+	// height defines at which height to query the finality signatures.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryFinalitySigsAtHeightRequest) Reset()         { *m = QueryFinalitySigsAtHeightRequest{} }
+func (m *QueryFinalitySigsAtHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalitySigsAtHeightRequest) ProtoMessage()    {}
+func (*QueryFinalitySigsAtHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{13}
+}
+func (m *QueryFinalitySigsAtHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalitySigsAtHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalitySigsAtHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalitySigsAtHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalitySigsAtHeightRequest.Merge(m, src)
+}
+func (m *QueryFinalitySigsAtHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalitySigsAtHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalitySigsAtHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalitySigsAtHeightRequest proto.InternalMessageInfo
+
+func (m *QueryFinalitySigsAtHeightRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryFinalitySigsAtHeightResponse is the response type for the
+// Query/FinalitySigsAtHeight RPC method.
+type QueryFinalitySigsAtHeightResponse struct {
+	// sigs is the list of finality signatures on the block at given height,
+	// ordered by the finality providers' BTC PKs
+	Sigs []*FinalitySigResponse `protobuf:"bytes,1,rep,name=sigs,proto3" json:"sigs,omitempty"`
+}
+
+func (m *QueryFinalitySigsAtHeightResponse) Reset()         { *m = QueryFinalitySigsAtHeightResponse{} }
+func (m *QueryFinalitySigsAtHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalitySigsAtHeightResponse) ProtoMessage()    {}
+func (*QueryFinalitySigsAtHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{14}
+}
+func (m *QueryFinalitySigsAtHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalitySigsAtHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalitySigsAtHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalitySigsAtHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalitySigsAtHeightResponse.Merge(m, src)
+}
+func (m *QueryFinalitySigsAtHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalitySigsAtHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalitySigsAtHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalitySigsAtHeightResponse proto.InternalMessageInfo
+
+func (m *QueryFinalitySigsAtHeightResponse) GetSigs() []*FinalitySigResponse {
+	if m != nil {
+		return m.Sigs
+	}
+	return nil
+}
+
+// FinalitySigResponse is a finality signature of a finality provider on a block
+type FinalitySigResponse struct {
+	// fp_btc_pk is the BTC PK of the finality provider that casts this vote
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// block_app_hash is the AppHash of the voted block
+	BlockAppHash []byte `protobuf:"bytes,2,opt,name=block_app_hash,json=blockAppHash,proto3" json:"block_app_hash,omitempty"`
+	// finality_sig is the EOTS signature on the voted block
+	FinalitySig *github_com_babylonchain_babylon_types.SchnorrEOTSSig `protobuf:"bytes,3,opt,name=finality_sig,json=finalitySig,proto3,customtype=github.com/babylonchain/babylon/types.SchnorrEOTSSig" json:"finality_sig,omitempty"`
+	// pub_rand is the public randomness the EOTS signature commits to
+	PubRand *github_com_babylonchain_babylon_types.SchnorrPubRand `protobuf:"bytes,4,opt,name=pub_rand,json=pubRand,proto3,customtype=github.com/babylonchain/babylon/types.SchnorrPubRand" json:"pub_rand,omitempty"`
+	// height is the height of the voted block
+	Height uint64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *FinalitySigResponse) Reset()         { *m = FinalitySigResponse{} }
+func (m *FinalitySigResponse) String() string { return proto.CompactTextString(m) }
+func (*FinalitySigResponse) ProtoMessage()    {}
+func (*FinalitySigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{15}
+}
+func (m *FinalitySigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalitySigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalitySigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalitySigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalitySigResponse.Merge(m, src)
+}
+func (m *FinalitySigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalitySigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalitySigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalitySigResponse proto.InternalMessageInfo
+
+func (m *FinalitySigResponse) GetBlockAppHash() []byte {
+	if m != nil {
+		return m.BlockAppHash
+	}
+	return nil
+}
+
+func (m *FinalitySigResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // QueryEvidenceRequest is the request type for the
 // Query/Evidence RPC method.
 type QueryEvidenceRequest struct {
@@ -726,7 +930,7 @@ func (m *QueryEvidenceRequest) Reset()         { *m = QueryEvidenceRequest{} }
 func (m *QueryEvidenceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEvidenceRequest) ProtoMessage()    {}
 func (*QueryEvidenceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32bddab77af6fdae, []int{13}
+	return fileDescriptor_32bddab77af6fdae, []int{16}
 }
 func (m *QueryEvidenceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -772,7 +976,7 @@ func (m *QueryEvidenceResponse) Reset()         { *m = QueryEvidenceResponse{} }
 func (m *QueryEvidenceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEvidenceResponse) ProtoMessage()    {}
 func (*QueryEvidenceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32bddab77af6fdae, []int{14}
+	return fileDescriptor_32bddab77af6fdae, []int{17}
 }
 func (m *QueryEvidenceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -822,7 +1026,7 @@ func (m *QueryListEvidencesRequest) Reset()         { *m = QueryListEvidencesReq
 func (m *QueryListEvidencesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryListEvidencesRequest) ProtoMessage()    {}
 func (*QueryListEvidencesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32bddab77af6fdae, []int{15}
+	return fileDescriptor_32bddab77af6fdae, []int{18}
 }
 func (m *QueryListEvidencesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -878,7 +1082,7 @@ func (m *QueryListEvidencesResponse) Reset()         { *m = QueryListEvidencesRe
 func (m *QueryListEvidencesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryListEvidencesResponse) ProtoMessage()    {}
 func (*QueryListEvidencesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32bddab77af6fdae, []int{16}
+	return fileDescriptor_32bddab77af6fdae, []int{19}
 }
 func (m *QueryListEvidencesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -921,1417 +1125,6473 @@ func (m *QueryListEvidencesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("babylon.finality.v1.QueriedBlockStatus", QueriedBlockStatus_name, QueriedBlockStatus_value)
-	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.finality.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.finality.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryListPublicRandomnessRequest)(nil), "babylon.finality.v1.QueryListPublicRandomnessRequest")
-	proto.RegisterType((*QueryListPublicRandomnessResponse)(nil), "babylon.finality.v1.QueryListPublicRandomnessResponse")
-	proto.RegisterMapType((map[uint64]*github_com_babylonchain_babylon_types.SchnorrPubRand)(nil), "babylon.finality.v1.QueryListPublicRandomnessResponse.PubRandMapEntry")
-	proto.RegisterType((*PubRandCommitResponse)(nil), "babylon.finality.v1.PubRandCommitResponse")
-	proto.RegisterType((*QueryListPubRandCommitRequest)(nil), "babylon.finality.v1.QueryListPubRandCommitRequest")
-	proto.RegisterType((*QueryListPubRandCommitResponse)(nil), "babylon.finality.v1.QueryListPubRandCommitResponse")
-	proto.RegisterMapType((map[uint64]*PubRandCommitResponse)(nil), "babylon.finality.v1.QueryListPubRandCommitResponse.PubRandCommitMapEntry")
-	proto.RegisterType((*QueryBlockRequest)(nil), "babylon.finality.v1.QueryBlockRequest")
-	proto.RegisterType((*QueryBlockResponse)(nil), "babylon.finality.v1.QueryBlockResponse")
-	proto.RegisterType((*QueryListBlocksRequest)(nil), "babylon.finality.v1.QueryListBlocksRequest")
-	proto.RegisterType((*QueryListBlocksResponse)(nil), "babylon.finality.v1.QueryListBlocksResponse")
-	proto.RegisterType((*QueryVotesAtHeightRequest)(nil), "babylon.finality.v1.QueryVotesAtHeightRequest")
-	proto.RegisterType((*QueryVotesAtHeightResponse)(nil), "babylon.finality.v1.QueryVotesAtHeightResponse")
-	proto.RegisterType((*QueryEvidenceRequest)(nil), "babylon.finality.v1.QueryEvidenceRequest")
-	proto.RegisterType((*QueryEvidenceResponse)(nil), "babylon.finality.v1.QueryEvidenceResponse")
-	proto.RegisterType((*QueryListEvidencesRequest)(nil), "babylon.finality.v1.QueryListEvidencesRequest")
-	proto.RegisterType((*QueryListEvidencesResponse)(nil), "babylon.finality.v1.QueryListEvidencesResponse")
+// QueryEarliestUnfinalizedHeightRequest is the request type for the
+// Query/EarliestUnfinalizedHeight RPC method.
+type QueryEarliestUnfinalizedHeightRequest struct {
 }
 
-func init() { proto.RegisterFile("babylon/finality/v1/query.proto", fileDescriptor_32bddab77af6fdae) }
-
-var fileDescriptor_32bddab77af6fdae = []byte{
-	// 1170 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0x38, 0x8d, 0xdb, 0xbc, 0x24, 0xdf, 0x6f, 0x32, 0x49, 0x4b, 0x70, 0x89, 0xe3, 0x6c,
-	0x21, 0x09, 0x49, 0xb5, 0xdb, 0x38, 0xa5, 0xb4, 0x20, 0xd4, 0xc6, 0x90, 0x90, 0x40, 0xea, 0x9a,
-	0x2d, 0xaa, 0xd4, 0x5e, 0xac, 0x5d, 0x67, 0x62, 0xaf, 0xe2, 0xdd, 0xd9, 0x7a, 0x67, 0xad, 0x58,
-	0x55, 0x25, 0xc4, 0xa1, 0x07, 0x04, 0x12, 0x12, 0x17, 0x2e, 0x3d, 0xd0, 0x2b, 0xff, 0x04, 0xc7,
-	0x1e, 0x23, 0xe0, 0x80, 0x2a, 0x11, 0xa1, 0x84, 0x3f, 0x04, 0x79, 0x66, 0xd6, 0xf6, 0x3a, 0xeb,
-	0x1f, 0x0d, 0x11, 0x37, 0xef, 0xec, 0xfb, 0xf1, 0x79, 0x9f, 0xf7, 0xd9, 0xf7, 0xc6, 0x30, 0x6b,
-	0x1a, 0x66, 0xad, 0x4c, 0x1d, 0x6d, 0xd7, 0x72, 0x8c, 0xb2, 0xc5, 0x6a, 0x5a, 0x75, 0x45, 0x7b,
-	0xec, 0x93, 0x4a, 0x4d, 0x75, 0x2b, 0x94, 0x51, 0x3c, 0x29, 0x0d, 0xd4, 0xc0, 0x40, 0xad, 0xae,
-	0x24, 0xa6, 0x8a, 0xb4, 0x48, 0xf9, 0x7b, 0xad, 0xfe, 0x4b, 0x98, 0x26, 0xde, 0x2a, 0x52, 0x5a,
-	0x2c, 0x13, 0xcd, 0x70, 0x2d, 0xcd, 0x70, 0x1c, 0xca, 0x0c, 0x66, 0x51, 0xc7, 0x93, 0x6f, 0x97,
-	0x0a, 0xd4, 0xb3, 0xa9, 0xa7, 0x99, 0x86, 0x47, 0x44, 0x06, 0xad, 0xba, 0x62, 0x12, 0x66, 0xac,
-	0x68, 0xae, 0x51, 0xb4, 0x1c, 0x6e, 0x2c, 0x6d, 0x53, 0x51, 0xa8, 0x5c, 0xa3, 0x62, 0xd8, 0x41,
-	0x34, 0x25, 0xca, 0xa2, 0x01, 0x91, 0xdb, 0x28, 0x53, 0x80, 0xbf, 0xa8, 0xe7, 0xc9, 0x71, 0x47,
-	0x9d, 0x3c, 0xf6, 0x89, 0xc7, 0x94, 0x1c, 0x4c, 0x86, 0x4e, 0x3d, 0x97, 0x3a, 0x1e, 0xc1, 0xb7,
-	0x20, 0x2e, 0x12, 0x4c, 0xa3, 0x14, 0x5a, 0x1c, 0x49, 0x5f, 0x56, 0x23, 0x0a, 0x57, 0x85, 0x53,
-	0xe6, 0xdc, 0xcb, 0xc3, 0xd9, 0x01, 0x5d, 0x3a, 0x28, 0xdf, 0x21, 0x48, 0xf1, 0x90, 0xdb, 0x96,
-	0xc7, 0x72, 0xbe, 0x59, 0xb6, 0x0a, 0xba, 0xe1, 0xec, 0x50, 0xdb, 0x21, 0x5e, 0x90, 0x16, 0xcf,
-	0xc1, 0xd8, 0xae, 0x9b, 0x37, 0x59, 0x21, 0xef, 0xee, 0xe5, 0x4b, 0x64, 0x9f, 0xa7, 0x19, 0xd6,
-	0x61, 0xd7, 0xcd, 0xb0, 0x42, 0x6e, 0x6f, 0x93, 0xec, 0xe3, 0x0d, 0x80, 0x26, 0x13, 0xd3, 0x31,
-	0x0e, 0x63, 0x5e, 0x15, 0xb4, 0xa9, 0x75, 0xda, 0x54, 0xd1, 0x18, 0x49, 0x9b, 0x9a, 0x33, 0x8a,
-	0x44, 0x86, 0xd7, 0x5b, 0x3c, 0x95, 0x83, 0x18, 0xcc, 0x75, 0xc1, 0x23, 0x0b, 0x7e, 0x81, 0x60,
-	0xd4, 0xf5, 0xcd, 0x7c, 0xc5, 0x70, 0x76, 0xf2, 0xb6, 0xe1, 0x4e, 0xa3, 0xd4, 0xe0, 0xe2, 0x48,
-	0x7a, 0x23, 0xb2, 0xee, 0x9e, 0xe1, 0xd4, 0x9c, 0x6f, 0xd6, 0x4f, 0xef, 0x1a, 0xee, 0xba, 0xc3,
-	0x2a, 0xb5, 0xcc, 0xcd, 0x57, 0x87, 0xb3, 0xd7, 0x8b, 0x16, 0x2b, 0xf9, 0xa6, 0x5a, 0xa0, 0xb6,
-	0x26, 0xa3, 0x16, 0x4a, 0x86, 0xe5, 0x04, 0x0f, 0x1a, 0xab, 0xb9, 0xc4, 0x53, 0xef, 0x17, 0x4a,
-	0x0e, 0xad, 0x54, 0x64, 0x04, 0x1d, 0xdc, 0x46, 0x28, 0xfc, 0x69, 0x04, 0x25, 0x0b, 0x3d, 0x29,
-	0x11, 0x90, 0x5a, 0x39, 0x49, 0x7c, 0x04, 0xff, 0x6f, 0x43, 0x88, 0xc7, 0x61, 0x70, 0x8f, 0xd4,
-	0x78, 0x1f, 0xce, 0xe9, 0xf5, 0x9f, 0x78, 0x0a, 0x86, 0xaa, 0x46, 0xd9, 0x27, 0x3c, 0xd1, 0xa8,
-	0x2e, 0x1e, 0x3e, 0x88, 0xdd, 0x44, 0xca, 0x43, 0xb8, 0x28, 0xdd, 0x3f, 0xa6, 0xb6, 0x6d, 0xb1,
-	0x06, 0x8b, 0x29, 0x18, 0x75, 0x7c, 0x3b, 0x1f, 0x10, 0x29, 0xa3, 0x81, 0xe3, 0xdb, 0xd2, 0x1e,
-	0x27, 0x01, 0x0a, 0xdc, 0xc7, 0x26, 0x0e, 0x93, 0x91, 0x5b, 0x4e, 0x94, 0x6f, 0x10, 0xcc, 0xb4,
-	0xd2, 0xdb, 0x9a, 0xe4, 0x3f, 0x97, 0xce, 0xef, 0x31, 0x48, 0x76, 0x02, 0x23, 0x2b, 0xde, 0x87,
-	0xc9, 0x86, 0x6c, 0x44, 0x19, 0x2d, 0xea, 0xd9, 0xea, 0xa9, 0x9e, 0x93, 0x11, 0xd5, 0xd0, 0x69,
-	0xd0, 0x1e, 0x7d, 0xdc, 0x6d, 0x3b, 0x3e, 0x3b, 0x31, 0xd0, 0xb6, 0x6e, 0x76, 0x91, 0xc4, 0x9d,
-	0x56, 0x49, 0x8c, 0xa4, 0x97, 0xa2, 0xa7, 0x42, 0x54, 0x59, 0xad, 0xf2, 0x59, 0x86, 0x09, 0xce,
-	0x41, 0xa6, 0x4c, 0x0b, 0x7b, 0x41, 0x5b, 0x2f, 0x41, 0xbc, 0x44, 0xac, 0x62, 0x89, 0xc9, 0x7c,
-	0xf2, 0x49, 0xb9, 0x2b, 0xc7, 0x96, 0x34, 0x96, 0xb4, 0xbf, 0x0f, 0x43, 0x66, 0xfd, 0x40, 0x8e,
-	0xa7, 0xb9, 0x48, 0x20, 0x5b, 0xce, 0x0e, 0xd9, 0x27, 0x3b, 0xc2, 0x53, 0xd8, 0x2b, 0x3f, 0x21,
-	0xb8, 0xd4, 0x68, 0x00, 0x7f, 0xd3, 0x98, 0x49, 0xb7, 0x21, 0xee, 0x31, 0x83, 0xf9, 0x62, 0xe6,
-	0xfd, 0x2f, 0xbd, 0xd0, 0xb1, 0x7b, 0x96, 0x0c, 0x7a, 0x9f, 0x9b, 0xeb, 0xd2, 0xed, 0xcc, 0x64,
-	0xf7, 0x1c, 0xc1, 0x1b, 0x27, 0x30, 0x36, 0x07, 0x33, 0x2f, 0xc4, 0x93, 0x12, 0xeb, 0xa3, 0x72,
-	0xe9, 0x70, 0x66, 0x82, 0x51, 0x56, 0xe1, 0x4d, 0x0e, 0xef, 0x01, 0x65, 0xc4, 0x5b, 0x63, 0x9b,
-	0xbc, 0x51, 0xbd, 0xfa, 0x68, 0x43, 0x22, 0xca, 0x49, 0x96, 0x75, 0x0f, 0xce, 0x8b, 0x2f, 0x5a,
-	0xd4, 0x35, 0x9a, 0xb9, 0xf1, 0xea, 0x70, 0x36, 0xdd, 0xdf, 0xc0, 0xcc, 0x6c, 0xe5, 0x56, 0xaf,
-	0x5f, 0xcb, 0xf9, 0xe6, 0xe7, 0xa4, 0xa6, 0xc7, 0xcd, 0xfa, 0x10, 0xf0, 0x94, 0x5b, 0x30, 0xc5,
-	0xd3, 0xad, 0x57, 0xad, 0x1d, 0xe2, 0x14, 0x48, 0xff, 0xd3, 0x43, 0xd1, 0xe1, 0x62, 0x9b, 0x6b,
-	0x83, 0xfb, 0x0b, 0x44, 0x9e, 0x49, 0xdd, 0xcd, 0x44, 0xb2, 0xdf, 0x70, 0x6c, 0x98, 0x2b, 0xcf,
-	0x90, 0xe4, 0xac, 0xde, 0xd2, 0xe0, 0x7d, 0xcb, 0x36, 0x1c, 0xf5, 0x98, 0x51, 0x61, 0xf9, 0x10,
-	0x73, 0x23, 0xfc, 0x4c, 0x10, 0x75, 0x66, 0xda, 0x7a, 0x81, 0x64, 0x1f, 0xda, 0x80, 0xc8, 0x12,
-	0x3f, 0x84, 0xe1, 0x00, 0x73, 0xa0, 0xb0, 0x1e, 0x35, 0x36, 0xed, 0xcf, 0x4c, 0x60, 0x4b, 0xb7,
-	0xc5, 0x37, 0x1f, 0xfe, 0xcc, 0xf0, 0x04, 0x8c, 0x65, 0xef, 0x65, 0xf3, 0x1b, 0x5b, 0xd9, 0xb5,
-	0xed, 0xad, 0x47, 0xeb, 0x9f, 0x8c, 0x0f, 0xe0, 0x31, 0x18, 0x6e, 0x3e, 0x22, 0x7c, 0x1e, 0x06,
-	0xd7, 0xb2, 0x0f, 0xc7, 0x63, 0xe9, 0x5f, 0x00, 0x86, 0x78, 0x95, 0xf8, 0x2b, 0x04, 0x71, 0x71,
-	0x4d, 0xc1, 0x9d, 0xbf, 0xe7, 0xf0, 0x9d, 0x28, 0xb1, 0xd8, 0xdb, 0x50, 0x80, 0x56, 0xae, 0x7c,
-	0xfd, 0xdb, 0xdf, 0x3f, 0xc4, 0x66, 0xf0, 0x65, 0xad, 0xf3, 0x15, 0x0d, 0xff, 0x89, 0x60, 0x2a,
-	0xea, 0xb2, 0x80, 0xdf, 0x7b, 0xdd, 0xcb, 0x85, 0x80, 0x77, 0xe3, 0x74, 0x77, 0x12, 0xe5, 0x01,
-	0x07, 0x9b, 0xc3, 0x59, 0xad, 0xdb, 0x6d, 0x31, 0xef, 0x56, 0x68, 0xbd, 0xa3, 0x15, 0x4f, 0x7b,
-	0x12, 0xfa, 0x52, 0x9e, 0x6a, 0x2e, 0x8f, 0xcc, 0x77, 0x9d, 0x08, 0x9d, 0x2f, 0x5b, 0x1e, 0xc3,
-	0xbf, 0x22, 0x98, 0x38, 0xb1, 0xce, 0x70, 0xfa, 0xb5, 0x76, 0x9f, 0xa8, 0x6c, 0xf5, 0x14, 0xfb,
-	0x52, 0xf9, 0x92, 0x97, 0x95, 0xc5, 0xdb, 0xff, 0xa2, 0xac, 0xd0, 0xfe, 0xe6, 0x45, 0x3d, 0x43,
-	0x30, 0xc4, 0xc5, 0x87, 0xe7, 0x3b, 0x83, 0x6a, 0x5d, 0x60, 0x89, 0x85, 0x9e, 0x76, 0x12, 0xf0,
-	0x55, 0x0e, 0x78, 0x1e, 0xbf, 0x1d, 0x09, 0x58, 0x0c, 0x6b, 0xed, 0x89, 0x18, 0x05, 0x4f, 0xf1,
-	0xb7, 0x08, 0xa0, 0xb9, 0x07, 0xf0, 0x72, 0x77, 0x8a, 0x42, 0x1b, 0x2d, 0x71, 0xb5, 0x3f, 0xe3,
-	0xbe, 0xc4, 0x2c, 0x97, 0xc8, 0x73, 0x04, 0x63, 0xa1, 0x11, 0x8e, 0xd5, 0xce, 0x49, 0xa2, 0x16,
-	0x44, 0x42, 0xeb, 0xdb, 0x5e, 0xe2, 0x5a, 0xe6, 0xb8, 0xde, 0xc1, 0x57, 0x22, 0x71, 0x55, 0xeb,
-	0x3e, 0x4d, 0xba, 0x7e, 0x46, 0x70, 0x21, 0x98, 0x4d, 0xf8, 0xdd, 0xce, 0xa9, 0xda, 0xf6, 0x42,
-	0x62, 0xa9, 0x1f, 0x53, 0x09, 0x68, 0x93, 0x03, 0xca, 0xe0, 0x3b, 0xa7, 0x55, 0x5c, 0x30, 0x32,
-	0xf1, 0x8f, 0x08, 0xc6, 0x42, 0x83, 0xb8, 0x1b, 0x9b, 0x51, 0xab, 0xa3, 0x1b, 0x9b, 0x91, 0x13,
-	0x5e, 0x99, 0xe7, 0xe0, 0x53, 0x38, 0x19, 0x09, 0xbe, 0x31, 0xcc, 0x33, 0x9f, 0xbd, 0x3c, 0x4a,
-	0xa2, 0x83, 0xa3, 0x24, 0xfa, 0xeb, 0x28, 0x89, 0xbe, 0x3f, 0x4e, 0x0e, 0x1c, 0x1c, 0x27, 0x07,
-	0xfe, 0x38, 0x4e, 0x0e, 0x3c, 0xba, 0xd6, 0x6b, 0x2d, 0xef, 0x37, 0x43, 0xf2, 0x0d, 0x6d, 0xc6,
-	0xf9, 0x3f, 0xd0, 0xd5, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x8d, 0x4f, 0x9a, 0xb0, 0x5f, 0x0f,
-	0x00, 0x00,
+func (m *QueryEarliestUnfinalizedHeightRequest) Reset()         { *m = QueryEarliestUnfinalizedHeightRequest{} }
+func (m *QueryEarliestUnfinalizedHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEarliestUnfinalizedHeightRequest) ProtoMessage()    {}
+func (*QueryEarliestUnfinalizedHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{20}
+}
+func (m *QueryEarliestUnfinalizedHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEarliestUnfinalizedHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEarliestUnfinalizedHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEarliestUnfinalizedHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEarliestUnfinalizedHeightRequest.Merge(m, src)
+}
+func (m *QueryEarliestUnfinalizedHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEarliestUnfinalizedHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEarliestUnfinalizedHeightRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryEarliestUnfinalizedHeightRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ListPublicRandomness is a range query for public randomness of a given finality provider
-	// NOTE: Babylon only has the knowledge of public randomness that is already revealed by
-	// finality providers, i.e., the finality provider alreayd provides a finality signature
-	// at the corresponding height
-	// TODO: remove public randomness storage?
-	ListPublicRandomness(ctx context.Context, in *QueryListPublicRandomnessRequest, opts ...grpc.CallOption) (*QueryListPublicRandomnessResponse, error)
-	// ListPubRandCommit is a range query for public randomness commitments of a given finality provider
-	ListPubRandCommit(ctx context.Context, in *QueryListPubRandCommitRequest, opts ...grpc.CallOption) (*QueryListPubRandCommitResponse, error)
-	// Block queries a block at a given height
-	Block(ctx context.Context, in *QueryBlockRequest, opts ...grpc.CallOption) (*QueryBlockResponse, error)
-	// ListBlocks is a range query for blocks at a given status
-	ListBlocks(ctx context.Context, in *QueryListBlocksRequest, opts ...grpc.CallOption) (*QueryListBlocksResponse, error)
-	// VotesAtHeight queries finality providers who have signed the block at given height.
-	VotesAtHeight(ctx context.Context, in *QueryVotesAtHeightRequest, opts ...grpc.CallOption) (*QueryVotesAtHeightResponse, error)
-	// Evidence queries the first evidence which can be used for extracting the BTC SK
-	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
-	// ListEvidences queries is a range query for evidences
-	ListEvidences(ctx context.Context, in *QueryListEvidencesRequest, opts ...grpc.CallOption) (*QueryListEvidencesResponse, error)
+// QueryEarliestUnfinalizedHeightResponse is the response type for the
+// Query/EarliestUnfinalizedHeight RPC method.
+type QueryEarliestUnfinalizedHeightResponse struct {
+	// height is the lowest height since the BTC staking protocol is activated
+	// that is not finalized yet
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// num_votes is the number of finality providers who have voted for the
+	// block at this height
+	NumVotes uint64 `protobuf:"varint,2,opt,name=num_votes,json=numVotes,proto3" json:"num_votes,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryEarliestUnfinalizedHeightResponse) Reset() {
+	*m = QueryEarliestUnfinalizedHeightResponse{}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryEarliestUnfinalizedHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEarliestUnfinalizedHeightResponse) ProtoMessage()    {}
+func (*QueryEarliestUnfinalizedHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{21}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEarliestUnfinalizedHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEarliestUnfinalizedHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEarliestUnfinalizedHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryEarliestUnfinalizedHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEarliestUnfinalizedHeightResponse.Merge(m, src)
+}
+func (m *QueryEarliestUnfinalizedHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEarliestUnfinalizedHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEarliestUnfinalizedHeightResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListPublicRandomness(ctx context.Context, in *QueryListPublicRandomnessRequest, opts ...grpc.CallOption) (*QueryListPublicRandomnessResponse, error) {
-	out := new(QueryListPublicRandomnessResponse)
-	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/ListPublicRandomness", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEarliestUnfinalizedHeightResponse proto.InternalMessageInfo
+
+func (m *QueryEarliestUnfinalizedHeightResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) ListPubRandCommit(ctx context.Context, in *QueryListPubRandCommitRequest, opts ...grpc.CallOption) (*QueryListPubRandCommitResponse, error) {
-	out := new(QueryListPubRandCommitResponse)
-	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/ListPubRandCommit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEarliestUnfinalizedHeightResponse) GetNumVotes() uint64 {
+	if m != nil {
+		return m.NumVotes
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Block(ctx context.Context, in *QueryBlockRequest, opts ...grpc.CallOption) (*QueryBlockResponse, error) {
-	out := new(QueryBlockResponse)
-	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/Block", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryBlockSecuringDelegationsRequest is the request type for the
+// Query/BlockSecuringDelegations RPC method.
+type QueryBlockSecuringDelegationsRequest struct {
+	// height defines at which height to query the securing BTC delegations.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryBlockSecuringDelegationsRequest) Reset()         { *m = QueryBlockSecuringDelegationsRequest{} }
+func (m *QueryBlockSecuringDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockSecuringDelegationsRequest) ProtoMessage()    {}
+func (*QueryBlockSecuringDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{22}
+}
+func (m *QueryBlockSecuringDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockSecuringDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockSecuringDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryBlockSecuringDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockSecuringDelegationsRequest.Merge(m, src)
+}
+func (m *QueryBlockSecuringDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockSecuringDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockSecuringDelegationsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListBlocks(ctx context.Context, in *QueryListBlocksRequest, opts ...grpc.CallOption) (*QueryListBlocksResponse, error) {
-	out := new(QueryListBlocksResponse)
-	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/ListBlocks", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBlockSecuringDelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryBlockSecuringDelegationsRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) VotesAtHeight(ctx context.Context, in *QueryVotesAtHeightRequest, opts ...grpc.CallOption) (*QueryVotesAtHeightResponse, error) {
-	out := new(QueryVotesAtHeightResponse)
-	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/VotesAtHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryBlockSecuringDelegationsResponse is the response type for the
+// Query/BlockSecuringDelegations RPC method.
+type QueryBlockSecuringDelegationsResponse struct {
+	// delegations is the list of BTC delegations whose finality providers
+	// voted for the block
+	Delegations []*SecuringDelegation `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations,omitempty"`
+	// total_sat is the total amount of sats of the BTC delegations, where a
+	// BTC delegation restaked to multiple voters is counted once
+	TotalSat uint64 `protobuf:"varint,2,opt,name=total_sat,json=totalSat,proto3" json:"total_sat,omitempty"`
 }
 
-func (c *queryClient) Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error) {
-	out := new(QueryEvidenceResponse)
-	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/Evidence", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBlockSecuringDelegationsResponse) Reset()         { *m = QueryBlockSecuringDelegationsResponse{} }
+func (m *QueryBlockSecuringDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockSecuringDelegationsResponse) ProtoMessage()    {}
+func (*QueryBlockSecuringDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{23}
+}
+func (m *QueryBlockSecuringDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockSecuringDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockSecuringDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryBlockSecuringDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockSecuringDelegationsResponse.Merge(m, src)
+}
+func (m *QueryBlockSecuringDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockSecuringDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockSecuringDelegationsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListEvidences(ctx context.Context, in *QueryListEvidencesRequest, opts ...grpc.CallOption) (*QueryListEvidencesResponse, error) {
-	out := new(QueryListEvidencesResponse)
-	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/ListEvidences", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBlockSecuringDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryBlockSecuringDelegationsResponse) GetDelegations() []*SecuringDelegation {
+	if m != nil {
+		return m.Delegations
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ListPublicRandomness is a range query for public randomness of a given finality provider
-	// NOTE: Babylon only has the knowledge of public randomness that is already revealed by
-	// finality providers, i.e., the finality provider alreayd provides a finality signature
-	// at the corresponding height
-	// TODO: remove public randomness storage?
-	ListPublicRandomness(context.Context, *QueryListPublicRandomnessRequest) (*QueryListPublicRandomnessResponse, error)
-	// ListPubRandCommit is a range query for public randomness commitments of a given finality provider
-	ListPubRandCommit(context.Context, *QueryListPubRandCommitRequest) (*QueryListPubRandCommitResponse, error)
-	// Block queries a block at a given height
-	Block(context.Context, *QueryBlockRequest) (*QueryBlockResponse, error)
-	// ListBlocks is a range query for blocks at a given status
-	ListBlocks(context.Context, *QueryListBlocksRequest) (*QueryListBlocksResponse, error)
-	// VotesAtHeight queries finality providers who have signed the block at given height.
-	VotesAtHeight(context.Context, *QueryVotesAtHeightRequest) (*QueryVotesAtHeightResponse, error)
-	// Evidence queries the first evidence which can be used for extracting the BTC SK
-	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
-	// ListEvidences queries is a range query for evidences
-	ListEvidences(context.Context, *QueryListEvidencesRequest) (*QueryListEvidencesResponse, error)
+func (m *QueryBlockSecuringDelegationsResponse) GetTotalSat() uint64 {
+	if m != nil {
+		return m.TotalSat
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// SecuringDelegation is a BTC delegation securing a finalized block
+type SecuringDelegation struct {
+	// staking_tx_hash_hex is the hash of the staking tx in hex
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+	// btc_pk_hex is the Bitcoin secp256k1 PK of the BTC delegator in hex
+	BtcPkHex string `protobuf:"bytes,2,opt,name=btc_pk_hex,json=btcPkHex,proto3" json:"btc_pk_hex,omitempty"`
+	// total_sat is the amount of sats staked in the BTC delegation
+	TotalSat uint64 `protobuf:"varint,3,opt,name=total_sat,json=totalSat,proto3" json:"total_sat,omitempty"`
+	// fp_btc_pk_hex_list is the list of finality providers that the BTC
+	// delegation restakes to and that voted for the block
+	FpBtcPkHexList []string `protobuf:"bytes,4,rep,name=fp_btc_pk_hex_list,json=fpBtcPkHexList,proto3" json:"fp_btc_pk_hex_list,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
-}
-func (*UnimplementedQueryServer) ListPublicRandomness(ctx context.Context, req *QueryListPublicRandomnessRequest) (*QueryListPublicRandomnessResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPublicRandomness not implemented")
+func (m *SecuringDelegation) Reset()         { *m = SecuringDelegation{} }
+func (m *SecuringDelegation) String() string { return proto.CompactTextString(m) }
+func (*SecuringDelegation) ProtoMessage()    {}
+func (*SecuringDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{24}
 }
-func (*UnimplementedQueryServer) ListPubRandCommit(ctx context.Context, req *QueryListPubRandCommitRequest) (*QueryListPubRandCommitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPubRandCommit not implemented")
-}
-func (*UnimplementedQueryServer) Block(ctx context.Context, req *QueryBlockRequest) (*QueryBlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Block not implemented")
+func (m *SecuringDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ListBlocks(ctx context.Context, req *QueryListBlocksRequest) (*QueryListBlocksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListBlocks not implemented")
+func (m *SecuringDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecuringDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) VotesAtHeight(ctx context.Context, req *QueryVotesAtHeightRequest) (*QueryVotesAtHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VotesAtHeight not implemented")
+func (m *SecuringDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecuringDelegation.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Evidence(ctx context.Context, req *QueryEvidenceRequest) (*QueryEvidenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Evidence not implemented")
+func (m *SecuringDelegation) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ListEvidences(ctx context.Context, req *QueryListEvidencesRequest) (*QueryListEvidencesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListEvidences not implemented")
+func (m *SecuringDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecuringDelegation.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_SecuringDelegation proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.finality.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *SecuringDelegation) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ListPublicRandomness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListPublicRandomnessRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListPublicRandomness(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.finality.v1.Query/ListPublicRandomness",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListPublicRandomness(ctx, req.(*QueryListPublicRandomnessRequest))
+func (m *SecuringDelegation) GetBtcPkHex() string {
+	if m != nil {
+		return m.BtcPkHex
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ListPubRandCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListPubRandCommitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListPubRandCommit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.finality.v1.Query/ListPubRandCommit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListPubRandCommit(ctx, req.(*QueryListPubRandCommitRequest))
+func (m *SecuringDelegation) GetTotalSat() uint64 {
+	if m != nil {
+		return m.TotalSat
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Block_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Block(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.finality.v1.Query/Block",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Block(ctx, req.(*QueryBlockRequest))
+func (m *SecuringDelegation) GetFpBtcPkHexList() []string {
+	if m != nil {
+		return m.FpBtcPkHexList
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ListBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListBlocksRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListBlocks(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.finality.v1.Query/ListBlocks",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListBlocks(ctx, req.(*QueryListBlocksRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryFinalityProvidersLowOnPubRandRequest is the request type for the
+// Query/FinalityProvidersLowOnPubRand RPC method.
+type QueryFinalityProvidersLowOnPubRandRequest struct {
+	// min_remaining is the number of heights above the current height that
+	// a finality provider is expected to have committed public randomness for
+	MinRemaining uint64 `protobuf:"varint,1,opt,name=min_remaining,json=minRemaining,proto3" json:"min_remaining,omitempty"`
 }
 
-func _Query_VotesAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVotesAtHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).VotesAtHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.finality.v1.Query/VotesAtHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VotesAtHeight(ctx, req.(*QueryVotesAtHeightRequest))
+func (m *QueryFinalityProvidersLowOnPubRandRequest) Reset() {
+	*m = QueryFinalityProvidersLowOnPubRandRequest{}
+}
+func (m *QueryFinalityProvidersLowOnPubRandRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryFinalityProvidersLowOnPubRandRequest) ProtoMessage() {}
+func (*QueryFinalityProvidersLowOnPubRandRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{25}
+}
+func (m *QueryFinalityProvidersLowOnPubRandRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProvidersLowOnPubRandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProvidersLowOnPubRandRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryFinalityProvidersLowOnPubRandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProvidersLowOnPubRandRequest.Merge(m, src)
+}
+func (m *QueryFinalityProvidersLowOnPubRandRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProvidersLowOnPubRandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProvidersLowOnPubRandRequest.DiscardUnknown(m)
 }
 
-func _Query_Evidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEvidenceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFinalityProvidersLowOnPubRandRequest proto.InternalMessageInfo
+
+func (m *QueryFinalityProvidersLowOnPubRandRequest) GetMinRemaining() uint64 {
+	if m != nil {
+		return m.MinRemaining
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Evidence(ctx, in)
+	return 0
+}
+
+// QueryFinalityProvidersLowOnPubRandResponse is the response type for the
+// Query/FinalityProvidersLowOnPubRand RPC method.
+type QueryFinalityProvidersLowOnPubRandResponse struct {
+	// current_height is the current Babylon height
+	CurrentHeight uint64 `protobuf:"varint,1,opt,name=current_height,json=currentHeight,proto3" json:"current_height,omitempty"`
+	// finality_providers is the list of active finality providers whose
+	// highest height with committed public randomness is within min_remaining
+	// of the current height
+	FinalityProviders []*FinalityProviderPubRandStatus `protobuf:"bytes,2,rep,name=finality_providers,json=finalityProviders,proto3" json:"finality_providers,omitempty"`
+}
+
+func (m *QueryFinalityProvidersLowOnPubRandResponse) Reset() {
+	*m = QueryFinalityProvidersLowOnPubRandResponse{}
+}
+func (m *QueryFinalityProvidersLowOnPubRandResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryFinalityProvidersLowOnPubRandResponse) ProtoMessage() {}
+func (*QueryFinalityProvidersLowOnPubRandResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{26}
+}
+func (m *QueryFinalityProvidersLowOnPubRandResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProvidersLowOnPubRandResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProvidersLowOnPubRandResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.finality.v1.Query/Evidence",
+}
+func (m *QueryFinalityProvidersLowOnPubRandResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProvidersLowOnPubRandResponse.Merge(m, src)
+}
+func (m *QueryFinalityProvidersLowOnPubRandResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProvidersLowOnPubRandResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProvidersLowOnPubRandResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalityProvidersLowOnPubRandResponse proto.InternalMessageInfo
+
+func (m *QueryFinalityProvidersLowOnPubRandResponse) GetCurrentHeight() uint64 {
+	if m != nil {
+		return m.CurrentHeight
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Evidence(ctx, req.(*QueryEvidenceRequest))
+	return 0
+}
+
+func (m *QueryFinalityProvidersLowOnPubRandResponse) GetFinalityProviders() []*FinalityProviderPubRandStatus {
+	if m != nil {
+		return m.FinalityProviders
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ListEvidences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListEvidencesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListEvidences(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.finality.v1.Query/ListEvidences",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListEvidences(ctx, req.(*QueryListEvidencesRequest))
+// FinalityProviderPubRandStatus is the status of the committed public
+// randomness of a finality provider
+type FinalityProviderPubRandStatus struct {
+	// fp_btc_pk_hex is the BTC PK of the finality provider in hex
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// has_pub_rand_commit is whether the finality provider has ever committed
+	// public randomness
+	HasPubRandCommit bool `protobuf:"varint,2,opt,name=has_pub_rand_commit,json=hasPubRandCommit,proto3" json:"has_pub_rand_commit,omitempty"`
+	// last_committed_height is the highest height that the finality provider
+	// has committed public randomness for
+	LastCommittedHeight uint64 `protobuf:"varint,3,opt,name=last_committed_height,json=lastCommittedHeight,proto3" json:"last_committed_height,omitempty"`
+	// remaining is the number of heights above the current height that the
+	// finality provider has committed public randomness for
+	Remaining uint64 `protobuf:"varint,4,opt,name=remaining,proto3" json:"remaining,omitempty"`
+}
+
+func (m *FinalityProviderPubRandStatus) Reset()         { *m = FinalityProviderPubRandStatus{} }
+func (m *FinalityProviderPubRandStatus) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderPubRandStatus) ProtoMessage()    {}
+func (*FinalityProviderPubRandStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{27}
+}
+func (m *FinalityProviderPubRandStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderPubRandStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderPubRandStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "babylon.finality.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ListPublicRandomness",
-			Handler:    _Query_ListPublicRandomness_Handler,
-		},
-		{
-			MethodName: "ListPubRandCommit",
-			Handler:    _Query_ListPubRandCommit_Handler,
-		},
-		{
-			MethodName: "Block",
-			Handler:    _Query_Block_Handler,
-		},
-		{
-			MethodName: "ListBlocks",
-			Handler:    _Query_ListBlocks_Handler,
-		},
-		{
-			MethodName: "VotesAtHeight",
-			Handler:    _Query_VotesAtHeight_Handler,
-		},
-		{
-			MethodName: "Evidence",
-			Handler:    _Query_Evidence_Handler,
-		},
-		{
-			MethodName: "ListEvidences",
-			Handler:    _Query_ListEvidences_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "babylon/finality/v1/query.proto",
+func (m *FinalityProviderPubRandStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderPubRandStatus.Merge(m, src)
+}
+func (m *FinalityProviderPubRandStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderPubRandStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderPubRandStatus.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_FinalityProviderPubRandStatus proto.InternalMessageInfo
+
+func (m *FinalityProviderPubRandStatus) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FinalityProviderPubRandStatus) GetHasPubRandCommit() bool {
+	if m != nil {
+		return m.HasPubRandCommit
+	}
+	return false
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *FinalityProviderPubRandStatus) GetLastCommittedHeight() uint64 {
+	if m != nil {
+		return m.LastCommittedHeight
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FinalityProviderPubRandStatus) GetRemaining() uint64 {
+	if m != nil {
+		return m.Remaining
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryInactiveFinalityProvidersRequest is the request type for the
+// Query/InactiveFinalityProviders RPC method.
+type QueryInactiveFinalityProvidersRequest struct {
+	// reason is the reason to filter the inactive finality providers by
+	Reason FinalityProviderInactiveReason `protobuf:"varint,1,opt,name=reason,proto3,enum=babylon.finality.v1.FinalityProviderInactiveReason" json:"reason,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryInactiveFinalityProvidersRequest) Reset()         { *m = QueryInactiveFinalityProvidersRequest{} }
+func (m *QueryInactiveFinalityProvidersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInactiveFinalityProvidersRequest) ProtoMessage()    {}
+func (*QueryInactiveFinalityProvidersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{28}
+}
+func (m *QueryInactiveFinalityProvidersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInactiveFinalityProvidersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInactiveFinalityProvidersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryInactiveFinalityProvidersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInactiveFinalityProvidersRequest.Merge(m, src)
+}
+func (m *QueryInactiveFinalityProvidersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInactiveFinalityProvidersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInactiveFinalityProvidersRequest.DiscardUnknown(m)
 }
 
-func (m *QueryListPublicRandomnessRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryInactiveFinalityProvidersRequest proto.InternalMessageInfo
+
+func (m *QueryInactiveFinalityProvidersRequest) GetReason() FinalityProviderInactiveReason {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return FinalityProviderInactiveReason_INACTIVE_REASON_ANY
 }
 
-func (m *QueryListPublicRandomnessRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryInactiveFinalityProvidersResponse is the response type for the
+// Query/InactiveFinalityProviders RPC method.
+type QueryInactiveFinalityProvidersResponse struct {
+	// current_height is the current Babylon height
+	CurrentHeight uint64 `protobuf:"varint,1,opt,name=current_height,json=currentHeight,proto3" json:"current_height,omitempty"`
+	// finality_providers is the list of inactive finality providers matching
+	// the given reason
+	FinalityProviders []*InactiveFinalityProvider `protobuf:"bytes,2,rep,name=finality_providers,json=finalityProviders,proto3" json:"finality_providers,omitempty"`
 }
 
-func (m *QueryListPublicRandomnessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryInactiveFinalityProvidersResponse) Reset() {
+	*m = QueryInactiveFinalityProvidersResponse{}
+}
+func (m *QueryInactiveFinalityProvidersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInactiveFinalityProvidersResponse) ProtoMessage()    {}
+func (*QueryInactiveFinalityProvidersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{29}
+}
+func (m *QueryInactiveFinalityProvidersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInactiveFinalityProvidersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInactiveFinalityProvidersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FpBtcPkHex) > 0 {
-		i -= len(m.FpBtcPkHex)
-		copy(dAtA[i:], m.FpBtcPkHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryListPublicRandomnessResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryInactiveFinalityProvidersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInactiveFinalityProvidersResponse.Merge(m, src)
 }
-
-func (m *QueryListPublicRandomnessResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryInactiveFinalityProvidersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInactiveFinalityProvidersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInactiveFinalityProvidersResponse.DiscardUnknown(m)
 }
 
-func (m *QueryListPublicRandomnessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PubRandMap) > 0 {
-		for k := range m.PubRandMap {
-			v := m.PubRandMap[k]
-			baseI := i
-			if v != nil {
-				{
-					size := v.Size()
-					i -= size
-					if _, err := v.MarshalTo(dAtA[i:]); err != nil {
-						return 0, err
-					}
-					i = encodeVarintQuery(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_QueryInactiveFinalityProvidersResponse proto.InternalMessageInfo
+
+func (m *QueryInactiveFinalityProvidersResponse) GetCurrentHeight() uint64 {
+	if m != nil {
+		return m.CurrentHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *PubRandCommitResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryInactiveFinalityProvidersResponse) GetFinalityProviders() []*InactiveFinalityProvider {
+	if m != nil {
+		return m.FinalityProviders
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PubRandCommitResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// InactiveFinalityProvider is a finality provider that does not participate
+// in finality voting, together with the reason why
+type InactiveFinalityProvider struct {
+	// fp_btc_pk_hex is the BTC PK of the finality provider in hex
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// reason is the reason why the finality provider is inactive
+	Reason FinalityProviderInactiveReason `protobuf:"varint,2,opt,name=reason,proto3,enum=babylon.finality.v1.FinalityProviderInactiveReason" json:"reason,omitempty"`
 }
 
-func (m *PubRandCommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Commitment) > 0 {
-		i -= len(m.Commitment)
-		copy(dAtA[i:], m.Commitment)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Commitment)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.NumPubRand != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NumPubRand))
-		i--
-		dAtA[i] = 0x8
+func (m *InactiveFinalityProvider) Reset()         { *m = InactiveFinalityProvider{} }
+func (m *InactiveFinalityProvider) String() string { return proto.CompactTextString(m) }
+func (*InactiveFinalityProvider) ProtoMessage()    {}
+func (*InactiveFinalityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{30}
+}
+func (m *InactiveFinalityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InactiveFinalityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InactiveFinalityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *InactiveFinalityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InactiveFinalityProvider.Merge(m, src)
+}
+func (m *InactiveFinalityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *InactiveFinalityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_InactiveFinalityProvider.DiscardUnknown(m)
 }
 
-func (m *QueryListPubRandCommitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_InactiveFinalityProvider proto.InternalMessageInfo
+
+func (m *InactiveFinalityProvider) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryListPubRandCommitRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *InactiveFinalityProvider) GetReason() FinalityProviderInactiveReason {
+	if m != nil {
+		return m.Reason
+	}
+	return FinalityProviderInactiveReason_INACTIVE_REASON_ANY
 }
 
-func (m *QueryListPubRandCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QuerySimulateFinalitySigRequest is the request type for the
+// Query/SimulateFinalitySig RPC method.
+type QuerySimulateFinalitySigRequest struct {
+	// fp_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the finality provider
+	// that casts this vote
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// block_height is the height of the voted block
+	BlockHeight uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// pub_rand is the public randomness committed at this height
+	PubRand *github_com_babylonchain_babylon_types.SchnorrPubRand `protobuf:"bytes,3,opt,name=pub_rand,json=pubRand,proto3,customtype=github.com/babylonchain/babylon/types.SchnorrPubRand" json:"pub_rand,omitempty"`
+	// proof is the proof that the given public randomness is committed under the commitment
+	Proof *crypto.Proof `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof,omitempty"`
+	// block_app_hash is the AppHash of the voted block
+	BlockAppHash []byte `protobuf:"bytes,5,opt,name=block_app_hash,json=blockAppHash,proto3" json:"block_app_hash,omitempty"`
+	// finality_sig is the finality signature to this block
+	FinalitySig *github_com_babylonchain_babylon_types.SchnorrEOTSSig `protobuf:"bytes,6,opt,name=finality_sig,json=finalitySig,proto3,customtype=github.com/babylonchain/babylon/types.SchnorrEOTSSig" json:"finality_sig,omitempty"`
+}
+
+func (m *QuerySimulateFinalitySigRequest) Reset()         { *m = QuerySimulateFinalitySigRequest{} }
+func (m *QuerySimulateFinalitySigRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateFinalitySigRequest) ProtoMessage()    {}
+func (*QuerySimulateFinalitySigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{31}
+}
+func (m *QuerySimulateFinalitySigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateFinalitySigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateFinalitySigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.FpBtcPkHex) > 0 {
-		i -= len(m.FpBtcPkHex)
-		copy(dAtA[i:], m.FpBtcPkHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QuerySimulateFinalitySigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateFinalitySigRequest.Merge(m, src)
+}
+func (m *QuerySimulateFinalitySigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateFinalitySigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateFinalitySigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateFinalitySigRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateFinalitySigRequest) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryListPubRandCommitResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateFinalitySigRequest) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryListPubRandCommitResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySimulateFinalitySigRequest) GetProof() *crypto.Proof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
 }
 
-func (m *QueryListPubRandCommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *QuerySimulateFinalitySigRequest) GetBlockAppHash() []byte {
+	if m != nil {
+		return m.BlockAppHash
 	}
-	if len(m.PubRandCommitMap) > 0 {
-		for k := range m.PubRandCommitMap {
-			v := m.PubRandCommitMap[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintQuery(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+	return nil
+}
+
+// QuerySimulateFinalitySigResponse is the response type for the
+// Query/SimulateFinalitySig RPC method.
+type QuerySimulateFinalitySigResponse struct {
+	// valid is true if the finality signature would be accepted
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// invalid_reason is the reason of rejecting the finality signature if
+	// valid is false
+	InvalidReason string `protobuf:"bytes,2,opt,name=invalid_reason,json=invalidReason,proto3" json:"invalid_reason,omitempty"`
+}
+
+func (m *QuerySimulateFinalitySigResponse) Reset()         { *m = QuerySimulateFinalitySigResponse{} }
+func (m *QuerySimulateFinalitySigResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateFinalitySigResponse) ProtoMessage()    {}
+func (*QuerySimulateFinalitySigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{32}
+}
+func (m *QuerySimulateFinalitySigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateFinalitySigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateFinalitySigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySimulateFinalitySigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateFinalitySigResponse.Merge(m, src)
+}
+func (m *QuerySimulateFinalitySigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateFinalitySigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateFinalitySigResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulateFinalitySigResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateFinalitySigResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryBlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySimulateFinalitySigResponse) GetInvalidReason() string {
+	if m != nil {
+		return m.InvalidReason
+	}
+	return ""
 }
 
-func (m *QueryBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+// QueryFinalityProviderFinalitySigsRequest is the request type for the
+// Query/FinalityProviderFinalitySigs RPC method.
+type QueryFinalityProviderFinalitySigsRequest struct {
+	// fp_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the finality provider
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// from_height is the lowest height of the range (inclusive)
+	FromHeight uint64 `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height is the highest height of the range (inclusive)
+	ToHeight uint64 `protobuf:"varint,3,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFinalityProviderFinalitySigsRequest) Reset() {
+	*m = QueryFinalityProviderFinalitySigsRequest{}
+}
+func (m *QueryFinalityProviderFinalitySigsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalityProviderFinalitySigsRequest) ProtoMessage()    {}
+func (*QueryFinalityProviderFinalitySigsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{33}
+}
+func (m *QueryFinalityProviderFinalitySigsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderFinalitySigsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderFinalitySigsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFinalityProviderFinalitySigsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderFinalitySigsRequest.Merge(m, src)
+}
+func (m *QueryFinalityProviderFinalitySigsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderFinalitySigsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderFinalitySigsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBlockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFinalityProviderFinalitySigsRequest proto.InternalMessageInfo
+
+func (m *QueryFinalityProviderFinalitySigsRequest) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryBlockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFinalityProviderFinalitySigsRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
 }
 
-func (m *QueryBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Block != nil {
-		{
-			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryFinalityProviderFinalitySigsRequest) GetToHeight() uint64 {
+	if m != nil {
+		return m.ToHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryListBlocksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFinalityProviderFinalitySigsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryListBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFinalityProviderFinalitySigsResponse is the response type for the
+// Query/FinalityProviderFinalitySigs RPC method.
+type QueryFinalityProviderFinalitySigsResponse struct {
+	// sigs is the list of finality signatures submitted by the finality
+	// provider within the height range, ordered by height
+	Sigs []*FinalitySigResponse `protobuf:"bytes,1,rep,name=sigs,proto3" json:"sigs,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryListBlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryFinalityProviderFinalitySigsResponse) Reset() {
+	*m = QueryFinalityProviderFinalitySigsResponse{}
+}
+func (m *QueryFinalityProviderFinalitySigsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryFinalityProviderFinalitySigsResponse) ProtoMessage() {}
+func (*QueryFinalityProviderFinalitySigsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{34}
+}
+func (m *QueryFinalityProviderFinalitySigsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderFinalitySigsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderFinalitySigsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryListBlocksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryFinalityProviderFinalitySigsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderFinalitySigsResponse.Merge(m, src)
 }
-
-func (m *QueryListBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFinalityProviderFinalitySigsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderFinalitySigsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderFinalitySigsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryListBlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Blocks) > 0 {
-		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_QueryFinalityProviderFinalitySigsResponse proto.InternalMessageInfo
+
+func (m *QueryFinalityProviderFinalitySigsResponse) GetSigs() []*FinalitySigResponse {
+	if m != nil {
+		return m.Sigs
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryVotesAtHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFinalityProviderFinalitySigsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryVotesAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFinalizingVotersRequest is the request type for the
+// Query/FinalizingVoters RPC method.
+type QueryFinalizingVotersRequest struct {
+	// height is the height of the finalized block
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *QueryVotesAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryFinalizingVotersRequest) Reset()         { *m = QueryFinalizingVotersRequest{} }
+func (m *QueryFinalizingVotersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalizingVotersRequest) ProtoMessage()    {}
+func (*QueryFinalizingVotersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{35}
+}
+func (m *QueryFinalizingVotersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalizingVotersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalizingVotersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFinalizingVotersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalizingVotersRequest.Merge(m, src)
+}
+func (m *QueryFinalizingVotersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalizingVotersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalizingVotersRequest.DiscardUnknown(m)
 }
 
-func (m *QueryVotesAtHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFinalizingVotersRequest proto.InternalMessageInfo
+
+func (m *QueryFinalizingVotersRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryVotesAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// FinalizingVoter is a finality provider whose vote finalized a block
+type FinalizingVoter struct {
+	// fp_btc_pk is the BTC PK of the finality provider
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// voting_power is the voting power of the finality provider at the height
+	// of the block
+	VotingPower uint64 `protobuf:"varint,2,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// cumulative_power is the voting power of this vote and all votes that
+	// arrived before it
+	CumulativePower uint64 `protobuf:"varint,3,opt,name=cumulative_power,json=cumulativePower,proto3" json:"cumulative_power,omitempty"`
 }
 
-func (m *QueryVotesAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BtcPks) > 0 {
-		for iNdEx := len(m.BtcPks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.BtcPks[iNdEx].Size()
-				i -= size
-				if _, err := m.BtcPks[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *FinalizingVoter) Reset()         { *m = FinalizingVoter{} }
+func (m *FinalizingVoter) String() string { return proto.CompactTextString(m) }
+func (*FinalizingVoter) ProtoMessage()    {}
+func (*FinalizingVoter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{36}
+}
+func (m *FinalizingVoter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalizingVoter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalizingVoter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryEvidenceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *FinalizingVoter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizingVoter.Merge(m, src)
 }
-
-func (m *QueryEvidenceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FinalizingVoter) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalizingVoter) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizingVoter.DiscardUnknown(m)
 }
 
-func (m *QueryEvidenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FpBtcPkHex) > 0 {
-		i -= len(m.FpBtcPkHex)
-		copy(dAtA[i:], m.FpBtcPkHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_FinalizingVoter proto.InternalMessageInfo
+
+func (m *FinalizingVoter) GetVotingPower() uint64 {
+	if m != nil {
+		return m.VotingPower
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryEvidenceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FinalizingVoter) GetCumulativePower() uint64 {
+	if m != nil {
+		return m.CumulativePower
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFinalizingVotersResponse is the response type for the
+// Query/FinalizingVoters RPC method.
+type QueryFinalizingVotersResponse struct {
+	// voters is the list of finality providers whose votes finalized the block,
+	// in the order their votes arrived. Votes of finality providers without
+	// voting power at the height are skipped, and votes cast before the arrival
+	// order was tracked are placed last, ordered by BTC PK. If the votes do not
+	// cross the finalization threshold, e.g., upon a finalization discrepancy,
+	// all of them are returned
+	Voters []*FinalizingVoter `protobuf:"bytes,1,rep,name=voters,proto3" json:"voters,omitempty"`
+	// finalizing_power is the cumulative voting power at the point the block
+	// was finalized, i.e., the cumulative power of the last voter
+	FinalizingPower uint64 `protobuf:"varint,2,opt,name=finalizing_power,json=finalizingPower,proto3" json:"finalizing_power,omitempty"`
+	// total_power is the total voting power at the height of the block
+	TotalPower uint64 `protobuf:"varint,3,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+}
+
+func (m *QueryFinalizingVotersResponse) Reset()         { *m = QueryFinalizingVotersResponse{} }
+func (m *QueryFinalizingVotersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalizingVotersResponse) ProtoMessage()    {}
+func (*QueryFinalizingVotersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{37}
+}
+func (m *QueryFinalizingVotersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Evidence != nil {
-		{
-			size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryFinalizingVotersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalizingVotersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFinalizingVotersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalizingVotersResponse.Merge(m, src)
+}
+func (m *QueryFinalizingVotersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalizingVotersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalizingVotersResponse.DiscardUnknown(m)
 }
 
-func (m *QueryListEvidencesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFinalizingVotersResponse proto.InternalMessageInfo
+
+func (m *QueryFinalizingVotersResponse) GetVoters() []*FinalizingVoter {
+	if m != nil {
+		return m.Voters
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryListEvidencesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFinalizingVotersResponse) GetFinalizingPower() uint64 {
+	if m != nil {
+		return m.FinalizingPower
+	}
+	return 0
 }
 
-func (m *QueryListEvidencesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.StartHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryFinalizingVotersResponse) GetTotalPower() uint64 {
+	if m != nil {
+		return m.TotalPower
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryListEvidencesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterEnum("babylon.finality.v1.QueriedBlockStatus", QueriedBlockStatus_name, QueriedBlockStatus_value)
+	proto.RegisterEnum("babylon.finality.v1.FinalityProviderInactiveReason", FinalityProviderInactiveReason_name, FinalityProviderInactiveReason_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.finality.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.finality.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryListPublicRandomnessRequest)(nil), "babylon.finality.v1.QueryListPublicRandomnessRequest")
+	proto.RegisterType((*QueryListPublicRandomnessResponse)(nil), "babylon.finality.v1.QueryListPublicRandomnessResponse")
+	proto.RegisterMapType((map[uint64]*github_com_babylonchain_babylon_types.SchnorrPubRand)(nil), "babylon.finality.v1.QueryListPublicRandomnessResponse.PubRandMapEntry")
+	proto.RegisterType((*PubRandCommitResponse)(nil), "babylon.finality.v1.PubRandCommitResponse")
+	proto.RegisterType((*QueryListPubRandCommitRequest)(nil), "babylon.finality.v1.QueryListPubRandCommitRequest")
+	proto.RegisterType((*QueryListPubRandCommitResponse)(nil), "babylon.finality.v1.QueryListPubRandCommitResponse")
+	proto.RegisterMapType((map[uint64]*PubRandCommitResponse)(nil), "babylon.finality.v1.QueryListPubRandCommitResponse.PubRandCommitMapEntry")
+	proto.RegisterType((*QueryBlockRequest)(nil), "babylon.finality.v1.QueryBlockRequest")
+	proto.RegisterType((*QueryBlockResponse)(nil), "babylon.finality.v1.QueryBlockResponse")
+	proto.RegisterType((*QueryListBlocksRequest)(nil), "babylon.finality.v1.QueryListBlocksRequest")
+	proto.RegisterType((*QueryListBlocksResponse)(nil), "babylon.finality.v1.QueryListBlocksResponse")
+	proto.RegisterType((*QueryVotesAtHeightRequest)(nil), "babylon.finality.v1.QueryVotesAtHeightRequest")
+	proto.RegisterType((*QueryVotesAtHeightResponse)(nil), "babylon.finality.v1.QueryVotesAtHeightResponse")
+	proto.RegisterType((*QueryFinalitySigsAtHeightRequest)(nil), "babylon.finality.v1.QueryFinalitySigsAtHeightRequest")
+	proto.RegisterType((*QueryFinalitySigsAtHeightResponse)(nil), "babylon.finality.v1.QueryFinalitySigsAtHeightResponse")
+	proto.RegisterType((*FinalitySigResponse)(nil), "babylon.finality.v1.FinalitySigResponse")
+	proto.RegisterType((*QueryEvidenceRequest)(nil), "babylon.finality.v1.QueryEvidenceRequest")
+	proto.RegisterType((*QueryEvidenceResponse)(nil), "babylon.finality.v1.QueryEvidenceResponse")
+	proto.RegisterType((*QueryListEvidencesRequest)(nil), "babylon.finality.v1.QueryListEvidencesRequest")
+	proto.RegisterType((*QueryListEvidencesResponse)(nil), "babylon.finality.v1.QueryListEvidencesResponse")
+	proto.RegisterType((*QueryEarliestUnfinalizedHeightRequest)(nil), "babylon.finality.v1.QueryEarliestUnfinalizedHeightRequest")
+	proto.RegisterType((*QueryEarliestUnfinalizedHeightResponse)(nil), "babylon.finality.v1.QueryEarliestUnfinalizedHeightResponse")
+	proto.RegisterType((*QueryBlockSecuringDelegationsRequest)(nil), "babylon.finality.v1.QueryBlockSecuringDelegationsRequest")
+	proto.RegisterType((*QueryBlockSecuringDelegationsResponse)(nil), "babylon.finality.v1.QueryBlockSecuringDelegationsResponse")
+	proto.RegisterType((*SecuringDelegation)(nil), "babylon.finality.v1.SecuringDelegation")
+	proto.RegisterType((*QueryFinalityProvidersLowOnPubRandRequest)(nil), "babylon.finality.v1.QueryFinalityProvidersLowOnPubRandRequest")
+	proto.RegisterType((*QueryFinalityProvidersLowOnPubRandResponse)(nil), "babylon.finality.v1.QueryFinalityProvidersLowOnPubRandResponse")
+	proto.RegisterType((*FinalityProviderPubRandStatus)(nil), "babylon.finality.v1.FinalityProviderPubRandStatus")
+	proto.RegisterType((*QueryInactiveFinalityProvidersRequest)(nil), "babylon.finality.v1.QueryInactiveFinalityProvidersRequest")
+	proto.RegisterType((*QueryInactiveFinalityProvidersResponse)(nil), "babylon.finality.v1.QueryInactiveFinalityProvidersResponse")
+	proto.RegisterType((*InactiveFinalityProvider)(nil), "babylon.finality.v1.InactiveFinalityProvider")
+	proto.RegisterType((*QuerySimulateFinalitySigRequest)(nil), "babylon.finality.v1.QuerySimulateFinalitySigRequest")
+	proto.RegisterType((*QuerySimulateFinalitySigResponse)(nil), "babylon.finality.v1.QuerySimulateFinalitySigResponse")
+	proto.RegisterType((*QueryFinalityProviderFinalitySigsRequest)(nil), "babylon.finality.v1.QueryFinalityProviderFinalitySigsRequest")
+	proto.RegisterType((*QueryFinalityProviderFinalitySigsResponse)(nil), "babylon.finality.v1.QueryFinalityProviderFinalitySigsResponse")
+	proto.RegisterType((*QueryFinalizingVotersRequest)(nil), "babylon.finality.v1.QueryFinalizingVotersRequest")
+	proto.RegisterType((*FinalizingVoter)(nil), "babylon.finality.v1.FinalizingVoter")
+	proto.RegisterType((*QueryFinalizingVotersResponse)(nil), "babylon.finality.v1.QueryFinalizingVotersResponse")
+}
+
+func init() { proto.RegisterFile("babylon/finality/v1/query.proto", fileDescriptor_32bddab77af6fdae) }
+
+var fileDescriptor_32bddab77af6fdae = []byte{
+	// 2351 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0xcb, 0x6f, 0x1b, 0xd7,
+	0xd5, 0xd7, 0xa5, 0x1e, 0x96, 0x0e, 0x25, 0x9b, 0xbe, 0x92, 0x63, 0x99, 0xb6, 0x29, 0x69, 0x6c,
+	0xcb, 0xb2, 0x1c, 0x73, 0x6c, 0xda, 0x51, 0x2c, 0xfb, 0x8b, 0x1d, 0xca, 0xa2, 0x2c, 0x7e, 0x91,
+	0x29, 0x76, 0x28, 0x19, 0x48, 0xda, 0x62, 0x30, 0xa4, 0x46, 0xe4, 0x40, 0xe4, 0xcc, 0x64, 0xe6,
+	0x92, 0x96, 0x12, 0x04, 0x28, 0xba, 0xc8, 0x22, 0x68, 0xd1, 0x02, 0xdd, 0x74, 0x93, 0x45, 0xb3,
+	0x6b, 0x8b, 0x6e, 0x5a, 0xa0, 0x45, 0xd7, 0xdd, 0x64, 0x55, 0x18, 0x8d, 0x17, 0x4d, 0x80, 0x1a,
+	0xad, 0xdd, 0x65, 0x81, 0xfe, 0x0b, 0xc5, 0xdc, 0xb9, 0xf3, 0xa2, 0x86, 0xe4, 0x88, 0x51, 0xbb,
+	0x13, 0xcf, 0x3d, 0x8f, 0xdf, 0x79, 0xdc, 0x33, 0xe7, 0x1e, 0x08, 0x66, 0xca, 0x52, 0xf9, 0xa0,
+	0xae, 0xa9, 0xfc, 0xae, 0xa2, 0x4a, 0x75, 0x85, 0x1c, 0xf0, 0xad, 0x5b, 0xfc, 0x87, 0x4d, 0xd9,
+	0x38, 0x48, 0xeb, 0x86, 0x46, 0x34, 0x3c, 0xc9, 0x18, 0xd2, 0x0e, 0x43, 0xba, 0x75, 0x2b, 0x39,
+	0x55, 0xd5, 0xaa, 0x1a, 0x3d, 0xe7, 0xad, 0xbf, 0x6c, 0xd6, 0xe4, 0x85, 0xaa, 0xa6, 0x55, 0xeb,
+	0x32, 0x2f, 0xe9, 0x0a, 0x2f, 0xa9, 0xaa, 0x46, 0x24, 0xa2, 0x68, 0xaa, 0xc9, 0x4e, 0x17, 0x2b,
+	0x9a, 0xd9, 0xd0, 0x4c, 0xbe, 0x2c, 0x99, 0xb2, 0x6d, 0x81, 0x6f, 0xdd, 0x2a, 0xcb, 0x44, 0xba,
+	0xc5, 0xeb, 0x52, 0x55, 0x51, 0x29, 0x33, 0xe3, 0xbd, 0x48, 0x64, 0x75, 0x47, 0x36, 0x1a, 0x8a,
+	0x4a, 0xf8, 0x8a, 0x71, 0xa0, 0x13, 0x8d, 0xd7, 0x0d, 0x4d, 0xdb, 0x65, 0xc7, 0xb3, 0x61, 0xa0,
+	0x75, 0xc9, 0x90, 0x1a, 0x8e, 0x31, 0x2e, 0x8c, 0xc3, 0xf5, 0x80, 0xf2, 0x70, 0x53, 0x80, 0xbf,
+	0x63, 0xc1, 0x28, 0x52, 0x41, 0x41, 0xfe, 0xb0, 0x29, 0x9b, 0x84, 0x2b, 0xc2, 0x64, 0x80, 0x6a,
+	0xea, 0x9a, 0x6a, 0xca, 0x78, 0x19, 0x46, 0x6c, 0x03, 0xd3, 0x68, 0x16, 0x2d, 0xc4, 0x33, 0xe7,
+	0xd3, 0x21, 0x71, 0x49, 0xdb, 0x42, 0x2b, 0x43, 0x5f, 0xbe, 0x9c, 0x19, 0x10, 0x98, 0x00, 0xf7,
+	0x63, 0x04, 0xb3, 0x54, 0xe5, 0x86, 0x62, 0x92, 0x62, 0xb3, 0x5c, 0x57, 0x2a, 0x82, 0xa4, 0xee,
+	0x68, 0x0d, 0x55, 0x36, 0x1d, 0xb3, 0x78, 0x0e, 0x26, 0x76, 0x75, 0xb1, 0x4c, 0x2a, 0xa2, 0xbe,
+	0x27, 0xd6, 0xe4, 0x7d, 0x6a, 0x66, 0x4c, 0x80, 0x5d, 0x7d, 0x85, 0x54, 0x8a, 0x7b, 0xeb, 0xf2,
+	0x3e, 0x5e, 0x03, 0xf0, 0x02, 0x35, 0x1d, 0xa3, 0x30, 0xe6, 0xd3, 0x76, 0x54, 0xd3, 0x56, 0x54,
+	0xd3, 0x76, 0xde, 0x58, 0x54, 0xd3, 0x45, 0xa9, 0x2a, 0x33, 0xf5, 0x82, 0x4f, 0x92, 0x7b, 0x1e,
+	0x83, 0xb9, 0x2e, 0x78, 0x98, 0xc3, 0x5f, 0x20, 0x18, 0xd7, 0x9b, 0x65, 0xd1, 0x90, 0xd4, 0x1d,
+	0xb1, 0x21, 0xe9, 0xd3, 0x68, 0x76, 0x70, 0x21, 0x9e, 0x59, 0x0b, 0xf5, 0xbb, 0xa7, 0xba, 0x74,
+	0xb1, 0x59, 0xb6, 0xa8, 0x4f, 0x24, 0x3d, 0xa7, 0x12, 0xe3, 0x60, 0xe5, 0xee, 0x37, 0x2f, 0x67,
+	0xee, 0x54, 0x15, 0x52, 0x6b, 0x96, 0xd3, 0x15, 0xad, 0xc1, 0x33, 0xad, 0x95, 0x9a, 0xa4, 0xa8,
+	0xce, 0x0f, 0x9e, 0x1c, 0xe8, 0xb2, 0x99, 0x2e, 0x55, 0x6a, 0xaa, 0x66, 0x18, 0x4c, 0x83, 0x00,
+	0xba, 0xab, 0x0a, 0x3f, 0x0e, 0x09, 0xc9, 0xd5, 0x9e, 0x21, 0xb1, 0x21, 0xf9, 0x63, 0x92, 0x7c,
+	0x07, 0x4e, 0xb5, 0x21, 0xc4, 0x09, 0x18, 0xdc, 0x93, 0x0f, 0x68, 0x1e, 0x86, 0x04, 0xeb, 0x4f,
+	0x3c, 0x05, 0xc3, 0x2d, 0xa9, 0xde, 0x94, 0xa9, 0xa1, 0x71, 0xc1, 0xfe, 0x71, 0x2f, 0x76, 0x17,
+	0x71, 0xef, 0xc3, 0x19, 0x26, 0xfe, 0x48, 0x6b, 0x34, 0x14, 0xe2, 0x46, 0x71, 0x16, 0xc6, 0xd5,
+	0x66, 0x43, 0x74, 0x02, 0xc9, 0xb4, 0x81, 0xda, 0x6c, 0x30, 0x7e, 0x9c, 0x02, 0xa8, 0x50, 0x99,
+	0x86, 0xac, 0x12, 0xa6, 0xd9, 0x47, 0xe1, 0x3e, 0x43, 0x70, 0xd1, 0x1f, 0x5e, 0xbf, 0x91, 0xff,
+	0x79, 0xe9, 0xbc, 0x88, 0x41, 0xaa, 0x13, 0x18, 0xe6, 0xf1, 0x3e, 0x4c, 0xba, 0x65, 0x63, 0xbb,
+	0xe1, 0xab, 0x9e, 0x7c, 0xcf, 0xea, 0x39, 0xac, 0x31, 0x1d, 0xa0, 0x3a, 0xe9, 0x11, 0x12, 0x7a,
+	0x1b, 0xf9, 0xf8, 0x8a, 0x41, 0x83, 0x33, 0xa1, 0x36, 0x43, 0x4a, 0xe2, 0x5d, 0x7f, 0x49, 0xc4,
+	0x33, 0x8b, 0xe1, 0x5d, 0x21, 0xcc, 0x2d, 0x7f, 0xf9, 0x5c, 0x87, 0xd3, 0x34, 0x06, 0x2b, 0x75,
+	0xad, 0xb2, 0xe7, 0xa4, 0xf5, 0x0d, 0x18, 0xa9, 0xc9, 0x4a, 0xb5, 0x46, 0x98, 0x3d, 0xf6, 0x8b,
+	0x7b, 0x02, 0xd8, 0xcf, 0xcc, 0xc2, 0xfe, 0x36, 0x0c, 0x97, 0x2d, 0x02, 0x6b, 0x4f, 0x73, 0xa1,
+	0x40, 0xf2, 0xea, 0x8e, 0xbc, 0x2f, 0xef, 0xd8, 0x92, 0x36, 0x3f, 0xf7, 0x0b, 0x04, 0x6f, 0xb8,
+	0x09, 0xa0, 0x27, 0x6e, 0x4f, 0x7a, 0x08, 0x23, 0x26, 0x91, 0x48, 0xd3, 0xee, 0x79, 0x27, 0x33,
+	0x57, 0x3b, 0x66, 0x4f, 0x61, 0x4a, 0x4b, 0x94, 0x5d, 0x60, 0x62, 0xc7, 0x56, 0x76, 0x9f, 0x23,
+	0x38, 0x7b, 0x08, 0xa3, 0xd7, 0x98, 0xa9, 0x23, 0x26, 0x2b, 0xb1, 0x08, 0x9e, 0x33, 0x81, 0x63,
+	0x2b, 0x18, 0xee, 0x36, 0x9c, 0xa3, 0xf0, 0x9e, 0x6a, 0x44, 0x36, 0xb3, 0x64, 0x9d, 0x26, 0xaa,
+	0x57, 0x1e, 0x1b, 0x90, 0x0c, 0x13, 0x62, 0x6e, 0x6d, 0xc2, 0x09, 0xfb, 0x46, 0xdb, 0x7e, 0x8d,
+	0xaf, 0x2c, 0x7d, 0xf3, 0x72, 0x26, 0x13, 0xad, 0x61, 0xae, 0xe4, 0x8b, 0xb7, 0xef, 0xdc, 0x2c,
+	0x36, 0xcb, 0xef, 0xc9, 0x07, 0xc2, 0x48, 0xd9, 0x6a, 0x02, 0x26, 0x77, 0x8f, 0x7d, 0x84, 0xd6,
+	0x58, 0x54, 0x4a, 0x4a, 0x35, 0x32, 0x54, 0x09, 0xe6, 0xba, 0xc8, 0x32, 0xc4, 0xff, 0x07, 0x43,
+	0xa6, 0x52, 0x75, 0xd2, 0xb0, 0x10, 0x9a, 0x06, 0x9f, 0x02, 0x37, 0x90, 0x54, 0x8a, 0xfb, 0x3a,
+	0x06, 0x93, 0x21, 0xa7, 0x58, 0x80, 0x31, 0xb7, 0xb9, 0x51, 0x54, 0xfd, 0x47, 0xe2, 0x04, 0x6b,
+	0x88, 0xf8, 0x32, 0x9c, 0xa4, 0x15, 0x20, 0x4a, 0xba, 0x2e, 0xd6, 0x24, 0xb3, 0xc6, 0xda, 0xee,
+	0x38, 0xa5, 0x66, 0x75, 0x7d, 0x5d, 0x32, 0x6b, 0xf8, 0xbb, 0x30, 0xee, 0x40, 0x17, 0x4d, 0xa5,
+	0x3a, 0x3d, 0x48, 0x8d, 0x1f, 0xfd, 0xbb, 0x95, 0xdb, 0xdc, 0x2a, 0x59, 0x1e, 0xc5, 0x77, 0x3d,
+	0xf7, 0x70, 0x09, 0x46, 0xdd, 0x6f, 0xc2, 0x50, 0x9f, 0x8a, 0x9d, 0x0f, 0xe2, 0x09, 0xd6, 0x09,
+	0x7d, 0xe9, 0x1b, 0x0e, 0xa4, 0x6f, 0x19, 0xa6, 0x68, 0xfa, 0x72, 0x2d, 0x65, 0x47, 0x56, 0x2b,
+	0x72, 0xf4, 0x0f, 0x07, 0x27, 0xc0, 0x99, 0x36, 0x51, 0xf7, 0xda, 0x8d, 0xca, 0x8c, 0xc6, 0x5a,
+	0xce, 0xc5, 0xd0, 0x8c, 0xbb, 0x82, 0x2e, 0x3b, 0xf7, 0x29, 0x82, 0x73, 0xee, 0x6d, 0x76, 0xce,
+	0x7d, 0x83, 0xd0, 0xb8, 0x49, 0x24, 0x83, 0x88, 0x81, 0x4a, 0x8c, 0x53, 0x9a, 0x5d, 0x71, 0xc7,
+	0xd6, 0x56, 0xbe, 0x40, 0x90, 0x0c, 0x03, 0xc2, 0x5c, 0xbc, 0x0f, 0x63, 0x0e, 0x66, 0xa7, 0xaa,
+	0x7b, 0xf8, 0xe8, 0xf1, 0x1f, 0x5f, 0x6f, 0xb9, 0x0a, 0x57, 0xec, 0x0c, 0x48, 0x46, 0x5d, 0x91,
+	0x4d, 0xb2, 0xad, 0xda, 0xa6, 0x3f, 0x92, 0x77, 0x02, 0x97, 0x97, 0xfb, 0x3e, 0xcc, 0xf7, 0x62,
+	0x64, 0x8e, 0x75, 0xb8, 0xe6, 0xf8, 0x3c, 0x8c, 0x59, 0xc3, 0x4a, 0xcb, 0x6a, 0x48, 0x14, 0xf2,
+	0x90, 0x30, 0xaa, 0x36, 0x1b, 0xb4, 0x41, 0x71, 0x0f, 0xe0, 0xb2, 0xf7, 0xd9, 0x29, 0xc9, 0x95,
+	0xa6, 0xa1, 0xa8, 0xd5, 0x55, 0xb9, 0x2e, 0x57, 0xed, 0x29, 0xbf, 0x57, 0x0f, 0xf9, 0x09, 0x82,
+	0x2b, 0x3d, 0x14, 0x30, 0x78, 0x79, 0x88, 0xef, 0x78, 0x64, 0x16, 0xf9, 0xf0, 0x6f, 0xcf, 0x61,
+	0x35, 0x82, 0x5f, 0xd6, 0xf2, 0x88, 0x68, 0x44, 0xaa, 0x8b, 0xa6, 0x44, 0x1c, 0x8f, 0x28, 0xa1,
+	0x24, 0x11, 0xee, 0x57, 0x08, 0xf0, 0x61, 0x05, 0xf8, 0x06, 0x4c, 0x9a, 0x44, 0xda, 0x53, 0xd4,
+	0xaa, 0x48, 0xf6, 0x69, 0x7b, 0xf0, 0xdd, 0x8d, 0x04, 0x3b, 0xda, 0xda, 0xb7, 0x7a, 0x84, 0x35,
+	0x5a, 0x5d, 0x00, 0xf0, 0xdd, 0xa0, 0x18, 0xe5, 0x1a, 0x2d, 0x3b, 0x83, 0x57, 0x00, 0xc0, 0x60,
+	0x10, 0x00, 0x5e, 0x04, 0x1c, 0xb8, 0x7f, 0x62, 0x5d, 0x31, 0xc9, 0xf4, 0xd0, 0xec, 0xe0, 0xc2,
+	0x98, 0x70, 0xd2, 0xbb, 0x84, 0x56, 0x75, 0x72, 0x45, 0xb8, 0x16, 0x68, 0xc1, 0x45, 0x43, 0xb3,
+	0x6a, 0xcd, 0x30, 0x37, 0xb4, 0x67, 0x9b, 0xaa, 0xd3, 0x0a, 0x58, 0x0e, 0x2e, 0xc1, 0x44, 0x43,
+	0x51, 0x45, 0x43, 0x6e, 0x48, 0x8a, 0xaa, 0xa8, 0x55, 0x96, 0x8a, 0xf1, 0x86, 0xa2, 0x0a, 0x0e,
+	0x8d, 0xfb, 0x3d, 0x82, 0xc5, 0x28, 0x2a, 0x59, 0x56, 0xae, 0xc0, 0xc9, 0x4a, 0xd3, 0x30, 0x64,
+	0xb5, 0xed, 0x66, 0x4e, 0x30, 0x2a, 0xbb, 0x9b, 0x12, 0x60, 0xb7, 0x6b, 0xea, 0x8e, 0xc2, 0xe9,
+	0x18, 0xcd, 0x61, 0xa6, 0xeb, 0x37, 0xc1, 0x31, 0xcf, 0x0c, 0xb3, 0x51, 0xe2, 0xf4, 0x6e, 0x3b,
+	0x3a, 0xee, 0x4f, 0x08, 0x2e, 0x76, 0x15, 0x8a, 0x32, 0x11, 0xdf, 0x80, 0xc9, 0x9a, 0x64, 0x8a,
+	0x6d, 0xa3, 0x2a, 0xcd, 0xdf, 0xa8, 0x90, 0xa8, 0x49, 0x66, 0x60, 0x68, 0xc3, 0x19, 0x38, 0x53,
+	0x97, 0x4c, 0xc2, 0xd8, 0x88, 0xbc, 0xe3, 0x04, 0xc1, 0xce, 0xe9, 0xa4, 0x75, 0xf8, 0xc8, 0x39,
+	0x63, 0xa1, 0xb8, 0x00, 0x63, 0x5e, 0x06, 0x86, 0x28, 0x9f, 0x47, 0xe0, 0x08, 0xbb, 0x0e, 0x79,
+	0x55, 0xaa, 0x10, 0xa5, 0x25, 0x1f, 0xca, 0x82, 0x93, 0xcc, 0xf7, 0x60, 0xc4, 0x90, 0x25, 0x53,
+	0x53, 0xd9, 0x14, 0x76, 0x3b, 0x52, 0x14, 0x1d, 0xb5, 0x02, 0x15, 0x15, 0x98, 0x0a, 0xee, 0x37,
+	0x88, 0x75, 0x89, 0x2e, 0x66, 0x8f, 0x96, 0xf0, 0xef, 0x75, 0x49, 0xf8, 0x8d, 0x0e, 0xb3, 0x58,
+	0xb8, 0xe9, 0xb0, 0x5c, 0x7f, 0x86, 0x60, 0xba, 0x13, 0x7f, 0x94, 0x34, 0x7b, 0xc1, 0x8b, 0x7d,
+	0xfb, 0xe0, 0xfd, 0x3b, 0x06, 0x33, 0x34, 0x78, 0x25, 0xa5, 0xd1, 0xac, 0x4b, 0x44, 0x0e, 0x0c,
+	0x2c, 0x91, 0x1f, 0x63, 0x73, 0x60, 0x0f, 0x1a, 0x4e, 0x58, 0xed, 0xbe, 0x14, 0xa7, 0x34, 0x16,
+	0x54, 0xff, 0x78, 0x30, 0x78, 0x5c, 0xe3, 0x41, 0x1a, 0x86, 0xe9, 0x12, 0x85, 0xd6, 0x62, 0x3c,
+	0x33, 0x9d, 0xf6, 0x96, 0x2c, 0x69, 0x7b, 0xc9, 0x92, 0x2e, 0x5a, 0xe7, 0x82, 0xcd, 0x16, 0x32,
+	0x26, 0x0d, 0x47, 0x18, 0x93, 0x46, 0x8e, 0x71, 0x4c, 0xe2, 0x44, 0x98, 0xed, 0x1c, 0x70, 0x56,
+	0xa7, 0xf6, 0xab, 0x5c, 0xb1, 0xdf, 0xd6, 0xa3, 0x82, 0xfd, 0xc3, 0xaa, 0x5e, 0x45, 0xa5, 0x7f,
+	0x8a, 0xbe, 0x02, 0x18, 0x13, 0x26, 0x18, 0xd5, 0x4e, 0x2d, 0xf7, 0x15, 0x82, 0x85, 0xd0, 0x26,
+	0xe8, 0xb3, 0x74, 0x94, 0x1d, 0xcd, 0x0c, 0xc4, 0x77, 0x0d, 0xad, 0x11, 0x4c, 0x2d, 0x58, 0xa4,
+	0x75, 0xf7, 0x1b, 0x4b, 0xb4, 0x60, 0xf3, 0x18, 0x25, 0x5a, 0xe8, 0x60, 0x33, 0xd4, 0xf7, 0x60,
+	0xf3, 0x5b, 0x04, 0xd7, 0x22, 0x78, 0x75, 0x1c, 0x83, 0xfb, 0xf1, 0x0d, 0x3a, 0x4b, 0x70, 0xc1,
+	0x87, 0xf9, 0x23, 0x45, 0xad, 0x5a, 0x83, 0x87, 0xd1, 0x73, 0xb0, 0xf8, 0x1d, 0x82, 0x53, 0x6d,
+	0x32, 0xff, 0x95, 0x57, 0xc3, 0x1c, 0x8c, 0xb7, 0x34, 0x62, 0x8d, 0x05, 0xba, 0xf6, 0x4c, 0x36,
+	0x9c, 0x6b, 0x6b, 0xd3, 0x8a, 0x16, 0x09, 0x5f, 0x83, 0x44, 0xa5, 0x49, 0x2b, 0x55, 0x69, 0xc9,
+	0x8c, 0xcd, 0xce, 0xf1, 0x29, 0x8f, 0x4e, 0x59, 0xb9, 0x5f, 0x3a, 0x6b, 0x9d, 0xc3, 0xee, 0xba,
+	0x69, 0x19, 0x69, 0x51, 0x0a, 0x4b, 0xcc, 0xe5, 0x2e, 0x89, 0x71, 0xc5, 0x05, 0x26, 0x63, 0x41,
+	0xd9, 0x75, 0x8f, 0x02, 0x88, 0x4f, 0x79, 0x74, 0x1b, 0xf5, 0x0c, 0xc4, 0xed, 0x19, 0xc5, 0x0f,
+	0x18, 0x28, 0x89, 0x32, 0x2c, 0x3e, 0x04, 0x7c, 0xf8, 0x91, 0x8f, 0x4f, 0xc3, 0x44, 0x61, 0xb3,
+	0x20, 0xae, 0xe5, 0x0b, 0xd9, 0x8d, 0xfc, 0x07, 0xb9, 0xd5, 0xc4, 0x00, 0x9e, 0x80, 0x31, 0xef,
+	0x27, 0xc2, 0x27, 0x60, 0x30, 0x5b, 0x78, 0x3f, 0x11, 0x5b, 0x7c, 0x81, 0x20, 0xd5, 0xbd, 0xc7,
+	0xe2, 0xb3, 0x30, 0x99, 0x2f, 0x64, 0x1f, 0x6d, 0xe5, 0x9f, 0xe6, 0x44, 0x21, 0x97, 0x2d, 0x6d,
+	0x16, 0x44, 0x4b, 0x76, 0x00, 0x9f, 0x87, 0xb3, 0xed, 0x07, 0xa5, 0x8d, 0x6c, 0x69, 0x9d, 0x5a,
+	0xb8, 0x06, 0x57, 0xda, 0x0f, 0x0b, 0x9b, 0x22, 0x23, 0xac, 0xe6, 0x36, 0x72, 0x8f, 0xb3, 0x5b,
+	0xf9, 0xcd, 0x42, 0x29, 0x11, 0xc3, 0xf3, 0xc0, 0xb5, 0xb3, 0x6e, 0x6e, 0x6f, 0x95, 0xf2, 0xab,
+	0x39, 0x87, 0xbf, 0x94, 0xdb, 0x4a, 0x0c, 0x86, 0xa9, 0xcc, 0x17, 0x4a, 0xdb, 0x6b, 0x6b, 0xf9,
+	0x47, 0xf9, 0x5c, 0x61, 0x4b, 0x2c, 0x6e, 0xaf, 0x88, 0x42, 0xb6, 0xb0, 0x9a, 0x18, 0xca, 0xbc,
+	0x3c, 0x0b, 0xc3, 0x34, 0x87, 0xf8, 0x07, 0x08, 0x46, 0xec, 0xdd, 0x2f, 0xee, 0xbc, 0x24, 0x09,
+	0x2e, 0x9a, 0x93, 0x0b, 0xbd, 0x19, 0xed, 0x4a, 0xe0, 0x2e, 0xfd, 0xf0, 0xab, 0x7f, 0xfe, 0x2c,
+	0x76, 0x11, 0x9f, 0xe7, 0x3b, 0xef, 0xbd, 0xf1, 0xdf, 0x10, 0x4c, 0x85, 0x6d, 0x60, 0xf1, 0x5b,
+	0x47, 0xdd, 0xd8, 0xda, 0xf0, 0x96, 0xfa, 0x5b, 0xf4, 0x72, 0x4f, 0x29, 0xd8, 0x22, 0x2e, 0xf0,
+	0xdd, 0x56, 0xf0, 0xde, 0xa8, 0xc0, 0x7f, 0x1c, 0xe8, 0xa9, 0x9f, 0xf0, 0x3a, 0xd5, 0x2c, 0x1a,
+	0xae, 0x6a, 0x3a, 0x16, 0xe3, 0xbf, 0x20, 0x38, 0x7d, 0x68, 0x47, 0x88, 0x33, 0x47, 0x5a, 0x28,
+	0xda, 0x9e, 0xdd, 0xee, 0x63, 0x09, 0xc9, 0x6d, 0x51, 0xb7, 0x0a, 0x78, 0xe3, 0x5b, 0xb8, 0x15,
+	0x58, 0x8a, 0x52, 0xa7, 0x3e, 0x45, 0x30, 0x4c, 0xef, 0x14, 0x9e, 0xef, 0x0c, 0xca, 0xbf, 0x15,
+	0x4c, 0x5e, 0xed, 0xc9, 0xc7, 0x00, 0xbf, 0x49, 0x01, 0xcf, 0xe3, 0xcb, 0xa1, 0x80, 0xed, 0x0d,
+	0x18, 0xff, 0xb1, 0xdd, 0x43, 0x3f, 0xc1, 0x3f, 0x42, 0x00, 0xde, 0x72, 0x0d, 0x5f, 0xef, 0x1e,
+	0xa2, 0xc0, 0x9a, 0x30, 0xf9, 0x66, 0x34, 0xe6, 0x48, 0xc5, 0xcc, 0x36, 0x73, 0x9f, 0x23, 0x98,
+	0x08, 0xec, 0xc5, 0x70, 0xba, 0xb3, 0x91, 0xb0, 0xad, 0x5b, 0x92, 0x8f, 0xcc, 0xcf, 0x70, 0x5d,
+	0xa7, 0xb8, 0xae, 0xe0, 0x4b, 0xa1, 0xb8, 0xe8, 0x9b, 0xd8, 0x0b, 0xd7, 0x1f, 0x10, 0x4c, 0x85,
+	0x2d, 0xc3, 0xba, 0x5d, 0xb6, 0x2e, 0x8b, 0xb7, 0xe4, 0xd2, 0x51, 0xc5, 0x18, 0xe8, 0x9b, 0x14,
+	0xf4, 0x22, 0x5e, 0x88, 0x00, 0x9a, 0xa7, 0x9f, 0xeb, 0x5f, 0x23, 0x18, 0x75, 0xf6, 0x15, 0xf8,
+	0x5a, 0x67, 0xb3, 0x6d, 0xbb, 0xa2, 0xe4, 0x62, 0x14, 0x56, 0x86, 0x6a, 0x9d, 0xa2, 0x5a, 0xc1,
+	0xef, 0xf6, 0x7b, 0x57, 0x9c, 0x35, 0x0a, 0xfe, 0x39, 0x82, 0x89, 0xc0, 0x72, 0xa6, 0x5b, 0x1d,
+	0x84, 0xad, 0x93, 0x92, 0x7c, 0x64, 0x7e, 0x06, 0x7e, 0x9e, 0x82, 0x9f, 0xc5, 0xa9, 0x50, 0xf0,
+	0xde, 0x82, 0xe7, 0xcf, 0x08, 0xce, 0x75, 0x5c, 0xb5, 0xe0, 0x7b, 0x5d, 0xc2, 0xd5, 0x63, 0x91,
+	0x93, 0xbc, 0xdf, 0x97, 0x2c, 0x83, 0x7f, 0x97, 0xc2, 0xcf, 0xe0, 0x9b, 0xe1, 0xf0, 0x99, 0xbc,
+	0xd8, 0xf4, 0x14, 0xb0, 0x61, 0x14, 0xbf, 0x40, 0x30, 0xdd, 0x69, 0x37, 0x83, 0x97, 0x7b, 0xb4,
+	0x9d, 0xce, 0x0b, 0xa1, 0xe4, 0xbd, 0x7e, 0x44, 0x99, 0x37, 0x59, 0xea, 0xcd, 0x7d, 0xbc, 0x1c,
+	0xa5, 0x89, 0xf1, 0x26, 0xd3, 0x24, 0xfa, 0x57, 0x40, 0xff, 0x08, 0xd9, 0x16, 0x04, 0x36, 0x1c,
+	0xf8, 0x41, 0xef, 0xcb, 0xd7, 0x6d, 0xdb, 0x92, 0x7c, 0xd8, 0xb7, 0x3c, 0xf3, 0xf2, 0x21, 0xf5,
+	0x72, 0x19, 0xbf, 0x1d, 0xf5, 0xbe, 0xd4, 0xb5, 0x67, 0xa2, 0xa6, 0xba, 0xcb, 0x0b, 0x5a, 0x8b,
+	0x1d, 0x1f, 0xf4, 0xdd, 0x6a, 0xb1, 0xd7, 0xf2, 0x21, 0x79, 0xbf, 0x2f, 0xd9, 0x48, 0xb5, 0x18,
+	0xe2, 0x97, 0xc2, 0x54, 0xe2, 0xaf, 0x11, 0x4c, 0x86, 0xbc, 0xf9, 0xf0, 0x9d, 0xce, 0x70, 0x3a,
+	0xbf, 0xc9, 0x93, 0x6f, 0x1d, 0x51, 0x8a, 0xc1, 0xdf, 0xa6, 0xf0, 0x37, 0xf1, 0x93, 0x7e, 0xdb,
+	0x98, 0xc9, 0x94, 0x8b, 0xfe, 0x07, 0x32, 0xfe, 0x17, 0x82, 0x0b, 0xdd, 0xde, 0x65, 0xf8, 0x9d,
+	0xe8, 0xf5, 0x14, 0xf2, 0x4a, 0x4d, 0x3e, 0xe8, 0x57, 0x9c, 0xb9, 0xfd, 0x84, 0xba, 0xfd, 0x18,
+	0xe7, 0xfa, 0x75, 0xdb, 0xef, 0xad, 0x89, 0xff, 0x88, 0x20, 0xd1, 0xfe, 0xc6, 0xc1, 0xb7, 0x7a,
+	0x61, 0x3c, 0xf4, 0xfc, 0x4b, 0x66, 0x8e, 0x22, 0xc2, 0x5c, 0x79, 0x40, 0x5d, 0xb9, 0x8b, 0x97,
+	0x22, 0xb5, 0x0f, 0xdf, 0x7b, 0xc9, 0x7e, 0x44, 0xad, 0xfc, 0xff, 0x97, 0xaf, 0x52, 0xe8, 0xf9,
+	0xab, 0x14, 0xfa, 0xfb, 0xab, 0x14, 0xfa, 0xe9, 0xeb, 0xd4, 0xc0, 0xf3, 0xd7, 0xa9, 0x81, 0xbf,
+	0xbe, 0x4e, 0x0d, 0x7c, 0x70, 0xb3, 0xd7, 0x4b, 0x72, 0xdf, 0x33, 0x45, 0x1f, 0x95, 0xe5, 0x11,
+	0xfa, 0x4f, 0x27, 0xb7, 0xff, 0x33, 0x00, 0xe9, 0x2b, 0x86, 0x68, 0x71, 0x23, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ListPublicRandomness is a range query for public randomness of a given finality provider
+	// NOTE: Babylon only has the knowledge of public randomness that is already revealed by
+	// finality providers, i.e., the finality provider alreayd provides a finality signature
+	// at the corresponding height
+	// TODO: remove public randomness storage?
+	ListPublicRandomness(ctx context.Context, in *QueryListPublicRandomnessRequest, opts ...grpc.CallOption) (*QueryListPublicRandomnessResponse, error)
+	// ListPubRandCommit is a range query for public randomness commitments of a given finality provider
+	ListPubRandCommit(ctx context.Context, in *QueryListPubRandCommitRequest, opts ...grpc.CallOption) (*QueryListPubRandCommitResponse, error)
+	// Block queries a block at a given height
+	Block(ctx context.Context, in *QueryBlockRequest, opts ...grpc.CallOption) (*QueryBlockResponse, error)
+	// ListBlocks is a range query for blocks at a given status
+	ListBlocks(ctx context.Context, in *QueryListBlocksRequest, opts ...grpc.CallOption) (*QueryListBlocksResponse, error)
+	// VotesAtHeight queries finality providers who have signed the block at given height.
+	VotesAtHeight(ctx context.Context, in *QueryVotesAtHeightRequest, opts ...grpc.CallOption) (*QueryVotesAtHeightResponse, error)
+	// FinalitySigsAtHeight queries the finality signatures of the finality
+	// providers who have signed the block at given height, together with the
+	// voted app hash and public randomness, so that third parties can verify
+	// them and extract keys upon equivocation
+	FinalitySigsAtHeight(ctx context.Context, in *QueryFinalitySigsAtHeightRequest, opts ...grpc.CallOption) (*QueryFinalitySigsAtHeightResponse, error)
+	// Evidence queries the first evidence which can be used for extracting the BTC SK
+	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
+	// ListEvidences queries is a range query for evidences
+	ListEvidences(ctx context.Context, in *QueryListEvidencesRequest, opts ...grpc.CallOption) (*QueryListEvidencesResponse, error)
+	// EarliestUnfinalizedHeight queries the lowest height since the BTC staking
+	// protocol is activated that is not finalized yet, together with its votes
+	EarliestUnfinalizedHeight(ctx context.Context, in *QueryEarliestUnfinalizedHeightRequest, opts ...grpc.CallOption) (*QueryEarliestUnfinalizedHeightResponse, error)
+	// BlockSecuringDelegations queries the BTC delegations whose finality
+	// providers voted for the finalized block at a given height
+	BlockSecuringDelegations(ctx context.Context, in *QueryBlockSecuringDelegationsRequest, opts ...grpc.CallOption) (*QueryBlockSecuringDelegationsResponse, error)
+	// FinalityProvidersLowOnPubRand queries the active finality providers whose
+	// committed public randomness is about to run out
+	FinalityProvidersLowOnPubRand(ctx context.Context, in *QueryFinalityProvidersLowOnPubRandRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersLowOnPubRandResponse, error)
+	// InactiveFinalityProviders queries the finality providers that do not
+	// participate in finality voting at the current height, together with the
+	// reason why
+	InactiveFinalityProviders(ctx context.Context, in *QueryInactiveFinalityProvidersRequest, opts ...grpc.CallOption) (*QueryInactiveFinalityProvidersResponse, error)
+	// SimulateFinalitySig checks whether a finality signature would be
+	// accepted, using the same checks as submitting it, without changing state
+	SimulateFinalitySig(ctx context.Context, in *QuerySimulateFinalitySigRequest, opts ...grpc.CallOption) (*QuerySimulateFinalitySigResponse, error)
+	// FinalityProviderFinalitySigs queries all finality signatures submitted by
+	// a finality provider on the blocks within a height range
+	FinalityProviderFinalitySigs(ctx context.Context, in *QueryFinalityProviderFinalitySigsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderFinalitySigsResponse, error)
+	// FinalizingVoters queries the finality providers whose votes finalized the
+	// block at the given height, i.e., the shortest prefix of the votes in the
+	// order they arrived that crosses the finalization threshold
+	FinalizingVoters(ctx context.Context, in *QueryFinalizingVotersRequest, opts ...grpc.CallOption) (*QueryFinalizingVotersResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryListEvidencesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListPublicRandomness(ctx context.Context, in *QueryListPublicRandomnessRequest, opts ...grpc.CallOption) (*QueryListPublicRandomnessResponse, error) {
+	out := new(QueryListPublicRandomnessResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/ListPublicRandomness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryListEvidencesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Evidences) > 0 {
-		for iNdEx := len(m.Evidences) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Evidences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) ListPubRandCommit(ctx context.Context, in *QueryListPubRandCommitRequest, opts ...grpc.CallOption) (*QueryListPubRandCommitResponse, error) {
+	out := new(QueryListPubRandCommitResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/ListPubRandCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) Block(ctx context.Context, in *QueryBlockRequest, opts ...grpc.CallOption) (*QueryBlockResponse, error) {
+	out := new(QueryBlockResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/Block", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) ListBlocks(ctx context.Context, in *QueryListBlocksRequest, opts ...grpc.CallOption) (*QueryListBlocksResponse, error) {
+	out := new(QueryListBlocksResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/ListBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) VotesAtHeight(ctx context.Context, in *QueryVotesAtHeightRequest, opts ...grpc.CallOption) (*QueryVotesAtHeightResponse, error) {
+	out := new(QueryVotesAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/VotesAtHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryListPublicRandomnessRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) FinalitySigsAtHeight(ctx context.Context, in *QueryFinalitySigsAtHeightRequest, opts ...grpc.CallOption) (*QueryFinalitySigsAtHeightResponse, error) {
+	out := new(QueryFinalitySigsAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/FinalitySigsAtHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error) {
+	out := new(QueryEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/Evidence", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) ListEvidences(ctx context.Context, in *QueryListEvidencesRequest, opts ...grpc.CallOption) (*QueryListEvidencesResponse, error) {
+	out := new(QueryListEvidencesResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/ListEvidences", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryListPublicRandomnessResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) EarliestUnfinalizedHeight(ctx context.Context, in *QueryEarliestUnfinalizedHeightRequest, opts ...grpc.CallOption) (*QueryEarliestUnfinalizedHeightResponse, error) {
+	out := new(QueryEarliestUnfinalizedHeightResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/EarliestUnfinalizedHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.PubRandMap) > 0 {
-		for k, v := range m.PubRandMap {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovQuery(uint64(l))
-			}
-			mapEntrySize := 1 + sovQuery(uint64(k)) + l
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
-		}
+	return out, nil
+}
+
+func (c *queryClient) BlockSecuringDelegations(ctx context.Context, in *QueryBlockSecuringDelegationsRequest, opts ...grpc.CallOption) (*QueryBlockSecuringDelegationsResponse, error) {
+	out := new(QueryBlockSecuringDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/BlockSecuringDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) FinalityProvidersLowOnPubRand(ctx context.Context, in *QueryFinalityProvidersLowOnPubRandRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersLowOnPubRandResponse, error) {
+	out := new(QueryFinalityProvidersLowOnPubRandResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/FinalityProvidersLowOnPubRand", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *PubRandCommitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) InactiveFinalityProviders(ctx context.Context, in *QueryInactiveFinalityProvidersRequest, opts ...grpc.CallOption) (*QueryInactiveFinalityProvidersResponse, error) {
+	out := new(QueryInactiveFinalityProvidersResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/InactiveFinalityProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.NumPubRand != 0 {
-		n += 1 + sovQuery(uint64(m.NumPubRand))
-	}
-	l = len(m.Commitment)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return out, nil
 }
 
-func (m *QueryListPubRandCommitRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) SimulateFinalitySig(ctx context.Context, in *QuerySimulateFinalitySigRequest, opts ...grpc.CallOption) (*QuerySimulateFinalitySigResponse, error) {
+	out := new(QuerySimulateFinalitySigResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/SimulateFinalitySig", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryListPubRandCommitResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PubRandCommitMap) > 0 {
-		for k, v := range m.PubRandCommitMap {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovQuery(uint64(l))
-			}
-			mapEntrySize := 1 + sovQuery(uint64(k)) + l
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) FinalityProviderFinalitySigs(ctx context.Context, in *QueryFinalityProviderFinalitySigsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderFinalitySigsResponse, error) {
+	out := new(QueryFinalityProviderFinalitySigsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/FinalityProviderFinalitySigs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryBlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+func (c *queryClient) FinalizingVoters(ctx context.Context, in *QueryFinalizingVotersRequest, opts ...grpc.CallOption) (*QueryFinalizingVotersResponse, error) {
+	out := new(QueryFinalizingVotersResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/FinalizingVoters", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryBlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Block != nil {
-		l = m.Block.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ListPublicRandomness is a range query for public randomness of a given finality provider
+	// NOTE: Babylon only has the knowledge of public randomness that is already revealed by
+	// finality providers, i.e., the finality provider alreayd provides a finality signature
+	// at the corresponding height
+	// TODO: remove public randomness storage?
+	ListPublicRandomness(context.Context, *QueryListPublicRandomnessRequest) (*QueryListPublicRandomnessResponse, error)
+	// ListPubRandCommit is a range query for public randomness commitments of a given finality provider
+	ListPubRandCommit(context.Context, *QueryListPubRandCommitRequest) (*QueryListPubRandCommitResponse, error)
+	// Block queries a block at a given height
+	Block(context.Context, *QueryBlockRequest) (*QueryBlockResponse, error)
+	// ListBlocks is a range query for blocks at a given status
+	ListBlocks(context.Context, *QueryListBlocksRequest) (*QueryListBlocksResponse, error)
+	// VotesAtHeight queries finality providers who have signed the block at given height.
+	VotesAtHeight(context.Context, *QueryVotesAtHeightRequest) (*QueryVotesAtHeightResponse, error)
+	// FinalitySigsAtHeight queries the finality signatures of the finality
+	// providers who have signed the block at given height, together with the
+	// voted app hash and public randomness, so that third parties can verify
+	// them and extract keys upon equivocation
+	FinalitySigsAtHeight(context.Context, *QueryFinalitySigsAtHeightRequest) (*QueryFinalitySigsAtHeightResponse, error)
+	// Evidence queries the first evidence which can be used for extracting the BTC SK
+	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
+	// ListEvidences queries is a range query for evidences
+	ListEvidences(context.Context, *QueryListEvidencesRequest) (*QueryListEvidencesResponse, error)
+	// EarliestUnfinalizedHeight queries the lowest height since the BTC staking
+	// protocol is activated that is not finalized yet, together with its votes
+	EarliestUnfinalizedHeight(context.Context, *QueryEarliestUnfinalizedHeightRequest) (*QueryEarliestUnfinalizedHeightResponse, error)
+	// BlockSecuringDelegations queries the BTC delegations whose finality
+	// providers voted for the finalized block at a given height
+	BlockSecuringDelegations(context.Context, *QueryBlockSecuringDelegationsRequest) (*QueryBlockSecuringDelegationsResponse, error)
+	// FinalityProvidersLowOnPubRand queries the active finality providers whose
+	// committed public randomness is about to run out
+	FinalityProvidersLowOnPubRand(context.Context, *QueryFinalityProvidersLowOnPubRandRequest) (*QueryFinalityProvidersLowOnPubRandResponse, error)
+	// InactiveFinalityProviders queries the finality providers that do not
+	// participate in finality voting at the current height, together with the
+	// reason why
+	InactiveFinalityProviders(context.Context, *QueryInactiveFinalityProvidersRequest) (*QueryInactiveFinalityProvidersResponse, error)
+	// SimulateFinalitySig checks whether a finality signature would be
+	// accepted, using the same checks as submitting it, without changing state
+	SimulateFinalitySig(context.Context, *QuerySimulateFinalitySigRequest) (*QuerySimulateFinalitySigResponse, error)
+	// FinalityProviderFinalitySigs queries all finality signatures submitted by
+	// a finality provider on the blocks within a height range
+	FinalityProviderFinalitySigs(context.Context, *QueryFinalityProviderFinalitySigsRequest) (*QueryFinalityProviderFinalitySigsResponse, error)
+	// FinalizingVoters queries the finality providers whose votes finalized the
+	// block at the given height, i.e., the shortest prefix of the votes in the
+	// order they arrived that crosses the finalization threshold
+	FinalizingVoters(context.Context, *QueryFinalizingVotersRequest) (*QueryFinalizingVotersResponse, error)
 }
 
-func (m *QueryListBlocksRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ListPublicRandomness(ctx context.Context, req *QueryListPublicRandomnessRequest) (*QueryListPublicRandomnessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPublicRandomness not implemented")
+}
+func (*UnimplementedQueryServer) ListPubRandCommit(ctx context.Context, req *QueryListPubRandCommitRequest) (*QueryListPubRandCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPubRandCommit not implemented")
+}
+func (*UnimplementedQueryServer) Block(ctx context.Context, req *QueryBlockRequest) (*QueryBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Block not implemented")
+}
+func (*UnimplementedQueryServer) ListBlocks(ctx context.Context, req *QueryListBlocksRequest) (*QueryListBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlocks not implemented")
+}
+func (*UnimplementedQueryServer) VotesAtHeight(ctx context.Context, req *QueryVotesAtHeightRequest) (*QueryVotesAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotesAtHeight not implemented")
+}
+func (*UnimplementedQueryServer) FinalitySigsAtHeight(ctx context.Context, req *QueryFinalitySigsAtHeightRequest) (*QueryFinalitySigsAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalitySigsAtHeight not implemented")
+}
+func (*UnimplementedQueryServer) Evidence(ctx context.Context, req *QueryEvidenceRequest) (*QueryEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Evidence not implemented")
+}
+func (*UnimplementedQueryServer) ListEvidences(ctx context.Context, req *QueryListEvidencesRequest) (*QueryListEvidencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvidences not implemented")
+}
+func (*UnimplementedQueryServer) EarliestUnfinalizedHeight(ctx context.Context, req *QueryEarliestUnfinalizedHeightRequest) (*QueryEarliestUnfinalizedHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EarliestUnfinalizedHeight not implemented")
+}
+func (*UnimplementedQueryServer) BlockSecuringDelegations(ctx context.Context, req *QueryBlockSecuringDelegationsRequest) (*QueryBlockSecuringDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockSecuringDelegations not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProvidersLowOnPubRand(ctx context.Context, req *QueryFinalityProvidersLowOnPubRandRequest) (*QueryFinalityProvidersLowOnPubRandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProvidersLowOnPubRand not implemented")
+}
+func (*UnimplementedQueryServer) InactiveFinalityProviders(ctx context.Context, req *QueryInactiveFinalityProvidersRequest) (*QueryInactiveFinalityProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InactiveFinalityProviders not implemented")
+}
+func (*UnimplementedQueryServer) SimulateFinalitySig(ctx context.Context, req *QuerySimulateFinalitySigRequest) (*QuerySimulateFinalitySigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateFinalitySig not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviderFinalitySigs(ctx context.Context, req *QueryFinalityProviderFinalitySigsRequest) (*QueryFinalityProviderFinalitySigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderFinalitySigs not implemented")
+}
+func (*UnimplementedQueryServer) FinalizingVoters(ctx context.Context, req *QueryFinalizingVotersRequest) (*QueryFinalizingVotersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizingVoters not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/Params",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListBlocksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ListPublicRandomness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListPublicRandomnessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Blocks) > 0 {
-		for _, e := range m.Blocks {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ListPublicRandomness(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/ListPublicRandomness",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPublicRandomness(ctx, req.(*QueryListPublicRandomnessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVotesAtHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ListPubRandCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListPubRandCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	if interceptor == nil {
+		return srv.(QueryServer).ListPubRandCommit(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryVotesAtHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/ListPubRandCommit",
 	}
-	var l int
-	_ = l
-	if len(m.BtcPks) > 0 {
-		for _, e := range m.BtcPks {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPubRandCommit(ctx, req.(*QueryListPubRandCommitRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryEvidenceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Block_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Block(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryEvidenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/Block",
 	}
-	var l int
-	_ = l
-	if m.Evidence != nil {
-		l = m.Evidence.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Block(ctx, req.(*QueryBlockRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryListEvidencesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ListBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/ListBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListBlocks(ctx, req.(*QueryListBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VotesAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotesAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotesAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/VotesAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotesAtHeight(ctx, req.(*QueryVotesAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalitySigsAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalitySigsAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalitySigsAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/FinalitySigsAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalitySigsAtHeight(ctx, req.(*QueryFinalitySigsAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Evidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Evidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/Evidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Evidence(ctx, req.(*QueryEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListEvidences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListEvidencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListEvidences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/ListEvidences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListEvidences(ctx, req.(*QueryListEvidencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EarliestUnfinalizedHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEarliestUnfinalizedHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EarliestUnfinalizedHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/EarliestUnfinalizedHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EarliestUnfinalizedHeight(ctx, req.(*QueryEarliestUnfinalizedHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockSecuringDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockSecuringDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockSecuringDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/BlockSecuringDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockSecuringDelegations(ctx, req.(*QueryBlockSecuringDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityProvidersLowOnPubRand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProvidersLowOnPubRandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProvidersLowOnPubRand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/FinalityProvidersLowOnPubRand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProvidersLowOnPubRand(ctx, req.(*QueryFinalityProvidersLowOnPubRandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InactiveFinalityProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInactiveFinalityProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InactiveFinalityProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/InactiveFinalityProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InactiveFinalityProviders(ctx, req.(*QueryInactiveFinalityProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateFinalitySig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateFinalitySigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateFinalitySig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/SimulateFinalitySig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateFinalitySig(ctx, req.(*QuerySimulateFinalitySigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityProviderFinalitySigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderFinalitySigsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviderFinalitySigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/FinalityProviderFinalitySigs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviderFinalitySigs(ctx, req.(*QueryFinalityProviderFinalitySigsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalizingVoters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalizingVotersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalizingVoters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/FinalizingVoters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalizingVoters(ctx, req.(*QueryFinalizingVotersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylon.finality.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ListPublicRandomness",
+			Handler:    _Query_ListPublicRandomness_Handler,
+		},
+		{
+			MethodName: "ListPubRandCommit",
+			Handler:    _Query_ListPubRandCommit_Handler,
+		},
+		{
+			MethodName: "Block",
+			Handler:    _Query_Block_Handler,
+		},
+		{
+			MethodName: "ListBlocks",
+			Handler:    _Query_ListBlocks_Handler,
+		},
+		{
+			MethodName: "VotesAtHeight",
+			Handler:    _Query_VotesAtHeight_Handler,
+		},
+		{
+			MethodName: "FinalitySigsAtHeight",
+			Handler:    _Query_FinalitySigsAtHeight_Handler,
+		},
+		{
+			MethodName: "Evidence",
+			Handler:    _Query_Evidence_Handler,
+		},
+		{
+			MethodName: "ListEvidences",
+			Handler:    _Query_ListEvidences_Handler,
+		},
+		{
+			MethodName: "EarliestUnfinalizedHeight",
+			Handler:    _Query_EarliestUnfinalizedHeight_Handler,
+		},
+		{
+			MethodName: "BlockSecuringDelegations",
+			Handler:    _Query_BlockSecuringDelegations_Handler,
+		},
+		{
+			MethodName: "FinalityProvidersLowOnPubRand",
+			Handler:    _Query_FinalityProvidersLowOnPubRand_Handler,
+		},
+		{
+			MethodName: "InactiveFinalityProviders",
+			Handler:    _Query_InactiveFinalityProviders_Handler,
+		},
+		{
+			MethodName: "SimulateFinalitySig",
+			Handler:    _Query_SimulateFinalitySig_Handler,
+		},
+		{
+			MethodName: "FinalityProviderFinalitySigs",
+			Handler:    _Query_FinalityProviderFinalitySigs_Handler,
+		},
+		{
+			MethodName: "FinalizingVoters",
+			Handler:    _Query_FinalizingVoters_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "babylon/finality/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StartHeight != 0 {
-		n += 1 + sovQuery(uint64(m.StartHeight))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPublicRandomnessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPublicRandomnessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPublicRandomnessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPublicRandomnessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPublicRandomnessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPublicRandomnessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PubRandMap) > 0 {
+		for k := range m.PubRandMap {
+			v := m.PubRandMap[k]
+			baseI := i
+			if v != nil {
+				{
+					size := v.Size()
+					i -= size
+					if _, err := v.MarshalTo(dAtA[i:]); err != nil {
+						return 0, err
+					}
+					i = encodeVarintQuery(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PubRandCommitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PubRandCommitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubRandCommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.NumPubRand != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumPubRand))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPubRandCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPubRandCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPubRandCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPubRandCommitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPubRandCommitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPubRandCommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PubRandCommitMap) > 0 {
+		for k := range m.PubRandCommitMap {
+			v := m.PubRandCommitMap[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintQuery(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListBlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListBlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListBlocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListBlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotesAtHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotesAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotesAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotesAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotesAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotesAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BtcPks) > 0 {
+		for iNdEx := len(m.BtcPks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.BtcPks[iNdEx].Size()
+				i -= size
+				if _, err := m.BtcPks[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalitySigsAtHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalitySigsAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalitySigsAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalitySigsAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalitySigsAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalitySigsAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sigs) > 0 {
+		for iNdEx := len(m.Sigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalitySigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalitySigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalitySigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PubRand != nil {
+		{
+			size := m.PubRand.Size()
+			i -= size
+			if _, err := m.PubRand.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FinalitySig != nil {
+		{
+			size := m.FinalitySig.Size()
+			i -= size
+			if _, err := m.FinalitySig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlockAppHash) > 0 {
+		i -= len(m.BlockAppHash)
+		copy(dAtA[i:], m.BlockAppHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockAppHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvidenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvidenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvidenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Evidence != nil {
+		{
+			size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListEvidencesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListEvidencesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListEvidencesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListEvidencesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListEvidencesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListEvidencesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Evidences) > 0 {
+		for iNdEx := len(m.Evidences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEarliestUnfinalizedHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEarliestUnfinalizedHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEarliestUnfinalizedHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEarliestUnfinalizedHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEarliestUnfinalizedHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEarliestUnfinalizedHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumVotes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumVotes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockSecuringDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockSecuringDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockSecuringDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockSecuringDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockSecuringDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockSecuringDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSat))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SecuringDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecuringDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecuringDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FpBtcPkHexList) > 0 {
+		for iNdEx := len(m.FpBtcPkHexList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FpBtcPkHexList[iNdEx])
+			copy(dAtA[i:], m.FpBtcPkHexList[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHexList[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TotalSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSat))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BtcPkHex) > 0 {
+		i -= len(m.BtcPkHex)
+		copy(dAtA[i:], m.BtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BtcPkHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProvidersLowOnPubRandRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProvidersLowOnPubRandRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProvidersLowOnPubRandRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinRemaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinRemaining))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProvidersLowOnPubRandResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProvidersLowOnPubRandResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProvidersLowOnPubRandResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FinalityProviders) > 0 {
+		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.CurrentHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalityProviderPubRandStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderPubRandStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderPubRandStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastCommittedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastCommittedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HasPubRandCommit {
+		i--
+		if m.HasPubRandCommit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInactiveFinalityProvidersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInactiveFinalityProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInactiveFinalityProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInactiveFinalityProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInactiveFinalityProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInactiveFinalityProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FinalityProviders) > 0 {
+		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.CurrentHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InactiveFinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InactiveFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InactiveFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateFinalitySigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateFinalitySigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateFinalitySigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinalitySig != nil {
+		{
+			size := m.FinalitySig.Size()
+			i -= size
+			if _, err := m.FinalitySig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BlockAppHash) > 0 {
+		i -= len(m.BlockAppHash)
+		copy(dAtA[i:], m.BlockAppHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockAppHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PubRand != nil {
+		{
+			size := m.PubRand.Size()
+			i -= size
+			if _, err := m.PubRand.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateFinalitySigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateFinalitySigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateFinalitySigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InvalidReason) > 0 {
+		i -= len(m.InvalidReason)
+		copy(dAtA[i:], m.InvalidReason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvalidReason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderFinalitySigsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderFinalitySigsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderFinalitySigsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderFinalitySigsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderFinalitySigsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderFinalitySigsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sigs) > 0 {
+		for iNdEx := len(m.Sigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalizingVotersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalizingVotersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalizingVotersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalizingVoter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalizingVoter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalizingVoter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CumulativePower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CumulativePower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalizingVotersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalizingVotersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalizingVotersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FinalizingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FinalizingPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Voters) > 0 {
+		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Voters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryListPublicRandomnessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListPublicRandomnessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PubRandMap) > 0 {
+		for k, v := range m.PubRandMap {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovQuery(uint64(l))
+			}
+			mapEntrySize := 1 + sovQuery(uint64(k)) + l
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PubRandCommitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumPubRand != 0 {
+		n += 1 + sovQuery(uint64(m.NumPubRand))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListPubRandCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListPubRandCommitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PubRandCommitMap) > 0 {
+		for k, v := range m.PubRandCommitMap {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovQuery(uint64(l))
+			}
+			mapEntrySize := 1 + sovQuery(uint64(k)) + l
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListBlocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVotesAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryVotesAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcPks) > 0 {
+		for _, e := range m.BtcPks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFinalitySigsAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryFinalitySigsAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sigs) > 0 {
+		for _, e := range m.Sigs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FinalitySigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockAppHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FinalitySig != nil {
+		l = m.FinalitySig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PubRand != nil {
+		l = m.PubRand.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryEvidenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Evidence != nil {
+		l = m.Evidence.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListEvidencesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListEvidencesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Evidences) > 0 {
+		for _, e := range m.Evidences {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEarliestUnfinalizedHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEarliestUnfinalizedHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.NumVotes != 0 {
+		n += 1 + sovQuery(uint64(m.NumVotes))
+	}
+	return n
+}
+
+func (m *QueryBlockSecuringDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryBlockSecuringDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TotalSat != 0 {
+		n += 1 + sovQuery(uint64(m.TotalSat))
+	}
+	return n
+}
+
+func (m *SecuringDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TotalSat != 0 {
+		n += 1 + sovQuery(uint64(m.TotalSat))
+	}
+	if len(m.FpBtcPkHexList) > 0 {
+		for _, s := range m.FpBtcPkHexList {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFinalityProvidersLowOnPubRandRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinRemaining != 0 {
+		n += 1 + sovQuery(uint64(m.MinRemaining))
+	}
+	return n
+}
+
+func (m *QueryFinalityProvidersLowOnPubRandResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentHeight != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentHeight))
+	}
+	if len(m.FinalityProviders) > 0 {
+		for _, e := range m.FinalityProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FinalityProviderPubRandStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.HasPubRandCommit {
+		n += 2
+	}
+	if m.LastCommittedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastCommittedHeight))
+	}
+	if m.Remaining != 0 {
+		n += 1 + sovQuery(uint64(m.Remaining))
+	}
+	return n
+}
+
+func (m *QueryInactiveFinalityProvidersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		n += 1 + sovQuery(uint64(m.Reason))
+	}
+	return n
+}
+
+func (m *QueryInactiveFinalityProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentHeight != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentHeight))
+	}
+	if len(m.FinalityProviders) > 0 {
+		for _, e := range m.FinalityProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InactiveFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovQuery(uint64(m.Reason))
+	}
+	return n
+}
+
+func (m *QuerySimulateFinalitySigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if m.PubRand != nil {
+		l = m.PubRand.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockAppHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FinalitySig != nil {
+		l = m.FinalitySig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateFinalitySigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.InvalidReason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderFinalitySigsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderFinalitySigsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sigs) > 0 {
+		for _, e := range m.Sigs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalizingVotersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *FinalizingVoter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotingPower))
+	}
+	if m.CumulativePower != 0 {
+		n += 1 + sovQuery(uint64(m.CumulativePower))
+	}
+	return n
+}
+
+func (m *QueryFinalizingVotersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Voters) > 0 {
+		for _, e := range m.Voters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.FinalizingPower != 0 {
+		n += 1 + sovQuery(uint64(m.FinalizingPower))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPower))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListPublicRandomnessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListPublicRandomnessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListPublicRandomnessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListPublicRandomnessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListPublicRandomnessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListPublicRandomnessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRandMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubRandMap == nil {
+				m.PubRandMap = make(map[uint64]*github_com_babylonchain_babylon_types.SchnorrPubRand)
+			}
+			var mapkey uint64
+			var mapvalue1 github_com_babylonchain_babylon_types.SchnorrPubRand
+			var mapvalue = &mapvalue1
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postbytesIndex]); err != nil {
+						return err
+					}
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.PubRandMap[mapkey] = ((*github_com_babylonchain_babylon_types.SchnorrPubRand)(mapvalue))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubRandCommitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubRandCommitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubRandCommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPubRand", wireType)
+			}
+			m.NumPubRand = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPubRand |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListPubRandCommitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListPubRandCommitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListPubRandCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListPubRandCommitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListPubRandCommitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListPubRandCommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRandCommitMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubRandCommitMap == nil {
+				m.PubRandCommitMap = make(map[uint64]*PubRandCommitResponse)
+			}
+			var mapkey uint64
+			var mapvalue *PubRandCommitResponse
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &PubRandCommitResponse{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.PubRandCommitMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &IndexedBlock{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListBlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListBlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= QueriedBlockStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListBlocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListBlocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, &IndexedBlock{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotesAtHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotesAtHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotesAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotesAtHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotesAtHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotesAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPks", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.BtcPks = append(m.BtcPks, v)
+			if err := m.BtcPks[len(m.BtcPks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalitySigsAtHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalitySigsAtHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalitySigsAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalitySigsAtHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalitySigsAtHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalitySigsAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sigs = append(m.Sigs, &FinalitySigResponse{})
+			if err := m.Sigs[len(m.Sigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalitySigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalitySigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalitySigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockAppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockAppHash = append(m.BlockAppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockAppHash == nil {
+				m.BlockAppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalitySig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
+			m.FinalitySig = &v
+			if err := m.FinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrPubRand
+			m.PubRand = &v
+			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEvidenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvidenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvidenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEvidenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvidenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Evidence == nil {
+				m.Evidence = &Evidence{}
+			}
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListEvidencesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListEvidencesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListEvidencesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryListEvidencesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListEvidencesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListEvidencesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidences = append(m.Evidences, &Evidence{})
+			if err := m.Evidences[len(m.Evidences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryListEvidencesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.Evidences) > 0 {
-		for _, e := range m.Evidences {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryEarliestUnfinalizedHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEarliestUnfinalizedHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEarliestUnfinalizedHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEarliestUnfinalizedHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEarliestUnfinalizedHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEarliestUnfinalizedHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumVotes", wireType)
+			}
+			m.NumVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockSecuringDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2354,12 +7614,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockSecuringDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockSecuringDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2381,7 +7660,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockSecuringDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2404,15 +7683,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockSecuringDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockSecuringDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2439,10 +7718,30 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Delegations = append(m.Delegations, &SecuringDelegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSat", wireType)
+			}
+			m.TotalSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2464,7 +7763,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListPublicRandomnessRequest) Unmarshal(dAtA []byte) error {
+func (m *SecuringDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2487,15 +7786,15 @@ func (m *QueryListPublicRandomnessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListPublicRandomnessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecuringDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListPublicRandomnessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecuringDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2523,13 +7822,13 @@ func (m *QueryListPublicRandomnessRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPkHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2539,27 +7838,74 @@ func (m *QueryListPublicRandomnessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.BtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSat", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TotalSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHexList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.FpBtcPkHexList = append(m.FpBtcPkHexList, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2582,156 +7928,40 @@ func (m *QueryListPublicRandomnessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListPublicRandomnessResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProvidersLowOnPubRandRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListPublicRandomnessResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListPublicRandomnessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubRandMap", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PubRandMap == nil {
-				m.PubRandMap = make(map[uint64]*github_com_babylonchain_babylon_types.SchnorrPubRand)
-			}
-			var mapkey uint64
-			var mapvalue1 github_com_babylonchain_babylon_types.SchnorrPubRand
-			var mapvalue = &mapvalue1
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapbyteLen uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapbyteLen |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intMapbyteLen := int(mapbyteLen)
-					if intMapbyteLen < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postbytesIndex := iNdEx + intMapbyteLen
-					if postbytesIndex < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postbytesIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postbytesIndex]); err != nil {
-						return err
-					}
-					iNdEx = postbytesIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			m.PubRandMap[mapkey] = ((*github_com_babylonchain_babylon_types.SchnorrPubRand)(mapvalue))
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProvidersLowOnPubRandRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProvidersLowOnPubRandRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRemaining", wireType)
+			}
+			m.MinRemaining = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2741,28 +7971,11 @@ func (m *QueryListPublicRandomnessResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MinRemaining |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2784,7 +7997,7 @@ func (m *QueryListPublicRandomnessResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PubRandCommitResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProvidersLowOnPubRandResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2807,17 +8020,17 @@ func (m *PubRandCommitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PubRandCommitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProvidersLowOnPubRandResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PubRandCommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProvidersLowOnPubRandResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumPubRand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHeight", wireType)
 			}
-			m.NumPubRand = 0
+			m.CurrentHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2827,16 +8040,16 @@ func (m *PubRandCommitResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumPubRand |= uint64(b&0x7F) << shift
+				m.CurrentHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2846,24 +8059,24 @@ func (m *PubRandCommitResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
-			if m.Commitment == nil {
-				m.Commitment = []byte{}
+			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderPubRandStatus{})
+			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -2887,7 +8100,7 @@ func (m *PubRandCommitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListPubRandCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *FinalityProviderPubRandStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2910,10 +8123,10 @@ func (m *QueryListPubRandCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListPubRandCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalityProviderPubRandStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListPubRandCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalityProviderPubRandStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2949,10 +8162,10 @@ func (m *QueryListPubRandCommitRequest) Unmarshal(dAtA []byte) error {
 			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasPubRandCommit", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2962,28 +8175,50 @@ func (m *QueryListPubRandCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.HasPubRandCommit = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCommittedHeight", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.LastCommittedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastCommittedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3005,7 +8240,7 @@ func (m *QueryListPubRandCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListPubRandCommitResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInactiveFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3028,17 +8263,17 @@ func (m *QueryListPubRandCommitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListPubRandCommitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInactiveFinalityProvidersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListPubRandCommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInactiveFinalityProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubRandCommitMap", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			m.Reason = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3048,110 +8283,83 @@ func (m *QueryListPubRandCommitResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Reason |= FinalityProviderInactiveReason(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PubRandCommitMap == nil {
-				m.PubRandCommitMap = make(map[uint64]*PubRandCommitResponse)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInactiveFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			var mapkey uint64
-			var mapvalue *PubRandCommitResponse
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInactiveFinalityProvidersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInactiveFinalityProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHeight", wireType)
+			}
+			m.CurrentHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &PubRandCommitResponse{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.PubRandCommitMap[mapkey] = mapvalue
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3178,10 +8386,8 @@ func (m *QueryListPubRandCommitResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FinalityProviders = append(m.FinalityProviders, &InactiveFinalityProvider{})
+			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3206,7 +8412,7 @@ func (m *QueryListPubRandCommitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *InactiveFinalityProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3229,17 +8435,49 @@ func (m *QueryBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InactiveFinalityProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InactiveFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.Height = 0
+			m.Reason = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3249,7 +8487,7 @@ func (m *QueryBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.Reason |= FinalityProviderInactiveReason(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3275,7 +8513,7 @@ func (m *QueryBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateFinalitySigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3298,15 +8536,101 @@ func (m *QueryBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateFinalitySigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateFinalitySigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrPubRand
+			m.PubRand = &v
+			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3333,10 +8657,79 @@ func (m *QueryBlockResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Block == nil {
-				m.Block = &IndexedBlock{}
+			if m.Proof == nil {
+				m.Proof = &crypto.Proof{}
 			}
-			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockAppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockAppHash = append(m.BlockAppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockAppHash == nil {
+				m.BlockAppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalitySig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
+			m.FinalitySig = &v
+			if err := m.FinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3361,7 +8754,7 @@ func (m *QueryBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListBlocksRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateFinalitySigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3384,17 +8777,17 @@ func (m *QueryListBlocksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListBlocksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateFinalitySigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateFinalitySigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
 			}
-			m.Status = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3404,16 +8797,17 @@ func (m *QueryListBlocksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= QueriedBlockStatus(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Valid = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidReason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3423,27 +8817,23 @@ func (m *QueryListBlocksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.InvalidReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3466,7 +8856,7 @@ func (m *QueryListBlocksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListBlocksResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProviderFinalitySigsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3489,17 +8879,17 @@ func (m *QueryListBlocksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListBlocksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProviderFinalitySigsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProviderFinalitySigsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3509,27 +8899,63 @@ func (m *QueryListBlocksResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Blocks = append(m.Blocks, &IndexedBlock{})
-			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3559,7 +8985,7 @@ func (m *QueryListBlocksResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3586,7 +9012,7 @@ func (m *QueryListBlocksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotesAtHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProviderFinalitySigsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3609,17 +9035,17 @@ func (m *QueryVotesAtHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesAtHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProviderFinalitySigsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProviderFinalitySigsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sigs", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3629,66 +9055,31 @@ func (m *QueryVotesAtHeightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryVotesAtHeightResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Sigs = append(m.Sigs, &FinalitySigResponse{})
+			if err := m.Sigs[len(m.Sigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesAtHeightResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3698,24 +9089,25 @@ func (m *QueryVotesAtHeightResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340PubKey
-			m.BtcPks = append(m.BtcPks, v)
-			if err := m.BtcPks[len(m.BtcPks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3740,7 +9132,7 @@ func (m *QueryVotesAtHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEvidenceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalizingVotersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3763,17 +9155,17 @@ func (m *QueryEvidenceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEvidenceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalizingVotersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEvidenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalizingVotersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3783,24 +9175,11 @@ func (m *QueryEvidenceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3822,7 +9201,7 @@ func (m *QueryEvidenceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEvidenceResponse) Unmarshal(dAtA []byte) error {
+func (m *FinalizingVoter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3845,17 +9224,17 @@ func (m *QueryEvidenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEvidenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalizingVoter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalizingVoter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3865,83 +9244,32 @@ func (m *QueryEvidenceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Evidence == nil {
-				m.Evidence = &Evidence{}
-			}
-			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryListEvidencesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListEvidencesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListEvidencesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
 			}
-			m.StartHeight = 0
+			m.VotingPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3951,16 +9279,16 @@ func (m *QueryListEvidencesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= uint64(b&0x7F) << shift
+				m.VotingPower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePower", wireType)
 			}
-			var msglen int
+			m.CumulativePower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3970,28 +9298,11 @@ func (m *QueryListEvidencesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CumulativePower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4013,7 +9324,7 @@ func (m *QueryListEvidencesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListEvidencesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalizingVotersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4036,15 +9347,15 @@ func (m *QueryListEvidencesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListEvidencesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalizingVotersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListEvidencesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalizingVotersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evidences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4071,16 +9382,16 @@ func (m *QueryListEvidencesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Evidences = append(m.Evidences, &Evidence{})
-			if err := m.Evidences[len(m.Evidences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Voters = append(m.Voters, &FinalizingVoter{})
+			if err := m.Voters[len(m.Voters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizingPower", wireType)
 			}
-			var msglen int
+			m.FinalizingPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4090,28 +9401,30 @@ func (m *QueryListEvidencesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FinalizingPower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -268,6 +268,346 @@ func (m *MsgAddFinalitySigResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddFinalitySigResponse proto.InternalMessageInfo
 
+// MsgAddFinalitySigMulti defines a message for adding the finality votes of
+// multiple finality providers to a given block, e.g., by an operator running
+// several finality providers. Each entry is processed as a MsgAddFinalitySig.
+// The whole message is rejected if the finality signature of any entry fails
+// verification, whereas entries whose finality providers are not eligible to
+// vote are skipped
+type MsgAddFinalitySigMulti struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// block_height is the height of the voted block
+	BlockHeight uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// entries are the finality votes of the finality providers
+	Entries []*FinalitySigEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (m *MsgAddFinalitySigMulti) Reset()         { *m = MsgAddFinalitySigMulti{} }
+func (m *MsgAddFinalitySigMulti) String() string { return proto.CompactTextString(m) }
+func (*MsgAddFinalitySigMulti) ProtoMessage()    {}
+func (*MsgAddFinalitySigMulti) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{4}
+}
+func (m *MsgAddFinalitySigMulti) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddFinalitySigMulti) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddFinalitySigMulti.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddFinalitySigMulti) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddFinalitySigMulti.Merge(m, src)
+}
+func (m *MsgAddFinalitySigMulti) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddFinalitySigMulti) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddFinalitySigMulti.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddFinalitySigMulti proto.InternalMessageInfo
+
+func (m *MsgAddFinalitySigMulti) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgAddFinalitySigMulti) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *MsgAddFinalitySigMulti) GetEntries() []*FinalitySigEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// FinalitySigEntry is the finality vote of a finality provider in a
+// MsgAddFinalitySigMulti message
+type FinalitySigEntry struct {
+	// fp_btc_pk is the BTC PK of the finality provider that casts this vote
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// pub_rand is the public randomness committed at this height
+	PubRand *github_com_babylonchain_babylon_types.SchnorrPubRand `protobuf:"bytes,2,opt,name=pub_rand,json=pubRand,proto3,customtype=github.com/babylonchain/babylon/types.SchnorrPubRand" json:"pub_rand,omitempty"`
+	// proof is the proof that the given public randomness is committed under the commitment
+	Proof *crypto.Proof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	// block_app_hash is the AppHash of the voted block
+	BlockAppHash []byte `protobuf:"bytes,4,opt,name=block_app_hash,json=blockAppHash,proto3" json:"block_app_hash,omitempty"`
+	// finality_sig is the finality signature to this block
+	FinalitySig *github_com_babylonchain_babylon_types.SchnorrEOTSSig `protobuf:"bytes,5,opt,name=finality_sig,json=finalitySig,proto3,customtype=github.com/babylonchain/babylon/types.SchnorrEOTSSig" json:"finality_sig,omitempty"`
+}
+
+func (m *FinalitySigEntry) Reset()         { *m = FinalitySigEntry{} }
+func (m *FinalitySigEntry) String() string { return proto.CompactTextString(m) }
+func (*FinalitySigEntry) ProtoMessage()    {}
+func (*FinalitySigEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{5}
+}
+func (m *FinalitySigEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalitySigEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalitySigEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalitySigEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalitySigEntry.Merge(m, src)
+}
+func (m *FinalitySigEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalitySigEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalitySigEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalitySigEntry proto.InternalMessageInfo
+
+func (m *FinalitySigEntry) GetProof() *crypto.Proof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *FinalitySigEntry) GetBlockAppHash() []byte {
+	if m != nil {
+		return m.BlockAppHash
+	}
+	return nil
+}
+
+// MsgAddFinalitySigMultiResponse is the response to the MsgAddFinalitySigMulti message
+type MsgAddFinalitySigMultiResponse struct {
+	// results are the results of the entries, in the same order as the entries
+	Results []*FinalitySigEntryResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *MsgAddFinalitySigMultiResponse) Reset()         { *m = MsgAddFinalitySigMultiResponse{} }
+func (m *MsgAddFinalitySigMultiResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddFinalitySigMultiResponse) ProtoMessage()    {}
+func (*MsgAddFinalitySigMultiResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{6}
+}
+func (m *MsgAddFinalitySigMultiResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddFinalitySigMultiResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddFinalitySigMultiResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddFinalitySigMultiResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddFinalitySigMultiResponse.Merge(m, src)
+}
+func (m *MsgAddFinalitySigMultiResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddFinalitySigMultiResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddFinalitySigMultiResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddFinalitySigMultiResponse proto.InternalMessageInfo
+
+func (m *MsgAddFinalitySigMultiResponse) GetResults() []*FinalitySigEntryResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// FinalitySigEntryResult is the result of processing an entry of a
+// MsgAddFinalitySigMulti message
+type FinalitySigEntryResult struct {
+	// fp_btc_pk is the BTC PK of the finality provider of the entry
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// accepted is whether the entry is processed
+	Accepted bool `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// skip_reason is the reason why the entry is skipped, if not accepted
+	SkipReason string `protobuf:"bytes,3,opt,name=skip_reason,json=skipReason,proto3" json:"skip_reason,omitempty"`
+}
+
+func (m *FinalitySigEntryResult) Reset()         { *m = FinalitySigEntryResult{} }
+func (m *FinalitySigEntryResult) String() string { return proto.CompactTextString(m) }
+func (*FinalitySigEntryResult) ProtoMessage()    {}
+func (*FinalitySigEntryResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{7}
+}
+func (m *FinalitySigEntryResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalitySigEntryResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalitySigEntryResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalitySigEntryResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalitySigEntryResult.Merge(m, src)
+}
+func (m *FinalitySigEntryResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalitySigEntryResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalitySigEntryResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalitySigEntryResult proto.InternalMessageInfo
+
+func (m *FinalitySigEntryResult) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+func (m *FinalitySigEntryResult) GetSkipReason() string {
+	if m != nil {
+		return m.SkipReason
+	}
+	return ""
+}
+
+// MsgSubmitFinalizationChallenge defines a message for submitting the
+// evidence that a finalized block does not reach the finalization threshold.
+// If the evidence is valid, the discrepancy is recorded and an alert event is
+// emitted, while the block remains finalized
+type MsgSubmitFinalizationChallenge struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// block_height is the height of the finalized block
+	BlockHeight uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// evidence is the vote set and the voting power table of the block, which
+	// have to be the ones recorded on Babylon
+	Evidence *FinalizationQuorumEvidence `protobuf:"bytes,3,opt,name=evidence,proto3" json:"evidence,omitempty"`
+}
+
+func (m *MsgSubmitFinalizationChallenge) Reset()         { *m = MsgSubmitFinalizationChallenge{} }
+func (m *MsgSubmitFinalizationChallenge) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitFinalizationChallenge) ProtoMessage()    {}
+func (*MsgSubmitFinalizationChallenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{8}
+}
+func (m *MsgSubmitFinalizationChallenge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitFinalizationChallenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitFinalizationChallenge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitFinalizationChallenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitFinalizationChallenge.Merge(m, src)
+}
+func (m *MsgSubmitFinalizationChallenge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitFinalizationChallenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitFinalizationChallenge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitFinalizationChallenge proto.InternalMessageInfo
+
+func (m *MsgSubmitFinalizationChallenge) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgSubmitFinalizationChallenge) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *MsgSubmitFinalizationChallenge) GetEvidence() *FinalizationQuorumEvidence {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
+// MsgSubmitFinalizationChallengeResponse is the response to the MsgSubmitFinalizationChallenge message
+type MsgSubmitFinalizationChallengeResponse struct {
+}
+
+func (m *MsgSubmitFinalizationChallengeResponse) Reset() {
+	*m = MsgSubmitFinalizationChallengeResponse{}
+}
+func (m *MsgSubmitFinalizationChallengeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitFinalizationChallengeResponse) ProtoMessage()    {}
+func (*MsgSubmitFinalizationChallengeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{9}
+}
+func (m *MsgSubmitFinalizationChallengeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitFinalizationChallengeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitFinalizationChallengeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitFinalizationChallengeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitFinalizationChallengeResponse.Merge(m, src)
+}
+func (m *MsgSubmitFinalizationChallengeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitFinalizationChallengeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitFinalizationChallengeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitFinalizationChallengeResponse proto.InternalMessageInfo
+
 // MsgUpdateParams defines a message for updating finality module parameters.
 type MsgUpdateParams struct {
 	// authority is the address of the governance account.
@@ -285,7 +625,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2dd6da066b6baf1d, []int{4}
+	return fileDescriptor_2dd6da066b6baf1d, []int{10}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -336,7 +676,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2dd6da066b6baf1d, []int{5}
+	return fileDescriptor_2dd6da066b6baf1d, []int{11}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -370,6 +710,12 @@ func init() {
 	proto.RegisterType((*MsgCommitPubRandListResponse)(nil), "babylon.finality.v1.MsgCommitPubRandListResponse")
 	proto.RegisterType((*MsgAddFinalitySig)(nil), "babylon.finality.v1.MsgAddFinalitySig")
 	proto.RegisterType((*MsgAddFinalitySigResponse)(nil), "babylon.finality.v1.MsgAddFinalitySigResponse")
+	proto.RegisterType((*MsgAddFinalitySigMulti)(nil), "babylon.finality.v1.MsgAddFinalitySigMulti")
+	proto.RegisterType((*FinalitySigEntry)(nil), "babylon.finality.v1.FinalitySigEntry")
+	proto.RegisterType((*MsgAddFinalitySigMultiResponse)(nil), "babylon.finality.v1.MsgAddFinalitySigMultiResponse")
+	proto.RegisterType((*FinalitySigEntryResult)(nil), "babylon.finality.v1.FinalitySigEntryResult")
+	proto.RegisterType((*MsgSubmitFinalizationChallenge)(nil), "babylon.finality.v1.MsgSubmitFinalizationChallenge")
+	proto.RegisterType((*MsgSubmitFinalizationChallengeResponse)(nil), "babylon.finality.v1.MsgSubmitFinalizationChallengeResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "babylon.finality.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylon.finality.v1.MsgUpdateParamsResponse")
 }
@@ -377,52 +723,68 @@ func init() {
 func init() { proto.RegisterFile("babylon/finality/v1/tx.proto", fileDescriptor_2dd6da066b6baf1d) }
 
 var fileDescriptor_2dd6da066b6baf1d = []byte{
-	// 712 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x4f, 0x4f, 0x13, 0x4d,
-	0x1c, 0xee, 0x52, 0x28, 0x2f, 0xd3, 0x86, 0x37, 0xec, 0x4b, 0x5e, 0x96, 0x82, 0xdb, 0xda, 0x10,
-	0x83, 0x44, 0x77, 0xa1, 0x20, 0x11, 0x6e, 0xd4, 0x68, 0x50, 0x6c, 0x6c, 0xb6, 0x7a, 0xd1, 0xc3,
-	0x66, 0xff, 0x75, 0x76, 0x02, 0x3b, 0x33, 0xee, 0xcc, 0x12, 0x7a, 0x33, 0x7e, 0x02, 0x0f, 0x7e,
-	0x10, 0x0e, 0x26, 0x9e, 0xbd, 0x71, 0x31, 0x21, 0x9e, 0x0c, 0x87, 0xc6, 0xc0, 0x81, 0xaf, 0x61,
-	0x76, 0x77, 0x4a, 0x29, 0x94, 0x58, 0x3d, 0x78, 0xdb, 0x99, 0xdf, 0x33, 0xf3, 0x3c, 0xfb, 0x7b,
-	0x9e, 0xdf, 0x80, 0x79, 0xdb, 0xb2, 0xdb, 0x7b, 0x04, 0xeb, 0x2d, 0x84, 0xad, 0x3d, 0xc4, 0xdb,
-	0xfa, 0xfe, 0x8a, 0xce, 0x0f, 0x34, 0x1a, 0x12, 0x4e, 0xe4, 0xff, 0x44, 0x55, 0xeb, 0x56, 0xb5,
-	0xfd, 0x95, 0xe2, 0x34, 0x24, 0x90, 0x24, 0x75, 0x3d, 0xfe, 0x4a, 0xa1, 0xc5, 0x5b, 0xdc, 0xc3,
-	0xae, 0x17, 0x06, 0x08, 0x73, 0xdd, 0x09, 0xdb, 0x94, 0x13, 0x9d, 0x86, 0x84, 0xb4, 0x44, 0x79,
-	0xd6, 0x21, 0x2c, 0x20, 0xcc, 0x4c, 0xcf, 0xa5, 0x0b, 0x51, 0x9a, 0x49, 0x57, 0x7a, 0xc0, 0x60,
-	0x4c, 0x1e, 0x30, 0x28, 0x0a, 0xe5, 0x41, 0xda, 0xa8, 0x15, 0x5a, 0x81, 0x38, 0x5a, 0xf9, 0x32,
-	0x02, 0xa6, 0xeb, 0x0c, 0x3e, 0x22, 0x41, 0x80, 0x78, 0x23, 0xb2, 0x0d, 0x0b, 0xbb, 0xcf, 0x11,
-	0xe3, 0xf2, 0xff, 0x20, 0xc7, 0x10, 0xc4, 0x5e, 0xa8, 0x48, 0x65, 0x69, 0x71, 0xc2, 0x10, 0x2b,
-	0xd9, 0x00, 0x13, 0x2d, 0x6a, 0xda, 0xdc, 0x31, 0xe9, 0xae, 0x32, 0x52, 0x96, 0x16, 0x0b, 0xb5,
-	0xf5, 0x93, 0x4e, 0xa9, 0x0a, 0x11, 0xf7, 0x23, 0x5b, 0x73, 0x48, 0xa0, 0x0b, 0x52, 0xc7, 0xb7,
-	0x10, 0xee, 0x2e, 0x74, 0xde, 0xa6, 0x1e, 0xd3, 0x6a, 0x4f, 0x1b, 0xab, 0x6b, 0xcb, 0x8d, 0xc8,
-	0xde, 0xf1, 0xda, 0xc6, 0x78, 0x8b, 0xd6, 0xb8, 0xd3, 0xd8, 0x95, 0x6f, 0x83, 0x02, 0xe3, 0x56,
-	0xc8, 0x4d, 0xdf, 0x43, 0xd0, 0xe7, 0x4a, 0xb6, 0x2c, 0x2d, 0x8e, 0x1a, 0xf9, 0x64, 0x6f, 0x3b,
-	0xd9, 0x92, 0xcb, 0xa0, 0x80, 0xa3, 0xc0, 0xa4, 0x91, 0x6d, 0x86, 0x16, 0x76, 0x95, 0xd1, 0x04,
-	0x02, 0x70, 0x14, 0x08, 0xd1, 0xb2, 0x0a, 0x80, 0x93, 0xfc, 0x45, 0xe0, 0x61, 0xae, 0x8c, 0xc5,
-	0xca, 0x8c, 0x4b, 0x3b, 0xf2, 0x0e, 0xc8, 0x32, 0x04, 0x95, 0x5c, 0x22, 0x79, 0xe3, 0xa4, 0x53,
-	0x7a, 0xf0, 0x3b, 0x92, 0x9b, 0x08, 0x62, 0x8b, 0x47, 0xa1, 0x67, 0xc4, 0xb7, 0x6c, 0xe6, 0xdf,
-	0x9f, 0x1f, 0x2e, 0x89, 0x96, 0x54, 0x54, 0x30, 0x3f, 0xa8, 0x85, 0x86, 0xc7, 0x28, 0xc1, 0xcc,
-	0xab, 0x7c, 0xce, 0x82, 0xa9, 0x3a, 0x83, 0x5b, 0xae, 0xfb, 0x44, 0xd8, 0xd0, 0x44, 0xf0, 0x6f,
-	0x37, 0xd8, 0xde, 0x23, 0xce, 0xee, 0x95, 0x06, 0x27, 0x7b, 0xa2, 0xc1, 0x4d, 0xf0, 0x4f, 0x5f,
-	0x73, 0x0b, 0xb5, 0x87, 0x27, 0x9d, 0xd2, 0xda, 0x70, 0xac, 0x4d, 0xc7, 0xc7, 0x24, 0x0c, 0xc5,
-	0xcf, 0x1b, 0xe3, 0x54, 0x78, 0xa2, 0x81, 0xb1, 0x24, 0xc2, 0x89, 0x1d, 0xf9, 0xaa, 0xa2, 0xf5,
-	0x22, 0xae, 0xa5, 0x11, 0xd7, 0x1a, 0x71, 0xdd, 0x48, 0x61, 0xf2, 0x02, 0x98, 0x4c, 0x75, 0x5a,
-	0x94, 0x9a, 0xbe, 0xc5, 0xfc, 0xd4, 0x2e, 0x23, 0x55, 0xbf, 0x45, 0xe9, 0xb6, 0xc5, 0x7c, 0xf9,
-	0x0d, 0x28, 0x74, 0xf3, 0x6c, 0xc6, 0x96, 0x8e, 0xff, 0xa1, 0xdc, 0xc7, 0x2f, 0x5e, 0x36, 0x9b,
-	0x08, 0x1a, 0xf9, 0x56, 0xcf, 0x96, 0x7e, 0x67, 0xe7, 0xc0, 0xec, 0x35, 0xe3, 0x2e, 0x6c, 0xfd,
-	0x28, 0x81, 0x7f, 0xeb, 0x0c, 0xbe, 0xa2, 0xae, 0xc5, 0xbd, 0x46, 0x32, 0x54, 0xf2, 0x3a, 0x98,
-	0xb0, 0x22, 0xee, 0x93, 0x10, 0xf1, 0x76, 0xea, 0x6b, 0x4d, 0xf9, 0xf6, 0xe9, 0xfe, 0xb4, 0x18,
-	0xd7, 0x2d, 0xd7, 0x0d, 0x3d, 0xc6, 0x9a, 0x3c, 0x44, 0x18, 0x1a, 0x3d, 0xa8, 0xbc, 0x01, 0x72,
-	0xe9, 0x58, 0x26, 0x8e, 0xe7, 0xab, 0x73, 0xda, 0x80, 0x77, 0x43, 0x4b, 0x49, 0x6a, 0xa3, 0x47,
-	0x9d, 0x52, 0xc6, 0x10, 0x07, 0x36, 0x27, 0x63, 0xc1, 0xbd, 0xab, 0x2a, 0xb3, 0x60, 0xe6, 0x8a,
-	0xaa, 0xae, 0xe2, 0xea, 0xd7, 0x11, 0x90, 0xad, 0x33, 0x28, 0xbf, 0x05, 0x53, 0xd7, 0x07, 0xfe,
-	0xee, 0x40, 0xca, 0x41, 0xc1, 0x2e, 0xae, 0x0c, 0x0d, 0xed, 0x52, 0xcb, 0x3e, 0x98, 0xbc, 0x92,
-	0xff, 0x3b, 0x37, 0x5d, 0xd2, 0x8f, 0x2b, 0x6a, 0xc3, 0xe1, 0x2e, 0x98, 0x6c, 0x50, 0xe8, 0xb3,
-	0x64, 0xe1, 0xa6, 0xf3, 0x97, 0x51, 0xc5, 0x7b, 0xc3, 0xa0, 0xba, 0x1c, 0xc5, 0xb1, 0x77, 0xe7,
-	0x87, 0x4b, 0x52, 0xed, 0xd9, 0xd1, 0xa9, 0x2a, 0x1d, 0x9f, 0xaa, 0xd2, 0x8f, 0x53, 0x55, 0xfa,
-	0x70, 0xa6, 0x66, 0x8e, 0xcf, 0xd4, 0xcc, 0xf7, 0x33, 0x35, 0xf3, 0x7a, 0xf9, 0x57, 0x41, 0x3c,
-	0xe8, 0x3d, 0xc9, 0x49, 0x26, 0xed, 0x5c, 0xf2, 0x1e, 0xaf, 0xfe, 0x0c, 0x00, 0x00, 0xff, 0xff,
-	0x95, 0x73, 0xec, 0x64, 0x4f, 0x06, 0x00, 0x00,
+	// 972 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x73, 0xdb, 0x44,
+	0x14, 0x8e, 0xe2, 0xfc, 0x7c, 0xf6, 0x04, 0xaa, 0x66, 0x52, 0xc5, 0x29, 0xb6, 0xf1, 0x94, 0x8e,
+	0x69, 0x41, 0x6a, 0x9c, 0xd2, 0xa1, 0xe5, 0xc0, 0xc4, 0x9d, 0x30, 0x85, 0xe0, 0xc1, 0xac, 0xe1,
+	0x02, 0x07, 0x8f, 0x24, 0xaf, 0xa5, 0x9d, 0x58, 0xbb, 0x62, 0x77, 0x15, 0x6a, 0x4e, 0x0c, 0xff,
+	0x00, 0x1c, 0xf8, 0x07, 0x38, 0x72, 0xeb, 0x01, 0x86, 0x33, 0xb7, 0x1e, 0x3b, 0x9c, 0x3a, 0x39,
+	0x64, 0x98, 0xe4, 0xd0, 0x1b, 0x7f, 0x03, 0xe3, 0x95, 0x6c, 0xc7, 0x8e, 0x12, 0xdc, 0x10, 0xb8,
+	0x69, 0xf7, 0x7d, 0x6f, 0xdf, 0xb7, 0xef, 0xfb, 0x76, 0x57, 0x70, 0xdd, 0xb1, 0x9d, 0x5e, 0x97,
+	0x51, 0xab, 0x43, 0xa8, 0xdd, 0x25, 0xb2, 0x67, 0xed, 0x6f, 0x5a, 0xf2, 0xb1, 0x19, 0x72, 0x26,
+	0x99, 0x7e, 0x35, 0x89, 0x9a, 0x83, 0xa8, 0xb9, 0xbf, 0x99, 0x5f, 0xf5, 0x98, 0xc7, 0x54, 0xdc,
+	0xea, 0x7f, 0xc5, 0xd0, 0xfc, 0x6b, 0x12, 0xd3, 0x36, 0xe6, 0x01, 0xa1, 0xd2, 0x72, 0x79, 0x2f,
+	0x94, 0xcc, 0x0a, 0x39, 0x63, 0x9d, 0x24, 0xbc, 0xee, 0x32, 0x11, 0x30, 0xd1, 0x8a, 0xf3, 0xe2,
+	0x41, 0x12, 0xba, 0x16, 0x8f, 0xac, 0x40, 0x78, 0xfd, 0xe2, 0x81, 0xf0, 0x92, 0x40, 0x29, 0x8d,
+	0x5b, 0x68, 0x73, 0x3b, 0x18, 0xa4, 0x96, 0xd3, 0x10, 0x43, 0xae, 0x0a, 0x53, 0xfe, 0x7d, 0x16,
+	0x56, 0xeb, 0xc2, 0x7b, 0xc8, 0x82, 0x80, 0xc8, 0x46, 0xe4, 0x20, 0x9b, 0xb6, 0x3f, 0x26, 0x42,
+	0xea, 0x6b, 0xb0, 0x20, 0x88, 0x47, 0x31, 0x37, 0xb4, 0x92, 0x56, 0x59, 0x46, 0xc9, 0x48, 0x47,
+	0xb0, 0xdc, 0x09, 0x5b, 0x8e, 0x74, 0x5b, 0xe1, 0x9e, 0x31, 0x5b, 0xd2, 0x2a, 0xb9, 0xda, 0xbd,
+	0x83, 0xc3, 0x62, 0xd5, 0x23, 0xd2, 0x8f, 0x1c, 0xd3, 0x65, 0x81, 0x95, 0x94, 0x75, 0x7d, 0x9b,
+	0xd0, 0xc1, 0xc0, 0x92, 0xbd, 0x10, 0x0b, 0xb3, 0xf6, 0x61, 0x63, 0xeb, 0xee, 0x9d, 0x46, 0xe4,
+	0xec, 0xe2, 0x1e, 0x5a, 0xec, 0x84, 0x35, 0xe9, 0x36, 0xf6, 0xf4, 0xd7, 0x21, 0x27, 0xa4, 0xcd,
+	0x65, 0xcb, 0xc7, 0xc4, 0xf3, 0xa5, 0x91, 0x29, 0x69, 0x95, 0x39, 0x94, 0x55, 0x73, 0x8f, 0xd4,
+	0x94, 0x5e, 0x82, 0x1c, 0x8d, 0x82, 0x56, 0x18, 0x39, 0x2d, 0x6e, 0xd3, 0xb6, 0x31, 0xa7, 0x20,
+	0x40, 0xa3, 0x20, 0x21, 0xad, 0x17, 0x00, 0x5c, 0xb5, 0x8b, 0x00, 0x53, 0x69, 0xcc, 0xf7, 0x99,
+	0xa1, 0x13, 0x33, 0xfa, 0x2e, 0x64, 0x04, 0xf1, 0x8c, 0x05, 0x45, 0xf9, 0xfe, 0xc1, 0x61, 0xf1,
+	0x9d, 0x97, 0xa1, 0xdc, 0x24, 0x1e, 0xb5, 0x65, 0xc4, 0x31, 0xea, 0xaf, 0xf2, 0x20, 0xfb, 0xdd,
+	0x8b, 0x27, 0xb7, 0x92, 0x96, 0x94, 0x0b, 0x70, 0x3d, 0xad, 0x85, 0x08, 0x8b, 0x90, 0x51, 0x81,
+	0xcb, 0xbf, 0x65, 0xe0, 0x4a, 0x5d, 0x78, 0xdb, 0xed, 0xf6, 0x07, 0x49, 0xf3, 0x9b, 0xc4, 0xfb,
+	0xbf, 0x1b, 0xec, 0x74, 0x99, 0xbb, 0x37, 0xd1, 0x60, 0x35, 0x97, 0x34, 0xb8, 0x09, 0x4b, 0x63,
+	0xcd, 0xcd, 0xd5, 0xde, 0x3d, 0x38, 0x2c, 0xde, 0x9d, 0xae, 0x6a, 0xd3, 0xf5, 0x29, 0xe3, 0x3c,
+	0xd9, 0x3c, 0x5a, 0x0c, 0x13, 0x4d, 0x4c, 0x98, 0x57, 0x36, 0x57, 0x72, 0x64, 0xab, 0x86, 0x39,
+	0x3a, 0x06, 0x66, 0x7c, 0x0c, 0xcc, 0x46, 0x3f, 0x8e, 0x62, 0x98, 0x7e, 0x03, 0x56, 0x62, 0x9e,
+	0x76, 0x18, 0xb6, 0x7c, 0x5b, 0xf8, 0xb1, 0x5c, 0x28, 0x66, 0xbf, 0x1d, 0x86, 0x8f, 0x6c, 0xe1,
+	0xeb, 0x5f, 0x42, 0x6e, 0xe0, 0xe2, 0x56, 0x5f, 0xd2, 0xc5, 0x0b, 0xd2, 0xdd, 0xf9, 0xe4, 0xb3,
+	0x66, 0x93, 0x78, 0x28, 0xdb, 0x19, 0xc9, 0x32, 0xae, 0xec, 0x06, 0xac, 0x9f, 0x12, 0x6e, 0x28,
+	0xeb, 0x4f, 0x1a, 0xac, 0x9d, 0x8a, 0xd6, 0xa3, 0xae, 0x24, 0x67, 0x6a, 0x3b, 0xa9, 0xc3, 0xec,
+	0x69, 0x1d, 0xde, 0x87, 0x45, 0x4c, 0x25, 0x27, 0x58, 0x18, 0x99, 0x52, 0xa6, 0x92, 0xad, 0xbe,
+	0x61, 0xa6, 0x5c, 0x33, 0xe6, 0x89, 0x92, 0x3b, 0x54, 0xf2, 0x1e, 0x1a, 0x64, 0x8d, 0x6f, 0xe0,
+	0xaf, 0x59, 0x78, 0x75, 0x12, 0x3a, 0xee, 0x30, 0xed, 0x72, 0x1c, 0x76, 0xd2, 0x3e, 0xb3, 0x97,
+	0x6e, 0x9f, 0xcc, 0x45, 0xed, 0x33, 0x37, 0x85, 0x7d, 0xe6, 0x2f, 0xd1, 0x3e, 0x65, 0x0f, 0x0a,
+	0xe9, 0x9e, 0x18, 0xd8, 0x46, 0xdf, 0x81, 0x45, 0x8e, 0x45, 0xd4, 0x95, 0xc2, 0xd0, 0x94, 0xc0,
+	0xb7, 0xa7, 0x13, 0x58, 0xe5, 0xa0, 0x41, 0x6e, 0xf9, 0x67, 0x0d, 0xd6, 0xd2, 0x31, 0xff, 0x89,
+	0xbe, 0x79, 0x58, 0xb2, 0x5d, 0x17, 0x87, 0x12, 0xc7, 0xfa, 0x2e, 0xa1, 0xe1, 0x58, 0x2f, 0x42,
+	0x56, 0xec, 0x91, 0xb0, 0xc5, 0xb1, 0x2d, 0x18, 0x55, 0x62, 0x2d, 0x23, 0xe8, 0x4f, 0x21, 0x35,
+	0x53, 0xfe, 0x55, 0x53, 0x5d, 0x69, 0x46, 0x4e, 0x40, 0x64, 0x4c, 0xfa, 0x1b, 0x5b, 0x12, 0x46,
+	0x1f, 0xfa, 0x76, 0xb7, 0x8b, 0xa9, 0x87, 0xff, 0xcd, 0x89, 0xd9, 0x85, 0x25, 0xbc, 0x4f, 0xda,
+	0x98, 0xba, 0x38, 0x31, 0x8a, 0x75, 0x4e, 0x47, 0xe3, 0xc2, 0x9f, 0x46, 0x8c, 0x47, 0xc1, 0x4e,
+	0x92, 0x86, 0x86, 0x0b, 0x8c, 0x9f, 0x9e, 0x0a, 0xdc, 0x3c, 0x9f, 0xf6, 0xf0, 0x2e, 0xf8, 0x51,
+	0x83, 0x57, 0xea, 0xc2, 0xfb, 0x3c, 0x6c, 0xdb, 0x12, 0x37, 0xd4, 0x23, 0xac, 0xdf, 0x83, 0x65,
+	0x3b, 0x92, 0x3e, 0xe3, 0x44, 0xf6, 0xe2, 0x5d, 0xd5, 0x8c, 0x3f, 0x7e, 0x79, 0x7b, 0x35, 0x79,
+	0xde, 0xb7, 0xdb, 0x6d, 0x8e, 0x85, 0x68, 0x4a, 0x4e, 0xa8, 0x87, 0x46, 0x50, 0xfd, 0x3e, 0x2c,
+	0xc4, 0xcf, 0xb8, 0xda, 0x6c, 0xb6, 0xba, 0x91, 0xba, 0x9b, 0xb8, 0x48, 0x6d, 0xee, 0xe9, 0x61,
+	0x71, 0x06, 0x25, 0x09, 0x0f, 0x56, 0xfa, 0xec, 0x47, 0x4b, 0x95, 0xd7, 0xe1, 0xda, 0x04, 0xab,
+	0x01, 0xe3, 0xea, 0xf3, 0x39, 0xc8, 0xd4, 0x85, 0xa7, 0x7f, 0x05, 0x57, 0x4e, 0x3f, 0xfe, 0x6f,
+	0xa6, 0x96, 0x4c, 0x7b, 0xe4, 0xf2, 0x9b, 0x53, 0x43, 0x87, 0x27, 0xc0, 0x87, 0x95, 0x89, 0xb7,
+	0xf0, 0xe6, 0x59, 0x8b, 0x8c, 0xe3, 0xf2, 0xe6, 0x74, 0xb8, 0x61, 0xa5, 0xaf, 0xe1, 0x6a, 0xda,
+	0xf5, 0x7c, 0x7b, 0xba, 0x65, 0x14, 0x38, 0xbf, 0xf5, 0x12, 0xe0, 0x61, 0xe1, 0xef, 0x35, 0xd8,
+	0x38, 0xcf, 0xee, 0x67, 0x2e, 0x7a, 0x4e, 0x52, 0xfe, 0xbd, 0x0b, 0x24, 0x0d, 0x19, 0x39, 0x90,
+	0x1b, 0x73, 0xe7, 0x8d, 0xb3, 0x16, 0x3b, 0x89, 0xca, 0xbf, 0x35, 0x0d, 0x6a, 0x50, 0x23, 0x3f,
+	0xff, 0xed, 0x8b, 0x27, 0xb7, 0xb4, 0xda, 0x47, 0x4f, 0x8f, 0x0a, 0xda, 0xb3, 0xa3, 0x82, 0xf6,
+	0xe7, 0x51, 0x41, 0xfb, 0xe1, 0xb8, 0x30, 0xf3, 0xec, 0xb8, 0x30, 0xf3, 0xfc, 0xb8, 0x30, 0xf3,
+	0xc5, 0x9d, 0x7f, 0xba, 0x82, 0x1e, 0x8f, 0xfe, 0x55, 0xd5, 0x6d, 0xe4, 0x2c, 0xa8, 0xdf, 0xd4,
+	0xad, 0xbf, 0x07, 0x00, 0x94, 0xba, 0x63, 0x5f, 0x8a, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -441,7 +803,13 @@ type MsgClient interface {
 	CommitPubRandList(ctx context.Context, in *MsgCommitPubRandList, opts ...grpc.CallOption) (*MsgCommitPubRandListResponse, error)
 	// AddFinalitySig adds a finality signature to a given block
 	AddFinalitySig(ctx context.Context, in *MsgAddFinalitySig, opts ...grpc.CallOption) (*MsgAddFinalitySigResponse, error)
+	// AddFinalitySigMulti adds the finality signatures of multiple finality
+	// providers to a given block
+	AddFinalitySigMulti(ctx context.Context, in *MsgAddFinalitySigMulti, opts ...grpc.CallOption) (*MsgAddFinalitySigMultiResponse, error)
 	// TODO: msg for evidence of equivocation. this is not specified yet
+	// SubmitFinalizationChallenge submits the evidence that a finalized block
+	// does not reach the finalization threshold
+	SubmitFinalizationChallenge(ctx context.Context, in *MsgSubmitFinalizationChallenge, opts ...grpc.CallOption) (*MsgSubmitFinalizationChallengeResponse, error)
 	// UpdateParams updates the finality module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
@@ -472,6 +840,24 @@ func (c *msgClient) AddFinalitySig(ctx context.Context, in *MsgAddFinalitySig, o
 	return out, nil
 }
 
+func (c *msgClient) AddFinalitySigMulti(ctx context.Context, in *MsgAddFinalitySigMulti, opts ...grpc.CallOption) (*MsgAddFinalitySigMultiResponse, error) {
+	out := new(MsgAddFinalitySigMultiResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Msg/AddFinalitySigMulti", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitFinalizationChallenge(ctx context.Context, in *MsgSubmitFinalizationChallenge, opts ...grpc.CallOption) (*MsgSubmitFinalizationChallengeResponse, error) {
+	out := new(MsgSubmitFinalizationChallengeResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Msg/SubmitFinalizationChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Msg/UpdateParams", in, out, opts...)
@@ -487,7 +873,13 @@ type MsgServer interface {
 	CommitPubRandList(context.Context, *MsgCommitPubRandList) (*MsgCommitPubRandListResponse, error)
 	// AddFinalitySig adds a finality signature to a given block
 	AddFinalitySig(context.Context, *MsgAddFinalitySig) (*MsgAddFinalitySigResponse, error)
+	// AddFinalitySigMulti adds the finality signatures of multiple finality
+	// providers to a given block
+	AddFinalitySigMulti(context.Context, *MsgAddFinalitySigMulti) (*MsgAddFinalitySigMultiResponse, error)
 	// TODO: msg for evidence of equivocation. this is not specified yet
+	// SubmitFinalizationChallenge submits the evidence that a finalized block
+	// does not reach the finalization threshold
+	SubmitFinalizationChallenge(context.Context, *MsgSubmitFinalizationChallenge) (*MsgSubmitFinalizationChallengeResponse, error)
 	// UpdateParams updates the finality module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
@@ -502,6 +894,12 @@ func (*UnimplementedMsgServer) CommitPubRandList(ctx context.Context, req *MsgCo
 func (*UnimplementedMsgServer) AddFinalitySig(ctx context.Context, req *MsgAddFinalitySig) (*MsgAddFinalitySigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddFinalitySig not implemented")
 }
+func (*UnimplementedMsgServer) AddFinalitySigMulti(ctx context.Context, req *MsgAddFinalitySigMulti) (*MsgAddFinalitySigMultiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFinalitySigMulti not implemented")
+}
+func (*UnimplementedMsgServer) SubmitFinalizationChallenge(ctx context.Context, req *MsgSubmitFinalizationChallenge) (*MsgSubmitFinalizationChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitFinalizationChallenge not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -546,6 +944,42 @@ func _Msg_AddFinalitySig_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddFinalitySigMulti_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddFinalitySigMulti)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddFinalitySigMulti(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Msg/AddFinalitySigMulti",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddFinalitySigMulti(ctx, req.(*MsgAddFinalitySigMulti))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitFinalizationChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitFinalizationChallenge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitFinalizationChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Msg/SubmitFinalizationChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitFinalizationChallenge(ctx, req.(*MsgSubmitFinalizationChallenge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -576,6 +1010,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddFinalitySig",
 			Handler:    _Msg_AddFinalitySig_Handler,
 		},
+		{
+			MethodName: "AddFinalitySigMulti",
+			Handler:    _Msg_AddFinalitySigMulti_Handler,
+		},
+		{
+			MethodName: "SubmitFinalizationChallenge",
+			Handler:    _Msg_SubmitFinalizationChallenge_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -792,7 +1234,7 @@ func (m *MsgAddFinalitySigResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddFinalitySigMulti) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -802,26 +1244,312 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddFinalitySigMulti) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddFinalitySigMulti) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
+	if m.BlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalitySigEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalitySigEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalitySigEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinalitySig != nil {
+		{
+			size := m.FinalitySig.Size()
+			i -= size
+			if _, err := m.FinalitySig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BlockAppHash) > 0 {
+		i -= len(m.BlockAppHash)
+		copy(dAtA[i:], m.BlockAppHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlockAppHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PubRand != nil {
+		{
+			size := m.PubRand.Size()
+			i -= size
+			if _, err := m.PubRand.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddFinalitySigMultiResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddFinalitySigMultiResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddFinalitySigMultiResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalitySigEntryResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalitySigEntryResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalitySigEntryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SkipReason) > 0 {
+		i -= len(m.SkipReason)
+		copy(dAtA[i:], m.SkipReason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SkipReason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Accepted {
+		i--
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitFinalizationChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitFinalizationChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitFinalizationChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Evidence != nil {
+		{
+			size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitFinalizationChallengeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitFinalizationChallengeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitFinalizationChallengeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
@@ -951,32 +1679,147 @@ func (m *MsgAddFinalitySigResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
+func (m *MsgAddFinalitySigMulti) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
+	l = len(m.Signer)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.BlockHeight))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
+func (m *FinalitySigEntry) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PubRand != nil {
+		l = m.PubRand.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BlockAppHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FinalitySig != nil {
+		l = m.FinalitySig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddFinalitySigMultiResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FinalitySigEntryResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Accepted {
+		n += 2
+	}
+	l = len(m.SkipReason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitFinalizationChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.BlockHeight))
+	}
+	if m.Evidence != nil {
+		l = m.Evidence.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitFinalizationChallengeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -1081,7 +1924,745 @@ func (m *MsgCommitPubRandList) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			m.StartHeight = 0
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPubRand", wireType)
+			}
+			m.NumPubRand = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPubRand |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340Signature
+			m.Sig = &v
+			if err := m.Sig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitPubRandListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitPubRandListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitPubRandListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddFinalitySig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddFinalitySig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrPubRand
+			m.PubRand = &v
+			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &crypto.Proof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockAppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockAppHash = append(m.BlockAppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockAppHash == nil {
+				m.BlockAppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalitySig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
+			m.FinalitySig = &v
+			if err := m.FinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddFinalitySigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddFinalitySigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddFinalitySigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddFinalitySigMulti) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddFinalitySigMulti: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddFinalitySigMulti: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &FinalitySigEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalitySigEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalitySigEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalitySigEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.SchnorrPubRand
+			m.PubRand = &v
+			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1091,33 +2672,31 @@ func (m *MsgCommitPubRandList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumPubRand", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.NumPubRand = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumPubRand |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 5:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &crypto.Proof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockAppHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1144,14 +2723,14 @@ func (m *MsgCommitPubRandList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
-			if m.Commitment == nil {
-				m.Commitment = []byte{}
+			m.BlockAppHash = append(m.BlockAppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockAppHash == nil {
+				m.BlockAppHash = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalitySig", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1178,9 +2757,9 @@ func (m *MsgCommitPubRandList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.BIP340Signature
-			m.Sig = &v
-			if err := m.Sig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
+			m.FinalitySig = &v
+			if err := m.FinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1205,7 +2784,7 @@ func (m *MsgCommitPubRandList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCommitPubRandListResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddFinalitySigMultiResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1228,12 +2807,46 @@ func (m *MsgCommitPubRandListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCommitPubRandListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddFinalitySigMultiResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCommitPubRandListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddFinalitySigMultiResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &FinalitySigEntryResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1255,7 +2868,7 @@ func (m *MsgCommitPubRandListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
+func (m *FinalitySigEntryResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1278,45 +2891,13 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddFinalitySig: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalitySigEntryResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddFinalitySig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalitySigEntryResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
 			}
@@ -1351,11 +2932,11 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
 			}
-			m.BlockHeight = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1365,16 +2946,17 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			m.Accepted = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipReason", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1384,32 +2966,79 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.SchnorrPubRand
-			m.PubRand = &v
-			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SkipReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitFinalizationChallenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitFinalizationChallenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitFinalizationChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1419,33 +3048,29 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Proof == nil {
-				m.Proof = &crypto.Proof{}
-			}
-			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockAppHash", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var byteLen int
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1455,31 +3080,16 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlockAppHash = append(m.BlockAppHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockAppHash == nil {
-				m.BlockAppHash = []byte{}
-			}
-			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalitySig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1489,24 +3099,25 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonchain_babylon_types.SchnorrEOTSSig
-			m.FinalitySig = &v
-			if err := m.FinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Evidence == nil {
+				m.Evidence = &FinalizationQuorumEvidence{}
+			}
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1531,7 +3142,7 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddFinalitySigResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitFinalizationChallengeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1554,10 +3165,10 @@ func (m *MsgAddFinalitySigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddFinalitySigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitFinalizationChallengeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddFinalitySigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitFinalizationChallengeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
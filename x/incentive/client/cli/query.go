@@ -26,6 +26,12 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		CmdQueryRewardGauges(),
 		CmdQueryBTCStakingGauge(),
 		CmdQueryBTCTimestampingGauge(),
+		CmdQueryBlockRewardDistribution(),
+		CmdQueryBTCDelegationRewardLockup(),
+		CmdQueryLifetimeRewards(),
+		CmdQueryRewardGaugeDenoms(),
+		CmdQueryDelegatorRewardsByValidator(),
+		CmdQueryRewardPoolBalance(),
 	)
 
 	return cmd
@@ -130,3 +136,186 @@ func CmdQueryBTCTimestampingGauge() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryBlockRewardDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-reward-distribution [height]",
+		Short: "shows how the BTC staking rewards of a given height were distributed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBlockRewardDistributionRequest{
+				Height: height,
+			}
+			res, err := queryClient.BlockRewardDistribution(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryBTCDelegationRewardLockup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-delegation-reward-lockup [staking-tx-hash-hex]",
+		Short: "shows the epoch from which a given BTC delegation earns full rewards",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBTCDelegationRewardLockupRequest{
+				StakingTxHashHex: args[0],
+			}
+			res, err := queryClient.BTCDelegationRewardLockup(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryLifetimeRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lifetime-rewards [address] [stakeholder_type]",
+		Short: "shows rewards ever credited to a given stakeholder address in a given stakeholder type, i.e., one of {submitter, reporter, finality_provider, btc_delegation}",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryLifetimeRewardsRequest{
+				Address:         args[0],
+				StakeholderType: args[1],
+			}
+			res, err := queryClient.LifetimeRewards(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryRewardGaugeDenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-gauge-denoms [address]",
+		Short: "shows denoms present in the reward gauges of a given stakeholder address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRewardGaugeDenomsRequest{
+				Address: args[0],
+			}
+			res, err := queryClient.RewardGaugeDenoms(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryDelegatorRewardsByValidator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegator-rewards-by-validator [del_btc_pk_hex]",
+		Short: "shows rewards ever credited to a given BTC delegator, grouped by finality provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDelegatorRewardsByValidatorRequest{
+				DelBtcPkHex: args[0],
+			}
+			res, err := queryClient.DelegatorRewardsByValidator(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryRewardPoolBalance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-pool-balance",
+		Short: "shows the balances of the accounts in which rewards are pooled before being distributed",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RewardPoolBalance(cmd.Context(), &types.QueryRewardPoolBalanceRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -10,6 +10,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 )
 
+const (
+	FlagDestinationAddress = "destination-address"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -38,15 +42,22 @@ func NewWithdrawRewardCmd() *cobra.Command {
 				return err
 			}
 
+			destAddr, err := cmd.Flags().GetString(FlagDestinationAddress)
+			if err != nil {
+				return err
+			}
+
 			msg := types.MsgWithdrawReward{
-				Type:    args[0],
-				Address: clientCtx.FromAddress.String(),
+				Type:               args[0],
+				Address:            clientCtx.FromAddress.String(),
+				DestinationAddress: destAddr,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
+	cmd.Flags().String(FlagDestinationAddress, "", "address that receives the reward (default: the transaction submitter)")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
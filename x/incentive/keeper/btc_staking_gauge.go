@@ -14,11 +14,36 @@ import (
 // to the filtered reward distribution cache (that only contains voted finality providers)
 // (adapted from https://github.com/cosmos/cosmos-sdk/blob/release/v0.47.x/x/distribution/keeper/allocation.go#L12-L64)
 func (k Keeper) RewardBTCStaking(ctx context.Context, height uint64, filteredDc *bstypes.VotingPowerDistCache) {
+	// while rewards are paused, nothing is credited. The gauge of a height that
+	// was accumulated before pausing but finalised while paused stays in the
+	// incentive module
+	params := k.GetParams(ctx)
+	if params.RewardsPaused {
+		return
+	}
 	gauge := k.GetBTCStakingGauge(ctx, height)
 	if gauge == nil {
 		// failing to get a reward gauge at previous height is a programming error
 		panic("failed to get a reward gauge at previous height")
 	}
+	// rewards credited in this distribution, reported to hook subscribers
+	fpRewards := []*types.StakeholderReward{}
+	btcDelRewards := []*types.StakeholderReward{}
+	// record of the distribution at this height, persisted for auditing
+	rewardDist := &types.BlockRewardDistribution{
+		Height:           height,
+		TotalReward:      gauge.Coins,
+		TotalVotingPower: filteredDc.TotalVotingPower,
+	}
+	// BTC delegations that are younger than the lockup only receive a prorated
+	// portion of their rewards, and the rest stays in the incentive module
+	curEpoch := k.epochingKeeper.GetEpoch(ctx).EpochNumber
+	lockupEpochs := params.RewardLockupEpochs
+	// rewards of BTC delegations that opt out of rewards are either burned or
+	// redistributed to the other BTC delegations of the same finality provider,
+	// or to the finality provider itself if all of its BTC delegations opt out
+	burnOptedOut := params.BurnOptedOutRewards
+	coinsToBurn := sdk.NewCoins()
 	// reward each of the finality provider and its BTC delegations in proportion
 	for _, fp := range filteredDc.FinalityProviders {
 		// get coins that will be allocated to the finality provider and its BTC delegations
@@ -26,17 +51,67 @@ func (k Keeper) RewardBTCStaking(ctx context.Context, height uint64, filteredDc
 		coinsForFpsAndDels := gauge.GetCoinsPortion(fpPortion)
 		// reward the finality provider with commission
 		coinsForCommission := types.GetCoinsPortion(coinsForFpsAndDels, *fp.Commission)
-		k.accumulateRewardGauge(ctx, types.FinalityProviderType, fp.GetAddress(), coinsForCommission)
-		// reward the rest of coins to each BTC delegation proportional to its voting power portion
 		coinsForBTCDels := coinsForFpsAndDels.Sub(coinsForCommission...)
+		rewardedPower := fp.GetRewardedVotingPower()
+		coinsForFp := coinsForCommission
+		if !burnOptedOut && rewardedPower == 0 {
+			// no BTC delegation is left to redistribute the rewards of the
+			// opted-out BTC delegations to, so the finality provider receives
+			// them rather than leaving them in the incentive module
+			coinsForFp = coinsForFpsAndDels
+		}
+		if k.accumulateRewardGauge(ctx, types.FinalityProviderType, fp.GetAddress(), coinsForFp) {
+			fpRewards = append(fpRewards, types.NewStakeholderReward(fp.GetAddress(), coinsForFp))
+		}
+		// reward the rest of coins to each BTC delegation proportional to its voting power portion
+		coinsToDels := sdk.NewCoins()
 		for _, btcDel := range fp.BtcDels {
+			if btcDel.RewardOptOut {
+				if burnOptedOut {
+					coinsToBurn = coinsToBurn.Add(types.GetCoinsPortion(coinsForBTCDels, fp.GetBTCDelPortion(btcDel))...)
+				}
+				continue
+			}
 			btcDelPortion := fp.GetBTCDelPortion(btcDel)
+			if !burnOptedOut && rewardedPower < fp.TotalVotingPower {
+				btcDelPortion = fp.GetBTCDelRewardedPortion(btcDel)
+			}
+			if lockupEpochs > 0 {
+				btcDelPortion = btcDelPortion.Mul(k.getBTCDelRewardLockupPortion(ctx, btcDel.StakingTxHash, curEpoch, lockupEpochs))
+			}
 			coinsForDel := types.GetCoinsPortion(coinsForBTCDels, btcDelPortion)
-			k.accumulateRewardGauge(ctx, types.BTCDelegationType, btcDel.GetAddress(), coinsForDel)
+			if k.accumulateRewardGauge(ctx, types.BTCDelegationType, btcDel.GetAddress(), coinsForDel) {
+				btcDelRewards = append(btcDelRewards, types.NewStakeholderReward(btcDel.GetAddress(), coinsForDel))
+				k.accumulateDelegatorValidatorRewards(ctx, btcDel.BtcPk, fp.BtcPk, coinsForDel)
+				k.recordBTCDelRewardWatermark(ctx, btcDel.StakingTxHash, btcDel.GetAddress())
+				coinsToDels = coinsToDels.Add(coinsForDel...)
+			}
+		}
+		rewardDist.FinalityProviders = append(rewardDist.FinalityProviders, &types.FinalityProviderRewardDistribution{
+			BtcPk:           fp.BtcPk,
+			Address:         fp.GetAddress().String(),
+			VotingPower:     fp.TotalVotingPower,
+			CommissionRate:  *fp.Commission,
+			Reward:          coinsForFpsAndDels,
+			Commission:      coinsForFp,
+			DelegatorReward: coinsToDels,
+		})
+	}
+	k.recordBlockRewardDistribution(ctx, rewardDist)
+
+	if !coinsToBurn.IsZero() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coinsToBurn); err != nil {
+			// this can only be programming error and is unrecoverable
+			panic(err)
 		}
 	}
 
 	// TODO: handle the change in the gauge due to the truncating operations
+
+	// invoke hook
+	if err := k.AfterRewardsDistributed(ctx, height, fpRewards, btcDelRewards, filteredDc.FinalityProviders); err != nil {
+		k.Logger(sdk.UnwrapSDKContext(ctx)).Error("failed to trigger rewards distributed hook", "height", height, "error", err)
+	}
 }
 
 func (k Keeper) accumulateBTCStakingReward(ctx context.Context, btcStakingReward sdk.Coins) {
@@ -45,6 +120,11 @@ func (k Keeper) accumulateBTCStakingReward(ctx context.Context, btcStakingReward
 	gauge := types.NewGauge(btcStakingReward...)
 	k.SetBTCStakingGauge(ctx, height, gauge)
 
+	// nothing to transfer, e.g., when rewards are paused
+	if btcStakingReward.IsZero() {
+		return
+	}
+
 	// transfer the BTC staking reward from fee collector account to incentive module account
 	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, btcStakingReward)
 	if err != nil {
@@ -71,6 +151,53 @@ func (k Keeper) GetBTCStakingGauge(ctx context.Context, height uint64) *types.Ga
 	return &gauge
 }
 
+// recordBlockRewardDistribution stores the reward distribution record of a
+// finalized height and prunes the records that fall out of the retention
+// window. Each record takes roughly 100 bytes plus 150 bytes per finality
+// provider that voted, so the retention bounds the records' total size
+func (k Keeper) recordBlockRewardDistribution(ctx context.Context, rewardDist *types.BlockRewardDistribution) {
+	retention := k.GetParams(ctx).BlockRewardDistRetention
+	if retention > 0 {
+		k.SetBlockRewardDistribution(ctx, rewardDist)
+	}
+	if rewardDist.Height >= retention {
+		k.pruneBlockRewardDistributions(ctx, rewardDist.Height-retention)
+	}
+}
+
+// pruneBlockRewardDistributions removes the reward distribution records of
+// all heights up to and including the given height
+func (k Keeper) pruneBlockRewardDistributions(ctx context.Context, height uint64) {
+	store := k.blockRewardDistStore(ctx)
+	iter := store.Iterator(nil, sdk.Uint64ToBigEndian(height+1))
+	defer iter.Close()
+	keys := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) SetBlockRewardDistribution(ctx context.Context, rewardDist *types.BlockRewardDistribution) {
+	store := k.blockRewardDistStore(ctx)
+	rewardDistBytes := k.cdc.MustMarshal(rewardDist)
+	store.Set(sdk.Uint64ToBigEndian(rewardDist.Height), rewardDistBytes)
+}
+
+func (k Keeper) GetBlockRewardDistribution(ctx context.Context, height uint64) *types.BlockRewardDistribution {
+	store := k.blockRewardDistStore(ctx)
+	rewardDistBytes := store.Get(sdk.Uint64ToBigEndian(height))
+	if rewardDistBytes == nil {
+		return nil
+	}
+
+	var rewardDist types.BlockRewardDistribution
+	k.cdc.MustUnmarshal(rewardDistBytes, &rewardDist)
+	return &rewardDist
+}
+
 // btcStakingGaugeStore returns the KVStore of the gauge of total reward for
 // BTC staking at each height
 // prefix: BTCStakingGaugeKey
@@ -80,3 +207,13 @@ func (k Keeper) btcStakingGaugeStore(ctx context.Context) prefix.Store {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	return prefix.NewStore(storeAdapter, types.BTCStakingGaugeKey)
 }
+
+// blockRewardDistStore returns the KVStore of the record of how the BTC
+// staking gauge was distributed at each height
+// prefix: BlockRewardDistKey
+// key: height
+// value: BlockRewardDistribution at this height
+func (k Keeper) blockRewardDistStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.BlockRewardDistKey)
+}
@@ -1,13 +1,20 @@
 package keeper_test
 
 import (
+	"context"
 	"math/rand"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/babylonchain/babylon/testutil/datagen"
 	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
 	"github.com/babylonchain/babylon/x/incentive/types"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -22,9 +29,14 @@ func FuzzRewardBTCStaking(f *testing.F) {
 
 		// mock bank keeper
 		bankKeeper := types.NewMockBankKeeper(ctrl)
+		// mock epoching keeper
+		epochingKeeper := types.NewMockEpochingKeeper(ctrl)
+		epochingKeeper.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: 1}).AnyTimes()
 
 		// create incentive keeper
-		keeper, ctx := testkeeper.IncentiveKeeper(t, bankKeeper, nil, nil)
+		keeper, ctx := testkeeper.IncentiveKeeper(t, bankKeeper, nil, epochingKeeper)
+		hooks := &rewardsRecorderHooks{}
+		keeper.SetHooks(hooks)
 		height := datagen.RandomInt(r, 1000)
 		ctx = datagen.WithCtxHeight(ctx, height)
 
@@ -81,5 +93,453 @@ func FuzzRewardBTCStaking(f *testing.F) {
 
 		// assert distributedCoins is a subset of coins in gauge
 		require.True(t, gauge.Coins.IsAllGTE(distributedCoins))
+
+		// assert the distribution record is persisted and queryable
+		resp, err := keeper.BlockRewardDistribution(ctx, &types.QueryBlockRewardDistributionRequest{Height: height})
+		require.NoError(t, err)
+		rewardDist := resp.Distribution
+		require.Equal(t, height, rewardDist.Height)
+		require.True(t, gauge.Coins.Equal(rewardDist.TotalReward))
+		require.Equal(t, dc.TotalVotingPower, rewardDist.TotalVotingPower)
+		require.Len(t, rewardDist.FinalityProviders, len(dc.FinalityProviders))
+		for i, fpDist := range rewardDist.FinalityProviders {
+			fp := dc.FinalityProviders[i]
+			require.Equal(t, fp.GetAddress().String(), fpDist.Address)
+			require.Equal(t, fp.TotalVotingPower, fpDist.VotingPower)
+			require.True(t, fp.Commission.Equal(fpDist.CommissionRate))
+			require.True(t, fpDist.Reward.IsAllGTE(fpDist.Commission.Add(fpDist.DelegatorReward...)))
+			if fpReward, ok := fpRewardMap[fpDist.Address]; ok {
+				require.True(t, fpReward.Equal(fpDist.Commission))
+			} else {
+				require.True(t, fpDist.Commission.IsZero())
+			}
+		}
+		_, err = keeper.BlockRewardDistribution(ctx, &types.QueryBlockRewardDistributionRequest{Height: height + 1})
+		require.ErrorIs(t, err, types.ErrBlockRewardDistNotFound)
+
+		// assert the hook observes exactly the distributed rewards
+		require.Equal(t, height, hooks.height)
+		require.Equal(t, dc.FinalityProviders, hooks.finalizingFps)
+		require.Len(t, hooks.fpRewards, len(fpRewardMap))
+		for _, reward := range hooks.fpRewards {
+			require.Equal(t, fpRewardMap[reward.Address.String()], reward.Coins)
+		}
+		require.Len(t, hooks.btcDelRewards, len(btcDelRewardMap))
+		for _, reward := range hooks.btcDelRewards {
+			require.Equal(t, btcDelRewardMap[reward.Address.String()], reward.Coins)
+		}
+
+		// assert each credited reward gauge emits an update event
+		gaugeEvents := map[string]map[string]*types.EventRewardGaugeUpdated{
+			types.FinalityProviderType.String(): {},
+			types.BTCDelegationType.String():    {},
+		}
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != proto.MessageName(&types.EventRewardGaugeUpdated{}) {
+				continue
+			}
+			typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(t, err)
+			gaugeEvent := typedEvent.(*types.EventRewardGaugeUpdated)
+			gaugeEvents[gaugeEvent.Type][gaugeEvent.Address] = gaugeEvent
+		}
+		for sType, rewardMap := range map[types.StakeholderType]map[string]sdk.Coins{
+			types.FinalityProviderType: fpRewardMap,
+			types.BTCDelegationType:    btcDelRewardMap,
+		} {
+			require.Len(t, gaugeEvents[sType.String()], len(rewardMap))
+			for addrStr, reward := range rewardMap {
+				gaugeEvent, ok := gaugeEvents[sType.String()][addrStr]
+				require.True(t, ok)
+				require.Equal(t, reward, gaugeEvent.Delta)
+				require.Equal(t, reward, gaugeEvent.NewTotal)
+			}
+		}
+	})
+}
+
+func FuzzRewardBTCStakingWhenRewardsPaused(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock epoching keeper
+		epochingKeeper := types.NewMockEpochingKeeper(ctrl)
+		epochingKeeper.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: 1}).AnyTimes()
+
+		// create incentive keeper
+		keeper, ctx := testkeeper.IncentiveKeeper(t, nil, nil, epochingKeeper)
+		hooks := &rewardsRecorderHooks{}
+		keeper.SetHooks(hooks)
+		params := keeper.GetParams(ctx)
+		params.BlockRewardDistRetention = 100
+		params.RewardsPaused = true
+		err := keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		dc, err := datagen.GenRandomVotingPowerDistCache(r, 100)
+		require.NoError(t, err)
+		// sumRewards returns the total reward credited to the finality
+		// providers and BTC delegations of the distribution cache
+		sumRewards := func() sdk.Coins {
+			total := sdk.NewCoins()
+			for _, fp := range dc.FinalityProviders {
+				if rg := keeper.GetRewardGauge(ctx, types.FinalityProviderType, fp.GetAddress()); rg != nil {
+					total = total.Add(rg.Coins...)
+				}
+				for _, btcDel := range fp.BtcDels {
+					if rg := keeper.GetRewardGauge(ctx, types.BTCDelegationType, btcDel.GetAddress()); rg != nil {
+						total = total.Add(rg.Coins...)
+					}
+				}
+			}
+			return total
+		}
+
+		// reward gauges do not grow while rewards are paused
+		startHeight := datagen.RandomInt(r, 1000) + 1
+		numPausedHeights := datagen.RandomInt(r, 10) + 1
+		for height := startHeight; height < startHeight+numPausedHeights; height++ {
+			keeper.SetBTCStakingGauge(ctx, height, datagen.GenRandomGauge(r))
+			keeper.RewardBTCStaking(ctx, height, dc)
+			require.True(t, sumRewards().IsZero())
+			require.Nil(t, keeper.GetBlockRewardDistribution(ctx, height))
+		}
+		require.Zero(t, hooks.height)
+
+		// rewards are distributed again after unpausing
+		params.RewardsPaused = false
+		err = keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+		height := startHeight + numPausedHeights
+		keeper.SetBTCStakingGauge(ctx, height, datagen.GenRandomGauge(r))
+		keeper.RewardBTCStaking(ctx, height, dc)
+		require.NotNil(t, keeper.GetBlockRewardDistribution(ctx, height))
+		require.Equal(t, height, hooks.height)
+	})
+}
+
+// rewardsRecorderHooks records the payload of the last AfterRewardsDistributed call
+type rewardsRecorderHooks struct {
+	height        uint64
+	fpRewards     []*types.StakeholderReward
+	btcDelRewards []*types.StakeholderReward
+	finalizingFps []*bstypes.FinalityProviderDistInfo
+}
+
+func (h *rewardsRecorderHooks) AfterRewardsDistributed(
+	_ context.Context,
+	height uint64,
+	fpRewards []*types.StakeholderReward,
+	btcDelRewards []*types.StakeholderReward,
+	finalizingFps []*bstypes.FinalityProviderDistInfo,
+) error {
+	h.height = height
+	h.fpRewards = fpRewards
+	h.btcDelRewards = btcDelRewards
+	h.finalizingFps = finalizingFps
+	return nil
+}
+
+func FuzzPruneBlockRewardDistribution(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		epochingKeeper := types.NewMockEpochingKeeper(ctrl)
+		epochingKeeper.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: 1}).AnyTimes()
+
+		keeper, ctx := testkeeper.IncentiveKeeper(t, nil, nil, epochingKeeper)
+		retention := datagen.RandomInt(r, 10) + 1
+		params := keeper.GetParams(ctx)
+		params.BlockRewardDistRetention = retention
+		err := keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		dc, err := datagen.GenRandomVotingPowerDistCache(r, 10)
+		require.NoError(t, err)
+
+		// distribute rewards at a number of consecutive finalized heights
+		startHeight := datagen.RandomInt(r, 1000) + 1
+		endHeight := startHeight + retention + datagen.RandomInt(r, 10)
+		for height := startHeight; height <= endHeight; height++ {
+			keeper.SetBTCStakingGauge(ctx, height, datagen.GenRandomGauge(r))
+			keeper.RewardBTCStaking(ctx, height, dc)
+		}
+
+		// only the records of the last `retention` heights are kept
+		for height := startHeight; height <= endHeight; height++ {
+			rewardDist := keeper.GetBlockRewardDistribution(ctx, height)
+			if height+retention > endHeight {
+				require.NotNil(t, rewardDist)
+			} else {
+				require.Nil(t, rewardDist)
+			}
+		}
+
+		// zero retention disables the records
+		params.BlockRewardDistRetention = 0
+		err = keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+		keeper.SetBTCStakingGauge(ctx, endHeight+1, datagen.GenRandomGauge(r))
+		keeper.RewardBTCStaking(ctx, endHeight+1, dc)
+		for height := startHeight; height <= endHeight+1; height++ {
+			require.Nil(t, keeper.GetBlockRewardDistribution(ctx, height))
+		}
+	})
+}
+
+func FuzzRewardBTCStakingWithLockup(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		epochingKeeper := types.NewMockEpochingKeeper(ctrl)
+
+		keeper, ctx := testkeeper.IncentiveKeeper(t, nil, nil, epochingKeeper)
+		hooks := &rewardsRecorderHooks{}
+		keeper.SetHooks(hooks)
+		lockupEpochs := datagen.RandomInt(r, 10) + 1
+		params := keeper.GetParams(ctx)
+		params.RewardLockupEpochs = lockupEpochs
+		err := keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		dc, err := datagen.GenRandomVotingPowerDistCache(r, 10)
+		require.NoError(t, err)
+
+		// distribute rewards once per epoch, starting from a random epoch
+		startEpoch := datagen.RandomInt(r, 100) + 1
+		height := datagen.RandomInt(r, 1000) + 1
+		for epoch := startEpoch; epoch <= startEpoch+lockupEpochs; epoch++ {
+			epochingKeeper.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: epoch}).Times(1)
+			gauge := datagen.GenRandomGauge(r)
+			keeper.SetBTCStakingGauge(ctx, height, gauge)
+
+			// expected rewards of each BTC delegation at this epoch
+			expectedRewards := map[string]sdk.Coins{}
+			for _, fp := range dc.FinalityProviders {
+				coinsForFpsAndDels := gauge.GetCoinsPortion(dc.GetFinalityProviderPortion(fp))
+				coinsForBTCDels := coinsForFpsAndDels.Sub(types.GetCoinsPortion(coinsForFpsAndDels, *fp.Commission)...)
+				for _, btcDel := range fp.BtcDels {
+					lockupPortion := sdkmath.LegacyNewDec(int64(epoch - startEpoch)).QuoInt64(int64(lockupEpochs))
+					coinsForDel := types.GetCoinsPortion(coinsForBTCDels, fp.GetBTCDelPortion(btcDel).Mul(lockupPortion))
+					if coinsForDel.IsAllPositive() {
+						expectedRewards[btcDel.GetAddress().String()] = coinsForDel
+					}
+				}
+			}
+
+			keeper.RewardBTCStaking(ctx, height, dc)
+			height++
+
+			// no BTC delegation is rewarded in its first epoch, and the full
+			// reward is only given once the lockup has passed
+			require.Len(t, hooks.btcDelRewards, len(expectedRewards))
+			for _, btcDelReward := range hooks.btcDelRewards {
+				require.Equal(t, expectedRewards[btcDelReward.Address.String()], btcDelReward.Coins)
+			}
+		}
+
+		// the reward lockup of each BTC delegation is exposed
+		for _, fp := range dc.FinalityProviders {
+			for _, btcDel := range fp.BtcDels {
+				resp, err := keeper.BTCDelegationRewardLockup(ctx, &types.QueryBTCDelegationRewardLockupRequest{
+					StakingTxHashHex: btcDel.StakingTxHash,
+				})
+				require.NoError(t, err)
+				require.Equal(t, startEpoch, resp.RewardStartEpoch)
+				require.Equal(t, startEpoch+lockupEpochs, resp.RewardActiveEpoch)
+			}
+		}
+	})
+}
+
+func FuzzRewardBTCStakingLockupStartEpoch(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		epochingKeeper := types.NewMockEpochingKeeper(ctrl)
+
+		keeper, ctx := testkeeper.IncentiveKeeper(t, nil, nil, epochingKeeper)
+		hooks := &rewardsRecorderHooks{}
+		keeper.SetHooks(hooks)
+
+		dc, err := datagen.GenRandomVotingPowerDistCache(r, 10)
+		require.NoError(t, err)
+
+		// without reward lockup, no start epoch is recorded
+		epoch := datagen.RandomInt(r, 100) + 1
+		height := datagen.RandomInt(r, 1000) + 1
+		epochingKeeper.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: epoch}).Times(1)
+		keeper.SetBTCStakingGauge(ctx, height, datagen.GenRandomGauge(r))
+		keeper.RewardBTCStaking(ctx, height, dc)
+		rewarded := map[string]bool{}
+		for _, btcDelReward := range hooks.btcDelRewards {
+			rewarded[btcDelReward.Address.String()] = true
+		}
+		for _, fp := range dc.FinalityProviders {
+			for _, btcDel := range fp.BtcDels {
+				stakingTxHash, err := chainhash.NewHashFromStr(btcDel.StakingTxHash)
+				require.NoError(t, err)
+				_, lockedUp, isRewarded := keeper.GetBTCDelRewardLockup(ctx, stakingTxHash)
+				require.False(t, lockedUp)
+				require.Equal(t, rewarded[btcDel.GetAddress().String()], isRewarded)
+			}
+		}
+
+		// once the reward lockup is enabled, the BTC delegations rewarded
+		// before are not locked up, while the others start their lockup
+		params := keeper.GetParams(ctx)
+		params.RewardLockupEpochs = datagen.RandomInt(r, 10) + 1
+		err = keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+		epoch++
+		height++
+		epochingKeeper.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: epoch}).Times(1)
+		gauge := datagen.GenRandomGauge(r)
+		keeper.SetBTCStakingGauge(ctx, height, gauge)
+		expectedRewards := map[string]sdk.Coins{}
+		for _, fp := range dc.FinalityProviders {
+			coinsForFpsAndDels := gauge.GetCoinsPortion(dc.GetFinalityProviderPortion(fp))
+			coinsForBTCDels := coinsForFpsAndDels.Sub(types.GetCoinsPortion(coinsForFpsAndDels, *fp.Commission)...)
+			for _, btcDel := range fp.BtcDels {
+				if !rewarded[btcDel.GetAddress().String()] {
+					continue
+				}
+				coinsForDel := types.GetCoinsPortion(coinsForBTCDels, fp.GetBTCDelPortion(btcDel))
+				if coinsForDel.IsAllPositive() {
+					expectedRewards[btcDel.GetAddress().String()] = coinsForDel
+				}
+			}
+		}
+		keeper.RewardBTCStaking(ctx, height, dc)
+		require.Len(t, hooks.btcDelRewards, len(expectedRewards))
+		for _, btcDelReward := range hooks.btcDelRewards {
+			require.Equal(t, expectedRewards[btcDelReward.Address.String()], btcDelReward.Coins)
+		}
+		for _, fp := range dc.FinalityProviders {
+			for _, btcDel := range fp.BtcDels {
+				stakingTxHash, err := chainhash.NewHashFromStr(btcDel.StakingTxHash)
+				require.NoError(t, err)
+				startEpoch, found := keeper.GetBTCDelRewardStartEpoch(ctx, stakingTxHash)
+				require.Equal(t, !rewarded[btcDel.GetAddress().String()], found)
+				if found {
+					require.Equal(t, epoch, startEpoch)
+				}
+				lockupStartEpoch, lockedUp, _ := keeper.GetBTCDelRewardLockup(ctx, stakingTxHash)
+				require.Equal(t, found, lockedUp)
+				require.Equal(t, startEpoch, lockupStartEpoch)
+
+				// the start epoch is removed when the BTC delegation is unbonded
+				keeper.DeleteBTCDelRewardStartEpoch(ctx, stakingTxHash)
+				_, found = keeper.GetBTCDelRewardStartEpoch(ctx, stakingTxHash)
+				require.False(t, found)
+			}
+		}
+	})
+}
+
+func FuzzRewardBTCStakingWithRewardOptOut(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		bankKeeper := types.NewMockBankKeeper(ctrl)
+		epochingKeeper := types.NewMockEpochingKeeper(ctrl)
+		epochingKeeper.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: 1}).AnyTimes()
+
+		keeper, ctx := testkeeper.IncentiveKeeper(t, bankKeeper, nil, epochingKeeper)
+		hooks := &rewardsRecorderHooks{}
+		keeper.SetHooks(hooks)
+		burnOptedOut := datagen.OneInN(r, 2)
+		params := keeper.GetParams(ctx)
+		params.BurnOptedOutRewards = burnOptedOut
+		err := keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+		height := datagen.RandomInt(r, 1000)
+		ctx = datagen.WithCtxHeight(ctx, height)
+
+		gauge := datagen.GenRandomGauge(r)
+		keeper.SetBTCStakingGauge(ctx, height, gauge)
+
+		// a random subset of BTC delegations opt out of rewards
+		dc, err := datagen.GenRandomVotingPowerDistCache(r, 10)
+		require.NoError(t, err)
+		// and all BTC delegations of the first finality provider opt out
+		for i, fp := range dc.FinalityProviders {
+			for _, btcDel := range fp.BtcDels {
+				btcDel.RewardOptOut = i == 0 || datagen.OneInN(r, 2)
+			}
+		}
+
+		// expected values
+		expectedFpRewards := map[string]sdk.Coins{}
+		expectedRewards := map[string]sdk.Coins{}
+		expectedBurned := sdk.NewCoins()
+		for _, fp := range dc.FinalityProviders {
+			coinsForFpsAndDels := gauge.GetCoinsPortion(dc.GetFinalityProviderPortion(fp))
+			coinsForCommission := types.GetCoinsPortion(coinsForFpsAndDels, *fp.Commission)
+			coinsForBTCDels := coinsForFpsAndDels.Sub(coinsForCommission...)
+			rewardedPower := uint64(0)
+			for _, btcDel := range fp.BtcDels {
+				if !btcDel.RewardOptOut {
+					rewardedPower += btcDel.VotingPower
+				}
+			}
+			coinsForFp := coinsForCommission
+			if !burnOptedOut && rewardedPower == 0 {
+				coinsForFp = coinsForFpsAndDels
+			}
+			if coinsForFp.IsAllPositive() {
+				expectedFpRewards[fp.GetAddress().String()] = coinsForFp
+			}
+			for _, btcDel := range fp.BtcDels {
+				if btcDel.RewardOptOut {
+					if burnOptedOut {
+						expectedBurned = expectedBurned.Add(types.GetCoinsPortion(coinsForBTCDels, fp.GetBTCDelPortion(btcDel))...)
+					}
+					continue
+				}
+				btcDelPortion := fp.GetBTCDelPortion(btcDel)
+				if !burnOptedOut {
+					btcDelPortion = sdkmath.LegacyNewDec(int64(btcDel.VotingPower)).QuoTruncate(sdkmath.LegacyNewDec(int64(rewardedPower)))
+				}
+				coinsForDel := types.GetCoinsPortion(coinsForBTCDels, btcDelPortion)
+				if coinsForDel.IsAllPositive() {
+					expectedRewards[btcDel.GetAddress().String()] = coinsForDel
+				}
+			}
+		}
+		if !expectedBurned.IsZero() {
+			bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, expectedBurned).Return(nil).Times(1)
+		}
+
+		keeper.RewardBTCStaking(ctx, height, dc)
+
+		// BTC delegations that opt out of rewards are never rewarded, and
+		// their rewards are either burned or redistributed
+		require.Len(t, hooks.btcDelRewards, len(expectedRewards))
+		for _, btcDelReward := range hooks.btcDelRewards {
+			require.Equal(t, expectedRewards[btcDelReward.Address.String()], btcDelReward.Coins)
+		}
+		// a finality provider whose BTC delegations all opt out receives
+		// their rewards if they are not burned
+		require.Len(t, hooks.fpRewards, len(expectedFpRewards))
+		for _, fpReward := range hooks.fpRewards {
+			require.Equal(t, expectedFpRewards[fpReward.Address.String()], fpReward.Coins)
+		}
 	})
 }
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	bbn "github.com/babylonchain/babylon/types"
+	"github.com/babylonchain/babylon/x/incentive/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// accumulateDelegatorValidatorRewards adds the given reward to the cumulative
+// rewards ever credited to the given BTC delegator for its BTC delegations
+// under the given finality provider. Like the lifetime rewards, they only
+// increase, since a withdrawal from the reward gauge of the delegator's
+// address cannot be attributed to a finality provider
+func (k Keeper) accumulateDelegatorValidatorRewards(ctx context.Context, delBTCPK *bbn.BIP340PubKey, fpBTCPK *bbn.BIP340PubKey, reward sdk.Coins) {
+	store := k.delValRewardsStore(ctx, delBTCPK)
+	dvr := &types.DelegatorValidatorRewards{FpBtcPk: fpBTCPK, Coins: sdk.NewCoins()}
+	if dvrBytes := store.Get(fpBTCPK.MustMarshal()); dvrBytes != nil {
+		k.cdc.MustUnmarshal(dvrBytes, dvr)
+	}
+	dvr.Coins = dvr.Coins.Add(reward...)
+	store.Set(fpBTCPK.MustMarshal(), k.cdc.MustMarshal(dvr))
+}
+
+// GetDelegatorRewardsByValidator returns the cumulative rewards ever credited
+// to the given BTC delegator under each finality provider, sorted by the
+// finality provider's BTC PK
+func (k Keeper) GetDelegatorRewardsByValidator(ctx context.Context, delBTCPK *bbn.BIP340PubKey) []*types.DelegatorValidatorRewards {
+	store := k.delValRewardsStore(ctx, delBTCPK)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	rewards := []*types.DelegatorValidatorRewards{}
+	for ; iter.Valid(); iter.Next() {
+		var dvr types.DelegatorValidatorRewards
+		k.cdc.MustUnmarshal(iter.Value(), &dvr)
+		rewards = append(rewards, &dvr)
+	}
+	return rewards
+}
+
+// PruneDelegatorValidatorRewards removes the cumulative rewards credited to
+// the given BTC delegator under the given finality provider. It is called
+// when the last BTC delegation of the pair is pruned from the BTC staking
+// module
+func (k Keeper) PruneDelegatorValidatorRewards(ctx context.Context, delBTCPK *bbn.BIP340PubKey, fpBTCPK *bbn.BIP340PubKey) {
+	k.delValRewardsStore(ctx, delBTCPK).Delete(fpBTCPK.MustMarshal())
+}
+
+// delValRewardsStore returns the KVStore of the cumulative rewards ever
+// credited to a BTC delegator under each finality provider. Entries are
+// removed when the last BTC delegation of the (BTC delegator, finality
+// provider) pair is pruned
+// prefix: DelValRewardsKey
+// key: (BTC delegator's BTC PK || finality provider's BTC PK)
+// value: DelegatorValidatorRewards
+func (k Keeper) delValRewardsStore(ctx context.Context, delBTCPK *bbn.BIP340PubKey) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	dvrStore := prefix.NewStore(storeAdapter, types.DelValRewardsKey)
+	return prefix.NewStore(dvrStore, delBTCPK.MustMarshal())
+}
@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/babylon/x/incentive/types"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -71,3 +73,170 @@ func (k Keeper) BTCTimestampingGauge(goCtx context.Context, req *types.QueryBTCT
 
 	return &types.QueryBTCTimestampingGaugeResponse{Gauge: gauge}, nil
 }
+
+func (k Keeper) BlockRewardDistribution(goCtx context.Context, req *types.QueryBlockRewardDistributionRequest) (*types.QueryBlockRewardDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// find distribution record
+	rewardDist := k.GetBlockRewardDistribution(ctx, req.Height)
+	if rewardDist == nil {
+		return nil, types.ErrBlockRewardDistNotFound
+	}
+
+	return &types.QueryBlockRewardDistributionResponse{Distribution: rewardDist}, nil
+}
+
+func (k Keeper) BTCDelegationRewardLockup(goCtx context.Context, req *types.QueryBTCDelegationRewardLockupRequest) (*types.QueryBTCDelegationRewardLockupResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	stakingTxHash, err := chainhash.NewHashFromStr(req.StakingTxHashHex)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	startEpoch, found := k.GetBTCDelRewardStartEpoch(ctx, stakingTxHash)
+	if !found {
+		return nil, types.ErrBTCDelRewardStartNotFound
+	}
+
+	return &types.QueryBTCDelegationRewardLockupResponse{
+		RewardStartEpoch:  startEpoch,
+		RewardActiveEpoch: startEpoch + k.GetParams(ctx).RewardLockupEpochs,
+	}, nil
+}
+
+func (k Keeper) LifetimeRewards(goCtx context.Context, req *types.QueryLifetimeRewardsRequest) (*types.QueryLifetimeRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// try to cast address
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	sType, err := types.NewStakeHolderTypeFromString(req.StakeholderType)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid stakeholder type %q: %v", req.StakeholderType, err)
+	}
+
+	// a stakeholder that has never been credited has no lifetime rewards
+	lr := k.GetLifetimeRewards(ctx, sType, address)
+	if lr == nil {
+		lr = &types.LifetimeRewards{Coins: sdk.NewCoins()}
+	}
+
+	return &types.QueryLifetimeRewardsResponse{LifetimeRewards: lr}, nil
+}
+
+// RewardGaugeDenoms returns the denoms present in the reward gauges of the
+// given stakeholder address across all stakeholder types
+func (k Keeper) RewardGaugeDenoms(goCtx context.Context, req *types.QueryRewardGaugeDenomsRequest) (*types.QueryRewardGaugeDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// try to cast address
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// sum up the coins in the reward gauges, so that each denom appears once
+	// and the denoms are sorted
+	found := false
+	coins, withdrawableCoins := sdk.NewCoins(), sdk.NewCoins()
+	for _, sType := range types.GetAllStakeholderTypes() {
+		rg := k.GetRewardGauge(ctx, sType, address)
+		if rg == nil {
+			continue
+		}
+		found = true
+		coins = coins.Add(rg.Coins...)
+		withdrawableCoins = withdrawableCoins.Add(rg.GetWithdrawableCoins()...)
+	}
+
+	// return error if no reward gauge is found
+	if !found {
+		return nil, types.ErrRewardGaugeNotFound
+	}
+
+	return &types.QueryRewardGaugeDenomsResponse{
+		Denoms:             coins.Denoms(),
+		WithdrawableDenoms: withdrawableCoins.Denoms(),
+	}, nil
+}
+
+// DelegatorRewardsByValidator returns the cumulative rewards ever credited to
+// the given BTC delegator, broken down by the finality provider that each of
+// its BTC delegations is under
+func (k Keeper) DelegatorRewardsByValidator(goCtx context.Context, req *types.QueryDelegatorRewardsByValidatorRequest) (*types.QueryDelegatorRewardsByValidatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delBTCPK, err := bbn.NewBIP340PubKeyFromHex(req.DelBtcPkHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid BTC PK of the BTC delegator: %v", err)
+	}
+
+	rewards := k.GetDelegatorRewardsByValidator(ctx, delBTCPK)
+	total := sdk.NewCoins()
+	for _, dvr := range rewards {
+		total = total.Add(dvr.Coins...)
+	}
+
+	return &types.QueryDelegatorRewardsByValidatorResponse{
+		Rewards: rewards,
+		Total:   total,
+	}, nil
+}
+
+// RewardPoolBalance returns the balances of the fee collector account and the
+// incentive module account, in which rewards are pooled before being
+// distributed, along with the portion of the fee collector's balance that
+// will be intercepted at the next block
+func (k Keeper) RewardPoolBalance(goCtx context.Context, req *types.QueryRewardPoolBalanceRequest) (*types.QueryRewardPoolBalanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	feeCollector := k.accountKeeper.GetModuleAccount(ctx, k.feeCollectorName)
+	feeCollectorBalance := k.bankKeeper.GetAllBalances(ctx, feeCollector.GetAddress())
+	toBeIntercepted := sdk.NewCoins()
+	if feeCollectorBalance.IsAllPositive() {
+		btcStakingReward, btcTimestampingReward := getInterceptedCoins(k.GetParams(ctx), feeCollectorBalance)
+		toBeIntercepted = btcStakingReward.Add(btcTimestampingReward...)
+	}
+	moduleAcc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	moduleBalance := k.bankKeeper.GetAllBalances(ctx, moduleAcc.GetAddress())
+
+	// Coins are sorted by denom, and so are their union's denoms
+	allCoins := feeCollectorBalance.Add(moduleBalance...)
+	denoms := make([]*types.RewardPoolDenomBalance, 0, len(allCoins))
+	for _, coin := range allCoins {
+		denoms = append(denoms, &types.RewardPoolDenomBalance{
+			Denom:                 coin.Denom,
+			FeeCollectorAmount:    feeCollectorBalance.AmountOf(coin.Denom),
+			ToBeInterceptedAmount: toBeIntercepted.AmountOf(coin.Denom),
+			ModuleAmount:          moduleBalance.AmountOf(coin.Denom),
+		})
+	}
+
+	return &types.QueryRewardPoolBalanceResponse{
+		FeeCollectorBalance: feeCollectorBalance,
+		ToBeIntercepted:     toBeIntercepted,
+		ModuleBalance:       moduleBalance,
+		Denoms:              denoms,
+	}, nil
+}
@@ -1,13 +1,20 @@
 package keeper_test
 
 import (
+	"bytes"
 	"math/rand"
 	"testing"
 
 	"github.com/babylonchain/babylon/testutil/datagen"
 	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
+	"github.com/babylonchain/babylon/x/incentive/keeper"
 	"github.com/babylonchain/babylon/x/incentive/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -51,6 +58,47 @@ func FuzzRewardGaugesQuery(f *testing.F) {
 	})
 }
 
+func FuzzRewardGaugeDenomsQuery(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		keeper, ctx := testkeeper.IncentiveKeeper(t, nil, nil, nil)
+
+		// a stakeholder with reward gauges in random stakeholder types, each
+		// holding coins in multiple denoms, some of which are fully withdrawn
+		sAddr := datagen.GenRandomAccount().GetAddress()
+		coins, withdrawableCoins := sdk.NewCoins(), sdk.NewCoins()
+		for i := uint64(0); i <= datagen.RandomInt(r, 4); i++ {
+			sType := datagen.GenRandomStakeholderType(r)
+			rg := datagen.GenRandomRewardGauge(r)
+			if datagen.OneInN(r, 2) {
+				rg.SetFullyWithdrawn()
+			}
+			keeper.SetRewardGauge(ctx, sType, sAddr, rg)
+		}
+		for _, sType := range types.GetAllStakeholderTypes() {
+			if rg := keeper.GetRewardGauge(ctx, sType, sAddr); rg != nil {
+				coins = coins.Add(rg.Coins...)
+				withdrawableCoins = withdrawableCoins.Add(rg.GetWithdrawableCoins()...)
+			}
+		}
+
+		resp, err := keeper.RewardGaugeDenoms(ctx, &types.QueryRewardGaugeDenomsRequest{
+			Address: sAddr.String(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, coins.Denoms(), resp.Denoms)
+		require.Equal(t, withdrawableCoins.Denoms(), resp.WithdrawableDenoms)
+
+		// a stakeholder without reward gauges
+		_, err = keeper.RewardGaugeDenoms(ctx, &types.QueryRewardGaugeDenomsRequest{
+			Address: datagen.GenRandomAccount().GetAddress().String(),
+		})
+		require.ErrorIs(t, err, types.ErrRewardGaugeNotFound)
+	})
+}
+
 func FuzzBTCStakingGaugeQuery(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 	f.Fuzz(func(t *testing.T, seed int64) {
@@ -123,3 +171,218 @@ func FuzzBTCTimestampingGaugeQuery(f *testing.F) {
 		}
 	})
 }
+
+func FuzzLifetimeRewardsQuery(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock bank keeper
+		bk := types.NewMockBankKeeper(ctrl)
+
+		ik, ctx := testkeeper.IncentiveKeeper(t, bk, nil, nil)
+		ms := keeper.NewMsgServerImpl(*ik)
+
+		// distribute a random BTC timestamping gauge to a single submission
+		epoch := datagen.RandomInt(r, 1000) + 1
+		ik.SetBTCTimestampingGauge(ctx, epoch, datagen.GenRandomGauge(r))
+		rdi := datagen.GenRandomBTCTimestampingRewardDistInfo(r)
+		rdi.Others = nil
+		ik.RewardBTCTimestamping(ctx, epoch, rdi)
+		sAddr := rdi.Best.Submitter
+
+		// lifetime rewards are consistent with the reward gauge
+		req := &types.QueryLifetimeRewardsRequest{
+			Address:         sAddr.String(),
+			StakeholderType: types.SubmitterType.String(),
+		}
+		resp, err := ik.LifetimeRewards(ctx, req)
+		require.NoError(t, err)
+		rg := ik.GetRewardGauge(ctx, types.SubmitterType, sAddr)
+		require.NotNil(t, rg)
+		require.Equal(t, rg.Coins, resp.LifetimeRewards.Coins)
+		lifetimeRewards := resp.LifetimeRewards.Coins
+
+		// lifetime rewards are unchanged after withdrawing rewards
+		bk.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Eq(types.ModuleName), gomock.Eq(sAddr), gomock.Any()).AnyTimes()
+		_, err = ms.WithdrawReward(ctx, &types.MsgWithdrawReward{
+			Type:    types.SubmitterType.String(),
+			Address: sAddr.String(),
+		})
+		require.NoError(t, err)
+		resp, err = ik.LifetimeRewards(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, lifetimeRewards, resp.LifetimeRewards.Coins)
+
+		// lifetime rewards keep accumulating rewards after withdrawal
+		ik.SetBTCTimestampingGauge(ctx, epoch+1, datagen.GenRandomGauge(r))
+		ik.RewardBTCTimestamping(ctx, epoch+1, rdi)
+		rg = ik.GetRewardGauge(ctx, types.SubmitterType, sAddr)
+		resp, err = ik.LifetimeRewards(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, rg.Coins, resp.LifetimeRewards.Coins)
+		require.True(t, resp.LifetimeRewards.Coins.IsAllGTE(lifetimeRewards))
+
+		// a stakeholder that has never been credited has no lifetime rewards
+		resp, err = ik.LifetimeRewards(ctx, &types.QueryLifetimeRewardsRequest{
+			Address:         datagen.GenRandomAccount().GetAddress().String(),
+			StakeholderType: types.ReporterType.String(),
+		})
+		require.NoError(t, err)
+		require.True(t, resp.LifetimeRewards.Coins.IsZero())
+
+		// invalid stakeholder type
+		req.StakeholderType = "invalid"
+		_, err = ik.LifetimeRewards(ctx, req)
+		require.Error(t, err)
+	})
+}
+
+func FuzzDelegatorRewardsByValidatorQuery(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock epoching keeper
+		epochingKeeper := types.NewMockEpochingKeeper(ctrl)
+		epochingKeeper.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: 1}).AnyTimes()
+
+		ik, ctx := testkeeper.IncentiveKeeper(t, nil, nil, epochingKeeper)
+
+		// a BTC delegator that has a BTC delegation under each of a random
+		// number of finality providers
+		delBTCPK, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		delBabylonPK := datagen.GenRandomAccount().GetPubKey().(*secp256k1.PubKey)
+		dc := bstypes.NewVotingPowerDistCache()
+		numFps := datagen.RandomInt(r, 5) + 1
+		for i := uint64(0); i < numFps; i++ {
+			fp, err := datagen.GenRandomFinalityProviderDistInfo(r)
+			require.NoError(t, err)
+			btcDel, err := datagen.GenRandomBTCDelDistInfo(r)
+			require.NoError(t, err)
+			btcDel.BtcPk = delBTCPK
+			btcDel.BabylonPk = delBabylonPK
+			fp.BtcDels = append(fp.BtcDels, btcDel)
+			fp.TotalVotingPower += btcDel.VotingPower
+			dc.AddFinalityProviderDistInfo(fp)
+		}
+		dc.ApplyActiveFinalityProviders(uint32(numFps))
+
+		// distribute rewards at a random number of heights, and record the
+		// rewards the BTC delegator is expected to receive under each
+		// finality provider
+		expectedRewards := map[string]sdk.Coins{} // key: FP BTC PK hex, value: reward
+		numHeights := datagen.RandomInt(r, 3) + 1
+		for height := uint64(1); height <= numHeights; height++ {
+			gauge := datagen.GenRandomGauge(r)
+			ik.SetBTCStakingGauge(ctx, height, gauge)
+			for _, fp := range dc.FinalityProviders {
+				coinsForFpsAndDels := gauge.GetCoinsPortion(dc.GetFinalityProviderPortion(fp))
+				coinsForBTCDels := coinsForFpsAndDels.Sub(types.GetCoinsPortion(coinsForFpsAndDels, *fp.Commission)...)
+				btcDel := fp.BtcDels[len(fp.BtcDels)-1]
+				coinsForDel := types.GetCoinsPortion(coinsForBTCDels, fp.GetBTCDelPortion(btcDel))
+				if coinsForDel.IsAllPositive() {
+					expectedRewards[fp.BtcPk.MarshalHex()] = expectedRewards[fp.BtcPk.MarshalHex()].Add(coinsForDel...)
+				}
+			}
+			ik.RewardBTCStaking(ctx, height, dc)
+		}
+
+		// the rewards are broken down by the finality provider, and sum up to
+		// the rewards credited to the BTC delegator's reward gauge
+		resp, err := ik.DelegatorRewardsByValidator(ctx, &types.QueryDelegatorRewardsByValidatorRequest{
+			DelBtcPkHex: delBTCPK.MarshalHex(),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Rewards, len(expectedRewards))
+		for i, dvr := range resp.Rewards {
+			require.True(t, expectedRewards[dvr.FpBtcPk.MarshalHex()].Equal(dvr.Coins))
+			if i > 0 {
+				require.Negative(t, bytes.Compare(resp.Rewards[i-1].FpBtcPk.MustMarshal(), dvr.FpBtcPk.MustMarshal()))
+			}
+		}
+		rg := ik.GetRewardGauge(ctx, types.BTCDelegationType, sdk.AccAddress(delBabylonPK.Address()))
+		if len(expectedRewards) > 0 {
+			require.NotNil(t, rg)
+			require.True(t, rg.Coins.Equal(resp.Total))
+		} else {
+			require.True(t, resp.Total.IsZero())
+		}
+
+		// a BTC delegator that has never been credited has no rewards
+		otherBTCPK, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		resp, err = ik.DelegatorRewardsByValidator(ctx, &types.QueryDelegatorRewardsByValidatorRequest{
+			DelBtcPkHex: otherBTCPK.MarshalHex(),
+		})
+		require.NoError(t, err)
+		require.Empty(t, resp.Rewards)
+		require.True(t, resp.Total.IsZero())
+
+		// invalid BTC PK
+		_, err = ik.DelegatorRewardsByValidator(ctx, &types.QueryDelegatorRewardsByValidatorRequest{
+			DelBtcPkHex: "invalid",
+		})
+		require.Error(t, err)
+	})
+}
+
+func FuzzRewardPoolBalanceQuery(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock the balances of the fee collector and the incentive module
+		// accounts
+		feeCollectorBalance := datagen.GenRandomCoins(r)
+		moduleAcc := authtypes.NewEmptyModuleAccount(types.ModuleName)
+		moduleBalance := datagen.GenRandomCoins(r)
+		bankKeeper := types.NewMockBankKeeper(ctrl)
+		bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(feeCollectorBalance).AnyTimes()
+		bankKeeper.EXPECT().GetAllBalances(gomock.Any(), moduleAcc.GetAddress()).Return(moduleBalance).AnyTimes()
+		accountKeeper := types.NewMockAccountKeeper(ctrl)
+		accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), authtypes.FeeCollectorName).Return(feeCollectorAcc).AnyTimes()
+		accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(moduleAcc).AnyTimes()
+
+		ik, ctx := testkeeper.IncentiveKeeper(t, bankKeeper, accountKeeper, nil)
+		params := ik.GetParams(ctx)
+		params.RewardsPaused = datagen.OneInN(r, 2)
+		err := ik.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		resp, err := ik.RewardPoolBalance(ctx, &types.QueryRewardPoolBalanceRequest{})
+		require.NoError(t, err)
+		require.Equal(t, feeCollectorBalance, resp.FeeCollectorBalance)
+		require.Equal(t, moduleBalance, resp.ModuleBalance)
+
+		// the portions intercepted at the next block, where the BTC staking
+		// portion is not intercepted while rewards are paused
+		expectedToBeIntercepted := types.GetCoinsPortion(feeCollectorBalance, params.BTCTimestampingPortion())
+		if !params.RewardsPaused {
+			expectedToBeIntercepted = expectedToBeIntercepted.Add(types.GetCoinsPortion(feeCollectorBalance, params.BTCStakingPortion())...)
+		}
+		require.True(t, expectedToBeIntercepted.Equal(resp.ToBeIntercepted))
+		require.True(t, resp.ToBeIntercepted.IsAllLTE(resp.FeeCollectorBalance))
+
+		// the breakdown by denom covers every denom of both accounts in order
+		allCoins := feeCollectorBalance.Add(moduleBalance...)
+		require.Len(t, resp.Denoms, len(allCoins))
+		for i, denomBalance := range resp.Denoms {
+			require.Equal(t, allCoins[i].Denom, denomBalance.Denom)
+			require.Equal(t, feeCollectorBalance.AmountOf(denomBalance.Denom), denomBalance.FeeCollectorAmount)
+			require.Equal(t, resp.ToBeIntercepted.AmountOf(denomBalance.Denom), denomBalance.ToBeInterceptedAmount)
+			require.Equal(t, moduleBalance.AmountOf(denomBalance.Denom), denomBalance.ModuleAmount)
+			require.True(t, allCoins[i].Amount.Equal(denomBalance.FeeCollectorAmount.Add(denomBalance.ModuleAmount)))
+		}
+	})
+}
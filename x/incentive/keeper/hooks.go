@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/babylonchain/babylon/x/incentive/types"
+)
+
+// Implements IncentiveHooks interface
+var _ types.IncentiveHooks = Keeper{}
+
+// AfterRewardsDistributed - call hook if rewards of a finalized block are distributed
+func (k Keeper) AfterRewardsDistributed(
+	ctx context.Context,
+	height uint64,
+	fpRewards []*types.StakeholderReward,
+	btcDelRewards []*types.StakeholderReward,
+	finalizingFps []*bstypes.FinalityProviderDistInfo,
+) error {
+	if k.hooks != nil {
+		return k.hooks.AfterRewardsDistributed(ctx, height, fpRewards, btcDelRewards, finalizingFps)
+	}
+	return nil
+}
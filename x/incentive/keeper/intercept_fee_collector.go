@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+
 	"github.com/babylonchain/babylon/x/incentive/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // HandleCoinsInFeeCollector intercepts a portion of coins in fee collector, and distributes
@@ -23,18 +25,29 @@ func (k Keeper) HandleCoinsInFeeCollector(ctx context.Context) {
 		return
 	}
 
+	btcStakingReward, btcTimestampingReward := getInterceptedCoins(params, feesCollectedInt)
+
 	// record BTC staking gauge for the current height, and transfer corresponding amount
 	// from fee collector account to incentive module account
 	// TODO: maybe we should not transfer reward to BTC staking gauge before BTC staking is activated
 	// this is tricky to implement since finality module will depend on incentive and incentive cannot
 	// depend on finality module due to cyclic dependency
-	btcStakingPortion := params.BTCStakingPortion()
-	btcStakingReward := types.GetCoinsPortion(feesCollectedInt, btcStakingPortion)
 	k.accumulateBTCStakingReward(ctx, btcStakingReward)
 
 	// record BTC timestamping gauge for the current epoch, and transfer corresponding amount
 	// from fee collector account to incentive module account
-	btcTimestampingPortion := params.BTCTimestampingPortion()
-	btcTimestampingReward := types.GetCoinsPortion(feesCollectedInt, btcTimestampingPortion)
 	k.accumulateBTCTimestampingReward(ctx, btcTimestampingReward)
 }
+
+// getInterceptedCoins returns the BTC staking and BTC timestamping portions of
+// the given coins in the fee collector account under the given parameters.
+// While rewards are paused, the BTC staking portion stays in the fee collector
+// account and an empty BTC staking gauge is recorded for the current height
+func getInterceptedCoins(params types.Params, feesCollected sdk.Coins) (sdk.Coins, sdk.Coins) {
+	btcStakingReward := sdk.NewCoins()
+	if !params.RewardsPaused {
+		btcStakingReward = types.GetCoinsPortion(feesCollected, params.BTCStakingPortion())
+	}
+	btcTimestampingReward := types.GetCoinsPortion(feesCollected, params.BTCTimestampingPortion())
+	return btcStakingReward, btcTimestampingReward
+}
@@ -89,3 +89,48 @@ func FuzzInterceptFeeCollector(f *testing.F) {
 		}
 	})
 }
+
+func FuzzInterceptFeeCollectorWhenRewardsPaused(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock bank keeper
+		bankKeeper := types.NewMockBankKeeper(ctrl)
+		bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees).Times(1)
+
+		// mock account keeper
+		accountKeeper := types.NewMockAccountKeeper(ctrl)
+		accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), authtypes.FeeCollectorName).Return(feeCollectorAcc).Times(1)
+
+		// mock epoching keeper
+		epochNum := datagen.RandomInt(r, 100) + 1
+		epochingKeeper := types.NewMockEpochingKeeper(ctrl)
+		epochingKeeper.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: epochNum}).Times(1)
+
+		keeper, ctx := testkeeper.IncentiveKeeper(t, bankKeeper, accountKeeper, epochingKeeper)
+		height := datagen.RandomInt(r, 1000)
+		ctx = datagen.WithCtxHeight(ctx, height)
+
+		// pause rewards
+		params := keeper.GetParams(ctx)
+		params.RewardsPaused = true
+		err := keeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		// mock (thus ensure) that only fees for BTC timestamping are intercepted
+		feesForBTCTimestamping := types.GetCoinsPortion(fees, params.BTCTimestampingPortion())
+		bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), gomock.Eq(authtypes.FeeCollectorName), gomock.Eq(types.ModuleName), gomock.Eq(feesForBTCTimestamping)).Times(1)
+
+		// handle coins in fee collector
+		keeper.HandleCoinsInFeeCollector(ctx)
+
+		// BTC staking gauge at height is empty
+		btcStakingGauge := keeper.GetBTCStakingGauge(ctx, height)
+		require.NotNil(t, btcStakingGauge)
+		require.True(t, btcStakingGauge.Coins.IsZero())
+	})
+}
@@ -18,6 +18,7 @@ type (
 		epochingKeeper types.EpochingKeeper
 		bankKeeper     types.BankKeeper
 		accountKeeper  types.AccountKeeper
+		hooks          types.IncentiveHooks
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
 		authority string
@@ -41,6 +42,7 @@ func NewKeeper(
 		epochingKeeper:   epochingKeeper,
 		bankKeeper:       bankKeeper,
 		accountKeeper:    accountKeeper,
+		hooks:            nil,
 		authority:        authority,
 		feeCollectorName: feeCollectorName,
 	}
@@ -49,3 +51,14 @@ func NewKeeper(
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
+
+// SetHooks sets the incentive hooks
+func (k *Keeper) SetHooks(ih types.IncentiveHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set incentive hooks twice")
+	}
+
+	k.hooks = ih
+
+	return k
+}
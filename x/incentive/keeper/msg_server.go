@@ -33,10 +33,23 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	wasPaused := ms.GetParams(ctx).RewardsPaused
 	if err := ms.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
 
+	// notify about pausing/unpausing rewards
+	height := uint64(ctx.HeaderInfo().Height)
+	if !wasPaused && req.Params.RewardsPaused {
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventRewardsPaused{Height: height}); err != nil {
+			return nil, err
+		}
+	} else if wasPaused && !req.Params.RewardsPaused {
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventRewardsUnpaused{Height: height}); err != nil {
+			return nil, err
+		}
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
@@ -53,13 +66,31 @@ func (ms msgServer) WithdrawReward(goCtx context.Context, req *types.MsgWithdraw
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	// the reward goes to the stakeholder address unless the stakeholder,
+	// being the signer of this msg, designates another destination address
+	destAddr := addr
+	if req.DestinationAddress != "" {
+		destAddr, err = sdk.AccAddressFromBech32(req.DestinationAddress)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid destination address: %v", err)
+		}
+	}
 
-	// withdraw reward, i.e., send withdrawable reward to the stakeholder address and clear the reward gauge
-	withdrawnCoins, err := ms.withdrawReward(ctx, sType, addr)
+	// withdraw reward, i.e., send withdrawable reward to the destination address and clear the reward gauge
+	withdrawnCoins, err := ms.withdrawReward(ctx, sType, addr, destAddr)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRewardWithdrawn{
+		Type:               sType.String(),
+		Address:            addr.String(),
+		DestinationAddress: destAddr.String(),
+		Coins:              withdrawnCoins,
+	}); err != nil {
+		return nil, err
+	}
+
 	// all good
 	return &types.MsgWithdrawRewardResponse{
 		Coins: withdrawnCoins,
@@ -9,6 +9,11 @@ import (
 	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
 	"github.com/babylonchain/babylon/x/incentive/keeper"
 	"github.com/babylonchain/babylon/x/incentive/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -63,3 +68,101 @@ func FuzzWithdrawReward(f *testing.F) {
 		require.True(t, newRg.IsFullyWithdrawn())
 	})
 }
+
+func FuzzWithdrawRewardToDestination(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock bank keeper
+		bk := types.NewMockBankKeeper(ctrl)
+
+		ik, ctx := testkeeper.IncentiveKeeper(t, bk, nil, nil)
+		ms := keeper.NewMsgServerImpl(*ik)
+
+		// generate and set a random reward gauge with a random set of withdrawable coins
+		rg := datagen.GenRandomRewardGauge(r)
+		rg.WithdrawnCoins = datagen.GenRandomWithdrawnCoins(r, rg.Coins)
+		sType := datagen.GenRandomStakeholderType(r)
+		sAddr := datagen.GenRandomAccount().GetAddress()
+		destAddr := datagen.GenRandomAccount().GetAddress()
+		ik.SetRewardGauge(ctx, sType, sAddr, rg)
+
+		// invalid destination address
+		_, err := ms.WithdrawReward(ctx, &types.MsgWithdrawReward{
+			Type:               sType.String(),
+			Address:            sAddr.String(),
+			DestinationAddress: "invalid",
+		})
+		require.Error(t, err)
+
+		// mock transfer of withdrawable coins to the destination address rather
+		// than the stakeholder address
+		withdrawableCoins := rg.GetWithdrawableCoins()
+		bk.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Eq(types.ModuleName), gomock.Eq(destAddr), gomock.Eq(withdrawableCoins)).Times(1)
+
+		// invoke withdraw and assert consistency
+		resp, err := ms.WithdrawReward(ctx, &types.MsgWithdrawReward{
+			Type:               sType.String(),
+			Address:            sAddr.String(),
+			DestinationAddress: destAddr.String(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, withdrawableCoins, resp.Coins)
+
+		// ensure reward gauge of the stakeholder is now empty
+		newRg := ik.GetRewardGauge(ctx, sType, sAddr)
+		require.NotNil(t, newRg)
+		require.True(t, newRg.IsFullyWithdrawn())
+
+		// ensure the withdrawal is emitted as an event with both addresses
+		var withdrawnEvent *types.EventRewardWithdrawn
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != proto.MessageName(&types.EventRewardWithdrawn{}) {
+				continue
+			}
+			typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(t, err)
+			withdrawnEvent = typedEvent.(*types.EventRewardWithdrawn)
+		}
+		require.NotNil(t, withdrawnEvent)
+		require.Equal(t, sAddr.String(), withdrawnEvent.Address)
+		require.Equal(t, destAddr.String(), withdrawnEvent.DestinationAddress)
+		require.Equal(t, withdrawableCoins, withdrawnEvent.Coins)
+	})
+}
+
+func TestUpdateParamsPauseRewards(t *testing.T) {
+	ik, ctx := testkeeper.IncentiveKeeper(t, nil, nil, nil)
+	ms := keeper.NewMsgServerImpl(*ik)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// updateRewardsPaused updates the rewards_paused param and returns the
+	// names of the emitted pause/unpause events
+	updateRewardsPaused := func(paused bool) []string {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		params := ik.GetParams(ctx)
+		params.RewardsPaused = paused
+		_, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+		require.NoError(t, err)
+		require.Equal(t, paused, ik.GetParams(ctx).RewardsPaused)
+
+		eventTypes := []string{}
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == proto.MessageName(&types.EventRewardsPaused{}) ||
+				event.Type == proto.MessageName(&types.EventRewardsUnpaused{}) {
+				eventTypes = append(eventTypes, event.Type)
+			}
+		}
+		return eventTypes
+	}
+
+	require.Equal(t, []string{proto.MessageName(&types.EventRewardsPaused{})}, updateRewardsPaused(true))
+	// pausing paused rewards does not emit events
+	require.Empty(t, updateRewardsPaused(true))
+	require.Equal(t, []string{proto.MessageName(&types.EventRewardsUnpaused{})}, updateRewardsPaused(false))
+	require.Empty(t, updateRewardsPaused(false))
+}
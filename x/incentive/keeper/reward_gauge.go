@@ -2,13 +2,17 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+
 	"cosmossdk.io/store/prefix"
 	"github.com/babylonchain/babylon/x/incentive/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-func (k Keeper) withdrawReward(ctx context.Context, sType types.StakeholderType, addr sdk.AccAddress) (sdk.Coins, error) {
+// withdrawReward sends the withdrawable reward of the given stakeholder to the
+// given destination address, and clears the stakeholder's reward gauge
+func (k Keeper) withdrawReward(ctx context.Context, sType types.StakeholderType, addr sdk.AccAddress, destAddr sdk.AccAddress) (sdk.Coins, error) {
 	// retrieve reward gauge of the given stakeholder
 	rg := k.GetRewardGauge(ctx, sType, addr)
 	if rg == nil {
@@ -19,8 +23,8 @@ func (k Keeper) withdrawReward(ctx context.Context, sType types.StakeholderType,
 	if !withdrawableCoins.IsAllPositive() {
 		return nil, types.ErrNoWithdrawableCoins
 	}
-	// transfer withdrawable coins from incentive module account to the stakeholder's address
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, withdrawableCoins); err != nil {
+	// transfer withdrawable coins from incentive module account to the destination address
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, destAddr, withdrawableCoins); err != nil {
 		return nil, err
 	}
 	// empty reward gauge
@@ -30,11 +34,12 @@ func (k Keeper) withdrawReward(ctx context.Context, sType types.StakeholderType,
 	return withdrawableCoins, nil
 }
 
-// accumulateRewardGauge accumulates the given reward of of a given stakeholder in a given type
-func (k Keeper) accumulateRewardGauge(ctx context.Context, sType types.StakeholderType, addr sdk.AccAddress, reward sdk.Coins) {
+// accumulateRewardGauge accumulates the given reward of of a given stakeholder in a given type,
+// and returns whether the reward gauge is credited or not
+func (k Keeper) accumulateRewardGauge(ctx context.Context, sType types.StakeholderType, addr sdk.AccAddress, reward sdk.Coins) bool {
 	// if reward contains nothing, do nothing
 	if !reward.IsAllPositive() {
-		return
+		return false
 	}
 	// get reward gauge, or create a new one if it does not exist
 	rg := k.GetRewardGauge(ctx, sType, addr)
@@ -45,6 +50,46 @@ func (k Keeper) accumulateRewardGauge(ctx context.Context, sType types.Stakehold
 	rg.Add(reward)
 	// set back
 	k.SetRewardGauge(ctx, sType, addr, rg)
+	// record the given reward in the lifetime rewards
+	k.accumulateLifetimeRewards(ctx, sType, addr, reward)
+	// notify indexers of the update, so that they do not need to poll
+	event := &types.EventRewardGaugeUpdated{
+		Type:     sType.String(),
+		Address:  addr.String(),
+		NewTotal: rg.Coins,
+		Delta:    reward,
+	}
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(event); err != nil {
+		panic(fmt.Errorf("failed to emit EventRewardGaugeUpdated: %w", err))
+	}
+	return true
+}
+
+// accumulateLifetimeRewards adds the given reward to the cumulative rewards
+// ever credited to a given stakeholder in a given type. Unlike the reward
+// gauge, the lifetime rewards only increase
+func (k Keeper) accumulateLifetimeRewards(ctx context.Context, sType types.StakeholderType, addr sdk.AccAddress, reward sdk.Coins) {
+	lr := k.GetLifetimeRewards(ctx, sType, addr)
+	if lr == nil {
+		lr = &types.LifetimeRewards{Coins: sdk.NewCoins()}
+	}
+	lr.Coins = lr.Coins.Add(reward...)
+	store := k.lifetimeRewardsStore(ctx, sType)
+	store.Set(addr.Bytes(), k.cdc.MustMarshal(lr))
+}
+
+// GetLifetimeRewards returns the cumulative rewards ever credited to a given
+// stakeholder in a given type, or nil if it has never been credited
+func (k Keeper) GetLifetimeRewards(ctx context.Context, sType types.StakeholderType, addr sdk.AccAddress) *types.LifetimeRewards {
+	store := k.lifetimeRewardsStore(ctx, sType)
+	lrBytes := store.Get(addr.Bytes())
+	if lrBytes == nil {
+		return nil
+	}
+
+	var lr types.LifetimeRewards
+	k.cdc.MustUnmarshal(lrBytes, &lr)
+	return &lr
 }
 
 func (k Keeper) SetRewardGauge(ctx context.Context, sType types.StakeholderType, addr sdk.AccAddress, rg *types.RewardGauge) {
@@ -65,6 +110,53 @@ func (k Keeper) GetRewardGauge(ctx context.Context, sType types.StakeholderType,
 	return &rg
 }
 
+// recordBTCDelRewardWatermark records the total coins in the reward gauge of
+// the given staker right after the given BTC delegation of it is rewarded.
+// Since withdrawals always empty the reward gauge, the BTC delegation's
+// rewards are fully withdrawn once the staker has withdrawn this many coins
+func (k Keeper) recordBTCDelRewardWatermark(ctx context.Context, stakingTxHash string, stakerAddr sdk.AccAddress) {
+	rg := k.GetRewardGauge(ctx, types.BTCDelegationType, stakerAddr)
+	if rg == nil {
+		return
+	}
+	k.btcDelRewardWatermarkStore(ctx).Set([]byte(stakingTxHash), k.cdc.MustMarshal(types.NewRewardGauge(rg.Coins...)))
+}
+
+// IsBTCDelegationRewardFullyWithdrawn returns whether the staker with the
+// given address has withdrawn all the rewards of its BTC delegation with the
+// given staking tx hash, regardless of the rewards of its other BTC
+// delegations. A BTC delegation rewarded before the watermark was recorded
+// falls back to whether the staker has nothing left to withdraw at all
+func (k Keeper) IsBTCDelegationRewardFullyWithdrawn(ctx context.Context, stakerAddr sdk.AccAddress, stakingTxHash string) bool {
+	rg := k.GetRewardGauge(ctx, types.BTCDelegationType, stakerAddr)
+	if rg == nil {
+		// the staker has never received any reward
+		return true
+	}
+	watermarkBytes := k.btcDelRewardWatermarkStore(ctx).Get([]byte(stakingTxHash))
+	if watermarkBytes == nil {
+		return rg.IsFullyWithdrawn()
+	}
+	var watermark types.RewardGauge
+	k.cdc.MustUnmarshal(watermarkBytes, &watermark)
+	return rg.WithdrawnCoins.IsAllGTE(watermark.Coins)
+}
+
+// IsBTCDelegationRewarded returns whether the BTC delegation with the given
+// staking tx hash has received a non-zero reward
+func (k Keeper) IsBTCDelegationRewarded(ctx context.Context, stakingTxHash string) bool {
+	return k.btcDelRewardWatermarkStore(ctx).Has([]byte(stakingTxHash))
+}
+
+// PruneBTCDelegationRewardRecords removes the reward records kept for the
+// BTC delegation with the given staking tx hash, i.e., the epoch in which it
+// first received rewards and its reward watermark. It is called when the BTC
+// delegation is pruned from the BTC staking module
+func (k Keeper) PruneBTCDelegationRewardRecords(ctx context.Context, stakingTxHash string) {
+	k.btcDelRewardStartStore(ctx).Delete(mustParseStakingTxHash(stakingTxHash)[:])
+	k.btcDelRewardWatermarkStore(ctx).Delete([]byte(stakingTxHash))
+}
+
 // rewardGaugeStore returns the KVStore of the reward gauge of a stakeholder
 // of a given type {submitter, reporter, finality provider, BTC delegation}
 // prefix: RewardGaugeKey
@@ -75,3 +167,25 @@ func (k Keeper) rewardGaugeStore(ctx context.Context, sType types.StakeholderTyp
 	rgStore := prefix.NewStore(storeAdaptor, types.RewardGaugeKey)
 	return prefix.NewStore(rgStore, sType.Bytes())
 }
+
+// btcDelRewardWatermarkStore returns the KVStore of the total coins in the
+// reward gauge of each BTC delegation's staker right after the BTC delegation
+// was last rewarded. Entries are removed when the BTC delegation is pruned
+// prefix: BTCDelRewardWatermarkKey
+// key: staking tx hash of the BTC delegation in hex
+// value: reward gauge whose coins are the watermark
+func (k Keeper) btcDelRewardWatermarkStore(ctx context.Context) prefix.Store {
+	storeAdaptor := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdaptor, types.BTCDelRewardWatermarkKey)
+}
+
+// lifetimeRewardsStore returns the KVStore of the cumulative rewards ever
+// credited to a stakeholder of a given type
+// prefix: LifetimeRewardsKey
+// key: (stakeholder type || stakeholder address)
+// value: lifetime rewards
+func (k Keeper) lifetimeRewardsStore(ctx context.Context, sType types.StakeholderType) prefix.Store {
+	storeAdaptor := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	lrStore := prefix.NewStore(storeAdaptor, types.LifetimeRewardsKey)
+	return prefix.NewStore(lrStore, sType.Bytes())
+}
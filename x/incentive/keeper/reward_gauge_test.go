@@ -1,10 +1,18 @@
 package keeper_test
 
 import (
+	"math/rand"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+	"github.com/babylonchain/babylon/testutil/datagen"
+	testkeeper "github.com/babylonchain/babylon/testutil/keeper"
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
 	"github.com/babylonchain/babylon/x/incentive/types"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -18,3 +26,95 @@ func TestEmptyRewardGauge(t *testing.T) {
 	require.NotNil(t, rgBytes)         // the marshaled empty reward gauge is not nil
 	require.True(t, len(rgBytes) == 0) // the marshalled empty reward gauge has 0 bytes
 }
+
+func TestRewardGaugeMultipleDenoms(t *testing.T) {
+	// coins in different denoms are sorted regardless of the given order
+	rg := types.NewRewardGauge(sdk.NewInt64Coin("ubbn", 100), sdk.NewInt64Coin("uatom", 50))
+	require.NoError(t, rg.Coins.Validate())
+	require.Equal(t, []string{"uatom", "ubbn"}, rg.Coins.Denoms())
+	require.Equal(t, rg.Coins, rg.GetWithdrawableCoins())
+	require.False(t, rg.IsFullyWithdrawn())
+
+	// withdrawing all coins in only one denom leaves the other one withdrawable
+	rg.WithdrawnCoins = sdk.NewCoins(sdk.NewInt64Coin("uatom", 50))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ubbn", 100)), rg.GetWithdrawableCoins())
+	require.False(t, rg.IsFullyWithdrawn())
+
+	rg.SetFullyWithdrawn()
+	require.True(t, rg.GetWithdrawableCoins().IsZero())
+	require.True(t, rg.IsFullyWithdrawn())
+
+	// crediting a new denom makes only the new denom withdrawable
+	rg.Add(sdk.NewCoins(sdk.NewInt64Coin("uosmo", 10)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uosmo", 10)), rg.GetWithdrawableCoins())
+	require.False(t, rg.IsFullyWithdrawn())
+}
+
+func FuzzIsBTCDelegationRewardFullyWithdrawn(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		epochingKeeper := types.NewMockEpochingKeeper(ctrl)
+		epochingKeeper.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: 1}).AnyTimes()
+		keeper, ctx := testkeeper.IncentiveKeeper(t, nil, nil, epochingKeeper)
+
+		// a staker with two BTC delegations under the same finality provider
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		commission := sdkmath.LegacyNewDecWithPrec(1, 1)
+		fp.Commission = &commission
+		oldDel, err := datagen.GenRandomBTCDelDistInfo(r)
+		require.NoError(t, err)
+		newDel, err := datagen.GenRandomBTCDelDistInfo(r)
+		require.NoError(t, err)
+		newDel.BabylonPk = oldDel.BabylonPk
+		newDel.BtcPk = oldDel.BtcPk
+		stakerAddr := oldDel.GetAddress()
+		genDistCache := func(btcDels ...*bstypes.BTCDelDistInfo) *bstypes.VotingPowerDistCache {
+			fpDistInfo := bstypes.NewFinalityProviderDistInfo(fp)
+			for _, btcDel := range btcDels {
+				fpDistInfo.BtcDels = append(fpDistInfo.BtcDels, btcDel)
+				fpDistInfo.TotalVotingPower += btcDel.VotingPower
+			}
+			dc := bstypes.NewVotingPowerDistCache()
+			dc.AddFinalityProviderDistInfo(fpDistInfo)
+			dc.ApplyActiveFinalityProviders(1)
+			return dc
+		}
+
+		// a BTC delegation that has never been rewarded is fully withdrawn
+		require.True(t, keeper.IsBTCDelegationRewardFullyWithdrawn(ctx, stakerAddr, oldDel.StakingTxHash))
+
+		// both BTC delegations are rewarded, and the staker withdraws
+		height := datagen.RandomInt(r, 1000) + 1
+		keeper.SetBTCStakingGauge(ctx, height, types.NewGauge(sdk.NewInt64Coin("ubbn", int64(datagen.RandomInt(r, 1e6))+1e6)))
+		keeper.RewardBTCStaking(ctx, height, genDistCache(oldDel, newDel))
+		require.False(t, keeper.IsBTCDelegationRewardFullyWithdrawn(ctx, stakerAddr, oldDel.StakingTxHash))
+		require.False(t, keeper.IsBTCDelegationRewardFullyWithdrawn(ctx, stakerAddr, newDel.StakingTxHash))
+		rg := keeper.GetRewardGauge(ctx, types.BTCDelegationType, stakerAddr)
+		rg.SetFullyWithdrawn()
+		keeper.SetRewardGauge(ctx, types.BTCDelegationType, stakerAddr, rg)
+
+		// only the new BTC delegation keeps being rewarded, which does not
+		// affect the withdrawal of the old one's rewards
+		height++
+		keeper.SetBTCStakingGauge(ctx, height, types.NewGauge(sdk.NewInt64Coin("ubbn", int64(datagen.RandomInt(r, 1e6))+1e6)))
+		keeper.RewardBTCStaking(ctx, height, genDistCache(newDel))
+		require.True(t, keeper.IsBTCDelegationRewardFullyWithdrawn(ctx, stakerAddr, oldDel.StakingTxHash))
+		require.False(t, keeper.IsBTCDelegationRewardFullyWithdrawn(ctx, stakerAddr, newDel.StakingTxHash))
+
+		// pruning the old BTC delegation's reward records removes its lockup
+		// start epoch, and pruning the rewards of the pair removes them
+		keeper.PruneBTCDelegationRewardRecords(ctx, oldDel.StakingTxHash)
+		oldStakingTxHash, err := chainhash.NewHashFromStr(oldDel.StakingTxHash)
+		require.NoError(t, err)
+		_, found := keeper.GetBTCDelRewardStartEpoch(ctx, oldStakingTxHash)
+		require.False(t, found)
+		require.Len(t, keeper.GetDelegatorRewardsByValidator(ctx, oldDel.BtcPk), 1)
+		keeper.PruneDelegatorValidatorRewards(ctx, oldDel.BtcPk, fp.BtcPk)
+		require.Empty(t, keeper.GetDelegatorRewardsByValidator(ctx, oldDel.BtcPk))
+	})
+}
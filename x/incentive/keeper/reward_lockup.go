@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/babylonchain/babylon/x/incentive/types"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// btcDelRewardPortion returns the portion of its reward that a BTC delegation
+// receives in the given epoch. A delegation receives nothing in the epoch it
+// first receives rewards, and its portion then grows linearly until it reaches
+// one after `lockupEpochs` epochs
+func btcDelRewardPortion(startEpoch uint64, curEpoch uint64, lockupEpochs uint64) math.LegacyDec {
+	elapsed := curEpoch - startEpoch
+	if elapsed >= lockupEpochs {
+		return math.LegacyOneDec()
+	}
+	return math.LegacyNewDec(int64(elapsed)).QuoInt64(int64(lockupEpochs))
+}
+
+// getBTCDelRewardLockupPortion returns the portion of its reward that the
+// given BTC delegation receives in the given epoch under a non-zero lockup. If
+// the BTC delegation has not received rewards yet, the given epoch is recorded
+// as its start epoch. A BTC delegation that has received rewards without a
+// recorded start epoch, i.e., before the lockup was enabled or after it was
+// unbonded, is no longer locked up
+func (k Keeper) getBTCDelRewardLockupPortion(ctx context.Context, stakingTxHashHex string, curEpoch uint64, lockupEpochs uint64) math.LegacyDec {
+	stakingTxHash := mustParseStakingTxHash(stakingTxHashHex)
+	if startEpoch, found := k.GetBTCDelRewardStartEpoch(ctx, stakingTxHash); found {
+		return btcDelRewardPortion(startEpoch, curEpoch, lockupEpochs)
+	}
+	if k.IsBTCDelegationRewarded(ctx, stakingTxHashHex) {
+		return math.LegacyOneDec()
+	}
+	k.btcDelRewardStartStore(ctx).Set(stakingTxHash[:], sdk.Uint64ToBigEndian(curEpoch))
+	return btcDelRewardPortion(curEpoch, curEpoch, lockupEpochs)
+}
+
+// GetBTCDelRewardStartEpoch returns the epoch in which the given BTC delegation
+// first received rewards, and false if it has not received rewards yet
+func (k Keeper) GetBTCDelRewardStartEpoch(ctx context.Context, stakingTxHash *chainhash.Hash) (uint64, bool) {
+	epochBytes := k.btcDelRewardStartStore(ctx).Get(stakingTxHash[:])
+	if epochBytes == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(epochBytes), true
+}
+
+// GetBTCDelRewardLockup returns the epoch in which the given BTC delegation
+// first received rewards if its rewards are locked up, and whether it has
+// received rewards at all. A BTC delegation rewarded without a reward lockup
+// has no start epoch but is rewarded
+func (k Keeper) GetBTCDelRewardLockup(ctx context.Context, stakingTxHash *chainhash.Hash) (startEpoch uint64, lockedUp bool, rewarded bool) {
+	if startEpoch, found := k.GetBTCDelRewardStartEpoch(ctx, stakingTxHash); found {
+		return startEpoch, true, true
+	}
+	return 0, false, k.IsBTCDelegationRewarded(ctx, stakingTxHash.String())
+}
+
+// DeleteBTCDelRewardStartEpoch removes the recorded start epoch of the given
+// BTC delegation. It is called when the BTC delegation is unbonded
+func (k Keeper) DeleteBTCDelRewardStartEpoch(ctx context.Context, stakingTxHash *chainhash.Hash) {
+	k.btcDelRewardStartStore(ctx).Delete(stakingTxHash[:])
+}
+
+// GetRewardLockupEpochs returns the number of epochs over which the rewards
+// of a new BTC delegation are prorated
+func (k Keeper) GetRewardLockupEpochs(ctx context.Context) uint64 {
+	return k.GetParams(ctx).RewardLockupEpochs
+}
+
+// mustParseStakingTxHash parses the given staking tx hash in hex. The BTC
+// staking module only hands over valid staking tx hashes, so a failure is a
+// programming error
+func mustParseStakingTxHash(stakingTxHashHex string) *chainhash.Hash {
+	stakingTxHash, err := chainhash.NewHashFromStr(stakingTxHashHex)
+	if err != nil {
+		panic(err)
+	}
+	return stakingTxHash
+}
+
+// btcDelRewardStartStore returns the KVStore of the epoch in which each BTC
+// delegation first received rewards. Entries are only written under a
+// non-zero reward lockup, and are removed when the BTC delegation is unbonded
+// or pruned
+// prefix: BTCDelRewardStartKey
+// key: staking tx hash of the BTC delegation
+// value: epoch number
+func (k Keeper) btcDelRewardStartStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.BTCDelRewardStartKey)
+}
@@ -10,4 +10,6 @@ var (
 	ErrBTCTimestampingGaugeNotFound = errorsmod.Register(ModuleName, 1101, "BTC timestamping gauge not found")
 	ErrRewardGaugeNotFound          = errorsmod.Register(ModuleName, 1102, "reward gauge not found")
 	ErrNoWithdrawableCoins          = errorsmod.Register(ModuleName, 1103, "no coin is withdrawable")
+	ErrBlockRewardDistNotFound      = errorsmod.Register(ModuleName, 1104, "block reward distribution not found")
+	ErrBTCDelRewardStartNotFound    = errorsmod.Register(ModuleName, 1105, "BTC delegation has not received rewards yet")
 )
@@ -2,6 +2,8 @@ package types
 
 import (
 	"context"
+
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
 	epochingtypes "github.com/babylonchain/babylon/x/epoching/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -16,8 +18,28 @@ type BankKeeper interface {
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule string, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 }
 
 type EpochingKeeper interface {
 	GetEpoch(ctx context.Context) *epochingtypes.Epoch
 }
+
+// Event Hooks
+// These can be utilized to communicate between an incentive keeper and another
+// keeper which must take particular actions when rewards are distributed. The
+// second keeper must implement this interface, which then the incentive keeper
+// can call.
+
+// IncentiveHooks event hooks for reward distribution (noalias)
+type IncentiveHooks interface {
+	// AfterRewardsDistributed must be called after the rewards of a finalized
+	// block have been distributed to finality providers and BTC delegations
+	AfterRewardsDistributed(
+		ctx context.Context,
+		height uint64,
+		fpRewards []*StakeholderReward,
+		btcDelRewards []*StakeholderReward,
+		finalizingFps []*bstypes.FinalityProviderDistInfo,
+	) error
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"context"
+
+	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
+)
+
+// combine multiple incentive hooks, all hook functions are run in array sequence
+var _ IncentiveHooks = &MultiIncentiveHooks{}
+
+type MultiIncentiveHooks []IncentiveHooks
+
+func NewMultiIncentiveHooks(hooks ...IncentiveHooks) MultiIncentiveHooks {
+	return hooks
+}
+
+func (h MultiIncentiveHooks) AfterRewardsDistributed(
+	ctx context.Context,
+	height uint64,
+	fpRewards []*StakeholderReward,
+	btcDelRewards []*StakeholderReward,
+	finalizingFps []*bstypes.FinalityProviderDistInfo,
+) error {
+	for i := range h {
+		if err := h[i].AfterRewardsDistributed(ctx, height, fpRewards, btcDelRewards, finalizingFps); err != nil {
+			return err
+		}
+	}
+	return nil
+}
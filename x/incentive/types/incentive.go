@@ -7,9 +7,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// NewGauge creates a gauge holding the given coins, which may be of
+// multiple denoms and in any order
 func NewGauge(coins ...sdk.Coin) *Gauge {
 	return &Gauge{
-		Coins: coins,
+		Coins: sdk.NewCoins(coins...),
 	}
 }
 
@@ -17,9 +19,11 @@ func (g *Gauge) GetCoinsPortion(portion math.LegacyDec) sdk.Coins {
 	return GetCoinsPortion(g.Coins, portion)
 }
 
+// NewRewardGauge creates a reward gauge holding the given coins, which may be
+// of multiple denoms and in any order, with nothing withdrawn
 func NewRewardGauge(coins ...sdk.Coin) *RewardGauge {
 	return &RewardGauge{
-		Coins:          coins,
+		Coins:          sdk.NewCoins(coins...),
 		WithdrawnCoins: sdk.NewCoins(),
 	}
 }
@@ -36,14 +40,29 @@ func (rg *RewardGauge) SetFullyWithdrawn() {
 }
 
 // IsFullyWithdrawn returns whether the reward gauge has nothing to withdraw
+// in any denom
 func (rg *RewardGauge) IsFullyWithdrawn() bool {
-	return rg.Coins.Equal(rg.WithdrawnCoins)
+	return rg.GetWithdrawableCoins().IsZero()
 }
 
 func (rg *RewardGauge) Add(coins sdk.Coins) {
 	rg.Coins = rg.Coins.Add(coins...)
 }
 
+// StakeholderReward is the reward credited to a stakeholder's reward gauge
+// upon a reward distribution
+type StakeholderReward struct {
+	Address sdk.AccAddress
+	Coins   sdk.Coins
+}
+
+func NewStakeholderReward(addr sdk.AccAddress, coins sdk.Coins) *StakeholderReward {
+	return &StakeholderReward{
+		Address: addr,
+		Coins:   coins,
+	}
+}
+
 func GetCoinsPortion(coinsInt sdk.Coins, portion math.LegacyDec) sdk.Coins {
 	// coins with decimal value
 	coins := sdk.NewDecCoinsFromCoins(coinsInt...)
@@ -4,7 +4,10 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	github_com_babylonchain_babylon_types "github.com/babylonchain/babylon/types"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -130,32 +133,328 @@ func (m *RewardGauge) GetWithdrawnCoins() github_com_cosmos_cosmos_sdk_types.Coi
 	return nil
 }
 
+// LifetimeRewards is the cumulative rewards ever credited to a BTC
+// staking/timestamping stakeholder, including the withdrawn ones
+type LifetimeRewards struct {
+	// coins are coins that have ever been credited to the stakeholder
+	// Can have multiple coin denoms
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *LifetimeRewards) Reset()         { *m = LifetimeRewards{} }
+func (m *LifetimeRewards) String() string { return proto.CompactTextString(m) }
+func (*LifetimeRewards) ProtoMessage()    {}
+func (*LifetimeRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3954bc4942045a7a, []int{2}
+}
+func (m *LifetimeRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LifetimeRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LifetimeRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LifetimeRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LifetimeRewards.Merge(m, src)
+}
+func (m *LifetimeRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *LifetimeRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_LifetimeRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LifetimeRewards proto.InternalMessageInfo
+
+func (m *LifetimeRewards) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+// DelegatorValidatorRewards is the cumulative rewards ever credited to a BTC
+// delegator for its BTC delegations under a given finality provider,
+// including the withdrawn ones
+type DelegatorValidatorRewards struct {
+	// fp_btc_pk is the Bitcoin secp256k1 PK of the finality provider
+	// the PK follows encoding in BIP-340 spec
+	FpBtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// coins are coins that have ever been credited to the BTC delegator for
+	// its BTC delegations under this finality provider
+	// Can have multiple coin denoms
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *DelegatorValidatorRewards) Reset()         { *m = DelegatorValidatorRewards{} }
+func (m *DelegatorValidatorRewards) String() string { return proto.CompactTextString(m) }
+func (*DelegatorValidatorRewards) ProtoMessage()    {}
+func (*DelegatorValidatorRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3954bc4942045a7a, []int{3}
+}
+func (m *DelegatorValidatorRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorValidatorRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorValidatorRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorValidatorRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorValidatorRewards.Merge(m, src)
+}
+func (m *DelegatorValidatorRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorValidatorRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorValidatorRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorValidatorRewards proto.InternalMessageInfo
+
+func (m *DelegatorValidatorRewards) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+// BlockRewardDistribution records how the BTC staking gauge of a finalized
+// Babylon height was distributed to finality providers and BTC delegations
+type BlockRewardDistribution struct {
+	// height is the Babylon height whose BTC staking gauge was distributed
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// total_reward is the BTC staking gauge at this height
+	TotalReward github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_reward,json=totalReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_reward"`
+	// total_voting_power is the total voting power of the finality providers
+	// that voted for this height
+	TotalVotingPower uint64 `protobuf:"varint,3,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+	// finality_providers is the list of rewards distributed to each of the
+	// finality providers that voted for this height and their BTC delegations
+	FinalityProviders []*FinalityProviderRewardDistribution `protobuf:"bytes,4,rep,name=finality_providers,json=finalityProviders,proto3" json:"finality_providers,omitempty"`
+}
+
+func (m *BlockRewardDistribution) Reset()         { *m = BlockRewardDistribution{} }
+func (m *BlockRewardDistribution) String() string { return proto.CompactTextString(m) }
+func (*BlockRewardDistribution) ProtoMessage()    {}
+func (*BlockRewardDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3954bc4942045a7a, []int{4}
+}
+func (m *BlockRewardDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockRewardDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockRewardDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockRewardDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockRewardDistribution.Merge(m, src)
+}
+func (m *BlockRewardDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockRewardDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockRewardDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockRewardDistribution proto.InternalMessageInfo
+
+func (m *BlockRewardDistribution) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockRewardDistribution) GetTotalReward() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalReward
+	}
+	return nil
+}
+
+func (m *BlockRewardDistribution) GetTotalVotingPower() uint64 {
+	if m != nil {
+		return m.TotalVotingPower
+	}
+	return 0
+}
+
+func (m *BlockRewardDistribution) GetFinalityProviders() []*FinalityProviderRewardDistribution {
+	if m != nil {
+		return m.FinalityProviders
+	}
+	return nil
+}
+
+// FinalityProviderRewardDistribution records the rewards distributed to a
+// finality provider and its BTC delegations at a given height
+type FinalityProviderRewardDistribution struct {
+	// btc_pk is the Bitcoin secp256k1 PK of this finality provider
+	// the PK follows encoding in BIP-340 spec
+	BtcPk *github_com_babylonchain_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonchain/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
+	// address is the Babylon address of the finality provider in bech32 string
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// voting_power is the voting power of the finality provider at this height
+	VotingPower uint64 `protobuf:"varint,3,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// commission_rate is the commission rate of the finality provider at this height
+	CommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=commission_rate,json=commissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission_rate"`
+	// reward is the portion of the total reward allocated to the finality
+	// provider and its BTC delegations
+	Reward github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=reward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward"`
+	// commission is the portion of the reward credited to the finality
+	// provider. It also includes the reward of its BTC delegations if all of
+	// them opt out of rewards and the opted-out rewards are not burned
+	Commission github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=commission,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"commission"`
+	// delegator_reward is the portion of the reward credited to the BTC
+	// delegations of the finality provider
+	DelegatorReward github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=delegator_reward,json=delegatorReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"delegator_reward"`
+}
+
+func (m *FinalityProviderRewardDistribution) Reset()         { *m = FinalityProviderRewardDistribution{} }
+func (m *FinalityProviderRewardDistribution) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderRewardDistribution) ProtoMessage()    {}
+func (*FinalityProviderRewardDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3954bc4942045a7a, []int{5}
+}
+func (m *FinalityProviderRewardDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderRewardDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderRewardDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityProviderRewardDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderRewardDistribution.Merge(m, src)
+}
+func (m *FinalityProviderRewardDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderRewardDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderRewardDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProviderRewardDistribution proto.InternalMessageInfo
+
+func (m *FinalityProviderRewardDistribution) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FinalityProviderRewardDistribution) GetVotingPower() uint64 {
+	if m != nil {
+		return m.VotingPower
+	}
+	return 0
+}
+
+func (m *FinalityProviderRewardDistribution) GetReward() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Reward
+	}
+	return nil
+}
+
+func (m *FinalityProviderRewardDistribution) GetCommission() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Commission
+	}
+	return nil
+}
+
+func (m *FinalityProviderRewardDistribution) GetDelegatorReward() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DelegatorReward
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Gauge)(nil), "babylon.incentive.Gauge")
 	proto.RegisterType((*RewardGauge)(nil), "babylon.incentive.RewardGauge")
+	proto.RegisterType((*LifetimeRewards)(nil), "babylon.incentive.LifetimeRewards")
+	proto.RegisterType((*DelegatorValidatorRewards)(nil), "babylon.incentive.DelegatorValidatorRewards")
+	proto.RegisterType((*BlockRewardDistribution)(nil), "babylon.incentive.BlockRewardDistribution")
+	proto.RegisterType((*FinalityProviderRewardDistribution)(nil), "babylon.incentive.FinalityProviderRewardDistribution")
 }
 
 func init() { proto.RegisterFile("babylon/incentive/incentive.proto", fileDescriptor_3954bc4942045a7a) }
 
 var fileDescriptor_3954bc4942045a7a = []byte{
-	// 269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0xcf, 0xcc, 0x4b, 0x4e, 0xcd, 0x2b, 0xc9, 0x2c, 0x4b, 0x45, 0xb0, 0xf4,
-	0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x04, 0xa1, 0x4a, 0xf4, 0xe0, 0x12, 0x52, 0x22, 0xe9, 0xf9,
-	0xe9, 0xf9, 0x60, 0x59, 0x7d, 0x10, 0x0b, 0xa2, 0x50, 0x4a, 0x2e, 0x39, 0xbf, 0x38, 0x37, 0xbf,
-	0x58, 0x3f, 0x29, 0xb1, 0x38, 0x55, 0xbf, 0xcc, 0x30, 0x29, 0xb5, 0x24, 0xd1, 0x50, 0x3f, 0x39,
-	0x3f, 0x33, 0x0f, 0x22, 0xaf, 0x94, 0xc5, 0xc5, 0xea, 0x9e, 0x58, 0x9a, 0x9e, 0x2a, 0x94, 0xc8,
-	0xc5, 0x0a, 0x12, 0x2e, 0x96, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x36, 0x92, 0xd4, 0x83, 0x68, 0xd4,
-	0x03, 0x69, 0xd4, 0x83, 0x6a, 0xd4, 0x73, 0xce, 0xcf, 0xcc, 0x73, 0x32, 0x38, 0x71, 0x4f, 0x9e,
-	0x61, 0xd5, 0x7d, 0x79, 0x8d, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d,
-	0xa8, 0x2d, 0x10, 0x4a, 0xb7, 0x38, 0x25, 0x5b, 0xbf, 0xa4, 0xb2, 0x20, 0xb5, 0x18, 0xac, 0xa1,
-	0x38, 0x08, 0x62, 0xb2, 0xd2, 0x33, 0x46, 0x2e, 0xee, 0xa0, 0xd4, 0xf2, 0xc4, 0xa2, 0x14, 0x7a,
-	0x59, 0x29, 0x54, 0xc2, 0xc5, 0x5f, 0x9e, 0x59, 0x92, 0x91, 0x52, 0x94, 0x58, 0x9e, 0x17, 0x0f,
-	0xb1, 0x8c, 0x89, 0xfa, 0x96, 0xf1, 0xc1, 0xed, 0x00, 0xf3, 0x9d, 0xbc, 0x4f, 0x3c, 0x92, 0x63,
-	0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96,
-	0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x10, 0xc9, 0x4c, 0x68, 0x14, 0x26, 0x67, 0x24, 0x66,
-	0xe6, 0xc1, 0x38, 0xfa, 0x15, 0x48, 0x91, 0x0e, 0xb6, 0x22, 0x89, 0x0d, 0x1c, 0x51, 0xc6, 0x80,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xdc, 0x83, 0xc5, 0xfb, 0x16, 0x02, 0x00, 0x00,
+	// 625 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcd, 0x4e, 0x1b, 0x3d,
+	0x14, 0xcd, 0x40, 0x12, 0x84, 0x83, 0x08, 0x58, 0x9f, 0xbe, 0x0e, 0x54, 0x9a, 0x40, 0x56, 0x59,
+	0x94, 0x19, 0x02, 0x6d, 0x1f, 0x60, 0x1a, 0xb5, 0xaa, 0xa0, 0x52, 0x34, 0x0b, 0x16, 0x6c, 0x46,
+	0x1e, 0x8f, 0x33, 0x71, 0x93, 0xd8, 0xd1, 0xd8, 0x99, 0x34, 0x6f, 0xd1, 0xe7, 0xe8, 0xba, 0x7d,
+	0x07, 0x96, 0x88, 0x45, 0x55, 0xb1, 0xa0, 0x15, 0x48, 0x7d, 0x8e, 0x6a, 0x6c, 0xe7, 0x47, 0xa5,
+	0x12, 0x9b, 0xc0, 0x2a, 0xbe, 0x39, 0xf7, 0x9e, 0x73, 0xef, 0xf5, 0xb1, 0x06, 0xec, 0x47, 0x28,
+	0x9a, 0xf4, 0x39, 0xf3, 0x28, 0xc3, 0x84, 0x49, 0x9a, 0x91, 0xf9, 0xc9, 0x1d, 0xa6, 0x5c, 0x72,
+	0xb8, 0x6d, 0x52, 0xdc, 0x19, 0xb0, 0xfb, 0x5f, 0xc2, 0x13, 0xae, 0x50, 0x2f, 0x3f, 0xe9, 0xc4,
+	0x5d, 0x07, 0x73, 0x31, 0xe0, 0xc2, 0x8b, 0x90, 0x20, 0x5e, 0xd6, 0x8c, 0x88, 0x44, 0x4d, 0x0f,
+	0x73, 0xca, 0x0c, 0xbe, 0xa3, 0xf1, 0x50, 0x17, 0xea, 0x40, 0x43, 0xf5, 0x8f, 0xa0, 0xf4, 0x0e,
+	0x8d, 0x12, 0x02, 0x11, 0x28, 0xe5, 0x15, 0xc2, 0xb6, 0xf6, 0x56, 0x1b, 0x95, 0xa3, 0x1d, 0xd7,
+	0xa4, 0xe5, 0x9c, 0xae, 0xe1, 0x74, 0xdf, 0x70, 0xca, 0xfc, 0xc3, 0x8b, 0x9b, 0x5a, 0xe1, 0xcb,
+	0xcf, 0x5a, 0x23, 0xa1, 0xb2, 0x3b, 0x8a, 0x5c, 0xcc, 0x07, 0x86, 0xd3, 0xfc, 0x1c, 0x88, 0xb8,
+	0xe7, 0xc9, 0xc9, 0x90, 0x08, 0x55, 0x20, 0x02, 0xcd, 0x5c, 0xff, 0x6d, 0x81, 0x4a, 0x40, 0xc6,
+	0x28, 0x8d, 0x9f, 0x4a, 0x12, 0x4a, 0x50, 0x1d, 0x53, 0xd9, 0x8d, 0x53, 0x34, 0x66, 0xa1, 0x16,
+	0x5b, 0x59, 0xbe, 0xd8, 0xe6, 0x4c, 0x43, 0xc5, 0x75, 0x09, 0xaa, 0xa7, 0xb4, 0x43, 0x24, 0x1d,
+	0x10, 0x3d, 0xaf, 0x78, 0x8a, 0xf5, 0x5e, 0x59, 0x60, 0xa7, 0x45, 0xfa, 0x24, 0x41, 0x92, 0xa7,
+	0x67, 0xa8, 0x4f, 0xe3, 0xfc, 0x30, 0x6d, 0x20, 0x00, 0xeb, 0x9d, 0x61, 0x18, 0x49, 0x1c, 0x0e,
+	0x7b, 0xb6, 0xb5, 0x67, 0x35, 0x36, 0xfc, 0xd7, 0xd7, 0x37, 0xb5, 0xa3, 0x05, 0x15, 0x63, 0x37,
+	0xdc, 0x45, 0x94, 0x4d, 0x03, 0x23, 0xe4, 0xbf, 0x6f, 0x1f, 0xbf, 0x3c, 0x6c, 0x8f, 0xa2, 0x13,
+	0x32, 0x09, 0xd6, 0x3a, 0x43, 0x5f, 0xe2, 0x76, 0x6f, 0x3e, 0xd4, 0xca, 0xa3, 0x0d, 0xf5, 0x6d,
+	0x05, 0x3c, 0xf3, 0xfb, 0x1c, 0xf7, 0xf4, 0x1c, 0x2d, 0x2a, 0x64, 0x4a, 0xa3, 0x91, 0xa4, 0x9c,
+	0xc1, 0xff, 0x41, 0xb9, 0x4b, 0x68, 0xd2, 0x95, 0x6a, 0x9e, 0x62, 0x60, 0x22, 0xc8, 0xc0, 0x86,
+	0xe4, 0x12, 0xf5, 0xc3, 0x54, 0xd5, 0x3c, 0x46, 0x77, 0x15, 0x25, 0xa0, 0x7b, 0x82, 0x2f, 0x00,
+	0xd4, 0x7a, 0x19, 0x97, 0x94, 0x25, 0xe1, 0x90, 0x8f, 0x49, 0x6a, 0xaf, 0xaa, 0x9e, 0xb6, 0x14,
+	0x72, 0xa6, 0x80, 0x76, 0xfe, 0x3f, 0x8c, 0x01, 0xec, 0x50, 0x86, 0xfa, 0x54, 0x4e, 0xf2, 0x07,
+	0x99, 0xd1, 0x98, 0xa4, 0xc2, 0x2e, 0xaa, 0x1e, 0x5f, 0xb9, 0xf7, 0x9e, 0xbc, 0xfb, 0xd6, 0x24,
+	0xb7, 0x4d, 0xee, 0xfd, 0x45, 0x04, 0xdb, 0x9d, 0xbf, 0x72, 0x44, 0xfd, 0x7b, 0x11, 0xd4, 0x1f,
+	0xae, 0x84, 0x1f, 0x40, 0x79, 0x29, 0x96, 0x28, 0x45, 0xca, 0x10, 0x36, 0x58, 0x43, 0x71, 0x9c,
+	0x12, 0x91, 0x5b, 0xc2, 0x6a, 0xac, 0x07, 0xd3, 0x10, 0xee, 0x83, 0x8d, 0x7f, 0x6c, 0xa7, 0x92,
+	0x2d, 0x2c, 0xe6, 0x1c, 0x54, 0x31, 0x1f, 0x0c, 0xa8, 0x10, 0x94, 0xb3, 0x30, 0x45, 0x92, 0xd8,
+	0xc5, 0x9c, 0xc4, 0x6f, 0xe6, 0xd7, 0x73, 0x7d, 0x53, 0x7b, 0xae, 0x2f, 0x43, 0xc4, 0x3d, 0x97,
+	0x72, 0x6f, 0x80, 0x64, 0xd7, 0x3d, 0x25, 0x09, 0xc2, 0x93, 0x16, 0xc1, 0x57, 0x5f, 0x0f, 0x80,
+	0xb9, 0xdf, 0x16, 0xc1, 0xc1, 0xe6, 0x9c, 0x29, 0x40, 0x92, 0x40, 0x0c, 0xca, 0xc6, 0x0c, 0xa5,
+	0xe5, 0x9b, 0xc1, 0x50, 0xc3, 0x1e, 0x00, 0x73, 0x59, 0xbb, 0xbc, 0x7c, 0xa1, 0x05, 0x7a, 0x98,
+	0x81, 0xad, 0x78, 0xfa, 0xd8, 0xa7, 0x46, 0x5f, 0x5b, 0xbe, 0x64, 0x75, 0x26, 0xa2, 0xdd, 0xe3,
+	0x9f, 0x5c, 0xdc, 0x3a, 0xd6, 0xe5, 0xad, 0x63, 0xfd, 0xba, 0x75, 0xac, 0xcf, 0x77, 0x4e, 0xe1,
+	0xf2, 0xce, 0x29, 0xfc, 0xb8, 0x73, 0x0a, 0xe7, 0xcd, 0x87, 0x7c, 0xf3, 0x69, 0xe1, 0x5b, 0xa7,
+	0x34, 0xa2, 0xb2, 0xfa, 0x08, 0x1d, 0xff, 0x19, 0x00, 0xb2, 0x11, 0x49, 0x7a, 0x0d, 0x07, 0x00,
+	0x00,
 }
 
 func (m *Gauge) Marshal() (dAtA []byte, err error) {
@@ -246,60 +545,812 @@ func (m *RewardGauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintIncentive(dAtA []byte, offset int, v uint64) int {
-	offset -= sovIncentive(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *LifetimeRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Gauge) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *LifetimeRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LifetimeRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorValidatorRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorValidatorRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorValidatorRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovIncentive(uint64(l))
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintIncentive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockRewardDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockRewardDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockRewardDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FinalityProviders) > 0 {
+		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TotalReward) > 0 {
+		for iNdEx := len(m.TotalReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalityProviderRewardDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderRewardDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderRewardDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorReward) > 0 {
+		for iNdEx := len(m.DelegatorReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Commission) > 0 {
+		for iNdEx := len(m.Commission) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commission[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Reward) > 0 {
+		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentive(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentive(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.VotingPower != 0 {
+		i = encodeVarintIncentive(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintIncentive(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintIncentive(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIncentive(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIncentive(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Gauge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardGauge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	if len(m.WithdrawnCoins) > 0 {
+		for _, e := range m.WithdrawnCoins {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LifetimeRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegatorValidatorRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovIncentive(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockRewardDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovIncentive(uint64(m.Height))
+	}
+	if len(m.TotalReward) > 0 {
+		for _, e := range m.TotalReward {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovIncentive(uint64(m.TotalVotingPower))
+	}
+	if len(m.FinalityProviders) > 0 {
+		for _, e := range m.FinalityProviders {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FinalityProviderRewardDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovIncentive(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovIncentive(uint64(l))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovIncentive(uint64(m.VotingPower))
+	}
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovIncentive(uint64(l))
+	if len(m.Reward) > 0 {
+		for _, e := range m.Reward {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	if len(m.Commission) > 0 {
+		for _, e := range m.Commission {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	if len(m.DelegatorReward) > 0 {
+		for _, e := range m.DelegatorReward {
+			l = e.Size()
+			n += 1 + l + sovIncentive(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovIncentive(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIncentive(x uint64) (n int) {
+	return sovIncentive(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Gauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardGauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardGauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardGauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawnCoins = append(m.WithdrawnCoins, types.Coin{})
+			if err := m.WithdrawnCoins[len(m.WithdrawnCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LifetimeRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LifetimeRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LifetimeRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorValidatorRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentive
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorValidatorRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorValidatorRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentive(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *RewardGauge) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovIncentive(uint64(l))
-		}
-	}
-	if len(m.WithdrawnCoins) > 0 {
-		for _, e := range m.WithdrawnCoins {
-			l = e.Size()
-			n += 1 + l + sovIncentive(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovIncentive(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozIncentive(x uint64) (n int) {
-	return sovIncentive(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Gauge) Unmarshal(dAtA []byte) error {
+func (m *BlockRewardDistribution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -322,15 +1373,34 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockRewardDistribution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockRewardDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalReward", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -357,8 +1427,61 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalReward = append(m.TotalReward, types.Coin{})
+			if err := m.TotalReward[len(m.TotalReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderRewardDistribution{})
+			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -383,7 +1506,7 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RewardGauge) Unmarshal(dAtA []byte) error {
+func (m *FinalityProviderRewardDistribution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -406,15 +1529,135 @@ func (m *RewardGauge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RewardGauge: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalityProviderRewardDistribution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardGauge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalityProviderRewardDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonchain_babylon_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -441,14 +1684,14 @@ func (m *RewardGauge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reward = append(m.Reward, types.Coin{})
+			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -475,8 +1718,42 @@ func (m *RewardGauge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawnCoins = append(m.WithdrawnCoins, types.Coin{})
-			if err := m.WithdrawnCoins[len(m.WithdrawnCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commission = append(m.Commission, types.Coin{})
+			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentive
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentive
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorReward = append(m.DelegatorReward, types.Coin{})
+			if err := m.DelegatorReward[len(m.DelegatorReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
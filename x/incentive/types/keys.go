@@ -15,8 +15,13 @@ const (
 )
 
 var (
-	ParamsKey               = []byte{0x01} // key prefix for the parameters
-	BTCStakingGaugeKey      = []byte{0x02} // key prefix for BTC staking gauge at each height
-	BTCTimestampingGaugeKey = []byte{0x03} // key prefix for BTC timestamping gauge at each height
-	RewardGaugeKey          = []byte{0x04} // key prefix for reward gauge for a given stakeholder in a given type
+	ParamsKey                = []byte{0x01} // key prefix for the parameters
+	BTCStakingGaugeKey       = []byte{0x02} // key prefix for BTC staking gauge at each height
+	BTCTimestampingGaugeKey  = []byte{0x03} // key prefix for BTC timestamping gauge at each height
+	RewardGaugeKey           = []byte{0x04} // key prefix for reward gauge for a given stakeholder in a given type
+	BlockRewardDistKey       = []byte{0x05} // key prefix for BTC staking reward distribution record at each height
+	BTCDelRewardStartKey     = []byte{0x06} // key prefix for the epoch in which each BTC delegation first received rewards
+	LifetimeRewardsKey       = []byte{0x07} // key prefix for cumulative rewards ever credited to a given stakeholder in a given type
+	DelValRewardsKey         = []byte{0x08} // key prefix for cumulative rewards ever credited to a given BTC delegator under a given finality provider
+	BTCDelRewardWatermarkKey = []byte{0x0A} // key prefix for the reward gauge total of each BTC delegation's staker when the BTC delegation was last rewarded
 )
@@ -15,12 +15,18 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
+// DefaultBlockRewardDistRetention is the default number of finalized heights
+// whose BTC staking reward distribution records are kept, i.e., about one
+// week with 6-second blocks
+const DefaultBlockRewardDistRetention uint64 = 100_000
+
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
 	return Params{
-		SubmitterPortion:  math.LegacyNewDecWithPrec(5, 2), // 5 * 10^{-2} = 0.05
-		ReporterPortion:   math.LegacyNewDecWithPrec(5, 2), // 5 * 10^{-2} = 0.05
-		BtcStakingPortion: math.LegacyNewDecWithPrec(2, 1), // 2 * 10^{-1} = 0.2
+		SubmitterPortion:         math.LegacyNewDecWithPrec(5, 2), // 5 * 10^{-2} = 0.05
+		ReporterPortion:          math.LegacyNewDecWithPrec(5, 2), // 5 * 10^{-2} = 0.05
+		BtcStakingPortion:        math.LegacyNewDecWithPrec(2, 1), // 2 * 10^{-1} = 0.2
+		BlockRewardDistRetention: DefaultBlockRewardDistRetention,
 	}
 }
 
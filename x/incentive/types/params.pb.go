@@ -38,6 +38,27 @@ type Params struct {
 	// NOTE: the portion of each Finality Provider/delegation is calculated by using its voting
 	// power and finality provider's commission
 	BtcStakingPortion cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=btc_staking_portion,json=btcStakingPortion,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"btc_staking_portion"`
+	// block_reward_dist_retention is the number of most recent finalized heights
+	// whose BTC staking reward distribution records are kept in the store.
+	// Records of older heights are pruned. Zero disables the records
+	BlockRewardDistRetention uint64 `protobuf:"varint,4,opt,name=block_reward_dist_retention,json=blockRewardDistRetention,proto3" json:"block_reward_dist_retention,omitempty"`
+	// reward_lockup_epochs is the number of epochs a BTC delegation has to wait,
+	// counting from the epoch it first receives rewards, before it earns full
+	// rewards. During the lockup its rewards are prorated linearly by the number
+	// of epochs elapsed, and the withheld part stays in the incentive module.
+	// Zero disables the lockup
+	RewardLockupEpochs uint64 `protobuf:"varint,5,opt,name=reward_lockup_epochs,json=rewardLockupEpochs,proto3" json:"reward_lockup_epochs,omitempty"`
+	// rewards_paused freezes the BTC staking rewards upon emergency. While it is
+	// true, the BTC staking portion of fees is not intercepted from the fee
+	// collector, and no BTC staking reward is credited to finality providers and
+	// BTC delegations upon finalising blocks
+	RewardsPaused bool `protobuf:"varint,6,opt,name=rewards_paused,json=rewardsPaused,proto3" json:"rewards_paused,omitempty"`
+	// burn_opted_out_rewards decides what happens to the rewards of BTC
+	// delegations that opt out of rewards. If true, their rewards are burned.
+	// Otherwise, their rewards are redistributed to the other BTC delegations
+	// of the same finality provider in proportion to their voting power, or
+	// credited to the finality provider if all of its BTC delegations opt out
+	BurnOptedOutRewards bool `protobuf:"varint,7,opt,name=burn_opted_out_rewards,json=burnOptedOutRewards,proto3" json:"burn_opted_out_rewards,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -72,6 +93,34 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetBlockRewardDistRetention() uint64 {
+	if m != nil {
+		return m.BlockRewardDistRetention
+	}
+	return 0
+}
+
+func (m *Params) GetRewardLockupEpochs() uint64 {
+	if m != nil {
+		return m.RewardLockupEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetRewardsPaused() bool {
+	if m != nil {
+		return m.RewardsPaused
+	}
+	return false
+}
+
+func (m *Params) GetBurnOptedOutRewards() bool {
+	if m != nil {
+		return m.BurnOptedOutRewards
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.incentive.Params")
 }
@@ -79,26 +128,33 @@ func init() {
 func init() { proto.RegisterFile("babylon/incentive/params.proto", fileDescriptor_c42276168f0adf4b) }
 
 var fileDescriptor_c42276168f0adf4b = []byte{
-	// 294 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0xcf, 0xcc, 0x4b, 0x4e, 0xcd, 0x2b, 0xc9, 0x2c, 0x4b, 0xd5, 0x2f, 0x48,
-	0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0xca, 0xeb, 0xc1,
-	0xe5, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0xb2, 0xfa, 0x20, 0x16, 0x44, 0xa1, 0x94, 0x64,
-	0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x71, 0x3c, 0x44, 0x02, 0xc2, 0x81, 0x48, 0x29, 0xad, 0x67, 0xe2,
-	0x62, 0x0b, 0x00, 0x1b, 0x2a, 0x14, 0xc7, 0x25, 0x58, 0x5c, 0x9a, 0x94, 0x9b, 0x59, 0x52, 0x92,
-	0x5a, 0x14, 0x5f, 0x90, 0x5f, 0x54, 0x92, 0x99, 0x9f, 0x27, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9,
-	0x64, 0x78, 0xe2, 0x9e, 0x3c, 0xc3, 0xad, 0x7b, 0xf2, 0xd2, 0x10, 0xbd, 0xc5, 0x29, 0xd9, 0x7a,
-	0x99, 0xf9, 0xfa, 0xb9, 0x89, 0x25, 0x19, 0x7a, 0x3e, 0xa9, 0xe9, 0x89, 0xc9, 0x95, 0x2e, 0xa9,
-	0xc9, 0x97, 0xb6, 0xe8, 0x72, 0x41, 0x8d, 0x76, 0x49, 0x4d, 0x0e, 0x12, 0x80, 0x9b, 0x15, 0x00,
-	0x31, 0x4a, 0x28, 0x86, 0x4b, 0xa0, 0x28, 0x15, 0x64, 0x2e, 0x92, 0xf1, 0x4c, 0xe4, 0x1a, 0xcf,
-	0x0f, 0x33, 0x0a, 0x66, 0x7a, 0x22, 0x97, 0x70, 0x52, 0x49, 0x72, 0x7c, 0x71, 0x49, 0x62, 0x76,
-	0x66, 0x5e, 0x3a, 0xdc, 0x02, 0x66, 0x72, 0x2d, 0x10, 0x4c, 0x2a, 0x49, 0x0e, 0x86, 0x18, 0x06,
-	0xb5, 0xc2, 0x8a, 0x65, 0xc6, 0x02, 0x79, 0x06, 0x27, 0xef, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c,
-	0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e,
-	0x3c, 0x96, 0x63, 0x88, 0x32, 0x4c, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5,
-	0x87, 0x46, 0x4d, 0x72, 0x46, 0x62, 0x66, 0x1e, 0x8c, 0xa3, 0x5f, 0x81, 0x14, 0x93, 0x25, 0x95,
-	0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x58, 0x30, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x55, 0x13,
-	0x48, 0x09, 0xeb, 0x01, 0x00, 0x00,
+	// 414 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x13, 0xbb, 0xae, 0x3a, 0xa0, 0x76, 0xd3, 0x22, 0xb1, 0x85, 0xec, 0x22, 0x08, 0x7b,
+	0x31, 0xb1, 0xf4, 0x26, 0x78, 0x29, 0xeb, 0xc9, 0x42, 0x97, 0x78, 0x13, 0x71, 0x98, 0x99, 0x0c,
+	0xd9, 0x61, 0x9b, 0xbc, 0x61, 0xe6, 0x45, 0xdd, 0x6f, 0xe1, 0xd1, 0xa3, 0x1f, 0xc2, 0x0f, 0xd1,
+	0x63, 0xf1, 0x24, 0x1e, 0x8a, 0xec, 0x7e, 0x0d, 0x0f, 0x25, 0x33, 0xc9, 0xd2, 0xf3, 0xde, 0x32,
+	0xef, 0xf7, 0x7f, 0xbf, 0xf7, 0x08, 0x8f, 0x24, 0x9c, 0xf1, 0xd5, 0x25, 0xd4, 0x99, 0xaa, 0x85,
+	0xac, 0x51, 0x7d, 0x91, 0x99, 0x66, 0x86, 0x55, 0x36, 0xd5, 0x06, 0x10, 0xa2, 0x51, 0xc7, 0xd3,
+	0x2d, 0x3f, 0x3a, 0x2c, 0xa1, 0x04, 0x47, 0xb3, 0xf6, 0xcb, 0x07, 0x8f, 0x9e, 0x0b, 0xb0, 0x15,
+	0x58, 0xea, 0x81, 0x7f, 0x78, 0xf4, 0xe2, 0xff, 0x1e, 0x19, 0xce, 0x9d, 0x34, 0xfa, 0x4c, 0x46,
+	0xb6, 0xe1, 0x95, 0x42, 0x94, 0x86, 0x6a, 0x30, 0xa8, 0xa0, 0x8e, 0xc3, 0x49, 0x38, 0x7d, 0x74,
+	0x76, 0x72, 0x75, 0x33, 0x0e, 0xfe, 0xde, 0x8c, 0x8f, 0x7d, 0xaf, 0x2d, 0x96, 0xa9, 0x82, 0xac,
+	0x62, 0xb8, 0x48, 0xcf, 0x65, 0xc9, 0xc4, 0x6a, 0x26, 0xc5, 0xef, 0x5f, 0xaf, 0x48, 0xa7, 0x9e,
+	0x49, 0x91, 0xef, 0x6f, 0x5d, 0x73, 0xaf, 0x8a, 0x3e, 0x91, 0x7d, 0x23, 0x5b, 0xef, 0x1d, 0xfd,
+	0xbd, 0x5d, 0xf5, 0x4f, 0x7b, 0x55, 0x6f, 0x67, 0xe4, 0x80, 0xa3, 0xa0, 0x16, 0xd9, 0x52, 0xd5,
+	0xe5, 0x76, 0xc0, 0xde, 0xae, 0x03, 0x46, 0x1c, 0xc5, 0x07, 0x2f, 0xeb, 0x47, 0xbc, 0x25, 0xc7,
+	0xfc, 0x12, 0xc4, 0x92, 0x1a, 0xf9, 0x95, 0x99, 0x82, 0x16, 0xca, 0x22, 0x35, 0x12, 0xdb, 0x7f,
+	0x0f, 0x75, 0x3c, 0x98, 0x84, 0xd3, 0x41, 0x1e, 0xbb, 0x48, 0xee, 0x12, 0x33, 0x65, 0x31, 0xef,
+	0x79, 0xf4, 0x9a, 0x1c, 0x76, 0x8d, 0x6d, 0xa2, 0xd1, 0x54, 0x6a, 0x10, 0x0b, 0x1b, 0xdf, 0x77,
+	0x7d, 0x91, 0x67, 0xe7, 0x0e, 0xbd, 0x73, 0x24, 0x7a, 0x49, 0x9e, 0xf8, 0xaa, 0xa5, 0x9a, 0x35,
+	0x56, 0x16, 0xf1, 0x70, 0x12, 0x4e, 0x1f, 0xe6, 0x8f, 0xbb, 0xea, 0xdc, 0x15, 0xa3, 0x53, 0xf2,
+	0x8c, 0x37, 0xa6, 0xa6, 0xa0, 0x51, 0x16, 0x14, 0x1a, 0xec, 0x16, 0xb4, 0xf1, 0x03, 0x17, 0x3f,
+	0x68, 0xe9, 0x45, 0x0b, 0x2f, 0x1a, 0xf4, 0x9b, 0xd9, 0x37, 0x83, 0x1f, 0x3f, 0xc7, 0xc1, 0xd9,
+	0xfb, 0xab, 0x75, 0x12, 0x5e, 0xaf, 0x93, 0xf0, 0xdf, 0x3a, 0x09, 0xbf, 0x6f, 0x92, 0xe0, 0x7a,
+	0x93, 0x04, 0x7f, 0x36, 0x49, 0xf0, 0xf1, 0xa4, 0x54, 0xb8, 0x68, 0x78, 0x2a, 0xa0, 0xca, 0xba,
+	0x3b, 0x13, 0x0b, 0xa6, 0xea, 0xfe, 0x91, 0x7d, 0xbb, 0x73, 0x96, 0xb8, 0xd2, 0xd2, 0xf2, 0xa1,
+	0x3b, 0xa9, 0xd3, 0xdb, 0x01, 0x00, 0x01, 0xc4, 0xeb, 0x57, 0xb8, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -121,6 +177,36 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BurnOptedOutRewards {
+		i--
+		if m.BurnOptedOutRewards {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RewardsPaused {
+		i--
+		if m.RewardsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RewardLockupEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RewardLockupEpochs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BlockRewardDistRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BlockRewardDistRetention))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size := m.BtcStakingPortion.Size()
 		i -= size
@@ -177,6 +263,18 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.BtcStakingPortion.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.BlockRewardDistRetention != 0 {
+		n += 1 + sovParams(uint64(m.BlockRewardDistRetention))
+	}
+	if m.RewardLockupEpochs != 0 {
+		n += 1 + sovParams(uint64(m.RewardLockupEpochs))
+	}
+	if m.RewardsPaused {
+		n += 2
+	}
+	if m.BurnOptedOutRewards {
+		n += 2
+	}
 	return n
 }
 
@@ -317,6 +415,84 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRewardDistRetention", wireType)
+			}
+			m.BlockRewardDistRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRewardDistRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardLockupEpochs", wireType)
+			}
+			m.RewardLockupEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardLockupEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RewardsPaused = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnOptedOutRewards", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnOptedOutRewards = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -5,7 +5,11 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -389,643 +393,3589 @@ func (m *QueryBTCTimestampingGaugeResponse) GetGauge() *Gauge {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.incentive.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.incentive.QueryParamsResponse")
-	proto.RegisterType((*QueryRewardGaugesRequest)(nil), "babylon.incentive.QueryRewardGaugesRequest")
-	proto.RegisterType((*QueryRewardGaugesResponse)(nil), "babylon.incentive.QueryRewardGaugesResponse")
-	proto.RegisterMapType((map[string]*RewardGauge)(nil), "babylon.incentive.QueryRewardGaugesResponse.RewardGaugesEntry")
-	proto.RegisterType((*QueryBTCStakingGaugeRequest)(nil), "babylon.incentive.QueryBTCStakingGaugeRequest")
-	proto.RegisterType((*QueryBTCStakingGaugeResponse)(nil), "babylon.incentive.QueryBTCStakingGaugeResponse")
-	proto.RegisterType((*QueryBTCTimestampingGaugeRequest)(nil), "babylon.incentive.QueryBTCTimestampingGaugeRequest")
-	proto.RegisterType((*QueryBTCTimestampingGaugeResponse)(nil), "babylon.incentive.QueryBTCTimestampingGaugeResponse")
+// QueryBlockRewardDistributionRequest is request type for the Query/BlockRewardDistribution RPC method.
+type QueryBlockRewardDistributionRequest struct {
+	// height is the queried Babylon height
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func init() { proto.RegisterFile("babylon/incentive/query.proto", fileDescriptor_e1a59cc0c7c44135) }
-
-var fileDescriptor_e1a59cc0c7c44135 = []byte{
-	// 610 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0xe3, 0xb4, 0x09, 0xf4, 0x5a, 0x04, 0x3d, 0x22, 0xe4, 0x38, 0xc5, 0x24, 0x96, 0x40,
-	0x95, 0x00, 0x5b, 0xcd, 0x1f, 0x15, 0x90, 0x00, 0x29, 0x08, 0x31, 0x20, 0x45, 0xe0, 0x76, 0x62,
-	0x89, 0x2e, 0xce, 0xc9, 0xb1, 0x1a, 0xfb, 0x5c, 0xfb, 0x5c, 0x08, 0x55, 0x16, 0x3e, 0x01, 0x12,
-	0x13, 0x3b, 0x0b, 0xdf, 0x82, 0xb1, 0x63, 0x25, 0x16, 0x26, 0x04, 0x09, 0x1f, 0x04, 0xe5, 0xee,
-	0x1c, 0xb9, 0x8d, 0x5d, 0x5a, 0xb6, 0xf3, 0xfb, 0x3e, 0xef, 0xf3, 0xfe, 0x72, 0x7e, 0x1c, 0x70,
-	0xb3, 0x87, 0x7a, 0xa3, 0x21, 0xf1, 0x0c, 0xc7, 0xb3, 0xb0, 0x47, 0x9d, 0x03, 0x6c, 0xec, 0x47,
-	0x38, 0x18, 0xe9, 0x7e, 0x40, 0x28, 0x81, 0xeb, 0xa2, 0xad, 0xcf, 0xdb, 0x4a, 0xc9, 0x26, 0x36,
-	0x61, 0x5d, 0x63, 0x76, 0xe2, 0x42, 0x65, 0xc3, 0x26, 0xc4, 0x1e, 0x62, 0x03, 0xf9, 0x8e, 0x81,
-	0x3c, 0x8f, 0x50, 0x44, 0x1d, 0xe2, 0x85, 0xa2, 0xab, 0x2e, 0x6e, 0xf1, 0x51, 0x80, 0xdc, 0xb8,
-	0x5f, 0x5b, 0xec, 0xcf, 0x4f, 0x5c, 0xa2, 0x95, 0x00, 0x7c, 0x3d, 0x03, 0x7b, 0xc5, 0xe6, 0x4c,
-	0xbc, 0x1f, 0xe1, 0x90, 0x6a, 0x1d, 0x70, 0xfd, 0x44, 0x35, 0xf4, 0x89, 0x17, 0x62, 0xb8, 0x0d,
-	0x8a, 0xdc, 0x5f, 0x96, 0xaa, 0xd2, 0xe6, 0x6a, 0xbd, 0xac, 0x2f, 0xfc, 0x0e, 0x9d, 0x8f, 0xb4,
-	0x97, 0x8f, 0x7e, 0xde, 0xca, 0x99, 0x42, 0xae, 0x35, 0x81, 0xcc, 0xfc, 0x4c, 0xfc, 0x16, 0x05,
-	0xfd, 0x17, 0x28, 0xb2, 0x71, 0xbc, 0x0b, 0xca, 0xe0, 0x12, 0xea, 0xf7, 0x03, 0x1c, 0x72, 0xd7,
-	0x15, 0x33, 0x7e, 0xd4, 0x7e, 0x4b, 0xa0, 0x9c, 0x32, 0x26, 0x60, 0x2c, 0x70, 0x25, 0x60, 0xf5,
-	0xae, 0xcd, 0x1a, 0xb2, 0x54, 0x5d, 0xda, 0x5c, 0xad, 0x3f, 0x49, 0x61, 0xca, 0x34, 0xd1, 0x93,
-	0xc5, 0xe7, 0x1e, 0x0d, 0x46, 0xe6, 0x5a, 0x90, 0x28, 0x29, 0x5d, 0xb0, 0xbe, 0x20, 0x81, 0xd7,
-	0xc0, 0xd2, 0x1e, 0x1e, 0x09, 0xda, 0xd9, 0x11, 0x36, 0x41, 0xe1, 0x00, 0x0d, 0x23, 0x2c, 0xe7,
-	0xd9, 0xbd, 0xa8, 0x29, 0x0c, 0x09, 0x1b, 0x93, 0x8b, 0x1f, 0xe5, 0x1f, 0x48, 0x5a, 0x0b, 0x54,
-	0x18, 0x5d, 0x7b, 0xf7, 0xd9, 0x0e, 0x45, 0x7b, 0x8e, 0x67, 0x73, 0x89, 0xb8, 0x9c, 0x1b, 0xa0,
-	0x38, 0xc0, 0x8e, 0x3d, 0xa0, 0x6c, 0xdb, 0xb2, 0x29, 0x9e, 0xb4, 0x0e, 0xd8, 0x48, 0x1f, 0x13,
-	0x97, 0xa3, 0x83, 0x02, 0xbb, 0x15, 0xf1, 0xa2, 0xe4, 0x14, 0x20, 0x81, 0xc2, 0x64, 0xda, 0x53,
-	0x50, 0x8d, 0xfd, 0x76, 0x1d, 0x17, 0x87, 0x14, 0xb9, 0xfe, 0x69, 0x96, 0x0a, 0x58, 0xc1, 0x3e,
-	0xb1, 0x06, 0x5d, 0x2f, 0x72, 0x05, 0xce, 0x65, 0x56, 0xe8, 0x44, 0xae, 0xb6, 0x03, 0x6a, 0x67,
-	0x18, 0xfc, 0x1f, 0x55, 0xfd, 0x73, 0x01, 0x14, 0x98, 0x2b, 0x7c, 0x0f, 0x8a, 0x3c, 0x58, 0xf0,
-	0x76, 0xd6, 0xfb, 0x3d, 0x91, 0x60, 0xe5, 0xce, 0xbf, 0x64, 0x1c, 0x49, 0xab, 0x7d, 0xf8, 0xfe,
-	0xe7, 0x53, 0xbe, 0x02, 0xcb, 0x46, 0xd6, 0xb7, 0x04, 0xbf, 0x48, 0x60, 0x2d, 0x19, 0x02, 0x78,
-	0xf7, 0x7c, 0x11, 0xe3, 0x20, 0xf7, 0x2e, 0x92, 0x47, 0xed, 0x21, 0xc3, 0x69, 0xc0, 0xad, 0x14,
-	0x1c, 0xf1, 0x59, 0x18, 0x87, 0xe2, 0x30, 0x36, 0x92, 0xf9, 0x87, 0x5f, 0x25, 0x70, 0xf5, 0x54,
-	0x1c, 0xa0, 0x9e, 0xb5, 0x3c, 0x3d, 0x6e, 0x8a, 0x71, 0x6e, 0xbd, 0xe0, 0x6d, 0x31, 0x5e, 0x03,
-	0xde, 0x4f, 0xe1, 0xed, 0x51, 0xab, 0x1b, 0xf2, 0x21, 0x8e, 0x68, 0x1c, 0xf2, 0xf4, 0x8e, 0xe1,
-	0x37, 0x09, 0x94, 0xd2, 0x92, 0x02, 0x1b, 0x67, 0x00, 0x64, 0x05, 0x53, 0x69, 0x5e, 0x6c, 0x48,
-	0xa0, 0x3f, 0x66, 0xe8, 0xdb, 0xb0, 0x95, 0x81, 0x4e, 0x13, 0x93, 0x31, 0xff, 0x3c, 0xff, 0xe3,
-	0xf6, 0xcb, 0xa3, 0x89, 0x2a, 0x1d, 0x4f, 0x54, 0xe9, 0xd7, 0x44, 0x95, 0x3e, 0x4e, 0xd5, 0xdc,
-	0xf1, 0x54, 0xcd, 0xfd, 0x98, 0xaa, 0xb9, 0x37, 0x5b, 0xb6, 0x43, 0x07, 0x51, 0x4f, 0xb7, 0x88,
-	0x1b, 0x5b, 0x5b, 0x03, 0xe4, 0x78, 0xf3, 0x3d, 0xef, 0x12, 0x9b, 0xe8, 0xc8, 0xc7, 0x61, 0xaf,
-	0xc8, 0xfe, 0x8c, 0x1b, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x1f, 0x12, 0x0c, 0x37, 0x06,
-	0x00, 0x00,
+func (m *QueryBlockRewardDistributionRequest) Reset()         { *m = QueryBlockRewardDistributionRequest{} }
+func (m *QueryBlockRewardDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockRewardDistributionRequest) ProtoMessage()    {}
+func (*QueryBlockRewardDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{8}
+}
+func (m *QueryBlockRewardDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockRewardDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockRewardDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockRewardDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockRewardDistributionRequest.Merge(m, src)
+}
+func (m *QueryBlockRewardDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockRewardDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockRewardDistributionRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryBlockRewardDistributionRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// RewardGauge queries the reward gauge of a given stakeholder address
-	RewardGauges(ctx context.Context, in *QueryRewardGaugesRequest, opts ...grpc.CallOption) (*QueryRewardGaugesResponse, error)
-	// BTCStakingGauge queries the BTC staking gauge of a given height
-	BTCStakingGauge(ctx context.Context, in *QueryBTCStakingGaugeRequest, opts ...grpc.CallOption) (*QueryBTCStakingGaugeResponse, error)
-	// BTCTimestampingGauge queries the BTC timestamping gauge of a given epoch
-	BTCTimestampingGauge(ctx context.Context, in *QueryBTCTimestampingGaugeRequest, opts ...grpc.CallOption) (*QueryBTCTimestampingGaugeResponse, error)
+func (m *QueryBlockRewardDistributionRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryBlockRewardDistributionResponse is response type for the Query/BlockRewardDistribution RPC method.
+type QueryBlockRewardDistributionResponse struct {
+	// distribution is the reward distribution record at the queried height
+	Distribution *BlockRewardDistribution `protobuf:"bytes,1,opt,name=distribution,proto3" json:"distribution,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryBlockRewardDistributionResponse) Reset()         { *m = QueryBlockRewardDistributionResponse{} }
+func (m *QueryBlockRewardDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockRewardDistributionResponse) ProtoMessage()    {}
+func (*QueryBlockRewardDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{9}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBlockRewardDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockRewardDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockRewardDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryBlockRewardDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockRewardDistributionResponse.Merge(m, src)
+}
+func (m *QueryBlockRewardDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockRewardDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockRewardDistributionResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) RewardGauges(ctx context.Context, in *QueryRewardGaugesRequest, opts ...grpc.CallOption) (*QueryRewardGaugesResponse, error) {
-	out := new(QueryRewardGaugesResponse)
-	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/RewardGauges", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBlockRewardDistributionResponse proto.InternalMessageInfo
+
+func (m *QueryBlockRewardDistributionResponse) GetDistribution() *BlockRewardDistribution {
+	if m != nil {
+		return m.Distribution
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) BTCStakingGauge(ctx context.Context, in *QueryBTCStakingGaugeRequest, opts ...grpc.CallOption) (*QueryBTCStakingGaugeResponse, error) {
-	out := new(QueryBTCStakingGaugeResponse)
-	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/BTCStakingGauge", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryBTCDelegationRewardLockupRequest is request type for the Query/BTCDelegationRewardLockup RPC method.
+type QueryBTCDelegationRewardLockupRequest struct {
+	// staking_tx_hash_hex is the staking tx hash of the BTC delegation in hex
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
 }
 
-func (c *queryClient) BTCTimestampingGauge(ctx context.Context, in *QueryBTCTimestampingGaugeRequest, opts ...grpc.CallOption) (*QueryBTCTimestampingGaugeResponse, error) {
-	out := new(QueryBTCTimestampingGaugeResponse)
-	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/BTCTimestampingGauge", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBTCDelegationRewardLockupRequest) Reset()         { *m = QueryBTCDelegationRewardLockupRequest{} }
+func (m *QueryBTCDelegationRewardLockupRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationRewardLockupRequest) ProtoMessage()    {}
+func (*QueryBTCDelegationRewardLockupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{10}
+}
+func (m *QueryBTCDelegationRewardLockupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationRewardLockupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationRewardLockupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryBTCDelegationRewardLockupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationRewardLockupRequest.Merge(m, src)
+}
+func (m *QueryBTCDelegationRewardLockupRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationRewardLockupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationRewardLockupRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// RewardGauge queries the reward gauge of a given stakeholder address
-	RewardGauges(context.Context, *QueryRewardGaugesRequest) (*QueryRewardGaugesResponse, error)
-	// BTCStakingGauge queries the BTC staking gauge of a given height
-	BTCStakingGauge(context.Context, *QueryBTCStakingGaugeRequest) (*QueryBTCStakingGaugeResponse, error)
-	// BTCTimestampingGauge queries the BTC timestamping gauge of a given epoch
-	BTCTimestampingGauge(context.Context, *QueryBTCTimestampingGaugeRequest) (*QueryBTCTimestampingGaugeResponse, error)
+var xxx_messageInfo_QueryBTCDelegationRewardLockupRequest proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationRewardLockupRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryBTCDelegationRewardLockupResponse is response type for the Query/BTCDelegationRewardLockup RPC method.
+type QueryBTCDelegationRewardLockupResponse struct {
+	// reward_start_epoch is the epoch in which the BTC delegation first
+	// received rewards
+	RewardStartEpoch uint64 `protobuf:"varint,1,opt,name=reward_start_epoch,json=rewardStartEpoch,proto3" json:"reward_start_epoch,omitempty"`
+	// reward_active_epoch is the epoch from which the BTC delegation earns full
+	// rewards under the current reward_lockup_epochs
+	RewardActiveEpoch uint64 `protobuf:"varint,2,opt,name=reward_active_epoch,json=rewardActiveEpoch,proto3" json:"reward_active_epoch,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryBTCDelegationRewardLockupResponse) Reset() {
+	*m = QueryBTCDelegationRewardLockupResponse{}
 }
-func (*UnimplementedQueryServer) RewardGauges(ctx context.Context, req *QueryRewardGaugesRequest) (*QueryRewardGaugesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RewardGauges not implemented")
+func (m *QueryBTCDelegationRewardLockupResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationRewardLockupResponse) ProtoMessage()    {}
+func (*QueryBTCDelegationRewardLockupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{11}
 }
-func (*UnimplementedQueryServer) BTCStakingGauge(ctx context.Context, req *QueryBTCStakingGaugeRequest) (*QueryBTCStakingGaugeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BTCStakingGauge not implemented")
+func (m *QueryBTCDelegationRewardLockupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) BTCTimestampingGauge(ctx context.Context, req *QueryBTCTimestampingGaugeRequest) (*QueryBTCTimestampingGaugeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BTCTimestampingGauge not implemented")
+func (m *QueryBTCDelegationRewardLockupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationRewardLockupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryBTCDelegationRewardLockupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationRewardLockupResponse.Merge(m, src)
+}
+func (m *QueryBTCDelegationRewardLockupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationRewardLockupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationRewardLockupResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.incentive.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryBTCDelegationRewardLockupResponse proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationRewardLockupResponse) GetRewardStartEpoch() uint64 {
+	if m != nil {
+		return m.RewardStartEpoch
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_RewardGauges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRewardGaugesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RewardGauges(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.incentive.Query/RewardGauges",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RewardGauges(ctx, req.(*QueryRewardGaugesRequest))
+func (m *QueryBTCDelegationRewardLockupResponse) GetRewardActiveEpoch() uint64 {
+	if m != nil {
+		return m.RewardActiveEpoch
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_BTCStakingGauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBTCStakingGaugeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BTCStakingGauge(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.incentive.Query/BTCStakingGauge",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BTCStakingGauge(ctx, req.(*QueryBTCStakingGaugeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryLifetimeRewardsRequest is request type for the Query/LifetimeRewards RPC method.
+type QueryLifetimeRewardsRequest struct {
+	// address is the address of the stakeholder in bech32 string
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// stakeholder_type is the type of the stakeholder, i.e., one of
+	// {submitter, reporter, finality_provider, btc_delegation}
+	StakeholderType string `protobuf:"bytes,2,opt,name=stakeholder_type,json=stakeholderType,proto3" json:"stakeholder_type,omitempty"`
 }
 
-func _Query_BTCTimestampingGauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBTCTimestampingGaugeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BTCTimestampingGauge(ctx, in)
+func (m *QueryLifetimeRewardsRequest) Reset()         { *m = QueryLifetimeRewardsRequest{} }
+func (m *QueryLifetimeRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLifetimeRewardsRequest) ProtoMessage()    {}
+func (*QueryLifetimeRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{12}
+}
+func (m *QueryLifetimeRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLifetimeRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLifetimeRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.incentive.Query/BTCTimestampingGauge",
+}
+func (m *QueryLifetimeRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLifetimeRewardsRequest.Merge(m, src)
+}
+func (m *QueryLifetimeRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLifetimeRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLifetimeRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLifetimeRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryLifetimeRewardsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BTCTimestampingGauge(ctx, req.(*QueryBTCTimestampingGaugeRequest))
+	return ""
+}
+
+func (m *QueryLifetimeRewardsRequest) GetStakeholderType() string {
+	if m != nil {
+		return m.StakeholderType
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "babylon.incentive.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "RewardGauges",
-			Handler:    _Query_RewardGauges_Handler,
-		},
-		{
-			MethodName: "BTCStakingGauge",
-			Handler:    _Query_BTCStakingGauge_Handler,
-		},
-		{
-			MethodName: "BTCTimestampingGauge",
-			Handler:    _Query_BTCTimestampingGauge_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "babylon/incentive/query.proto",
+// QueryLifetimeRewardsResponse is response type for the Query/LifetimeRewards RPC method.
+type QueryLifetimeRewardsResponse struct {
+	// lifetime_rewards is the cumulative rewards ever credited to the
+	// stakeholder in the given type
+	LifetimeRewards *LifetimeRewards `protobuf:"bytes,1,opt,name=lifetime_rewards,json=lifetimeRewards,proto3" json:"lifetime_rewards,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryLifetimeRewardsResponse) Reset()         { *m = QueryLifetimeRewardsResponse{} }
+func (m *QueryLifetimeRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLifetimeRewardsResponse) ProtoMessage()    {}
+func (*QueryLifetimeRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{13}
+}
+func (m *QueryLifetimeRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLifetimeRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLifetimeRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryLifetimeRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLifetimeRewardsResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryLifetimeRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLifetimeRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLifetimeRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLifetimeRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryLifetimeRewardsResponse) GetLifetimeRewards() *LifetimeRewards {
+	if m != nil {
+		return m.LifetimeRewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRewardGaugeDenomsRequest is request type for the Query/RewardGaugeDenoms RPC method.
+type QueryRewardGaugeDenomsRequest struct {
+	// address is the address of the stakeholder in bech32 string
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRewardGaugeDenomsRequest) Reset()         { *m = QueryRewardGaugeDenomsRequest{} }
+func (m *QueryRewardGaugeDenomsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardGaugeDenomsRequest) ProtoMessage()    {}
+func (*QueryRewardGaugeDenomsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{14}
+}
+func (m *QueryRewardGaugeDenomsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardGaugeDenomsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardGaugeDenomsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryRewardGaugesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRewardGaugeDenomsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardGaugeDenomsRequest.Merge(m, src)
 }
-
-func (m *QueryRewardGaugesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRewardGaugeDenomsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryRewardGaugesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryRewardGaugeDenomsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardGaugeDenomsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRewardGaugesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRewardGaugeDenomsRequest proto.InternalMessageInfo
+
+func (m *QueryRewardGaugeDenomsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryRewardGaugesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRewardGaugeDenomsResponse is response type for the Query/RewardGaugeDenoms RPC method.
+type QueryRewardGaugeDenomsResponse struct {
+	// denoms is the sorted list of denoms ever credited to the reward gauges
+	// of the stakeholder across all stakeholder types
+	Denoms []string `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	// withdrawable_denoms is the sorted list of denoms that the stakeholder
+	// can currently withdraw from its reward gauges
+	WithdrawableDenoms []string `protobuf:"bytes,2,rep,name=withdrawable_denoms,json=withdrawableDenoms,proto3" json:"withdrawable_denoms,omitempty"`
 }
 
-func (m *QueryRewardGaugesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RewardGauges) > 0 {
-		for k := range m.RewardGauges {
-			v := m.RewardGauges[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintQuery(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryRewardGaugeDenomsResponse) Reset()         { *m = QueryRewardGaugeDenomsResponse{} }
+func (m *QueryRewardGaugeDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardGaugeDenomsResponse) ProtoMessage()    {}
+func (*QueryRewardGaugeDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{15}
+}
+func (m *QueryRewardGaugeDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardGaugeDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardGaugeDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryBTCStakingGaugeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRewardGaugeDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardGaugeDenomsResponse.Merge(m, src)
 }
-
-func (m *QueryBTCStakingGaugeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRewardGaugeDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardGaugeDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardGaugeDenomsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBTCStakingGaugeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryRewardGaugeDenomsResponse proto.InternalMessageInfo
+
+func (m *QueryRewardGaugeDenomsResponse) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryBTCStakingGaugeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRewardGaugeDenomsResponse) GetWithdrawableDenoms() []string {
+	if m != nil {
+		return m.WithdrawableDenoms
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBTCStakingGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDelegatorRewardsByValidatorRequest is request type for the Query/DelegatorRewardsByValidator RPC method.
+type QueryDelegatorRewardsByValidatorRequest struct {
+	// del_btc_pk_hex is the hex str of the BTC PK of the BTC delegator
+	DelBtcPkHex string `protobuf:"bytes,1,opt,name=del_btc_pk_hex,json=delBtcPkHex,proto3" json:"del_btc_pk_hex,omitempty"`
 }
 
-func (m *QueryBTCStakingGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Gauge != nil {
-		{
-			size, err := m.Gauge.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDelegatorRewardsByValidatorRequest) Reset() {
+	*m = QueryDelegatorRewardsByValidatorRequest{}
+}
+func (m *QueryDelegatorRewardsByValidatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorRewardsByValidatorRequest) ProtoMessage()    {}
+func (*QueryDelegatorRewardsByValidatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{16}
+}
+func (m *QueryDelegatorRewardsByValidatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorRewardsByValidatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorRewardsByValidatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDelegatorRewardsByValidatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorRewardsByValidatorRequest.Merge(m, src)
+}
+func (m *QueryDelegatorRewardsByValidatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorRewardsByValidatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorRewardsByValidatorRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBTCTimestampingGaugeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDelegatorRewardsByValidatorRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatorRewardsByValidatorRequest) GetDelBtcPkHex() string {
+	if m != nil {
+		return m.DelBtcPkHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryBTCTimestampingGaugeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDelegatorRewardsByValidatorResponse is response type for the Query/DelegatorRewardsByValidator RPC method.
+type QueryDelegatorRewardsByValidatorResponse struct {
+	// rewards is the list of cumulative rewards credited to the BTC delegator
+	// under each finality provider, sorted by the finality provider's BTC PK
+	Rewards []*DelegatorValidatorRewards `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	// total is the sum of the rewards over all finality providers
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
 }
 
-func (m *QueryBTCTimestampingGaugeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EpochNum != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryDelegatorRewardsByValidatorResponse) Reset() {
+	*m = QueryDelegatorRewardsByValidatorResponse{}
+}
+func (m *QueryDelegatorRewardsByValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorRewardsByValidatorResponse) ProtoMessage()    {}
+func (*QueryDelegatorRewardsByValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{17}
+}
+func (m *QueryDelegatorRewardsByValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorRewardsByValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorRewardsByValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDelegatorRewardsByValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorRewardsByValidatorResponse.Merge(m, src)
+}
+func (m *QueryDelegatorRewardsByValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorRewardsByValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorRewardsByValidatorResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBTCTimestampingGaugeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDelegatorRewardsByValidatorResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorRewardsByValidatorResponse) GetRewards() []*DelegatorValidatorRewards {
+	if m != nil {
+		return m.Rewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBTCTimestampingGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDelegatorRewardsByValidatorResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
+	}
+	return nil
 }
 
-func (m *QueryBTCTimestampingGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Gauge != nil {
-		{
-			size, err := m.Gauge.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryRewardPoolBalanceRequest is request type for the Query/RewardPoolBalance RPC method.
+type QueryRewardPoolBalanceRequest struct {
+}
+
+func (m *QueryRewardPoolBalanceRequest) Reset()         { *m = QueryRewardPoolBalanceRequest{} }
+func (m *QueryRewardPoolBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPoolBalanceRequest) ProtoMessage()    {}
+func (*QueryRewardPoolBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{18}
+}
+func (m *QueryRewardPoolBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardPoolBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardPoolBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRewardPoolBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPoolBalanceRequest.Merge(m, src)
+}
+func (m *QueryRewardPoolBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardPoolBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPoolBalanceRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+var xxx_messageInfo_QueryRewardPoolBalanceRequest proto.InternalMessageInfo
+
+// QueryRewardPoolBalanceResponse is response type for the Query/RewardPoolBalance RPC method.
+type QueryRewardPoolBalanceResponse struct {
+	// fee_collector_balance is the balance of the fee collector account, which
+	// includes the fees and the minted tokens of the current block. Part of it
+	// is intercepted by the incentive module at the next block, while the rest
+	// is distributed to validators and their delegators by x/distribution
+	FeeCollectorBalance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fee_collector_balance,json=feeCollectorBalance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_collector_balance"`
+	// to_be_intercepted is the portion of fee_collector_balance that the
+	// incentive module will intercept for the BTC staking and BTC timestamping
+	// gauges at the next block under the current parameters
+	ToBeIntercepted github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=to_be_intercepted,json=toBeIntercepted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"to_be_intercepted"`
+	// module_balance is the balance of the incentive module account, which
+	// backs the gauges that are pending distribution and the reward gauges
+	// that are not withdrawn yet
+	ModuleBalance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=module_balance,json=moduleBalance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"module_balance"`
+	// denoms is the breakdown of the above balances by denom, sorted by denom
+	Denoms []*RewardPoolDenomBalance `protobuf:"bytes,4,rep,name=denoms,proto3" json:"denoms,omitempty"`
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryRewardPoolBalanceResponse) Reset()         { *m = QueryRewardPoolBalanceResponse{} }
+func (m *QueryRewardPoolBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardPoolBalanceResponse) ProtoMessage()    {}
+func (*QueryRewardPoolBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{19}
+}
+func (m *QueryRewardPoolBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardPoolBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardPoolBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryRewardPoolBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardPoolBalanceResponse.Merge(m, src)
+}
+func (m *QueryRewardPoolBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardPoolBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardPoolBalanceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryRewardPoolBalanceResponse proto.InternalMessageInfo
+
+func (m *QueryRewardPoolBalanceResponse) GetFeeCollectorBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeCollectorBalance
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryRewardGaugesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRewardPoolBalanceResponse) GetToBeIntercepted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ToBeIntercepted
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *QueryRewardPoolBalanceResponse) GetModuleBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ModuleBalance
 	}
-	return n
+	return nil
 }
 
-func (m *QueryRewardGaugesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRewardPoolBalanceResponse) GetDenoms() []*RewardPoolDenomBalance {
+	if m != nil {
+		return m.Denoms
 	}
-	var l int
-	_ = l
-	if len(m.RewardGauges) > 0 {
-		for k, v := range m.RewardGauges {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovQuery(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+	return nil
+}
+
+// RewardPoolDenomBalance is the balances of the reward pool in a denom
+type RewardPoolDenomBalance struct {
+	// denom is the denom of the balances
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// fee_collector_amount is the amount in the fee collector account
+	FeeCollectorAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=fee_collector_amount,json=feeCollectorAmount,proto3,customtype=cosmossdk.io/math.Int" json:"fee_collector_amount"`
+	// to_be_intercepted_amount is the amount to be intercepted from the fee
+	// collector account by the incentive module at the next block
+	ToBeInterceptedAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=to_be_intercepted_amount,json=toBeInterceptedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"to_be_intercepted_amount"`
+	// module_amount is the amount in the incentive module account
+	ModuleAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=module_amount,json=moduleAmount,proto3,customtype=cosmossdk.io/math.Int" json:"module_amount"`
+}
+
+func (m *RewardPoolDenomBalance) Reset()         { *m = RewardPoolDenomBalance{} }
+func (m *RewardPoolDenomBalance) String() string { return proto.CompactTextString(m) }
+func (*RewardPoolDenomBalance) ProtoMessage()    {}
+func (*RewardPoolDenomBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1a59cc0c7c44135, []int{20}
+}
+func (m *RewardPoolDenomBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardPoolDenomBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardPoolDenomBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *RewardPoolDenomBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardPoolDenomBalance.Merge(m, src)
+}
+func (m *RewardPoolDenomBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardPoolDenomBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardPoolDenomBalance.DiscardUnknown(m)
 }
 
-func (m *QueryBTCStakingGaugeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+var xxx_messageInfo_RewardPoolDenomBalance proto.InternalMessageInfo
+
+func (m *RewardPoolDenomBalance) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return n
+	return ""
 }
 
-func (m *QueryBTCStakingGaugeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.incentive.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.incentive.QueryParamsResponse")
+	proto.RegisterType((*QueryRewardGaugesRequest)(nil), "babylon.incentive.QueryRewardGaugesRequest")
+	proto.RegisterType((*QueryRewardGaugesResponse)(nil), "babylon.incentive.QueryRewardGaugesResponse")
+	proto.RegisterMapType((map[string]*RewardGauge)(nil), "babylon.incentive.QueryRewardGaugesResponse.RewardGaugesEntry")
+	proto.RegisterType((*QueryBTCStakingGaugeRequest)(nil), "babylon.incentive.QueryBTCStakingGaugeRequest")
+	proto.RegisterType((*QueryBTCStakingGaugeResponse)(nil), "babylon.incentive.QueryBTCStakingGaugeResponse")
+	proto.RegisterType((*QueryBTCTimestampingGaugeRequest)(nil), "babylon.incentive.QueryBTCTimestampingGaugeRequest")
+	proto.RegisterType((*QueryBTCTimestampingGaugeResponse)(nil), "babylon.incentive.QueryBTCTimestampingGaugeResponse")
+	proto.RegisterType((*QueryBlockRewardDistributionRequest)(nil), "babylon.incentive.QueryBlockRewardDistributionRequest")
+	proto.RegisterType((*QueryBlockRewardDistributionResponse)(nil), "babylon.incentive.QueryBlockRewardDistributionResponse")
+	proto.RegisterType((*QueryBTCDelegationRewardLockupRequest)(nil), "babylon.incentive.QueryBTCDelegationRewardLockupRequest")
+	proto.RegisterType((*QueryBTCDelegationRewardLockupResponse)(nil), "babylon.incentive.QueryBTCDelegationRewardLockupResponse")
+	proto.RegisterType((*QueryLifetimeRewardsRequest)(nil), "babylon.incentive.QueryLifetimeRewardsRequest")
+	proto.RegisterType((*QueryLifetimeRewardsResponse)(nil), "babylon.incentive.QueryLifetimeRewardsResponse")
+	proto.RegisterType((*QueryRewardGaugeDenomsRequest)(nil), "babylon.incentive.QueryRewardGaugeDenomsRequest")
+	proto.RegisterType((*QueryRewardGaugeDenomsResponse)(nil), "babylon.incentive.QueryRewardGaugeDenomsResponse")
+	proto.RegisterType((*QueryDelegatorRewardsByValidatorRequest)(nil), "babylon.incentive.QueryDelegatorRewardsByValidatorRequest")
+	proto.RegisterType((*QueryDelegatorRewardsByValidatorResponse)(nil), "babylon.incentive.QueryDelegatorRewardsByValidatorResponse")
+	proto.RegisterType((*QueryRewardPoolBalanceRequest)(nil), "babylon.incentive.QueryRewardPoolBalanceRequest")
+	proto.RegisterType((*QueryRewardPoolBalanceResponse)(nil), "babylon.incentive.QueryRewardPoolBalanceResponse")
+	proto.RegisterType((*RewardPoolDenomBalance)(nil), "babylon.incentive.RewardPoolDenomBalance")
+}
+
+func init() { proto.RegisterFile("babylon/incentive/query.proto", fileDescriptor_e1a59cc0c7c44135) }
+
+var fileDescriptor_e1a59cc0c7c44135 = []byte{
+	// 1459 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x5b, 0x6f, 0xdc, 0x44,
+	0x1b, 0x8e, 0x73, 0xea, 0x97, 0xb7, 0x69, 0x93, 0x4c, 0xd2, 0x7e, 0x9b, 0x4d, 0xbb, 0x69, 0xdd,
+	0xaf, 0xfd, 0x52, 0xda, 0xd8, 0x4d, 0x0f, 0xf4, 0x80, 0x0a, 0x74, 0x7b, 0x4a, 0xa0, 0x84, 0xb0,
+	0x8d, 0x7a, 0x81, 0x84, 0xac, 0xb1, 0x3d, 0xdd, 0xb5, 0xd6, 0xf6, 0x6c, 0xed, 0xd9, 0x24, 0x4b,
+	0x14, 0x21, 0x71, 0xc1, 0x35, 0x12, 0x7f, 0x81, 0x0b, 0xe0, 0x9a, 0x2b, 0xae, 0xb8, 0x00, 0xa9,
+	0xdc, 0x15, 0x10, 0x12, 0xe2, 0xa2, 0xf4, 0x80, 0xc4, 0xdf, 0x40, 0x9e, 0x19, 0x6f, 0xbd, 0x1b,
+	0x7b, 0x0f, 0x55, 0xb9, 0x8a, 0x3d, 0xef, 0xe9, 0x79, 0xde, 0xd7, 0x33, 0xf3, 0x64, 0xe1, 0xb0,
+	0x89, 0xcd, 0x86, 0x4b, 0x7d, 0xdd, 0xf1, 0x2d, 0xe2, 0x33, 0x67, 0x83, 0xe8, 0x0f, 0xea, 0x24,
+	0x68, 0x68, 0xb5, 0x80, 0x32, 0x8a, 0xa6, 0xa4, 0x59, 0x6b, 0x9a, 0xf3, 0x33, 0x65, 0x5a, 0xa6,
+	0xdc, 0xaa, 0x47, 0x4f, 0xc2, 0x31, 0x3f, 0x6b, 0xd1, 0xd0, 0xa3, 0xa1, 0x21, 0x0c, 0xe2, 0x45,
+	0x9a, 0x0e, 0x95, 0x29, 0x2d, 0xbb, 0x44, 0xc7, 0x35, 0x47, 0xc7, 0xbe, 0x4f, 0x19, 0x66, 0x0e,
+	0xf5, 0x63, 0x6b, 0x61, 0x37, 0x80, 0x1a, 0x0e, 0xb0, 0x17, 0xdb, 0x8f, 0xee, 0xb6, 0x37, 0x9f,
+	0xe2, 0x14, 0xa2, 0x9c, 0x6e, 0xe2, 0x90, 0xe8, 0x1b, 0x4b, 0x26, 0x61, 0x78, 0x49, 0xb7, 0xa8,
+	0xe3, 0x0b, 0xbb, 0x3a, 0x03, 0xe8, 0x83, 0x88, 0xd3, 0x1a, 0xcf, 0x5b, 0x22, 0x0f, 0xea, 0x24,
+	0x64, 0xea, 0x2a, 0x4c, 0xb7, 0xac, 0x86, 0x35, 0xea, 0x87, 0x04, 0x5d, 0x84, 0x51, 0x51, 0x3f,
+	0xa7, 0x1c, 0x51, 0x16, 0xf6, 0x9e, 0x9d, 0xd5, 0x76, 0xb5, 0x40, 0x13, 0x21, 0xc5, 0xe1, 0x87,
+	0x8f, 0xe7, 0x07, 0x4a, 0xd2, 0x5d, 0x3d, 0x0f, 0x39, 0x9e, 0xaf, 0x44, 0x36, 0x71, 0x60, 0xdf,
+	0xc6, 0xf5, 0x32, 0x89, 0x6b, 0xa1, 0x1c, 0xec, 0xc1, 0xb6, 0x1d, 0x90, 0x50, 0x64, 0x1d, 0x2b,
+	0xc5, 0xaf, 0xea, 0x53, 0x05, 0x66, 0x53, 0xc2, 0x24, 0x18, 0x0b, 0xf6, 0x05, 0x7c, 0xdd, 0x28,
+	0x73, 0x43, 0x4e, 0x39, 0x32, 0xb4, 0xb0, 0xf7, 0xec, 0x9b, 0x29, 0x98, 0x32, 0x93, 0x68, 0xc9,
+	0xc5, 0x9b, 0x3e, 0x0b, 0x1a, 0xa5, 0xf1, 0x20, 0xb1, 0x94, 0x37, 0x60, 0x6a, 0x97, 0x0b, 0x9a,
+	0x84, 0xa1, 0x2a, 0x69, 0x48, 0xb4, 0xd1, 0x23, 0x3a, 0x0f, 0x23, 0x1b, 0xd8, 0xad, 0x93, 0xdc,
+	0x20, 0xef, 0x4b, 0x21, 0x05, 0x43, 0x22, 0x4d, 0x49, 0x38, 0x5f, 0x19, 0xbc, 0xa4, 0xa8, 0x17,
+	0x60, 0x8e, 0xa3, 0x2b, 0xae, 0x5f, 0xbf, 0xcb, 0x70, 0xd5, 0xf1, 0xcb, 0xc2, 0x45, 0x36, 0xe7,
+	0x20, 0x8c, 0x56, 0x88, 0x53, 0xae, 0x30, 0x5e, 0x6d, 0xb8, 0x24, 0xdf, 0xd4, 0x55, 0x38, 0x94,
+	0x1e, 0x26, 0x9b, 0xa3, 0xc1, 0x08, 0xef, 0x8a, 0x1c, 0x54, 0x2e, 0x05, 0x90, 0x84, 0xc2, 0xdd,
+	0xd4, 0xb7, 0xe0, 0x48, 0x9c, 0x6f, 0xdd, 0xf1, 0x48, 0xc8, 0xb0, 0x57, 0x6b, 0xc7, 0x32, 0x07,
+	0x63, 0xa4, 0x46, 0xad, 0x8a, 0xe1, 0xd7, 0x3d, 0x09, 0xe7, 0x3f, 0x7c, 0x61, 0xb5, 0xee, 0xa9,
+	0x77, 0xe1, 0x68, 0x87, 0x04, 0x2f, 0x89, 0xea, 0x2a, 0x1c, 0x13, 0x49, 0x5d, 0x6a, 0x55, 0x45,
+	0x03, 0x6f, 0x38, 0x21, 0x0b, 0x1c, 0xb3, 0x1e, 0x6d, 0x93, 0x6e, 0x4d, 0xda, 0x80, 0xff, 0x75,
+	0x0e, 0x97, 0xb0, 0x56, 0x61, 0xdc, 0x4e, 0xac, 0x4b, 0x74, 0xaf, 0xa5, 0xa0, 0xcb, 0xca, 0xd4,
+	0x12, 0xaf, 0xde, 0x83, 0xe3, 0x71, 0x2f, 0x6e, 0x10, 0x97, 0x94, 0xb1, 0xa8, 0x16, 0x45, 0xdd,
+	0xa1, 0x56, 0xb5, 0x5e, 0x8b, 0x81, 0x2f, 0xc2, 0x74, 0x28, 0xa6, 0x67, 0xb0, 0x2d, 0xa3, 0x82,
+	0xc3, 0x8a, 0x51, 0x21, 0x5b, 0xf2, 0xc3, 0x9a, 0x94, 0xa6, 0xf5, 0xad, 0x65, 0x1c, 0x56, 0x96,
+	0xc9, 0x96, 0xfa, 0x99, 0x02, 0x27, 0xba, 0x25, 0x96, 0x94, 0x4e, 0x03, 0x92, 0x9b, 0x23, 0x64,
+	0x38, 0x60, 0x06, 0x9f, 0x93, 0x6c, 0xcf, 0xa4, 0xb0, 0xdc, 0x8d, 0x0c, 0x37, 0xa3, 0x75, 0xa4,
+	0xc1, 0xb4, 0xf4, 0xc6, 0x56, 0xc4, 0x53, 0xba, 0x0f, 0x72, 0xf7, 0x29, 0x61, 0xba, 0xc6, 0x2d,
+	0xdc, 0x5f, 0x35, 0xe5, 0x47, 0x7b, 0xc7, 0xb9, 0x4f, 0x98, 0xe3, 0x11, 0x01, 0xa1, 0xfb, 0x8e,
+	0x46, 0x27, 0x81, 0xb3, 0x22, 0x15, 0xea, 0xda, 0x24, 0x30, 0x58, 0xa3, 0x26, 0xb6, 0xcc, 0x58,
+	0x69, 0x22, 0xb1, 0xbe, 0xde, 0xa8, 0x11, 0xd5, 0x83, 0x43, 0xe9, 0x35, 0x24, 0xc3, 0xf7, 0x60,
+	0xd2, 0x95, 0x26, 0x43, 0x20, 0x8c, 0x4f, 0x25, 0x35, 0x65, 0x70, 0xed, 0x59, 0x26, 0xdc, 0xd6,
+	0x05, 0xf5, 0x32, 0x1c, 0x6e, 0x3f, 0x25, 0x6e, 0x10, 0x9f, 0x7a, 0x3d, 0x1c, 0x53, 0x0e, 0x14,
+	0xb2, 0x42, 0x25, 0xd6, 0x83, 0x30, 0x6a, 0xf3, 0x15, 0x7e, 0x46, 0x8d, 0x95, 0xe4, 0x1b, 0xd2,
+	0x61, 0x7a, 0xd3, 0x61, 0x15, 0x3b, 0xc0, 0x9b, 0xd8, 0x74, 0x89, 0x21, 0x9d, 0x06, 0xb9, 0x13,
+	0x4a, 0x9a, 0x44, 0x42, 0x75, 0x15, 0xfe, 0xcf, 0x4b, 0xc9, 0xe9, 0xd3, 0x40, 0xc2, 0x2f, 0x36,
+	0xee, 0x61, 0xd7, 0xb1, 0xc5, 0x8a, 0xc0, 0x7b, 0x0c, 0xf6, 0xdb, 0xc4, 0x35, 0x4c, 0x66, 0x19,
+	0xb5, 0x6a, 0xe2, 0xb3, 0xda, 0x6b, 0x13, 0xb7, 0xc8, 0xac, 0xb5, 0x6a, 0xf4, 0x45, 0xfd, 0xa6,
+	0xc0, 0x42, 0xf7, 0x84, 0x92, 0xc5, 0x2d, 0xd8, 0xf3, 0xa2, 0xd1, 0xd1, 0x51, 0x7b, 0x3a, 0xa5,
+	0xd1, 0xcd, 0x44, 0x89, 0x78, 0xd1, 0xf2, 0x38, 0x18, 0x61, 0x18, 0x61, 0x94, 0x61, 0x97, 0xf3,
+	0x8c, 0x2e, 0x11, 0x79, 0x23, 0x46, 0x57, 0x94, 0x26, 0xaf, 0x28, 0xed, 0x3a, 0x75, 0xfc, 0xe2,
+	0x99, 0xe8, 0x12, 0xf9, 0xe6, 0xcf, 0xf9, 0x85, 0xb2, 0xc3, 0x2a, 0x75, 0x53, 0xb3, 0xa8, 0x27,
+	0xaf, 0x4f, 0xf9, 0x67, 0x31, 0xb4, 0xab, 0x7a, 0xf4, 0x15, 0x85, 0x3c, 0x20, 0x2c, 0x89, 0xcc,
+	0xea, 0x7c, 0xcb, 0x34, 0xd7, 0x28, 0x75, 0x8b, 0xd8, 0xc5, 0xbe, 0x15, 0x9f, 0x65, 0xea, 0xcf,
+	0x43, 0x50, 0xc8, 0xf2, 0x90, 0x74, 0x3f, 0x81, 0x03, 0xf7, 0x09, 0x31, 0x2c, 0xea, 0xba, 0xc4,
+	0x62, 0x34, 0x30, 0x4c, 0xe1, 0x90, 0x53, 0x5e, 0x3d, 0xec, 0xe9, 0xfb, 0x84, 0x5c, 0x8f, 0x0b,
+	0x49, 0x20, 0x68, 0x13, 0xa6, 0x18, 0x35, 0x4c, 0x62, 0x38, 0x3e, 0x23, 0x81, 0x45, 0x6a, 0x8c,
+	0xd8, 0xff, 0x46, 0xcf, 0x26, 0x18, 0x2d, 0x92, 0x95, 0x17, 0x35, 0x50, 0x00, 0xfb, 0x3d, 0x6a,
+	0xd7, 0x5d, 0xd2, 0xa4, 0x3c, 0xf4, 0xea, 0xab, 0xee, 0x13, 0x25, 0x62, 0xb2, 0xd7, 0x9a, 0x5b,
+	0x64, 0x98, 0xd7, 0x3a, 0x99, 0x79, 0x85, 0x46, 0xb3, 0xe2, 0xdb, 0x21, 0x1e, 0x98, 0x0c, 0x54,
+	0x7f, 0x1c, 0x84, 0x83, 0xe9, 0x2e, 0x68, 0x06, 0x46, 0xb8, 0x93, 0xdc, 0x03, 0xe2, 0x05, 0x7d,
+	0x04, 0x33, 0xad, 0x13, 0xc6, 0x1e, 0xad, 0xfb, 0x4c, 0x9c, 0x48, 0xc5, 0x53, 0x11, 0xa5, 0x3f,
+	0x1e, 0xcf, 0x1f, 0x10, 0x04, 0x42, 0xbb, 0xaa, 0x39, 0x54, 0xf7, 0x30, 0xab, 0x68, 0x2b, 0x3e,
+	0xfb, 0xe5, 0xdb, 0x45, 0x90, 0xdd, 0x58, 0xf1, 0x59, 0x09, 0x25, 0x07, 0x78, 0x8d, 0xa7, 0x41,
+	0x36, 0xe4, 0x76, 0xcd, 0x2f, 0x2e, 0x31, 0xd4, 0x7f, 0x89, 0x03, 0x6d, 0x63, 0x92, 0x55, 0xd6,
+	0x40, 0x76, 0x32, 0x4e, 0x3d, 0xdc, 0x7f, 0xea, 0x71, 0x91, 0x41, 0x64, 0x3c, 0xfb, 0x74, 0x3f,
+	0x8c, 0xf0, 0xbd, 0x81, 0x3e, 0x86, 0x51, 0x21, 0xe7, 0xd0, 0xf1, 0x2c, 0x55, 0xd5, 0xa2, 0x1b,
+	0xf3, 0x27, 0xba, 0xb9, 0x89, 0xbd, 0xa5, 0x1e, 0xfd, 0xf4, 0xd7, 0xbf, 0xbe, 0x18, 0x9c, 0x43,
+	0xb3, 0x7a, 0x96, 0xc2, 0x45, 0x5f, 0x2a, 0x30, 0x9e, 0x94, 0x5e, 0xe8, 0x54, 0x6f, 0xc2, 0x4e,
+	0x00, 0x39, 0xdd, 0x8f, 0x0a, 0x54, 0x2f, 0x73, 0x38, 0xe7, 0xd0, 0x52, 0x0a, 0x1c, 0x79, 0xca,
+	0xeb, 0xdb, 0xf2, 0x61, 0x47, 0x4f, 0xaa, 0x4e, 0xf4, 0xb5, 0x02, 0x13, 0x6d, 0x22, 0x0c, 0x69,
+	0x59, 0xc5, 0xd3, 0x45, 0x5e, 0x5e, 0xef, 0xd9, 0x5f, 0xe2, 0xbd, 0xc0, 0xf1, 0xea, 0x68, 0x31,
+	0x05, 0x6f, 0x74, 0xe0, 0xc7, 0xa2, 0x82, 0x43, 0xd4, 0xb7, 0x85, 0x1c, 0xda, 0x41, 0xdf, 0x2b,
+	0x30, 0x93, 0xa6, 0xcf, 0xd0, 0xb9, 0x0e, 0x00, 0xb2, 0xe4, 0x60, 0xfe, 0x7c, 0x7f, 0x41, 0x12,
+	0xfa, 0x55, 0x0e, 0xfd, 0x22, 0xba, 0x90, 0x01, 0x9d, 0x25, 0x22, 0x63, 0xfc, 0x4d, 0xd5, 0xb9,
+	0x83, 0x7e, 0x52, 0xe0, 0xbf, 0x19, 0x22, 0x0c, 0xbd, 0x9e, 0x09, 0xa8, 0xa3, 0x7c, 0xcc, 0x5f,
+	0xec, 0x3b, 0xae, 0x17, 0x2e, 0x51, 0xac, 0x14, 0x26, 0x46, 0x52, 0x1d, 0xbe, 0x18, 0xc7, 0x13,
+	0x05, 0x66, 0x33, 0x95, 0x1c, 0xba, 0xd4, 0xa1, 0xbd, 0x1d, 0x55, 0x65, 0xfe, 0xf2, 0x4b, 0x44,
+	0x4a, 0x46, 0xab, 0x9c, 0xd1, 0x32, 0xba, 0x95, 0x31, 0x1d, 0xbb, 0x19, 0x1e, 0xea, 0xdb, 0x29,
+	0xd2, 0xb5, 0xb9, 0x39, 0x5c, 0x41, 0xe2, 0x07, 0x05, 0x26, 0xda, 0xa4, 0x57, 0xf6, 0xee, 0x48,
+	0x57, 0x93, 0x79, 0xbd, 0x67, 0x7f, 0x49, 0x62, 0x8d, 0x93, 0x78, 0x07, 0x2d, 0xf7, 0xb4, 0x9b,
+	0xdb, 0x45, 0xa4, 0xbe, 0x9d, 0x50, 0xa2, 0x5c, 0xa1, 0xee, 0xa0, 0xef, 0x94, 0x96, 0x7f, 0x03,
+	0x85, 0x18, 0x43, 0x67, 0x7a, 0x38, 0x63, 0x5a, 0x34, 0x64, 0x7e, 0xa9, 0x8f, 0x08, 0x49, 0xe6,
+	0x6d, 0x4e, 0xe6, 0x0a, 0xba, 0xd4, 0xf7, 0xd1, 0x24, 0xd5, 0x24, 0xfa, 0x5b, 0x81, 0xb9, 0x0e,
+	0xf2, 0x0e, 0x5d, 0xc9, 0x02, 0xd5, 0x5d, 0x64, 0xe6, 0xdf, 0x78, 0xa9, 0x58, 0x49, 0xed, 0x7d,
+	0x4e, 0x6d, 0x05, 0xdd, 0xee, 0xfc, 0xb1, 0xd1, 0x20, 0xd4, 0xb7, 0x5b, 0xa5, 0x6c, 0x4c, 0x34,
+	0x34, 0xcc, 0x86, 0xb1, 0xd1, 0x64, 0xf2, 0x55, 0x73, 0x4c, 0x09, 0x3d, 0xd7, 0x6d, 0x4c, 0xbb,
+	0xc5, 0x61, 0x7e, 0xa9, 0x8f, 0x08, 0xc9, 0x45, 0xe3, 0x5c, 0x16, 0xd0, 0x89, 0x14, 0x2e, 0x72,
+	0x28, 0x35, 0x4a, 0xdd, 0x58, 0x50, 0x15, 0xdf, 0x7d, 0xf8, 0xac, 0xa0, 0x3c, 0x7a, 0x56, 0x50,
+	0x9e, 0x3c, 0x2b, 0x28, 0x9f, 0x3f, 0x2f, 0x0c, 0x3c, 0x7a, 0x5e, 0x18, 0xf8, 0xfd, 0x79, 0x61,
+	0xe0, 0xc3, 0xa5, 0x84, 0x82, 0x92, 0xb9, 0xac, 0x0a, 0x76, 0xfc, 0x66, 0xe2, 0xad, 0x44, 0x6a,
+	0x2e, 0xa8, 0xcc, 0x51, 0xfe, 0x53, 0xce, 0xb9, 0x7f, 0x06, 0x00, 0x36, 0x49, 0x85, 0x31, 0xb0,
+	0x12, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// RewardGauge queries the reward gauge of a given stakeholder address
+	RewardGauges(ctx context.Context, in *QueryRewardGaugesRequest, opts ...grpc.CallOption) (*QueryRewardGaugesResponse, error)
+	// BTCStakingGauge queries the BTC staking gauge of a given height
+	BTCStakingGauge(ctx context.Context, in *QueryBTCStakingGaugeRequest, opts ...grpc.CallOption) (*QueryBTCStakingGaugeResponse, error)
+	// BTCTimestampingGauge queries the BTC timestamping gauge of a given epoch
+	BTCTimestampingGauge(ctx context.Context, in *QueryBTCTimestampingGaugeRequest, opts ...grpc.CallOption) (*QueryBTCTimestampingGaugeResponse, error)
+	// BlockRewardDistribution queries how the BTC staking rewards of a given
+	// finalized height were distributed. Only the records of the last
+	// block_reward_dist_retention finalized heights are kept
+	BlockRewardDistribution(ctx context.Context, in *QueryBlockRewardDistributionRequest, opts ...grpc.CallOption) (*QueryBlockRewardDistributionResponse, error)
+	// BTCDelegationRewardLockup queries the epoch from which a given BTC
+	// delegation earns full rewards. The start epoch of a BTC delegation is
+	// only recorded under a non-zero reward lockup, and is removed once the
+	// BTC delegation is unbonded
+	BTCDelegationRewardLockup(ctx context.Context, in *QueryBTCDelegationRewardLockupRequest, opts ...grpc.CallOption) (*QueryBTCDelegationRewardLockupResponse, error)
+	// LifetimeRewards queries the cumulative rewards ever credited to a given
+	// stakeholder address in a given stakeholder type, including the withdrawn ones
+	LifetimeRewards(ctx context.Context, in *QueryLifetimeRewardsRequest, opts ...grpc.CallOption) (*QueryLifetimeRewardsResponse, error)
+	// RewardGaugeDenoms queries the denoms present in the reward gauges of a
+	// given stakeholder address across all stakeholder types
+	RewardGaugeDenoms(ctx context.Context, in *QueryRewardGaugeDenomsRequest, opts ...grpc.CallOption) (*QueryRewardGaugeDenomsResponse, error)
+	// DelegatorRewardsByValidator queries the cumulative rewards ever credited
+	// to a given BTC delegator, broken down by the finality provider that each
+	// of its BTC delegations is under
+	DelegatorRewardsByValidator(ctx context.Context, in *QueryDelegatorRewardsByValidatorRequest, opts ...grpc.CallOption) (*QueryDelegatorRewardsByValidatorResponse, error)
+	// RewardPoolBalance queries the balances of the accounts in which rewards
+	// are pooled before being distributed
+	RewardPoolBalance(ctx context.Context, in *QueryRewardPoolBalanceRequest, opts ...grpc.CallOption) (*QueryRewardPoolBalanceResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Gauge != nil {
-		l = m.Gauge.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) RewardGauges(ctx context.Context, in *QueryRewardGaugesRequest, opts ...grpc.CallOption) (*QueryRewardGaugesResponse, error) {
+	out := new(QueryRewardGaugesResponse)
+	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/RewardGauges", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryBTCTimestampingGaugeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) BTCStakingGauge(ctx context.Context, in *QueryBTCStakingGaugeRequest, opts ...grpc.CallOption) (*QueryBTCStakingGaugeResponse, error) {
+	out := new(QueryBTCStakingGaugeResponse)
+	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/BTCStakingGauge", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.EpochNum != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNum))
+	return out, nil
+}
+
+func (c *queryClient) BTCTimestampingGauge(ctx context.Context, in *QueryBTCTimestampingGaugeRequest, opts ...grpc.CallOption) (*QueryBTCTimestampingGaugeResponse, error) {
+	out := new(QueryBTCTimestampingGaugeResponse)
+	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/BTCTimestampingGauge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockRewardDistribution(ctx context.Context, in *QueryBlockRewardDistributionRequest, opts ...grpc.CallOption) (*QueryBlockRewardDistributionResponse, error) {
+	out := new(QueryBlockRewardDistributionResponse)
+	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/BlockRewardDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BTCDelegationRewardLockup(ctx context.Context, in *QueryBTCDelegationRewardLockupRequest, opts ...grpc.CallOption) (*QueryBTCDelegationRewardLockupResponse, error) {
+	out := new(QueryBTCDelegationRewardLockupResponse)
+	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/BTCDelegationRewardLockup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LifetimeRewards(ctx context.Context, in *QueryLifetimeRewardsRequest, opts ...grpc.CallOption) (*QueryLifetimeRewardsResponse, error) {
+	out := new(QueryLifetimeRewardsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/LifetimeRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardGaugeDenoms(ctx context.Context, in *QueryRewardGaugeDenomsRequest, opts ...grpc.CallOption) (*QueryRewardGaugeDenomsResponse, error) {
+	out := new(QueryRewardGaugeDenomsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/RewardGaugeDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorRewardsByValidator(ctx context.Context, in *QueryDelegatorRewardsByValidatorRequest, opts ...grpc.CallOption) (*QueryDelegatorRewardsByValidatorResponse, error) {
+	out := new(QueryDelegatorRewardsByValidatorResponse)
+	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/DelegatorRewardsByValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardPoolBalance(ctx context.Context, in *QueryRewardPoolBalanceRequest, opts ...grpc.CallOption) (*QueryRewardPoolBalanceResponse, error) {
+	out := new(QueryRewardPoolBalanceResponse)
+	err := c.cc.Invoke(ctx, "/babylon.incentive.Query/RewardPoolBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// RewardGauge queries the reward gauge of a given stakeholder address
+	RewardGauges(context.Context, *QueryRewardGaugesRequest) (*QueryRewardGaugesResponse, error)
+	// BTCStakingGauge queries the BTC staking gauge of a given height
+	BTCStakingGauge(context.Context, *QueryBTCStakingGaugeRequest) (*QueryBTCStakingGaugeResponse, error)
+	// BTCTimestampingGauge queries the BTC timestamping gauge of a given epoch
+	BTCTimestampingGauge(context.Context, *QueryBTCTimestampingGaugeRequest) (*QueryBTCTimestampingGaugeResponse, error)
+	// BlockRewardDistribution queries how the BTC staking rewards of a given
+	// finalized height were distributed. Only the records of the last
+	// block_reward_dist_retention finalized heights are kept
+	BlockRewardDistribution(context.Context, *QueryBlockRewardDistributionRequest) (*QueryBlockRewardDistributionResponse, error)
+	// BTCDelegationRewardLockup queries the epoch from which a given BTC
+	// delegation earns full rewards. The start epoch of a BTC delegation is
+	// only recorded under a non-zero reward lockup, and is removed once the
+	// BTC delegation is unbonded
+	BTCDelegationRewardLockup(context.Context, *QueryBTCDelegationRewardLockupRequest) (*QueryBTCDelegationRewardLockupResponse, error)
+	// LifetimeRewards queries the cumulative rewards ever credited to a given
+	// stakeholder address in a given stakeholder type, including the withdrawn ones
+	LifetimeRewards(context.Context, *QueryLifetimeRewardsRequest) (*QueryLifetimeRewardsResponse, error)
+	// RewardGaugeDenoms queries the denoms present in the reward gauges of a
+	// given stakeholder address across all stakeholder types
+	RewardGaugeDenoms(context.Context, *QueryRewardGaugeDenomsRequest) (*QueryRewardGaugeDenomsResponse, error)
+	// DelegatorRewardsByValidator queries the cumulative rewards ever credited
+	// to a given BTC delegator, broken down by the finality provider that each
+	// of its BTC delegations is under
+	DelegatorRewardsByValidator(context.Context, *QueryDelegatorRewardsByValidatorRequest) (*QueryDelegatorRewardsByValidatorResponse, error)
+	// RewardPoolBalance queries the balances of the accounts in which rewards
+	// are pooled before being distributed
+	RewardPoolBalance(context.Context, *QueryRewardPoolBalanceRequest) (*QueryRewardPoolBalanceResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) RewardGauges(ctx context.Context, req *QueryRewardGaugesRequest) (*QueryRewardGaugesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardGauges not implemented")
+}
+func (*UnimplementedQueryServer) BTCStakingGauge(ctx context.Context, req *QueryBTCStakingGaugeRequest) (*QueryBTCStakingGaugeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCStakingGauge not implemented")
+}
+func (*UnimplementedQueryServer) BTCTimestampingGauge(ctx context.Context, req *QueryBTCTimestampingGaugeRequest) (*QueryBTCTimestampingGaugeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCTimestampingGauge not implemented")
+}
+func (*UnimplementedQueryServer) BlockRewardDistribution(ctx context.Context, req *QueryBlockRewardDistributionRequest) (*QueryBlockRewardDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockRewardDistribution not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegationRewardLockup(ctx context.Context, req *QueryBTCDelegationRewardLockupRequest) (*QueryBTCDelegationRewardLockupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegationRewardLockup not implemented")
+}
+func (*UnimplementedQueryServer) LifetimeRewards(ctx context.Context, req *QueryLifetimeRewardsRequest) (*QueryLifetimeRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LifetimeRewards not implemented")
+}
+func (*UnimplementedQueryServer) RewardGaugeDenoms(ctx context.Context, req *QueryRewardGaugeDenomsRequest) (*QueryRewardGaugeDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardGaugeDenoms not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorRewardsByValidator(ctx context.Context, req *QueryDelegatorRewardsByValidatorRequest) (*QueryDelegatorRewardsByValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorRewardsByValidator not implemented")
+}
+func (*UnimplementedQueryServer) RewardPoolBalance(ctx context.Context, req *QueryRewardPoolBalanceRequest) (*QueryRewardPoolBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardPoolBalance not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.incentive.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardGauges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardGaugesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardGauges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.incentive.Query/RewardGauges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardGauges(ctx, req.(*QueryRewardGaugesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCStakingGauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCStakingGaugeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCStakingGauge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.incentive.Query/BTCStakingGauge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCStakingGauge(ctx, req.(*QueryBTCStakingGaugeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCTimestampingGauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCTimestampingGaugeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCTimestampingGauge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.incentive.Query/BTCTimestampingGauge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCTimestampingGauge(ctx, req.(*QueryBTCTimestampingGaugeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockRewardDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockRewardDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockRewardDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.incentive.Query/BlockRewardDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockRewardDistribution(ctx, req.(*QueryBlockRewardDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCDelegationRewardLockup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationRewardLockupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegationRewardLockup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.incentive.Query/BTCDelegationRewardLockup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegationRewardLockup(ctx, req.(*QueryBTCDelegationRewardLockupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LifetimeRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLifetimeRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LifetimeRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.incentive.Query/LifetimeRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LifetimeRewards(ctx, req.(*QueryLifetimeRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardGaugeDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardGaugeDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardGaugeDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.incentive.Query/RewardGaugeDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardGaugeDenoms(ctx, req.(*QueryRewardGaugeDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorRewardsByValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorRewardsByValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorRewardsByValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.incentive.Query/DelegatorRewardsByValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorRewardsByValidator(ctx, req.(*QueryDelegatorRewardsByValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardPoolBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardPoolBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardPoolBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.incentive.Query/RewardPoolBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardPoolBalance(ctx, req.(*QueryRewardPoolBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylon.incentive.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "RewardGauges",
+			Handler:    _Query_RewardGauges_Handler,
+		},
+		{
+			MethodName: "BTCStakingGauge",
+			Handler:    _Query_BTCStakingGauge_Handler,
+		},
+		{
+			MethodName: "BTCTimestampingGauge",
+			Handler:    _Query_BTCTimestampingGauge_Handler,
+		},
+		{
+			MethodName: "BlockRewardDistribution",
+			Handler:    _Query_BlockRewardDistribution_Handler,
+		},
+		{
+			MethodName: "BTCDelegationRewardLockup",
+			Handler:    _Query_BTCDelegationRewardLockup_Handler,
+		},
+		{
+			MethodName: "LifetimeRewards",
+			Handler:    _Query_LifetimeRewards_Handler,
+		},
+		{
+			MethodName: "RewardGaugeDenoms",
+			Handler:    _Query_RewardGaugeDenoms_Handler,
+		},
+		{
+			MethodName: "DelegatorRewardsByValidator",
+			Handler:    _Query_DelegatorRewardsByValidator_Handler,
+		},
+		{
+			MethodName: "RewardPoolBalance",
+			Handler:    _Query_RewardPoolBalance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "babylon/incentive/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardGaugesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardGaugesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardGaugesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardGaugesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardGaugesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardGaugesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardGauges) > 0 {
+		for k := range m.RewardGauges {
+			v := m.RewardGauges[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintQuery(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCStakingGaugeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCStakingGaugeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCStakingGaugeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCStakingGaugeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCStakingGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCStakingGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gauge != nil {
+		{
+			size, err := m.Gauge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCTimestampingGaugeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCTimestampingGaugeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCTimestampingGaugeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCTimestampingGaugeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCTimestampingGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCTimestampingGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gauge != nil {
+		{
+			size, err := m.Gauge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockRewardDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockRewardDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockRewardDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockRewardDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockRewardDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockRewardDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Distribution != nil {
+		{
+			size, err := m.Distribution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationRewardLockupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationRewardLockupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationRewardLockupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationRewardLockupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationRewardLockupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationRewardLockupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RewardActiveEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RewardActiveEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RewardStartEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RewardStartEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLifetimeRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLifetimeRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLifetimeRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakeholderType) > 0 {
+		i -= len(m.StakeholderType)
+		copy(dAtA[i:], m.StakeholderType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakeholderType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLifetimeRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLifetimeRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLifetimeRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LifetimeRewards != nil {
+		{
+			size, err := m.LifetimeRewards.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardGaugeDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardGaugeDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardGaugeDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardGaugeDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardGaugeDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardGaugeDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawableDenoms) > 0 {
+		for iNdEx := len(m.WithdrawableDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WithdrawableDenoms[iNdEx])
+			copy(dAtA[i:], m.WithdrawableDenoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawableDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorRewardsByValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorRewardsByValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorRewardsByValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelBtcPkHex) > 0 {
+		i -= len(m.DelBtcPkHex)
+		copy(dAtA[i:], m.DelBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorRewardsByValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorRewardsByValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorRewardsByValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPoolBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPoolBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPoolBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPoolBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPoolBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPoolBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Denoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ModuleBalance) > 0 {
+		for iNdEx := len(m.ModuleBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModuleBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ToBeIntercepted) > 0 {
+		for iNdEx := len(m.ToBeIntercepted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ToBeIntercepted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FeeCollectorBalance) > 0 {
+		for iNdEx := len(m.FeeCollectorBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeCollectorBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardPoolDenomBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardPoolDenomBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardPoolDenomBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ModuleAmount.Size()
+		i -= size
+		if _, err := m.ModuleAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ToBeInterceptedAmount.Size()
+		i -= size
+		if _, err := m.ToBeInterceptedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.FeeCollectorAmount.Size()
+		i -= size
+		if _, err := m.FeeCollectorAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRewardGaugesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardGaugesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RewardGauges) > 0 {
+		for k, v := range m.RewardGauges {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovQuery(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryBTCStakingGaugeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryBTCStakingGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gauge != nil {
+		l = m.Gauge.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCTimestampingGaugeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	return n
+}
+
+func (m *QueryBTCTimestampingGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gauge != nil {
+		l = m.Gauge.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockRewardDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryBlockRewardDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Distribution != nil {
+		l = m.Distribution.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationRewardLockupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationRewardLockupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RewardStartEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.RewardStartEpoch))
+	}
+	if m.RewardActiveEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.RewardActiveEpoch))
+	}
+	return n
+}
+
+func (m *QueryLifetimeRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.StakeholderType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLifetimeRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LifetimeRewards != nil {
+		l = m.LifetimeRewards.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardGaugeDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardGaugeDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.WithdrawableDenoms) > 0 {
+		for _, s := range m.WithdrawableDenoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegatorRewardsByValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorRewardsByValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRewardPoolBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRewardPoolBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeCollectorBalance) > 0 {
+		for _, e := range m.FeeCollectorBalance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ToBeIntercepted) > 0 {
+		for _, e := range m.ToBeIntercepted {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ModuleBalance) > 0 {
+		for _, e := range m.ModuleBalance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Denoms) > 0 {
+		for _, e := range m.Denoms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardPoolDenomBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.FeeCollectorAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ToBeInterceptedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ModuleAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardGaugesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardGaugesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardGaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardGaugesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardGaugesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardGauges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RewardGauges == nil {
+				m.RewardGauges = make(map[string]*RewardGauge)
+			}
+			var mapkey string
+			var mapvalue *RewardGauge
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &RewardGauge{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RewardGauges[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCStakingGaugeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCStakingGaugeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCStakingGaugeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCStakingGaugeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCStakingGaugeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCStakingGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gauge == nil {
+				m.Gauge = &Gauge{}
+			}
+			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCTimestampingGaugeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCTimestampingGaugeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCTimestampingGaugeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCTimestampingGaugeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCTimestampingGaugeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCTimestampingGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gauge == nil {
+				m.Gauge = &Gauge{}
+			}
+			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockRewardDistributionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockRewardDistributionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockRewardDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockRewardDistributionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockRewardDistributionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockRewardDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Distribution == nil {
+				m.Distribution = &BlockRewardDistribution{}
+			}
+			if err := m.Distribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationRewardLockupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationRewardLockupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationRewardLockupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationRewardLockupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationRewardLockupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationRewardLockupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardStartEpoch", wireType)
+			}
+			m.RewardStartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardStartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardActiveEpoch", wireType)
+			}
+			m.RewardActiveEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardActiveEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLifetimeRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLifetimeRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLifetimeRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeholderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeholderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLifetimeRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLifetimeRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLifetimeRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LifetimeRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LifetimeRewards == nil {
+				m.LifetimeRewards = &LifetimeRewards{}
+			}
+			if err := m.LifetimeRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryBTCTimestampingGaugeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Gauge != nil {
-		l = m.Gauge.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardGaugeDenomsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1048,12 +3998,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardGaugeDenomsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardGaugeDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1075,7 +4057,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardGaugeDenomsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1098,17 +4080,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardGaugeDenomsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardGaugeDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1118,24 +4100,55 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawableDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.WithdrawableDenoms = append(m.WithdrawableDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1158,7 +4171,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardGaugesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorRewardsByValidatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1181,15 +4194,15 @@ func (m *QueryRewardGaugesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardGaugesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorRewardsByValidatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardGaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorRewardsByValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelBtcPkHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1217,7 +4230,7 @@ func (m *QueryRewardGaugesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.DelBtcPkHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1240,7 +4253,7 @@ func (m *QueryRewardGaugesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardGaugesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorRewardsByValidatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1263,15 +4276,15 @@ func (m *QueryRewardGaugesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardGaugesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorRewardsByValidatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorRewardsByValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardGauges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1298,105 +4311,44 @@ func (m *QueryRewardGaugesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RewardGauges == nil {
-				m.RewardGauges = make(map[string]*RewardGauge)
+			m.Rewards = append(m.Rewards, &DelegatorValidatorRewards{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue *RewardGauge
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &RewardGauge{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.RewardGauges[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1419,7 +4371,7 @@ func (m *QueryRewardGaugesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBTCStakingGaugeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardPoolBalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1442,31 +4394,12 @@ func (m *QueryBTCStakingGaugeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCStakingGaugeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardPoolBalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCStakingGaugeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardPoolBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1488,7 +4421,7 @@ func (m *QueryBTCStakingGaugeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBTCStakingGaugeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardPoolBalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1511,15 +4444,15 @@ func (m *QueryBTCStakingGaugeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCStakingGaugeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardPoolBalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCStakingGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardPoolBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollectorBalance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1546,10 +4479,110 @@ func (m *QueryBTCStakingGaugeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gauge == nil {
-				m.Gauge = &Gauge{}
+			m.FeeCollectorBalance = append(m.FeeCollectorBalance, types.Coin{})
+			if err := m.FeeCollectorBalance[len(m.FeeCollectorBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBeIntercepted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToBeIntercepted = append(m.ToBeIntercepted, types.Coin{})
+			if err := m.ToBeIntercepted[len(m.ToBeIntercepted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleBalance = append(m.ModuleBalance, types.Coin{})
+			if err := m.ModuleBalance[len(m.ModuleBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, &RewardPoolDenomBalance{})
+			if err := m.Denoms[len(m.Denoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1574,7 +4607,7 @@ func (m *QueryBTCStakingGaugeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBTCTimestampingGaugeRequest) Unmarshal(dAtA []byte) error {
+func (m *RewardPoolDenomBalance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1597,17 +4630,17 @@ func (m *QueryBTCTimestampingGaugeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCTimestampingGaugeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardPoolDenomBalance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCTimestampingGaugeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardPoolDenomBalance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.EpochNum = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1617,66 +4650,63 @@ func (m *QueryBTCTimestampingGaugeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNum |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBTCTimestampingGaugeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollectorAmount", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.FeeCollectorAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCTimestampingGaugeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCTimestampingGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBeInterceptedAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1686,25 +4716,57 @@ func (m *QueryBTCTimestampingGaugeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gauge == nil {
-				m.Gauge = &Gauge{}
+			if err := m.ToBeInterceptedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ModuleAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -39,6 +39,9 @@ type MsgWithdrawReward struct {
 	// address is the address of the stakeholder in bech32 string
 	// signer of this msg has to be this address
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// destination_address is the address in bech32 string that receives the
+	// withdrawn reward. If empty, the reward is sent to the stakeholder address
+	DestinationAddress string `protobuf:"bytes,3,opt,name=destination_address,json=destinationAddress,proto3" json:"destination_address,omitempty"`
 }
 
 func (m *MsgWithdrawReward) Reset()         { *m = MsgWithdrawReward{} }
@@ -88,6 +91,13 @@ func (m *MsgWithdrawReward) GetAddress() string {
 	return ""
 }
 
+func (m *MsgWithdrawReward) GetDestinationAddress() string {
+	if m != nil {
+		return m.DestinationAddress
+	}
+	return ""
+}
+
 // MsgWithdrawRewardResponse is the response to the MsgWithdrawReward message
 type MsgWithdrawRewardResponse struct {
 	// coins is the withdrawed coins
@@ -241,37 +251,38 @@ func init() {
 func init() { proto.RegisterFile("babylon/incentive/tx.proto", fileDescriptor_b4de6776d39a3a22) }
 
 var fileDescriptor_b4de6776d39a3a22 = []byte{
-	// 467 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x6b, 0xd4, 0x40,
-	0x1c, 0xdd, 0xb1, 0x7f, 0xa4, 0x63, 0xa9, 0x74, 0x28, 0x34, 0x9b, 0x43, 0x5a, 0x82, 0x87, 0x65,
-	0xb1, 0x19, 0xb7, 0x82, 0x42, 0x6f, 0xc6, 0xa3, 0x2c, 0x4a, 0x44, 0x04, 0x0f, 0xca, 0x24, 0x19,
-	0x26, 0x83, 0x66, 0x26, 0x64, 0xa6, 0xdb, 0xee, 0x45, 0xc4, 0x4f, 0x20, 0x7e, 0x0c, 0x4f, 0x3d,
-	0xf8, 0x21, 0x7a, 0x2c, 0x3d, 0x79, 0x52, 0xd9, 0x3d, 0xf4, 0x6b, 0xc8, 0x64, 0x26, 0xed, 0xda,
-	0x14, 0xf4, 0x34, 0xf3, 0xdb, 0xf7, 0xe6, 0xfd, 0xde, 0xef, 0xfd, 0x36, 0xd0, 0x4f, 0x49, 0x3a,
-	0xfd, 0x20, 0x05, 0xe6, 0x22, 0xa3, 0x42, 0xf3, 0x09, 0xc5, 0xfa, 0x38, 0xaa, 0x6a, 0xa9, 0x25,
-	0xda, 0x74, 0x58, 0x74, 0x89, 0xf9, 0x5b, 0x4c, 0x32, 0xd9, 0xa0, 0xd8, 0xdc, 0x2c, 0xd1, 0xef,
-	0x67, 0x52, 0x95, 0x52, 0xbd, 0xb3, 0x80, 0x2d, 0x1c, 0xb4, 0x6d, 0x2b, 0x5c, 0x2a, 0x86, 0x27,
-	0x23, 0x73, 0x38, 0x20, 0x70, 0x40, 0x4a, 0x14, 0xc5, 0x93, 0x51, 0x4a, 0x35, 0x19, 0xe1, 0x4c,
-	0x72, 0xd1, 0xe2, 0x5d, 0x63, 0x15, 0xa9, 0x49, 0xe9, 0x84, 0xc3, 0xe7, 0x70, 0x73, 0xac, 0xd8,
-	0x6b, 0xae, 0x8b, 0xbc, 0x26, 0x47, 0x09, 0x3d, 0x22, 0x75, 0x8e, 0x10, 0x5c, 0xd6, 0xd3, 0x8a,
-	0x7a, 0x60, 0x17, 0x0c, 0xd6, 0x92, 0xe6, 0x8e, 0x3c, 0x78, 0x9b, 0xe4, 0x79, 0x4d, 0x95, 0xf2,
-	0x6e, 0x35, 0x3f, 0xb7, 0xe5, 0xc1, 0xfa, 0xe7, 0x8b, 0x93, 0x61, 0x5b, 0x85, 0x1f, 0x61, 0xbf,
-	0x23, 0x98, 0x50, 0x55, 0x49, 0xa1, 0x28, 0x22, 0x70, 0xc5, 0x78, 0x53, 0x1e, 0xd8, 0x5d, 0x1a,
-	0xdc, 0xd9, 0xef, 0x47, 0x6e, 0x48, 0xe3, 0x3e, 0x72, 0xee, 0xa3, 0xa7, 0x92, 0x8b, 0xf8, 0xc1,
-	0xe9, 0xcf, 0x9d, 0xde, 0xb7, 0x5f, 0x3b, 0x03, 0xc6, 0x75, 0x71, 0x98, 0x46, 0x99, 0x2c, 0x5d,
-	0x22, 0xee, 0xd8, 0x53, 0xf9, 0x7b, 0x6c, 0x9c, 0xa9, 0xe6, 0x81, 0x4a, 0xac, 0x72, 0xf8, 0x15,
-	0xc0, 0xbb, 0x63, 0xc5, 0x5e, 0x55, 0x39, 0xd1, 0xf4, 0x45, 0x33, 0x2a, 0x7a, 0x04, 0xd7, 0xc8,
-	0xa1, 0x2e, 0x64, 0xcd, 0xf5, 0xd4, 0x0e, 0x15, 0x7b, 0xe7, 0xdf, 0xf7, 0xb6, 0x5c, 0xf7, 0x27,
-	0xd6, 0xfa, 0x4b, 0x5d, 0x73, 0xc1, 0x92, 0x2b, 0x2a, 0x7a, 0x0c, 0x57, 0x6d, 0x58, 0xcd, 0xc8,
-	0xc6, 0x6f, 0x67, 0x95, 0x91, 0x6d, 0x11, 0x2f, 0x1b, 0xbf, 0x89, 0xa3, 0x1f, 0x6c, 0x98, 0x48,
-	0xae, 0x84, 0xc2, 0x3e, 0xdc, 0xbe, 0xe6, 0xa9, 0x8d, 0x64, 0xff, 0x1c, 0xc0, 0xa5, 0xb1, 0x62,
-	0x28, 0x87, 0x1b, 0xd7, 0xb6, 0x70, 0xef, 0x86, 0x6e, 0x9d, 0x68, 0xfd, 0xfb, 0xff, 0xc3, 0xba,
-	0x5c, 0xc0, 0x5b, 0xb8, 0xfe, 0x57, 0x32, 0xe1, 0xcd, 0xaf, 0x17, 0x39, 0xfe, 0xf0, 0xdf, 0x9c,
-	0x56, 0xdf, 0x5f, 0xf9, 0x74, 0x71, 0x32, 0x04, 0xf1, 0xb3, 0xd3, 0x59, 0x00, 0xce, 0x66, 0x01,
-	0xf8, 0x3d, 0x0b, 0xc0, 0x97, 0x79, 0xd0, 0x3b, 0x9b, 0x07, 0xbd, 0x1f, 0xf3, 0xa0, 0xf7, 0x66,
-	0xb4, 0xb0, 0x4f, 0x27, 0x9b, 0x15, 0x84, 0x8b, 0xb6, 0xc0, 0xc7, 0x8b, 0x9f, 0x90, 0x59, 0x6f,
-	0xba, 0xda, 0xfc, 0x53, 0x1f, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0xc7, 0x73, 0x0d, 0x40, 0x64,
-	0x03, 0x00, 0x00,
+	// 484 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcf, 0x6a, 0xd4, 0x40,
+	0x1c, 0xde, 0x71, 0xdb, 0x4a, 0xc7, 0x52, 0xe9, 0x58, 0x68, 0x36, 0x87, 0xb4, 0x04, 0x0f, 0xcb,
+	0x62, 0x33, 0x6e, 0x05, 0x85, 0xde, 0x5c, 0x8f, 0xb2, 0x20, 0x11, 0x11, 0x3c, 0x58, 0x26, 0xc9,
+	0x90, 0x1d, 0x74, 0x67, 0x42, 0x7e, 0xd3, 0x6d, 0xf7, 0x22, 0xc5, 0x27, 0x10, 0x1f, 0xc3, 0x53,
+	0x0f, 0x3e, 0x44, 0x8f, 0xa5, 0x27, 0x4f, 0x2a, 0xbb, 0x87, 0xbe, 0x86, 0x4c, 0x66, 0xd2, 0xae,
+	0x4d, 0x41, 0x4f, 0x33, 0xbf, 0x7c, 0xdf, 0xef, 0xdf, 0xf7, 0x65, 0xb0, 0x9f, 0xb0, 0x64, 0xfa,
+	0x51, 0x49, 0x2a, 0x64, 0xca, 0xa5, 0x16, 0x13, 0x4e, 0xf5, 0x71, 0x54, 0x94, 0x4a, 0x2b, 0xb2,
+	0xe1, 0xb0, 0xe8, 0x0a, 0xf3, 0x37, 0x73, 0x95, 0xab, 0x0a, 0xa5, 0xe6, 0x66, 0x89, 0x7e, 0x27,
+	0x55, 0x30, 0x56, 0x70, 0x60, 0x01, 0x1b, 0x38, 0x68, 0xcb, 0x46, 0x74, 0x0c, 0x39, 0x9d, 0xf4,
+	0xcd, 0xe1, 0x80, 0xc0, 0x01, 0x09, 0x03, 0x4e, 0x27, 0xfd, 0x84, 0x6b, 0xd6, 0xa7, 0xa9, 0x12,
+	0xb2, 0xc6, 0x9b, 0x83, 0x15, 0xac, 0x64, 0x63, 0x57, 0x38, 0x3c, 0x41, 0x78, 0x63, 0x08, 0xf9,
+	0x5b, 0xa1, 0x47, 0x59, 0xc9, 0x8e, 0x62, 0x7e, 0xc4, 0xca, 0x8c, 0x10, 0xbc, 0xa4, 0xa7, 0x05,
+	0xf7, 0xd0, 0x0e, 0xea, 0xae, 0xc6, 0xd5, 0x9d, 0x78, 0xf8, 0x2e, 0xcb, 0xb2, 0x92, 0x03, 0x78,
+	0x77, 0xaa, 0xcf, 0x75, 0x48, 0x28, 0x7e, 0x90, 0x71, 0xd0, 0x42, 0x32, 0x2d, 0x94, 0x3c, 0xa8,
+	0x59, 0xed, 0x8a, 0x45, 0x16, 0xa0, 0xe7, 0x16, 0xd9, 0x5f, 0xfb, 0x7c, 0x79, 0xda, 0xab, 0xd3,
+	0xc3, 0x4f, 0xb8, 0xd3, 0x98, 0x20, 0xe6, 0x50, 0x28, 0x09, 0x9c, 0x30, 0xbc, 0x6c, 0xb6, 0x01,
+	0x0f, 0xed, 0xb4, 0xbb, 0xf7, 0xf6, 0x3a, 0x91, 0x93, 0xc5, 0xec, 0x1b, 0xb9, 0x7d, 0xa3, 0x17,
+	0x4a, 0xc8, 0xc1, 0xe3, 0xb3, 0x9f, 0xdb, 0xad, 0x6f, 0xbf, 0xb6, 0xbb, 0xb9, 0xd0, 0xa3, 0xc3,
+	0x24, 0x4a, 0xd5, 0xd8, 0x69, 0xe8, 0x8e, 0x5d, 0xc8, 0x3e, 0x50, 0xb3, 0x0a, 0x54, 0x09, 0x10,
+	0xdb, 0xca, 0xe1, 0x57, 0x84, 0xef, 0x0f, 0x21, 0x7f, 0x53, 0x64, 0x4c, 0xf3, 0x57, 0x95, 0x38,
+	0xe4, 0x29, 0x5e, 0x65, 0x87, 0x7a, 0xa4, 0x4a, 0xa1, 0xa7, 0x56, 0x85, 0x81, 0x77, 0xf1, 0x7d,
+	0x77, 0xd3, 0x75, 0x77, 0x8b, 0xbc, 0xd6, 0xa5, 0x90, 0x79, 0x7c, 0x4d, 0x25, 0xcf, 0xf0, 0x8a,
+	0x95, 0xb7, 0xd2, 0xc8, 0xcc, 0xdb, 0x30, 0x3f, 0xb2, 0x2d, 0x06, 0x4b, 0x66, 0xde, 0xd8, 0xd1,
+	0xf7, 0xd7, 0x8d, 0x24, 0xd7, 0x85, 0xc2, 0x0e, 0xde, 0xba, 0x31, 0x53, 0x2d, 0xc9, 0xde, 0x05,
+	0xc2, 0xed, 0x21, 0xe4, 0x24, 0xc3, 0xeb, 0x37, 0x6c, 0x7b, 0x78, 0x4b, 0xb7, 0x86, 0xb4, 0xfe,
+	0xa3, 0xff, 0x61, 0x5d, 0x19, 0xf0, 0x1e, 0xaf, 0xfd, 0xa5, 0x4c, 0x78, 0x7b, 0xf6, 0x22, 0xc7,
+	0xef, 0xfd, 0x9b, 0x53, 0xd7, 0xf7, 0x97, 0x4f, 0x2e, 0x4f, 0x7b, 0x68, 0xf0, 0xf2, 0x6c, 0x16,
+	0xa0, 0xf3, 0x59, 0x80, 0x7e, 0xcf, 0x02, 0xf4, 0x65, 0x1e, 0xb4, 0xce, 0xe7, 0x41, 0xeb, 0xc7,
+	0x3c, 0x68, 0xbd, 0xeb, 0x2f, 0xf8, 0xe9, 0xca, 0xa6, 0x23, 0x26, 0x64, 0x1d, 0xd0, 0xe3, 0xc5,
+	0x47, 0x67, 0xec, 0x4d, 0x56, 0xaa, 0x7f, 0xfb, 0xc9, 0x9f, 0x01, 0x00, 0x81, 0x41, 0xd4, 0x24,
+	0x96, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -414,6 +425,13 @@ func (m *MsgWithdrawReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DestinationAddress) > 0 {
+		i -= len(m.DestinationAddress)
+		copy(dAtA[i:], m.DestinationAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -556,6 +574,10 @@ func (m *MsgWithdrawReward) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.DestinationAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -697,6 +719,38 @@ func (m *MsgWithdrawReward) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
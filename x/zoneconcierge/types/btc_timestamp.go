@@ -124,7 +124,7 @@ func VerifyEpochSealed(epoch *epochingtypes.Epoch, rawCkpt *checkpointingtypes.R
 	}
 	// ensure the signerSet has > 2/3 voting power
 	if signerSetPower*3 <= valSet.GetTotalPower()*2 {
-		return checkpointingtypes.ErrInsufficientVotingPower
+		return checkpointingtypes.ErrInsufficientVotingPower.Wrapf("signer set power: %d, total power: %d", signerSetPower, valSet.GetTotalPower())
 	}
 	// verify BLS multisig
 	signedMsgBytes := rawCkpt.SignedMsg()